@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+)
+
+// newAnonymization은 anonymization 설정으로 익명화 작업 유즈케이스를 생성합니다
+// hash_key_env를 지정했으면 환경변수가 비어 있을 때 시작하지 않습니다 (키 없이 hash 규칙을 받지 않도록)
+func newAnonymization(documentUC *usecase.DocumentUseCase, cfg *config.AnonymizationConfig) (*usecase.AnonymizationUseCase, error) {
+	var hashKey []byte
+	if cfg.HashKeyEnv != "" {
+		hashKey = []byte(os.Getenv(cfg.HashKeyEnv))
+		if len(hashKey) == 0 {
+			return nil, fmt.Errorf("anonymization hash key: environment variable %s is empty", cfg.HashKeyEnv)
+		}
+	}
+	return usecase.NewAnonymizationUseCase(documentUC, hashKey, cfg.BatchSize, cfg.BatchInterval), nil
+}
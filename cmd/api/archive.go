@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/archive"
+)
+
+// defaultArchiveBatchSize는 archive.batch_size가 없을 때 한 번에 컬렉션당 옮길 문서 수입니다
+const defaultArchiveBatchSize = 1000
+
+// newArchivePolicies는 archive 설정을 컬렉션별 보관 정책으로 변환합니다
+func newArchivePolicies(cfg *config.ArchiveConfig) []usecase.ArchivePolicy {
+	policies := make([]usecase.ArchivePolicy, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		policies = append(policies, usecase.ArchivePolicy{
+			Collection: c.Name,
+			After:      c.After,
+		})
+	}
+	return policies
+}
+
+// startArchiver는 보관 저장소와 정책을 설정하고 콜드 데이터 보관 작업을 백그라운드에서 시작합니다
+func startArchiver(ctx context.Context, cfg *config.ArchiveConfig, documentUC *usecase.DocumentUseCase, jobs *singletonJobs) error {
+	store, err := newObjectStore(ctx, cfg.Storage, cfg.LocalPath, &cfg.S3)
+	if err != nil {
+		return err
+	}
+	documentUC.SetArchiving(archive.New(store), newArchivePolicies(cfg))
+
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultArchiveBatchSize
+	}
+	jobs.Go(ctx, func(ctx context.Context) { documentUC.RunArchiver(ctx, interval, batchSize) })
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
+)
+
+// newOIDCVerifier는 설정으로부터 OIDC 토큰 검증기를 생성합니다
+func newOIDCVerifier(ctx context.Context, cfg *config.AuthConfig) (*auth.OIDCVerifier, error) {
+	oidcCfg := auth.DefaultOIDCConfig()
+	oidcCfg.IssuerURL = cfg.OIDC.IssuerURL
+	oidcCfg.Audiences = cfg.OIDC.Audiences
+	oidcCfg.TenantClaim = cfg.OIDC.TenantClaim
+
+	if len(cfg.OIDC.RolesClaims) > 0 {
+		oidcCfg.RolesClaims = cfg.OIDC.RolesClaims
+	}
+	if cfg.OIDC.UsernameClaim != "" {
+		oidcCfg.UsernameClaim = cfg.OIDC.UsernameClaim
+	}
+	if len(cfg.OIDC.AllowedAlgorithms) > 0 {
+		oidcCfg.AllowedAlgorithms = cfg.OIDC.AllowedAlgorithms
+	}
+	if cfg.OIDC.JWKSRefreshInterval > 0 {
+		oidcCfg.JWKSRefreshInterval = cfg.OIDC.JWKSRefreshInterval
+	}
+	if cfg.OIDC.ClockSkew > 0 {
+		oidcCfg.ClockSkew = cfg.OIDC.ClockSkew
+	}
+
+	for _, m := range cfg.OIDC.RoleMappings {
+		oidcCfg.RoleMappings = append(oidcCfg.RoleMappings, auth.RoleMapping{
+			ClaimValue: m.ClaimValue,
+			Role:       auth.Role(m.Role),
+		})
+	}
+	for _, r := range cfg.OIDC.DefaultRoles {
+		oidcCfg.DefaultRoles = append(oidcCfg.DefaultRoles, auth.Role(r))
+	}
+
+	return auth.NewOIDCVerifier(ctx, oidcCfg)
+}
+
+// newRowPolicySet은 설정으로부터 행 수준 보안 규칙을 생성합니다
+// 규칙이 없으면 nil을 반환합니다
+func newRowPolicySet(cfg *config.AuthConfig) (*auth.RowPolicySet, error) {
+	if len(cfg.RowPolicies) == 0 {
+		return nil, nil
+	}
+
+	policies := make([]auth.RowPolicy, 0, len(cfg.RowPolicies))
+	for _, p := range cfg.RowPolicies {
+		policy := auth.RowPolicy{
+			Collection: p.Collection,
+			Field:      p.Field,
+			Claim:      p.Claim,
+		}
+		for _, r := range p.ExemptRoles {
+			policy.ExemptRoles = append(policy.ExemptRoles, auth.Role(r))
+		}
+		policies = append(policies, policy)
+	}
+
+	return auth.NewRowPolicySet(policies)
+}
+
+// newHMACVerifier는 설정으로부터 HMAC 요청 서명 검증기를 생성합니다
+// 사용한 서명은 Redis에 기록해 모든 인스턴스에서 재전송을 거부합니다
+func newHMACVerifier(cfg *config.HMACConfig, redisCache *cache.RedisCache) (*auth.HMACVerifier, error) {
+	hmacCfg := &auth.HMACConfig{
+		Tolerance:   cfg.Tolerance,
+		ReplayCache: cache.NewRedisExtended(redisCache.Client()).NewSignatureReplayCache("auth:hmac:replay"),
+	}
+
+	for _, k := range cfg.Keys {
+		secret := k.Secret
+		if k.SecretEnv != "" {
+			secret = os.Getenv(k.SecretEnv)
+			if secret == "" {
+				return nil, fmt.Errorf("hmac key %q: environment variable %s is empty", k.KeyID, k.SecretEnv)
+			}
+		}
+
+		key := auth.HMACKey{
+			ID:     k.KeyID,
+			Secret: []byte(secret),
+		}
+		for _, r := range k.Roles {
+			key.Roles = append(key.Roles, auth.Role(r))
+		}
+		hmacCfg.Keys = append(hmacCfg.Keys, key)
+	}
+
+	return auth.NewHMACVerifier(hmacCfg)
+}
+
+// newImpersonator는 설정으로부터 운영자 대리 실행 정책을 생성합니다
+func newImpersonator(cfg *config.ImpersonationConfig) (*auth.Impersonator, error) {
+	impersonationCfg := auth.ImpersonationConfig{}
+	for _, r := range cfg.AllowedRoles {
+		impersonationCfg.AllowedRoles = append(impersonationCfg.AllowedRoles, auth.Role(r))
+	}
+	for _, r := range cfg.TargetRoles {
+		impersonationCfg.TargetRoles = append(impersonationCfg.TargetRoles, auth.Role(r))
+	}
+	return auth.NewImpersonator(impersonationCfg)
+}
+
+// newLockoutGuard는 설정으로부터 인증 실패 잠금 가드를 생성합니다
+// 설정하지 않은 값은 lockout.DefaultPolicy를 따릅니다
+func newLockoutGuard(cfg *config.LockoutConfig, redisCache *cache.RedisCache) (*lockout.Guard, error) {
+	policy := lockout.DefaultPolicy()
+	if cfg.MaxFailures > 0 {
+		policy.MaxFailures = cfg.MaxFailures
+	}
+	if cfg.Window > 0 {
+		policy.Window = cfg.Window
+	}
+	if cfg.BaseDuration > 0 {
+		policy.BaseDuration = cfg.BaseDuration
+	}
+	if cfg.MaxDuration > 0 {
+		policy.MaxDuration = cfg.MaxDuration
+	}
+	if cfg.LevelTTL > 0 {
+		policy.LevelTTL = cfg.LevelTTL
+	}
+
+	store := cache.NewRedisExtended(redisCache.Client()).NewLockoutStore("auth:lockout")
+	return lockout.NewGuard(store, policy)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/backendrouting"
+)
+
+// newBackendRouting은 설정의 컬렉션별 백엔드 규칙으로 요청 라우터를 생성합니다
+// 기본 백엔드를 지정하지 않으면 mongodb가 켜져 있을 때 mongodb, 아니면 처음 활성화된 데이터베이스를 사용합니다
+func newBackendRouting(cfg *config.BackendRoutingConfig, enabledDatabases []string) (*backendrouting.Router, error) {
+	defaultBackend := cfg.Default
+	if defaultBackend == "" {
+		defaultBackend = enabledDatabases[0]
+		for _, db := range enabledDatabases {
+			if db == "mongodb" {
+				defaultBackend = db
+				break
+			}
+		}
+	}
+
+	routes := make([]backendrouting.Route, 0, len(cfg.Collections))
+	for _, route := range cfg.Collections {
+		routes = append(routes, backendrouting.Route{
+			Collection: route.Collection,
+			Backend:    route.Backend,
+		})
+	}
+	table, err := backendrouting.New(defaultBackend, enabledDatabases, routes)
+	if err != nil {
+		return nil, err
+	}
+	return backendrouting.NewRouter(table, cfg.DrainTimeout), nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/backup"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// newBackupUseCase는 backup 설정의 저장소(local, s3)로 백업/복원 유즈케이스를 생성합니다
+func newBackupUseCase(ctx context.Context, cfg *config.BackupConfig, repoManager *persistence.RepositoryManager) (*usecase.BackupUseCase, error) {
+	store, err := newObjectStore(ctx, cfg.Storage, cfg.LocalPath, &cfg.S3)
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.NewBackupUseCase(repoManager, store, usecase.BackupOptions{
+		BatchSize:  cfg.BatchSize,
+		Consistent: cfg.Consistent,
+	}), nil
+}
+
+// newObjectStore는 storage(local, s3)에 맞는 객체 저장소를 생성합니다 (백업과 콜드 데이터 보관에서 사용)
+func newObjectStore(ctx context.Context, storage, localPath string, s3cfg *config.BackupS3Config) (backup.Store, error) {
+	switch storage {
+	case "local":
+		localStore, err := backup.NewLocalStore(localPath)
+		if err != nil {
+			return nil, err
+		}
+		return localStore, nil
+	case "s3":
+		s3Store, err := backup.NewS3Store(ctx, backup.S3Config{
+			Bucket:          s3cfg.Bucket,
+			Prefix:          s3cfg.Prefix,
+			Region:          s3cfg.Region,
+			Endpoint:        s3cfg.Endpoint,
+			UsePathStyle:    s3cfg.UsePathStyle,
+			AccessKeyID:     s3cfg.AccessKeyID,
+			SecretAccessKey: s3cfg.SecretAccessKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s3Store, nil
+	default:
+		return nil, fmt.Errorf("unsupported object storage: %s", storage)
+	}
+}
+
+// startBackupSchedule은 backup.schedule이 켜져 있으면 주기적 백업을 백그라운드로 시작합니다
+func startBackupSchedule(ctx context.Context, cfg *config.BackupScheduleConfig, backupUC *usecase.BackupUseCase) {
+	if !cfg.Enabled {
+		return
+	}
+	dbType := cfg.DatabaseType
+	if dbType == "" {
+		dbType = "mongodb"
+	}
+	go backupUC.RunSchedule(ctx, cfg.Interval, dbType, cfg.Collections)
+	logger.Info(ctx, "scheduled collection backups enabled",
+		zap.Duration("interval", cfg.Interval),
+		zap.String("database_type", dbType),
+		zap.Strings("collections", cfg.Collections),
+	)
+}
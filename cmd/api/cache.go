@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// newRedisCache는 설정된 배포 모드(standalone, sentinel, cluster)로 Redis 캐시를 생성합니다
+func newRedisCache(ctx context.Context, cfg *config.RedisConfig) (*cache.RedisCache, error) {
+	return cache.NewRedisCache(ctx, &cache.Config{
+		Mode:             cfg.Mode,
+		Host:             cfg.Host,
+		Port:             cfg.Port,
+		Addresses:        cfg.Addresses,
+		MasterName:       cfg.MasterName,
+		SentinelPassword: cfg.SentinelPassword,
+		Password:         cfg.Password,
+		DB:               cfg.DB,
+		MaxRetries:       cfg.MaxRetries,
+		PoolSize:         cfg.PoolSize,
+		MinIdleConn:      cfg.MinIdleConns,
+		DialTimeout:      cfg.DialTimeout,
+		ReadTimeout:      cfg.ReadTimeout,
+		WriteTimeout:     cfg.WriteTimeout,
+		PoolTimeout:      cfg.PoolTimeout,
+		ConnMaxIdleTime:  cfg.ConnMaxIdleTime,
+		ConnMaxLifetime:  cfg.ConnMaxLifetime,
+	})
+}
+
+// newCachePolicies는 설정으로부터 컬렉션별 캐시 전략을 생성합니다
+func newCachePolicies(cfg *config.CacheConfig) (*usecase.CachePolicies, error) {
+	policies := make([]usecase.CachePolicy, 0, len(cfg.Policies))
+	for _, p := range cfg.Policies {
+		policies = append(policies, usecase.CachePolicy{
+			Collection:           p.Collection,
+			Strategy:             usecase.CacheStrategy(p.Strategy),
+			TTL:                  p.TTL,
+			MaxDocumentSize:      p.MaxDocumentSize,
+			StaleWhileRevalidate: p.StaleWhileRevalidate,
+		})
+	}
+
+	return usecase.NewCachePolicies(usecase.CachePolicy{
+		Collection:      "*",
+		Strategy:        usecase.CacheStrategy(cfg.DefaultStrategy),
+		TTL:             cfg.DefaultTTL,
+		MaxDocumentSize: cfg.MaxDocumentSize,
+	}, policies)
+}
+
+// newWriteBehindQueue는 write-behind 캐시 큐를 생성합니다 (Start는 호출자가 수행)
+func newWriteBehindQueue(cfg *config.WriteBehindConfig, target repository.CacheRepository) *cache.WriteBehindQueue {
+	return cache.NewWriteBehindQueue(target, cache.WriteBehindConfig{
+		FlushInterval: cfg.FlushInterval,
+		BatchSize:     cfg.BatchSize,
+		MaxPending:    cfg.MaxPending,
+	})
+}
+
+// newDocumentCache는 유스케이스가 사용할 문서 캐시를 생성합니다
+// cache.backend가 memcached이면 memcached를, 아니면 Redis를 사용하며
+// cache.local.enabled이면 그 앞에 인프로세스 LRU 계층을 둡니다
+func newDocumentCache(ctx context.Context, cfg *config.CacheConfig, redisCache *cache.RedisCache) (repository.CacheRepository, error) {
+	var backend repository.CacheRepository = redisCache
+	if cfg.Backend == "memcached" {
+		memcached, err := cache.NewMemcachedCache(ctx, cache.MemcachedConfig{
+			Servers:      cfg.Memcached.Servers,
+			Timeout:      cfg.Memcached.Timeout,
+			MaxIdleConns: cfg.Memcached.MaxIdleConns,
+		})
+		if err != nil {
+			return nil, err
+		}
+		backend = memcached
+	}
+
+	if !cfg.Local.Enabled {
+		return backend, nil
+	}
+	return cache.NewTieredCache(cache.NewLocalCache(cache.LocalCacheConfig{
+		MaxEntries: cfg.Local.MaxEntries,
+		TTL:        cfg.Local.TTL,
+	}), backend), nil
+}
+
+// warmCache는 설정된 컬렉션의 문서를 캐시에 미리 적재합니다 (백그라운드 실행용)
+func warmCache(ctx context.Context, cfg *config.CacheWarmupConfig, documentUC *usecase.DocumentUseCase) {
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	total := 0
+	for _, target := range cfg.Collections {
+		warmed, err := documentUC.WarmCache(ctx, usecase.CacheWarmupTarget{
+			Collection: target.Collection,
+			IDs:        target.IDs,
+			Limit:      target.Limit,
+		})
+		if err != nil {
+			logger.Warn(ctx, "cache warmup failed",
+				zap.String("collection", target.Collection),
+				zap.Error(err),
+			)
+			continue
+		}
+		total += warmed
+	}
+
+	logger.Info(ctx, "cache warmup completed",
+		zap.Int("documents", total),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
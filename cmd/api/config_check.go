@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/cassandra"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/elasticsearch"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	gomysql "github.com/go-sql-driver/mysql"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// configProbeTimeout은 백엔드 하나의 연결 확인에 쓰는 시간 한도입니다
+const configProbeTimeout = 10 * time.Second
+
+// configLocation은 --config로 지정한 설정 파일을 LoadConfig의 디렉터리와 이름으로 나눕니다 (비어 있으면 ./configs/config.yaml)
+func configLocation(file string) (configPath, configName string) {
+	if file == "" {
+		return "./configs", "config"
+	}
+	return filepath.Dir(file), strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+}
+
+// runConfigCheck는 설정을 검증하고 활성화된 백엔드에 연결해 본 뒤, 비밀 값을 가린 최종 설정과 함께 결과를 JSON으로 출력합니다
+// 설정이 유효하고 연결에 실패한 백엔드가 없으면 0, 아니면 1을 반환합니다 (--validate-config)
+func runConfigCheck(configPath, configName string) int {
+	report := config.Check(context.Background(), configPath, configName, probeBackends)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write config report: %v\n", err)
+		return 1
+	}
+	if !report.OK() {
+		return 1
+	}
+	return 0
+}
+
+// probeBackends는 활성화된 데이터베이스와 Redis에 새 연결을 열어 확인한 뒤 닫습니다 (config.ProbeFunc)
+// Vault나 시크릿 매니저에서 자격증명을 받는 백엔드는 이 확인에서 자격증명을 가져오지 않으므로 건너뜁니다
+func probeBackends(ctx context.Context, cfg *config.Config) []config.ProbeResult {
+	type probe struct {
+		name          string
+		enabled       bool
+		externalCreds bool // Vault 또는 시크릿 매니저 자격증명
+		run           func(ctx context.Context) error
+	}
+	probes := []probe{
+		{"mongodb", cfg.MongoDB.Enabled, cfg.MongoDB.UseVault, func(ctx context.Context) error { return probeMongoDB(ctx, &cfg.MongoDB) }},
+		{"postgresql", cfg.PostgreSQL.Enabled, cfg.PostgreSQL.UseVault || cfg.PostgreSQL.SecretName != "", func(ctx context.Context) error { return probePostgreSQL(ctx, &cfg.PostgreSQL) }},
+		{"mysql", cfg.MySQL.Enabled, cfg.MySQL.UseVault || cfg.MySQL.SecretName != "", func(ctx context.Context) error { return probeMySQL(ctx, &cfg.MySQL) }},
+		{"cassandra", cfg.Cassandra.Enabled, cfg.Cassandra.UseVault, func(ctx context.Context) error { return probeCassandra(ctx, &cfg.Cassandra) }},
+		{"elasticsearch", cfg.Elasticsearch.Enabled, cfg.Elasticsearch.UseVault, func(ctx context.Context) error { return probeElasticsearch(ctx, &cfg.Elasticsearch) }},
+		{"vitess", cfg.Vitess.Enabled, cfg.Vitess.UseVault, func(ctx context.Context) error { return probeVitess(ctx, &cfg.Vitess) }},
+		{"redis", cfg.Redis.Enabled, cfg.Redis.UseVault, func(ctx context.Context) error { return probeRedis(ctx, &cfg.Redis) }},
+	}
+
+	results := make([]config.ProbeResult, 0, len(probes))
+	for _, p := range probes {
+		if !p.enabled {
+			continue
+		}
+		if p.externalCreds {
+			results = append(results, config.ProbeResult{
+				Backend: p.name,
+				Status:  config.ProbeStatusSkipped,
+				Error:   "credentials are issued by vault or a secret manager",
+			})
+			continue
+		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, configProbeTimeout)
+		start := time.Now()
+		err := p.run(probeCtx)
+		cancel()
+
+		result := config.ProbeResult{
+			Backend:   p.name,
+			Status:    config.ProbeStatusOK,
+			LatencyMS: time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			result.Status = config.ProbeStatusFailed
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// probeMongoDB는 MongoDB에 연결해 주 노드에 ping을 보냅니다
+func probeMongoDB(ctx context.Context, cfg *config.MongoDBConfig) error {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.URI))
+	if err != nil {
+		return fmt.Errorf("failed to connect to mongodb: %w", err)
+	}
+	defer func() { _ = client.Disconnect(context.Background()) }()
+	return client.Ping(ctx, nil)
+}
+
+// probePostgreSQL은 PostgreSQL 연결을 열어 ping합니다
+func probePostgreSQL(ctx context.Context, cfg *config.PostgreSQLConfig) error {
+	db, err := postgresql.NewClient(ctx, &postgresql.Config{
+		Host:         cfg.Host,
+		Port:         cfg.Port,
+		User:         cfg.User,
+		Password:     cfg.Password,
+		Database:     cfg.Database,
+		SSLMode:      cfg.SSLMode,
+		MaxOpenConns: 1,
+	})
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// probeMySQL은 MySQL 연결을 열어 ping합니다
+func probeMySQL(ctx context.Context, cfg *config.MySQLConfig) error {
+	db, err := mysql.NewClient(ctx, &mysql.Config{
+		Host:         cfg.Host,
+		Port:         cfg.Port,
+		User:         cfg.User,
+		Password:     cfg.Password,
+		Database:     cfg.Database,
+		Charset:      cfg.Charset,
+		ParseTime:    cfg.ParseTime,
+		MaxOpenConns: 1,
+	})
+	if err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// probeCassandra는 Cassandra 세션을 열었다가 닫습니다
+func probeCassandra(ctx context.Context, cfg *config.CassandraConfig) error {
+	session, err := cassandra.NewClient(ctx, &cassandra.Config{
+		Hosts:          cfg.Hosts,
+		Port:           cfg.Port,
+		Keyspace:       cfg.Keyspace,
+		Username:       cfg.Username,
+		Password:       cfg.Password,
+		Consistency:    cfg.Consistency,
+		NumConns:       1,
+		Timeout:        cfg.Timeout,
+		ConnectTimeout: cfg.ConnectTimeout,
+		LocalDC:        cfg.LocalDC,
+	})
+	if err != nil {
+		return err
+	}
+	session.Close()
+	return nil
+}
+
+// probeElasticsearch는 Elasticsearch 클라이언트를 만들어 ping합니다
+func probeElasticsearch(ctx context.Context, cfg *config.ElasticsearchConfig) error {
+	_, err := elasticsearch.NewClient(ctx, &elasticsearch.Config{
+		Addresses: cfg.Addresses,
+		Username:  cfg.Username,
+		Password:  cfg.Password,
+		APIKey:    cfg.APIKey,
+		CloudID:   cfg.CloudID,
+	})
+	return err
+}
+
+// probeVitess는 vtgate에 MySQL 프로토콜로 연결해 ping합니다
+func probeVitess(ctx context.Context, cfg *config.VitessConfig) error {
+	dsn := gomysql.NewConfig()
+	dsn.User = cfg.Username
+	dsn.Passwd = cfg.Password
+	dsn.Net = "tcp"
+	dsn.Addr = net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	dsn.DBName = cfg.Keyspace
+
+	db, err := sql.Open("mysql", dsn.FormatDSN())
+	if err != nil {
+		return fmt.Errorf("failed to open vitess connection: %w", err)
+	}
+	defer db.Close()
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to ping vitess: %w", err)
+	}
+	return nil
+}
+
+// probeRedis는 설정된 배포 모드로 Redis에 연결해 ping합니다
+func probeRedis(ctx context.Context, cfg *config.RedisConfig) error {
+	redisCache, err := newRedisCache(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	return redisCache.Client().Close()
+}
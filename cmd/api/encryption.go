@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// newDataCipher는 SQL data 컬럼 암호화에 사용할 Cipher를 생성합니다
+// vault 제공자는 Transit 엔진으로 데이터 키를 래핑하고, local 제공자는 환경변수의 마스터 키를 사용합니다
+func newDataCipher(ctx context.Context, cfg *config.EncryptionConfig, vaultClient *vault.Client) (*encryption.Cipher, error) {
+	var provider encryption.KeyProvider
+	switch cfg.Provider {
+	case "vault":
+		if vaultClient == nil {
+			return nil, fmt.Errorf("encryption.provider vault requires vault to be enabled")
+		}
+		provider = vault.NewTransitKeyProvider(vaultClient, cfg.TransitKey)
+	case "local":
+		masterKey, err := base64.StdEncoding.DecodeString(os.Getenv(cfg.LocalKeyEnv))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", cfg.LocalKeyEnv, err)
+		}
+		local, err := encryption.NewLocalKeyProvider(masterKey)
+		if err != nil {
+			return nil, err
+		}
+		provider = local
+	default:
+		return nil, fmt.Errorf("unsupported encryption provider: %s", cfg.Provider)
+	}
+
+	cipher, err := encryption.NewCipher(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "sql data column encryption enabled", zap.String("provider", cfg.Provider))
+	return cipher, nil
+}
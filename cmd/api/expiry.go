@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+)
+
+// newExpiryPolicies는 expiry 설정을 컬렉션별 만료 정책으로 변환합니다
+func newExpiryPolicies(cfg *config.ExpiryConfig) []usecase.ExpiryPolicy {
+	policies := make([]usecase.ExpiryPolicy, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		policies = append(policies, usecase.ExpiryPolicy{
+			Collection: c.Name,
+			DefaultTTL: c.DefaultTTL,
+		})
+	}
+	return policies
+}
+
+// startExpirySweeper는 만료 정책을 설정하고 만료 문서 정리를 백그라운드에서 시작합니다
+func startExpirySweeper(ctx context.Context, cfg *config.ExpiryConfig, documentUC *usecase.DocumentUseCase, jobs *singletonJobs) {
+	documentUC.SetExpiryPolicies(newExpiryPolicies(cfg))
+
+	interval := cfg.SweepInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	batchSize := cfg.SweepBatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	jobs.Go(ctx, func(ctx context.Context) { documentUC.RunExpirySweeper(ctx, interval, batchSize) })
+}
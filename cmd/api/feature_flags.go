@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// newFeatureFlags는 설정의 기능 플래그와 Redis 실행 중 변경 저장소로 플래그 평가기를 생성하고 새로 고침을 백그라운드에서 시작합니다
+// 비활성화되어 있으면 nil을 반환하며, 이 경우 모든 게이트가 켜진 것으로 동작합니다
+func newFeatureFlags(ctx context.Context, cfg *config.FeatureFlagsConfig, environment string, redisCache *cache.RedisCache) (*featureflag.Flags, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	flags := make([]featureflag.Flag, 0, len(cfg.Flags))
+	for _, f := range cfg.Flags {
+		tenants := make(map[string]bool, len(f.Tenants))
+		for _, t := range f.Tenants {
+			tenants[t.Tenant] = t.Enabled
+		}
+		flags = append(flags, featureflag.Flag{
+			Name:         f.Name,
+			Description:  f.Description,
+			Enabled:      f.Enabled,
+			Environments: f.Environments,
+			Tenants:      tenants,
+		})
+	}
+
+	key := cfg.RedisKey
+	if key == "" {
+		key = "feature_flags"
+	}
+	store := cache.NewRedisExtended(redisCache.Client()).NewFeatureFlagStore(key)
+	featureFlags, err := featureflag.New(environment, flags, store)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redis 장애로 시작이 막히지 않도록 첫 읽기 실패는 설정 값만으로 계속합니다
+	if err := featureFlags.Refresh(ctx); err != nil {
+		logger.Warn(ctx, "failed to load feature flag overrides, using configured values", zap.Error(err))
+	}
+
+	interval := cfg.RefreshInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	go featureFlags.Run(ctx, interval)
+	return featureFlags, nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// loadStartupFixtures는 fixtures.startup_set을 fixtures.database_type에 불러옵니다
+// 이미 불러온 문서는 다시 만들지 않으므로 재시작할 때마다 실행해도 안전합니다
+func loadStartupFixtures(ctx context.Context, cfg *config.FixturesConfig, fixtureUC *usecase.FixtureUseCase) error {
+	dbType := cfg.DatabaseType
+	if dbType == "" {
+		dbType = "mongodb"
+	}
+	ctx = context.WithValue(ctx, middleware.DatabaseTypeContextKey, middleware.DatabaseType(dbType))
+
+	resp, err := fixtureUC.Load(ctx, &dto.LoadFixturesRequest{Set: cfg.StartupSet, Overwrite: cfg.Overwrite})
+	if err != nil {
+		return err
+	}
+	if resp.Failed > 0 {
+		logger.Warn(ctx, "some fixture documents failed to load",
+			zap.String("database_type", dbType),
+			zap.Int64("failed", resp.Failed),
+		)
+	}
+	return nil
+}
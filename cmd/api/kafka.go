@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/schemaregistry"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// newKafkaSecurity는 설정으로부터 Kafka SASL/TLS 설정을 생성합니다
+// sasl.use_vault이면 Vault에서 자격증명을 가져오며, 반환된 관리자로 자동 갱신을 시작해야 합니다
+func newKafkaSecurity(ctx context.Context, cfg *config.KafkaSecurityConfig, vaultClient *vault.Client) (*kafka.SecurityConfig, *vault.KafkaCredentialsManager, error) {
+	security := &kafka.SecurityConfig{
+		SASL: kafka.SASLConfig{
+			Enabled:   cfg.SASL.Enabled,
+			Mechanism: cfg.SASL.Mechanism,
+			Username:  cfg.SASL.Username,
+			Password:  cfg.SASL.Password,
+		},
+		TLS: kafka.TLSConfig{
+			Enabled:            cfg.TLS.Enabled,
+			CAFile:             cfg.TLS.CAFile,
+			CertFile:           cfg.TLS.CertFile,
+			KeyFile:            cfg.TLS.KeyFile,
+			InsecureSkipVerify: cfg.TLS.InsecureSkipVerify,
+		},
+	}
+
+	if !cfg.SASL.Enabled || !cfg.SASL.UseVault {
+		return security, nil, nil
+	}
+	if vaultClient == nil {
+		return nil, nil, fmt.Errorf("kafka sasl credentials require vault to be enabled")
+	}
+
+	manager := vault.NewKafkaCredentialsManager(vaultClient, "")
+	creds, err := manager.GetCredentials(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	security.SASL.Username = creds.Username
+	security.SASL.Password = creds.Password
+	if len(creds.CACert) > 0 {
+		security.TLS.CAPEM = creds.CACert
+	}
+	if len(creds.ClientCert) > 0 && len(creds.ClientKey) > 0 {
+		security.TLS.CertPEM = creds.ClientCert
+		security.TLS.KeyPEM = creds.ClientKey
+	}
+
+	logger.Info(ctx, "using vault-managed kafka credentials",
+		zap.String("username", creds.Username),
+		zap.String("mechanism", cfg.SASL.Mechanism),
+	)
+	return security, manager, nil
+}
+
+// watchKafkaCredentials는 Vault 자격증명이 갱신되면 프로듀서를 새 자격증명으로 다시 연결합니다
+func watchKafkaCredentials(ctx context.Context, manager *vault.KafkaCredentialsManager, producer *kafka.Producer) {
+	manager.OnRotate(func(creds *vault.KafkaCredentials) {
+		if err := producer.UpdateCredentials(creds.Username, creds.Password); err != nil {
+			logger.Error(ctx, "failed to apply rotated kafka credentials", zap.Error(err))
+		}
+	})
+	manager.StartAutoRenewal(ctx)
+}
+
+// newKafkaProducerConfig는 kafka.producer 설정으로 프로듀서 설정을 생성합니다
+// 최종 전송에 실패한 메시지는 kafka_produce_errors_total 메트릭으로 집계합니다
+func newKafkaProducerConfig(cfg *config.KafkaConfig, clientID string, security *kafka.SecurityConfig, m *metrics.Metrics) (*kafka.ProducerConfig, error) {
+	compression, err := kafka.ParseCompression(cfg.Producer.Compression)
+	if err != nil {
+		return nil, err
+	}
+	acks := sarama.RequiredAcks(cfg.Producer.RequiredAcks)
+	if cfg.Producer.EnableIdempotent {
+		// 멱등 프로듀서는 모든 복제본의 확인이 필요합니다
+		acks = sarama.WaitForAll
+	}
+
+	producer := &kafka.ProducerConfig{
+		Brokers:          cfg.Brokers,
+		ClientID:         clientID,
+		MaxMessageBytes:  cfg.Producer.MaxMessageBytes,
+		RequiredAcks:     acks,
+		Timeout:          cfg.Producer.Timeout,
+		Compression:      compression,
+		CompressionLevel: cfg.Producer.CompressionLevel,
+		MaxRetries:       cfg.Producer.MaxRetries,
+		RetryBackoff:     cfg.Producer.RetryBackoff,
+		EnableIdempotent: cfg.Producer.EnableIdempotent,
+		UseAsync:         cfg.Producer.Async,
+		Linger:           cfg.Producer.Linger,
+		BatchBytes:       cfg.Producer.BatchBytes,
+		BatchMessages:    cfg.Producer.BatchMessages,
+		Security:         security,
+		ErrorHandler: func(msg *sarama.ProducerMessage, _ error) {
+			if m != nil {
+				m.RecordKafkaProduceError(msg.Topic)
+			}
+		},
+	}
+	return producer, nil
+}
+
+// newAvroSerializer는 kafka.avro 설정으로 CDC 이벤트 Avro 직렬화기를 생성합니다 (비활성화되면 nil)
+func newAvroSerializer(cfg *config.KafkaAvroConfig) (*kafka.AvroSerializer, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	registry, err := schemaregistry.NewClient(schemaregistry.Config{
+		URL:      cfg.SchemaRegistry.URL,
+		Username: cfg.SchemaRegistry.Username,
+		Password: cfg.SchemaRegistry.Password,
+		Timeout:  cfg.SchemaRegistry.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return kafka.NewAvroSerializer(registry, cfg.Topics)
+}
+
+// newTopicRouter는 kafka.cdc_routing 규칙으로 CDC 토픽 라우터를 생성합니다 (규칙에 없는 컬렉션은 cdc_topics 사용)
+func newTopicRouter(cfg *config.KafkaConfig) (*kafka.TopicRouter, error) {
+	rules := make([]kafka.TopicRule, 0, len(cfg.CDCRouting))
+	for _, route := range cfg.CDCRouting {
+		rules = append(rules, kafka.TopicRule{
+			Collection: route.Collection,
+			Topic:      route.Topic,
+			Created:    route.Topics.DocumentCreated,
+			Updated:    route.Topics.DocumentUpdated,
+			Deleted:    route.Topics.DocumentDeleted,
+			Disabled:   route.Disabled,
+		})
+	}
+	return kafka.NewTopicRouter(rules,
+		cfg.CDCTopics.DocumentCreated,
+		cfg.CDCTopics.DocumentUpdated,
+		cfg.CDCTopics.DocumentDeleted,
+	)
+}
+
+// instanceID는 CDC 이벤트 발행 인스턴스를 구분하는 ID를 반환합니다 (호스트명-PID)
+func instanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// startCacheInvalidation은 다른 인스턴스의 CDC 이벤트로 문서 캐시를 무효화하는 컨슈머를 시작합니다
+// 인스턴스마다 별도 컨슈머 그룹을 사용해 모든 인스턴스가 모든 이벤트를 받으며, 시작 시점 이후 이벤트만 처리합니다
+func startCacheInvalidation(ctx context.Context, cfg *config.Config, security *kafka.SecurityConfig, manager *vault.KafkaCredentialsManager, documentUC *usecase.DocumentUseCase) error {
+	prefix := cfg.Cache.CDCInvalidation.GroupPrefix
+	if prefix == "" {
+		prefix = "database-service-cache"
+	}
+	origin := instanceID()
+	groupID := fmt.Sprintf("%s-%s", prefix, origin)
+	avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+	if err != nil {
+		return fmt.Errorf("failed to configure avro deserialization: %w", err)
+	}
+
+	consumer, err := kafka.NewCacheInvalidationConsumer(&kafka.ConsumerConfig{
+		Brokers: cfg.Kafka.Brokers,
+		GroupID: groupID,
+		Topics: []string{
+			cfg.Kafka.CDCTopics.DocumentCreated,
+			cfg.Kafka.CDCTopics.DocumentUpdated,
+			cfg.Kafka.CDCTopics.DocumentDeleted,
+		},
+		InitialOffset:     "newest",
+		SessionTimeout:    cfg.Kafka.Consumer.SessionTimeout,
+		HeartbeatInterval: cfg.Kafka.Consumer.HeartbeatInterval,
+		Security:          security,
+		Avro:              avroSerializer,
+	}, origin, documentUC.InvalidateCachedDocument)
+	if err != nil {
+		return fmt.Errorf("failed to create cache invalidation consumer: %w", err)
+	}
+
+	if manager != nil {
+		manager.OnRotate(func(creds *vault.KafkaCredentials) {
+			if err := consumer.UpdateCredentials(ctx, creds.Username, creds.Password); err != nil {
+				logger.Error(ctx, "failed to apply rotated kafka credentials to cache invalidation consumer", zap.Error(err))
+			}
+		})
+	}
+
+	// Start는 컨텍스트가 취소될 때까지 블록되며 종료 시 컨슈머 그룹을 닫습니다
+	go func() {
+		if err := consumer.Start(ctx); err != nil {
+			logger.Error(ctx, "cache invalidation consumer stopped", zap.Error(err))
+		}
+	}()
+
+	logger.Info(ctx, "cdc cache invalidation enabled", zap.String("group_id", groupID))
+	return nil
+}
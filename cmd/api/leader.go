@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/leader"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// newLeaderElector는 leader_election 설정으로 Kubernetes Lease 리더 선출기를 생성합니다 (꺼져 있으면 nil)
+func newLeaderElector(cfg *config.LeaderElectionConfig, name string) (*leader.Elector, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	client, namespace, err := leader.NewKubernetesClient(leader.KubernetesConfig{Namespace: cfg.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	return leader.NewElector(client, namespace, leader.Config{
+		Name:          leader.LeaseName(name),
+		Identity:      instanceID(),
+		LeaseDuration: cfg.LeaseDuration,
+		RenewDeadline: cfg.RenewDeadline,
+		RetryPeriod:   cfg.RetryPeriod,
+	})
+}
+
+// singletonJobs는 클러스터에서 레플리카 하나만 실행해야 하는 백그라운드 작업(정리 작업 등)을 모읍니다
+// 리더 선출이 꺼져 있으면 Go가 작업을 바로 시작하고, 켜져 있으면 Start 이후 리스를 가진 동안에만 실행합니다
+type singletonJobs struct {
+	elector *leader.Elector
+	jobs    []func(ctx context.Context)
+}
+
+// Go는 작업을 등록합니다 (리더 선출이 꺼져 있으면 바로 시작)
+func (s *singletonJobs) Go(ctx context.Context, job func(ctx context.Context)) {
+	if s.elector == nil {
+		go job(ctx)
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start는 리더 선출에 참여해 리더인 동안 등록된 작업을 실행합니다
+// 리더십을 잃으면 작업을 멈추고 ctx가 취소될 때까지 다시 선출에 참여합니다
+func (s *singletonJobs) Start(ctx context.Context) {
+	if s.elector == nil || len(s.jobs) == 0 {
+		return
+	}
+	go func() {
+		for ctx.Err() == nil {
+			s.elector.Run(ctx, func(leaderCtx context.Context) {
+				logger.Info(ctx, "running singleton jobs as leader", zap.Int("jobs", len(s.jobs)))
+				var wg sync.WaitGroup
+				for _, job := range s.jobs {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						job(leaderCtx)
+					}()
+				}
+				wg.Wait()
+				// 작업이 모두 먼저 끝나도 리스를 계속 가져 다른 레플리카가 같은 작업을 시작하지 않게 합니다
+				<-leaderCtx.Done()
+			})
+		}
+	}()
+}
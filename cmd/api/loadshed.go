@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/loadshed"
+)
+
+// newLoadShedder는 설정으로부터 적응형 동시성 제한기를 생성합니다 (비활성화 시 nil)
+func newLoadShedder(cfg *config.LoadSheddingConfig) *loadshed.Limiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return loadshed.New(loadshed.Config{
+		InitialLimit: cfg.InitialLimit,
+		MinLimit:     cfg.MinLimit,
+		MaxLimit:     cfg.MaxLimit,
+		Smoothing:    cfg.Smoothing,
+		Tolerance:    cfg.Tolerance,
+	})
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,14 +13,23 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/application/usecase"
 	"github.com/YouSangSon/database-service/internal/config"
-	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
 	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/redisstream"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/webhook"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
 	httpHandler "github.com/YouSangSon/database-service/internal/interfaces/http/handler"
 	"github.com/YouSangSon/database-service/internal/interfaces/http/router"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
 	"github.com/YouSangSon/database-service/internal/pkg/tracing"
 	"github.com/YouSangSon/database-service/internal/pkg/vault"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -53,10 +63,18 @@ import (
 // @x-extension-openapi {"example": "value on a json format"}
 
 func main() {
+	configFile := flag.String("config", "", "configuration file (default ./configs/config.yaml)")
+	validateConfig := flag.Bool("validate-config", false, "validate the configuration, probe enabled backends, print the redacted effective configuration and exit")
+	flag.Parse()
+	configPath, configName := configLocation(*configFile)
+	if *validateConfig {
+		os.Exit(runConfigCheck(configPath, configName))
+	}
+
 	// ============================================
 	// 1. Configuration
 	// ============================================
-	cfg, err := config.LoadConfig("./configs", "config")
+	cfg, configSources, err := config.LoadConfigWithSources(configPath, configName)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
 		os.Exit(1)
@@ -79,6 +97,8 @@ func main() {
 	logger.Info(ctx, "starting database service",
 		zap.String("version", cfg.App.Version),
 		zap.String("environment", cfg.App.Environment),
+		zap.String("config_profile", configSources.Profile),
+		zap.Strings("config_files", configSources.Files),
 		zap.String("go_version", runtime.Version()),
 	)
 
@@ -86,6 +106,7 @@ func main() {
 	// 3. Metrics Initialization
 	// ============================================
 	m := metrics.Init(cfg.App.Name)
+	pools := poolstats.NewRegistry()
 	logger.Info(ctx, "metrics initialized")
 
 	// ============================================
@@ -126,6 +147,7 @@ func main() {
 			SecretID:          cfg.Vault.SecretID,
 			K8sRole:           cfg.Vault.K8sRole,
 			MongoDBPath:       cfg.Vault.Paths.MongoDB,
+			KafkaPath:         cfg.Vault.Paths.Kafka,
 			RenewInterval:     cfg.Vault.Renewal.Interval,
 			RenewBeforeExpiry: cfg.Vault.Renewal.RenewBeforeExpiry,
 		})
@@ -175,72 +197,325 @@ func main() {
 	// ============================================
 	repoManager := persistence.NewRepositoryManager()
 
-	// Register MongoDB repository
-	if err := repoManager.RegisterMongoDB(mongoRepo); err != nil {
+	// Register MongoDB repository (sharding이 켜져 있으면 샤드 인스턴스에 나눠 저장하는 저장소)
+	var documentRepo repository.DocumentRepository = mongoRepo
+	if cfg.Sharding.Enabled {
+		shardedRepo, closeShards, err := newMongoShards(ctx, cfg, pools)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize mongodb shards", zap.Error(err))
+		}
+		defer closeShards()
+		documentRepo = shardedRepo
+		logger.Info(ctx, "mongodb sharding enabled",
+			zap.Int("shards", len(cfg.Sharding.Shards)),
+			zap.String("shard_key", cfg.Sharding.ShardKey),
+		)
+	}
+	if err := repoManager.RegisterMongoDB(documentRepo); err != nil {
 		logger.Fatal(ctx, "failed to register mongodb repository", zap.Error(err))
 	}
 	logger.Info(ctx, "repository manager initialized with mongodb")
 
+	// MongoDB 복제본 읽기 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+	if cfg.MongoDB.ReadReplica.Enabled {
+		replicaPool := mongodb.NewPoolMonitor()
+		replicaRepo, replicaClient, err := newMongoReadReplica(ctx, mongoURI, cfg.MongoDB.Database, &cfg.MongoDB.ReadReplica, replicaPool)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize mongodb read replica", zap.Error(err))
+		}
+		defer replicaClient.Disconnect(context.Background())
+		pools.Register("mongodb-replica", poolstats.DriverMongoDB, replicaPool.Stats)
+
+		if err := repoManager.RegisterReadReplica("mongodb", replicaRepo); err != nil {
+			logger.Fatal(ctx, "failed to register mongodb read replica", zap.Error(err))
+		}
+		logger.Info(ctx, "mongodb read replica initialized",
+			zap.String("read_preference", cfg.MongoDB.ReadReplica.ReadPreference),
+		)
+	}
+
 	// ============================================
 	// 8. Redis Cache Initialization
 	// ============================================
-	redisCache, err := cache.NewRedisCache(ctx, &cache.Config{
-		Host:        cfg.Redis.Host,
-		Port:        cfg.Redis.Port,
-		Password:    cfg.Redis.Password,
-		DB:          cfg.Redis.DB,
-		MaxRetries:  cfg.Redis.MaxRetries,
-		PoolSize:    cfg.Redis.PoolSize,
-		MinIdleConn: cfg.Redis.MinIdleConn,
-	})
+	redisCache, err := newRedisCache(ctx, &cfg.Redis)
 	if err != nil {
 		logger.Fatal(ctx, "failed to initialize redis cache", zap.Error(err))
 	}
 	defer redisCache.Close()
+	pools.Register("redis", poolstats.DriverRedis, redisCache.PoolStats)
 	logger.Info(ctx, "redis cache initialized",
+		zap.String("mode", redisCache.Mode()),
 		zap.String("host", cfg.Redis.Host),
-		zap.Int("port", cfg.Redis.Port),
+		zap.Strings("addresses", cfg.Redis.Addresses),
 	)
 
+	// 기능 플래그 (Optional) - 환경/테넌트별로 백엔드, 캐시 전략, CDC 발행을 켜고 끔
+	featureFlags, err := newFeatureFlags(ctx, &cfg.FeatureFlags, cfg.App.Environment, redisCache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure feature flags", zap.Error(err))
+	}
+	if featureFlags != nil {
+		logger.Info(ctx, "feature flags enabled",
+			zap.String("environment", featureFlags.Environment()),
+			zap.Int("flags", len(cfg.FeatureFlags.Flags)),
+		)
+	}
+
 	// ============================================
 	// 9. Kafka Producer Initialization (Optional)
 	// ============================================
 	var kafkaProducer *kafka.Producer
-	var cdcPublisher *kafka.CDCPublisher
+	var cdcPublisher messaging.CDCPublisher
+	var kafkaSecurity *kafka.SecurityConfig
+	var kafkaCreds *vault.KafkaCredentialsManager
 	if cfg.Kafka.Enabled {
-		kafkaProducer, err = kafka.NewProducer(&kafka.ProducerConfig{
-			Brokers:          cfg.Kafka.Brokers,
-			ClientID:         cfg.Kafka.ClientID,
-			MaxMessageBytes:  1000000,
-			RequiredAcks:     -1, // Wait for all replicas
-			Compression:      1,  // Gzip
-			MaxRetries:       3,
-			RetryBackoff:     100 * time.Millisecond,
-			EnableIdempotent: true,
-			UseAsync:         false,
-		})
+		var err error
+		kafkaSecurity, kafkaCreds, err = newKafkaSecurity(ctx, &cfg.Kafka.Security, vaultClient)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure kafka security", zap.Error(err))
+		}
+		if kafkaCreds != nil {
+			defer kafkaCreds.Close(context.Background())
+		}
+
+		producerConfig, err := newKafkaProducerConfig(&cfg.Kafka, cfg.Kafka.ClientID, kafkaSecurity, m)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure kafka producer", zap.Error(err))
+		}
+		kafkaProducer, err = kafka.NewProducer(producerConfig)
 		if err != nil {
 			logger.Warn(ctx, "failed to initialize kafka producer", zap.Error(err))
 		} else {
 			defer kafkaProducer.Close()
-			cdcPublisher = kafka.NewCDCPublisher(
+			if kafkaCreds != nil {
+				watchKafkaCredentials(ctx, kafkaCreds, kafkaProducer)
+			}
+			kafkaPublisher := kafka.NewCDCPublisher(
 				kafkaProducer,
 				cfg.Kafka.Topics.Created,
 				cfg.Kafka.Topics.Updated,
 				cfg.Kafka.Topics.Deleted,
 			)
+			avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+			if err != nil {
+				logger.Fatal(ctx, "failed to configure avro serialization", zap.Error(err))
+			}
+			if avroSerializer != nil {
+				kafkaPublisher.SetAvro(avroSerializer)
+				logger.Info(ctx, "cdc events serialized with avro",
+					zap.String("schema_registry", cfg.Kafka.Avro.SchemaRegistry.URL),
+					zap.Strings("topics", cfg.Kafka.Avro.Topics),
+				)
+			}
+			if len(cfg.Kafka.CDCRouting) > 0 {
+				topicRouter, err := newTopicRouter(&cfg.Kafka)
+				if err != nil {
+					logger.Fatal(ctx, "failed to configure cdc topic routing", zap.Error(err))
+				}
+				kafkaPublisher.SetRouter(topicRouter)
+				logger.Info(ctx, "cdc topic routing enabled", zap.Int("rules", len(cfg.Kafka.CDCRouting)))
+			}
+			cdcPublisher = kafkaPublisher
 			logger.Info(ctx, "kafka producer initialized",
 				zap.Strings("brokers", cfg.Kafka.Brokers),
 			)
 		}
 	}
 
+	// ============================================
+	// 9-1. NATS JetStream CDC Publisher (Optional)
+	// ============================================
+	// 활성화되면 CDC 이벤트를 Kafka 대신 JetStream 스트림으로 발행합니다
+	if cfg.NATS.Enabled {
+		natsPublisher, err := newNATSPublisher(ctx, &cfg.NATS, cfg.App.Name)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize nats jetstream publisher", zap.Error(err))
+		}
+		defer natsPublisher.Close()
+		cdcPublisher = natsPublisher
+		logger.Info(ctx, "cdc events published to nats jetstream",
+			zap.Strings("servers", cfg.NATS.Servers),
+			zap.String("stream", cfg.NATS.Stream),
+		)
+	}
+
+	// ============================================
+	// 9-2. RabbitMQ CDC Publisher (Optional)
+	// ============================================
+	// Kafka가 없는 환경에서 CDC 이벤트를 이벤트 타입별 교환기로 발행합니다
+	if cfg.RabbitMQ.Enabled {
+		rabbitPublisher, err := newRabbitMQPublisher(ctx, &cfg.RabbitMQ, cfg.App.Name)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize rabbitmq publisher", zap.Error(err))
+		}
+		defer rabbitPublisher.Close()
+		cdcPublisher = rabbitPublisher
+		logger.Info(ctx, "cdc events published to rabbitmq",
+			zap.String("exchange_type", cfg.RabbitMQ.ExchangeType),
+		)
+	}
+
+	// ============================================
+	// 9-3. Redis Streams CDC Publisher (Optional)
+	// ============================================
+	// 별도 브로커 없이 Redis 스트림(XADD)으로 CDC 이벤트를 발행합니다
+	if cfg.Redis.Streams.Enabled {
+		cdcPublisher = redisstream.NewPublisher(redisCache.Client(), redisstream.Config{
+			KeyPrefix:       cfg.Redis.Streams.KeyPrefix,
+			MaxLen:          cfg.Redis.Streams.MaxLen,
+			ApproximateTrim: cfg.Redis.Streams.ApproximateTrim,
+		})
+		logger.Info(ctx, "cdc events published to redis streams",
+			zap.String("key_prefix", cfg.Redis.Streams.KeyPrefix),
+			zap.Int64("max_len", cfg.Redis.Streams.MaxLen),
+		)
+	}
+
+	if cdcPublisher != nil {
+		cdcPublisher.SetOrigin(instanceID())
+		cdcPublisher.SetEncoder(messaging.EventEncoder{
+			Format:     cfg.CDC.Format,
+			Source:     cfg.CDC.Source,
+			ServerName: cfg.CDC.ServerName,
+		})
+		if cfg.CDC.Format == messaging.EventFormatCloudEvents {
+			logger.Info(ctx, "cdc events wrapped in cloudevents envelope", zap.String("source", cfg.CDC.Source))
+		}
+		if cfg.CDC.Format == messaging.EventFormatProtobuf {
+			logger.Info(ctx, "cdc events encoded as protobuf", zap.String("message_type", messaging.ProtoMessageType))
+		}
+		if cfg.CDC.Format == messaging.EventFormatDebezium {
+			logger.Info(ctx, "cdc events wrapped in debezium envelope", zap.String("server_name", cfg.CDC.ServerName))
+		}
+	}
+
+	// 발행 실패 이벤트 DLQ (재시도 후 MongoDB에 보관, 관리 API로 재발행)
+	var deadLetterUC *usecase.DeadLetterUseCase
+	if cdcPublisher != nil && cfg.CDC.DeadLetter.Enabled {
+		deadLetterRepo, deadLetterClient, err := newDeadLetterRepository(ctx, mongoURI, cfg.MongoDB.Database)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize cdc dead letter queue", zap.Error(err))
+		}
+		defer deadLetterClient.Disconnect(context.Background())
+
+		deadLetterPublisher := messaging.NewDeadLetterPublisher(cdcPublisher, deadLetterRepo, messaging.DeadLetterConfig{
+			MaxAttempts: cfg.CDC.DeadLetter.MaxAttempts,
+			Backoff:     cfg.CDC.DeadLetter.Backoff,
+		})
+		cdcPublisher = deadLetterPublisher
+		deadLetterUC = usecase.NewDeadLetterUseCase(deadLetterRepo, deadLetterPublisher)
+		logger.Info(ctx, "cdc dead letter queue enabled",
+			zap.String("collection", mongodb.DeadLetterCollectionName),
+		)
+	}
+
+	// 웹훅 구독 (일치하는 CDC 이벤트를 전송 큐에 넣고 디스패처가 서명해 콜백 URL로 전송)
+	var webhookUC *usecase.WebhookUseCase
+	if cfg.CDC.Webhooks.Enabled {
+		webhookSubs, webhookDeliveries, webhookClient, err := newWebhookRepositories(ctx, mongoURI, cfg.MongoDB.Database, cfg.CDC.Webhooks.Retention)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize webhooks", zap.Error(err))
+		}
+		defer webhookClient.Disconnect(context.Background())
+
+		webhookPublisher := webhook.NewPublisher(cdcPublisher, webhookSubs, webhookDeliveries, webhook.PublisherConfig{
+			RefreshInterval: cfg.CDC.Webhooks.RefreshInterval,
+		})
+		webhookPublisher.SetOrigin(instanceID())
+		cdcPublisher = webhookPublisher
+
+		dispatcher := webhook.NewDispatcher(webhookSubs, webhookDeliveries, webhook.DispatcherConfig{
+			Workers:     cfg.CDC.Webhooks.Workers,
+			Timeout:     cfg.CDC.Webhooks.Timeout,
+			MaxAttempts: cfg.CDC.Webhooks.MaxAttempts,
+			Backoff:     cfg.CDC.Webhooks.Backoff,
+			MaxBackoff:  cfg.CDC.Webhooks.MaxBackoff,
+		})
+		dispatchCtx, stopDispatcher := context.WithCancel(ctx)
+		defer stopDispatcher()
+		go dispatcher.Run(dispatchCtx)
+
+		webhookUC = usecase.NewWebhookUseCase(webhookSubs, webhookDeliveries, webhookPublisher, cfg.CDC.Webhooks.AllowHTTP)
+		logger.Info(ctx, "webhooks enabled",
+			zap.String("collection", mongodb.WebhookDeliveryCollectionName),
+			zap.Int("workers", cfg.CDC.Webhooks.Workers),
+		)
+	}
+
+	// 발행 전 필드 변환 (DLQ 바깥에서 적용해 DLQ에도 변환된 이벤트만 보관)
+	if cdcPublisher != nil && len(cfg.CDC.Transforms) > 0 {
+		transformPublisher, err := messaging.NewTransformPublisher(cdcPublisher, newCDCTransforms(cfg.CDC.Transforms))
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure cdc field transforms", zap.Error(err))
+		}
+		cdcPublisher = transformPublisher
+		logger.Info(ctx, "cdc field transforms enabled", zap.Int("rules", len(cfg.CDC.Transforms)))
+	}
+
+	// cdc 플래그가 꺼진 환경/테넌트의 이벤트는 발행하지 않음
+	if cdcPublisher != nil && featureFlags != nil {
+		cdcPublisher = messaging.NewGatedPublisher(cdcPublisher, func(ctx context.Context) bool {
+			return featureFlags.Enabled(ctx, featureflag.CDC)
+		})
+	}
+
+	// CDC 재생 (Kafka 토픽에 남아 있는 이벤트를 다시 발행해 다운스트림 읽기 모델 재구축)
+	var cdcReplayUC *usecase.CDCReplayUseCase
+	var cdcScanner messaging.EventScanner // 시점 복원에서 CDC 이벤트를 읽는 데 사용
+	if kafkaProducer != nil && cfg.CDC.Replay.Enabled {
+		avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure avro deserialization for cdc replay", zap.Error(err))
+		}
+		topicRouter, err := newTopicRouter(&cfg.Kafka)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure cdc topic routing", zap.Error(err))
+		}
+		replayer := kafka.NewReplayer(kafkaProducer, avroSerializer, topicRouter.Topics())
+		cdcReplayUC = usecase.NewCDCReplayUseCase(replayer, cfg.CDC.Replay.MaxEvents)
+		cdcScanner = replayer
+		logger.Info(ctx, "cdc replay enabled", zap.Int("max_events", cfg.CDC.Replay.MaxEvents))
+	}
+
 	// ============================================
 	// 10. UseCase Layer Initialization (with RepositoryManager)
 	// ============================================
-	documentUC := usecase.NewDocumentUseCaseWithManager(repoManager, redisCache)
+	documentCache, err := newDocumentCache(ctx, &cfg.Cache, redisCache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize document cache", zap.Error(err))
+	}
+	if cfg.Cache.Backend == "memcached" {
+		logger.Info(ctx, "memcached document cache enabled",
+			zap.Strings("servers", cfg.Cache.Memcached.Servers),
+		)
+	}
+	documentUC := usecase.NewDocumentUseCaseWithManager(repoManager, documentCache)
+	if cfg.Cache.Local.Enabled {
+		logger.Info(ctx, "local lru cache enabled in front of redis",
+			zap.Int("max_entries", cfg.Cache.Local.MaxEntries),
+			zap.Duration("ttl", cfg.Cache.Local.TTL),
+		)
+	}
 	logger.Info(ctx, "use cases initialized with repository manager")
 
+	// 컬렉션 백업/복원 (백그라운드 작업, 로컬 디렉터리 또는 S3에 저장)
+	var backupUC *usecase.BackupUseCase
+	if cfg.Backup.Enabled {
+		backupUC, err = newBackupUseCase(ctx, &cfg.Backup, repoManager)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize backup", zap.Error(err))
+		}
+		logger.Info(ctx, "collection backup enabled",
+			zap.String("storage", cfg.Backup.Storage),
+			zap.Bool("consistent", cfg.Backup.Consistent),
+		)
+		if cdcScanner != nil {
+			backupUC.SetEventScanner(cdcScanner)
+			logger.Info(ctx, "point-in-time restore enabled")
+		}
+		startBackupSchedule(ctx, &cfg.Backup.Schedule, backupUC)
+	}
+
 	// ============================================
 	// 11. HTTP Handlers Initialization
 	// ============================================
@@ -248,6 +523,184 @@ func main() {
 	healthHandler := httpHandler.NewHealthHandler(mongoRepo, redisCache, vaultClient, kafkaProducer)
 	logger.Info(ctx, "http handlers initialized")
 
+	// OIDC 인증 (Optional)
+	var oidcVerifier *auth.OIDCVerifier
+	if cfg.Auth.Enabled {
+		oidcVerifier, err = newOIDCVerifier(ctx, &cfg.Auth)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize oidc verifier", zap.Error(err))
+		}
+		defer oidcVerifier.Close()
+		logger.Info(ctx, "oidc authentication enabled",
+			zap.String("issuer", cfg.Auth.OIDC.IssuerURL),
+		)
+	}
+
+	// HMAC 요청 서명 인증 (Optional)
+	var hmacVerifier *auth.HMACVerifier
+	if cfg.Auth.HMAC.Enabled {
+		hmacVerifier, err = newHMACVerifier(&cfg.Auth.HMAC, redisCache)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize hmac verifier", zap.Error(err))
+		}
+		logger.Info(ctx, "hmac request signing enabled",
+			zap.Int("key_count", len(cfg.Auth.HMAC.Keys)),
+		)
+	}
+
+	// 운영자 대리 실행 (Optional)
+	var impersonator *auth.Impersonator
+	if cfg.Auth.Impersonation.Enabled {
+		impersonator, err = newImpersonator(&cfg.Auth.Impersonation)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize impersonation", zap.Error(err))
+		}
+		logger.Info(ctx, "admin impersonation enabled",
+			zap.Strings("allowed_roles", cfg.Auth.Impersonation.AllowedRoles),
+		)
+	}
+
+	// 인증 실패 잠금 (Optional)
+	var authLockout *lockout.Guard
+	if cfg.Auth.Lockout.Enabled {
+		authLockout, err = newLockoutGuard(&cfg.Auth.Lockout, redisCache)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize auth lockout", zap.Error(err))
+		}
+		logger.Info(ctx, "auth brute-force protection enabled",
+			zap.Int64("max_failures", cfg.Auth.Lockout.MaxFailures),
+			zap.Duration("window", cfg.Auth.Lockout.Window),
+		)
+	}
+
+	// IP 허용/차단 (비활성화 시 빈 규칙으로 시작하며 SIGHUP으로 활성화할 수 있습니다)
+	ipFilterCfg, err := newIPFilterConfig(&cfg.IPFilter)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize ip filter", zap.Error(err))
+	}
+	if !cfg.IPFilter.Enabled {
+		ipFilterCfg = nil
+	}
+	ipFilter := ipfilter.New(ipFilterCfg)
+
+	// 행 수준 보안 (Optional)
+	rowPolicies, err := newRowPolicySet(&cfg.Auth)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize row policies", zap.Error(err))
+	}
+	if rowPolicies != nil {
+		documentUC.SetRowPolicies(rowPolicies)
+		logger.Info(ctx, "row-level security enabled",
+			zap.Int("policy_count", len(cfg.Auth.RowPolicies)),
+		)
+	}
+
+	// 쓰기 시 개인정보 탐지 (Optional)
+	piiScanner, err := newPIIScanner(&cfg.PII)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize pii scanner", zap.Error(err))
+	}
+	if piiScanner != nil {
+		documentUC.SetPIIScanner(piiScanner)
+		logger.Info(ctx, "pii detection enabled",
+			zap.String("default_action", cfg.PII.DefaultAction),
+			zap.Int("policy_count", len(cfg.PII.Policies)),
+		)
+	}
+
+	// 컬렉션별 캐시 전략
+	cachePolicies, err := newCachePolicies(&cfg.Cache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize cache policies", zap.Error(err))
+	}
+	documentUC.SetCachePolicies(cachePolicies)
+	documentUC.SetNegativeCacheTTL(cfg.Cache.NegativeTTL)
+	documentUC.SetEarlyRefresh(cfg.Cache.EarlyRefresh)
+	documentUC.SetCircuitBreakers(newCircuitBreakers(cfg))
+	documentUC.SetFeatureFlags(featureFlags)
+	configureRetry(documentUC, &cfg.Retry)
+	documentUC.SetOperationTimeouts(usecase.OperationTimeouts{
+		Read:  cfg.Timeouts.Read,
+		Write: cfg.Timeouts.Write,
+		Admin: cfg.Timeouts.Admin,
+	})
+	documentUC.SetBulkWriteParallelism(usecase.BulkWriteParallelism{
+		Workers:       cfg.BulkWrite.Workers,
+		MinOperations: cfg.BulkWrite.MinOperations,
+	})
+	if cfg.Cache.Query.Enabled {
+		documentUC.SetQueryCacheTTL(cfg.Cache.Query.TTL)
+	}
+	if cachePolicies.UsesWriteBehind() {
+		cacheWriteQueue := newWriteBehindQueue(&cfg.Cache.WriteBehind, documentCache)
+		cacheWriteQueue.Start(ctx)
+		defer cacheWriteQueue.Close()
+		documentUC.SetCacheWriteQueue(cacheWriteQueue)
+	}
+	logger.Info(ctx, "cache strategies configured",
+		zap.String("default_strategy", cfg.Cache.DefaultStrategy),
+		zap.Int("policy_count", len(cfg.Cache.Policies)),
+		zap.Duration("negative_ttl", cfg.Cache.NegativeTTL),
+	)
+	if cfg.Cache.Warmup.Enabled {
+		go warmCache(ctx, &cfg.Cache.Warmup, documentUC)
+	}
+	if cfg.Cache.CDCInvalidation.Enabled && kafkaSecurity != nil {
+		if err := startCacheInvalidation(ctx, cfg, kafkaSecurity, kafkaCreds, documentUC); err != nil {
+			logger.Fatal(ctx, "failed to start cdc cache invalidation", zap.Error(err))
+		}
+	}
+
+	// CQRS 읽기/쓰기 라우팅 (조회/검색/집계를 컬렉션별로 읽기 복제본에 분배)
+	if cfg.ReadRouting.Enabled {
+		readRouting, err := newReadRouting(&cfg.ReadRouting)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure read routing", zap.Error(err))
+		}
+		documentUC.SetReadRouting(readRouting)
+		logger.Info(ctx, "read routing enabled",
+			zap.String("default", cfg.ReadRouting.Default),
+			zap.Int("rules", len(cfg.ReadRouting.Collections)),
+		)
+	}
+
+	// Rate limiting 정책 (Optional, 미설정 시 IP 기반 고정 윈도우)
+	var rateLimitPolicy *ratelimit.Policy
+	if cfg.RateLimit.Enabled {
+		rateLimitPolicy = newRateLimitPolicy(&cfg.RateLimit)
+		logger.Info(ctx, "token bucket rate limiting enabled",
+			zap.Float64("requests_per_second", cfg.RateLimit.RequestsPerSecond),
+			zap.Int64("burst", cfg.RateLimit.Burst),
+			zap.Int("route_overrides", len(cfg.RateLimit.Routes)),
+		)
+	}
+
+	// 설정 다시 읽기 (SIGHUP, reload.watch_file) - 로그 레벨, 캐시 TTL, rate limit, circuit breaker, IP 필터
+	if err := watchConfigReload(ctx, cfg, reloadTargets{
+		configPath:      configPath,
+		configName:      configName,
+		documentUC:      documentUC,
+		cachePolicies:   cachePolicies,
+		circuitBreakers: documentUC.CircuitBreakers(),
+		rateLimitPolicy: rateLimitPolicy,
+		ipFilter:        ipFilter,
+	}); err != nil {
+		logger.Fatal(ctx, "failed to watch config for reload", zap.Error(err))
+	}
+
+	// 적응형 동시성 제한 (Optional) - 한도를 넘는 요청은 503으로 거부
+	loadShedder := newLoadShedder(&cfg.LoadShedding)
+	if loadShedder != nil {
+		logger.Info(ctx, "load shedding enabled", zap.Int("initial_limit", loadShedder.Limit()))
+	}
+
+	// 연결 풀 상태 메트릭 (db_pool_*)
+	if cfg.Observability.Metrics.Enabled {
+		poolStatsCtx, stopPoolStats := context.WithCancel(ctx)
+		defer stopPoolStats()
+		go pools.Run(poolStatsCtx, cfg.Observability.Metrics.PoolStatsInterval, m)
+	}
+
 	// ============================================
 	// 12. Router Setup with all 36 endpoints
 	// ============================================
@@ -259,6 +712,23 @@ func main() {
 		cfg.Observability.Tracing.Enabled,
 		cfg.Observability.Metrics.Enabled,
 		cfg.App.Environment,
+		&router.Options{
+			OIDCVerifier:      oidcVerifier,
+			HMACVerifier:      hmacVerifier,
+			Impersonator:      impersonator,
+			AuthLockout:       authLockout,
+			LoadShedder:       loadShedder,
+			RateLimitPolicy:   rateLimitPolicy,
+			IPFilter:          ipFilter,
+			TrustedProxies:    cfg.Server.HTTP.TrustedProxies,
+			DeadLetterUseCase: deadLetterUC,
+			WebhookUseCase:    webhookUC,
+			CDCReplayUseCase:  cdcReplayUC,
+			BackupUseCase:     backupUC,
+			ConfigChecker:     config.NewChecker(configPath, configName, probeBackends),
+			PoolStats:         pools,
+			FeatureFlags:      featureFlags,
+		},
 	)
 
 	logger.Info(ctx, "router initialized with 36 REST API endpoints")
@@ -266,6 +736,15 @@ func main() {
 	// ============================================
 	// 13. HTTP Server Configuration
 	// ============================================
+	// TLS (인증서 파일 또는 Vault PKI 자동 교체)
+	serverTLS, certManager, err := newServerTLSConfig(ctx, cfg, vaultClient)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure server tls", zap.Error(err))
+	}
+	if certManager != nil {
+		defer certManager.StopAutoRotation()
+	}
+
 	srv := &http.Server{
 		Addr:           fmt.Sprintf(":%d", cfg.Server.HTTP.Port),
 		Handler:        r,
@@ -273,6 +752,7 @@ func main() {
 		WriteTimeout:   cfg.Server.HTTP.WriteTimeout,
 		MaxHeaderBytes: 1 << 20, // 1 MB
 		IdleTimeout:    120 * time.Second,
+		TLSConfig:      serverTLS,
 	}
 
 	// Start server in goroutine
@@ -282,7 +762,14 @@ func main() {
 			zap.String("environment", cfg.App.Environment),
 		)
 
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if serverTLS != nil {
+			// 인증서는 TLSConfig에서 제공하므로 파일 경로를 넘기지 않습니다
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logger.Fatal(ctx, "failed to start HTTP server", zap.Error(err))
 		}
 	}()
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
@@ -13,7 +14,6 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/application/usecase"
 	"github.com/YouSangSon/database-service/internal/config"
-	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
 	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/cassandra"
@@ -21,11 +21,20 @@ import (
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/vitess"
 	httpHandler "github.com/YouSangSon/database-service/internal/interfaces/http/handler"
 	"github.com/YouSangSon/database-service/internal/interfaces/http/router"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/poolhealth"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
+	"github.com/YouSangSon/database-service/internal/pkg/secrets"
 	"github.com/YouSangSon/database-service/internal/pkg/tracing"
 	"github.com/YouSangSon/database-service/internal/pkg/vault"
 	es "github.com/elastic/go-elasticsearch/v8"
@@ -53,10 +62,18 @@ import (
 // @BasePath /api/v1
 
 func main() {
+	configFile := flag.String("config", "", "configuration file (default ./configs/config.yaml)")
+	validateConfig := flag.Bool("validate-config", false, "validate the configuration, probe enabled backends, print the redacted effective configuration and exit")
+	flag.Parse()
+	configPath, configName := configLocation(*configFile)
+	if *validateConfig {
+		os.Exit(runConfigCheck(configPath, configName))
+	}
+
 	// ============================================
 	// 1. Configuration
 	// ============================================
-	cfg, err := config.LoadConfig("./configs", "config")
+	cfg, configSources, err := config.LoadConfigWithSources(configPath, configName)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
 		os.Exit(1)
@@ -79,6 +96,8 @@ func main() {
 	logger.Info(ctx, "starting multi-database service",
 		zap.String("version", cfg.App.Version),
 		zap.String("environment", cfg.App.Environment),
+		zap.String("config_profile", configSources.Profile),
+		zap.Strings("config_files", configSources.Files),
 		zap.String("go_version", runtime.Version()),
 	)
 
@@ -86,6 +105,8 @@ func main() {
 	// 3. Metrics Initialization
 	// ============================================
 	m := metrics.Init(cfg.App.Name)
+	pools := poolstats.NewRegistry()
+	poolHealth := poolhealth.NewRegistry()
 	logger.Info(ctx, "metrics initialized")
 
 	// ============================================
@@ -126,6 +147,7 @@ func main() {
 			SecretID:          cfg.Vault.SecretID,
 			K8sRole:           cfg.Vault.K8sRole,
 			MongoDBPath:       cfg.Vault.Paths.MongoDB,
+			KafkaPath:         cfg.Vault.Paths.Kafka,
 			RenewInterval:     cfg.Vault.Renewal.Interval,
 			RenewBeforeExpiry: cfg.Vault.Renewal.RenewBeforeExpiry,
 		})
@@ -141,6 +163,15 @@ func main() {
 		}
 	}
 
+	// 시크릿 매니저 (Optional) - Vault 대신 AWS/GCP Secret Manager(또는 Vault KV)에서 데이터베이스 자격증명을 가져옴
+	secretProvider, err := newSecretProvider(&cfg.Secrets, vaultClient)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure secret provider", zap.Error(err))
+	}
+	if secretProvider != nil {
+		logger.Info(ctx, "secret provider configured", zap.String("provider", secretProvider.Name()))
+	}
+
 	// ============================================
 	// 6. Repository Manager Initialization
 	// ============================================
@@ -174,12 +205,48 @@ func main() {
 			logger.Fatal(ctx, "failed to initialize mongodb repository", zap.Error(err))
 		}
 		mongoClient = client
+		poolHealth.Register("mongodb", mongodb.NewHealthPool(mongoClient), int(cfg.MongoDB.MinPoolSize))
 
 		// Register with RepositoryManager
 		if err := repoManager.InitializeMongoDB(ctx, mongoClient, cfg.MongoDB.Database); err != nil {
 			logger.Fatal(ctx, "failed to register mongodb repository", zap.Error(err))
 		}
 
+		// sharding이 켜져 있으면 문서를 샤드 인스턴스에 나눠 저장하는 저장소로 교체
+		if cfg.Sharding.Enabled {
+			shardedRepo, closeShards, err := newMongoShards(ctx, cfg, pools)
+			if err != nil {
+				logger.Fatal(ctx, "failed to initialize mongodb shards", zap.Error(err))
+			}
+			defer closeShards()
+			if err := repoManager.RegisterMongoDB(shardedRepo); err != nil {
+				logger.Fatal(ctx, "failed to register mongodb shards", zap.Error(err))
+			}
+			logger.Info(ctx, "mongodb sharding enabled",
+				zap.Int("shards", len(cfg.Sharding.Shards)),
+				zap.String("shard_key", cfg.Sharding.ShardKey),
+			)
+		}
+
+		// 복제본 읽기 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+		if cfg.MongoDB.ReadReplica.Enabled {
+			replicaPool := mongodb.NewPoolMonitor()
+			replicaRepo, replicaClient, err := newMongoReadReplica(ctx, mongoURI, cfg.MongoDB.Database, &cfg.MongoDB.ReadReplica, replicaPool)
+			if err != nil {
+				logger.Fatal(ctx, "failed to initialize mongodb read replica", zap.Error(err))
+			}
+			defer replicaClient.Disconnect(context.Background())
+			pools.Register("mongodb-replica", poolstats.DriverMongoDB, replicaPool.Stats)
+			poolHealth.Register("mongodb-replica", mongodb.NewHealthPool(replicaClient), int(cfg.MongoDB.MinPoolSize))
+
+			if err := repoManager.RegisterReadReplica("mongodb", replicaRepo); err != nil {
+				logger.Fatal(ctx, "failed to register mongodb read replica", zap.Error(err))
+			}
+			logger.Info(ctx, "mongodb read replica initialized",
+				zap.String("read_preference", cfg.MongoDB.ReadReplica.ReadPreference),
+			)
+		}
+
 		enabledDatabases = append(enabledDatabases, "mongodb")
 		logger.Info(ctx, "mongodb repository initialized and registered",
 			zap.String("database", cfg.MongoDB.Database),
@@ -193,6 +260,18 @@ func main() {
 		}()
 	}
 
+	// PostgreSQL/MySQL data 컬럼 암호화
+	var dataCipher *encryption.Cipher
+	if cfg.Encryption.Enabled && (cfg.PostgreSQL.Enabled || cfg.MySQL.Enabled) {
+		dataCipher, err = newDataCipher(ctx, &cfg.Encryption, vaultClient)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize data encryption", zap.Error(err))
+		}
+	}
+
+	// SQL 문서 테이블 스키마 마이그레이션 (schema_migrations.enabled일 때 백엔드별로 등록)
+	schemaMigrators := make(map[string]*schema.Migrator)
+
 	// 7.2. PostgreSQL
 	var postgresDB *sql.DB
 	if cfg.PostgreSQL.Enabled {
@@ -207,6 +286,31 @@ func main() {
 			MaxIdleConns:    cfg.PostgreSQL.MaxIdleConns,
 			ConnMaxLifetime: cfg.PostgreSQL.ConnMaxLifetime,
 			ConnMaxIdleTime: cfg.PostgreSQL.ConnMaxIdleTime,
+
+			StatementCacheSize: cfg.PostgreSQL.StatementCacheSize,
+		}
+
+		var postgresqlCreds *vault.SQLCredentialsManager
+		if cfg.PostgreSQL.UseVault {
+			creds, err := newSQLCredentials(ctx, "postgresql", cfg.PostgreSQL.VaultPath, cfg.Vault.Paths.PostgreSQL, vaultClient)
+			if err != nil {
+				logger.Fatal(ctx, "failed to get postgresql credentials from vault", zap.Error(err))
+			}
+			defer creds.Close(context.Background())
+
+			pgConfig.Credentials = creds.Current
+			pgConfig.ConnMaxLifetime = sqlConnMaxLifetime(pgConfig.ConnMaxLifetime, cfg.Vault.Renewal.RenewBeforeExpiry)
+			postgresqlCreds = creds
+		}
+
+		var postgresqlSecret *secrets.CredentialsManager
+		if cfg.PostgreSQL.SecretName != "" {
+			creds, err := newSecretCredentials(ctx, "postgresql", cfg.PostgreSQL.SecretName, secretProvider)
+			if err != nil {
+				logger.Fatal(ctx, "failed to get postgresql credentials from secret manager", zap.Error(err))
+			}
+			pgConfig.Credentials = creds.Current
+			postgresqlSecret = creds
 		}
 
 		postgresDB, err = postgresql.NewClient(ctx, pgConfig)
@@ -214,11 +318,84 @@ func main() {
 			logger.Fatal(ctx, "failed to initialize postgresql client", zap.Error(err))
 		}
 
+		if postgresqlCreds != nil {
+			watchSQLCredentials(ctx, postgresqlCreds, postgresDB, cfg.PostgreSQL.MaxIdleConns)
+		}
+		if postgresqlSecret != nil {
+			watchSecretCredentials(ctx, postgresqlSecret, postgresDB, cfg.PostgreSQL.MaxIdleConns, cfg.Secrets.RefreshInterval)
+		}
+		pools.RegisterSQL("postgresql", postgresDB)
+		poolHealth.RegisterSQL("postgresql", postgresDB, sqlWarmConns(cfg.PostgreSQL.MaxIdleConns))
+
 		// Register with RepositoryManager
-		if err := repoManager.InitializePostgreSQL(ctx, postgresDB); err != nil {
+		postgresRepo := postgresql.NewPostgreSQLRepository(postgresDB)
+		if dataCipher != nil {
+			postgresRepo = postgresql.NewEncryptedPostgreSQLRepository(postgresDB, dataCipher)
+		}
+		// 변경 구독(WatchChanges)은 LISTEN 전용 연결을 사용합니다
+		if pgRepo, ok := postgresRepo.(*postgresql.PostgreSQLRepository); ok {
+			pgRepo.SetListenerConfig(pgConfig)
+			pgRepo.SetCopyThreshold(cfg.PostgreSQL.CopyThreshold)
+			if rules := cfg.PostgreSQL.Partitioning.Rules; len(rules) > 0 {
+				partitionRules := make([]postgresql.PartitionRule, len(rules))
+				for i, rule := range rules {
+					partitionRules[i] = postgresql.PartitionRule{
+						Collection: rule.Collection,
+						Strategy:   postgresql.PartitionStrategy(rule.Strategy),
+						Interval:   rule.Interval,
+						Premake:    rule.Premake,
+						Modulus:    rule.Modulus,
+					}
+				}
+				if err := pgRepo.SetPartitioning(partitionRules); err != nil {
+					logger.Fatal(ctx, "invalid postgresql partitioning rules", zap.Error(err))
+				}
+				// 범위 파티션은 주기적으로 다음 기간 파티션을 미리 만들어 둡니다
+				go pgRepo.RunPartitionMaintenance(ctx, cfg.PostgreSQL.Partitioning.MaintenanceInterval)
+			}
+			if cfg.SchemaMigrations.Enabled {
+				migrator, err := postgresql.NewSchemaMigrator(postgresDB)
+				if err != nil {
+					logger.Fatal(ctx, "failed to load postgresql schema migrations", zap.Error(err))
+				}
+				pgRepo.SetSchemaMigrator(migrator)
+				schemaMigrators["postgresql"] = migrator
+			}
+		}
+		if err := repoManager.RegisterPostgreSQL(postgresRepo); err != nil {
 			logger.Fatal(ctx, "failed to register postgresql repository", zap.Error(err))
 		}
 
+		// 읽기 전용 복제본 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+		if cfg.PostgreSQL.ReadReplica.Enabled {
+			replicaConfig := *pgConfig
+			replicaConfig.Host = cfg.PostgreSQL.ReadReplica.Host
+			if cfg.PostgreSQL.ReadReplica.Port > 0 {
+				replicaConfig.Port = cfg.PostgreSQL.ReadReplica.Port
+			}
+			replicaDB, err := postgresql.NewClient(ctx, &replicaConfig)
+			if err != nil {
+				logger.Fatal(ctx, "failed to initialize postgresql read replica", zap.Error(err))
+			}
+			defer replicaDB.Close()
+			if postgresqlCreds != nil {
+				watchSQLCredentials(ctx, postgresqlCreds, replicaDB, cfg.PostgreSQL.MaxIdleConns)
+			}
+			pools.RegisterSQL("postgresql-replica", replicaDB)
+			poolHealth.RegisterSQL("postgresql-replica", replicaDB, sqlWarmConns(cfg.PostgreSQL.MaxIdleConns))
+
+			replicaRepo := postgresql.NewPostgreSQLRepository(replicaDB)
+			if dataCipher != nil {
+				replicaRepo = postgresql.NewEncryptedPostgreSQLRepository(replicaDB, dataCipher)
+			}
+			if err := repoManager.RegisterReadReplica("postgresql", replicaRepo); err != nil {
+				logger.Fatal(ctx, "failed to register postgresql read replica", zap.Error(err))
+			}
+			logger.Info(ctx, "postgresql read replica initialized",
+				zap.String("host", replicaConfig.Host),
+			)
+		}
+
 		enabledDatabases = append(enabledDatabases, "postgresql")
 		logger.Info(ctx, "postgresql repository initialized and registered",
 			zap.String("database", cfg.PostgreSQL.Database),
@@ -246,6 +423,31 @@ func main() {
 			MaxIdleConns:    cfg.MySQL.MaxIdleConns,
 			ConnMaxLifetime: cfg.MySQL.ConnMaxLifetime,
 			ConnMaxIdleTime: cfg.MySQL.ConnMaxIdleTime,
+
+			StatementCacheSize: cfg.MySQL.StatementCacheSize,
+		}
+
+		var mysqlCreds *vault.SQLCredentialsManager
+		if cfg.MySQL.UseVault {
+			creds, err := newSQLCredentials(ctx, "mysql", cfg.MySQL.VaultPath, cfg.Vault.Paths.MySQL, vaultClient)
+			if err != nil {
+				logger.Fatal(ctx, "failed to get mysql credentials from vault", zap.Error(err))
+			}
+			defer creds.Close(context.Background())
+
+			mysqlConfig.Credentials = creds.Current
+			mysqlConfig.ConnMaxLifetime = sqlConnMaxLifetime(mysqlConfig.ConnMaxLifetime, cfg.Vault.Renewal.RenewBeforeExpiry)
+			mysqlCreds = creds
+		}
+
+		var mysqlSecret *secrets.CredentialsManager
+		if cfg.MySQL.SecretName != "" {
+			creds, err := newSecretCredentials(ctx, "mysql", cfg.MySQL.SecretName, secretProvider)
+			if err != nil {
+				logger.Fatal(ctx, "failed to get mysql credentials from secret manager", zap.Error(err))
+			}
+			mysqlConfig.Credentials = creds.Current
+			mysqlSecret = creds
 		}
 
 		mysqlDB, err = mysql.NewClient(ctx, mysqlConfig)
@@ -253,11 +455,67 @@ func main() {
 			logger.Fatal(ctx, "failed to initialize mysql client", zap.Error(err))
 		}
 
+		if mysqlCreds != nil {
+			watchSQLCredentials(ctx, mysqlCreds, mysqlDB, cfg.MySQL.MaxIdleConns)
+		}
+		if mysqlSecret != nil {
+			watchSecretCredentials(ctx, mysqlSecret, mysqlDB, cfg.MySQL.MaxIdleConns, cfg.Secrets.RefreshInterval)
+		}
+		pools.RegisterSQL("mysql", mysqlDB)
+		poolHealth.RegisterSQL("mysql", mysqlDB, sqlWarmConns(cfg.MySQL.MaxIdleConns))
+
 		// Register with RepositoryManager
-		if err := repoManager.InitializeMySQL(ctx, mysqlDB); err != nil {
+		mysqlRepo := mysql.NewMySQLRepository(mysqlDB)
+		if dataCipher != nil {
+			mysqlRepo = mysql.NewEncryptedMySQLRepository(mysqlDB, dataCipher)
+		}
+		if myRepo, ok := mysqlRepo.(*mysql.MySQLRepository); ok {
+			myRepo.SetChangeCapture(cfg.MySQL.ChangeCapture)
+			myRepo.SetInsertBatchSize(cfg.MySQL.InsertBatchSize)
+			myRepo.SetDuplicateMode(mysql.DuplicateMode(cfg.MySQL.OnDuplicate))
+			if cfg.SchemaMigrations.Enabled {
+				migrator, err := mysql.NewSchemaMigrator(mysqlDB)
+				if err != nil {
+					logger.Fatal(ctx, "failed to load mysql schema migrations", zap.Error(err))
+				}
+				myRepo.SetSchemaMigrator(migrator)
+				schemaMigrators["mysql"] = migrator
+			}
+		}
+		if err := repoManager.RegisterMySQL(mysqlRepo); err != nil {
 			logger.Fatal(ctx, "failed to register mysql repository", zap.Error(err))
 		}
 
+		// 읽기 전용 복제본 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+		if cfg.MySQL.ReadReplica.Enabled {
+			replicaConfig := *mysqlConfig
+			replicaConfig.Host = cfg.MySQL.ReadReplica.Host
+			if cfg.MySQL.ReadReplica.Port > 0 {
+				replicaConfig.Port = cfg.MySQL.ReadReplica.Port
+			}
+			replicaDB, err := mysql.NewClient(ctx, &replicaConfig)
+			if err != nil {
+				logger.Fatal(ctx, "failed to initialize mysql read replica", zap.Error(err))
+			}
+			defer replicaDB.Close()
+			if mysqlCreds != nil {
+				watchSQLCredentials(ctx, mysqlCreds, replicaDB, cfg.MySQL.MaxIdleConns)
+			}
+			pools.RegisterSQL("mysql-replica", replicaDB)
+			poolHealth.RegisterSQL("mysql-replica", replicaDB, sqlWarmConns(cfg.MySQL.MaxIdleConns))
+
+			replicaRepo := mysql.NewMySQLRepository(replicaDB)
+			if dataCipher != nil {
+				replicaRepo = mysql.NewEncryptedMySQLRepository(replicaDB, dataCipher)
+			}
+			if err := repoManager.RegisterReadReplica("mysql", replicaRepo); err != nil {
+				logger.Fatal(ctx, "failed to register mysql read replica", zap.Error(err))
+			}
+			logger.Info(ctx, "mysql read replica initialized",
+				zap.String("host", replicaConfig.Host),
+			)
+		}
+
 		enabledDatabases = append(enabledDatabases, "mysql")
 		logger.Info(ctx, "mysql repository initialized and registered",
 			zap.String("database", cfg.MySQL.Database),
@@ -282,15 +540,30 @@ func main() {
 			Consistency: cfg.Cassandra.Consistency,
 			NumConns:    cfg.Cassandra.NumConns,
 			Timeout:     cfg.Cassandra.Timeout,
+
+			ConnectTimeout:    cfg.Cassandra.ConnectTimeout,
+			MaxRetries:        cfg.Cassandra.MaxRetries,
+			ReconnectInterval: cfg.Cassandra.ReconnectInterval,
+			LocalDC:           cfg.Cassandra.LocalDC,
+			PoolObserver:      cassandra.NewPoolObserver(),
 		}
 
 		cassandraSession, err = cassandra.NewClient(ctx, cassandraConfig)
 		if err != nil {
 			logger.Fatal(ctx, "failed to initialize cassandra client", zap.Error(err))
 		}
+		pools.Register("cassandra", poolstats.DriverCassandra, cassandraConfig.PoolObserver.Stats)
+		poolHealth.Register("cassandra", cassandra.NewHealthPool(cassandraSession), cassandraWarmConns(&cfg.Cassandra))
 
 		// Register with RepositoryManager
-		if err := repoManager.InitializeCassandra(ctx, cassandraSession, cfg.Cassandra.Keyspace); err != nil {
+		cassandraRepo := cassandra.NewCassandraRepository(cassandraSession, cfg.Cassandra.Keyspace)
+		if batchRepo, ok := cassandraRepo.(*cassandra.CassandraRepository); ok {
+			batchRepo.SetBatchConfig(cassandra.BatchConfig{
+				Size:        cfg.Cassandra.BatchSize,
+				Concurrency: cfg.Cassandra.BatchConcurrency,
+			})
+		}
+		if err := repoManager.RegisterCassandra(cassandraRepo); err != nil {
 			logger.Fatal(ctx, "failed to register cassandra repository", zap.Error(err))
 		}
 
@@ -320,7 +593,16 @@ func main() {
 		}
 
 		// Register with RepositoryManager
-		if err := repoManager.InitializeElasticsearch(ctx, elasticsearchClient); err != nil {
+		esRepo := elasticsearch.NewElasticsearchRepository(elasticsearchClient)
+		if bulkRepo, ok := esRepo.(*elasticsearch.ElasticsearchRepository); ok {
+			bulkRepo.SetBulkConfig(elasticsearch.BulkConfig{
+				Workers:       cfg.Elasticsearch.Bulk.Workers,
+				FlushBytes:    cfg.Elasticsearch.Bulk.FlushBytes,
+				FlushInterval: cfg.Elasticsearch.Bulk.FlushInterval,
+				Refresh:       cfg.Elasticsearch.Bulk.Refresh,
+			})
+		}
+		if err := repoManager.RegisterElasticsearch(esRepo); err != nil {
 			logger.Fatal(ctx, "failed to register elasticsearch repository", zap.Error(err))
 		}
 
@@ -347,9 +629,11 @@ func main() {
 		if err != nil {
 			logger.Fatal(ctx, "failed to initialize vitess client", zap.Error(err))
 		}
+		pools.RegisterSQL("vitess", vitessDB)
+		poolHealth.RegisterSQL("vitess", vitessDB, sqlWarmConns(cfg.Vitess.MaxIdleConns))
 
 		// Register with RepositoryManager
-		if err := repoManager.InitializeVitess(ctx, vitessDB); err != nil {
+		if err := repoManager.InitializeVitess(ctx, vitessDB, cfg.Vitess.Keyspace); err != nil {
 			logger.Fatal(ctx, "failed to register vitess repository", zap.Error(err))
 		}
 
@@ -375,47 +659,106 @@ func main() {
 		zap.Int("count", len(enabledDatabases)),
 	)
 
+	// 요청별 백엔드 라우팅 (경로/헤더로 지정하지 않은 요청은 컬렉션 규칙 또는 기본 백엔드로)
+	backendRouting, err := newBackendRouting(&cfg.BackendRouting, enabledDatabases)
+	if err != nil {
+		logger.Fatal(ctx, "invalid backend routing configuration", zap.Error(err))
+	}
+	logger.Info(ctx, "backend routing configured",
+		zap.String("default", backendRouting.Table().Default()),
+		zap.Int("rules", len(cfg.BackendRouting.Collections)),
+	)
+
+	// 쓰기 배치 모드 (Optional, 지정한 컬렉션의 단건 저장을 모아 SaveMany로 저장)
+	if cfg.WriteBatching.Enabled {
+		closeBatching := enableWriteBatching(&cfg.WriteBatching, repoManager)
+		defer closeBatching()
+		logger.Info(ctx, "write batching enabled",
+			zap.Strings("collections", cfg.WriteBatching.Collections),
+			zap.Duration("max_delay", cfg.WriteBatching.MaxDelay),
+			zap.Int("max_batch", cfg.WriteBatching.MaxBatch),
+		)
+	}
+
+	// 백엔드 간 온라인 마이그레이션 (Optional, 컬렉션을 dual-write로 다른 데이터베이스에 옮기고 전환)
+	var migrationUC *usecase.MigrationUseCase
+	if cfg.OnlineMigration.Enabled {
+		migrationUC = enableOnlineMigration(&cfg.OnlineMigration, repoManager)
+		logger.Info(ctx, "online migration enabled",
+			zap.Int("batch_size", cfg.OnlineMigration.BatchSize),
+		)
+	}
+
+	// SQL 문서 테이블 스키마 마이그레이션 (Optional, 시작할 때 모든 컬렉션 테이블에 남은 버전 적용)
+	var schemaMigrationUC *usecase.SchemaMigrationUseCase
+	if cfg.SchemaMigrations.Enabled {
+		if cfg.SchemaMigrations.ApplyOnStartup {
+			if err := applySchemaMigrations(ctx, schemaMigrators); err != nil {
+				logger.Fatal(ctx, "failed to apply schema migrations", zap.Error(err))
+			}
+		}
+		schemaMigrationUC = usecase.NewSchemaMigrationUseCase(schemaMigrators)
+	}
+
+	// 연결 풀 예열 (첫 요청 지연 제거) 및 끊어진 유휴 연결 정리
+	stopPoolHealth := startPoolHealth(ctx, &cfg.PoolHealth, poolHealth, m)
+	defer stopPoolHealth()
+
 	// ============================================
 	// 8. Redis Cache Initialization
 	// ============================================
-	redisCache, err := cache.NewRedisCache(ctx, &cache.Config{
-		Host:        cfg.Redis.Host,
-		Port:        cfg.Redis.Port,
-		Password:    cfg.Redis.Password,
-		DB:          cfg.Redis.DB,
-		MaxRetries:  cfg.Redis.MaxRetries,
-		PoolSize:    cfg.Redis.PoolSize,
-		MinIdleConn: cfg.Redis.MinIdleConns,
-	})
+	redisCache, err := newRedisCache(ctx, &cfg.Redis)
 	if err != nil {
 		logger.Fatal(ctx, "failed to initialize redis cache", zap.Error(err))
 	}
 	defer redisCache.Close()
+	pools.Register("redis", poolstats.DriverRedis, redisCache.PoolStats)
 	logger.Info(ctx, "redis cache initialized",
+		zap.String("mode", redisCache.Mode()),
 		zap.String("host", cfg.Redis.Host),
-		zap.Int("port", cfg.Redis.Port),
+		zap.Strings("addresses", cfg.Redis.Addresses),
 	)
 
+	// 기능 플래그 (Optional) - 환경/테넌트별로 백엔드, 캐시 전략, CDC 발행을 켜고 끔
+	featureFlags, err := newFeatureFlags(ctx, &cfg.FeatureFlags, cfg.App.Environment, redisCache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure feature flags", zap.Error(err))
+	}
+	if featureFlags != nil {
+		logger.Info(ctx, "feature flags enabled",
+			zap.String("environment", featureFlags.Environment()),
+			zap.Int("flags", len(cfg.FeatureFlags.Flags)),
+		)
+	}
+
 	// ============================================
 	// 9. Kafka Producer Initialization (Optional)
 	// ============================================
 	var kafkaProducer *kafka.Producer
+	var kafkaSecurity *kafka.SecurityConfig
+	var kafkaCreds *vault.KafkaCredentialsManager
 	if cfg.Kafka.Enabled {
-		kafkaProducer, err = kafka.NewProducer(&kafka.ProducerConfig{
-			Brokers:          cfg.Kafka.Brokers,
-			ClientID:         cfg.Kafka.ClientID,
-			MaxMessageBytes:  1000000,
-			RequiredAcks:     -1,
-			Compression:      1,
-			MaxRetries:       3,
-			RetryBackoff:     100 * time.Millisecond,
-			EnableIdempotent: true,
-			UseAsync:         false,
-		})
+		var err error
+		kafkaSecurity, kafkaCreds, err = newKafkaSecurity(ctx, &cfg.Kafka.Security, vaultClient)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure kafka security", zap.Error(err))
+		}
+		if kafkaCreds != nil {
+			defer kafkaCreds.Close(context.Background())
+		}
+
+		producerConfig, err := newKafkaProducerConfig(&cfg.Kafka, cfg.Kafka.ClientID, kafkaSecurity, m)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure kafka producer", zap.Error(err))
+		}
+		kafkaProducer, err = kafka.NewProducer(producerConfig)
 		if err != nil {
 			logger.Warn(ctx, "failed to initialize kafka producer", zap.Error(err))
 		} else {
 			defer kafkaProducer.Close()
+			if kafkaCreds != nil {
+				watchKafkaCredentials(ctx, kafkaCreds, kafkaProducer)
+			}
 			logger.Info(ctx, "kafka producer initialized", zap.Strings("brokers", cfg.Kafka.Brokers))
 		}
 	}
@@ -423,9 +766,143 @@ func main() {
 	// ============================================
 	// 10. UseCase Layer Initialization with RepositoryManager
 	// ============================================
-	documentUC := usecase.NewDocumentUseCaseWithManager(repoManager, redisCache)
+	documentCache, err := newDocumentCache(ctx, &cfg.Cache, redisCache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize document cache", zap.Error(err))
+	}
+	if cfg.Cache.Backend == "memcached" {
+		logger.Info(ctx, "memcached document cache enabled",
+			zap.Strings("servers", cfg.Cache.Memcached.Servers),
+		)
+	}
+	documentUC := usecase.NewDocumentUseCaseWithManager(repoManager, documentCache)
+	if cfg.Cache.Local.Enabled {
+		logger.Info(ctx, "local lru cache enabled in front of redis",
+			zap.Int("max_entries", cfg.Cache.Local.MaxEntries),
+			zap.Duration("ttl", cfg.Cache.Local.TTL),
+		)
+	}
 	logger.Info(ctx, "use case initialized with repository manager")
 
+	// 감사 로그 (Optional, MongoDB append-only 컬렉션)
+	var auditUC *usecase.AuditUseCase
+	if cfg.Audit.Enabled && mongoClient != nil {
+		auditRepo := mongodb.NewAuditRepository(mongoClient.Database(cfg.MongoDB.Database))
+		if err := auditRepo.EnsureIndexes(ctx, cfg.Audit.Retention); err != nil {
+			logger.Fatal(ctx, "failed to prepare audit log collection", zap.Error(err))
+		}
+		documentUC.SetAuditRepository(auditRepo)
+		auditUC = usecase.NewAuditUseCase(auditRepo)
+		logger.Info(ctx, "audit log enabled",
+			zap.String("collection", mongodb.AuditCollectionName),
+			zap.Duration("retention", cfg.Audit.Retention),
+		)
+	}
+
+	// 문서 리비전 (Optional, MongoDB 또는 PostgreSQL _revisions에 이전 버전 보관)
+	if cfg.Revisions.Enabled {
+		revisionRepo, err := newRevisionRepository(&cfg.Revisions, mongoClient, cfg.MongoDB.Database, postgresDB)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize revision store", zap.Error(err))
+		}
+		if err := revisionRepo.EnsureSchema(ctx); err != nil {
+			logger.Fatal(ctx, "failed to prepare revision store", zap.Error(err))
+		}
+		documentUC.SetRevisions(revisionRepo, newRevisionPolicies(&cfg.Revisions))
+		logger.Info(ctx, "revision history enabled",
+			zap.String("store", cfg.Revisions.Store),
+			zap.Int("collections", len(cfg.Revisions.Collections)),
+		)
+	}
+
+	// 컬렉션 백업/복원 (백그라운드 작업, 로컬 디렉터리 또는 S3에 저장)
+	var backupUC *usecase.BackupUseCase
+	if cfg.Backup.Enabled {
+		backupUC, err = newBackupUseCase(ctx, &cfg.Backup, repoManager)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize backup", zap.Error(err))
+		}
+		logger.Info(ctx, "collection backup enabled",
+			zap.String("storage", cfg.Backup.Storage),
+			zap.Bool("consistent", cfg.Backup.Consistent),
+		)
+		startBackupSchedule(ctx, &cfg.Backup.Schedule, backupUC)
+	}
+
+	// 컬렉션 유지보수 (compact, vacuum, forcemerge 등 백그라운드 작업과 스케줄)
+	var maintenanceUC *usecase.MaintenanceUseCase
+	if cfg.Maintenance.Enabled {
+		maintenanceUC = newMaintenanceUseCase(ctx, &cfg.Maintenance, repoManager)
+		logger.Info(ctx, "collection maintenance enabled", zap.Int("schedules", len(cfg.Maintenance.Schedules)))
+	}
+
+	// 중복 문서 탐지/정리 (키 필드 해시로 그룹화, 병합 또는 삭제)
+	var duplicateUC *usecase.DuplicateUseCase
+	if cfg.Duplicates.Enabled {
+		duplicateUC = usecase.NewDuplicateUseCase(documentUC, cfg.Duplicates.MaxGroups)
+		logger.Info(ctx, "duplicate detection enabled")
+	}
+
+	// 컬렉션 익명화 작업 (필드 해시/덮어쓰기, 재개 가능)
+	var anonymizationUC *usecase.AnonymizationUseCase
+	if cfg.Anonymization.Enabled {
+		anonymizationUC, err = newAnonymization(documentUC, &cfg.Anonymization)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize anonymization", zap.Error(err))
+		}
+		logger.Info(ctx, "anonymization jobs enabled", zap.Int("batch_size", cfg.Anonymization.BatchSize))
+	}
+
+	// Elasticsearch 검색 미러 (CDC 동기화, 주 저장소와 인덱스 일관성 검증)
+	var searchMirrorUC *usecase.SearchMirrorUseCase
+	if cfg.SearchMirror.Enabled {
+		searchMirrorUC, err = newSearchMirrorUseCase(ctx, &cfg.SearchMirror, repoManager)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize search mirror", zap.Error(err))
+		}
+		if cfg.SearchMirror.Sync.Enabled && kafkaSecurity != nil {
+			if err := startSearchMirrorSync(ctx, cfg, kafkaSecurity, kafkaCreds, searchMirrorUC); err != nil {
+				logger.Fatal(ctx, "failed to start search mirror sync", zap.Error(err))
+			}
+		}
+		logger.Info(ctx, "search mirror enabled", zap.Int("collections", len(cfg.SearchMirror.Collections)))
+	}
+
+	// JSON 픽스처 불러오기 (데모 환경, 통합 테스트용)
+	var fixtureUC *usecase.FixtureUseCase
+	if cfg.Fixtures.Enabled {
+		fixtureUC = usecase.NewFixtureUseCase(documentUC, cfg.Fixtures.Directory)
+		if cfg.Fixtures.LoadOnStartup {
+			if err := loadStartupFixtures(ctx, &cfg.Fixtures, fixtureUC); err != nil {
+				logger.Fatal(ctx, "failed to load startup fixtures", zap.Error(err))
+			}
+		}
+		logger.Info(ctx, "fixture loading enabled", zap.String("directory", cfg.Fixtures.Directory))
+	}
+
+	// 테넌트 프로비저닝 (컬렉션 네임스페이스, 인덱스, 쿼터, API 키, CDC 토픽)
+	var tenantUC *usecase.TenantUseCase
+	var apiKeyVerifier *auth.APIKeyVerifier
+	if cfg.Tenants.Enabled && mongoClient != nil {
+		tenantUC, apiKeyVerifier, err = newTenantUseCase(ctx, cfg, mongoClient.Database(cfg.MongoDB.Database), documentUC, kafkaSecurity, kafkaCreds)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize tenant provisioning", zap.Error(err))
+		}
+		logger.Info(ctx, "tenant provisioning enabled",
+			zap.Int("default_collections", len(cfg.Tenants.DefaultCollections)),
+			zap.Bool("cdc_topics", cfg.Tenants.CDCTopics.Enabled),
+		)
+	}
+
+	// 컬렉션/테넌트 저장 용량 쿼터 (Optional)
+	if cfg.Quotas.Enabled {
+		startStorageQuotas(ctx, &cfg.Quotas, documentUC, tenantUC)
+		logger.Info(ctx, "storage quotas enabled",
+			zap.Int("collection_quotas", len(cfg.Quotas.Collections)),
+			zap.Bool("tenant_quotas", tenantUC != nil),
+		)
+	}
+
 	// ============================================
 	// 11. HTTP Handlers Initialization
 	// For health check, use first available repository
@@ -433,6 +910,267 @@ func main() {
 	healthHandler := httpHandler.NewHealthHandler(defaultRepo, redisCache, vaultClient, kafkaProducer)
 	logger.Info(ctx, "http handlers initialized")
 
+	// OIDC 인증 (Optional)
+	var oidcVerifier *auth.OIDCVerifier
+	if cfg.Auth.Enabled {
+		oidcVerifier, err = newOIDCVerifier(ctx, &cfg.Auth)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize oidc verifier", zap.Error(err))
+		}
+		defer oidcVerifier.Close()
+		logger.Info(ctx, "oidc authentication enabled",
+			zap.String("issuer", cfg.Auth.OIDC.IssuerURL),
+		)
+	}
+
+	// HMAC 요청 서명 인증 (Optional)
+	var hmacVerifier *auth.HMACVerifier
+	if cfg.Auth.HMAC.Enabled {
+		hmacVerifier, err = newHMACVerifier(&cfg.Auth.HMAC, redisCache)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize hmac verifier", zap.Error(err))
+		}
+		logger.Info(ctx, "hmac request signing enabled",
+			zap.Int("key_count", len(cfg.Auth.HMAC.Keys)),
+		)
+	}
+
+	// 운영자 대리 실행 (Optional)
+	var impersonator *auth.Impersonator
+	if cfg.Auth.Impersonation.Enabled {
+		impersonator, err = newImpersonator(&cfg.Auth.Impersonation)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize impersonation", zap.Error(err))
+		}
+		logger.Info(ctx, "admin impersonation enabled",
+			zap.Strings("allowed_roles", cfg.Auth.Impersonation.AllowedRoles),
+		)
+	}
+
+	// 인증 실패 잠금 (Optional)
+	var authLockout *lockout.Guard
+	if cfg.Auth.Lockout.Enabled {
+		authLockout, err = newLockoutGuard(&cfg.Auth.Lockout, redisCache)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize auth lockout", zap.Error(err))
+		}
+		if auditUC != nil {
+			authLockout.OnLockout(auditUC.RecordLockout)
+		}
+		logger.Info(ctx, "auth brute-force protection enabled",
+			zap.Int64("max_failures", cfg.Auth.Lockout.MaxFailures),
+			zap.Duration("window", cfg.Auth.Lockout.Window),
+		)
+	}
+
+	// IP 허용/차단 (비활성화 시 빈 규칙으로 시작하며 SIGHUP으로 활성화할 수 있습니다)
+	ipFilterCfg, err := newIPFilterConfig(&cfg.IPFilter)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize ip filter", zap.Error(err))
+	}
+	if !cfg.IPFilter.Enabled {
+		ipFilterCfg = nil
+	}
+	ipFilter := ipfilter.New(ipFilterCfg)
+
+	// 행 수준 보안 (Optional)
+	rowPolicies, err := newRowPolicySet(&cfg.Auth)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize row policies", zap.Error(err))
+	}
+	if rowPolicies != nil {
+		documentUC.SetRowPolicies(rowPolicies)
+		logger.Info(ctx, "row-level security enabled",
+			zap.Int("policy_count", len(cfg.Auth.RowPolicies)),
+		)
+	}
+
+	// 쓰기 시 개인정보 탐지 (Optional)
+	piiScanner, err := newPIIScanner(&cfg.PII)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize pii scanner", zap.Error(err))
+	}
+	if piiScanner != nil {
+		documentUC.SetPIIScanner(piiScanner)
+		logger.Info(ctx, "pii detection enabled",
+			zap.String("default_action", cfg.PII.DefaultAction),
+			zap.Int("policy_count", len(cfg.PII.Policies)),
+		)
+	}
+
+	// 쓰기 시 JSON Schema 검증 (Optional)
+	if cfg.SchemaValidation.Enabled {
+		schemas, err := newCollectionSchemas(&cfg.SchemaValidation)
+		if err != nil {
+			logger.Fatal(ctx, "failed to load collection schemas", zap.Error(err))
+		}
+		documentUC.SetSchemas(schemas)
+		logger.Info(ctx, "schema validation enabled", zap.Int("collections", len(schemas)))
+	}
+
+	// data 필드 고유 제약 (Optional)
+	configureUniqueKeys(ctx, documentUC, &cfg.UniqueKeys)
+
+	// 컬렉션 간 문서 참조 (Optional)
+	if cfg.References.Enabled {
+		documentUC.SetReferences(newReferences(&cfg.References), cfg.References.MaxCascade)
+		logger.Info(ctx, "document references enabled", zap.Int("references", len(cfg.References.Definitions)))
+	}
+
+	// 컬렉션별 캐시 전략
+	cachePolicies, err := newCachePolicies(&cfg.Cache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize cache policies", zap.Error(err))
+	}
+	documentUC.SetCachePolicies(cachePolicies)
+	documentUC.SetNegativeCacheTTL(cfg.Cache.NegativeTTL)
+	documentUC.SetEarlyRefresh(cfg.Cache.EarlyRefresh)
+	documentUC.SetCircuitBreakers(newCircuitBreakers(cfg))
+	documentUC.SetFeatureFlags(featureFlags)
+	configureRetry(documentUC, &cfg.Retry)
+	documentUC.SetOperationTimeouts(usecase.OperationTimeouts{
+		Read:  cfg.Timeouts.Read,
+		Write: cfg.Timeouts.Write,
+		Admin: cfg.Timeouts.Admin,
+	})
+	documentUC.SetBulkWriteParallelism(usecase.BulkWriteParallelism{
+		Workers:       cfg.BulkWrite.Workers,
+		MinOperations: cfg.BulkWrite.MinOperations,
+	})
+	if cfg.Cache.Query.Enabled {
+		documentUC.SetQueryCacheTTL(cfg.Cache.Query.TTL)
+	}
+	if cachePolicies.UsesWriteBehind() {
+		cacheWriteQueue := newWriteBehindQueue(&cfg.Cache.WriteBehind, documentCache)
+		cacheWriteQueue.Start(ctx)
+		defer cacheWriteQueue.Close()
+		documentUC.SetCacheWriteQueue(cacheWriteQueue)
+	}
+	logger.Info(ctx, "cache strategies configured",
+		zap.String("default_strategy", cfg.Cache.DefaultStrategy),
+		zap.Int("policy_count", len(cfg.Cache.Policies)),
+		zap.Duration("negative_ttl", cfg.Cache.NegativeTTL),
+	)
+	if cfg.Cache.Warmup.Enabled {
+		go warmCache(ctx, &cfg.Cache.Warmup, documentUC)
+	}
+	if cfg.Cache.CDCInvalidation.Enabled && kafkaSecurity != nil {
+		if err := startCacheInvalidation(ctx, cfg, kafkaSecurity, kafkaCreds, documentUC); err != nil {
+			logger.Fatal(ctx, "failed to start cdc cache invalidation", zap.Error(err))
+		}
+	}
+
+	// CQRS 읽기/쓰기 라우팅 (조회/검색/집계를 컬렉션별로 데이터베이스의 읽기 복제본에 분배)
+	if cfg.ReadRouting.Enabled {
+		readRouting, err := newReadRouting(&cfg.ReadRouting)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure read routing", zap.Error(err))
+		}
+		documentUC.SetReadRouting(readRouting)
+		logger.Info(ctx, "read routing enabled",
+			zap.String("default", cfg.ReadRouting.Default),
+			zap.Int("rules", len(cfg.ReadRouting.Collections)),
+		)
+	}
+
+	// 정리 작업 리더 선출 (Optional, 켜져 있으면 Lease를 가진 레플리카만 만료/휴지통/보존/아카이브 작업 실행)
+	janitorElector, err := newLeaderElector(&cfg.LeaderElection, cfg.App.Name+"-janitor")
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure leader election", zap.Error(err))
+	}
+	janitorJobs := &singletonJobs{elector: janitorElector}
+
+	// 문서 만료 (TTL, Optional)
+	if cfg.Expiry.Enabled {
+		startExpirySweeper(ctx, &cfg.Expiry, documentUC, janitorJobs)
+		logger.Info(ctx, "document expiry enabled",
+			zap.Int("collections", len(cfg.Expiry.Collections)),
+			zap.Duration("sweep_interval", cfg.Expiry.SweepInterval),
+		)
+	}
+
+	// 소프트 삭제 (Optional)
+	if cfg.SoftDelete.Enabled {
+		startSoftDeletePurge(ctx, &cfg.SoftDelete, documentUC, janitorJobs)
+		logger.Info(ctx, "soft delete enabled",
+			zap.Int("collections", len(cfg.SoftDelete.Collections)),
+			zap.Duration("purge_interval", cfg.SoftDelete.PurgeInterval),
+		)
+	}
+
+	// 데이터 보존 정책 (Optional)
+	if cfg.Retention.Enabled {
+		startRetentionJanitor(ctx, &cfg.Retention, documentUC, janitorJobs)
+		logger.Info(ctx, "retention janitor enabled",
+			zap.Int("collections", len(cfg.Retention.Collections)),
+			zap.Duration("interval", cfg.Retention.Interval),
+			zap.Bool("dry_run", cfg.Retention.DryRun),
+		)
+	}
+
+	// 콜드 데이터 보관 (Optional, 로컬 디렉터리 또는 S3로 계층화)
+	if cfg.Archive.Enabled {
+		if err := startArchiver(ctx, &cfg.Archive, documentUC, janitorJobs); err != nil {
+			logger.Fatal(ctx, "failed to initialize archive", zap.Error(err))
+		}
+		logger.Info(ctx, "cold data archiving enabled",
+			zap.String("storage", cfg.Archive.Storage),
+			zap.Int("collections", len(cfg.Archive.Collections)),
+			zap.Duration("interval", cfg.Archive.Interval),
+		)
+	}
+	if janitorElector != nil {
+		janitorJobs.Start(ctx)
+		logger.Info(ctx, "leader election enabled for cleanup jobs",
+			zap.String("namespace", cfg.LeaderElection.Namespace),
+			zap.String("identity", instanceID()),
+		)
+	}
+
+	// Rate limiting 정책 (Optional, 미설정 시 IP 기반 고정 윈도우)
+	var rateLimitPolicy *ratelimit.Policy
+	if cfg.RateLimit.Enabled {
+		rateLimitPolicy = newRateLimitPolicy(&cfg.RateLimit)
+		logger.Info(ctx, "token bucket rate limiting enabled",
+			zap.Float64("requests_per_second", cfg.RateLimit.RequestsPerSecond),
+			zap.Int64("burst", cfg.RateLimit.Burst),
+			zap.Int("route_overrides", len(cfg.RateLimit.Routes)),
+		)
+	}
+
+	// 설정 다시 읽기 (SIGHUP, reload.watch_file) - 로그 레벨, 캐시 TTL, rate limit, circuit breaker, IP 필터
+	if err := watchConfigReload(ctx, cfg, reloadTargets{
+		configPath:      configPath,
+		configName:      configName,
+		documentUC:      documentUC,
+		cachePolicies:   cachePolicies,
+		circuitBreakers: documentUC.CircuitBreakers(),
+		rateLimitPolicy: rateLimitPolicy,
+		ipFilter:        ipFilter,
+	}); err != nil {
+		logger.Fatal(ctx, "failed to watch config for reload", zap.Error(err))
+	}
+
+	// 적응형 동시성 제한 (Optional) - 한도를 넘는 요청은 503으로 거부
+	loadShedder := newLoadShedder(&cfg.LoadShedding)
+	if loadShedder != nil {
+		logger.Info(ctx, "load shedding enabled", zap.Int("initial_limit", loadShedder.Limit()))
+	}
+
+	// pprof 내부 포트와 연속 프로파일링 (Optional)
+	stopProfiling, err := startProfiling(ctx, cfg, oidcVerifier, ipFilter)
+	if err != nil {
+		logger.Fatal(ctx, "failed to start profiling", zap.Error(err))
+	}
+	defer stopProfiling()
+
+	// 연결 풀 상태 메트릭 (db_pool_*)
+	if cfg.Observability.Metrics.Enabled {
+		poolStatsCtx, stopPoolStats := context.WithCancel(ctx)
+		defer stopPoolStats()
+		go pools.Run(poolStatsCtx, cfg.Observability.Metrics.PoolStatsInterval, m)
+	}
+
 	// ============================================
 	// 12. Router Setup with all 36 endpoints
 	// ============================================
@@ -444,13 +1182,47 @@ func main() {
 		cfg.Observability.Tracing.Enabled,
 		cfg.Observability.Metrics.Enabled,
 		cfg.App.Environment,
+		&router.Options{
+			OIDCVerifier:           oidcVerifier,
+			HMACVerifier:           hmacVerifier,
+			APIKeyVerifier:         apiKeyVerifier,
+			Impersonator:           impersonator,
+			AuthLockout:            authLockout,
+			LoadShedder:            loadShedder,
+			RateLimitPolicy:        rateLimitPolicy,
+			AuditUseCase:           auditUC,
+			IPFilter:               ipFilter,
+			TrustedProxies:         cfg.Server.HTTP.TrustedProxies,
+			BackupUseCase:          backupUC,
+			MaintenanceUseCase:     maintenanceUC,
+			DuplicateUseCase:       duplicateUC,
+			AnonymizationUseCase:   anonymizationUC,
+			SearchMirrorUseCase:    searchMirrorUC,
+			FixtureUseCase:         fixtureUC,
+			MigrationUseCase:       migrationUC,
+			SchemaMigrationUseCase: schemaMigrationUC,
+			TenantUseCase:          tenantUC,
+			BackendRouting:         backendRouting,
+			ConfigChecker:          config.NewChecker(configPath, configName, probeBackends),
+			PoolStats:              pools,
+			FeatureFlags:           featureFlags,
+		},
 	)
 
-	logger.Info(ctx, "router initialized with 36 REST API endpoints supporting dynamic database selection via X-Database-Type header")
+	logger.Info(ctx, "router initialized with 36 REST API endpoints supporting dynamic database selection via X-Database-Type header, /api/v1/{backend} path or collection routing")
 
 	// ============================================
 	// 13. HTTP Server Configuration
 	// ============================================
+	// TLS (인증서 파일 또는 Vault PKI 자동 교체)
+	serverTLS, certManager, err := newServerTLSConfig(ctx, cfg, vaultClient)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure server tls", zap.Error(err))
+	}
+	if certManager != nil {
+		defer certManager.StopAutoRotation()
+	}
+
 	srv := &http.Server{
 		Addr:           fmt.Sprintf(":%d", cfg.Server.HTTP.Port),
 		Handler:        r,
@@ -458,6 +1230,7 @@ func main() {
 		WriteTimeout:   cfg.Server.HTTP.WriteTimeout,
 		MaxHeaderBytes: 1 << 20, // 1 MB
 		IdleTimeout:    120 * time.Second,
+		TLSConfig:      serverTLS,
 	}
 
 	// Start server in goroutine
@@ -474,7 +1247,14 @@ func main() {
 			zap.Bool("vitess", cfg.Vitess.Enabled),
 		)
 
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if serverTLS != nil {
+			// 인증서는 TLSConfig에서 제공하므로 파일 경로를 넘기지 않습니다
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logger.Fatal(ctx, "failed to start HTTP server", zap.Error(err))
 		}
 	}()
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// newMaintenanceUseCase는 유지보수 유즈케이스를 생성하고 maintenance.schedules의 작업을 백그라운드로 시작합니다
+func newMaintenanceUseCase(ctx context.Context, cfg *config.MaintenanceConfig, repoManager *persistence.RepositoryManager) *usecase.MaintenanceUseCase {
+	maintenanceUC := usecase.NewMaintenanceUseCase(repoManager)
+	for _, s := range cfg.Schedules {
+		go maintenanceUC.RunSchedule(ctx, usecase.MaintenanceSchedule{
+			DatabaseType: s.DatabaseType,
+			Collection:   s.Collection,
+			Operation:    s.Operation,
+			Interval:     s.Interval,
+			Options: repository.MaintenanceOptions{
+				Full:        s.Full,
+				MaxSegments: s.MaxSegments,
+			},
+		})
+		logger.Info(ctx, "scheduled collection maintenance enabled",
+			zap.String("database_type", s.DatabaseType),
+			zap.String("collection", s.Collection),
+			zap.String("operation", s.Operation),
+			zap.Duration("interval", s.Interval),
+		)
+	}
+	return maintenanceUC
+}
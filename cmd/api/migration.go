@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/migration"
+)
+
+// enableOnlineMigration은 등록된 주 저장소와 읽기 복제본을 마이그레이션 라우팅 저장소로 감싸고 마이그레이션 유즈케이스를 반환합니다
+// 다른 저장소 래퍼(쓰기 배치 등)를 적용한 뒤에 호출해야 마이그레이션 쓰기도 그 래퍼를 거칩니다
+func enableOnlineMigration(cfg *config.OnlineMigrationConfig, repoManager *persistence.RepositoryManager) *usecase.MigrationUseCase {
+	coordinator := migration.NewCoordinator()
+	repoManager.WrapRepositories(coordinator.Wrap)
+	repoManager.WrapReadReplicas(coordinator.WrapReplica)
+	return usecase.NewMigrationUseCase(repoManager, coordinator, cfg.BatchSize)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/nats"
+)
+
+// newNATSPublisher는 설정으로부터 NATS JetStream CDC 발행자를 생성합니다
+func newNATSPublisher(ctx context.Context, cfg *config.NATSConfig, clientName string) (*nats.JetStreamPublisher, error) {
+	return nats.NewJetStreamPublisher(ctx, nats.Config{
+		Servers:         cfg.Servers,
+		Username:        cfg.Username,
+		Password:        cfg.Password,
+		Token:           cfg.Token,
+		Name:            clientName,
+		Stream:          cfg.Stream,
+		SubjectPrefix:   cfg.SubjectPrefix,
+		DuplicateWindow: cfg.DuplicateWindow,
+		Timeout:         cfg.Timeout,
+	})
+}
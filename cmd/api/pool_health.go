@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/poolhealth"
+)
+
+// sqlWarmConns는 SQL 연결 풀에서 예열할 연결 수입니다 (유휴 연결 한도를 넘으면 반환 시 닫히므로 max_idle_conns)
+func sqlWarmConns(maxIdleConns int) int {
+	if maxIdleConns <= 0 {
+		return 5 // client 기본값
+	}
+	return maxIdleConns
+}
+
+// cassandraWarmConns는 Cassandra 세션에서 예열할 조회 수입니다 (호스트 수 × 호스트당 연결 수)
+func cassandraWarmConns(cfg *config.CassandraConfig) int {
+	numConns := cfg.NumConns
+	if numConns <= 0 {
+		numConns = 2 // client 기본값
+	}
+	return len(cfg.Hosts) * numConns
+}
+
+// startPoolHealth는 등록된 연결 풀을 예열하고 백그라운드 검증을 시작합니다 (예열이 끝날 때까지 기다림)
+// 검증은 반환된 stop 함수를 호출하거나 ctx가 취소될 때까지 실행됩니다
+func startPoolHealth(ctx context.Context, cfg *config.PoolHealthConfig, registry *poolhealth.Registry, m *metrics.Metrics) (stop func()) {
+	if cfg.WarmUp {
+		registry.WarmUp(ctx, cfg.WarmUpTimeout)
+	}
+	if !cfg.Validation {
+		return func() {}
+	}
+
+	validationCtx, cancel := context.WithCancel(ctx)
+	go registry.Run(validationCtx, cfg.ValidationInterval, cfg.ValidationTimeout, m)
+	return cancel
+}
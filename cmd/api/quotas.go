@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/quota"
+)
+
+// startStorageQuotas는 컬렉션/테넌트 저장 용량 쿼터를 설정하고 사용량 갱신을 백그라운드에서 시작합니다
+// tenantUC가 nil이 아니면 테넌트 쿼터도 적용합니다
+func startStorageQuotas(ctx context.Context, cfg *config.QuotasConfig, documentUC *usecase.DocumentUseCase, tenantUC *usecase.TenantUseCase) {
+	collections := make([]usecase.CollectionQuota, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		collections = append(collections, usecase.CollectionQuota{
+			Collection:   c.Collection,
+			MaxDocuments: c.MaxDocuments,
+			MaxBytes:     c.MaxBytes,
+		})
+	}
+	documentUC.SetStorageQuotas(collections, quota.Limit{
+		MaxDocuments: cfg.Default.MaxDocuments,
+		MaxBytes:     cfg.Default.MaxBytes,
+	})
+	if tenantUC != nil {
+		documentUC.SetTenantQuotaResolver(tenantUC)
+	}
+
+	interval := cfg.RefreshInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	go documentUC.RunQuotaRefresher(ctx, interval)
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/rabbitmq"
+)
+
+// newRabbitMQPublisher는 설정으로부터 RabbitMQ CDC 발행자를 생성합니다
+func newRabbitMQPublisher(ctx context.Context, cfg *config.RabbitMQConfig, clientName string) (*rabbitmq.Publisher, error) {
+	return rabbitmq.NewPublisher(ctx, rabbitmq.Config{
+		URL:             cfg.URL,
+		ExchangeCreated: cfg.Exchanges.DocumentCreated,
+		ExchangeUpdated: cfg.Exchanges.DocumentUpdated,
+		ExchangeDeleted: cfg.Exchanges.DocumentDeleted,
+		ExchangeType:    cfg.ExchangeType,
+		Name:            clientName,
+		Heartbeat:       cfg.Heartbeat,
+		Timeout:         cfg.Timeout,
+	})
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
+)
+
+// newRateLimitPolicy는 설정으로부터 토큰 버킷 정책을 생성합니다
+func newRateLimitPolicy(cfg *config.RateLimitConfig) *ratelimit.Policy {
+	policy := &ratelimit.Policy{
+		Default: ratelimit.Limit{
+			Rate:  cfg.RequestsPerSecond,
+			Burst: cfg.Burst,
+		},
+	}
+
+	for _, route := range cfg.Routes {
+		policy.Rules = append(policy.Rules, ratelimit.Rule{
+			Pattern: route.Pattern,
+			Limit: ratelimit.Limit{
+				Rate:  route.RequestsPerSecond,
+				Burst: route.Burst,
+			},
+		})
+	}
+
+	return policy
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// newReadRouting은 설정의 컬렉션별 읽기 라우팅 규칙을 생성합니다
+func newReadRouting(cfg *config.ReadRoutingConfig) (*usecase.ReadRouting, error) {
+	routes := make([]usecase.ReadRoute, 0, len(cfg.Collections))
+	for _, route := range cfg.Collections {
+		routes = append(routes, usecase.ReadRoute{
+			Collection: route.Collection,
+			Mode:       usecase.ReadMode(route.Mode),
+		})
+	}
+	return usecase.NewReadRouting(usecase.ReadMode(cfg.Default), routes)
+}
+
+// newMongoReadReplica는 읽기 선호도(기본 secondaryPreferred), 읽기 일관성, hedged reads를 적용한 MongoDB 복제본 읽기 저장소를 생성합니다
+// 쓰기 연결과 별도 연결 풀을 사용해 반환된 클라이언트는 호출자가 종료해야 합니다
+// poolMonitor가 있으면 복제본 연결 풀 상태를 집계합니다
+func newMongoReadReplica(ctx context.Context, uri, database string, cfg *config.MongoDBReadReplicaConfig, poolMonitor *mongodb.PoolMonitor) (repository.DocumentRepository, *mongo.Client, error) {
+	if cfg.URI != "" {
+		uri = cfg.URI
+	}
+	mode := cfg.ReadPreference
+	if mode == "" {
+		mode = "secondaryPreferred"
+	}
+	rp, err := mongodb.ParseReadPreference(mode, cfg.MaxStaleness, cfg.HedgedReads)
+	if err != nil {
+		return nil, nil, err
+	}
+	rc, err := mongodb.ParseReadConcern(cfg.ReadConcern)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	clientOptions := options.Client().ApplyURI(uri).SetReadPreference(rp)
+	if rc != nil {
+		clientOptions.SetReadConcern(rc)
+	}
+	if cfg.MaxPoolSize > 0 {
+		clientOptions.SetMaxPoolSize(cfg.MaxPoolSize)
+	}
+	if poolMonitor != nil {
+		clientOptions.SetPoolMonitor(poolMonitor.Monitor())
+	}
+	client, err := mongo.Connect(connectCtx, clientOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to mongodb read replica: %w", err)
+	}
+	if err := client.Ping(connectCtx, rp); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, nil, fmt.Errorf("failed to ping mongodb read replica: %w", err)
+	}
+	return mongodb.NewReadReplicaRepository(client, database), client, nil
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+)
+
+// newReferences는 references 설정을 컬렉션 간 참조 선언으로 변환합니다
+func newReferences(cfg *config.ReferencesConfig) []usecase.Reference {
+	references := make([]usecase.Reference, 0, len(cfg.Definitions))
+	for _, d := range cfg.Definitions {
+		action := usecase.ReferenceAction(d.OnDelete)
+		if action == "none" {
+			action = usecase.ReferenceNoAction
+		}
+		references = append(references, usecase.Reference{
+			Collection: d.Collection,
+			Field:      d.Field,
+			Target:     d.Target,
+			OnDelete:   action,
+		})
+	}
+	return references
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/circuitbreaker"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
+	"go.uber.org/zap"
+)
+
+// reloadTargets는 설정을 다시 읽을 때 실행 중에 바꾸는 구성 요소입니다
+// rateLimitPolicy가 nil이면(시작할 때 rate_limit이 꺼져 있었으면) rate limit은 재시작해야 바뀝니다
+type reloadTargets struct {
+	configPath      string // 시작할 때 읽은 설정 파일 위치 (--config)
+	configName      string
+	documentUC      *usecase.DocumentUseCase
+	cachePolicies   *usecase.CachePolicies
+	circuitBreakers *circuitbreaker.Registry
+	rateLimitPolicy *ratelimit.Policy
+	ipFilter        *ipfilter.Filter
+}
+
+// watchConfigReload는 SIGHUP을 받거나 (reload.watch_file이면) 설정 파일이 바뀌면
+// 로그 레벨, 캐시 TTL, rate limit, circuit breaker 임계값, IP 필터 규칙을 새 설정으로 바꿉니다
+// 읽기나 검증에 실패한 설정은 적용하지 않고 기존 값을 유지합니다
+func watchConfigReload(ctx context.Context, cfg *config.Config, targets reloadTargets) error {
+	return config.Watch(ctx, targets.configPath, targets.configName, cfg.Reload.WatchFile, func(source string, next *config.Config, err error) {
+		if err != nil {
+			logger.Error(ctx, "config reload failed, keeping current settings",
+				zap.String("source", source),
+				zap.Error(err),
+			)
+			return
+		}
+		applyConfigReload(ctx, next, targets)
+		logger.Info(ctx, "config reloaded",
+			zap.String("source", source),
+			zap.String("log_level", logger.Level()),
+			zap.Duration("cache_default_ttl", next.Cache.DefaultTTL),
+			zap.Bool("rate_limit", next.RateLimit.Enabled),
+			zap.Bool("ip_filter", next.IPFilter.Enabled),
+		)
+	})
+}
+
+// applyConfigReload는 다시 읽은 설정을 실행 중인 구성 요소에 적용합니다
+// 항목별로 적용하므로 한 항목이 잘못되어도 나머지 항목은 바뀝니다
+func applyConfigReload(ctx context.Context, cfg *config.Config, targets reloadTargets) {
+	if level := cfg.Observability.Logging.Level; level != "" {
+		if err := logger.SetLevel(level); err != nil {
+			logger.Error(ctx, "invalid log level in reloaded config", zap.String("level", level), zap.Error(err))
+		}
+	}
+
+	if targets.cachePolicies != nil {
+		ttls := make(map[string]time.Duration, len(cfg.Cache.Policies))
+		for _, p := range cfg.Cache.Policies {
+			ttls[p.Collection] = p.TTL
+		}
+		targets.cachePolicies.SetTTLs(cfg.Cache.DefaultTTL, ttls)
+	}
+	if targets.documentUC != nil {
+		targets.documentUC.SetNegativeCacheTTL(cfg.Cache.NegativeTTL)
+		var queryTTL time.Duration
+		if cfg.Cache.Query.Enabled {
+			queryTTL = cfg.Cache.Query.TTL
+		}
+		targets.documentUC.SetQueryCacheTTL(queryTTL)
+	}
+
+	switch {
+	case targets.rateLimitPolicy != nil && cfg.RateLimit.Enabled:
+		targets.rateLimitPolicy.Update(newRateLimitPolicy(&cfg.RateLimit))
+	case targets.rateLimitPolicy != nil:
+		// 빈 정책은 모든 요청을 제한 없이 통과시킵니다
+		targets.rateLimitPolicy.Update(&ratelimit.Policy{})
+	case cfg.RateLimit.Enabled:
+		logger.Warn(ctx, "rate_limit was disabled at startup; restart to enable token bucket rate limiting")
+	}
+
+	if targets.circuitBreakers != nil {
+		backends := make(map[string]circuitbreaker.Settings, len(cfg.CircuitBreaker.Backends))
+		for name, settings := range cfg.CircuitBreaker.Backends {
+			backends[name] = circuitBreakerSettings(settings)
+		}
+		targets.circuitBreakers.Update(circuitBreakerSettings(cfg.CircuitBreaker.CircuitBreakerSettings), backends)
+	}
+
+	if targets.ipFilter != nil {
+		filterCfg, err := newIPFilterConfig(&cfg.IPFilter)
+		if err != nil {
+			logger.Error(ctx, "ip filter reload failed", zap.Error(err))
+			return
+		}
+		if !cfg.IPFilter.Enabled {
+			filterCfg = nil
+		}
+		targets.ipFilter.Update(filterCfg)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+)
+
+// newRetentionPolicies는 retention 설정을 컬렉션별 보존 정책으로 변환합니다
+func newRetentionPolicies(cfg *config.RetentionConfig) []usecase.RetentionPolicy {
+	policies := make([]usecase.RetentionPolicy, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		policies = append(policies, usecase.RetentionPolicy{
+			Collection: c.Name,
+			MaxAge:     c.MaxAge,
+			MaxCount:   c.MaxCount,
+		})
+	}
+	return policies
+}
+
+// startRetentionJanitor는 보존 정책을 설정하고 정책을 넘은 문서의 정리를 백그라운드에서 시작합니다
+func startRetentionJanitor(ctx context.Context, cfg *config.RetentionConfig, documentUC *usecase.DocumentUseCase, jobs *singletonJobs) {
+	documentUC.SetRetentionPolicies(newRetentionPolicies(cfg), cfg.DryRun)
+
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	jobs.Go(ctx, func(ctx context.Context) { documentUC.RunRetentionJanitor(ctx, interval) })
+}
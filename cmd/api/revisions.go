@@ -0,0 +1,44 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newRevisionRepository는 revisions.store에 맞는 리비전 저장소를 생성합니다
+func newRevisionRepository(cfg *config.RevisionsConfig, mongoClient *mongo.Client, mongoDatabase string, postgresDB *sql.DB) (repository.RevisionRepository, error) {
+	switch cfg.Store {
+	case "", "mongodb":
+		if mongoClient == nil {
+			return nil, fmt.Errorf("revision store mongodb requires mongodb to be enabled")
+		}
+		return mongodb.NewRevisionRepository(mongoClient.Database(mongoDatabase)), nil
+	case "postgresql":
+		if postgresDB == nil {
+			return nil, fmt.Errorf("revision store postgresql requires postgresql to be enabled")
+		}
+		return postgresql.NewRevisionRepository(postgresDB), nil
+	default:
+		return nil, fmt.Errorf("unsupported revision store: %s", cfg.Store)
+	}
+}
+
+// newRevisionPolicies는 revisions 설정을 컬렉션별 리비전 보관 정책으로 변환합니다
+func newRevisionPolicies(cfg *config.RevisionsConfig) []usecase.RevisionPolicy {
+	policies := make([]usecase.RevisionPolicy, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		policies = append(policies, usecase.RevisionPolicy{
+			Collection:   c.Name,
+			MaxRevisions: c.MaxRevisions,
+			Retention:    c.Retention,
+		})
+	}
+	return policies
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// applySchemaMigrations는 등록된 SQL 백엔드의 모든 문서 테이블에 남은 스키마 마이그레이션을 적용합니다
+// 여러 인스턴스가 동시에 시작해도 데이터베이스 잠금으로 한 인스턴스만 적용합니다
+func applySchemaMigrations(ctx context.Context, migrators map[string]*schema.Migrator) error {
+	dbTypes := make([]string, 0, len(migrators))
+	for dbType := range migrators {
+		dbTypes = append(dbTypes, dbType)
+	}
+	sort.Strings(dbTypes)
+
+	for _, dbType := range dbTypes {
+		migrator := migrators[dbType]
+		changes, err := migrator.Up(ctx, 0)
+		if err != nil {
+			return err
+		}
+		logger.Info(ctx, "schema migrations applied",
+			zap.String("database_type", dbType),
+			zap.Int("latest_version", migrator.Latest()),
+			zap.Int("changes", len(changes)),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/jsonschema"
+)
+
+// newCollectionSchemas는 schema_validation.collections의 스키마 파일을 읽어 컴파일합니다
+func newCollectionSchemas(cfg *config.SchemaValidationConfig) (map[string]*jsonschema.Schema, error) {
+	schemas := make(map[string]*jsonschema.Schema, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		data, err := os.ReadFile(c.SchemaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema for %s: %w", c.Name, err)
+		}
+		schema, err := jsonschema.Compile(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema for %s: %w", c.Name, err)
+		}
+		schemas[c.Name] = schema
+	}
+	return schemas, nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// newSearchMirrorUseCase는 검색 미러 유즈케이스를 생성하고 mapping_file이 설정된 미러 인덱스를 준비한 뒤,
+// verify_interval이 설정된 컬렉션의 주기적 검증을 시작합니다
+func newSearchMirrorUseCase(ctx context.Context, cfg *config.SearchMirrorConfig, repoManager *persistence.RepositoryManager) (*usecase.SearchMirrorUseCase, error) {
+	mirrors := make([]usecase.SearchMirror, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		source := c.Source
+		if source == "" {
+			source = "mongodb"
+		}
+		mirror := usecase.SearchMirror{
+			Collection:     c.Collection,
+			Source:         source,
+			Index:          c.Index,
+			VerifyInterval: c.VerifyInterval,
+			AutoRepair:     c.AutoRepair,
+		}
+		if c.MappingFile != "" {
+			mapping, err := readIndexMapping(c.MappingFile)
+			if err != nil {
+				return nil, fmt.Errorf("invalid mapping for %s: %w", c.Collection, err)
+			}
+			mirror.Mapping = mapping
+		}
+		mirrors = append(mirrors, mirror)
+	}
+
+	searchMirrorUC := usecase.NewSearchMirrorUseCase(repoManager, mirrors)
+	if err := searchMirrorUC.EnsureMappings(ctx); err != nil {
+		return nil, err
+	}
+	for _, m := range mirrors {
+		if m.VerifyInterval <= 0 {
+			continue
+		}
+		go searchMirrorUC.RunSchedule(ctx, m)
+		logger.Info(ctx, "scheduled search mirror verification enabled",
+			zap.String("collection", m.Collection),
+			zap.String("source_database", m.Source),
+			zap.Duration("interval", m.VerifyInterval),
+			zap.Bool("auto_repair", m.AutoRepair),
+		)
+	}
+	return searchMirrorUC, nil
+}
+
+// readIndexMapping은 {"settings": {...}, "mappings": {...}} 형식의 매핑 파일을 읽습니다 (mappings는 문서 data 객체의 매핑)
+func readIndexMapping(path string) (*repository.IndexMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Settings map[string]interface{} `json:"settings"`
+		Mappings map[string]interface{} `json:"mappings"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	return &repository.IndexMapping{Settings: file.Settings, Data: file.Mappings}, nil
+}
+
+// startSearchMirrorSync는 CDC 이벤트로 검색 미러 인덱스를 갱신하는 컨슈머를 시작합니다
+// 모든 인스턴스가 같은 컨슈머 그룹을 사용해 이벤트를 한 번씩 나눠 처리합니다
+func startSearchMirrorSync(ctx context.Context, cfg *config.Config, security *kafka.SecurityConfig, manager *vault.KafkaCredentialsManager, searchMirrorUC *usecase.SearchMirrorUseCase) error {
+	groupID := cfg.SearchMirror.Sync.GroupID
+	if groupID == "" {
+		groupID = "database-service-search-mirror"
+	}
+	initialOffset := cfg.SearchMirror.Sync.InitialOffset
+	if initialOffset == "" {
+		initialOffset = "newest"
+	}
+	avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+	if err != nil {
+		return fmt.Errorf("failed to configure avro deserialization: %w", err)
+	}
+
+	consumer, err := kafka.NewSearchMirrorConsumer(&kafka.ConsumerConfig{
+		Brokers: cfg.Kafka.Brokers,
+		GroupID: groupID,
+		Topics: []string{
+			cfg.Kafka.CDCTopics.DocumentCreated,
+			cfg.Kafka.CDCTopics.DocumentUpdated,
+			cfg.Kafka.CDCTopics.DocumentDeleted,
+		},
+		InitialOffset:     initialOffset,
+		SessionTimeout:    cfg.Kafka.Consumer.SessionTimeout,
+		HeartbeatInterval: cfg.Kafka.Consumer.HeartbeatInterval,
+		Security:          security,
+		Avro:              avroSerializer,
+	}, searchMirrorUC.SyncDocument)
+	if err != nil {
+		return fmt.Errorf("failed to create search mirror consumer: %w", err)
+	}
+
+	if manager != nil {
+		manager.OnRotate(func(creds *vault.KafkaCredentials) {
+			if err := consumer.UpdateCredentials(ctx, creds.Username, creds.Password); err != nil {
+				logger.Error(ctx, "failed to apply rotated kafka credentials to search mirror consumer", zap.Error(err))
+			}
+		})
+	}
+
+	// Start는 컨텍스트가 취소될 때까지 블록되며 종료 시 컨슈머 그룹을 닫습니다
+	go func() {
+		if err := consumer.Start(ctx); err != nil {
+			logger.Error(ctx, "search mirror consumer stopped", zap.Error(err))
+		}
+	}()
+
+	logger.Info(ctx, "search mirror sync enabled", zap.String("group_id", groupID), zap.String("initial_offset", initialOffset))
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/secrets"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// newSecretProvider는 secrets.provider 설정으로 데이터베이스 자격증명 공급자를 생성합니다 (비어 있으면 nil)
+func newSecretProvider(cfg *config.SecretsConfig, vaultClient *vault.Client) (secrets.Provider, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case "aws":
+		return secrets.NewAWSProvider(secrets.AWSConfig{
+			Region:          cfg.AWS.Region,
+			Endpoint:        cfg.AWS.Endpoint,
+			AccessKeyID:     cfg.AWS.AccessKeyID,
+			SecretAccessKey: cfg.AWS.SecretAccessKey,
+			SessionToken:    cfg.AWS.SessionToken,
+		})
+	case "gcp":
+		return secrets.NewGCPProvider(secrets.GCPConfig{
+			Project:         cfg.GCP.Project,
+			Endpoint:        cfg.GCP.Endpoint,
+			CredentialsFile: cfg.GCP.CredentialsFile,
+		})
+	case "vault":
+		if vaultClient == nil {
+			return nil, fmt.Errorf("secrets.provider vault requires vault to be enabled")
+		}
+		return vault.NewKVSecretProvider(vaultClient), nil
+	default:
+		return nil, fmt.Errorf("unsupported secret provider: %s", cfg.Provider)
+	}
+}
+
+// newSecretCredentials는 시크릿 매니저에서 엔진의 첫 자격증명을 읽습니다
+func newSecretCredentials(ctx context.Context, engine, name string, provider secrets.Provider) (*secrets.CredentialsManager, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("%s.secret_name requires secrets.provider", engine)
+	}
+
+	manager := secrets.NewCredentialsManager(provider, engine, name)
+	if _, err := manager.GetCredentials(ctx); err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "using secret manager sql credentials",
+		zap.String("engine", engine),
+		zap.String("provider", provider.Name()),
+		zap.String("secret", name),
+	)
+	return manager, nil
+}
+
+// watchSecretCredentials는 refresh_interval마다 시크릿을 다시 읽고, 교체되면 유휴 연결을 비워 이후 연결이 새 자격증명을 사용하도록 합니다
+func watchSecretCredentials(ctx context.Context, manager *secrets.CredentialsManager, db *sql.DB, maxIdleConns int, interval time.Duration) {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	manager.OnRotate(func(creds *secrets.Credentials) {
+		refreshSQLPool(ctx, db, maxIdleConns, creds.Username)
+	})
+	go manager.Run(ctx, interval)
+}
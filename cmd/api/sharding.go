@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sharding"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// newMongoShards는 sharding.shards의 MongoDB 인스턴스에 연결해 해시 샤딩 저장소를 생성합니다
+// 새 문서에는 저장 전에 ObjectID를 배정해 샤드를 정하며, 반환된 함수로 모든 샤드 연결을 종료해야 합니다
+func newMongoShards(ctx context.Context, cfg *config.Config, pools *poolstats.Registry) (*sharding.Repository, func(), error) {
+	var clients []*mongo.Client
+	closeAll := func() {
+		for _, client := range clients {
+			_ = client.Disconnect(context.Background())
+		}
+	}
+
+	shards := make([]sharding.Shard, 0, len(cfg.Sharding.Shards))
+	for _, shardCfg := range cfg.Sharding.Shards {
+		database := shardCfg.Database
+		if database == "" {
+			database = cfg.MongoDB.Database
+		}
+
+		client, poolMonitor, err := connectMongoShard(ctx, shardCfg.URI, cfg.MongoDB.MaxPoolSize)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("failed to connect to mongodb shard %s: %w", shardCfg.Name, err)
+		}
+		clients = append(clients, client)
+		pools.Register("mongodb-shard-"+shardCfg.Name, poolstats.DriverMongoDB, poolMonitor.Stats)
+
+		shards = append(shards, sharding.Shard{
+			Name:       shardCfg.Name,
+			Repository: mongodb.NewDocumentRepositoryWithClient(client, database),
+		})
+	}
+
+	repo, err := sharding.NewRepository(shards, sharding.Config{
+		ShardKey: cfg.Sharding.ShardKey,
+		NewID: func() string {
+			return primitive.NewObjectID().Hex()
+		},
+	})
+	if err != nil {
+		closeAll()
+		return nil, nil, err
+	}
+	return repo, closeAll, nil
+}
+
+// connectMongoShard는 샤드 인스턴스 하나에 연결하고 primary 응답을 확인합니다
+func connectMongoShard(ctx context.Context, uri string, maxPoolSize uint64) (*mongo.Client, *mongodb.PoolMonitor, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	poolMonitor := mongodb.NewPoolMonitor()
+	clientOptions := options.Client().ApplyURI(uri).SetPoolMonitor(poolMonitor.Monitor())
+	if maxPoolSize > 0 {
+		clientOptions.SetMaxPoolSize(maxPoolSize)
+	}
+	client, err := mongo.Connect(connectCtx, clientOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := client.Ping(connectCtx, readpref.Primary()); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, nil, err
+	}
+	return client, poolMonitor, nil
+}
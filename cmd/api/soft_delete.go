@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+)
+
+// newSoftDeletePolicies는 soft_delete 설정을 컬렉션별 소프트 삭제 정책으로 변환합니다
+func newSoftDeletePolicies(cfg *config.SoftDeleteConfig) []usecase.SoftDeletePolicy {
+	policies := make([]usecase.SoftDeletePolicy, 0, len(cfg.Collections))
+	for _, c := range cfg.Collections {
+		policies = append(policies, usecase.SoftDeletePolicy{
+			Collection: c.Name,
+			Retention:  c.Retention,
+		})
+	}
+	return policies
+}
+
+// startSoftDeletePurge는 소프트 삭제 정책을 설정하고 보존 기간이 지난 문서의 영구 삭제를 백그라운드에서 시작합니다
+func startSoftDeletePurge(ctx context.Context, cfg *config.SoftDeleteConfig, documentUC *usecase.DocumentUseCase, jobs *singletonJobs) {
+	documentUC.SetSoftDeletePolicies(newSoftDeletePolicies(cfg))
+
+	interval := cfg.PurgeInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	jobs.Go(ctx, func(ctx context.Context) { documentUC.RunSoftDeletePurge(ctx, interval) })
+}
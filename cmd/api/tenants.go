@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newTenantUseCase는 테넌트 프로비저닝 유즈케이스와 테넌트 API 키 검증기를 생성합니다
+// cdc_topics가 켜져 있으면 Kafka 토픽 관리자를 만들고 Vault 자격증명이 갱신되면 새 자격증명을 사용합니다
+func newTenantUseCase(ctx context.Context, cfg *config.Config, mongoDB *mongo.Database, documentUC *usecase.DocumentUseCase, kafkaSecurity *kafka.SecurityConfig, kafkaCreds *vault.KafkaCredentialsManager) (*usecase.TenantUseCase, *auth.APIKeyVerifier, error) {
+	tenantRepo := mongodb.NewTenantRepository(mongoDB)
+	if err := tenantRepo.EnsureIndexes(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	opts := usecase.TenantOptions{
+		DefaultQuota: entity.TenantQuota{
+			MaxDocuments: cfg.Tenants.DefaultQuota.MaxDocuments,
+			MaxBytes:     cfg.Tenants.DefaultQuota.MaxBytes,
+		},
+		DefaultAPIKeyRoles: cfg.Tenants.DefaultAPIKeyRoles,
+	}
+	for _, c := range cfg.Tenants.DefaultCollections {
+		coll := dto.TenantCollectionRequest{Name: c.Name}
+		for _, idx := range c.Indexes {
+			coll.Indexes = append(coll.Indexes, dto.TenantIndexRequest{
+				Name:   idx.Name,
+				Keys:   idx.Keys,
+				Unique: idx.Unique,
+			})
+		}
+		opts.DefaultCollections = append(opts.DefaultCollections, coll)
+	}
+
+	var topics usecase.TenantTopicAdmin
+	if cfg.Tenants.CDCTopics.Enabled {
+		admin, err := kafka.NewTopicAdmin(kafka.TopicAdminConfig{
+			Brokers:           cfg.Kafka.Brokers,
+			ClientID:          cfg.Kafka.ClientID + "-tenant-admin",
+			Partitions:        cfg.Tenants.CDCTopics.Partitions,
+			ReplicationFactor: cfg.Tenants.CDCTopics.ReplicationFactor,
+			Security:          kafkaSecurity,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if kafkaCreds != nil {
+			kafkaCreds.OnRotate(func(creds *vault.KafkaCredentials) {
+				admin.UpdateCredentials(creds.Username, creds.Password)
+			})
+		}
+		topics = admin
+		opts.CDCTopics = true
+		opts.CDCBaseTopics = []string{
+			cfg.Kafka.CDCTopics.DocumentCreated,
+			cfg.Kafka.CDCTopics.DocumentUpdated,
+			cfg.Kafka.CDCTopics.DocumentDeleted,
+		}
+	}
+
+	tenantUC := usecase.NewTenantUseCase(tenantRepo, documentUC, topics, opts)
+	return tenantUC, auth.NewAPIKeyVerifier(tenantUC, cfg.Tenants.APIKeyCacheTTL), nil
+}
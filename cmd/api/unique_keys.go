@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// configureUniqueKeys는 설정의 고유 제약과 데이터베이스 종류별 중복 키 에러 판별 함수를 적용하고 저장소에 제약을 준비합니다
+// 제약이 없어도 판별 함수는 등록해 ID 중복 같은 저장소의 중복 키 에러를 409로 응답합니다
+func configureUniqueKeys(ctx context.Context, documentUC *usecase.DocumentUseCase, cfg *config.UniqueKeysConfig) {
+	var constraints []usecase.UniqueConstraint
+	if cfg.Enabled {
+		for _, c := range cfg.Constraints {
+			constraints = append(constraints, usecase.UniqueConstraint{Collection: c.Collection, Fields: c.Fields})
+		}
+	}
+
+	documentUC.SetUniqueConstraints(constraints, map[string]func(err error) bool{
+		"mongodb":    mongodb.IsDuplicateKey,
+		"postgresql": postgresql.IsDuplicateKey,
+		"mysql":      mysql.IsDuplicateKey,
+		"vitess":     mysql.IsDuplicateKey,
+	})
+	if len(constraints) == 0 {
+		return
+	}
+
+	documentUC.PrepareUniqueConstraints(ctx)
+	logger.Info(ctx, "unique keys enabled", zap.Int("constraints", len(constraints)))
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/batching"
+)
+
+// newBatchingConfig는 write_batching 설정을 쓰기 배치 저장소 설정으로 변환합니다
+func newBatchingConfig(cfg *config.WriteBatchingConfig) batching.Config {
+	return batching.Config{
+		Collections:  cfg.Collections,
+		MaxDelay:     cfg.MaxDelay,
+		MaxBatch:     cfg.MaxBatch,
+		FlushTimeout: cfg.FlushTimeout,
+	}
+}
+
+// enableWriteBatching은 등록된 주 저장소를 모두 쓰기 배치 저장소로 감싸고, 배치 모드를 끝내고 대기 중인 문서를 저장하는 close 함수를 반환합니다
+func enableWriteBatching(cfg *config.WriteBatchingConfig, repoManager *persistence.RepositoryManager) (closeBatching func()) {
+	batchingConfig := newBatchingConfig(cfg)
+	var batchers []*batching.Repository
+	repoManager.WrapRepositories(func(dbType string, repo repository.DocumentRepository) repository.DocumentRepository {
+		batcher := batching.NewRepository(repo, batchingConfig)
+		batchers = append(batchers, batcher)
+		return batcher
+	})
+	return func() {
+		for _, batcher := range batchers {
+			batcher.Close()
+		}
+	}
+}
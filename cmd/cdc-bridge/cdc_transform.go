@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+)
+
+// newCDCTransforms는 cdc.transforms 설정을 CDC 필드 변환 규칙으로 변환합니다
+func newCDCTransforms(cfg []config.CDCTransformConfig) []messaging.FieldTransform {
+	transforms := make([]messaging.FieldTransform, 0, len(cfg))
+	for _, t := range cfg {
+		renames := make([]messaging.FieldRename, 0, len(t.Rename))
+		for _, r := range t.Rename {
+			renames = append(renames, messaging.FieldRename{From: r.From, To: r.To})
+		}
+		transforms = append(transforms, messaging.FieldTransform{
+			Collection:  t.Collection,
+			Drop:        t.Drop,
+			Rename:      renames,
+			ChangedOnly: t.ChangedOnly,
+		})
+	}
+	return transforms
+}
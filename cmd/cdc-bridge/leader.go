@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/leader"
+)
+
+// newLeaderElector는 leader_election 설정으로 Kubernetes Lease 리더 선출기를 생성합니다 (꺼져 있으면 nil)
+func newLeaderElector(cfg *config.LeaderElectionConfig, name string) (*leader.Elector, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	client, namespace, err := leader.NewKubernetesClient(leader.KubernetesConfig{Namespace: cfg.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	return leader.NewElector(client, namespace, leader.Config{
+		Name:          leader.LeaseName(name),
+		Identity:      instanceID(),
+		LeaseDuration: cfg.LeaseDuration,
+		RenewDeadline: cfg.RenewDeadline,
+		RetryPeriod:   cfg.RetryPeriod,
+	})
+}
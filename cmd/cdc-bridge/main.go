@@ -0,0 +1,324 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const (
+	restartInitialBackoff = time.Second
+	restartMaxBackoff     = time.Minute
+)
+
+// cdc-bridge는 MongoDB 변경 스트림(또는 MySQL 변경 로그)을 읽어 CDC 토픽으로 발행하는 워커입니다
+// 서비스를 거치지 않은 쓰기도 다운스트림 컨슈머가 받을 수 있도록 합니다
+func main() {
+	// ============================================
+	// 1. Configuration
+	// ============================================
+	cfg, err := config.LoadConfig("./configs", "config")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+	if !cfg.CDCBridge.Enabled {
+		fmt.Fprintln(os.Stderr, "cdc bridge is disabled (cdc_bridge.enabled=false)")
+		os.Exit(1)
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// ============================================
+	// 2. Logger Initialization
+	// ============================================
+	if err := logger.Init(logger.Config{
+		Level:       cfg.Observability.Logging.Level,
+		Environment: cfg.App.Environment,
+		ServiceName: cfg.App.Name + "-cdc-bridge",
+		Version:     cfg.App.Version,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger.Info(ctx, "starting cdc bridge",
+		zap.String("version", cfg.App.Version),
+		zap.String("environment", cfg.App.Environment),
+		zap.String("go_version", runtime.Version()),
+	)
+
+	// ============================================
+	// 3. Metrics Server (published/skipped/error counts, publish lag)
+	// ============================================
+	metrics.Init(strings.ReplaceAll(cfg.App.Name, "-", "_"))
+	metricsServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.CDCBridge.MetricsPort),
+		Handler:           promhttp.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if cfg.CDCBridge.MetricsPort > 0 {
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error(ctx, "metrics server failed", zap.Error(err))
+			}
+		}()
+		logger.Info(ctx, "metrics server started", zap.Int("port", cfg.CDCBridge.MetricsPort))
+	}
+
+	// ============================================
+	// 4. Vault Client Initialization (Optional, MongoDB/MySQL/Kafka 자격증명용)
+	// ============================================
+	var vaultClient *vault.Client
+	if cfg.Vault.Enabled {
+		vaultClient, err = vault.NewClient(&vault.Config{
+			Address:           cfg.Vault.Address,
+			Token:             cfg.Vault.Token,
+			AuthMethod:        cfg.Vault.AuthMethod,
+			RoleID:            cfg.Vault.RoleID,
+			SecretID:          cfg.Vault.SecretID,
+			K8sRole:           cfg.Vault.K8sRole,
+			MongoDBPath:       cfg.Vault.Paths.MongoDB,
+			KafkaPath:         cfg.Vault.Paths.Kafka,
+			RenewInterval:     cfg.Vault.Renewal.Interval,
+			RenewBeforeExpiry: cfg.Vault.Renewal.RenewBeforeExpiry,
+		})
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize vault client", zap.Error(err))
+		}
+		defer vaultClient.Close()
+	}
+
+	// ============================================
+	// 5. Change Source Initialization
+	// ============================================
+	name := cfg.CDCBridge.Name
+	if name == "" {
+		name = "cdc-bridge"
+	}
+	sourceType := cfg.CDCBridge.Source
+	if sourceType == "" {
+		sourceType = "mongodb"
+	}
+
+	var (
+		source      changeSource
+		closeSource func()
+	)
+	switch sourceType {
+	case "mysql":
+		source, closeSource, err = newMySQLSource(ctx, cfg, name, vaultClient)
+	default:
+		source, closeSource, err = newMongoDBSource(ctx, cfg, name, vaultClient)
+	}
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize change source", zap.String("source", sourceType), zap.Error(err))
+	}
+	defer closeSource()
+
+	// ============================================
+	// 6. Kafka CDC Publisher
+	// ============================================
+	kafkaSecurity, kafkaCreds, err := newKafkaSecurity(ctx, &cfg.Kafka.Security, vaultClient)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure kafka security", zap.Error(err))
+	}
+	if kafkaCreds != nil {
+		defer kafkaCreds.Close(context.Background())
+	}
+
+	// 동기 전송으로 발행이 확인된 변경까지만 재개 토큰(읽기 위치)을 저장합니다
+	producerConfig, err := newKafkaProducerConfig(&cfg.Kafka, cfg.Kafka.ClientID+"-cdc-bridge", kafkaSecurity, metrics.GetMetrics())
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure kafka producer", zap.Error(err))
+	}
+	producer, err := kafka.NewProducer(producerConfig)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize kafka producer", zap.Error(err))
+	}
+	defer producer.Close()
+	if kafkaCreds != nil {
+		watchKafkaCredentials(ctx, kafkaCreds, producer)
+	}
+
+	topicRouter, err := newTopicRouter(&cfg.Kafka)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure cdc topic routing", zap.Error(err))
+	}
+	kafkaPublisher := kafka.NewCDCPublisher(
+		producer,
+		cfg.Kafka.CDCTopics.DocumentCreated,
+		cfg.Kafka.CDCTopics.DocumentUpdated,
+		cfg.Kafka.CDCTopics.DocumentDeleted,
+	)
+	kafkaPublisher.SetRouter(topicRouter)
+	avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure avro serialization", zap.Error(err))
+	}
+	if avroSerializer != nil {
+		kafkaPublisher.SetAvro(avroSerializer)
+	}
+
+	var cdcPublisher messaging.CDCPublisher = kafkaPublisher
+	cdcPublisher.SetOrigin(instanceID())
+	cdcPublisher.SetEncoder(messaging.EventEncoder{
+		Format:     cfg.CDC.Format,
+		Source:     cfg.CDC.Source,
+		ServerName: cfg.CDC.ServerName,
+	})
+	if len(cfg.CDC.Transforms) > 0 {
+		transformPublisher, err := messaging.NewTransformPublisher(cdcPublisher, newCDCTransforms(cfg.CDC.Transforms))
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure cdc field transforms", zap.Error(err))
+		}
+		cdcPublisher = transformPublisher
+	}
+
+	// ============================================
+	// 7. Bridge Loop
+	// ============================================
+	// 스트림이 끊기거나 발행에 실패하면 마지막으로 저장한 재개 토큰(읽기 위치)부터 다시 시작합니다
+	runBridge := func(ctx context.Context) {
+		backoff := restartInitialBackoff
+		for {
+			err := source.Run(ctx, func(ctx context.Context, event *repository.ChangeEvent) error {
+				return publishChange(ctx, cdcPublisher, event)
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			if mongodb.IsHistoryLost(err) {
+				logger.Fatal(ctx, "resume token is no longer in the oplog, delete it from the resume token collection to restart from now",
+					zap.String("name", name),
+					zap.String("collection", mongodb.ResumeTokenCollectionName),
+					zap.Error(err),
+				)
+			}
+
+			logger.Error(ctx, "change source stopped, restarting",
+				zap.Duration("backoff", backoff),
+				zap.Error(err),
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > restartMaxBackoff {
+				backoff = restartMaxBackoff
+			}
+		}
+	}
+
+	// 리더 선출이 켜져 있으면 Lease를 가진 레플리카만 변경을 읽고, 리더십을 잃으면 멈췄다가 다시 선출에 참여합니다
+	elector, err := newLeaderElector(&cfg.LeaderElection, cfg.App.Name+"-"+name)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure leader election", zap.Error(err))
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if elector == nil {
+			runBridge(ctx)
+			return
+		}
+		for ctx.Err() == nil {
+			elector.Run(ctx, runBridge)
+		}
+	}()
+	logger.Info(ctx, "cdc bridge started",
+		zap.String("name", name),
+		zap.String("source", sourceType),
+		zap.Strings("collections", cfg.CDCBridge.Collections),
+		zap.Bool("leader_election", elector != nil),
+	)
+
+	// ============================================
+	// 8. Graceful Shutdown
+	// ============================================
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	logger.Info(ctx, "shutting down cdc bridge...")
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(15 * time.Second):
+		logger.Warn(ctx, "cdc bridge shutdown timeout")
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error(ctx, "failed to shutdown metrics server", zap.Error(err))
+	}
+
+	logger.Info(ctx, "cdc bridge stopped")
+}
+
+// publishChange는 변경 스트림 이벤트를 CDC 이벤트로 발행합니다
+// MongoDB 변경 스트림에는 삭제 전 버전이 없으므로 삭제 이벤트의 version은 0입니다 (MySQL 변경 로그는 삭제된 행의 버전)
+func publishChange(ctx context.Context, publisher messaging.CDCPublisher, event *repository.ChangeEvent) error {
+	m := metrics.GetMetrics()
+	lag := time.Since(event.Timestamp)
+
+	var err error
+	switch event.Operation {
+	case repository.ChangeOperationInsert:
+		err = publisher.PublishDocumentCreated(ctx, event.DocumentID, event.Collection, event.Data, event.Version)
+	case repository.ChangeOperationUpdate, repository.ChangeOperationReplace:
+		if event.Data == nil {
+			// 조회 전에 삭제된 문서입니다 (뒤따르는 삭제 이벤트로 전달됨)
+			m.RecordCDCBridgeEvent(event.Operation, "skipped", lag)
+			return nil
+		}
+		previousVersion := event.Version - 1
+		if previousVersion < 0 {
+			previousVersion = 0
+		}
+		err = publisher.PublishDocumentUpdated(ctx, event.DocumentID, event.Collection, event.Data, event.Version, previousVersion, event.Changes)
+	case repository.ChangeOperationDelete:
+		err = publisher.PublishDocumentDeleted(ctx, event.DocumentID, event.Collection, event.Version)
+	default:
+		m.RecordCDCBridgeEvent(event.Operation, "skipped", lag)
+		return nil
+	}
+
+	if err != nil {
+		m.RecordCDCBridgeEvent(event.Operation, "error", lag)
+		return err
+	}
+	m.RecordCDCBridgeEvent(event.Operation, "published", lag)
+	logger.Debug(ctx, "change stream event published",
+		zap.String("operation", event.Operation),
+		logger.Collection(event.Collection),
+		zap.String("document_id", event.DocumentID),
+	)
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// changeSource는 변경을 읽어 처리 함수에 전달하는 소스입니다 (MongoDB 변경 스트림, MySQL 변경 로그)
+type changeSource interface {
+	Run(ctx context.Context, handle func(ctx context.Context, event *repository.ChangeEvent) error) error
+}
+
+// newMongoDBSource는 MongoDB 변경 스트림 소스를 생성합니다
+func newMongoDBSource(ctx context.Context, cfg *config.Config, name string, vaultClient *vault.Client) (changeSource, func(), error) {
+	mongoURI := cfg.MongoDB.URI
+	if cfg.MongoDB.UseVault && vaultClient != nil {
+		username, password, err := vaultClient.GetMongoDBCredentials(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get mongodb credentials from vault: %w", err)
+		}
+		mongoURI = fmt.Sprintf("mongodb://%s:%s@%s", username, password, cfg.MongoDB.Host)
+	}
+
+	source, err := mongodb.NewChangeStreamSource(&mongodb.Config{
+		URI:            mongoURI,
+		Database:       cfg.MongoDB.Database,
+		MaxPoolSize:    cfg.MongoDB.MaxPoolSize,
+		ConnectTimeout: cfg.MongoDB.ConnectTimeout,
+		Timeout:        cfg.MongoDB.Timeout,
+	}, mongodb.ChangeStreamConfig{
+		Name:               name,
+		Collections:        cfg.CDCBridge.Collections,
+		ExcludeCollections: cfg.CDCBridge.ExcludeCollections,
+		CheckpointInterval: cfg.CDCBridge.CheckpointInterval,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to mongodb: %w", err)
+	}
+
+	closeSource := func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := source.Close(closeCtx); err != nil {
+			logger.Error(ctx, "failed to close mongodb connection", zap.Error(err))
+		}
+	}
+	return source, closeSource, nil
+}
+
+// newMySQLSource는 MySQL 변경 로그 소스를 생성합니다
+// 대상 테이블에 변경 로그 트리거가 없으면 리더가 생성하며, data 컬럼이 암호화되어 있으면 복호화해 발행합니다
+func newMySQLSource(ctx context.Context, cfg *config.Config, name string, vaultClient *vault.Client) (changeSource, func(), error) {
+	mysqlConfig := &mysql.Config{
+		Host:            cfg.MySQL.Host,
+		Port:            cfg.MySQL.Port,
+		User:            cfg.MySQL.User,
+		Password:        cfg.MySQL.Password,
+		Database:        cfg.MySQL.Database,
+		Charset:         cfg.MySQL.Charset,
+		ParseTime:       cfg.MySQL.ParseTime,
+		MaxOpenConns:    cfg.MySQL.MaxOpenConns,
+		MaxIdleConns:    cfg.MySQL.MaxIdleConns,
+		ConnMaxLifetime: cfg.MySQL.ConnMaxLifetime,
+		ConnMaxIdleTime: cfg.MySQL.ConnMaxIdleTime,
+	}
+
+	var creds *vault.SQLCredentialsManager
+	if cfg.MySQL.UseVault {
+		manager, err := newSQLCredentials(ctx, "mysql", cfg.MySQL.VaultPath, cfg.Vault.Paths.MySQL, vaultClient)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get mysql credentials from vault: %w", err)
+		}
+		mysqlConfig.Credentials = manager.Current
+		mysqlConfig.ConnMaxLifetime = sqlConnMaxLifetime(mysqlConfig.ConnMaxLifetime, cfg.Vault.Renewal.RenewBeforeExpiry)
+		creds = manager
+	}
+
+	db, err := mysql.NewClient(ctx, mysqlConfig)
+	if err != nil {
+		if creds != nil {
+			creds.Close(context.Background())
+		}
+		return nil, nil, fmt.Errorf("failed to connect to mysql: %w", err)
+	}
+	if creds != nil {
+		watchSQLCredentials(ctx, creds, db, cfg.MySQL.MaxIdleConns)
+	}
+
+	closeSource := func() {
+		if err := mysql.Close(db); err != nil {
+			logger.Error(ctx, "failed to close mysql connection", zap.Error(err))
+		}
+		if creds != nil {
+			creds.Close(context.Background())
+		}
+	}
+
+	var cipher *encryption.Cipher
+	if cfg.Encryption.Enabled {
+		if cipher, err = newDataCipher(ctx, &cfg.Encryption, vaultClient); err != nil {
+			closeSource()
+			return nil, nil, fmt.Errorf("failed to initialize data encryption: %w", err)
+		}
+	}
+
+	reader, err := mysql.NewChangeLogReader(db, cipher, mysql.ChangeLogConfig{
+		Name:               name,
+		Collections:        cfg.CDCBridge.Collections,
+		ExcludeCollections: cfg.CDCBridge.ExcludeCollections,
+		CheckpointInterval: cfg.CDCBridge.CheckpointInterval,
+	})
+	if err != nil {
+		closeSource()
+		return nil, nil, err
+	}
+	return reader, closeSource, nil
+}
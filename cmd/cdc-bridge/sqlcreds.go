@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// newSQLCredentials는 Vault 동적 자격증명 관리자를 생성하고 첫 자격증명을 발급받습니다
+// path가 비어 있으면 vault.paths의 엔진별 기본 경로를 사용합니다
+func newSQLCredentials(ctx context.Context, engine, path, defaultPath string, vaultClient *vault.Client) (*vault.SQLCredentialsManager, error) {
+	if vaultClient == nil {
+		return nil, fmt.Errorf("%s.use_vault requires vault to be enabled", engine)
+	}
+	if path == "" {
+		path = defaultPath
+	}
+
+	manager := vault.NewSQLCredentialsManager(vaultClient, engine, path)
+	if _, err := manager.GetCredentials(ctx); err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "using vault-managed sql credentials",
+		zap.String("engine", engine),
+		zap.String("path", path),
+	)
+	return manager, nil
+}
+
+// sqlConnMaxLifetime은 교체 전 자격증명으로 맺은 연결이 리스 만료 전에 닫히도록 연결 수명을 제한합니다
+// 새 자격증명은 만료 renewBeforeExpiry 전에 발급되므로 연결 수명이 그보다 짧아야 합니다
+func sqlConnMaxLifetime(configured, renewBeforeExpiry time.Duration) time.Duration {
+	if renewBeforeExpiry <= 0 {
+		renewBeforeExpiry = 5 * time.Minute
+	}
+	if configured <= 0 || configured > renewBeforeExpiry {
+		return renewBeforeExpiry
+	}
+	return configured
+}
+
+// watchSQLCredentials는 자격증명이 교체되면 유휴 연결을 비워 이후 연결이 새 자격증명을 사용하도록 합니다
+// 사용 중인 연결은 ConnMaxLifetime이 지나면 새 자격증명으로 다시 맺어집니다
+func watchSQLCredentials(ctx context.Context, manager *vault.SQLCredentialsManager, db *sql.DB, maxIdleConns int) {
+	if maxIdleConns <= 0 {
+		maxIdleConns = 5 // client 기본값
+	}
+	manager.OnRotate(func(creds *vault.DatabaseCredentials) {
+		db.SetMaxIdleConns(0)
+		db.SetMaxIdleConns(maxIdleConns)
+		logger.Info(ctx, "sql connection pool refreshed with rotated credentials",
+			zap.String("username", creds.Username),
+			zap.Int("open_connections", db.Stats().OpenConnections),
+		)
+	})
+	manager.StartAutoRenewal(ctx)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/circuitbreaker"
+)
+
+// newCircuitBreakers는 설정의 임계값으로 데이터베이스 종류별 circuit breaker 모음을 생성합니다
+// 활성화된 데이터베이스의 circuit breaker는 미리 만들어 첫 요청 전에도 관리 API에서 조회/수동 차단할 수 있게 합니다
+func newCircuitBreakers(cfg *config.Config) *circuitbreaker.Registry {
+	backends := make(map[string]circuitbreaker.Settings, len(cfg.CircuitBreaker.Backends))
+	for name, settings := range cfg.CircuitBreaker.Backends {
+		backends[name] = circuitBreakerSettings(settings)
+	}
+	registry := usecase.NewCircuitBreakers(circuitBreakerSettings(cfg.CircuitBreaker.CircuitBreakerSettings), backends)
+
+	enabled := map[string]bool{
+		"mongodb":       true,
+		"postgresql":    cfg.PostgreSQL.Enabled,
+		"mysql":         cfg.MySQL.Enabled,
+		"cassandra":     cfg.Cassandra.Enabled,
+		"elasticsearch": cfg.Elasticsearch.Enabled,
+		"vitess":        cfg.Vitess.Enabled,
+	}
+	for name, ok := range enabled {
+		if ok {
+			registry.Get(name)
+		}
+	}
+	return registry
+}
+
+// circuitBreakerSettings는 설정 값을 circuit breaker 임계값으로 변환합니다
+func circuitBreakerSettings(s config.CircuitBreakerSettings) circuitbreaker.Settings {
+	return circuitbreaker.Settings{
+		FailureRatio:     s.FailureRatio,
+		MinRequests:      s.MinRequests,
+		Interval:         s.Interval,
+		OpenTimeout:      s.OpenTimeout,
+		HalfOpenRequests: s.HalfOpenRequests,
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// newDeadLetterRepository는 CDC DLQ 저장소를 생성합니다 (MongoDB _cdc_dead_letters 컬렉션)
+// 문서 저장소와 별도 연결을 사용해 반환된 클라이언트는 호출자가 종료해야 합니다
+func newDeadLetterRepository(ctx context.Context, uri, database string) (*mongodb.DeadLetterRepository, *mongo.Client, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(connectCtx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to mongodb for dead letter queue: %w", err)
+	}
+
+	repo := mongodb.NewDeadLetterRepository(client.Database(database))
+	if err := repo.EnsureIndexes(connectCtx); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, nil, err
+	}
+	return repo, client, nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+)
+
+// newIPFilterConfig는 설정으로부터 IP 필터 규칙을 생성합니다
+func newIPFilterConfig(cfg *config.IPFilterConfig) (*ipfilter.Config, error) {
+	global, err := ipfilter.ParseRules(cfg.Allow, cfg.Deny)
+	if err != nil {
+		return nil, err
+	}
+
+	filterCfg := &ipfilter.Config{Global: global}
+	for _, g := range cfg.Groups {
+		rules, err := ipfilter.ParseRules(g.Allow, g.Deny)
+		if err != nil {
+			return nil, fmt.Errorf("ip_filter group %q: %w", g.Name, err)
+		}
+		filterCfg.Groups = append(filterCfg.Groups, ipfilter.Group{
+			Name:     g.Name,
+			Prefixes: g.Prefixes,
+			Rules:    rules,
+		})
+	}
+
+	return filterCfg, nil
+}
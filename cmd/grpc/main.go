@@ -12,18 +12,29 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/application/usecase"
 	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
 	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
 	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/redisstream"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/webhook"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/batching"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
 	grpcHandler "github.com/YouSangSon/database-service/internal/interfaces/grpc/handler"
 	"github.com/YouSangSon/database-service/internal/interfaces/grpc/interceptor"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
 	"github.com/YouSangSon/database-service/internal/pkg/tracing"
 	"github.com/YouSangSon/database-service/internal/pkg/vault"
 	pb "github.com/YouSangSon/database-service/proto/pb"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -60,7 +71,8 @@ func main() {
 	// ============================================
 	// 3. Metrics Initialization
 	// ============================================
-	m := metrics.Init(cfg.App.Name + "-grpc")
+	m := metrics.Init(cfg.App.Name+"-grpc")
+	pools := poolstats.NewRegistry()
 	logger.Info(ctx, "metrics initialized")
 
 	// ============================================
@@ -101,6 +113,7 @@ func main() {
 			SecretID:          cfg.Vault.SecretID,
 			K8sRole:           cfg.Vault.K8sRole,
 			MongoDBPath:       cfg.Vault.Paths.MongoDB,
+			KafkaPath:         cfg.Vault.Paths.Kafka,
 			RenewInterval:     cfg.Vault.Renewal.Interval,
 			RenewBeforeExpiry: cfg.Vault.Renewal.RenewBeforeExpiry,
 		})
@@ -145,64 +158,270 @@ func main() {
 		zap.String("database", cfg.MongoDB.Database),
 	)
 
+	// sharding이 켜져 있으면 문서를 샤드 인스턴스에 나눠 저장합니다
+	var documentRepo repository.DocumentRepository = mongoRepo
+	if cfg.Sharding.Enabled {
+		shardedRepo, closeShards, err := newMongoShards(ctx, cfg, pools)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize mongodb shards", zap.Error(err))
+		}
+		defer closeShards()
+		documentRepo = shardedRepo
+		logger.Info(ctx, "mongodb sharding enabled",
+			zap.Int("shards", len(cfg.Sharding.Shards)),
+			zap.String("shard_key", cfg.Sharding.ShardKey),
+		)
+	}
+
+	// 쓰기 배치 모드 (Optional, 지정한 컬렉션의 단건 저장을 모아 SaveMany로 저장)
+	if cfg.WriteBatching.Enabled {
+		batcher := batching.NewRepository(documentRepo, newBatchingConfig(&cfg.WriteBatching))
+		defer batcher.Close()
+		documentRepo = batcher
+		logger.Info(ctx, "write batching enabled",
+			zap.Strings("collections", cfg.WriteBatching.Collections),
+			zap.Duration("max_delay", cfg.WriteBatching.MaxDelay),
+			zap.Int("max_batch", cfg.WriteBatching.MaxBatch),
+		)
+	}
+
+	// MongoDB 복제본 읽기 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+	var mongoReplicaRepo repository.DocumentRepository
+	if cfg.MongoDB.ReadReplica.Enabled {
+		replicaPool := mongodb.NewPoolMonitor()
+		replicaRepo, replicaClient, err := newMongoReadReplica(ctx, mongoURI, cfg.MongoDB.Database, &cfg.MongoDB.ReadReplica, replicaPool)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize mongodb read replica", zap.Error(err))
+		}
+		defer replicaClient.Disconnect(context.Background())
+		pools.Register("mongodb-replica", poolstats.DriverMongoDB, replicaPool.Stats)
+		mongoReplicaRepo = replicaRepo
+		logger.Info(ctx, "mongodb read replica initialized",
+			zap.String("read_preference", cfg.MongoDB.ReadReplica.ReadPreference),
+		)
+	}
+
 	// ============================================
 	// 7. Redis Cache Initialization
 	// ============================================
-	redisCache, err := cache.NewRedisCache(ctx, &cache.Config{
-		Host:        cfg.Redis.Host,
-		Port:        cfg.Redis.Port,
-		Password:    cfg.Redis.Password,
-		DB:          cfg.Redis.DB,
-		MaxRetries:  cfg.Redis.MaxRetries,
-		PoolSize:    cfg.Redis.PoolSize,
-		MinIdleConn: cfg.Redis.MinIdleConn,
-	})
+	redisCache, err := newRedisCache(ctx, &cfg.Redis)
 	if err != nil {
 		logger.Fatal(ctx, "failed to initialize redis cache", zap.Error(err))
 	}
 	defer redisCache.Close()
+	pools.Register("redis", poolstats.DriverRedis, redisCache.PoolStats)
 	logger.Info(ctx, "redis cache initialized",
+		zap.String("mode", redisCache.Mode()),
 		zap.String("host", cfg.Redis.Host),
-		zap.Int("port", cfg.Redis.Port),
+		zap.Strings("addresses", cfg.Redis.Addresses),
 	)
 
 	// ============================================
 	// 8. Kafka Producer Initialization (Optional)
 	// ============================================
 	var kafkaProducer *kafka.Producer
-	var cdcPublisher *kafka.CDCPublisher
+	var cdcPublisher messaging.CDCPublisher
+	var kafkaSecurity *kafka.SecurityConfig
+	var kafkaCreds *vault.KafkaCredentialsManager
 	if cfg.Kafka.Enabled {
-		kafkaProducer, err = kafka.NewProducer(&kafka.ProducerConfig{
-			Brokers:          cfg.Kafka.Brokers,
-			ClientID:         cfg.Kafka.ClientID + "-grpc",
-			MaxMessageBytes:  1000000,
-			RequiredAcks:     -1, // Wait for all replicas
-			Compression:      1,  // Gzip
-			MaxRetries:       3,
-			RetryBackoff:     100 * time.Millisecond,
-			EnableIdempotent: true,
-			UseAsync:         false,
-		})
+		var err error
+		kafkaSecurity, kafkaCreds, err = newKafkaSecurity(ctx, &cfg.Kafka.Security, vaultClient)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure kafka security", zap.Error(err))
+		}
+		if kafkaCreds != nil {
+			defer kafkaCreds.Close(context.Background())
+		}
+
+		producerConfig, err := newKafkaProducerConfig(&cfg.Kafka, cfg.Kafka.ClientID+"-grpc", kafkaSecurity, m)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure kafka producer", zap.Error(err))
+		}
+		kafkaProducer, err = kafka.NewProducer(producerConfig)
 		if err != nil {
 			logger.Warn(ctx, "failed to initialize kafka producer", zap.Error(err))
 		} else {
 			defer kafkaProducer.Close()
-			cdcPublisher = kafka.NewCDCPublisher(
+			if kafkaCreds != nil {
+				watchKafkaCredentials(ctx, kafkaCreds, kafkaProducer)
+			}
+			kafkaPublisher := kafka.NewCDCPublisher(
 				kafkaProducer,
 				cfg.Kafka.Topics.Created,
 				cfg.Kafka.Topics.Updated,
 				cfg.Kafka.Topics.Deleted,
 			)
+			avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+			if err != nil {
+				logger.Fatal(ctx, "failed to configure avro serialization", zap.Error(err))
+			}
+			if avroSerializer != nil {
+				kafkaPublisher.SetAvro(avroSerializer)
+				logger.Info(ctx, "cdc events serialized with avro",
+					zap.String("schema_registry", cfg.Kafka.Avro.SchemaRegistry.URL),
+					zap.Strings("topics", cfg.Kafka.Avro.Topics),
+				)
+			}
+			if len(cfg.Kafka.CDCRouting) > 0 {
+				topicRouter, err := newTopicRouter(&cfg.Kafka)
+				if err != nil {
+					logger.Fatal(ctx, "failed to configure cdc topic routing", zap.Error(err))
+				}
+				kafkaPublisher.SetRouter(topicRouter)
+				logger.Info(ctx, "cdc topic routing enabled", zap.Int("rules", len(cfg.Kafka.CDCRouting)))
+			}
+			cdcPublisher = kafkaPublisher
 			logger.Info(ctx, "kafka producer initialized",
 				zap.Strings("brokers", cfg.Kafka.Brokers),
 			)
 		}
 	}
 
+	// ============================================
+	// 8-1. NATS JetStream CDC Publisher (Optional)
+	// ============================================
+	// 활성화되면 CDC 이벤트를 Kafka 대신 JetStream 스트림으로 발행합니다
+	if cfg.NATS.Enabled {
+		natsPublisher, err := newNATSPublisher(ctx, &cfg.NATS, cfg.App.Name+"-grpc")
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize nats jetstream publisher", zap.Error(err))
+		}
+		defer natsPublisher.Close()
+		cdcPublisher = natsPublisher
+		logger.Info(ctx, "cdc events published to nats jetstream",
+			zap.Strings("servers", cfg.NATS.Servers),
+			zap.String("stream", cfg.NATS.Stream),
+		)
+	}
+
+	// ============================================
+	// 8-2. RabbitMQ CDC Publisher (Optional)
+	// ============================================
+	// Kafka가 없는 환경에서 CDC 이벤트를 이벤트 타입별 교환기로 발행합니다
+	if cfg.RabbitMQ.Enabled {
+		rabbitPublisher, err := newRabbitMQPublisher(ctx, &cfg.RabbitMQ, cfg.App.Name+"-grpc")
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize rabbitmq publisher", zap.Error(err))
+		}
+		defer rabbitPublisher.Close()
+		cdcPublisher = rabbitPublisher
+		logger.Info(ctx, "cdc events published to rabbitmq",
+			zap.String("exchange_type", cfg.RabbitMQ.ExchangeType),
+		)
+	}
+
+	// ============================================
+	// 8-3. Redis Streams CDC Publisher (Optional)
+	// ============================================
+	// 별도 브로커 없이 Redis 스트림(XADD)으로 CDC 이벤트를 발행합니다
+	if cfg.Redis.Streams.Enabled {
+		cdcPublisher = redisstream.NewPublisher(redisCache.Client(), redisstream.Config{
+			KeyPrefix:       cfg.Redis.Streams.KeyPrefix,
+			MaxLen:          cfg.Redis.Streams.MaxLen,
+			ApproximateTrim: cfg.Redis.Streams.ApproximateTrim,
+		})
+		logger.Info(ctx, "cdc events published to redis streams",
+			zap.String("key_prefix", cfg.Redis.Streams.KeyPrefix),
+			zap.Int64("max_len", cfg.Redis.Streams.MaxLen),
+		)
+	}
+
+	if cdcPublisher != nil {
+		cdcPublisher.SetOrigin(instanceID())
+		cdcPublisher.SetEncoder(messaging.EventEncoder{
+			Format:     cfg.CDC.Format,
+			Source:     cfg.CDC.Source,
+			ServerName: cfg.CDC.ServerName,
+		})
+		if cfg.CDC.Format == messaging.EventFormatCloudEvents {
+			logger.Info(ctx, "cdc events wrapped in cloudevents envelope", zap.String("source", cfg.CDC.Source))
+		}
+		if cfg.CDC.Format == messaging.EventFormatProtobuf {
+			logger.Info(ctx, "cdc events encoded as protobuf", zap.String("message_type", messaging.ProtoMessageType))
+		}
+		if cfg.CDC.Format == messaging.EventFormatDebezium {
+			logger.Info(ctx, "cdc events wrapped in debezium envelope", zap.String("server_name", cfg.CDC.ServerName))
+		}
+	}
+
+	// 발행 실패 이벤트 DLQ (재시도 후 MongoDB에 보관, 관리 API로 재발행)
+	if cdcPublisher != nil && cfg.CDC.DeadLetter.Enabled {
+		deadLetterRepo, deadLetterClient, err := newDeadLetterRepository(ctx, mongoURI, cfg.MongoDB.Database)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize cdc dead letter queue", zap.Error(err))
+		}
+		defer deadLetterClient.Disconnect(context.Background())
+
+		deadLetterPublisher := messaging.NewDeadLetterPublisher(cdcPublisher, deadLetterRepo, messaging.DeadLetterConfig{
+			MaxAttempts: cfg.CDC.DeadLetter.MaxAttempts,
+			Backoff:     cfg.CDC.DeadLetter.Backoff,
+		})
+		cdcPublisher = deadLetterPublisher
+		logger.Info(ctx, "cdc dead letter queue enabled",
+			zap.String("collection", mongodb.DeadLetterCollectionName),
+		)
+	}
+
+	// 웹훅 구독 (구독 관리는 REST API, 전송 큐는 인스턴스 간 공유)
+	if cfg.CDC.Webhooks.Enabled {
+		webhookSubs, webhookDeliveries, webhookClient, err := newWebhookRepositories(ctx, mongoURI, cfg.MongoDB.Database, cfg.CDC.Webhooks.Retention)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize webhooks", zap.Error(err))
+		}
+		defer webhookClient.Disconnect(context.Background())
+
+		webhookPublisher := webhook.NewPublisher(cdcPublisher, webhookSubs, webhookDeliveries, webhook.PublisherConfig{
+			RefreshInterval: cfg.CDC.Webhooks.RefreshInterval,
+		})
+		webhookPublisher.SetOrigin(instanceID())
+		cdcPublisher = webhookPublisher
+
+		dispatcher := webhook.NewDispatcher(webhookSubs, webhookDeliveries, webhook.DispatcherConfig{
+			Workers:     cfg.CDC.Webhooks.Workers,
+			Timeout:     cfg.CDC.Webhooks.Timeout,
+			MaxAttempts: cfg.CDC.Webhooks.MaxAttempts,
+			Backoff:     cfg.CDC.Webhooks.Backoff,
+			MaxBackoff:  cfg.CDC.Webhooks.MaxBackoff,
+		})
+		dispatchCtx, stopDispatcher := context.WithCancel(ctx)
+		defer stopDispatcher()
+		go dispatcher.Run(dispatchCtx)
+
+		logger.Info(ctx, "webhooks enabled",
+			zap.String("collection", mongodb.WebhookDeliveryCollectionName),
+			zap.Int("workers", cfg.CDC.Webhooks.Workers),
+		)
+	}
+
+	// 발행 전 필드 변환 (DLQ 바깥에서 적용해 DLQ에도 변환된 이벤트만 보관)
+	if cdcPublisher != nil && len(cfg.CDC.Transforms) > 0 {
+		transformPublisher, err := messaging.NewTransformPublisher(cdcPublisher, newCDCTransforms(cfg.CDC.Transforms))
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure cdc field transforms", zap.Error(err))
+		}
+		cdcPublisher = transformPublisher
+		logger.Info(ctx, "cdc field transforms enabled", zap.Int("rules", len(cfg.CDC.Transforms)))
+	}
+
 	// ============================================
 	// 9. UseCase Layer Initialization
 	// ============================================
-	documentUC := usecase.NewDocumentUseCase(mongoRepo, redisCache)
+	documentCache, err := newDocumentCache(ctx, &cfg.Cache, redisCache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize document cache", zap.Error(err))
+	}
+	if cfg.Cache.Backend == "memcached" {
+		logger.Info(ctx, "memcached document cache enabled",
+			zap.Strings("servers", cfg.Cache.Memcached.Servers),
+		)
+	}
+	documentUC := usecase.NewDocumentUseCase(documentRepo, documentCache)
+	if cfg.Cache.Local.Enabled {
+		logger.Info(ctx, "local lru cache enabled in front of redis",
+			zap.Int("max_entries", cfg.Cache.Local.MaxEntries),
+			zap.Duration("ttl", cfg.Cache.Local.TTL),
+		)
+	}
 	logger.Info(ctx, "use cases initialized")
 
 	// ============================================
@@ -211,15 +430,206 @@ func main() {
 	databaseHandler := grpcHandler.NewDatabaseHandler(documentUC)
 	logger.Info(ctx, "gRPC handlers initialized")
 
+	// OIDC 인증 (Optional)
+	var oidcVerifier *auth.OIDCVerifier
+	if cfg.Auth.Enabled {
+		oidcVerifier, err = newOIDCVerifier(ctx, &cfg.Auth)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize oidc verifier", zap.Error(err))
+		}
+		defer oidcVerifier.Close()
+		logger.Info(ctx, "oidc authentication enabled",
+			zap.String("issuer", cfg.Auth.OIDC.IssuerURL),
+		)
+	}
+
+	// 운영자 대리 실행 (Optional)
+	var impersonator *auth.Impersonator
+	if cfg.Auth.Impersonation.Enabled {
+		impersonator, err = newImpersonator(&cfg.Auth.Impersonation)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize impersonation", zap.Error(err))
+		}
+		logger.Info(ctx, "admin impersonation enabled",
+			zap.Strings("allowed_roles", cfg.Auth.Impersonation.AllowedRoles),
+		)
+	}
+
+	// 인증 실패 잠금 (Optional)
+	var authLockout *lockout.Guard
+	if cfg.Auth.Lockout.Enabled {
+		authLockout, err = newLockoutGuard(&cfg.Auth.Lockout, redisCache)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize auth lockout", zap.Error(err))
+		}
+		logger.Info(ctx, "auth brute-force protection enabled",
+			zap.Int64("max_failures", cfg.Auth.Lockout.MaxFailures),
+			zap.Duration("window", cfg.Auth.Lockout.Window),
+		)
+	}
+
+	// IP 허용/차단 (비활성화 시 빈 규칙으로 시작하며 SIGHUP으로 활성화할 수 있습니다)
+	ipFilterCfg, err := newIPFilterConfig(&cfg.IPFilter)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize ip filter", zap.Error(err))
+	}
+	if !cfg.IPFilter.Enabled {
+		ipFilterCfg = nil
+	}
+	ipFilter := ipfilter.New(ipFilterCfg)
+
+	// 행 수준 보안 (Optional)
+	rowPolicies, err := newRowPolicySet(&cfg.Auth)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize row policies", zap.Error(err))
+	}
+	if rowPolicies != nil {
+		documentUC.SetRowPolicies(rowPolicies)
+		logger.Info(ctx, "row-level security enabled",
+			zap.Int("policy_count", len(cfg.Auth.RowPolicies)),
+		)
+	}
+
+	// 쓰기 시 개인정보 탐지 (Optional)
+	piiScanner, err := newPIIScanner(&cfg.PII)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize pii scanner", zap.Error(err))
+	}
+	if piiScanner != nil {
+		documentUC.SetPIIScanner(piiScanner)
+		logger.Info(ctx, "pii detection enabled",
+			zap.String("default_action", cfg.PII.DefaultAction),
+			zap.Int("policy_count", len(cfg.PII.Policies)),
+		)
+	}
+
+	// 컬렉션별 캐시 전략
+	cachePolicies, err := newCachePolicies(&cfg.Cache)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize cache policies", zap.Error(err))
+	}
+	documentUC.SetCachePolicies(cachePolicies)
+	documentUC.SetNegativeCacheTTL(cfg.Cache.NegativeTTL)
+	documentUC.SetEarlyRefresh(cfg.Cache.EarlyRefresh)
+	documentUC.SetCircuitBreakers(newCircuitBreakers(cfg))
+	configureRetry(documentUC, &cfg.Retry)
+	documentUC.SetOperationTimeouts(usecase.OperationTimeouts{
+		Read:  cfg.Timeouts.Read,
+		Write: cfg.Timeouts.Write,
+		Admin: cfg.Timeouts.Admin,
+	})
+	documentUC.SetBulkWriteParallelism(usecase.BulkWriteParallelism{
+		Workers:       cfg.BulkWrite.Workers,
+		MinOperations: cfg.BulkWrite.MinOperations,
+	})
+	if cfg.Cache.Query.Enabled {
+		documentUC.SetQueryCacheTTL(cfg.Cache.Query.TTL)
+	}
+	if cachePolicies.UsesWriteBehind() {
+		cacheWriteQueue := newWriteBehindQueue(&cfg.Cache.WriteBehind, documentCache)
+		cacheWriteQueue.Start(ctx)
+		defer cacheWriteQueue.Close()
+		documentUC.SetCacheWriteQueue(cacheWriteQueue)
+	}
+	logger.Info(ctx, "cache strategies configured",
+		zap.String("default_strategy", cfg.Cache.DefaultStrategy),
+		zap.Int("policy_count", len(cfg.Cache.Policies)),
+		zap.Duration("negative_ttl", cfg.Cache.NegativeTTL),
+	)
+	if cfg.Cache.Warmup.Enabled {
+		go warmCache(ctx, &cfg.Cache.Warmup, documentUC)
+	}
+	if cfg.Cache.CDCInvalidation.Enabled && kafkaSecurity != nil {
+		if err := startCacheInvalidation(ctx, cfg, kafkaSecurity, kafkaCreds, documentUC); err != nil {
+			logger.Fatal(ctx, "failed to start cdc cache invalidation", zap.Error(err))
+		}
+	}
+
+	// CQRS 읽기/쓰기 라우팅 (조회/검색/집계를 컬렉션별로 읽기 복제본에 분배)
+	if cfg.ReadRouting.Enabled {
+		readRouting, err := newReadRouting(&cfg.ReadRouting)
+		if err != nil {
+			logger.Fatal(ctx, "failed to configure read routing", zap.Error(err))
+		}
+		documentUC.SetReadRouting(readRouting)
+		if mongoReplicaRepo != nil {
+			documentUC.SetReadReplica(mongoReplicaRepo)
+		}
+		logger.Info(ctx, "read routing enabled",
+			zap.String("default", cfg.ReadRouting.Default),
+			zap.Int("rules", len(cfg.ReadRouting.Collections)),
+		)
+	}
+
+	// Rate limiting (Optional) - HTTP API와 같은 버킷을 공유합니다
+	var rateLimiter *cache.TokenBucketLimiter
+	var rateLimitPolicy *ratelimit.Policy
+	if cfg.RateLimit.Enabled {
+		rateLimiter = cache.NewRedisExtended(redisCache.Client()).NewTokenBucketLimiter("api:ratelimit:bucket")
+		rateLimitPolicy = newRateLimitPolicy(&cfg.RateLimit)
+		logger.Info(ctx, "token bucket rate limiting enabled",
+			zap.Float64("requests_per_second", cfg.RateLimit.RequestsPerSecond),
+			zap.Int64("burst", cfg.RateLimit.Burst),
+		)
+	}
+
+	// 설정 다시 읽기 (SIGHUP, reload.watch_file) - 로그 레벨, 캐시 TTL, rate limit, circuit breaker, IP 필터
+	if err := watchConfigReload(ctx, cfg, reloadTargets{
+		documentUC:      documentUC,
+		cachePolicies:   cachePolicies,
+		circuitBreakers: documentUC.CircuitBreakers(),
+		rateLimitPolicy: rateLimitPolicy,
+		ipFilter:        ipFilter,
+	}); err != nil {
+		logger.Fatal(ctx, "failed to watch config for reload", zap.Error(err))
+	}
+
+	// 적응형 동시성 제한 (Optional) - 한도를 넘는 요청은 RESOURCE_EXHAUSTED로 거부
+	loadShedder := newLoadShedder(&cfg.LoadShedding)
+	if loadShedder != nil {
+		logger.Info(ctx, "load shedding enabled", zap.Int("initial_limit", loadShedder.Limit()))
+	}
+
+	// pprof 내부 포트와 연속 프로파일링 (Optional)
+	stopProfiling, err := startProfiling(ctx, cfg, oidcVerifier, ipFilter)
+	if err != nil {
+		logger.Fatal(ctx, "failed to start profiling", zap.Error(err))
+	}
+	defer stopProfiling()
+
+	// 연결 풀 상태 메트릭 (db_pool_*)
+	if cfg.Observability.Metrics.Enabled {
+		poolStatsCtx, stopPoolStats := context.WithCancel(ctx)
+		defer stopPoolStats()
+		go pools.Run(poolStatsCtx, cfg.Observability.Metrics.PoolStatsInterval, m)
+	}
+
 	// ============================================
 	// 11. gRPC Server Setup with Interceptors
 	// ============================================
 	var grpcServerOptions []grpc.ServerOption
 
+	// TLS (인증서 파일 또는 Vault PKI 자동 교체)
+	serverTLS, certManager, err := newServerTLSConfig(ctx, cfg, vaultClient)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure server tls", zap.Error(err))
+	}
+	if certManager != nil {
+		defer certManager.StopAutoRotation()
+	}
+	if serverTLS != nil {
+		grpcServerOptions = append(grpcServerOptions, grpc.Creds(credentials.NewTLS(serverTLS)))
+	}
+
 	// Unary interceptors
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		interceptor.UnaryRecoveryInterceptor(),
 		interceptor.UnaryLoggingInterceptor(),
+		interceptor.UnaryIPFilterInterceptor(ipFilter),
+	}
+
+	if loadShedder != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptor.UnaryLoadShedInterceptor(loadShedder, m))
 	}
 
 	if cfg.Observability.Tracing.Enabled {
@@ -230,6 +640,22 @@ func main() {
 		unaryInterceptors = append(unaryInterceptors, interceptor.UnaryMetricsInterceptor(m))
 	}
 
+	if oidcVerifier != nil && authLockout != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptor.UnaryLockoutInterceptor(authLockout))
+	}
+
+	if oidcVerifier != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptor.UnaryAuthInterceptor(oidcVerifier))
+	}
+
+	if oidcVerifier != nil && impersonator != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptor.UnaryImpersonationInterceptor(impersonator))
+	}
+
+	if rateLimiter != nil {
+		unaryInterceptors = append(unaryInterceptors, interceptor.UnaryRateLimitInterceptor(rateLimiter, rateLimitPolicy))
+	}
+
 	grpcServerOptions = append(grpcServerOptions,
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	)
@@ -238,6 +664,11 @@ func main() {
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		interceptor.StreamRecoveryInterceptor(),
 		interceptor.StreamLoggingInterceptor(),
+		interceptor.StreamIPFilterInterceptor(ipFilter),
+	}
+
+	if loadShedder != nil {
+		streamInterceptors = append(streamInterceptors, interceptor.StreamLoadShedInterceptor(loadShedder, m))
 	}
 
 	if cfg.Observability.Tracing.Enabled {
@@ -248,6 +679,22 @@ func main() {
 		streamInterceptors = append(streamInterceptors, interceptor.StreamMetricsInterceptor(m))
 	}
 
+	if oidcVerifier != nil && authLockout != nil {
+		streamInterceptors = append(streamInterceptors, interceptor.StreamLockoutInterceptor(authLockout))
+	}
+
+	if oidcVerifier != nil {
+		streamInterceptors = append(streamInterceptors, interceptor.StreamAuthInterceptor(oidcVerifier))
+	}
+
+	if oidcVerifier != nil && impersonator != nil {
+		streamInterceptors = append(streamInterceptors, interceptor.StreamImpersonationInterceptor(impersonator))
+	}
+
+	if rateLimiter != nil {
+		streamInterceptors = append(streamInterceptors, interceptor.StreamRateLimitInterceptor(rateLimiter, rateLimitPolicy))
+	}
+
 	grpcServerOptions = append(grpcServerOptions,
 		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
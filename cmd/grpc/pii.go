@@ -0,0 +1,37 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/pii"
+)
+
+// newPIIScanner는 설정으로부터 개인정보 탐지기를 생성합니다
+// 비활성화되어 있으면 nil을 반환합니다
+func newPIIScanner(cfg *config.PIIConfig) (*pii.Scanner, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	detectors := pii.DefaultDetectors()
+	if len(cfg.Detectors) > 0 {
+		detectors = detectors[:0]
+		for _, name := range cfg.Detectors {
+			detector, err := pii.DetectorByType(name)
+			if err != nil {
+				return nil, err
+			}
+			detectors = append(detectors, detector)
+		}
+	}
+
+	policies := make([]pii.Policy, 0, len(cfg.Policies))
+	for _, p := range cfg.Policies {
+		policies = append(policies, pii.Policy{
+			Collection:   p.Collection,
+			Action:       pii.Action(p.Action),
+			ExemptFields: p.ExemptFields,
+		})
+	}
+
+	return pii.NewScanner(detectors, policies, pii.Action(cfg.DefaultAction))
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/router"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/profiling"
+	"go.uber.org/zap"
+)
+
+// startProfiling은 observability.profiling 설정에 따라 pprof 내부 서버와 연속 프로파일러 전송을 시작합니다
+// 반환된 stop 함수는 pprof 서버를 닫고 전송을 중단합니다
+func startProfiling(ctx context.Context, cfg *config.Config, verifier *auth.OIDCVerifier, ipFilter *ipfilter.Filter) (stop func(), err error) {
+	profilingCfg := &cfg.Observability.Profiling
+	ctx, cancel := context.WithCancel(ctx)
+	var srv *http.Server
+
+	if profilingCfg.Enabled {
+		host := profilingCfg.Host
+		if host == "" {
+			host = "127.0.0.1"
+		}
+		port := profilingCfg.Port
+		if port == 0 {
+			port = 6060
+		}
+		srv = &http.Server{
+			Addr:              fmt.Sprintf("%s:%d", host, port),
+			Handler:           router.SetupProfilingRouter(verifier, ipFilter),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error(ctx, "pprof server stopped", zap.Error(err))
+			}
+		}()
+		logger.Info(ctx, "pprof server started",
+			zap.String("addr", srv.Addr),
+			zap.Bool("admin_auth", verifier != nil),
+		)
+	}
+
+	if profilingCfg.Push.Enabled {
+		name := profilingCfg.Push.ApplicationName
+		if name == "" {
+			name = cfg.App.Name
+		}
+		pusher, err := profiling.NewPusher(profiling.PushConfig{
+			ServerAddress:   profilingCfg.Push.ServerAddress,
+			ApplicationName: name,
+			Tags:            profilingCfg.Push.Tags,
+			AuthToken:       profilingCfg.Push.AuthToken,
+			Interval:        profilingCfg.Push.Interval,
+		})
+		if err != nil {
+			cancel()
+			if srv != nil {
+				srv.Close()
+			}
+			return nil, err
+		}
+		go pusher.Run(ctx)
+		logger.Info(ctx, "continuous profiling enabled",
+			zap.String("server_address", profilingCfg.Push.ServerAddress),
+			zap.String("application_name", name),
+		)
+	}
+
+	return func() {
+		cancel()
+		if srv != nil {
+			srv.Close()
+		}
+	}, nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+)
+
+// configureRetry는 설정의 재시도 backoff, 예산과 데이터베이스 종류별 에러 판별 함수를 적용합니다
+func configureRetry(documentUC *usecase.DocumentUseCase, cfg *config.RetryConfig) {
+	retryConfig := retry.DefaultConfig()
+	if cfg.MaxAttempts > 0 {
+		retryConfig.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.InitialInterval > 0 {
+		retryConfig.InitialInterval = cfg.InitialInterval
+	}
+	if cfg.MaxInterval > 0 {
+		retryConfig.MaxInterval = cfg.MaxInterval
+	}
+	if cfg.Multiplier > 0 {
+		retryConfig.Multiplier = cfg.Multiplier
+	}
+	if cfg.MaxElapsedTime > 0 {
+		retryConfig.MaxElapsedTime = cfg.MaxElapsedTime
+	}
+	retryConfig.Jitter = cfg.Jitter != "none"
+	documentUC.SetRetryConfig(retryConfig)
+
+	if cfg.Budget.Enabled {
+		documentUC.SetRetryBudgets(usecase.NewRetryBudgets(retry.BudgetConfig{
+			Ratio:        cfg.Budget.Ratio,
+			MinPerSecond: cfg.Budget.MinPerSecond,
+			Max:          cfg.Budget.Max,
+		}))
+	} else {
+		documentUC.SetRetryBudgets(nil)
+	}
+
+	// gRPC 서버는 MongoDB 저장소만 사용합니다
+	documentUC.SetRetryClassifiers(map[string]func(err error) bool{
+		"mongodb": mongodb.IsRetryable,
+	})
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+)
+
+// newServerTLSConfig는 서버 TLS 설정을 생성합니다
+// use_vault이면 Vault PKI로 인증서를 발급받고 만료 전에 자동 교체하며, 아니면 인증서 파일을 읽습니다
+// TLS가 비활성화되어 있으면 nil을 반환합니다
+func newServerTLSConfig(ctx context.Context, cfg *config.Config, vaultClient *vault.Client) (*tls.Config, *vault.CertificateManager, error) {
+	tlsCfg := cfg.Server.TLS
+	if !tlsCfg.Enabled {
+		return nil, nil, nil
+	}
+
+	if !tlsCfg.UseVault {
+		cert, err := tls.LoadX509KeyPair(tlsCfg.CertFile, tlsCfg.KeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load tls certificate: %w", err)
+		}
+		return &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{cert},
+		}, nil, nil
+	}
+
+	if vaultClient == nil {
+		return nil, nil, fmt.Errorf("server.tls.use_vault requires vault to be enabled")
+	}
+
+	manager := vault.NewCertificateManager(vaultClient, cfg.Vault.Paths.PKI, vault.CertificateRequest{
+		CommonName: tlsCfg.CommonName,
+		AltNames:   tlsCfg.AltNames,
+		IPSANs:     tlsCfg.IPSANs,
+		TTL:        tlsCfg.TTL,
+	}, tlsCfg.RenewBefore)
+	if err := manager.Issue(ctx); err != nil {
+		return nil, nil, err
+	}
+	manager.StartAutoRotation(ctx)
+
+	return manager.TLSConfig(), manager, nil
+}
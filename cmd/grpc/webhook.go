@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// newWebhookRepositories는 웹훅 구독/전송 큐 저장소를 생성합니다 (MongoDB _webhook_subscriptions, _webhook_deliveries 컬렉션)
+// 문서 저장소와 별도 연결을 사용해 반환된 클라이언트는 호출자가 종료해야 합니다
+func newWebhookRepositories(ctx context.Context, uri, database string, retention time.Duration) (*mongodb.WebhookSubscriptionRepository, *mongodb.WebhookDeliveryRepository, *mongo.Client, error) {
+	connectCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(connectCtx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to connect to mongodb for webhooks: %w", err)
+	}
+
+	db := client.Database(database)
+	deliveries := mongodb.NewWebhookDeliveryRepository(db)
+	if err := deliveries.EnsureIndexes(connectCtx, retention); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, nil, nil, err
+	}
+	return mongodb.NewWebhookSubscriptionRepository(db), deliveries, client, nil
+}
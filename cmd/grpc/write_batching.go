@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/batching"
+)
+
+// newBatchingConfig는 write_batching 설정을 쓰기 배치 저장소 설정으로 변환합니다
+func newBatchingConfig(cfg *config.WriteBatchingConfig) batching.Config {
+	return batching.Config{
+		Collections:  cfg.Collections,
+		MaxDelay:     cfg.MaxDelay,
+		MaxBatch:     cfg.MaxBatch,
+		FlushTimeout: cfg.FlushTimeout,
+	}
+}
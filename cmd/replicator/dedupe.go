@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/dedupe"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// dedupePurgeInterval은 SQL 중복 제거 테이블의 만료 기록 정리 주기입니다
+const dedupePurgeInterval = time.Hour
+
+// newDedupeStore는 replication.dedupe 설정으로 중복 제거 저장소를 생성합니다
+// 반환된 close 함수로 저장소 연결을 닫습니다
+func newDedupeStore(ctx context.Context, cfg *config.Config) (messaging.DedupeStore, func(), error) {
+	dedupeCfg := cfg.Replication.Dedupe
+	switch dedupeCfg.Store {
+	case "redis":
+		redisCache, err := cache.NewRedisCache(ctx, &cache.Config{
+			Mode:             cfg.Redis.Mode,
+			Host:             cfg.Redis.Host,
+			Port:             cfg.Redis.Port,
+			Addresses:        cfg.Redis.Addresses,
+			MasterName:       cfg.Redis.MasterName,
+			SentinelPassword: cfg.Redis.SentinelPassword,
+			Password:         cfg.Redis.Password,
+			DB:               cfg.Redis.DB,
+			MaxRetries:       cfg.Redis.MaxRetries,
+			PoolSize:         cfg.Redis.PoolSize,
+			MinIdleConn:      cfg.Redis.MinIdleConns,
+			DialTimeout:      cfg.Redis.DialTimeout,
+			ReadTimeout:      cfg.Redis.ReadTimeout,
+			WriteTimeout:     cfg.Redis.WriteTimeout,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to connect to redis: %w", err)
+		}
+		return dedupe.NewRedisStore(redisCache.Client(), dedupeCfg.KeyPrefix), func() { redisCache.Client().Close() }, nil
+
+	case "postgresql", "mysql":
+		var db *sql.DB
+		var err error
+		if dedupeCfg.Store == "postgresql" {
+			db, err = postgresql.NewClient(ctx, &postgresql.Config{
+				Host:     cfg.PostgreSQL.Host,
+				Port:     cfg.PostgreSQL.Port,
+				User:     cfg.PostgreSQL.User,
+				Password: cfg.PostgreSQL.Password,
+				Database: cfg.PostgreSQL.Database,
+				SSLMode:  cfg.PostgreSQL.SSLMode,
+			})
+		} else {
+			db, err = mysql.NewClient(ctx, &mysql.Config{
+				Host:      cfg.MySQL.Host,
+				Port:      cfg.MySQL.Port,
+				User:      cfg.MySQL.User,
+				Password:  cfg.MySQL.Password,
+				Database:  cfg.MySQL.Database,
+				Charset:   cfg.MySQL.Charset,
+				ParseTime: true,
+			})
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to connect to %s: %w", dedupeCfg.Store, err)
+		}
+
+		store, err := dedupe.NewSQLStore(db, dedupeCfg.Store, dedupeCfg.Table)
+		if err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+		if err := store.EnsureTable(ctx); err != nil {
+			db.Close()
+			return nil, nil, err
+		}
+
+		purgeCtx, stopPurge := context.WithCancel(ctx)
+		go purgeDedupeRecords(purgeCtx, store)
+		return store, func() {
+			stopPurge()
+			db.Close()
+		}, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported dedupe store %q", dedupeCfg.Store)
+	}
+}
+
+// purgeDedupeRecords는 주기적으로 만료된 중복 제거 기록을 삭제합니다 (Redis는 TTL로 자동 정리)
+func purgeDedupeRecords(ctx context.Context, store *dedupe.SQLStore) {
+	ticker := time.NewTicker(dedupePurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := store.Purge(ctx)
+			if err != nil {
+				logger.Warn(ctx, "failed to purge dedupe records", zap.Error(err))
+				continue
+			}
+			logger.Debug(ctx, "dedupe records purged", zap.Int64("count", purged))
+		}
+	}
+}
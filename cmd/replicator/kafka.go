@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/schemaregistry"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"go.uber.org/zap"
+)
+
+// newKafkaSecurity는 설정으로부터 Kafka SASL/TLS 설정을 생성합니다
+// sasl.use_vault이면 Vault에서 자격증명을 가져오며, 반환된 관리자로 자동 갱신을 시작해야 합니다
+func newKafkaSecurity(ctx context.Context, cfg *config.KafkaSecurityConfig, vaultClient *vault.Client) (*kafka.SecurityConfig, *vault.KafkaCredentialsManager, error) {
+	security := &kafka.SecurityConfig{
+		SASL: kafka.SASLConfig{
+			Enabled:   cfg.SASL.Enabled,
+			Mechanism: cfg.SASL.Mechanism,
+			Username:  cfg.SASL.Username,
+			Password:  cfg.SASL.Password,
+		},
+		TLS: kafka.TLSConfig{
+			Enabled:            cfg.TLS.Enabled,
+			CAFile:             cfg.TLS.CAFile,
+			CertFile:           cfg.TLS.CertFile,
+			KeyFile:            cfg.TLS.KeyFile,
+			InsecureSkipVerify: cfg.TLS.InsecureSkipVerify,
+		},
+	}
+
+	if !cfg.SASL.Enabled || !cfg.SASL.UseVault {
+		return security, nil, nil
+	}
+	if vaultClient == nil {
+		return nil, nil, fmt.Errorf("kafka sasl credentials require vault to be enabled")
+	}
+
+	manager := vault.NewKafkaCredentialsManager(vaultClient, "")
+	creds, err := manager.GetCredentials(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	security.SASL.Username = creds.Username
+	security.SASL.Password = creds.Password
+	if len(creds.CACert) > 0 {
+		security.TLS.CAPEM = creds.CACert
+	}
+	if len(creds.ClientCert) > 0 && len(creds.ClientKey) > 0 {
+		security.TLS.CertPEM = creds.ClientCert
+		security.TLS.KeyPEM = creds.ClientKey
+	}
+
+	logger.Info(ctx, "using vault-managed kafka credentials",
+		zap.String("username", creds.Username),
+		zap.String("mechanism", cfg.SASL.Mechanism),
+	)
+	return security, manager, nil
+}
+
+// newAvroSerializer는 kafka.avro 설정으로 CDC 이벤트 Avro 직렬화기를 생성합니다 (비활성화되면 nil)
+func newAvroSerializer(cfg *config.KafkaAvroConfig) (*kafka.AvroSerializer, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	registry, err := schemaregistry.NewClient(schemaregistry.Config{
+		URL:      cfg.SchemaRegistry.URL,
+		Username: cfg.SchemaRegistry.Username,
+		Password: cfg.SchemaRegistry.Password,
+		Timeout:  cfg.SchemaRegistry.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return kafka.NewAvroSerializer(registry, cfg.Topics)
+}
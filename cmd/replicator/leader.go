@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/leader"
+)
+
+// newLeaderElector는 leader_election 설정으로 Kubernetes Lease 리더 선출기를 생성합니다 (꺼져 있으면 nil)
+func newLeaderElector(cfg *config.LeaderElectionConfig, name string) (*leader.Elector, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	client, namespace, err := leader.NewKubernetesClient(leader.KubernetesConfig{Namespace: cfg.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	return leader.NewElector(client, namespace, leader.Config{
+		Name:          leader.LeaseName(name),
+		Identity:      instanceID(),
+		LeaseDuration: cfg.LeaseDuration,
+		RenewDeadline: cfg.RenewDeadline,
+		RetryPeriod:   cfg.RetryPeriod,
+	})
+}
+
+// instanceID는 리더 선출에서 이 인스턴스를 구분하는 ID를 반환합니다 (호스트명-PID)
+func instanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
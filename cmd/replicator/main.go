@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// replicator는 CDC 토픽을 소비해 다른 백엔드/리전의 복제본에 변경을 적용하는 워커입니다
+func main() {
+	// ============================================
+	// 1. Configuration
+	// ============================================
+	cfg, err := config.LoadConfig("./configs", "config")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+	if !cfg.Replication.Enabled {
+		fmt.Fprintln(os.Stderr, "replication is disabled (replication.enabled=false)")
+		os.Exit(1)
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// ============================================
+	// 2. Logger Initialization
+	// ============================================
+	if err := logger.Init(logger.Config{
+		Level:       cfg.Observability.Logging.Level,
+		Environment: cfg.App.Environment,
+		ServiceName: cfg.App.Name + "-replicator",
+		Version:     cfg.App.Version,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logger.Info(ctx, "starting replicator",
+		zap.String("version", cfg.App.Version),
+		zap.String("environment", cfg.App.Environment),
+		zap.String("target_region", cfg.Replication.Target.Region),
+		zap.String("go_version", runtime.Version()),
+	)
+
+	// ============================================
+	// 3. Metrics Server (replication lag, applied/skipped/error counts)
+	// ============================================
+	metrics.Init(strings.ReplaceAll(cfg.App.Name, "-", "_"))
+	metricsServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Replication.MetricsPort),
+		Handler:           promhttp.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	if cfg.Replication.MetricsPort > 0 {
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error(ctx, "metrics server failed", zap.Error(err))
+			}
+		}()
+		logger.Info(ctx, "metrics server started", zap.Int("port", cfg.Replication.MetricsPort))
+	}
+
+	// ============================================
+	// 4. Vault Client Initialization (Optional, Kafka 자격증명용)
+	// ============================================
+	var vaultClient *vault.Client
+	if cfg.Vault.Enabled {
+		vaultClient, err = vault.NewClient(&vault.Config{
+			Address:           cfg.Vault.Address,
+			Token:             cfg.Vault.Token,
+			AuthMethod:        cfg.Vault.AuthMethod,
+			RoleID:            cfg.Vault.RoleID,
+			SecretID:          cfg.Vault.SecretID,
+			K8sRole:           cfg.Vault.K8sRole,
+			MongoDBPath:       cfg.Vault.Paths.MongoDB,
+			KafkaPath:         cfg.Vault.Paths.Kafka,
+			RenewInterval:     cfg.Vault.Renewal.Interval,
+			RenewBeforeExpiry: cfg.Vault.Renewal.RenewBeforeExpiry,
+		})
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize vault client", zap.Error(err))
+		}
+		defer vaultClient.Close()
+	}
+
+	// ============================================
+	// 5. Replica Target Initialization
+	// ============================================
+	target := cfg.Replication.Target
+	replica, err := mongodb.NewReplicaRepository(&mongodb.Config{
+		URI:            target.URI,
+		Database:       target.Database,
+		MaxPoolSize:    target.MaxPoolSize,
+		ConnectTimeout: target.ConnectTimeout,
+		Timeout:        target.Timeout,
+	})
+	if err != nil {
+		logger.Fatal(ctx, "failed to connect to replica target", zap.Error(err))
+	}
+	defer func() {
+		closeCtx, closeCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer closeCancel()
+		if err := replica.Close(closeCtx); err != nil {
+			logger.Error(ctx, "failed to close replica target", zap.Error(err))
+		}
+	}()
+	logger.Info(ctx, "replica target initialized",
+		zap.String("type", target.Type),
+		zap.String("database", target.Database),
+	)
+
+	// ============================================
+	// 6. CDC Consumer
+	// ============================================
+	kafkaSecurity, kafkaCreds, err := newKafkaSecurity(ctx, &cfg.Kafka.Security, vaultClient)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure kafka security", zap.Error(err))
+	}
+
+	groupID := cfg.Replication.GroupID
+	if groupID == "" {
+		groupID = "database-service-replicator"
+	}
+	initialOffset := cfg.Replication.InitialOffset
+	if initialOffset == "" {
+		initialOffset = "oldest"
+	}
+
+	avroSerializer, err := newAvroSerializer(&cfg.Kafka.Avro)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure avro deserialization", zap.Error(err))
+	}
+
+	var idempotent *messaging.IdempotentConsumer
+	if cfg.Replication.Dedupe.Enabled {
+		store, closeStore, err := newDedupeStore(ctx, cfg)
+		if err != nil {
+			logger.Fatal(ctx, "failed to initialize dedupe store", zap.Error(err))
+		}
+		defer closeStore()
+
+		idempotent = messaging.NewIdempotentConsumer(store, messaging.IdempotencyConfig{
+			Consumer:          groupID,
+			ProcessingTimeout: cfg.Replication.Dedupe.ProcessingTimeout,
+			Retention:         cfg.Replication.Dedupe.Retention,
+		})
+		logger.Info(ctx, "event deduplication enabled", zap.String("store", cfg.Replication.Dedupe.Store))
+	}
+
+	consumer, err := kafka.NewReplicationConsumer(&kafka.ConsumerConfig{
+		Brokers: cfg.Kafka.Brokers,
+		GroupID: groupID,
+		Topics: []string{
+			cfg.Kafka.CDCTopics.DocumentCreated,
+			cfg.Kafka.CDCTopics.DocumentUpdated,
+			cfg.Kafka.CDCTopics.DocumentDeleted,
+		},
+		InitialOffset:     initialOffset,
+		SessionTimeout:    cfg.Kafka.Consumer.SessionTimeout,
+		HeartbeatInterval: cfg.Kafka.Consumer.HeartbeatInterval,
+		Security:          kafkaSecurity,
+		Avro:              avroSerializer,
+	}, replica, idempotent)
+	if err != nil {
+		logger.Fatal(ctx, "failed to create replication consumer", zap.Error(err))
+	}
+
+	if kafkaCreds != nil {
+		kafkaCreds.OnRotate(func(creds *vault.KafkaCredentials) {
+			if err := consumer.UpdateCredentials(ctx, creds.Username, creds.Password); err != nil {
+				logger.Error(ctx, "failed to apply rotated kafka credentials", zap.Error(err))
+			}
+		})
+		kafkaCreds.StartAutoRenewal(ctx)
+	}
+
+	// 리더 선출이 켜져 있으면 Lease를 가진 레플리카만 소비합니다
+	elector, err := newLeaderElector(&cfg.LeaderElection, groupID)
+	if err != nil {
+		logger.Fatal(ctx, "failed to configure leader election", zap.Error(err))
+	}
+
+	// Start는 컨텍스트가 취소될 때까지 블록되며 종료 시 컨슈머 그룹을 닫습니다
+	runConsumer := func(ctx context.Context) {
+		if err := consumer.Start(ctx); err != nil {
+			logger.Error(ctx, "replication consumer stopped", zap.Error(err))
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if elector == nil {
+			runConsumer(ctx)
+			return
+		}
+		elector.Run(ctx, runConsumer)
+		// 닫힌 컨슈머는 다시 시작할 수 없으므로 리더십을 잃으면 종료해 재시작된 파드가 다시 선출에 참여하게 합니다
+		if ctx.Err() == nil {
+			logger.Fatal(ctx, "leader lease lost, exiting replicator", zap.String("group_id", groupID))
+		}
+	}()
+	logger.Info(ctx, "replicator started",
+		zap.String("group_id", groupID),
+		zap.Bool("leader_election", elector != nil),
+	)
+
+	// ============================================
+	// 7. Graceful Shutdown
+	// ============================================
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	logger.Info(ctx, "shutting down replicator...")
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(15 * time.Second):
+		logger.Warn(ctx, "replication consumer shutdown timeout")
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error(ctx, "failed to shutdown metrics server", zap.Error(err))
+	}
+
+	logger.Info(ctx, "replicator stopped")
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+)
+
+const usage = `usage: schema-migrate [-config ./configs] [-db postgresql|mysql] <command>
+
+commands:
+  status           문서 테이블별 스키마 버전과 남은 마이그레이션 출력
+  up [version]     모든 문서 테이블에 version까지 남은 마이그레이션 적용 (생략하면 최신 버전)
+  down <version>   모든 문서 테이블에서 version보다 높은 마이그레이션 되돌리기 (0이면 전부)
+`
+
+// schema-migrate는 서버 없이 SQL 백엔드 문서 테이블의 스키마 마이그레이션을 적용/되돌리는 명령입니다
+// 서버와 같은 설정 파일로 연결하며, 실행 중인 서버와 동시에 실행해도 데이터베이스 잠금으로 순서가 보장됩니다
+func main() {
+	configPath := flag.String("config", "./configs", "config directory")
+	dbType := flag.String("db", "postgresql", "database type (postgresql, mysql)")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig(*configPath, "config")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	migrator, closeDB, err := newMigrator(ctx, cfg, *dbType)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to %s: %v\n", *dbType, err)
+		os.Exit(1)
+	}
+
+	err = run(ctx, migrator, flag.Args())
+	closeDB()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run은 명령을 실행하고 결과를 출력합니다
+func run(ctx context.Context, migrator *schema.Migrator, args []string) error {
+	version := 0
+	if len(args) > 1 {
+		v, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		version = v
+	}
+
+	out := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer out.Flush()
+
+	switch args[0] {
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "latest version: %d\n\nTABLE\tVERSION\tPENDING\n", migrator.Latest())
+		for _, status := range statuses {
+			fmt.Fprintf(out, "%s\t%d\t%v\n", status.Table, status.Version, status.Pending)
+		}
+		return nil
+	case "up", "down":
+		if args[0] == "down" && len(args) < 2 {
+			return fmt.Errorf("down requires a target version (0 reverts every migration)")
+		}
+		var changes []schema.Change
+		var err error
+		if args[0] == "up" {
+			changes, err = migrator.Up(ctx, version)
+		} else {
+			changes, err = migrator.Down(ctx, version)
+		}
+		for _, change := range changes {
+			fmt.Fprintf(out, "%s\t%04d_%s\t%s\n", change.Table, change.Version, change.Name, change.Direction)
+		}
+		if err == nil {
+			fmt.Fprintf(out, "%d changes\n", len(changes))
+		}
+		return err
+	default:
+		return fmt.Errorf("unknown command %q\n\n%s", args[0], usage)
+	}
+}
+
+// newMigrator는 설정의 연결 정보로 데이터베이스에 연결하고 Migrator를 생성합니다
+func newMigrator(ctx context.Context, cfg *config.Config, dbType string) (*schema.Migrator, func(), error) {
+	var vaultClient *vault.Client
+	useVault := (dbType == "postgresql" && cfg.PostgreSQL.UseVault) || (dbType == "mysql" && cfg.MySQL.UseVault)
+	if useVault {
+		if !cfg.Vault.Enabled {
+			return nil, nil, fmt.Errorf("%s.use_vault requires vault to be enabled", dbType)
+		}
+		client, err := vault.NewClient(&vault.Config{
+			Address:           cfg.Vault.Address,
+			Token:             cfg.Vault.Token,
+			AuthMethod:        cfg.Vault.AuthMethod,
+			RoleID:            cfg.Vault.RoleID,
+			SecretID:          cfg.Vault.SecretID,
+			K8sRole:           cfg.Vault.K8sRole,
+			RenewInterval:     cfg.Vault.Renewal.Interval,
+			RenewBeforeExpiry: cfg.Vault.Renewal.RenewBeforeExpiry,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize vault client: %w", err)
+		}
+		vaultClient = client
+	}
+
+	var db *sql.DB
+	var creds *vault.SQLCredentialsManager
+	closeVault := func() {
+		if creds != nil {
+			// 이 명령에서만 쓴 동적 자격증명은 바로 폐기
+			creds.Close(context.Background())
+		}
+		if vaultClient != nil {
+			vaultClient.Close()
+		}
+	}
+
+	var migrator *schema.Migrator
+	var err error
+	switch dbType {
+	case "postgresql":
+		pgConfig := &postgresql.Config{
+			Host:     cfg.PostgreSQL.Host,
+			Port:     cfg.PostgreSQL.Port,
+			User:     cfg.PostgreSQL.User,
+			Password: cfg.PostgreSQL.Password,
+			Database: cfg.PostgreSQL.Database,
+			SSLMode:  cfg.PostgreSQL.SSLMode,
+		}
+		if vaultClient != nil {
+			if creds, err = issueSQLCredentials(ctx, vaultClient, "postgresql", cfg.PostgreSQL.VaultPath, cfg.Vault.Paths.PostgreSQL); err != nil {
+				break
+			}
+			pgConfig.Credentials = creds.Current
+		}
+		if db, err = postgresql.NewClient(ctx, pgConfig); err == nil {
+			migrator, err = postgresql.NewSchemaMigrator(db)
+		}
+	case "mysql":
+		mysqlConfig := &mysql.Config{
+			Host:      cfg.MySQL.Host,
+			Port:      cfg.MySQL.Port,
+			User:      cfg.MySQL.User,
+			Password:  cfg.MySQL.Password,
+			Database:  cfg.MySQL.Database,
+			Charset:   cfg.MySQL.Charset,
+			ParseTime: cfg.MySQL.ParseTime,
+		}
+		if vaultClient != nil {
+			if creds, err = issueSQLCredentials(ctx, vaultClient, "mysql", cfg.MySQL.VaultPath, cfg.Vault.Paths.MySQL); err != nil {
+				break
+			}
+			mysqlConfig.Credentials = creds.Current
+		}
+		if db, err = mysql.NewClient(ctx, mysqlConfig); err == nil {
+			migrator, err = mysql.NewSchemaMigrator(db)
+		}
+	default:
+		err = fmt.Errorf("schema migrations are only supported for postgresql and mysql")
+	}
+	if err != nil {
+		if db != nil {
+			db.Close()
+		}
+		closeVault()
+		return nil, nil, err
+	}
+
+	return migrator, func() {
+		db.Close()
+		closeVault()
+	}, nil
+}
+
+// issueSQLCredentials는 Vault에서 동적 SQL 자격증명을 발급받습니다 (path가 비어 있으면 vault.paths의 기본 경로)
+func issueSQLCredentials(ctx context.Context, vaultClient *vault.Client, engine, path, defaultPath string) (*vault.SQLCredentialsManager, error) {
+	if path == "" {
+		path = defaultPath
+	}
+	manager := vault.NewSQLCredentialsManager(vaultClient, engine, path)
+	if _, err := manager.GetCredentials(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get %s credentials from vault: %w", engine, err)
+	}
+	return manager, nil
+}
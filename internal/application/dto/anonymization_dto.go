@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+// StartAnonymizationRequest는 익명화 작업 요청 DTO입니다
+// 데이터베이스는 X-Database-Type 헤더를 따릅니다
+type StartAnonymizationRequest struct {
+	Collection string                 `json:"collection" binding:"required"`
+	Filter     map[string]interface{} `json:"filter,omitempty"` // 대상 문서 필터 (예: 삭제를 요청한 사용자, 비어 있으면 컬렉션 전체)
+	Rules      []AnonymizationRule    `json:"rules" binding:"required,min=1,dive"`
+	BatchSize  int                    `json:"batch_size,omitempty"` // 진행 상황을 기록하는 문서 단위 (0이면 설정값)
+}
+
+// AnonymizationRule은 필드 익명화 규칙 DTO입니다
+type AnonymizationRule struct {
+	Field  string      `json:"field" binding:"required"`                     // 점으로 구분된 data 필드 경로
+	Action string      `json:"action" binding:"required,oneof=hash rewrite"` // hash: HMAC-SHA256 가명화, rewrite: value로 덮어쓰기
+	Value  interface{} `json:"value,omitempty"`                              // rewrite로 쓸 값 (없으면 null)
+}
+
+// AnonymizationJobResponse는 익명화 작업 상태 DTO입니다
+type AnonymizationJobResponse struct {
+	JobID        string                 `json:"job_id"`
+	Status       string                 `json:"status"` // running, completed, failed, cancelled
+	Collection   string                 `json:"collection"`
+	DatabaseType string                 `json:"database_type"`
+	Filter       map[string]interface{} `json:"filter,omitempty"`
+	Fields       []string               `json:"fields"`
+	Runs         int                    `json:"runs"`       // 실행 횟수 (재개할 때마다 증가)
+	Scanned      int64                  `json:"scanned"`    // 이번 실행에서 확인한 문서 수
+	Anonymized   int64                  `json:"anonymized"` // 익명화한 문서 수 (모든 실행 합계)
+	Unchanged    int64                  `json:"unchanged"`  // 이번 실행에서 바꿀 값이 없었던 문서 수 (이미 익명화된 문서 포함)
+	Failed       int64                  `json:"failed"`     // 이번 실행에서 실패한 문서 수 (재개하면 다시 시도)
+	Batches      int64                  `json:"batches"`    // 이번 실행에서 끝낸 배치 수
+	Errors       []AnonymizationError   `json:"errors,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+	StartedAt    time.Time              `json:"started_at"`
+	FinishedAt   *time.Time             `json:"finished_at,omitempty"`
+}
+
+// AnonymizationError는 익명화하지 못한 문서 DTO입니다
+type AnonymizationError struct {
+	DocumentID string `json:"document_id"`
+	Error      string `json:"error"`
+}
+
+// ListAnonymizationJobsResponse는 익명화 작업 목록 DTO입니다
+type ListAnonymizationJobsResponse struct {
+	Jobs []*AnonymizationJobResponse `json:"jobs"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+// AuditLogQueryRequest는 감사 로그 조회 요청 DTO입니다
+type AuditLogQueryRequest struct {
+	Collection     string    `form:"collection" json:"collection"`
+	DocumentID     string    `form:"document_id" json:"document_id"`
+	Actor          string    `form:"actor" json:"actor"`
+	TenantID       string    `form:"tenant_id" json:"tenant_id"`
+	ImpersonatedBy string    `form:"impersonated_by" json:"impersonated_by"`
+	RequestID      string    `form:"request_id" json:"request_id"`
+	Operation      string    `form:"operation" json:"operation"`
+	From           time.Time `form:"from" json:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To             time.Time `form:"to" json:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page           int       `form:"page" json:"page"`
+	PageSize       int       `form:"page_size" json:"page_size"`
+}
+
+// AuditLogEntry는 감사 기록 DTO입니다
+type AuditLogEntry struct {
+	ID                 string                 `json:"id"`
+	Timestamp          time.Time              `json:"timestamp"`
+	Operation          string                 `json:"operation"`
+	DatabaseType       string                 `json:"database_type,omitempty"`
+	Collection         string                 `json:"collection,omitempty"`
+	DocumentID         string                 `json:"document_id,omitempty"`
+	Actor              string                 `json:"actor,omitempty"`
+	ActorName          string                 `json:"actor_name,omitempty"`
+	TenantID           string                 `json:"tenant_id,omitempty"`
+	ImpersonatedBy     string                 `json:"impersonated_by,omitempty"`
+	ImpersonatedByName string                 `json:"impersonated_by_name,omitempty"`
+	RequestID          string                 `json:"request_id,omitempty"`
+	Filter             map[string]interface{} `json:"filter,omitempty"`
+	Before             map[string]interface{} `json:"before,omitempty"`
+	After              map[string]interface{} `json:"after,omitempty"`
+	BeforeVersion      int                    `json:"before_version,omitempty"`
+	AfterVersion       int                    `json:"after_version,omitempty"`
+	AffectedCount      int64                  `json:"affected_count,omitempty"`
+	Success            bool                   `json:"success"`
+	Error              string                 `json:"error,omitempty"`
+}
+
+// AuditLogQueryResponse는 감사 로그 조회 응답 DTO입니다
+type AuditLogQueryResponse struct {
+	Entries    []AuditLogEntry `json:"entries"`
+	TotalCount int64           `json:"total_count"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+}
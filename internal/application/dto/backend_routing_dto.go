@@ -0,0 +1,6 @@
+package dto
+
+// PinBackendRouteRequest는 컬렉션을 다른 백엔드로 고정하는 요청 DTO입니다
+type PinBackendRouteRequest struct {
+	Backend string `json:"backend" binding:"required"`
+}
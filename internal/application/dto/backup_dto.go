@@ -0,0 +1,67 @@
+package dto
+
+import "time"
+
+// StartBackupRequest는 컬렉션 백업 요청 DTO입니다
+type StartBackupRequest struct {
+	Collection string `json:"collection" binding:"required"`
+}
+
+// StartRestoreRequest는 백업 복원 요청 DTO입니다
+// target_collection이 비어 있으면 백업한 컬렉션으로 복원합니다
+type StartRestoreRequest struct {
+	BackupID         string `json:"-"`
+	TargetCollection string `json:"target_collection,omitempty"`
+	DropTarget       bool   `json:"drop_target,omitempty"` // 복원 전에 대상 컬렉션을 삭제 (false이면 대상 컬렉션이 비어 있어야 함)
+}
+
+// BackupJobResponse는 백업/복원 작업 상태 DTO입니다
+type BackupJobResponse struct {
+	JobID        string     `json:"job_id"`
+	Type         string     `json:"type"`   // backup, restore, pitr
+	Status       string     `json:"status"` // running, completed, failed
+	BackupID     string     `json:"backup_id"`
+	Collection   string     `json:"collection"`
+	DatabaseType string     `json:"database_type"`
+	Location     string     `json:"location,omitempty"`
+	Processed    int64      `json:"processed"`
+	Total        int64      `json:"total,omitempty"` // 백업은 시작 시점의 추정 문서 수, 복원은 매니페스트의 문서 수
+	Error        string     `json:"error,omitempty"`
+	StartedAt    time.Time  `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+}
+
+// ListBackupJobsResponse는 백업/복원 작업 목록 DTO입니다
+type ListBackupJobsResponse struct {
+	Jobs []*BackupJobResponse `json:"jobs"`
+}
+
+// PointInTimeRestoreRequest는 시점 복원 요청 DTO입니다
+// timestamp 이전의 가장 최근 백업을 복원한 뒤 CDC 이벤트를 timestamp까지 적용합니다
+type PointInTimeRestoreRequest struct {
+	Collection       string    `json:"collection" binding:"required"`
+	Timestamp        time.Time `json:"timestamp" binding:"required"`
+	TargetCollection string    `json:"target_collection,omitempty"` // 비어 있으면 원래 컬렉션
+	DropTarget       bool      `json:"drop_target,omitempty"`       // 복원 전에 대상 컬렉션을 삭제 (false이면 대상 컬렉션이 비어 있어야 함)
+	DryRun           bool      `json:"dry_run,omitempty"`           // 복원하지 않고 영향받는 문서 수만 계산
+}
+
+// PointInTimeRestorePreview는 시점 복원 미리보기 DTO입니다
+type PointInTimeRestorePreview struct {
+	BackupID          string    `json:"backup_id"`
+	SnapshotTime      time.Time `json:"snapshot_time"`
+	Timestamp         time.Time `json:"timestamp"`
+	SnapshotDocuments int64     `json:"snapshot_documents"` // 백업에 들어 있는 문서 수
+	EventsScanned     int       `json:"events_scanned"`
+	EventsApplied     int       `json:"events_applied"`     // 컬렉션/시간 조건에 맞은 CDC 이벤트 수
+	AffectedDocuments int       `json:"affected_documents"` // 이벤트로 상태가 바뀌는 문서 수
+	Upserted          int       `json:"upserted"`           // 이벤트 적용 후 존재하는 문서 수
+	Deleted           int       `json:"deleted"`            // 이벤트 적용 후 삭제된 문서 수
+}
+
+// PointInTimeRestoreResponse는 시점 복원 응답 DTO입니다 (dry_run이면 Job은 비어 있음)
+type PointInTimeRestoreResponse struct {
+	DryRun  bool                       `json:"dry_run"`
+	Preview *PointInTimeRestorePreview `json:"preview"`
+	Job     *BackupJobResponse         `json:"job,omitempty"`
+}
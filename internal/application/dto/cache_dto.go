@@ -0,0 +1,26 @@
+package dto
+
+// CachePolicy는 컬렉션 캐시 정책 DTO입니다
+type CachePolicy struct {
+	Collection                  string `json:"collection"`
+	Strategy                    string `json:"strategy"`
+	TTLSeconds                  int    `json:"ttl_seconds"`
+	MaxDocumentSize             int    `json:"max_document_size"`
+	StaleWhileRevalidateSeconds int    `json:"stale_while_revalidate_seconds"`
+}
+
+// CachePolicyListResponse는 캐시 정책 목록 응답 DTO입니다
+type CachePolicyListResponse struct {
+	Default  CachePolicy   `json:"default"`
+	Policies []CachePolicy `json:"policies"`
+}
+
+// PutCachePolicyRequest는 캐시 정책 추가/교체 요청 DTO입니다
+// Strategy를 none으로 지정하면 컬렉션의 캐시를 우회합니다
+type PutCachePolicyRequest struct {
+	Collection                  string `json:"-"`
+	Strategy                    string `json:"strategy" binding:"required,oneof=read_through write_through write_behind none"`
+	TTLSeconds                  int    `json:"ttl_seconds" binding:"min=0"`
+	MaxDocumentSize             int    `json:"max_document_size" binding:"min=0"`
+	StaleWhileRevalidateSeconds int    `json:"stale_while_revalidate_seconds" binding:"min=0"`
+}
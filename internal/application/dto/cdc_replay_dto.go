@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// CDCReplayRequest는 CDC 이벤트 재생 요청 DTO입니다
+// from_timestamp 또는 from_offset 중 하나가 필요하며, 둘 다 있으면 from_timestamp를 사용합니다
+type CDCReplayRequest struct {
+	Collection    string     `json:"collection" binding:"required"`
+	FromTimestamp *time.Time `json:"from_timestamp,omitempty"`
+	FromOffset    *int64     `json:"from_offset,omitempty"` // 모든 파티션에 적용 (-1이면 가장 오래된 오프셋)
+	ToTimestamp   *time.Time `json:"to_timestamp,omitempty"`
+	TargetTopic   string     `json:"target_topic,omitempty"` // 비어 있으면 원래 토픽으로 재발행
+	Limit         int        `json:"limit,omitempty"`
+	DryRun        bool       `json:"dry_run,omitempty"`
+}
+
+// CDCReplayResponse는 CDC 이벤트 재생 결과 DTO입니다
+// truncated이면 next_timestamp를 from_timestamp로 다시 요청해 이어서 재생합니다 (같은 시각 이벤트는 중복될 수 있음)
+type CDCReplayResponse struct {
+	ReplayID      string     `json:"replay_id"`
+	Scanned       int        `json:"scanned"`
+	Matched       int        `json:"matched"`
+	Replayed      int        `json:"replayed"`
+	DryRun        bool       `json:"dry_run,omitempty"`
+	Truncated     bool       `json:"truncated"`
+	NextTimestamp *time.Time `json:"next_timestamp,omitempty"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// DeadLetterListRequest는 CDC DLQ 조회 요청 DTO입니다
+type DeadLetterListRequest struct {
+	Collection string `form:"collection" json:"collection"`
+	EventType  string `form:"event_type" json:"event_type"`
+	Page       int    `form:"page" json:"page"`
+	PageSize   int    `form:"page_size" json:"page_size"`
+}
+
+// DeadLetterEntry는 DLQ 이벤트 DTO입니다
+type DeadLetterEntry struct {
+	ID              string                 `json:"id"`
+	EventType       string                 `json:"event_type"`
+	DocumentID      string                 `json:"document_id"`
+	Collection      string                 `json:"collection"`
+	Data            map[string]interface{} `json:"data,omitempty"`
+	Version         int                    `json:"version"`
+	PreviousVersion int                    `json:"previous_version,omitempty"`
+	Changes         map[string]interface{} `json:"changes,omitempty"`
+	Error           string                 `json:"error"`
+	Attempts        int                    `json:"attempts"`
+	FailedAt        time.Time              `json:"failed_at"`
+	LastRedriveAt   *time.Time             `json:"last_redrive_at,omitempty"`
+}
+
+// DeadLetterListResponse는 DLQ 조회 응답 DTO입니다
+type DeadLetterListResponse struct {
+	Entries    []DeadLetterEntry `json:"entries"`
+	TotalCount int64             `json:"total_count"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+}
+
+// DeadLetterRedriveRequest는 DLQ 일괄 재발행 요청 DTO입니다 (오래된 순으로 최대 Limit개)
+type DeadLetterRedriveRequest struct {
+	Collection string `json:"collection"`
+	EventType  string `json:"event_type"`
+	Limit      int    `json:"limit"`
+}
+
+// DeadLetterRedriveResponse는 DLQ 재발행 결과 DTO입니다
+type DeadLetterRedriveResponse struct {
+	Redriven  int      `json:"redriven"`
+	Failed    int      `json:"failed"`
+	FailedIDs []string `json:"failed_ids,omitempty"`
+}
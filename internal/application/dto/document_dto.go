@@ -6,27 +6,36 @@ import "time"
 type CreateDocumentRequest struct {
 	Collection string                 `json:"collection" validate:"required"`
 	Data       map[string]interface{} `json:"data" validate:"required"`
+	ExpiresAt  *time.Time             `json:"expires_at,omitempty"`  // 만료 시각 (만료 정책이 있는 컬렉션만)
+	TTLSeconds int64                  `json:"ttl_seconds,omitempty"` // 생성 시점부터의 만료 시간 (expires_at과 함께 쓸 수 없음)
 }
 
 // CreateDocumentResponse는 문서 생성 응답 DTO입니다
 type CreateDocumentResponse struct {
-	ID        string    `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 // GetDocumentRequest는 문서 조회 요청 DTO입니다
 type GetDocumentRequest struct {
-	Collection string `json:"collection" validate:"required"`
-	ID         string `json:"id" validate:"required"`
+	Collection     string     `json:"collection" validate:"required"`
+	ID             string     `json:"id" validate:"required"`
+	IncludeDeleted bool       `json:"include_deleted"`    // 소프트 삭제된 문서도 반환
+	AsOf           *time.Time `json:"as_of,omitempty"`    // 이 시점의 문서 상태 조회 (리비전 보관 컬렉션만)
+	Populate       []string   `json:"populate,omitempty"` // 대상 문서를 함께 반환할 참조 필드
 }
 
 // GetDocumentResponse는 문서 조회 응답 DTO입니다
 type GetDocumentResponse struct {
-	ID        string                 `json:"id"`
-	Data      map[string]interface{} `json:"data"`
-	Version   int                    `json:"version"`
-	CreatedAt time.Time              `json:"created_at"`
-	UpdatedAt time.Time              `json:"updated_at"`
+	ID        string                          `json:"id"`
+	Data      map[string]interface{}          `json:"data"`
+	Version   int                             `json:"version"`
+	CreatedAt time.Time                       `json:"created_at"`
+	UpdatedAt time.Time                       `json:"updated_at"`
+	ExpiresAt *time.Time                      `json:"expires_at,omitempty"`
+	DeletedAt *time.Time                      `json:"deleted_at,omitempty"` // 소프트 삭제된 문서 (include_deleted로 조회한 경우)
+	Populated map[string]*GetDocumentResponse `json:"populated,omitempty"`  // populate로 요청한 참조 필드별 대상 문서 (없는 문서는 null)
 }
 
 // UpdateDocumentRequest는 문서 업데이트 요청 DTO입니다
@@ -43,12 +52,52 @@ type DeleteDocumentRequest struct {
 	ID         string `json:"id" validate:"required"`
 }
 
+// ListRevisionsRequest는 문서 리비전 목록 조회 요청 DTO입니다
+type ListRevisionsRequest struct {
+	Collection string `json:"collection" validate:"required"`
+	ID         string `json:"id" validate:"required"`
+	Limit      int64  `json:"limit"` // 0이면 전체
+}
+
+// RevisionResponse는 문서의 이전 버전 하나입니다
+type RevisionResponse struct {
+	Version   int                    `json:"version"`
+	Data      map[string]interface{} `json:"data"`
+	ValidFrom time.Time              `json:"valid_from"`
+	ValidTo   time.Time              `json:"valid_to"`
+	Operation string                 `json:"operation"` // 이 버전을 대체한 작업
+}
+
+// ListRevisionsResponse는 문서 리비전 목록 응답 DTO입니다 (최신 버전부터)
+type ListRevisionsResponse struct {
+	ID        string             `json:"id"`
+	Revisions []RevisionResponse `json:"revisions"`
+}
+
+// RestoreDocumentRequest는 소프트 삭제된 문서 복원 요청 DTO입니다
+type RestoreDocumentRequest struct {
+	Collection string `json:"collection" validate:"required"`
+	ID         string `json:"id" validate:"required"`
+}
+
 // ListDocumentsRequest는 문서 목록 조회 요청 DTO입니다
 type ListDocumentsRequest struct {
-	Collection string                 `json:"collection" validate:"required"`
-	Filter     map[string]interface{} `json:"filter"`
-	Page       int                    `json:"page"`
-	PageSize   int                    `json:"page_size"`
+	Collection     string                 `json:"collection" validate:"required"`
+	Filter         map[string]interface{} `json:"filter"`
+	Page           int                    `json:"page"`
+	PageSize       int                    `json:"page_size"`
+	IncludeDeleted bool                   `json:"include_deleted"`    // 소프트 삭제된 문서도 반환
+	Populate       []string               `json:"populate,omitempty"` // 대상 문서를 함께 반환할 참조 필드
+}
+
+// ExportDocumentsRequest는 문서 export 요청 DTO입니다
+type ExportDocumentsRequest struct {
+	Collection     string                 `json:"collection" validate:"required"`
+	Filter         map[string]interface{} `json:"filter"`
+	Sort           map[string]int         `json:"sort"`
+	Limit          int64                  `json:"limit"`           // 0이면 전체
+	Fields         []string               `json:"fields"`          // 비어 있으면 전체 필드
+	IncludeDeleted bool                   `json:"include_deleted"` // 소프트 삭제된 문서도 반환
 }
 
 // ListDocumentsResponse는 문서 목록 조회 응답 DTO입니다
@@ -263,6 +312,17 @@ type BulkWriteResponse struct {
 	DeletedCount  int64    `json:"deleted_count"`
 	UpsertedCount int64    `json:"upserted_count"`
 	UpsertedIDs   []string `json:"upserted_ids"`
+
+	// Errors는 실패한 개별 작업입니다 (부분 실패를 지원하는 데이터베이스에서만 채워집니다)
+	Errors []BulkWriteError `json:"errors,omitempty"`
+}
+
+// BulkWriteError는 대량 쓰기에서 실패한 개별 작업 DTO입니다
+type BulkWriteError struct {
+	Index  int    `json:"index"`
+	ID     string `json:"id,omitempty"`
+	Status int    `json:"status,omitempty"`
+	Reason string `json:"reason"`
 }
 
 // CreateIndexRequest는 인덱스 생성 요청 DTO입니다
@@ -348,6 +408,24 @@ type RenameCollectionResponse struct {
 	Success bool `json:"success"`
 }
 
+// CloneCollectionRequest는 컬렉션 복제 요청 DTO입니다
+type CloneCollectionRequest struct {
+	Source      string                 `json:"-"`
+	Target      string                 `json:"target" validate:"required"`
+	Filter      map[string]interface{} `json:"filter,omitempty"`       // 복제할 문서 필터 (비어 있으면 전체)
+	BatchSize   int                    `json:"batch_size,omitempty"`   // 한 번에 저장할 문서 수 (0이면 500)
+	SkipIndexes bool                   `json:"skip_indexes,omitempty"` // 인덱스를 복제하지 않음
+}
+
+// CloneCollectionResponse는 컬렉션 복제 응답 DTO입니다
+type CloneCollectionResponse struct {
+	Source         string   `json:"source"`
+	Target         string   `json:"target"`
+	Documents      int64    `json:"documents"`
+	Indexes        []string `json:"indexes"`
+	SkippedIndexes []string `json:"skipped_indexes,omitempty"` // 이 데이터베이스에서 옮길 수 없거나 생성에 실패한 인덱스
+}
+
 // ListCollectionsRequest는 컬렉션 목록 조회 요청 DTO입니다
 type ListCollectionsRequest struct {
 	Filter map[string]interface{} `json:"filter"`
@@ -386,6 +464,9 @@ type TransactionOperation struct {
 // ExecuteTransactionRequest는 트랜잭션 실행 요청 DTO입니다
 type ExecuteTransactionRequest struct {
 	Operations []TransactionOperation `json:"operations" validate:"required"`
+	Isolation  string                 `json:"isolation,omitempty" validate:"omitempty,oneof=read_uncommitted read_committed repeatable_read snapshot serializable"`
+	ReadOnly   bool                   `json:"read_only,omitempty"`
+	TimeoutMS  int64                  `json:"timeout_ms,omitempty" validate:"omitempty,min=0"`
 }
 
 // ExecuteTransactionResponse는 트랜잭션 실행 응답 DTO입니다
@@ -463,11 +544,22 @@ type DatabaseStatsRequest struct {
 
 // DatabaseStatsResponse는 데이터베이스 통계 응답 DTO입니다
 type DatabaseStatsResponse struct {
-	DatabaseType    string  `json:"database_type"`
-	Collections     int     `json:"collections"`
-	TotalDocuments  int64   `json:"total_documents"`
-	TotalSize       int64   `json:"total_size_bytes"`
-	AvgDocumentSize float64 `json:"avg_document_size_bytes"`
+	DatabaseType    string                     `json:"database_type"`
+	Collections     int                        `json:"collections"`
+	TotalDocuments  int64                      `json:"total_documents"`
+	TotalSize       int64                      `json:"total_size_bytes"`
+	AvgDocumentSize float64                    `json:"avg_document_size_bytes"`
+	StorageSize     int64                      `json:"storage_size_bytes"`
+	IndexSize       int64                      `json:"index_size_bytes"`
+	Connections     *ConnectionStatsResponse   `json:"connections,omitempty"`      // 권한이 없어 조회하지 못하면 생략
+	CollectionStats []*CollectionStatsResponse `json:"collection_stats,omitempty"` // 컬렉션별 통계
+}
+
+// ConnectionStatsResponse는 데이터베이스 서버 연결 수 DTO입니다
+type ConnectionStatsResponse struct {
+	Current   int64 `json:"current"`
+	Active    int64 `json:"active"`
+	Available int64 `json:"available,omitempty"`
 }
 
 // CollectionStatsRequest는 컬렉션 통계 요청 DTO입니다
@@ -477,11 +569,33 @@ type CollectionStatsRequest struct {
 
 // CollectionStatsResponse는 컬렉션 통계 응답 DTO입니다
 type CollectionStatsResponse struct {
-	Collection      string  `json:"collection"`
-	DocumentCount   int64   `json:"document_count"`
-	Size            int64   `json:"size_bytes"`
-	AvgDocumentSize float64 `json:"avg_document_size_bytes"`
-	IndexCount      int     `json:"index_count"`
+	Collection      string           `json:"collection"`
+	DocumentCount   int64            `json:"document_count"`
+	Size            int64            `json:"size_bytes"`
+	AvgDocumentSize float64          `json:"avg_document_size_bytes"`
+	StorageSize     int64            `json:"storage_size_bytes"`
+	IndexCount      int              `json:"index_count"`
+	TotalIndexSize  int64            `json:"total_index_size_bytes"`
+	IndexSizes      map[string]int64 `json:"index_sizes,omitempty"`    // 인덱스별 크기 (바이트)
+	StorageEngine   string           `json:"storage_engine,omitempty"` // wiredTiger, heap, InnoDB, lucene 등
+}
+
+// StorageUsageResponse는 컬렉션 또는 테넌트의 저장 용량 사용량 DTO입니다
+type StorageUsageResponse struct {
+	DatabaseType string    `json:"database_type"`
+	Scope        string    `json:"scope"` // collection, tenant
+	Name         string    `json:"name"`  // 컬렉션 이름 또는 테넌트 ID
+	Documents    int64     `json:"documents"`
+	Bytes        int64     `json:"bytes"`
+	MaxDocuments int64     `json:"max_documents"` // 0이면 제한 없음
+	MaxBytes     int64     `json:"max_bytes"`     // 0이면 제한 없음
+	SyncedAt     time.Time `json:"synced_at"`
+
+	// Tenant는 컬렉션이 속한 테넌트의 사용량입니다 (테넌트 컬렉션인 경우)
+	Tenant *StorageUsageResponse `json:"tenant,omitempty"`
+
+	// Collections는 테넌트의 컬렉션별 사용량입니다 (테넌트 사용량인 경우)
+	Collections []StorageUsageResponse `json:"collections,omitempty"`
 }
 
 // APIResponse는 공통 API 응답 래퍼입니다
@@ -0,0 +1,67 @@
+package dto
+
+import "time"
+
+// StartDuplicateScanRequest는 중복 문서 탐지 작업 요청 DTO입니다
+// 데이터베이스는 X-Database-Type 헤더를 따릅니다
+type StartDuplicateScanRequest struct {
+	Collection      string   `json:"collection" binding:"required"`
+	Fields          []string `json:"fields" binding:"required,min=1"` // 값이 모두 같으면 중복인 data 필드 경로
+	CaseInsensitive bool     `json:"case_insensitive,omitempty"`      // 문자열 값을 대소문자 구분 없이 비교
+	TrimSpace       bool     `json:"trim_space,omitempty"`            // 문자열 값의 앞뒤 공백 무시
+	MaxGroups       int      `json:"max_groups,omitempty"`            // 결과에 담을 최대 그룹 수 (0이면 설정값)
+}
+
+// DuplicateScanJobResponse는 중복 문서 탐지 작업 상태 DTO입니다
+type DuplicateScanJobResponse struct {
+	JobID           string                 `json:"job_id"`
+	Status          string                 `json:"status"` // running, completed, failed
+	Collection      string                 `json:"collection"`
+	DatabaseType    string                 `json:"database_type"`
+	Fields          []string               `json:"fields"`
+	CaseInsensitive bool                   `json:"case_insensitive,omitempty"`
+	TrimSpace       bool                   `json:"trim_space,omitempty"`
+	Scanned         int64                  `json:"scanned"`         // 확인한 문서 수
+	GroupCount      int                    `json:"group_count"`     // 중복 그룹 수 (groups는 max_groups개까지)
+	DuplicateCount  int64                  `json:"duplicate_count"` // 그룹마다 한 문서를 남길 때 정리 대상 문서 수
+	Groups          []DuplicateGroupResult `json:"groups,omitempty"`
+	Error           string                 `json:"error,omitempty"`
+	StartedAt       time.Time              `json:"started_at"`
+	FinishedAt      *time.Time             `json:"finished_at,omitempty"`
+}
+
+// DuplicateGroupResult는 키가 같은 문서 묶음 DTO입니다
+type DuplicateGroupResult struct {
+	Key         string                 `json:"key"`     // 정규화한 키 값의 SHA-256
+	Values      map[string]interface{} `json:"values"`  // 필드별 키 값 (그룹 문서 중 하나의 값)
+	KeepID      string                 `json:"keep_id"` // 남길 문서 제안 (가장 먼저 생성된 문서)
+	DocumentIDs []string               `json:"document_ids"`
+}
+
+// ListDuplicateScansResponse는 중복 문서 탐지 작업 목록 DTO입니다
+type ListDuplicateScansResponse struct {
+	Jobs []*DuplicateScanJobResponse `json:"jobs"`
+}
+
+// ResolveDuplicatesRequest는 중복 문서 정리 요청 DTO입니다
+// 정리 전에 keep_id와 duplicate_ids 문서의 키가 모두 같은지 다시 확인합니다
+type ResolveDuplicatesRequest struct {
+	Collection      string   `json:"collection" binding:"required"`
+	Fields          []string `json:"fields" binding:"required,min=1"`
+	CaseInsensitive bool     `json:"case_insensitive,omitempty"`
+	TrimSpace       bool     `json:"trim_space,omitempty"`
+	KeepID          string   `json:"keep_id" binding:"required"`
+	DuplicateIDs    []string `json:"duplicate_ids" binding:"required,min=1"`
+	Action          string   `json:"action" binding:"required,oneof=merge delete"` // merge: 남길 문서에 없는 필드를 채운 뒤 삭제, delete: 삭제만
+}
+
+// ResolveDuplicatesResponse는 중복 문서 정리 결과 DTO입니다
+type ResolveDuplicatesResponse struct {
+	Collection   string   `json:"collection"`
+	Action       string   `json:"action"`
+	KeepID       string   `json:"keep_id"`
+	Version      int      `json:"version"`                 // 정리 후 남긴 문서의 버전
+	MergedFields []string `json:"merged_fields,omitempty"` // 병합으로 채운 필드
+	Repointed    int      `json:"repointed,omitempty"`     // 삭제한 문서 대신 남긴 문서를 가리키도록 바꾼 참조 수
+	DeletedIDs   []string `json:"deleted_ids"`
+}
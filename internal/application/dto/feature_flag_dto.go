@@ -0,0 +1,7 @@
+package dto
+
+// SetFeatureFlagRequest는 기능 플래그 값을 실행 중에 바꾸는 요청 DTO입니다 (tenant가 비어 있으면 모든 테넌트)
+type SetFeatureFlagRequest struct {
+	Enabled *bool  `json:"enabled" binding:"required"`
+	Tenant  string `json:"tenant"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+// LoadFixturesRequest는 픽스처 불러오기 요청 DTO입니다
+type LoadFixturesRequest struct {
+	Set       string `json:"set,omitempty"`       // fixtures.directory 아래 하위 디렉터리 이름 (비어 있으면 fixtures.directory)
+	Overwrite bool   `json:"overwrite,omitempty"` // 내용이 다른 기존 문서를 픽스처로 덮어씀
+}
+
+// LoadFixturesResponse는 픽스처 불러오기 결과 DTO입니다
+type LoadFixturesResponse struct {
+	Set         string                    `json:"set,omitempty"`
+	Collections []FixtureCollectionResult `json:"collections"`
+	Created     int64                     `json:"created"`
+	Updated     int64                     `json:"updated"`
+	Unchanged   int64                     `json:"unchanged"`
+	Skipped     int64                     `json:"skipped"`
+	Failed      int64                     `json:"failed"`
+}
+
+// FixtureCollectionResult는 컬렉션 하나의 픽스처 불러오기 결과 DTO입니다
+type FixtureCollectionResult struct {
+	Collection string         `json:"collection"`
+	File       string         `json:"file"`
+	Created    int64          `json:"created"`
+	Updated    int64          `json:"updated"`
+	Unchanged  int64          `json:"unchanged"`
+	Skipped    int64          `json:"skipped"` // 내용이 다르지만 overwrite가 아니어서 그대로 둔 문서
+	Failed     int64          `json:"failed"`
+	Errors     []FixtureError `json:"errors,omitempty"`
+}
+
+// FixtureError는 불러오지 못한 픽스처 문서 DTO입니다
+type FixtureError struct {
+	DocumentID string `json:"document_id"`
+	Error      string `json:"error"`
+}
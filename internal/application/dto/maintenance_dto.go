@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+// StartMaintenanceRequest는 컬렉션 유지보수 요청 DTO입니다
+// 데이터베이스는 X-Database-Type 헤더를 따르며, 지원 작업은 GET /admin/maintenance/operations/:db_type으로 확인합니다
+type StartMaintenanceRequest struct {
+	Collection  string `json:"collection" binding:"required"`
+	Operation   string `json:"operation" binding:"required"` // compact, vacuum, analyze, optimize, forcemerge, refresh, reindex
+	Full        bool   `json:"full,omitempty"`               // PostgreSQL VACUUM FULL (작업 중 테이블 잠금)
+	MaxSegments int    `json:"max_segments,omitempty"`       // Elasticsearch forcemerge의 max_num_segments
+}
+
+// MaintenanceJobResponse는 유지보수 작업 상태 DTO입니다
+type MaintenanceJobResponse struct {
+	JobID        string                 `json:"job_id"`
+	Status       string                 `json:"status"` // running, completed, failed
+	Operation    string                 `json:"operation"`
+	Collection   string                 `json:"collection"`
+	DatabaseType string                 `json:"database_type"`
+	Scheduled    bool                   `json:"scheduled"`          // 스케줄에 의해 시작된 작업
+	Progress     *MaintenanceProgress   `json:"progress,omitempty"` // 진행 상황을 보고하는 작업(reindex)만
+	Result       map[string]interface{} `json:"result,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+	StartedAt    time.Time              `json:"started_at"`
+	FinishedAt   *time.Time             `json:"finished_at,omitempty"`
+}
+
+// MaintenanceProgress는 유지보수 작업 진행 상황 DTO입니다
+// 단위는 백엔드마다 다릅니다 (MongoDB/PostgreSQL/MySQL은 재생성한 인덱스 수, Elasticsearch는 재색인한 문서 수)
+type MaintenanceProgress struct {
+	Done  int64 `json:"done"`
+	Total int64 `json:"total"`
+}
+
+// ListMaintenanceJobsResponse는 유지보수 작업 목록 DTO입니다
+type ListMaintenanceJobsResponse struct {
+	Jobs []*MaintenanceJobResponse `json:"jobs"`
+}
+
+// MaintenanceOperationsResponse는 데이터베이스가 지원하는 유지보수 작업 목록 DTO입니다
+type MaintenanceOperationsResponse struct {
+	DatabaseType string   `json:"database_type"`
+	Operations   []string `json:"operations"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// StartMigrationRequest는 백엔드 간 온라인 마이그레이션 시작 요청 DTO입니다
+type StartMigrationRequest struct {
+	Collection string `json:"collection" binding:"required"`
+	Source     string `json:"source" binding:"required"` // 원본 데이터베이스 (예: mongodb)
+	Target     string `json:"target" binding:"required"` // 대상 데이터베이스 (예: postgresql)
+	DropTarget bool   `json:"drop_target,omitempty"`     // 시작 전에 대상 컬렉션을 삭제 (false이면 대상 컬렉션이 비어 있어야 함)
+}
+
+// CutOverMigrationRequest는 마이그레이션 전환 요청 DTO입니다
+type CutOverMigrationRequest struct {
+	Force bool `json:"force,omitempty"` // 체크섬이 일치하지 않아도 전환
+}
+
+// MigrationVerifyResponse는 원본과 대상의 체크섬 비교 결과 DTO입니다
+type MigrationVerifyResponse struct {
+	SourceDocuments int64     `json:"source_documents"`
+	TargetDocuments int64     `json:"target_documents"`
+	SourceChecksum  string    `json:"source_checksum"`
+	TargetChecksum  string    `json:"target_checksum"`
+	Match           bool      `json:"match"`
+	VerifiedAt      time.Time `json:"verified_at"`
+}
+
+// MigrationResponse는 마이그레이션 상태 DTO입니다
+type MigrationResponse struct {
+	MigrationID    string                   `json:"migration_id"`
+	Collection     string                   `json:"collection"`
+	Source         string                   `json:"source"`
+	Target         string                   `json:"target"`
+	Phase          string                   `json:"phase"` // backfilling, verifying, ready, verify_failed, cut_over, completed, failed, aborted
+	Copied         int64                    `json:"copied"`
+	Skipped        int64                    `json:"skipped"` // dual-write로 이미 반영되어 복사하지 않은 문서 수
+	Total          int64                    `json:"total,omitempty"`
+	MirrorFailures int64                    `json:"mirror_failures"`
+	Verify         *MigrationVerifyResponse `json:"verify,omitempty"`
+	Error          string                   `json:"error,omitempty"`
+	StartedAt      time.Time                `json:"started_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
+
+// ListMigrationsResponse는 마이그레이션 목록 DTO입니다
+type ListMigrationsResponse struct {
+	Migrations []*MigrationResponse `json:"migrations"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+// SchemaMigrationUpRequest는 스키마 마이그레이션 적용 요청입니다
+type SchemaMigrationUpRequest struct {
+	Version int `json:"version,omitempty"` // 적용할 마지막 버전 (0이면 최신 버전)
+}
+
+// SchemaMigrationDownRequest는 스키마 마이그레이션 되돌리기 요청입니다
+type SchemaMigrationDownRequest struct {
+	Version *int `json:"version" binding:"required"` // 이 버전보다 높은 마이그레이션을 되돌림 (0이면 전부)
+}
+
+// SchemaTableStatusResponse는 문서 테이블 하나의 스키마 버전입니다
+type SchemaTableStatusResponse struct {
+	Table   string `json:"table"`
+	Version int    `json:"version"`
+	Pending []int  `json:"pending"`
+}
+
+// SchemaMigrationStatusResponse는 데이터베이스의 스키마 마이그레이션 상태입니다
+type SchemaMigrationStatusResponse struct {
+	DatabaseType  string                       `json:"database_type"`
+	LatestVersion int                          `json:"latest_version"`
+	Tables        []*SchemaTableStatusResponse `json:"tables"`
+}
+
+// SchemaMigrationChangeResponse는 적용하거나 되돌린 마이그레이션 하나입니다
+type SchemaMigrationChangeResponse struct {
+	Table     string `json:"table"`
+	Version   int    `json:"version"`
+	Name      string `json:"name"`
+	Direction string `json:"direction"` // up, down
+}
+
+// SchemaMigrationResponse는 스키마 마이그레이션 실행 결과입니다
+type SchemaMigrationResponse struct {
+	DatabaseType string                           `json:"database_type"`
+	Changes      []*SchemaMigrationChangeResponse `json:"changes"`
+}
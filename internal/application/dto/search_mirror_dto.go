@@ -0,0 +1,59 @@
+package dto
+
+import "time"
+
+// SearchMirrorResponse는 검색 미러 설정 DTO입니다
+type SearchMirrorResponse struct {
+	Collection     string `json:"collection"`
+	SourceDatabase string `json:"source_database"` // 주 저장소 데이터베이스 종류
+	Index          string `json:"index"`           // Elasticsearch 인덱스
+	VerifyInterval string `json:"verify_interval,omitempty"`
+	AutoRepair     bool   `json:"auto_repair,omitempty"`
+}
+
+// ListSearchMirrorsResponse는 검색 미러 목록 DTO입니다
+type ListSearchMirrorsResponse struct {
+	Mirrors []SearchMirrorResponse `json:"mirrors"`
+}
+
+// StartMirrorVerificationRequest는 검색 미러 검증 요청 DTO입니다
+type StartMirrorVerificationRequest struct {
+	Collection string `json:"collection" binding:"required"` // search_mirror.collections에 설정한 컬렉션
+	Repair     bool   `json:"repair,omitempty"`              // 어긋난 문서를 주 저장소의 상태로 미러에 다시 씀
+}
+
+// MirrorVerificationResponse는 검색 미러 검증 작업 상태 DTO입니다
+type MirrorVerificationResponse struct {
+	JobID          string        `json:"job_id"`
+	Status         string        `json:"status"` // running, completed, failed
+	Collection     string        `json:"collection"`
+	SourceDatabase string        `json:"source_database"`
+	Index          string        `json:"index"`
+	Repair         bool          `json:"repair"`
+	Scheduled      bool          `json:"scheduled"`    // 스케줄에 의해 시작된 작업
+	SourceCount    int64         `json:"source_count"` // 주 저장소 문서 수 (실행 중에는 지금까지 순회한 수)
+	MirrorCount    int64         `json:"mirror_count"` // 미러 문서 수 (실행 중에는 지금까지 순회한 수)
+	Missing        int64         `json:"missing"`      // 미러에 없는 문서 수
+	Extra          int64         `json:"extra"`        // 주 저장소에 없는 미러 문서 수
+	Diverged       int64         `json:"diverged"`     // 내용이 다른 문서 수
+	Repaired       int64         `json:"repaired"`
+	RepairFailed   int64         `json:"repair_failed"`
+	InSync         *bool         `json:"in_sync,omitempty"` // 완료된 작업만
+	Drift          []MirrorDrift `json:"drift,omitempty"`   // 최대 1000개
+	Error          string        `json:"error,omitempty"`
+	StartedAt      time.Time     `json:"started_at"`
+	FinishedAt     *time.Time    `json:"finished_at,omitempty"`
+}
+
+// MirrorDrift는 주 저장소와 미러가 어긋난 문서 DTO입니다
+type MirrorDrift struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"` // missing, extra, diverged
+	Repaired bool   `json:"repaired,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ListMirrorVerificationsResponse는 검색 미러 검증 작업 목록 DTO입니다
+type ListMirrorVerificationsResponse struct {
+	Jobs []*MirrorVerificationResponse `json:"jobs"`
+}
@@ -0,0 +1,98 @@
+package dto
+
+import "time"
+
+// 프로비저닝 단계 결과
+const (
+	ProvisioningCreated    = "created"    // 새로 만듦
+	ProvisioningExists     = "exists"     // 이미 있어 그대로 사용
+	ProvisioningConfigured = "configured" // 테넌트 설정으로 저장
+	ProvisioningSkipped    = "skipped"    // 이전 단계 실패 등으로 실행하지 않음
+	ProvisioningFailed     = "failed"
+)
+
+// ProvisionTenantRequest는 테넌트 프로비저닝 요청 DTO입니다
+type ProvisionTenantRequest struct {
+	TenantID    string                    `json:"tenant_id" validate:"required"`
+	Collections []TenantCollectionRequest `json:"collections,omitempty"` // tenants.default_collections에 추가로 만들 컬렉션 (네임스페이스 제외 이름)
+	Quota       *TenantQuotaRequest       `json:"quota,omitempty"`       // 비어 있으면 tenants.default_quota
+	APIKeys     []TenantAPIKeyRequest     `json:"api_keys,omitempty"`    // 비어 있으면 tenants.default_api_key_roles 역할의 키 하나
+	CDCTopics   *bool                     `json:"cdc_topics,omitempty"`  // 비어 있으면 tenants.cdc_topics.enabled
+}
+
+// TenantCollectionRequest는 테넌트에 만들 컬렉션과 인덱스 DTO입니다
+type TenantCollectionRequest struct {
+	Name    string               `json:"name"`
+	Indexes []TenantIndexRequest `json:"indexes,omitempty"`
+}
+
+// TenantIndexRequest는 테넌트 컬렉션에 만들 인덱스 DTO입니다
+type TenantIndexRequest struct {
+	Name   string         `json:"name,omitempty"` // 비어 있으면 컬렉션과 키 이름으로 생성
+	Keys   map[string]int `json:"keys"`
+	Unique bool           `json:"unique,omitempty"`
+}
+
+// TenantQuotaRequest는 테넌트 저장 용량 한도 DTO입니다 (0이면 제한 없음)
+type TenantQuotaRequest struct {
+	MaxDocuments int64 `json:"max_documents"`
+	MaxBytes     int64 `json:"max_bytes"`
+}
+
+// TenantAPIKeyRequest는 발급할 테넌트 API 키 DTO입니다
+type TenantAPIKeyRequest struct {
+	Roles       []string `json:"roles"` // reader, writer
+	Description string   `json:"description,omitempty"`
+}
+
+// ProvisionTenantResponse는 테넌트 프로비저닝 보고서 DTO입니다
+type ProvisionTenantResponse struct {
+	Tenant *TenantResponse    `json:"tenant,omitempty"` // 모든 단계가 성공해 테넌트를 저장한 경우에만
+	Steps  []ProvisioningStep `json:"steps"`
+
+	// APIKeys는 발급한 API 키 원문입니다 (이 응답에서만 반환하며 다시 조회할 수 없음)
+	APIKeys []IssuedTenantAPIKey `json:"api_keys,omitempty"`
+
+	Complete bool `json:"complete"`
+}
+
+// ProvisioningStep은 프로비저닝 단계 하나의 결과 DTO입니다
+type ProvisioningStep struct {
+	Step     string `json:"step"`     // collection, index, cdc_topic, quota, api_key, tenant
+	Resource string `json:"resource"` // 컬렉션/인덱스/토픽 이름 등
+	Status   string `json:"status"`   // created, exists, configured, skipped, failed
+	Error    string `json:"error,omitempty"`
+}
+
+// IssuedTenantAPIKey는 새로 발급한 API 키 DTO입니다
+type IssuedTenantAPIKey struct {
+	ID    string   `json:"id"`
+	Key   string   `json:"key"`
+	Roles []string `json:"roles"`
+}
+
+// TenantResponse는 테넌트 DTO입니다 (API 키 비밀 값은 포함하지 않음)
+type TenantResponse struct {
+	ID           string                 `json:"id"`
+	Namespace    string                 `json:"namespace"`
+	DatabaseType string                 `json:"database_type"`
+	Collections  []string               `json:"collections"`
+	Quota        TenantQuotaRequest     `json:"quota"`
+	APIKeys      []TenantAPIKeyResponse `json:"api_keys"`
+	CDCTopics    []string               `json:"cdc_topics,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// TenantAPIKeyResponse는 테넌트 API 키 정보 DTO입니다
+type TenantAPIKeyResponse struct {
+	ID          string    `json:"id"`
+	Roles       []string  `json:"roles"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ListTenantsResponse는 테넌트 목록 DTO입니다
+type ListTenantsResponse struct {
+	Tenants []TenantResponse `json:"tenants"`
+}
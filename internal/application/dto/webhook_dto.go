@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CreateWebhookSubscriptionRequest는 웹훅 구독 생성 요청 DTO입니다
+type CreateWebhookSubscriptionRequest struct {
+	URL         string                 `json:"url" binding:"required"`
+	Collection  string                 `json:"collection" binding:"required"` // 컬렉션 이름 또는 와일드카드 (*, ?, [...])
+	EventTypes  []string               `json:"event_types"`                   // 비어 있으면 모든 이벤트
+	Filter      map[string]interface{} `json:"filter"`                        // 데이터 필드 조건 (점 표기, 값 일치)
+	Secret      string                 `json:"secret"`                        // 비어 있으면 생성
+	Description string                 `json:"description"`
+	Active      *bool                  `json:"active"` // 기본 true
+}
+
+// UpdateWebhookSubscriptionRequest는 웹훅 구독 수정 요청 DTO입니다 (지정한 필드만 변경)
+type UpdateWebhookSubscriptionRequest struct {
+	URL          *string                 `json:"url"`
+	Collection   *string                 `json:"collection"`
+	EventTypes   *[]string               `json:"event_types"`
+	Filter       *map[string]interface{} `json:"filter"`
+	Description  *string                 `json:"description"`
+	Active       *bool                   `json:"active"`
+	RotateSecret bool                    `json:"rotate_secret"` // true이면 새 서명 키를 생성해 응답에 포함
+}
+
+// WebhookSubscriptionResponse는 웹훅 구독 DTO입니다
+// Secret은 생성하거나 교체했을 때만 포함됩니다
+type WebhookSubscriptionResponse struct {
+	ID          string                 `json:"id"`
+	URL         string                 `json:"url"`
+	Collection  string                 `json:"collection"`
+	EventTypes  []string               `json:"event_types,omitempty"`
+	Filter      map[string]interface{} `json:"filter,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Active      bool                   `json:"active"`
+	Secret      string                 `json:"secret,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+// WebhookSubscriptionListResponse는 웹훅 구독 목록 응답 DTO입니다
+type WebhookSubscriptionListResponse struct {
+	Subscriptions []WebhookSubscriptionResponse `json:"subscriptions"`
+	TotalCount    int                           `json:"total_count"`
+}
+
+// WebhookDeliveryListRequest는 웹훅 전송 로그 조회 요청 DTO입니다
+type WebhookDeliveryListRequest struct {
+	Status   string `form:"status" json:"status"` // pending, delivered, failed
+	Page     int    `form:"page" json:"page"`
+	PageSize int    `form:"page_size" json:"page_size"`
+}
+
+// WebhookDeliveryEntry는 웹훅 전송 기록 DTO입니다
+type WebhookDeliveryEntry struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Collection     string          `json:"collection"`
+	DocumentID     string          `json:"document_id"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+}
+
+// WebhookDeliveryListResponse는 웹훅 전송 로그 응답 DTO입니다
+type WebhookDeliveryListResponse struct {
+	Entries    []WebhookDeliveryEntry `json:"entries"`
+	TotalCount int64                  `json:"total_count"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"page_size"`
+}
@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/anonymize"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AnonymizationJobCancelled는 취소된 익명화 작업 상태입니다 (재개 가능)
+const AnonymizationJobCancelled = "cancelled"
+
+const (
+	maxFinishedAnonymizationJobs  = 50
+	defaultAnonymizationBatchSize = 500
+)
+
+// AnonymizationUseCase는 컬렉션 익명화 작업 유즈케이스입니다
+// 작업은 백그라운드에서 실행되며 상태는 이 인스턴스의 메모리에 보관됩니다 (재시작 후에는 같은 요청으로 새 작업을 시작하면 이어서 처리)
+type AnonymizationUseCase struct {
+	documentUC *DocumentUseCase
+	hashKey    []byte
+	batchSize  int
+	interval   time.Duration
+
+	mu   sync.Mutex
+	jobs map[string]*anonymizationJob
+}
+
+// anonymizationJob은 실행 중이거나 끝난 익명화 작업입니다
+type anonymizationJob struct {
+	anonymizer *anonymize.Anonymizer
+	batchSize  int
+	baseCtx    context.Context // 작업을 시작한 요청의 context 값 (데이터베이스 종류, 감사 로그의 요청자)
+
+	mu     sync.Mutex
+	resp   dto.AnonymizationJobResponse
+	cancel context.CancelFunc
+}
+
+// NewAnonymizationUseCase는 새로운 AnonymizationUseCase를 생성합니다
+// hashKey는 hash 규칙의 HMAC 키이며, batchSize는 기본 배치 크기(0이면 500), interval은 배치 사이 대기 시간입니다
+func NewAnonymizationUseCase(documentUC *DocumentUseCase, hashKey []byte, batchSize int, interval time.Duration) *AnonymizationUseCase {
+	if batchSize <= 0 {
+		batchSize = defaultAnonymizationBatchSize
+	}
+	return &AnonymizationUseCase{
+		documentUC: documentUC,
+		hashKey:    hashKey,
+		batchSize:  batchSize,
+		interval:   interval,
+		jobs:       make(map[string]*anonymizationJob),
+	}
+}
+
+// StartJob은 익명화 작업을 시작하고 작업 상태를 바로 반환합니다
+// 데이터베이스는 요청 context의 데이터베이스 종류(X-Database-Type)를 따릅니다
+func (uc *AnonymizationUseCase) StartJob(ctx context.Context, req *dto.StartAnonymizationRequest) (*dto.AnonymizationJobResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "AnonymizationUseCase.StartJob")
+	defer span.End()
+
+	rules := make([]anonymize.Rule, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = anonymize.Rule{Field: rule.Field, Action: anonymize.Action(rule.Action), Value: rule.Value}
+	}
+	anonymizer, err := anonymize.New(uc.hashKey, rules)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+	}
+
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = uc.batchSize
+	}
+
+	job, err := uc.register(req, anonymizer, batchSize, context.WithoutCancel(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "starting anonymization",
+		zap.String("job_id", job.id()),
+		zap.String("collection", req.Collection),
+		zap.Strings("fields", anonymizer.Fields()),
+	)
+	uc.launch(job)
+	return job.snapshot(), nil
+}
+
+// GetJob은 작업 상태를 반환합니다
+func (uc *AnonymizationUseCase) GetJob(ctx context.Context, jobID string) (*dto.AnonymizationJobResponse, error) {
+	job, err := uc.find(jobID)
+	if err != nil {
+		return nil, err
+	}
+	return job.snapshot(), nil
+}
+
+// ListJobs는 작업 목록을 최근 시작한 순서로 반환합니다 (실패 문서 목록은 제외)
+func (uc *AnonymizationUseCase) ListJobs(ctx context.Context) *dto.ListAnonymizationJobsResponse {
+	uc.mu.Lock()
+	jobs := make([]*dto.AnonymizationJobResponse, 0, len(uc.jobs))
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		snap.Errors = nil
+		jobs = append(jobs, snap)
+	}
+	uc.mu.Unlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return &dto.ListAnonymizationJobsResponse{Jobs: jobs}
+}
+
+// CancelJob은 실행 중인 작업을 현재 문서까지 처리한 뒤 멈춥니다 (ResumeJob으로 재개)
+func (uc *AnonymizationUseCase) CancelJob(ctx context.Context, jobID string) (*dto.AnonymizationJobResponse, error) {
+	job, err := uc.find(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	job.mu.Lock()
+	running := job.resp.Status == MaintenanceJobRunning
+	cancel := job.cancel
+	job.mu.Unlock()
+	if !running {
+		return nil, fmt.Errorf("%w: job %s is not running", entity.ErrInvalidData, jobID)
+	}
+
+	cancel()
+	logger.Info(ctx, "anonymization cancel requested", zap.String("job_id", jobID))
+	return job.snapshot(), nil
+}
+
+// ResumeJob은 취소되었거나 실패한(또는 실패한 문서가 남은) 작업을 같은 규칙으로 다시 실행합니다
+// 컬렉션을 처음부터 다시 순회하지만 이미 익명화된 문서는 쓰지 않으므로 남은 문서만 처리됩니다
+func (uc *AnonymizationUseCase) ResumeJob(ctx context.Context, jobID string) (*dto.AnonymizationJobResponse, error) {
+	job, err := uc.find(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	snap := job.snapshot()
+	switch {
+	case snap.Status == MaintenanceJobRunning:
+		return nil, fmt.Errorf("%w: job %s is already running", entity.ErrInvalidData, jobID)
+	case snap.Status == MaintenanceJobCompleted && snap.Failed == 0:
+		return nil, fmt.Errorf("%w: job %s completed without failures", entity.ErrInvalidData, jobID)
+	}
+	if err := uc.checkRunning(snap.Collection, snap.DatabaseType); err != nil {
+		return nil, err
+	}
+
+	job.update(func(r *dto.AnonymizationJobResponse) {
+		r.Status = MaintenanceJobRunning
+		r.Scanned, r.Unchanged, r.Failed, r.Batches = 0, 0, 0, 0
+		r.Errors, r.Error, r.FinishedAt = nil, "", nil
+	})
+
+	logger.Info(ctx, "resuming anonymization", zap.String("job_id", jobID), zap.Int("run", snap.Runs+1))
+	uc.launch(job)
+	return job.snapshot(), nil
+}
+
+// launch는 작업을 취소 가능한 context로 실행합니다 (요청이 끝나도 작업은 계속 실행)
+func (uc *AnonymizationUseCase) launch(job *anonymizationJob) {
+	ctx, cancel := context.WithCancel(job.baseCtx)
+	job.update(func(r *dto.AnonymizationJobResponse) { r.Runs++ })
+	job.mu.Lock()
+	job.cancel = cancel
+	job.mu.Unlock()
+
+	go uc.run(ctx, cancel, job)
+}
+
+// run은 컬렉션을 익명화하고 결과를 기록합니다
+func (uc *AnonymizationUseCase) run(ctx context.Context, cancel context.CancelFunc, job *anonymizationJob) {
+	defer cancel()
+
+	snap := job.snapshot()
+	err := uc.documentUC.AnonymizeDocuments(ctx, snap.Collection, snap.Filter, job.anonymizer, job.batchSize, uc.interval, func(batch AnonymizationBatch) {
+		job.update(func(r *dto.AnonymizationJobResponse) {
+			r.Scanned += batch.Scanned
+			r.Anonymized += batch.Anonymized
+			r.Unchanged += batch.Unchanged
+			r.Failed += batch.Failed
+			r.Batches++
+			for _, e := range batch.Errors {
+				if len(r.Errors) < maxAnonymizationErrors {
+					r.Errors = append(r.Errors, e)
+				}
+			}
+		})
+	})
+
+	now := time.Now().UTC()
+	job.update(func(r *dto.AnonymizationJobResponse) {
+		r.FinishedAt = &now
+		switch {
+		case err != nil && errors.Is(ctx.Err(), context.Canceled):
+			// 취소되면 커서 오류로 끝날 수도 있으므로 context로 판단
+			r.Status = AnonymizationJobCancelled
+		case err != nil:
+			r.Status = MaintenanceJobFailed
+			r.Error = err.Error()
+		default:
+			r.Status = MaintenanceJobCompleted
+		}
+	})
+
+	snap = job.snapshot()
+	fields := []zap.Field{
+		zap.String("job_id", snap.JobID),
+		zap.String("collection", snap.Collection),
+		zap.String("database_type", snap.DatabaseType),
+		zap.String("status", snap.Status),
+		zap.Int64("scanned", snap.Scanned),
+		zap.Int64("anonymized", snap.Anonymized),
+		zap.Int64("failed", snap.Failed),
+	}
+	if snap.Status == MaintenanceJobFailed {
+		logger.Error(ctx, "anonymization failed", append(fields, zap.Error(err))...)
+		return
+	}
+	logger.Info(ctx, "anonymization finished", fields...)
+}
+
+// register는 새 작업을 등록합니다 (같은 컬렉션에 실행 중인 작업이 있으면 거부)
+func (uc *AnonymizationUseCase) register(req *dto.StartAnonymizationRequest, anonymizer *anonymize.Anonymizer, batchSize int, baseCtx context.Context) (*anonymizationJob, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	dbType := string(middleware.GetDatabaseType(baseCtx))
+	if err := uc.checkRunning(req.Collection, dbType); err != nil {
+		return nil, err
+	}
+
+	// 끝난 작업은 최근 maxFinishedAnonymizationJobs개만 보관
+	var finished []*dto.AnonymizationJobResponse
+	for _, job := range uc.jobs {
+		if snap := job.snapshot(); snap.Status != MaintenanceJobRunning {
+			finished = append(finished, snap)
+		}
+	}
+	if len(finished) >= maxFinishedAnonymizationJobs {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].StartedAt.Before(finished[j].StartedAt)
+		})
+		for _, snap := range finished[:len(finished)-maxFinishedAnonymizationJobs+1] {
+			delete(uc.jobs, snap.JobID)
+		}
+	}
+
+	job := &anonymizationJob{
+		anonymizer: anonymizer,
+		batchSize:  batchSize,
+		baseCtx:    baseCtx,
+		resp: dto.AnonymizationJobResponse{
+			JobID:        uuid.New().String(),
+			Status:       MaintenanceJobRunning,
+			Collection:   req.Collection,
+			DatabaseType: dbType,
+			Filter:       req.Filter,
+			Fields:       anonymizer.Fields(),
+			StartedAt:    time.Now().UTC(),
+		},
+	}
+	uc.jobs[job.resp.JobID] = job
+	return job, nil
+}
+
+// checkRunning은 같은 컬렉션에 실행 중인 작업이 있는지 확인합니다 (uc.mu를 잡고 호출)
+func (uc *AnonymizationUseCase) checkRunning(collection, dbType string) error {
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		if snap.Status == MaintenanceJobRunning && snap.Collection == collection && snap.DatabaseType == dbType {
+			return fmt.Errorf("%w: anonymization %s is already running for collection %s", entity.ErrInvalidData, snap.JobID, collection)
+		}
+	}
+	return nil
+}
+
+func (uc *AnonymizationUseCase) find(jobID string) (*anonymizationJob, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	job, ok := uc.jobs[jobID]
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return job, nil
+}
+
+func (j *anonymizationJob) id() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.resp.JobID
+}
+
+func (j *anonymizationJob) update(fn func(r *dto.AnonymizationJobResponse)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(&j.resp)
+}
+
+func (j *anonymizationJob) snapshot() *dto.AnonymizationJobResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	resp := j.resp
+	resp.Errors = append([]dto.AnonymizationError(nil), j.resp.Errors...)
+	return &resp
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+// AuditUseCase는 컴플라이언스 검토를 위한 감사 로그 조회 유즈케이스입니다
+type AuditUseCase struct {
+	auditRepo repository.AuditRepository
+}
+
+// NewAuditUseCase는 새로운 AuditUseCase를 생성합니다
+func NewAuditUseCase(auditRepo repository.AuditRepository) *AuditUseCase {
+	return &AuditUseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+// QueryAuditLog는 조건에 맞는 감사 기록을 조회합니다
+func (uc *AuditUseCase) QueryAuditLog(ctx context.Context, req *dto.AuditLogQueryRequest) (*dto.AuditLogQueryResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "AuditUseCase.QueryAuditLog")
+	defer span.End()
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("actor", req.Actor),
+		attribute.Int("page", page),
+	)
+
+	query := &repository.AuditQuery{
+		Collection:     req.Collection,
+		DocumentID:     req.DocumentID,
+		Actor:          req.Actor,
+		TenantID:       req.TenantID,
+		ImpersonatedBy: req.ImpersonatedBy,
+		RequestID:      req.RequestID,
+		Operation:      entity.AuditOperation(req.Operation),
+		From:           req.From,
+		To:             req.To,
+		Limit:          int64(pageSize),
+		Skip:           int64((page - 1) * pageSize),
+	}
+
+	entries, total, err := uc.auditRepo.Query(ctx, query)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to query audit log", zap.Error(err))
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+
+	items := make([]dto.AuditLogEntry, len(entries))
+	for i, e := range entries {
+		items[i] = dto.AuditLogEntry{
+			ID:                 e.ID,
+			Timestamp:          e.Timestamp,
+			Operation:          string(e.Operation),
+			DatabaseType:       e.DatabaseType,
+			Collection:         e.Collection,
+			DocumentID:         e.DocumentID,
+			Actor:              e.Actor,
+			ActorName:          e.ActorName,
+			TenantID:           e.TenantID,
+			ImpersonatedBy:     e.ImpersonatedBy,
+			ImpersonatedByName: e.ImpersonatedByName,
+			RequestID:          e.RequestID,
+			Filter:             e.Filter,
+			Before:             e.Before,
+			After:              e.After,
+			BeforeVersion:      e.BeforeVersion,
+			AfterVersion:       e.AfterVersion,
+			AffectedCount:      e.AffectedCount,
+			Success:            e.Success,
+			Error:              e.Error,
+		}
+	}
+
+	return &dto.AuditLogQueryResponse{
+		Entries:    items,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+// RecordLockout은 인증 실패 잠금 보안 이벤트를 감사 로그에 기록합니다
+// 요청이 끝나도 기록이 유실되지 않도록 취소 신호와 분리된 컨텍스트를 사용합니다
+func (uc *AuditUseCase) RecordLockout(ctx context.Context, event lockout.Event) {
+	entry := &entity.AuditEntry{
+		Timestamp: time.Now().UTC(),
+		Operation: entity.AuditOpAuthLockout,
+		Actor:     event.Key,
+		RequestID: logger.RequestIDFromContext(ctx),
+		After: map[string]interface{}{
+			"source":             event.Source,
+			"failures":           event.Failures,
+			"level":              event.Level,
+			"locked_for_seconds": int64(event.LockedFor.Seconds()),
+		},
+		Success: false,
+		Error:   "authentication locked out after repeated failures",
+	}
+
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+	defer cancel()
+
+	if err := uc.auditRepo.Append(writeCtx, entry); err != nil {
+		logger.Error(ctx, "failed to write security audit entry",
+			zap.String("operation", string(entry.Operation)),
+			zap.String("key", event.Key),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,488 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/backup"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRestoreBatchSize = 500
+	maxFinishedBackupJobs   = 100
+)
+
+// 백업/복원 작업 종류와 상태
+const (
+	BackupJobTypeBackup      = "backup"
+	BackupJobTypeRestore     = "restore"
+	BackupJobTypePointInTime = "pitr"
+
+	BackupJobRunning   = "running"
+	BackupJobCompleted = "completed"
+	BackupJobFailed    = "failed"
+)
+
+// BackupOptions는 백업/복원 동작 설정입니다
+type BackupOptions struct {
+	// BatchSize는 복원 시 SaveMany 한 번에 저장할 문서 수입니다 (0이면 500)
+	BatchSize int
+
+	// Consistent이면 트랜잭션을 지원하는 데이터베이스(MongoDB, PostgreSQL, MySQL, Vitess)에서
+	// 읽기 전용 repeatable read 트랜잭션 하나로 컬렉션을 읽어 시점이 일관된 스냅샷을 만듭니다
+	Consistent bool
+}
+
+// BackupUseCase는 컬렉션 백업/복원 유즈케이스입니다
+// 작업은 백그라운드에서 실행되며 진행 상황은 이 인스턴스의 메모리에 보관됩니다 (재시작 시 초기화)
+type BackupUseCase struct {
+	repoManager  *persistence.RepositoryManager
+	store        backup.Store
+	opts         BackupOptions
+	eventScanner messaging.EventScanner // 시점 복원용 CDC 이벤트 저장소 (선택)
+
+	mu   sync.Mutex
+	jobs map[string]*backupJob
+}
+
+// backupJob은 실행 중이거나 끝난 백업/복원 작업입니다
+type backupJob struct {
+	mu   sync.Mutex
+	resp dto.BackupJobResponse
+}
+
+// NewBackupUseCase는 새로운 BackupUseCase를 생성합니다
+func NewBackupUseCase(repoManager *persistence.RepositoryManager, store backup.Store, opts BackupOptions) *BackupUseCase {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultRestoreBatchSize
+	}
+	return &BackupUseCase{
+		repoManager: repoManager,
+		store:       store,
+		opts:        opts,
+		jobs:        make(map[string]*backupJob),
+	}
+}
+
+// StartBackup은 컬렉션 백업 작업을 시작하고 작업 상태를 바로 반환합니다
+// 데이터베이스는 요청 context의 데이터베이스 종류(X-Database-Type)를 따릅니다
+func (uc *BackupUseCase) StartBackup(ctx context.Context, req *dto.StartBackupRequest) (*dto.BackupJobResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "BackupUseCase.StartBackup")
+	defer span.End()
+
+	dbType := string(middleware.GetDatabaseType(ctx))
+	docRepo, err := uc.repoManager.GetRepository(dbType)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	exists, err := docRepo.CollectionExists(ctx, req.Collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: collection %s does not exist", entity.ErrInvalidData, req.Collection)
+	}
+
+	backupID := fmt.Sprintf("%s-%s-%s", req.Collection, time.Now().UTC().Format("20060102T150405Z"), uuid.New().String()[:8])
+	job, err := uc.register(BackupJobTypeBackup, backupID, req.Collection, dbType)
+	if err != nil {
+		return nil, err
+	}
+	job.update(func(r *dto.BackupJobResponse) {
+		r.Location = uc.store.Location(backup.ManifestKey(backupID))
+	})
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("backup_id", backupID),
+	)
+	logger.Info(ctx, "starting collection backup",
+		zap.String("job_id", job.id()),
+		zap.String("backup_id", backupID),
+		zap.String("collection", req.Collection),
+		zap.String("database_type", dbType),
+	)
+
+	// 요청이 끝나도 작업은 계속 실행 (context 값은 유지)
+	go uc.runBackup(context.WithoutCancel(ctx), job, docRepo)
+
+	return job.snapshot(), nil
+}
+
+// StartRestore는 백업을 컬렉션으로 복원하는 작업을 시작하고 작업 상태를 바로 반환합니다
+func (uc *BackupUseCase) StartRestore(ctx context.Context, req *dto.StartRestoreRequest) (*dto.BackupJobResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "BackupUseCase.StartRestore")
+	defer span.End()
+
+	manifest, err := backup.ReadManifest(ctx, uc.store, req.BackupID)
+	if errors.Is(err, backup.ErrNotFound) {
+		return nil, fmt.Errorf("%w: backup %s", entity.ErrDocumentNotFound, req.BackupID)
+	}
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	dbType := string(middleware.GetDatabaseType(ctx))
+	docRepo, err := uc.repoManager.GetRepository(dbType)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	target := req.TargetCollection
+	if target == "" {
+		target = manifest.Collection
+	}
+
+	if !req.DropTarget {
+		if err := checkRestoreTargetEmpty(ctx, docRepo, target); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, err
+		}
+	}
+
+	job, err := uc.register(BackupJobTypeRestore, manifest.BackupID, target, dbType)
+	if err != nil {
+		return nil, err
+	}
+	job.update(func(r *dto.BackupJobResponse) {
+		r.Location = uc.store.Location(manifest.DocumentsKey)
+		r.Total = manifest.Documents
+	})
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", target),
+		attribute.String("backup_id", manifest.BackupID),
+	)
+	logger.Info(ctx, "starting collection restore",
+		zap.String("job_id", job.id()),
+		zap.String("backup_id", manifest.BackupID),
+		zap.String("source_collection", manifest.Collection),
+		zap.String("target_collection", target),
+		zap.String("database_type", dbType),
+		zap.Bool("drop_target", req.DropTarget),
+	)
+
+	go uc.runRestore(context.WithoutCancel(ctx), job, docRepo, manifest, req.DropTarget, nil)
+
+	return job.snapshot(), nil
+}
+
+// GetJob은 작업 상태를 반환합니다
+func (uc *BackupUseCase) GetJob(ctx context.Context, jobID string) (*dto.BackupJobResponse, error) {
+	uc.mu.Lock()
+	job, ok := uc.jobs[jobID]
+	uc.mu.Unlock()
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return job.snapshot(), nil
+}
+
+// ListJobs는 작업 목록을 최근 시작한 순서로 반환합니다
+func (uc *BackupUseCase) ListJobs(ctx context.Context) *dto.ListBackupJobsResponse {
+	uc.mu.Lock()
+	jobs := make([]*dto.BackupJobResponse, 0, len(uc.jobs))
+	for _, job := range uc.jobs {
+		jobs = append(jobs, job.snapshot())
+	}
+	uc.mu.Unlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return &dto.ListBackupJobsResponse{Jobs: jobs}
+}
+
+// runBackup은 컬렉션을 커서로 읽어 백업 저장소에 씁니다
+func (uc *BackupUseCase) runBackup(ctx context.Context, job *backupJob, docRepo repository.DocumentRepository) {
+	resp := job.snapshot()
+	consistent := uc.opts.Consistent && supportsSnapshotBackup(resp.DatabaseType)
+
+	if total, err := docRepo.EstimatedDocumentCount(ctx, resp.Collection); err == nil {
+		job.update(func(r *dto.BackupJobResponse) { r.Total = total })
+	}
+
+	writer, err := backup.NewSnapshotWriter(ctx, uc.store, backup.Manifest{
+		BackupID:     resp.BackupID,
+		Collection:   resp.Collection,
+		DatabaseType: resp.DatabaseType,
+		Consistent:   consistent,
+		StartedAt:    resp.StartedAt,
+	})
+	if err != nil {
+		uc.finish(ctx, job, err)
+		return
+	}
+
+	copyAll := func(ctx context.Context) error {
+		it, err := docRepo.FindStream(ctx, resp.Collection, map[string]interface{}{}, &repository.FindOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to open cursor: %w", err)
+		}
+		defer it.Close(ctx)
+
+		for it.Next(ctx) {
+			doc, err := it.Decode()
+			if err != nil {
+				return err
+			}
+			if err := writer.Write(doc); err != nil {
+				return err
+			}
+			count := writer.Count()
+			job.update(func(r *dto.BackupJobResponse) { r.Processed = count })
+		}
+		return it.Err()
+	}
+
+	if consistent {
+		err = docRepo.WithTransaction(ctx, &repository.TxOptions{
+			Isolation: repository.IsolationRepeatableRead,
+			ReadOnly:  true,
+		}, copyAll)
+	} else {
+		err = copyAll(ctx)
+	}
+	if err != nil {
+		writer.Abort()
+		uc.finish(ctx, job, err)
+		return
+	}
+
+	_, err = writer.Commit(ctx)
+	uc.finish(ctx, job, err)
+}
+
+// runRestore는 백업 문서를 배치 단위로 대상 컬렉션에 저장합니다
+// states가 있으면(시점 복원) 해당 문서는 백업 대신 CDC 이벤트의 최종 상태로 저장하고, 삭제된 문서는 저장하지 않습니다
+func (uc *BackupUseCase) runRestore(ctx context.Context, job *backupJob, docRepo repository.DocumentRepository, manifest *backup.Manifest, dropTarget bool, states map[string]*pitrState) {
+	target := job.snapshot().Collection
+
+	reader, err := backup.OpenSnapshot(ctx, uc.store, manifest)
+	if err != nil {
+		uc.finish(ctx, job, err)
+		return
+	}
+	defer reader.Close()
+
+	if err := prepareRestoreTarget(ctx, docRepo, target, dropTarget); err != nil {
+		uc.finish(ctx, job, err)
+		return
+	}
+
+	var restored int64
+	batch := make([]*entity.Document, 0, uc.opts.BatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := docRepo.SaveMany(ctx, batch); err != nil {
+			return fmt.Errorf("failed to restore documents: %w", err)
+		}
+		restored += int64(len(batch))
+		job.update(func(r *dto.BackupJobResponse) { r.Processed = restored })
+		batch = batch[:0]
+		return nil
+	}
+	add := func(doc *entity.Document) error {
+		batch = append(batch, doc)
+		if len(batch) >= uc.opts.BatchSize {
+			return flush()
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for {
+		doc, err := reader.Next(target)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			uc.finish(ctx, job, err)
+			return
+		}
+		if state, ok := states[doc.ID()]; ok {
+			seen[doc.ID()] = true
+			if doc = state.document(doc.ID(), target, doc.CreatedAt()); doc == nil {
+				continue
+			}
+		}
+		if err := add(doc); err != nil {
+			uc.finish(ctx, job, err)
+			return
+		}
+	}
+
+	// 백업 이후 생성된 문서
+	for id, state := range states {
+		if seen[id] {
+			continue
+		}
+		if doc := state.document(id, target, time.Time{}); doc != nil {
+			if err := add(doc); err != nil {
+				uc.finish(ctx, job, err)
+				return
+			}
+		}
+	}
+	uc.finish(ctx, job, flush())
+}
+
+// prepareRestoreTarget은 복원할 컬렉션을 준비합니다 (dropTarget이면 삭제 후 다시 생성)
+func prepareRestoreTarget(ctx context.Context, docRepo repository.DocumentRepository, target string, dropTarget bool) error {
+	exists, err := docRepo.CollectionExists(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to check collection: %w", err)
+	}
+	if exists && dropTarget {
+		if err := docRepo.DropCollection(ctx, target); err != nil {
+			return fmt.Errorf("failed to drop target collection: %w", err)
+		}
+		exists = false
+	}
+	if !exists {
+		if err := docRepo.CreateCollection(ctx, target); err != nil {
+			return fmt.Errorf("failed to create target collection: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkRestoreTargetEmpty는 대상 컬렉션이 없거나 비어 있는지 확인합니다
+// 대상 컬렉션을 비우지 않으면 기존 문서와 섞이지 않도록 빈 컬렉션에만 복원합니다
+func checkRestoreTargetEmpty(ctx context.Context, docRepo repository.DocumentRepository, target string) error {
+	exists, err := docRepo.CollectionExists(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+	count, err := docRepo.Count(ctx, target, map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to count documents: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: target collection %s is not empty (set drop_target to replace it)", entity.ErrInvalidData, target)
+	}
+	return nil
+}
+
+// register는 새 작업을 등록합니다 (같은 컬렉션에 실행 중인 작업이 있으면 거부)
+func (uc *BackupUseCase) register(jobType, backupID, collection, dbType string) (*backupJob, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	var finished []*dto.BackupJobResponse
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		if snap.Status == BackupJobRunning && snap.Collection == collection && snap.DatabaseType == dbType {
+			return nil, fmt.Errorf("%w: job %s is already running for collection %s", entity.ErrInvalidData, snap.JobID, collection)
+		}
+		if snap.Status != BackupJobRunning {
+			finished = append(finished, snap)
+		}
+	}
+
+	// 끝난 작업은 최근 maxFinishedBackupJobs개만 보관
+	if len(finished) >= maxFinishedBackupJobs {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].StartedAt.Before(finished[j].StartedAt)
+		})
+		for _, snap := range finished[:len(finished)-maxFinishedBackupJobs+1] {
+			delete(uc.jobs, snap.JobID)
+		}
+	}
+
+	job := &backupJob{resp: dto.BackupJobResponse{
+		JobID:        uuid.New().String(),
+		Type:         jobType,
+		Status:       BackupJobRunning,
+		BackupID:     backupID,
+		Collection:   collection,
+		DatabaseType: dbType,
+		StartedAt:    time.Now().UTC(),
+	}}
+	uc.jobs[job.resp.JobID] = job
+	return job, nil
+}
+
+// finish는 작업을 완료 또는 실패로 기록합니다
+func (uc *BackupUseCase) finish(ctx context.Context, job *backupJob, err error) {
+	now := time.Now().UTC()
+	job.update(func(r *dto.BackupJobResponse) {
+		r.FinishedAt = &now
+		if err != nil {
+			r.Status = BackupJobFailed
+			r.Error = err.Error()
+			return
+		}
+		r.Status = BackupJobCompleted
+	})
+
+	snap := job.snapshot()
+	fields := []zap.Field{
+		zap.String("job_id", snap.JobID),
+		zap.String("type", snap.Type),
+		zap.String("backup_id", snap.BackupID),
+		zap.String("collection", snap.Collection),
+		zap.Int64("processed", snap.Processed),
+		zap.Duration("duration", now.Sub(snap.StartedAt)),
+	}
+	if err != nil {
+		logger.Error(ctx, "backup job failed", append(fields, zap.Error(err))...)
+		return
+	}
+	logger.Info(ctx, "backup job completed", fields...)
+}
+
+func (j *backupJob) id() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.resp.JobID
+}
+
+func (j *backupJob) update(fn func(r *dto.BackupJobResponse)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(&j.resp)
+}
+
+func (j *backupJob) snapshot() *dto.BackupJobResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	resp := j.resp
+	return &resp
+}
+
+// supportsSnapshotBackup은 읽기 전용 트랜잭션으로 일관된 스냅샷을 읽을 수 있는 데이터베이스인지 확인합니다
+func supportsSnapshotBackup(dbType string) bool {
+	switch dbType {
+	case "mongodb", "postgresql", "mysql", "vitess":
+		return true
+	default:
+		return false
+	}
+}
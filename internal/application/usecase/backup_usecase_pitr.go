@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/backup"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// pitrClockSkew는 백업 시작 시각과 CDC 이벤트 시각의 시계 오차 여유입니다
+// 여유 구간의 이벤트는 백업에 이미 반영되어 있어도 최종 상태만 적용하므로 다시 적용해도 결과가 같습니다
+const pitrClockSkew = time.Minute
+
+// pitrState는 CDC 이벤트를 적용한 문서의 최종 상태입니다
+type pitrState struct {
+	event     *messaging.DocumentEvent
+	createdAt time.Time // 마지막 document.created 이벤트 시각 (없으면 백업의 생성 시각 사용)
+}
+
+// pitrPlan은 시점 복원에 사용할 백업과 문서별 최종 상태입니다
+type pitrPlan struct {
+	manifest *backup.Manifest
+	states   map[string]*pitrState
+	preview  *dto.PointInTimeRestorePreview
+}
+
+// SetEventScanner는 시점 복원에 사용할 CDC 이벤트 저장소를 설정합니다 (없으면 시점 복원 비활성화)
+func (uc *BackupUseCase) SetEventScanner(scanner messaging.EventScanner) {
+	uc.eventScanner = scanner
+}
+
+// PointInTimeRestore는 컬렉션을 req.Timestamp 시점의 상태로 복원합니다
+// timestamp 이전에 완료된 가장 최근 백업을 복원하고, 백업 시작 이후 timestamp까지의 CDC 이벤트를
+// 문서별 최종 상태로 줄여 덮어씁니다. dry_run이면 복원하지 않고 영향받는 문서 수만 반환합니다
+// CDC 이벤트가 문서 전체를 담고 있어야 하므로 cdc.transforms로 필드를 제거한 컬렉션에는 사용할 수 없습니다
+func (uc *BackupUseCase) PointInTimeRestore(ctx context.Context, req *dto.PointInTimeRestoreRequest) (*dto.PointInTimeRestoreResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "BackupUseCase.PointInTimeRestore")
+	defer span.End()
+
+	if uc.eventScanner == nil {
+		return nil, fmt.Errorf("%w: point-in-time restore requires cdc replay to be enabled", entity.ErrInvalidData)
+	}
+	if req.Timestamp.After(time.Now()) {
+		return nil, fmt.Errorf("%w: timestamp is in the future", entity.ErrInvalidData)
+	}
+
+	dbType := string(middleware.GetDatabaseType(ctx))
+	docRepo, err := uc.repoManager.GetRepository(dbType)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	target := req.TargetCollection
+	if target == "" {
+		target = req.Collection
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("target_collection", target),
+		attribute.Bool("dry_run", req.DryRun),
+	)
+
+	plan, err := uc.planPointInTime(ctx, req.Collection, req.Timestamp)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if req.DryRun {
+		return &dto.PointInTimeRestoreResponse{DryRun: true, Preview: plan.preview}, nil
+	}
+
+	if !req.DropTarget {
+		if err := checkRestoreTargetEmpty(ctx, docRepo, target); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, err
+		}
+	}
+
+	job, err := uc.register(BackupJobTypePointInTime, plan.manifest.BackupID, target, dbType)
+	if err != nil {
+		return nil, err
+	}
+	job.update(func(r *dto.BackupJobResponse) {
+		r.Location = uc.store.Location(plan.manifest.DocumentsKey)
+		r.Total = plan.manifest.Documents + int64(plan.preview.Upserted)
+	})
+
+	logger.Info(ctx, "starting point-in-time restore",
+		zap.String("job_id", job.id()),
+		zap.String("backup_id", plan.manifest.BackupID),
+		zap.String("source_collection", req.Collection),
+		zap.String("target_collection", target),
+		zap.Time("timestamp", req.Timestamp),
+		zap.Int("affected_documents", plan.preview.AffectedDocuments),
+		zap.Bool("drop_target", req.DropTarget),
+	)
+
+	go uc.runRestore(context.WithoutCancel(ctx), job, docRepo, plan.manifest, req.DropTarget, plan.states)
+
+	return &dto.PointInTimeRestoreResponse{Preview: plan.preview, Job: job.snapshot()}, nil
+}
+
+// planPointInTime은 timestamp 이전의 가장 최근 백업을 고르고 그 이후의 CDC 이벤트를 문서별 최종 상태로 줄입니다
+func (uc *BackupUseCase) planPointInTime(ctx context.Context, collection string, timestamp time.Time) (*pitrPlan, error) {
+	manifests, err := backup.ListManifests(ctx, uc.store, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	var manifest *backup.Manifest
+	for _, m := range manifests {
+		if !m.SnapshotTime().After(timestamp) && (manifest == nil || m.SnapshotTime().After(manifest.SnapshotTime())) {
+			manifest = m
+		}
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: no backup of collection %s was taken before %s", entity.ErrInvalidData, collection, timestamp.Format(time.RFC3339))
+	}
+
+	states := make(map[string]*pitrState)
+	result, err := uc.eventScanner.ScanEvents(ctx, &messaging.ReplayRequest{
+		Collection:  collection,
+		From:        manifest.StartedAt.Add(-pitrClockSkew),
+		To:          timestamp,
+		RequireFrom: true,
+	}, func(event *messaging.DocumentEvent) error {
+		applyPointInTimeEvent(states, event)
+		return nil
+	})
+	if errors.Is(err, messaging.ErrLogTruncated) {
+		return nil, fmt.Errorf("%w: cdc events after backup %s are no longer retained", entity.ErrInvalidData, manifest.BackupID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cdc events: %w", err)
+	}
+
+	preview := &dto.PointInTimeRestorePreview{
+		BackupID:          manifest.BackupID,
+		SnapshotTime:      manifest.SnapshotTime(),
+		Timestamp:         timestamp,
+		SnapshotDocuments: manifest.Documents,
+		EventsScanned:     result.Scanned,
+		EventsApplied:     result.Matched,
+		AffectedDocuments: len(states),
+	}
+	for _, state := range states {
+		if state.event.EventType == messaging.EventDocumentDeleted {
+			preview.Deleted++
+		} else {
+			preview.Upserted++
+		}
+	}
+
+	return &pitrPlan{manifest: manifest, states: states, preview: preview}, nil
+}
+
+// applyPointInTimeEvent는 이벤트가 문서의 기존 상태보다 나중이면 최종 상태로 기록합니다
+// 파티션 사이의 순서는 보장되지 않으므로 이벤트 시각, 같으면 버전으로 비교하고 그래도 같으면 삭제를 우선합니다
+func applyPointInTimeEvent(states map[string]*pitrState, event *messaging.DocumentEvent) {
+	state, ok := states[event.DocumentID]
+	if !ok {
+		state = &pitrState{}
+		states[event.DocumentID] = state
+	}
+	if event.EventType == messaging.EventDocumentCreated && event.Timestamp.After(state.createdAt) {
+		state.createdAt = event.Timestamp
+	}
+	if state.event == nil || isLaterEvent(event, state.event) {
+		state.event = event
+	}
+}
+
+func isLaterEvent(event, current *messaging.DocumentEvent) bool {
+	if !event.Timestamp.Equal(current.Timestamp) {
+		return event.Timestamp.After(current.Timestamp)
+	}
+	if event.Version != current.Version {
+		return event.Version > current.Version
+	}
+	return event.EventType == messaging.EventDocumentDeleted
+}
+
+// document는 최종 상태를 collection 문서로 반환합니다 (삭제된 문서면 nil)
+// snapshotCreatedAt은 백업에 들어 있던 문서의 생성 시각입니다 (백업에 없던 문서면 zero)
+func (s *pitrState) document(id, collection string, snapshotCreatedAt time.Time) *entity.Document {
+	if s.event.EventType == messaging.EventDocumentDeleted {
+		return nil
+	}
+	createdAt := s.createdAt
+	if createdAt.IsZero() {
+		createdAt = snapshotCreatedAt
+	}
+	if createdAt.IsZero() {
+		createdAt = s.event.Timestamp
+	}
+	return entity.ReconstructDocument(id, collection, s.event.Data, s.event.Version, createdAt, s.event.Timestamp)
+}
+
+// RunSchedule은 interval마다 collections를 백업합니다 (ctx가 취소될 때까지 실행)
+// 시점 복원은 복원 시각 이전의 백업부터 CDC 이벤트를 적용하므로, interval은 CDC 토픽 보존 기간보다 짧아야 합니다
+func (uc *BackupUseCase) RunSchedule(ctx context.Context, interval time.Duration, dbType string, collections []string) {
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	ctx = context.WithValue(ctx, middleware.DatabaseTypeContextKey, middleware.DatabaseType(dbType))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, collection := range collections {
+			if _, err := uc.StartBackup(ctx, &dto.StartBackupRequest{Collection: collection}); err != nil {
+				logger.Warn(ctx, "scheduled backup failed to start",
+					zap.String("collection", collection),
+					zap.String("database_type", dbType),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const defaultReplayLimit = 10000
+
+// CDCReplayUseCase는 다운스트림 읽기 모델 재구축을 위한 CDC 이벤트 재생 유즈케이스입니다
+type CDCReplayUseCase struct {
+	replayer messaging.Replayer
+	maxLimit int
+}
+
+// NewCDCReplayUseCase는 새로운 CDCReplayUseCase를 생성합니다
+// maxLimit은 한 번에 재생할 수 있는 최대 이벤트 수입니다 (0 이하이면 기본값)
+func NewCDCReplayUseCase(replayer messaging.Replayer, maxLimit int) *CDCReplayUseCase {
+	if maxLimit <= 0 {
+		maxLimit = defaultReplayLimit
+	}
+	return &CDCReplayUseCase{
+		replayer: replayer,
+		maxLimit: maxLimit,
+	}
+}
+
+// Replay는 컬렉션의 CDC 이벤트를 지정한 시각/오프셋부터 다시 발행합니다
+func (uc *CDCReplayUseCase) Replay(ctx context.Context, req *dto.CDCReplayRequest) (*dto.CDCReplayResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "CDCReplayUseCase.Replay")
+	defer span.End()
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.Bool("dry_run", req.DryRun),
+	)
+
+	if req.FromTimestamp == nil && req.FromOffset == nil {
+		return nil, fmt.Errorf("%w: from_timestamp or from_offset is required", entity.ErrInvalidData)
+	}
+	if req.FromTimestamp != nil && req.ToTimestamp != nil && req.ToTimestamp.Before(*req.FromTimestamp) {
+		return nil, fmt.Errorf("%w: to_timestamp must not be before from_timestamp", entity.ErrInvalidData)
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > uc.maxLimit {
+		limit = uc.maxLimit
+	}
+
+	replayReq := &messaging.ReplayRequest{
+		ReplayID:    uuid.New().String(),
+		Collection:  req.Collection,
+		FromOffset:  -1,
+		TargetTopic: req.TargetTopic,
+		Limit:       limit,
+		DryRun:      req.DryRun,
+	}
+	if req.FromTimestamp != nil {
+		replayReq.From = *req.FromTimestamp
+	} else {
+		replayReq.FromOffset = *req.FromOffset
+	}
+	if req.ToTimestamp != nil {
+		replayReq.To = *req.ToTimestamp
+	}
+
+	logger.Info(ctx, "replaying cdc events",
+		zap.String("replay_id", replayReq.ReplayID),
+		zap.String("collection", req.Collection),
+		zap.Time("from", replayReq.From),
+		zap.Int64("from_offset", replayReq.FromOffset),
+		zap.String("target_topic", req.TargetTopic),
+		zap.Int("limit", limit),
+		zap.Bool("dry_run", req.DryRun),
+	)
+
+	result, err := uc.replayer.Replay(ctx, replayReq)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to replay cdc events: %w", err)
+	}
+
+	resp := &dto.CDCReplayResponse{
+		ReplayID:  replayReq.ReplayID,
+		Scanned:   result.Scanned,
+		Matched:   result.Matched,
+		Replayed:  result.Replayed,
+		DryRun:    req.DryRun,
+		Truncated: result.Truncated,
+	}
+	if result.Truncated && !result.Next.IsZero() {
+		next := result.Next
+		resp.NextTimestamp = &next
+	}
+	return resp, nil
+}
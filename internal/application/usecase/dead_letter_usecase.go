@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDeadLetterPageSize = 50
+	maxDeadLetterPageSize     = 500
+	defaultRedriveLimit       = 100
+	maxRedriveLimit           = 1000
+)
+
+// DeadLetterRedriver는 DLQ 이벤트를 다시 발행합니다 (messaging.DeadLetterPublisher가 구현)
+type DeadLetterRedriver interface {
+	Redrive(ctx context.Context, event *entity.DeadLetterEvent) error
+}
+
+// DeadLetterUseCase는 발행에 실패한 CDC 이벤트 조회/재발행 유즈케이스입니다
+type DeadLetterUseCase struct {
+	repo     repository.DeadLetterRepository
+	redriver DeadLetterRedriver
+}
+
+// NewDeadLetterUseCase는 새로운 DeadLetterUseCase를 생성합니다
+func NewDeadLetterUseCase(repo repository.DeadLetterRepository, redriver DeadLetterRedriver) *DeadLetterUseCase {
+	return &DeadLetterUseCase{
+		repo:     repo,
+		redriver: redriver,
+	}
+}
+
+// ListDeadLetters는 DLQ 이벤트를 오래된 순으로 조회합니다
+func (uc *DeadLetterUseCase) ListDeadLetters(ctx context.Context, req *dto.DeadLetterListRequest) (*dto.DeadLetterListResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DeadLetterUseCase.ListDeadLetters")
+	defer span.End()
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultDeadLetterPageSize
+	}
+	if pageSize > maxDeadLetterPageSize {
+		pageSize = maxDeadLetterPageSize
+	}
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	events, total, err := uc.repo.List(ctx, &repository.DeadLetterQuery{
+		Collection: req.Collection,
+		EventType:  req.EventType,
+		Limit:      int64(pageSize),
+		Skip:       int64((page - 1) * pageSize),
+	})
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to list dead letter events: %w", err)
+	}
+
+	entries := make([]dto.DeadLetterEntry, len(events))
+	for i, e := range events {
+		entries[i] = toDeadLetterEntry(e)
+	}
+
+	return &dto.DeadLetterListResponse{
+		Entries:    entries,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+// RedriveDeadLetter는 DLQ 이벤트 하나를 다시 발행하고, 성공하면 DLQ에서 제거합니다
+func (uc *DeadLetterUseCase) RedriveDeadLetter(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "DeadLetterUseCase.RedriveDeadLetter")
+	defer span.End()
+	tracing.SetAttributes(ctx, attribute.String("dead_letter_id", id))
+
+	event, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return uc.redrive(ctx, event)
+}
+
+// RedriveDeadLetters는 조건에 맞는 DLQ 이벤트를 오래된 순으로 다시 발행합니다
+// 같은 문서의 이벤트 순서를 지키기 위해 하나씩 순차적으로 발행합니다
+func (uc *DeadLetterUseCase) RedriveDeadLetters(ctx context.Context, req *dto.DeadLetterRedriveRequest) (*dto.DeadLetterRedriveResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DeadLetterUseCase.RedriveDeadLetters")
+	defer span.End()
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRedriveLimit
+	}
+	if limit > maxRedriveLimit {
+		limit = maxRedriveLimit
+	}
+
+	events, _, err := uc.repo.List(ctx, &repository.DeadLetterQuery{
+		Collection: req.Collection,
+		EventType:  req.EventType,
+		Limit:      int64(limit),
+	})
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to list dead letter events: %w", err)
+	}
+
+	resp := &dto.DeadLetterRedriveResponse{}
+	for _, event := range events {
+		if err := uc.redrive(ctx, event); err != nil {
+			resp.Failed++
+			resp.FailedIDs = append(resp.FailedIDs, event.ID)
+			continue
+		}
+		resp.Redriven++
+	}
+
+	logger.Info(ctx, "dead letter events redriven",
+		zap.Int("redriven", resp.Redriven),
+		zap.Int("failed", resp.Failed),
+	)
+	return resp, nil
+}
+
+// DiscardDeadLetter는 DLQ 이벤트를 재발행하지 않고 삭제합니다
+func (uc *DeadLetterUseCase) DiscardDeadLetter(ctx context.Context, id string) error {
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	logger.Info(ctx, "dead letter event discarded", zap.String("dead_letter_id", id))
+	return nil
+}
+
+func (uc *DeadLetterUseCase) redrive(ctx context.Context, event *entity.DeadLetterEvent) error {
+	if err := uc.redriver.Redrive(ctx, event); err != nil {
+		logger.Warn(ctx, "failed to redrive dead letter event",
+			zap.String("dead_letter_id", event.ID),
+			zap.Error(err),
+		)
+		if recordErr := uc.repo.RecordRedriveFailure(ctx, event.ID, err.Error()); recordErr != nil {
+			logger.Error(ctx, "failed to record redrive failure", zap.Error(recordErr))
+		}
+		return fmt.Errorf("failed to redrive event: %w", err)
+	}
+
+	if err := uc.repo.Delete(ctx, event.ID); err != nil {
+		// 발행은 되었으므로 다음 재발행 때 중복될 수 있습니다 (소비자는 event_id/version으로 멱등 처리)
+		return fmt.Errorf("event redriven but failed to remove from dead letter queue: %w", err)
+	}
+	return nil
+}
+
+func toDeadLetterEntry(e *entity.DeadLetterEvent) dto.DeadLetterEntry {
+	entry := dto.DeadLetterEntry{
+		ID:              e.ID,
+		EventType:       e.EventType,
+		DocumentID:      e.DocumentID,
+		Collection:      e.Collection,
+		Data:            e.Data,
+		Version:         e.Version,
+		PreviousVersion: e.PreviousVersion,
+		Changes:         e.Changes,
+		Error:           e.Error,
+		Attempts:        e.Attempts,
+		FailedAt:        e.FailedAt,
+	}
+	if !e.LastRedriveAt.IsZero() {
+		lastRedriveAt := e.LastRedriveAt
+		entry.LastRedriveAt = &lastRedriveAt
+	}
+	return entry
+}
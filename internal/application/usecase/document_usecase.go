@@ -2,32 +2,72 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/application/dto"
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/archive"
 	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
 	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
 	"github.com/YouSangSon/database-service/internal/pkg/circuitbreaker"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/YouSangSon/database-service/internal/pkg/jsonschema"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/pii"
+	"github.com/YouSangSon/database-service/internal/pkg/retention"
 	"github.com/YouSangSon/database-service/internal/pkg/retry"
 	"github.com/YouSangSon/database-service/internal/pkg/tracing"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 )
 
 // DocumentUseCase는 문서 관련 유즈케이스입니다
 type DocumentUseCase struct {
-	docRepo        repository.DocumentRepository
-	repoManager    *persistence.RepositoryManager // For multi-database support
-	cacheRepo      repository.CacheRepository
-	metrics        *metrics.Metrics
-	circuitBreaker *circuitbreaker.CircuitBreaker
-	retryConfig    retry.Config
+	docRepo            repository.DocumentRepository
+	repoManager        *persistence.RepositoryManager // For multi-database support
+	cacheRepo          repository.CacheRepository
+	metrics            *metrics.Metrics
+	circuitBreakers    *circuitbreaker.Registry
+	retryConfig        retry.Config
+	retryBudgets       *retry.Budgets
+	retryClassifiers   map[string]func(err error) bool
+	auditRepo          repository.AuditRepository
+	cachePolicies      *CachePolicies
+	cacheWriteQueue    repository.CacheWriteQueue
+	negativeCacheTTL   atomic.Int64 // time.Duration (설정 다시 읽기로 실행 중에 바뀜)
+	queryCacheTTL      atomic.Int64 // time.Duration (설정 다시 읽기로 실행 중에 바뀜)
+	loadGroup          singleflight.Group
+	earlyRefresh       float64
+	lastLoadNanos      atomic.Int64
+	rowPolicies        *auth.RowPolicySet
+	piiScanner         *pii.Scanner
+	schemas            map[string]*jsonschema.Schema
+	uniqueConstraints  map[string][]UniqueConstraint
+	uniqueChecks       map[string]bool // 저장소/컬렉션/제약별 쓰기 전 확인 필요 여부
+	dupKeyClassifiers  map[string]func(err error) bool
+	references         map[string][]Reference // 참조하는 컬렉션별 참조 선언
+	referencedBy       map[string][]Reference // 참조 대상 컬렉션별 참조 선언
+	maxCascade         int
+	readRouting        *ReadRouting
+	readReplica        repository.DocumentRepository
+	bulkParallelism    BulkWriteParallelism
+	operationTimeouts  OperationTimeouts
+	expiryPolicies     map[string]ExpiryPolicy
+	softDeletePolicies map[string]SoftDeletePolicy
+	revisionRepo       repository.RevisionRepository
+	revisionPolicies   map[string]RevisionPolicy
+	retentionPolicies  map[string]retention.Policy
+	retentionDryRun    bool
+	archive            *archive.Archive
+	archivePolicies    map[string]ArchivePolicy
+	quotas             *storageQuotas
+	featureFlags       *featureflag.Flags
 }
 
 // NewDocumentUseCase는 새로운 DocumentUseCase를 생성합니다
@@ -35,31 +75,14 @@ func NewDocumentUseCase(
 	docRepo repository.DocumentRepository,
 	cacheRepo repository.CacheRepository,
 ) *DocumentUseCase {
-	// Circuit breaker 설정
-	cb := circuitbreaker.NewCircuitBreaker("document_usecase", circuitbreaker.Config{
-		MaxRequests: 3,
-		Interval:    10 * time.Second,
-		Timeout:     30 * time.Second,
-		ReadyToTrip: func(counts circuitbreaker.Counts) bool {
-			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
-			return counts.Requests >= 3 && failureRatio >= 0.6
-		},
-		OnStateChange: func(name string, from circuitbreaker.State, to circuitbreaker.State) {
-			logger.Info(context.Background(), "circuit breaker state changed",
-				zap.String("name", name),
-				zap.Int("from", int(from)),
-				zap.Int("to", int(to)),
-			)
-		},
-	})
-
 	return &DocumentUseCase{
-		docRepo:        docRepo,
-		repoManager:    nil,
-		cacheRepo:      cacheRepo,
-		metrics:        metrics.GetMetrics(),
-		circuitBreaker: cb,
-		retryConfig:    retry.DefaultConfig(),
+		docRepo:         docRepo,
+		repoManager:     nil,
+		cacheRepo:       cacheRepo,
+		metrics:         metrics.GetMetrics(),
+		circuitBreakers: NewCircuitBreakers(circuitbreaker.DefaultSettings(), nil),
+		retryConfig:     retry.DefaultConfig(),
+		retryBudgets:    NewRetryBudgets(retry.DefaultBudgetConfig()),
 	}
 }
 
@@ -68,31 +91,14 @@ func NewDocumentUseCaseWithManager(
 	repoManager *persistence.RepositoryManager,
 	cacheRepo repository.CacheRepository,
 ) *DocumentUseCase {
-	// Circuit breaker 설정
-	cb := circuitbreaker.NewCircuitBreaker("document_usecase", circuitbreaker.Config{
-		MaxRequests: 3,
-		Interval:    10 * time.Second,
-		Timeout:     30 * time.Second,
-		ReadyToTrip: func(counts circuitbreaker.Counts) bool {
-			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
-			return counts.Requests >= 3 && failureRatio >= 0.6
-		},
-		OnStateChange: func(name string, from circuitbreaker.State, to circuitbreaker.State) {
-			logger.Info(context.Background(), "circuit breaker state changed",
-				zap.String("name", name),
-				zap.Int("from", int(from)),
-				zap.Int("to", int(to)),
-			)
-		},
-	})
-
 	return &DocumentUseCase{
-		docRepo:        nil,
-		repoManager:    repoManager,
-		cacheRepo:      cacheRepo,
-		metrics:        metrics.GetMetrics(),
-		circuitBreaker: cb,
-		retryConfig:    retry.DefaultConfig(),
+		docRepo:         nil,
+		repoManager:     repoManager,
+		cacheRepo:       cacheRepo,
+		metrics:         metrics.GetMetrics(),
+		circuitBreakers: NewCircuitBreakers(circuitbreaker.DefaultSettings(), nil),
+		retryConfig:     retry.DefaultConfig(),
+		retryBudgets:    NewRetryBudgets(retry.DefaultBudgetConfig()),
 	}
 }
 
@@ -123,6 +129,9 @@ func (uc *DocumentUseCase) CreateDocument(ctx context.Context, req *dto.CreateDo
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CreateDocument")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -141,6 +150,22 @@ func (uc *DocumentUseCase) CreateDocument(ctx context.Context, req *dto.CreateDo
 		zap.String("database_type", string(dbType)),
 	)
 
+	// 행 수준 보안: 소유 필드를 호출자 값으로 채움
+	if err := uc.stampRow(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.validateSchema(ctx, req.Collection, "create", req.Data); err != nil {
+		return nil, err
+	}
+	if err := uc.scanPII(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.checkUnique(ctx, docRepo, req.Collection, "", req.Data); err != nil {
+		return nil, err
+	}
+
 	// 도메인 엔티티 생성
 	doc, err := entity.NewDocument(req.Collection, req.Data)
 	if err != nil {
@@ -148,25 +173,47 @@ func (uc *DocumentUseCase) CreateDocument(ctx context.Context, req *dto.CreateDo
 		logger.Error(ctx, "failed to create domain entity", zap.Error(err))
 		return nil, fmt.Errorf("invalid document: %w", err)
 	}
+	if err := uc.applyExpiry(req, doc, time.Now()); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	// 저장 용량 쿼터: 문서 수와 크기를 미리 더하고 저장에 실패하면 되돌림
+	size := documentBytes(req.Data)
+	claims, err := uc.reserveQuota(ctx, docRepo, req.Collection, 1, size)
+	if err != nil {
+		return nil, err
+	}
 
 	// Circuit breaker와 retry를 사용하여 저장
-	_, err = uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "create"), func(ctx context.Context) error {
 			return docRepo.Save(ctx, doc)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:    entity.AuditOpCreate,
+		Collection:   req.Collection,
+		DocumentID:   doc.ID(),
+		After:        doc.Data(),
+		AfterVersion: doc.Version(),
+	}, err)
+
 	if err != nil {
+		uc.releaseQuota(claims, 1, size)
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to save document", zap.Error(err))
-		return nil, fmt.Errorf("failed to save document: %w", err)
+		return nil, fmt.Errorf("failed to save document: %w", uc.duplicateKeyError(ctx, req.Collection, err))
 	}
 
-	// 캐시에 저장
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, doc.ID())
-	if err := uc.cacheRepo.Set(ctx, cacheKey, doc, 300); err != nil {
-		logger.Warn(ctx, "failed to cache document", zap.Error(err))
-		// 캐시 실패는 무시
+	// 캐시에 저장 (캐시 실패는 무시, read-through는 생성 직후에도 채움)
+	// 새 문서가 검색/개수 결과에 보이도록 쿼리 캐시도 함께 무효화
+	if uc.cachePolicy(ctx, req.Collection).Strategy == CacheReadThrough {
+		uc.invalidateQueries(ctx, req.Collection)
+		uc.cacheFill(ctx, req.Collection, doc)
+	} else {
+		uc.cacheWritten(ctx, req.Collection, doc.ID(), doc)
 	}
 
 	logger.Info(ctx, "document created successfully",
@@ -177,6 +224,7 @@ func (uc *DocumentUseCase) CreateDocument(ctx context.Context, req *dto.CreateDo
 	return &dto.CreateDocumentResponse{
 		ID:        doc.ID(),
 		CreatedAt: doc.CreatedAt(),
+		ExpiresAt: expiresAtResponse(doc),
 	}, nil
 }
 
@@ -185,8 +233,28 @@ func (uc *DocumentUseCase) GetDocument(ctx context.Context, req *dto.GetDocument
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.GetDocument")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	// 참조 채우기는 문서를 조회한 뒤 대상 문서를 따로 조회
+	if len(req.Populate) > 0 {
+		return uc.getPopulated(ctx, req)
+	}
+
+	// 시점 조회는 캐시와 복제본을 거치지 않고 주 저장소와 리비전으로 처리
+	if req.AsOf != nil {
+		return uc.getDocumentAsOf(ctx, req)
+	}
+
+	// 단건 조회는 결과로 문서 캐시를 채우므로, 복제 지연된 값이 캐시에 남지 않도록
+	// 캐시를 쓰지 않는 컬렉션만 읽기 라우팅 설정에 따라 복제본으로 보냅니다
+	var docRepo repository.DocumentRepository
+	var err error
+	if uc.cachePolicy(ctx, req.Collection).Strategy == CacheNone {
+		docRepo, err = uc.getReadRepository(ctx, req.Collection)
+	} else {
+		docRepo, err = uc.getRepository(ctx)
+	}
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -205,50 +273,54 @@ func (uc *DocumentUseCase) GetDocument(ctx context.Context, req *dto.GetDocument
 		zap.String("database_type", string(dbType)),
 	)
 
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
+	cacheKey := documentCacheKey(req.Collection, req.ID)
 
-	// 캐시에서 조회 시도
-	cachedData, err := uc.cacheRepo.Get(ctx, cacheKey)
-	if err == nil {
-		uc.metrics.RecordCacheHit("document")
+	// 캐시에서 조회 시도 (문서로 재구성할 수 없는 항목은 미스로 처리)
+	cachedData, hit := uc.cacheLookup(ctx, req.Collection, req.ID)
+	var cachedDoc *entity.Document
+	if hit && !isNegativeCacheEntry(cachedData) {
+		if cachedDoc, err = decodeCachedDocument(cachedData); err != nil {
+			logger.Warn(ctx, "ignoring undecodable cache entry", zap.String("key", cacheKey), zap.Error(err))
+			hit = false
+		}
+	}
+	if hit {
+		uc.metrics.RecordCacheHit("document", req.Collection)
 		logger.Debug(ctx, "cache hit", zap.String("key", cacheKey))
 
-		// 캐시 데이터를 DTO로 변환
-		data, _ := json.Marshal(cachedData)
-		var doc entity.Document
-		json.Unmarshal(data, &doc)
+		// 존재하지 않는 문서로 캐시된 경우 DB 조회 없이 반환
+		if cachedDoc == nil {
+			return nil, fmt.Errorf("failed to get document: %w", entity.ErrDocumentNotFound)
+		}
+
+		// TTL이 지난(stale) 항목은 그대로 반환하고 백그라운드에서 갱신하며,
+		// 만료가 임박한 핫 키는 확률적으로 백그라운드에서 미리 갱신
+		if uc.isStale(ctx, uc.cachePolicy(ctx, req.Collection), cacheKey) {
+			logger.Debug(ctx, "serving stale cache entry", zap.String("key", cacheKey))
+			uc.refreshInBackground(ctx, docRepo, req.Collection, req.ID)
+		} else if uc.shouldRefreshEarly(ctx, cacheKey) {
+			uc.refreshInBackground(ctx, docRepo, req.Collection, req.ID)
+		}
 
-		return &dto.GetDocumentResponse{
-			ID:        req.ID,
-			Data:      doc.Data(),
-			Version:   doc.Version(),
-			CreatedAt: doc.CreatedAt(),
-			UpdatedAt: doc.UpdatedAt(),
-		}, nil
+		if err := uc.checkVisible(ctx, req, cachedDoc); err != nil {
+			return nil, err
+		}
+		return documentResponse(cachedDoc), nil
 	}
 
-	uc.metrics.RecordCacheMiss("document")
+	uc.metrics.RecordCacheMiss("document", req.Collection)
 	logger.Debug(ctx, "cache miss", zap.String("key", cacheKey))
 
-	// DB에서 조회
-	var doc *entity.Document
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (*entity.Document, error) {
-			return docRepo.FindByID(ctx, req.Collection, req.ID)
-		})
-	})
-
+	// DB에서 조회 (동시 캐시 미스는 한 번의 조회로 합쳐지고 결과가 캐시에 저장됨)
+	doc, err := uc.loadDocument(ctx, docRepo, req.Collection, req.ID)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to get document", zap.Error(err))
 		return nil, fmt.Errorf("failed to get document: %w", err)
 	}
 
-	doc = result.(*entity.Document)
-
-	// 캐시에 저장
-	if err := uc.cacheRepo.Set(ctx, cacheKey, doc, 300); err != nil {
-		logger.Warn(ctx, "failed to cache document", zap.Error(err))
+	if err := uc.checkVisible(ctx, req, doc); err != nil {
+		return nil, err
 	}
 
 	logger.Info(ctx, "document retrieved successfully",
@@ -256,13 +328,17 @@ func (uc *DocumentUseCase) GetDocument(ctx context.Context, req *dto.GetDocument
 		zap.String("collection", req.Collection),
 	)
 
-	return &dto.GetDocumentResponse{
-		ID:        doc.ID(),
-		Data:      doc.Data(),
-		Version:   doc.Version(),
-		CreatedAt: doc.CreatedAt(),
-		UpdatedAt: doc.UpdatedAt(),
-	}, nil
+	return documentResponse(doc), nil
+}
+
+// checkVisible은 조회한 문서를 호출자에게 반환할 수 있는지 확인합니다
+// 만료 정리 전까지 남아 있는 문서와 소프트 삭제된 문서는 없는 문서로 취급하고 행 수준 보안을 확인합니다
+// 캐시 적중과 DB 조회 모두 같은 검사를 거칩니다
+func (uc *DocumentUseCase) checkVisible(ctx context.Context, req *dto.GetDocumentRequest, doc *entity.Document) error {
+	if doc.IsExpired(time.Now()) || uc.hideDeleted(req.Collection, doc, req.IncludeDeleted) {
+		return fmt.Errorf("failed to get document: %w", entity.ErrDocumentNotFound)
+	}
+	return uc.checkRowAccess(ctx, req.Collection, doc.Data())
 }
 
 // UpdateDocument는 문서를 업데이트합니다
@@ -270,6 +346,9 @@ func (uc *DocumentUseCase) UpdateDocument(ctx context.Context, req *dto.UpdateDo
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.UpdateDocument")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -298,11 +377,36 @@ func (uc *DocumentUseCase) UpdateDocument(ctx context.Context, req *dto.UpdateDo
 		return fmt.Errorf("failed to find document: %w", err)
 	}
 
+	if err := uc.checkRowAccess(ctx, req.Collection, doc.Data()); err != nil {
+		return err
+	}
+	if uc.hideDeleted(req.Collection, doc, false) {
+		return fmt.Errorf("failed to find document: %w", entity.ErrDocumentNotFound)
+	}
+	if err := uc.stampRow(ctx, req.Collection, req.Data); err != nil {
+		return err
+	}
+	if err := uc.validateSchema(ctx, req.Collection, "update", req.Data); err != nil {
+		return err
+	}
+	if err := uc.scanPII(ctx, req.Collection, req.Data); err != nil {
+		return err
+	}
+	if err := uc.checkUnique(ctx, docRepo, req.Collection, req.ID, req.Data); err != nil {
+		return err
+	}
+	if _, err := uc.reserveQuota(ctx, docRepo, req.Collection, 0, 0); err != nil {
+		return err
+	}
+
 	// 버전 확인
 	if doc.Version() != req.Version {
 		return entity.ErrVersionConflict
 	}
 
+	before, beforeVersion := auditDocumentState(doc)
+	prior := entity.ReconstructDocument(doc.ID(), doc.Collection(), before, beforeVersion, doc.CreatedAt(), doc.UpdatedAt())
+
 	// 업데이트
 	if err := doc.Update(req.Data); err != nil {
 		tracing.RecordError(ctx, err)
@@ -310,23 +414,32 @@ func (uc *DocumentUseCase) UpdateDocument(ctx context.Context, req *dto.UpdateDo
 	}
 
 	// Circuit breaker와 retry를 사용하여 저장
-	_, err = uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "update"), func(ctx context.Context) error {
 			return docRepo.Update(ctx, doc)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpUpdate,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Before:        before,
+		BeforeVersion: beforeVersion,
+		After:         doc.Data(),
+		AfterVersion:  doc.Version(),
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to update document", zap.Error(err))
-		return fmt.Errorf("failed to update document: %w", err)
+		return fmt.Errorf("failed to update document: %w", uc.duplicateKeyError(ctx, req.Collection, err))
 	}
 
-	// 캐시 무효화
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	if err := uc.cacheRepo.Delete(ctx, cacheKey); err != nil {
-		logger.Warn(ctx, "failed to invalidate cache", zap.Error(err))
-	}
+	uc.recordRevision(ctx, prior, doc.UpdatedAt(), entity.AuditOpUpdate)
+
+	// 캐시 갱신 (컬렉션 캐시 전략에 따름)
+	uc.cacheWritten(ctx, req.Collection, req.ID, doc)
 
 	logger.Info(ctx, "document updated successfully",
 		zap.String("id", req.ID),
@@ -341,6 +454,9 @@ func (uc *DocumentUseCase) DeleteDocument(ctx context.Context, req *dto.DeleteDo
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.DeleteDocument")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -361,24 +477,50 @@ func (uc *DocumentUseCase) DeleteDocument(ctx context.Context, req *dto.DeleteDo
 		zap.String("database_type", string(dbType)),
 	)
 
+	if err := uc.checkRowAccessByID(ctx, req.Collection, req.ID); err != nil {
+		tracing.RecordError(ctx, err)
+		return err
+	}
+
+	// 이 문서를 참조하는 문서에 삭제 동작(cascade/nullify)을 먼저 적용
+	if err := uc.applyReferences(ctx, docRepo, req.Collection, req.ID); err != nil {
+		tracing.RecordError(ctx, err)
+		return err
+	}
+
+	// 소프트 삭제 컬렉션은 삭제 시각만 기록하고 문서를 남김
+	if uc.softDeleteEnabled(req.Collection) {
+		return uc.softDeleteDocument(ctx, docRepo, req)
+	}
+
+	snapshot := uc.snapshotDocument(ctx, docRepo, req.Collection, req.ID)
+	before, beforeVersion := auditDocumentState(snapshot)
+
 	// Circuit breaker와 retry를 사용하여 삭제
-	_, err = uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "delete"), func(ctx context.Context) error {
 			return docRepo.Delete(ctx, req.Collection, req.ID)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpDelete,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Before:        before,
+		BeforeVersion: beforeVersion,
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to delete document", zap.Error(err))
 		return fmt.Errorf("failed to delete document: %w", err)
 	}
 
+	uc.recordRevision(ctx, snapshot, time.Now(), entity.AuditOpDelete)
+
 	// 캐시 무효화
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	if err := uc.cacheRepo.Delete(ctx, cacheKey); err != nil {
-		logger.Warn(ctx, "failed to invalidate cache", zap.Error(err))
-	}
+	uc.cacheEvict(ctx, req.Collection, req.ID)
 
 	logger.Info(ctx, "document deleted successfully",
 		zap.String("id", req.ID),
@@ -393,8 +535,15 @@ func (uc *DocumentUseCase) ListDocuments(ctx context.Context, req *dto.ListDocum
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ListDocuments")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	if len(req.Populate) > 0 {
+		return uc.listPopulated(ctx, req)
+	}
+
+	// 읽기는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	docRepo, err := uc.getReadRepository(ctx, req.Collection)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -415,41 +564,63 @@ func (uc *DocumentUseCase) ListDocuments(ctx context.Context, req *dto.ListDocum
 		zap.String("database_type", string(dbType)),
 	)
 
-	// Circuit breaker를 사용하여 조회
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return docRepo.FindAll(ctx, req.Collection, req.Filter)
-	})
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
 
+	// 요청한 페이지만 커서로 읽어 전체 결과를 메모리에 올리지 않습니다 (page_size가 0이면 전체)
+	opts := &repository.FindOptions{}
+	if req.PageSize > 0 {
+		opts.Limit = int64(req.PageSize)
+		if req.Page > 1 {
+			opts.Skip = int64(req.Page-1) * int64(req.PageSize)
+		}
+	}
+
+	it, err := uc.openStream(ctx, docRepo, req.Collection, filter, opts)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to list documents", zap.Error(err))
 		return nil, fmt.Errorf("failed to list documents: %w", err)
 	}
-
-	docs := result.([]*entity.Document)
+	defer it.Close(context.WithoutCancel(ctx))
+
+	dtoList := make([]dto.GetDocumentResponse, 0, max(req.PageSize, 0))
+	now := time.Now()
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to list documents: %w", err)
+		}
+		if doc.IsExpired(now) || uc.hideDeleted(req.Collection, doc, req.IncludeDeleted) {
+			continue
+		}
+		// 보관된 문서는 목록 조회로 주 저장소에 되살리지 않고 보관 객체의 데이터만 반환
+		if doc, err = uc.rehydrate(ctx, doc, false); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to list documents: %w", err)
+		}
+		dtoList = append(dtoList, *documentResponse(doc))
+	}
+	if err := it.Err(); err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to list documents", zap.Error(err))
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
 
 	// 총 개수 조회
-	count, err := docRepo.Count(ctx, req.Collection, req.Filter)
+	count, err := docRepo.Count(ctx, req.Collection, filter)
 	if err != nil {
 		logger.Warn(ctx, "failed to count documents", zap.Error(err))
-		count = int64(len(docs))
-	}
-
-	// DTO로 변환
-	dtoList := make([]dto.GetDocumentResponse, len(docs))
-	for i, doc := range docs {
-		dtoList[i] = dto.GetDocumentResponse{
-			ID:        doc.ID(),
-			Data:      doc.Data(),
-			Version:   doc.Version(),
-			CreatedAt: doc.CreatedAt(),
-			UpdatedAt: doc.UpdatedAt(),
-		}
+		count = int64(len(dtoList))
 	}
 
 	logger.Info(ctx, "documents listed successfully",
 		zap.String("collection", req.Collection),
-		zap.Int("count", len(docs)),
+		zap.Int("count", len(dtoList)),
 	)
 
 	return &dto.ListDocumentsResponse{
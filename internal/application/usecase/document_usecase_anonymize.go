@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/anonymize"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// maxAnonymizationErrors는 배치 결과에 담는 실패 문서의 최대 개수입니다
+const maxAnonymizationErrors = 100
+
+// AnonymizationBatch는 익명화 배치 하나의 결과입니다
+type AnonymizationBatch struct {
+	Scanned    int64
+	Anonymized int64
+	Unchanged  int64
+	Failed     int64
+	Errors     []dto.AnonymizationError // 최대 maxAnonymizationErrors개
+}
+
+// AnonymizeDocuments는 filter와 일치하는 문서를 순회하며 익명화 규칙을 적용합니다
+// batchSize개마다 progress를 호출하고 interval만큼 쉬며, 문서별 실패는 기록만 하고 계속 진행합니다
+// 이미 익명화된 값은 다시 쓰지 않으므로 중단된 작업은 같은 규칙으로 처음부터 다시 실행하면 이어서 처리됩니다
+func (uc *DocumentUseCase) AnonymizeDocuments(ctx context.Context, collection string, filter map[string]interface{}, anonymizer *anonymize.Anonymizer, batchSize int, interval time.Duration, progress func(batch AnonymizationBatch)) error {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.AnonymizeDocuments")
+	defer span.End()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return err
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", collection),
+		attribute.StringSlice("fields", anonymizer.Fields()),
+	)
+
+	it, err := uc.openStream(ctx, docRepo, collection, filter, &repository.FindOptions{})
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return fmt.Errorf("failed to scan documents: %w", err)
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	batch := make([]*entity.Document, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		progress(uc.anonymizeBatch(ctx, docRepo, batch, anonymizer))
+		batch = batch[:0]
+		if interval <= 0 {
+			return ctx.Err()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+			return nil
+		}
+	}
+
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return fmt.Errorf("failed to scan documents: %w", err)
+		}
+		batch = append(batch, doc)
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		tracing.RecordError(ctx, err)
+		return fmt.Errorf("failed to scan documents: %w", err)
+	}
+	return flush()
+}
+
+// anonymizeBatch는 배치의 문서를 하나씩 익명화합니다
+func (uc *DocumentUseCase) anonymizeBatch(ctx context.Context, docRepo repository.DocumentRepository, batch []*entity.Document, anonymizer *anonymize.Anonymizer) AnonymizationBatch {
+	var result AnonymizationBatch
+	for _, doc := range batch {
+		if ctx.Err() != nil {
+			// 취소되면 남은 문서는 처리하지 않음 (다시 실행할 때 처리)
+			break
+		}
+		result.Scanned++
+		changed, err := uc.anonymizeDocument(ctx, docRepo, doc, anonymizer)
+		switch {
+		case err != nil:
+			result.Failed++
+			if len(result.Errors) < maxAnonymizationErrors {
+				result.Errors = append(result.Errors, dto.AnonymizationError{DocumentID: doc.ID(), Error: err.Error()})
+			}
+			logger.Warn(ctx, "failed to anonymize document",
+				zap.String("collection", doc.Collection()),
+				zap.String("id", doc.ID()),
+				zap.Error(err),
+			)
+		case changed:
+			result.Anonymized++
+		default:
+			result.Unchanged++
+		}
+	}
+	return result
+}
+
+// anonymizeDocument는 문서를 익명화하고 바꿨는지 반환합니다
+// 순회 중 문서가 바뀌어 버전이 충돌하면 다시 읽어 한 번 더 시도하고, 그 사이 삭제된 문서는 건너뜁니다
+func (uc *DocumentUseCase) anonymizeDocument(ctx context.Context, docRepo repository.DocumentRepository, doc *entity.Document, anonymizer *anonymize.Anonymizer) (bool, error) {
+	changed, err := uc.anonymizeOnce(ctx, docRepo, doc, anonymizer)
+	if !errors.Is(err, entity.ErrVersionConflict) {
+		return changed, err
+	}
+
+	doc, err = docRepo.FindByID(ctx, doc.Collection(), doc.ID())
+	if errors.Is(err, entity.ErrDocumentNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return uc.anonymizeOnce(ctx, docRepo, doc, anonymizer)
+}
+
+// anonymizeOnce는 문서에 익명화 규칙을 적용해 버전 확인과 함께 씁니다
+// 감사 로그에는 원래 값을 남기지 않고 바꾼 필드와 버전만 기록하며, 이전 값이 남지 않도록 리비전을 먼저 삭제합니다
+// 보관된 문서는 보관 객체의 데이터를 익명화해 보관 객체를 덮어쓰고 주 저장소에도 씁니다
+func (uc *DocumentUseCase) anonymizeOnce(ctx context.Context, docRepo repository.DocumentRepository, doc *entity.Document, anonymizer *anonymize.Anonymizer) (bool, error) {
+	source := doc
+	archiveKey, archived := doc.ArchiveKey()
+	if archived && uc.archive != nil {
+		restored, err := uc.rehydrate(ctx, doc, false)
+		if err != nil {
+			return false, err
+		}
+		source = restored
+	}
+
+	data, fields := anonymizer.Apply(source.Data())
+	if len(fields) == 0 {
+		return false, nil
+	}
+
+	if err := uc.purgeRevisions(ctx, doc.Collection(), doc.ID()); err != nil {
+		return false, err
+	}
+	if archived && uc.archive != nil {
+		anonymized := entity.ReconstructDocument(doc.ID(), doc.Collection(), data, source.Version(), source.CreatedAt(), source.UpdatedAt())
+		if err := uc.archive.Overwrite(ctx, archiveKey, anonymized); err != nil {
+			return false, err
+		}
+	}
+
+	beforeVersion := doc.Version()
+	if err := doc.Update(data); err != nil {
+		return false, err
+	}
+	_, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "update"), func(ctx context.Context) error {
+			return docRepo.Update(ctx, doc)
+		})
+	})
+
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpAnonymize,
+		Collection:    doc.Collection(),
+		DocumentID:    doc.ID(),
+		Filter:        map[string]interface{}{"fields": fields},
+		BeforeVersion: beforeVersion,
+		AfterVersion:  doc.Version(),
+	}, err)
+	if err != nil {
+		return false, err
+	}
+
+	uc.cacheWritten(ctx, doc.Collection(), doc.ID(), doc)
+	return true, nil
+}
+
+// purgeRevisions는 문서의 모든 리비전을 삭제합니다 (리비전 정책이 없어진 컬렉션에 남은 리비전도 포함)
+func (uc *DocumentUseCase) purgeRevisions(ctx context.Context, collection, id string) error {
+	if uc.revisionRepo == nil {
+		return nil
+	}
+	// 모든 리비전의 ValidTo는 현재 시각 이전
+	if _, err := uc.revisionRepo.Prune(ctx, revisionKey(ctx, collection, id), 0, time.Now().Add(time.Second)); err != nil {
+		return fmt.Errorf("failed to purge revisions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/archive"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"go.uber.org/zap"
+)
+
+// ArchivePolicy는 컬렉션의 콜드 데이터 보관 정책입니다
+type ArchivePolicy struct {
+	Collection string
+	After      time.Duration // 마지막 수정 후 이 기간이 지난 문서를 보관
+}
+
+// SetArchiving은 보관 저장소와 컬렉션별 보관 정책을 설정합니다
+// 보관된 문서는 주 저장소에 스텁만 남고, 조회하면 보관 객체에서 원래 데이터를 읽어 되살립니다
+func (uc *DocumentUseCase) SetArchiving(archiver *archive.Archive, policies []ArchivePolicy) {
+	uc.archive = archiver
+	uc.archivePolicies = make(map[string]ArchivePolicy, len(policies))
+	for _, p := range policies {
+		uc.archivePolicies[p.Collection] = p
+	}
+}
+
+// RunArchiver는 interval마다 정책 기간 동안 수정되지 않은 문서를 보관 저장소로 옮깁니다
+// 한 번에 컬렉션당 최대 batchSize개를 옮기며(0이면 제한 없음), ctx가 취소될 때까지 실행되므로 별도 goroutine에서 호출해야 합니다
+func (uc *DocumentUseCase) RunArchiver(ctx context.Context, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.ArchiveColdDocuments(ctx, batchSize)
+		}
+	}
+}
+
+// ArchiveColdDocuments는 모든 저장소에서 보관 대상 문서를 옮기고 옮긴 문서 수를 반환합니다
+func (uc *DocumentUseCase) ArchiveColdDocuments(ctx context.Context, batchSize int) int64 {
+	if uc.archive == nil {
+		return 0
+	}
+
+	repos := map[string]repository.DocumentRepository{"default": uc.docRepo}
+	if uc.repoManager != nil {
+		repos = uc.repoManager.Repositories()
+	}
+
+	var total int64
+	for dbType, repo := range repos {
+		repoCtx := ctx
+		if uc.repoManager != nil {
+			// 리비전 키와 로그가 저장소의 데이터베이스 종류를 쓰도록 컨텍스트에 기록
+			repoCtx = context.WithValue(ctx, middleware.DatabaseTypeContextKey, middleware.DatabaseType(dbType))
+		}
+		for collection, policy := range uc.archivePolicies {
+			if ctx.Err() != nil {
+				return total
+			}
+			archived, err := uc.archiveCollection(repoCtx, repo, collection, time.Now().Add(-policy.After), batchSize)
+			total += archived
+			if err != nil {
+				logger.Error(ctx, "failed to archive documents",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.Error(err),
+				)
+			}
+			if archived > 0 {
+				uc.metrics.RecordDocumentsArchived(dbType, collection, archived)
+				logger.Info(ctx, "cold documents archived",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.Int64("count", archived),
+				)
+			}
+		}
+	}
+	return total
+}
+
+// archiveCollection은 before 이전에 마지막으로 수정된 문서를 보관하고 스텁으로 바꿉니다
+// 보관 객체를 먼저 저장한 뒤 버전 확인과 함께 스텁을 쓰므로, 그 사이에 문서가 바뀌면 스텁을 쓰지 않고 건너뜁니다
+func (uc *DocumentUseCase) archiveCollection(ctx context.Context, docRepo repository.DocumentRepository, collection string, before time.Time, batchSize int) (int64, error) {
+	ids, err := uc.findColdDocuments(ctx, docRepo, collection, before, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	dbType := string(middleware.GetDatabaseType(ctx))
+	var archived int64
+	for _, id := range ids {
+		doc, err := docRepo.FindByID(ctx, collection, id)
+		if err != nil {
+			continue
+		}
+		if doc.IsArchived() || doc.IsDeleted() || !doc.UpdatedAt().Before(before) {
+			continue
+		}
+
+		key, err := uc.archive.Put(ctx, dbType, doc)
+		if err != nil {
+			return archived, err
+		}
+
+		prior := entity.ReconstructDocument(doc.ID(), doc.Collection(), doc.Data(), doc.Version(), doc.CreatedAt(), doc.UpdatedAt())
+		if err := doc.Update(map[string]interface{}{
+			entity.ArchiveKeyField: key,
+			entity.ArchivedAtField: time.Now().UTC().Format(time.RFC3339Nano),
+		}); err != nil {
+			return archived, err
+		}
+		if err := docRepo.Update(ctx, doc); err != nil {
+			// 보관 중에 문서가 수정되었으면 다음 실행에서 다시 판단 (남은 보관 객체는 새 버전과 키가 달라 영향 없음)
+			logger.Warn(ctx, "skipped archiving modified document",
+				zap.String("collection", collection),
+				zap.String("id", id),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		uc.recordRevision(ctx, prior, doc.UpdatedAt(), entity.AuditOpArchive)
+		uc.cacheEvict(ctx, collection, id)
+		archived++
+	}
+	return archived, nil
+}
+
+// findColdDocuments는 before 이전에 마지막으로 수정된, 보관되지 않은 문서의 ID를 최대 limit개 모읍니다
+func (uc *DocumentUseCase) findColdDocuments(ctx context.Context, docRepo repository.DocumentRepository, collection string, before time.Time, limit int) ([]string, error) {
+	it, err := uc.openStream(ctx, docRepo, collection, nil, &repository.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	var ids []string
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return nil, err
+		}
+		if doc.IsArchived() || doc.IsDeleted() || !doc.UpdatedAt().Before(before) {
+			continue
+		}
+		ids = append(ids, doc.ID())
+		if limit > 0 && len(ids) >= limit {
+			break
+		}
+	}
+	return ids, it.Err()
+}
+
+// rehydrate는 보관된 문서의 스텁을 보관 객체의 원래 데이터로 바꿔 반환합니다 (보관되지 않은 문서는 그대로 반환)
+// writeBack이면 되살린 데이터를 주 저장소에 다시 써서 이후 조회가 보관 저장소를 거치지 않게 합니다
+func (uc *DocumentUseCase) rehydrate(ctx context.Context, doc *entity.Document, writeBack bool) (*entity.Document, error) {
+	key, ok := doc.ArchiveKey()
+	if !ok || uc.archive == nil {
+		return doc, nil
+	}
+
+	archived, err := uc.archive.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rehydrate archived document %s: %w", doc.ID(), err)
+	}
+
+	restored := entity.ReconstructDocument(doc.ID(), doc.Collection(), archived.Data(), doc.Version(), doc.CreatedAt(), doc.UpdatedAt())
+	restored.SetExpiresAt(doc.ExpiresAt())
+	if !writeBack {
+		return restored, nil
+	}
+
+	written, err := uc.writeBackRehydrated(ctx, doc, archived.Data())
+	if err != nil {
+		// 되살린 데이터는 반환하고, 스텁은 다음 조회에서 다시 되살림
+		logger.Warn(ctx, "failed to write back rehydrated document",
+			zap.String("collection", doc.Collection()),
+			zap.String("id", doc.ID()),
+			zap.Error(err),
+		)
+		return restored, nil
+	}
+
+	uc.metrics.RecordDocumentRehydrated(string(middleware.GetDatabaseType(ctx)), doc.Collection())
+	logger.Info(ctx, "archived document rehydrated",
+		zap.String("collection", doc.Collection()),
+		zap.String("id", doc.ID()),
+		zap.String("archive", uc.archive.Location(key)),
+	)
+	return written, nil
+}
+
+// writeBackRehydrated는 스텁을 되살린 데이터로 바꿔 주 저장소에 씁니다 (버전 확인 포함)
+// 읽은 저장소가 복제본일 수 있으므로 항상 주 저장소에 씁니다
+func (uc *DocumentUseCase) writeBackRehydrated(ctx context.Context, stub *entity.Document, data map[string]interface{}) (*entity.Document, error) {
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := entity.ReconstructDocument(stub.ID(), stub.Collection(), stub.Data(), stub.Version(), stub.CreatedAt(), stub.UpdatedAt())
+	doc.SetExpiresAt(stub.ExpiresAt())
+	if err := doc.Update(data); err != nil {
+		return nil, err
+	}
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "update"), func(ctx context.Context) error {
+			return docRepo.Update(ctx, doc)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.recordRevision(ctx, stub, doc.UpdatedAt(), entity.AuditOpRehydrate)
+	return doc, nil
+}
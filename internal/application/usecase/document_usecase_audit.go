@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// auditWriteTimeout은 감사 기록 저장 제한 시간입니다
+const auditWriteTimeout = 5 * time.Second
+
+// SetAuditRepository는 변경 작업 감사 기록 저장소를 설정합니다
+// 설정하지 않으면 감사 기록을 남기지 않습니다
+func (uc *DocumentUseCase) SetAuditRepository(auditRepo repository.AuditRepository) {
+	uc.auditRepo = auditRepo
+}
+
+// auditEnabled는 감사 기록이 활성화되어 있는지 확인합니다
+func (uc *DocumentUseCase) auditEnabled() bool {
+	return uc.auditRepo != nil
+}
+
+// recordAudit는 변경 작업의 감사 기록을 남깁니다
+// 요청이 취소되어도 기록이 유실되지 않도록 취소 신호와 분리된 컨텍스트를 사용합니다
+// 감사 기록 실패는 작업 결과에 영향을 주지 않고 에러 로그만 남깁니다
+func (uc *DocumentUseCase) recordAudit(ctx context.Context, entry *entity.AuditEntry, opErr error) {
+	if uc.auditRepo == nil {
+		return
+	}
+
+	entry.Timestamp = time.Now().UTC()
+	entry.DatabaseType = string(middleware.GetDatabaseType(ctx))
+	entry.RequestID = logger.RequestIDFromContext(ctx)
+	entry.Success = opErr == nil
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		entry.Actor = principal.Subject
+		entry.ActorName = principal.Username
+		entry.TenantID = principal.TenantID
+		if operator := principal.ImpersonatedBy; operator != nil {
+			entry.ImpersonatedBy = operator.Subject
+			entry.ImpersonatedByName = operator.Username
+		}
+	}
+
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+	defer cancel()
+
+	if err := uc.auditRepo.Append(writeCtx, entry); err != nil {
+		logger.Error(ctx, "failed to write audit entry",
+			zap.String("operation", string(entry.Operation)),
+			zap.String("collection", entry.Collection),
+			zap.String("document_id", entry.DocumentID),
+			zap.Error(err),
+		)
+	}
+}
+
+// snapshotDocument는 감사 기록과 리비전 보관용으로 변경 전 문서를 조회합니다
+// 감사와 리비전이 모두 비활성화되어 있거나 조회에 실패하면 nil을 반환합니다
+func (uc *DocumentUseCase) snapshotDocument(ctx context.Context, docRepo repository.DocumentRepository, collection, id string) *entity.Document {
+	if !uc.auditEnabled() && !uc.revisionsEnabled(collection) {
+		return nil
+	}
+
+	doc, err := uc.findDocument(ctx, docRepo, collection, id)
+	if err != nil {
+		logger.Debug(ctx, "audit snapshot unavailable",
+			zap.String("collection", collection),
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return nil
+	}
+	return doc
+}
+
+// auditDocumentState는 문서 상태를 감사 기록의 Before/After 필드로 변환합니다
+func auditDocumentState(doc *entity.Document) (map[string]interface{}, int) {
+	if doc == nil {
+		return nil, 0
+	}
+	return doc.Data(), doc.Version()
+}
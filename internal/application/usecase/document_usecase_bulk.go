@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultBulkWriteMinOperations는 병렬 실행을 시작하는 기본 작업 수입니다
+const defaultBulkWriteMinOperations = 1000
+
+// BulkWriteParallelism은 BulkWrite 병렬 실행 설정입니다
+type BulkWriteParallelism struct {
+	Workers       int // 동시에 실행하는 파티션 수 (1 이하면 순차 실행)
+	MinOperations int // 이 수 이상의 작업일 때만 병렬 실행 (0이면 1000)
+}
+
+// SetBulkWriteParallelism은 BulkWrite 병렬 실행 설정을 지정합니다
+// 작업은 문서(컬렉션+ID)별로 파티션에 나뉘므로 같은 문서에 대한 작업의 순서는 유지됩니다
+func (uc *DocumentUseCase) SetBulkWriteParallelism(cfg BulkWriteParallelism) {
+	uc.bulkParallelism = cfg
+}
+
+// bulkPartition은 한 워커가 순서대로 실행하는 작업 묶음입니다
+type bulkPartition struct {
+	indexes    []int // 요청의 operations에서의 위치
+	operations []*repository.BulkOperation
+}
+
+// partitionBulkOperations는 작업을 문서별로 최대 workers개의 파티션에 나눕니다
+// 같은 문서의 작업은 항상 같은 파티션에 요청 순서대로 담깁니다
+// ID 없이 필터로 여러 문서를 바꿀 수 있는 작업이 있는 컬렉션은 다른 작업과의 순서를 알 수 없으므로 컬렉션 전체를 한 파티션에 담고,
+// ID 없는 insert는 다른 작업과 겹치지 않으므로 파티션에 고르게 나눕니다
+func partitionBulkOperations(reqOps []dto.BulkOperation, operations []*repository.BulkOperation, workers int) []bulkPartition {
+	unkeyed := make(map[string]bool)
+	for _, op := range reqOps {
+		if op.Type != "insert" && bulkOperationID(op) == "" {
+			unkeyed[op.Collection] = true
+		}
+	}
+
+	partitions := make([]bulkPartition, workers)
+	for i, op := range reqOps {
+		var key string
+		switch {
+		case unkeyed[op.Collection]:
+			key = op.Collection
+		case bulkOperationID(op) != "":
+			key = op.Collection + "\x00" + bulkOperationID(op)
+		default:
+			key = strconv.Itoa(i)
+		}
+
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		p := &partitions[h.Sum32()%uint32(workers)]
+		p.indexes = append(p.indexes, i)
+		p.operations = append(p.operations, operations[i])
+	}
+
+	nonEmpty := partitions[:0]
+	for _, p := range partitions {
+		if len(p.operations) > 0 {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return nonEmpty
+}
+
+// bulkOperationID는 작업이 대상으로 하는 문서 ID를 반환합니다 (알 수 없으면 빈 문자열)
+func bulkOperationID(op dto.BulkOperation) string {
+	if op.ID != "" {
+		return op.ID
+	}
+	fields := op.Filter
+	if op.Type == "insert" {
+		fields = op.Data
+	}
+	for _, field := range []string{"id", "_id"} {
+		if id, ok := fields[field].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// executeBulkWrite는 벌크 작업을 실행합니다
+// 작업 수가 MinOperations 이상이면 파티션별로 나누어 Workers개까지 동시에 실행하고 결과를 요청 위치 기준으로 합칩니다
+// 일부 파티션만 실패하면 그 파티션의 작업을 BulkResult.Errors로 보고하고, 모든 파티션이 실패하면 에러를 반환합니다
+func (uc *DocumentUseCase) executeBulkWrite(ctx context.Context, docRepo repository.DocumentRepository, reqOps []dto.BulkOperation, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
+	minOps := uc.bulkParallelism.MinOperations
+	if minOps <= 0 {
+		minOps = defaultBulkWriteMinOperations
+	}
+	workers := uc.bulkParallelism.Workers
+	if workers <= 1 || len(operations) < minOps {
+		return uc.bulkWritePartition(ctx, docRepo, operations)
+	}
+
+	partitions := partitionBulkOperations(reqOps, operations, workers)
+	if len(partitions) == 1 {
+		return uc.bulkWritePartition(ctx, docRepo, operations)
+	}
+
+	logger.Debug(ctx, "executing bulk write in parallel",
+		zap.Int("operation_count", len(operations)),
+		zap.Int("partitions", len(partitions)),
+	)
+
+	var (
+		mu       sync.Mutex
+		merged   = &repository.BulkResult{UpsertedIDs: make(map[int]interface{})}
+		failures int
+		firstErr error
+		g        errgroup.Group
+	)
+	for _, p := range partitions {
+		g.Go(func() error {
+			res, err := uc.bulkWritePartition(ctx, docRepo, p.operations)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures++
+				if firstErr == nil {
+					firstErr = err
+				}
+				for _, index := range p.indexes {
+					merged.Errors = append(merged.Errors, repository.BulkWriteError{
+						Index:  index,
+						ID:     bulkOperationID(reqOps[index]),
+						Reason: err.Error(),
+					})
+				}
+				return nil
+			}
+			mergeBulkResult(merged, res, p.indexes)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if failures == len(partitions) {
+		return nil, firstErr
+	}
+	sort.Slice(merged.Errors, func(i, j int) bool {
+		return merged.Errors[i].Index < merged.Errors[j].Index
+	})
+	return merged, nil
+}
+
+// bulkWritePartition은 작업 묶음 하나를 서킷 브레이커와 재시도를 거쳐 실행합니다
+func (uc *DocumentUseCase) bulkWritePartition(ctx context.Context, docRepo repository.DocumentRepository, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "bulk_write"), func(ctx context.Context) (*repository.BulkResult, error) {
+			return docRepo.BulkWrite(ctx, operations)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, ok := result.(*repository.BulkResult)
+	if !ok {
+		return nil, fmt.Errorf("unexpected bulk write result type %T", result)
+	}
+	return res, nil
+}
+
+// mergeBulkResult는 파티션 결과를 합치며, 파티션 안의 위치를 요청의 위치로 바꿉니다
+func mergeBulkResult(merged, res *repository.BulkResult, indexes []int) {
+	merged.InsertedCount += res.InsertedCount
+	merged.MatchedCount += res.MatchedCount
+	merged.ModifiedCount += res.ModifiedCount
+	merged.DeletedCount += res.DeletedCount
+	merged.UpsertedCount += res.UpsertedCount
+	for i, id := range res.UpsertedIDs {
+		if i >= 0 && i < len(indexes) {
+			merged.UpsertedIDs[indexes[i]] = id
+		}
+	}
+	for _, e := range res.Errors {
+		if e.Index >= 0 && e.Index < len(indexes) {
+			e.Index = indexes[e.Index]
+		}
+		merged.Errors = append(merged.Errors, e)
+	}
+}
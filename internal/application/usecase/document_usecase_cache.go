@@ -0,0 +1,638 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"go.uber.org/zap"
+)
+
+// defaultCacheTTL은 캐시 정책이 없을 때의 문서 캐시 TTL입니다
+const defaultCacheTTL = 300 * time.Second
+
+// negativeCacheMarker는 존재하지 않는 문서를 나타내는 캐시 값의 필드입니다
+const negativeCacheMarker = "__not_found__"
+
+// CacheStrategy는 컬렉션별 문서 캐시 전략입니다
+type CacheStrategy string
+
+const (
+	// CacheReadThrough는 조회 시 캐시를 채우고 변경 시 무효화합니다 (기본값)
+	CacheReadThrough CacheStrategy = "read_through"
+
+	// CacheWriteThrough는 변경 직후 새 문서를 캐시에 바로 저장합니다
+	CacheWriteThrough CacheStrategy = "write_through"
+
+	// CacheWriteBehind는 변경 시 기존 캐시를 즉시 무효화하고, 새 문서는 큐에 모아 일괄로 저장합니다
+	CacheWriteBehind CacheStrategy = "write_behind"
+
+	// CacheNone은 캐시를 사용하지 않습니다
+	CacheNone CacheStrategy = "none"
+)
+
+// CachePolicy는 컬렉션에 적용할 캐시 전략과 TTL입니다
+type CachePolicy struct {
+	// Collection은 적용 대상 컬렉션입니다 (path.Match 패턴 지원)
+	Collection string
+	Strategy   CacheStrategy
+	TTL        time.Duration
+
+	// MaxDocumentSize보다 큰 문서(JSON 바이트)는 캐시하지 않습니다 (0이면 제한 없음)
+	MaxDocumentSize int
+
+	// StaleWhileRevalidate는 TTL이 지난 뒤에도 캐시 값을 반환하는 기간입니다 (0이면 비활성화)
+	// 이 기간의 조회는 캐시 값을 즉시 반환하고 DB 조회는 백그라운드에서 수행하여, DB가 느려져도 응답 지연이 늘지 않습니다
+	StaleWhileRevalidate time.Duration
+}
+
+// storeTTL은 캐시 항목의 실제 보관 기간(TTL + stale-while-revalidate)을 초 단위로 반환합니다
+func (p CachePolicy) storeTTL() int {
+	return int((p.TTL + p.StaleWhileRevalidate) / time.Second)
+}
+
+// CachePolicies는 컬렉션별 캐시 정책 목록입니다 (먼저 선언된 정책 우선)
+// 관리 API로 실행 중에 변경할 수 있습니다
+type CachePolicies struct {
+	mu            sync.RWMutex
+	defaultPolicy CachePolicy
+	policies      []CachePolicy
+}
+
+// NewCachePolicies는 새로운 CachePolicies를 생성합니다
+// TTL과 MaxDocumentSize가 0인 정책은 기본 정책의 값을 사용합니다
+func NewCachePolicies(defaultPolicy CachePolicy, policies []CachePolicy) (*CachePolicies, error) {
+	if defaultPolicy.Strategy == "" {
+		defaultPolicy.Strategy = CacheReadThrough
+	}
+	if defaultPolicy.TTL <= 0 {
+		defaultPolicy.TTL = defaultCacheTTL
+	}
+	if !defaultPolicy.Strategy.valid() {
+		return nil, fmt.Errorf("invalid default cache strategy: %s", defaultPolicy.Strategy)
+	}
+
+	for i := range policies {
+		if err := policies[i].normalize(defaultPolicy); err != nil {
+			return nil, fmt.Errorf("cache policy %d: %w", i, err)
+		}
+	}
+
+	return &CachePolicies{defaultPolicy: defaultPolicy, policies: policies}, nil
+}
+
+// normalize는 정책을 검증하고 비어 있는 값을 기본 정책으로 채웁니다
+func (p *CachePolicy) normalize(defaultPolicy CachePolicy) error {
+	if p.Collection == "" {
+		return fmt.Errorf("collection is required")
+	}
+	if _, err := path.Match(p.Collection, ""); err != nil {
+		return fmt.Errorf("invalid collection pattern %q: %w", p.Collection, err)
+	}
+	if !p.Strategy.valid() {
+		return fmt.Errorf("invalid strategy: %s", p.Strategy)
+	}
+	if p.TTL <= 0 {
+		p.TTL = defaultPolicy.TTL
+	}
+	if p.MaxDocumentSize < 0 {
+		return fmt.Errorf("max document size must not be negative")
+	}
+	if p.MaxDocumentSize == 0 {
+		p.MaxDocumentSize = defaultPolicy.MaxDocumentSize
+	}
+	if p.StaleWhileRevalidate < 0 {
+		return fmt.Errorf("stale while revalidate must not be negative")
+	}
+	return nil
+}
+
+// For는 컬렉션에 적용할 정책을 반환합니다
+func (p *CachePolicies) For(collection string) CachePolicy {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, policy := range p.policies {
+		if ok, _ := path.Match(policy.Collection, collection); ok {
+			return policy
+		}
+	}
+	return p.defaultPolicy
+}
+
+// Snapshot은 기본 정책과 컬렉션별 정책 목록의 사본을 반환합니다
+func (p *CachePolicies) Snapshot() (CachePolicy, []CachePolicy) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	policies := make([]CachePolicy, len(p.policies))
+	copy(policies, p.policies)
+	return p.defaultPolicy, policies
+}
+
+// Put은 컬렉션 정책을 추가하거나 교체합니다
+// 같은 Collection 값의 정책이 있으면 그 자리에서 교체하고, 없으면 기존 패턴보다 우선하도록 맨 앞에 추가합니다
+func (p *CachePolicies) Put(policy CachePolicy) (CachePolicy, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := policy.normalize(p.defaultPolicy); err != nil {
+		return CachePolicy{}, err
+	}
+
+	for i := range p.policies {
+		if p.policies[i].Collection == policy.Collection {
+			p.policies[i] = policy
+			return policy, nil
+		}
+	}
+	p.policies = append([]CachePolicy{policy}, p.policies...)
+	return policy, nil
+}
+
+// Remove는 컬렉션 정책을 제거합니다 (제거되면 true)
+func (p *CachePolicies) Remove(collection string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := range p.policies {
+		if p.policies[i].Collection == collection {
+			p.policies = append(p.policies[:i], p.policies[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// SetTTLs는 전략은 그대로 두고 기본 정책과 컬렉션 정책의 TTL만 바꿉니다 (설정 다시 읽기용)
+// ttls는 Collection 값별 TTL이며 0이면 기본 TTL을 사용합니다. ttls에 없는 정책(관리 API로 추가한 정책 등)은 유지합니다
+func (p *CachePolicies) SetTTLs(defaultTTL time.Duration, ttls map[string]time.Duration) {
+	if defaultTTL <= 0 {
+		defaultTTL = defaultCacheTTL
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.defaultPolicy.TTL = defaultTTL
+	for i := range p.policies {
+		ttl, ok := ttls[p.policies[i].Collection]
+		if !ok {
+			continue
+		}
+		if ttl <= 0 {
+			ttl = defaultTTL
+		}
+		p.policies[i].TTL = ttl
+	}
+}
+
+// UsesWriteBehind는 write-behind 전략을 쓰는 정책이 있는지 확인합니다
+func (p *CachePolicies) UsesWriteBehind() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.defaultPolicy.Strategy == CacheWriteBehind {
+		return true
+	}
+	for _, policy := range p.policies {
+		if policy.Strategy == CacheWriteBehind {
+			return true
+		}
+	}
+	return false
+}
+
+// valid는 지원하는 전략인지 확인합니다
+func (s CacheStrategy) valid() bool {
+	switch s {
+	case CacheReadThrough, CacheWriteThrough, CacheWriteBehind, CacheNone:
+		return true
+	}
+	return false
+}
+
+// SetCachePolicies는 컬렉션별 캐시 전략을 설정합니다
+// 설정하지 않으면 모든 컬렉션에 read-through 전략을 적용합니다
+func (uc *DocumentUseCase) SetCachePolicies(policies *CachePolicies) {
+	uc.cachePolicies = policies
+}
+
+// SetFeatureFlags는 캐시 전략을 요청의 환경/테넌트별로 켜고 끄는 기능 플래그를 설정합니다
+// 설정하지 않으면 모든 전략을 정책대로 사용합니다
+func (uc *DocumentUseCase) SetFeatureFlags(flags *featureflag.Flags) {
+	uc.featureFlags = flags
+}
+
+// SetCacheWriteQueue는 write-behind 전략에서 사용할 쓰기 큐를 설정합니다
+// 설정하지 않으면 write-behind 컬렉션은 write-through로 동작합니다
+func (uc *DocumentUseCase) SetCacheWriteQueue(queue repository.CacheWriteQueue) {
+	uc.cacheWriteQueue = queue
+}
+
+// SetNegativeCacheTTL은 존재하지 않는 문서 조회 결과를 캐시할 기간을 설정합니다
+// 없는 ID의 반복 조회로부터 DB를 보호하며, 0이면 비활성화합니다
+// 문서가 생성되면 캐시 갱신/무효화 경로에서 자동으로 제거됩니다
+func (uc *DocumentUseCase) SetNegativeCacheTTL(ttl time.Duration) {
+	uc.negativeCacheTTL.Store(int64(ttl))
+}
+
+// SetEarlyRefresh는 확률적 조기 갱신(XFetch)의 beta 값을 설정합니다 (0이면 비활성화)
+// 캐시 적중 시 남은 TTL이 최근 DB 조회 시간에 비해 짧을수록 높은 확률로 백그라운드 갱신하여,
+// 핫 키가 만료되는 순간 동시 미스가 몰리는 것을 막습니다. 클수록 더 일찍 갱신하며 1이 일반적입니다
+// 캐시 저장소가 repository.CacheTTLReader를 구현해야 동작합니다
+func (uc *DocumentUseCase) SetEarlyRefresh(beta float64) {
+	uc.earlyRefresh = beta
+}
+
+// InvalidateCachedDocument는 다른 인스턴스에서 변경된 문서와 컬렉션 쿼리 결과의 캐시를 무효화합니다 (CDC 이벤트 처리용)
+func (uc *DocumentUseCase) InvalidateCachedDocument(ctx context.Context, collection, id string) {
+	if collection == "" || id == "" {
+		return
+	}
+	uc.cacheEvict(ctx, collection, id)
+}
+
+// ListCachePolicies는 현재 적용 중인 캐시 정책을 반환합니다
+func (uc *DocumentUseCase) ListCachePolicies(ctx context.Context) *dto.CachePolicyListResponse {
+	if uc.cachePolicies == nil {
+		return &dto.CachePolicyListResponse{
+			Default:  toCachePolicyDTO(uc.cachePolicy(ctx, "")),
+			Policies: []dto.CachePolicy{},
+		}
+	}
+
+	defaultPolicy, policies := uc.cachePolicies.Snapshot()
+	resp := &dto.CachePolicyListResponse{
+		Default:  toCachePolicyDTO(defaultPolicy),
+		Policies: make([]dto.CachePolicy, 0, len(policies)),
+	}
+	for _, policy := range policies {
+		resp.Policies = append(resp.Policies, toCachePolicyDTO(policy))
+	}
+	return resp
+}
+
+// PutCachePolicy는 컬렉션 캐시 정책을 추가하거나 교체합니다
+// 변경은 이 인스턴스에만 적용되며 재시작 시 설정 파일 값으로 돌아갑니다
+func (uc *DocumentUseCase) PutCachePolicy(ctx context.Context, req *dto.PutCachePolicyRequest) (*dto.CachePolicy, error) {
+	if uc.cachePolicies == nil {
+		return nil, fmt.Errorf("cache policies are not configured")
+	}
+
+	policy, err := uc.cachePolicies.Put(CachePolicy{
+		Collection:           req.Collection,
+		Strategy:             CacheStrategy(req.Strategy),
+		TTL:                  time.Duration(req.TTLSeconds) * time.Second,
+		MaxDocumentSize:      req.MaxDocumentSize,
+		StaleWhileRevalidate: time.Duration(req.StaleWhileRevalidateSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid cache policy: %w", err)
+	}
+
+	logger.Info(ctx, "cache policy updated",
+		zap.String("collection", policy.Collection),
+		zap.String("strategy", string(policy.Strategy)),
+		zap.Duration("ttl", policy.TTL),
+		zap.Int("max_document_size", policy.MaxDocumentSize),
+		zap.Duration("stale_while_revalidate", policy.StaleWhileRevalidate),
+	)
+	resp := toCachePolicyDTO(policy)
+	return &resp, nil
+}
+
+// DeleteCachePolicy는 컬렉션 캐시 정책을 제거합니다 (이후 다른 패턴 또는 기본 정책 적용)
+func (uc *DocumentUseCase) DeleteCachePolicy(ctx context.Context, collection string) (bool, error) {
+	if uc.cachePolicies == nil {
+		return false, fmt.Errorf("cache policies are not configured")
+	}
+
+	removed := uc.cachePolicies.Remove(collection)
+	if removed {
+		logger.Info(ctx, "cache policy removed", zap.String("collection", collection))
+	}
+	return removed, nil
+}
+
+// toCachePolicyDTO는 캐시 정책을 DTO로 변환합니다
+func toCachePolicyDTO(policy CachePolicy) dto.CachePolicy {
+	return dto.CachePolicy{
+		Collection:                  policy.Collection,
+		Strategy:                    string(policy.Strategy),
+		TTLSeconds:                  int(policy.TTL / time.Second),
+		MaxDocumentSize:             policy.MaxDocumentSize,
+		StaleWhileRevalidateSeconds: int(policy.StaleWhileRevalidate / time.Second),
+	}
+}
+
+// cachePolicy는 컬렉션의 캐시 정책을 반환합니다
+// write-through/write-behind 전략은 기능 플래그(cache.<전략>)가 꺼진 요청에서 read-through로 동작합니다
+func (uc *DocumentUseCase) cachePolicy(ctx context.Context, collection string) CachePolicy {
+	if uc.cachePolicies == nil {
+		return CachePolicy{Collection: "*", Strategy: CacheReadThrough, TTL: defaultCacheTTL}
+	}
+	policy := uc.cachePolicies.For(collection)
+	if (policy.Strategy == CacheWriteThrough || policy.Strategy == CacheWriteBehind) &&
+		!uc.featureFlags.Enabled(ctx, featureflag.CacheStrategy(string(policy.Strategy))) {
+		policy.Strategy = CacheReadThrough
+	}
+	return policy
+}
+
+// exceedsCacheSize는 문서가 정책의 최대 캐시 크기를 넘는지 확인합니다
+func exceedsCacheSize(policy CachePolicy, doc *entity.Document) bool {
+	if policy.MaxDocumentSize <= 0 || doc == nil {
+		return false
+	}
+	data, err := json.Marshal(doc.Data())
+	if err != nil {
+		return true
+	}
+	return len(data) > policy.MaxDocumentSize
+}
+
+// documentCacheKey는 문서 캐시 키를 생성합니다
+func documentCacheKey(collection, id string) string {
+	return fmt.Sprintf("document:%s:%s", collection, id)
+}
+
+// cachedDocument는 문서 캐시에 저장하는 문서 스냅샷입니다
+// entity.Document는 필드가 비공개라 그대로 직렬화하면 빈 객체가 되므로 이 형태로 저장하고 읽을 때 재구성합니다
+type cachedDocument struct {
+	ID         string                 `json:"id"`
+	Collection string                 `json:"collection"`
+	Data       map[string]interface{} `json:"data"`
+	Version    int                    `json:"version"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+	ExpiresAt  *time.Time             `json:"expires_at,omitempty"`
+}
+
+// newCachedDocument는 문서를 캐시 스냅샷으로 변환합니다
+func newCachedDocument(doc *entity.Document) *cachedDocument {
+	snapshot := &cachedDocument{
+		ID:         doc.ID(),
+		Collection: doc.Collection(),
+		Data:       doc.Data(),
+		Version:    doc.Version(),
+		CreatedAt:  doc.CreatedAt(),
+		UpdatedAt:  doc.UpdatedAt(),
+	}
+	if expiresAt := doc.ExpiresAt(); !expiresAt.IsZero() {
+		snapshot.ExpiresAt = &expiresAt
+	}
+	return snapshot
+}
+
+// decodeCachedDocument는 캐시 값을 문서로 재구성합니다
+// 캐시 저장소에 따라 역직렬화된 맵 또는 스냅샷 그대로 반환되므로 JSON을 거쳐 해석합니다
+func decodeCachedDocument(cached interface{}) (*entity.Document, error) {
+	raw, err := json.Marshal(cached)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cached document: %w", err)
+	}
+	var snapshot cachedDocument
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode cached document: %w", err)
+	}
+	if snapshot.ID == "" || snapshot.Data == nil {
+		return nil, fmt.Errorf("cached document is missing id or data")
+	}
+
+	doc := entity.ReconstructDocument(snapshot.ID, snapshot.Collection, snapshot.Data, snapshot.Version, snapshot.CreatedAt, snapshot.UpdatedAt)
+	if snapshot.ExpiresAt != nil {
+		doc.SetExpiresAt(*snapshot.ExpiresAt)
+	}
+	return doc, nil
+}
+
+// cacheLookup은 캐시에서 문서를 조회합니다 (none 전략이면 항상 miss)
+func (uc *DocumentUseCase) cacheLookup(ctx context.Context, collection, id string) (interface{}, bool) {
+	if uc.cachePolicy(ctx, collection).Strategy == CacheNone {
+		return nil, false
+	}
+	cached, err := uc.cacheGet(ctx, "document", collection, documentCacheKey(collection, id))
+	if err != nil {
+		return nil, false
+	}
+	return cached, true
+}
+
+// cacheFill은 조회한 문서를 캐시에 저장합니다 (read-through)
+func (uc *DocumentUseCase) cacheFill(ctx context.Context, collection string, doc *entity.Document) {
+	policy := uc.cachePolicy(ctx, collection)
+	if policy.Strategy == CacheNone {
+		return
+	}
+	if exceedsCacheSize(policy, doc) {
+		uc.cacheEvict(ctx, collection, doc.ID())
+		return
+	}
+	if err := uc.cacheSet(ctx, "document", collection, documentCacheKey(collection, doc.ID()), newCachedDocument(doc), expiryCacheTTL(policy.storeTTL(), doc)); err != nil {
+		logger.Warn(ctx, "failed to cache document", zap.Error(err))
+	}
+}
+
+// cacheMissing은 문서가 존재하지 않는다는 결과를 짧게 캐시합니다 (negative caching)
+// 문서 캐시와 같은 키를 사용하므로 생성 시 cacheFill/cacheWritten이 이 항목을 덮어쓰거나 제거합니다
+func (uc *DocumentUseCase) cacheMissing(ctx context.Context, collection, id string) {
+	negativeTTL := time.Duration(uc.negativeCacheTTL.Load())
+	if negativeTTL <= 0 || uc.cachePolicy(ctx, collection).Strategy == CacheNone {
+		return
+	}
+	ttl := int(negativeTTL / time.Second)
+	if ttl < 1 {
+		ttl = 1
+	}
+	marker := map[string]interface{}{negativeCacheMarker: true}
+	if err := uc.cacheSet(ctx, "document", collection, documentCacheKey(collection, id), marker, ttl); err != nil {
+		logger.Warn(ctx, "failed to cache missing document", zap.Error(err))
+	}
+}
+
+// isNegativeCacheEntry는 캐시 값이 존재하지 않는 문서 표시인지 확인합니다
+func isNegativeCacheEntry(cached interface{}) bool {
+	m, ok := cached.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	notFound, _ := m[negativeCacheMarker].(bool)
+	return notFound
+}
+
+// loadDocument는 DB에서 문서를 조회하고 결과를 캐시에 반영합니다
+// 같은 데이터베이스의 같은 문서에 대한 동시 조회는 singleflight로 한 번만 실행되어
+// 핫 키가 만료되어도 DB에 동일한 조회가 몰리지 않습니다 (coalesce 참고)
+func (uc *DocumentUseCase) loadDocument(ctx context.Context, docRepo repository.DocumentRepository, collection, id string) (*entity.Document, error) {
+	// strong 읽기가 복제본 조회와 합쳐지지 않도록 읽기 일관성 수준도 키에 포함합니다
+	key := string(middleware.GetDatabaseType(ctx)) + ":" + string(middleware.GetReadConsistency(ctx)) + ":" + documentCacheKey(collection, id)
+
+	result, err := uc.coalesce(ctx, key, collection, func(ctx context.Context) (interface{}, error) {
+		start := time.Now()
+		result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+			return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "find"), func(ctx context.Context) (*entity.Document, error) {
+				return docRepo.FindByID(ctx, collection, id)
+			})
+		})
+		uc.lastLoadNanos.Store(int64(time.Since(start)))
+
+		if err != nil {
+			if errors.Is(err, entity.ErrDocumentNotFound) {
+				uc.cacheMissing(ctx, collection, id)
+			}
+			return nil, err
+		}
+
+		// 보관된 문서는 되살린 뒤 캐시에 채워, 캐시 적중 시에도 스텁이 반환되지 않게 함
+		doc, err := uc.rehydrate(ctx, result.(*entity.Document), true)
+		if err != nil {
+			return nil, err
+		}
+		uc.cacheFill(ctx, collection, doc)
+		return doc, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*entity.Document), nil
+}
+
+// shouldRefreshEarly는 캐시 항목을 만료 전에 갱신할지 결정합니다 (XFetch)
+// 남은 TTL <= -delta * beta * ln(rand) 이면 갱신하며, delta는 최근 DB 조회 시간입니다
+func (uc *DocumentUseCase) shouldRefreshEarly(ctx context.Context, key string) bool {
+	if uc.earlyRefresh <= 0 {
+		return false
+	}
+	reader, ok := uc.cacheRepo.(repository.CacheTTLReader)
+	if !ok {
+		return false
+	}
+	delta := time.Duration(uc.lastLoadNanos.Load())
+	if delta <= 0 {
+		return false
+	}
+
+	remaining, err := reader.TTL(ctx, key)
+	if err != nil || remaining <= 0 {
+		return false
+	}
+	gap := -float64(delta) * uc.earlyRefresh * math.Log(1-rand.Float64())
+	return gap >= float64(remaining)
+}
+
+// isStale은 캐시 항목이 TTL을 지나 stale-while-revalidate 기간에 있는지 확인합니다
+// 캐시 저장소가 repository.CacheTTLReader를 구현하지 않으면 항상 false입니다
+func (uc *DocumentUseCase) isStale(ctx context.Context, policy CachePolicy, key string) bool {
+	if policy.StaleWhileRevalidate <= 0 {
+		return false
+	}
+	reader, ok := uc.cacheRepo.(repository.CacheTTLReader)
+	if !ok {
+		return false
+	}
+	remaining, err := reader.TTL(ctx, key)
+	if err != nil || remaining <= 0 {
+		return false
+	}
+	return remaining <= policy.StaleWhileRevalidate
+}
+
+// refreshInBackground는 캐시 항목을 백그라운드에서 DB 값으로 다시 채웁니다
+func (uc *DocumentUseCase) refreshInBackground(ctx context.Context, docRepo repository.DocumentRepository, collection, id string) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if _, err := uc.loadDocument(ctx, docRepo, collection, id); err != nil && !errors.Is(err, entity.ErrDocumentNotFound) {
+			logger.Warn(ctx, "background cache refresh failed",
+				zap.String("collection", collection),
+				zap.String("id", id),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// cacheWritten은 문서 생성/변경 후 컬렉션 전략에 따라 캐시를 갱신합니다
+// doc이 nil이거나(변경 결과를 알 수 없는 경우) 최대 캐시 크기를 넘으면 무효화만 합니다
+func (uc *DocumentUseCase) cacheWritten(ctx context.Context, collection, id string, doc *entity.Document) {
+	policy := uc.cachePolicy(ctx, collection)
+	key := documentCacheKey(collection, id)
+	uc.invalidateQueries(ctx, collection)
+
+	strategy := policy.Strategy
+	if strategy == CacheWriteBehind && uc.cacheWriteQueue == nil {
+		strategy = CacheWriteThrough
+	}
+	if (doc == nil || exceedsCacheSize(policy, doc)) && strategy != CacheNone {
+		strategy = CacheReadThrough
+	}
+
+	switch strategy {
+	case CacheWriteThrough:
+		if err := uc.cacheSet(ctx, "document", collection, key, newCachedDocument(doc), expiryCacheTTL(policy.storeTTL(), doc)); err != nil {
+			logger.Warn(ctx, "failed to write through cache", zap.Error(err))
+			uc.evictDocument(ctx, collection, key)
+		}
+	case CacheWriteBehind:
+		if err := uc.cacheDelete(ctx, "document", collection, key); err != nil {
+			logger.Warn(ctx, "failed to invalidate cache", zap.Error(err))
+		}
+		uc.cacheWriteQueue.Enqueue(key, newCachedDocument(doc), policy.TTL+policy.StaleWhileRevalidate)
+	default:
+		uc.evictDocument(ctx, collection, key)
+	}
+}
+
+// cacheEvict는 문서 캐시와 컬렉션의 쿼리 결과 캐시를 무효화합니다
+func (uc *DocumentUseCase) cacheEvict(ctx context.Context, collection, id string) {
+	uc.evictDocument(ctx, collection, documentCacheKey(collection, id))
+	uc.invalidateQueries(ctx, collection)
+}
+
+// evictDocument는 문서 캐시를 제거하고 대기 중인 write-behind 쓰기를 취소합니다
+func (uc *DocumentUseCase) evictDocument(ctx context.Context, collection, key string) {
+	if uc.cacheWriteQueue != nil {
+		uc.cacheWriteQueue.Discard(key)
+	}
+	if err := uc.cacheDelete(ctx, "document", collection, key); err != nil {
+		logger.Warn(ctx, "failed to invalidate cache", zap.Error(err))
+	}
+}
+
+// cacheGet은 캐시에서 값을 조회하고 지연 시간을 기록합니다
+// 캐시 저장소는 미스도 에러로 반환하므로 조회 실패는 에러로 집계하지 않습니다 (히트/미스는 호출자가 기록)
+func (uc *DocumentUseCase) cacheGet(ctx context.Context, cacheName, collection, key string) (interface{}, error) {
+	start := time.Now()
+	value, err := uc.cacheRepo.Get(ctx, key)
+	uc.metrics.RecordCacheOperation(cacheName, collection, "get", time.Since(start), false)
+	return value, err
+}
+
+// cacheSet은 캐시에 값을 저장하고 지연 시간과 실패를 기록합니다
+func (uc *DocumentUseCase) cacheSet(ctx context.Context, cacheName, collection, key string, value interface{}, ttl int) error {
+	start := time.Now()
+	err := uc.cacheRepo.Set(ctx, key, value, ttl)
+	uc.metrics.RecordCacheOperation(cacheName, collection, "set", time.Since(start), err != nil)
+	return err
+}
+
+// cacheDelete는 캐시에서 값을 삭제하고 지연 시간과 실패를 기록합니다
+func (uc *DocumentUseCase) cacheDelete(ctx context.Context, cacheName, collection, key string) error {
+	start := time.Now()
+	err := uc.cacheRepo.Delete(ctx, key)
+	uc.metrics.RecordCacheOperation(cacheName, collection, "delete", time.Since(start), err != nil)
+	return err
+}
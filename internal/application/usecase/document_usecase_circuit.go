@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/circuitbreaker"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// NewCircuitBreakers는 데이터베이스 종류별 circuit breaker 모음을 생성합니다
+// backends에 없는 데이터베이스는 defaults를 사용하며, 상태 변경은 로그와 circuit_breaker_* 메트릭으로 기록됩니다
+func NewCircuitBreakers(defaults circuitbreaker.Settings, backends map[string]circuitbreaker.Settings) *circuitbreaker.Registry {
+	m := metrics.GetMetrics()
+	return circuitbreaker.NewRegistry(defaults, backends, func(name string, from circuitbreaker.State, to circuitbreaker.State) {
+		logger.Info(context.Background(), "circuit breaker state changed",
+			zap.String("name", name),
+			zap.String("from", from.String()),
+			zap.String("to", to.String()),
+		)
+		m.RecordCircuitBreakerTransition(name, from.String(), to.String(), int(to))
+	})
+}
+
+// SetCircuitBreakers는 데이터베이스 종류별 circuit breaker 모음을 설정합니다
+func (uc *DocumentUseCase) SetCircuitBreakers(breakers *circuitbreaker.Registry) {
+	uc.circuitBreakers = breakers
+}
+
+// CircuitBreakers는 데이터베이스 종류별 circuit breaker 모음을 반환합니다 (관리 API용)
+func (uc *DocumentUseCase) CircuitBreakers() *circuitbreaker.Registry {
+	return uc.circuitBreakers
+}
+
+// breaker는 요청의 데이터베이스 종류에 해당하는 circuit breaker를 반환합니다
+// 한 백엔드가 장애로 열려도 다른 백엔드로 가는 요청은 영향을 받지 않습니다
+func (uc *DocumentUseCase) breaker(ctx context.Context) *circuitbreaker.CircuitBreaker {
+	return uc.circuitBreakers.Get(string(middleware.GetDatabaseType(ctx)))
+}
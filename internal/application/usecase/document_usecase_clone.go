@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// defaultCloneBatchSize는 복제 시 SaveMany 한 번에 저장하는 기본 문서 수입니다
+const defaultCloneBatchSize = 500
+
+// CloneCollection은 컬렉션의 문서(filter와 일치하는 문서)와 인덱스를 새 컬렉션으로 복제합니다 (운영 데이터 형태로 스테이징 실험)
+// 문서는 커서로 순회하며 배치 단위로 저장하므로 메모리에 컬렉션 전체를 올리지 않습니다.
+// 대상 컬렉션은 없거나 비어 있어야 하며, 문서 ID·버전·생성/수정 시각은 원본과 같게 유지합니다
+func (uc *DocumentUseCase) CloneCollection(ctx context.Context, req *dto.CloneCollectionRequest) (*dto.CloneCollectionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CloneCollection")
+	defer span.End()
+
+	if req.Target == "" || req.Target == req.Source {
+		return nil, fmt.Errorf("%w: target must be a different collection", entity.ErrInvalidData)
+	}
+	batchSize := req.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultCloneBatchSize
+	}
+
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	tracing.SetAttributes(ctx,
+		attribute.String("source", req.Source),
+		attribute.String("target", req.Target),
+	)
+
+	exists, err := docRepo.CollectionExists(ctx, req.Source)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: collection %s does not exist", entity.ErrDocumentNotFound, req.Source)
+	}
+	if err := prepareCloneTarget(ctx, docRepo, req.Target); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	start := time.Now()
+	logger.Info(ctx, "cloning collection",
+		zap.String("source", req.Source),
+		zap.String("target", req.Target),
+		zap.Bool("filtered", len(req.Filter) > 0),
+	)
+
+	resp := &dto.CloneCollectionResponse{Source: req.Source, Target: req.Target, Indexes: []string{}}
+	resp.Documents, err = uc.cloneDocuments(ctx, docRepo, req.Source, req.Target, req.Filter, batchSize)
+	if err == nil && !req.SkipIndexes {
+		resp.Indexes, resp.SkippedIndexes, err = uc.cloneIndexes(ctx, docRepo, req.Source, req.Target)
+	}
+
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpCloneCollection,
+		Collection: req.Source,
+		After: map[string]interface{}{
+			"target":    req.Target,
+			"filter":    req.Filter,
+			"documents": resp.Documents,
+		},
+	}, err)
+
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to clone collection",
+			zap.String("source", req.Source),
+			zap.String("target", req.Target),
+			zap.Int64("documents", resp.Documents),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to clone collection: %w", err)
+	}
+
+	logger.Info(ctx, "collection cloned successfully",
+		zap.String("source", req.Source),
+		zap.String("target", req.Target),
+		zap.Int64("documents", resp.Documents),
+		zap.Int("indexes", len(resp.Indexes)),
+		zap.Int("skipped_indexes", len(resp.SkippedIndexes)),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return resp, nil
+}
+
+// prepareCloneTarget은 복제할 컬렉션을 만듭니다 (이미 있으면 비어 있어야 함)
+// 기존 문서와 섞이거나 덮어쓰지 않도록 문서가 있는 컬렉션에는 복제하지 않습니다
+func prepareCloneTarget(ctx context.Context, docRepo repository.DocumentRepository, target string) error {
+	exists, err := docRepo.CollectionExists(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		if err := docRepo.CreateCollection(ctx, target); err != nil {
+			return fmt.Errorf("failed to create target collection: %w", err)
+		}
+		return nil
+	}
+	count, err := docRepo.Count(ctx, target, map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to count documents: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: target collection %s is not empty", entity.ErrInvalidData, target)
+	}
+	return nil
+}
+
+// cloneDocuments는 source의 문서를 순회하며 batchSize개씩 target에 저장하고 저장한 문서 수를 반환합니다
+// 보관된 문서는 보관 객체의 데이터로 되살려 저장하므로 복제본이 원본과 보관 객체를 공유하지 않습니다
+func (uc *DocumentUseCase) cloneDocuments(ctx context.Context, docRepo repository.DocumentRepository, source, target string, filter map[string]interface{}, batchSize int) (int64, error) {
+	if filter == nil {
+		filter = map[string]interface{}{}
+	}
+	it, err := uc.openStream(ctx, docRepo, source, filter, &repository.FindOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan documents: %w", err)
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	var copied int64
+	batch := make([]*entity.Document, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := docRepo.SaveMany(ctx, batch); err != nil {
+			return fmt.Errorf("failed to save documents: %w", err)
+		}
+		copied += int64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return copied, fmt.Errorf("failed to scan documents: %w", err)
+		}
+		if doc, err = uc.rehydrate(ctx, doc, false); err != nil {
+			return copied, err
+		}
+		clone := entity.ReconstructDocument(doc.ID(), target, doc.Data(), doc.Version(), doc.CreatedAt(), doc.UpdatedAt())
+		clone.SetExpiresAt(doc.ExpiresAt())
+		batch = append(batch, clone)
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return copied, err
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return copied, fmt.Errorf("failed to scan documents: %w", err)
+	}
+	if err := flush(); err != nil {
+		return copied, err
+	}
+	return copied, nil
+}
+
+// cloneIndexes는 source의 인덱스를 target에 같은 이름으로 만듭니다
+// 저장소가 자동으로 만드는 기본 인덱스는 건너뛰며, 옮길 수 없는 인덱스와 생성에 실패한 인덱스는 skipped로 반환하고 계속 진행합니다
+func (uc *DocumentUseCase) cloneIndexes(ctx context.Context, docRepo repository.DocumentRepository, source, target string) (created, skipped []string, err error) {
+	specs, err := docRepo.ListIndexes(ctx, source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+
+	created = []string{}
+	for _, spec := range specs {
+		if repository.IsDefaultIndex(spec) {
+			continue
+		}
+		name, _ := spec["name"].(string)
+		model, ok := repository.IndexModelFromSpec(spec)
+		if !ok {
+			skipped = append(skipped, name)
+			continue
+		}
+
+		indexName, err := docRepo.CreateIndex(ctx, target, model)
+		uc.recordAudit(ctx, &entity.AuditEntry{
+			Operation:  entity.AuditOpCreateIndex,
+			Collection: target,
+			DocumentID: indexName,
+			After:      model.Keys,
+		}, err)
+		if err != nil {
+			logger.Warn(ctx, "failed to clone index",
+				zap.String("target", target),
+				zap.String("index", name),
+				zap.Error(err),
+			)
+			skipped = append(skipped, name)
+			continue
+		}
+		created = append(created, indexName)
+	}
+	return created, skipped, nil
+}
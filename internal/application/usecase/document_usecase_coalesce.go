@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// coalescedReadTimeout은 합쳐진 조회 한 번의 최대 실행 시간입니다
+// 조회는 요청 컨텍스트와 분리되어 실행되므로 요청 마감 시간 대신 이 값(읽기 시간 한도가 설정되어 있으면 그 값)으로 제한합니다
+const coalescedReadTimeout = 30 * time.Second
+
+// coalesce는 같은 키로 동시에 들어온 조회를 백엔드 조회 한 번으로 합칩니다
+// 조회는 먼저 도착한 요청의 취소와 분리된 컨텍스트로 실행되므로 그 요청이 취소되어도 함께 기다리던 요청은 결과를 받습니다
+// 각 요청은 자신의 컨텍스트가 취소되면 결과를 기다리지 않고 바로 반환합니다
+func (uc *DocumentUseCase) coalesce(ctx context.Context, key, collection string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	ch := uc.loadGroup.DoChan(key, func() (interface{}, error) {
+		// 취소될 수 없는 컨텍스트는 분리할 필요가 없습니다
+		if ctx.Done() == nil {
+			return fn(ctx)
+		}
+		timeout := coalescedReadTimeout
+		if uc.operationTimeouts.Read > 0 {
+			timeout = uc.operationTimeouts.Read
+		}
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		return fn(loadCtx)
+	})
+
+	select {
+	case res := <-ch:
+		if res.Shared {
+			uc.metrics.RecordCoalescedRead(collection)
+			logger.Debug(ctx, "document read shared with concurrent request", zap.String("key", key))
+		}
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// findDocument는 주 저장소에서 문서를 조회하며, 같은 문서에 대한 동시 조회를 하나로 합칩니다
+// 반환된 문서는 다른 요청과 공유될 수 있으므로 읽기 전용으로만 사용해야 합니다
+func (uc *DocumentUseCase) findDocument(ctx context.Context, docRepo repository.DocumentRepository, collection, id string) (*entity.Document, error) {
+	// 캐시를 채우는 loadDocument와 키가 겹치지 않도록 접두사를 붙입니다
+	key := "find:" + string(middleware.GetDatabaseType(ctx)) + ":" + documentCacheKey(collection, id)
+
+	result, err := uc.coalesce(ctx, key, collection, func(ctx context.Context) (interface{}, error) {
+		return docRepo.FindByID(ctx, collection, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*entity.Document), nil
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/dedup"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// duplicateScanProgressEvery는 중복 탐지 중 진행 상황을 보고하는 문서 간격입니다
+const duplicateScanProgressEvery = 1000
+
+// 병합으로 옮기지 않는 시스템 필드 (삭제/보관/만료 상태는 남길 문서의 것을 유지)
+var unmergeableFields = map[string]bool{
+	entity.DeletedAtField:  true,
+	entity.ArchiveKeyField: true,
+	entity.ArchivedAtField: true,
+	entity.ExpiresAtField:  true,
+}
+
+// ScanDuplicates는 컬렉션의 모든 문서를 순회하며 키 필드 값이 같은 문서를 묶습니다
+// 만료되었거나 소프트 삭제된 문서, 키 필드가 없는 문서는 제외하며, progress는 duplicateScanProgressEvery개마다 호출됩니다
+func (uc *DocumentUseCase) ScanDuplicates(ctx context.Context, req *dto.StartDuplicateScanRequest, progress func(scanned int64)) ([]*dedup.Group, int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ScanDuplicates")
+	defer span.End()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, 0, err
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.StringSlice("fields", req.Fields),
+	)
+
+	it, err := uc.openStream(ctx, docRepo, req.Collection, nil, &repository.FindOptions{})
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, 0, fmt.Errorf("failed to scan documents: %w", err)
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	grouper := dedup.NewGrouper(req.Fields, dedup.Options{CaseInsensitive: req.CaseInsensitive, TrimSpace: req.TrimSpace})
+	var scanned int64
+	now := time.Now()
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, scanned, fmt.Errorf("failed to scan documents: %w", err)
+		}
+		scanned++
+		if scanned%duplicateScanProgressEvery == 0 && progress != nil {
+			progress(scanned)
+		}
+		if doc.IsExpired(now) || uc.hideDeleted(req.Collection, doc, false) {
+			continue
+		}
+		grouper.Add(doc.ID(), doc.CreatedAt(), doc.Data())
+	}
+	if err := it.Err(); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, scanned, fmt.Errorf("failed to scan documents: %w", err)
+	}
+	if progress != nil {
+		progress(scanned)
+	}
+
+	return grouper.Groups(), scanned, nil
+}
+
+// ResolveDuplicates는 중복 문서를 정리합니다
+// 정리 전에 남길 문서와 중복 문서의 키가 모두 같은지 다시 확인하고, 하나라도 다르면 아무것도 바꾸지 않습니다
+// merge는 남길 문서에 없는(또는 null인) 최상위 필드를 중복 문서의 값으로 채우고, 중복 문서를 가리키던 참조를 남길 문서로 바꾼 뒤 중복 문서를 삭제합니다
+// 수정과 삭제는 UpdateDocument/DeleteDocument로 처리하므로 문서별 감사 로그와 캐시 무효화가 적용되며, 정리 전체도 감사 로그에 한 건으로 남깁니다
+func (uc *DocumentUseCase) ResolveDuplicates(ctx context.Context, req *dto.ResolveDuplicatesRequest) (*dto.ResolveDuplicatesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ResolveDuplicates")
+	defer span.End()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("keep_id", req.KeepID),
+		attribute.String("action", req.Action),
+	)
+
+	keep, duplicates, err := uc.loadDuplicates(ctx, docRepo, req)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	resp := &dto.ResolveDuplicatesResponse{
+		Collection: req.Collection,
+		Action:     req.Action,
+		KeepID:     keep.ID(),
+		Version:    keep.Version(),
+		DeletedIDs: []string{},
+	}
+	before, beforeVersion := auditDocumentState(keep)
+	after := before
+
+	err = func() error {
+		if req.Action == "merge" {
+			merged, fields := mergeDuplicateData(keep.Data(), duplicates)
+			if len(fields) > 0 {
+				if err := uc.UpdateDocument(ctx, &dto.UpdateDocumentRequest{
+					Collection: req.Collection,
+					ID:         keep.ID(),
+					Data:       merged,
+					Version:    keep.Version(),
+				}); err != nil {
+					return fmt.Errorf("failed to merge into %s: %w", keep.ID(), err)
+				}
+				resp.MergedFields, resp.Version, after = fields, keep.Version()+1, merged
+			}
+
+			for _, dup := range duplicates {
+				repointed, err := uc.repointReferences(ctx, docRepo, req.Collection, dup.ID(), keep.ID())
+				resp.Repointed += repointed
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, dup := range duplicates {
+			err := uc.DeleteDocument(ctx, &dto.DeleteDocumentRequest{Collection: req.Collection, ID: dup.ID()})
+			if err != nil {
+				return fmt.Errorf("failed to delete duplicate %s: %w", dup.ID(), err)
+			}
+			resp.DeletedIDs = append(resp.DeletedIDs, dup.ID())
+		}
+		return nil
+	}()
+
+	operation := entity.AuditOpDeleteDuplicates
+	if req.Action == "merge" {
+		operation = entity.AuditOpMergeDuplicates
+	}
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     operation,
+		Collection:    req.Collection,
+		DocumentID:    keep.ID(),
+		Filter:        map[string]interface{}{"fields": req.Fields, "duplicate_ids": req.DuplicateIDs},
+		Before:        before,
+		BeforeVersion: beforeVersion,
+		After:         after,
+		AfterVersion:  resp.Version,
+		AffectedCount: int64(len(resp.DeletedIDs)),
+	}, err)
+
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to resolve duplicates",
+			zap.String("collection", req.Collection),
+			zap.String("keep_id", keep.ID()),
+			zap.Strings("deleted_ids", resp.DeletedIDs),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	logger.Info(ctx, "duplicates resolved",
+		zap.String("collection", req.Collection),
+		zap.String("action", req.Action),
+		zap.String("keep_id", keep.ID()),
+		zap.Int("deleted", len(resp.DeletedIDs)),
+		zap.Strings("merged_fields", resp.MergedFields),
+	)
+	return resp, nil
+}
+
+// loadDuplicates는 남길 문서와 중복 문서를 조회하고 키가 모두 같은지 확인합니다
+func (uc *DocumentUseCase) loadDuplicates(ctx context.Context, docRepo repository.DocumentRepository, req *dto.ResolveDuplicatesRequest) (*entity.Document, []*entity.Document, error) {
+	opts := dedup.Options{CaseInsensitive: req.CaseInsensitive, TrimSpace: req.TrimSpace}
+
+	keep, err := uc.findDuplicate(ctx, docRepo, req.Collection, req.KeepID)
+	if err != nil {
+		return nil, nil, err
+	}
+	keepKey, ok := dedup.KeyOf(keep.Data(), req.Fields, opts)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: document %s has no value for %v", entity.ErrInvalidData, keep.ID(), req.Fields)
+	}
+
+	seen := map[string]bool{keep.ID(): true}
+	duplicates := make([]*entity.Document, 0, len(req.DuplicateIDs))
+	for _, id := range req.DuplicateIDs {
+		if seen[id] {
+			return nil, nil, fmt.Errorf("%w: document %s is listed more than once", entity.ErrInvalidData, id)
+		}
+		seen[id] = true
+
+		doc, err := uc.findDuplicate(ctx, docRepo, req.Collection, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if key, ok := dedup.KeyOf(doc.Data(), req.Fields, opts); !ok || key.Hash != keepKey.Hash {
+			return nil, nil, fmt.Errorf("%w: document %s is not a duplicate of %s by %v", entity.ErrInvalidData, id, keep.ID(), req.Fields)
+		}
+		duplicates = append(duplicates, doc)
+	}
+	return keep, duplicates, nil
+}
+
+// findDuplicate는 정리할 문서를 조회합니다 (소프트 삭제되었거나 볼 수 없는 문서는 없는 문서로 취급)
+func (uc *DocumentUseCase) findDuplicate(ctx context.Context, docRepo repository.DocumentRepository, collection, id string) (*entity.Document, error) {
+	doc, err := uc.findDocument(ctx, docRepo, collection, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find document %s: %w", id, err)
+	}
+	if uc.hideDeleted(collection, doc, false) {
+		return nil, fmt.Errorf("failed to find document %s: %w", id, entity.ErrDocumentNotFound)
+	}
+	if err := uc.checkRowAccess(ctx, collection, doc.Data()); err != nil {
+		return nil, fmt.Errorf("failed to find document %s: %w", id, err)
+	}
+	return doc, nil
+}
+
+// repointReferences는 fromID 문서를 가리키는 참조를 toID 문서를 가리키도록 바꾸고 바꾼 문서 수를 반환합니다
+func (uc *DocumentUseCase) repointReferences(ctx context.Context, docRepo repository.DocumentRepository, collection, fromID, toID string) (int, error) {
+	refs := uc.referencedBy[collection]
+	if len(refs) == 0 {
+		return 0, nil
+	}
+	finder, ok := docRepo.(repository.ReferenceFinder)
+	if !ok {
+		return 0, fmt.Errorf("repository does not support references")
+	}
+
+	repointed := 0
+	for _, ref := range refs {
+		ids, err := finder.FindReferencing(ctx, ref.Collection, ref.Field, fromID, uc.maxCascade+1)
+		if err != nil {
+			return repointed, fmt.Errorf("failed to find documents referencing %s/%s: %w", collection, fromID, err)
+		}
+		if len(ids) > uc.maxCascade {
+			return repointed, fmt.Errorf("%w: more than %d documents in %s reference %s/%s", ErrTooManyReferences, uc.maxCascade, ref.Collection, collection, fromID)
+		}
+		for _, id := range ids {
+			if err := uc.setReference(ctx, docRepo, ref, id, fromID, toID); err != nil {
+				return repointed, fmt.Errorf("failed to repoint %s/%s to %s: %w", ref.Collection, id, toID, err)
+			}
+			repointed++
+		}
+	}
+	return repointed, nil
+}
+
+// mergeDuplicateData는 keep에 없거나 null인 최상위 필드를 중복 문서의 값으로 채운 사본과 채운 필드 목록을 반환합니다
+// 여러 중복 문서에 같은 필드가 있으면 요청에서 먼저 나온 문서의 값을 사용합니다
+func mergeDuplicateData(keep map[string]interface{}, duplicates []*entity.Document) (map[string]interface{}, []string) {
+	merged := make(map[string]interface{}, len(keep))
+	for k, v := range keep {
+		merged[k] = v
+	}
+
+	var fields []string
+	for _, dup := range duplicates {
+		for k, v := range dup.Data() {
+			if v == nil || unmergeableFields[k] || merged[k] != nil {
+				continue
+			}
+			merged[k] = v
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return merged, fields
+}
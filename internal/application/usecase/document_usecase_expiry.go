@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ErrExpiryNotEnabled는 만료 정책이 없는 컬렉션에 만료 시각을 지정해 문서를 쓰려고 할 때의 에러입니다
+var ErrExpiryNotEnabled = errors.New("document expiry is not enabled for collection")
+
+// ErrInvalidExpiry는 요청한 만료 시각이 올바르지 않을 때의 에러입니다
+var ErrInvalidExpiry = errors.New("invalid document expiry")
+
+// ExpiryPolicy는 컬렉션의 문서 만료 정책입니다
+type ExpiryPolicy struct {
+	Collection string
+	DefaultTTL time.Duration // 요청에 만료 시각이 없을 때 적용할 TTL (0이면 요청한 문서만 만료)
+}
+
+// SetExpiryPolicies는 컬렉션별 문서 만료 정책을 설정합니다
+// 정책이 있는 컬렉션에서만 요청의 expires_at/ttl_seconds를 받으며, 만료된 문서는 정리 전이라도 조회 결과에서 제외합니다
+func (uc *DocumentUseCase) SetExpiryPolicies(policies []ExpiryPolicy) {
+	uc.expiryPolicies = make(map[string]ExpiryPolicy, len(policies))
+	for _, p := range policies {
+		uc.expiryPolicies[p.Collection] = p
+	}
+}
+
+// applyExpiry는 생성 요청의 만료 시각 또는 컬렉션 기본 TTL을 문서에 설정합니다
+func (uc *DocumentUseCase) applyExpiry(req *dto.CreateDocumentRequest, doc *entity.Document, now time.Time) error {
+	policy, ok := uc.expiryPolicies[req.Collection]
+	requested := req.ExpiresAt != nil || req.TTLSeconds != 0
+	if !ok {
+		if requested {
+			return fmt.Errorf("%w: %s", ErrExpiryNotEnabled, req.Collection)
+		}
+		return nil
+	}
+
+	switch {
+	case req.ExpiresAt != nil && req.TTLSeconds != 0:
+		return fmt.Errorf("%w: expires_at and ttl_seconds are mutually exclusive", ErrInvalidExpiry)
+	case req.ExpiresAt != nil:
+		if !req.ExpiresAt.After(now) {
+			return fmt.Errorf("%w: expires_at must be in the future", ErrInvalidExpiry)
+		}
+		doc.SetExpiresAt(req.ExpiresAt.UTC())
+	case req.TTLSeconds < 0:
+		return fmt.Errorf("%w: ttl_seconds must be positive", ErrInvalidExpiry)
+	case req.TTLSeconds > 0:
+		doc.SetExpiresAt(now.Add(time.Duration(req.TTLSeconds) * time.Second).UTC())
+	case policy.DefaultTTL > 0:
+		doc.SetExpiresAt(now.Add(policy.DefaultTTL).UTC())
+	}
+	return nil
+}
+
+// expiryCacheTTL은 문서 캐시 TTL(초)이 문서 만료 시각을 넘지 않도록 줄입니다
+func expiryCacheTTL(ttl int, doc *entity.Document) int {
+	expiresAt := doc.ExpiresAt()
+	if expiresAt.IsZero() {
+		return ttl
+	}
+	remaining := int(time.Until(expiresAt) / time.Second)
+	if remaining < 1 {
+		remaining = 1
+	}
+	if ttl <= 0 || remaining < ttl {
+		return remaining
+	}
+	return ttl
+}
+
+// expiryTarget은 만료 정리를 실행할 저장소와 컬렉션입니다
+type expiryTarget struct {
+	dbType     string
+	collection string
+	expirer    repository.DocumentExpirer
+}
+
+// RunExpirySweeper는 만료 정책이 있는 컬렉션의 만료 인덱스를 준비하고, 스스로 만료 문서를 삭제하지 않는 저장소
+// (PostgreSQL, MySQL, Vitess, Elasticsearch)에서 interval마다 만료 문서를 batchSize개씩 삭제합니다
+// ctx가 취소될 때까지 실행되므로 별도 goroutine에서 호출해야 합니다
+func (uc *DocumentUseCase) RunExpirySweeper(ctx context.Context, interval time.Duration, batchSize int) {
+	targets := uc.prepareExpiry(ctx)
+	if len(targets) == 0 {
+		logger.Info(ctx, "no collections need expiry sweeping")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, target := range targets {
+				uc.sweepExpired(ctx, target, batchSize)
+			}
+		}
+	}
+}
+
+// prepareExpiry는 모든 저장소의 정책 컬렉션에 EnableExpiry를 호출하고, 직접 정리해야 하는 대상을 반환합니다
+func (uc *DocumentUseCase) prepareExpiry(ctx context.Context) []expiryTarget {
+	repos := map[string]repository.DocumentRepository{"default": uc.docRepo}
+	if uc.repoManager != nil {
+		repos = uc.repoManager.Repositories()
+	}
+
+	var targets []expiryTarget
+	for dbType, repo := range repos {
+		expirer, ok := repo.(repository.DocumentExpirer)
+		if !ok {
+			logger.Warn(ctx, "repository does not support document expiry", zap.String("database_type", dbType))
+			continue
+		}
+		for collection := range uc.expiryPolicies {
+			native, err := expirer.EnableExpiry(ctx, collection)
+			if err != nil {
+				logger.Error(ctx, "failed to enable document expiry",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.Error(err),
+				)
+				continue
+			}
+			if !native {
+				targets = append(targets, expiryTarget{dbType: dbType, collection: collection, expirer: expirer})
+			}
+		}
+	}
+	return targets
+}
+
+// sweepExpired는 한 대상의 만료 문서를 남은 것이 없을 때까지 배치 단위로 삭제합니다
+func (uc *DocumentUseCase) sweepExpired(ctx context.Context, target expiryTarget, batchSize int) {
+	now := time.Now()
+	var total int64
+	for ctx.Err() == nil {
+		deleted, err := target.expirer.DeleteExpired(ctx, target.collection, now, batchSize)
+		total += deleted
+		if err != nil {
+			logger.Error(ctx, "failed to delete expired documents",
+				zap.String("database_type", target.dbType),
+				zap.String("collection", target.collection),
+				zap.Error(err),
+			)
+			break
+		}
+		if batchSize <= 0 || deleted < int64(batchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		uc.metrics.RecordDocumentsExpired(target.dbType, target.collection, total)
+		logger.Info(ctx, "expired documents deleted",
+			zap.String("database_type", target.dbType),
+			zap.String("collection", target.collection),
+			zap.Int64("count", total),
+		)
+	}
+}
@@ -3,13 +3,17 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/YouSangSon/database-service/internal/application/dto"
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/jsonschema"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/retry"
 	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
@@ -19,6 +23,9 @@ func (uc *DocumentUseCase) ReplaceDocument(ctx context.Context, req *dto.Replace
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ReplaceDocument")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -42,30 +49,58 @@ func (uc *DocumentUseCase) ReplaceDocument(ctx context.Context, req *dto.Replace
 		tracing.RecordError(ctx, err)
 		return nil, fmt.Errorf("failed to find document: %w", err)
 	}
+	if err := uc.checkRowAccess(ctx, req.Collection, existing.Data()); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.stampRow(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.validateSchema(ctx, req.Collection, "replace", req.Data); err != nil {
+		return nil, err
+	}
+	if err := uc.scanPII(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.checkUnique(ctx, docRepo, req.Collection, req.ID, req.Data); err != nil {
+		return nil, err
+	}
+	if _, err := uc.reserveQuota(ctx, docRepo, req.Collection, 0, 0); err != nil {
+		return nil, err
+	}
 
 	// Create new document with same ID
-	doc := &entity.Document{}
-	doc.SetID(req.ID)
-	doc.SetCollection(req.Collection)
-	doc.SetData(req.Data)
-	doc.SetVersion(existing.Version() + 1)
+	doc := entity.ReconstructDocument(req.ID, req.Collection, req.Data, existing.Version()+1, existing.CreatedAt(), time.Now())
 
 	// Save
-	_, err = uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
-			return docRepo.Replace(ctx, doc)
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "replace"), func(ctx context.Context) error {
+			return docRepo.Replace(ctx, req.Collection, req.ID, doc)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpReplace,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Before:        existing.Data(),
+		BeforeVersion: existing.Version(),
+		After:         doc.Data(),
+		AfterVersion:  doc.Version(),
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to replace document", zap.Error(err))
-		return nil, fmt.Errorf("failed to replace document: %w", err)
+		return nil, fmt.Errorf("failed to replace document: %w", uc.duplicateKeyError(ctx, req.Collection, err))
 	}
 
-	// Invalidate cache
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	uc.cacheRepo.Delete(ctx, cacheKey)
+	uc.recordRevision(ctx, existing, time.Now(), entity.AuditOpReplace)
+
+	// Update cache according to the collection cache strategy
+	uc.cacheWritten(ctx, req.Collection, req.ID, doc)
 
 	logger.Info(ctx, "document replaced successfully",
 		zap.String("id", req.ID),
@@ -85,8 +120,11 @@ func (uc *DocumentUseCase) SearchDocuments(ctx context.Context, req *dto.SearchD
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.SearchDocuments")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	// 읽기는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	docRepo, err := uc.getReadRepository(ctx, req.Collection)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -104,12 +142,31 @@ func (uc *DocumentUseCase) SearchDocuments(ctx context.Context, req *dto.SearchD
 		zap.Int("offset", req.Offset),
 	)
 
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	cacheKey, cacheable := uc.queryCacheKey(ctx, req.Collection, "search", map[string]interface{}{
+		"filter": filter,
+		"sort":   req.Sort,
+		"limit":  req.Limit,
+		"offset": req.Offset,
+	})
+	if cacheable {
+		var cached dto.SearchDocumentsResponse
+		if uc.queryCacheGet(ctx, req.Collection, cacheKey, &cached) {
+			return &cached, nil
+		}
+	}
+
 	// Execute search
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return docRepo.Find(ctx, req.Collection, req.Filter, &repository.FindOptions{
-			Sort:   req.Sort,
-			Limit:  req.Limit,
-			Offset: req.Offset,
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return docRepo.FindWithOptions(ctx, req.Collection, filter, &repository.FindOptions{
+			Sort:  req.Sort,
+			Limit: int64(req.Limit),
+			Skip:  int64(req.Offset),
 		})
 	})
 
@@ -122,7 +179,7 @@ func (uc *DocumentUseCase) SearchDocuments(ctx context.Context, req *dto.SearchD
 	docs := result.([]*entity.Document)
 
 	// Get total count
-	count, err := docRepo.Count(ctx, req.Collection, req.Filter)
+	count, err := docRepo.Count(ctx, req.Collection, filter)
 	if err != nil {
 		logger.Warn(ctx, "failed to count documents", zap.Error(err))
 		count = int64(len(docs))
@@ -145,12 +202,17 @@ func (uc *DocumentUseCase) SearchDocuments(ctx context.Context, req *dto.SearchD
 		zap.Int("count", len(docs)),
 	)
 
-	return &dto.SearchDocumentsResponse{
+	resp := &dto.SearchDocumentsResponse{
 		Documents: dtoList,
 		Total:     count,
 		Limit:     req.Limit,
 		Offset:    req.Offset,
-	}, nil
+	}
+	if cacheable {
+		uc.queryCacheSet(ctx, req.Collection, cacheKey, resp)
+	}
+
+	return resp, nil
 }
 
 // CountDocuments counts documents matching filter
@@ -158,8 +220,11 @@ func (uc *DocumentUseCase) CountDocuments(ctx context.Context, req *dto.CountDoc
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CountDocuments")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	// 읽기는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	docRepo, err := uc.getReadRepository(ctx, req.Collection)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -173,7 +238,23 @@ func (uc *DocumentUseCase) CountDocuments(ctx context.Context, req *dto.CountDoc
 		zap.String("collection", req.Collection),
 	)
 
-	count, err := docRepo.Count(ctx, req.Collection, req.Filter)
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	cacheKey, cacheable := uc.queryCacheKey(ctx, req.Collection, "count", map[string]interface{}{
+		"filter": filter,
+	})
+	if cacheable {
+		var cached dto.CountDocumentsResponse
+		if uc.queryCacheGet(ctx, req.Collection, cacheKey, &cached) {
+			return &cached, nil
+		}
+	}
+
+	count, err := docRepo.Count(ctx, req.Collection, filter)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to count documents", zap.Error(err))
@@ -185,9 +266,14 @@ func (uc *DocumentUseCase) CountDocuments(ctx context.Context, req *dto.CountDoc
 		zap.Int64("count", count),
 	)
 
-	return &dto.CountDocumentsResponse{
+	resp := &dto.CountDocumentsResponse{
 		Count: count,
-	}, nil
+	}
+	if cacheable {
+		uc.queryCacheSet(ctx, req.Collection, cacheKey, resp)
+	}
+
+	return resp, nil
 }
 
 // EstimatedCount returns estimated document count
@@ -195,8 +281,11 @@ func (uc *DocumentUseCase) EstimatedCount(ctx context.Context, req *dto.Estimate
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.EstimatedCount")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	// 읽기는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	docRepo, err := uc.getReadRepository(ctx, req.Collection)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -210,7 +299,13 @@ func (uc *DocumentUseCase) EstimatedCount(ctx context.Context, req *dto.Estimate
 		zap.String("collection", req.Collection),
 	)
 
-	count, err := docRepo.EstimatedCount(ctx, req.Collection)
+	// 추정 개수는 필터를 적용할 수 없으므로 행 수준 보안 대상 컬렉션에서는 거부합니다
+	if err := uc.denyIfRowPolicy(ctx, req.Collection, "estimated count"); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	count, err := docRepo.EstimatedDocumentCount(ctx, req.Collection)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to get estimated count", zap.Error(err))
@@ -232,6 +327,9 @@ func (uc *DocumentUseCase) FindAndUpdate(ctx context.Context, req *dto.FindAndUp
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.FindAndUpdate")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -249,12 +347,40 @@ func (uc *DocumentUseCase) FindAndUpdate(ctx context.Context, req *dto.FindAndUp
 		zap.String("id", req.ID),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (*entity.Document, error) {
+	if err := uc.checkRowAccessByID(ctx, req.Collection, req.ID); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.guardRowUpdate(ctx, req.Collection, req.Update); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.scanPIIUpdate(ctx, req.Collection, req.Update); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	before, beforeVersion := auditDocumentState(uc.snapshotDocument(ctx, docRepo, req.Collection, req.ID))
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "find_and_update"), func(ctx context.Context) (*entity.Document, error) {
 			return docRepo.FindAndUpdate(ctx, req.Collection, req.ID, req.Update)
 		})
 	})
 
+	auditEntry := &entity.AuditEntry{
+		Operation:     entity.AuditOpFindAndUpdate,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Filter:        req.Update,
+		Before:        before,
+		BeforeVersion: beforeVersion,
+	}
+	if err == nil {
+		auditEntry.After, auditEntry.AfterVersion = auditDocumentState(result.(*entity.Document))
+	}
+	uc.recordAudit(ctx, auditEntry, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to find and update document", zap.Error(err))
@@ -263,9 +389,8 @@ func (uc *DocumentUseCase) FindAndUpdate(ctx context.Context, req *dto.FindAndUp
 
 	doc := result.(*entity.Document)
 
-	// Invalidate cache
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	uc.cacheRepo.Delete(ctx, cacheKey)
+	// Update cache according to the collection cache strategy
+	uc.cacheWritten(ctx, req.Collection, req.ID, doc)
 
 	logger.Info(ctx, "document found and updated successfully",
 		zap.String("id", req.ID),
@@ -285,6 +410,9 @@ func (uc *DocumentUseCase) FindAndReplace(ctx context.Context, req *dto.FindAndR
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.FindAndReplace")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -302,12 +430,46 @@ func (uc *DocumentUseCase) FindAndReplace(ctx context.Context, req *dto.FindAndR
 		zap.String("id", req.ID),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (*entity.Document, error) {
-			return docRepo.FindAndReplace(ctx, req.Collection, req.ID, req.Data)
+	if err := uc.checkRowAccessByID(ctx, req.Collection, req.ID); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.stampRow(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.scanPII(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	// 교체 문서는 현재 문서의 버전과 생성 시각을 이어받습니다
+	current, err := docRepo.FindByID(ctx, req.Collection, req.ID)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to find document: %w", err)
+	}
+	before, beforeVersion := auditDocumentState(current)
+	replacement := entity.ReconstructDocument(req.ID, req.Collection, req.Data, current.Version(), current.CreatedAt(), time.Now())
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "find_and_replace"), func(ctx context.Context) (*entity.Document, error) {
+			return docRepo.FindOneAndReplace(ctx, req.Collection, req.ID, replacement)
 		})
 	})
 
+	auditEntry := &entity.AuditEntry{
+		Operation:     entity.AuditOpFindAndReplace,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Before:        before,
+		BeforeVersion: beforeVersion,
+	}
+	if err == nil {
+		auditEntry.After, auditEntry.AfterVersion = auditDocumentState(result.(*entity.Document))
+	}
+	uc.recordAudit(ctx, auditEntry, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to find and replace document", zap.Error(err))
@@ -316,9 +478,8 @@ func (uc *DocumentUseCase) FindAndReplace(ctx context.Context, req *dto.FindAndR
 
 	doc := result.(*entity.Document)
 
-	// Invalidate cache
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	uc.cacheRepo.Delete(ctx, cacheKey)
+	// Update cache according to the collection cache strategy
+	uc.cacheWritten(ctx, req.Collection, req.ID, doc)
 
 	logger.Info(ctx, "document found and replaced successfully",
 		zap.String("id", req.ID),
@@ -338,6 +499,9 @@ func (uc *DocumentUseCase) FindAndDelete(ctx context.Context, req *dto.FindAndDe
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.FindAndDelete")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -355,12 +519,27 @@ func (uc *DocumentUseCase) FindAndDelete(ctx context.Context, req *dto.FindAndDe
 		zap.String("id", req.ID),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (*entity.Document, error) {
-			return docRepo.FindAndDelete(ctx, req.Collection, req.ID)
+	if err := uc.checkRowAccessByID(ctx, req.Collection, req.ID); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "find_and_delete"), func(ctx context.Context) (*entity.Document, error) {
+			return docRepo.FindOneAndDelete(ctx, req.Collection, req.ID)
 		})
 	})
 
+	auditEntry := &entity.AuditEntry{
+		Operation:  entity.AuditOpFindAndDelete,
+		Collection: req.Collection,
+		DocumentID: req.ID,
+	}
+	if err == nil {
+		auditEntry.Before, auditEntry.BeforeVersion = auditDocumentState(result.(*entity.Document))
+	}
+	uc.recordAudit(ctx, auditEntry, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to find and delete document", zap.Error(err))
@@ -370,8 +549,7 @@ func (uc *DocumentUseCase) FindAndDelete(ctx context.Context, req *dto.FindAndDe
 	doc := result.(*entity.Document)
 
 	// Invalidate cache
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	uc.cacheRepo.Delete(ctx, cacheKey)
+	uc.cacheEvict(ctx, req.Collection, req.ID)
 
 	logger.Info(ctx, "document found and deleted successfully",
 		zap.String("id", req.ID),
@@ -389,6 +567,9 @@ func (uc *DocumentUseCase) Upsert(ctx context.Context, req *dto.UpsertRequest) (
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.Upsert")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -409,34 +590,71 @@ func (uc *DocumentUseCase) Upsert(ctx context.Context, req *dto.UpsertRequest) (
 	// Try to find existing document
 	existing, err := docRepo.FindByID(ctx, req.Collection, req.ID)
 	upserted := err != nil // If not found, it's an insert
-
-	// Create or update document
-	doc := &entity.Document{}
-	doc.SetID(req.ID)
-	doc.SetCollection(req.Collection)
-	doc.SetData(req.Data)
 	if !upserted {
-		doc.SetVersion(existing.Version() + 1)
+		if err := uc.checkRowAccess(ctx, req.Collection, existing.Data()); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, err
+		}
+	}
+	if err := uc.stampRow(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.scanPII(ctx, req.Collection, req.Data); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	// Storage quota: inserts reserve the new document, updates only check the limit
+	var quotaDocs, quotaBytes int64
+	if upserted {
+		quotaDocs, quotaBytes = 1, documentBytes(req.Data)
+	}
+	claims, err := uc.reserveQuota(ctx, docRepo, req.Collection, quotaDocs, quotaBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create or update document: 기존 문서는 교체하고, 없으면 요청한 ID로 새로 저장합니다
+	now := time.Now()
+	var doc *entity.Document
+	if upserted {
+		doc = entity.ReconstructDocument(req.ID, req.Collection, req.Data, 1, now, now)
 	} else {
-		doc.SetVersion(1)
+		doc = entity.ReconstructDocument(req.ID, req.Collection, req.Data, existing.Version()+1, existing.CreatedAt(), now)
 	}
 
 	// Execute upsert
-	_, err = uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
-			return docRepo.Upsert(ctx, doc)
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "upsert"), func(ctx context.Context) error {
+			if upserted {
+				return docRepo.Save(ctx, doc)
+			}
+			return docRepo.Replace(ctx, req.Collection, req.ID, doc)
 		})
 	})
 
+	auditEntry := &entity.AuditEntry{
+		Operation:    entity.AuditOpUpsert,
+		Collection:   req.Collection,
+		DocumentID:   req.ID,
+		After:        doc.Data(),
+		AfterVersion: doc.Version(),
+	}
+	if !upserted {
+		auditEntry.Before, auditEntry.BeforeVersion = auditDocumentState(existing)
+	}
+	uc.recordAudit(ctx, auditEntry, err)
+
 	if err != nil {
+		uc.releaseQuota(claims, quotaDocs, quotaBytes)
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to upsert document", zap.Error(err))
 		return nil, fmt.Errorf("failed to upsert document: %w", err)
 	}
 
-	// Invalidate cache
-	cacheKey := fmt.Sprintf("document:%s:%s", req.Collection, req.ID)
-	uc.cacheRepo.Delete(ctx, cacheKey)
+	// Update cache according to the collection cache strategy
+	uc.cacheWritten(ctx, req.Collection, req.ID, doc)
 
 	logger.Info(ctx, "document upserted successfully",
 		zap.String("id", req.ID),
@@ -458,8 +676,17 @@ func (uc *DocumentUseCase) AggregateDocuments(ctx context.Context, req *dto.Aggr
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.AggregateDocuments")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	// $out/$merge가 없는 집계는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	var docRepo repository.DocumentRepository
+	var err error
+	if writesInPipeline(req.Pipeline) {
+		docRepo, err = uc.getRepository(ctx)
+	} else {
+		docRepo, err = uc.getReadRepository(ctx, req.Collection)
+	}
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -473,8 +700,19 @@ func (uc *DocumentUseCase) AggregateDocuments(ctx context.Context, req *dto.Aggr
 		zap.String("collection", req.Collection),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return docRepo.Aggregate(ctx, req.Collection, req.Pipeline)
+	pipeline, err := uc.scopePipeline(ctx, req.Collection, req.Pipeline)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	stages := make([]bson.M, len(pipeline))
+	for i, stage := range pipeline {
+		stages[i] = bson.M(stage)
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return docRepo.Aggregate(ctx, req.Collection, stages)
 	})
 
 	if err != nil {
@@ -500,8 +738,11 @@ func (uc *DocumentUseCase) Distinct(ctx context.Context, req *dto.DistinctReques
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.Distinct")
 	defer span.End()
 
-	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	// 읽기는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	docRepo, err := uc.getReadRepository(ctx, req.Collection)
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
@@ -517,8 +758,14 @@ func (uc *DocumentUseCase) Distinct(ctx context.Context, req *dto.DistinctReques
 		zap.String("field", req.Field),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return docRepo.Distinct(ctx, req.Collection, req.Field, req.Filter)
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return docRepo.Distinct(ctx, req.Collection, req.Field, filter)
 	})
 
 	if err != nil {
@@ -545,6 +792,9 @@ func (uc *DocumentUseCase) BulkInsert(ctx context.Context, req *dto.BulkInsertRe
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.BulkInsert")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -562,9 +812,30 @@ func (uc *DocumentUseCase) BulkInsert(ctx context.Context, req *dto.BulkInsertRe
 		zap.Int("count", len(req.Documents)),
 	)
 
+	// 스키마 위반은 모든 문서를 검사해 한 번에 보고 (경로는 documents 배열 기준)
+	var violations []jsonschema.Violation
+	for i, data := range req.Documents {
+		if err := uc.stampRow(ctx, req.Collection, data); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("document at index %d: %w", i, err)
+		}
+		docViolations, err := uc.schemaViolations(req.Collection, data, fmt.Sprintf("/%d", i))
+		if err != nil {
+			return nil, fmt.Errorf("document at index %d: %w", i, err)
+		}
+		violations = append(violations, docViolations...)
+	}
+	if err := uc.rejectSchemaViolations(ctx, req.Collection, "bulk_insert", violations); err != nil {
+		return nil, err
+	}
+
 	// Convert to domain entities
 	docs := make([]*entity.Document, len(req.Documents))
 	for i, data := range req.Documents {
+		if err := uc.scanPII(ctx, req.Collection, data); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("document at index %d: %w", i, err)
+		}
 		doc, err := entity.NewDocument(req.Collection, data)
 		if err != nil {
 			tracing.RecordError(ctx, err)
@@ -572,18 +843,41 @@ func (uc *DocumentUseCase) BulkInsert(ctx context.Context, req *dto.BulkInsertRe
 		}
 		docs[i] = doc
 	}
+	if err := uc.checkUniqueBatch(ctx, docRepo, req.Collection, req.Documents); err != nil {
+		return nil, err
+	}
+
+	// 저장 용량 쿼터: 배치 전체를 한 번에 확인해 일부만 들어가는 경우가 없도록 함
+	var size int64
+	for _, data := range req.Documents {
+		size += documentBytes(data)
+	}
+	claims, err := uc.reserveQuota(ctx, docRepo, req.Collection, int64(len(docs)), size)
+	if err != nil {
+		return nil, err
+	}
 
 	// Execute bulk insert
-	_, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
-			return docRepo.BulkInsert(ctx, docs)
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "bulk_insert"), func(ctx context.Context) error {
+			return docRepo.SaveMany(ctx, docs)
 		})
 	})
 
+	bulkAudit := &entity.AuditEntry{
+		Operation:  entity.AuditOpBulkInsert,
+		Collection: req.Collection,
+	}
+	if err == nil {
+		bulkAudit.AffectedCount = int64(len(docs))
+	}
+	uc.recordAudit(ctx, bulkAudit, err)
+
 	if err != nil {
+		uc.releaseQuota(claims, int64(len(docs)), size)
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to bulk insert documents", zap.Error(err))
-		return nil, fmt.Errorf("failed to bulk insert documents: %w", err)
+		return nil, fmt.Errorf("failed to bulk insert documents: %w", uc.duplicateKeyError(ctx, req.Collection, err))
 	}
 
 	// Collect IDs
@@ -608,6 +902,9 @@ func (uc *DocumentUseCase) UpdateMany(ctx context.Context, req *dto.UpdateManyRe
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.UpdateMany")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -623,29 +920,54 @@ func (uc *DocumentUseCase) UpdateMany(ctx context.Context, req *dto.UpdateManyRe
 		zap.String("collection", req.Collection),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (*repository.UpdateResult, error) {
-			return docRepo.UpdateMany(ctx, req.Collection, req.Filter, req.Update)
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.guardRowUpdate(ctx, req.Collection, req.Update); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	if err := uc.scanPIIUpdate(ctx, req.Collection, req.Update); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "update_many"), func(ctx context.Context) (int64, error) {
+			return docRepo.UpdateMany(ctx, req.Collection, filter, req.Update)
 		})
 	})
 
+	updateAudit := &entity.AuditEntry{
+		Operation:  entity.AuditOpUpdateMany,
+		Collection: req.Collection,
+		Filter:     req.Filter,
+		After:      req.Update,
+	}
+	if err == nil {
+		updateAudit.AffectedCount = result.(int64)
+	}
+	uc.recordAudit(ctx, updateAudit, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to update many documents", zap.Error(err))
 		return nil, fmt.Errorf("failed to update many documents: %w", err)
 	}
 
-	updateResult := result.(*repository.UpdateResult)
+	// 저장소는 갱신된 문서 수만 반환하므로 일치 수와 수정 수가 같습니다
+	modified := result.(int64)
 
 	logger.Info(ctx, "documents updated successfully",
 		zap.String("collection", req.Collection),
-		zap.Int64("matched", updateResult.MatchedCount),
-		zap.Int64("modified", updateResult.ModifiedCount),
+		zap.Int64("modified", modified),
 	)
 
 	return &dto.UpdateManyResponse{
-		MatchedCount:  updateResult.MatchedCount,
-		ModifiedCount: updateResult.ModifiedCount,
+		MatchedCount:  modified,
+		ModifiedCount: modified,
 	}, nil
 }
 
@@ -654,6 +976,9 @@ func (uc *DocumentUseCase) DeleteMany(ctx context.Context, req *dto.DeleteManyRe
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.DeleteMany")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -669,12 +994,28 @@ func (uc *DocumentUseCase) DeleteMany(ctx context.Context, req *dto.DeleteManyRe
 		zap.String("collection", req.Collection),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (int64, error) {
-			return docRepo.DeleteMany(ctx, req.Collection, req.Filter)
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "delete_many"), func(ctx context.Context) (int64, error) {
+			return docRepo.DeleteMany(ctx, req.Collection, filter)
 		})
 	})
 
+	deleteAudit := &entity.AuditEntry{
+		Operation:  entity.AuditOpDeleteMany,
+		Collection: req.Collection,
+		Filter:     req.Filter,
+	}
+	if err == nil {
+		deleteAudit.AffectedCount = result.(int64)
+	}
+	uc.recordAudit(ctx, deleteAudit, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to delete many documents", zap.Error(err))
@@ -698,6 +1039,9 @@ func (uc *DocumentUseCase) BulkWrite(ctx context.Context, req *dto.BulkWriteRequ
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.BulkWrite")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -720,21 +1064,38 @@ func (uc *DocumentUseCase) BulkWrite(ctx context.Context, req *dto.BulkWriteRequ
 			Type:       op.Type,
 			Collection: op.Collection,
 			Filter:     op.Filter,
-			Data:       op.Data,
 			Update:     op.Update,
 		}
 		if op.ID != "" {
 			bulkOp.Filter = map[string]interface{}{"id": op.ID}
 		}
+		if op.Type == "insert" {
+			doc, err := entity.NewDocument(op.Collection, op.Data)
+			if err != nil {
+				tracing.RecordError(ctx, err)
+				return nil, fmt.Errorf("operation at index %d: %w", i, err)
+			}
+			bulkOp.Document = doc
+		}
+		scoped, err := uc.scopeWriteOperation(ctx, op.Type, op.Collection, bulkOp.Filter, op.Data, op.Update)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("operation at index %d: %w", i, err)
+		}
+		bulkOp.Filter = scoped
 		operations[i] = bulkOp
 	}
 
 	// Execute bulk write
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (*repository.BulkResult, error) {
-			return docRepo.BulkWrite(ctx, operations)
-		})
-	})
+	bulkResult, err := uc.executeBulkWrite(ctx, docRepo, req.Operations, operations)
+
+	bulkWriteAudit := &entity.AuditEntry{
+		Operation: entity.AuditOpBulkWrite,
+	}
+	if err == nil {
+		bulkWriteAudit.AffectedCount = bulkResult.InsertedCount + bulkResult.ModifiedCount + bulkResult.DeletedCount + bulkResult.UpsertedCount
+	}
+	uc.recordAudit(ctx, bulkWriteAudit, err)
 
 	if err != nil {
 		tracing.RecordError(ctx, err)
@@ -742,20 +1103,45 @@ func (uc *DocumentUseCase) BulkWrite(ctx context.Context, req *dto.BulkWriteRequ
 		return nil, fmt.Errorf("failed to execute bulk write: %w", err)
 	}
 
-	bulkResult := result.(*repository.BulkResult)
-
 	logger.Info(ctx, "bulk write executed successfully",
 		zap.Int64("inserted", bulkResult.InsertedCount),
 		zap.Int64("modified", bulkResult.ModifiedCount),
 		zap.Int64("deleted", bulkResult.DeletedCount),
+		zap.Int("failed", len(bulkResult.Errors)),
 	)
 
+	var writeErrors []dto.BulkWriteError
+	for _, e := range bulkResult.Errors {
+		writeErrors = append(writeErrors, dto.BulkWriteError{
+			Index:  e.Index,
+			ID:     e.ID,
+			Status: e.Status,
+			Reason: e.Reason,
+		})
+	}
+
 	return &dto.BulkWriteResponse{
 		InsertedCount: bulkResult.InsertedCount,
 		MatchedCount:  bulkResult.MatchedCount,
 		ModifiedCount: bulkResult.ModifiedCount,
 		DeletedCount:  bulkResult.DeletedCount,
 		UpsertedCount: bulkResult.UpsertedCount,
-		UpsertedIDs:   bulkResult.UpsertedIDs,
+		UpsertedIDs:   upsertedIDs(bulkResult.UpsertedIDs),
+		Errors:        writeErrors,
 	}, nil
 }
+
+// upsertedIDs는 작업 위치별 upsert ID를 작업 순서대로 정렬한 문자열 목록으로 변환합니다
+func upsertedIDs(byIndex map[int]interface{}) []string {
+	indexes := make([]int, 0, len(byIndex))
+	for i := range byIndex {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	ids := make([]string, len(indexes))
+	for n, i := range indexes {
+		ids[n] = fmt.Sprint(byIndex[i])
+	}
+	return ids
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/fixtures"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// maxFixtureErrors는 컬렉션 결과에 담는 실패 문서의 최대 개수입니다
+const maxFixtureErrors = 100
+
+// FixtureResult는 픽스처 문서 하나를 불러온 결과입니다
+type FixtureResult string
+
+const (
+	FixtureCreated   FixtureResult = "created"   // 없던 문서를 만듦
+	FixtureUpdated   FixtureResult = "updated"   // 내용이 다른 문서를 픽스처로 덮어씀 (overwrite)
+	FixtureUnchanged FixtureResult = "unchanged" // 이미 같은 내용
+	FixtureSkipped   FixtureResult = "skipped"   // 내용이 다르지만 overwrite가 아니어서 그대로 둠
+)
+
+// LoadFixture는 픽스처 파일의 문서를 컬렉션에 같은 ID로 저장합니다 (컬렉션이 없으면 생성)
+// 문서별 실패는 결과에 기록하고 계속 진행하며, 컬렉션을 준비하지 못하면 에러를 반환합니다
+func (uc *DocumentUseCase) LoadFixture(ctx context.Context, fixture fixtures.Fixture, overwrite bool) (*dto.FixtureCollectionResult, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.LoadFixture")
+	defer span.End()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", fixture.Collection),
+		attribute.Int("documents", len(fixture.Documents)),
+	)
+
+	exists, err := docRepo.CollectionExists(ctx, fixture.Collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		if err := docRepo.CreateCollection(ctx, fixture.Collection); err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to create collection %s: %w", fixture.Collection, err)
+		}
+	}
+
+	result := &dto.FixtureCollectionResult{Collection: fixture.Collection, File: fixture.File}
+	for _, doc := range fixture.Documents {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		outcome, err := uc.loadFixtureDocument(ctx, docRepo, fixture.Collection, doc, overwrite)
+		switch {
+		case err != nil:
+			result.Failed++
+			if len(result.Errors) < maxFixtureErrors {
+				result.Errors = append(result.Errors, dto.FixtureError{DocumentID: doc.ID, Error: err.Error()})
+			}
+			logger.Warn(ctx, "failed to load fixture document",
+				zap.String("collection", fixture.Collection),
+				zap.String("id", doc.ID),
+				zap.Error(err),
+			)
+		case outcome == FixtureCreated:
+			result.Created++
+		case outcome == FixtureUpdated:
+			result.Updated++
+		case outcome == FixtureUnchanged:
+			result.Unchanged++
+		case outcome == FixtureSkipped:
+			result.Skipped++
+		}
+	}
+	return result, nil
+}
+
+// loadFixtureDocument는 픽스처 문서를 같은 ID로 저장합니다
+// 이미 있는 문서는 내용이 같으면 그대로 두고, 다르면 overwrite일 때만 픽스처 내용으로 덮어씁니다
+func (uc *DocumentUseCase) loadFixtureDocument(ctx context.Context, docRepo repository.DocumentRepository, collection string, doc fixtures.Document, overwrite bool) (FixtureResult, error) {
+	existing, err := docRepo.FindByID(ctx, collection, doc.ID)
+	switch {
+	case err == nil:
+		if fixtures.Equal(existing.Data(), doc.Data) {
+			return FixtureUnchanged, nil
+		}
+		if !overwrite {
+			return FixtureSkipped, nil
+		}
+	case !errors.Is(err, entity.ErrDocumentNotFound) && !strings.Contains(err.Error(), "not found"):
+		// SQL 저장소는 문서가 없으면 메시지로만 알려줌
+		return "", fmt.Errorf("failed to find document: %w", err)
+	}
+
+	// Upsert가 데이터에 행 소유자를 기록할 수 있으므로 픽스처는 복사해서 넘김
+	data := make(map[string]interface{}, len(doc.Data))
+	for k, v := range doc.Data {
+		data[k] = v
+	}
+	resp, err := uc.Upsert(ctx, &dto.UpsertRequest{Collection: collection, ID: doc.ID, Data: data})
+	if err != nil {
+		return "", err
+	}
+	if resp.Upserted {
+		return FixtureCreated, nil
+	}
+	return FixtureUpdated, nil
+}
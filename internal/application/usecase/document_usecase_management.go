@@ -8,9 +8,11 @@ import (
 	"github.com/YouSangSon/database-service/internal/application/dto"
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/retry"
 	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
@@ -20,6 +22,9 @@ func (uc *DocumentUseCase) CreateIndex(ctx context.Context, req *dto.CreateIndex
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CreateIndex")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -36,16 +41,22 @@ func (uc *DocumentUseCase) CreateIndex(ctx context.Context, req *dto.CreateIndex
 	)
 
 	indexModel := repository.IndexModel{
-		Keys:    req.Keys,
-		Options: req.Options,
+		Keys:    indexKeys(req.Keys),
+		Options: indexOptions(req.Options),
 	}
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return retry.DoWithValue(ctx, uc.retryConfig, func(ctx context.Context) (string, error) {
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "create_index"), func(ctx context.Context) (string, error) {
 			return docRepo.CreateIndex(ctx, req.Collection, indexModel)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpCreateIndex,
+		Collection: req.Collection,
+		After:      indexKeys(req.Keys),
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to create index", zap.Error(err))
@@ -69,6 +80,9 @@ func (uc *DocumentUseCase) CreateIndexes(ctx context.Context, req *dto.CreateInd
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CreateIndexes")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -108,11 +122,17 @@ func (uc *DocumentUseCase) CreateIndexes(ctx context.Context, req *dto.CreateInd
 		options, _ := indexDef["options"].(map[string]interface{})
 
 		indexModel := repository.IndexModel{
-			Keys:    keys,
-			Options: options,
+			Keys:    indexKeys(keys),
+			Options: indexOptions(options),
 		}
 
 		indexName, err := docRepo.CreateIndex(ctx, req.Collection, indexModel)
+		uc.recordAudit(ctx, &entity.AuditEntry{
+			Operation:  entity.AuditOpCreateIndex,
+			Collection: req.Collection,
+			DocumentID: indexName,
+			After:      indexKeys(keys),
+		}, err)
 		if err != nil {
 			logger.Warn(ctx, "failed to create index", zap.Error(err))
 			continue
@@ -136,6 +156,9 @@ func (uc *DocumentUseCase) DropIndex(ctx context.Context, req *dto.DropIndexRequ
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.DropIndex")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -153,12 +176,18 @@ func (uc *DocumentUseCase) DropIndex(ctx context.Context, req *dto.DropIndexRequ
 		zap.String("index_name", req.IndexName),
 	)
 
-	_, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "drop_index"), func(ctx context.Context) error {
 			return docRepo.DropIndex(ctx, req.Collection, req.IndexName)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpDropIndex,
+		Collection: req.Collection,
+		DocumentID: req.IndexName,
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to drop index", zap.Error(err))
@@ -180,6 +209,9 @@ func (uc *DocumentUseCase) ListIndexes(ctx context.Context, req *dto.ListIndexes
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ListIndexes")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -195,7 +227,7 @@ func (uc *DocumentUseCase) ListIndexes(ctx context.Context, req *dto.ListIndexes
 		zap.String("collection", req.Collection),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
 		return docRepo.ListIndexes(ctx, req.Collection)
 	})
 
@@ -205,22 +237,12 @@ func (uc *DocumentUseCase) ListIndexes(ctx context.Context, req *dto.ListIndexes
 		return nil, fmt.Errorf("failed to list indexes: %w", err)
 	}
 
-	indexes := result.([]repository.IndexModel)
+	indexes := result.([]map[string]interface{})
 
 	// Convert to DTO
 	indexInfoList := make([]dto.IndexInfo, len(indexes))
 	for i, idx := range indexes {
-		unique := false
-		if idx.Options != nil {
-			if u, ok := idx.Options["unique"].(bool); ok {
-				unique = u
-			}
-		}
-		indexInfoList[i] = dto.IndexInfo{
-			Name:   idx.Name,
-			Keys:   idx.Keys,
-			Unique: unique,
-		}
+		indexInfoList[i] = indexInfo(idx)
 	}
 
 	logger.Info(ctx, "indexes listed successfully",
@@ -238,6 +260,9 @@ func (uc *DocumentUseCase) CreateCollection(ctx context.Context, req *dto.Create
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CreateCollection")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -253,12 +278,17 @@ func (uc *DocumentUseCase) CreateCollection(ctx context.Context, req *dto.Create
 		zap.String("collection", req.Collection),
 	)
 
-	_, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
-			return docRepo.CreateCollection(ctx, req.Collection, req.Options)
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "create_collection"), func(ctx context.Context) error {
+			return docRepo.CreateCollection(ctx, req.Collection)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpCreateCollection,
+		Collection: req.Collection,
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to create collection", zap.Error(err))
@@ -279,6 +309,9 @@ func (uc *DocumentUseCase) DropCollection(ctx context.Context, req *dto.DropColl
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.DropCollection")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -294,12 +327,17 @@ func (uc *DocumentUseCase) DropCollection(ctx context.Context, req *dto.DropColl
 		zap.String("collection", req.Collection),
 	)
 
-	_, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "drop_collection"), func(ctx context.Context) error {
 			return docRepo.DropCollection(ctx, req.Collection)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpDropCollection,
+		Collection: req.Collection,
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to drop collection", zap.Error(err))
@@ -320,6 +358,9 @@ func (uc *DocumentUseCase) RenameCollection(ctx context.Context, req *dto.Rename
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.RenameCollection")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -337,12 +378,18 @@ func (uc *DocumentUseCase) RenameCollection(ctx context.Context, req *dto.Rename
 		zap.String("new_name", req.NewName),
 	)
 
-	_, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return nil, retry.Do(ctx, uc.retryConfig, func(ctx context.Context) error {
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "rename_collection"), func(ctx context.Context) error {
 			return docRepo.RenameCollection(ctx, req.OldName, req.NewName)
 		})
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpRenameCollection,
+		Collection: req.OldName,
+		After:      map[string]interface{}{"name": req.NewName},
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to rename collection", zap.Error(err))
@@ -364,6 +411,9 @@ func (uc *DocumentUseCase) ListCollections(ctx context.Context, req *dto.ListCol
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ListCollections")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -373,8 +423,8 @@ func (uc *DocumentUseCase) ListCollections(ctx context.Context, req *dto.ListCol
 
 	logger.Info(ctx, "listing collections")
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return docRepo.ListCollections(ctx, req.Filter)
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return docRepo.ListCollections(ctx)
 	})
 
 	if err != nil {
@@ -383,7 +433,7 @@ func (uc *DocumentUseCase) ListCollections(ctx context.Context, req *dto.ListCol
 		return nil, fmt.Errorf("failed to list collections: %w", err)
 	}
 
-	collections := result.([]string)
+	collections := filterCollections(result.([]string), req.Filter)
 
 	// Convert to DTO
 	collectionInfoList := make([]dto.CollectionInfo, len(collections))
@@ -407,6 +457,9 @@ func (uc *DocumentUseCase) CollectionExists(ctx context.Context, req *dto.Collec
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CollectionExists")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -422,7 +475,7 @@ func (uc *DocumentUseCase) CollectionExists(ctx context.Context, req *dto.Collec
 		zap.String("collection", req.Collection),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
 		return docRepo.CollectionExists(ctx, req.Collection)
 	})
 
@@ -449,6 +502,9 @@ func (uc *DocumentUseCase) ExecuteTransaction(ctx context.Context, req *dto.Exec
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ExecuteTransaction")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -456,20 +512,53 @@ func (uc *DocumentUseCase) ExecuteTransaction(ctx context.Context, req *dto.Exec
 		return nil, err
 	}
 
+	isolation, err := repository.ParseIsolationLevel(req.Isolation)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+	}
+	if req.TimeoutMS < 0 {
+		return nil, fmt.Errorf("%w: timeout_ms must not be negative", entity.ErrInvalidData)
+	}
+	txOpts := &repository.TxOptions{
+		Isolation: isolation,
+		ReadOnly:  req.ReadOnly,
+		Timeout:   time.Duration(req.TimeoutMS) * time.Millisecond,
+	}
+
 	tracing.SetAttributes(ctx,
 		attribute.Int("operation_count", len(req.Operations)),
+		attribute.String("isolation", string(isolation)),
 	)
 
 	logger.Info(ctx, "executing transaction",
 		zap.Int("operation_count", len(req.Operations)),
+		zap.String("isolation", string(isolation)),
 	)
 
+	// 행 수준 보안: 트랜잭션 시작 전에 모든 작업의 범위를 확인합니다
+	scopedFilters := make([]map[string]interface{}, len(req.Operations))
+	for i, op := range req.Operations {
+		if op.ID != "" && op.Type != "insert" {
+			if err := uc.checkRowAccessByID(ctx, op.Collection, op.ID); err != nil {
+				tracing.RecordError(ctx, err)
+				return nil, fmt.Errorf("operation at index %d: %w", i, err)
+			}
+		}
+		scoped, err := uc.scopeWriteOperation(ctx, op.Type, op.Collection, op.Filter, op.Data, op.Update)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("operation at index %d: %w", i, err)
+		}
+		scopedFilters[i] = scoped
+	}
+
 	insertedIDs := make([]string, 0)
 	var modifiedCount, deletedCount int64
 
 	// Execute transaction
-	err := docRepo.WithTransaction(ctx, func(txCtx context.Context) error {
-		for _, op := range req.Operations {
+	err = docRepo.WithTransaction(ctx, txOpts, func(txCtx context.Context) error {
+		for i, op := range req.Operations {
 			switch op.Type {
 			case "insert":
 				doc, err := entity.NewDocument(op.Collection, op.Data)
@@ -492,9 +581,10 @@ func (uc *DocumentUseCase) ExecuteTransaction(ctx context.Context, req *dto.Exec
 						modifiedCount++
 					}
 				} else {
-					result, err := docRepo.UpdateMany(txCtx, op.Collection, op.Filter, op.Update)
+					var n int64
+					n, err = docRepo.UpdateMany(txCtx, op.Collection, scopedFilters[i], op.Update)
 					if err == nil {
-						modifiedCount += result.ModifiedCount
+						modifiedCount += n
 					}
 				}
 				if err != nil {
@@ -508,7 +598,7 @@ func (uc *DocumentUseCase) ExecuteTransaction(ctx context.Context, req *dto.Exec
 					}
 					deletedCount++
 				} else {
-					deleted, err := docRepo.DeleteMany(txCtx, op.Collection, op.Filter)
+					deleted, err := docRepo.DeleteMany(txCtx, op.Collection, scopedFilters[i])
 					if err != nil {
 						return fmt.Errorf("failed to delete documents: %w", err)
 					}
@@ -522,6 +612,11 @@ func (uc *DocumentUseCase) ExecuteTransaction(ctx context.Context, req *dto.Exec
 		return nil
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpTransaction,
+		AffectedCount: int64(len(insertedIDs)) + modifiedCount + deletedCount,
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "transaction failed", zap.Error(err))
@@ -548,6 +643,9 @@ func (uc *DocumentUseCase) ExecuteRawQuery(ctx context.Context, req *dto.RawQuer
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ExecuteRawQuery")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -557,12 +655,25 @@ func (uc *DocumentUseCase) ExecuteRawQuery(ctx context.Context, req *dto.RawQuer
 
 	logger.Info(ctx, "executing raw query")
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		var results interface{}
-		err := docRepo.ExecuteRawQuery(ctx, req.Query, req.Parameters, &results)
-		return results, err
+	// 원시 쿼리에는 행 수준 보안 조건을 주입할 수 없습니다
+	if err := uc.denyIfRowPolicy(ctx, "", "raw query"); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	if err := rawQueryParameters(req.Parameters); err != nil {
+		return nil, err
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return docRepo.ExecuteRawQuery(ctx, req.Query)
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation: entity.AuditOpRawQuery,
+		Filter:    map[string]interface{}{"query": req.Query},
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to execute raw query", zap.Error(err))
@@ -581,6 +692,9 @@ func (uc *DocumentUseCase) ExecuteRawQueryTyped(ctx context.Context, req *dto.Ra
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ExecuteRawQueryTyped")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -592,12 +706,27 @@ func (uc *DocumentUseCase) ExecuteRawQueryTyped(ctx context.Context, req *dto.Ra
 		zap.String("result_type", req.ResultType),
 	)
 
-	result, err := uc.circuitBreaker.Execute(ctx, func() (interface{}, error) {
+	// 원시 쿼리에는 행 수준 보안 조건을 주입할 수 없습니다
+	if err := uc.denyIfRowPolicy(ctx, "", "raw query"); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	if err := rawQueryParameters(req.Parameters); err != nil {
+		return nil, err
+	}
+
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
 		var results interface{}
-		err := docRepo.ExecuteRawQueryWithResult(ctx, req.Query, req.Parameters, &results)
+		err := docRepo.ExecuteRawQueryWithResult(ctx, req.Query, &results)
 		return results, err
 	})
 
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation: entity.AuditOpRawQuery,
+		Filter:    map[string]interface{}{"query": req.Query},
+	}, err)
+
 	if err != nil {
 		tracing.RecordError(ctx, err)
 		logger.Error(ctx, "failed to execute raw query", zap.Error(err))
@@ -628,19 +757,21 @@ func (uc *DocumentUseCase) HealthCheck(ctx context.Context) (*dto.HealthCheckRes
 	services := make(map[string]string)
 
 	// Check database
-	if err := docRepo.Ping(ctx); err != nil {
+	if err := docRepo.HealthCheck(ctx); err != nil {
 		services["database"] = "unhealthy"
 		logger.Error(ctx, "database health check failed", zap.Error(err))
 	} else {
 		services["database"] = "healthy"
 	}
 
-	// Check cache
-	if err := uc.cacheRepo.Ping(ctx); err != nil {
-		services["cache"] = "unhealthy"
-		logger.Warn(ctx, "cache health check failed", zap.Error(err))
-	} else {
-		services["cache"] = "healthy"
+	// Check cache: 캐시 저장소에는 ping이 없으므로 키 조회가 성공하는지로 확인합니다
+	if uc.cacheRepo != nil {
+		if _, err := uc.cacheRepo.Exists(ctx, cacheHealthKey); err != nil {
+			services["cache"] = "unhealthy"
+			logger.Warn(ctx, "cache health check failed", zap.Error(err))
+		} else {
+			services["cache"] = "healthy"
+		}
 	}
 
 	status := "healthy"
@@ -680,7 +811,7 @@ func (uc *DocumentUseCase) DatabaseHealth(ctx context.Context, req *dto.Database
 	)
 
 	start := time.Now()
-	err := docRepo.Ping(ctx)
+	err = docRepo.HealthCheck(ctx)
 	responseTime := time.Since(start).Milliseconds()
 
 	status := "healthy"
@@ -710,8 +841,7 @@ func (uc *DocumentUseCase) GetMetrics(ctx context.Context) (*dto.MetricsResponse
 	defer span.End()
 
 	// Get repository based on database type in context
-	docRepo, err := uc.getRepository(ctx)
-	if err != nil {
+	if _, err := uc.getRepository(ctx); err != nil {
 		tracing.RecordError(ctx, err)
 		return nil, err
 	}
@@ -738,6 +868,14 @@ func (uc *DocumentUseCase) GetDatabaseStats(ctx context.Context, req *dto.Databa
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.GetDatabaseStats")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
+	// 경로의 데이터베이스 종류가 X-Database-Type보다 우선
+	if req.DatabaseType != "" {
+		ctx = context.WithValue(ctx, middleware.DatabaseTypeContextKey, middleware.DatabaseType(req.DatabaseType))
+	}
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -753,21 +891,41 @@ func (uc *DocumentUseCase) GetDatabaseStats(ctx context.Context, req *dto.Databa
 		zap.String("database_type", req.DatabaseType),
 	)
 
+	// 백엔드 고유 통계를 지원하면 크기, 컬렉션별 통계, 연결 수까지 조회
+	if reader, ok := docRepo.(repository.DatabaseStatsReader); ok {
+		native, err := reader.DatabaseStats(ctx)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to get database stats: %w", err)
+		}
+		logger.Info(ctx, "database stats retrieved successfully",
+			zap.String("database_type", req.DatabaseType),
+		)
+		return databaseStatsResponse(req.DatabaseType, native), nil
+	}
+
 	// Get collections
-	collections, err := docRepo.ListCollections(ctx, nil)
+	collections, err := docRepo.ListCollections(ctx)
 	if err != nil {
 		logger.Warn(ctx, "failed to list collections", zap.Error(err))
 		collections = []string{}
 	}
 
-	// This is a placeholder implementation
-	// In a real system, you would collect actual statistics
+	// 크기 통계를 지원하지 않는 백엔드는 컬렉션별 추정 문서 수만 합산
 	stats := &dto.DatabaseStatsResponse{
-		DatabaseType:    req.DatabaseType,
-		Collections:     len(collections),
-		TotalDocuments:  0,
-		TotalSize:       0,
-		AvgDocumentSize: 0,
+		DatabaseType: req.DatabaseType,
+		Collections:  len(collections),
+	}
+	for _, collection := range collections {
+		count, err := docRepo.EstimatedDocumentCount(ctx, collection)
+		if err != nil {
+			logger.Warn(ctx, "failed to count documents",
+				zap.String("collection", collection),
+				zap.Error(err),
+			)
+			continue
+		}
+		stats.TotalDocuments += count
 	}
 
 	logger.Info(ctx, "database stats retrieved successfully",
@@ -782,6 +940,9 @@ func (uc *DocumentUseCase) GetCollectionStats(ctx context.Context, req *dto.Coll
 	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.GetCollectionStats")
 	defer span.End()
 
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
 	// Get repository based on database type in context
 	docRepo, err := uc.getRepository(ctx)
 	if err != nil {
@@ -797,6 +958,28 @@ func (uc *DocumentUseCase) GetCollectionStats(ctx context.Context, req *dto.Coll
 		zap.String("collection", req.Collection),
 	)
 
+	exists, err := docRepo.CollectionExists(ctx, req.Collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: collection %s does not exist", entity.ErrDocumentNotFound, req.Collection)
+	}
+
+	// 백엔드 고유 통계를 지원하면 크기와 인덱스 크기까지 조회
+	if reader, ok := docRepo.(repository.CollectionStatsReader); ok {
+		native, err := reader.CollectionStats(ctx, req.Collection)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to get collection stats: %w", err)
+		}
+		logger.Info(ctx, "collection stats retrieved successfully",
+			zap.String("collection", req.Collection),
+		)
+		return collectionStatsResponse(native), nil
+	}
+
 	// Get document count
 	count, err := docRepo.Count(ctx, req.Collection, nil)
 	if err != nil {
@@ -808,16 +991,14 @@ func (uc *DocumentUseCase) GetCollectionStats(ctx context.Context, req *dto.Coll
 	indexes, err := docRepo.ListIndexes(ctx, req.Collection)
 	if err != nil {
 		logger.Warn(ctx, "failed to list indexes", zap.Error(err))
-		indexes = []repository.IndexModel{}
+		indexes = []map[string]interface{}{}
 	}
 
-	// This is a placeholder implementation
+	// 크기 통계를 지원하지 않는 백엔드는 문서 수와 인덱스 개수만 반환
 	stats := &dto.CollectionStatsResponse{
-		Collection:      req.Collection,
-		DocumentCount:   count,
-		Size:            0,
-		AvgDocumentSize: 0,
-		IndexCount:      len(indexes),
+		Collection:    req.Collection,
+		DocumentCount: count,
+		IndexCount:    len(indexes),
 	}
 
 	logger.Info(ctx, "collection stats retrieved successfully",
@@ -826,3 +1007,134 @@ func (uc *DocumentUseCase) GetCollectionStats(ctx context.Context, req *dto.Coll
 
 	return stats, nil
 }
+
+// collectionStatsResponse는 백엔드 통계를 응답 DTO로 변환합니다
+func collectionStatsResponse(stats *repository.CollectionStats) *dto.CollectionStatsResponse {
+	return &dto.CollectionStatsResponse{
+		Collection:      stats.Collection,
+		DocumentCount:   stats.Count,
+		Size:            stats.Size,
+		AvgDocumentSize: stats.AvgDocSize,
+		StorageSize:     stats.StorageSize,
+		IndexCount:      stats.IndexCount,
+		TotalIndexSize:  stats.TotalIndexSize,
+		IndexSizes:      stats.IndexSizes,
+		StorageEngine:   stats.StorageEngine,
+	}
+}
+
+// databaseStatsResponse는 백엔드 통계를 응답 DTO로 변환합니다
+func databaseStatsResponse(dbType string, stats *repository.DatabaseStats) *dto.DatabaseStatsResponse {
+	resp := &dto.DatabaseStatsResponse{
+		DatabaseType:    dbType,
+		Collections:     stats.Collections,
+		TotalDocuments:  stats.Documents,
+		TotalSize:       stats.DataSize,
+		StorageSize:     stats.StorageSize,
+		IndexSize:       stats.IndexSize,
+		CollectionStats: make([]*dto.CollectionStatsResponse, 0, len(stats.PerCollection)),
+	}
+	if stats.Documents > 0 {
+		resp.AvgDocumentSize = float64(stats.DataSize) / float64(stats.Documents)
+	}
+	if stats.Connections != nil {
+		resp.Connections = &dto.ConnectionStatsResponse{
+			Current:   stats.Connections.Current,
+			Active:    stats.Connections.Active,
+			Available: stats.Connections.Available,
+		}
+	}
+	for _, c := range stats.PerCollection {
+		resp.CollectionStats = append(resp.CollectionStats, collectionStatsResponse(c))
+	}
+	return resp
+}
+
+// cacheHealthKey는 캐시 상태 확인에 조회하는 키입니다
+const cacheHealthKey = "health:cache"
+
+// indexKeys는 요청의 인덱스 키(필드 → 1/-1)를 저장소 인덱스 모델의 키로 변환합니다
+func indexKeys(keys map[string]int) map[string]interface{} {
+	converted := make(map[string]interface{}, len(keys))
+	for field, order := range keys {
+		converted[field] = order
+	}
+	return converted
+}
+
+// indexOptions는 요청의 인덱스 옵션(unique, name, sparse, background, expireAfterSeconds)을 변환합니다
+func indexOptions(options map[string]interface{}) *repository.IndexOptions {
+	if len(options) == 0 {
+		return nil
+	}
+
+	opts := &repository.IndexOptions{}
+	if v, ok := options["unique"].(bool); ok {
+		opts.Unique = &v
+	}
+	if v, ok := options["sparse"].(bool); ok {
+		opts.Sparse = &v
+	}
+	if v, ok := options["background"].(bool); ok {
+		opts.Background = &v
+	}
+	if v, ok := options["name"].(string); ok {
+		opts.Name = v
+	}
+	if v, ok := options["expireAfterSeconds"].(float64); ok {
+		seconds := int32(v)
+		opts.ExpireAfter = &seconds
+	}
+	return opts
+}
+
+// indexInfo는 저장소가 반환한 인덱스 정보(name, key, unique)를 DTO로 변환합니다
+func indexInfo(idx map[string]interface{}) dto.IndexInfo {
+	info := dto.IndexInfo{Keys: map[string]int{}}
+	info.Name, _ = idx["name"].(string)
+	info.Unique, _ = idx["unique"].(bool)
+
+	var keys map[string]interface{}
+	switch k := idx["key"].(type) {
+	case map[string]interface{}:
+		keys = k
+	case bson.M:
+		keys = k
+	}
+	for field, order := range keys {
+		switch v := order.(type) {
+		case int:
+			info.Keys[field] = v
+		case int32:
+			info.Keys[field] = int(v)
+		case int64:
+			info.Keys[field] = int(v)
+		case float64:
+			info.Keys[field] = int(v)
+		}
+	}
+	return info
+}
+
+// filterCollections는 filter의 name 조건과 일치하는 컬렉션만 남깁니다 (조건이 없으면 전체)
+func filterCollections(collections []string, filter map[string]interface{}) []string {
+	name, ok := filter["name"].(string)
+	if !ok {
+		return collections
+	}
+	for _, c := range collections {
+		if c == name {
+			return []string{c}
+		}
+	}
+	return []string{}
+}
+
+// rawQueryParameters는 원시 쿼리 바인드 인자를 확인합니다
+// 저장소의 원시 쿼리 실행은 인자 바인딩을 지원하지 않으므로 인자가 있으면 거부합니다
+func rawQueryParameters(params []interface{}) error {
+	if len(params) > 0 {
+		return fmt.Errorf("raw query parameters are not supported")
+	}
+	return nil
+}
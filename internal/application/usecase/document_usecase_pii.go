@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/pii"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// SetPIIScanner는 쓰기 시 개인정보 탐지기를 설정합니다
+// 설정하면 생성/수정/교체/대량 쓰기 데이터를 검사해 정책에 따라 태그, 마스킹 또는 거부합니다
+func (uc *DocumentUseCase) SetPIIScanner(scanner *pii.Scanner) {
+	uc.piiScanner = scanner
+}
+
+// scanPII는 새로 쓰는 문서 데이터를 검사하고 정책을 적용합니다
+func (uc *DocumentUseCase) scanPII(ctx context.Context, collection string, data map[string]interface{}) error {
+	if uc.piiScanner == nil {
+		return nil
+	}
+
+	result, err := uc.piiScanner.Scan(collection, data)
+	uc.recordPIIFindings(ctx, collection, result)
+	return err
+}
+
+// scanPIIUpdate는 업데이트 내용을 검사합니다
+// 부분 업데이트는 문서 전체의 탐지 결과를 알 수 없으므로 tag 정책에서는 TagField를 쓰지 않고 메트릭만 기록합니다
+func (uc *DocumentUseCase) scanPIIUpdate(ctx context.Context, collection string, update map[string]interface{}) error {
+	if uc.piiScanner == nil {
+		return nil
+	}
+
+	result, err := uc.piiScanner.Scan(collection, update)
+	if result != nil && result.Action == pii.ActionTag {
+		delete(update, pii.TagField)
+	}
+	uc.recordPIIFindings(ctx, collection, result)
+	return err
+}
+
+// recordPIIFindings는 탐지 결과를 메트릭, 트레이스, 로그에 기록합니다
+// 값 자체는 기록하지 않고 필드 경로와 유형만 남깁니다
+func (uc *DocumentUseCase) recordPIIFindings(ctx context.Context, collection string, result *pii.Result) {
+	if result == nil || len(result.Findings) == 0 {
+		return
+	}
+
+	fields := make([]string, 0, len(result.Findings))
+	for _, f := range result.Findings {
+		uc.metrics.RecordPIIDetection(collection, f.Type, string(result.Action))
+		fields = append(fields, f.Field)
+	}
+
+	tracing.SetAttributes(ctx,
+		attribute.Int("pii.findings", len(result.Findings)),
+		attribute.String("pii.action", string(result.Action)),
+	)
+	logger.Info(ctx, "personal data detected in write",
+		zap.String("collection", collection),
+		zap.String("action", string(result.Action)),
+		zap.Strings("fields", fields),
+	)
+}
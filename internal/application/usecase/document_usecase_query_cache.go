@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SetQueryCacheTTL은 검색/개수 조회 결과를 캐시할 기간을 설정합니다 (0이면 비활성화)
+// 결과는 컬렉션+필터+옵션의 정규화된 해시로 캐시되며, 컬렉션에 변경이 생기면(로컬 쓰기 또는 CDC 이벤트)
+// 컬렉션 세대 값이 바뀌어 이전 결과는 더 이상 조회되지 않습니다
+// 캐시 무효화 경로를 거치지 않는 대량 쓰기는 TTL 동안 반영되지 않을 수 있으므로 TTL을 짧게 유지합니다
+func (uc *DocumentUseCase) SetQueryCacheTTL(ttl time.Duration) {
+	uc.queryCacheTTL.Store(int64(ttl))
+}
+
+// queryGenerationKey는 컬렉션의 쿼리 캐시 세대 키를 생성합니다
+func queryGenerationKey(collection string) string {
+	return fmt.Sprintf("query:gen:%s", collection)
+}
+
+// queryCacheKey는 쿼리 결과 캐시 키를 생성합니다
+// params는 JSON으로 직렬화되며 맵 키가 정렬되므로 같은 조건은 항상 같은 해시가 됩니다
+// 행 수준 보안 범위가 적용된 필터를 넘겨야 호출자 범위별로 결과가 분리됩니다
+func (uc *DocumentUseCase) queryCacheKey(ctx context.Context, collection, op string, params map[string]interface{}) (string, bool) {
+	if uc.queryCacheTTL.Load() <= 0 || uc.cachePolicy(ctx, collection).Strategy == CacheNone {
+		return "", false
+	}
+
+	canonical, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(canonical)
+
+	generation := "0"
+	if value, err := uc.cacheGet(ctx, "query", collection, queryGenerationKey(collection)); err == nil {
+		if s, ok := value.(string); ok {
+			generation = s
+		}
+	}
+
+	return fmt.Sprintf("query:%s:%s:%s:%s:%s",
+		middleware.GetDatabaseType(ctx), collection, generation, op, hex.EncodeToString(sum[:]),
+	), true
+}
+
+// queryCacheGet은 캐시된 쿼리 결과를 out에 채웁니다
+func (uc *DocumentUseCase) queryCacheGet(ctx context.Context, collection, key string, out interface{}) bool {
+	cached, err := uc.cacheGet(ctx, "query", collection, key)
+	if err != nil {
+		uc.metrics.RecordCacheMiss("query", collection)
+		return false
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil || json.Unmarshal(data, out) != nil {
+		uc.metrics.RecordCacheMiss("query", collection)
+		return false
+	}
+
+	uc.metrics.RecordCacheHit("query", collection)
+	logger.Debug(ctx, "query cache hit", zap.String("key", key))
+	return true
+}
+
+// queryCacheSet은 쿼리 결과를 캐시합니다
+func (uc *DocumentUseCase) queryCacheSet(ctx context.Context, collection, key string, value interface{}) {
+	ttl := int(time.Duration(uc.queryCacheTTL.Load()) / time.Second)
+	if ttl < 1 {
+		ttl = 1
+	}
+	if err := uc.cacheSet(ctx, "query", collection, key, value, ttl); err != nil {
+		logger.Warn(ctx, "failed to cache query result", zap.Error(err))
+	}
+}
+
+// invalidateQueries는 컬렉션의 쿼리 캐시 세대를 바꿔 이전 결과를 무효화합니다
+// 세대 키는 만료 없이 저장되며, 이전 세대의 결과는 TTL이 지나면 자연히 제거됩니다
+func (uc *DocumentUseCase) invalidateQueries(ctx context.Context, collection string) {
+	if uc.queryCacheTTL.Load() <= 0 {
+		return
+	}
+	generation := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := uc.cacheSet(ctx, "query", collection, queryGenerationKey(collection), generation, 0); err != nil {
+		logger.Warn(ctx, "failed to invalidate query cache", zap.String("collection", collection), zap.Error(err))
+	}
+}
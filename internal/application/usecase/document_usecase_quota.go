@@ -0,0 +1,459 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/quota"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// 쿼터 범위
+const (
+	quotaScopeCollection = "collection"
+	quotaScopeTenant     = "tenant"
+)
+
+// CollectionQuota는 컬렉션 저장 용량 한도입니다 (0이면 해당 제한 없음)
+type CollectionQuota struct {
+	Collection   string // 컬렉션 이름 또는 와일드카드 패턴 (*, ?, [...])
+	MaxDocuments int64
+	MaxBytes     int64
+}
+
+// TenantQuotaScope는 컬렉션이 속한 테넌트와 테넌트 쿼터입니다
+type TenantQuotaScope struct {
+	TenantID  string
+	Namespace string // 테넌트 컬렉션 이름 접두사
+	Limit     quota.Limit
+}
+
+// TenantQuotaResolver는 컬렉션이 속한 테넌트의 쿼터를 찾습니다 (TenantUseCase가 구현)
+type TenantQuotaResolver interface {
+	// ResolveTenantQuota는 테넌트 컬렉션이 아니면 nil을 반환합니다
+	ResolveTenantQuota(ctx context.Context, collection string) (*TenantQuotaScope, error)
+}
+
+// storageQuotas는 저장 용량 쿼터 설정과 추적 중인 사용량입니다
+type storageQuotas struct {
+	collections  []CollectionQuota
+	defaultLimit quota.Limit
+	tenants      TenantQuotaResolver
+	tracker      *quota.Tracker
+
+	mu      sync.Mutex
+	targets map[string]quotaTarget // 추적 키별 사용량을 다시 맞출 대상
+}
+
+// quotaTarget은 사용량을 추적하는 컬렉션 또는 테넌트입니다
+type quotaTarget struct {
+	databaseType string
+	collection   string            // 컬렉션 범위
+	tenant       *TenantQuotaScope // 테넌트 범위
+	limit        quota.Limit
+}
+
+func (t quotaTarget) scope() string {
+	if t.tenant != nil {
+		return quotaScopeTenant
+	}
+	return quotaScopeCollection
+}
+
+func (t quotaTarget) name() string {
+	if t.tenant != nil {
+		return t.tenant.TenantID
+	}
+	return t.collection
+}
+
+func (t quotaTarget) key() string {
+	return t.databaseType + "/" + t.scope() + ":" + t.name()
+}
+
+// SetStorageQuotas는 컬렉션별 저장 용량 한도를 설정합니다
+// collections에서 처음 일치한 한도를 사용하고, 일치하는 한도가 없으면 defaultLimit을 적용합니다
+// 한도가 있는 컬렉션은 삽입 시 문서 수와 크기를 더해 한도를 넘으면 quota.ErrExceeded로 거부하고,
+// 수정/교체/upsert는 이미 한도에 도달한 경우 거부합니다
+func (uc *DocumentUseCase) SetStorageQuotas(collections []CollectionQuota, defaultLimit quota.Limit) {
+	uc.quotas = &storageQuotas{
+		collections:  collections,
+		defaultLimit: defaultLimit,
+		tracker:      quota.NewTracker(),
+		targets:      make(map[string]quotaTarget),
+	}
+}
+
+// SetTenantQuotaResolver는 테넌트 쿼터를 찾을 resolver를 설정합니다 (SetStorageQuotas 이후에 호출)
+// 테넌트 쿼터는 데이터베이스별로 테넌트 네임스페이스의 모든 컬렉션 사용량을 합쳐 적용합니다
+func (uc *DocumentUseCase) SetTenantQuotaResolver(resolver TenantQuotaResolver) {
+	if uc.quotas != nil {
+		uc.quotas.tenants = resolver
+	}
+}
+
+// RunQuotaRefresher는 interval마다 추적 중인 컬렉션/테넌트 사용량을 저장소 통계로 다시 맞춥니다
+// ctx가 취소될 때까지 실행되므로 별도 goroutine에서 호출해야 합니다
+func (uc *DocumentUseCase) RunQuotaRefresher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.RefreshQuotaUsage(ctx)
+		}
+	}
+}
+
+// RefreshQuotaUsage는 추적 중인 모든 컬렉션/테넌트 사용량을 저장소 통계로 다시 맞춥니다
+// 삭제나 수정으로 줄어든 사용량과 다른 인스턴스의 쓰기는 이때 반영됩니다
+func (uc *DocumentUseCase) RefreshQuotaUsage(ctx context.Context) {
+	if uc.quotas == nil {
+		return
+	}
+
+	uc.quotas.mu.Lock()
+	targets := make([]quotaTarget, 0, len(uc.quotas.targets))
+	for _, target := range uc.quotas.targets {
+		targets = append(targets, target)
+	}
+	uc.quotas.mu.Unlock()
+
+	for _, target := range targets {
+		if ctx.Err() != nil {
+			return
+		}
+		docRepo, err := uc.repositoryByType(target.databaseType)
+		if err != nil {
+			logger.Warn(ctx, "storage quota refresh skipped", zap.String("database_type", target.databaseType), zap.Error(err))
+			continue
+		}
+		if _, err := uc.syncQuota(ctx, docRepo, target); err != nil {
+			logger.Warn(ctx, "failed to refresh storage quota usage",
+				zap.String("database_type", target.databaseType),
+				zap.String("scope", target.scope()),
+				zap.String("name", target.name()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// CollectionStorageUsage는 컬렉션(테넌트 컬렉션이면 테넌트 합계 포함)의 현재 사용량과 한도를 반환합니다
+func (uc *DocumentUseCase) CollectionStorageUsage(ctx context.Context, collection string) (*dto.StorageUsageResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.CollectionStorageUsage")
+	defer span.End()
+
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	// 사용량은 컬렉션 전체 기준이므로 행 수준 보안이 적용되는 호출자에게는 반환하지 않습니다
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err == nil && len(conditions) > 0 {
+		err = fmt.Errorf("%w: storage usage is not allowed on row-restricted collection %q", auth.ErrForbidden, collection)
+	}
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	tracing.SetAttributes(ctx, attribute.String("collection", collection))
+
+	target := quotaTarget{
+		databaseType: string(middleware.GetDatabaseType(ctx)),
+		collection:   collection,
+	}
+	if uc.quotas != nil {
+		target.limit = uc.quotas.collectionLimit(collection)
+	}
+	usage, err := uc.syncQuota(ctx, docRepo, target)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	resp := storageUsageResponse(target, usage)
+
+	if uc.quotas != nil && uc.quotas.tenants != nil {
+		scope, err := uc.quotas.tenants.ResolveTenantQuota(ctx, collection)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to resolve tenant quota: %w", err)
+		}
+		if scope != nil {
+			tenantTarget := quotaTarget{databaseType: target.databaseType, tenant: scope, limit: scope.Limit}
+			tenantUsage, err := uc.syncQuota(ctx, docRepo, tenantTarget)
+			if err != nil {
+				tracing.RecordError(ctx, err)
+				return nil, err
+			}
+			resp.Tenant = storageUsageResponse(tenantTarget, tenantUsage)
+		}
+	}
+	return resp, nil
+}
+
+// TenantStorageUsage는 테넌트 네임스페이스의 컬렉션별 사용량과 합계를 반환합니다
+func (uc *DocumentUseCase) TenantStorageUsage(ctx context.Context, scope *TenantQuotaScope) (*dto.StorageUsageResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.TenantStorageUsage")
+	defer span.End()
+
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	tracing.SetAttributes(ctx, attribute.String("tenant_id", scope.TenantID))
+
+	databaseType := string(middleware.GetDatabaseType(ctx))
+	collections, err := tenantCollections(ctx, docRepo, scope.Namespace)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	now := time.Now()
+	tenantTarget := quotaTarget{databaseType: databaseType, tenant: scope, limit: scope.Limit}
+	var total quota.Usage
+	breakdown := make([]dto.StorageUsageResponse, 0, len(collections))
+	for _, collection := range collections {
+		documents, bytes, err := collectionUsage(ctx, docRepo, collection)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, err
+		}
+		total.Documents += documents
+		total.Bytes += bytes
+
+		target := quotaTarget{databaseType: databaseType, collection: collection}
+		if uc.quotas != nil {
+			target.limit = uc.quotas.collectionLimit(collection)
+		}
+		breakdown = append(breakdown, *storageUsageResponse(target, quota.Usage{Documents: documents, Bytes: bytes, SyncedAt: now}))
+	}
+	total.SyncedAt = now
+	uc.applyQuotaUsage(tenantTarget, total)
+
+	resp := storageUsageResponse(tenantTarget, total)
+	resp.Collections = breakdown
+	return resp, nil
+}
+
+// reserveQuota는 삽입할 문서 수와 크기를 컬렉션/테넌트 사용량에 더합니다 (한도를 넘으면 quota.ErrExceeded)
+// documents와 bytes가 0이면 더하지 않고 이미 한도에 도달했는지만 확인합니다 (수정/교체/upsert)
+// 쓰기가 실패하면 반환한 claim으로 releaseQuota를 호출해야 합니다
+func (uc *DocumentUseCase) reserveQuota(ctx context.Context, docRepo repository.DocumentRepository, collection string, documents, bytes int64) ([]quota.Claim, error) {
+	if uc.quotas == nil {
+		return nil, nil
+	}
+
+	databaseType := string(middleware.GetDatabaseType(ctx))
+	claims, err := uc.quotaClaims(ctx, docRepo, databaseType, collection)
+	if err != nil || len(claims) == 0 {
+		return nil, err
+	}
+
+	if err := uc.quotas.tracker.Reserve(claims, documents, bytes); err != nil {
+		var exceeded *quota.ExceededError
+		if errors.As(err, &exceeded) {
+			uc.metrics.RecordQuotaRejection(databaseType, exceeded.Scope, exceeded.Name, exceeded.Resource)
+			logger.Info(ctx, "write rejected by storage quota",
+				zap.String("collection", collection),
+				zap.String("scope", exceeded.Scope),
+				zap.String("name", exceeded.Name),
+				zap.String("resource", exceeded.Resource),
+				zap.Int64("limit", exceeded.Limit),
+				zap.Int64("used", exceeded.Used),
+			)
+		}
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	return claims, nil
+}
+
+// releaseQuota는 reserveQuota로 더한 사용량을 되돌립니다
+func (uc *DocumentUseCase) releaseQuota(claims []quota.Claim, documents, bytes int64) {
+	if len(claims) > 0 {
+		uc.quotas.tracker.Release(claims, documents, bytes)
+	}
+}
+
+// quotaClaims는 컬렉션에 적용되는 컬렉션/테넌트 한도를 반환합니다 (처음 보는 대상은 사용량을 먼저 조회)
+func (uc *DocumentUseCase) quotaClaims(ctx context.Context, docRepo repository.DocumentRepository, databaseType, collection string) ([]quota.Claim, error) {
+	var targets []quotaTarget
+	if limit := uc.quotas.collectionLimit(collection); limit.Enabled() {
+		targets = append(targets, quotaTarget{databaseType: databaseType, collection: collection, limit: limit})
+	}
+	if uc.quotas.tenants != nil {
+		scope, err := uc.quotas.tenants.ResolveTenantQuota(ctx, collection)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve tenant quota: %w", err)
+		}
+		if scope != nil && scope.Limit.Enabled() {
+			targets = append(targets, quotaTarget{databaseType: databaseType, tenant: scope, limit: scope.Limit})
+		}
+	}
+
+	claims := make([]quota.Claim, 0, len(targets))
+	for _, target := range targets {
+		key := target.key()
+		uc.quotas.mu.Lock()
+		uc.quotas.targets[key] = target
+		uc.quotas.mu.Unlock()
+
+		if _, ok := uc.quotas.tracker.Get(key); !ok {
+			if _, err := uc.syncQuota(ctx, docRepo, target); err != nil {
+				return nil, err
+			}
+		}
+		claims = append(claims, quota.Claim{Key: key, Scope: target.scope(), Name: target.name(), Limit: target.limit})
+	}
+	return claims, nil
+}
+
+// syncQuota는 대상의 사용량을 저장소 통계로 조회해 추적 값을 바꿉니다
+func (uc *DocumentUseCase) syncQuota(ctx context.Context, docRepo repository.DocumentRepository, target quotaTarget) (quota.Usage, error) {
+	collections := []string{target.collection}
+	if target.tenant != nil {
+		var err error
+		if collections, err = tenantCollections(ctx, docRepo, target.tenant.Namespace); err != nil {
+			return quota.Usage{}, err
+		}
+	}
+
+	usage := quota.Usage{SyncedAt: time.Now()}
+	for _, collection := range collections {
+		documents, bytes, err := collectionUsage(ctx, docRepo, collection)
+		if err != nil {
+			return quota.Usage{}, err
+		}
+		usage.Documents += documents
+		usage.Bytes += bytes
+	}
+
+	uc.applyQuotaUsage(target, usage)
+	return usage, nil
+}
+
+// applyQuotaUsage는 한도가 있는 대상의 추적 사용량을 바꾸고 메트릭으로 기록합니다
+func (uc *DocumentUseCase) applyQuotaUsage(target quotaTarget, usage quota.Usage) {
+	if !target.limit.Enabled() {
+		return
+	}
+	if uc.quotas != nil {
+		uc.quotas.tracker.Sync(target.key(), usage.Documents, usage.Bytes, usage.SyncedAt)
+	}
+	uc.metrics.RecordQuotaUsage(target.databaseType, target.scope(), target.name(),
+		usage.Documents, usage.Bytes, target.limit.MaxDocuments, target.limit.MaxBytes)
+}
+
+// repositoryByType은 데이터베이스 종류의 저장소를 반환합니다 (단일 저장소 모드에서는 그 저장소)
+func (uc *DocumentUseCase) repositoryByType(databaseType string) (repository.DocumentRepository, error) {
+	if uc.repoManager == nil {
+		if uc.docRepo == nil {
+			return nil, fmt.Errorf("no repository configured")
+		}
+		return uc.docRepo, nil
+	}
+	return uc.repoManager.GetRepository(databaseType)
+}
+
+// collectionLimit은 컬렉션에 적용할 한도를 반환합니다 (처음 일치한 설정, 없으면 기본 한도)
+func (q *storageQuotas) collectionLimit(collection string) quota.Limit {
+	for _, c := range q.collections {
+		if c.Collection == collection {
+			return quota.Limit{MaxDocuments: c.MaxDocuments, MaxBytes: c.MaxBytes}
+		}
+		if matched, _ := path.Match(c.Collection, collection); matched {
+			return quota.Limit{MaxDocuments: c.MaxDocuments, MaxBytes: c.MaxBytes}
+		}
+	}
+	return q.defaultLimit
+}
+
+// collectionUsage는 컬렉션의 문서 수와 데이터 크기를 반환합니다 (없는 컬렉션은 0)
+// 백엔드 고유 통계를 지원하지 않는 저장소는 추정 문서 수만 반환하고 크기는 0입니다
+func collectionUsage(ctx context.Context, docRepo repository.DocumentRepository, collection string) (int64, int64, error) {
+	exists, err := docRepo.CollectionExists(ctx, collection)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return 0, 0, nil
+	}
+
+	if reader, ok := docRepo.(repository.CollectionStatsReader); ok {
+		stats, err := reader.CollectionStats(ctx, collection)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get collection stats: %w", err)
+		}
+		return stats.Count, stats.Size, nil
+	}
+
+	count, err := docRepo.EstimatedDocumentCount(ctx, collection)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count documents: %w", err)
+	}
+	return count, 0, nil
+}
+
+// tenantCollections는 테넌트 네임스페이스에 속한 컬렉션을 반환합니다
+func tenantCollections(ctx context.Context, docRepo repository.DocumentRepository, namespace string) ([]string, error) {
+	all, err := docRepo.ListCollections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	var collections []string
+	for _, collection := range all {
+		if strings.HasPrefix(collection, namespace) {
+			collections = append(collections, collection)
+		}
+	}
+	return collections, nil
+}
+
+// documentBytes는 쿼터 계산에 쓰는 문서 데이터 크기(JSON 인코딩 길이)를 반환합니다
+func documentBytes(data map[string]interface{}) int64 {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return 0
+	}
+	return int64(len(encoded))
+}
+
+// storageUsageResponse는 사용량을 응답 DTO로 변환합니다
+func storageUsageResponse(target quotaTarget, usage quota.Usage) *dto.StorageUsageResponse {
+	return &dto.StorageUsageResponse{
+		DatabaseType: target.databaseType,
+		Scope:        target.scope(),
+		Name:         target.name(),
+		Documents:    usage.Documents,
+		Bytes:        usage.Bytes,
+		MaxDocuments: target.limit.MaxDocuments,
+		MaxBytes:     target.limit.MaxBytes,
+		SyncedAt:     usage.SyncedAt,
+	}
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ReadMode는 컬렉션 읽기를 보낼 저장소입니다
+type ReadMode string
+
+const (
+	// ReadPrimary는 쓰기와 같은 주 저장소에서 읽습니다 (기본값, 방금 쓴 값이 바로 보임)
+	ReadPrimary ReadMode = "primary"
+
+	// ReadReplica는 읽기 복제본(MongoDB secondary, SQL 읽기 전용 복제본)에서 읽습니다 (복제 지연만큼 오래된 값 가능)
+	ReadReplica ReadMode = "replica"
+)
+
+// ReadRoute는 컬렉션에 적용할 읽기 모드입니다
+type ReadRoute struct {
+	// Collection은 적용 대상 컬렉션입니다 (path.Match 패턴 지원)
+	Collection string
+	Mode       ReadMode
+}
+
+// ReadRouting은 컬렉션별 읽기 라우팅 규칙입니다 (먼저 선언된 규칙 우선)
+// 쓰기와 관리 작업은 항상 주 저장소로 보내고, 조회/검색/집계만 규칙에 따라 복제본으로 보냅니다
+type ReadRouting struct {
+	defaultMode ReadMode
+	routes      []ReadRoute
+}
+
+// NewReadRouting은 새로운 ReadRouting을 생성합니다
+func NewReadRouting(defaultMode ReadMode, routes []ReadRoute) (*ReadRouting, error) {
+	if defaultMode == "" {
+		defaultMode = ReadPrimary
+	}
+	if !defaultMode.valid() {
+		return nil, fmt.Errorf("invalid default read mode: %s", defaultMode)
+	}
+
+	for i, route := range routes {
+		if route.Collection == "" {
+			return nil, fmt.Errorf("read route %d: collection is required", i)
+		}
+		if _, err := path.Match(route.Collection, ""); err != nil {
+			return nil, fmt.Errorf("read route %d: invalid collection pattern %q: %w", i, route.Collection, err)
+		}
+		if !route.Mode.valid() {
+			return nil, fmt.Errorf("read route %d: invalid mode: %s", i, route.Mode)
+		}
+	}
+
+	return &ReadRouting{defaultMode: defaultMode, routes: routes}, nil
+}
+
+// ModeFor는 컬렉션에 적용할 읽기 모드를 반환합니다
+func (r *ReadRouting) ModeFor(collection string) ReadMode {
+	for _, route := range r.routes {
+		if ok, _ := path.Match(route.Collection, collection); ok {
+			return route.Mode
+		}
+	}
+	return r.defaultMode
+}
+
+// valid는 지원하는 읽기 모드인지 확인합니다
+func (m ReadMode) valid() bool {
+	return m == ReadPrimary || m == ReadReplica
+}
+
+// SetReadRouting은 컬렉션별 읽기 라우팅을 설정합니다
+// 설정하지 않으면 모든 읽기를 주 저장소로 보냅니다
+func (uc *DocumentUseCase) SetReadRouting(routing *ReadRouting) {
+	uc.readRouting = routing
+}
+
+// SetReadReplica는 단일 저장소 모드(NewDocumentUseCase)에서 사용할 읽기 복제본 저장소를 설정합니다
+// RepositoryManager 모드에서는 RepositoryManager.RegisterReadReplica로 데이터베이스별 복제본을 등록합니다
+func (uc *DocumentUseCase) SetReadReplica(replica repository.DocumentRepository) {
+	uc.readReplica = replica
+}
+
+// getReadRepository는 컬렉션 읽기에 사용할 저장소를 반환합니다
+// 복제본 모드인 컬렉션이라도 요청이 strong 일관성을 요구하거나 해당 데이터베이스의 복제본이 없으면 주 저장소를 사용합니다
+func (uc *DocumentUseCase) getReadRepository(ctx context.Context, collection string) (repository.DocumentRepository, error) {
+	if uc.readRouting == nil {
+		return uc.getRepository(ctx)
+	}
+	if middleware.GetReadConsistency(ctx) == middleware.ReadConsistencyStrong {
+		return uc.getRepository(ctx)
+	}
+	if uc.readRouting.ModeFor(collection) != ReadReplica {
+		return uc.getRepository(ctx)
+	}
+
+	dbType := middleware.GetDatabaseType(ctx)
+	replica, ok := uc.readReplica, uc.readReplica != nil
+	if uc.repoManager != nil {
+		replica, ok = uc.repoManager.GetReadRepository(string(dbType))
+	}
+	if !ok {
+		return uc.getRepository(ctx)
+	}
+
+	logger.Debug(ctx, "routing read to replica",
+		zap.String("collection", collection),
+		zap.String("database_type", string(dbType)),
+	)
+	return replica, nil
+}
+
+// writesInPipeline은 집계 파이프라인이 결과를 컬렉션에 쓰는지($out, $merge) 확인합니다
+// 쓰기 단계가 있는 파이프라인은 복제본에서 실행할 수 없으므로 주 저장소로 보냅니다
+func writesInPipeline(pipeline []map[string]interface{}) bool {
+	for _, stage := range pipeline {
+		for op := range stage {
+			switch strings.ToLower(op) {
+			case "$out", "$merge":
+				return true
+			}
+		}
+	}
+	return false
+}
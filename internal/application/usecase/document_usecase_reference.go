@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+// defaultMaxCascade는 삭제 한 번에 참조별로 cascade/nullify할 수 있는 기본 문서 수입니다
+const defaultMaxCascade = 1000
+
+// ErrUnknownReference는 populate로 요청한 필드가 선언된 참조가 아닐 때의 에러입니다
+var ErrUnknownReference = errors.New("field is not a declared reference")
+
+// ErrTooManyReferences는 삭제할 문서를 참조하는 문서가 cascade/nullify 한도보다 많을 때의 에러입니다
+var ErrTooManyReferences = errors.New("too many referencing documents")
+
+// ReferenceAction은 참조 대상 문서가 삭제될 때 참조하는 문서에 적용하는 동작입니다
+type ReferenceAction string
+
+const (
+	ReferenceNoAction ReferenceAction = ""        // 참조하는 문서를 그대로 둠
+	ReferenceCascade  ReferenceAction = "cascade" // 참조하는 문서도 삭제
+	ReferenceNullify  ReferenceAction = "nullify" // 참조 필드를 null로 변경
+)
+
+// Reference는 컬렉션의 data 필드가 다른 컬렉션 문서의 ID를 참조한다는 선언입니다
+type Reference struct {
+	Collection string // 참조하는 컬렉션
+	Field      string // 대상 문서 ID를 담는 data 필드 경로 (점으로 구분)
+	Target     string // 참조 대상 컬렉션
+	OnDelete   ReferenceAction
+}
+
+// cascadeVisitedKey는 한 삭제 요청에서 이미 처리 중인 문서를 기록하는 컨텍스트 키입니다 (순환 참조 방지)
+type cascadeVisitedKey struct{}
+
+// SetReferences는 컬렉션 간 참조와 삭제 한 번에 참조별로 처리할 수 있는 최대 문서 수를 설정합니다 (0이면 기본값 1000)
+// 대상 문서를 삭제하면 참조하는 문서에 OnDelete 동작을 먼저 적용하고, 조회의 populate로 대상 문서를 함께 반환합니다
+func (uc *DocumentUseCase) SetReferences(references []Reference, maxCascade int) {
+	uc.references = make(map[string][]Reference)
+	uc.referencedBy = make(map[string][]Reference)
+	for _, ref := range references {
+		uc.references[ref.Collection] = append(uc.references[ref.Collection], ref)
+		uc.referencedBy[ref.Target] = append(uc.referencedBy[ref.Target], ref)
+	}
+	if maxCascade <= 0 {
+		maxCascade = defaultMaxCascade
+	}
+	uc.maxCascade = maxCascade
+}
+
+// applyReferences는 삭제할 문서를 참조하는 문서에 cascade/nullify를 적용합니다 (DeleteDocument에서 삭제 전에 호출)
+// cascade는 DeleteDocument로 삭제하므로 감사 로그, 캐시 무효화, 소프트 삭제, 연쇄 참조가 그대로 적용됩니다
+// 저장소 트랜잭션 없이 문서 단위로 처리하므로 중간에 실패하면 이미 처리한 문서는 되돌리지 않습니다
+func (uc *DocumentUseCase) applyReferences(ctx context.Context, docRepo repository.DocumentRepository, collection, id string) error {
+	refs := uc.referencedBy[collection]
+	if len(refs) == 0 {
+		return nil
+	}
+
+	visited, ok := ctx.Value(cascadeVisitedKey{}).(map[string]bool)
+	if !ok {
+		visited = make(map[string]bool)
+		ctx = context.WithValue(ctx, cascadeVisitedKey{}, visited)
+	}
+	visited[collection+"/"+id] = true
+
+	finder, supported := docRepo.(repository.ReferenceFinder)
+	for _, ref := range refs {
+		if ref.OnDelete == ReferenceNoAction {
+			continue
+		}
+		if !supported {
+			return fmt.Errorf("repository does not support references")
+		}
+
+		ids, err := finder.FindReferencing(ctx, ref.Collection, ref.Field, id, uc.maxCascade+1)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return fmt.Errorf("failed to find documents referencing %s/%s: %w", collection, id, err)
+		}
+		if len(ids) > uc.maxCascade {
+			return fmt.Errorf("%w: more than %d documents in %s reference %s/%s", ErrTooManyReferences, uc.maxCascade, ref.Collection, collection, id)
+		}
+
+		for _, refID := range ids {
+			if visited[ref.Collection+"/"+refID] {
+				continue
+			}
+			switch ref.OnDelete {
+			case ReferenceCascade:
+				err = uc.DeleteDocument(ctx, &dto.DeleteDocumentRequest{Collection: ref.Collection, ID: refID})
+			case ReferenceNullify:
+				err = uc.nullifyReference(ctx, docRepo, ref, refID, id)
+			}
+			// 동시에 삭제되었거나 이미 소프트 삭제된 문서는 건너뜀
+			if err != nil && !errors.Is(err, entity.ErrDocumentNotFound) {
+				return fmt.Errorf("failed to %s %s/%s referencing %s/%s: %w", ref.OnDelete, ref.Collection, refID, collection, id, err)
+			}
+		}
+
+		if len(ids) > 0 {
+			logger.Info(ctx, "references applied",
+				zap.String("collection", ref.Collection),
+				zap.String("field", ref.Field),
+				zap.String("action", string(ref.OnDelete)),
+				zap.Int("documents", len(ids)),
+			)
+		}
+	}
+	return nil
+}
+
+// nullifyReference는 참조하는 문서의 참조 필드를 null로 바꿉니다
+func (uc *DocumentUseCase) nullifyReference(ctx context.Context, docRepo repository.DocumentRepository, ref Reference, id, targetID string) error {
+	return uc.setReference(ctx, docRepo, ref, id, targetID, nil)
+}
+
+// setReference는 참조하는 문서의 참조 필드를 value로 바꿉니다
+// 조회 이후 참조 필드가 targetID가 아닌 다른 값으로 바뀐 문서는 그대로 둡니다
+func (uc *DocumentUseCase) setReference(ctx context.Context, docRepo repository.DocumentRepository, ref Reference, id, targetID string, value interface{}) error {
+	doc, err := docRepo.FindByID(ctx, ref.Collection, id)
+	if err != nil {
+		return err
+	}
+	if current, ok := referenceID(doc.Data(), ref.Field); !ok || current != targetID {
+		return nil
+	}
+
+	return uc.UpdateDocument(ctx, &dto.UpdateDocumentRequest{
+		Collection: ref.Collection,
+		ID:         id,
+		Data:       withFieldValue(doc.Data(), strings.Split(ref.Field, "."), value),
+		Version:    doc.Version(),
+	})
+}
+
+// getPopulated는 문서를 조회한 뒤 요청한 참조 필드의 대상 문서를 채웁니다
+func (uc *DocumentUseCase) getPopulated(ctx context.Context, req *dto.GetDocumentRequest) (*dto.GetDocumentResponse, error) {
+	refs, err := uc.populateReferences(req.Collection, req.Populate)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := *req
+	plain.Populate = nil
+	resp, err := uc.GetDocument(ctx, &plain)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.populate(ctx, refs, []*dto.GetDocumentResponse{resp}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// listPopulated는 문서 목록을 조회한 뒤 요청한 참조 필드의 대상 문서를 채웁니다
+func (uc *DocumentUseCase) listPopulated(ctx context.Context, req *dto.ListDocumentsRequest) (*dto.ListDocumentsResponse, error) {
+	refs, err := uc.populateReferences(req.Collection, req.Populate)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := *req
+	plain.Populate = nil
+	resp, err := uc.ListDocuments(ctx, &plain)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*dto.GetDocumentResponse, len(resp.Documents))
+	for i := range resp.Documents {
+		docs[i] = &resp.Documents[i]
+	}
+	if err := uc.populate(ctx, refs, docs); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// populateReferences는 populate로 요청한 필드에 해당하는 참조 선언을 찾습니다
+func (uc *DocumentUseCase) populateReferences(collection string, fields []string) ([]Reference, error) {
+	refs := make([]Reference, 0, len(fields))
+	for _, field := range fields {
+		found := false
+		for _, ref := range uc.references[collection] {
+			if ref.Field == field {
+				refs = append(refs, ref)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: %s.%s", ErrUnknownReference, collection, field)
+		}
+	}
+	return refs, nil
+}
+
+// populate는 문서들의 참조 필드가 가리키는 대상 문서를 조회해 Populated에 채웁니다
+// 대상 문서는 GetDocument로 조회하므로 캐시와 행 수준 보안이 적용되며, 없거나 볼 수 없는 문서는 null입니다
+func (uc *DocumentUseCase) populate(ctx context.Context, refs []Reference, docs []*dto.GetDocumentResponse) error {
+	loaded := make(map[string]*dto.GetDocumentResponse)
+	for _, doc := range docs {
+		for _, ref := range refs {
+			targetID, ok := referenceID(doc.Data, ref.Field)
+			if !ok {
+				continue
+			}
+
+			key := ref.Target + "/" + targetID
+			target, seen := loaded[key]
+			if !seen {
+				var err error
+				target, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: ref.Target, ID: targetID})
+				if errors.Is(err, entity.ErrDocumentNotFound) {
+					target, err = nil, nil
+				}
+				if err != nil {
+					return fmt.Errorf("failed to populate %s: %w", ref.Field, err)
+				}
+				loaded[key] = target
+			}
+
+			if doc.Populated == nil {
+				doc.Populated = make(map[string]*dto.GetDocumentResponse, len(refs))
+			}
+			doc.Populated[ref.Field] = target
+		}
+	}
+	return nil
+}
+
+// referenceID는 data의 참조 필드 값을 꺼냅니다 (필드가 없거나 빈 문자열이 아닌 문자열이 아니면 false)
+func referenceID(data map[string]interface{}, field string) (string, bool) {
+	var current interface{} = data
+	for _, segment := range strings.Split(field, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		current = m[segment]
+	}
+	id, ok := current.(string)
+	return id, ok && id != ""
+}
+
+// withFieldValue는 path의 값을 value로 바꾼 data의 사본을 반환합니다 (경로의 맵만 복사하므로 원본은 바뀌지 않음)
+func withFieldValue(data map[string]interface{}, path []string, value interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	if len(path) == 1 {
+		copied[path[0]] = value
+		return copied
+	}
+	if child, ok := copied[path[0]].(map[string]interface{}); ok {
+		copied[path[0]] = withFieldValue(child, path[1:], value)
+	}
+	return copied
+}
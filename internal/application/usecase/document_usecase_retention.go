@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/retention"
+	"go.uber.org/zap"
+)
+
+// RetentionPolicy는 컬렉션의 데이터 보존 정책입니다
+type RetentionPolicy struct {
+	Collection string
+	MaxAge     time.Duration // 생성 후 이 기간이 지난 문서 삭제 (0이면 제한 없음)
+	MaxCount   int           // 최신 문서를 이 개수만큼만 보존 (0이면 제한 없음)
+}
+
+// SetRetentionPolicies는 컬렉션별 데이터 보존 정책을 설정합니다
+// dryRun이면 정리 작업이 삭제 대상을 로그와 메트릭으로만 남기고 문서를 삭제하지 않습니다
+func (uc *DocumentUseCase) SetRetentionPolicies(policies []RetentionPolicy, dryRun bool) {
+	uc.retentionPolicies = make(map[string]retention.Policy, len(policies))
+	for _, p := range policies {
+		uc.retentionPolicies[p.Collection] = retention.Policy{MaxAge: p.MaxAge, MaxCount: p.MaxCount}
+	}
+	uc.retentionDryRun = dryRun
+}
+
+// RunRetentionJanitor는 interval마다 보존 정책을 넘은 문서를 삭제합니다
+// ctx가 취소될 때까지 실행되므로 별도 goroutine에서 호출해야 합니다
+func (uc *DocumentUseCase) RunRetentionJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.EnforceRetention(ctx)
+		}
+	}
+}
+
+// EnforceRetention은 모든 저장소에서 보존 정책을 넘은 문서를 삭제하고 삭제한 문서 수를 반환합니다
+// dry-run 모드에서는 삭제 대상 문서 수를 반환합니다
+func (uc *DocumentUseCase) EnforceRetention(ctx context.Context) int64 {
+	repos := map[string]repository.DocumentRepository{"default": uc.docRepo}
+	if uc.repoManager != nil {
+		repos = uc.repoManager.Repositories()
+	}
+
+	var total int64
+	for dbType, repo := range repos {
+		for collection, policy := range uc.retentionPolicies {
+			if ctx.Err() != nil {
+				return total
+			}
+			counts, err := uc.enforceCollectionRetention(ctx, repo, collection, policy)
+			for reason, count := range counts {
+				total += count
+				uc.metrics.RecordRetentionDocuments(dbType, collection, string(reason), uc.retentionDryRun, count)
+				logger.Info(ctx, "retention policy applied",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.String("reason", string(reason)),
+					zap.Int64("count", count),
+					zap.Bool("dry_run", uc.retentionDryRun),
+				)
+			}
+			if err != nil {
+				logger.Error(ctx, "failed to apply retention policy",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+	return total
+}
+
+// enforceCollectionRetention은 컬렉션에서 정책을 넘은 문서를 골라 삭제하고 이유별 문서 수를 반환합니다
+func (uc *DocumentUseCase) enforceCollectionRetention(ctx context.Context, docRepo repository.DocumentRepository, collection string, policy retention.Policy) (map[retention.Reason]int64, error) {
+	items, err := uc.retentionItems(ctx, docRepo, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[retention.Reason]int64)
+	for _, victim := range retention.Select(items, policy, time.Now()) {
+		if uc.retentionDryRun {
+			counts[victim.Reason]++
+			continue
+		}
+		if err := docRepo.Delete(ctx, collection, victim.ID); err != nil {
+			if errors.Is(err, entity.ErrDocumentNotFound) {
+				continue
+			}
+			return counts, err
+		}
+		uc.cacheEvict(ctx, collection, victim.ID)
+		counts[victim.Reason]++
+	}
+	return counts, nil
+}
+
+// retentionItems는 컬렉션 문서의 ID와 생성 시각을 모읍니다
+// 보존 개수 제한은 전체 문서의 생성 시각 순서가 필요하므로 컬렉션 전체를 순회합니다
+func (uc *DocumentUseCase) retentionItems(ctx context.Context, docRepo repository.DocumentRepository, collection string) ([]retention.Item, error) {
+	it, err := uc.openStream(ctx, docRepo, collection, nil, &repository.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	var items []retention.Item
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, retention.Item{ID: doc.ID(), CreatedAt: doc.CreatedAt()})
+	}
+	return items, it.Err()
+}
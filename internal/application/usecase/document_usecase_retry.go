@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"go.uber.org/zap"
+)
+
+// NewRetryBudgets는 데이터베이스 종류+작업별 재시도 예산 모음을 생성합니다
+// 예산이 소진되어 재시도하지 않으면 경고 로그와 retry_budget_exhausted_total 메트릭으로 기록됩니다
+func NewRetryBudgets(cfg retry.BudgetConfig) *retry.Budgets {
+	m := metrics.GetMetrics()
+	return retry.NewBudgets(cfg, func(name string) {
+		database, operation, _ := strings.Cut(name, ":")
+		logger.Warn(context.Background(), "retry budget exhausted",
+			zap.String("database_type", database),
+			zap.String("operation", operation),
+		)
+		m.RecordRetryBudgetExhausted(database, operation)
+	})
+}
+
+// SetRetryConfig는 재시도 횟수와 backoff(jitter 포함) 설정을 지정합니다
+func (uc *DocumentUseCase) SetRetryConfig(cfg retry.Config) {
+	uc.retryConfig = cfg
+}
+
+// SetRetryBudgets는 재시도 예산 모음을 설정합니다 (nil이면 예산 제한 없음)
+func (uc *DocumentUseCase) SetRetryBudgets(budgets *retry.Budgets) {
+	uc.retryBudgets = budgets
+}
+
+// SetRetryClassifiers는 데이터베이스 종류별 재시도 가능 에러 판별 함수를 설정합니다
+// 등록되지 않은 데이터베이스는 retry.IsRetryable을 사용합니다
+func (uc *DocumentUseCase) SetRetryClassifiers(classifiers map[string]func(err error) bool) {
+	uc.retryClassifiers = classifiers
+}
+
+// retryPolicy는 요청의 데이터베이스 종류와 작업에 맞는 재시도 설정을 반환합니다
+// 예산은 데이터베이스 종류+작업마다 따로 관리되어 한 작업의 재시도가 다른 작업의 예산을 소진하지 않습니다
+func (uc *DocumentUseCase) retryPolicy(ctx context.Context, operation string) retry.Config {
+	cfg := uc.retryConfig
+	dbType := string(middleware.GetDatabaseType(ctx))
+	if classify, ok := uc.retryClassifiers[dbType]; ok {
+		cfg.Retryable = classify
+	}
+	if uc.retryBudgets != nil {
+		cfg.Budget = uc.retryBudgets.Get(dbType + ":" + operation)
+	}
+	return cfg
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// revisionWriteTimeout은 리비전 저장 제한 시간입니다
+const revisionWriteTimeout = 5 * time.Second
+
+// ErrRevisionsNotEnabled는 리비전을 보관하지 않는 컬렉션의 이력을 조회하려고 할 때의 에러입니다
+var ErrRevisionsNotEnabled = errors.New("revision history is not enabled for collection")
+
+// RevisionPolicy는 컬렉션의 리비전 보관 정책입니다
+type RevisionPolicy struct {
+	Collection   string
+	MaxRevisions int           // 문서당 보관할 최대 리비전 수 (0이면 제한 없음)
+	Retention    time.Duration // 대체된 지 이 기간이 지난 리비전 삭제 (0이면 영구 보관)
+}
+
+// SetRevisions는 리비전 저장소와 컬렉션별 보관 정책을 설정합니다
+// 정책이 있는 컬렉션은 수정/교체/삭제/복원 전의 버전을 보관하고, 이력 조회와 asOf 시점 조회를 지원합니다
+func (uc *DocumentUseCase) SetRevisions(revisionRepo repository.RevisionRepository, policies []RevisionPolicy) {
+	uc.revisionRepo = revisionRepo
+	uc.revisionPolicies = make(map[string]RevisionPolicy, len(policies))
+	for _, p := range policies {
+		uc.revisionPolicies[p.Collection] = p
+	}
+}
+
+// revisionsEnabled는 컬렉션이 리비전을 보관하는지 반환합니다
+func (uc *DocumentUseCase) revisionsEnabled(collection string) bool {
+	if uc.revisionRepo == nil {
+		return false
+	}
+	_, ok := uc.revisionPolicies[collection]
+	return ok
+}
+
+// revisionKey는 요청의 데이터베이스 종류를 포함한 리비전 키를 반환합니다
+func revisionKey(ctx context.Context, collection, id string) repository.RevisionKey {
+	return repository.RevisionKey{
+		DatabaseType: string(middleware.GetDatabaseType(ctx)),
+		Collection:   collection,
+		DocumentID:   id,
+	}
+}
+
+// recordRevision은 대체된 문서 버전을 리비전으로 보관하고 보관 정책을 넘은 리비전을 정리합니다
+// 요청이 취소되어도 기록이 유실되지 않도록 취소 신호와 분리된 컨텍스트를 사용하며, 실패는 작업 결과에 영향을 주지 않고 에러 로그만 남깁니다
+func (uc *DocumentUseCase) recordRevision(ctx context.Context, prior *entity.Document, supersededAt time.Time, op entity.AuditOperation) {
+	if prior == nil || !uc.revisionsEnabled(prior.Collection()) {
+		return
+	}
+
+	validFrom := prior.UpdatedAt()
+	if validFrom.IsZero() {
+		validFrom = prior.CreatedAt()
+	}
+	key := revisionKey(ctx, prior.Collection(), prior.ID())
+	revision := &entity.Revision{
+		DatabaseType: key.DatabaseType,
+		Collection:   key.Collection,
+		DocumentID:   key.DocumentID,
+		Version:      prior.Version(),
+		Data:         prior.Data(),
+		ValidFrom:    validFrom.UTC(),
+		ValidTo:      supersededAt.UTC(),
+		Operation:    op,
+	}
+
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), revisionWriteTimeout)
+	defer cancel()
+
+	if err := uc.revisionRepo.Append(writeCtx, revision); err != nil {
+		logger.Error(ctx, "failed to record revision",
+			zap.String("collection", key.Collection),
+			zap.String("document_id", key.DocumentID),
+			zap.Int("version", revision.Version),
+			zap.Error(err),
+		)
+		return
+	}
+
+	policy := uc.revisionPolicies[key.Collection]
+	var olderThan time.Time
+	if policy.Retention > 0 {
+		olderThan = time.Now().Add(-policy.Retention)
+	}
+	if _, err := uc.revisionRepo.Prune(writeCtx, key, policy.MaxRevisions, olderThan); err != nil {
+		logger.Warn(ctx, "failed to prune revisions",
+			zap.String("collection", key.Collection),
+			zap.String("document_id", key.DocumentID),
+			zap.Error(err),
+		)
+	}
+}
+
+// ListRevisions는 문서의 이전 버전을 최신 버전부터 조회합니다
+func (uc *DocumentUseCase) ListRevisions(ctx context.Context, req *dto.ListRevisionsRequest) (*dto.ListRevisionsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ListRevisions")
+	defer span.End()
+
+	ctx, cancel := uc.withTimeout(ctx, OperationRead)
+	defer cancel()
+
+	if !uc.revisionsEnabled(req.Collection) {
+		return nil, fmt.Errorf("%w: %s", ErrRevisionsNotEnabled, req.Collection)
+	}
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("id", req.ID),
+	)
+
+	revisions, err := uc.revisionRepo.List(ctx, revisionKey(ctx, req.Collection, req.ID), req.Limit)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	resp := &dto.ListRevisionsResponse{
+		ID:        req.ID,
+		Revisions: make([]dto.RevisionResponse, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		// 행 수준 보안 조건을 만족하지 않는 버전은 존재 여부도 드러내지 않도록 제외
+		if err := uc.checkRowAccess(ctx, req.Collection, revision.Data); err != nil {
+			if errors.Is(err, entity.ErrDocumentNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		resp.Revisions = append(resp.Revisions, dto.RevisionResponse{
+			Version:   revision.Version,
+			Data:      revision.Data,
+			ValidFrom: revision.ValidFrom,
+			ValidTo:   revision.ValidTo,
+			Operation: string(revision.Operation),
+		})
+	}
+	return resp, nil
+}
+
+// getDocumentAsOf는 at 시점의 문서 상태를 조회합니다 (GetDocument에서 asOf가 있을 때 호출)
+// 현재 버전이 at 이전에 저장되었으면 현재 문서를, 아니면 그 시점에 유효했던 리비전을 반환합니다
+func (uc *DocumentUseCase) getDocumentAsOf(ctx context.Context, req *dto.GetDocumentRequest) (*dto.GetDocumentResponse, error) {
+	if !uc.revisionsEnabled(req.Collection) {
+		return nil, fmt.Errorf("%w: %s", ErrRevisionsNotEnabled, req.Collection)
+	}
+	at := *req.AsOf
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	tracing.SetAttributes(ctx, attribute.String("as_of", at.Format(time.RFC3339Nano)))
+
+	current, err := docRepo.FindByID(ctx, req.Collection, req.ID)
+	switch {
+	case err == nil:
+		if !current.UpdatedAt().After(at) {
+			if current, err = uc.rehydrate(ctx, current, false); err != nil {
+				return nil, fmt.Errorf("failed to get document: %w", err)
+			}
+			if current.CreatedAt().After(at) {
+				return nil, fmt.Errorf("failed to get document: %w", entity.ErrDocumentNotFound)
+			}
+			if err := uc.checkRowAccess(ctx, req.Collection, current.Data()); err != nil {
+				return nil, err
+			}
+			if uc.hideDeleted(req.Collection, current, req.IncludeDeleted) {
+				return nil, fmt.Errorf("failed to get document: %w", entity.ErrDocumentNotFound)
+			}
+			return documentResponse(current), nil
+		}
+	case !errors.Is(err, entity.ErrDocumentNotFound):
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	// 현재 버전이 at 이후에 저장되었거나 문서가 삭제되었으면 그 시점의 리비전을 조회
+	revision, err := uc.revisionRepo.FindAsOf(ctx, revisionKey(ctx, req.Collection, req.ID), at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	// 보관 중이던 시점의 리비전은 스텁이므로 보관 객체의 데이터로 되살림
+	doc, err := uc.rehydrate(ctx, entity.ReconstructDocument(req.ID, req.Collection, revision.Data, revision.Version, time.Time{}, revision.ValidFrom), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	if err := uc.checkRowAccess(ctx, req.Collection, doc.Data()); err != nil {
+		return nil, err
+	}
+	if uc.hideDeleted(req.Collection, doc, req.IncludeDeleted) {
+		return nil, fmt.Errorf("failed to get document: %w", entity.ErrDocumentNotFound)
+	}
+
+	logger.Debug(ctx, "document read as of revision",
+		zap.String("collection", req.Collection),
+		zap.String("id", req.ID),
+		zap.Int("version", revision.Version),
+	)
+	return documentResponse(doc), nil
+}
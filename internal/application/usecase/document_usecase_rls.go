@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SetRowPolicies는 행 수준 보안 규칙을 설정합니다
+// 설정하면 모든 필터에 principal별 조건이 AND로 결합됩니다
+func (uc *DocumentUseCase) SetRowPolicies(policies *auth.RowPolicySet) {
+	uc.rowPolicies = policies
+}
+
+// rowConditions는 현재 principal에 적용되는 행 수준 보안 조건을 반환합니다
+// 테넌트 API 키처럼 네임스페이스가 제한된 principal은 네임스페이스 밖의 컬렉션에 접근할 수 없습니다
+func (uc *DocumentUseCase) rowConditions(ctx context.Context, collection string) (map[string]interface{}, error) {
+	principal, _ := auth.PrincipalFromContext(ctx)
+	if !principal.CanAccessCollection(collection) {
+		logger.Warn(ctx, "namespace denied request",
+			zap.String("collection", collection),
+			zap.String("namespace", principal.Namespace),
+		)
+		return nil, fmt.Errorf("%w: collection %q is outside namespace %q", auth.ErrForbidden, collection, principal.Namespace)
+	}
+	if uc.rowPolicies == nil {
+		return nil, nil
+	}
+
+	conditions, err := uc.rowPolicies.Conditions(principal, collection)
+	if err != nil {
+		logger.Warn(ctx, "row policy denied request",
+			zap.String("collection", collection),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return conditions, nil
+}
+
+// scopeFilter는 사용자 필터에 행 수준 보안 조건을 결합합니다
+func (uc *DocumentUseCase) scopeFilter(ctx context.Context, collection string, filter map[string]interface{}) (map[string]interface{}, error) {
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	return auth.ScopeFilter(filter, conditions), nil
+}
+
+// checkRowAccess는 ID로 조회한 문서 데이터가 행 수준 보안 조건을 만족하는지 확인합니다
+// 문서의 존재 여부가 노출되지 않도록 조건을 만족하지 않으면 ErrDocumentNotFound를 반환합니다
+func (uc *DocumentUseCase) checkRowAccess(ctx context.Context, collection string, data map[string]interface{}) error {
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil || len(conditions) == 0 {
+		return err
+	}
+
+	if !auth.MatchesConditions(map[string]interface{}{"data": data}, conditions) {
+		return entity.ErrDocumentNotFound
+	}
+	return nil
+}
+
+// checkRowAccessByID는 변경 전 문서를 조회해 행 수준 보안 조건을 확인합니다
+func (uc *DocumentUseCase) checkRowAccessByID(ctx context.Context, collection, id string) error {
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil || len(conditions) == 0 {
+		return err
+	}
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		return err
+	}
+	doc, err := uc.findDocument(ctx, docRepo, collection, id)
+	if err != nil {
+		return fmt.Errorf("failed to find document: %w", err)
+	}
+	return uc.checkRowAccess(ctx, collection, doc.Data())
+}
+
+// stampRow는 새로 쓰는 문서 데이터가 행 수준 보안 조건을 만족하도록 소유 필드를 채웁니다
+func (uc *DocumentUseCase) stampRow(ctx context.Context, collection string, data map[string]interface{}) error {
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil || len(conditions) == 0 {
+		return err
+	}
+	if data == nil {
+		return fmt.Errorf("%w: document data is required", auth.ErrForbidden)
+	}
+	return auth.StampConditions(map[string]interface{}{"data": data}, conditions)
+}
+
+// guardRowUpdate는 업데이트가 행 수준 보안 필드를 다른 값으로 바꾸지 못하도록 막습니다
+func (uc *DocumentUseCase) guardRowUpdate(ctx context.Context, collection string, update map[string]interface{}) error {
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil || len(conditions) == 0 {
+		return err
+	}
+	if auth.UpdateChangesConditions(update, conditions) {
+		return fmt.Errorf("%w: update would move documents outside the caller's row policy", auth.ErrForbidden)
+	}
+	return nil
+}
+
+// scopeWriteOperation은 대량 쓰기/트랜잭션의 개별 작업에 행 수준 보안과 개인정보 정책을 적용하고 범위가 제한된 필터를 반환합니다
+func (uc *DocumentUseCase) scopeWriteOperation(ctx context.Context, opType, collection string, filter, data, update map[string]interface{}) (map[string]interface{}, error) {
+	switch opType {
+	case "insert", "replace":
+		if err := uc.stampRow(ctx, collection, data); err != nil {
+			return nil, err
+		}
+		if err := uc.scanPII(ctx, collection, data); err != nil {
+			return nil, err
+		}
+	case "update":
+		if err := uc.guardRowUpdate(ctx, collection, update); err != nil {
+			return nil, err
+		}
+		if err := uc.scanPIIUpdate(ctx, collection, update); err != nil {
+			return nil, err
+		}
+	}
+	return uc.scopeFilter(ctx, collection, filter)
+}
+
+// crossCollectionStages는 다른 컬렉션을 읽거나 쓰는 집계 단계입니다
+var crossCollectionStages = []string{"$lookup", "$graphLookup", "$unionWith", "$out", "$merge"}
+
+// scopePipeline은 집계 파이프라인 맨 앞에 행 수준 보안 $match 단계를 추가합니다
+// 다른 컬렉션을 참조하는 단계는 그 컬렉션의 규칙을 우회할 수 있으므로 거부합니다
+func (uc *DocumentUseCase) scopePipeline(ctx context.Context, collection string, pipeline []map[string]interface{}) ([]map[string]interface{}, error) {
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil || len(conditions) == 0 {
+		return pipeline, err
+	}
+
+	for _, stage := range pipeline {
+		for _, name := range crossCollectionStages {
+			if _, ok := stage[name]; ok {
+				return nil, fmt.Errorf("%w: %s stage is not allowed on row-restricted collection %q", auth.ErrForbidden, name, collection)
+			}
+		}
+	}
+
+	scoped := make([]map[string]interface{}, 0, len(pipeline)+1)
+	scoped = append(scoped, map[string]interface{}{"$match": conditions})
+	return append(scoped, pipeline...), nil
+}
+
+// denyIfRowPolicy는 행 수준 보안 조건을 적용할 수 없는 작업(원시 쿼리, 추정 개수 등)을 거부합니다
+// collection이 비어 있으면 principal에게 적용되는 규칙이 하나라도 있을 때 거부합니다
+func (uc *DocumentUseCase) denyIfRowPolicy(ctx context.Context, collection, operation string) error {
+	if uc.rowPolicies == nil {
+		return nil
+	}
+
+	if collection == "" {
+		principal, _ := auth.PrincipalFromContext(ctx)
+		if uc.rowPolicies.Restricts(principal) {
+			return fmt.Errorf("%w: %s is not allowed while row policies apply", auth.ErrForbidden, operation)
+		}
+		return nil
+	}
+
+	conditions, err := uc.rowConditions(ctx, collection)
+	if err != nil {
+		return err
+	}
+	if len(conditions) > 0 {
+		return fmt.Errorf("%w: %s is not allowed on row-restricted collection %q", auth.ErrForbidden, operation, collection)
+	}
+	return nil
+}
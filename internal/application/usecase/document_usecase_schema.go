@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/pkg/jsonschema"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ErrSchemaViolation은 문서가 컬렉션 스키마를 만족하지 않을 때의 에러입니다
+var ErrSchemaViolation = errors.New("document does not match collection schema")
+
+// SchemaValidationError는 스키마 위반 목록을 담은 에러입니다 (errors.Is(err, ErrSchemaViolation)로 판별)
+// 단건 쓰기의 경로는 문서 기준이고, 대량 삽입의 경로는 documents 배열 기준입니다 (/3/email)
+type SchemaValidationError struct {
+	Collection string
+	Violations []jsonschema.Violation
+}
+
+func (e *SchemaValidationError) Error() string {
+	if len(e.Violations) == 0 {
+		return fmt.Sprintf("%s: %s", ErrSchemaViolation, e.Collection)
+	}
+	first := e.Violations[0]
+	msg := fmt.Sprintf("%s %s: %s %s", ErrSchemaViolation, e.Collection, first.Path, first.Message)
+	if len(e.Violations) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(e.Violations)-1)
+	}
+	return msg
+}
+
+func (e *SchemaValidationError) Unwrap() error {
+	return ErrSchemaViolation
+}
+
+// SetSchemas는 컬렉션별 JSON Schema를 설정합니다
+// 스키마가 있는 컬렉션은 생성/수정/교체/대량 삽입 시 저장될 문서 전체를 검증하고, 위반하면 저장하지 않습니다
+func (uc *DocumentUseCase) SetSchemas(schemas map[string]*jsonschema.Schema) {
+	uc.schemas = schemas
+}
+
+// validateSchema는 저장될 문서 데이터를 컬렉션 스키마로 검증합니다 (스키마가 없으면 통과)
+func (uc *DocumentUseCase) validateSchema(ctx context.Context, collection, operation string, data map[string]interface{}) error {
+	violations, err := uc.schemaViolations(collection, data, "")
+	if err != nil {
+		return err
+	}
+	return uc.rejectSchemaViolations(ctx, collection, operation, violations)
+}
+
+// schemaViolations는 데이터의 스키마 위반을 반환하며, 경로 앞에 prefix를 붙입니다
+func (uc *DocumentUseCase) schemaViolations(collection string, data map[string]interface{}, prefix string) ([]jsonschema.Violation, error) {
+	schema, ok := uc.schemas[collection]
+	if !ok {
+		return nil, nil
+	}
+	violations, err := schema.Validate(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate document: %w", err)
+	}
+	for i := range violations {
+		violations[i].Path = prefix + violations[i].Path
+	}
+	return violations, nil
+}
+
+// rejectSchemaViolations는 위반이 있으면 메트릭과 로그를 남기고 SchemaValidationError를 반환합니다
+func (uc *DocumentUseCase) rejectSchemaViolations(ctx context.Context, collection, operation string, violations []jsonschema.Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	uc.metrics.RecordSchemaReject(collection, operation)
+	tracing.SetAttributes(ctx, attribute.Int("schema.violations", len(violations)))
+
+	paths := make([]string, 0, len(violations))
+	for _, v := range violations {
+		paths = append(paths, v.Path)
+	}
+	logger.Info(ctx, "write rejected by collection schema",
+		zap.String("collection", collection),
+		zap.String("operation", operation),
+		zap.Strings("paths", paths),
+	)
+
+	err := &SchemaValidationError{Collection: collection, Violations: violations}
+	tracing.RecordError(ctx, err)
+	return err
+}
@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ErrSoftDeleteNotEnabled는 소프트 삭제를 쓰지 않는 컬렉션의 문서를 복원하려고 할 때의 에러입니다
+var ErrSoftDeleteNotEnabled = errors.New("soft delete is not enabled for collection")
+
+// ErrDocumentNotDeleted는 삭제되지 않은 문서를 복원하려고 할 때의 에러입니다
+var ErrDocumentNotDeleted = errors.New("document is not deleted")
+
+// SoftDeletePolicy는 컬렉션의 소프트 삭제 정책입니다
+type SoftDeletePolicy struct {
+	Collection string
+	Retention  time.Duration // 삭제 후 이 기간이 지나면 영구 삭제 (0이면 영구 삭제하지 않음)
+}
+
+// SetSoftDeletePolicies는 컬렉션별 소프트 삭제 정책을 설정합니다
+// 정책이 있는 컬렉션의 삭제는 문서를 지우는 대신 데이터에 _deleted_at을 기록하고,
+// 삭제된 문서는 include_deleted를 지정하지 않으면 조회/목록/export 결과에서 제외됩니다
+func (uc *DocumentUseCase) SetSoftDeletePolicies(policies []SoftDeletePolicy) {
+	uc.softDeletePolicies = make(map[string]SoftDeletePolicy, len(policies))
+	for _, p := range policies {
+		uc.softDeletePolicies[p.Collection] = p
+	}
+}
+
+// softDeleteEnabled는 컬렉션이 소프트 삭제를 쓰는지 반환합니다
+func (uc *DocumentUseCase) softDeleteEnabled(collection string) bool {
+	_, ok := uc.softDeletePolicies[collection]
+	return ok
+}
+
+// hideDeleted는 소프트 삭제된 문서를 결과에서 제외해야 하는지 반환합니다
+func (uc *DocumentUseCase) hideDeleted(collection string, doc *entity.Document, includeDeleted bool) bool {
+	return !includeDeleted && uc.softDeleteEnabled(collection) && doc.IsDeleted()
+}
+
+// softDeleteDocument는 문서 데이터에 삭제 시각을 기록합니다 (DeleteDocument에서 호출)
+// 이미 삭제된 문서는 없는 문서로 취급합니다
+func (uc *DocumentUseCase) softDeleteDocument(ctx context.Context, docRepo repository.DocumentRepository, req *dto.DeleteDocumentRequest) error {
+	doc, err := docRepo.FindByID(ctx, req.Collection, req.ID)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return fmt.Errorf("failed to find document: %w", err)
+	}
+	if doc.IsDeleted() {
+		return fmt.Errorf("failed to delete document: %w", entity.ErrDocumentNotFound)
+	}
+
+	before, beforeVersion := auditDocumentState(doc)
+	prior := entity.ReconstructDocument(doc.ID(), doc.Collection(), before, beforeVersion, doc.CreatedAt(), doc.UpdatedAt())
+
+	data := make(map[string]interface{}, len(before)+1)
+	for k, v := range before {
+		data[k] = v
+	}
+	data[entity.DeletedAtField] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	err = uc.saveDeletedState(ctx, docRepo, doc, data, "delete")
+
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpDelete,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Before:        before,
+		BeforeVersion: beforeVersion,
+		After:         doc.Data(),
+		AfterVersion:  doc.Version(),
+	}, err)
+
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to soft delete document", zap.Error(err))
+		return fmt.Errorf("failed to delete document: %w", err)
+	}
+
+	uc.recordRevision(ctx, prior, doc.UpdatedAt(), entity.AuditOpDelete)
+	uc.cacheEvict(ctx, req.Collection, req.ID)
+
+	logger.Info(ctx, "document soft deleted",
+		zap.String("id", req.ID),
+		zap.String("collection", req.Collection),
+	)
+	return nil
+}
+
+// RestoreDocument는 소프트 삭제된 문서의 삭제 표시를 제거합니다
+func (uc *DocumentUseCase) RestoreDocument(ctx context.Context, req *dto.RestoreDocumentRequest) (*dto.GetDocumentResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.RestoreDocument")
+	defer span.End()
+
+	ctx, cancel := uc.withTimeout(ctx, OperationWrite)
+	defer cancel()
+
+	if !uc.softDeleteEnabled(req.Collection) {
+		return nil, fmt.Errorf("%w: %s", ErrSoftDeleteNotEnabled, req.Collection)
+	}
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	dbType := middleware.GetDatabaseType(ctx)
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("id", req.ID),
+		attribute.String("database_type", string(dbType)),
+	)
+
+	doc, err := docRepo.FindByID(ctx, req.Collection, req.ID)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to find document: %w", err)
+	}
+	if err := uc.checkRowAccess(ctx, req.Collection, doc.Data()); err != nil {
+		return nil, err
+	}
+	if !doc.IsDeleted() {
+		return nil, ErrDocumentNotDeleted
+	}
+
+	before, beforeVersion := auditDocumentState(doc)
+	prior := entity.ReconstructDocument(doc.ID(), doc.Collection(), before, beforeVersion, doc.CreatedAt(), doc.UpdatedAt())
+
+	data := make(map[string]interface{}, len(before))
+	for k, v := range before {
+		if k != entity.DeletedAtField {
+			data[k] = v
+		}
+	}
+
+	err = uc.saveDeletedState(ctx, docRepo, doc, data, "restore")
+
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:     entity.AuditOpRestore,
+		Collection:    req.Collection,
+		DocumentID:    req.ID,
+		Before:        before,
+		BeforeVersion: beforeVersion,
+		After:         doc.Data(),
+		AfterVersion:  doc.Version(),
+	}, err)
+
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to restore document", zap.Error(err))
+		return nil, fmt.Errorf("failed to restore document: %w", err)
+	}
+
+	uc.recordRevision(ctx, prior, doc.UpdatedAt(), entity.AuditOpRestore)
+	uc.cacheWritten(ctx, req.Collection, req.ID, doc)
+
+	logger.Info(ctx, "document restored",
+		zap.String("id", req.ID),
+		zap.String("collection", req.Collection),
+	)
+	return documentResponse(doc), nil
+}
+
+// saveDeletedState는 삭제 표시를 바꾼 데이터로 문서를 저장합니다 (버전 확인 포함)
+func (uc *DocumentUseCase) saveDeletedState(ctx context.Context, docRepo repository.DocumentRepository, doc *entity.Document, data map[string]interface{}, operation string) error {
+	if err := doc.Update(data); err != nil {
+		return err
+	}
+	_, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, operation), func(ctx context.Context) error {
+			return docRepo.Update(ctx, doc)
+		})
+	})
+	return err
+}
+
+// RunSoftDeletePurge는 interval마다 보존 기간이 지난 소프트 삭제 문서를 영구 삭제합니다
+// 삭제 표시는 데이터 필드라 백엔드마다 조건 검색이 다르므로, 컬렉션을 순회하며 대상을 찾습니다
+// ctx가 취소될 때까지 실행되므로 별도 goroutine에서 호출해야 합니다
+func (uc *DocumentUseCase) RunSoftDeletePurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.PurgeDeleted(ctx)
+		}
+	}
+}
+
+// PurgeDeleted는 모든 저장소에서 보존 기간이 지난 소프트 삭제 문서를 영구 삭제하고 삭제한 문서 수를 반환합니다
+func (uc *DocumentUseCase) PurgeDeleted(ctx context.Context) int64 {
+	repos := map[string]repository.DocumentRepository{"default": uc.docRepo}
+	if uc.repoManager != nil {
+		repos = uc.repoManager.Repositories()
+	}
+
+	var total int64
+	for dbType, repo := range repos {
+		for collection, policy := range uc.softDeletePolicies {
+			if policy.Retention <= 0 {
+				continue
+			}
+			purged, err := uc.purgeCollection(ctx, repo, collection, time.Now().Add(-policy.Retention))
+			total += purged
+			if err != nil {
+				logger.Error(ctx, "failed to purge deleted documents",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.Error(err),
+				)
+			}
+			if purged > 0 {
+				uc.metrics.RecordDocumentsPurged(dbType, collection, purged)
+				logger.Info(ctx, "deleted documents purged",
+					zap.String("database_type", dbType),
+					zap.String("collection", collection),
+					zap.Int64("count", purged),
+				)
+			}
+		}
+	}
+	return total
+}
+
+// purgeCollection은 before 이전에 삭제된 문서를 영구 삭제합니다
+func (uc *DocumentUseCase) purgeCollection(ctx context.Context, docRepo repository.DocumentRepository, collection string, before time.Time) (int64, error) {
+	ids, err := uc.findDeletedBefore(ctx, docRepo, collection, before)
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for _, id := range ids {
+		if err := docRepo.Delete(ctx, collection, id); err != nil {
+			if errors.Is(err, entity.ErrDocumentNotFound) {
+				continue
+			}
+			return purged, err
+		}
+		uc.cacheEvict(ctx, collection, id)
+		purged++
+	}
+	return purged, nil
+}
+
+// findDeletedBefore는 before 이전에 삭제된 문서의 ID를 모읍니다
+// 순회 중에 삭제하면 커서가 흔들리는 백엔드가 있으므로 대상 ID를 먼저 모은 뒤 삭제합니다
+func (uc *DocumentUseCase) findDeletedBefore(ctx context.Context, docRepo repository.DocumentRepository, collection string, before time.Time) ([]string, error) {
+	it, err := uc.openStream(ctx, docRepo, collection, nil, &repository.FindOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	var ids []string
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return nil, err
+		}
+		if deletedAt, ok := doc.DeletedAt(); ok && deletedAt.Before(before) {
+			ids = append(ids, doc.ID())
+		}
+	}
+	return ids, it.Err()
+}
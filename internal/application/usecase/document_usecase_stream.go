@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// ExportDocuments는 필터와 일치하는 문서를 커서로 읽어 한 건씩 emit에 전달하고, 전달한 문서 수를 반환합니다
+// 결과를 메모리에 모두 올리지 않으므로 컬렉션 크기와 관계없이 메모리 사용량이 일정합니다
+// emit이 에러를 반환하면(클라이언트 연결 종료 등) 순회를 중단하고 그 에러를 반환합니다
+func (uc *DocumentUseCase) ExportDocuments(ctx context.Context, req *dto.ExportDocumentsRequest, emit func(*dto.GetDocumentResponse) error) (int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.ExportDocuments")
+	defer span.End()
+
+	// 읽기는 컬렉션 라우팅 설정에 따라 복제본으로 보냅니다
+	docRepo, err := uc.getReadRepository(ctx, req.Collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return 0, err
+	}
+
+	dbType := middleware.GetDatabaseType(ctx)
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.Int64("limit", req.Limit),
+		attribute.String("database_type", string(dbType)),
+	)
+
+	filter, err := uc.scopeFilter(ctx, req.Collection, req.Filter)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return 0, err
+	}
+
+	opts := &repository.FindOptions{
+		Sort:  req.Sort,
+		Limit: req.Limit,
+	}
+	if len(req.Fields) > 0 {
+		opts.Projection = make(map[string]interface{}, len(req.Fields))
+		for _, field := range req.Fields {
+			opts.Projection[field] = 1
+		}
+	}
+
+	it, err := uc.openStream(ctx, docRepo, req.Collection, filter, opts)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "failed to export documents", zap.Error(err))
+		return 0, fmt.Errorf("failed to export documents: %w", err)
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	var exported int64
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return exported, fmt.Errorf("failed to export documents: %w", err)
+		}
+		if uc.hideDeleted(req.Collection, doc, req.IncludeDeleted) {
+			continue
+		}
+		if doc, err = uc.rehydrate(ctx, doc, false); err != nil {
+			tracing.RecordError(ctx, err)
+			return exported, fmt.Errorf("failed to export documents: %w", err)
+		}
+		if err := emit(documentResponse(doc)); err != nil {
+			return exported, err
+		}
+		exported++
+	}
+	if err := it.Err(); err != nil {
+		tracing.RecordError(ctx, err)
+		logger.Error(ctx, "document export interrupted",
+			zap.String("collection", req.Collection),
+			zap.Int64("exported", exported),
+			zap.Error(err),
+		)
+		return exported, fmt.Errorf("failed to export documents: %w", err)
+	}
+
+	logger.Info(ctx, "documents exported successfully",
+		zap.String("collection", req.Collection),
+		zap.Int64("count", exported),
+		zap.String("database_type", string(dbType)),
+	)
+
+	return exported, nil
+}
+
+// openStream은 circuit breaker를 거쳐 스트리밍 커서를 엽니다
+// 커서를 여는 요청만 circuit breaker에 기록되며, 순회 중 에러는 호출자가 처리합니다
+func (uc *DocumentUseCase) openStream(ctx context.Context, docRepo repository.DocumentRepository, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	result, err := uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return docRepo.FindStream(ctx, collection, filter, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(repository.DocumentIterator), nil
+}
+
+// documentResponse는 문서를 조회 응답 DTO로 변환합니다
+func documentResponse(doc *entity.Document) *dto.GetDocumentResponse {
+	return &dto.GetDocumentResponse{
+		ID:        doc.ID(),
+		Data:      doc.Data(),
+		Version:   doc.Version(),
+		CreatedAt: doc.CreatedAt(),
+		UpdatedAt: doc.UpdatedAt(),
+		ExpiresAt: expiresAtResponse(doc),
+		DeletedAt: deletedAtResponse(doc),
+	}
+}
+
+// deletedAtResponse는 소프트 삭제 시각을 응답 값으로 변환합니다 (삭제되지 않았으면 nil)
+func deletedAtResponse(doc *entity.Document) *time.Time {
+	deletedAt, ok := doc.DeletedAt()
+	if !ok {
+		return nil
+	}
+	return &deletedAt
+}
+
+// expiresAtResponse는 문서 만료 시각을 응답 값으로 변환합니다 (만료가 없으면 nil)
+func expiresAtResponse(doc *entity.Document) *time.Time {
+	expiresAt := doc.ExpiresAt()
+	if expiresAt.IsZero() {
+		return nil
+	}
+	return &expiresAt
+}
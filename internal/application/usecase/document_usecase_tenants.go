@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// EnsureCollection은 컬렉션이 없으면 만들고 이미 있었는지 반환합니다 (테넌트 프로비저닝)
+func (uc *DocumentUseCase) EnsureCollection(ctx context.Context, collection string) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.EnsureCollection")
+	defer span.End()
+
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return false, err
+	}
+	tracing.SetAttributes(ctx, attribute.String("collection", collection))
+
+	exists, err := docRepo.CollectionExists(ctx, collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return false, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if exists {
+		return true, nil
+	}
+
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return nil, retry.Do(ctx, uc.retryPolicy(ctx, "create_collection"), func(ctx context.Context) error {
+			return docRepo.CreateCollection(ctx, collection)
+		})
+	})
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpCreateCollection,
+		Collection: collection,
+	}, err)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return false, fmt.Errorf("failed to create collection: %w", err)
+	}
+	return false, nil
+}
+
+// EnsureIndex는 이름이 같은 인덱스가 없으면 만들고 이미 있었는지 반환합니다 (model.Options.Name 필수)
+func (uc *DocumentUseCase) EnsureIndex(ctx context.Context, collection string, model repository.IndexModel) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.EnsureIndex")
+	defer span.End()
+
+	if model.Options == nil || model.Options.Name == "" {
+		return false, fmt.Errorf("%w: index name is required", entity.ErrInvalidData)
+	}
+
+	ctx, cancel := uc.withTimeout(ctx, OperationAdmin)
+	defer cancel()
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return false, err
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", collection),
+		attribute.String("index", model.Options.Name),
+	)
+
+	// PostgreSQL 등은 같은 이름의 인덱스를 다시 만들면 실패하므로 이름으로 먼저 확인합니다
+	indexes, err := docRepo.ListIndexes(ctx, collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return false, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	for _, spec := range indexes {
+		if name, _ := spec["name"].(string); name == model.Options.Name {
+			return true, nil
+		}
+	}
+
+	_, err = uc.breaker(ctx).Execute(ctx, func() (interface{}, error) {
+		return retry.DoWithValue(ctx, uc.retryPolicy(ctx, "create_index"), func(ctx context.Context) (string, error) {
+			return docRepo.CreateIndex(ctx, collection, model)
+		})
+	})
+	uc.recordAudit(ctx, &entity.AuditEntry{
+		Operation:  entity.AuditOpCreateIndex,
+		Collection: collection,
+		After:      model.Keys,
+	}, err)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return false, fmt.Errorf("failed to create index: %w", err)
+	}
+	return false, nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ErrOperationTimeout은 작업 종류별 시간 한도를 넘어 작업이 중단되었을 때 context.Cause로 확인할 수 있는 원인입니다
+var ErrOperationTimeout = errors.New("operation timeout exceeded")
+
+// OperationKind는 시간 한도를 적용하는 작업 종류입니다
+type OperationKind string
+
+const (
+	OperationRead  OperationKind = "read"  // 조회, 검색, 집계
+	OperationWrite OperationKind = "write" // 생성, 수정, 삭제, 벌크 쓰기, 트랜잭션
+	OperationAdmin OperationKind = "admin" // 인덱스, 컬렉션 관리, raw 쿼리, 통계
+)
+
+// OperationTimeouts는 작업 종류별 시간 한도입니다 (0이면 한도 없음)
+type OperationTimeouts struct {
+	Read  time.Duration
+	Write time.Duration
+	Admin time.Duration
+}
+
+// SetOperationTimeouts는 작업 종류별 시간 한도를 설정합니다
+// 한도는 요청 context의 deadline으로 드라이버까지 전달되며, 요청에 이미 더 짧은 deadline이 있으면 그 deadline을 따릅니다
+func (uc *DocumentUseCase) SetOperationTimeouts(timeouts OperationTimeouts) {
+	uc.operationTimeouts = timeouts
+}
+
+// withTimeout은 작업 종류의 시간 한도를 적용한 context를 반환합니다
+// 반환된 cancel은 반드시 호출해야 하며, 이 한도로 작업이 중단되었으면 경고 로그와 operation_timeouts_total 메트릭을 기록합니다
+func (uc *DocumentUseCase) withTimeout(ctx context.Context, kind OperationKind) (context.Context, context.CancelFunc) {
+	var timeout time.Duration
+	switch kind {
+	case OperationRead:
+		timeout = uc.operationTimeouts.Read
+	case OperationWrite:
+		timeout = uc.operationTimeouts.Write
+	case OperationAdmin:
+		timeout = uc.operationTimeouts.Admin
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	timeoutCtx, cancel := context.WithTimeoutCause(ctx, timeout, ErrOperationTimeout)
+	return timeoutCtx, func() {
+		if errors.Is(context.Cause(timeoutCtx), ErrOperationTimeout) {
+			dbType := string(middleware.GetDatabaseType(ctx))
+			logger.Warn(ctx, "operation timeout exceeded",
+				zap.String("database_type", dbType),
+				zap.String("kind", string(kind)),
+				zap.Duration("timeout", timeout),
+			)
+			if uc.metrics != nil {
+				uc.metrics.RecordOperationTimeout(dbType, string(kind))
+			}
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.uber.org/zap"
+)
+
+// UniqueConstraint는 컬렉션의 고유 제약입니다 (Fields 값의 조합이 컬렉션 안에서 고유)
+type UniqueConstraint struct {
+	Collection string
+	Fields     []string // 점으로 구분된 data 필드 경로
+}
+
+// DuplicateKeyError는 고유 키가 같은 문서가 이미 있어 쓰기가 거부되었을 때의 에러입니다 (errors.Is(err, entity.ErrDuplicateKey)로 판별)
+// 저장소의 중복 키 에러(고유 인덱스, ID 중복)와 쓰기 전 확인에서 발견한 중복 모두 이 타입으로 반환합니다
+type DuplicateKeyError struct {
+	Collection string
+	Fields     []string // 위반한 고유 제약의 필드 (ID 중복처럼 제약을 알 수 없으면 비어 있음)
+}
+
+func (e *DuplicateKeyError) Error() string {
+	if len(e.Fields) == 0 {
+		return fmt.Sprintf("%s: %s", entity.ErrDuplicateKey.Message, e.Collection)
+	}
+	return fmt.Sprintf("%s: %s (%s)", entity.ErrDuplicateKey.Message, e.Collection, strings.Join(e.Fields, ", "))
+}
+
+func (e *DuplicateKeyError) Unwrap() error {
+	return entity.ErrDuplicateKey
+}
+
+// SetUniqueConstraints는 컬렉션별 고유 제약과 데이터베이스 종류별 중복 키 에러 판별 함수를 설정합니다
+// 제약은 PrepareUniqueConstraints를 호출해야 적용되며, 판별 함수가 등록된 데이터베이스의 중복 키 에러는 DuplicateKeyError로 바뀝니다
+func (uc *DocumentUseCase) SetUniqueConstraints(constraints []UniqueConstraint, classifiers map[string]func(err error) bool) {
+	uc.uniqueConstraints = make(map[string][]UniqueConstraint)
+	for _, c := range constraints {
+		uc.uniqueConstraints[c.Collection] = append(uc.uniqueConstraints[c.Collection], c)
+	}
+	uc.dupKeyClassifiers = classifiers
+}
+
+// PrepareUniqueConstraints는 모든 저장소에 고유 제약을 준비합니다 (서버 시작 시 한 번 호출)
+// 고유 인덱스로 스스로 중복을 거부하는 저장소(MongoDB, PostgreSQL, MySQL)는 그대로 두고,
+// 그렇지 않거나 인덱스를 만들지 못한 저장소(기존 데이터에 중복이 있는 경우 등)는 쓰기 전에 같은 값을 가진 문서를 조회해 확인합니다
+func (uc *DocumentUseCase) PrepareUniqueConstraints(ctx context.Context) {
+	repos := map[string]repository.DocumentRepository{"default": uc.docRepo}
+	if uc.repoManager != nil {
+		repos = uc.repoManager.Repositories()
+	}
+
+	uc.uniqueChecks = make(map[string]bool)
+	for dbType, repo := range repos {
+		enforcer, ok := repo.(repository.UniqueKeyEnforcer)
+		if !ok {
+			logger.Warn(ctx, "repository does not support unique keys", zap.String("database_type", dbType))
+			continue
+		}
+		for collection, constraints := range uc.uniqueConstraints {
+			for _, c := range constraints {
+				native, err := enforcer.EnsureUniqueKey(ctx, collection, c.Fields)
+				if err != nil {
+					logger.Error(ctx, "failed to prepare unique key, falling back to write-time checks",
+						zap.String("database_type", dbType),
+						zap.String("collection", collection),
+						zap.Strings("fields", c.Fields),
+						zap.Error(err),
+					)
+				}
+				uc.uniqueChecks[uniqueCheckKey(dbType, collection, c.Fields)] = !native || err != nil
+			}
+		}
+	}
+}
+
+// uniqueCheckKey는 쓰기 전 확인 여부를 기록하는 키입니다
+func uniqueCheckKey(dbType, collection string, fields []string) string {
+	return dbType + "/" + collection + "/" + repository.UniqueKeyName(fields)
+}
+
+// repositoryName은 요청이 사용하는 저장소 이름입니다 (단일 저장소 모드는 "default")
+func (uc *DocumentUseCase) repositoryName(ctx context.Context) string {
+	if uc.repoManager == nil {
+		return "default"
+	}
+	return string(middleware.GetDatabaseType(ctx))
+}
+
+// checkUnique는 고유 인덱스가 없는 저장소에서 data와 고유 키가 같은 다른 문서가 있는지 확인합니다 (id는 쓰려는 문서, 생성이면 "")
+// 확인과 쓰기 사이에 다른 요청이 같은 값을 쓰는 경쟁은 막지 못하므로, 엄격한 고유성이 필요하면 고유 인덱스를 지원하는 저장소를 사용해야 합니다
+func (uc *DocumentUseCase) checkUnique(ctx context.Context, docRepo repository.DocumentRepository, collection, id string, data map[string]interface{}) error {
+	constraints := uc.uniqueConstraints[collection]
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	dbType := uc.repositoryName(ctx)
+	for _, c := range constraints {
+		if !uc.uniqueChecks[uniqueCheckKey(dbType, collection, c.Fields)] {
+			continue
+		}
+		key, ok := uniqueKeyValues(data, c.Fields)
+		if !ok {
+			continue
+		}
+		enforcer, ok := docRepo.(repository.UniqueKeyEnforcer)
+		if !ok {
+			continue
+		}
+
+		ids, err := enforcer.FindByUniqueKey(ctx, collection, key, 2)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return fmt.Errorf("failed to check unique key: %w", err)
+		}
+		for _, existing := range ids {
+			if existing != id {
+				return uc.rejectDuplicate(ctx, collection, c.Fields)
+			}
+		}
+	}
+	return nil
+}
+
+// checkUniqueBatch는 대량 삽입할 문서들 사이의 고유 키 중복과 기존 문서와의 중복을 확인합니다
+// 배치 안의 중복은 저장소가 일부 문서를 저장한 뒤 실패하지 않도록 고유 인덱스가 있는 저장소에서도 미리 확인합니다
+func (uc *DocumentUseCase) checkUniqueBatch(ctx context.Context, docRepo repository.DocumentRepository, collection string, documents []map[string]interface{}) error {
+	constraints := uc.uniqueConstraints[collection]
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	for _, c := range constraints {
+		seen := make(map[string]int, len(documents))
+		for i, data := range documents {
+			key, ok := uniqueKeyValues(data, c.Fields)
+			if !ok {
+				continue
+			}
+			values := make([]interface{}, len(c.Fields))
+			for j, field := range c.Fields {
+				values[j] = key[field]
+			}
+			encoded, err := json.Marshal(values)
+			if err != nil {
+				return fmt.Errorf("document at index %d: %w", i, err)
+			}
+			if first, dup := seen[string(encoded)]; dup {
+				return fmt.Errorf("document at index %d duplicates index %d: %w", i, first, uc.rejectDuplicate(ctx, collection, c.Fields))
+			}
+			seen[string(encoded)] = i
+		}
+	}
+
+	for i, data := range documents {
+		if err := uc.checkUnique(ctx, docRepo, collection, "", data); err != nil {
+			return fmt.Errorf("document at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// duplicateKeyError는 저장소의 중복 키 에러를 DuplicateKeyError로 바꿉니다 (중복 키 에러가 아니면 그대로 반환)
+// 위반한 제약은 에러 메시지에 포함된 고유 인덱스 이름으로 찾습니다
+func (uc *DocumentUseCase) duplicateKeyError(ctx context.Context, collection string, err error) error {
+	classify, ok := uc.dupKeyClassifiers[uc.repositoryName(ctx)]
+	if !ok || !classify(err) {
+		return err
+	}
+
+	var fields []string
+	matched := ""
+	for _, c := range uc.uniqueConstraints[collection] {
+		name := repository.UniqueKeyName(c.Fields)
+		if len(name) > len(matched) && strings.Contains(err.Error(), name) {
+			matched, fields = name, c.Fields
+		}
+	}
+	logger.Debug(ctx, "duplicate key reported by repository", zap.Error(err))
+	return uc.rejectDuplicate(ctx, collection, fields)
+}
+
+// rejectDuplicate는 중복 키 거부를 로그로 남기고 DuplicateKeyError를 반환합니다
+func (uc *DocumentUseCase) rejectDuplicate(ctx context.Context, collection string, fields []string) error {
+	logger.Info(ctx, "write rejected by unique key",
+		zap.String("collection", collection),
+		zap.Strings("fields", fields),
+	)
+	err := &DuplicateKeyError{Collection: collection, Fields: fields}
+	tracing.RecordError(ctx, err)
+	return err
+}
+
+// uniqueKeyValues는 data에서 고유 키 필드 값을 꺼냅니다 (필드 중 하나라도 없거나 null이면 false)
+func uniqueKeyValues(data map[string]interface{}, fields []string) (map[string]interface{}, bool) {
+	key := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		var current interface{} = data
+		for _, segment := range strings.Split(field, ".") {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			current = m[segment]
+		}
+		if current == nil {
+			return nil, false
+		}
+		key[field] = current
+	}
+	return key, true
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// CacheWarmupTarget은 시작 시 캐시에 미리 적재할 컬렉션과 대상입니다
+type CacheWarmupTarget struct {
+	Collection string
+
+	// IDs가 있으면 해당 문서만 적재하고, 없으면 최근 수정된 문서 Limit개를 적재합니다
+	IDs   []string
+	Limit int
+}
+
+// WarmCache는 대상 문서를 DB에서 읽어 캐시에 미리 적재합니다
+// 캐시 정책이 none인 컬렉션은 건너뛰며, 적재한 문서 수를 반환합니다
+func (uc *DocumentUseCase) WarmCache(ctx context.Context, target CacheWarmupTarget) (int, error) {
+	ctx, span := tracing.StartSpan(ctx, "DocumentUseCase.WarmCache")
+	defer span.End()
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", target.Collection),
+		attribute.Int("ids", len(target.IDs)),
+		attribute.Int("limit", target.Limit),
+	)
+
+	if uc.cachePolicy(ctx, target.Collection).Strategy == CacheNone {
+		return 0, nil
+	}
+
+	docRepo, err := uc.getRepository(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return 0, err
+	}
+
+	var docs []*entity.Document
+	if len(target.IDs) > 0 {
+		for _, id := range target.IDs {
+			doc, err := docRepo.FindByID(ctx, target.Collection, id)
+			if err != nil {
+				logger.Debug(ctx, "skipping cache warmup for document",
+					zap.String("collection", target.Collection),
+					zap.String("id", id),
+					zap.Error(err),
+				)
+				continue
+			}
+			docs = append(docs, doc)
+		}
+	} else {
+		if target.Limit <= 0 {
+			return 0, nil
+		}
+		docs, err = docRepo.FindWithOptions(ctx, target.Collection, map[string]interface{}{}, &repository.FindOptions{
+			Sort:  map[string]int{"updated_at": -1},
+			Limit: int64(target.Limit),
+		})
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return 0, fmt.Errorf("failed to find documents to warm: %w", err)
+		}
+	}
+
+	for _, doc := range docs {
+		uc.cacheFill(ctx, target.Collection, doc)
+	}
+	return len(docs), nil
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	maxFinishedDuplicateScans = 20
+	defaultDuplicateMaxGroups = 1000
+)
+
+// DuplicateUseCase는 중복 문서 탐지 작업과 정리 유즈케이스입니다
+// 탐지 작업은 백그라운드에서 실행되며 결과는 이 인스턴스의 메모리에 보관됩니다 (재시작 시 초기화)
+type DuplicateUseCase struct {
+	documentUC *DocumentUseCase
+	maxGroups  int
+
+	mu   sync.Mutex
+	jobs map[string]*duplicateScanJob
+}
+
+// duplicateScanJob은 실행 중이거나 끝난 중복 문서 탐지 작업입니다
+type duplicateScanJob struct {
+	mu   sync.Mutex
+	resp dto.DuplicateScanJobResponse
+}
+
+// NewDuplicateUseCase는 새로운 DuplicateUseCase를 생성합니다 (maxGroups는 작업 결과에 담는 기본 최대 그룹 수, 0이면 1000)
+func NewDuplicateUseCase(documentUC *DocumentUseCase, maxGroups int) *DuplicateUseCase {
+	if maxGroups <= 0 {
+		maxGroups = defaultDuplicateMaxGroups
+	}
+	return &DuplicateUseCase{
+		documentUC: documentUC,
+		maxGroups:  maxGroups,
+		jobs:       make(map[string]*duplicateScanJob),
+	}
+}
+
+// StartScan은 중복 문서 탐지 작업을 시작하고 작업 상태를 바로 반환합니다
+// 데이터베이스는 요청 context의 데이터베이스 종류(X-Database-Type)를 따릅니다
+func (uc *DuplicateUseCase) StartScan(ctx context.Context, req *dto.StartDuplicateScanRequest) (*dto.DuplicateScanJobResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "DuplicateUseCase.StartScan")
+	defer span.End()
+
+	if req.MaxGroups <= 0 || req.MaxGroups > uc.maxGroups {
+		req.MaxGroups = uc.maxGroups
+	}
+
+	job, err := uc.register(req, string(middleware.GetDatabaseType(ctx)))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "starting duplicate scan",
+		zap.String("job_id", job.snapshot().JobID),
+		zap.String("collection", req.Collection),
+		zap.Strings("fields", req.Fields),
+	)
+
+	// 요청이 끝나도 작업은 계속 실행 (context 값은 유지)
+	go uc.run(context.WithoutCancel(ctx), job, req)
+
+	return job.snapshot(), nil
+}
+
+// GetScan은 작업 상태와 결과를 반환합니다
+func (uc *DuplicateUseCase) GetScan(ctx context.Context, jobID string) (*dto.DuplicateScanJobResponse, error) {
+	uc.mu.Lock()
+	job, ok := uc.jobs[jobID]
+	uc.mu.Unlock()
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return job.snapshot(), nil
+}
+
+// ListScans는 작업 목록을 최근 시작한 순서로 반환합니다 (그룹 목록은 제외)
+func (uc *DuplicateUseCase) ListScans(ctx context.Context) *dto.ListDuplicateScansResponse {
+	uc.mu.Lock()
+	jobs := make([]*dto.DuplicateScanJobResponse, 0, len(uc.jobs))
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		snap.Groups = nil
+		jobs = append(jobs, snap)
+	}
+	uc.mu.Unlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return &dto.ListDuplicateScansResponse{Jobs: jobs}
+}
+
+// Resolve는 중복 문서를 병합하거나 삭제합니다
+func (uc *DuplicateUseCase) Resolve(ctx context.Context, req *dto.ResolveDuplicatesRequest) (*dto.ResolveDuplicatesResponse, error) {
+	return uc.documentUC.ResolveDuplicates(ctx, req)
+}
+
+// run은 컬렉션을 순회해 중복 그룹을 찾고 결과를 기록합니다
+func (uc *DuplicateUseCase) run(ctx context.Context, job *duplicateScanJob, req *dto.StartDuplicateScanRequest) {
+	groups, scanned, err := uc.documentUC.ScanDuplicates(ctx, req, func(scanned int64) {
+		job.update(func(r *dto.DuplicateScanJobResponse) { r.Scanned = scanned })
+	})
+
+	now := time.Now().UTC()
+	job.update(func(r *dto.DuplicateScanJobResponse) {
+		r.Scanned = scanned
+		r.FinishedAt = &now
+		if err != nil {
+			r.Status = MaintenanceJobFailed
+			r.Error = err.Error()
+			return
+		}
+
+		r.Status = MaintenanceJobCompleted
+		r.GroupCount = len(groups)
+		for i, group := range groups {
+			r.DuplicateCount += int64(len(group.IDs) - 1)
+			if i < req.MaxGroups {
+				r.Groups = append(r.Groups, dto.DuplicateGroupResult{
+					Key:         group.Key.Hash,
+					Values:      group.Key.Values,
+					KeepID:      group.KeepID,
+					DocumentIDs: group.IDs,
+				})
+			}
+		}
+	})
+
+	snap := job.snapshot()
+	fields := []zap.Field{
+		zap.String("job_id", snap.JobID),
+		zap.String("collection", snap.Collection),
+		zap.String("database_type", snap.DatabaseType),
+		zap.Int64("scanned", snap.Scanned),
+		zap.Duration("duration", now.Sub(snap.StartedAt)),
+	}
+	if err != nil {
+		logger.Error(ctx, "duplicate scan failed", append(fields, zap.Error(err))...)
+		return
+	}
+	logger.Info(ctx, "duplicate scan completed", append(fields,
+		zap.Int("groups", snap.GroupCount),
+		zap.Int64("duplicates", snap.DuplicateCount),
+	)...)
+}
+
+// register는 새 작업을 등록합니다 (같은 컬렉션에 실행 중인 작업이 있으면 거부)
+func (uc *DuplicateUseCase) register(req *dto.StartDuplicateScanRequest, dbType string) (*duplicateScanJob, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	var finished []*dto.DuplicateScanJobResponse
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		if snap.Status == MaintenanceJobRunning && snap.Collection == req.Collection && snap.DatabaseType == dbType {
+			return nil, fmt.Errorf("%w: scan %s is already running for collection %s", entity.ErrInvalidData, snap.JobID, req.Collection)
+		}
+		if snap.Status != MaintenanceJobRunning {
+			finished = append(finished, snap)
+		}
+	}
+
+	// 끝난 작업은 결과가 클 수 있으므로 최근 maxFinishedDuplicateScans개만 보관
+	if len(finished) >= maxFinishedDuplicateScans {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].StartedAt.Before(finished[j].StartedAt)
+		})
+		for _, snap := range finished[:len(finished)-maxFinishedDuplicateScans+1] {
+			delete(uc.jobs, snap.JobID)
+		}
+	}
+
+	job := &duplicateScanJob{resp: dto.DuplicateScanJobResponse{
+		JobID:           uuid.New().String(),
+		Status:          MaintenanceJobRunning,
+		Collection:      req.Collection,
+		DatabaseType:    dbType,
+		Fields:          req.Fields,
+		CaseInsensitive: req.CaseInsensitive,
+		TrimSpace:       req.TrimSpace,
+		StartedAt:       time.Now().UTC(),
+	}}
+	uc.jobs[job.resp.JobID] = job
+	return job, nil
+}
+
+func (j *duplicateScanJob) update(fn func(r *dto.DuplicateScanJobResponse)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(&j.resp)
+}
+
+func (j *duplicateScanJob) snapshot() *dto.DuplicateScanJobResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	resp := j.resp
+	return &resp
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/fixtures"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// FixtureUseCase는 픽스처 디렉터리의 JSON 문서를 컬렉션에 불러오는 유즈케이스입니다 (데모 환경, 통합 테스트용)
+// 문서는 _id로 식별되어 같은 픽스처를 여러 번 불러와도 문서가 늘어나지 않습니다
+type FixtureUseCase struct {
+	documentUC *DocumentUseCase
+	directory  string
+
+	// mu는 불러오기를 한 번에 하나만 실행합니다 (같은 문서를 동시에 만들지 않도록)
+	mu sync.Mutex
+}
+
+// NewFixtureUseCase는 새로운 FixtureUseCase를 생성합니다 (directory는 픽스처 루트 디렉터리)
+func NewFixtureUseCase(documentUC *DocumentUseCase, directory string) *FixtureUseCase {
+	return &FixtureUseCase{
+		documentUC: documentUC,
+		directory:  directory,
+	}
+}
+
+// Load는 픽스처 세트(루트 디렉터리 또는 그 아래 하위 디렉터리)를 불러옵니다
+// 데이터베이스는 context의 데이터베이스 종류(X-Database-Type)를 따르며, 파일을 모두 읽고 검증한 뒤에 쓰기 시작합니다
+func (uc *FixtureUseCase) Load(ctx context.Context, req *dto.LoadFixturesRequest) (*dto.LoadFixturesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "FixtureUseCase.Load")
+	defer span.End()
+
+	dir, err := uc.resolve(req.Set)
+	if err != nil {
+		return nil, err
+	}
+	set, err := fixtures.LoadDir(dir)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+	}
+	tracing.SetAttributes(ctx,
+		attribute.String("set", req.Set),
+		attribute.Int("files", len(set)),
+	)
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	start := time.Now()
+	resp := &dto.LoadFixturesResponse{Set: req.Set, Collections: make([]dto.FixtureCollectionResult, 0, len(set))}
+	for _, fixture := range set {
+		result, err := uc.documentUC.LoadFixture(ctx, fixture, req.Overwrite)
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, fmt.Errorf("failed to load fixture %s: %w", filepath.Base(fixture.File), err)
+		}
+		resp.Collections = append(resp.Collections, *result)
+		resp.Created += result.Created
+		resp.Updated += result.Updated
+		resp.Unchanged += result.Unchanged
+		resp.Skipped += result.Skipped
+		resp.Failed += result.Failed
+	}
+
+	logger.Info(ctx, "fixtures loaded",
+		zap.String("directory", dir),
+		zap.Int("collections", len(resp.Collections)),
+		zap.Int64("created", resp.Created),
+		zap.Int64("updated", resp.Updated),
+		zap.Int64("unchanged", resp.Unchanged),
+		zap.Int64("skipped", resp.Skipped),
+		zap.Int64("failed", resp.Failed),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return resp, nil
+}
+
+// resolve는 세트 이름을 디렉터리로 바꿉니다 (루트 디렉터리 밖을 가리키는 이름은 거부)
+func (uc *FixtureUseCase) resolve(set string) (string, error) {
+	if set == "" {
+		return uc.directory, nil
+	}
+	if set == "." || set == ".." || strings.ContainsAny(set, `/\`) {
+		return "", fmt.Errorf("%w: fixture set must be a directory name under the fixture directory", entity.ErrInvalidData)
+	}
+	return filepath.Join(uc.directory, set), nil
+}
@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const maxFinishedMaintenanceJobs = 100
+
+// 유지보수 작업 상태
+const (
+	MaintenanceJobRunning   = "running"
+	MaintenanceJobCompleted = "completed"
+	MaintenanceJobFailed    = "failed"
+)
+
+// MaintenanceSchedule은 주기적으로 실행할 컬렉션 유지보수 작업입니다
+type MaintenanceSchedule struct {
+	DatabaseType string
+	Collection   string
+	Operation    string
+	Interval     time.Duration
+	Options      repository.MaintenanceOptions
+}
+
+// MaintenanceUseCase는 컬렉션 유지보수(compact, vacuum, forcemerge, reindex 등) 유즈케이스입니다
+// 작업은 백그라운드에서 실행되며 결과는 이 인스턴스의 메모리에 보관됩니다 (재시작 시 초기화)
+type MaintenanceUseCase struct {
+	repoManager *persistence.RepositoryManager
+	metrics     *metrics.Metrics
+
+	mu   sync.Mutex
+	jobs map[string]*maintenanceJob
+}
+
+// maintenanceJob은 실행 중이거나 끝난 유지보수 작업입니다
+type maintenanceJob struct {
+	mu   sync.Mutex
+	resp dto.MaintenanceJobResponse
+}
+
+// NewMaintenanceUseCase는 새로운 MaintenanceUseCase를 생성합니다
+func NewMaintenanceUseCase(repoManager *persistence.RepositoryManager) *MaintenanceUseCase {
+	return &MaintenanceUseCase{
+		repoManager: repoManager,
+		metrics:     metrics.GetMetrics(),
+		jobs:        make(map[string]*maintenanceJob),
+	}
+}
+
+// Operations는 데이터베이스가 지원하는 유지보수 작업 목록을 반환합니다
+func (uc *MaintenanceUseCase) Operations(ctx context.Context, dbType string) (*dto.MaintenanceOperationsResponse, error) {
+	docRepo, err := uc.repoManager.GetRepository(dbType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrDocumentNotFound, err)
+	}
+
+	operations := []string{}
+	if maintainer, ok := docRepo.(repository.CollectionMaintainer); ok {
+		operations = append(operations, maintainer.MaintenanceOperations()...)
+	}
+	return &dto.MaintenanceOperationsResponse{DatabaseType: dbType, Operations: operations}, nil
+}
+
+// StartMaintenance는 컬렉션 유지보수 작업을 시작하고 작업 상태를 바로 반환합니다
+// 데이터베이스는 요청 context의 데이터베이스 종류(X-Database-Type)를 따릅니다
+func (uc *MaintenanceUseCase) StartMaintenance(ctx context.Context, req *dto.StartMaintenanceRequest) (*dto.MaintenanceJobResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "MaintenanceUseCase.StartMaintenance")
+	defer span.End()
+
+	return uc.start(ctx, req, false)
+}
+
+// start는 작업을 검증하고 등록한 뒤 백그라운드에서 실행합니다
+func (uc *MaintenanceUseCase) start(ctx context.Context, req *dto.StartMaintenanceRequest, scheduled bool) (*dto.MaintenanceJobResponse, error) {
+	dbType := string(middleware.GetDatabaseType(ctx))
+	docRepo, err := uc.repoManager.GetRepository(dbType)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	maintainer, ok := docRepo.(repository.CollectionMaintainer)
+	if !ok || !containsOperation(maintainer.MaintenanceOperations(), req.Operation) {
+		return nil, fmt.Errorf("%w: %s on %s", repository.ErrUnsupportedMaintenance, req.Operation, dbType)
+	}
+
+	exists, err := docRepo.CollectionExists(ctx, req.Collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: collection %s does not exist", entity.ErrInvalidData, req.Collection)
+	}
+
+	job, err := uc.register(req.Operation, req.Collection, dbType, scheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("operation", req.Operation),
+	)
+	logger.Info(ctx, "starting collection maintenance",
+		zap.String("job_id", job.id()),
+		zap.String("operation", req.Operation),
+		zap.String("collection", req.Collection),
+		zap.String("database_type", dbType),
+		zap.Bool("scheduled", scheduled),
+	)
+
+	opts := repository.MaintenanceOptions{
+		Full:        req.Full,
+		MaxSegments: req.MaxSegments,
+		Progress: func(done, total int64) {
+			job.update(func(r *dto.MaintenanceJobResponse) {
+				r.Progress = &dto.MaintenanceProgress{Done: done, Total: total}
+			})
+		},
+	}
+	// 요청이 끝나도 작업은 계속 실행 (context 값은 유지)
+	go uc.run(context.WithoutCancel(ctx), job, maintainer, opts)
+
+	return job.snapshot(), nil
+}
+
+// GetJob은 작업 상태를 반환합니다
+func (uc *MaintenanceUseCase) GetJob(ctx context.Context, jobID string) (*dto.MaintenanceJobResponse, error) {
+	uc.mu.Lock()
+	job, ok := uc.jobs[jobID]
+	uc.mu.Unlock()
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return job.snapshot(), nil
+}
+
+// ListJobs는 작업 목록을 최근 시작한 순서로 반환합니다
+func (uc *MaintenanceUseCase) ListJobs(ctx context.Context) *dto.ListMaintenanceJobsResponse {
+	uc.mu.Lock()
+	jobs := make([]*dto.MaintenanceJobResponse, 0, len(uc.jobs))
+	for _, job := range uc.jobs {
+		jobs = append(jobs, job.snapshot())
+	}
+	uc.mu.Unlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return &dto.ListMaintenanceJobsResponse{Jobs: jobs}
+}
+
+// RunSchedule은 schedule.Interval마다 유지보수 작업을 시작합니다 (ctx가 취소될 때까지 실행)
+// 이전 실행이 아직 끝나지 않았으면 이번 실행은 건너뜁니다
+func (uc *MaintenanceUseCase) RunSchedule(ctx context.Context, schedule MaintenanceSchedule) {
+	if schedule.Interval <= 0 {
+		schedule.Interval = 24 * time.Hour
+	}
+	ctx = context.WithValue(ctx, middleware.DatabaseTypeContextKey, middleware.DatabaseType(schedule.DatabaseType))
+	req := &dto.StartMaintenanceRequest{
+		Collection:  schedule.Collection,
+		Operation:   schedule.Operation,
+		Full:        schedule.Options.Full,
+		MaxSegments: schedule.Options.MaxSegments,
+	}
+
+	ticker := time.NewTicker(schedule.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := uc.start(ctx, req, true); err != nil {
+			logger.Warn(ctx, "scheduled maintenance failed to start",
+				zap.String("operation", schedule.Operation),
+				zap.String("collection", schedule.Collection),
+				zap.String("database_type", schedule.DatabaseType),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// run은 유지보수 작업을 실행하고 결과를 기록합니다
+func (uc *MaintenanceUseCase) run(ctx context.Context, job *maintenanceJob, maintainer repository.CollectionMaintainer, opts repository.MaintenanceOptions) {
+	resp := job.snapshot()
+	result, err := maintainer.RunMaintenance(ctx, resp.Collection, resp.Operation, opts)
+	job.update(func(r *dto.MaintenanceJobResponse) { r.Result = result })
+	uc.finish(ctx, job, err)
+}
+
+// register는 새 작업을 등록합니다 (같은 컬렉션에 실행 중인 작업이 있으면 거부)
+func (uc *MaintenanceUseCase) register(operation, collection, dbType string, scheduled bool) (*maintenanceJob, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	var finished []*dto.MaintenanceJobResponse
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		if snap.Status == MaintenanceJobRunning && snap.Collection == collection && snap.DatabaseType == dbType {
+			return nil, fmt.Errorf("%w: job %s is already running for collection %s", entity.ErrInvalidData, snap.JobID, collection)
+		}
+		if snap.Status != MaintenanceJobRunning {
+			finished = append(finished, snap)
+		}
+	}
+
+	// 끝난 작업은 최근 maxFinishedMaintenanceJobs개만 보관
+	if len(finished) >= maxFinishedMaintenanceJobs {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].StartedAt.Before(finished[j].StartedAt)
+		})
+		for _, snap := range finished[:len(finished)-maxFinishedMaintenanceJobs+1] {
+			delete(uc.jobs, snap.JobID)
+		}
+	}
+
+	job := &maintenanceJob{resp: dto.MaintenanceJobResponse{
+		JobID:        uuid.New().String(),
+		Status:       MaintenanceJobRunning,
+		Operation:    operation,
+		Collection:   collection,
+		DatabaseType: dbType,
+		Scheduled:    scheduled,
+		StartedAt:    time.Now().UTC(),
+	}}
+	uc.jobs[job.resp.JobID] = job
+	return job, nil
+}
+
+// finish는 작업을 완료 또는 실패로 기록합니다
+func (uc *MaintenanceUseCase) finish(ctx context.Context, job *maintenanceJob, err error) {
+	now := time.Now().UTC()
+	job.update(func(r *dto.MaintenanceJobResponse) {
+		r.FinishedAt = &now
+		if err != nil {
+			r.Status = MaintenanceJobFailed
+			r.Error = err.Error()
+			return
+		}
+		r.Status = MaintenanceJobCompleted
+	})
+
+	snap := job.snapshot()
+	duration := now.Sub(snap.StartedAt)
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	uc.metrics.RecordMaintenanceRun(snap.DatabaseType, snap.Operation, status, duration)
+
+	fields := []zap.Field{
+		zap.String("job_id", snap.JobID),
+		zap.String("operation", snap.Operation),
+		zap.String("collection", snap.Collection),
+		zap.String("database_type", snap.DatabaseType),
+		zap.Duration("duration", duration),
+	}
+	if err != nil {
+		logger.Error(ctx, "maintenance job failed", append(fields, zap.Error(err))...)
+		return
+	}
+	logger.Info(ctx, "maintenance job completed", fields...)
+}
+
+func (j *maintenanceJob) id() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.resp.JobID
+}
+
+func (j *maintenanceJob) update(fn func(r *dto.MaintenanceJobResponse)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(&j.resp)
+}
+
+func (j *maintenanceJob) snapshot() *dto.MaintenanceJobResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	resp := j.resp
+	return &resp
+}
+
+// containsOperation은 operations에 operation이 있는지 확인합니다
+func containsOperation(operations []string, operation string) bool {
+	for _, op := range operations {
+		if op == operation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/migration"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// MigrationUseCase는 컬렉션을 다른 백엔드로 옮기는 온라인 마이그레이션 유즈케이스입니다
+// 복사와 검증은 백그라운드에서 실행되며, 전환/완료/취소는 관리자가 단계를 확인하고 요청합니다
+type MigrationUseCase struct {
+	repoManager *persistence.RepositoryManager
+	coordinator *migration.Coordinator
+	batchSize   int
+}
+
+// NewMigrationUseCase는 새로운 MigrationUseCase를 생성합니다
+// coordinator는 RepositoryManager의 저장소를 감싼 것이어야 쓰기가 양쪽에 반영됩니다
+func NewMigrationUseCase(repoManager *persistence.RepositoryManager, coordinator *migration.Coordinator, batchSize int) *MigrationUseCase {
+	return &MigrationUseCase{
+		repoManager: repoManager,
+		coordinator: coordinator,
+		batchSize:   batchSize,
+	}
+}
+
+// StartMigration은 dual-write를 켜고 기존 문서 복사와 검증을 백그라운드로 시작합니다
+func (uc *MigrationUseCase) StartMigration(ctx context.Context, req *dto.StartMigrationRequest) (*dto.MigrationResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "MigrationUseCase.StartMigration")
+	defer span.End()
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", req.Collection),
+		attribute.String("source", req.Source),
+		attribute.String("target", req.Target),
+	)
+
+	if req.Source == req.Target {
+		return nil, fmt.Errorf("%w: source and target must differ", entity.ErrInvalidData)
+	}
+	sourceRepo, err := uc.repoManager.GetRepository(req.Source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+	}
+	targetRepo, err := uc.repoManager.GetRepository(req.Target)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+	}
+
+	exists, err := sourceRepo.CollectionExists(ctx, req.Collection)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to check collection: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: collection %s does not exist in %s", entity.ErrInvalidData, req.Collection, req.Source)
+	}
+
+	if err := uc.coordinator.CheckAvailable(req.Collection, req.Source, req.Target); err != nil {
+		return nil, migrationError(err)
+	}
+
+	// 대상에 남은 문서와 섞이지 않도록 빈 컬렉션에서 시작
+	if !req.DropTarget {
+		if err := checkRestoreTargetEmpty(ctx, targetRepo, req.Collection); err != nil {
+			return nil, err
+		}
+	}
+	if err := prepareRestoreTarget(ctx, targetRepo, req.Collection, req.DropTarget); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	m, err := uc.coordinator.Start(req.Collection, req.Source, req.Target)
+	if err != nil {
+		return nil, migrationError(err)
+	}
+
+	logger.Info(ctx, "starting online migration",
+		zap.String("migration_id", m.ID()),
+		zap.String("collection", req.Collection),
+		zap.String("source", req.Source),
+		zap.String("target", req.Target),
+	)
+
+	// 요청이 끝나도 복사는 계속 실행
+	go uc.run(context.WithoutCancel(ctx), m)
+
+	return toMigrationResponse(m.Status()), nil
+}
+
+// run은 복사와 검증을 실행하고 결과를 기록합니다
+func (uc *MigrationUseCase) run(ctx context.Context, m *migration.Migration) {
+	m.Run(ctx, uc.batchSize)
+
+	status := m.Status()
+	fields := []zap.Field{
+		zap.String("migration_id", status.ID),
+		zap.String("collection", status.Collection),
+		zap.String("phase", string(status.Phase)),
+		zap.Int64("copied", status.Copied),
+		zap.Int64("skipped", status.Skipped),
+		zap.Duration("duration", status.UpdatedAt.Sub(status.StartedAt)),
+	}
+	if status.Error != "" {
+		logger.Error(ctx, "online migration failed", append(fields, zap.String("error", status.Error))...)
+		return
+	}
+	logger.Info(ctx, "online migration backfill finished", fields...)
+}
+
+// GetMigration은 마이그레이션 상태를 반환합니다
+func (uc *MigrationUseCase) GetMigration(ctx context.Context, id string) (*dto.MigrationResponse, error) {
+	m, err := uc.coordinator.Get(id)
+	if err != nil {
+		return nil, migrationError(err)
+	}
+	return toMigrationResponse(m.Status()), nil
+}
+
+// ListMigrations는 마이그레이션 목록을 반환합니다
+func (uc *MigrationUseCase) ListMigrations(ctx context.Context) *dto.ListMigrationsResponse {
+	migrations := uc.coordinator.List()
+	resp := &dto.ListMigrationsResponse{Migrations: make([]*dto.MigrationResponse, 0, len(migrations))}
+	for _, m := range migrations {
+		resp.Migrations = append(resp.Migrations, toMigrationResponse(m.Status()))
+	}
+	return resp
+}
+
+// VerifyMigration은 원본과 대상의 체크섬을 백그라운드에서 다시 비교합니다
+func (uc *MigrationUseCase) VerifyMigration(ctx context.Context, id string) (*dto.MigrationResponse, error) {
+	m, err := uc.coordinator.Get(id)
+	if err != nil {
+		return nil, migrationError(err)
+	}
+	switch phase := m.Status().Phase; phase {
+	case migration.PhaseReady, migration.PhaseVerifyFailed, migration.PhaseCutOver:
+	default:
+		return nil, fmt.Errorf("%w: cannot verify a migration in phase %s", entity.ErrInvalidData, phase)
+	}
+
+	go func(ctx context.Context) {
+		if err := m.Verify(ctx); err != nil {
+			logger.Error(ctx, "online migration verification failed", zap.String("migration_id", id), zap.Error(err))
+		}
+	}(context.WithoutCancel(ctx))
+
+	return toMigrationResponse(m.Status()), nil
+}
+
+// CutOver는 컬렉션의 읽기/쓰기를 대상 백엔드로 전환합니다
+func (uc *MigrationUseCase) CutOver(ctx context.Context, id string, req *dto.CutOverMigrationRequest) (*dto.MigrationResponse, error) {
+	return uc.apply(ctx, id, "online migration cut over", func(m *migration.Migration) error {
+		return m.CutOver(req.Force)
+	})
+}
+
+// Complete는 원본 반영을 멈추고 마이그레이션을 끝냅니다
+func (uc *MigrationUseCase) Complete(ctx context.Context, id string) (*dto.MigrationResponse, error) {
+	return uc.apply(ctx, id, "online migration completed", (*migration.Migration).Complete)
+}
+
+// Abort는 마이그레이션을 취소하고 원본 백엔드로 되돌립니다
+func (uc *MigrationUseCase) Abort(ctx context.Context, id string) (*dto.MigrationResponse, error) {
+	return uc.apply(ctx, id, "online migration aborted", (*migration.Migration).Abort)
+}
+
+// apply는 마이그레이션 단계를 바꾸고 결과를 기록합니다
+func (uc *MigrationUseCase) apply(ctx context.Context, id, message string, fn func(m *migration.Migration) error) (*dto.MigrationResponse, error) {
+	m, err := uc.coordinator.Get(id)
+	if err != nil {
+		return nil, migrationError(err)
+	}
+	if err := fn(m); err != nil {
+		return nil, migrationError(err)
+	}
+
+	status := m.Status()
+	logger.Info(ctx, message,
+		zap.String("migration_id", status.ID),
+		zap.String("collection", status.Collection),
+		zap.String("source", status.Source),
+		zap.String("target", status.Target),
+	)
+	return toMigrationResponse(status), nil
+}
+
+// migrationError는 마이그레이션 에러를 도메인 에러로 변환합니다
+func migrationError(err error) error {
+	switch {
+	case errors.Is(err, migration.ErrNotFound):
+		return fmt.Errorf("%w: %v", entity.ErrDocumentNotFound, err)
+	case errors.Is(err, migration.ErrInvalidState), errors.Is(err, migration.ErrInProgress):
+		return fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+	default:
+		return err
+	}
+}
+
+func toMigrationResponse(status migration.Status) *dto.MigrationResponse {
+	resp := &dto.MigrationResponse{
+		MigrationID:    status.ID,
+		Collection:     status.Collection,
+		Source:         status.Source,
+		Target:         status.Target,
+		Phase:          string(status.Phase),
+		Copied:         status.Copied,
+		Skipped:        status.Skipped,
+		Total:          status.Total,
+		MirrorFailures: status.MirrorFailures,
+		Error:          status.Error,
+		StartedAt:      status.StartedAt,
+		UpdatedAt:      status.UpdatedAt,
+	}
+	if status.Verify != nil {
+		resp.Verify = &dto.MigrationVerifyResponse{
+			SourceDocuments: status.Verify.SourceDocuments,
+			TargetDocuments: status.Verify.TargetDocuments,
+			SourceChecksum:  status.Verify.SourceChecksum,
+			TargetChecksum:  status.Verify.TargetChecksum,
+			Match:           status.Verify.Match,
+			VerifiedAt:      status.Verify.VerifiedAt,
+		}
+	}
+	return resp
+}
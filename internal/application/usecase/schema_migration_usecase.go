@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// SchemaMigrationUseCase는 SQL 백엔드 문서 테이블의 스키마 마이그레이션 유즈케이스입니다
+type SchemaMigrationUseCase struct {
+	migrators map[string]*schema.Migrator // 데이터베이스 종류 -> Migrator
+}
+
+// NewSchemaMigrationUseCase는 새로운 SchemaMigrationUseCase를 생성합니다
+func NewSchemaMigrationUseCase(migrators map[string]*schema.Migrator) *SchemaMigrationUseCase {
+	return &SchemaMigrationUseCase{
+		migrators: migrators,
+	}
+}
+
+// Status는 문서 테이블별 스키마 버전과 남은 마이그레이션을 반환합니다
+func (uc *SchemaMigrationUseCase) Status(ctx context.Context, dbType string) (*dto.SchemaMigrationStatusResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SchemaMigrationUseCase.Status")
+	defer span.End()
+
+	migrator, err := uc.migrator(dbType)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	resp := &dto.SchemaMigrationStatusResponse{
+		DatabaseType:  dbType,
+		LatestVersion: migrator.Latest(),
+		Tables:        make([]*dto.SchemaTableStatusResponse, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		resp.Tables = append(resp.Tables, &dto.SchemaTableStatusResponse{
+			Table:   status.Table,
+			Version: status.Version,
+			Pending: status.Pending,
+		})
+	}
+	return resp, nil
+}
+
+// Up은 모든 문서 테이블에 req.Version까지 남은 마이그레이션을 적용합니다
+func (uc *SchemaMigrationUseCase) Up(ctx context.Context, dbType string, req *dto.SchemaMigrationUpRequest) (*dto.SchemaMigrationResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SchemaMigrationUseCase.Up")
+	defer span.End()
+
+	migrator, err := uc.migrator(dbType)
+	if err != nil {
+		return nil, err
+	}
+	tracing.SetAttributes(ctx, attribute.String("db.type", dbType), attribute.Int("version", req.Version))
+
+	changes, err := migrator.Up(ctx, req.Version)
+	return uc.result(ctx, dbType, "up", changes, err)
+}
+
+// Down은 모든 문서 테이블에서 req.Version보다 높은 마이그레이션을 되돌립니다
+func (uc *SchemaMigrationUseCase) Down(ctx context.Context, dbType string, req *dto.SchemaMigrationDownRequest) (*dto.SchemaMigrationResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SchemaMigrationUseCase.Down")
+	defer span.End()
+
+	migrator, err := uc.migrator(dbType)
+	if err != nil {
+		return nil, err
+	}
+	tracing.SetAttributes(ctx, attribute.String("db.type", dbType), attribute.Int("version", *req.Version))
+
+	changes, err := migrator.Down(ctx, *req.Version)
+	return uc.result(ctx, dbType, "down", changes, err)
+}
+
+// result는 실행 결과를 기록하고 응답으로 변환합니다 (실패해도 이미 실행한 변경은 기록)
+func (uc *SchemaMigrationUseCase) result(ctx context.Context, dbType, direction string, changes []schema.Change, err error) (*dto.SchemaMigrationResponse, error) {
+	for _, change := range changes {
+		logger.Info(ctx, "schema migration applied",
+			zap.String("database_type", dbType),
+			zap.String("table", change.Table),
+			zap.Int("version", change.Version),
+			zap.String("name", change.Name),
+			zap.String("direction", change.Direction),
+		)
+	}
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		if errors.Is(err, schema.ErrUnknownVersion) || errors.Is(err, schema.ErrIrreversible) {
+			return nil, fmt.Errorf("%w: %v", entity.ErrInvalidData, err)
+		}
+		return nil, fmt.Errorf("schema migration %s stopped after %d changes: %w", direction, len(changes), err)
+	}
+
+	resp := &dto.SchemaMigrationResponse{
+		DatabaseType: dbType,
+		Changes:      make([]*dto.SchemaMigrationChangeResponse, 0, len(changes)),
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, &dto.SchemaMigrationChangeResponse{
+			Table:     change.Table,
+			Version:   change.Version,
+			Name:      change.Name,
+			Direction: change.Direction,
+		})
+	}
+	return resp, nil
+}
+
+func (uc *SchemaMigrationUseCase) migrator(dbType string) (*schema.Migrator, error) {
+	migrator, ok := uc.migrators[dbType]
+	if !ok {
+		return nil, fmt.Errorf("%w: schema migrations are not enabled for %s", entity.ErrInvalidData, dbType)
+	}
+	return migrator, nil
+}
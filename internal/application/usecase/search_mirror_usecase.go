@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence"
+	"github.com/YouSangSon/database-service/internal/infrastructure/searchmirror"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// searchMirrorDatabase는 검색 미러를 두는 데이터베이스 종류입니다
+const searchMirrorDatabase = "elasticsearch"
+
+const maxFinishedMirrorVerifications = 50
+
+// SearchMirror는 Elasticsearch 인덱스로 미러되는 컬렉션입니다
+type SearchMirror struct {
+	Collection     string
+	Source         string        // 주 저장소 데이터베이스 종류
+	Index          string        // Elasticsearch 인덱스 (비어 있으면 Collection)
+	VerifyInterval time.Duration // 주기적 검증 간격 (0이면 요청할 때만)
+	AutoRepair     bool          // 주기적 검증에서 어긋난 문서를 다시 맞춤
+
+	// Mapping은 미러 인덱스의 설정과 data 필드 매핑입니다 (nil이면 Elasticsearch 저장소 기본 매핑)
+	Mapping *repository.IndexMapping
+}
+
+// SearchMirrorUseCase는 주 저장소 컬렉션을 Elasticsearch 검색 미러로 동기화하고 일관성을 검증하는 유즈케이스입니다
+// 검증 작업은 백그라운드에서 실행되며 결과는 이 인스턴스의 메모리에 보관됩니다 (재시작 시 초기화)
+type SearchMirrorUseCase struct {
+	repoManager *persistence.RepositoryManager
+	mirrors     map[string]SearchMirror
+	metrics     *metrics.Metrics
+
+	mu      sync.Mutex
+	jobs    map[string]*mirrorVerificationJob
+	syncers map[string]*searchmirror.Syncer
+}
+
+// mirrorVerificationJob은 실행 중이거나 끝난 검증 작업입니다
+type mirrorVerificationJob struct {
+	mu   sync.Mutex
+	resp dto.MirrorVerificationResponse
+}
+
+// NewSearchMirrorUseCase는 새로운 SearchMirrorUseCase를 생성합니다
+func NewSearchMirrorUseCase(repoManager *persistence.RepositoryManager, mirrors []SearchMirror) *SearchMirrorUseCase {
+	byCollection := make(map[string]SearchMirror, len(mirrors))
+	for _, m := range mirrors {
+		if m.Index == "" {
+			m.Index = m.Collection
+		}
+		byCollection[m.Collection] = m
+	}
+	return &SearchMirrorUseCase{
+		repoManager: repoManager,
+		mirrors:     byCollection,
+		metrics:     metrics.GetMetrics(),
+		jobs:        make(map[string]*mirrorVerificationJob),
+		syncers:     make(map[string]*searchmirror.Syncer),
+	}
+}
+
+// EnsureMappings는 Mapping이 설정된 미러 인덱스를 그 매핑으로 준비합니다 (없는 인덱스는 생성, 있는 인덱스는 필드 추가)
+func (uc *SearchMirrorUseCase) EnsureMappings(ctx context.Context) error {
+	for _, mirror := range uc.mirrors {
+		if mirror.Mapping == nil {
+			continue
+		}
+		syncer, err := uc.syncer(mirror)
+		if err != nil {
+			return err
+		}
+		if err := syncer.EnsureMapping(ctx, *mirror.Mapping); err != nil {
+			return err
+		}
+		logger.Info(ctx, "search mirror index mapping applied",
+			zap.String("collection", mirror.Collection),
+			zap.String("index", mirror.Index),
+		)
+	}
+	return nil
+}
+
+// SyncDocument는 변경된 문서를 주 저장소의 현재 상태로 미러 인덱스에 반영합니다
+// 미러하지 않는 컬렉션이면 false를 반환합니다
+func (uc *SearchMirrorUseCase) SyncDocument(ctx context.Context, collection, id string) (bool, error) {
+	mirror, ok := uc.mirrors[collection]
+	if !ok {
+		return false, nil
+	}
+	syncer, err := uc.syncer(mirror)
+	if err != nil {
+		return true, err
+	}
+
+	result, err := syncer.Sync(ctx, id)
+	if err != nil {
+		uc.metrics.RecordSearchMirrorSync(collection, "failed")
+		return true, err
+	}
+	uc.metrics.RecordSearchMirrorSync(collection, string(result))
+	logger.Debug(ctx, "search mirror synced",
+		zap.String("collection", collection),
+		zap.String("index", mirror.Index),
+		zap.String("id", id),
+		zap.String("result", string(result)),
+	)
+	return true, nil
+}
+
+// syncer는 컬렉션의 Syncer를 반환합니다 (처음 호출할 때 생성)
+func (uc *SearchMirrorUseCase) syncer(mirror SearchMirror) (*searchmirror.Syncer, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if syncer, ok := uc.syncers[mirror.Collection]; ok {
+		return syncer, nil
+	}
+	source, err := uc.repoManager.GetRepository(mirror.Source)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.repoManager.GetRepository(searchMirrorDatabase)
+	if err != nil {
+		return nil, err
+	}
+	syncer := searchmirror.NewSyncer(source, target, mirror.Collection, mirror.Index)
+	uc.syncers[mirror.Collection] = syncer
+	return syncer, nil
+}
+
+// Mirrors는 설정된 검색 미러 목록을 반환합니다
+func (uc *SearchMirrorUseCase) Mirrors(ctx context.Context) *dto.ListSearchMirrorsResponse {
+	mirrors := make([]dto.SearchMirrorResponse, 0, len(uc.mirrors))
+	for _, m := range uc.mirrors {
+		resp := dto.SearchMirrorResponse{
+			Collection:     m.Collection,
+			SourceDatabase: m.Source,
+			Index:          m.Index,
+			AutoRepair:     m.AutoRepair,
+		}
+		if m.VerifyInterval > 0 {
+			resp.VerifyInterval = m.VerifyInterval.String()
+		}
+		mirrors = append(mirrors, resp)
+	}
+	sort.Slice(mirrors, func(i, j int) bool {
+		return mirrors[i].Collection < mirrors[j].Collection
+	})
+	return &dto.ListSearchMirrorsResponse{Mirrors: mirrors}
+}
+
+// StartVerification은 검증 작업을 시작하고 작업 상태를 바로 반환합니다
+func (uc *SearchMirrorUseCase) StartVerification(ctx context.Context, req *dto.StartMirrorVerificationRequest) (*dto.MirrorVerificationResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "SearchMirrorUseCase.StartVerification")
+	defer span.End()
+
+	return uc.start(ctx, req, false)
+}
+
+// start는 작업을 등록하고 백그라운드에서 실행합니다
+func (uc *SearchMirrorUseCase) start(ctx context.Context, req *dto.StartMirrorVerificationRequest, scheduled bool) (*dto.MirrorVerificationResponse, error) {
+	mirror, ok := uc.mirrors[req.Collection]
+	if !ok {
+		return nil, fmt.Errorf("%w: collection %s is not mirrored", entity.ErrInvalidData, req.Collection)
+	}
+	source, err := uc.repoManager.GetRepository(mirror.Source)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	target, err := uc.repoManager.GetRepository(searchMirrorDatabase)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	job, err := uc.register(mirror, req.Repair, scheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	tracing.SetAttributes(ctx,
+		attribute.String("collection", mirror.Collection),
+		attribute.Bool("repair", req.Repair),
+	)
+	logger.Info(ctx, "starting search mirror verification",
+		zap.String("job_id", job.id()),
+		zap.String("collection", mirror.Collection),
+		zap.String("source_database", mirror.Source),
+		zap.String("index", mirror.Index),
+		zap.Bool("repair", req.Repair),
+		zap.Bool("scheduled", scheduled),
+	)
+
+	verifier := searchmirror.NewVerifier(source, target, mirror.Collection, mirror.Index)
+	// 요청이 끝나도 작업은 계속 실행 (context 값은 유지)
+	go uc.run(context.WithoutCancel(ctx), job, verifier, req.Repair)
+
+	return job.snapshot(), nil
+}
+
+// GetVerification은 작업 상태를 반환합니다
+func (uc *SearchMirrorUseCase) GetVerification(ctx context.Context, jobID string) (*dto.MirrorVerificationResponse, error) {
+	uc.mu.Lock()
+	job, ok := uc.jobs[jobID]
+	uc.mu.Unlock()
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return job.snapshot(), nil
+}
+
+// ListVerifications는 작업 목록을 최근 시작한 순서로 반환합니다 (어긋난 문서 목록은 제외)
+func (uc *SearchMirrorUseCase) ListVerifications(ctx context.Context) *dto.ListMirrorVerificationsResponse {
+	uc.mu.Lock()
+	jobs := make([]*dto.MirrorVerificationResponse, 0, len(uc.jobs))
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		snap.Drift = nil
+		jobs = append(jobs, snap)
+	}
+	uc.mu.Unlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return &dto.ListMirrorVerificationsResponse{Jobs: jobs}
+}
+
+// RunSchedule은 mirror.VerifyInterval마다 검증을 시작합니다 (ctx가 취소될 때까지 실행)
+// 이전 검증이 아직 끝나지 않았으면 이번 검증은 건너뜁니다
+func (uc *SearchMirrorUseCase) RunSchedule(ctx context.Context, mirror SearchMirror) {
+	ticker := time.NewTicker(mirror.VerifyInterval)
+	defer ticker.Stop()
+
+	req := &dto.StartMirrorVerificationRequest{Collection: mirror.Collection, Repair: mirror.AutoRepair}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := uc.start(ctx, req, true); err != nil {
+			logger.Warn(ctx, "scheduled search mirror verification failed to start",
+				zap.String("collection", mirror.Collection),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// run은 검증을 실행하고 결과를 기록합니다
+func (uc *SearchMirrorUseCase) run(ctx context.Context, job *mirrorVerificationJob, verifier *searchmirror.Verifier, repair bool) {
+	report, err := verifier.Verify(ctx, searchmirror.Options{
+		Repair: repair,
+		Progress: func(sourceScanned, mirrorScanned int64) {
+			job.update(func(r *dto.MirrorVerificationResponse) {
+				r.SourceCount, r.MirrorCount = sourceScanned, mirrorScanned
+			})
+		},
+	})
+
+	now := time.Now().UTC()
+	job.update(func(r *dto.MirrorVerificationResponse) {
+		r.FinishedAt = &now
+		if err != nil {
+			r.Status = MaintenanceJobFailed
+			r.Error = err.Error()
+			return
+		}
+
+		r.Status = MaintenanceJobCompleted
+		inSync := report.InSync()
+		r.InSync = &inSync
+		r.SourceCount, r.MirrorCount = report.SourceCount, report.MirrorCount
+		r.Missing, r.Extra, r.Diverged = report.Missing, report.Extra, report.Diverged
+		r.Repaired, r.RepairFailed = report.Repaired, report.RepairFailed
+		for _, d := range report.Drift {
+			r.Drift = append(r.Drift, dto.MirrorDrift{ID: d.ID, Kind: string(d.Kind), Repaired: d.Repaired, Error: d.Error})
+		}
+	})
+
+	snap := job.snapshot()
+	fields := []zap.Field{
+		zap.String("job_id", snap.JobID),
+		zap.String("collection", snap.Collection),
+		zap.String("index", snap.Index),
+		zap.Duration("duration", now.Sub(snap.StartedAt)),
+	}
+	if err != nil {
+		logger.Error(ctx, "search mirror verification failed", append(fields, zap.Error(err))...)
+		return
+	}
+
+	uc.metrics.RecordSearchMirrorVerification(snap.Collection, snap.Missing, snap.Extra, snap.Diverged, snap.Repaired)
+	fields = append(fields,
+		zap.Int64("source_count", snap.SourceCount),
+		zap.Int64("mirror_count", snap.MirrorCount),
+		zap.Int64("missing", snap.Missing),
+		zap.Int64("extra", snap.Extra),
+		zap.Int64("diverged", snap.Diverged),
+		zap.Int64("repaired", snap.Repaired),
+	)
+	if !report.InSync() {
+		logger.Warn(ctx, "search mirror drift detected", fields...)
+		return
+	}
+	logger.Info(ctx, "search mirror in sync", fields...)
+}
+
+// register는 새 작업을 등록합니다 (같은 컬렉션에 실행 중인 작업이 있으면 거부)
+func (uc *SearchMirrorUseCase) register(mirror SearchMirror, repair, scheduled bool) (*mirrorVerificationJob, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	var finished []*dto.MirrorVerificationResponse
+	for _, job := range uc.jobs {
+		snap := job.snapshot()
+		if snap.Status == MaintenanceJobRunning && snap.Collection == mirror.Collection {
+			return nil, fmt.Errorf("%w: verification %s is already running for collection %s", entity.ErrInvalidData, snap.JobID, mirror.Collection)
+		}
+		if snap.Status != MaintenanceJobRunning {
+			finished = append(finished, snap)
+		}
+	}
+
+	// 끝난 작업은 최근 maxFinishedMirrorVerifications개만 보관
+	if len(finished) >= maxFinishedMirrorVerifications {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].StartedAt.Before(finished[j].StartedAt)
+		})
+		for _, snap := range finished[:len(finished)-maxFinishedMirrorVerifications+1] {
+			delete(uc.jobs, snap.JobID)
+		}
+	}
+
+	job := &mirrorVerificationJob{resp: dto.MirrorVerificationResponse{
+		JobID:          uuid.New().String(),
+		Status:         MaintenanceJobRunning,
+		Collection:     mirror.Collection,
+		SourceDatabase: mirror.Source,
+		Index:          mirror.Index,
+		Repair:         repair,
+		Scheduled:      scheduled,
+		StartedAt:      time.Now().UTC(),
+	}}
+	uc.jobs[job.resp.JobID] = job
+	return job, nil
+}
+
+func (j *mirrorVerificationJob) id() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.resp.JobID
+}
+
+func (j *mirrorVerificationJob) update(fn func(r *dto.MirrorVerificationResponse)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(&j.resp)
+}
+
+func (j *mirrorVerificationJob) snapshot() *dto.MirrorVerificationResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	resp := j.resp
+	return &resp
+}
@@ -0,0 +1,466 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// 프로비저닝 단계 이름
+const (
+	tenantStepCollection = "collection"
+	tenantStepIndex      = "index"
+	tenantStepCDCTopic   = "cdc_topic"
+	tenantStepQuota      = "quota"
+	tenantStepAPIKey     = "api_key"
+	tenantStepTenant     = "tenant"
+)
+
+var (
+	// tenantIDPattern은 테넌트 ID 형식입니다 (밑줄은 네임스페이스 구분자라 허용하지 않음)
+	tenantIDPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{1,39}$`)
+
+	// tenantNamePattern은 네임스페이스를 제외한 컬렉션/인덱스 이름 형식입니다
+	tenantNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+)
+
+// TenantTopicAdmin은 테넌트 CDC 토픽을 만드는 메시지 브로커 관리자입니다 (kafka.TopicAdmin이 구현)
+type TenantTopicAdmin interface {
+	// EnsureTopics는 없는 토픽을 만들고 새로 만든 토픽을 반환합니다
+	EnsureTopics(ctx context.Context, topics []string) ([]string, error)
+}
+
+// TenantOptions는 테넌트 프로비저닝 기본값입니다
+type TenantOptions struct {
+	// DefaultCollections는 모든 테넌트에 만드는 컬렉션과 인덱스입니다 (네임스페이스 제외 이름)
+	DefaultCollections []dto.TenantCollectionRequest
+
+	// DefaultQuota는 요청에 쿼터가 없을 때 적용할 저장 용량 한도입니다
+	DefaultQuota entity.TenantQuota
+
+	// DefaultAPIKeyRoles는 요청에 API 키가 없을 때 발급할 키 하나의 역할입니다 (기본값: writer)
+	DefaultAPIKeyRoles []string
+
+	// CDCTopics가 true이면 요청에서 끄지 않는 한 CDCBaseTopics마다 "<테넌트>.<토픽>"을 만듭니다
+	CDCTopics     bool
+	CDCBaseTopics []string
+}
+
+// TenantUseCase는 테넌트 프로비저닝과 테넌트 API 키 조회 유즈케이스입니다
+type TenantUseCase struct {
+	tenants    repository.TenantRepository
+	documentUC *DocumentUseCase
+	topics     TenantTopicAdmin
+	opts       TenantOptions
+
+	quotaMu    sync.Mutex
+	quotaCache map[string]tenantQuotaEntry // 테넌트 ID별 쿼터 조회 결과 (없는 테넌트 포함)
+}
+
+// NewTenantUseCase는 새로운 TenantUseCase를 생성합니다 (topics가 nil이면 CDC 토픽을 만들 수 없음)
+func NewTenantUseCase(tenants repository.TenantRepository, documentUC *DocumentUseCase, topics TenantTopicAdmin, opts TenantOptions) *TenantUseCase {
+	if len(opts.DefaultAPIKeyRoles) == 0 {
+		opts.DefaultAPIKeyRoles = []string{string(auth.RoleWriter)}
+	}
+	return &TenantUseCase{
+		tenants:    tenants,
+		documentUC: documentUC,
+		topics:     topics,
+		opts:       opts,
+		quotaCache: make(map[string]tenantQuotaEntry),
+	}
+}
+
+// tenantIndexPlan은 만들 인덱스 하나입니다
+type tenantIndexPlan struct {
+	name  string
+	model repository.IndexModel
+}
+
+// tenantCollectionPlan은 만들 컬렉션 하나와 인덱스입니다
+type tenantCollectionPlan struct {
+	name    string
+	indexes []tenantIndexPlan
+}
+
+// Provision은 테넌트의 컬렉션, 인덱스, CDC 토픽을 만들고 쿼터와 API 키를 저장합니다
+// 리소스 생성은 멱등이므로 실패한 프로비저닝은 같은 요청으로 다시 시도할 수 있습니다
+// 테넌트 레코드와 API 키는 모든 리소스 단계가 성공한 경우에만 저장합니다
+func (uc *TenantUseCase) Provision(ctx context.Context, req *dto.ProvisionTenantRequest) (*dto.ProvisionTenantResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "TenantUseCase.Provision")
+	defer span.End()
+
+	tracing.SetAttributes(ctx, attribute.String("tenant_id", req.TenantID))
+
+	namespace := req.TenantID + "_"
+	plans, quota, keyRequests, topics, err := uc.plan(req, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.tenants.FindByID(ctx, req.TenantID); err == nil {
+		return nil, fmt.Errorf("%w: tenant %s already exists", entity.ErrDuplicateKey, req.TenantID)
+	} else if !errors.Is(err, entity.ErrDocumentNotFound) {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	report := &dto.ProvisionTenantResponse{}
+	failed := false
+	step := func(kind, resource, status string, err error) {
+		s := dto.ProvisioningStep{Step: kind, Resource: resource, Status: status}
+		if err != nil {
+			s.Status = dto.ProvisioningFailed
+			s.Error = err.Error()
+			failed = true
+		}
+		report.Steps = append(report.Steps, s)
+	}
+
+	collections := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		collections = append(collections, plan.name)
+
+		existed, err := uc.documentUC.EnsureCollection(ctx, plan.name)
+		step(tenantStepCollection, plan.name, createdOrExists(existed), err)
+		if err != nil {
+			for _, index := range plan.indexes {
+				step(tenantStepIndex, plan.name+"."+index.name, dto.ProvisioningSkipped, nil)
+			}
+			continue
+		}
+
+		for _, index := range plan.indexes {
+			existed, err := uc.documentUC.EnsureIndex(ctx, plan.name, index.model)
+			step(tenantStepIndex, plan.name+"."+index.name, createdOrExists(existed), err)
+		}
+	}
+
+	if len(topics) > 0 {
+		created, err := uc.topics.EnsureTopics(ctx, topics)
+		isCreated := make(map[string]bool, len(created))
+		for _, topic := range created {
+			isCreated[topic] = true
+		}
+		for _, topic := range topics {
+			switch {
+			case isCreated[topic]:
+				step(tenantStepCDCTopic, topic, dto.ProvisioningCreated, nil)
+			case err != nil:
+				step(tenantStepCDCTopic, topic, "", err)
+			default:
+				step(tenantStepCDCTopic, topic, dto.ProvisioningExists, nil)
+			}
+		}
+	}
+
+	quotaResource := fmt.Sprintf("max_documents=%d,max_bytes=%d", quota.MaxDocuments, quota.MaxBytes)
+	if failed {
+		step(tenantStepQuota, quotaResource, dto.ProvisioningSkipped, nil)
+		for range keyRequests {
+			step(tenantStepAPIKey, "", dto.ProvisioningSkipped, nil)
+		}
+		step(tenantStepTenant, req.TenantID, dto.ProvisioningSkipped, nil)
+		logger.Warn(ctx, "tenant provisioning incomplete", zap.String("tenant_id", req.TenantID))
+		return report, nil
+	}
+
+	now := time.Now()
+	tenant := &entity.Tenant{
+		ID:           req.TenantID,
+		Namespace:    namespace,
+		DatabaseType: string(middleware.GetDatabaseType(ctx)),
+		Collections:  collections,
+		Quota:        quota,
+		CDCTopics:    topics,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	var issued []dto.IssuedTenantAPIKey
+	for _, keyReq := range keyRequests {
+		key, err := auth.GenerateAPIKey()
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			return nil, err
+		}
+		tenant.APIKeys = append(tenant.APIKeys, entity.TenantAPIKey{
+			ID:          key.ID,
+			SecretHash:  key.SecretHash,
+			Roles:       keyReq.Roles,
+			Description: keyReq.Description,
+			CreatedAt:   now,
+		})
+		issued = append(issued, dto.IssuedTenantAPIKey{ID: key.ID, Key: key.Token, Roles: keyReq.Roles})
+	}
+
+	if err := uc.tenants.Create(ctx, tenant); err != nil {
+		tracing.RecordError(ctx, err)
+		step(tenantStepQuota, quotaResource, dto.ProvisioningSkipped, nil)
+		for _, key := range issued {
+			step(tenantStepAPIKey, key.ID, dto.ProvisioningSkipped, nil)
+		}
+		step(tenantStepTenant, req.TenantID, "", err)
+		logger.Warn(ctx, "tenant provisioning incomplete",
+			zap.String("tenant_id", req.TenantID),
+			zap.Error(err),
+		)
+		return report, nil
+	}
+
+	step(tenantStepQuota, quotaResource, dto.ProvisioningConfigured, nil)
+	for _, key := range issued {
+		step(tenantStepAPIKey, key.ID, dto.ProvisioningCreated, nil)
+	}
+	step(tenantStepTenant, req.TenantID, dto.ProvisioningCreated, nil)
+
+	uc.forgetTenantQuota(tenant.ID)
+
+	report.Tenant = toTenantResponse(tenant)
+	report.APIKeys = issued
+	report.Complete = true
+
+	logger.Info(ctx, "tenant provisioned",
+		zap.String("tenant_id", tenant.ID),
+		zap.Int("collections", len(tenant.Collections)),
+		zap.Int("api_keys", len(tenant.APIKeys)),
+		zap.Int("cdc_topics", len(tenant.CDCTopics)),
+	)
+	return report, nil
+}
+
+// GetTenant는 테넌트를 조회합니다
+func (uc *TenantUseCase) GetTenant(ctx context.Context, id string) (*dto.TenantResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "TenantUseCase.GetTenant")
+	defer span.End()
+
+	tenant, err := uc.tenants.FindByID(ctx, id)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	return toTenantResponse(tenant), nil
+}
+
+// ListTenants는 모든 테넌트를 조회합니다
+func (uc *TenantUseCase) ListTenants(ctx context.Context) (*dto.ListTenantsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "TenantUseCase.ListTenants")
+	defer span.End()
+
+	tenants, err := uc.tenants.List(ctx)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	resp := &dto.ListTenantsResponse{Tenants: make([]dto.TenantResponse, 0, len(tenants))}
+	for _, tenant := range tenants {
+		resp.Tenants = append(resp.Tenants, *toTenantResponse(tenant))
+	}
+	return resp, nil
+}
+
+// FindAPIKey는 키 ID로 테넌트 API 키를 조회합니다 (auth.APIKeyStore 구현, 없으면 nil, nil)
+func (uc *TenantUseCase) FindAPIKey(ctx context.Context, id string) (*auth.APIKey, error) {
+	tenant, err := uc.tenants.FindByAPIKeyID(ctx, id)
+	if errors.Is(err, entity.ErrDocumentNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range tenant.APIKeys {
+		if key.ID != id {
+			continue
+		}
+		roles := make([]auth.Role, 0, len(key.Roles))
+		for _, role := range key.Roles {
+			roles = append(roles, auth.Role(role))
+		}
+		return &auth.APIKey{
+			ID:         key.ID,
+			TenantID:   tenant.ID,
+			Namespace:  tenant.Namespace,
+			SecretHash: key.SecretHash,
+			Roles:      roles,
+		}, nil
+	}
+	return nil, nil
+}
+
+// plan은 요청을 검증하고 기본값을 합쳐 만들 리소스를 정합니다
+func (uc *TenantUseCase) plan(req *dto.ProvisionTenantRequest, namespace string) ([]tenantCollectionPlan, entity.TenantQuota, []dto.TenantAPIKeyRequest, []string, error) {
+	var quota entity.TenantQuota
+	if !tenantIDPattern.MatchString(req.TenantID) {
+		return nil, quota, nil, nil, fmt.Errorf("%w: tenant_id must match %s", entity.ErrInvalidData, tenantIDPattern)
+	}
+
+	// 기본 컬렉션에 요청 컬렉션을 합칩니다 (같은 이름이면 인덱스를 추가)
+	var order []string
+	requested := make(map[string][]dto.TenantIndexRequest)
+	for _, coll := range append(append([]dto.TenantCollectionRequest{}, uc.opts.DefaultCollections...), req.Collections...) {
+		if !tenantNamePattern.MatchString(coll.Name) {
+			return nil, quota, nil, nil, fmt.Errorf("%w: invalid collection name %q", entity.ErrInvalidData, coll.Name)
+		}
+		if _, ok := requested[coll.Name]; !ok {
+			order = append(order, coll.Name)
+		}
+		requested[coll.Name] = append(requested[coll.Name], coll.Indexes...)
+	}
+	if len(order) == 0 {
+		return nil, quota, nil, nil, fmt.Errorf("%w: at least one collection is required", entity.ErrInvalidData)
+	}
+
+	plans := make([]tenantCollectionPlan, 0, len(order))
+	for _, name := range order {
+		plan := tenantCollectionPlan{name: namespace + name}
+		seen := make(map[string]bool)
+		for _, index := range requested[name] {
+			indexPlan, err := tenantIndex(plan.name, index)
+			if err != nil {
+				return nil, quota, nil, nil, err
+			}
+			if seen[indexPlan.name] {
+				continue
+			}
+			seen[indexPlan.name] = true
+			plan.indexes = append(plan.indexes, indexPlan)
+		}
+		plans = append(plans, plan)
+	}
+
+	quota = uc.opts.DefaultQuota
+	if req.Quota != nil {
+		quota = entity.TenantQuota{MaxDocuments: req.Quota.MaxDocuments, MaxBytes: req.Quota.MaxBytes}
+	}
+	if quota.MaxDocuments < 0 || quota.MaxBytes < 0 {
+		return nil, quota, nil, nil, fmt.Errorf("%w: quota must not be negative", entity.ErrInvalidData)
+	}
+
+	keys := req.APIKeys
+	if len(keys) == 0 {
+		keys = []dto.TenantAPIKeyRequest{{Roles: uc.opts.DefaultAPIKeyRoles}}
+	}
+	for _, key := range keys {
+		if len(key.Roles) == 0 {
+			return nil, quota, nil, nil, fmt.Errorf("%w: api key roles are required", entity.ErrInvalidData)
+		}
+		for _, role := range key.Roles {
+			// 테넌트 키로 관리 API를 호출할 수 없도록 admin 역할은 발급하지 않습니다
+			if role != string(auth.RoleReader) && role != string(auth.RoleWriter) {
+				return nil, quota, nil, nil, fmt.Errorf("%w: api key role must be reader or writer, got %q", entity.ErrInvalidData, role)
+			}
+		}
+	}
+
+	var topics []string
+	if (req.CDCTopics == nil && uc.opts.CDCTopics) || (req.CDCTopics != nil && *req.CDCTopics) {
+		if uc.topics == nil || len(uc.opts.CDCBaseTopics) == 0 {
+			return nil, quota, nil, nil, fmt.Errorf("%w: cdc topics are not configured", entity.ErrInvalidData)
+		}
+		for _, base := range uc.opts.CDCBaseTopics {
+			topics = append(topics, req.TenantID+"."+base)
+		}
+	}
+
+	return plans, quota, keys, topics, nil
+}
+
+// tenantIndex는 인덱스 요청을 테넌트 컬렉션의 인덱스로 바꿉니다
+// 인덱스 이름은 PostgreSQL처럼 스키마 단위로 고유해야 하는 저장소를 위해 컬렉션 이름을 포함합니다
+func tenantIndex(collection string, req dto.TenantIndexRequest) (tenantIndexPlan, error) {
+	if len(req.Keys) == 0 {
+		return tenantIndexPlan{}, fmt.Errorf("%w: index on %s has no keys", entity.ErrInvalidData, collection)
+	}
+
+	fields := make([]string, 0, len(req.Keys))
+	keys := make(map[string]interface{}, len(req.Keys))
+	for field, order := range req.Keys {
+		if order != 1 && order != -1 {
+			return tenantIndexPlan{}, fmt.Errorf("%w: index key %s must be 1 or -1", entity.ErrInvalidData, field)
+		}
+		fields = append(fields, field)
+		keys[field] = order
+	}
+	sort.Strings(fields)
+
+	suffix := req.Name
+	if suffix == "" {
+		suffix = strings.Join(fields, "_")
+	} else if !tenantNamePattern.MatchString(suffix) {
+		return tenantIndexPlan{}, fmt.Errorf("%w: invalid index name %q", entity.ErrInvalidData, suffix)
+	}
+	name := sanitizeIndexName("idx_" + collection + "_" + suffix)
+
+	opts := &repository.IndexOptions{Name: name}
+	if req.Unique {
+		unique := true
+		opts.Unique = &unique
+	}
+	return tenantIndexPlan{
+		name:  name,
+		model: repository.IndexModel{Keys: keys, Options: opts},
+	}, nil
+}
+
+// sanitizeIndexName은 인덱스 이름에서 영문, 숫자, 밑줄 외의 문자를 밑줄로 바꿉니다
+func sanitizeIndexName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// createdOrExists는 리소스를 새로 만들었는지에 따른 단계 결과입니다
+func createdOrExists(existed bool) string {
+	if existed {
+		return dto.ProvisioningExists
+	}
+	return dto.ProvisioningCreated
+}
+
+// toTenantResponse는 테넌트 엔티티를 응답 DTO로 변환합니다 (키 해시는 제외)
+func toTenantResponse(tenant *entity.Tenant) *dto.TenantResponse {
+	resp := &dto.TenantResponse{
+		ID:           tenant.ID,
+		Namespace:    tenant.Namespace,
+		DatabaseType: tenant.DatabaseType,
+		Collections:  tenant.Collections,
+		Quota: dto.TenantQuotaRequest{
+			MaxDocuments: tenant.Quota.MaxDocuments,
+			MaxBytes:     tenant.Quota.MaxBytes,
+		},
+		APIKeys:   make([]dto.TenantAPIKeyResponse, 0, len(tenant.APIKeys)),
+		CDCTopics: tenant.CDCTopics,
+		CreatedAt: tenant.CreatedAt,
+		UpdatedAt: tenant.UpdatedAt,
+	}
+	for _, key := range tenant.APIKeys {
+		resp.APIKeys = append(resp.APIKeys, dto.TenantAPIKeyResponse{
+			ID:          key.ID,
+			Roles:       key.Roles,
+			Description: key.Description,
+			CreatedAt:   key.CreatedAt,
+		})
+	}
+	return resp
+}
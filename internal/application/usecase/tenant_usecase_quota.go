@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/quota"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tenantQuotaTTL은 테넌트 쿼터 조회 결과를 재사용하는 시간입니다
+// 쓰기마다 테넌트 저장소를 조회하지 않도록 캐시하며, 이 서버에서 프로비저닝한 테넌트는 즉시 반영됩니다
+const tenantQuotaTTL = time.Minute
+
+// tenantQuotaEntry는 캐시된 테넌트 쿼터 조회 결과입니다 (scope가 nil이면 없는 테넌트)
+type tenantQuotaEntry struct {
+	scope        *TenantQuotaScope
+	databaseType string
+	expiresAt    time.Time
+}
+
+// ResolveTenantQuota는 컬렉션이 속한 테넌트의 쿼터를 반환합니다 (TenantQuotaResolver 구현)
+// 컬렉션 이름의 첫 밑줄 앞부분을 테넌트 ID로 보고, 테넌트가 없거나 테넌트를 만든 데이터베이스가 아니면 nil입니다
+func (uc *TenantUseCase) ResolveTenantQuota(ctx context.Context, collection string) (*TenantQuotaScope, error) {
+	tenantID, _, ok := strings.Cut(collection, "_")
+	if !ok || !tenantIDPattern.MatchString(tenantID) {
+		return nil, nil
+	}
+
+	entry, err := uc.tenantQuota(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.scope == nil || entry.databaseType != string(middleware.GetDatabaseType(ctx)) {
+		return nil, nil
+	}
+	return entry.scope, nil
+}
+
+// GetTenantUsage는 테넌트의 저장 용량 사용량과 쿼터를 컬렉션별 사용량과 함께 반환합니다
+func (uc *TenantUseCase) GetTenantUsage(ctx context.Context, id string) (*dto.StorageUsageResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "TenantUseCase.GetTenantUsage")
+	defer span.End()
+
+	tracing.SetAttributes(ctx, attribute.String("tenant_id", id))
+
+	tenant, err := uc.tenants.FindByID(ctx, id)
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+
+	// 테넌트 컬렉션은 프로비저닝한 데이터베이스에 있으므로 요청의 데이터베이스 선택과 관계없이 그 저장소를 조회합니다
+	ctx = context.WithValue(ctx, middleware.DatabaseTypeContextKey, middleware.DatabaseType(tenant.DatabaseType))
+	return uc.documentUC.TenantStorageUsage(ctx, tenantQuotaScope(tenant))
+}
+
+// tenantQuota는 테넌트 쿼터를 캐시에서 찾고, 없거나 만료되었으면 저장소에서 다시 조회합니다
+func (uc *TenantUseCase) tenantQuota(ctx context.Context, tenantID string) (tenantQuotaEntry, error) {
+	now := time.Now()
+	uc.quotaMu.Lock()
+	entry, ok := uc.quotaCache[tenantID]
+	uc.quotaMu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	entry = tenantQuotaEntry{expiresAt: now.Add(tenantQuotaTTL)}
+	tenant, err := uc.tenants.FindByID(ctx, tenantID)
+	switch {
+	case err == nil:
+		entry.scope = tenantQuotaScope(tenant)
+		entry.databaseType = tenant.DatabaseType
+	case !errors.Is(err, entity.ErrDocumentNotFound):
+		return tenantQuotaEntry{}, err
+	}
+
+	uc.quotaMu.Lock()
+	uc.quotaCache[tenantID] = entry
+	uc.quotaMu.Unlock()
+	return entry, nil
+}
+
+// forgetTenantQuota는 캐시된 테넌트 쿼터 조회 결과를 지웁니다
+func (uc *TenantUseCase) forgetTenantQuota(tenantID string) {
+	uc.quotaMu.Lock()
+	delete(uc.quotaCache, tenantID)
+	uc.quotaMu.Unlock()
+}
+
+// tenantQuotaScope는 테넌트 엔티티를 쿼터 범위로 변환합니다
+func tenantQuotaScope(tenant *entity.Tenant) *TenantQuotaScope {
+	return &TenantQuotaScope{
+		TenantID:  tenant.ID,
+		Namespace: tenant.Namespace,
+		Limit: quota.Limit{
+			MaxDocuments: tenant.Quota.MaxDocuments,
+			MaxBytes:     tenant.Quota.MaxBytes,
+		},
+	}
+}
@@ -0,0 +1,341 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultWebhookDeliveryPageSize = 50
+	maxWebhookDeliveryPageSize     = 500
+	webhookSecretBytes             = 32
+)
+
+// WebhookSubscriptionCache는 구독 변경 시 비울 구독 캐시입니다 (webhook.Publisher가 구현)
+type WebhookSubscriptionCache interface {
+	Invalidate()
+}
+
+// WebhookUseCase는 웹훅 구독 관리와 전송 로그 조회 유즈케이스입니다
+type WebhookUseCase struct {
+	subs       repository.WebhookSubscriptionRepository
+	deliveries repository.WebhookDeliveryRepository
+	cache      WebhookSubscriptionCache
+	allowHTTP  bool
+}
+
+// NewWebhookUseCase는 새로운 WebhookUseCase를 생성합니다
+// allowHTTP가 false이면 HTTPS 콜백 URL만 허용합니다
+func NewWebhookUseCase(subs repository.WebhookSubscriptionRepository, deliveries repository.WebhookDeliveryRepository, cache WebhookSubscriptionCache, allowHTTP bool) *WebhookUseCase {
+	return &WebhookUseCase{
+		subs:       subs,
+		deliveries: deliveries,
+		cache:      cache,
+		allowHTTP:  allowHTTP,
+	}
+}
+
+// CreateSubscription은 웹훅 구독을 생성합니다 (서명 키를 지정하지 않으면 생성해 응답에 포함)
+func (uc *WebhookUseCase) CreateSubscription(ctx context.Context, req *dto.CreateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "WebhookUseCase.CreateSubscription")
+	defer span.End()
+
+	now := time.Now()
+	sub := &entity.WebhookSubscription{
+		URL:         req.URL,
+		Collection:  req.Collection,
+		EventTypes:  req.EventTypes,
+		Filter:      req.Filter,
+		Secret:      req.Secret,
+		Description: req.Description,
+		Active:      req.Active == nil || *req.Active,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if sub.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		sub.Secret = secret
+	}
+	if err := uc.validate(sub); err != nil {
+		return nil, err
+	}
+
+	if err := uc.subs.Create(ctx, sub); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	uc.invalidate()
+
+	logger.Info(ctx, "webhook subscription created",
+		zap.String("subscription_id", sub.ID),
+		logger.Collection(sub.Collection),
+	)
+	resp := toWebhookSubscriptionResponse(sub)
+	resp.Secret = sub.Secret
+	return &resp, nil
+}
+
+// ListSubscriptions는 웹훅 구독 목록을 조회합니다
+func (uc *WebhookUseCase) ListSubscriptions(ctx context.Context) (*dto.WebhookSubscriptionListResponse, error) {
+	subs, err := uc.subs.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.WebhookSubscriptionListResponse{
+		Subscriptions: make([]dto.WebhookSubscriptionResponse, len(subs)),
+		TotalCount:    len(subs),
+	}
+	for i, sub := range subs {
+		resp.Subscriptions[i] = toWebhookSubscriptionResponse(sub)
+	}
+	return resp, nil
+}
+
+// GetSubscription은 웹훅 구독을 조회합니다
+func (uc *WebhookUseCase) GetSubscription(ctx context.Context, id string) (*dto.WebhookSubscriptionResponse, error) {
+	sub, err := uc.subs.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toWebhookSubscriptionResponse(sub)
+	return &resp, nil
+}
+
+// UpdateSubscription은 웹훅 구독의 지정한 필드를 변경합니다
+func (uc *WebhookUseCase) UpdateSubscription(ctx context.Context, id string, req *dto.UpdateWebhookSubscriptionRequest) (*dto.WebhookSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "WebhookUseCase.UpdateSubscription")
+	defer span.End()
+	tracing.SetAttributes(ctx, attribute.String("subscription_id", id))
+
+	sub, err := uc.subs.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		sub.URL = *req.URL
+	}
+	if req.Collection != nil {
+		sub.Collection = *req.Collection
+	}
+	if req.EventTypes != nil {
+		sub.EventTypes = *req.EventTypes
+	}
+	if req.Filter != nil {
+		sub.Filter = *req.Filter
+	}
+	if req.Description != nil {
+		sub.Description = *req.Description
+	}
+	if req.Active != nil {
+		sub.Active = *req.Active
+	}
+	if req.RotateSecret {
+		if sub.Secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.validate(sub); err != nil {
+		return nil, err
+	}
+	sub.UpdatedAt = time.Now()
+
+	if err := uc.subs.Update(ctx, sub); err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, err
+	}
+	uc.invalidate()
+
+	logger.Info(ctx, "webhook subscription updated",
+		zap.String("subscription_id", sub.ID),
+		zap.Bool("active", sub.Active),
+		zap.Bool("secret_rotated", req.RotateSecret),
+	)
+	resp := toWebhookSubscriptionResponse(sub)
+	if req.RotateSecret {
+		resp.Secret = sub.Secret
+	}
+	return &resp, nil
+}
+
+// DeleteSubscription은 웹훅 구독과 전송 로그를 삭제합니다
+func (uc *WebhookUseCase) DeleteSubscription(ctx context.Context, id string) error {
+	if err := uc.subs.Delete(ctx, id); err != nil {
+		return err
+	}
+	uc.invalidate()
+	logger.Info(ctx, "webhook subscription deleted", zap.String("subscription_id", id))
+	return nil
+}
+
+// ListDeliveries는 구독의 전송 로그를 최신 순으로 조회합니다
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, subscriptionID string, req *dto.WebhookDeliveryListRequest) (*dto.WebhookDeliveryListResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "WebhookUseCase.ListDeliveries")
+	defer span.End()
+
+	if _, err := uc.subs.FindByID(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	switch req.Status {
+	case "", entity.WebhookDeliveryPending, entity.WebhookDeliveryDelivered, entity.WebhookDeliveryFailed:
+	default:
+		return nil, fmt.Errorf("%w: status must be pending, delivered or failed", entity.ErrInvalidData)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultWebhookDeliveryPageSize
+	}
+	if pageSize > maxWebhookDeliveryPageSize {
+		pageSize = maxWebhookDeliveryPageSize
+	}
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	deliveries, total, err := uc.deliveries.List(ctx, &repository.WebhookDeliveryQuery{
+		SubscriptionID: subscriptionID,
+		Status:         req.Status,
+		Limit:          int64(pageSize),
+		Skip:           int64((page - 1) * pageSize),
+	})
+	if err != nil {
+		tracing.RecordError(ctx, err)
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	entries := make([]dto.WebhookDeliveryEntry, len(deliveries))
+	for i, d := range deliveries {
+		entries[i] = toWebhookDeliveryEntry(d)
+	}
+	return &dto.WebhookDeliveryListResponse{
+		Entries:    entries,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+// Redeliver는 구독의 전송 기록을 즉시 다시 보내도록 전송 큐에 되돌립니다 (시도 횟수 초기화)
+func (uc *WebhookUseCase) Redeliver(ctx context.Context, subscriptionID, deliveryID string) error {
+	delivery, err := uc.deliveries.FindByID(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	if delivery.SubscriptionID != subscriptionID {
+		return entity.ErrDocumentNotFound
+	}
+	if err := uc.deliveries.Requeue(ctx, deliveryID); err != nil {
+		return err
+	}
+	logger.Info(ctx, "webhook delivery requeued",
+		zap.String("subscription_id", subscriptionID),
+		zap.String("delivery_id", deliveryID),
+	)
+	return nil
+}
+
+// validate는 구독 설정을 검증합니다
+func (uc *WebhookUseCase) validate(sub *entity.WebhookSubscription) error {
+	u, err := url.Parse(sub.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute URL", entity.ErrInvalidData)
+	}
+	if u.Scheme != "https" && !(uc.allowHTTP && u.Scheme == "http") {
+		return fmt.Errorf("%w: url must use https", entity.ErrInvalidData)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: url must not contain credentials", entity.ErrInvalidData)
+	}
+	if sub.Collection == "" {
+		return fmt.Errorf("%w: collection is required", entity.ErrInvalidData)
+	}
+	if _, err := path.Match(sub.Collection, ""); err != nil {
+		return fmt.Errorf("%w: invalid collection pattern %q", entity.ErrInvalidData, sub.Collection)
+	}
+	for _, t := range sub.EventTypes {
+		switch t {
+		case messaging.EventDocumentCreated, messaging.EventDocumentUpdated, messaging.EventDocumentDeleted:
+		default:
+			return fmt.Errorf("%w: unsupported event type %q", entity.ErrInvalidData, t)
+		}
+	}
+	return nil
+}
+
+func (uc *WebhookUseCase) invalidate() {
+	if uc.cache != nil {
+		uc.cache.Invalidate()
+	}
+}
+
+// newWebhookSecret은 임의의 서명 키를 생성합니다
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func toWebhookSubscriptionResponse(sub *entity.WebhookSubscription) dto.WebhookSubscriptionResponse {
+	return dto.WebhookSubscriptionResponse{
+		ID:          sub.ID,
+		URL:         sub.URL,
+		Collection:  sub.Collection,
+		EventTypes:  sub.EventTypes,
+		Filter:      sub.Filter,
+		Description: sub.Description,
+		Active:      sub.Active,
+		CreatedAt:   sub.CreatedAt,
+		UpdatedAt:   sub.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryEntry(d *entity.WebhookDelivery) dto.WebhookDeliveryEntry {
+	entry := dto.WebhookDeliveryEntry{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Collection:     d.Collection,
+		DocumentID:     d.DocumentID,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+	}
+	if json.Valid([]byte(d.Payload)) {
+		entry.Payload = json.RawMessage(d.Payload)
+	}
+	if d.Status == entity.WebhookDeliveryPending {
+		nextAttemptAt := d.NextAttemptAt
+		entry.NextAttemptAt = &nextAttemptAt
+	}
+	if !d.DeliveredAt.IsZero() {
+		deliveredAt := d.DeliveredAt
+		entry.DeliveredAt = &deliveredAt
+	}
+	return entry
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"net/netip"
+	"path"
 	"strings"
 	"time"
 
@@ -10,18 +12,62 @@ import (
 
 // Config는 애플리케이션 전체 설정입니다
 type Config struct {
-	App           AppConfig           `mapstructure:"app"`
-	Server        ServerConfig        `mapstructure:"server"`
-	MongoDB       MongoDBConfig       `mapstructure:"mongodb"`
-	PostgreSQL    PostgreSQLConfig    `mapstructure:"postgresql"`
-	MySQL         MySQLConfig         `mapstructure:"mysql"`
-	Cassandra     CassandraConfig     `mapstructure:"cassandra"`
-	Elasticsearch ElasticsearchConfig `mapstructure:"elasticsearch"`
-	Vitess        VitessConfig        `mapstructure:"vitess"`
-	Redis         RedisConfig         `mapstructure:"redis"`
-	Kafka         KafkaConfig         `mapstructure:"kafka"`
-	Vault         VaultConfig         `mapstructure:"vault"`
-	Observability ObservabilityConfig `mapstructure:"observability"`
+	App              AppConfig              `mapstructure:"app"`
+	Server           ServerConfig           `mapstructure:"server"`
+	MongoDB          MongoDBConfig          `mapstructure:"mongodb"`
+	PostgreSQL       PostgreSQLConfig       `mapstructure:"postgresql"`
+	MySQL            MySQLConfig            `mapstructure:"mysql"`
+	Cassandra        CassandraConfig        `mapstructure:"cassandra"`
+	Elasticsearch    ElasticsearchConfig    `mapstructure:"elasticsearch"`
+	Vitess           VitessConfig           `mapstructure:"vitess"`
+	Redis            RedisConfig            `mapstructure:"redis"`
+	Kafka            KafkaConfig            `mapstructure:"kafka"`
+	NATS             NATSConfig             `mapstructure:"nats"`
+	RabbitMQ         RabbitMQConfig         `mapstructure:"rabbitmq"`
+	CDC              CDCConfig              `mapstructure:"cdc"`
+	Vault            VaultConfig            `mapstructure:"vault"`
+	Secrets          SecretsConfig          `mapstructure:"secrets"`
+	Auth             AuthConfig             `mapstructure:"auth"`
+	RateLimit        RateLimitConfig        `mapstructure:"rate_limit"`
+	Audit            AuditConfig            `mapstructure:"audit"`
+	IPFilter         IPFilterConfig         `mapstructure:"ip_filter"`
+	Encryption       EncryptionConfig       `mapstructure:"encryption"`
+	PII              PIIConfig              `mapstructure:"pii"`
+	SchemaValidation SchemaValidationConfig `mapstructure:"schema_validation"`
+	UniqueKeys       UniqueKeysConfig       `mapstructure:"unique_keys"`
+	References       ReferencesConfig       `mapstructure:"references"`
+	Cache            CacheConfig            `mapstructure:"cache"`
+	Replication      ReplicationConfig      `mapstructure:"replication"`
+	CDCBridge        CDCBridgeConfig        `mapstructure:"cdc_bridge"`
+	ReadRouting      ReadRoutingConfig      `mapstructure:"read_routing"`
+	BackendRouting   BackendRoutingConfig   `mapstructure:"backend_routing"`
+	BulkWrite        BulkWriteConfig        `mapstructure:"bulk_write"`
+	WriteBatching    WriteBatchingConfig    `mapstructure:"write_batching"`
+	Expiry           ExpiryConfig           `mapstructure:"expiry"`
+	SoftDelete       SoftDeleteConfig       `mapstructure:"soft_delete"`
+	Revisions        RevisionsConfig        `mapstructure:"revisions"`
+	Retention        RetentionConfig        `mapstructure:"retention"`
+	Archive          ArchiveConfig          `mapstructure:"archive"`
+	Sharding         ShardingConfig         `mapstructure:"sharding"`
+	CircuitBreaker   CircuitBreakerConfig   `mapstructure:"circuit_breaker"`
+	Retry            RetryConfig            `mapstructure:"retry"`
+	Timeouts         TimeoutsConfig         `mapstructure:"timeouts"`
+	LoadShedding     LoadSheddingConfig     `mapstructure:"load_shedding"`
+	PoolHealth       PoolHealthConfig       `mapstructure:"pool_health"`
+	Backup           BackupConfig           `mapstructure:"backup"`
+	Maintenance      MaintenanceConfig      `mapstructure:"maintenance"`
+	Duplicates       DuplicatesConfig       `mapstructure:"duplicates"`
+	Anonymization    AnonymizationConfig    `mapstructure:"anonymization"`
+	SearchMirror     SearchMirrorConfig     `mapstructure:"search_mirror"`
+	Fixtures         FixturesConfig         `mapstructure:"fixtures"`
+	OnlineMigration  OnlineMigrationConfig  `mapstructure:"online_migration"`
+	SchemaMigrations SchemaMigrationsConfig `mapstructure:"schema_migrations"`
+	Tenants          TenantsConfig          `mapstructure:"tenants"`
+	Quotas           QuotasConfig           `mapstructure:"quotas"`
+	Reload           ReloadConfig           `mapstructure:"reload"`
+	FeatureFlags     FeatureFlagsConfig     `mapstructure:"feature_flags"`
+	LeaderElection   LeaderElectionConfig   `mapstructure:"leader_election"`
+	Observability    ObservabilityConfig    `mapstructure:"observability"`
 }
 
 // AppConfig는 애플리케이션 기본 설정입니다
@@ -36,6 +82,21 @@ type AppConfig struct {
 type ServerConfig struct {
 	HTTP HTTPServerConfig `mapstructure:"http"`
 	GRPC GRPCServerConfig `mapstructure:"grpc"`
+	TLS  ServerTLSConfig  `mapstructure:"tls"`
+}
+
+// ServerTLSConfig는 HTTP/gRPC 서버 TLS 설정입니다
+// UseVault가 true이면 vault.paths.pki로 인증서를 발급받고 만료 전에 자동 교체합니다
+type ServerTLSConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	CertFile    string        `mapstructure:"cert_file"`
+	KeyFile     string        `mapstructure:"key_file"`
+	UseVault    bool          `mapstructure:"use_vault"`
+	CommonName  string        `mapstructure:"common_name"`
+	AltNames    []string      `mapstructure:"alt_names"`
+	IPSANs      []string      `mapstructure:"ip_sans"`
+	TTL         time.Duration `mapstructure:"ttl"`
+	RenewBefore time.Duration `mapstructure:"renew_before"`
 }
 
 // HTTPServerConfig는 HTTP 서버 설정입니다
@@ -48,6 +109,7 @@ type HTTPServerConfig struct {
 	MaxRequestSize    int64         `mapstructure:"max_request_size"`
 	EnableCORS        bool          `mapstructure:"enable_cors"`
 	AllowedOrigins    []string      `mapstructure:"allowed_origins"`
+	TrustedProxies    []string      `mapstructure:"trusted_proxies"` // X-Forwarded-For를 믿을 프록시 IP/CIDR (비어 있으면 연결 주소 사용)
 }
 
 // GRPCServerConfig는 gRPC 서버 설정입니다
@@ -75,6 +137,38 @@ type MongoDBConfig struct {
 	Timeout         time.Duration `mapstructure:"timeout"`
 	UseVault        bool          `mapstructure:"use_vault"`
 	VaultPath       string        `mapstructure:"vault_path"`
+
+	// ReadReplica는 복제본 읽기용 클라이언트 설정입니다 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+	ReadReplica MongoDBReadReplicaConfig `mapstructure:"read_replica"`
+}
+
+// ShardingConfig는 해시 기반 샤딩 설정입니다
+// 활성화하면 database_type의 문서를 shards에 나열한 인스턴스로 나눠 저장합니다 (현재 mongodb 지원)
+// 라우팅은 샤드 순서에 따라 결정되므로 샤드를 추가할 때는 목록 끝에만 추가해야 합니다
+type ShardingConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	DatabaseType string        `mapstructure:"database_type"` // mongodb
+	ShardKey     string        `mapstructure:"shard_key"`     // 라우팅할 문서 데이터 필드 (비어 있으면 문서 ID)
+	Shards       []ShardConfig `mapstructure:"shards"`
+}
+
+// ShardConfig는 샤드 인스턴스 하나의 접속 설정입니다
+type ShardConfig struct {
+	Name     string `mapstructure:"name"`
+	URI      string `mapstructure:"uri"`
+	Database string `mapstructure:"database"` // 비어 있으면 mongodb.database
+}
+
+// MongoDBReadReplicaConfig는 MongoDB 복제본 읽기 설정입니다
+// 쓰기와 별도 연결 풀을 사용하며 ReadPreference로 secondary를 선택합니다
+type MongoDBReadReplicaConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	URI            string        `mapstructure:"uri"`             // 비어 있으면 mongodb.uri 사용 (분석용 노드 등 별도 접속 시 지정)
+	ReadPreference string        `mapstructure:"read_preference"` // secondaryPreferred(기본), secondary, nearest, primaryPreferred
+	MaxStaleness   time.Duration `mapstructure:"max_staleness"`   // 이보다 지연된 secondary는 제외 (0이면 제한 없음, 최소 90초)
+	ReadConcern    string        `mapstructure:"read_concern"`    // local, available, majority, linearizable (비어 있으면 서버 기본값)
+	HedgedReads    bool          `mapstructure:"hedged_reads"`    // 샤드 클러스터에서 두 멤버에 동시 읽기 후 빠른 응답 사용 (지연 민감 조회용, MongoDB 4.4+)
+	MaxPoolSize    uint64        `mapstructure:"max_pool_size"`
 }
 
 // PostgreSQLConfig는 PostgreSQL 설정입니다
@@ -92,6 +186,47 @@ type PostgreSQLConfig struct {
 	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
 	UseVault        bool          `mapstructure:"use_vault"`
 	VaultPath       string        `mapstructure:"vault_path"`
+	SecretName      string        `mapstructure:"secret_name"` // secrets.provider에서 읽을 자격증명 시크릿 (username, password 필드)
+
+	// StatementCacheSize는 연결당 prepared statement 캐시 크기입니다 (0이면 128, 음수면 비활성화)
+	StatementCacheSize int `mapstructure:"statement_cache_size"`
+
+	// CopyThreshold는 SaveMany가 COPY FROM으로 적재하는 최소 문서 수입니다 (0이면 100, 음수면 COPY 사용 안 함)
+	CopyThreshold int `mapstructure:"copy_threshold"`
+
+	// ReadReplica는 읽기 전용 복제본 접속 설정입니다 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+	ReadReplica SQLReadReplicaConfig `mapstructure:"read_replica"`
+
+	// Partitioning은 컬렉션 테이블의 선언적 파티션 설정입니다
+	Partitioning PostgreSQLPartitioningConfig `mapstructure:"partitioning"`
+}
+
+// PostgreSQLPartitioningConfig는 컬렉션 테이블 파티션 설정입니다
+// 규칙은 새로 만드는 컬렉션에만 적용되며 기존 일반 테이블은 바뀌지 않습니다
+type PostgreSQLPartitioningConfig struct {
+	Rules []PostgreSQLPartitionRule `mapstructure:"rules"`
+
+	// MaintenanceInterval은 범위 파티션을 미리 만들어 두는 주기입니다 (0이면 1h)
+	MaintenanceInterval time.Duration `mapstructure:"maintenance_interval"`
+}
+
+// PostgreSQLPartitionRule은 컬렉션별 파티션 규칙입니다
+// range는 created_at 범위로, hash는 id 해시로 파티션을 나눕니다
+type PostgreSQLPartitionRule struct {
+	Collection string `mapstructure:"collection"` // 컬렉션 이름 또는 패턴 (예: events_*)
+	Strategy   string `mapstructure:"strategy"`   // range, hash
+	Interval   string `mapstructure:"interval"`   // range: day, week, month (기본값: month)
+	Premake    int    `mapstructure:"premake"`    // range: 미리 만들 이후 기간 파티션 수 (기본값: 3)
+	Modulus    int    `mapstructure:"modulus"`    // hash: 파티션 수
+}
+
+// SQLReadReplicaConfig는 PostgreSQL/MySQL 읽기 전용 복제본 설정입니다
+// 계정, 데이터베이스, 연결 풀 설정은 주 서버 설정을 그대로 사용합니다
+// 복제본이 여러 대이면 로드밸런서나 프록시 주소를 지정합니다
+type SQLReadReplicaConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Host    string `mapstructure:"host"`
+	Port    int    `mapstructure:"port"` // 0이면 주 서버 포트 사용
 }
 
 // MySQLConfig는 MySQL 설정입니다
@@ -110,6 +245,22 @@ type MySQLConfig struct {
 	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
 	UseVault        bool          `mapstructure:"use_vault"`
 	VaultPath       string        `mapstructure:"vault_path"`
+	SecretName      string        `mapstructure:"secret_name"` // secrets.provider에서 읽을 자격증명 시크릿 (username, password 필드)
+
+	// StatementCacheSize는 연결당 prepared statement 캐시 크기입니다 (0이면 128, 음수면 비활성화)
+	StatementCacheSize int `mapstructure:"statement_cache_size"`
+
+	// InsertBatchSize는 SaveMany가 다중 행 INSERT 한 번에 담는 문서 수입니다 (0이면 500)
+	InsertBatchSize int `mapstructure:"insert_batch_size"`
+
+	// OnDuplicate는 SaveMany에서 이미 있는 ID의 처리 방식입니다: error(기본), ignore, update
+	OnDuplicate string `mapstructure:"on_duplicate"`
+
+	// ChangeCapture는 컬렉션 테이블에 변경 로그 트리거를 생성합니다 (MySQL 변경 구독/CDC 브리지용)
+	ChangeCapture bool `mapstructure:"change_capture"`
+
+	// ReadReplica는 읽기 전용 복제본 접속 설정입니다 (read_routing에서 replica 모드인 컬렉션의 조회에 사용)
+	ReadReplica SQLReadReplicaConfig `mapstructure:"read_replica"`
 }
 
 // CassandraConfig는 Cassandra 설정입니다
@@ -123,6 +274,12 @@ type CassandraConfig struct {
 	Consistency string   `mapstructure:"consistency"`
 	NumConns    int      `mapstructure:"num_conns"`
 	Timeout     time.Duration `mapstructure:"timeout"`
+	ConnectTimeout    time.Duration `mapstructure:"connect_timeout"`    // 0이면 10초
+	MaxRetries        int           `mapstructure:"max_retries"`        // 0이면 3회
+	ReconnectInterval time.Duration `mapstructure:"reconnect_interval"` // 끊긴 호스트 재연결 주기 (0이면 10초)
+	LocalDC           string        `mapstructure:"local_dc"`           // 토큰 인식 라우팅에서 우선할 데이터센터 (비어 있으면 전체 라운드로빈)
+	BatchSize         int           `mapstructure:"batch_size"`         // SaveMany 파티션별 배치의 최대 문장 수 (0이면 50)
+	BatchConcurrency  int           `mapstructure:"batch_concurrency"`  // SaveMany 동시 실행 배치 수 (0이면 16)
 	UseVault    bool     `mapstructure:"use_vault"`
 	VaultPath   string   `mapstructure:"vault_path"`
 }
@@ -138,6 +295,17 @@ type ElasticsearchConfig struct {
 	MaxRetries int     `mapstructure:"max_retries"`
 	UseVault  bool     `mapstructure:"use_vault"`
 	VaultPath string   `mapstructure:"vault_path"`
+
+	// Bulk는 SaveMany/BulkWrite의 BulkIndexer 설정입니다
+	Bulk ElasticsearchBulkConfig `mapstructure:"bulk"`
+}
+
+// ElasticsearchBulkConfig는 Elasticsearch BulkIndexer 설정입니다
+type ElasticsearchBulkConfig struct {
+	Workers       int           `mapstructure:"workers"`        // 동시 전송 워커 수 (0이면 CPU 수)
+	FlushBytes    int           `mapstructure:"flush_bytes"`    // 버퍼가 이 크기를 넘으면 전송 (0이면 5MB)
+	FlushInterval time.Duration `mapstructure:"flush_interval"` // 마지막 전송 후 이 시간이 지나면 전송 (0이면 30초)
+	Refresh       string        `mapstructure:"refresh"`        // true(기본), wait_for, false
 }
 
 // VitessConfig는 Vitess 설정입니다
@@ -158,21 +326,38 @@ type VitessConfig struct {
 
 // RedisConfig는 Redis 설정입니다
 type RedisConfig struct {
-	Enabled         bool          `mapstructure:"enabled"`
-	Host            string        `mapstructure:"host"`
-	Port            int           `mapstructure:"port"`
-	Password        string        `mapstructure:"password"`
-	DB              int           `mapstructure:"db"`
-	MaxRetries      int           `mapstructure:"max_retries"`
-	PoolSize        int           `mapstructure:"pool_size"`
-	MinIdleConns    int           `mapstructure:"min_idle_conns"`
-	DialTimeout     time.Duration `mapstructure:"dial_timeout"`
-	ReadTimeout     time.Duration `mapstructure:"read_timeout"`
-	WriteTimeout    time.Duration `mapstructure:"write_timeout"`
-	UseVault        bool          `mapstructure:"use_vault"`
-	VaultPath       string        `mapstructure:"vault_path"`
-	EnablePubSub    bool          `mapstructure:"enable_pubsub"`
-	PubSubChannels  []string      `mapstructure:"pubsub_channels"`
+	Enabled          bool          `mapstructure:"enabled"`
+	Mode             string        `mapstructure:"mode"` // standalone, sentinel, cluster
+	Host             string        `mapstructure:"host"`
+	Port             int           `mapstructure:"port"`
+	Addresses        []string      `mapstructure:"addresses"`   // sentinel 주소 또는 cluster 시드 노드
+	MasterName       string        `mapstructure:"master_name"` // sentinel 마스터 이름
+	SentinelPassword string        `mapstructure:"sentinel_password"`
+	Password         string        `mapstructure:"password"`
+	DB               int           `mapstructure:"db"`
+	MaxRetries       int           `mapstructure:"max_retries"`
+	PoolSize         int           `mapstructure:"pool_size"`
+	MinIdleConns     int           `mapstructure:"min_idle_conns"`
+	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
+	ReadTimeout      time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout     time.Duration `mapstructure:"write_timeout"`
+	PoolTimeout      time.Duration `mapstructure:"pool_timeout"`       // 모든 연결이 사용 중일 때 대기 한도 (0이면 read_timeout + 1초)
+	ConnMaxIdleTime  time.Duration `mapstructure:"conn_max_idle_time"` // 0이면 30분
+	ConnMaxLifetime  time.Duration `mapstructure:"conn_max_lifetime"`  // 0이면 제한 없음
+	UseVault         bool          `mapstructure:"use_vault"`
+	VaultPath        string        `mapstructure:"vault_path"`
+	EnablePubSub     bool          `mapstructure:"enable_pubsub"`
+	PubSubChannels   []string      `mapstructure:"pubsub_channels"`
+	Streams          RedisStreamsConfig `mapstructure:"streams"`
+}
+
+// RedisStreamsConfig는 Redis Streams CDC 발행 설정입니다
+// Enabled가 true이면 CDC 이벤트를 컬렉션별 스트림(<key_prefix>:<collection>)에 XADD로 발행합니다
+type RedisStreamsConfig struct {
+	Enabled         bool   `mapstructure:"enabled"`
+	KeyPrefix       string `mapstructure:"key_prefix"`
+	MaxLen          int64  `mapstructure:"max_len"`          // 스트림별 최대 항목 수 (0이면 무제한)
+	ApproximateTrim bool   `mapstructure:"approximate_trim"` // MAXLEN ~ 근사 자르기
 }
 
 // KafkaConfig는 Kafka 설정입니다
@@ -185,17 +370,66 @@ type KafkaConfig struct {
 	Consumer        KafkaConsumerConfig `mapstructure:"consumer"`
 	EnableCDC       bool     `mapstructure:"enable_cdc"`
 	CDCTopics       KafkaCDCTopics `mapstructure:"cdc_topics"`
+	CDCRouting      []KafkaCDCRoute `mapstructure:"cdc_routing"`
+	Security        KafkaSecurityConfig `mapstructure:"security"`
+	Avro            KafkaAvroConfig `mapstructure:"avro"`
+}
+
+// KafkaAvroConfig는 CDC 이벤트 Avro 직렬화 설정입니다
+// 스키마는 Schema Registry의 <topic>-value subject에 등록되며, 레지스트리의 호환성 규칙으로 진화를 검사합니다
+type KafkaAvroConfig struct {
+	Enabled        bool                 `mapstructure:"enabled"`
+	Topics         []string             `mapstructure:"topics"` // 비어 있으면 모든 CDC 토픽
+	SchemaRegistry SchemaRegistryConfig `mapstructure:"schema_registry"`
+}
+
+// SchemaRegistryConfig는 Confluent Schema Registry 연결 설정입니다
+type SchemaRegistryConfig struct {
+	URL      string        `mapstructure:"url"`
+	Username string        `mapstructure:"username"`
+	Password string        `mapstructure:"password"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// KafkaSecurityConfig는 Kafka 브로커 인증/암호화 설정입니다
+type KafkaSecurityConfig struct {
+	SASL KafkaSASLConfig `mapstructure:"sasl"`
+	TLS  KafkaTLSConfig  `mapstructure:"tls"`
+}
+
+// KafkaSASLConfig는 Kafka SASL 설정입니다
+// UseVault가 true이면 vault.paths.kafka에서 자격증명을 가져오고 자동 갱신합니다
+type KafkaSASLConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	Mechanism string `mapstructure:"mechanism"` // PLAIN, SCRAM-SHA-256, SCRAM-SHA-512
+	Username  string `mapstructure:"username"`
+	Password  string `mapstructure:"password"`
+	UseVault  bool   `mapstructure:"use_vault"`
+}
+
+// KafkaTLSConfig는 Kafka TLS 설정입니다
+type KafkaTLSConfig struct {
+	Enabled            bool   `mapstructure:"enabled"`
+	CAFile             string `mapstructure:"ca_file"`
+	CertFile           string `mapstructure:"cert_file"`
+	KeyFile            string `mapstructure:"key_file"`
+	InsecureSkipVerify bool   `mapstructure:"insecure_skip_verify"`
 }
 
 // KafkaProducerConfig는 Kafka Producer 설정입니다
 type KafkaProducerConfig struct {
-	MaxMessageBytes   int           `mapstructure:"max_message_bytes"`
-	RequiredAcks      int16         `mapstructure:"required_acks"`
-	Timeout           time.Duration `mapstructure:"timeout"`
-	Compression       string        `mapstructure:"compression"`
-	MaxRetries        int           `mapstructure:"max_retries"`
-	RetryBackoff      time.Duration `mapstructure:"retry_backoff"`
-	EnableIdempotent  bool          `mapstructure:"enable_idempotent"`
+	MaxMessageBytes  int           `mapstructure:"max_message_bytes"`
+	RequiredAcks     int16         `mapstructure:"required_acks"`
+	Timeout          time.Duration `mapstructure:"timeout"`
+	Compression      string        `mapstructure:"compression"`       // none, gzip, snappy, lz4, zstd
+	CompressionLevel int           `mapstructure:"compression_level"` // 0이면 코덱 기본 레벨
+	MaxRetries       int           `mapstructure:"max_retries"`
+	RetryBackoff     time.Duration `mapstructure:"retry_backoff"`
+	EnableIdempotent bool          `mapstructure:"enable_idempotent"`
+	Linger           time.Duration `mapstructure:"linger"`         // 배치를 채우기 위해 메시지를 모으는 최대 시간
+	BatchBytes       int           `mapstructure:"batch_bytes"`    // 이 크기만큼 모이면 linger 전에 전송
+	BatchMessages    int           `mapstructure:"batch_messages"` // 이 개수만큼 모이면 linger 전에 전송
+	Async            bool          `mapstructure:"async"`          // 발행 결과를 기다리지 않음 (실패는 로그와 메트릭으로만 보고)
 }
 
 // KafkaConsumerConfig는 Kafka Consumer 설정입니다
@@ -216,6 +450,111 @@ type KafkaCDCTopics struct {
 	DocumentDeleted string `mapstructure:"document_deleted"`
 }
 
+// KafkaCDCRoute는 컬렉션별 CDC 토픽 라우팅 규칙입니다
+// 규칙은 순서대로 검사해 처음 일치한 규칙을 사용하며, 일치하는 규칙이 없으면 cdc_topics로 발행합니다
+type KafkaCDCRoute struct {
+	Collection string         `mapstructure:"collection"` // 컬렉션 이름 또는 와일드카드 패턴 (*, ?, [...])
+	Topic      string         `mapstructure:"topic"`      // 모든 이벤트 타입에 사용할 토픽
+	Topics     KafkaCDCTopics `mapstructure:"topics"`     // 이벤트 타입별 토픽 (topic보다 우선)
+	Disabled   bool           `mapstructure:"disabled"`   // 일치하는 컬렉션의 CDC 이벤트를 발행하지 않음
+}
+
+// NATSConfig는 NATS JetStream CDC 발행 설정입니다
+// Enabled가 true이면 CDC 이벤트를 Kafka 대신 JetStream 스트림으로 발행합니다
+// 주제는 <subject_prefix>.<collection>.<created|updated|deleted>이며, 이벤트 ID(Nats-Msg-Id)로 중복 발행을 제거합니다
+type NATSConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Servers         []string      `mapstructure:"servers"`
+	Username        string        `mapstructure:"username"`
+	Password        string        `mapstructure:"password"`
+	Token           string        `mapstructure:"token"`
+	Stream          string        `mapstructure:"stream"`
+	SubjectPrefix   string        `mapstructure:"subject_prefix"`
+	DuplicateWindow time.Duration `mapstructure:"duplicate_window"`
+	Timeout         time.Duration `mapstructure:"timeout"`
+}
+
+// RabbitMQConfig는 RabbitMQ CDC 발행 설정입니다 (Kafka가 없는 환경용)
+// Enabled가 true이면 CDC 이벤트를 이벤트 타입별 교환기로 발행하며, 라우팅 키는 컬렉션 이름입니다
+type RabbitMQConfig struct {
+	Enabled      bool              `mapstructure:"enabled"`
+	URL          string            `mapstructure:"url"`
+	ExchangeType string            `mapstructure:"exchange_type"` // topic(기본), direct, fanout
+	Exchanges    RabbitMQExchanges `mapstructure:"exchanges"`
+	Heartbeat    time.Duration     `mapstructure:"heartbeat"`
+	Timeout      time.Duration     `mapstructure:"timeout"`
+}
+
+// RabbitMQExchanges는 이벤트 타입별 교환기 이름입니다
+type RabbitMQExchanges struct {
+	DocumentCreated string `mapstructure:"document_created"`
+	DocumentUpdated string `mapstructure:"document_updated"`
+	DocumentDeleted string `mapstructure:"document_deleted"`
+}
+
+// CDCConfig는 CDC 메시지 공통 설정입니다 (Kafka, NATS, RabbitMQ, Redis Streams 모두 적용)
+type CDCConfig struct {
+	// Format은 메시지 형식입니다: native(기본, 이벤트 JSON), cloudevents(CloudEvents 1.0 구조화 JSON),
+	// protobuf(proto/cdc_events.proto의 database.cdc.v1.DocumentEvent), debezium(Debezium 변경 이벤트 봉투)
+	Format string `mapstructure:"format"`
+	// Source는 CloudEvents source 속성입니다 (기본 /database-service)
+	Source string `mapstructure:"source"`
+	// ServerName은 Debezium source.name(논리 서버 이름)입니다 (기본 database-service)
+	ServerName string `mapstructure:"server_name"`
+	// DeadLetter는 발행 실패 이벤트 DLQ 설정입니다
+	DeadLetter CDCDeadLetterConfig `mapstructure:"dead_letter"`
+	// Replay는 Kafka CDC 토픽 재생 API 설정입니다
+	Replay CDCReplayConfig `mapstructure:"replay"`
+	// Transforms는 발행 전에 적용할 컬렉션별 필드 변환 규칙입니다 (일치하는 규칙 모두 순서대로 적용)
+	Transforms []CDCTransformConfig `mapstructure:"transforms"`
+	// Webhooks는 문서 변경 웹훅 구독 설정입니다
+	Webhooks CDCWebhookConfig `mapstructure:"webhooks"`
+}
+
+// CDCWebhookConfig는 문서 변경 웹훅 설정입니다
+// 구독은 MongoDB _webhook_subscriptions, 전송 로그(재시도 큐)는 _webhook_deliveries 컬렉션에 보관합니다
+type CDCWebhookConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Workers         int           `mapstructure:"workers"`          // 인스턴스당 전송 워커 수 (기본 4)
+	Timeout         time.Duration `mapstructure:"timeout"`          // 콜백 요청 타임아웃 (기본 10s)
+	MaxAttempts     int           `mapstructure:"max_attempts"`     // 실패로 표시하기 전 최대 시도 횟수 (기본 8)
+	Backoff         time.Duration `mapstructure:"backoff"`          // 첫 재시도 대기 시간, 시도마다 두 배 (기본 10s)
+	MaxBackoff      time.Duration `mapstructure:"max_backoff"`      // 재시도 대기 시간 상한 (기본 1h)
+	Retention       time.Duration `mapstructure:"retention"`        // 전송 로그 보관 기간 (0이면 영구 보관)
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"` // 구독 목록 캐시 갱신 주기 (기본 30s)
+	AllowHTTP       bool          `mapstructure:"allow_http"`       // 개발용: HTTPS가 아닌 콜백 URL 허용
+}
+
+// CDCDeadLetterConfig는 발행 실패 CDC 이벤트 DLQ 설정입니다
+// 재시도 후에도 실패한 이벤트는 MongoDB _cdc_dead_letters 컬렉션에 보관되며 관리 API로 재발행합니다
+type CDCDeadLetterConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	MaxAttempts int           `mapstructure:"max_attempts"` // 최초 시도 포함 (기본 3)
+	Backoff     time.Duration `mapstructure:"backoff"`      // 첫 재시도 대기 시간, 시도마다 두 배 (기본 200ms)
+}
+
+// CDCTransformConfig는 CDC 이벤트 필드 변환 규칙입니다
+// 민감한 필드를 제거하거나 이름을 바꿔 이벤트 버스로 나가지 않게 합니다 (DLQ에도 변환된 이벤트가 보관됨)
+type CDCTransformConfig struct {
+	Collection  string           `mapstructure:"collection"`   // 컬렉션 이름 또는 와일드카드 패턴 (*, ?, [...])
+	Drop        []string         `mapstructure:"drop"`         // 제거할 필드 (점 표기로 중첩 필드)
+	Rename      []CDCFieldRename `mapstructure:"rename"`       // 필드 이름 변경
+	ChangedOnly bool             `mapstructure:"changed_only"` // 업데이트 이벤트의 data를 변경된 필드로만 제한
+}
+
+// CDCFieldRename은 CDC 이벤트 필드 이름 변경 규칙입니다
+type CDCFieldRename struct {
+	From string `mapstructure:"from"` // 원래 필드 경로 (점 표기로 중첩 필드)
+	To   string `mapstructure:"to"`   // 같은 위치의 새 필드 이름
+}
+
+// CDCReplayConfig는 CDC 이벤트 재생 설정입니다
+// Enabled이면 Kafka CDC 토픽에 남아 있는 이벤트를 컬렉션 단위로 다시 발행하는 관리 API를 노출합니다
+type CDCReplayConfig struct {
+	Enabled   bool `mapstructure:"enabled"`
+	MaxEvents int  `mapstructure:"max_events"` // 요청당 최대 재생 이벤트 수 (기본 10000)
+}
+
 // VaultConfig는 Vault 설정입니다
 type VaultConfig struct {
 	Enabled           bool          `mapstructure:"enabled"`
@@ -252,6 +591,8 @@ type VaultPaths struct {
 	Redis         string `mapstructure:"redis"`
 	Secrets       string `mapstructure:"secrets"`
 	Transit       string `mapstructure:"transit"`
+	Kafka         string `mapstructure:"kafka"`
+	PKI           string `mapstructure:"pki"`
 }
 
 // VaultRenewal는 Vault 갱신 설정입니다
@@ -268,11 +609,762 @@ type VaultCache struct {
 	TTL     time.Duration `mapstructure:"ttl"`
 }
 
+// SecretsConfig는 Vault 대신 데이터베이스 자격증명을 가져올 시크릿 매니저 설정입니다
+// postgresql.secret_name, mysql.secret_name을 지정한 백엔드는 이 공급자에서 username/password를 읽고
+// refresh_interval마다 다시 읽어 교체(rotation)되면 새 연결부터 새 자격증명을 사용합니다
+type SecretsConfig struct {
+	Provider        string           `mapstructure:"provider"`         // aws, gcp, vault(KV) (비어 있으면 사용 안 함)
+	RefreshInterval time.Duration    `mapstructure:"refresh_interval"` // 교체 확인 주기 (기본 5m)
+	AWS             SecretsAWSConfig `mapstructure:"aws"`
+	GCP             SecretsGCPConfig `mapstructure:"gcp"`
+}
+
+// SecretsAWSConfig는 AWS Secrets Manager 설정입니다
+// 키를 비워 두면 AWS_* 환경변수, IRSA(웹 ID 토큰), 컨테이너 자격증명(ECS, EKS Pod Identity) 순으로 사용합니다
+type SecretsAWSConfig struct {
+	Region          string `mapstructure:"region"`
+	Endpoint        string `mapstructure:"endpoint"` // VPC 엔드포인트, LocalStack 등 (비어 있으면 리전 기본 엔드포인트)
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	SessionToken    string `mapstructure:"session_token"`
+}
+
+// SecretsGCPConfig는 GCP Secret Manager 설정입니다
+// 키 파일을 비워 두면 GOOGLE_APPLICATION_CREDENTIALS, 없으면 메타데이터 서버(GKE Workload Identity, GCE)를 사용합니다
+type SecretsGCPConfig struct {
+	Project         string `mapstructure:"project"`
+	Endpoint        string `mapstructure:"endpoint"`
+	CredentialsFile string `mapstructure:"credentials_file"`
+}
+
+// AuthConfig는 인증/인가 설정입니다
+type AuthConfig struct {
+	Enabled       bool                `mapstructure:"enabled"`
+	OIDC          OIDCConfig          `mapstructure:"oidc"`
+	RowPolicies   []RowPolicyConfig   `mapstructure:"row_policies"`
+	HMAC          HMACConfig          `mapstructure:"hmac"`
+	Impersonation ImpersonationConfig `mapstructure:"impersonation"`
+	Lockout       LockoutConfig       `mapstructure:"lockout"`
+}
+
+// OIDCConfig는 OIDC 토큰 검증 설정입니다 (Keycloak, Auth0, Entra ID 등)
+type OIDCConfig struct {
+	IssuerURL           string            `mapstructure:"issuer_url"`
+	Audiences           []string          `mapstructure:"audiences"`
+	RolesClaims         []string          `mapstructure:"roles_claims"`
+	UsernameClaim       string            `mapstructure:"username_claim"`
+	TenantClaim         string            `mapstructure:"tenant_claim"`
+	RoleMappings        []OIDCRoleMapping `mapstructure:"role_mappings"`
+	DefaultRoles        []string          `mapstructure:"default_roles"`
+	AllowedAlgorithms   []string          `mapstructure:"allowed_algorithms"`
+	JWKSRefreshInterval time.Duration     `mapstructure:"jwks_refresh_interval"`
+	ClockSkew           time.Duration     `mapstructure:"clock_skew"`
+}
+
+// OIDCRoleMapping은 IdP 역할/그룹 클레임 값을 서비스 역할(reader, writer, admin)로 매핑합니다
+type OIDCRoleMapping struct {
+	ClaimValue string `mapstructure:"claim_value"`
+	Role       string `mapstructure:"role"`
+}
+
+// HMACConfig는 HMAC 요청 서명 인증 설정입니다 (HTTP API 전용)
+// mTLS나 OAuth를 쓸 수 없는 웹훅 스타일 연동에서 X-Signature-* 헤더로 인증합니다
+type HMACConfig struct {
+	Enabled   bool            `mapstructure:"enabled"`
+	Tolerance time.Duration   `mapstructure:"tolerance"`
+	Keys      []HMACKeyConfig `mapstructure:"keys"`
+}
+
+// HMACKeyConfig는 서명 키 설정입니다
+// 비밀키는 설정 파일 대신 SecretEnv로 지정한 환경변수에서 읽는 것을 권장합니다
+type HMACKeyConfig struct {
+	KeyID     string   `mapstructure:"key_id"`
+	Secret    string   `mapstructure:"secret"`
+	SecretEnv string   `mapstructure:"secret_env"`
+	Roles     []string `mapstructure:"roles"`
+}
+
+// ImpersonationConfig는 운영자 대리 실행(X-Impersonate) 설정입니다
+// AllowedRoles 역할의 운영자가 지원 업무를 위해 다른 사용자/테넌트로 요청을 실행하며, 감사 로그에 운영자가 함께 기록됩니다
+type ImpersonationConfig struct {
+	Enabled      bool     `mapstructure:"enabled"`
+	AllowedRoles []string `mapstructure:"allowed_roles"`
+	TargetRoles  []string `mapstructure:"target_roles"`
+}
+
+// LockoutConfig는 인증 실패 잠금(무차별 대입 방지) 설정입니다
+// 클라이언트 IP와 (서명 키 ID, 클라이언트 IP)별로 실패를 Redis에 집계하고, 잠금이 반복될수록 잠금 시간을 두 배로 늘립니다
+type LockoutConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	MaxFailures  int64         `mapstructure:"max_failures"`
+	Window       time.Duration `mapstructure:"window"`
+	BaseDuration time.Duration `mapstructure:"base_duration"`
+	MaxDuration  time.Duration `mapstructure:"max_duration"`
+	LevelTTL     time.Duration `mapstructure:"level_ttl"`
+}
+
+// RowPolicyConfig는 행 수준 보안 규칙입니다
+// 예: {collection: "orders", field: "data.owner", claim: "sub"}이면
+// 모든 필터에 data.owner == principal.sub 조건이 AND로 결합됩니다
+type RowPolicyConfig struct {
+	Collection  string   `mapstructure:"collection"`
+	Field       string   `mapstructure:"field"`
+	Claim       string   `mapstructure:"claim"`
+	ExemptRoles []string `mapstructure:"exempt_roles"`
+}
+
+// RateLimitConfig는 Redis 토큰 버킷 rate limiting 설정입니다
+type RateLimitConfig struct {
+	Enabled           bool                   `mapstructure:"enabled"`
+	RequestsPerSecond float64                `mapstructure:"requests_per_second"`
+	Burst             int64                  `mapstructure:"burst"`
+	Routes            []RouteRateLimitConfig `mapstructure:"routes"`
+}
+
+// RouteRateLimitConfig는 라우트별 rate limit 오버라이드입니다
+// HTTP는 "METHOD /path" (예: "POST /api/v1/documents/bulk/*"), gRPC는 전체 메서드 이름을 사용합니다
+type RouteRateLimitConfig struct {
+	Pattern           string  `mapstructure:"pattern"`
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	Burst             int64   `mapstructure:"burst"`
+}
+
+// AuditConfig는 감사 로그 설정입니다
+// 감사 기록은 MongoDB의 append-only 컬렉션에 저장되며 Retention이 지나면 TTL 인덱스로 삭제됩니다
+type AuditConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Retention time.Duration `mapstructure:"retention"` // 0이면 영구 보관
+}
+
+// IPFilterConfig는 CIDR 기반 IP 허용/차단 설정입니다 (HTTP, gRPC 공통)
+// 차단 목록이 허용 목록보다 우선하며, 허용 목록이 비어 있으면 차단되지 않은 모든 주소를 허용합니다
+// SIGHUP으로 재시작 없이 규칙을 다시 읽습니다
+type IPFilterConfig struct {
+	Enabled bool                  `mapstructure:"enabled"`
+	Allow   []string              `mapstructure:"allow"`
+	Deny    []string              `mapstructure:"deny"`
+	Groups  []IPFilterGroupConfig `mapstructure:"groups"`
+}
+
+// IPFilterGroupConfig는 경로 접두사별 추가 IP 규칙입니다
+// 접두사는 HTTP 경로(예: "/api/v1/collections") 또는 gRPC 메서드(예: "/database.v1.DatabaseService/Drop")입니다
+type IPFilterGroupConfig struct {
+	Name     string   `mapstructure:"name"`
+	Prefixes []string `mapstructure:"prefixes"`
+	Allow    []string `mapstructure:"allow"`
+	Deny     []string `mapstructure:"deny"`
+}
+
+// EncryptionConfig는 PostgreSQL/MySQL data 컬럼의 애플리케이션 수준 암호화 설정입니다
+// 디스크 암호화가 없는 배포 환경용이며, 암호화된 컬렉션은 id 이외의 필드로 필터링/정렬할 수 없습니다
+type EncryptionConfig struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	Provider    string `mapstructure:"provider"`      // vault (Transit), local
+	TransitKey  string `mapstructure:"transit_key"`   // Vault Transit 키 이름
+	LocalKeyEnv string `mapstructure:"local_key_env"` // base64 인코딩된 32바이트 마스터 키를 담은 환경변수
+}
+
+// PIIConfig는 쓰기 시 개인정보 탐지 설정입니다
+// 정책에 해당하지 않는 컬렉션에는 DefaultAction을 적용하며, 비어 있으면 검사하지 않습니다
+type PIIConfig struct {
+	Enabled       bool              `mapstructure:"enabled"`
+	Detectors     []string          `mapstructure:"detectors"`      // email, card_number (비어 있으면 전체)
+	DefaultAction string            `mapstructure:"default_action"` // tag, mask, reject
+	Policies      []PIIPolicyConfig `mapstructure:"policies"`
+}
+
+// SchemaValidationConfig는 쓰기 시 JSON Schema 검증 설정입니다
+// collections의 생성/수정/교체/대량 삽입 문서를 스키마로 검증하고, 위반하면 위반 경로와 함께 422로 거부합니다
+type SchemaValidationConfig struct {
+	Enabled     bool                               `mapstructure:"enabled"`
+	Collections []SchemaValidationCollectionConfig `mapstructure:"collections"`
+}
+
+// SchemaValidationCollectionConfig는 컬렉션별 JSON Schema입니다
+type SchemaValidationCollectionConfig struct {
+	Name       string `mapstructure:"name"`
+	SchemaFile string `mapstructure:"schema_file"` // JSON Schema 파일 경로
+}
+
+// UniqueKeysConfig는 data 필드 고유 제약 설정입니다
+// MongoDB/PostgreSQL/MySQL은 고유 인덱스로, 그 밖의 저장소는 쓰기 전 조회로 중복을 거부합니다 (409 DUPLICATE_KEY)
+type UniqueKeysConfig struct {
+	Enabled     bool              `mapstructure:"enabled"`
+	Constraints []UniqueKeyConfig `mapstructure:"constraints"`
+}
+
+// UniqueKeyConfig는 컬렉션의 고유 제약 하나입니다 (fields가 여러 개면 값의 조합이 고유)
+type UniqueKeyConfig struct {
+	Collection string   `mapstructure:"collection"`
+	Fields     []string `mapstructure:"fields"` // 점으로 구분된 data 필드 경로
+}
+
+// ReferencesConfig는 컬렉션 간 문서 참조 설정입니다
+// 대상 문서 삭제 시 참조하는 문서에 on_delete 동작을 적용하고, 조회의 populate로 대상 문서를 함께 반환합니다
+type ReferencesConfig struct {
+	Enabled     bool              `mapstructure:"enabled"`
+	MaxCascade  int               `mapstructure:"max_cascade"` // 삭제 한 번에 참조별로 처리할 수 있는 최대 문서 수 (0이면 1000)
+	Definitions []ReferenceConfig `mapstructure:"definitions"`
+}
+
+// ReferenceConfig는 참조 하나입니다 (collection의 data.field 값이 target 문서의 ID)
+type ReferenceConfig struct {
+	Collection string `mapstructure:"collection"`
+	Field      string `mapstructure:"field"` // 점으로 구분된 data 필드 경로
+	Target     string `mapstructure:"target"`
+	OnDelete   string `mapstructure:"on_delete"` // none(기본), cascade, nullify
+}
+
+// CacheConfig는 문서 캐시 전략 설정입니다
+// 정책에 해당하지 않는 컬렉션에는 DefaultStrategy를 적용합니다
+type CacheConfig struct {
+	Backend         string                `mapstructure:"backend"` // 문서/쿼리 캐시 저장소: redis(기본), memcached
+	Memcached       MemcachedConfig       `mapstructure:"memcached"`
+	DefaultStrategy string                `mapstructure:"default_strategy"` // read_through, write_through, write_behind, none
+	DefaultTTL      time.Duration         `mapstructure:"default_ttl"`
+	NegativeTTL     time.Duration         `mapstructure:"negative_ttl"`       // 존재하지 않는 문서 조회 결과 캐시 기간 (0이면 비활성화)
+	MaxDocumentSize int                   `mapstructure:"max_document_size"`  // 이보다 큰 문서(JSON 바이트)는 캐시하지 않음 (0이면 제한 없음)
+	EarlyRefresh    float64               `mapstructure:"early_refresh_beta"` // 만료 임박 항목의 확률적 조기 갱신 (0이면 비활성화, 일반적으로 1)
+	Policies        []CachePolicyConfig   `mapstructure:"policies"`
+	WriteBehind     WriteBehindConfig     `mapstructure:"write_behind"`
+	Local           LocalCacheConfig      `mapstructure:"local"`
+	Query           QueryCacheConfig      `mapstructure:"query"`
+	Warmup          CacheWarmupConfig     `mapstructure:"warmup"`
+	CDCInvalidation CDCInvalidationConfig `mapstructure:"cdc_invalidation"`
+}
+
+// CachePolicyConfig는 컬렉션별 캐시 전략입니다
+// strategy none은 컬렉션의 캐시를 우회합니다
+type CachePolicyConfig struct {
+	Collection           string        `mapstructure:"collection"`
+	Strategy             string        `mapstructure:"strategy"`
+	TTL                  time.Duration `mapstructure:"ttl"`
+	MaxDocumentSize      int           `mapstructure:"max_document_size"`
+	StaleWhileRevalidate time.Duration `mapstructure:"stale_while_revalidate"` // TTL 경과 후 이 기간 동안은 캐시 값을 반환하며 백그라운드 갱신 (0이면 비활성화)
+}
+
+// ReadRoutingConfig는 CQRS 읽기/쓰기 라우팅 설정입니다
+// 쓰기는 항상 주 저장소로 보내고, 조회/검색/집계는 컬렉션별 모드에 따라 읽기 복제본(mongodb.read_replica, postgresql.read_replica, mysql.read_replica)으로 보냅니다
+// 요청에 X-Read-Consistency: strong 헤더가 있으면 모드와 관계없이 주 저장소에서 읽습니다
+type ReadRoutingConfig struct {
+	Enabled     bool               `mapstructure:"enabled"`
+	Default     string            `mapstructure:"default"` // primary(기본), replica
+	Collections []ReadRouteConfig `mapstructure:"collections"`
+}
+
+// ReadRouteConfig는 컬렉션별 읽기 모드입니다 (먼저 선언된 규칙 우선)
+type ReadRouteConfig struct {
+	Collection string `mapstructure:"collection"` // 컬렉션 이름 또는 와일드카드 (*, ?, [...])
+	Mode       string `mapstructure:"mode"`       // primary, replica
+}
+
+// BackendRoutingConfig는 요청별 데이터베이스 백엔드 선택 설정입니다
+// 요청은 경로(/api/v1/{backend}/...)나 X-Database-Type 헤더로 백엔드를 직접 고를 수 있고,
+// 고르지 않으면 collections에서 처음 일치한 규칙의 백엔드, 그것도 없으면 default를 사용합니다
+// 관리 API(/api/v1/admin/backend-routes)로 컬렉션을 다른 백엔드로 고정할 수 있으며, 고정은 인스턴스별이고 재시작하면 사라집니다
+type BackendRoutingConfig struct {
+	Default      string               `mapstructure:"default"` // 비우면 mongodb가 켜져 있을 때 mongodb, 아니면 처음 활성화된 데이터베이스
+	Collections  []BackendRouteConfig `mapstructure:"collections"`
+	DrainTimeout time.Duration        `mapstructure:"drain_timeout"` // 백엔드 변경 후 이전 라우팅으로 처리 중인 요청을 기다리는 시간 (기본 30s)
+}
+
+// BackendRouteConfig는 컬렉션별 백엔드 규칙입니다 (먼저 선언된 규칙 우선)
+type BackendRouteConfig struct {
+	Collection string `mapstructure:"collection"` // 컬렉션 이름 또는 와일드카드 (*, ?, [...])
+	Backend    string `mapstructure:"backend"`    // mongodb, postgresql, mysql, cassandra, elasticsearch, vitess
+}
+
+// BulkWriteConfig는 BulkWrite 병렬 실행 설정입니다
+// 작업을 문서(컬렉션+ID)별 파티션으로 나누어 동시에 실행하므로 같은 문서에 대한 작업의 순서는 유지됩니다
+type BulkWriteConfig struct {
+	Workers       int `mapstructure:"workers"`        // 동시에 실행하는 파티션 수 (1 이하면 순차 실행)
+	MinOperations int `mapstructure:"min_operations"` // 이 수 이상의 작업일 때만 병렬 실행 (0이면 1000)
+}
+
+// WriteBatchingConfig는 고처리량 수집용 쓰기 배치 설정입니다
+// collections의 단건 저장을 max_delay 동안 모아 SaveMany 한 번으로 저장하므로, 약간의 지연을 감수하고 처리량을 높입니다
+type WriteBatchingConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	Collections  []string      `mapstructure:"collections"`   // 배치로 저장할 컬렉션 목록
+	MaxDelay     time.Duration `mapstructure:"max_delay"`     // 첫 문서 이후 배치를 저장하기까지 최대 대기 시간 (기본 5ms)
+	MaxBatch     int           `mapstructure:"max_batch"`     // 배치 하나의 최대 문서 수 (기본 500)
+	FlushTimeout time.Duration `mapstructure:"flush_timeout"` // 배치 저장 한 번의 시간 한도 (기본 10s)
+}
+
+// ExpiryConfig는 문서 만료(TTL) 설정입니다
+// MongoDB는 TTL 인덱스, Cassandra는 쓰기 TTL로 스스로 삭제하고, 나머지 백엔드는 sweep_interval마다 만료 문서를 삭제합니다
+type ExpiryConfig struct {
+	Enabled        bool                     `mapstructure:"enabled"`
+	SweepInterval  time.Duration            `mapstructure:"sweep_interval"`   // 만료 문서 정리 주기 (기본 1m)
+	SweepBatchSize int                      `mapstructure:"sweep_batch_size"` // 정리 시 한 번에 삭제할 문서 수 (기본 1000)
+	Collections    []ExpiryCollectionConfig `mapstructure:"collections"`
+}
+
+// ExpiryCollectionConfig는 컬렉션별 만료 정책입니다
+type ExpiryCollectionConfig struct {
+	Name       string        `mapstructure:"name"`
+	DefaultTTL time.Duration `mapstructure:"default_ttl"` // 요청에 만료 시각이 없을 때 적용 (0이면 요청한 문서만 만료)
+}
+
+// SoftDeleteConfig는 소프트 삭제 설정입니다
+// collections의 삭제는 문서 데이터에 _deleted_at을 기록하고, retention이 지나면 purge_interval마다 영구 삭제합니다
+type SoftDeleteConfig struct {
+	Enabled       bool                         `mapstructure:"enabled"`
+	PurgeInterval time.Duration                `mapstructure:"purge_interval"` // 영구 삭제 작업 주기 (기본 1h)
+	Collections   []SoftDeleteCollectionConfig `mapstructure:"collections"`
+}
+
+// SoftDeleteCollectionConfig는 컬렉션별 소프트 삭제 정책입니다
+type SoftDeleteCollectionConfig struct {
+	Name      string        `mapstructure:"name"`
+	Retention time.Duration `mapstructure:"retention"` // 삭제 후 영구 삭제까지 보존 기간 (0이면 영구 삭제하지 않음)
+}
+
+// RevisionsConfig는 문서 리비전(이전 버전) 보관 설정입니다
+// collections의 수정/교체/삭제/복원 전 버전을 store의 _revisions 컬렉션/테이블에 보관합니다
+type RevisionsConfig struct {
+	Enabled     bool                       `mapstructure:"enabled"`
+	Store       string                     `mapstructure:"store"` // mongodb, postgresql (기본 mongodb)
+	Collections []RevisionCollectionConfig `mapstructure:"collections"`
+}
+
+// RevisionCollectionConfig는 컬렉션별 리비전 보관 정책입니다
+type RevisionCollectionConfig struct {
+	Name         string        `mapstructure:"name"`
+	MaxRevisions int           `mapstructure:"max_revisions"` // 문서당 최대 리비전 수 (0이면 제한 없음)
+	Retention    time.Duration `mapstructure:"retention"`     // 대체된 지 이 기간이 지난 리비전 삭제 (0이면 영구 보관)
+}
+
+// RetentionConfig는 컬렉션 데이터 보존 정책 설정입니다
+// 정리 작업이 interval마다 max_age를 넘거나 max_count 밖으로 밀려난 문서를 삭제합니다
+type RetentionConfig struct {
+	Enabled     bool                        `mapstructure:"enabled"`
+	Interval    time.Duration               `mapstructure:"interval"` // 정리 작업 주기 (기본 1h)
+	DryRun      bool                        `mapstructure:"dry_run"`  // 삭제하지 않고 대상만 로그/메트릭으로 기록
+	Collections []RetentionCollectionConfig `mapstructure:"collections"`
+}
+
+// RetentionCollectionConfig는 컬렉션별 보존 정책입니다
+type RetentionCollectionConfig struct {
+	Name     string        `mapstructure:"name"`
+	MaxAge   time.Duration `mapstructure:"max_age"`   // 생성 후 이 기간이 지난 문서 삭제 (0이면 제한 없음)
+	MaxCount int           `mapstructure:"max_count"` // 최신 문서를 이 개수만큼만 보존 (0이면 제한 없음)
+}
+
+// ArchiveConfig는 콜드 데이터 보관(객체 저장소 계층화) 설정입니다
+// interval마다 collections에서 after 동안 수정되지 않은 문서를 보관 저장소로 옮기고 주 저장소에는 스텁을 남깁니다
+type ArchiveConfig struct {
+	Enabled     bool                      `mapstructure:"enabled"`
+	Interval    time.Duration             `mapstructure:"interval"`   // 보관 작업 주기 (기본 1h)
+	BatchSize   int                       `mapstructure:"batch_size"` // 한 번에 컬렉션당 옮길 최대 문서 수 (기본 1000)
+	Storage     string                    `mapstructure:"storage"`    // local, s3
+	LocalPath   string                    `mapstructure:"local_path"` // storage가 local일 때 보관 디렉터리
+	S3          BackupS3Config            `mapstructure:"s3"`
+	Collections []ArchiveCollectionConfig `mapstructure:"collections"`
+}
+
+// ArchiveCollectionConfig는 컬렉션별 보관 정책입니다
+type ArchiveCollectionConfig struct {
+	Name  string        `mapstructure:"name"`
+	After time.Duration `mapstructure:"after"` // 마지막 수정 후 이 기간이 지난 문서를 보관
+}
+
+// BackupConfig는 컬렉션 백업/복원 설정입니다
+type BackupConfig struct {
+	Enabled    bool                 `mapstructure:"enabled"`
+	Storage    string               `mapstructure:"storage"`    // local, s3
+	LocalPath  string               `mapstructure:"local_path"` // storage가 local일 때 백업 디렉터리
+	S3         BackupS3Config       `mapstructure:"s3"`
+	BatchSize  int                  `mapstructure:"batch_size"` // 복원 시 SaveMany 한 번에 저장할 문서 수 (기본 500)
+	Consistent bool                 `mapstructure:"consistent"` // 읽기 전용 트랜잭션 하나로 컬렉션을 읽어 일관된 스냅샷을 만듦 (MongoDB는 replica set 필요)
+	Schedule   BackupScheduleConfig `mapstructure:"schedule"`
+}
+
+// BackupScheduleConfig는 주기적 백업 설정입니다 (시점 복원의 기준 스냅샷)
+type BackupScheduleConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	Interval     time.Duration `mapstructure:"interval"`      // 백업 주기 (CDC 토픽 보존 기간보다 짧아야 시점 복원 가능)
+	DatabaseType string        `mapstructure:"database_type"` // 백업할 데이터베이스 (기본 mongodb)
+	Collections  []string      `mapstructure:"collections"`
+}
+
+// MaintenanceConfig는 컬렉션 유지보수(compact, vacuum, forcemerge 등) 설정입니다
+// 켜면 /api/v1/admin/maintenance로 작업을 실행하고, schedules의 작업을 주기적으로 실행합니다
+type MaintenanceConfig struct {
+	Enabled   bool                        `mapstructure:"enabled"`
+	Schedules []MaintenanceScheduleConfig `mapstructure:"schedules"`
+}
+
+// MaintenanceScheduleConfig는 주기적으로 실행할 유지보수 작업입니다
+type MaintenanceScheduleConfig struct {
+	DatabaseType string        `mapstructure:"database_type"`
+	Collection   string        `mapstructure:"collection"`
+	Operation    string        `mapstructure:"operation"`    // mongodb: compact, postgresql: vacuum/analyze, mysql: optimize/analyze, elasticsearch: forcemerge/refresh
+	Interval     time.Duration `mapstructure:"interval"`     // 실행 주기 (기본 24h)
+	Full         bool          `mapstructure:"full"`         // PostgreSQL VACUUM FULL (작업 중 테이블 잠금)
+	MaxSegments  int           `mapstructure:"max_segments"` // Elasticsearch forcemerge의 max_num_segments
+}
+
+// DuplicatesConfig는 중복 문서 탐지/정리 설정입니다
+// 켜면 /api/v1/admin/duplicates로 키 필드 값이 같은 문서를 찾고 병합하거나 삭제합니다
+type DuplicatesConfig struct {
+	Enabled   bool `mapstructure:"enabled"`
+	MaxGroups int  `mapstructure:"max_groups"` // 탐지 결과에 담는 최대 중복 그룹 수 (기본 1000)
+}
+
+// AnonymizationConfig는 컬렉션 익명화 작업 설정입니다 (GDPR 삭제 요청 처리용)
+// 켜면 /api/v1/admin/anonymization으로 필드를 해시하거나 고정 값으로 덮어쓰는 작업을 실행합니다
+type AnonymizationConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	HashKeyEnv    string        `mapstructure:"hash_key_env"`   // hash 규칙의 HMAC 키(16바이트 이상)를 담은 환경변수 (비어 있으면 rewrite만 사용 가능)
+	BatchSize     int           `mapstructure:"batch_size"`     // 진행 상황을 기록하는 문서 단위 (기본 500)
+	BatchInterval time.Duration `mapstructure:"batch_interval"` // 배치 사이 대기 시간 (운영 중 부하 조절)
+}
+
+// SearchMirrorConfig는 Elasticsearch 검색 미러 설정입니다
+// 켜면 /api/v1/admin/search-mirror로 주 저장소 컬렉션과 미러 인덱스의 일관성을 검증하고,
+// sync를 켜면 CDC 이벤트를 소비해 미러 인덱스를 계속 갱신합니다
+type SearchMirrorConfig struct {
+	Enabled     bool                           `mapstructure:"enabled"`
+	Collections []SearchMirrorCollectionConfig `mapstructure:"collections"`
+	Sync        SearchMirrorSyncConfig         `mapstructure:"sync"`
+}
+
+// SearchMirrorSyncConfig는 CDC 토픽을 소비해 미러 인덱스를 갱신하는 동기화 워커 설정입니다 (kafka.enable_cdc 필요)
+// 모든 인스턴스가 같은 컨슈머 그룹으로 이벤트를 나눠 처리합니다
+type SearchMirrorSyncConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	GroupID       string `mapstructure:"group_id"`       // 기본 database-service-search-mirror
+	InitialOffset string `mapstructure:"initial_offset"` // newest(기본), oldest
+}
+
+// FixturesConfig는 JSON 픽스처 불러오기 설정입니다 (데모 환경, 통합 테스트용)
+// 켜면 POST /api/v1/admin/fixtures/load로 directory(또는 그 아래 세트 디렉터리)의 <collection>.json 파일을 불러옵니다
+type FixturesConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	Directory     string `mapstructure:"directory"`
+	LoadOnStartup bool   `mapstructure:"load_on_startup"` // 시작할 때 startup_set을 불러옴
+	StartupSet    string `mapstructure:"startup_set"`     // 시작할 때 불러올 세트 (비어 있으면 directory)
+	DatabaseType  string `mapstructure:"database_type"`   // 시작할 때 불러올 데이터베이스 (기본 mongodb)
+	Overwrite     bool   `mapstructure:"overwrite"`       // 시작할 때 내용이 다른 기존 문서를 픽스처로 덮어씀
+}
+
+// SearchMirrorCollectionConfig는 미러되는 컬렉션입니다
+type SearchMirrorCollectionConfig struct {
+	Collection     string        `mapstructure:"collection"`
+	Source         string        `mapstructure:"source"`          // 주 저장소 데이터베이스 종류 (기본 mongodb)
+	Index          string        `mapstructure:"index"`           // Elasticsearch 인덱스 (비어 있으면 collection)
+	VerifyInterval time.Duration `mapstructure:"verify_interval"` // 주기적 검증 간격 (0이면 요청할 때만)
+	AutoRepair     bool          `mapstructure:"auto_repair"`     // 주기적 검증에서 어긋난 문서를 다시 맞춤
+	MappingFile    string        `mapstructure:"mapping_file"`    // 인덱스 settings와 data 필드 mappings를 담은 JSON 파일 (비어 있으면 기본 매핑)
+}
+
+// BackupS3Config는 S3(또는 S3 호환 저장소) 백업 설정입니다
+type BackupS3Config struct {
+	Bucket          string `mapstructure:"bucket"`
+	Prefix          string `mapstructure:"prefix"`
+	Region          string `mapstructure:"region"`
+	Endpoint        string `mapstructure:"endpoint"`       // S3 호환 저장소 주소 (비어 있으면 AWS)
+	UsePathStyle    bool   `mapstructure:"use_path_style"` // MinIO 등 경로 방식 주소
+	AccessKeyID     string `mapstructure:"access_key_id"`  // 비어 있으면 기본 자격증명 체인
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+}
+
+// OnlineMigrationConfig는 백엔드 간 온라인 마이그레이션(dual-write, 복사, 전환) 설정입니다
+type OnlineMigrationConfig struct {
+	Enabled   bool `mapstructure:"enabled"`
+	BatchSize int  `mapstructure:"batch_size"` // 복사 시 SaveMany 한 번에 저장할 문서 수 (기본 500)
+}
+
+// SchemaMigrationsConfig는 SQL 백엔드(PostgreSQL, MySQL) 문서 테이블 스키마 마이그레이션 설정입니다
+// 켜면 컬렉션 테이블을 처음 쓸 때 남은 마이그레이션을 적용합니다
+type SchemaMigrationsConfig struct {
+	Enabled        bool `mapstructure:"enabled"`
+	ApplyOnStartup bool `mapstructure:"apply_on_startup"` // 시작할 때 모든 문서 테이블에 남은 마이그레이션 적용
+}
+
+// TenantsConfig는 테넌트 프로비저닝 설정입니다
+// 켜면 POST /api/v1/admin/tenants로 "<tenant>_" 네임스페이스의 컬렉션, 인덱스, 쿼터, API 키, CDC 토픽을 한 번에 만들고
+// 발급한 API 키(X-API-Key)는 테넌트 네임스페이스의 컬렉션에만 접근할 수 있습니다
+type TenantsConfig struct {
+	Enabled            bool                     `mapstructure:"enabled"`
+	DefaultCollections []TenantCollectionConfig `mapstructure:"default_collections"`   // 모든 테넌트에 만드는 컬렉션 (네임스페이스 제외 이름)
+	DefaultQuota       TenantQuotaConfig        `mapstructure:"default_quota"`         // 요청에 쿼터가 없을 때 적용
+	DefaultAPIKeyRoles []string                 `mapstructure:"default_api_key_roles"` // 요청에 API 키가 없을 때 발급할 키의 역할 (reader, writer, 기본 writer)
+	APIKeyCacheTTL     time.Duration            `mapstructure:"api_key_cache_ttl"`     // 검증한 API 키 캐시 시간 (기본 1m)
+	CDCTopics          TenantCDCTopicsConfig    `mapstructure:"cdc_topics"`
+}
+
+// TenantCollectionConfig는 테넌트 기본 컬렉션과 인덱스입니다
+type TenantCollectionConfig struct {
+	Name    string              `mapstructure:"name"`
+	Indexes []TenantIndexConfig `mapstructure:"indexes"`
+}
+
+// TenantIndexConfig는 테넌트 기본 인덱스입니다
+type TenantIndexConfig struct {
+	Name   string         `mapstructure:"name"` // 비어 있으면 키 이름으로 생성
+	Keys   map[string]int `mapstructure:"keys"`
+	Unique bool           `mapstructure:"unique"`
+}
+
+// TenantQuotaConfig는 테넌트 저장 용량 한도입니다 (0이면 제한 없음)
+type TenantQuotaConfig struct {
+	MaxDocuments int64 `mapstructure:"max_documents"`
+	MaxBytes     int64 `mapstructure:"max_bytes"`
+}
+
+// TenantCDCTopicsConfig는 테넌트 전용 CDC 토픽 설정입니다
+// 켜면 kafka.cdc_topics의 토픽마다 "<tenant>.<토픽>"을 만듭니다 (예: acme.documents.created)
+type TenantCDCTopicsConfig struct {
+	Enabled           bool  `mapstructure:"enabled"`
+	Partitions        int32 `mapstructure:"partitions"`         // 기본 3
+	ReplicationFactor int16 `mapstructure:"replication_factor"` // 기본 1
+}
+
+// QuotasConfig는 컬렉션/테넌트 저장 용량 쿼터 설정입니다
+// 켜면 한도를 넘는 삽입을 507(RESOURCE_EXHAUSTED)로 거부하고, 이미 한도에 도달한 컬렉션의 수정도 거부합니다
+// 테넌트 쿼터(tenants 프로비저닝 시 지정)는 테넌트 네임스페이스의 모든 컬렉션 사용량 합계에 적용됩니다
+type QuotasConfig struct {
+	Enabled         bool                    `mapstructure:"enabled"`
+	RefreshInterval time.Duration           `mapstructure:"refresh_interval"` // 사용량을 저장소 통계로 다시 맞추는 간격 (기본 1m)
+	Default         QuotaLimitConfig        `mapstructure:"default"`          // collections에 일치하는 항목이 없는 컬렉션의 한도
+	Collections     []CollectionQuotaConfig `mapstructure:"collections"`      // 처음 일치한 항목을 적용
+}
+
+// QuotaLimitConfig는 저장 용량 한도입니다 (0이면 제한 없음)
+type QuotaLimitConfig struct {
+	MaxDocuments int64 `mapstructure:"max_documents"`
+	MaxBytes     int64 `mapstructure:"max_bytes"`
+}
+
+// CollectionQuotaConfig는 컬렉션 저장 용량 한도입니다
+type CollectionQuotaConfig struct {
+	Collection   string `mapstructure:"collection"` // 컬렉션 이름 또는 와일드카드 패턴 (예: logs_*)
+	MaxDocuments int64  `mapstructure:"max_documents"`
+	MaxBytes     int64  `mapstructure:"max_bytes"`
+}
+
+// ReloadConfig는 실행 중 설정 다시 읽기 설정입니다
+// SIGHUP을 받으면 항상 다시 읽고, WatchFile이면 설정 파일이 바뀔 때도 다시 읽습니다
+// 다시 읽을 때는 로그 레벨, 캐시 TTL, rate limit, circuit breaker 임계값, IP 필터 규칙만 바뀌며 나머지는 재시작해야 반영됩니다
+type ReloadConfig struct {
+	WatchFile bool `mapstructure:"watch_file"`
+}
+
+// FeatureFlagsConfig는 기능 플래그 설정입니다
+// 플래그 값은 관리 API(/api/v1/admin/feature-flags)로 실행 중에 바꿀 수 있으며, 변경은 Redis에 저장되어 모든 인스턴스가 공유합니다
+// 코드에서 확인하는 플래그: cdc(CDC 이벤트 발행), backend.<데이터베이스>(백엔드 사용), cache.<전략>(write_through, write_behind 캐시 전략)
+// 정의하지 않은 플래그는 켜진 것으로 봅니다
+type FeatureFlagsConfig struct {
+	Enabled         bool                `mapstructure:"enabled"`
+	RedisKey        string              `mapstructure:"redis_key"`        // 실행 중 변경을 저장하는 Redis 해시 키 (기본 feature_flags)
+	RefreshInterval time.Duration       `mapstructure:"refresh_interval"` // 다른 인스턴스의 변경을 다시 읽는 주기 (기본 10s)
+	Flags           []FeatureFlagConfig `mapstructure:"flags"`
+}
+
+// FeatureFlagConfig는 기능 플래그 하나의 정의입니다
+// 우선순위: 테넌트별 값 > 환경별 값 > enabled (관리 API의 변경은 같은 범위의 설정 값보다 우선)
+type FeatureFlagConfig struct {
+	Name         string                    `mapstructure:"name"`
+	Description  string                    `mapstructure:"description"`
+	Enabled      bool                      `mapstructure:"enabled"`
+	Environments map[string]bool           `mapstructure:"environments"` // app.environment별 값
+	Tenants      []FeatureFlagTenantConfig `mapstructure:"tenants"`
+}
+
+// FeatureFlagTenantConfig는 테넌트별 플래그 값입니다
+type FeatureFlagTenantConfig struct {
+	Tenant  string `mapstructure:"tenant"`
+	Enabled bool   `mapstructure:"enabled"`
+}
+
+// LeaderElectionConfig는 백그라운드 작업(CDC 브리지, 복제기, 정리 작업)의 리더 선출 설정입니다
+// 켜면 같은 작업을 실행하는 레플리카 중 Kubernetes Lease를 가진 하나만 작업을 실행합니다
+type LeaderElectionConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Namespace     string        `mapstructure:"namespace"`      // Lease를 만들 네임스페이스 (비어 있으면 POD_NAMESPACE 또는 파드의 네임스페이스)
+	LeaseDuration time.Duration `mapstructure:"lease_duration"` // 리더가 사라졌을 때 다른 레플리카가 기다리는 시간 (기본 15s)
+	RenewDeadline time.Duration `mapstructure:"renew_deadline"` // 리더가 갱신에 계속 실패하면 작업을 멈추는 시간 (기본 10s)
+	RetryPeriod   time.Duration `mapstructure:"retry_period"`   // 획득/갱신 시도 간격 (기본 2s)
+}
+
+// CircuitBreakerConfig는 데이터베이스 종류별 circuit breaker 설정입니다
+// backends에 없는 데이터베이스와 backends의 비어 있는 값은 기본 설정을 사용합니다
+type CircuitBreakerConfig struct {
+	CircuitBreakerSettings `mapstructure:",squash"`
+	Backends               map[string]CircuitBreakerSettings `mapstructure:"backends"` // mongodb, postgresql, mysql, cassandra, elasticsearch, vitess
+}
+
+// CircuitBreakerSettings는 circuit breaker 임계값입니다 (0이면 기본값)
+type CircuitBreakerSettings struct {
+	FailureRatio     float64       `mapstructure:"failure_ratio"`      // 이 비율 이상 실패하면 open (기본 0.6)
+	MinRequests      uint32        `mapstructure:"min_requests"`       // 실패 비율을 판단하기 위한 최소 요청 수 (기본 3)
+	Interval         time.Duration `mapstructure:"interval"`           // closed 상태의 통계 리셋 간격 (기본 10s)
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`       // open 유지 시간 (기본 30s)
+	HalfOpenRequests uint32        `mapstructure:"half_open_requests"` // half-open 상태의 시험 요청 수 (기본 3)
+}
+
+// RetryConfig는 데이터베이스 작업의 재시도 설정입니다 (0이면 기본값)
+// 재시도할 에러는 데이터베이스 종류별 드라이버 에러 코드로 판별합니다 (연결 끊김, 교착 상태, 선출 중 등)
+type RetryConfig struct {
+	MaxAttempts     int               `mapstructure:"max_attempts"`     // 최대 시도 횟수 (기본 3)
+	InitialInterval time.Duration     `mapstructure:"initial_interval"` // 첫 재시도 전 대기 시간 (기본 500ms)
+	MaxInterval     time.Duration     `mapstructure:"max_interval"`     // 최대 대기 시간 (기본 30s)
+	Multiplier      float64           `mapstructure:"multiplier"`       // 대기 시간 증가 배율 (기본 2)
+	MaxElapsedTime  time.Duration     `mapstructure:"max_elapsed_time"` // 재시도를 포함한 최대 시간 (기본 2m)
+	Jitter          string            `mapstructure:"jitter"`           // full(기본, 0~backoff 사이 무작위), none
+	Budget          RetryBudgetConfig `mapstructure:"budget"`
+}
+
+// RetryBudgetConfig는 데이터베이스 종류+작업별 재시도 예산 설정입니다
+// 요청마다 ratio만큼 적립하고 재시도마다 1을 차감하므로 장애 시 재시도가 요청 수의 ratio 비율을 넘지 않습니다
+type RetryBudgetConfig struct {
+	Enabled      bool    `mapstructure:"enabled"`
+	Ratio        float64 `mapstructure:"ratio"`          // 요청 하나가 적립하는 예산 (기본 0.1)
+	MinPerSecond float64 `mapstructure:"min_per_second"` // 요청과 관계없이 초당 적립되는 예산 (기본 10)
+	Max          float64 `mapstructure:"max"`            // 최대 예산 (기본 100)
+}
+
+// TimeoutsConfig는 작업 종류별 시간 한도 설정입니다 (0이면 한도 없음)
+// 한도는 요청 context의 deadline으로 데이터베이스 드라이버까지 전달되며, 클라이언트가 더 짧은 deadline을 보내면 그 값을 따릅니다
+type TimeoutsConfig struct {
+	Read  time.Duration `mapstructure:"read"`  // 조회, 검색, 집계
+	Write time.Duration `mapstructure:"write"` // 생성, 수정, 삭제, 벌크 쓰기, 트랜잭션
+	Admin time.Duration `mapstructure:"admin"` // 인덱스, 컬렉션 관리, raw 쿼리, 통계
+}
+
+// LoadSheddingConfig는 HTTP/gRPC 적응형 동시성 제한 설정입니다
+// 응답 시간이 장기 평균보다 tolerance배 넘게 늘어나면 동시 처리 한도를 줄이고, 한도를 넘는 요청은 503/RESOURCE_EXHAUSTED로 거부합니다
+type LoadSheddingConfig struct {
+	Enabled      bool    `mapstructure:"enabled"`
+	InitialLimit int     `mapstructure:"initial_limit"` // 시작 한도 (기본 20)
+	MinLimit     int     `mapstructure:"min_limit"`     // 최소 한도 (기본 4)
+	MaxLimit     int     `mapstructure:"max_limit"`     // 최대 한도 (기본 1000)
+	Smoothing    float64 `mapstructure:"smoothing"`     // 새 한도를 반영하는 비율 0~1 (기본 0.2)
+	Tolerance    float64 `mapstructure:"tolerance"`     // 허용하는 응답 시간 증가 배율 (기본 1.5)
+}
+
+// PoolHealthConfig는 데이터베이스 연결 풀 예열과 백그라운드 검증 설정입니다
+// 예열은 SQL은 max_idle_conns, MongoDB는 min_pool_size, Cassandra는 호스트 수 × num_conns만큼 연결을 미리 맺습니다
+type PoolHealthConfig struct {
+	WarmUp             bool          `mapstructure:"warm_up"`
+	WarmUpTimeout      time.Duration `mapstructure:"warm_up_timeout"`     // 예열 전체 시간 한도 (0이면 10초)
+	Validation         bool          `mapstructure:"validation"`          // 유휴 연결을 주기적으로 검사해 끊어진 연결을 버림
+	ValidationInterval time.Duration `mapstructure:"validation_interval"` // 검사 주기 (0이면 30초)
+	ValidationTimeout  time.Duration `mapstructure:"validation_timeout"`  // 연결 하나의 검사 시간 한도 (0이면 2초)
+}
+
+// WriteBehindConfig는 write-behind 캐시 큐 설정입니다
+type WriteBehindConfig struct {
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	BatchSize     int           `mapstructure:"batch_size"`
+	MaxPending    int           `mapstructure:"max_pending"`
+}
+
+// LocalCacheConfig는 Redis 앞단의 인프로세스 LRU 캐시 설정입니다
+// TTL은 다른 인스턴스의 변경이 반영되지 않을 수 있는 최대 시간이므로 짧게 유지합니다
+type LocalCacheConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	MaxEntries int           `mapstructure:"max_entries"`
+	TTL        time.Duration `mapstructure:"ttl"`
+}
+
+// MemcachedConfig는 memcached 캐시 백엔드 설정입니다
+// rate limit, 분산 락 등 Redis 전용 기능은 backend와 무관하게 계속 Redis를 사용합니다
+type MemcachedConfig struct {
+	Servers      []string      `mapstructure:"servers"`
+	Timeout      time.Duration `mapstructure:"timeout"`
+	MaxIdleConns int           `mapstructure:"max_idle_conns"`
+}
+
+// QueryCacheConfig는 검색/개수 조회 결과 캐시 설정입니다
+// 컬렉션 변경 시(로컬 쓰기 또는 cdc_invalidation) 무효화되며, 그 외 대량 쓰기는 TTL 동안 반영되지 않을 수 있습니다
+type QueryCacheConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	TTL     time.Duration `mapstructure:"ttl"`
+}
+
+// CacheWarmupConfig는 시작 시 캐시 예열 설정입니다
+// 예열은 백그라운드에서 실행되며 완료 전에도 요청을 처리합니다
+type CacheWarmupConfig struct {
+	Enabled     bool                      `mapstructure:"enabled"`
+	Timeout     time.Duration             `mapstructure:"timeout"`
+	Collections []CacheWarmupTargetConfig `mapstructure:"collections"`
+}
+
+// CacheWarmupTargetConfig는 컬렉션별 예열 대상입니다 (ids가 있으면 해당 문서만, 없으면 최근 수정된 문서 limit개)
+type CacheWarmupTargetConfig struct {
+	Collection string   `mapstructure:"collection"`
+	Limit      int      `mapstructure:"limit"`
+	IDs        []string `mapstructure:"ids"`
+}
+
+// CDCInvalidationConfig는 Kafka CDC 이벤트 기반 캐시 무효화 설정입니다
+// 인스턴스마다 "<group_prefix>-<인스턴스 ID>" 컨슈머 그룹으로 모든 변경 이벤트를 받아 캐시를 무효화합니다
+type CDCInvalidationConfig struct {
+	Enabled     bool   `mapstructure:"enabled"`
+	GroupPrefix string `mapstructure:"group_prefix"`
+}
+
+// ReplicationConfig는 CDC 토픽을 소비해 다른 백엔드/리전에 변경을 적용하는 복제 워커(cmd/replicator) 설정입니다
+type ReplicationConfig struct {
+	Enabled       bool                    `mapstructure:"enabled"`
+	GroupID       string                  `mapstructure:"group_id"`
+	InitialOffset string                  `mapstructure:"initial_offset"` // oldest(기본), newest
+	MetricsPort   int                     `mapstructure:"metrics_port"`
+	Target        ReplicationTargetConfig `mapstructure:"target"`
+	Dedupe        ReplicationDedupeConfig `mapstructure:"dedupe"`
+}
+
+// ReplicationDedupeConfig는 복제 워커의 이벤트 ID 중복 제거 설정입니다
+// Store는 redis(redis 설정 사용), postgresql(postgresql 설정 사용), mysql(mysql 설정 사용) 중 하나입니다
+type ReplicationDedupeConfig struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	Store             string        `mapstructure:"store"`
+	KeyPrefix         string        `mapstructure:"key_prefix"`         // redis 키 접두사 (기본 cdc:dedupe)
+	Table             string        `mapstructure:"table"`              // SQL 테이블 (기본 cdc_processed_events)
+	ProcessingTimeout time.Duration `mapstructure:"processing_timeout"` // 처리 선점 유효 시간 (기본 5m)
+	Retention         time.Duration `mapstructure:"retention"`          // 처리 기록 보관 기간 (기본 168h)
+}
+
+// CDCBridgeConfig는 MongoDB 변경 스트림을 읽어 CDC 토픽으로 발행하는 브리지 워커(cmd/cdc-bridge) 설정입니다
+// 서비스를 거치지 않은 쓰기(마이그레이션, 다른 애플리케이션, 셸 작업)도 CDC 이벤트로 발행합니다
+// 서비스가 직접 발행하는 컬렉션을 함께 구독하면 이벤트가 중복되므로 collections/exclude_collections로 나눕니다
+type CDCBridgeConfig struct {
+	Enabled            bool          `mapstructure:"enabled"`
+	Source             string        `mapstructure:"source"`              // mongodb(Change Streams, 기본) 또는 mysql(변경 로그 트리거)
+	Name               string        `mapstructure:"name"`                // 재개 토큰/읽기 위치 이름 (기본 cdc-bridge)
+	Collections        []string      `mapstructure:"collections"`         // 비어 있으면 데이터베이스 전체
+	ExcludeCollections []string      `mapstructure:"exclude_collections"` // _로 시작하는 내부 컬렉션은 항상 제외
+	CheckpointInterval time.Duration `mapstructure:"checkpoint_interval"` // 재개 토큰 저장 주기 (기본 5s)
+	MetricsPort        int           `mapstructure:"metrics_port"`
+}
+
+// ReplicationTargetConfig는 복제 대상 백엔드 설정입니다 (현재 mongodb 지원)
+type ReplicationTargetConfig struct {
+	Type           string        `mapstructure:"type"`
+	Region         string        `mapstructure:"region"`
+	URI            string        `mapstructure:"uri"`
+	Database       string        `mapstructure:"database"`
+	MaxPoolSize    uint64        `mapstructure:"max_pool_size"`
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	Timeout        time.Duration `mapstructure:"timeout"`
+}
+
+// PIIPolicyConfig는 컬렉션별 개인정보 처리 정책입니다
+type PIIPolicyConfig struct {
+	Collection   string   `mapstructure:"collection"`
+	Action       string   `mapstructure:"action"`
+	ExemptFields []string `mapstructure:"exempt_fields"`
+}
+
 // ObservabilityConfig는 관찰성 설정입니다
 type ObservabilityConfig struct {
 	Logging LoggingConfig `mapstructure:"logging"`
 	Tracing TracingConfig `mapstructure:"tracing"`
-	Metrics MetricsConfig `mapstructure:"metrics"`
+	Metrics   MetricsConfig   `mapstructure:"metrics"`
+	Profiling ProfilingConfig `mapstructure:"profiling"`
 }
 
 // LoggingConfig는 로깅 설정입니다
@@ -296,10 +1388,59 @@ type MetricsConfig struct {
 	Enabled bool   `mapstructure:"enabled"`
 	Port    int    `mapstructure:"port"`
 	Path    string `mapstructure:"path"`
+
+	// PoolStatsInterval은 연결 풀 상태를 메트릭으로 기록하는 주기입니다 (0이면 15초)
+	PoolStatsInterval time.Duration `mapstructure:"pool_stats_interval"`
+}
+
+// ProfilingConfig는 pprof 내부 포트와 연속 프로파일러 전송 설정입니다
+// pprof는 API 포트가 아닌 별도 포트에서만 제공하며, auth가 켜져 있으면 admin 역할만 접근할 수 있습니다
+type ProfilingConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Host    string `mapstructure:"host"` // pprof 서버 주소 (기본 127.0.0.1)
+	Port    int    `mapstructure:"port"` // pprof 서버 포트 (기본 6060)
+
+	Push ProfilingPushConfig `mapstructure:"push"`
+}
+
+// ProfilingPushConfig는 연속 프로파일러(Pyroscope 호환 /ingest API) 전송 설정입니다
+type ProfilingPushConfig struct {
+	Enabled         bool              `mapstructure:"enabled"`
+	ServerAddress   string            `mapstructure:"server_address"`   // 프로파일러 서버 주소
+	ApplicationName string            `mapstructure:"application_name"` // 비어 있으면 app.name
+	AuthToken       string            `mapstructure:"auth_token"`
+	Interval        time.Duration     `mapstructure:"interval"` // CPU 프로파일 수집 구간이자 전송 주기 (기본 15s)
+	Tags            map[string]string `mapstructure:"tags"`
 }
 
 // LoadConfig는 설정 파일을 로드합니다
 func LoadConfig(configPath string, configName string) (*Config, error) {
+	config, _, err := LoadConfigWithSources(configPath, configName)
+	return config, err
+}
+
+// LoadConfigWithSources는 설정을 로드하고 사용한 프로필과 설정 파일을 함께 반환합니다
+func LoadConfigWithSources(configPath string, configName string) (*Config, *Sources, error) {
+	// 설정 파일 읽기 (기본 파일 + 프로필 오버레이)
+	v, sources, err := readLayered(configPath, configName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 설정 구조체로 언마샬
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	// 환경변수로 민감한 값 오버라이드
+	overrideFromEnv(&config)
+
+	return &config, sources, nil
+}
+
+// newViper는 설정 파일 경로와 환경변수 바인딩을 지정한 viper 인스턴스를 생성합니다
+func newViper(configPath string, configName string) *viper.Viper {
 	v := viper.New()
 
 	// 설정 파일 경로 및 이름 설정
@@ -322,21 +1463,7 @@ func LoadConfig(configPath string, configName string) (*Config, error) {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
-	// 설정 파일 읽기
-	if err := v.ReadInConfig(); err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
-	}
-
-	// 설정 구조체로 언마샬
-	var config Config
-	if err := v.Unmarshal(&config); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
-	}
-
-	// 환경변수로 민감한 값 오버라이드
-	overrideFromEnv(&config)
-
-	return &config, nil
+	return v
 }
 
 // overrideFromEnv는 환경변수로 민감한 설정을 오버라이드합니다
@@ -464,6 +1591,9 @@ func overrideFromEnv(config *Config) {
 	if val := viper.GetString("REDIS_PASSWORD"); val != "" {
 		config.Redis.Password = val
 	}
+	if val := viper.GetString("REDIS_ADDRESSES"); val != "" {
+		config.Redis.Addresses = strings.Split(val, ",")
+	}
 
 	// Kafka 설정
 	if val := viper.GetString("KAFKA_BROKERS"); val != "" {
@@ -473,6 +1603,27 @@ func overrideFromEnv(config *Config) {
 	if val := viper.GetString("KAFKA_CLIENT_ID"); val != "" {
 		config.Kafka.ClientID = val
 	}
+	if val := viper.GetString("SCHEMA_REGISTRY_URL"); val != "" {
+		config.Kafka.Avro.SchemaRegistry.URL = val
+	}
+
+	// NATS 설정
+	if val := viper.GetString("NATS_SERVERS"); val != "" {
+		config.NATS.Servers = strings.Split(val, ",")
+	}
+	if val := viper.GetString("NATS_TOKEN"); val != "" {
+		config.NATS.Token = val
+	}
+
+	// RabbitMQ 설정
+	if val := viper.GetString("RABBITMQ_URL"); val != "" {
+		config.RabbitMQ.URL = val
+	}
+
+	// 복제 대상 설정
+	if val := viper.GetString("REPLICATION_TARGET_URI"); val != "" {
+		config.Replication.Target.URI = val
+	}
 
 	// 애플리케이션 설정 (GitLab CI/CD 변수)
 	if val := viper.GetString("APP_ENVIRONMENT"); val != "" {
@@ -490,6 +1641,14 @@ func overrideFromEnv(config *Config) {
 		config.App.Environment = val
 	}
 
+	// 인증 설정
+	if val := viper.GetString("OIDC_ISSUER_URL"); val != "" {
+		config.Auth.OIDC.IssuerURL = val
+	}
+	if val := viper.GetString("OIDC_AUDIENCES"); val != "" {
+		config.Auth.OIDC.Audiences = strings.Split(val, ",")
+	}
+
 	// Observability 설정
 	if val := viper.GetString("JAEGER_ENDPOINT"); val != "" {
 		config.Observability.Tracing.JaegerEndpoint = val
@@ -508,6 +1667,13 @@ func (c *Config) Validate() error {
 	if c.Server.HTTP.Port <= 0 {
 		return fmt.Errorf("server.http.port must be positive")
 	}
+	for _, proxy := range c.Server.HTTP.TrustedProxies {
+		if _, err := netip.ParsePrefix(proxy); err != nil {
+			if _, err := netip.ParseAddr(proxy); err != nil {
+				return fmt.Errorf("server.http.trusted_proxies: %q is not an IP address or CIDR", proxy)
+			}
+		}
+	}
 
 	if c.Server.GRPC.Port <= 0 {
 		return fmt.Errorf("server.grpc.port must be positive")
@@ -523,18 +1689,68 @@ func (c *Config) Validate() error {
 		if !c.PostgreSQL.UseVault && (c.PostgreSQL.Host == "" || c.PostgreSQL.Database == "") {
 			return fmt.Errorf("postgresql.host and postgresql.database are required when vault is not used")
 		}
+		if c.PostgreSQL.UseVault && !c.Vault.Enabled {
+			return fmt.Errorf("postgresql.use_vault requires vault to be enabled")
+		}
+		if c.PostgreSQL.SecretName != "" {
+			if c.PostgreSQL.UseVault {
+				return fmt.Errorf("postgresql.secret_name and postgresql.use_vault cannot be used together")
+			}
+			if c.Secrets.Provider == "" {
+				return fmt.Errorf("postgresql.secret_name requires secrets.provider")
+			}
+		}
 	}
 
 	if c.MySQL.Enabled {
 		if !c.MySQL.UseVault && (c.MySQL.Host == "" || c.MySQL.Database == "") {
 			return fmt.Errorf("mysql.host and mysql.database are required when vault is not used")
 		}
+		if c.MySQL.UseVault && !c.Vault.Enabled {
+			return fmt.Errorf("mysql.use_vault requires vault to be enabled")
+		}
+		if c.MySQL.SecretName != "" {
+			if c.MySQL.UseVault {
+				return fmt.Errorf("mysql.secret_name and mysql.use_vault cannot be used together")
+			}
+			if c.Secrets.Provider == "" {
+				return fmt.Errorf("mysql.secret_name requires secrets.provider")
+			}
+		}
+		if c.MySQL.InsertBatchSize < 0 {
+			return fmt.Errorf("mysql.insert_batch_size must not be negative")
+		}
+		switch c.MySQL.OnDuplicate {
+		case "", "error", "ignore", "update":
+		default:
+			return fmt.Errorf("mysql.on_duplicate must be error, ignore or update")
+		}
+	}
+
+	if c.Elasticsearch.Enabled {
+		if c.Elasticsearch.Bulk.Workers < 0 || c.Elasticsearch.Bulk.FlushBytes < 0 || c.Elasticsearch.Bulk.FlushInterval < 0 {
+			return fmt.Errorf("elasticsearch.bulk workers, flush_bytes and flush_interval must not be negative")
+		}
+		switch c.Elasticsearch.Bulk.Refresh {
+		case "", "true", "false", "wait_for":
+		default:
+			return fmt.Errorf("elasticsearch.bulk.refresh must be true, false or wait_for")
+		}
 	}
 
 	if c.Cassandra.Enabled {
 		if !c.Cassandra.UseVault && (len(c.Cassandra.Hosts) == 0 || c.Cassandra.Keyspace == "") {
 			return fmt.Errorf("cassandra.hosts and cassandra.keyspace are required when vault is not used")
 		}
+		if c.Cassandra.BatchSize < 0 || c.Cassandra.BatchConcurrency < 0 {
+			return fmt.Errorf("cassandra.batch_size and cassandra.batch_concurrency must not be negative")
+		}
+		if c.Cassandra.NumConns < 0 || c.Cassandra.MaxRetries < 0 {
+			return fmt.Errorf("cassandra.num_conns and cassandra.max_retries must not be negative")
+		}
+		if c.Cassandra.ConnectTimeout < 0 || c.Cassandra.ReconnectInterval < 0 {
+			return fmt.Errorf("cassandra.connect_timeout and cassandra.reconnect_interval must not be negative")
+		}
 	}
 
 	if c.Elasticsearch.Enabled {
@@ -550,8 +1766,30 @@ func (c *Config) Validate() error {
 	}
 
 	if c.Redis.Enabled {
-		if c.Redis.Host == "" {
-			return fmt.Errorf("redis.host is required")
+		switch c.Redis.Mode {
+		case "", "standalone":
+			if c.Redis.Host == "" {
+				return fmt.Errorf("redis.host is required")
+			}
+		case "sentinel":
+			if len(c.Redis.Addresses) == 0 || c.Redis.MasterName == "" {
+				return fmt.Errorf("redis.addresses and redis.master_name are required in sentinel mode")
+			}
+		case "cluster":
+			if len(c.Redis.Addresses) == 0 {
+				return fmt.Errorf("redis.addresses is required in cluster mode")
+			}
+			if c.Redis.DB != 0 {
+				return fmt.Errorf("redis.db must be 0 in cluster mode")
+			}
+		default:
+			return fmt.Errorf("unsupported redis.mode: %s", c.Redis.Mode)
+		}
+		if c.Redis.PoolSize < 0 || c.Redis.MinIdleConns < 0 {
+			return fmt.Errorf("redis.pool_size and redis.min_idle_conns must not be negative")
+		}
+		if c.Redis.PoolTimeout < 0 || c.Redis.ConnMaxIdleTime < 0 || c.Redis.ConnMaxLifetime < 0 {
+			return fmt.Errorf("redis.pool_timeout, redis.conn_max_idle_time and redis.conn_max_lifetime must not be negative")
 		}
 	}
 
@@ -559,6 +1797,163 @@ func (c *Config) Validate() error {
 		if len(c.Kafka.Brokers) == 0 {
 			return fmt.Errorf("kafka.brokers is required")
 		}
+		if sasl := c.Kafka.Security.SASL; sasl.Enabled {
+			switch sasl.Mechanism {
+			case "", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+			default:
+				return fmt.Errorf("kafka.security.sasl.mechanism must be one of PLAIN, SCRAM-SHA-256, SCRAM-SHA-512")
+			}
+			if sasl.UseVault {
+				if !c.Vault.Enabled || c.Vault.Paths.Kafka == "" {
+					return fmt.Errorf("kafka.security.sasl.use_vault requires vault to be enabled with vault.paths.kafka")
+				}
+			} else if sasl.Username == "" || sasl.Password == "" {
+				return fmt.Errorf("kafka.security.sasl.username and password are required")
+			}
+		}
+		producer := c.Kafka.Producer
+		switch producer.Compression {
+		case "", "none", "gzip", "snappy", "lz4", "zstd":
+		default:
+			return fmt.Errorf("kafka.producer.compression must be one of none, gzip, snappy, lz4, zstd")
+		}
+		if producer.MaxMessageBytes < 0 || producer.BatchBytes < 0 || producer.BatchMessages < 0 {
+			return fmt.Errorf("kafka.producer.max_message_bytes, batch_bytes and batch_messages must not be negative")
+		}
+		if producer.Linger < 0 || producer.Timeout < 0 || producer.RetryBackoff < 0 {
+			return fmt.Errorf("kafka.producer.linger, timeout and retry_backoff must not be negative")
+		}
+		if producer.EnableIdempotent && producer.RequiredAcks != 0 && producer.RequiredAcks != -1 {
+			return fmt.Errorf("kafka.producer.enable_idempotent requires required_acks to be -1 (all)")
+		}
+		if c.Kafka.Avro.Enabled {
+			if c.Kafka.Avro.SchemaRegistry.URL == "" {
+				return fmt.Errorf("kafka.avro.schema_registry.url is required")
+			}
+		}
+	}
+
+	if c.NATS.Enabled {
+		if len(c.NATS.Servers) == 0 {
+			return fmt.Errorf("nats.servers is required")
+		}
+		if c.NATS.Token != "" && c.NATS.Username != "" {
+			return fmt.Errorf("nats.token and nats.username are mutually exclusive")
+		}
+		if c.NATS.DuplicateWindow < 0 || c.NATS.Timeout < 0 {
+			return fmt.Errorf("nats.duplicate_window and nats.timeout must not be negative")
+		}
+	}
+
+	switch c.CDC.Format {
+	case "", "native", "cloudevents", "protobuf", "debezium":
+	default:
+		return fmt.Errorf("cdc.format must be native, cloudevents, protobuf or debezium")
+	}
+
+	for i, route := range c.Kafka.CDCRouting {
+		if route.Collection == "" {
+			return fmt.Errorf("kafka.cdc_routing[%d].collection is required", i)
+		}
+		if _, err := path.Match(route.Collection, ""); err != nil {
+			return fmt.Errorf("kafka.cdc_routing[%d].collection is not a valid pattern: %w", i, err)
+		}
+	}
+
+	if c.CDC.DeadLetter.Enabled && c.CDC.DeadLetter.MaxAttempts < 0 {
+		return fmt.Errorf("cdc.dead_letter.max_attempts must not be negative")
+	}
+	if c.CDC.Webhooks.Enabled {
+		if c.CDC.Webhooks.Workers < 0 || c.CDC.Webhooks.MaxAttempts < 0 {
+			return fmt.Errorf("cdc.webhooks.workers and max_attempts must not be negative")
+		}
+		if c.CDC.Webhooks.Timeout < 0 || c.CDC.Webhooks.Backoff < 0 || c.CDC.Webhooks.MaxBackoff < 0 || c.CDC.Webhooks.Retention < 0 {
+			return fmt.Errorf("cdc.webhooks durations must not be negative")
+		}
+	}
+	for i, transform := range c.CDC.Transforms {
+		if transform.Collection == "" {
+			return fmt.Errorf("cdc.transforms[%d].collection is required", i)
+		}
+		if _, err := path.Match(transform.Collection, ""); err != nil {
+			return fmt.Errorf("cdc.transforms[%d].collection is not a valid pattern: %w", i, err)
+		}
+		for _, rename := range transform.Rename {
+			if rename.From == "" || rename.To == "" || strings.Contains(rename.To, ".") {
+				return fmt.Errorf("cdc.transforms[%d].rename requires from and a field name without dots as to", i)
+			}
+		}
+	}
+	if c.Replication.Dedupe.Enabled {
+		switch c.Replication.Dedupe.Store {
+		case "redis", "postgresql", "mysql":
+		default:
+			return fmt.Errorf("replication.dedupe.store must be redis, postgresql or mysql")
+		}
+	}
+	if c.CDC.Replay.Enabled && c.CDC.Replay.MaxEvents < 0 {
+		return fmt.Errorf("cdc.replay.max_events must not be negative")
+	}
+
+	if c.alternativeCDCPublishers() > 1 {
+		return fmt.Errorf("only one of nats, rabbitmq and redis.streams can be enabled as cdc publisher")
+	}
+
+	if c.RabbitMQ.Enabled {
+		if c.RabbitMQ.URL == "" {
+			return fmt.Errorf("rabbitmq.url is required")
+		}
+		switch c.RabbitMQ.ExchangeType {
+		case "", "topic", "direct", "fanout":
+		default:
+			return fmt.Errorf("rabbitmq.exchange_type must be one of topic, direct, fanout")
+		}
+		if c.RabbitMQ.Heartbeat < 0 || c.RabbitMQ.Timeout < 0 {
+			return fmt.Errorf("rabbitmq.heartbeat and rabbitmq.timeout must not be negative")
+		}
+	}
+
+	if c.Redis.Streams.Enabled {
+		if !c.Redis.Enabled {
+			return fmt.Errorf("redis.streams requires redis to be enabled")
+		}
+		if c.Redis.Streams.MaxLen < 0 {
+			return fmt.Errorf("redis.streams.max_len must not be negative")
+		}
+	}
+
+	if c.Server.TLS.Enabled {
+		if c.Server.TLS.UseVault {
+			if !c.Vault.Enabled || c.Vault.Paths.PKI == "" {
+				return fmt.Errorf("server.tls.use_vault requires vault to be enabled with vault.paths.pki")
+			}
+			if c.Server.TLS.CommonName == "" {
+				return fmt.Errorf("server.tls.common_name is required when using vault pki")
+			}
+		} else if c.Server.TLS.CertFile == "" || c.Server.TLS.KeyFile == "" {
+			return fmt.Errorf("server.tls.cert_file and key_file are required")
+		}
+	}
+
+	switch c.Secrets.Provider {
+	case "":
+	case "aws":
+		if c.Secrets.AWS.Region == "" {
+			return fmt.Errorf("secrets.aws.region is required for the aws provider")
+		}
+		if c.Secrets.AWS.AccessKeyID != "" && c.Secrets.AWS.SecretAccessKey == "" {
+			return fmt.Errorf("secrets.aws.secret_access_key is required with secrets.aws.access_key_id")
+		}
+	case "gcp":
+	case "vault":
+		if !c.Vault.Enabled {
+			return fmt.Errorf("secrets.provider vault requires vault to be enabled")
+		}
+	default:
+		return fmt.Errorf("secrets.provider must be aws, gcp or vault")
+	}
+	if c.Secrets.RefreshInterval < 0 {
+		return fmt.Errorf("secrets.refresh_interval must not be negative")
 	}
 
 	if c.Vault.Enabled {
@@ -570,5 +1965,729 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.RateLimit.Enabled {
+		if !c.Redis.Enabled {
+			return fmt.Errorf("rate_limit requires redis to be enabled")
+		}
+		if c.RateLimit.RequestsPerSecond <= 0 || c.RateLimit.Burst <= 0 {
+			return fmt.Errorf("rate_limit.requests_per_second and rate_limit.burst must be positive")
+		}
+		for _, route := range c.RateLimit.Routes {
+			if route.Pattern == "" {
+				return fmt.Errorf("rate_limit.routes[].pattern is required")
+			}
+		}
+	}
+
+	if c.Audit.Enabled {
+		if !c.MongoDB.Enabled {
+			return fmt.Errorf("audit requires mongodb to be enabled")
+		}
+		if c.Audit.Retention < 0 {
+			return fmt.Errorf("audit.retention must not be negative")
+		}
+	}
+
+	if c.Auth.Enabled {
+		if c.Auth.OIDC.IssuerURL == "" {
+			return fmt.Errorf("auth.oidc.issuer_url is required")
+		}
+		if len(c.Auth.OIDC.Audiences) == 0 {
+			return fmt.Errorf("auth.oidc.audiences is required")
+		}
+	}
+
+	for _, group := range c.IPFilter.Groups {
+		if group.Name == "" || len(group.Prefixes) == 0 {
+			return fmt.Errorf("ip_filter.groups[].name and prefixes are required")
+		}
+	}
+
+	if c.Auth.HMAC.Enabled {
+		if !c.Redis.Enabled {
+			return fmt.Errorf("auth.hmac requires redis to be enabled (signature replay protection)")
+		}
+		if len(c.Auth.HMAC.Keys) == 0 {
+			return fmt.Errorf("auth.hmac.keys is required")
+		}
+		for _, key := range c.Auth.HMAC.Keys {
+			if key.KeyID == "" || (key.Secret == "" && key.SecretEnv == "") {
+				return fmt.Errorf("auth.hmac.keys[].key_id and secret or secret_env are required")
+			}
+		}
+	}
+
+	if c.Encryption.Enabled {
+		switch c.Encryption.Provider {
+		case "vault":
+			if !c.Vault.Enabled || c.Encryption.TransitKey == "" {
+				return fmt.Errorf("encryption.provider vault requires vault to be enabled with encryption.transit_key")
+			}
+		case "local":
+			if c.Encryption.LocalKeyEnv == "" {
+				return fmt.Errorf("encryption.local_key_env is required for local provider")
+			}
+		default:
+			return fmt.Errorf("unsupported encryption.provider: %s", c.Encryption.Provider)
+		}
+	}
+
+	if c.PII.Enabled {
+		for _, policy := range c.PII.Policies {
+			if policy.Collection == "" || policy.Action == "" {
+				return fmt.Errorf("pii.policies[].collection and action are required")
+			}
+		}
+	}
+
+	if c.SchemaValidation.Enabled {
+		if len(c.SchemaValidation.Collections) == 0 {
+			return fmt.Errorf("schema_validation.collections is required when schema validation is enabled")
+		}
+		for _, coll := range c.SchemaValidation.Collections {
+			if coll.Name == "" || coll.SchemaFile == "" {
+				return fmt.Errorf("schema_validation.collections[].name and schema_file are required")
+			}
+		}
+	}
+
+	if c.UniqueKeys.Enabled {
+		if len(c.UniqueKeys.Constraints) == 0 {
+			return fmt.Errorf("unique_keys.constraints is required when unique keys are enabled")
+		}
+		for _, uk := range c.UniqueKeys.Constraints {
+			if uk.Collection == "" || len(uk.Fields) == 0 {
+				return fmt.Errorf("unique_keys.constraints[].collection and fields are required")
+			}
+		}
+	}
+
+	if c.References.Enabled {
+		if len(c.References.Definitions) == 0 {
+			return fmt.Errorf("references.definitions is required when references are enabled")
+		}
+		for i, ref := range c.References.Definitions {
+			if ref.Collection == "" || ref.Field == "" || ref.Target == "" {
+				return fmt.Errorf("references.definitions[%d]: collection, field and target are required", i)
+			}
+			switch ref.OnDelete {
+			case "", "none", "cascade", "nullify":
+			default:
+				return fmt.Errorf("references.definitions[%d]: unsupported on_delete: %s", i, ref.OnDelete)
+			}
+		}
+		if c.References.MaxCascade < 0 {
+			return fmt.Errorf("references.max_cascade must not be negative")
+		}
+	}
+
+	switch c.Cache.Backend {
+	case "", "redis":
+	case "memcached":
+		if len(c.Cache.Memcached.Servers) == 0 {
+			return fmt.Errorf("cache.memcached.servers is required when cache.backend is memcached")
+		}
+	default:
+		return fmt.Errorf("unsupported cache.backend: %s", c.Cache.Backend)
+	}
+	for i, target := range c.Cache.Warmup.Collections {
+		if target.Collection == "" {
+			return fmt.Errorf("cache.warmup.collections[%d].collection is required", i)
+		}
+		if len(target.IDs) == 0 && target.Limit <= 0 {
+			return fmt.Errorf("cache.warmup.collections[%d] requires ids or a positive limit", i)
+		}
+	}
+	if c.Cache.Query.Enabled && c.Cache.Query.TTL <= 0 {
+		return fmt.Errorf("cache.query.ttl must be positive when query caching is enabled")
+	}
+	if c.Cache.EarlyRefresh < 0 {
+		return fmt.Errorf("cache.early_refresh_beta must not be negative")
+	}
+	if c.Cache.Local.Enabled && c.Cache.Local.TTL > c.Cache.DefaultTTL && c.Cache.DefaultTTL > 0 {
+		return fmt.Errorf("cache.local.ttl must not exceed cache.default_ttl")
+	}
+	if c.Cache.CDCInvalidation.Enabled && (!c.Kafka.Enabled || !c.Kafka.EnableCDC) {
+		return fmt.Errorf("cache.cdc_invalidation requires kafka and kafka.enable_cdc to be enabled")
+	}
+	if c.Cache.CDCInvalidation.Enabled && c.alternativeCDCPublishers() > 0 {
+		return fmt.Errorf("cache.cdc_invalidation consumes kafka cdc topics and cannot be used with nats, rabbitmq or redis.streams")
+	}
+	for _, policy := range c.Cache.Policies {
+		if policy.Collection == "" || policy.Strategy == "" {
+			return fmt.Errorf("cache.policies[].collection and strategy are required")
+		}
+		if policy.MaxDocumentSize < 0 {
+			return fmt.Errorf("cache.policies[].max_document_size must not be negative")
+		}
+		if policy.StaleWhileRevalidate < 0 {
+			return fmt.Errorf("cache.policies[].stale_while_revalidate must not be negative")
+		}
+	}
+
+	if c.MongoDB.ReadReplica.Enabled {
+		switch c.MongoDB.ReadReplica.ReadPreference {
+		case "", "primaryPreferred", "secondary", "secondaryPreferred", "nearest":
+		default:
+			return fmt.Errorf("mongodb.read_replica.read_preference must be primaryPreferred, secondary, secondaryPreferred or nearest")
+		}
+		if c.MongoDB.ReadReplica.MaxStaleness < 0 || (c.MongoDB.ReadReplica.MaxStaleness > 0 && c.MongoDB.ReadReplica.MaxStaleness < 90*time.Second) {
+			return fmt.Errorf("mongodb.read_replica.max_staleness must be 0 or at least 90s")
+		}
+		switch c.MongoDB.ReadReplica.ReadConcern {
+		case "", "local", "available", "majority", "linearizable":
+		default:
+			return fmt.Errorf("mongodb.read_replica.read_concern must be local, available, majority or linearizable")
+		}
+	}
+	if c.PostgreSQL.ReadReplica.Enabled && c.PostgreSQL.ReadReplica.Host == "" {
+		return fmt.Errorf("postgresql.read_replica.host is required when read replica is enabled")
+	}
+	for i, rule := range c.PostgreSQL.Partitioning.Rules {
+		if rule.Collection == "" {
+			return fmt.Errorf("postgresql.partitioning.rules[%d].collection is required", i)
+		}
+		switch rule.Strategy {
+		case "range":
+			switch rule.Interval {
+			case "", "day", "week", "month":
+			default:
+				return fmt.Errorf("postgresql.partitioning.rules[%d].interval must be day, week or month", i)
+			}
+			if rule.Premake < 0 {
+				return fmt.Errorf("postgresql.partitioning.rules[%d].premake must not be negative", i)
+			}
+		case "hash":
+			if rule.Modulus < 2 {
+				return fmt.Errorf("postgresql.partitioning.rules[%d].modulus must be at least 2", i)
+			}
+		default:
+			return fmt.Errorf("postgresql.partitioning.rules[%d].strategy must be range or hash", i)
+		}
+	}
+	if c.PostgreSQL.Partitioning.MaintenanceInterval < 0 {
+		return fmt.Errorf("postgresql.partitioning.maintenance_interval must not be negative")
+	}
+	if c.MySQL.ReadReplica.Enabled && c.MySQL.ReadReplica.Host == "" {
+		return fmt.Errorf("mysql.read_replica.host is required when read replica is enabled")
+	}
+	if c.ReadRouting.Enabled {
+		switch c.ReadRouting.Default {
+		case "", "primary", "replica":
+		default:
+			return fmt.Errorf("read_routing.default must be primary or replica")
+		}
+		for _, route := range c.ReadRouting.Collections {
+			if route.Collection == "" {
+				return fmt.Errorf("read_routing.collections[].collection is required")
+			}
+			if route.Mode != "primary" && route.Mode != "replica" {
+				return fmt.Errorf("read_routing.collections[].mode must be primary or replica")
+			}
+		}
+	}
+
+	if c.BackendRouting.Default != "" {
+		if !isDatabaseType(c.BackendRouting.Default) {
+			return fmt.Errorf("backend_routing.default: unknown database type %q", c.BackendRouting.Default)
+		}
+		if !c.databaseEnabled(c.BackendRouting.Default) {
+			return fmt.Errorf("backend_routing.default: database %q is not enabled", c.BackendRouting.Default)
+		}
+	}
+	if c.BackendRouting.DrainTimeout < 0 {
+		return fmt.Errorf("backend_routing.drain_timeout must not be negative")
+	}
+	for i, route := range c.BackendRouting.Collections {
+		if route.Collection == "" {
+			return fmt.Errorf("backend_routing.collections[%d].collection is required", i)
+		}
+		if _, err := path.Match(route.Collection, ""); err != nil {
+			return fmt.Errorf("backend_routing.collections[%d].collection is not a valid pattern: %w", i, err)
+		}
+		if !isDatabaseType(route.Backend) {
+			return fmt.Errorf("backend_routing.collections[%d]: unknown database type %q", i, route.Backend)
+		}
+		if !c.databaseEnabled(route.Backend) {
+			return fmt.Errorf("backend_routing.collections[%d]: database %q is not enabled", i, route.Backend)
+		}
+	}
+
+	if c.BulkWrite.Workers < 0 {
+		return fmt.Errorf("bulk_write.workers must not be negative")
+	}
+	if c.BulkWrite.MinOperations < 0 {
+		return fmt.Errorf("bulk_write.min_operations must not be negative")
+	}
+
+	if c.WriteBatching.Enabled {
+		if len(c.WriteBatching.Collections) == 0 {
+			return fmt.Errorf("write_batching.collections is required when write batching is enabled")
+		}
+		if c.WriteBatching.MaxDelay < 0 || c.WriteBatching.FlushTimeout < 0 {
+			return fmt.Errorf("write_batching.max_delay and flush_timeout must not be negative")
+		}
+		if c.WriteBatching.MaxBatch < 0 {
+			return fmt.Errorf("write_batching.max_batch must not be negative")
+		}
+	}
+
+	if c.Expiry.Enabled {
+		if len(c.Expiry.Collections) == 0 {
+			return fmt.Errorf("expiry.collections is required when expiry is enabled")
+		}
+		for _, coll := range c.Expiry.Collections {
+			if coll.Name == "" {
+				return fmt.Errorf("expiry.collections[].name is required")
+			}
+			if coll.DefaultTTL < 0 {
+				return fmt.Errorf("expiry.collections[].default_ttl must not be negative")
+			}
+		}
+		if c.Expiry.SweepInterval < 0 || c.Expiry.SweepBatchSize < 0 {
+			return fmt.Errorf("expiry.sweep_interval and sweep_batch_size must not be negative")
+		}
+	}
+
+	if c.SoftDelete.Enabled {
+		if len(c.SoftDelete.Collections) == 0 {
+			return fmt.Errorf("soft_delete.collections is required when soft delete is enabled")
+		}
+		for _, coll := range c.SoftDelete.Collections {
+			if coll.Name == "" {
+				return fmt.Errorf("soft_delete.collections[].name is required")
+			}
+			if coll.Retention < 0 {
+				return fmt.Errorf("soft_delete.collections[].retention must not be negative")
+			}
+		}
+		if c.SoftDelete.PurgeInterval < 0 {
+			return fmt.Errorf("soft_delete.purge_interval must not be negative")
+		}
+	}
+
+	if c.Revisions.Enabled {
+		switch c.Revisions.Store {
+		case "", "mongodb", "postgresql":
+		default:
+			return fmt.Errorf("revisions.store must be mongodb or postgresql")
+		}
+		if len(c.Revisions.Collections) == 0 {
+			return fmt.Errorf("revisions.collections is required when revisions are enabled")
+		}
+		for _, coll := range c.Revisions.Collections {
+			if coll.Name == "" {
+				return fmt.Errorf("revisions.collections[].name is required")
+			}
+			if coll.MaxRevisions < 0 || coll.Retention < 0 {
+				return fmt.Errorf("revisions.collections[].max_revisions and retention must not be negative")
+			}
+		}
+	}
+
+	if c.Retention.Enabled {
+		if len(c.Retention.Collections) == 0 {
+			return fmt.Errorf("retention.collections is required when retention is enabled")
+		}
+		for _, coll := range c.Retention.Collections {
+			if coll.Name == "" {
+				return fmt.Errorf("retention.collections[].name is required")
+			}
+			if coll.MaxAge < 0 || coll.MaxCount < 0 {
+				return fmt.Errorf("retention.collections[].max_age and max_count must not be negative")
+			}
+			if coll.MaxAge == 0 && coll.MaxCount == 0 {
+				return fmt.Errorf("retention.collections[%s] requires max_age or max_count", coll.Name)
+			}
+		}
+		if c.Retention.Interval < 0 {
+			return fmt.Errorf("retention.interval must not be negative")
+		}
+	}
+
+	if c.Archive.Enabled {
+		switch c.Archive.Storage {
+		case "local":
+			if c.Archive.LocalPath == "" {
+				return fmt.Errorf("archive.local_path is required when archive storage is local")
+			}
+		case "s3":
+			if c.Archive.S3.Bucket == "" {
+				return fmt.Errorf("archive.s3.bucket is required when archive storage is s3")
+			}
+		default:
+			return fmt.Errorf("archive.storage must be local or s3")
+		}
+		if len(c.Archive.Collections) == 0 {
+			return fmt.Errorf("archive.collections is required when archive is enabled")
+		}
+		for _, coll := range c.Archive.Collections {
+			if coll.Name == "" {
+				return fmt.Errorf("archive.collections[].name is required")
+			}
+			if coll.After <= 0 {
+				return fmt.Errorf("archive.collections[%s].after must be positive", coll.Name)
+			}
+		}
+		if c.Archive.Interval < 0 || c.Archive.BatchSize < 0 {
+			return fmt.Errorf("archive.interval and batch_size must not be negative")
+		}
+	}
+
+	if c.Backup.Enabled {
+		switch c.Backup.Storage {
+		case "local":
+			if c.Backup.LocalPath == "" {
+				return fmt.Errorf("backup.local_path is required when backup storage is local")
+			}
+		case "s3":
+			if c.Backup.S3.Bucket == "" {
+				return fmt.Errorf("backup.s3.bucket is required when backup storage is s3")
+			}
+		default:
+			return fmt.Errorf("backup.storage must be local or s3")
+		}
+		if c.Backup.BatchSize < 0 {
+			return fmt.Errorf("backup.batch_size must not be negative")
+		}
+		if c.Backup.Schedule.Enabled {
+			if c.Backup.Schedule.Interval <= 0 {
+				return fmt.Errorf("backup.schedule.interval must be positive")
+			}
+			if len(c.Backup.Schedule.Collections) == 0 {
+				return fmt.Errorf("backup.schedule.collections must not be empty")
+			}
+		}
+	}
+
+	if c.Maintenance.Enabled {
+		for _, schedule := range c.Maintenance.Schedules {
+			if schedule.DatabaseType == "" || schedule.Collection == "" || schedule.Operation == "" {
+				return fmt.Errorf("maintenance.schedules[] requires database_type, collection and operation")
+			}
+			if schedule.Interval < 0 || schedule.MaxSegments < 0 {
+				return fmt.Errorf("maintenance.schedules[%s].interval and max_segments must not be negative", schedule.Collection)
+			}
+		}
+	}
+
+	if c.Anonymization.Enabled && (c.Anonymization.BatchSize < 0 || c.Anonymization.BatchInterval < 0) {
+		return fmt.Errorf("anonymization.batch_size and batch_interval must not be negative")
+	}
+
+	if c.SearchMirror.Enabled {
+		seen := make(map[string]bool, len(c.SearchMirror.Collections))
+		for _, mirror := range c.SearchMirror.Collections {
+			if mirror.Collection == "" {
+				return fmt.Errorf("search_mirror.collections[] requires collection")
+			}
+			if seen[mirror.Collection] {
+				return fmt.Errorf("search_mirror.collections[%s] is duplicated", mirror.Collection)
+			}
+			seen[mirror.Collection] = true
+			if mirror.Source == "elasticsearch" {
+				return fmt.Errorf("search_mirror.collections[%s].source must not be elasticsearch", mirror.Collection)
+			}
+			if mirror.VerifyInterval < 0 {
+				return fmt.Errorf("search_mirror.collections[%s].verify_interval must not be negative", mirror.Collection)
+			}
+		}
+		if c.SearchMirror.Sync.Enabled {
+			if !c.Kafka.Enabled || !c.Kafka.EnableCDC {
+				return fmt.Errorf("search_mirror.sync requires kafka.enabled and kafka.enable_cdc")
+			}
+			switch c.SearchMirror.Sync.InitialOffset {
+			case "", "newest", "oldest":
+			default:
+				return fmt.Errorf("search_mirror.sync.initial_offset must be newest or oldest")
+			}
+		}
+	}
+
+	if c.Fixtures.Enabled && c.Fixtures.Directory == "" {
+		return fmt.Errorf("fixtures.directory is required")
+	}
+
+	if c.OnlineMigration.Enabled && c.OnlineMigration.BatchSize < 0 {
+		return fmt.Errorf("online_migration.batch_size must not be negative")
+	}
+
+	if c.Tenants.Enabled {
+		if !c.MongoDB.Enabled {
+			return fmt.Errorf("tenants requires mongodb to be enabled")
+		}
+		if !c.Auth.Enabled && !c.Auth.HMAC.Enabled {
+			return fmt.Errorf("tenants requires auth or auth.hmac to be enabled")
+		}
+		for _, role := range c.Tenants.DefaultAPIKeyRoles {
+			if role != "reader" && role != "writer" {
+				return fmt.Errorf("tenants.default_api_key_roles must be reader or writer")
+			}
+		}
+		if c.Tenants.DefaultQuota.MaxDocuments < 0 || c.Tenants.DefaultQuota.MaxBytes < 0 || c.Tenants.APIKeyCacheTTL < 0 {
+			return fmt.Errorf("tenants values must not be negative")
+		}
+		for _, coll := range c.Tenants.DefaultCollections {
+			if coll.Name == "" {
+				return fmt.Errorf("tenants.default_collections[].name is required")
+			}
+		}
+		if c.Tenants.CDCTopics.Enabled && !c.Kafka.Enabled {
+			return fmt.Errorf("tenants.cdc_topics requires kafka to be enabled")
+		}
+	}
+
+	if c.Quotas.Enabled {
+		if c.Quotas.RefreshInterval < 0 || c.Quotas.Default.MaxDocuments < 0 || c.Quotas.Default.MaxBytes < 0 {
+			return fmt.Errorf("quotas values must not be negative")
+		}
+		for i, q := range c.Quotas.Collections {
+			if q.Collection == "" {
+				return fmt.Errorf("quotas.collections[%d].collection is required", i)
+			}
+			if _, err := path.Match(q.Collection, ""); err != nil {
+				return fmt.Errorf("quotas.collections[%d].collection is not a valid pattern: %w", i, err)
+			}
+			if q.MaxDocuments < 0 || q.MaxBytes < 0 {
+				return fmt.Errorf("quotas.collections[%d] limits must not be negative", i)
+			}
+		}
+	}
+
+	if c.FeatureFlags.Enabled {
+		if c.FeatureFlags.RefreshInterval < 0 {
+			return fmt.Errorf("feature_flags.refresh_interval must not be negative")
+		}
+		names := make(map[string]bool, len(c.FeatureFlags.Flags))
+		for i, flag := range c.FeatureFlags.Flags {
+			if flag.Name == "" || strings.ContainsAny(flag.Name, "@ ") {
+				return fmt.Errorf("feature_flags.flags[%d].name is required and must not contain '@' or spaces", i)
+			}
+			if names[flag.Name] {
+				return fmt.Errorf("feature_flags.flags: duplicate flag name %q", flag.Name)
+			}
+			names[flag.Name] = true
+			for j, tenant := range flag.Tenants {
+				if tenant.Tenant == "" {
+					return fmt.Errorf("feature_flags.flags[%d].tenants[%d].tenant is required", i, j)
+				}
+			}
+		}
+	}
+
+	if c.LeaderElection.Enabled {
+		le := c.LeaderElection
+		if le.LeaseDuration < 0 || le.RenewDeadline < 0 || le.RetryPeriod < 0 {
+			return fmt.Errorf("leader_election durations must not be negative")
+		}
+		if le.LeaseDuration > 0 && le.RenewDeadline > 0 && le.RenewDeadline >= le.LeaseDuration {
+			return fmt.Errorf("leader_election.renew_deadline must be shorter than lease_duration")
+		}
+		// client-go leaderelection은 갱신 간격에 최대 1.2배 지터를 더합니다
+		if le.RenewDeadline > 0 && le.RetryPeriod > 0 && le.RenewDeadline <= le.RetryPeriod*12/10 {
+			return fmt.Errorf("leader_election.renew_deadline must be longer than 1.2 x retry_period")
+		}
+	}
+
+	if c.Sharding.Enabled {
+		if c.Sharding.DatabaseType != "mongodb" {
+			return fmt.Errorf("sharding.database_type must be mongodb")
+		}
+		if len(c.Sharding.Shards) == 0 {
+			return fmt.Errorf("sharding.shards must not be empty")
+		}
+		if c.MongoDB.ReadReplica.Enabled {
+			return fmt.Errorf("sharding cannot be used with mongodb.read_replica")
+		}
+		names := make(map[string]bool, len(c.Sharding.Shards))
+		for _, shard := range c.Sharding.Shards {
+			if shard.Name == "" || shard.URI == "" {
+				return fmt.Errorf("sharding.shards[].name and uri are required")
+			}
+			if names[shard.Name] {
+				return fmt.Errorf("sharding.shards: duplicate shard name %q", shard.Name)
+			}
+			names[shard.Name] = true
+		}
+	}
+
+	if err := c.CircuitBreaker.validate("circuit_breaker"); err != nil {
+		return err
+	}
+	for name, settings := range c.CircuitBreaker.Backends {
+		if !isDatabaseType(name) {
+			return fmt.Errorf("circuit_breaker.backends: unknown database type %q", name)
+		}
+		if err := settings.validate("circuit_breaker.backends." + name); err != nil {
+			return err
+		}
+	}
+
+	if c.Retry.MaxAttempts < 0 {
+		return fmt.Errorf("retry.max_attempts must not be negative")
+	}
+	if c.Retry.InitialInterval < 0 || c.Retry.MaxInterval < 0 || c.Retry.MaxElapsedTime < 0 {
+		return fmt.Errorf("retry intervals must not be negative")
+	}
+	if c.Retry.Multiplier != 0 && c.Retry.Multiplier < 1 {
+		return fmt.Errorf("retry.multiplier must be at least 1")
+	}
+	switch c.Retry.Jitter {
+	case "", "full", "none":
+	default:
+		return fmt.Errorf("retry.jitter must be full or none")
+	}
+	if c.Retry.Budget.Ratio < 0 || c.Retry.Budget.MinPerSecond < 0 || c.Retry.Budget.Max < 0 {
+		return fmt.Errorf("retry.budget values must not be negative")
+	}
+
+	if c.Timeouts.Read < 0 || c.Timeouts.Write < 0 || c.Timeouts.Admin < 0 {
+		return fmt.Errorf("timeouts must not be negative")
+	}
+
+	if c.Observability.Profiling.Port < 0 || c.Observability.Profiling.Port > 65535 {
+		return fmt.Errorf("observability.profiling.port must be between 0 and 65535")
+	}
+	if c.Observability.Profiling.Push.Enabled {
+		if c.Observability.Profiling.Push.ServerAddress == "" {
+			return fmt.Errorf("observability.profiling.push.server_address is required when profile push is enabled")
+		}
+		if c.Observability.Profiling.Push.Interval < 0 {
+			return fmt.Errorf("observability.profiling.push.interval must not be negative")
+		}
+	}
+
+	if c.PoolHealth.WarmUpTimeout < 0 || c.PoolHealth.ValidationInterval < 0 || c.PoolHealth.ValidationTimeout < 0 {
+		return fmt.Errorf("pool_health timeouts and interval must not be negative")
+	}
+
+	if c.LoadShedding.Enabled {
+		if c.LoadShedding.InitialLimit < 0 || c.LoadShedding.MinLimit < 0 || c.LoadShedding.MaxLimit < 0 {
+			return fmt.Errorf("load_shedding limits must not be negative")
+		}
+		if c.LoadShedding.MaxLimit > 0 && c.LoadShedding.MinLimit > c.LoadShedding.MaxLimit {
+			return fmt.Errorf("load_shedding.min_limit must not exceed max_limit")
+		}
+		if c.LoadShedding.Smoothing < 0 || c.LoadShedding.Smoothing > 1 {
+			return fmt.Errorf("load_shedding.smoothing must be between 0 and 1")
+		}
+		if c.LoadShedding.Tolerance != 0 && c.LoadShedding.Tolerance < 1 {
+			return fmt.Errorf("load_shedding.tolerance must be at least 1")
+		}
+	}
+
+	if c.Replication.Enabled {
+		if !c.Kafka.Enabled || !c.Kafka.EnableCDC {
+			return fmt.Errorf("replication requires kafka and kafka.enable_cdc to be enabled")
+		}
+		if c.alternativeCDCPublishers() > 0 {
+			return fmt.Errorf("replication consumes kafka cdc topics and cannot be used with nats, rabbitmq or redis.streams")
+		}
+		if c.Replication.Target.Type != "mongodb" {
+			return fmt.Errorf("unsupported replication.target.type: %s", c.Replication.Target.Type)
+		}
+		if c.Replication.Target.URI == "" || c.Replication.Target.Database == "" {
+			return fmt.Errorf("replication.target.uri and database are required")
+		}
+		if c.Replication.InitialOffset != "" && c.Replication.InitialOffset != "oldest" && c.Replication.InitialOffset != "newest" {
+			return fmt.Errorf("replication.initial_offset must be oldest or newest")
+		}
+	}
+
+	if c.CDCBridge.Enabled {
+		if !c.Kafka.Enabled || !c.Kafka.EnableCDC {
+			return fmt.Errorf("cdc_bridge requires kafka and kafka.enable_cdc to be enabled")
+		}
+		if c.CDCBridge.CheckpointInterval < 0 {
+			return fmt.Errorf("cdc_bridge.checkpoint_interval must not be negative")
+		}
+		switch c.CDCBridge.Source {
+		case "", "mongodb":
+		case "mysql":
+			if !c.MySQL.Enabled {
+				return fmt.Errorf("cdc_bridge.source mysql requires mysql to be enabled")
+			}
+		default:
+			return fmt.Errorf("cdc_bridge.source must be mongodb or mysql")
+		}
+	}
+
+	if c.Auth.Impersonation.Enabled && !c.Auth.Enabled && !c.Auth.HMAC.Enabled {
+		return fmt.Errorf("auth.impersonation requires auth or auth.hmac to be enabled")
+	}
+
+	if c.Auth.Lockout.Enabled {
+		if c.Auth.Lockout.MaxFailures < 0 || c.Auth.Lockout.Window < 0 || c.Auth.Lockout.BaseDuration < 0 ||
+			c.Auth.Lockout.MaxDuration < 0 || c.Auth.Lockout.LevelTTL < 0 {
+			return fmt.Errorf("auth.lockout values must not be negative")
+		}
+	}
+
+	if len(c.Auth.RowPolicies) > 0 && !c.Auth.Enabled && !c.Auth.HMAC.Enabled {
+		return fmt.Errorf("auth.row_policies requires auth or auth.hmac to be enabled")
+	}
+	for _, policy := range c.Auth.RowPolicies {
+		if policy.Collection == "" || policy.Field == "" || policy.Claim == "" {
+			return fmt.Errorf("auth.row_policies[].collection, field and claim are required")
+		}
+	}
+
+	if c.Observability.Metrics.PoolStatsInterval < 0 {
+		return fmt.Errorf("observability.metrics.pool_stats_interval must not be negative")
+	}
+
+	return nil
+}
+
+// alternativeCDCPublishers는 활성화된 Kafka 외 CDC 발행자(nats, rabbitmq, redis.streams) 수를 반환합니다
+func (c *Config) alternativeCDCPublishers() int {
+	count := 0
+	for _, enabled := range []bool{c.NATS.Enabled, c.RabbitMQ.Enabled, c.Redis.Streams.Enabled} {
+		if enabled {
+			count++
+		}
+	}
+	return count
+}
+
+// isDatabaseType은 name이 지원하는 데이터베이스 종류인지 확인합니다
+func isDatabaseType(name string) bool {
+	switch name {
+	case "mongodb", "postgresql", "mysql", "cassandra", "elasticsearch", "vitess":
+		return true
+	}
+	return false
+}
+
+// databaseEnabled는 데이터베이스 종류가 설정에서 활성화되어 있는지 확인합니다
+func (c *Config) databaseEnabled(name string) bool {
+	switch name {
+	case "mongodb":
+		return c.MongoDB.Enabled
+	case "postgresql":
+		return c.PostgreSQL.Enabled
+	case "mysql":
+		return c.MySQL.Enabled
+	case "cassandra":
+		return c.Cassandra.Enabled
+	case "elasticsearch":
+		return c.Elasticsearch.Enabled
+	case "vitess":
+		return c.Vitess.Enabled
+	}
+	return false
+}
+
+// validate는 circuit breaker 임계값을 검증합니다 (prefix는 에러 메시지의 설정 경로)
+func (s CircuitBreakerSettings) validate(prefix string) error {
+	if s.FailureRatio < 0 || s.FailureRatio > 1 {
+		return fmt.Errorf("%s.failure_ratio must be between 0 and 1", prefix)
+	}
+	if s.Interval < 0 {
+		return fmt.Errorf("%s.interval must not be negative", prefix)
+	}
+	if s.OpenTimeout < 0 {
+		return fmt.Errorf("%s.open_timeout must not be negative", prefix)
+	}
 	return nil
 }
@@ -0,0 +1,192 @@
+package config
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// redactedValue는 비밀 값 대신 출력하는 값입니다
+const redactedValue = "[REDACTED]"
+
+// 연결 확인 결과 상태
+const (
+	ProbeStatusOK      = "ok"
+	ProbeStatusFailed  = "failed"
+	ProbeStatusSkipped = "skipped"
+)
+
+// ProbeResult는 백엔드 하나의 연결 확인 결과입니다
+type ProbeResult struct {
+	Backend   string `json:"backend"`
+	Status    string `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"` // failed이면 연결 오류, skipped이면 건너뛴 이유
+}
+
+// ProbeFunc는 설정에서 활성화된 백엔드마다 실제로 연결해 보고 결과를 반환합니다
+type ProbeFunc func(ctx context.Context, cfg *Config) []ProbeResult
+
+// Report는 설정 검증과 연결 확인 결과입니다
+type Report struct {
+	Valid   bool                   `json:"valid"`
+	Error   string                 `json:"error,omitempty"`   // 읽기 또는 검증 오류
+	Sources *Sources               `json:"sources,omitempty"` // 활성 프로필과 병합한 설정 파일
+	Probes  []ProbeResult          `json:"probes,omitempty"`  // 설정이 유효할 때만 실행
+	Config  map[string]interface{} `json:"config,omitempty"`  // 비밀 값을 가린 최종 설정 (오버레이와 환경변수 적용 후)
+}
+
+// OK는 설정이 유효하고 연결에 실패한 백엔드가 없는지 확인합니다
+func (r *Report) OK() bool {
+	if !r.Valid {
+		return false
+	}
+	for _, probe := range r.Probes {
+		if probe.Status == ProbeStatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// Check는 설정 파일을 읽어 검증하고, 유효하면 probe로 백엔드 연결을 확인합니다
+// 읽기에 성공하면 검증 결과와 관계없이 비밀 값을 가린 설정을 보고서에 포함합니다
+func Check(ctx context.Context, configPath, configName string, probe ProbeFunc) *Report {
+	cfg, sources, err := LoadConfigWithSources(configPath, configName)
+	if err != nil {
+		return &Report{Error: err.Error()}
+	}
+
+	report := &Report{Sources: sources, Config: cfg.Redacted()}
+	if err := cfg.Validate(); err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	report.Valid = true
+	if probe != nil {
+		report.Probes = probe(ctx, cfg)
+	}
+	return report
+}
+
+// Checker는 정해진 설정 파일에 대해 Check를 실행합니다 (관리 API용)
+type Checker struct {
+	configPath string
+	configName string
+	probe      ProbeFunc
+}
+
+// NewChecker는 새로운 Checker를 생성합니다
+func NewChecker(configPath, configName string, probe ProbeFunc) *Checker {
+	return &Checker{
+		configPath: configPath,
+		configName: configName,
+		probe:      probe,
+	}
+}
+
+// Check는 설정 파일을 다시 읽어 검증하고 연결을 확인합니다
+func (c *Checker) Check(ctx context.Context) *Report {
+	return Check(ctx, c.configPath, c.configName, c.probe)
+}
+
+// Redacted는 비밀번호, 토큰, 키 같은 비밀 값과 URI의 비밀번호를 가린 설정을 설정 파일의 키 이름으로 반환합니다
+func (c *Config) Redacted() map[string]interface{} {
+	redacted, _ := redactValue(reflect.ValueOf(*c)).(map[string]interface{})
+	return redacted
+}
+
+// redactValue는 값을 설정 키 이름의 맵/슬라이스로 변환하면서 비밀 값을 가립니다
+func redactValue(v reflect.Value) interface{} {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		out := make(map[string]interface{}, v.NumField())
+		redactStruct(v, out)
+		return out
+	case reflect.Slice, reflect.Array:
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			out[i] = redactValue(v.Index(i))
+		}
+		return out
+	case reflect.Map:
+		out := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[iter.Key().String()] = redactValue(iter.Value())
+		}
+		return out
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return redactValue(v.Elem())
+	case reflect.String:
+		return redactURL(v.String())
+	default:
+		return v.Interface()
+	}
+}
+
+// redactStruct는 구조체 필드를 mapstructure 키 이름으로 out에 채웁니다 (squash 필드는 펼칩니다)
+func redactStruct(v reflect.Value, out map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if opts == "squash" && field.Type.Kind() == reflect.Struct {
+			redactStruct(v.Field(i), out)
+			continue
+		}
+		if name == "" || name == "-" {
+			name = strings.ToLower(field.Name)
+		}
+
+		value := v.Field(i)
+		if isSecretKey(name) && value.Kind() == reflect.String {
+			if value.String() != "" {
+				out[name] = redactedValue
+			} else {
+				out[name] = ""
+			}
+			continue
+		}
+		out[name] = redactValue(value)
+	}
+}
+
+// isSecretKey는 설정 키가 비밀 값을 담는지 확인합니다
+func isSecretKey(name string) bool {
+	switch name {
+	case "secret", "secret_id", "token":
+		return true
+	}
+	return strings.HasSuffix(name, "password") ||
+		strings.HasSuffix(name, "_token") ||
+		strings.HasSuffix(name, "api_key") ||
+		strings.HasSuffix(name, "access_key")
+}
+
+// redactURL은 URI에 포함된 비밀번호를 가립니다 (URI가 아니면 그대로 반환)
+func redactURL(s string) string {
+	if !strings.Contains(s, "://") {
+		return s
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); !ok {
+		return s
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ProfileEnv는 설정 프로필(환경)을 고르는 환경변수입니다
+const ProfileEnv = "APP_ENVIRONMENT"
+
+// Sources는 최종 설정을 만든 프로필과 설정 파일입니다
+type Sources struct {
+	Profile string   `json:"profile,omitempty"`
+	Files   []string `json:"files"` // 병합 순서 (뒤의 파일이 앞의 값을 덮어씀)
+}
+
+// readLayered는 기본 설정 파일을 읽고 활성 프로필의 오버레이 파일(<이름>.<프로필>.yaml)을 병합합니다
+// 우선순위: 기본 파일 < 프로필 오버레이 < 환경변수. 맵은 키 단위로 병합되고 목록은 통째로 바뀝니다
+// 프로필은 APP_ENVIRONMENT, 없으면 기본 파일의 app.environment이며 오버레이 파일이 없으면 기본 파일만 사용합니다
+func readLayered(configPath string, configName string) (*viper.Viper, *Sources, error) {
+	v := newViper(configPath, configName)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	base := v.ConfigFileUsed()
+	sources := &Sources{
+		Profile: activeProfile(v),
+		Files:   []string{base},
+	}
+	if sources.Profile == "" {
+		return v, sources, nil
+	}
+	if strings.ContainsAny(sources.Profile, `/\`) || strings.Contains(sources.Profile, "..") {
+		return nil, nil, fmt.Errorf("invalid config profile %q", sources.Profile)
+	}
+
+	overlay := overlayFile(base, sources.Profile)
+	f, err := os.Open(overlay)
+	if errors.Is(err, fs.ErrNotExist) {
+		return v, sources, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open config overlay %s: %w", overlay, err)
+	}
+	defer f.Close()
+
+	if err := v.MergeConfig(f); err != nil {
+		return nil, nil, fmt.Errorf("failed to merge config overlay %s: %w", overlay, err)
+	}
+	sources.Files = append(sources.Files, overlay)
+	return v, sources, nil
+}
+
+// activeProfile은 APP_ENVIRONMENT, 없으면 기본 설정 파일의 app.environment를 반환합니다
+func activeProfile(v *viper.Viper) string {
+	if profile := strings.TrimSpace(os.Getenv(ProfileEnv)); profile != "" {
+		return profile
+	}
+	return strings.TrimSpace(v.GetString("app.environment"))
+}
+
+// overlayFile은 기본 설정 파일과 같은 디렉터리의 프로필 오버레이 경로를 반환합니다 (config.yaml -> config.production.yaml)
+func overlayFile(base, profile string) string {
+	ext := filepath.Ext(base)
+	return strings.TrimSuffix(base, ext) + "." + profile + ext
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// 설정 다시 읽기 원인
+const (
+	ReloadSourceSignal = "sighup"
+	ReloadSourceFile   = "file"
+)
+
+// fileChangeDebounce는 설정 파일 변경 이벤트를 모으는 시간입니다
+// 편집기와 ConfigMap 갱신은 한 번의 저장에도 여러 이벤트를 만들고 중간에 내용이 비어 있을 수 있습니다
+const fileChangeDebounce = 500 * time.Millisecond
+
+// Watch는 SIGHUP을 받거나 (watchFile이면) 설정 파일이나 프로필 오버레이가 바뀔 때마다 설정을 다시 읽어 검증한 뒤 onReload를 호출합니다
+// 읽기나 검증에 실패하면 cfg 없이 err와 함께 호출하므로, 호출자는 기존 설정을 유지하면 됩니다
+// onReload는 한 goroutine에서 순서대로 호출되며 ctx가 취소되면 더 이상 호출되지 않습니다
+func Watch(ctx context.Context, configPath, configName string, watchFile bool, onReload func(source string, cfg *Config, err error)) error {
+	changed := make(chan struct{}, 1)
+	if watchFile {
+		// 기본 파일과 (있으면) 프로필 오버레이를 모두 감시합니다
+		_, sources, err := readLayered(configPath, configName)
+		if err != nil {
+			return err
+		}
+		for _, file := range sources.Files {
+			w := viper.New()
+			w.SetConfigFile(file)
+			w.OnConfigChange(func(fsnotify.Event) {
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			})
+			w.WatchConfig()
+		}
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	reload := func(source string) {
+		cfg, err := LoadConfig(configPath, configName)
+		if err == nil {
+			err = cfg.Validate()
+		}
+		if err != nil {
+			onReload(source, nil, err)
+			return
+		}
+		onReload(source, cfg, nil)
+	}
+
+	go func() {
+		defer signal.Stop(hup)
+
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				reload(ReloadSourceSignal)
+			case <-changed:
+				debounce = time.After(fileChangeDebounce)
+			case <-debounce:
+				debounce = nil
+				reload(ReloadSourceFile)
+			}
+		}
+	}()
+	return nil
+}
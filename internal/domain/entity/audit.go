@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"time"
+)
+
+// AuditOperation은 감사 대상 작업 유형입니다
+type AuditOperation string
+
+const (
+	AuditOpCreate           AuditOperation = "create"
+	AuditOpUpdate           AuditOperation = "update"
+	AuditOpReplace          AuditOperation = "replace"
+	AuditOpDelete           AuditOperation = "delete"
+	AuditOpRestore          AuditOperation = "restore"
+	AuditOpArchive          AuditOperation = "archive"
+	AuditOpRehydrate        AuditOperation = "rehydrate"
+	AuditOpUpsert           AuditOperation = "upsert"
+	AuditOpFindAndUpdate    AuditOperation = "find_and_update"
+	AuditOpFindAndReplace   AuditOperation = "find_and_replace"
+	AuditOpFindAndDelete    AuditOperation = "find_and_delete"
+	AuditOpBulkInsert       AuditOperation = "bulk_insert"
+	AuditOpUpdateMany       AuditOperation = "update_many"
+	AuditOpDeleteMany       AuditOperation = "delete_many"
+	AuditOpBulkWrite        AuditOperation = "bulk_write"
+	AuditOpTransaction      AuditOperation = "transaction"
+	AuditOpCreateIndex      AuditOperation = "create_index"
+	AuditOpDropIndex        AuditOperation = "drop_index"
+	AuditOpCreateCollection AuditOperation = "create_collection"
+	AuditOpDropCollection   AuditOperation = "drop_collection"
+	AuditOpRenameCollection AuditOperation = "rename_collection"
+	AuditOpCloneCollection  AuditOperation = "clone_collection"
+	AuditOpRawQuery         AuditOperation = "raw_query"
+	AuditOpMergeDuplicates  AuditOperation = "merge_duplicates"
+	AuditOpDeleteDuplicates AuditOperation = "delete_duplicates"
+	AuditOpAnonymize        AuditOperation = "anonymize"
+
+	// AuditOpAuthLockout은 반복된 인증 실패로 키/IP가 잠긴 보안 이벤트입니다
+	AuditOpAuthLockout AuditOperation = "auth_lockout"
+)
+
+// AuditEntry는 변경 작업 하나에 대한 불변 감사 기록입니다
+// 누가(Actor), 무엇을(Operation/Collection/DocumentID), 어떤 조건으로(Filter),
+// 변경 전후 상태(Before/After)와 요청 상관관계 ID(RequestID)를 보관합니다
+// 운영자가 대리 실행한 경우 Actor는 대상 사용자, ImpersonatedBy는 실제 운영자입니다
+type AuditEntry struct {
+	ID                 string
+	Timestamp          time.Time
+	Operation          AuditOperation
+	DatabaseType       string
+	Collection         string
+	DocumentID         string
+	Actor              string
+	ActorName          string
+	TenantID           string
+	ImpersonatedBy     string
+	ImpersonatedByName string
+	RequestID          string
+	Filter             map[string]interface{}
+	Before             map[string]interface{}
+	After              map[string]interface{}
+	BeforeVersion      int
+	AfterVersion       int
+	AffectedCount      int64
+	Success            bool
+	Error              string
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+)
+
+// DeadLetterEvent는 재시도 후에도 발행에 실패한 CDC 이벤트입니다
+// 재발행(redrive)에 필요한 발행 인자를 그대로 보관합니다
+type DeadLetterEvent struct {
+	ID              string
+	EventType       string // document.created, document.updated, document.deleted
+	DocumentID      string
+	Collection      string
+	Data            map[string]interface{}
+	Version         int
+	PreviousVersion int
+	Changes         map[string]interface{}
+	Error           string
+	Attempts        int // 최초 발행을 포함한 누적 시도 횟수
+	FailedAt        time.Time
+	LastRedriveAt   time.Time
+}
@@ -12,8 +12,54 @@ type Document struct {
 	version    int
 	createdAt  time.Time
 	updatedAt  time.Time
+	expiresAt  time.Time // zero 값이면 만료되지 않음
 }
 
+// ExpiresAtField는 문서 만료 시각을 담는 메타데이터 필드입니다 (TTL 문서)
+const ExpiresAtField = "expires_at"
+
+// ExpiresAtLayout은 만료 시각을 메타데이터에 문자열로 기록하는 형식입니다
+// 항상 UTC 고정 길이로 기록하므로 문자열 비교 순서가 시간 순서와 같습니다 (SQL/Elasticsearch 정리 조건에 사용)
+const ExpiresAtLayout = "2006-01-02T15:04:05.000000Z"
+
+// FormatExpiresAt은 만료 시각을 ExpiresAtLayout 문자열로 변환합니다
+func FormatExpiresAt(t time.Time) string {
+	return t.UTC().Format(ExpiresAtLayout)
+}
+
+// ParseExpiresAt은 메타데이터의 만료 시각을 해석합니다 (없거나 형식이 다르면 false)
+func ParseExpiresAt(metadata map[string]interface{}) (time.Time, bool) {
+	value, ok := metadata[ExpiresAtField].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ExpiryMetadata는 만료 시각을 저장소의 metadata 값으로 변환합니다 (ParseExpiresAt의 반대, zero 값이면 빈 맵)
+func ExpiryMetadata(expiresAt time.Time) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if !expiresAt.IsZero() {
+		metadata[ExpiresAtField] = FormatExpiresAt(expiresAt)
+	}
+	return metadata
+}
+
+// DeletedAtField는 소프트 삭제된 문서 데이터에 삭제 시각(RFC3339)을 기록하는 예약 필드입니다
+// 데이터에 기록하므로 백엔드와 관계없이 저장되며, 복원하면 필드를 제거합니다
+const DeletedAtField = "_deleted_at"
+
+// ArchiveKeyField와 ArchivedAtField는 객체 저장소로 보관된 문서의 스텁 데이터에 기록하는 예약 필드입니다
+// 보관된 문서는 데이터가 이 두 필드만 남은 스텁으로 바뀌고, 원래 데이터는 보관 객체 키로 다시 읽습니다
+const (
+	ArchiveKeyField = "_archive_key"
+	ArchivedAtField = "_archived_at"
+)
+
 // NewDocument는 새로운 Document 엔티티를 생성합니다
 func NewDocument(collection string, data map[string]interface{}) (*Document, error) {
 	if collection == "" {
@@ -80,6 +126,53 @@ func (d *Document) UpdatedAt() time.Time {
 	return d.updatedAt
 }
 
+// ExpiresAt은 문서 만료 시각을 반환합니다 (zero 값이면 만료되지 않음)
+func (d *Document) ExpiresAt() time.Time {
+	return d.expiresAt
+}
+
+// SetExpiresAt은 문서 만료 시각을 설정합니다 (zero 값이면 만료를 해제)
+func (d *Document) SetExpiresAt(t time.Time) {
+	d.expiresAt = t
+}
+
+// IsExpired는 now 시점에 문서가 만료되었는지 반환합니다
+// 저장소의 만료 정리는 주기적으로 실행되므로 정리 전까지 남아 있는 문서를 걸러낼 때 사용합니다
+func (d *Document) IsExpired(now time.Time) bool {
+	return !d.expiresAt.IsZero() && !now.Before(d.expiresAt)
+}
+
+// DeletedAt은 소프트 삭제 시각을 반환합니다 (삭제되지 않았으면 false)
+func (d *Document) DeletedAt() (time.Time, bool) {
+	value, ok := d.data[DeletedAtField].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// IsDeleted는 문서가 소프트 삭제되었는지 반환합니다
+func (d *Document) IsDeleted() bool {
+	_, ok := d.data[DeletedAtField]
+	return ok
+}
+
+// ArchiveKey는 보관된 문서의 보관 객체 키를 반환합니다 (보관되지 않았으면 false)
+func (d *Document) ArchiveKey() (string, bool) {
+	key, ok := d.data[ArchiveKeyField].(string)
+	return key, ok && key != ""
+}
+
+// IsArchived는 문서가 객체 저장소로 보관된 스텁인지 반환합니다
+func (d *Document) IsArchived() bool {
+	_, ok := d.ArchiveKey()
+	return ok
+}
+
 // Update는 문서 데이터를 업데이트합니다
 func (d *Document) Update(data map[string]interface{}) error {
 	if data == nil {
@@ -16,4 +16,7 @@ var (
 
 	// ErrVersionConflict는 낙관적 잠금 충돌이 발생했을 때 발생합니다
 	ErrVersionConflict = apperrors.ErrVersionConflict
+
+	// ErrDuplicateKey는 고유 키(ID 또는 고유 제약 필드)가 같은 문서가 이미 있을 때 발생합니다
+	ErrDuplicateKey = apperrors.ErrDuplicateKey
 )
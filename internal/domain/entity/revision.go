@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Revision은 변경 전에 보관한 문서의 이전 버전입니다
+// ValidFrom부터 ValidTo 직전까지 이 버전이 문서의 최신 상태였습니다
+type Revision struct {
+	ID           string
+	DatabaseType string
+	Collection   string
+	DocumentID   string
+	Version      int
+	Data         map[string]interface{}
+	ValidFrom    time.Time      // 이 버전이 저장된 시각
+	ValidTo      time.Time      // 다음 버전으로 바뀌거나 삭제된 시각
+	Operation    AuditOperation // 이 버전을 대체한 작업 (update, replace, delete, restore)
+}
+
+// ValidAt은 at 시점에 이 버전이 문서의 최신 상태였는지 반환합니다
+func (r *Revision) ValidAt(at time.Time) bool {
+	return !at.Before(r.ValidFrom) && at.Before(r.ValidTo)
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+)
+
+// Tenant는 프로비저닝된 테넌트입니다 (컬렉션 네임스페이스, 쿼터, API 키, CDC 토픽)
+type Tenant struct {
+	ID string
+
+	// Namespace는 테넌트 컬렉션 이름 접두사입니다 (예: "acme_")
+	Namespace string
+
+	// DatabaseType은 테넌트 컬렉션을 만든 데이터베이스입니다
+	DatabaseType string
+
+	// Collections는 프로비저닝할 때 만든 컬렉션 이름입니다 (네임스페이스 포함)
+	Collections []string
+
+	Quota   TenantQuota
+	APIKeys []TenantAPIKey
+
+	// CDCTopics는 테넌트 전용 CDC 토픽입니다 (Kafka를 사용하지 않으면 비어 있음)
+	CDCTopics []string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TenantQuota는 테넌트 저장 용량 한도입니다 (0이면 제한 없음)
+type TenantQuota struct {
+	MaxDocuments int64
+	MaxBytes     int64
+}
+
+// TenantAPIKey는 테넌트에 발급한 API 키입니다 (비밀 값은 SHA-256 해시만 보관)
+type TenantAPIKey struct {
+	ID          string
+	SecretHash  []byte
+	Roles       []string
+	Description string
+	CreatedAt   time.Time
+}
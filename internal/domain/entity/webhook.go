@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+)
+
+// 웹훅 전송 상태
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookSubscription은 문서 변경을 HTTPS 콜백으로 받는 구독입니다
+type WebhookSubscription struct {
+	ID  string
+	URL string
+
+	// Collection은 컬렉션 이름 또는 와일드카드 패턴입니다 (path.Match 문법)
+	Collection string
+
+	// EventTypes는 받을 이벤트 타입입니다 (비어 있으면 모든 타입)
+	EventTypes []string
+
+	// Filter는 변경 후 문서 데이터가 만족해야 하는 필드 조건입니다 (점 표기, 값 일치, 삭제 이벤트에는 적용하지 않음)
+	Filter map[string]interface{}
+
+	// Secret은 페이로드 HMAC-SHA256 서명 키입니다
+	Secret string
+
+	Description string
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WebhookDelivery는 구독 하나에 대한 이벤트 전송 기록입니다 (전송 로그 겸 재시도 큐)
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Collection     string
+	DocumentID     string
+
+	// Payload는 전송할 이벤트 JSON입니다
+	Payload string
+
+	Status        string // pending, delivered, failed
+	Attempts      int
+	NextAttemptAt time.Time
+
+	// LastStatusCode는 마지막 응답 상태 코드입니다 (연결 실패 시 0)
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
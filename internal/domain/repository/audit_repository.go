@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// AuditQuery는 감사 로그 조회 조건입니다
+type AuditQuery struct {
+	Collection     string
+	DocumentID     string
+	Actor          string
+	TenantID       string
+	ImpersonatedBy string
+	RequestID      string
+	Operation      entity.AuditOperation
+	From           time.Time
+	To             time.Time
+	Limit          int64
+	Skip           int64
+}
+
+// AuditRepository는 감사 로그 저장소 인터페이스입니다
+// 감사 기록은 추가만 가능하며 수정/삭제 API를 제공하지 않습니다 (보존 기간 만료 제외)
+type AuditRepository interface {
+	// Append는 감사 기록을 추가합니다
+	Append(ctx context.Context, entry *entity.AuditEntry) error
+
+	// Query는 조건에 맞는 감사 기록을 최신순으로 조회하고 전체 개수를 반환합니다
+	Query(ctx context.Context, query *AuditQuery) ([]*entity.AuditEntry, int64, error)
+
+	// EnsureIndexes는 조회용 인덱스와 보존 기간(TTL) 인덱스를 생성합니다
+	// retention이 0이면 영구 보존합니다
+	EnsureIndexes(ctx context.Context, retention time.Duration) error
+}
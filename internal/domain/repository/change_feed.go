@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 변경 이벤트 작업 타입
+const (
+	ChangeOperationInsert  = "insert"
+	ChangeOperationUpdate  = "update"
+	ChangeOperationReplace = "replace"
+	ChangeOperationDelete  = "delete"
+)
+
+// ChangeEvent는 백엔드에 독립적인 문서 변경 이벤트입니다
+type ChangeEvent struct {
+	Operation  string
+	Collection string
+	DocumentID string
+
+	// Data는 변경 후 문서 데이터입니다 (delete이거나 조회 시점에 이미 삭제되었으면 nil)
+	Data map[string]interface{}
+
+	// Changes는 update의 변경 필드입니다 (백엔드가 제공하지 않으면 nil, 제거된 필드는 nil 값)
+	Changes map[string]interface{}
+
+	// Version은 문서 버전입니다 (알 수 없으면 0)
+	Version int
+
+	// Timestamp는 백엔드가 변경을 기록한 시각입니다
+	Timestamp time.Time
+}
+
+// ChangeWatcher는 컬렉션 변경을 ChangeFeed로 구독할 수 있는 저장소입니다
+// MongoDB(Change Streams), PostgreSQL(트리거 + LISTEN/NOTIFY), MySQL(트리거 + 변경 로그 폴링)이 구현합니다
+type ChangeWatcher interface {
+	// WatchChanges는 구독 시점 이후 컬렉션의 변경을 전달하는 피드를 생성합니다
+	WatchChanges(ctx context.Context, collection string) (*ChangeFeed, error)
+}
+
+// ChangeFeed는 변경 이벤트 구독입니다
+// Events 채널은 구독이 끝나면(컨텍스트 취소, Close, 백엔드 에러) 닫히며, 닫힌 뒤 Err로 종료 원인을 확인합니다
+type ChangeFeed struct {
+	events chan ChangeEvent
+	done   chan struct{}
+	cancel context.CancelFunc
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewChangeFeed는 run을 별도 고루틴에서 실행하는 변경 피드를 생성합니다 (저장소 구현용)
+// run은 emit으로 이벤트를 전달하며, emit이 false를 반환하면(구독 종료) 즉시 반환해야 합니다
+func NewChangeFeed(ctx context.Context, buffer int, run func(ctx context.Context, emit func(ChangeEvent) bool) error) *ChangeFeed {
+	ctx, cancel := context.WithCancel(ctx)
+	f := &ChangeFeed{
+		events: make(chan ChangeEvent, buffer),
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+
+	go func() {
+		defer close(f.done)
+		defer close(f.events)
+
+		err := run(ctx, func(event ChangeEvent) bool {
+			select {
+			case f.events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			f.mu.Lock()
+			f.err = err
+			f.mu.Unlock()
+		}
+	}()
+	return f
+}
+
+// Events는 변경 이벤트 채널을 반환합니다
+func (f *ChangeFeed) Events() <-chan ChangeEvent {
+	return f.events
+}
+
+// Err는 구독이 백엔드 에러로 끝났으면 그 에러를 반환합니다 (취소나 Close로 끝났으면 nil)
+func (f *ChangeFeed) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// Close는 구독을 종료하고 백엔드 리소스가 정리될 때까지 기다립니다
+func (f *ChangeFeed) Close() error {
+	f.cancel()
+	<-f.done
+	return nil
+}
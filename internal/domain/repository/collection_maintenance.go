@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnsupportedMaintenance는 저장소가 지원하지 않는 유지보수 작업을 요청했을 때의 에러입니다
+var ErrUnsupportedMaintenance = errors.New("unsupported maintenance operation")
+
+// MaintenanceOptions는 컬렉션 유지보수 작업 옵션입니다 (작업과 관계없는 값은 무시)
+type MaintenanceOptions struct {
+	Full        bool // PostgreSQL VACUUM FULL (테이블을 다시 써서 디스크를 반환하지만 작업 중 테이블 잠금)
+	MaxSegments int  // Elasticsearch forcemerge의 max_num_segments (0이면 Elasticsearch 기본값)
+
+	// Progress는 오래 걸리는 작업(reindex)의 진행 상황을 받습니다 (nil이면 보고하지 않음)
+	// 단위는 작업마다 다르며(인덱스 개수, 문서 개수), 저장소는 total을 알게 된 뒤 처리할 때마다 호출합니다
+	Progress func(done, total int64)
+}
+
+// ReportProgress는 Progress가 설정되어 있으면 진행 상황을 전달합니다
+func (o MaintenanceOptions) ReportProgress(done, total int64) {
+	if o.Progress != nil {
+		o.Progress(done, total)
+	}
+}
+
+// CollectionMaintainer는 컬렉션 유지보수 작업(compact, vacuum, forcemerge 등)을 실행할 수 있는 저장소입니다 (선택 구현)
+type CollectionMaintainer interface {
+	// MaintenanceOperations는 저장소가 지원하는 작업 이름을 반환합니다
+	MaintenanceOperations() []string
+
+	// RunMaintenance는 컬렉션에 operation을 실행하고 저장소가 보고한 결과를 반환합니다
+	// 지원하지 않는 작업이면 ErrUnsupportedMaintenance를 반환합니다
+	RunMaintenance(ctx context.Context, collection, operation string, opts MaintenanceOptions) (map[string]interface{}, error)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// CollectionStatsReader는 백엔드 고유의 통계(collStats, pg_total_relation_size, _stats API, information_schema)로
+// 컬렉션 크기와 인덱스 크기를 조회할 수 있는 저장소입니다 (선택 구현)
+type CollectionStatsReader interface {
+	// CollectionStats는 컬렉션 통계를 반환합니다
+	// 문서 수는 백엔드에 따라 통계 기반 추정값일 수 있습니다
+	CollectionStats(ctx context.Context, collection string) (*CollectionStats, error)
+}
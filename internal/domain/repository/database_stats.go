@@ -0,0 +1,43 @@
+package repository
+
+import "context"
+
+// DatabaseStats는 데이터베이스 전체 통계입니다
+type DatabaseStats struct {
+	Collections int   // 컬렉션(테이블, 인덱스) 개수
+	Documents   int64 // 전체 문서 개수 (백엔드에 따라 추정값)
+	DataSize    int64 // 데이터 크기 (바이트)
+	StorageSize int64 // 저장소 크기 (바이트)
+	IndexSize   int64 // 전체 인덱스 크기 (바이트)
+
+	Connections   *ConnectionStats   // 서버 연결 수 (권한이 없어 조회하지 못하면 nil)
+	PerCollection []*CollectionStats // 컬렉션별 통계
+}
+
+// ConnectionStats는 데이터베이스 서버의 연결 수입니다
+type ConnectionStats struct {
+	Current   int64 // 현재 열린 연결 수
+	Active    int64 // 쿼리를 실행 중인 연결 수
+	Available int64 // 추가로 열 수 있는 연결 수 (백엔드가 제공할 때만)
+}
+
+// DatabaseStatsReader는 백엔드 고유의 통계(dbStats, information_schema, _cluster/stats)로
+// 데이터베이스 전체 통계와 컬렉션별 통계, 연결 수를 조회할 수 있는 저장소입니다 (선택 구현)
+type DatabaseStatsReader interface {
+	DatabaseStats(ctx context.Context) (*DatabaseStats, error)
+}
+
+// SummarizeCollections는 컬렉션별 통계를 합쳐 데이터베이스 통계를 만듭니다 (연결 수는 비어 있음)
+func SummarizeCollections(perCollection []*CollectionStats) *DatabaseStats {
+	stats := &DatabaseStats{
+		Collections:   len(perCollection),
+		PerCollection: perCollection,
+	}
+	for _, c := range perCollection {
+		stats.Documents += c.Count
+		stats.DataSize += c.Size
+		stats.StorageSize += c.StorageSize
+		stats.IndexSize += c.TotalIndexSize
+	}
+	return stats
+}
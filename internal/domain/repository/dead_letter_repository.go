@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// DeadLetterQuery는 DLQ 조회 조건입니다
+type DeadLetterQuery struct {
+	Collection string
+	EventType  string
+	Limit      int64
+	Skip       int64
+}
+
+// DeadLetterRepository는 발행에 실패한 CDC 이벤트 저장소 인터페이스입니다
+type DeadLetterRepository interface {
+	// Save는 실패한 이벤트를 저장합니다
+	Save(ctx context.Context, event *entity.DeadLetterEvent) error
+
+	// FindByID는 ID로 이벤트를 조회합니다 (없으면 entity.ErrDocumentNotFound)
+	FindByID(ctx context.Context, id string) (*entity.DeadLetterEvent, error)
+
+	// List는 조건에 맞는 이벤트를 오래된 순으로 조회하고 전체 개수를 반환합니다
+	List(ctx context.Context, query *DeadLetterQuery) ([]*entity.DeadLetterEvent, int64, error)
+
+	// Delete는 이벤트를 삭제합니다 (재발행 성공 또는 폐기)
+	Delete(ctx context.Context, id string) error
+
+	// RecordRedriveFailure는 재발행 실패를 기록합니다 (시도 횟수 증가, 에러 갱신)
+	RecordRedriveFailure(ctx context.Context, id string, redriveErr string) error
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
-	"go.mongodb.org/mongo-driver/bson"
 )
 
 // DocumentCommandRepository는 문서 쓰기 전용 저장소 인터페이스입니다 (CQRS Write Side)
@@ -88,9 +87,9 @@ type DocumentCommandRepository interface {
 
 	// ===== 트랜잭션 (Transaction) =====
 
-	// WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-	// ACID 보장이 필요한 복잡한 쓰기 작업에 사용
-	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithTransaction은 트랜잭션 내에서 함수를 실행합니다 (opts가 nil이면 기본 옵션)
+	// ACID 보장이 필요한 복잡한 쓰기 작업에 사용하며, fn에 전달되는 ctx로 호출한 작업은 같은 트랜잭션 안에서 실행됩니다
+	WithTransaction(ctx context.Context, opts *TxOptions, fn func(ctx context.Context) error) error
 
 	// ===== 변경 스트림 설정 (Change Stream Setup) =====
 
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// DocumentExpirer는 만료 시각(expires_at)이 지난 문서를 정리할 수 있는 저장소입니다 (선택 구현)
+type DocumentExpirer interface {
+	// EnableExpiry는 컬렉션에 만료 정리에 필요한 인덱스 등을 준비합니다
+	// 저장소가 만료 문서를 스스로 삭제하면(MongoDB TTL 인덱스, Cassandra 쓰기 TTL) true를 반환하며,
+	// false이면 호출자가 DeleteExpired로 주기적으로 정리해야 합니다
+	EnableExpiry(ctx context.Context, collection string) (native bool, err error)
+
+	// DeleteExpired는 before 시점까지 만료된 문서를 최대 limit개 삭제하고 삭제한 개수를 반환합니다 (limit이 0 이하면 제한 없음)
+	DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error)
+}
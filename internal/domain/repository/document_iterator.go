@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// DocumentIterator는 조회 결과를 메모리에 모두 올리지 않고 한 건씩 읽는 커서입니다
+// 사용이 끝나면 반드시 Close를 호출해야 합니다 (서버 커서와 연결 반환)
+//
+//	it, err := repo.FindStream(ctx, collection, filter, opts)
+//	defer it.Close(ctx)
+//	for it.Next(ctx) {
+//		doc, err := it.Decode()
+//		...
+//	}
+//	if err := it.Err(); err != nil { ... }
+type DocumentIterator interface {
+	// Next는 다음 문서로 이동하며, 더 이상 문서가 없거나 에러가 발생하면 false를 반환합니다
+	Next(ctx context.Context) bool
+
+	// Decode는 현재 문서를 반환합니다
+	Decode() (*entity.Document, error)
+
+	// Err는 순회 중 발생한 에러를 반환합니다 (Next가 false를 반환한 뒤 확인)
+	Err() error
+
+	// Close는 커서를 닫습니다
+	Close(ctx context.Context) error
+}
+
+// sliceIterator는 이미 메모리에 있는 문서 목록을 순회합니다
+type sliceIterator struct {
+	docs []*entity.Document
+	pos  int
+}
+
+// NewSliceIterator는 문서 목록을 DocumentIterator로 감쌉니다
+// 서버 커서를 지원하지 않는 저장소나 테스트에서 사용합니다
+func NewSliceIterator(docs []*entity.Document) DocumentIterator {
+	return &sliceIterator{docs: docs, pos: -1}
+}
+
+func (it *sliceIterator) Next(ctx context.Context) bool {
+	if ctx.Err() != nil || it.pos+1 >= len(it.docs) {
+		return false
+	}
+	it.pos++
+	return true
+}
+
+func (it *sliceIterator) Decode() (*entity.Document, error) {
+	return it.docs[it.pos], nil
+}
+
+func (it *sliceIterator) Err() error {
+	return nil
+}
+
+func (it *sliceIterator) Close(ctx context.Context) error {
+	it.docs = nil
+	return nil
+}
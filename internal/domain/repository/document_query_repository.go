@@ -248,6 +248,9 @@ type CollectionStats struct {
 	StorageSize    int64   // 저장소 크기
 	IndexCount     int     // 인덱스 개수
 	TotalIndexSize int64   // 전체 인덱스 크기
+
+	IndexSizes    map[string]int64 // 인덱스별 크기 (바이트, 백엔드가 제공할 때만)
+	StorageEngine string           // 저장 엔진 (wiredTiger, heap, InnoDB, lucene 등)
 }
 
 // IndexStat는 인덱스 사용 통계입니다
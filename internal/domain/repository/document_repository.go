@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"go.mongodb.org/mongo-driver/bson"
@@ -54,6 +55,17 @@ type BulkResult struct {
 	DeletedCount  int64
 	UpsertedCount int64
 	UpsertedIDs   map[int]interface{}
+
+	// Errors는 실패한 개별 작업입니다 (일부만 실패해도 나머지 작업은 반영되는 저장소에서 채웁니다)
+	Errors []BulkWriteError
+}
+
+// BulkWriteError는 벌크 작업 중 실패한 개별 작업입니다
+type BulkWriteError struct {
+	Index  int    // operations에서의 위치
+	ID     string // 문서 ID
+	Status int    // 저장소가 반환한 상태 코드 (Elasticsearch HTTP 상태 등, 없으면 0)
+	Reason string // 실패 원인
 }
 
 // DocumentRepository는 문서 저장소 인터페이스입니다
@@ -75,6 +87,10 @@ type DocumentRepository interface {
 	// FindWithOptions는 옵션을 사용하여 문서를 조회합니다 (Sort, Limit, Skip, Projection)
 	FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *FindOptions) ([]*entity.Document, error)
 
+	// FindStream은 FindWithOptions와 같은 조건으로 조회하되 결과를 커서로 반환합니다
+	// 결과를 메모리에 모두 올리지 않으므로 export와 대용량 목록 조회에 사용합니다 (opts는 nil 가능)
+	FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *FindOptions) (DocumentIterator, error)
+
 	// Update는 문서를 업데이트합니다 (낙관적 잠금 포함)
 	Update(ctx context.Context, doc *entity.Document) error
 
@@ -161,8 +177,9 @@ type DocumentRepository interface {
 
 	// ===== 트랜잭션 (Transaction) =====
 
-	// WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithTransaction은 트랜잭션 내에서 함수를 실행합니다 (opts가 nil이면 기본 옵션)
+	// fn에 전달되는 ctx로 호출한 저장소 작업은 같은 트랜잭션 안에서 실행됩니다
+	WithTransaction(ctx context.Context, opts *TxOptions, fn func(ctx context.Context) error) error
 
 	// ===== Raw Query Execution =====
 
@@ -196,3 +213,18 @@ type CacheRepository interface {
 	// Exists는 키가 존재하는지 확인합니다
 	Exists(ctx context.Context, key string) (bool, error)
 }
+
+// CacheTTLReader는 캐시 항목의 남은 TTL을 조회할 수 있는 캐시 저장소입니다 (선택 구현, 조기 갱신용)
+type CacheTTLReader interface {
+	// TTL은 키의 남은 수명을 반환합니다 (키가 없거나 만료가 없으면 0 이하)
+	TTL(ctx context.Context, key string) (time.Duration, error)
+}
+
+// CacheWriteQueue는 캐시 쓰기를 모아 비동기로 반영하는 큐입니다 (write-behind 캐시 전략)
+type CacheWriteQueue interface {
+	// Enqueue는 캐시 쓰기를 예약합니다 (같은 키의 대기 중인 쓰기는 최신 값으로 대체)
+	Enqueue(key string, value interface{}, ttl time.Duration)
+
+	// Discard는 대기 중인 쓰기를 취소합니다 (삭제된 문서가 다시 캐시되지 않도록)
+	Discard(key string)
+}
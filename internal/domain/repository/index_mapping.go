@@ -0,0 +1,20 @@
+package repository
+
+import "context"
+
+// IndexMapping은 검색 인덱스의 설정과 data 필드 매핑입니다
+type IndexMapping struct {
+	// Settings는 인덱스 설정입니다 (분석기 등, 인덱스를 만들 때만 적용)
+	Settings map[string]interface{}
+
+	// Data는 문서 data 객체의 매핑입니다 (properties, dynamic 등)
+	Data map[string]interface{}
+}
+
+// IndexMapper는 컬렉션 인덱스를 지정한 매핑으로 준비할 수 있는 저장소입니다 (선택 구현)
+// Elasticsearch가 구현하며, 검색 미러 인덱스를 검색에 맞는 매핑으로 만들 때 사용합니다
+type IndexMapper interface {
+	// EnsureMapping은 인덱스가 없으면 mapping으로 생성하고, 있으면 data 매핑에 새 필드를 추가합니다
+	// 이미 있는 필드의 타입은 바꿀 수 없으므로 매핑이 충돌하면 에러를 반환합니다 (reindex 필요)
+	EnsureMapping(ctx context.Context, collection string, mapping IndexMapping) error
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// defaultIndexName은 MongoDB 컬렉션마다 자동으로 만들어지는 _id 인덱스 이름입니다
+const defaultIndexName = "_id_"
+
+// primaryKeySuffix는 PostgreSQL이 기본 키 인덱스 이름에 붙이는 접미사입니다
+const primaryKeySuffix = "_pkey"
+
+// IsDefaultIndex는 컬렉션을 만들 때 저장소가 자동으로 만드는 인덱스인지 반환합니다 (MongoDB _id, PostgreSQL 기본 키)
+func IsDefaultIndex(spec map[string]interface{}) bool {
+	name, _ := spec["name"].(string)
+	return name == defaultIndexName || strings.HasSuffix(name, primaryKeySuffix)
+}
+
+// IndexModelFromSpec은 ListIndexes가 반환한 인덱스 정보를 같은 인덱스를 만드는 IndexModel로 바꿉니다
+// MongoDB 형식(key, name, unique, sparse, expireAfterSeconds, partialFilterExpression)만 옮길 수 있으며,
+// 기본 _id 인덱스, 텍스트 인덱스, 키를 알 수 없는 인덱스(예: PostgreSQL 인덱스 정의)는 ok=false를 반환합니다
+func IndexModelFromSpec(spec map[string]interface{}) (model IndexModel, ok bool) {
+	if IsDefaultIndex(spec) {
+		return IndexModel{}, false
+	}
+
+	keys := indexKeys(spec["key"])
+	if len(keys) == 0 {
+		return IndexModel{}, false
+	}
+	if _, text := keys["_fts"]; text {
+		return IndexModel{}, false
+	}
+
+	name, _ := spec["name"].(string)
+	opts := &IndexOptions{Name: name}
+	if unique, ok := spec["unique"].(bool); ok && unique {
+		opts.Unique = &unique
+	}
+	if sparse, ok := spec["sparse"].(bool); ok && sparse {
+		opts.Sparse = &sparse
+	}
+	if seconds, ok := indexInt32(spec["expireAfterSeconds"]); ok {
+		opts.ExpireAfter = &seconds
+	}
+	if filter := indexKeys(spec["partialFilterExpression"]); len(filter) > 0 {
+		opts.PartialFilter = bson.M(filter)
+	}
+	return IndexModel{Keys: keys, Options: opts}, true
+}
+
+// indexKeys는 인덱스 정보의 하위 문서를 map으로 바꿉니다 (문서가 아니면 nil)
+func indexKeys(value interface{}) map[string]interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v
+	case bson.M:
+		return v
+	case bson.D:
+		keys := make(map[string]interface{}, len(v))
+		for _, e := range v {
+			keys[e.Key] = e.Value
+		}
+		return keys
+	}
+	return nil
+}
+
+// indexInt32는 인덱스 정보의 숫자 값을 int32로 바꿉니다 (드라이버와 서버 버전에 따라 타입이 다름)
+func indexInt32(value interface{}) (int32, bool) {
+	switch v := value.(type) {
+	case int32:
+		return v, true
+	case int64:
+		return int32(v), true
+	case int:
+		return int32(v), true
+	case float64:
+		return int32(v), true
+	}
+	return 0, false
+}
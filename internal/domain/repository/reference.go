@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// ReferenceFinder는 다른 문서를 참조하는 문서를 찾을 수 있는 저장소입니다 (선택 구현)
+// 참조는 data 필드에 대상 문서의 ID 문자열을 저장하는 방식입니다 (예: orders.data.customer_id -> customers)
+type ReferenceFinder interface {
+	// FindReferencing은 컬렉션에서 data의 field 값이 id인 문서의 ID를 최대 limit개 반환합니다 (0이면 전체)
+	FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// ReplicaRepository는 CDC 이벤트를 다른 백엔드/리전에 적용하는 복제 대상 저장소입니다
+// 이벤트는 최소 한 번(at-least-once) 전달되므로 같은 이벤트를 여러 번 적용해도 결과가 같아야 합니다
+type ReplicaRepository interface {
+	// ApplyUpsert는 문서를 원본과 같은 ID로 저장합니다
+	// 대상 문서의 버전이 version 이상이면(이미 적용된 이벤트) 변경하지 않고 false를 반환합니다
+	// ID 형식이 대상 백엔드에 맞지 않으면 entity.ErrInvalidData를 반환합니다
+	ApplyUpsert(ctx context.Context, collection, id string, data map[string]interface{}, version int, updatedAt time.Time) (bool, error)
+
+	// ApplyDelete는 문서를 삭제합니다 (이미 없으면 false)
+	ApplyDelete(ctx context.Context, collection, id string) (bool, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// RevisionKey는 리비전을 보관하는 문서를 식별합니다 (같은 컬렉션 이름을 쓰는 백엔드를 구분하기 위해 데이터베이스 종류 포함)
+type RevisionKey struct {
+	DatabaseType string
+	Collection   string
+	DocumentID   string
+}
+
+// RevisionRepository는 문서 리비전(이전 버전) 저장소 인터페이스입니다
+type RevisionRepository interface {
+	// Append는 리비전을 추가합니다
+	Append(ctx context.Context, revision *entity.Revision) error
+
+	// List는 문서의 리비전을 최신 버전부터 최대 limit개 조회합니다 (limit이 0 이하면 전체)
+	List(ctx context.Context, key RevisionKey, limit int64) ([]*entity.Revision, error)
+
+	// FindAsOf는 at 시점에 최신 상태였던 리비전을 조회합니다 (없으면 entity.ErrDocumentNotFound)
+	FindAsOf(ctx context.Context, key RevisionKey, at time.Time) (*entity.Revision, error)
+
+	// Prune은 최신 keep개를 넘는 리비전과 ValidTo가 olderThan 이전인 리비전을 삭제합니다
+	// keep이 0 이하이거나 olderThan이 zero 값이면 해당 조건은 적용하지 않습니다
+	Prune(ctx context.Context, key RevisionKey, keep int, olderThan time.Time) (int64, error)
+
+	// EnsureSchema는 리비전 저장에 필요한 컬렉션/테이블과 인덱스를 생성합니다
+	EnsureSchema(ctx context.Context) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// TenantRepository는 테넌트 저장소 인터페이스입니다
+type TenantRepository interface {
+	// Create는 테넌트를 저장합니다 (같은 ID가 있으면 entity.ErrDuplicateKey)
+	Create(ctx context.Context, tenant *entity.Tenant) error
+
+	// FindByID는 ID로 테넌트를 조회합니다 (없으면 entity.ErrDocumentNotFound)
+	FindByID(ctx context.Context, id string) (*entity.Tenant, error)
+
+	// FindByAPIKeyID는 API 키 ID로 키를 가진 테넌트를 조회합니다 (없으면 entity.ErrDocumentNotFound)
+	FindByAPIKeyID(ctx context.Context, keyID string) (*entity.Tenant, error)
+
+	// List는 모든 테넌트를 생성 순으로 조회합니다
+	List(ctx context.Context) ([]*entity.Tenant, error)
+
+	// Update는 테넌트를 갱신합니다 (없으면 entity.ErrDocumentNotFound)
+	Update(ctx context.Context, tenant *entity.Tenant) error
+}
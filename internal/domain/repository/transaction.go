@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+)
+
+// IsolationLevel은 트랜잭션 격리 수준입니다
+type IsolationLevel string
+
+const (
+	IsolationDefault         IsolationLevel = ""                 // 데이터베이스 기본값
+	IsolationReadUncommitted IsolationLevel = "read_uncommitted" // 커밋되지 않은 변경도 읽음
+	IsolationReadCommitted   IsolationLevel = "read_committed"   // 커밋된 변경만 읽음
+	IsolationRepeatableRead  IsolationLevel = "repeatable_read"  // 트랜잭션 안에서 같은 행은 같은 값으로 읽음
+	IsolationSnapshot        IsolationLevel = "snapshot"         // 트랜잭션 시작 시점의 스냅샷을 읽음
+	IsolationSerializable    IsolationLevel = "serializable"     // 순차 실행과 같은 결과를 보장
+)
+
+// ParseIsolationLevel은 문자열을 격리 수준으로 변환합니다 (빈 문자열은 데이터베이스 기본값)
+func ParseIsolationLevel(s string) (IsolationLevel, error) {
+	switch level := IsolationLevel(s); level {
+	case IsolationDefault, IsolationReadUncommitted, IsolationReadCommitted,
+		IsolationRepeatableRead, IsolationSnapshot, IsolationSerializable:
+		return level, nil
+	default:
+		return "", fmt.Errorf("unsupported isolation level: %s", s)
+	}
+}
+
+// TxOptions는 WithTransaction 옵션입니다 (nil이면 모두 기본값)
+// 트랜잭션을 지원하지 않는 데이터베이스(Cassandra, Elasticsearch)는 Timeout만 적용합니다
+type TxOptions struct {
+	// Isolation은 격리 수준입니다 (데이터베이스가 지원하지 않는 수준이면 트랜잭션 시작이 실패)
+	Isolation IsolationLevel
+
+	// ReadOnly는 읽기 전용 트랜잭션 여부입니다
+	ReadOnly bool
+
+	// Timeout은 커밋까지 포함한 트랜잭션 전체의 시간 한도입니다 (0이면 한도 없음)
+	Timeout time.Duration
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+)
+
+// uniqueKeyNameLength는 고유 키 인덱스 이름의 최대 길이입니다 (MySQL 식별자 제한 64자 이내)
+const uniqueKeyNameLength = 60
+
+// UniqueKeyEnforcer는 data 필드 조합의 고유 제약을 지원하는 저장소입니다 (선택 구현)
+// 필드는 점으로 구분된 data 경로이며(address.city), 필드 중 하나라도 없거나 null인 문서는 제약 대상이 아닙니다
+type UniqueKeyEnforcer interface {
+	// EnsureUniqueKey는 컬렉션에 fields 조합의 고유 제약을 준비합니다
+	// 저장소가 쓰기 시 스스로 중복을 거부하면(고유 인덱스) true를 반환하며,
+	// false이면 호출자가 쓰기 전에 FindByUniqueKey로 같은 값을 가진 문서가 없는지 확인해야 합니다
+	EnsureUniqueKey(ctx context.Context, collection string, fields []string) (native bool, err error)
+
+	// FindByUniqueKey는 key의 모든 필드 값이 같은 문서의 ID를 최대 limit개 반환합니다
+	FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error)
+}
+
+// UniqueKeyName은 fields 조합의 고유 인덱스 이름입니다 (예: [tenant, user.email] -> uniq_tenant_user_email)
+// 저장소의 중복 키 에러 메시지에서 위반한 제약을 찾을 때도 사용하므로 모든 저장소가 같은 이름을 씁니다
+func UniqueKeyName(fields []string) string {
+	name := "uniq_" + strings.ReplaceAll(strings.Join(fields, "_"), ".", "_")
+	if len(name) <= uniqueKeyNameLength {
+		return name
+	}
+	sum := sha1.Sum([]byte(strings.Join(fields, ",")))
+	suffix := "_" + hex.EncodeToString(sum[:])[:8]
+	return name[:uniqueKeyNameLength-len(suffix)] + suffix
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// WebhookDeliveryQuery는 웹훅 전송 로그 조회 조건입니다
+type WebhookDeliveryQuery struct {
+	SubscriptionID string
+	Status         string
+	Limit          int64
+	Skip           int64
+}
+
+// WebhookSubscriptionRepository는 웹훅 구독 저장소 인터페이스입니다
+type WebhookSubscriptionRepository interface {
+	// Create는 구독을 저장하고 ID를 설정합니다
+	Create(ctx context.Context, sub *entity.WebhookSubscription) error
+
+	// FindByID는 ID로 구독을 조회합니다 (없으면 entity.ErrDocumentNotFound)
+	FindByID(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+
+	// List는 모든 구독을 생성 순으로 조회합니다
+	List(ctx context.Context) ([]*entity.WebhookSubscription, error)
+
+	// Update는 구독을 갱신합니다 (없으면 entity.ErrDocumentNotFound)
+	Update(ctx context.Context, sub *entity.WebhookSubscription) error
+
+	// Delete는 구독과 전송 로그를 삭제합니다 (없으면 entity.ErrDocumentNotFound)
+	Delete(ctx context.Context, id string) error
+}
+
+// WebhookDeliveryRepository는 웹훅 전송 로그(재시도 큐) 저장소 인터페이스입니다
+type WebhookDeliveryRepository interface {
+	// Enqueue는 전송 대기 기록을 저장합니다
+	Enqueue(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+
+	// ClaimDue는 전송할 때가 된 대기 기록을 하나 가져오고 lease 동안 다른 디스패처가 가져가지 못하게 합니다 (없으면 nil)
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*entity.WebhookDelivery, error)
+
+	// RecordAttempt는 전송 시도 결과(상태, 시도 횟수, 다음 시도 시각, 응답)를 저장합니다
+	RecordAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error
+
+	// FindByID는 ID로 전송 기록을 조회합니다 (없으면 entity.ErrDocumentNotFound)
+	FindByID(ctx context.Context, id string) (*entity.WebhookDelivery, error)
+
+	// List는 조건에 맞는 전송 기록을 최신 순으로 조회하고 전체 개수를 반환합니다
+	List(ctx context.Context, query *WebhookDeliveryQuery) ([]*entity.WebhookDelivery, int64, error)
+
+	// Requeue는 전송 기록을 즉시 다시 보내도록 대기 상태로 되돌립니다 (없으면 entity.ErrDocumentNotFound)
+	Requeue(ctx context.Context, id string) error
+}
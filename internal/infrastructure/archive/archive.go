@@ -0,0 +1,115 @@
+// Package archive는 오래 수정되지 않은 문서를 객체 저장소(로컬 디렉터리, S3)에 보관하고 다시 읽습니다
+//
+// 문서 하나는 archive/<database_type>/<collection>/<id>/v<version>.json.gz 객체 하나로 저장됩니다
+// 키에 버전이 들어가므로 같은 문서를 다시 보관해도 이전 객체를 덮어쓰지 않습니다
+package archive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/backup"
+)
+
+// keyPrefix는 보관 객체 키의 공통 접두사입니다 (백업과 같은 저장소를 써도 섞이지 않도록 분리)
+const keyPrefix = "archive"
+
+// Archive는 백업 저장소 위에 문서를 보관합니다
+type Archive struct {
+	store backup.Store
+}
+
+// record는 보관 객체에 저장하는 문서입니다
+type record struct {
+	ID         string                 `json:"id"`
+	Collection string                 `json:"collection"`
+	Data       map[string]interface{} `json:"data"`
+	Version    int                    `json:"version"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+	ArchivedAt time.Time              `json:"archived_at"`
+}
+
+// New는 store에 문서를 보관하는 Archive를 생성합니다
+func New(store backup.Store) *Archive {
+	return &Archive{store: store}
+}
+
+// Key는 문서 버전의 보관 객체 키를 반환합니다
+func Key(databaseType string, doc *entity.Document) string {
+	return path.Join(keyPrefix, url.PathEscape(databaseType), url.PathEscape(doc.Collection()),
+		url.PathEscape(doc.ID()), fmt.Sprintf("v%d.json.gz", doc.Version()))
+}
+
+// Put은 문서를 보관하고 객체 키를 반환합니다
+func (a *Archive) Put(ctx context.Context, databaseType string, doc *entity.Document) (string, error) {
+	key := Key(databaseType, doc)
+	if err := a.write(ctx, key, doc); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// Overwrite는 이미 있는 보관 객체를 doc의 데이터로 덮어씁니다 (보관된 문서를 익명화할 때 원래 데이터를 남기지 않기 위해 사용)
+func (a *Archive) Overwrite(ctx context.Context, key string, doc *entity.Document) error {
+	return a.write(ctx, key, doc)
+}
+
+// write는 문서를 key 객체로 저장합니다
+func (a *Archive) write(ctx context.Context, key string, doc *entity.Document) error {
+	w, err := a.store.Create(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to create archive object: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	err = json.NewEncoder(gz).Encode(record{
+		ID:         doc.ID(),
+		Collection: doc.Collection(),
+		Data:       doc.Data(),
+		Version:    doc.Version(),
+		CreatedAt:  doc.CreatedAt(),
+		UpdatedAt:  doc.UpdatedAt(),
+		ArchivedAt: time.Now().UTC(),
+	})
+	if err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		w.Abort()
+		return fmt.Errorf("failed to write archive object: %w", err)
+	}
+	return w.Commit()
+}
+
+// Get은 보관 객체에서 문서를 읽습니다 (객체가 없으면 backup.ErrNotFound)
+func (a *Archive) Get(ctx context.Context, key string) (*entity.Document, error) {
+	r, err := a.store.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive object %s: %w", key, err)
+	}
+	defer gz.Close()
+
+	var rec record
+	if err := json.NewDecoder(gz).Decode(&rec); err != nil {
+		return nil, fmt.Errorf("failed to decode archive object %s: %w", key, err)
+	}
+	return entity.ReconstructDocument(rec.ID, rec.Collection, rec.Data, rec.Version, rec.CreatedAt, rec.UpdatedAt), nil
+}
+
+// Location은 보관 객체의 위치를 반환합니다
+func (a *Archive) Location(key string) string {
+	return a.store.Location(key)
+}
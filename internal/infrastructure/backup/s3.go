@@ -0,0 +1,168 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Config는 S3(또는 S3 호환 저장소) 백업 설정입니다
+type S3Config struct {
+	Bucket          string
+	Prefix          string // 백업 객체 키 앞에 붙일 경로 (예: backups/prod)
+	Region          string
+	Endpoint        string // S3 호환 저장소 주소 (예: http://minio:9000, 비어 있으면 AWS)
+	UsePathStyle    bool   // 가상 호스트 대신 경로 방식 주소 사용 (MinIO 등)
+	AccessKeyID     string // 비어 있으면 기본 자격증명 체인(환경 변수, IRSA, 인스턴스 프로파일)
+	SecretAccessKey string
+}
+
+// S3Store는 S3 버킷에 백업을 저장합니다
+type S3Store struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+// NewS3Store는 새로운 S3Store를 생성합니다
+func NewS3Store(ctx context.Context, cfg S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	loadOpts := []func(*awsconfig.LoadOptions) error{}
+	if cfg.Region != "" {
+		loadOpts = append(loadOpts, awsconfig.WithRegion(cfg.Region))
+	}
+	if cfg.AccessKeyID != "" {
+		loadOpts = append(loadOpts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+		o.UsePathStyle = cfg.UsePathStyle
+	})
+
+	return &S3Store{
+		client:   client,
+		uploader: manager.NewUploader(client),
+		bucket:   cfg.Bucket,
+		prefix:   strings.Trim(cfg.Prefix, "/"),
+	}, nil
+}
+
+// Create는 쓴 내용을 멀티파트 업로드로 스트리밍합니다 (전체 내용을 메모리에 올리지 않음)
+// Abort하면 업로드가 취소되어 객체가 만들어지지 않습니다
+func (s *S3Store) Create(ctx context.Context, key string) (Writer, error) {
+	pr, pw := io.Pipe()
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+	go func() {
+		_, err := s.uploader.Upload(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(s.key(key)),
+			Body:   pr,
+		})
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, nil
+}
+
+// Open은 객체를 읽습니다
+func (s *S3Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get s3 object: %w", err)
+	}
+	return out.Body, nil
+}
+
+// List는 prefix로 시작하는 객체 키 목록을 반환합니다 (설정한 Prefix는 제외한 키)
+func (s *S3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	listPrefix := s.key(prefix)
+	if prefix == "" && s.prefix != "" {
+		listPrefix = s.prefix + "/"
+	}
+
+	var keys []string
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(listPrefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list s3 objects: %w", err)
+		}
+		for _, obj := range page.Contents {
+			key := aws.ToString(obj.Key)
+			if s.prefix != "" {
+				key = strings.TrimPrefix(key, s.prefix+"/")
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// Location은 s3://bucket/prefix/key 형태의 위치를 반환합니다
+func (s *S3Store) Location(key string) string {
+	return "s3://" + s.bucket + "/" + s.key(key)
+}
+
+func (s *S3Store) key(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
+
+// s3Writer는 파이프로 업로드 고루틴에 내용을 전달합니다
+type s3Writer struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *s3Writer) Commit() error {
+	w.pw.Close()
+	if err := <-w.done; err != nil {
+		return fmt.Errorf("failed to upload s3 object: %w", err)
+	}
+	return nil
+}
+
+func (w *s3Writer) Abort() error {
+	w.pw.CloseWithError(errors.New("backup aborted"))
+	<-w.done
+	return nil
+}
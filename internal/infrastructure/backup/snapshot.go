@@ -0,0 +1,304 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+const (
+	// Format은 백업 문서 파일 형식입니다
+	Format = "ndjson+gzip"
+
+	manifestFile  = "manifest.json"
+	documentsFile = "documents.ndjson.gz"
+)
+
+// Manifest는 완료된 백업의 메타데이터입니다
+type Manifest struct {
+	BackupID     string    `json:"backup_id"`
+	Collection   string    `json:"collection"`
+	DatabaseType string    `json:"database_type"`
+	Format       string    `json:"format"`
+	Consistent   bool      `json:"consistent"` // 하나의 읽기 스냅샷(트랜잭션)에서 읽었는지 여부
+	Documents    int64     `json:"documents"`
+	SizeBytes    int64     `json:"size_bytes"` // 압축된 문서 파일 크기
+	SHA256       string    `json:"sha256"`     // 압축된 문서 파일의 SHA-256
+	DocumentsKey string    `json:"documents_key"`
+	StartedAt    time.Time `json:"started_at"`
+	CompletedAt  time.Time `json:"completed_at"`
+}
+
+// record는 문서 파일의 한 줄입니다
+type record struct {
+	ID        string                 `json:"id"`
+	Data      map[string]interface{} `json:"data"`
+	Version   int                    `json:"version"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// ManifestKey는 백업의 매니페스트 키를 반환합니다
+func ManifestKey(backupID string) string {
+	return path.Join(backupID, manifestFile)
+}
+
+// DocumentsKey는 백업의 문서 파일 키를 반환합니다
+func DocumentsKey(backupID string) string {
+	return path.Join(backupID, documentsFile)
+}
+
+// SnapshotWriter는 문서를 백업 문서 파일로 씁니다
+type SnapshotWriter struct {
+	store    Store
+	manifest Manifest
+	out      Writer
+	counter  *countingWriter
+	gz       *gzip.Writer
+	buf      *bufio.Writer
+	enc      *json.Encoder
+}
+
+// NewSnapshotWriter는 backupID의 문서 파일을 만들고 SnapshotWriter를 반환합니다
+func NewSnapshotWriter(ctx context.Context, store Store, manifest Manifest) (*SnapshotWriter, error) {
+	manifest.Format = Format
+	manifest.DocumentsKey = DocumentsKey(manifest.BackupID)
+
+	out, err := store.Create(ctx, manifest.DocumentsKey)
+	if err != nil {
+		return nil, err
+	}
+	counter := &countingWriter{w: out, hash: sha256.New()}
+	gz := gzip.NewWriter(counter)
+	buf := bufio.NewWriter(gz)
+	return &SnapshotWriter{
+		store:    store,
+		manifest: manifest,
+		out:      out,
+		counter:  counter,
+		gz:       gz,
+		buf:      buf,
+		enc:      json.NewEncoder(buf),
+	}, nil
+}
+
+// Write는 문서 하나를 씁니다
+func (w *SnapshotWriter) Write(doc *entity.Document) error {
+	if err := w.enc.Encode(record{
+		ID:        doc.ID(),
+		Data:      doc.Data(),
+		Version:   doc.Version(),
+		CreatedAt: doc.CreatedAt(),
+		UpdatedAt: doc.UpdatedAt(),
+	}); err != nil {
+		return fmt.Errorf("failed to write document %s: %w", doc.ID(), err)
+	}
+	w.manifest.Documents++
+	return nil
+}
+
+// Count는 지금까지 쓴 문서 수를 반환합니다
+func (w *SnapshotWriter) Count() int64 {
+	return w.manifest.Documents
+}
+
+// Commit은 문서 파일을 저장하고 매니페스트를 마지막에 저장해 백업을 완료합니다
+func (w *SnapshotWriter) Commit(ctx context.Context) (*Manifest, error) {
+	if err := w.buf.Flush(); err != nil {
+		w.out.Abort()
+		return nil, fmt.Errorf("failed to flush backup: %w", err)
+	}
+	if err := w.gz.Close(); err != nil {
+		w.out.Abort()
+		return nil, fmt.Errorf("failed to compress backup: %w", err)
+	}
+	if err := w.out.Commit(); err != nil {
+		return nil, err
+	}
+
+	w.manifest.SizeBytes = w.counter.n
+	w.manifest.SHA256 = hex.EncodeToString(w.counter.hash.Sum(nil))
+	w.manifest.CompletedAt = time.Now().UTC()
+
+	data, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	out, err := w.store.Create(ctx, ManifestKey(w.manifest.BackupID))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := out.Write(data); err != nil {
+		out.Abort()
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := out.Commit(); err != nil {
+		return nil, err
+	}
+
+	manifest := w.manifest
+	return &manifest, nil
+}
+
+// Abort는 쓰던 문서 파일을 버립니다
+func (w *SnapshotWriter) Abort() {
+	w.out.Abort()
+}
+
+// ReadManifest는 백업의 매니페스트를 읽습니다 (완료되지 않은 백업이면 ErrNotFound)
+func ReadManifest(ctx context.Context, store Store, backupID string) (*Manifest, error) {
+	r, err := store.Open(ctx, ManifestKey(backupID))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// ListManifests는 collection의 완료된 백업 매니페스트를 시작 시각 순으로 반환합니다
+func ListManifests(ctx context.Context, store Store, collection string) ([]*Manifest, error) {
+	keys, err := store.List(ctx, collection+"-")
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []*Manifest
+	for _, key := range keys {
+		if path.Base(key) != manifestFile {
+			continue
+		}
+		manifest, err := ReadManifest(ctx, store, path.Dir(key))
+		if err != nil {
+			return nil, err
+		}
+		// 이름이 collection-으로 시작하는 다른 컬렉션의 백업 제외
+		if manifest.Collection == collection {
+			manifests = append(manifests, manifest)
+		}
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].StartedAt.Before(manifests[j].StartedAt)
+	})
+	return manifests, nil
+}
+
+// SnapshotTime은 백업이 반영하는 시각입니다
+// 일관된 백업은 읽기 트랜잭션을 시작한 시각, 그렇지 않으면 읽기를 마친 시각 이후의 상태만 보장합니다
+func (m *Manifest) SnapshotTime() time.Time {
+	if m.Consistent {
+		return m.StartedAt
+	}
+	return m.CompletedAt
+}
+
+// SnapshotReader는 백업 문서 파일을 한 건씩 읽습니다
+type SnapshotReader struct {
+	manifest *Manifest
+	in       io.ReadCloser
+	counter  *countingReader
+	gz       *gzip.Reader
+	dec      *json.Decoder
+	read     int64
+}
+
+// OpenSnapshot은 매니페스트의 문서 파일을 엽니다
+func OpenSnapshot(ctx context.Context, store Store, manifest *Manifest) (*SnapshotReader, error) {
+	if manifest.Format != Format {
+		return nil, fmt.Errorf("unsupported backup format: %s", manifest.Format)
+	}
+	in, err := store.Open(ctx, manifest.DocumentsKey)
+	if err != nil {
+		return nil, err
+	}
+	counter := &countingReader{r: in, hash: sha256.New()}
+	gz, err := gzip.NewReader(counter)
+	if err != nil {
+		in.Close()
+		return nil, fmt.Errorf("failed to decompress backup: %w", err)
+	}
+	return &SnapshotReader{
+		manifest: manifest,
+		in:       in,
+		counter:  counter,
+		gz:       gz,
+		dec:      json.NewDecoder(bufio.NewReader(gz)),
+	}, nil
+}
+
+// Next는 다음 문서를 collection 문서로 반환하고, 파일 끝이면 io.EOF를 반환합니다
+// 파일 끝에서 문서 수와 체크섬을 매니페스트와 비교해 다르면 에러를 반환합니다
+func (r *SnapshotReader) Next(collection string) (*entity.Document, error) {
+	var rec record
+	err := r.dec.Decode(&rec)
+	if err == io.EOF {
+		return nil, r.verify()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup document: %w", err)
+	}
+	r.read++
+	return entity.ReconstructDocument(rec.ID, collection, rec.Data, rec.Version, rec.CreatedAt, rec.UpdatedAt), nil
+}
+
+// verify는 문서 수와 압축 파일의 체크섬을 매니페스트와 비교합니다
+func (r *SnapshotReader) verify() error {
+	// gzip 트레일러 이후 남은 바이트까지 읽어 체크섬에 포함
+	if _, err := io.Copy(io.Discard, r.counter); err != nil {
+		return fmt.Errorf("failed to read backup: %w", err)
+	}
+	if r.read != r.manifest.Documents {
+		return fmt.Errorf("backup is incomplete: read %d of %d documents", r.read, r.manifest.Documents)
+	}
+	if sum := hex.EncodeToString(r.counter.hash.Sum(nil)); r.manifest.SHA256 != "" && sum != r.manifest.SHA256 {
+		return fmt.Errorf("backup checksum mismatch: expected %s, got %s", r.manifest.SHA256, sum)
+	}
+	return io.EOF
+}
+
+// Close는 문서 파일을 닫습니다
+func (r *SnapshotReader) Close() error {
+	r.gz.Close()
+	return r.in.Close()
+}
+
+// countingWriter는 쓴 바이트 수와 체크섬을 계산합니다
+type countingWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	n    int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.hash.Write(p[:n])
+	c.n += int64(n)
+	return n, err
+}
+
+// countingReader는 읽은 바이트의 체크섬을 계산합니다
+type countingReader struct {
+	r    io.Reader
+	hash hash.Hash
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.hash.Write(p[:n])
+	return n, err
+}
@@ -0,0 +1,161 @@
+// Package backup은 컬렉션 백업 스냅샷을 저장소(로컬 디렉터리, S3)에 쓰고 읽습니다
+//
+// 백업 하나는 <backup_id>/documents.ndjson.gz (gzip으로 압축한 NDJSON 문서 스트림)와
+// <backup_id>/manifest.json으로 구성됩니다
+// 매니페스트는 문서를 모두 쓴 뒤 마지막에 저장하므로, 매니페스트가 있는 백업만 완료된 백업입니다
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound는 백업 객체가 없을 때 반환됩니다
+var ErrNotFound = errors.New("backup object not found")
+
+// Store는 백업 객체를 저장하는 저장소입니다
+type Store interface {
+	// Create는 key에 쓰는 Writer를 반환합니다 (Commit이 성공해야 저장이 완료됨)
+	Create(ctx context.Context, key string) (Writer, error)
+
+	// Open은 key의 객체를 읽는 Reader를 반환합니다 (없으면 ErrNotFound)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// List는 prefix로 시작하는 객체 키 목록을 반환합니다
+	List(ctx context.Context, prefix string) ([]string, error)
+
+	// Location은 key의 위치를 사람이 읽을 수 있는 형태로 반환합니다 (예: s3://bucket/prefix/key)
+	Location(key string) string
+}
+
+// Writer는 백업 객체 하나를 씁니다
+// Commit이 성공하기 전에는 객체가 보이지 않으며, 실패하면 Abort로 쓰던 내용을 버립니다
+type Writer interface {
+	io.Writer
+
+	// Commit은 쓴 내용을 저장합니다
+	Commit() error
+
+	// Abort는 쓴 내용을 버립니다
+	Abort() error
+}
+
+// LocalStore는 로컬(또는 마운트된) 디렉터리에 백업을 저장합니다
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore는 dir 아래에 백업을 저장하는 LocalStore를 생성합니다
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("backup directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+// Create는 임시 파일에 쓰고 Commit 시 key 경로로 이름을 바꿔, 쓰는 중인 파일이 완료된 백업으로 보이지 않게 합니다
+func (s *LocalStore) Create(ctx context.Context, key string) (Writer, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup file: %w", err)
+	}
+	return &localFile{File: f, path: path}, nil
+}
+
+// Open은 key 경로의 파일을 엽니다
+func (s *LocalStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open backup file: %w", err)
+	}
+	return f, nil
+}
+
+// List는 dir 아래에서 prefix로 시작하는 파일 키 목록을 반환합니다 (쓰는 중인 임시 파일 제외)
+func (s *LocalStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backup files: %w", err)
+	}
+	return keys, nil
+}
+
+// Location은 key의 파일 경로를 반환합니다
+func (s *LocalStore) Location(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+// path는 key를 dir 아래의 파일 경로로 변환합니다 (dir 밖을 가리키는 key는 거부)
+func (s *LocalStore) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if clean == "." || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid backup key: %s", key)
+	}
+	return filepath.Join(s.dir, clean), nil
+}
+
+// localFile은 Commit 시 임시 파일을 최종 경로로 옮깁니다
+type localFile struct {
+	*os.File
+	path string
+}
+
+func (f *localFile) Commit() error {
+	if err := f.File.Sync(); err != nil {
+		f.Abort()
+		return fmt.Errorf("failed to sync backup file: %w", err)
+	}
+	if err := f.File.Close(); err != nil {
+		os.Remove(f.File.Name())
+		return fmt.Errorf("failed to close backup file: %w", err)
+	}
+	if err := os.Rename(f.File.Name(), f.path); err != nil {
+		os.Remove(f.File.Name())
+		return fmt.Errorf("failed to finalize backup file: %w", err)
+	}
+	return nil
+}
+
+func (f *localFile) Abort() error {
+	f.File.Close()
+	return os.Remove(f.File.Name())
+}
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/redis/go-redis/v9"
+)
+
+// FeatureFlagStore는 Redis 기반 기능 플래그 변경 저장소입니다
+// 하나의 해시에 <플래그> (전체) 또는 <플래그>@<테넌트> 필드로 "true"/"false"를 저장합니다
+type FeatureFlagStore struct {
+	client redis.UniversalClient
+	key    string
+}
+
+// NewFeatureFlagStore는 새로운 기능 플래그 변경 저장소를 생성합니다
+func (r *RedisExtended) NewFeatureFlagStore(key string) *FeatureFlagStore {
+	return &FeatureFlagStore{
+		client: r.client,
+		key:    key,
+	}
+}
+
+// List는 저장된 모든 변경을 반환합니다 (값을 읽을 수 없는 필드는 건너뜁니다)
+func (s *FeatureFlagStore) List(ctx context.Context) ([]featureflag.Override, error) {
+	fields, err := s.client.HGetAll(ctx, s.key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feature flag overrides: %w", err)
+	}
+
+	overrides := make([]featureflag.Override, 0, len(fields))
+	for field, value := range fields {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			continue
+		}
+		flag, tenant, _ := strings.Cut(field, "@")
+		overrides = append(overrides, featureflag.Override{Flag: flag, Tenant: tenant, Enabled: enabled})
+	}
+	return overrides, nil
+}
+
+// Set은 변경을 저장합니다
+func (s *FeatureFlagStore) Set(ctx context.Context, override featureflag.Override) error {
+	if err := s.client.HSet(ctx, s.key, featureFlagField(override.Flag, override.Tenant), strconv.FormatBool(override.Enabled)).Err(); err != nil {
+		return fmt.Errorf("failed to save feature flag override: %w", err)
+	}
+	return nil
+}
+
+// Delete는 변경을 삭제합니다
+func (s *FeatureFlagStore) Delete(ctx context.Context, flag, tenant string) (bool, error) {
+	n, err := s.client.HDel(ctx, s.key, featureFlagField(flag, tenant)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete feature flag override: %w", err)
+	}
+	return n > 0, nil
+}
+
+// featureFlagField는 변경을 저장할 해시 필드 이름을 생성합니다
+func featureFlagField(flag, tenant string) string {
+	if tenant == "" {
+		return flag
+	}
+	return flag + "@" + tenant
+}
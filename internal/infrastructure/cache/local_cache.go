@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// LocalCacheConfig는 인프로세스 LRU 캐시 설정입니다
+type LocalCacheConfig struct {
+	// MaxEntries를 넘으면 가장 오래 사용되지 않은 항목부터 제거합니다
+	MaxEntries int
+
+	// TTL은 로컬 항목의 최대 수명입니다
+	// 다른 인스턴스의 변경이 로컬 캐시에 반영되지 않는 최대 시간이므로 짧게 유지해야 합니다
+	TTL time.Duration
+}
+
+// DefaultLocalCacheConfig는 기본 설정을 반환합니다
+func DefaultLocalCacheConfig() LocalCacheConfig {
+	return LocalCacheConfig{
+		MaxEntries: 10000,
+		TTL:        5 * time.Second,
+	}
+}
+
+type localEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// LocalCache는 크기와 TTL이 제한된 인프로세스 LRU 캐시입니다
+type LocalCache struct {
+	config LocalCacheConfig
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewLocalCache는 새로운 LRU 캐시를 생성합니다
+func NewLocalCache(config LocalCacheConfig) *LocalCache {
+	defaults := DefaultLocalCacheConfig()
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaults.MaxEntries
+	}
+	if config.TTL <= 0 {
+		config.TTL = defaults.TTL
+	}
+
+	return &LocalCache{
+		config: config,
+		ll:     list.New(),
+		items:  make(map[string]*list.Element),
+	}
+}
+
+// Get은 만료되지 않은 항목을 반환하고 최근 사용으로 표시합니다
+func (c *LocalCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	entry := elem.Value.(*localEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.removeElement(elem)
+		c.misses.Add(1)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(elem)
+	c.hits.Add(1)
+	return entry.value, true
+}
+
+// Set은 항목을 저장합니다 (ttl이 0이거나 설정 TTL보다 길면 설정 TTL 사용)
+func (c *LocalCache) Set(key string, value interface{}, ttl time.Duration) {
+	if ttl <= 0 || ttl > c.config.TTL {
+		ttl = c.config.TTL
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*localEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(elem)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&localEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.config.MaxEntries {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Delete는 항목을 제거합니다
+func (c *LocalCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// Len은 저장된 항목 수를 반환합니다 (만료된 항목 포함)
+func (c *LocalCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// Stats는 누적 적중/미적중 횟수를 반환합니다
+func (c *LocalCache) Stats() (hits, misses int64) {
+	return c.hits.Load(), c.misses.Load()
+}
+
+// removeElement는 리스트와 맵에서 항목을 제거합니다 (호출자가 잠금 보유)
+func (c *LocalCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*localEntry).key)
+}
+
+// TieredCache는 인프로세스 LRU를 Redis 앞에 두는 2단계 캐시입니다
+// 핫 키 조회를 네트워크 왕복 없이 처리하며, 로컬 항목은 짧은 TTL로 다른 인스턴스의 변경과의 불일치를 제한합니다
+type TieredCache struct {
+	local  *LocalCache
+	remote repository.CacheRepository
+}
+
+// NewTieredCache는 새로운 2단계 캐시를 생성합니다
+func NewTieredCache(local *LocalCache, remote repository.CacheRepository) *TieredCache {
+	return &TieredCache{
+		local:  local,
+		remote: remote,
+	}
+}
+
+// Get은 로컬 캐시를 먼저 조회하고, 없으면 Redis에서 가져와 로컬에 채웁니다
+func (t *TieredCache) Get(ctx context.Context, key string) (interface{}, error) {
+	if value, ok := t.local.Get(key); ok {
+		return value, nil
+	}
+
+	value, err := t.remote.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	t.local.Set(key, value, 0)
+	return value, nil
+}
+
+// Set은 Redis에 저장하고 로컬 항목을 무효화합니다
+// 로컬에는 Redis에서 읽은(역직렬화된) 값만 두어 조회 결과의 타입을 계층과 무관하게 동일하게 유지합니다
+func (t *TieredCache) Set(ctx context.Context, key string, value interface{}, ttl int) error {
+	t.local.Delete(key)
+	return t.remote.Set(ctx, key, value, ttl)
+}
+
+// Delete는 로컬과 Redis 양쪽에서 제거합니다
+func (t *TieredCache) Delete(ctx context.Context, key string) error {
+	t.local.Delete(key)
+	return t.remote.Delete(ctx, key)
+}
+
+// Exists는 로컬 캐시를 먼저 확인하고, 없으면 Redis를 확인합니다
+func (t *TieredCache) Exists(ctx context.Context, key string) (bool, error) {
+	if _, ok := t.local.Get(key); ok {
+		return true, nil
+	}
+	return t.remote.Exists(ctx, key)
+}
+
+// TTL은 Redis 항목의 남은 수명을 반환합니다 (원격 저장소가 지원하지 않으면 0)
+func (t *TieredCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	reader, ok := t.remote.(repository.CacheTTLReader)
+	if !ok {
+		return 0, nil
+	}
+	return reader.TTL(ctx, key)
+}
+
+// Local은 로컬 캐시 계층을 반환합니다 (통계 조회용)
+func (t *TieredCache) Local() *LocalCache {
+	return t.local
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
+	"github.com/redis/go-redis/v9"
+)
+
+// lockoutFailureScript는 실패 횟수를 원자적으로 집계합니다
+// 임계치에 도달하면 잠금 단계를 올리고 실패 집계를 초기화합니다
+// 반환값: {실패 횟수, 잠금 단계, 임계치 도달 여부}
+var lockoutFailureScript = redis.NewScript(`
+	local failures_key = KEYS[1]
+	local level_key = KEYS[2]
+	local window_ms = tonumber(ARGV[1])
+	local max_failures = tonumber(ARGV[2])
+	local level_ttl_ms = tonumber(ARGV[3])
+
+	local failures = redis.call('INCR', failures_key)
+	if failures == 1 then
+		redis.call('PEXPIRE', failures_key, window_ms)
+	end
+
+	local level = tonumber(redis.call('GET', level_key) or '0')
+	if failures < max_failures then
+		return {failures, level, 0}
+	end
+
+	level = redis.call('INCR', level_key)
+	redis.call('PEXPIRE', level_key, level_ttl_ms)
+	redis.call('DEL', failures_key)
+	return {failures, level, 1}
+`)
+
+// LockoutStore는 Redis 기반 인증 실패 잠금 저장소입니다
+type LockoutStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewLockoutStore는 새로운 인증 실패 잠금 저장소를 생성합니다
+func (r *RedisExtended) NewLockoutStore(prefix string) *LockoutStore {
+	return &LockoutStore{
+		client: r.client,
+		prefix: prefix,
+	}
+}
+
+// LockedFor는 잠금 남은 시간을 반환합니다
+func (s *LockoutStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.client.PTTL(ctx, s.key("lock", key)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read lockout: %w", err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// RecordFailure는 인증 실패를 집계합니다
+func (s *LockoutStore) RecordFailure(ctx context.Context, key string, policy lockout.Policy) (*lockout.Failure, error) {
+	values, err := lockoutFailureScript.Run(ctx, s.client,
+		[]string{s.key("fail", key), s.key("level", key)},
+		policy.Window.Milliseconds(), policy.MaxFailures, policy.LevelTTL.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to record auth failure: %w", err)
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("unexpected lockout script result: %v", values)
+	}
+
+	return &lockout.Failure{
+		Failures: values[0],
+		Level:    values[1],
+		Tripped:  values[2] == 1,
+	}, nil
+}
+
+// Lock은 키를 duration 동안 잠급니다
+func (s *LockoutStore) Lock(ctx context.Context, key string, duration time.Duration) error {
+	if err := s.client.Set(ctx, s.key("lock", key), 1, duration).Err(); err != nil {
+		return fmt.Errorf("failed to set lockout: %w", err)
+	}
+	return nil
+}
+
+// key는 Redis 키를 생성합니다
+// 같은 대상의 키들이 클러스터에서 같은 슬롯에 배치되도록 대상을 해시 태그로 감쌉니다 (Lua 스크립트 다중 키 접근)
+func (s *LockoutStore) key(kind, key string) string {
+	return fmt.Sprintf("%s:%s:{%s}", s.prefix, kind, key)
+}
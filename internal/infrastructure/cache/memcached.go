@@ -0,0 +1,355 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// memcachedMaxRelativeTTL은 memcached가 상대 시간으로 해석하는 최대 만료(초)입니다 (30일)
+// 이보다 긴 만료는 Unix 시각으로 보내야 합니다
+const memcachedMaxRelativeTTL = 60 * 60 * 24 * 30
+
+// memcachedMaxKeyLength는 memcached 키 최대 길이입니다
+const memcachedMaxKeyLength = 250
+
+// MemcachedConfig는 memcached 캐시 설정입니다
+type MemcachedConfig struct {
+	// Servers는 host:port 목록입니다 (키 해시로 서버 선택)
+	Servers []string
+
+	// Timeout은 연결 및 요청당 읽기/쓰기 타임아웃입니다
+	Timeout time.Duration
+
+	// MaxIdleConns는 서버당 유지할 유휴 연결 수입니다
+	MaxIdleConns int
+}
+
+// DefaultMemcachedConfig는 기본 설정을 반환합니다
+func DefaultMemcachedConfig() MemcachedConfig {
+	return MemcachedConfig{
+		Timeout:      500 * time.Millisecond,
+		MaxIdleConns: 16,
+	}
+}
+
+// MemcachedCache는 memcached 텍스트 프로토콜 기반 CacheRepository 구현입니다
+// Redis 캐시와 같이 값을 JSON으로 직렬화하므로 캐시 백엔드를 바꿔도 조회 결과 형식이 같습니다
+type MemcachedCache struct {
+	config  MemcachedConfig
+	servers []*memcachedServer
+}
+
+// memcachedServer는 서버 하나의 연결 풀입니다
+type memcachedServer struct {
+	addr string
+	idle chan *memcachedConn
+}
+
+type memcachedConn struct {
+	nc net.Conn
+	rw *bufio.ReadWriter
+}
+
+// NewMemcachedCache는 새로운 memcached 캐시를 생성합니다
+func NewMemcachedCache(ctx context.Context, config MemcachedConfig) (*MemcachedCache, error) {
+	if len(config.Servers) == 0 {
+		return nil, fmt.Errorf("memcached requires at least one server")
+	}
+	defaults := DefaultMemcachedConfig()
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+	if config.MaxIdleConns <= 0 {
+		config.MaxIdleConns = defaults.MaxIdleConns
+	}
+
+	c := &MemcachedCache{config: config}
+	for _, addr := range config.Servers {
+		c.servers = append(c.servers, &memcachedServer{
+			addr: addr,
+			idle: make(chan *memcachedConn, config.MaxIdleConns),
+		})
+	}
+
+	for _, server := range c.servers {
+		if err := c.ping(ctx, server); err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed to connect to memcached %s: %w", server.addr, err)
+		}
+	}
+	return c, nil
+}
+
+// Get은 캐시에서 값을 가져옵니다
+func (c *MemcachedCache) Get(ctx context.Context, key string) (interface{}, error) {
+	data, found, err := c.get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get value: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("key not found: %s", key)
+	}
+
+	var result interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal value: %w", err)
+	}
+	return result, nil
+}
+
+// get은 저장된 원본 값을 조회합니다
+func (c *MemcachedCache) get(ctx context.Context, key string) ([]byte, bool, error) {
+	var data []byte
+	found := false
+	err := c.do(ctx, key, func(conn *memcachedConn, k string) error {
+		if _, err := fmt.Fprintf(conn.rw, "get %s\r\n", k); err != nil {
+			return err
+		}
+		if err := conn.rw.Flush(); err != nil {
+			return err
+		}
+
+		for {
+			line, err := readLine(conn.rw)
+			if err != nil {
+				return err
+			}
+			if line == "END" {
+				return nil
+			}
+			if !strings.HasPrefix(line, "VALUE ") {
+				return fmt.Errorf("unexpected memcached response: %s", line)
+			}
+			fields := strings.Fields(line)
+			if len(fields) < 4 {
+				return fmt.Errorf("malformed memcached value line: %s", line)
+			}
+			size, err := strconv.Atoi(fields[3])
+			if err != nil {
+				return fmt.Errorf("malformed memcached value size: %s", line)
+			}
+			buf := make([]byte, size+2)
+			if _, err := io.ReadFull(conn.rw, buf); err != nil {
+				return err
+			}
+			data = buf[:size]
+			found = true
+		}
+	})
+	return data, found, err
+}
+
+// Set은 캐시에 값을 저장합니다 (ttl은 초, 0이면 만료 없음)
+func (c *MemcachedCache) Set(ctx context.Context, key string, value interface{}, ttl int) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	exptime := int64(ttl)
+	if ttl > memcachedMaxRelativeTTL {
+		exptime = time.Now().Unix() + int64(ttl)
+	}
+
+	err = c.do(ctx, key, func(conn *memcachedConn, k string) error {
+		if _, err := fmt.Fprintf(conn.rw, "set %s 0 %d %d\r\n", k, exptime, len(data)); err != nil {
+			return err
+		}
+		if _, err := conn.rw.Write(data); err != nil {
+			return err
+		}
+		if _, err := conn.rw.WriteString("\r\n"); err != nil {
+			return err
+		}
+		if err := conn.rw.Flush(); err != nil {
+			return err
+		}
+		line, err := readLine(conn.rw)
+		if err != nil {
+			return err
+		}
+		if line != "STORED" {
+			return fmt.Errorf("unexpected memcached response: %s", line)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set value: %w", err)
+	}
+	return nil
+}
+
+// Delete는 캐시에서 값을 삭제합니다
+func (c *MemcachedCache) Delete(ctx context.Context, key string) error {
+	err := c.do(ctx, key, func(conn *memcachedConn, k string) error {
+		if _, err := fmt.Fprintf(conn.rw, "delete %s\r\n", k); err != nil {
+			return err
+		}
+		if err := conn.rw.Flush(); err != nil {
+			return err
+		}
+		line, err := readLine(conn.rw)
+		if err != nil {
+			return err
+		}
+		if line != "DELETED" && line != "NOT_FOUND" {
+			return fmt.Errorf("unexpected memcached response: %s", line)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete value: %w", err)
+	}
+	return nil
+}
+
+// Exists는 키가 존재하는지 확인합니다
+func (c *MemcachedCache) Exists(ctx context.Context, key string) (bool, error) {
+	_, found, err := c.get(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+	return found, nil
+}
+
+// Close는 유휴 연결을 모두 닫습니다
+func (c *MemcachedCache) Close() error {
+	for _, server := range c.servers {
+	drain:
+		for {
+			select {
+			case conn := <-server.idle:
+				conn.nc.Close()
+			default:
+				break drain
+			}
+		}
+	}
+	return nil
+}
+
+// ping은 서버 연결을 확인합니다
+func (c *MemcachedCache) ping(ctx context.Context, server *memcachedServer) error {
+	conn, err := c.getConn(ctx, server)
+	if err != nil {
+		return err
+	}
+	if err := conn.nc.SetDeadline(time.Now().Add(c.config.Timeout)); err != nil {
+		conn.nc.Close()
+		return err
+	}
+	if _, err := conn.rw.WriteString("version\r\n"); err != nil {
+		conn.nc.Close()
+		return err
+	}
+	if err := conn.rw.Flush(); err != nil {
+		conn.nc.Close()
+		return err
+	}
+	line, err := readLine(conn.rw)
+	if err != nil || !strings.HasPrefix(line, "VERSION") {
+		conn.nc.Close()
+		if err == nil {
+			err = fmt.Errorf("unexpected memcached response: %s", line)
+		}
+		return err
+	}
+	c.putConn(server, conn)
+	return nil
+}
+
+// do는 키를 담당하는 서버의 연결로 요청을 실행합니다
+// 요청이 실패한 연결은 프로토콜 상태를 알 수 없으므로 재사용하지 않습니다
+func (c *MemcachedCache) do(ctx context.Context, key string, fn func(conn *memcachedConn, key string) error) error {
+	k := memcachedKey(key)
+	server := c.servers[crc32.ChecksumIEEE([]byte(k))%uint32(len(c.servers))]
+
+	conn, err := c.getConn(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(c.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.nc.SetDeadline(deadline); err != nil {
+		conn.nc.Close()
+		return err
+	}
+
+	if err := fn(conn, k); err != nil {
+		conn.nc.Close()
+		return err
+	}
+	c.putConn(server, conn)
+	return nil
+}
+
+// getConn은 유휴 연결을 꺼내거나 새로 연결합니다
+func (c *MemcachedCache) getConn(ctx context.Context, server *memcachedServer) (*memcachedConn, error) {
+	select {
+	case conn := <-server.idle:
+		return conn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: c.config.Timeout}
+	nc, err := dialer.DialContext(ctx, "tcp", server.addr)
+	if err != nil {
+		return nil, err
+	}
+	return &memcachedConn{
+		nc: nc,
+		rw: bufio.NewReadWriter(bufio.NewReader(nc), bufio.NewWriter(nc)),
+	}, nil
+}
+
+// putConn은 연결을 풀에 반환합니다 (풀이 가득 차면 닫음)
+func (c *MemcachedCache) putConn(server *memcachedServer, conn *memcachedConn) {
+	select {
+	case server.idle <- conn:
+	default:
+		conn.nc.Close()
+	}
+}
+
+// memcachedKey는 memcached 키 제약(250바이트, 공백/제어 문자 불가)에 맞게 키를 변환합니다
+// 제약을 벗어나는 키는 SHA-256 해시로 대체합니다
+func memcachedKey(key string) string {
+	valid := len(key) > 0 && len(key) <= memcachedMaxKeyLength
+	for i := 0; valid && i < len(key); i++ {
+		if key[i] <= ' ' || key[i] == 0x7f {
+			valid = false
+		}
+	}
+	if valid {
+		return key
+	}
+	sum := sha256.Sum256([]byte(key))
+	return "h:" + hex.EncodeToString(sum[:])
+}
+
+// readLine은 CRLF로 끝나는 응답 한 줄을 읽습니다
+func readLine(r *bufio.ReadWriter) (string, error) {
+	line, err := r.ReadSlice('\n')
+	if err != nil {
+		return "", err
+	}
+	line = bytes.TrimSuffix(line, []byte("\r\n"))
+	if bytes.HasPrefix(line, []byte("SERVER_ERROR")) || bytes.HasPrefix(line, []byte("CLIENT_ERROR")) || bytes.Equal(line, []byte("ERROR")) {
+		return "", fmt.Errorf("memcached error: %s", line)
+	}
+	return string(line), nil
+}
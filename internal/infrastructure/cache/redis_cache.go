@@ -0,0 +1,192 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	redisrepo "github.com/YouSangSon/database-service/internal/infrastructure/persistence/redis"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis 배포 모드
+const (
+	// ModeStandalone은 단일 노드 Redis입니다 (기본값)
+	ModeStandalone = "standalone"
+
+	// ModeSentinel은 Sentinel이 감시하는 마스터/레플리카 구성입니다 (자동 장애 조치)
+	ModeSentinel = "sentinel"
+
+	// ModeCluster는 Redis Cluster입니다 (샤딩 + 자동 장애 조치)
+	ModeCluster = "cluster"
+)
+
+// Config는 Redis 캐시 연결 설정입니다
+type Config struct {
+	// Mode는 standalone, sentinel, cluster 중 하나입니다 (비어 있으면 standalone)
+	Mode string
+
+	// Host, Port는 standalone 모드의 주소입니다
+	Host string
+	Port int
+
+	// Addresses는 sentinel 모드의 Sentinel 주소 목록 또는 cluster 모드의 시드 노드 목록입니다
+	Addresses []string
+
+	// MasterName은 sentinel 모드에서 감시 대상 마스터 이름입니다
+	MasterName string
+
+	// SentinelPassword는 Sentinel 자체 인증 비밀번호입니다 (비어 있으면 인증 없음)
+	SentinelPassword string
+
+	Password     string
+	DB           int
+	MaxRetries   int
+	PoolSize     int
+	MinIdleConn  int
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+
+	// PoolTimeout은 모든 연결이 사용 중일 때 연결을 기다리는 최대 시간입니다 (0이면 ReadTimeout + 1초)
+	PoolTimeout time.Duration
+
+	// ConnMaxIdleTime은 유휴 연결을 닫기까지의 시간입니다 (0이면 드라이버 기본값 30분)
+	ConnMaxIdleTime time.Duration
+
+	// ConnMaxLifetime은 연결을 재사용하는 최대 시간입니다 (0이면 제한 없음)
+	ConnMaxLifetime time.Duration
+}
+
+// RedisCache는 Redis 기반 문서 캐시입니다
+// 배포 모드와 무관하게 같은 CacheRepository 구현과 UniversalClient를 제공합니다
+type RedisCache struct {
+	*redisrepo.CacheRepository
+	client   redis.UniversalClient
+	mode     string
+	poolSize int
+}
+
+// NewRedisCache는 설정된 모드로 Redis에 연결하고 캐시를 생성합니다
+func NewRedisCache(ctx context.Context, cfg *Config) (*RedisCache, error) {
+	client, err := newUniversalClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to connect to redis (%s): %w", cfg.mode(), err)
+	}
+
+	return &RedisCache{
+		CacheRepository: redisrepo.NewCacheRepositoryWithClient(client),
+		client:          client,
+		mode:            cfg.mode(),
+		poolSize:        cfg.PoolSize,
+	}, nil
+}
+
+// Client는 Redis 클라이언트를 반환합니다 (Pub/Sub, 분산 락, rate limit 등 확장 기능용)
+func (c *RedisCache) Client() redis.UniversalClient {
+	return c.client
+}
+
+// Mode는 연결된 Redis 배포 모드를 반환합니다
+func (c *RedisCache) Mode() string {
+	return c.mode
+}
+
+// PoolStats는 연결 풀 상태를 반환합니다 (클러스터 모드는 모든 노드 합계이며 MaxOpen은 노드당 풀 크기)
+// go-redis는 대기 시간을 제공하지 않으므로 풀에 유휴 연결이 없던 횟수(Misses)를 대기 횟수로 사용합니다
+func (c *RedisCache) PoolStats() poolstats.Stats {
+	s := c.client.PoolStats()
+	return poolstats.Stats{
+		MaxOpen:   c.poolSize,
+		Open:      int(s.TotalConns),
+		InUse:     int(s.TotalConns) - int(s.IdleConns),
+		Idle:      int(s.IdleConns),
+		WaitCount: int64(s.Misses),
+		Timeouts:  int64(s.Timeouts),
+	}
+}
+
+// mode는 기본값을 적용한 배포 모드를 반환합니다
+func (cfg *Config) mode() string {
+	if cfg.Mode == "" {
+		return ModeStandalone
+	}
+	return cfg.Mode
+}
+
+// newUniversalClient는 배포 모드에 맞는 Redis 클라이언트를 생성합니다
+func newUniversalClient(cfg *Config) (redis.UniversalClient, error) {
+	switch cfg.mode() {
+	case ModeStandalone:
+		return redis.NewClient(&redis.Options{
+			Addr:            fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+			Password:        cfg.Password,
+			DB:              cfg.DB,
+			MaxRetries:      cfg.MaxRetries,
+			PoolSize:        cfg.PoolSize,
+			MinIdleConns:    cfg.MinIdleConn,
+			DialTimeout:     cfg.DialTimeout,
+			ReadTimeout:     cfg.ReadTimeout,
+			WriteTimeout:    cfg.WriteTimeout,
+			PoolTimeout:     cfg.PoolTimeout,
+			ConnMaxIdleTime: cfg.ConnMaxIdleTime,
+			ConnMaxLifetime: cfg.ConnMaxLifetime,
+		}), nil
+
+	case ModeSentinel:
+		if len(cfg.Addresses) == 0 {
+			return nil, fmt.Errorf("redis sentinel mode requires at least one sentinel address")
+		}
+		if cfg.MasterName == "" {
+			return nil, fmt.Errorf("redis sentinel mode requires a master name")
+		}
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       cfg.MasterName,
+			SentinelAddrs:    cfg.Addresses,
+			SentinelPassword: cfg.SentinelPassword,
+			Password:         cfg.Password,
+			DB:               cfg.DB,
+			MaxRetries:       cfg.MaxRetries,
+			PoolSize:         cfg.PoolSize,
+			MinIdleConns:     cfg.MinIdleConn,
+			DialTimeout:      cfg.DialTimeout,
+			ReadTimeout:      cfg.ReadTimeout,
+			WriteTimeout:     cfg.WriteTimeout,
+			PoolTimeout:      cfg.PoolTimeout,
+			ConnMaxIdleTime:  cfg.ConnMaxIdleTime,
+			ConnMaxLifetime:  cfg.ConnMaxLifetime,
+		}), nil
+
+	case ModeCluster:
+		if len(cfg.Addresses) == 0 {
+			return nil, fmt.Errorf("redis cluster mode requires at least one node address")
+		}
+		if cfg.DB != 0 {
+			return nil, fmt.Errorf("redis cluster mode does not support db %d (only db 0)", cfg.DB)
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:           cfg.Addresses,
+			Password:        cfg.Password,
+			MaxRetries:      cfg.MaxRetries,
+			PoolSize:        cfg.PoolSize,
+			MinIdleConns:    cfg.MinIdleConn,
+			DialTimeout:     cfg.DialTimeout,
+			ReadTimeout:     cfg.ReadTimeout,
+			WriteTimeout:    cfg.WriteTimeout,
+			PoolTimeout:     cfg.PoolTimeout,
+			ConnMaxIdleTime: cfg.ConnMaxIdleTime,
+			ConnMaxLifetime: cfg.ConnMaxLifetime,
+		}), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported redis mode: %s", cfg.Mode)
+	}
+}
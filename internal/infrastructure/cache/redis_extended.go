@@ -12,11 +12,11 @@ import (
 
 // RedisExtended는 확장된 Redis 클라이언트입니다
 type RedisExtended struct {
-	client *redis.Client
+	client redis.UniversalClient
 }
 
 // NewRedisExtended는 새로운 확장 Redis 클라이언트를 생성합니다
-func NewRedisExtended(client *redis.Client) *RedisExtended {
+func NewRedisExtended(client redis.UniversalClient) *RedisExtended {
 	return &RedisExtended{
 		client: client,
 	}
@@ -26,7 +26,7 @@ func NewRedisExtended(client *redis.Client) *RedisExtended {
 
 // PubSubManager는 Pub/Sub 관리자입니다
 type PubSubManager struct {
-	client *redis.Client
+	client redis.UniversalClient
 	pubsub *redis.PubSub
 }
 
@@ -87,7 +87,7 @@ func (p *PubSubManager) Close() error {
 
 // RateLimiter는 속도 제한기입니다
 type RateLimiter struct {
-	client *redis.Client
+	client redis.UniversalClient
 	prefix string
 }
 
@@ -181,7 +181,7 @@ func (rl *RateLimiter) Reset(ctx context.Context, key string) error {
 
 // DistributedLock는 분산 락입니다
 type DistributedLock struct {
-	client   *redis.Client
+	client   redis.UniversalClient
 	key      string
 	token    string
 	ttl      time.Duration
@@ -289,7 +289,7 @@ func (dl *DistributedLock) Extend(ctx context.Context, ttl time.Duration) (bool,
 
 // DistributedCounter는 분산 카운터입니다
 type DistributedCounter struct {
-	client *redis.Client
+	client redis.UniversalClient
 	key    string
 }
 
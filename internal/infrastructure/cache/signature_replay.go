@@ -0,0 +1,33 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SignatureReplayCache는 Redis 기반 요청 서명 재전송 방지 저장소입니다
+// SET NX로 처음 본 서명만 기록하므로 여러 인스턴스가 같은 서명을 한 번만 받습니다
+type SignatureReplayCache struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewSignatureReplayCache는 새로운 서명 재전송 방지 저장소를 생성합니다
+func (r *RedisExtended) NewSignatureReplayCache(prefix string) *SignatureReplayCache {
+	return &SignatureReplayCache{
+		client: r.client,
+		prefix: prefix,
+	}
+}
+
+// Remember는 서명이 처음이면 ttl 동안 기록하고 true를, 이미 기록되어 있으면 false를 반환합니다
+func (s *SignatureReplayCache) Remember(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	first, err := s.client.SetNX(ctx, fmt.Sprintf("%s:%s", s.prefix, key), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record request signature: %w", err)
+	}
+	return first, nil
+}
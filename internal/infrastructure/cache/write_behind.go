@@ -0,0 +1,173 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// WriteBehindConfig는 write-behind 캐시 큐 설정입니다
+type WriteBehindConfig struct {
+	// FlushInterval마다 대기 중인 쓰기를 반영합니다
+	FlushInterval time.Duration
+
+	// BatchSize만큼 쓰기가 쌓이면 주기를 기다리지 않고 반영합니다
+	BatchSize int
+
+	// MaxPending을 넘는 쓰기는 버립니다 (캐시 채우기는 최선 노력이므로 메모리 상한을 우선)
+	MaxPending int
+}
+
+// DefaultWriteBehindConfig는 기본 설정을 반환합니다
+func DefaultWriteBehindConfig() WriteBehindConfig {
+	return WriteBehindConfig{
+		FlushInterval: 500 * time.Millisecond,
+		BatchSize:     100,
+		MaxPending:    10000,
+	}
+}
+
+type pendingWrite struct {
+	value interface{}
+	ttl   time.Duration
+}
+
+// WriteBehindQueue는 캐시 쓰기를 키별로 합쳐 두었다가 일괄로 Redis에 반영합니다
+// 같은 키에 대한 연속 쓰기는 마지막 값만 반영되어 쓰기가 잦은 문서의 Redis 부하를 줄입니다
+type WriteBehindQueue struct {
+	target repository.CacheRepository
+	config WriteBehindConfig
+
+	mu      sync.Mutex
+	pending map[string]pendingWrite
+	dropped int64
+
+	flushCh chan struct{}
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewWriteBehindQueue는 새로운 write-behind 큐를 생성합니다
+func NewWriteBehindQueue(target repository.CacheRepository, config WriteBehindConfig) *WriteBehindQueue {
+	defaults := DefaultWriteBehindConfig()
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaults.FlushInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.MaxPending <= 0 {
+		config.MaxPending = defaults.MaxPending
+	}
+
+	return &WriteBehindQueue{
+		target:  target,
+		config:  config,
+		pending: make(map[string]pendingWrite),
+		flushCh: make(chan struct{}, 1),
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+}
+
+// Start는 백그라운드 반영 루프를 시작합니다
+func (q *WriteBehindQueue) Start(ctx context.Context) {
+	go q.run(ctx)
+}
+
+// Enqueue는 캐시 쓰기를 예약합니다
+func (q *WriteBehindQueue) Enqueue(key string, value interface{}, ttl time.Duration) {
+	q.mu.Lock()
+	_, exists := q.pending[key]
+	if !exists && len(q.pending) >= q.config.MaxPending {
+		q.dropped++
+		q.mu.Unlock()
+		q.signal()
+		return
+	}
+	q.pending[key] = pendingWrite{value: value, ttl: ttl}
+	full := len(q.pending) >= q.config.BatchSize
+	q.mu.Unlock()
+
+	if full {
+		q.signal()
+	}
+}
+
+// Discard는 대기 중인 쓰기를 취소합니다
+func (q *WriteBehindQueue) Discard(key string) {
+	q.mu.Lock()
+	delete(q.pending, key)
+	q.mu.Unlock()
+}
+
+// Flush는 대기 중인 쓰기를 즉시 반영합니다
+func (q *WriteBehindQueue) Flush(ctx context.Context) {
+	q.mu.Lock()
+	batch := q.pending
+	dropped := q.dropped
+	q.pending = make(map[string]pendingWrite, len(batch))
+	q.dropped = 0
+	q.mu.Unlock()
+
+	if dropped > 0 {
+		logger.Warn(ctx, "write-behind cache queue full, writes dropped",
+			zap.Int64("dropped", dropped),
+			zap.Int("max_pending", q.config.MaxPending),
+		)
+	}
+
+	failed := 0
+	for key, w := range batch {
+		if err := q.target.Set(ctx, key, w.value, int(w.ttl/time.Second)); err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		logger.Warn(ctx, "write-behind cache flush partially failed",
+			zap.Int("batch_size", len(batch)),
+			zap.Int("failed", failed),
+		)
+	}
+}
+
+// Close는 반영 루프를 멈추고 남은 쓰기를 반영합니다
+func (q *WriteBehindQueue) Close() {
+	close(q.stopCh)
+	<-q.doneCh
+}
+
+// signal은 반영 루프를 깨웁니다
+func (q *WriteBehindQueue) signal() {
+	select {
+	case q.flushCh <- struct{}{}:
+	default:
+	}
+}
+
+// run은 주기 또는 배치 크기 도달 시 대기 중인 쓰기를 반영합니다
+func (q *WriteBehindQueue) run(ctx context.Context) {
+	defer close(q.doneCh)
+
+	ticker := time.NewTicker(q.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			q.Flush(ctx)
+		case <-q.flushCh:
+			q.Flush(ctx)
+		case <-q.stopCh:
+			q.Flush(context.WithoutCancel(ctx))
+			return
+		case <-ctx.Done():
+			q.Flush(context.WithoutCancel(ctx))
+			return
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package messaging
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CDC 메시지 형식
+const (
+	// EventFormatNative는 이벤트 구조체를 그대로 JSON으로 보냅니다 (기본값)
+	EventFormatNative = "native"
+
+	// EventFormatCloudEvents는 CloudEvents 1.0 구조화 JSON 봉투로 감싸서 보냅니다
+	EventFormatCloudEvents = "cloudevents"
+)
+
+const (
+	// CloudEventsSpecVersion은 CloudEvents 명세 버전입니다
+	CloudEventsSpecVersion = "1.0"
+
+	// CloudEventsContentType은 구조화 모드 메시지의 콘텐츠 타입입니다
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// CloudEventTypePrefix는 CloudEvents type 속성 접두사입니다 (com.dbservice.document.created 등)
+	CloudEventTypePrefix = "com.dbservice."
+
+	// DefaultCloudEventSource는 source를 지정하지 않았을 때 사용하는 값입니다
+	DefaultCloudEventSource = "/database-service"
+)
+
+// CloudEvent는 CloudEvents 1.0 구조화 JSON 봉투입니다
+// data에는 native 형식과 같은 이벤트 JSON이 들어가므로 봉투만 벗기면 기존 소비자 코드로 처리할 수 있습니다
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	PartitionKey    string          `json:"partitionkey,omitempty"` // Partitioning 확장 (문서 ID)
+	Data            json.RawMessage `json:"data"`
+}
+
+// EventEncoder는 CDC 이벤트를 설정된 형식(native, cloudevents, protobuf, debezium)으로 직렬화합니다
+// 0 값은 native 형식입니다
+type EventEncoder struct {
+	Format string
+	Source string
+
+	// ServerName은 Debezium source.name(논리 서버 이름)입니다
+	ServerName string
+}
+
+// Encode는 이벤트를 직렬화합니다 (meta는 event에 포함된 공통 필드)
+func (e EventEncoder) Encode(meta *DocumentEvent, event interface{}) ([]byte, error) {
+	switch e.Format {
+	case EventFormatProtobuf:
+		return EncodeProtoEvent(meta, event)
+	case EventFormatDebezium:
+		return encodeDebezium(e.ServerName, meta, event)
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	if e.Format != EventFormatCloudEvents {
+		return payload, nil
+	}
+
+	source := e.Source
+	if source == "" {
+		source = DefaultCloudEventSource
+	}
+	envelope, err := json.Marshal(CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              meta.EventID,
+		Source:          source,
+		Type:            CloudEventTypePrefix + meta.EventType,
+		Subject:         meta.Collection + "/" + meta.DocumentID,
+		Time:            meta.Timestamp,
+		DataContentType: "application/json",
+		PartitionKey:    meta.DocumentID,
+		Data:            payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cloudevent: %w", err)
+	}
+	return envelope, nil
+}
+
+// ContentType은 직렬화된 메시지의 콘텐츠 타입입니다
+func (e EventEncoder) ContentType() string {
+	switch e.Format {
+	case EventFormatCloudEvents:
+		return CloudEventsContentType
+	case EventFormatProtobuf:
+		return ProtobufContentType
+	}
+	return "application/json"
+}
+
+// UnwrapEvent는 CloudEvents 봉투이면 data를, Debezium 봉투이면 변환한 이벤트를, 아니면 메시지를 그대로 반환합니다
+// 소비자는 발행 형식과 관계없이 이벤트 JSON을 얻을 수 있습니다
+func UnwrapEvent(message []byte) ([]byte, error) {
+	var probe struct {
+		SpecVersion string          `json:"specversion"`
+		Data        json.RawMessage `json:"data"`
+		Op          string          `json:"op"`
+	}
+	if err := json.Unmarshal(message, &probe); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	if probe.SpecVersion == "" {
+		if probe.Op != "" {
+			var envelope DebeziumEnvelope
+			if err := json.Unmarshal(message, &envelope); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal debezium envelope: %w", err)
+			}
+			return unwrapDebezium(&envelope)
+		}
+		return message, nil
+	}
+	if len(probe.Data) == 0 {
+		return nil, fmt.Errorf("cloudevent has no data")
+	}
+	return probe.Data, nil
+}
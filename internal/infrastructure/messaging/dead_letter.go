@@ -0,0 +1,158 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ErrDeadLettered는 발행에 실패한 이벤트가 DLQ에 보관되었을 때 반환됩니다 (이벤트는 유실되지 않음)
+var ErrDeadLettered = errors.New("cdc event moved to dead letter queue")
+
+// DeadLetterStore는 발행 실패 이벤트 저장소입니다 (repository.DeadLetterRepository가 구현)
+type DeadLetterStore interface {
+	Save(ctx context.Context, event *entity.DeadLetterEvent) error
+}
+
+// DeadLetterConfig는 DLQ 발행자 설정입니다
+type DeadLetterConfig struct {
+	// MaxAttempts는 DLQ로 보내기 전 최대 발행 시도 횟수입니다 (최초 시도 포함)
+	MaxAttempts int
+
+	// Backoff는 첫 재시도 대기 시간입니다 (시도마다 두 배)
+	Backoff time.Duration
+}
+
+// DeadLetterPublisher는 발행 실패 시 재시도하고, 끝내 실패한 이벤트를 DLQ 저장소에 보관하는 CDCPublisher 래퍼입니다
+// 보관된 이벤트는 Redrive로 다시 발행합니다
+type DeadLetterPublisher struct {
+	next   CDCPublisher
+	store  DeadLetterStore
+	config DeadLetterConfig
+}
+
+// NewDeadLetterPublisher는 새로운 DLQ 발행자를 생성합니다
+func NewDeadLetterPublisher(next CDCPublisher, store DeadLetterStore, config DeadLetterConfig) *DeadLetterPublisher {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 3
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = 200 * time.Millisecond
+	}
+	return &DeadLetterPublisher{next: next, store: store, config: config}
+}
+
+// SetOrigin은 내부 발행자에 인스턴스 ID를 설정합니다
+func (p *DeadLetterPublisher) SetOrigin(origin string) {
+	p.next.SetOrigin(origin)
+}
+
+// SetEncoder는 내부 발행자에 메시지 형식을 설정합니다
+func (p *DeadLetterPublisher) SetEncoder(encoder EventEncoder) {
+	p.next.SetEncoder(encoder)
+}
+
+// PublishDocumentCreated는 문서 생성 이벤트를 발행합니다
+func (p *DeadLetterPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	return p.publish(ctx, &entity.DeadLetterEvent{
+		EventType:  EventDocumentCreated,
+		DocumentID: docID,
+		Collection: collection,
+		Data:       data,
+		Version:    version,
+	})
+}
+
+// PublishDocumentUpdated는 문서 업데이트 이벤트를 발행합니다
+func (p *DeadLetterPublisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	return p.publish(ctx, &entity.DeadLetterEvent{
+		EventType:       EventDocumentUpdated,
+		DocumentID:      docID,
+		Collection:      collection,
+		Data:            data,
+		Version:         version,
+		PreviousVersion: previousVersion,
+		Changes:         changes,
+	})
+}
+
+// PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다
+func (p *DeadLetterPublisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	return p.publish(ctx, &entity.DeadLetterEvent{
+		EventType:  EventDocumentDeleted,
+		DocumentID: docID,
+		Collection: collection,
+		Version:    version,
+	})
+}
+
+// Redrive는 DLQ 이벤트를 한 번 다시 발행합니다 (재시도와 DLQ 보관 없이 결과만 반환)
+func (p *DeadLetterPublisher) Redrive(ctx context.Context, event *entity.DeadLetterEvent) error {
+	return p.send(ctx, event)
+}
+
+// publish는 백오프를 두고 재시도하며, 모두 실패하면 이벤트를 DLQ에 보관합니다
+func (p *DeadLetterPublisher) publish(ctx context.Context, event *entity.DeadLetterEvent) error {
+	backoff := p.config.Backoff
+	var err error
+	attempts := 0
+	for attempts < p.config.MaxAttempts {
+		attempts++
+		if err = p.send(ctx, event); err == nil {
+			return nil
+		}
+		if attempts == p.config.MaxAttempts || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	event.Error = err.Error()
+	event.Attempts = attempts
+	event.FailedAt = time.Now()
+
+	// 요청이 취소되었더라도 이벤트는 보관해야 하므로 취소를 분리합니다
+	if saveErr := p.store.Save(context.WithoutCancel(ctx), event); saveErr != nil {
+		logger.Error(ctx, "failed to store cdc event in dead letter queue",
+			zap.String("event_type", event.EventType),
+			zap.String("document_id", event.DocumentID),
+			zap.String("collection", event.Collection),
+			zap.Error(saveErr),
+		)
+		return fmt.Errorf("failed to publish event: %w (dead letter store: %v)", err, saveErr)
+	}
+
+	logger.Warn(ctx, "cdc event moved to dead letter queue",
+		zap.String("dead_letter_id", event.ID),
+		zap.String("event_type", event.EventType),
+		zap.String("document_id", event.DocumentID),
+		zap.String("collection", event.Collection),
+		zap.Int("attempts", attempts),
+		zap.Error(err),
+	)
+	return fmt.Errorf("%w: %v", ErrDeadLettered, err)
+}
+
+// send는 이벤트 타입에 맞는 내부 발행 메서드를 호출합니다
+func (p *DeadLetterPublisher) send(ctx context.Context, event *entity.DeadLetterEvent) error {
+	switch event.EventType {
+	case EventDocumentCreated:
+		return p.next.PublishDocumentCreated(ctx, event.DocumentID, event.Collection, event.Data, event.Version)
+	case EventDocumentUpdated:
+		return p.next.PublishDocumentUpdated(ctx, event.DocumentID, event.Collection, event.Data, event.Version, event.PreviousVersion, event.Changes)
+	case EventDocumentDeleted:
+		return p.next.PublishDocumentDeleted(ctx, event.DocumentID, event.Collection, event.Version)
+	default:
+		return fmt.Errorf("unsupported event type %q", event.EventType)
+	}
+}
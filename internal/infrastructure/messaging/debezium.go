@@ -0,0 +1,156 @@
+package messaging
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EventFormatDebezium은 Debezium 변경 이벤트 봉투(before/after/op/source/ts_ms)로 보냅니다
+// 스키마 없는 JSON이므로 싱크 커넥터는 JsonConverter(schemas.enable=false)로 읽어야 합니다
+const EventFormatDebezium = "debezium"
+
+// Debezium op 값
+const (
+	DebeziumOpCreate = "c"
+	DebeziumOpUpdate = "u"
+	DebeziumOpDelete = "d"
+)
+
+const (
+	// DebeziumConnector는 source.connector 값입니다
+	DebeziumConnector = "database-service"
+
+	// DefaultDebeziumServerName은 source.name(논리 서버 이름)의 기본값입니다
+	DefaultDebeziumServerName = "database-service"
+)
+
+// DebeziumEnvelope는 Debezium 변경 이벤트 값(payload)입니다
+// after/before에는 문서 데이터와 _id가 들어가며, 업데이트 이전 상태는 알 수 없으므로 before는 삭제 이벤트에만 채웁니다
+type DebeziumEnvelope struct {
+	Before      map[string]interface{} `json:"before"`
+	After       map[string]interface{} `json:"after"`
+	Source      DebeziumSource         `json:"source"`
+	Op          string                 `json:"op"`
+	TsMs        int64                  `json:"ts_ms"`
+	Transaction interface{}            `json:"transaction"`
+}
+
+// DebeziumSource는 변경 출처 메타데이터입니다
+// Debezium 공통 필드 뒤에 native 이벤트를 복원하는 데 필요한 필드를 덧붙입니다
+type DebeziumSource struct {
+	Version         string `json:"version"`
+	Connector       string `json:"connector"`
+	Name            string `json:"name"`
+	TsMs            int64  `json:"ts_ms"`
+	Snapshot        string `json:"snapshot"`
+	Collection      string `json:"collection"`
+	EventID         string `json:"event_id"`
+	DocumentVersion int    `json:"document_version"`
+	PreviousVersion int    `json:"previous_version,omitempty"`
+	Origin          string `json:"origin,omitempty"`
+}
+
+// encodeDebezium은 이벤트를 Debezium 봉투로 직렬화합니다
+func encodeDebezium(serverName string, meta *DocumentEvent, event interface{}) ([]byte, error) {
+	if serverName == "" {
+		serverName = DefaultDebeziumServerName
+	}
+
+	envelope := DebeziumEnvelope{
+		Source: DebeziumSource{
+			Version:         "1",
+			Connector:       DebeziumConnector,
+			Name:            serverName,
+			TsMs:            meta.Timestamp.UnixMilli(),
+			Snapshot:        "false",
+			Collection:      meta.Collection,
+			EventID:         meta.EventID,
+			DocumentVersion: meta.Version,
+			Origin:          meta.Metadata[MetadataOrigin],
+		},
+		TsMs: time.Now().UnixMilli(),
+	}
+
+	switch e := event.(type) {
+	case DocumentCreatedEvent:
+		envelope.Op = DebeziumOpCreate
+		envelope.After = debeziumRow(meta.DocumentID, meta.Data)
+	case DocumentUpdatedEvent:
+		envelope.Op = DebeziumOpUpdate
+		envelope.After = debeziumRow(meta.DocumentID, meta.Data)
+		envelope.Source.PreviousVersion = e.PreviousVersion
+	case DocumentDeletedEvent:
+		envelope.Op = DebeziumOpDelete
+		envelope.Before = debeziumRow(meta.DocumentID, nil)
+		envelope.Source.TsMs = e.DeletedAt.UnixMilli()
+	default:
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal debezium envelope: %w", err)
+	}
+	return payload, nil
+}
+
+// debeziumRow는 문서 데이터에 _id를 더한 행을 만듭니다 (원본 맵은 수정하지 않음)
+func debeziumRow(docID string, data map[string]interface{}) map[string]interface{} {
+	row := make(map[string]interface{}, len(data)+1)
+	for k, v := range data {
+		row[k] = v
+	}
+	row["_id"] = docID
+	return row
+}
+
+// unwrapDebezium은 Debezium 봉투를 native 이벤트 JSON으로 변환합니다
+func unwrapDebezium(envelope *DebeziumEnvelope) ([]byte, error) {
+	timestamp := time.UnixMilli(envelope.Source.TsMs).UTC()
+	native := map[string]interface{}{
+		"event_id":   envelope.Source.EventID,
+		"timestamp":  timestamp,
+		"collection": envelope.Source.Collection,
+		"version":    envelope.Source.DocumentVersion,
+	}
+	if envelope.Source.Origin != "" {
+		native["metadata"] = map[string]string{MetadataOrigin: envelope.Source.Origin}
+	}
+
+	row := envelope.After
+	switch envelope.Op {
+	case DebeziumOpCreate, "r":
+		native["event_type"] = EventDocumentCreated
+	case DebeziumOpUpdate:
+		native["event_type"] = EventDocumentUpdated
+		native["previous_version"] = envelope.Source.PreviousVersion
+	case DebeziumOpDelete:
+		native["event_type"] = EventDocumentDeleted
+		native["deleted_at"] = timestamp
+		row = envelope.Before
+	default:
+		return nil, fmt.Errorf("unsupported debezium op %q", envelope.Op)
+	}
+
+	if row != nil {
+		if id, ok := row["_id"].(string); ok {
+			native["document_id"] = id
+		}
+		if envelope.Op != DebeziumOpDelete {
+			data := make(map[string]interface{}, len(row))
+			for k, v := range row {
+				if k != "_id" {
+					data[k] = v
+				}
+			}
+			native["data"] = data
+		}
+	}
+
+	data, err := json.Marshal(native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,87 @@
+package dedupe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultKeyPrefix는 Redis 중복 제거 키의 기본 접두사입니다
+	DefaultKeyPrefix = "cdc:dedupe"
+
+	statusProcessing = "processing"
+	statusDone       = "done"
+)
+
+// releaseScript는 처리 중 상태인 키만 삭제합니다 (완료 기록은 지우지 않음)
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisStore는 Redis 키(<prefix>:<consumer>:<event_id>)로 처리 기록을 관리하는 중복 제거 저장소입니다 (messaging.DedupeStore 구현)
+// 선점은 SET NX PX로 원자적으로 수행하고, 완료 기록은 보관 기간을 TTL로 두어 자동 정리됩니다
+type RedisStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisStore는 새로운 Redis 중복 제거 저장소를 생성합니다 (연결은 호출자가 소유)
+func NewRedisStore(client redis.UniversalClient, prefix string) *RedisStore {
+	if prefix == "" {
+		prefix = DefaultKeyPrefix
+	}
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// Reserve는 이벤트를 ttl 동안 선점합니다
+func (s *RedisStore) Reserve(ctx context.Context, consumer, eventID string, ttl time.Duration) (messaging.DedupeStatus, error) {
+	key := s.key(consumer, eventID)
+	acquired, err := s.client.SetNX(ctx, key, statusProcessing, ttl).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve event: %w", err)
+	}
+	if acquired {
+		return messaging.DedupeAcquired, nil
+	}
+
+	status, err := s.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		// 조회 사이에 선점이 만료/해제되었으므로 다시 시도합니다
+		return s.Reserve(ctx, consumer, eventID, ttl)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read event status: %w", err)
+	}
+	if status == statusDone {
+		return messaging.DedupeDuplicate, nil
+	}
+	return messaging.DedupeInProgress, nil
+}
+
+// Complete는 이벤트를 처리 완료로 기록합니다
+func (s *RedisStore) Complete(ctx context.Context, consumer, eventID string, retention time.Duration) error {
+	if err := s.client.Set(ctx, s.key(consumer, eventID), statusDone, retention).Err(); err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+	return nil
+}
+
+// Release는 처리 중 선점을 해제합니다
+func (s *RedisStore) Release(ctx context.Context, consumer, eventID string) error {
+	if err := releaseScript.Run(ctx, s.client, []string{s.key(consumer, eventID)}, statusProcessing).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("failed to release event: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStore) key(consumer, eventID string) string {
+	return fmt.Sprintf("%s:%s:%s", s.prefix, consumer, eventID)
+}
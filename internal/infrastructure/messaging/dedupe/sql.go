@@ -0,0 +1,160 @@
+package dedupe
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+)
+
+// SQL 방언
+const (
+	DialectPostgres = "postgresql"
+	DialectMySQL    = "mysql"
+)
+
+// DefaultTable은 SQL 중복 제거 테이블의 기본 이름입니다
+const DefaultTable = "cdc_processed_events"
+
+var tableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLStore는 (consumer, event_id) 기본 키 테이블로 처리 기록을 관리하는 중복 제거 저장소입니다 (messaging.DedupeStore 구현)
+// 만료된 행은 Purge로 정리합니다
+type SQLStore struct {
+	db      *sql.DB
+	dialect string
+	table   string
+}
+
+// NewSQLStore는 새로운 SQL 중복 제거 저장소를 생성합니다 (연결은 호출자가 소유)
+func NewSQLStore(db *sql.DB, dialect, table string) (*SQLStore, error) {
+	if dialect != DialectPostgres && dialect != DialectMySQL {
+		return nil, fmt.Errorf("unsupported dedupe sql dialect %q", dialect)
+	}
+	if table == "" {
+		table = DefaultTable
+	}
+	if !tableNamePattern.MatchString(table) {
+		return nil, fmt.Errorf("invalid dedupe table name %q", table)
+	}
+	return &SQLStore{db: db, dialect: dialect, table: table}, nil
+}
+
+// EnsureTable은 중복 제거 테이블을 생성합니다
+func (s *SQLStore) EnsureTable(ctx context.Context) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			consumer VARCHAR(255) NOT NULL,
+			event_id VARCHAR(255) NOT NULL,
+			status VARCHAR(16) NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (consumer, event_id)
+		)`, s.table)
+	if _, err := s.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create dedupe table: %w", err)
+	}
+	return nil
+}
+
+// Reserve는 이벤트를 ttl 동안 선점합니다 (만료된 선점/완료 기록은 다시 선점)
+func (s *SQLStore) Reserve(ctx context.Context, consumer, eventID string, ttl time.Duration) (messaging.DedupeStatus, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(ttl)
+
+	var query string
+	var args []interface{}
+	if s.dialect == DialectPostgres {
+		query = fmt.Sprintf(`
+			INSERT INTO %[1]s (consumer, event_id, status, expires_at) VALUES ($1, $2, '%[2]s', $3)
+			ON CONFLICT (consumer, event_id) DO UPDATE SET status = '%[2]s', expires_at = $3
+			WHERE %[1]s.expires_at < $4`, s.table, statusProcessing)
+		args = []interface{}{consumer, eventID, expiresAt, now}
+	} else {
+		// status를 먼저 갱신해야 비교에 이전 expires_at이 쓰입니다 (영향 행 수: 삽입 1, 갱신 2, 변경 없음 0)
+		query = fmt.Sprintf(`
+			INSERT INTO %[1]s (consumer, event_id, status, expires_at) VALUES (?, ?, '%[2]s', ?)
+			ON DUPLICATE KEY UPDATE
+				status = IF(expires_at < ?, '%[2]s', status),
+				expires_at = IF(expires_at < ?, ?, expires_at)`, s.table, statusProcessing)
+		args = []interface{}{consumer, eventID, expiresAt, now, now, expiresAt}
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve event: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve event: %w", err)
+	}
+	if affected > 0 {
+		return messaging.DedupeAcquired, nil
+	}
+
+	var status string
+	err = s.db.QueryRowContext(ctx, s.bind(fmt.Sprintf(
+		"SELECT status FROM %s WHERE consumer = ? AND event_id = ?", s.table)), consumer, eventID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		// 조회 사이에 선점이 해제되었으므로 다시 시도합니다
+		return s.Reserve(ctx, consumer, eventID, ttl)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read event status: %w", err)
+	}
+	if status == statusDone {
+		return messaging.DedupeDuplicate, nil
+	}
+	return messaging.DedupeInProgress, nil
+}
+
+// Complete는 이벤트를 처리 완료로 기록합니다
+func (s *SQLStore) Complete(ctx context.Context, consumer, eventID string, retention time.Duration) error {
+	query := s.bind(fmt.Sprintf(
+		"UPDATE %s SET status = '%s', expires_at = ? WHERE consumer = ? AND event_id = ?", s.table, statusDone))
+	if _, err := s.db.ExecContext(ctx, query, time.Now().UTC().Add(retention), consumer, eventID); err != nil {
+		return fmt.Errorf("failed to mark event as processed: %w", err)
+	}
+	return nil
+}
+
+// Release는 처리 중 선점을 해제합니다
+func (s *SQLStore) Release(ctx context.Context, consumer, eventID string) error {
+	query := s.bind(fmt.Sprintf(
+		"DELETE FROM %s WHERE consumer = ? AND event_id = ? AND status = '%s'", s.table, statusProcessing))
+	if _, err := s.db.ExecContext(ctx, query, consumer, eventID); err != nil {
+		return fmt.Errorf("failed to release event: %w", err)
+	}
+	return nil
+}
+
+// Purge는 만료된 기록을 삭제하고 삭제한 행 수를 반환합니다
+func (s *SQLStore) Purge(ctx context.Context) (int64, error) {
+	query := s.bind(fmt.Sprintf("DELETE FROM %s WHERE expires_at < ?", s.table))
+	result, err := s.db.ExecContext(ctx, query, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge dedupe records: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// bind는 ? 자리표시자를 방언에 맞게 바꿉니다 (PostgreSQL은 $1, $2, ...)
+func (s *SQLStore) bind(query string) string {
+	if s.dialect != DialectPostgres {
+		return query
+	}
+	out := make([]byte, 0, len(query)+8)
+	n := 0
+	for i := 0; i < len(query); i++ {
+		if query[i] == '?' {
+			n++
+			out = append(out, fmt.Sprintf("$%d", n)...)
+			continue
+		}
+		out = append(out, query[i])
+	}
+	return string(out)
+}
@@ -0,0 +1,74 @@
+package messaging
+
+import (
+	"context"
+	"time"
+)
+
+// 이벤트 타입
+const (
+	EventDocumentCreated = "document.created"
+	EventDocumentUpdated = "document.updated"
+	EventDocumentDeleted = "document.deleted"
+)
+
+// MetadataOrigin은 이벤트를 발행한 인스턴스 ID를 담는 메타데이터 키입니다
+// 캐시 무효화 컨슈머가 자신이 발행한 이벤트를 건너뛰는 데 사용합니다
+const MetadataOrigin = "origin"
+
+// DocumentEvent는 문서 이벤트 기본 구조입니다
+type DocumentEvent struct {
+	EventID    string                 `json:"event_id"`
+	EventType  string                 `json:"event_type"`
+	Timestamp  time.Time              `json:"timestamp"`
+	DocumentID string                 `json:"document_id"`
+	Collection string                 `json:"collection"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	Version    int                    `json:"version"`
+	Metadata   map[string]string      `json:"metadata,omitempty"`
+}
+
+// DocumentCreatedEvent는 문서 생성 이벤트입니다
+type DocumentCreatedEvent struct {
+	DocumentEvent
+}
+
+// DocumentUpdatedEvent는 문서 업데이트 이벤트입니다
+type DocumentUpdatedEvent struct {
+	DocumentEvent
+	PreviousVersion int                    `json:"previous_version"`
+	Changes         map[string]interface{} `json:"changes,omitempty"`
+}
+
+// DocumentDeletedEvent는 문서 삭제 이벤트입니다
+type DocumentDeletedEvent struct {
+	DocumentEvent
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// CDCPublisher는 문서 변경(CDC) 이벤트 발행자입니다
+// 구현: Kafka(kafka.CDCPublisher), NATS JetStream(nats.JetStreamPublisher)
+type CDCPublisher interface {
+	// PublishDocumentCreated는 문서 생성 이벤트를 발행합니다
+	PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error
+
+	// PublishDocumentUpdated는 문서 업데이트 이벤트를 발행합니다
+	PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error
+
+	// PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다
+	PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error
+
+	// SetOrigin은 발행하는 이벤트의 메타데이터에 인스턴스 ID를 기록하도록 설정합니다
+	SetOrigin(origin string)
+
+	// SetEncoder는 메시지 형식(native, CloudEvents)을 설정합니다
+	SetEncoder(encoder EventEncoder)
+}
+
+// OriginMetadata는 인스턴스 ID를 담은 이벤트 메타데이터를 생성합니다 (origin이 비어 있으면 nil)
+func OriginMetadata(origin string) map[string]string {
+	if origin == "" {
+		return nil
+	}
+	return map[string]string{MetadataOrigin: origin}
+}
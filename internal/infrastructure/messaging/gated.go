@@ -0,0 +1,48 @@
+package messaging
+
+import "context"
+
+// GatedPublisher는 gate가 false인 요청의 이벤트를 발행하지 않고 버리는 CDCPublisher 래퍼입니다 (기능 플래그용)
+type GatedPublisher struct {
+	next CDCPublisher
+	gate func(ctx context.Context) bool
+}
+
+// NewGatedPublisher는 새로운 게이트 발행자를 생성합니다
+func NewGatedPublisher(next CDCPublisher, gate func(ctx context.Context) bool) *GatedPublisher {
+	return &GatedPublisher{next: next, gate: gate}
+}
+
+// SetOrigin은 내부 발행자에 인스턴스 ID를 설정합니다
+func (p *GatedPublisher) SetOrigin(origin string) {
+	p.next.SetOrigin(origin)
+}
+
+// SetEncoder는 내부 발행자에 메시지 형식을 설정합니다
+func (p *GatedPublisher) SetEncoder(encoder EventEncoder) {
+	p.next.SetEncoder(encoder)
+}
+
+// PublishDocumentCreated는 게이트가 열려 있으면 문서 생성 이벤트를 발행합니다
+func (p *GatedPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	if !p.gate(ctx) {
+		return nil
+	}
+	return p.next.PublishDocumentCreated(ctx, docID, collection, data, version)
+}
+
+// PublishDocumentUpdated는 게이트가 열려 있으면 문서 업데이트 이벤트를 발행합니다
+func (p *GatedPublisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	if !p.gate(ctx) {
+		return nil
+	}
+	return p.next.PublishDocumentUpdated(ctx, docID, collection, data, version, previousVersion, changes)
+}
+
+// PublishDocumentDeleted는 게이트가 열려 있으면 문서 삭제 이벤트를 발행합니다
+func (p *GatedPublisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	if !p.gate(ctx) {
+		return nil
+	}
+	return p.next.PublishDocumentDeleted(ctx, docID, collection, version)
+}
@@ -0,0 +1,137 @@
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// DedupeStatus는 이벤트 선점 결과입니다
+type DedupeStatus int
+
+const (
+	// DedupeAcquired는 처리 권한을 얻었음을 뜻합니다
+	DedupeAcquired DedupeStatus = iota
+
+	// DedupeDuplicate는 이미 처리가 끝난 이벤트임을 뜻합니다
+	DedupeDuplicate
+
+	// DedupeInProgress는 다른 컨슈머가 처리 중인 이벤트임을 뜻합니다 (선점이 만료되면 다시 얻을 수 있음)
+	DedupeInProgress
+)
+
+// DedupeStore는 컨슈머별로 처리한 이벤트 ID를 기록하는 저장소입니다
+// 구현: Redis(dedupe.RedisStore), SQL(dedupe.SQLStore)
+type DedupeStore interface {
+	// Reserve는 처리를 시작하기 전에 이벤트를 ttl 동안 선점합니다
+	Reserve(ctx context.Context, consumer, eventID string, ttl time.Duration) (DedupeStatus, error)
+
+	// Complete는 이벤트를 처리 완료로 기록하고 retention 동안 보관합니다
+	Complete(ctx context.Context, consumer, eventID string, retention time.Duration) error
+
+	// Release는 처리에 실패한 이벤트의 선점을 해제해 다시 처리할 수 있게 합니다
+	Release(ctx context.Context, consumer, eventID string) error
+}
+
+// IdempotencyConfig는 멱등 컨슈머 설정입니다
+type IdempotencyConfig struct {
+	// Consumer는 처리 기록을 구분하는 이름입니다 (보통 컨슈머 그룹 ID)
+	Consumer string
+
+	// ProcessingTimeout은 선점 유효 시간입니다 (처리 도중 종료되면 이 시간 뒤에 다시 처리, 기본 5분)
+	ProcessingTimeout time.Duration
+
+	// Retention은 처리 완료 기록 보관 기간입니다 (이 기간 안의 재전달만 걸러짐, 기본 7일)
+	Retention time.Duration
+}
+
+const (
+	defaultProcessingTimeout = 5 * time.Minute
+	defaultDedupeRetention   = 7 * 24 * time.Hour
+	inProgressInitialBackoff = 100 * time.Millisecond
+	inProgressMaxBackoff     = 5 * time.Second
+)
+
+// IdempotentConsumer는 이벤트 ID로 중복 전달을 걸러 at-least-once CDC 스트림을 사실상 한 번 처리되게 합니다
+// 처리 전에 이벤트를 선점하고, 성공하면 완료로 기록하며, 실패하면 선점을 풀어 재전달 시 다시 처리합니다
+// 처리 도중 프로세스가 죽으면 선점이 만료된 뒤 다시 처리되므로, 처리 함수는 여전히 재실행에 안전해야 합니다
+type IdempotentConsumer struct {
+	store  DedupeStore
+	config IdempotencyConfig
+}
+
+// NewIdempotentConsumer는 새로운 멱등 컨슈머를 생성합니다
+func NewIdempotentConsumer(store DedupeStore, config IdempotencyConfig) *IdempotentConsumer {
+	if config.ProcessingTimeout <= 0 {
+		config.ProcessingTimeout = defaultProcessingTimeout
+	}
+	if config.Retention <= 0 {
+		config.Retention = defaultDedupeRetention
+	}
+	return &IdempotentConsumer{store: store, config: config}
+}
+
+// Process는 처음 보는 이벤트만 fn으로 처리합니다 (중복이면 false, nil)
+// 다른 컨슈머가 처리 중이면 끝나거나 선점이 만료될 때까지 기다립니다
+// 저장소 장애 시에는 이벤트를 잃지 않도록 중복 검사 없이 처리합니다
+func (c *IdempotentConsumer) Process(ctx context.Context, eventID string, fn func(ctx context.Context) error) (bool, error) {
+	if eventID == "" {
+		return true, fn(ctx)
+	}
+
+	backoff := inProgressInitialBackoff
+	for {
+		status, err := c.store.Reserve(ctx, c.config.Consumer, eventID, c.config.ProcessingTimeout)
+		if err != nil {
+			logger.Warn(ctx, "dedupe store unavailable, processing event without deduplication",
+				zap.String("consumer", c.config.Consumer),
+				zap.String("event_id", eventID),
+				zap.Error(err),
+			)
+			return true, fn(ctx)
+		}
+
+		switch status {
+		case DedupeDuplicate:
+			logger.Debug(ctx, "skipping duplicate event",
+				zap.String("consumer", c.config.Consumer),
+				zap.String("event_id", eventID),
+			)
+			return false, nil
+
+		case DedupeInProgress:
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > inProgressMaxBackoff {
+				backoff = inProgressMaxBackoff
+			}
+			continue
+		}
+
+		// 취소된 컨텍스트에서도 선점 해제/완료 기록은 남겨야 합니다
+		if err := fn(ctx); err != nil {
+			if releaseErr := c.store.Release(context.WithoutCancel(ctx), c.config.Consumer, eventID); releaseErr != nil {
+				logger.Warn(ctx, "failed to release event reservation",
+					zap.String("event_id", eventID),
+					zap.Error(releaseErr),
+				)
+			}
+			return true, err
+		}
+
+		if err := c.store.Complete(context.WithoutCancel(ctx), c.config.Consumer, eventID, c.config.Retention); err != nil {
+			// 처리는 끝났으므로 에러로 돌려주지 않습니다 (선점이 만료된 뒤 재전달되면 한 번 더 처리될 수 있음)
+			logger.Warn(ctx, "failed to mark event as processed",
+				zap.String("event_id", eventID),
+				zap.Error(err),
+			)
+		}
+		return true, nil
+	}
+}
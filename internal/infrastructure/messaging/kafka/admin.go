@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/sarama"
+)
+
+// TopicAdminConfig는 토픽 관리자 설정입니다
+type TopicAdminConfig struct {
+	Brokers  []string
+	ClientID string
+
+	// Partitions, ReplicationFactor는 새로 만드는 토픽의 파티션 수와 복제 수입니다 (0이면 3, 1)
+	Partitions        int32
+	ReplicationFactor int16
+
+	Security *SecurityConfig
+}
+
+// TopicAdmin은 토픽을 만드는 Kafka 클러스터 관리자입니다
+// 요청마다 연결을 열고 닫으므로 자주 호출하는 용도(프로비저닝 API)에만 사용합니다
+type TopicAdmin struct {
+	mu     sync.Mutex
+	config TopicAdminConfig
+}
+
+// NewTopicAdmin은 새로운 TopicAdmin을 생성합니다
+func NewTopicAdmin(cfg TopicAdminConfig) (*TopicAdmin, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("at least one kafka broker is required")
+	}
+	if cfg.Partitions <= 0 {
+		cfg.Partitions = 3
+	}
+	if cfg.ReplicationFactor <= 0 {
+		cfg.ReplicationFactor = 1
+	}
+	return &TopicAdmin{config: cfg}, nil
+}
+
+// UpdateCredentials는 이후 연결에 사용할 SASL 자격증명을 교체합니다 (Vault 자격증명 갱신)
+func (a *TopicAdmin) UpdateCredentials(username, password string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.config.Security = a.config.Security.WithCredentials(username, password)
+}
+
+// EnsureTopics는 없는 토픽을 만들고 새로 만든 토픽을 반환합니다 (이미 있는 토픽은 그대로 둠)
+func (a *TopicAdmin) EnsureTopics(ctx context.Context, topics []string) ([]string, error) {
+	a.mu.Lock()
+	cfg := a.config
+	a.mu.Unlock()
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V3_6_0_0
+	config.ClientID = cfg.ClientID
+	if err := cfg.Security.apply(config); err != nil {
+		return nil, err
+	}
+
+	admin, err := sarama.NewClusterAdmin(cfg.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect kafka cluster admin: %w", err)
+	}
+	defer admin.Close()
+
+	var created []string
+	for _, topic := range topics {
+		if err := ctx.Err(); err != nil {
+			return created, err
+		}
+		err := admin.CreateTopic(topic, &sarama.TopicDetail{
+			NumPartitions:     cfg.Partitions,
+			ReplicationFactor: cfg.ReplicationFactor,
+		}, false)
+		var topicErr *sarama.TopicError
+		if errors.As(err, &topicErr) && topicErr.Err == sarama.ErrTopicAlreadyExists {
+			continue
+		}
+		if err != nil {
+			return created, fmt.Errorf("failed to create topic %s: %w", topic, err)
+		}
+		created = append(created, topic)
+	}
+	return created, nil
+}
@@ -0,0 +1,185 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/schemaregistry"
+	"github.com/YouSangSon/database-service/internal/pkg/avro"
+)
+
+// CDCEventSchema는 CDC 이벤트의 Avro 스키마입니다
+// 세 이벤트 타입이 하나의 레코드를 공유하며, 타입별 필드는 nullable입니다
+// 문서 데이터는 스키마가 컬렉션마다 다르므로 JSON 문자열로 담습니다
+//
+// 스키마를 진화시킬 때는 기본값이 있는 필드만 추가해야 레지스트리의 BACKWARD 호환성 검사를 통과합니다
+const CDCEventSchema = `{
+  "type": "record",
+  "name": "DocumentEvent",
+  "namespace": "com.dbservice.cdc",
+  "fields": [
+    {"name": "event_id", "type": "string"},
+    {"name": "event_type", "type": "string"},
+    {"name": "timestamp", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "document_id", "type": "string"},
+    {"name": "collection", "type": "string"},
+    {"name": "data", "type": ["null", "string"], "default": null},
+    {"name": "version", "type": "int"},
+    {"name": "metadata", "type": {"type": "map", "values": "string"}, "default": {}},
+    {"name": "previous_version", "type": ["null", "int"], "default": null},
+    {"name": "changes", "type": ["null", "string"], "default": null},
+    {"name": "deleted_at", "type": ["null", {"type": "long", "logicalType": "timestamp-millis"}], "default": null}
+  ]
+}`
+
+// AvroContentType은 Avro 메시지의 content-type 헤더 값입니다
+const AvroContentType = "application/vnd.confluent.avro"
+
+// AvroSerializer는 CDC 이벤트를 Schema Registry에 등록된 Avro 스키마로 직렬화합니다 (Confluent 와이어 포맷)
+// subject는 TopicNameStrategy(<topic>-value)를 따릅니다
+type AvroSerializer struct {
+	registry *schemaregistry.Client
+	schema   *avro.Schema
+	topics   map[string]bool
+}
+
+// NewAvroSerializer는 새로운 Avro 직렬화기를 생성합니다
+// topics가 비어 있으면 모든 CDC 토픽에 적용합니다
+func NewAvroSerializer(registry *schemaregistry.Client, topics []string) (*AvroSerializer, error) {
+	schema, err := avro.Parse(CDCEventSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cdc event schema: %w", err)
+	}
+
+	s := &AvroSerializer{registry: registry, schema: schema}
+	if len(topics) > 0 {
+		s.topics = make(map[string]bool, len(topics))
+		for _, topic := range topics {
+			s.topics[topic] = true
+		}
+	}
+	return s, nil
+}
+
+// Applies는 토픽에 Avro 직렬화를 적용하는지 확인합니다
+func (s *AvroSerializer) Applies(topic string) bool {
+	return s != nil && (s.topics == nil || s.topics[topic])
+}
+
+// Serialize는 이벤트를 Avro로 인코딩합니다 (스키마는 처음 한 번 subject에 등록됩니다)
+func (s *AvroSerializer) Serialize(ctx context.Context, topic string, meta *DocumentEvent, event interface{}) ([]byte, error) {
+	schemaID, err := s.registry.Register(ctx, topic+"-value", CDCEventSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := toAvroRecord(meta, event)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := s.schema.Encode(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode avro event: %w", err)
+	}
+	return schemaregistry.Frame(schemaID, payload), nil
+}
+
+// Deserialize는 Avro 메시지를 작성자 스키마로 디코딩해 native 이벤트 JSON으로 변환합니다
+// 작성자 스키마에 없는 필드는 생략되므로 이전/이후 버전 스키마로 쓴 메시지도 읽을 수 있습니다
+func (s *AvroSerializer) Deserialize(ctx context.Context, message []byte) ([]byte, error) {
+	schemaID, payload, err := schemaregistry.Unframe(message)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := s.registry.SchemaByID(ctx, schemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := writer.Decode(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode avro event: %w", err)
+	}
+	record, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("avro event is not a record")
+	}
+
+	native := make(map[string]interface{}, len(record))
+	for name, value := range record {
+		if value == nil {
+			continue
+		}
+		switch name {
+		case "timestamp", "deleted_at":
+			if millis, ok := value.(int64); ok {
+				native[name] = time.UnixMilli(millis).UTC()
+			}
+		case "data", "changes":
+			if str, ok := value.(string); ok {
+				native[name] = json.RawMessage(str)
+			}
+		default:
+			native[name] = value
+		}
+	}
+
+	data, err := json.Marshal(native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal decoded event: %w", err)
+	}
+	return data, nil
+}
+
+// toAvroRecord는 이벤트를 CDCEventSchema 레코드 값으로 변환합니다
+func toAvroRecord(meta *DocumentEvent, event interface{}) (map[string]interface{}, error) {
+	data, err := optionalJSON(meta.Data)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]interface{}, len(meta.Metadata))
+	for k, v := range meta.Metadata {
+		metadata[k] = v
+	}
+
+	record := map[string]interface{}{
+		"event_id":    meta.EventID,
+		"event_type":  meta.EventType,
+		"timestamp":   meta.Timestamp.UnixMilli(),
+		"document_id": meta.DocumentID,
+		"collection":  meta.Collection,
+		"data":        data,
+		"version":     int32(meta.Version),
+		"metadata":    metadata,
+	}
+
+	switch e := event.(type) {
+	case DocumentUpdatedEvent:
+		changes, err := optionalJSON(e.Changes)
+		if err != nil {
+			return nil, err
+		}
+		record["previous_version"] = int32(e.PreviousVersion)
+		record["changes"] = changes
+	case DocumentDeletedEvent:
+		record["deleted_at"] = e.DeletedAt.UnixMilli()
+	case DocumentCreatedEvent:
+	default:
+		return nil, fmt.Errorf("unsupported event type %T", event)
+	}
+	return record, nil
+}
+
+// optionalJSON은 값이 있으면 JSON 문자열로, 없으면 nil(Avro null)로 변환합니다
+func optionalJSON(value map[string]interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event field: %w", err)
+	}
+	return string(data), nil
+}
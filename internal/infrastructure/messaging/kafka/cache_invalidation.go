@@ -0,0 +1,45 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// CacheInvalidateFunc는 문서 캐시를 무효화하는 함수입니다
+type CacheInvalidateFunc func(ctx context.Context, collection, id string)
+
+// NewCacheInvalidationConsumer는 CDC 이벤트로 다른 인스턴스가 변경한 문서의 캐시를 무효화하는 컨슈머를 생성합니다
+// 모든 인스턴스가 모든 이벤트를 받아야 하므로 cfg.GroupID는 인스턴스마다 달라야 하며,
+// cfg.Topics는 생성/수정/삭제 토픽 순서여야 합니다
+// origin과 같은 인스턴스가 발행한 이벤트는 이미 캐시에 반영되었으므로 건너뜁니다
+//
+// 이벤트에는 생성 시각이 없고 순서가 뒤바뀐 이벤트로 오래된 값이 캐시될 수 있어,
+// 캐시를 새 값으로 갱신하지 않고 무효화만 합니다 (다음 조회에서 DB 값으로 다시 채워짐)
+func NewCacheInvalidationConsumer(cfg *ConsumerConfig, origin string, invalidate CacheInvalidateFunc) (*CDCConsumer, error) {
+	handle := func(ctx context.Context, event *DocumentEvent) error {
+		if origin != "" && event.Metadata[MetadataOrigin] == origin {
+			return nil
+		}
+		invalidate(ctx, event.Collection, event.DocumentID)
+		logger.Debug(ctx, "cache invalidated by cdc event",
+			zap.String("event_type", event.EventType),
+			zap.String("collection", event.Collection),
+			zap.String("document_id", event.DocumentID),
+		)
+		return nil
+	}
+
+	return NewCDCConsumer(cfg, &CDCHandlers{
+		OnDocumentCreated: func(ctx context.Context, event *DocumentCreatedEvent) error {
+			return handle(ctx, &event.DocumentEvent)
+		},
+		OnDocumentUpdated: func(ctx context.Context, event *DocumentUpdatedEvent) error {
+			return handle(ctx, &event.DocumentEvent)
+		},
+		OnDocumentDeleted: func(ctx context.Context, event *DocumentDeletedEvent) error {
+			return handle(ctx, &event.DocumentEvent)
+		},
+	})
+}
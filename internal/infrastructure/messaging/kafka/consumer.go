@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/schemaregistry"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -29,6 +32,8 @@ type ConsumerConfig struct {
 	InitialOffset string // "oldest" or "newest"
 	SessionTimeout time.Duration
 	HeartbeatInterval time.Duration
+	Security      *SecurityConfig
+	Avro          *AvroSerializer // 설정되면 Confluent 와이어 포맷(Avro) 메시지를 디코딩합니다
 }
 
 // MessageHandler는 메시지 핸들러 함수 타입입니다
@@ -36,6 +41,29 @@ type MessageHandler func(ctx context.Context, msg *sarama.ConsumerMessage) error
 
 // NewConsumer는 새로운 Kafka 컨슈머를 생성합니다
 func NewConsumer(cfg *ConsumerConfig) (*Consumer, error) {
+	consumerGroup, err := newConsumerGroup(cfg, cfg.Security)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer := &Consumer{
+		consumer: consumerGroup,
+		config:   cfg,
+		handlers: make(map[string]MessageHandler),
+		ready:    make(chan bool),
+	}
+
+	logger.Info(context.Background(), "kafka consumer initialized",
+		zap.Strings("brokers", cfg.Brokers),
+		zap.String("group_id", cfg.GroupID),
+		zap.Strings("topics", cfg.Topics),
+	)
+
+	return consumer, nil
+}
+
+// newConsumerGroup은 보안 설정으로 sarama 컨슈머 그룹을 생성합니다
+func newConsumerGroup(cfg *ConsumerConfig, security *SecurityConfig) (sarama.ConsumerGroup, error) {
 	config := sarama.NewConfig()
 	config.Version = sarama.V3_6_0_0
 	config.Consumer.Group.Rebalance.Strategy = sarama.NewBalanceStrategyRoundRobin()
@@ -61,25 +89,57 @@ func NewConsumer(cfg *ConsumerConfig) (*Consumer, error) {
 		config.Consumer.Group.Heartbeat.Interval = 3 * time.Second
 	}
 
+	// SASL/TLS
+	if err := security.apply(config); err != nil {
+		return nil, fmt.Errorf("invalid kafka security config: %w", err)
+	}
+
 	consumerGroup, err := sarama.NewConsumerGroup(cfg.Brokers, cfg.GroupID, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create consumer group: %w", err)
 	}
+	return consumerGroup, nil
+}
 
-	consumer := &Consumer{
-		consumer: consumerGroup,
-		config:   cfg,
-		handlers: make(map[string]MessageHandler),
-		ready:    make(chan bool),
+// group은 현재 컨슈머 그룹을 반환합니다
+func (c *Consumer) group() sarama.ConsumerGroup {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.consumer
+}
+
+// UpdateCredentials는 새 SASL 자격증명으로 컨슈머 그룹을 다시 생성합니다
+// 기존 그룹을 닫으면 진행 중인 세션이 커밋 후 종료되고 Start 루프가 새 그룹으로 다시 참여합니다
+func (c *Consumer) UpdateCredentials(ctx context.Context, username, password string) error {
+	security := c.config.Security.WithCredentials(username, password)
+	consumerGroup, err := newConsumerGroup(c.config, security)
+	if err != nil {
+		return fmt.Errorf("failed to reconnect kafka consumer: %w", err)
 	}
 
-	logger.Info(context.Background(), "kafka consumer initialized",
-		zap.Strings("brokers", cfg.Brokers),
-		zap.String("group_id", cfg.GroupID),
-		zap.Strings("topics", cfg.Topics),
+	c.mu.Lock()
+	old := c.consumer
+	c.consumer = consumerGroup
+	c.config.Security = security
+	c.mu.Unlock()
+
+	go logConsumerErrors(ctx, consumerGroup)
+	if err := old.Close(); err != nil {
+		logger.Warn(ctx, "failed to close previous consumer group", zap.Error(err))
+	}
+
+	logger.Info(ctx, "kafka consumer credentials rotated",
+		zap.String("group_id", c.config.GroupID),
+		zap.String("username", username),
 	)
+	return nil
+}
 
-	return consumer, nil
+// logConsumerErrors는 컨슈머 그룹이 닫힐 때까지 에러를 기록합니다
+func logConsumerErrors(ctx context.Context, group sarama.ConsumerGroup) {
+	for err := range group.Errors() {
+		logger.Error(ctx, "consumer error", zap.Error(err))
+	}
 }
 
 // RegisterHandler는 특정 토픽에 대한 메시지 핸들러를 등록합니다
@@ -95,11 +155,7 @@ func (c *Consumer) RegisterHandler(topic string, handler MessageHandler) {
 // Start는 컨슈머를 시작합니다
 func (c *Consumer) Start(ctx context.Context) error {
 	// Handle errors
-	go func() {
-		for err := range c.consumer.Errors() {
-			logger.Error(ctx, "consumer error", zap.Error(err))
-		}
-	}()
+	go logConsumerErrors(ctx, c.group())
 
 	// Start consuming
 	wg := &sync.WaitGroup{}
@@ -114,7 +170,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 				ready:    c.ready,
 			}
 
-			if err := c.consumer.Consume(ctx, c.config.Topics, handler); err != nil {
+			if err := c.group().Consume(ctx, c.config.Topics, handler); err != nil {
 				logger.Error(ctx, "error from consumer", zap.Error(err))
 			}
 
@@ -138,7 +194,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 
 	wg.Wait()
 
-	if err := c.consumer.Close(); err != nil {
+	if err := c.group().Close(); err != nil {
 		logger.Error(ctx, "error closing consumer", zap.Error(err))
 		return err
 	}
@@ -149,7 +205,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 
 // Close는 컨슈머를 종료합니다
 func (c *Consumer) Close() error {
-	return c.consumer.Close()
+	return c.group().Close()
 }
 
 // consumerGroupHandler는 sarama.ConsumerGroupHandler를 구현합니다
@@ -188,6 +244,12 @@ func (h *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession,
 				zap.String("key", string(message.Key)),
 			)
 
+			// 툼스톤(null 값)은 로그 컴팩션용이므로 처리하지 않습니다
+			if message.Value == nil {
+				session.MarkMessage(message, "")
+				continue
+			}
+
 			// Get handler for this topic
 			h.consumer.mu.RLock()
 			handler, exists := h.consumer.handlers[message.Topic]
@@ -203,6 +265,10 @@ func (h *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession,
 
 			// Process message
 			if err := handler(ctx, message); err != nil {
+				// 세션 종료(리밸런스, 종료)로 중단된 메시지는 커밋하지 않아 다음 세션에서 다시 처리됩니다
+				if ctx.Err() != nil {
+					return nil
+				}
 				logger.Error(ctx, "error processing message",
 					zap.String("topic", message.Topic),
 					zap.Int64("offset", message.Offset),
@@ -264,10 +330,55 @@ func NewCDCConsumer(cfg *ConsumerConfig, handlers *CDCHandlers) (*CDCConsumer, e
 	return cdcConsumer, nil
 }
 
+// unmarshalEvent는 native, CloudEvents, Avro 또는 protobuf 형식 메시지에서 이벤트를 읽습니다
+func (c *CDCConsumer) unmarshalEvent(ctx context.Context, msg *sarama.ConsumerMessage, event interface{}) error {
+	return decodeEvent(ctx, c.consumer.config.Avro, msg, event)
+}
+
+// decodeEvent는 메시지 형식을 판별해 이벤트를 읽습니다 (avro가 nil이면 Avro 메시지는 에러)
+// protobuf는 내용만으로 구분할 수 없으므로 content-type 헤더로 판단합니다
+func decodeEvent(ctx context.Context, avro *AvroSerializer, msg *sarama.ConsumerMessage, event interface{}) error {
+	value := msg.Value
+	if strings.HasPrefix(headerValue(msg, "content-type"), "application/x-protobuf") {
+		data, err := messaging.DecodeProtoEvent(value)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, event)
+	}
+
+	if schemaregistry.IsFramed(value) {
+		if avro == nil {
+			return fmt.Errorf("received avro message but avro deserialization is not configured")
+		}
+		data, err := avro.Deserialize(ctx, value)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, event)
+	}
+
+	data, err := messaging.UnwrapEvent(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, event)
+}
+
+// headerValue는 메시지 헤더 값을 반환합니다 (없으면 빈 문자열)
+func headerValue(msg *sarama.ConsumerMessage, key string) string {
+	for _, header := range msg.Headers {
+		if header != nil && strings.EqualFold(string(header.Key), key) {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
 // handleCreatedEvent handles document.created events
 func (c *CDCConsumer) handleCreatedEvent(ctx context.Context, msg *sarama.ConsumerMessage) error {
 	var event DocumentCreatedEvent
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
+	if err := c.unmarshalEvent(ctx, msg, &event); err != nil {
 		return fmt.Errorf("failed to unmarshal created event: %w", err)
 	}
 
@@ -287,7 +398,7 @@ func (c *CDCConsumer) handleCreatedEvent(ctx context.Context, msg *sarama.Consum
 // handleUpdatedEvent handles document.updated events
 func (c *CDCConsumer) handleUpdatedEvent(ctx context.Context, msg *sarama.ConsumerMessage) error {
 	var event DocumentUpdatedEvent
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
+	if err := c.unmarshalEvent(ctx, msg, &event); err != nil {
 		return fmt.Errorf("failed to unmarshal updated event: %w", err)
 	}
 
@@ -308,7 +419,7 @@ func (c *CDCConsumer) handleUpdatedEvent(ctx context.Context, msg *sarama.Consum
 // handleDeletedEvent handles document.deleted events
 func (c *CDCConsumer) handleDeletedEvent(ctx context.Context, msg *sarama.ConsumerMessage) error {
 	var event DocumentDeletedEvent
-	if err := json.Unmarshal(msg.Value, &event); err != nil {
+	if err := c.unmarshalEvent(ctx, msg, &event); err != nil {
 		return fmt.Errorf("failed to unmarshal deleted event: %w", err)
 	}
 
@@ -334,3 +445,8 @@ func (c *CDCConsumer) Start(ctx context.Context) error {
 func (c *CDCConsumer) Close() error {
 	return c.consumer.Close()
 }
+
+// UpdateCredentials rotates the SASL credentials of the CDC consumer
+func (c *CDCConsumer) UpdateCredentials(ctx context.Context, username, password string) error {
+	return c.consumer.UpdateCredentials(ctx, username, password)
+}
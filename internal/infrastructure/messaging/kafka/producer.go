@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -16,6 +19,7 @@ type Producer struct {
 	producer sarama.SyncProducer
 	async    sarama.AsyncProducer
 	config   *ProducerConfig
+	mu       sync.RWMutex // 자격증명 교체 중 전송을 막습니다
 }
 
 // ProducerConfig는 프로듀서 설정입니다
@@ -24,56 +28,163 @@ type ProducerConfig struct {
 	ClientID         string
 	MaxMessageBytes  int
 	RequiredAcks     sarama.RequiredAcks
+	Timeout          time.Duration // 브로커 응답 대기 시간 (0이면 sarama 기본값 10s)
 	Compression      sarama.CompressionCodec
+	CompressionLevel int // 0이면 코덱 기본 레벨
 	MaxRetries       int
 	RetryBackoff     time.Duration
 	EnableIdempotent bool
 	UseAsync         bool
+	Security         *SecurityConfig
+
+	// Linger는 배치를 채우기 위해 메시지를 모으는 최대 시간입니다 (0이면 즉시 전송)
+	Linger time.Duration
+	// BatchBytes는 이 크기만큼 모이면 Linger 전이라도 전송합니다 (0이면 제한 없음)
+	BatchBytes int
+	// BatchMessages는 이 개수만큼 모이면 Linger 전이라도 전송합니다 (0이면 제한 없음)
+	BatchMessages int
+
+	// ErrorHandler는 전송에 최종 실패한 메시지마다 호출됩니다 (nil 가능)
+	// 비동기 모드에서는 호출자에게 에러를 돌려줄 수 없으므로 메트릭이나 재처리는 여기서 합니다
+	ErrorHandler func(msg *sarama.ProducerMessage, err error)
+}
+
+// ParseCompression은 압축 코덱 이름(none, gzip, snappy, lz4, zstd)을 sarama 코덱으로 변환합니다
+// 비어 있으면 none입니다
+func ParseCompression(name string) (sarama.CompressionCodec, error) {
+	codec := sarama.CompressionNone
+	if name == "" {
+		return codec, nil
+	}
+	if err := codec.UnmarshalText([]byte(strings.ToLower(name))); err != nil {
+		return codec, fmt.Errorf("unsupported kafka compression %q", name)
+	}
+	return codec, nil
 }
 
 // NewProducer는 새로운 Kafka 프로듀서를 생성합니다
 func NewProducer(cfg *ProducerConfig) (*Producer, error) {
+	p := &Producer{
+		config: cfg,
+	}
+
+	if err := p.connect(cfg.Security); err != nil {
+		return nil, err
+	}
+
+	logger.Info(context.Background(), "kafka producer initialized",
+		logger.Field("brokers", cfg.Brokers),
+		logger.Field("client_id", cfg.ClientID),
+		logger.Field("async", cfg.UseAsync),
+		logger.Field("compression", cfg.Compression.String()),
+		logger.Field("linger", cfg.Linger),
+	)
+
+	return p, nil
+}
+
+// newSaramaConfig는 프로듀서 설정으로 sarama 설정을 생성합니다
+func (cfg *ProducerConfig) newSaramaConfig(security *SecurityConfig) (*sarama.Config, error) {
 	config := sarama.NewConfig()
 	config.ClientID = cfg.ClientID
 	config.Producer.RequiredAcks = cfg.RequiredAcks
 	config.Producer.Compression = cfg.Compression
-	config.Producer.MaxMessageBytes = cfg.MaxMessageBytes
+	if cfg.CompressionLevel != 0 {
+		config.Producer.CompressionLevel = cfg.CompressionLevel
+	}
+	if cfg.MaxMessageBytes > 0 {
+		config.Producer.MaxMessageBytes = cfg.MaxMessageBytes
+	}
+	if cfg.Timeout > 0 {
+		config.Producer.Timeout = cfg.Timeout
+	}
 	config.Producer.Retry.Max = cfg.MaxRetries
 	config.Producer.Retry.Backoff = cfg.RetryBackoff
+	config.Producer.Flush.Frequency = cfg.Linger
+	config.Producer.Flush.Bytes = cfg.BatchBytes
+	config.Producer.Flush.Messages = cfg.BatchMessages
 	config.Producer.Idempotent = cfg.EnableIdempotent
-	config.Producer.Return.Successes = true
+	if cfg.EnableIdempotent {
+		// 멱등 프로듀서는 순서 보장을 위해 브로커당 진행 중인 요청을 하나로 제한해야 합니다
+		config.Net.MaxOpenRequests = 1
+	}
+	// 동기 프로듀서는 성공 응답으로 전송 완료를 확인하며, 비동기 프로듀서는 실패만 처리합니다
+	config.Producer.Return.Successes = !cfg.UseAsync
 	config.Producer.Return.Errors = true
 
 	// 버전 설정
 	config.Version = sarama.V3_6_0_0
 
-	p := &Producer{
-		config: cfg,
+	// SASL/TLS 설정
+	if err := security.apply(config); err != nil {
+		return nil, fmt.Errorf("invalid kafka security config: %w", err)
+	}
+
+	return config, nil
+}
+
+// connect는 보안 설정으로 sarama 프로듀서를 생성하고 기존 프로듀서와 교체합니다
+func (p *Producer) connect(security *SecurityConfig) error {
+	config, err := p.config.newSaramaConfig(security)
+	if err != nil {
+		return err
 	}
 
-	var err error
-	if cfg.UseAsync {
-		p.async, err = sarama.NewAsyncProducer(cfg.Brokers, config)
+	var (
+		syncProducer  sarama.SyncProducer
+		asyncProducer sarama.AsyncProducer
+	)
+	if p.config.UseAsync {
+		asyncProducer, err = sarama.NewAsyncProducer(p.config.Brokers, config)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create async producer: %w", err)
+			return fmt.Errorf("failed to create async producer: %w", err)
 		}
 
 		// 에러 및 성공 메시지 처리
-		go p.handleAsyncResults()
+		go handleAsyncResults(asyncProducer, p.config.ErrorHandler)
 	} else {
-		p.producer, err = sarama.NewSyncProducer(cfg.Brokers, config)
+		syncProducer, err = sarama.NewSyncProducer(p.config.Brokers, config)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create sync producer: %w", err)
+			return fmt.Errorf("failed to create sync producer: %w", err)
 		}
 	}
 
-	logger.Info(context.Background(), "kafka producer initialized",
-		logger.Field("brokers", cfg.Brokers),
-		logger.Field("client_id", cfg.ClientID),
-		logger.Field("async", cfg.UseAsync),
-	)
+	p.mu.Lock()
+	oldSync, oldAsync := p.producer, p.async
+	p.producer, p.async = syncProducer, asyncProducer
+	p.mu.Unlock()
 
-	return p, nil
+	// 이전 프로듀서는 남은 메시지를 보낸 뒤 종료됩니다
+	if oldSync != nil {
+		if err := oldSync.Close(); err != nil {
+			logger.Warn(context.Background(), "failed to close previous kafka producer", zap.Error(err))
+		}
+	}
+	if oldAsync != nil {
+		if err := oldAsync.Close(); err != nil {
+			logger.Warn(context.Background(), "failed to close previous kafka producer", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// UpdateCredentials는 새 SASL 자격증명으로 브로커에 다시 연결합니다
+// Vault 자격증명이 갱신될 때 호출되며, 새 연결이 성공한 경우에만 기존 연결을 교체합니다
+func (p *Producer) UpdateCredentials(username, password string) error {
+	security := p.config.Security.WithCredentials(username, password)
+	if err := p.connect(security); err != nil {
+		return fmt.Errorf("failed to reconnect kafka producer: %w", err)
+	}
+
+	p.mu.Lock()
+	p.config.Security = security
+	p.mu.Unlock()
+
+	logger.Info(context.Background(), "kafka producer credentials rotated",
+		zap.String("username", username),
+	)
+	return nil
 }
 
 // PublishEvent는 이벤트를 발행합니다
@@ -88,22 +199,84 @@ func (p *Producer) PublishEvent(ctx context.Context, topic string, key string, e
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
+	return p.PublishRaw(ctx, topic, key, eventJSON, "")
+}
+
+// PublishRaw는 이미 직렬화된 메시지를 발행합니다
+// contentType이 있으면 content-type 헤더로 보냅니다 (CloudEvents Kafka 바인딩 구조화 모드)
+func (p *Producer) PublishRaw(ctx context.Context, topic, key string, value []byte, contentType string) error {
+	headers := []sarama.RecordHeader{
+		{
+			Key:   []byte("event_time"),
+			Value: []byte(time.Now().Format(time.RFC3339)),
+		},
+	}
+	if contentType != "" {
+		headers = append(headers, sarama.RecordHeader{Key: []byte("content-type"), Value: []byte(contentType)})
+	}
+
 	msg := &sarama.ProducerMessage{
 		Topic: topic,
 		Key:   sarama.StringEncoder(key),
-		Value: sarama.ByteEncoder(eventJSON),
 		Timestamp: time.Now(),
-		Headers: []sarama.RecordHeader{
-			{
-				Key:   []byte("event_time"),
-				Value: []byte(time.Now().Format(time.RFC3339)),
-			},
-		},
+		Headers: headers,
+	}
+	if value != nil {
+		// value가 nil이면 툼스톤(null 값) 메시지입니다
+		msg.Value = sarama.ByteEncoder(value)
+	}
+
+	return p.send(ctx, msg, key)
+}
+
+// Republish는 읽어 온 메시지를 키, 값, 헤더를 유지한 채 topic으로 다시 발행합니다
+// extra 헤더는 같은 키의 기존 헤더를 대체합니다
+func (p *Producer) Republish(ctx context.Context, topic string, source *sarama.ConsumerMessage, extra ...sarama.RecordHeader) error {
+	headers := make([]sarama.RecordHeader, 0, len(source.Headers)+len(extra))
+	for _, header := range source.Headers {
+		if header == nil || hasHeader(extra, header.Key) {
+			continue
+		}
+		headers = append(headers, *header)
+	}
+	headers = append(headers, extra...)
+
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Key:       sarama.ByteEncoder(source.Key),
+		Timestamp: time.Now(),
+		Headers:   headers,
+	}
+	if source.Value != nil {
+		msg.Value = sarama.ByteEncoder(source.Value)
+	}
+
+	return p.send(ctx, msg, string(source.Key))
+}
+
+func hasHeader(headers []sarama.RecordHeader, key []byte) bool {
+	for _, header := range headers {
+		if string(header.Key) == string(key) {
+			return true
+		}
 	}
+	return false
+}
+
+// send는 동기 또는 비동기 프로듀서로 메시지를 전송합니다
+func (p *Producer) send(ctx context.Context, msg *sarama.ProducerMessage, key string) error {
+	topic := msg.Topic
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 
 	if p.config.UseAsync {
-		// 비동기 전송
-		p.async.Input() <- msg
+		// 비동기 전송 (전송 버퍼가 가득 차면 ctx가 끝날 때까지 기다립니다)
+		select {
+		case p.async.Input() <- msg:
+		case <-ctx.Done():
+			return fmt.Errorf("failed to enqueue event: %w", ctx.Err())
+		}
 		logger.Debug(ctx, "event sent asynchronously",
 			logger.Field("topic", topic),
 			logger.Field("key", key),
@@ -119,6 +292,9 @@ func (p *Producer) PublishEvent(ctx context.Context, topic string, key string, e
 			logger.Field("key", key),
 			zap.Error(err),
 		)
+		if p.config.ErrorHandler != nil {
+			p.config.ErrorHandler(msg, err)
+		}
 		return fmt.Errorf("failed to send event: %w", err)
 	}
 
@@ -133,27 +309,43 @@ func (p *Producer) PublishEvent(ctx context.Context, topic string, key string, e
 }
 
 // handleAsyncResults는 비동기 프로듀서의 결과를 처리합니다
-func (p *Producer) handleAsyncResults() {
-	for {
+// 실패한 메시지는 onError로 전달하며, 프로듀서가 종료되어 두 채널이 모두 닫히면 반환합니다
+func handleAsyncResults(async sarama.AsyncProducer, onError func(msg *sarama.ProducerMessage, err error)) {
+	successes, errs := async.Successes(), async.Errors()
+	for successes != nil || errs != nil {
 		select {
-		case success := <-p.async.Successes():
+		case success, ok := <-successes:
+			if !ok {
+				successes = nil
+				continue
+			}
 			logger.Debug(context.Background(), "async event published",
 				logger.Field("topic", success.Topic),
 				logger.Field("partition", success.Partition),
 				logger.Field("offset", success.Offset),
 			)
 
-		case err := <-p.async.Errors():
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
 			logger.Error(context.Background(), "async publish failed",
 				logger.Field("topic", err.Msg.Topic),
 				zap.Error(err.Err),
 			)
+			if onError != nil {
+				onError(err.Msg, err.Err)
+			}
 		}
 	}
 }
 
 // Close는 프로듀서를 종료합니다
 func (p *Producer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	if p.producer != nil {
 		return p.producer.Close()
 	}
@@ -163,71 +355,82 @@ func (p *Producer) Close() error {
 	return nil
 }
 
-// Event Types
+// Event Types (messaging 패키지의 공용 이벤트 타입 별칭)
 
 // DocumentEvent는 문서 이벤트 기본 구조입니다
-type DocumentEvent struct {
-	EventID     string                 `json:"event_id"`
-	EventType   string                 `json:"event_type"`
-	Timestamp   time.Time              `json:"timestamp"`
-	DocumentID  string                 `json:"document_id"`
-	Collection  string                 `json:"collection"`
-	Data        map[string]interface{} `json:"data,omitempty"`
-	Version     int                    `json:"version"`
-	Metadata    map[string]string      `json:"metadata,omitempty"`
-}
+type DocumentEvent = messaging.DocumentEvent
 
 // DocumentCreatedEvent는 문서 생성 이벤트입니다
-type DocumentCreatedEvent struct {
-	DocumentEvent
-}
+type DocumentCreatedEvent = messaging.DocumentCreatedEvent
 
 // DocumentUpdatedEvent는 문서 업데이트 이벤트입니다
-type DocumentUpdatedEvent struct {
-	DocumentEvent
-	PreviousVersion int                    `json:"previous_version"`
-	Changes         map[string]interface{} `json:"changes,omitempty"`
-}
+type DocumentUpdatedEvent = messaging.DocumentUpdatedEvent
 
 // DocumentDeletedEvent는 문서 삭제 이벤트입니다
-type DocumentDeletedEvent struct {
-	DocumentEvent
-	DeletedAt time.Time `json:"deleted_at"`
-}
+type DocumentDeletedEvent = messaging.DocumentDeletedEvent
+
+// MetadataOrigin은 이벤트를 발행한 인스턴스 ID를 담는 메타데이터 키입니다
+const MetadataOrigin = messaging.MetadataOrigin
 
-// CDCPublisher는 Change Data Capture 이벤트를 발행합니다
+// CDCPublisher는 Change Data Capture 이벤트를 Kafka 토픽으로 발행합니다 (messaging.CDCPublisher 구현)
 type CDCPublisher struct {
-	producer     *Producer
-	topicCreated string
-	topicUpdated string
-	topicDeleted string
+	producer *Producer
+	router   *TopicRouter
+	origin   string
+	encoder  messaging.EventEncoder
+	avro     *AvroSerializer
 }
 
-// NewCDCPublisher는 새로운 CDC 발행자를 생성합니다
+// NewCDCPublisher는 새로운 CDC 발행자를 생성합니다 (모든 컬렉션이 이벤트 타입별 토픽 하나씩을 공유)
 func NewCDCPublisher(producer *Producer, topicCreated, topicUpdated, topicDeleted string) *CDCPublisher {
+	router, _ := NewTopicRouter(nil, topicCreated, topicUpdated, topicDeleted)
 	return &CDCPublisher{
-		producer:     producer,
-		topicCreated: topicCreated,
-		topicUpdated: topicUpdated,
-		topicDeleted: topicDeleted,
+		producer: producer,
+		router:   router,
 	}
 }
 
+// SetOrigin은 발행하는 이벤트의 메타데이터에 인스턴스 ID를 기록하도록 설정합니다
+func (c *CDCPublisher) SetOrigin(origin string) {
+	c.origin = origin
+}
+
+// SetEncoder는 메시지 형식(native, CloudEvents)을 설정합니다
+func (c *CDCPublisher) SetEncoder(encoder messaging.EventEncoder) {
+	c.encoder = encoder
+}
+
+// SetRouter는 컬렉션별 토픽 라우팅 규칙을 설정합니다
+func (c *CDCPublisher) SetRouter(router *TopicRouter) {
+	c.router = router
+}
+
+// SetAvro는 Avro 직렬화기를 설정합니다 (적용 대상 토픽은 encoder 대신 Avro로 발행)
+func (c *CDCPublisher) SetAvro(serializer *AvroSerializer) {
+	c.avro = serializer
+}
+
+// metadata는 이벤트 메타데이터를 생성합니다
+func (c *CDCPublisher) metadata() map[string]string {
+	return messaging.OriginMetadata(c.origin)
+}
+
 // PublishDocumentCreated는 문서 생성 이벤트를 발행합니다
 func (c *CDCPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
 	event := DocumentCreatedEvent{
 		DocumentEvent: DocumentEvent{
 			EventID:    fmt.Sprintf("%s-%d", docID, time.Now().UnixNano()),
-			EventType:  "document.created",
+			EventType:  messaging.EventDocumentCreated,
 			Timestamp:  time.Now(),
 			DocumentID: docID,
 			Collection: collection,
 			Data:       data,
 			Version:    version,
+			Metadata:   c.metadata(),
 		},
 	}
 
-	return c.producer.PublishEvent(ctx, c.topicCreated, docID, event)
+	return c.publish(ctx, &event.DocumentEvent, event)
 }
 
 // PublishDocumentUpdated는 문서 업데이트 이벤트를 발행합니다
@@ -235,18 +438,19 @@ func (c *CDCPublisher) PublishDocumentUpdated(ctx context.Context, docID, collec
 	event := DocumentUpdatedEvent{
 		DocumentEvent: DocumentEvent{
 			EventID:    fmt.Sprintf("%s-%d", docID, time.Now().UnixNano()),
-			EventType:  "document.updated",
+			EventType:  messaging.EventDocumentUpdated,
 			Timestamp:  time.Now(),
 			DocumentID: docID,
 			Collection: collection,
 			Data:       data,
 			Version:    version,
+			Metadata:   c.metadata(),
 		},
 		PreviousVersion: previousVersion,
 		Changes:         changes,
 	}
 
-	return c.producer.PublishEvent(ctx, c.topicUpdated, docID, event)
+	return c.publish(ctx, &event.DocumentEvent, event)
 }
 
 // PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다
@@ -254,14 +458,50 @@ func (c *CDCPublisher) PublishDocumentDeleted(ctx context.Context, docID, collec
 	event := DocumentDeletedEvent{
 		DocumentEvent: DocumentEvent{
 			EventID:    fmt.Sprintf("%s-%d", docID, time.Now().UnixNano()),
-			EventType:  "document.deleted",
+			EventType:  messaging.EventDocumentDeleted,
 			Timestamp:  time.Now(),
 			DocumentID: docID,
 			Collection: collection,
 			Version:    version,
+			Metadata:   c.metadata(),
 		},
 		DeletedAt: time.Now(),
 	}
 
-	return c.producer.PublishEvent(ctx, c.topicDeleted, docID, event)
+	if err := c.publish(ctx, &event.DocumentEvent, event); err != nil {
+		return err
+	}
+
+	// Debezium처럼 삭제 뒤에 툼스톤(null 값)을 보내 로그 컴팩션과 싱크 커넥터의 delete.enabled를 지원합니다
+	topic, ok := c.router.Route(collection, messaging.EventDocumentDeleted)
+	if ok && c.encoder.Format == messaging.EventFormatDebezium && !c.avro.Applies(topic) {
+		return c.producer.PublishRaw(ctx, topic, docID, nil, "")
+	}
+	return nil
+}
+
+// publish는 라우팅 규칙으로 토픽을 정해 이벤트를 설정된 형식으로 직렬화하고 문서 ID를 키로 발행합니다 (같은 문서의 이벤트는 같은 파티션)
+func (c *CDCPublisher) publish(ctx context.Context, meta *DocumentEvent, event interface{}) error {
+	topic, ok := c.router.Route(meta.Collection, meta.EventType)
+	if !ok {
+		logger.Debug(ctx, "cdc event not routed to any topic",
+			zap.String("event_type", meta.EventType),
+			zap.String("collection", meta.Collection),
+		)
+		return nil
+	}
+
+	if c.avro.Applies(topic) {
+		payload, err := c.avro.Serialize(ctx, topic, meta, event)
+		if err != nil {
+			return err
+		}
+		return c.producer.PublishRaw(ctx, topic, meta.DocumentID, payload, AvroContentType)
+	}
+
+	payload, err := c.encoder.Encode(meta, event)
+	if err != nil {
+		return err
+	}
+	return c.producer.PublishRaw(ctx, topic, meta.DocumentID, payload, c.encoder.ContentType())
 }
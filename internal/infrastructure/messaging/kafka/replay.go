@@ -0,0 +1,283 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Replayer는 CDC 토픽에 남아 있는 이벤트를 읽어 다시 발행합니다 (messaging.Replayer 구현)
+// 다운스트림 읽기 모델을 다시 구축할 때 사용하며, 토픽 보존 기간 안의 이벤트만 재생할 수 있습니다
+type Replayer struct {
+	producer *Producer
+	avro     *AvroSerializer
+	topics   []string
+}
+
+// NewReplayer는 새로운 CDC 재생기를 생성합니다
+// topics는 재생할 CDC 토픽(생성/수정/삭제)이며, 브로커 연결과 자격증명은 producer 설정을 따릅니다
+func NewReplayer(producer *Producer, avro *AvroSerializer, topics []string) *Replayer {
+	return &Replayer{
+		producer: producer,
+		avro:     avro,
+		topics:   topics,
+	}
+}
+
+// replayMessage는 재생 대상 메시지와 이벤트 시각입니다
+type replayMessage struct {
+	msg       *sarama.ConsumerMessage
+	event     *DocumentEvent
+	timestamp time.Time
+}
+
+// Replay는 조건에 맞는 이벤트를 시각 순으로 다시 발행합니다
+// 재생 시작 시점의 파티션 끝 오프셋까지만 읽으며, 여러 토픽에 걸친 이벤트도 같은 문서의 순서가 유지되도록 시각 순으로 정렬합니다
+func (r *Replayer) Replay(ctx context.Context, req *messaging.ReplayRequest) (*messaging.ReplayResult, error) {
+	if req.Limit <= 0 {
+		return nil, fmt.Errorf("replay limit must be positive")
+	}
+
+	client, err := r.newClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replay consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	result := &messaging.ReplayResult{}
+	var matched []replayMessage
+	for _, topic := range r.topics {
+		partitions, err := client.Partitions(topic)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list partitions of %s: %w", topic, err)
+		}
+		for _, partition := range partitions {
+			scanned, err := r.scanPartition(ctx, client, consumer, topic, partition, req, func(m replayMessage) error {
+				result.Matched++
+				m.event = nil
+				matched = append(matched, m)
+				// 메모리 사용을 제한하기 위해 가장 이른 Limit개만 유지합니다
+				if len(matched) > 2*req.Limit {
+					matched = earliest(matched, req.Limit)
+				}
+				return nil
+			})
+			result.Scanned += scanned
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result.Truncated = result.Matched > req.Limit
+	matched = earliest(matched, req.Limit)
+	if result.Truncated && len(matched) > 0 {
+		result.Next = matched[len(matched)-1].timestamp
+	}
+	if req.DryRun {
+		return result, nil
+	}
+
+	replayHeader := sarama.RecordHeader{Key: []byte(messaging.ReplayHeader), Value: []byte(req.ReplayID)}
+	for _, m := range matched {
+		target := req.TargetTopic
+		if target == "" {
+			target = m.msg.Topic
+		}
+		if err := r.producer.Republish(ctx, target, m.msg, replayHeader); err != nil {
+			return result, fmt.Errorf("failed to replay event at %s/%d/%d: %w", m.msg.Topic, m.msg.Partition, m.msg.Offset, err)
+		}
+		result.Replayed++
+	}
+
+	logger.Info(ctx, "cdc events replayed",
+		zap.String("replay_id", req.ReplayID),
+		zap.String("collection", req.Collection),
+		zap.Int("scanned", result.Scanned),
+		zap.Int("replayed", result.Replayed),
+		zap.Bool("truncated", result.Truncated),
+	)
+	return result, nil
+}
+
+// ScanEvents는 조건에 맞는 이벤트를 다시 발행하지 않고 fn에 전달합니다 (messaging.EventScanner 구현)
+// 재생과 마찬가지로 시작 시점의 파티션 끝 오프셋까지만 읽으며, 이벤트를 메모리에 모으지 않습니다
+func (r *Replayer) ScanEvents(ctx context.Context, req *messaging.ReplayRequest, fn func(event *messaging.DocumentEvent) error) (*messaging.ReplayResult, error) {
+	client, err := r.newClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replay consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	result := &messaging.ReplayResult{}
+	for _, topic := range r.topics {
+		partitions, err := client.Partitions(topic)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list partitions of %s: %w", topic, err)
+		}
+		for _, partition := range partitions {
+			scanned, err := r.scanPartition(ctx, client, consumer, topic, partition, req, func(m replayMessage) error {
+				result.Matched++
+				if m.event.Timestamp.IsZero() {
+					m.event.Timestamp = m.timestamp
+				}
+				return fn(m.event)
+			})
+			result.Scanned += scanned
+			if err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// scanPartition은 시작 오프셋부터 재생 시작 시점의 끝 오프셋까지 읽어 조건에 맞는 메시지를 emit에 전달합니다
+// emit이 에러를 반환하면 읽기를 중단하고 그 에러를 반환합니다
+func (r *Replayer) scanPartition(ctx context.Context, client sarama.Client, consumer sarama.Consumer, topic string, partition int32, req *messaging.ReplayRequest, emit func(replayMessage) error) (int, error) {
+	newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get newest offset of %s/%d: %w", topic, partition, err)
+	}
+	oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get oldest offset of %s/%d: %w", topic, partition, err)
+	}
+
+	start := req.FromOffset
+	if !req.From.IsZero() {
+		// 해당 시각 이후 첫 메시지의 오프셋 (없으면 -1)
+		start, err = client.GetOffset(topic, partition, req.From.UnixMilli())
+		if err != nil {
+			return 0, fmt.Errorf("failed to find offset for time in %s/%d: %w", topic, partition, err)
+		}
+		if start < 0 {
+			return 0, nil
+		}
+		// 보존 기간으로 앞부분이 삭제된 파티션에서 From 이후 첫 메시지가 가장 오래된 메시지이면,
+		// 그 사이에 있던 이벤트가 삭제되었을 수 있음
+		if req.RequireFrom && oldest > 0 && start <= oldest {
+			return 0, fmt.Errorf("%w: %s/%d starts at offset %d", messaging.ErrLogTruncated, topic, partition, oldest)
+		}
+	}
+	if start < oldest {
+		start = oldest
+	}
+	if start >= newest {
+		return 0, nil
+	}
+
+	pc, err := consumer.ConsumePartition(topic, partition, start)
+	if err != nil {
+		return 0, fmt.Errorf("failed to consume %s/%d: %w", topic, partition, err)
+	}
+	defer pc.Close()
+
+	scanned := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return scanned, ctx.Err()
+		case consumerErr := <-pc.Errors():
+			if consumerErr != nil {
+				return scanned, fmt.Errorf("failed to read %s/%d: %w", topic, partition, consumerErr.Err)
+			}
+		case msg := <-pc.Messages():
+			if msg == nil {
+				return scanned, nil
+			}
+			scanned++
+
+			// 툼스톤은 이벤트가 아니므로 재생하지 않습니다 (원래 삭제 이벤트를 재생하면 다시 발행됨)
+			if msg.Value != nil {
+				event := &DocumentEvent{}
+				if err := decodeEvent(ctx, r.avro, msg, event); err != nil {
+					logger.Warn(ctx, "skipping undecodable cdc event during replay",
+						zap.String("topic", topic),
+						zap.Int32("partition", partition),
+						zap.Int64("offset", msg.Offset),
+						zap.Error(err),
+					)
+				} else if event.Collection == req.Collection {
+					timestamp := event.Timestamp
+					if timestamp.IsZero() {
+						timestamp = msg.Timestamp
+					}
+					if req.To.IsZero() || !timestamp.After(req.To) {
+						if err := emit(replayMessage{msg: msg, event: event, timestamp: timestamp}); err != nil {
+							return scanned, err
+						}
+					}
+				}
+			}
+
+			if msg.Offset >= newest-1 {
+				return scanned, nil
+			}
+		}
+	}
+}
+
+// newClient는 producer의 현재 브로커/보안 설정으로 sarama 클라이언트를 생성합니다
+func (r *Replayer) newClient() (sarama.Client, error) {
+	r.producer.mu.RLock()
+	brokers := r.producer.config.Brokers
+	security := r.producer.config.Security
+	clientID := r.producer.config.ClientID
+	r.producer.mu.RUnlock()
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V3_6_0_0
+	config.Consumer.Return.Errors = true
+	if clientID != "" {
+		config.ClientID = clientID + "-replay"
+	}
+	if err := security.apply(config); err != nil {
+		return nil, fmt.Errorf("invalid kafka security config: %w", err)
+	}
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replay client: %w", err)
+	}
+	return client, nil
+}
+
+// earliest는 메시지를 시각 순(같으면 토픽/파티션/오프셋 순)으로 정렬해 앞의 n개를 반환합니다
+func earliest(messages []replayMessage, n int) []replayMessage {
+	sort.SliceStable(messages, func(i, j int) bool {
+		a, b := messages[i], messages[j]
+		if !a.timestamp.Equal(b.timestamp) {
+			return a.timestamp.Before(b.timestamp)
+		}
+		if a.msg.Topic != b.msg.Topic {
+			return a.msg.Topic < b.msg.Topic
+		}
+		if a.msg.Partition != b.msg.Partition {
+			return a.msg.Partition < b.msg.Partition
+		}
+		return a.msg.Offset < b.msg.Offset
+	})
+	if len(messages) > n {
+		return messages[:n]
+	}
+	return messages
+}
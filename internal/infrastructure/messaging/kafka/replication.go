@@ -0,0 +1,127 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	// replicationInitialBackoff는 복제 적용 실패 후 첫 재시도 대기 시간입니다
+	replicationInitialBackoff = 500 * time.Millisecond
+
+	// replicationMaxBackoff는 복제 적용 재시도 대기 시간의 상한입니다
+	replicationMaxBackoff = 30 * time.Second
+)
+
+// NewReplicationConsumer는 CDC 이벤트를 다른 백엔드/리전의 복제 대상에 적용하는 컨슈머를 생성합니다
+// cfg.Topics는 생성/수정/삭제 토픽 순서여야 합니다
+//
+// 이벤트는 문서 ID를 키로 발행되어 같은 문서의 이벤트는 같은 파티션에서 순서대로 처리되며,
+// 복제 대상은 버전 조건으로 중복 적용을 무시합니다 (재전달, 재시작 후 재처리에 안전)
+// 적용에 실패하면 성공하거나 세션이 끝날 때까지 재시도하여, 복제본이 이벤트를 건너뛰지 않도록 합니다
+// ID 형식이 잘못된 이벤트처럼 재시도로 해결되지 않는 이벤트만 기록 후 건너뜁니다
+// dedupe가 있으면 이미 적용한 이벤트 ID의 재전달을 복제 대상에 접근하기 전에 걸러냅니다
+func NewReplicationConsumer(cfg *ConsumerConfig, replica repository.ReplicaRepository, dedupe *messaging.IdempotentConsumer) (*CDCConsumer, error) {
+	r := &replicator{
+		replica: replica,
+		dedupe:  dedupe,
+		metrics: metrics.GetMetrics(),
+	}
+
+	return NewCDCConsumer(cfg, &CDCHandlers{
+		OnDocumentCreated: func(ctx context.Context, event *DocumentCreatedEvent) error {
+			return r.apply(ctx, &event.DocumentEvent, r.upsert)
+		},
+		OnDocumentUpdated: func(ctx context.Context, event *DocumentUpdatedEvent) error {
+			return r.apply(ctx, &event.DocumentEvent, r.upsert)
+		},
+		OnDocumentDeleted: func(ctx context.Context, event *DocumentDeletedEvent) error {
+			return r.apply(ctx, &event.DocumentEvent, r.delete)
+		},
+	})
+}
+
+// replicator는 CDC 이벤트를 복제 대상에 적용합니다
+type replicator struct {
+	replica repository.ReplicaRepository
+	dedupe  *messaging.IdempotentConsumer
+	metrics *metrics.Metrics
+}
+
+// upsert는 생성/수정 이벤트를 적용합니다
+func (r *replicator) upsert(ctx context.Context, event *DocumentEvent) (bool, error) {
+	return r.replica.ApplyUpsert(ctx, event.Collection, event.DocumentID, event.Data, event.Version, event.Timestamp)
+}
+
+// delete는 삭제 이벤트를 적용합니다
+func (r *replicator) delete(ctx context.Context, event *DocumentEvent) (bool, error) {
+	return r.replica.ApplyDelete(ctx, event.Collection, event.DocumentID)
+}
+
+// apply는 중복이 아닌 이벤트만 적용합니다
+func (r *replicator) apply(ctx context.Context, event *DocumentEvent, fn func(ctx context.Context, event *DocumentEvent) (bool, error)) error {
+	if r.dedupe == nil {
+		return r.applyWithRetry(ctx, event, fn)
+	}
+
+	processed, err := r.dedupe.Process(ctx, event.EventID, func(ctx context.Context) error {
+		return r.applyWithRetry(ctx, event, fn)
+	})
+	if err == nil && !processed {
+		r.metrics.RecordReplicationEvent(event.EventType, "duplicate", time.Since(event.Timestamp))
+	}
+	return err
+}
+
+// applyWithRetry는 이벤트를 적용하고, 일시적인 실패는 지수 백오프로 재시도합니다
+func (r *replicator) applyWithRetry(ctx context.Context, event *DocumentEvent, fn func(ctx context.Context, event *DocumentEvent) (bool, error)) error {
+	backoff := replicationInitialBackoff
+	for {
+		applied, err := fn(ctx, event)
+		if err == nil {
+			status := "applied"
+			if !applied {
+				status = "skipped"
+			}
+			r.metrics.RecordReplicationEvent(event.EventType, status, time.Since(event.Timestamp))
+			return nil
+		}
+
+		r.metrics.RecordReplicationEvent(event.EventType, "error", 0)
+		if errors.Is(err, entity.ErrInvalidData) {
+			logger.Error(ctx, "dropping unreplicable cdc event",
+				zap.String("event_id", event.EventID),
+				zap.String("collection", event.Collection),
+				zap.String("document_id", event.DocumentID),
+				zap.Error(err),
+			)
+			return nil
+		}
+
+		logger.Warn(ctx, "failed to apply cdc event to replica, retrying",
+			zap.String("event_id", event.EventID),
+			zap.String("collection", event.Collection),
+			zap.String("document_id", event.DocumentID),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > replicationMaxBackoff {
+			backoff = replicationMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+)
+
+// TopicRule은 컬렉션별 CDC 토픽 라우팅 규칙입니다
+type TopicRule struct {
+	// Collection은 컬렉션 이름 또는 와일드카드 패턴입니다 (path.Match 문법: *, ?, [...])
+	Collection string
+
+	// Topic은 모든 이벤트 타입에 사용할 토픽입니다 (비어 있으면 기본 토픽)
+	Topic string
+
+	// Created, Updated, Deleted는 이벤트 타입별 토픽입니다 (Topic보다 우선)
+	Created string
+	Updated string
+	Deleted string
+
+	// Disabled이면 일치하는 컬렉션의 이벤트를 발행하지 않습니다
+	Disabled bool
+}
+
+// TopicRouter는 컬렉션과 이벤트 타입으로 CDC 토픽을 결정합니다
+// 규칙은 순서대로 검사해 처음 일치한 규칙을 사용하며, 일치하는 규칙이 없으면 기본 토픽을 사용합니다
+type TopicRouter struct {
+	rules    []TopicRule
+	defaults TopicRule
+}
+
+// NewTopicRouter는 새로운 토픽 라우터를 생성합니다
+// created, updated, deleted는 일치하는 규칙이 없을 때 사용할 기본 토픽입니다
+func NewTopicRouter(rules []TopicRule, created, updated, deleted string) (*TopicRouter, error) {
+	for i, rule := range rules {
+		if rule.Collection == "" {
+			return nil, fmt.Errorf("topic rule %d: collection is required", i)
+		}
+		if _, err := path.Match(rule.Collection, ""); err != nil {
+			return nil, fmt.Errorf("topic rule %d: invalid collection pattern %q: %w", i, rule.Collection, err)
+		}
+	}
+
+	return &TopicRouter{
+		rules:    rules,
+		defaults: TopicRule{Created: created, Updated: updated, Deleted: deleted},
+	}, nil
+}
+
+// Route는 이벤트를 보낼 토픽을 반환합니다 (발행하지 않아야 하면 false)
+func (r *TopicRouter) Route(collection, eventType string) (string, bool) {
+	for _, rule := range r.rules {
+		if matched, _ := path.Match(rule.Collection, collection); !matched {
+			continue
+		}
+		if rule.Disabled {
+			return "", false
+		}
+		if topic := rule.topicFor(eventType); topic != "" {
+			return topic, true
+		}
+		break
+	}
+
+	topic := r.defaults.topicFor(eventType)
+	return topic, topic != ""
+}
+
+// Topics는 규칙과 기본값에 등장하는 모든 토픽을 중복 없이 반환합니다 (재생 등 전체 토픽을 읽을 때 사용)
+func (r *TopicRouter) Topics() []string {
+	seen := make(map[string]bool)
+	var topics []string
+	add := func(rule TopicRule) {
+		for _, topic := range []string{rule.Created, rule.Updated, rule.Deleted, rule.Topic} {
+			if topic != "" && !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+
+	add(r.defaults)
+	for _, rule := range r.rules {
+		if !rule.Disabled {
+			add(rule)
+		}
+	}
+	return topics
+}
+
+// topicFor는 이벤트 타입에 맞는 토픽을 반환합니다 (이벤트 타입별 토픽 → Topic 순)
+func (rule *TopicRule) topicFor(eventType string) string {
+	var topic string
+	switch eventType {
+	case messaging.EventDocumentCreated:
+		topic = rule.Created
+	case messaging.EventDocumentUpdated:
+		topic = rule.Updated
+	case messaging.EventDocumentDeleted:
+		topic = rule.Deleted
+	}
+	if topic == "" {
+		topic = rule.Topic
+	}
+	return topic
+}
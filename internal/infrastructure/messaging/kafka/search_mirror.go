@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SearchMirrorSyncFunc는 문서 하나를 검색 미러에 동기화하는 함수입니다 (미러하지 않는 컬렉션이면 false)
+type SearchMirrorSyncFunc func(ctx context.Context, collection, id string) (bool, error)
+
+// NewSearchMirrorConsumer는 CDC 이벤트가 가리키는 문서를 검색 미러 인덱스에 반영하는 컨슈머를 생성합니다
+// 인스턴스들이 같은 cfg.GroupID로 이벤트를 나눠 처리하며, cfg.Topics는 생성/수정/삭제 토픽 순서여야 합니다
+//
+// sync는 이벤트 내용 대신 주 저장소의 현재 상태를 미러에 쓰므로 이벤트 종류와 관계없이 같은 방식으로 처리하며,
+// 토픽이 달라 순서가 바뀐 이벤트나 재전달된 이벤트도 안전합니다
+// 동기화에 실패하면 성공하거나 세션이 끝날 때까지 재시도하여 미러가 변경을 건너뛰지 않도록 합니다
+func NewSearchMirrorConsumer(cfg *ConsumerConfig, sync SearchMirrorSyncFunc) (*CDCConsumer, error) {
+	handle := func(ctx context.Context, event *DocumentEvent) error {
+		backoff := replicationInitialBackoff
+		for {
+			mirrored, err := sync(ctx, event.Collection, event.DocumentID)
+			if err == nil {
+				if mirrored {
+					logger.Debug(ctx, "cdc event applied to search mirror",
+						zap.String("event_id", event.EventID),
+						zap.String("collection", event.Collection),
+						zap.String("document_id", event.DocumentID),
+						zap.Duration("lag", time.Since(event.Timestamp)),
+					)
+				}
+				return nil
+			}
+			if errors.Is(err, entity.ErrInvalidData) {
+				logger.Error(ctx, "dropping cdc event that cannot be mirrored",
+					zap.String("event_id", event.EventID),
+					zap.String("collection", event.Collection),
+					zap.String("document_id", event.DocumentID),
+					zap.Error(err),
+				)
+				return nil
+			}
+
+			logger.Warn(ctx, "failed to apply cdc event to search mirror, retrying",
+				zap.String("event_id", event.EventID),
+				zap.String("collection", event.Collection),
+				zap.String("document_id", event.DocumentID),
+				zap.Duration("backoff", backoff),
+				zap.Error(err),
+			)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > replicationMaxBackoff {
+				backoff = replicationMaxBackoff
+			}
+		}
+	}
+
+	return NewCDCConsumer(cfg, &CDCHandlers{
+		OnDocumentCreated: func(ctx context.Context, event *DocumentCreatedEvent) error {
+			return handle(ctx, &event.DocumentEvent)
+		},
+		OnDocumentUpdated: func(ctx context.Context, event *DocumentUpdatedEvent) error {
+			return handle(ctx, &event.DocumentEvent)
+		},
+		OnDocumentDeleted: func(ctx context.Context, event *DocumentDeletedEvent) error {
+			return handle(ctx, &event.DocumentEvent)
+		},
+	})
+}
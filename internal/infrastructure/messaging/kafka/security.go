@@ -0,0 +1,179 @@
+package kafka
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// SASL 메커니즘
+const (
+	SASLMechanismPlain       = "PLAIN"
+	SASLMechanismSCRAMSHA256 = "SCRAM-SHA-256"
+	SASLMechanismSCRAMSHA512 = "SCRAM-SHA-512"
+)
+
+// SecurityConfig는 Kafka 브로커 연결 보안 설정입니다
+type SecurityConfig struct {
+	SASL SASLConfig
+	TLS  TLSConfig
+}
+
+// SASLConfig는 SASL 인증 설정입니다
+type SASLConfig struct {
+	Enabled   bool
+	Mechanism string // PLAIN, SCRAM-SHA-256, SCRAM-SHA-512
+	Username  string
+	Password  string
+}
+
+// TLSConfig는 브로커 TLS 설정입니다
+// 파일 경로와 PEM 데이터를 모두 지정하면 PEM 데이터가 우선합니다 (Vault에서 받은 인증서 등)
+type TLSConfig struct {
+	Enabled            bool
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	CAPEM              []byte
+	CertPEM            []byte
+	KeyPEM             []byte
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// WithCredentials는 SASL 사용자 이름/비밀번호만 교체한 복사본을 반환합니다
+func (s *SecurityConfig) WithCredentials(username, password string) *SecurityConfig {
+	out := &SecurityConfig{}
+	if s != nil {
+		*out = *s
+	}
+	out.SASL.Username = username
+	out.SASL.Password = password
+	return out
+}
+
+// apply는 보안 설정을 sarama 설정에 반영합니다
+func (s *SecurityConfig) apply(config *sarama.Config) error {
+	if s == nil {
+		return nil
+	}
+
+	if s.TLS.Enabled {
+		tlsConfig, err := s.TLS.build()
+		if err != nil {
+			return err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+
+	if !s.SASL.Enabled {
+		return nil
+	}
+	if s.SASL.Username == "" || s.SASL.Password == "" {
+		return fmt.Errorf("kafka sasl username and password are required")
+	}
+
+	config.Net.SASL.Enable = true
+	config.Net.SASL.Handshake = true
+	config.Net.SASL.User = s.SASL.Username
+	config.Net.SASL.Password = s.SASL.Password
+
+	switch s.SASL.Mechanism {
+	case "", SASLMechanismPlain:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case SASLMechanismSCRAMSHA256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: sha256.New}
+		}
+	case SASLMechanismSCRAMSHA512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{hashGenerator: sha512.New}
+		}
+	default:
+		return fmt.Errorf("unsupported kafka sasl mechanism: %s", s.SASL.Mechanism)
+	}
+
+	return nil
+}
+
+// build는 crypto/tls 설정을 생성합니다
+func (t *TLSConfig) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	caPEM, err := pemOrFile(t.CAPEM, t.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kafka ca certificate: %w", err)
+	}
+	if len(caPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("failed to parse kafka ca certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM, err := pemOrFile(t.CertPEM, t.CertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kafka client certificate: %w", err)
+	}
+	keyPEM, err := pemOrFile(t.KeyPEM, t.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kafka client key: %w", err)
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// pemOrFile은 PEM 데이터가 있으면 그대로, 없으면 파일에서 읽어 반환합니다
+func pemOrFile(pem []byte, file string) ([]byte, error) {
+	if len(pem) > 0 || file == "" {
+		return pem, nil
+	}
+	return os.ReadFile(file)
+}
+
+// scramClient는 xdg-go/scram 기반 sarama.SCRAMClient 구현입니다
+type scramClient struct {
+	hashGenerator scram.HashGeneratorFcn
+	conversation  *scram.ClientConversation
+}
+
+// Begin은 SCRAM 대화를 시작합니다
+func (c *scramClient) Begin(userName, password, authzID string) error {
+	client, err := c.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conversation = client.NewConversation()
+	return nil
+}
+
+// Step은 서버 챌린지에 응답합니다
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.conversation.Step(challenge)
+}
+
+// Done은 대화 완료 여부를 반환합니다
+func (c *scramClient) Done() bool {
+	return c.conversation.Done()
+}
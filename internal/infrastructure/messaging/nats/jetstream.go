@@ -0,0 +1,634 @@
+package nats
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ErrNoResponders는 발행 주제를 수신하는 JetStream 스트림이 없을 때 반환됩니다
+var ErrNoResponders = errors.New("nats: no responders (no jetstream stream for subject)")
+
+// errConnectionClosed는 응답을 기다리는 중 연결이 끊겼을 때 반환됩니다
+var errConnectionClosed = errors.New("nats: connection closed")
+
+// Config는 NATS JetStream 발행자 설정입니다
+type Config struct {
+	// Servers는 nats://host:port 또는 tls://host:port 목록입니다 (순서대로 연결 시도)
+	Servers []string
+
+	// Username/Password 또는 Token 인증 (선택)
+	Username string
+	Password string
+	Token    string
+
+	// Name은 서버 모니터링에 표시되는 클라이언트 이름입니다
+	Name string
+
+	// Stream은 CDC 주제를 저장하는 JetStream 스트림 이름입니다 (없으면 생성)
+	Stream string
+
+	// SubjectPrefix는 주제 접두사입니다 (<prefix>.<collection>.<created|updated|deleted>)
+	SubjectPrefix string
+
+	// DuplicateWindow는 Nats-Msg-Id 기반 중복 제거 기간입니다 (스트림 생성 시 적용)
+	DuplicateWindow time.Duration
+
+	// Timeout은 연결과 발행 확인(ack) 대기 시간입니다
+	Timeout time.Duration
+
+	// TLS는 tls:// 서버 또는 서버가 TLS를 요구할 때 사용할 설정입니다 (nil이면 기본값)
+	TLS *tls.Config
+}
+
+// DefaultConfig는 기본 설정을 반환합니다
+func DefaultConfig() Config {
+	return Config{
+		Servers:         []string{"nats://localhost:4222"},
+		Stream:          "DOCUMENTS_CDC",
+		SubjectPrefix:   "cdc",
+		DuplicateWindow: 2 * time.Minute,
+		Timeout:         5 * time.Second,
+	}
+}
+
+// JetStreamPublisher는 CDC 이벤트를 NATS JetStream으로 발행합니다 (messaging.CDCPublisher 구현)
+// 이벤트는 컬렉션별 주제(<prefix>.<collection>.<created|updated|deleted>)로 발행되며,
+// 이벤트 ID를 Nats-Msg-Id 헤더로 보내 재시도로 인한 중복 발행을 스트림이 제거합니다
+// 발행은 스트림의 저장 확인(PubAck)을 받은 뒤에 성공으로 처리합니다
+type JetStreamPublisher struct {
+	config  Config
+	origin  string
+	encoder messaging.EventEncoder
+	inbox   string
+	nextID  atomic.Uint64
+
+	mu   sync.Mutex // 연결과 쓰기 보호
+	conn *natsConn
+
+	pendingMu sync.Mutex
+	pending   map[string]chan natsReply
+}
+
+// natsConn은 서버 연결 하나입니다
+type natsConn struct {
+	nc net.Conn
+	bw *bufio.Writer
+}
+
+// natsReply는 요청에 대한 응답 또는 에러입니다
+type natsReply struct {
+	data []byte
+	err  error
+}
+
+// pubAck는 JetStream 발행 확인 응답입니다
+type pubAck struct {
+	Stream    string `json:"stream"`
+	Sequence  uint64 `json:"seq"`
+	Duplicate bool   `json:"duplicate"`
+	Error     *struct {
+		Code        int    `json:"code"`
+		ErrCode     int    `json:"err_code"`
+		Description string `json:"description"`
+	} `json:"error,omitempty"`
+}
+
+// serverInfo는 서버 INFO 메시지 중 필요한 필드입니다
+type serverInfo struct {
+	TLSRequired bool `json:"tls_required"`
+	Headers     bool `json:"headers"`
+	JetStream   bool `json:"jetstream"`
+}
+
+// NewJetStreamPublisher는 새로운 JetStream 발행자를 생성하고 스트림을 준비합니다
+func NewJetStreamPublisher(ctx context.Context, config Config) (*JetStreamPublisher, error) {
+	defaults := DefaultConfig()
+	if len(config.Servers) == 0 {
+		config.Servers = defaults.Servers
+	}
+	if config.Stream == "" {
+		config.Stream = defaults.Stream
+	}
+	if config.SubjectPrefix == "" {
+		config.SubjectPrefix = defaults.SubjectPrefix
+	}
+	if config.DuplicateWindow <= 0 {
+		config.DuplicateWindow = defaults.DuplicateWindow
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaults.Timeout
+	}
+
+	p := &JetStreamPublisher{
+		config:  config,
+		inbox:   "_INBOX." + randomToken(),
+		pending: make(map[string]chan natsReply),
+	}
+
+	if err := p.ensureStream(ctx); err != nil {
+		p.Close()
+		return nil, err
+	}
+
+	logger.Info(ctx, "nats jetstream publisher initialized",
+		zap.Strings("servers", config.Servers),
+		zap.String("stream", config.Stream),
+		zap.String("subject_prefix", config.SubjectPrefix),
+	)
+	return p, nil
+}
+
+// SetOrigin은 발행하는 이벤트의 메타데이터에 인스턴스 ID를 기록하도록 설정합니다
+func (p *JetStreamPublisher) SetOrigin(origin string) {
+	p.origin = origin
+}
+
+// SetEncoder는 메시지 형식(native, CloudEvents)을 설정합니다
+func (p *JetStreamPublisher) SetEncoder(encoder messaging.EventEncoder) {
+	p.encoder = encoder
+}
+
+// PublishDocumentCreated는 문서 생성 이벤트를 발행합니다
+func (p *JetStreamPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	event := messaging.DocumentCreatedEvent{
+		DocumentEvent: p.newEvent(messaging.EventDocumentCreated, docID, collection, data, version),
+	}
+	return p.publish(ctx, p.subject(collection, "created"), &event.DocumentEvent, event)
+}
+
+// PublishDocumentUpdated는 문서 업데이트 이벤트를 발행합니다
+func (p *JetStreamPublisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	event := messaging.DocumentUpdatedEvent{
+		DocumentEvent:   p.newEvent(messaging.EventDocumentUpdated, docID, collection, data, version),
+		PreviousVersion: previousVersion,
+		Changes:         changes,
+	}
+	return p.publish(ctx, p.subject(collection, "updated"), &event.DocumentEvent, event)
+}
+
+// PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다
+func (p *JetStreamPublisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	event := messaging.DocumentDeletedEvent{
+		DocumentEvent: p.newEvent(messaging.EventDocumentDeleted, docID, collection, nil, version),
+		DeletedAt:     time.Now(),
+	}
+	return p.publish(ctx, p.subject(collection, "deleted"), &event.DocumentEvent, event)
+}
+
+// Close는 연결을 종료합니다
+func (p *JetStreamPublisher) Close() error {
+	p.mu.Lock()
+	conn := p.conn
+	p.conn = nil
+	p.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return conn.nc.Close()
+}
+
+// newEvent는 공통 이벤트 필드를 채웁니다
+func (p *JetStreamPublisher) newEvent(eventType, docID, collection string, data map[string]interface{}, version int) messaging.DocumentEvent {
+	now := time.Now()
+	return messaging.DocumentEvent{
+		EventID:    fmt.Sprintf("%s-%d", docID, now.UnixNano()),
+		EventType:  eventType,
+		Timestamp:  now,
+		DocumentID: docID,
+		Collection: collection,
+		Data:       data,
+		Version:    version,
+		Metadata:   messaging.OriginMetadata(p.origin),
+	}
+}
+
+// subject는 컬렉션과 작업의 발행 주제를 생성합니다
+// 주제 토큰에 쓸 수 없는 문자(., 공백, *, >)는 _로 바꿉니다
+func (p *JetStreamPublisher) subject(collection, op string) string {
+	token := strings.Map(func(r rune) rune {
+		switch r {
+		case '.', ' ', '\t', '\r', '\n', '*', '>':
+			return '_'
+		}
+		return r
+	}, collection)
+	if token == "" {
+		token = "_"
+	}
+	return fmt.Sprintf("%s.%s.%s", p.config.SubjectPrefix, token, op)
+}
+
+// publish는 이벤트를 발행하고 JetStream 저장 확인을 기다립니다
+func (p *JetStreamPublisher) publish(ctx context.Context, subject string, meta *messaging.DocumentEvent, event interface{}) error {
+	payload, err := p.encoder.Encode(meta, event)
+	if err != nil {
+		return err
+	}
+
+	msgID := meta.EventID
+	header := "NATS/1.0\r\nNats-Msg-Id: " + msgID + "\r\nContent-Type: " + p.encoder.ContentType() + "\r\n\r\n"
+	reply, err := p.request(ctx, subject, header, payload)
+	if err != nil {
+		return fmt.Errorf("failed to publish event to %s: %w", subject, err)
+	}
+
+	var ack pubAck
+	if err := json.Unmarshal(reply, &ack); err != nil {
+		return fmt.Errorf("invalid jetstream ack: %w", err)
+	}
+	if ack.Error != nil {
+		return fmt.Errorf("jetstream rejected event: %s (code %d)", ack.Error.Description, ack.Error.ErrCode)
+	}
+
+	logger.Debug(ctx, "event published to jetstream",
+		zap.String("subject", subject),
+		zap.String("msg_id", msgID),
+		zap.Uint64("seq", ack.Sequence),
+		zap.Bool("duplicate", ack.Duplicate),
+	)
+	return nil
+}
+
+// ensureStream은 스트림이 없으면 <prefix>.> 주제를 저장하는 스트림을 생성합니다
+func (p *JetStreamPublisher) ensureStream(ctx context.Context) error {
+	reply, err := p.request(ctx, "$JS.API.STREAM.INFO."+p.config.Stream, "", nil)
+	if err != nil {
+		return fmt.Errorf("failed to query jetstream stream: %w", err)
+	}
+
+	var info struct {
+		Error *struct {
+			Code        int    `json:"code"`
+			Description string `json:"description"`
+		} `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(reply, &info); err != nil {
+		return fmt.Errorf("invalid jetstream response: %w", err)
+	}
+	if info.Error == nil {
+		return nil
+	}
+	if info.Error.Code != 404 {
+		return fmt.Errorf("failed to query jetstream stream: %s", info.Error.Description)
+	}
+
+	request, _ := json.Marshal(map[string]interface{}{
+		"name":             p.config.Stream,
+		"subjects":         []string{p.config.SubjectPrefix + ".>"},
+		"storage":          "file",
+		"duplicate_window": p.config.DuplicateWindow.Nanoseconds(),
+	})
+	reply, err = p.request(ctx, "$JS.API.STREAM.CREATE."+p.config.Stream, "", request)
+	if err != nil {
+		return fmt.Errorf("failed to create jetstream stream: %w", err)
+	}
+	var created struct {
+		Error *struct {
+			Description string `json:"description"`
+		} `json:"error,omitempty"`
+	}
+	if err := json.Unmarshal(reply, &created); err != nil {
+		return fmt.Errorf("invalid jetstream response: %w", err)
+	}
+	if created.Error != nil {
+		return fmt.Errorf("failed to create jetstream stream: %s", created.Error.Description)
+	}
+
+	logger.Info(ctx, "jetstream stream created",
+		zap.String("stream", p.config.Stream),
+		zap.String("subjects", p.config.SubjectPrefix+".>"),
+	)
+	return nil
+}
+
+// request는 응답 주제를 붙여 메시지를 보내고 응답을 기다립니다
+func (p *JetStreamPublisher) request(ctx context.Context, subject, header string, payload []byte) ([]byte, error) {
+	replyTo := p.inbox + "." + strconv.FormatUint(p.nextID.Add(1), 10)
+	ch := make(chan natsReply, 1)
+
+	p.pendingMu.Lock()
+	p.pending[replyTo] = ch
+	p.pendingMu.Unlock()
+	defer func() {
+		p.pendingMu.Lock()
+		delete(p.pending, replyTo)
+		p.pendingMu.Unlock()
+	}()
+
+	if err := p.write(ctx, subject, replyTo, header, payload); err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(p.config.Timeout)
+	defer timer.Stop()
+	select {
+	case reply := <-ch:
+		return reply.data, reply.err
+	case <-timer.C:
+		return nil, fmt.Errorf("nats: timeout waiting for response")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// write는 PUB/HPUB 명령을 보냅니다 (연결이 없으면 먼저 연결)
+func (p *JetStreamPublisher) write(ctx context.Context, subject, replyTo, header string, payload []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		conn, err := p.connect(ctx)
+		if err != nil {
+			return err
+		}
+		p.conn = conn
+	}
+
+	conn := p.conn
+	if err := conn.nc.SetWriteDeadline(time.Now().Add(p.config.Timeout)); err != nil {
+		return err
+	}
+
+	var err error
+	if header == "" {
+		_, err = fmt.Fprintf(conn.bw, "PUB %s %s %d\r\n", subject, replyTo, len(payload))
+	} else {
+		_, err = fmt.Fprintf(conn.bw, "HPUB %s %s %d %d\r\n%s", subject, replyTo, len(header), len(header)+len(payload), header)
+	}
+	if err == nil {
+		_, err = conn.bw.Write(payload)
+	}
+	if err == nil {
+		_, err = conn.bw.WriteString("\r\n")
+	}
+	if err == nil {
+		err = conn.bw.Flush()
+	}
+	if err != nil {
+		conn.nc.Close()
+		p.conn = nil
+		return fmt.Errorf("nats: write failed: %w", err)
+	}
+	return nil
+}
+
+// connect는 서버 목록을 순서대로 시도해 연결하고, 응답 수신용 inbox를 구독합니다 (호출자가 p.mu 보유)
+func (p *JetStreamPublisher) connect(ctx context.Context) (*natsConn, error) {
+	var lastErr error
+	for _, server := range p.config.Servers {
+		conn, br, err := p.dial(ctx, server)
+		if err != nil {
+			lastErr = err
+			logger.Warn(ctx, "failed to connect to nats server", zap.String("server", server), zap.Error(err))
+			continue
+		}
+		go p.readLoop(conn, br)
+		return conn, nil
+	}
+	return nil, fmt.Errorf("nats: no server available: %w", lastErr)
+}
+
+// dial은 서버 하나에 연결하고 핸드셰이크(INFO, CONNECT, PING/PONG)를 수행합니다
+func (p *JetStreamPublisher) dial(ctx context.Context, server string) (*natsConn, *bufio.Reader, error) {
+	u, err := url.Parse(server)
+	if err != nil || u.Host == "" {
+		u = &url.URL{Scheme: "nats", Host: server}
+	}
+
+	dialer := net.Dialer{Timeout: p.config.Timeout}
+	nc, err := dialer.DialContext(ctx, "tcp", u.Host)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := nc.SetDeadline(time.Now().Add(p.config.Timeout)); err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+
+	br := bufio.NewReader(nc)
+	line, err := readLine(br)
+	if err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		nc.Close()
+		return nil, nil, fmt.Errorf("nats: unexpected greeting: %s", line)
+	}
+	var info serverInfo
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		nc.Close()
+		return nil, nil, fmt.Errorf("nats: invalid server info: %w", err)
+	}
+	if !info.Headers {
+		nc.Close()
+		return nil, nil, fmt.Errorf("nats: server does not support headers")
+	}
+
+	if info.TLSRequired || u.Scheme == "tls" {
+		tlsConfig := p.config.TLS
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		tlsConfig = tlsConfig.Clone()
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = u.Hostname()
+		}
+		tlsConn := tls.Client(nc, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			nc.Close()
+			return nil, nil, fmt.Errorf("nats: tls handshake failed: %w", err)
+		}
+		nc = tlsConn
+		br = bufio.NewReader(nc)
+	}
+
+	options := map[string]interface{}{
+		"verbose":  false,
+		"pedantic": false,
+		"headers":  true,
+		"lang":     "go",
+		"version":  "database-service",
+		"protocol": 1,
+		"name":     p.config.Name,
+	}
+	if p.config.Token != "" {
+		options["auth_token"] = p.config.Token
+	} else if p.config.Username != "" {
+		options["user"] = p.config.Username
+		options["pass"] = p.config.Password
+	}
+	connect, _ := json.Marshal(options)
+
+	bw := bufio.NewWriter(nc)
+	fmt.Fprintf(bw, "CONNECT %s\r\nPING\r\nSUB %s.* 1\r\n", connect, p.inbox)
+	if err := bw.Flush(); err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+
+	line, err = readLine(br)
+	if err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+	if line != "PONG" {
+		nc.Close()
+		return nil, nil, fmt.Errorf("nats: connect rejected: %s", line)
+	}
+
+	if err := nc.SetDeadline(time.Time{}); err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+	return &natsConn{nc: nc, bw: bw}, br, nil
+}
+
+// readLoop는 연결이 끊길 때까지 서버 메시지를 읽어 응답을 전달합니다
+func (p *JetStreamPublisher) readLoop(conn *natsConn, br *bufio.Reader) {
+	err := p.readMessages(conn, br)
+
+	p.mu.Lock()
+	if p.conn == conn {
+		p.conn = nil
+	}
+	p.mu.Unlock()
+	conn.nc.Close()
+
+	// 응답을 기다리던 요청은 재연결을 기다리지 않고 실패 처리합니다
+	p.pendingMu.Lock()
+	for _, ch := range p.pending {
+		select {
+		case ch <- natsReply{err: errConnectionClosed}:
+		default:
+		}
+	}
+	p.pendingMu.Unlock()
+
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		logger.Warn(context.Background(), "nats connection lost", zap.Error(err))
+	}
+}
+
+// readMessages는 MSG/HMSG/PING/-ERR를 처리합니다
+func (p *JetStreamPublisher) readMessages(conn *natsConn, br *bufio.Reader) error {
+	for {
+		line, err := readLine(br)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PING":
+			p.mu.Lock()
+			_, err = conn.bw.WriteString("PONG\r\n")
+			if err == nil {
+				err = conn.bw.Flush()
+			}
+			p.mu.Unlock()
+			if err != nil {
+				return err
+			}
+		case line == "PONG", line == "+OK", strings.HasPrefix(line, "INFO "):
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		case strings.HasPrefix(line, "MSG "), strings.HasPrefix(line, "HMSG "):
+			if err := p.readMessage(br, line); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("nats: unexpected protocol line: %s", line)
+		}
+	}
+}
+
+// readMessage는 MSG/HMSG 본문을 읽어 대기 중인 요청에 전달합니다
+// MSG <subject> <sid> [reply] <size>, HMSG <subject> <sid> [reply] <header size> <total size>
+func (p *JetStreamPublisher) readMessage(br *bufio.Reader, line string) error {
+	fields := strings.Fields(line)
+	withHeaders := fields[0] == "HMSG"
+
+	minFields := 4
+	if withHeaders {
+		minFields = 5
+	}
+	if len(fields) < minFields {
+		return fmt.Errorf("nats: malformed message line: %s", line)
+	}
+
+	total, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return fmt.Errorf("nats: malformed message size: %s", line)
+	}
+	headerSize := 0
+	if withHeaders {
+		if headerSize, err = strconv.Atoi(fields[len(fields)-2]); err != nil || headerSize > total {
+			return fmt.Errorf("nats: malformed header size: %s", line)
+		}
+	}
+
+	buf := make([]byte, total+2)
+	if _, err := io.ReadFull(br, buf); err != nil {
+		return err
+	}
+
+	reply := natsReply{data: buf[headerSize:total]}
+	if withHeaders && isNoResponders(buf[:headerSize]) {
+		reply = natsReply{err: ErrNoResponders}
+	}
+
+	p.pendingMu.Lock()
+	ch, ok := p.pending[fields[1]]
+	p.pendingMu.Unlock()
+	if ok {
+		select {
+		case ch <- reply:
+		default:
+		}
+	}
+	return nil
+}
+
+// isNoResponders는 헤더가 503 상태(수신자 없음)인지 확인합니다
+func isNoResponders(header []byte) bool {
+	statusLine, _, _ := strings.Cut(string(header), "\r\n")
+	fields := strings.Fields(statusLine)
+	return len(fields) >= 2 && fields[1] == "503"
+}
+
+// readLine은 CRLF로 끝나는 프로토콜 한 줄을 읽습니다
+func readLine(br *bufio.Reader) (string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// randomToken은 inbox 주제용 임의 토큰을 생성합니다
+func randomToken() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
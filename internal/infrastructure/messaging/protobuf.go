@@ -0,0 +1,307 @@
+package messaging
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// EventFormatProtobuf는 proto/cdc_events.proto의 database.cdc.v1.DocumentEvent로 인코딩해 보냅니다
+const EventFormatProtobuf = "protobuf"
+
+const (
+	// ProtoSchemaVersion은 DocumentEvent.schema_version 값입니다
+	ProtoSchemaVersion = 1
+
+	// ProtoMessageType은 protobuf CDC 메시지의 전체 타입 이름입니다
+	ProtoMessageType = "database.cdc.v1.DocumentEvent"
+
+	// ProtobufContentType은 protobuf 메시지의 콘텐츠 타입입니다 (메시지 타입으로 계약 버전을 구분)
+	ProtobufContentType = "application/x-protobuf; messageType=" + ProtoMessageType
+)
+
+// DocumentEvent 필드 번호 (proto/cdc_events.proto와 일치해야 합니다)
+// 이벤트 발행 경로가 protoc 생성 코드에 의존하지 않도록 protowire로 직접 인코딩합니다
+const (
+	protoFieldSchemaVersion protowire.Number = 1
+	protoFieldEventID       protowire.Number = 2
+	protoFieldEventType     protowire.Number = 3
+	protoFieldTimestamp     protowire.Number = 4
+	protoFieldDocumentID    protowire.Number = 5
+	protoFieldCollection    protowire.Number = 6
+	protoFieldData          protowire.Number = 7
+	protoFieldVersion       protowire.Number = 8
+	protoFieldMetadata      protowire.Number = 9
+	protoFieldUpdated       protowire.Number = 10
+	protoFieldDeleted       protowire.Number = 11
+
+	// DocumentUpdated
+	protoFieldPreviousVersion protowire.Number = 1
+	protoFieldChanges         protowire.Number = 2
+
+	// DocumentDeleted
+	protoFieldDeletedAt protowire.Number = 1
+)
+
+// protoEventTypes는 이벤트 타입과 EventType enum 값의 대응입니다
+var protoEventTypes = map[string]protowire.Number{
+	EventDocumentCreated: 1,
+	EventDocumentUpdated: 2,
+	EventDocumentDeleted: 3,
+}
+
+// EncodeProtoEvent는 이벤트를 database.cdc.v1.DocumentEvent protobuf 바이너리로 인코딩합니다
+func EncodeProtoEvent(meta *DocumentEvent, event interface{}) ([]byte, error) {
+	var buf []byte
+	buf = protowire.AppendTag(buf, protoFieldSchemaVersion, protowire.VarintType)
+	buf = protowire.AppendVarint(buf, ProtoSchemaVersion)
+	buf = appendProtoString(buf, protoFieldEventID, meta.EventID)
+	if eventType, ok := protoEventTypes[meta.EventType]; ok {
+		buf = protowire.AppendTag(buf, protoFieldEventType, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, uint64(eventType))
+	}
+	buf = appendProtoTimestamp(buf, protoFieldTimestamp, meta.Timestamp)
+	buf = appendProtoString(buf, protoFieldDocumentID, meta.DocumentID)
+	buf = appendProtoString(buf, protoFieldCollection, meta.Collection)
+
+	var err error
+	if buf, err = appendProtoStruct(buf, protoFieldData, meta.Data); err != nil {
+		return nil, err
+	}
+	if meta.Version != 0 {
+		buf = protowire.AppendTag(buf, protoFieldVersion, protowire.VarintType)
+		buf = protowire.AppendVarint(buf, uint64(int64(meta.Version)))
+	}
+	for key, value := range meta.Metadata {
+		var entry []byte
+		entry = appendProtoString(entry, 1, key)
+		entry = appendProtoString(entry, 2, value)
+		buf = protowire.AppendTag(buf, protoFieldMetadata, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, entry)
+	}
+
+	switch e := event.(type) {
+	case DocumentUpdatedEvent:
+		var details []byte
+		details = protowire.AppendTag(details, protoFieldPreviousVersion, protowire.VarintType)
+		details = protowire.AppendVarint(details, uint64(int64(e.PreviousVersion)))
+		if details, err = appendProtoStruct(details, protoFieldChanges, e.Changes); err != nil {
+			return nil, err
+		}
+		buf = protowire.AppendTag(buf, protoFieldUpdated, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, details)
+	case DocumentDeletedEvent:
+		details := appendProtoTimestamp(nil, protoFieldDeletedAt, e.DeletedAt)
+		buf = protowire.AppendTag(buf, protoFieldDeleted, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, details)
+	}
+	return buf, nil
+}
+
+// DecodeProtoEvent는 protobuf 메시지를 native 이벤트 JSON으로 변환합니다
+// 모르는 필드는 건너뛰므로 이후 버전에서 필드가 추가되어도 읽을 수 있습니다
+func DecodeProtoEvent(message []byte) ([]byte, error) {
+	native := make(map[string]interface{})
+	err := consumeProtoFields(message, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch num {
+		case protoFieldSchemaVersion:
+			if varint > ProtoSchemaVersion {
+				return fmt.Errorf("unsupported cdc protobuf schema version %d", varint)
+			}
+		case protoFieldEventID:
+			native["event_id"] = string(value)
+		case protoFieldEventType:
+			for name, n := range protoEventTypes {
+				if uint64(n) == varint {
+					native["event_type"] = name
+				}
+			}
+		case protoFieldTimestamp:
+			ts, err := decodeProtoTimestamp(value)
+			if err != nil {
+				return err
+			}
+			native["timestamp"] = ts
+		case protoFieldDocumentID:
+			native["document_id"] = string(value)
+		case protoFieldCollection:
+			native["collection"] = string(value)
+		case protoFieldData:
+			data, err := decodeProtoStruct(value)
+			if err != nil {
+				return err
+			}
+			native["data"] = data
+		case protoFieldVersion:
+			native["version"] = int64(varint)
+		case protoFieldMetadata:
+			metadata, _ := native["metadata"].(map[string]string)
+			if metadata == nil {
+				metadata = make(map[string]string)
+				native["metadata"] = metadata
+			}
+			var key, val string
+			err := consumeProtoFields(value, func(n protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+				switch n {
+				case 1:
+					key = string(v)
+				case 2:
+					val = string(v)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			metadata[key] = val
+		case protoFieldUpdated:
+			return consumeProtoFields(value, func(n protowire.Number, _ protowire.Type, v []byte, vi uint64) error {
+				switch n {
+				case protoFieldPreviousVersion:
+					native["previous_version"] = int64(vi)
+				case protoFieldChanges:
+					changes, err := decodeProtoStruct(v)
+					if err != nil {
+						return err
+					}
+					native["changes"] = changes
+				}
+				return nil
+			})
+		case protoFieldDeleted:
+			return consumeProtoFields(value, func(n protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+				if n != protoFieldDeletedAt {
+					return nil
+				}
+				deletedAt, err := decodeProtoTimestamp(v)
+				if err != nil {
+					return err
+				}
+				native["deleted_at"] = deletedAt
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode protobuf event: %w", err)
+	}
+
+	data, err := json.Marshal(native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal decoded event: %w", err)
+	}
+	return data, nil
+}
+
+// consumeProtoFields는 메시지의 필드를 차례로 읽어 fn에 전달합니다 (varint는 varint, bytes는 value)
+func consumeProtoFields(message []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error) error {
+	for len(message) > 0 {
+		num, typ, n := protowire.ConsumeTag(message)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		message = message[n:]
+
+		var value []byte
+		var varint uint64
+		switch typ {
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(message)
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(message)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, message)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		message = message[n:]
+
+		if err := fn(num, typ, value, varint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendProtoString(buf []byte, num protowire.Number, value string) []byte {
+	if value == "" {
+		return buf
+	}
+	buf = protowire.AppendTag(buf, num, protowire.BytesType)
+	return protowire.AppendString(buf, value)
+}
+
+// appendProtoTimestamp는 google.protobuf.Timestamp 필드를 추가합니다
+func appendProtoTimestamp(buf []byte, num protowire.Number, t time.Time) []byte {
+	if t.IsZero() {
+		return buf
+	}
+	var ts []byte
+	if seconds := t.Unix(); seconds != 0 {
+		ts = protowire.AppendTag(ts, 1, protowire.VarintType)
+		ts = protowire.AppendVarint(ts, uint64(seconds))
+	}
+	if nanos := t.Nanosecond(); nanos != 0 {
+		ts = protowire.AppendTag(ts, 2, protowire.VarintType)
+		ts = protowire.AppendVarint(ts, uint64(nanos))
+	}
+	buf = protowire.AppendTag(buf, num, protowire.BytesType)
+	return protowire.AppendBytes(buf, ts)
+}
+
+func decodeProtoTimestamp(value []byte) (time.Time, error) {
+	var seconds, nanos int64
+	err := consumeProtoFields(value, func(n protowire.Number, _ protowire.Type, _ []byte, v uint64) error {
+		switch n {
+		case 1:
+			seconds = int64(v)
+		case 2:
+			nanos = int64(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(seconds, nanos).UTC(), nil
+}
+
+// appendProtoStruct는 map을 google.protobuf.Struct 필드로 추가합니다
+// structpb가 받지 않는 타입(time.Time 등)이 있을 수 있으므로 JSON으로 한 번 정규화합니다
+func appendProtoStruct(buf []byte, num protowire.Number, value map[string]interface{}) ([]byte, error) {
+	if value == nil {
+		return buf, nil
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event field: %w", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(normalized, &fields); err != nil {
+		return nil, fmt.Errorf("failed to normalize event field: %w", err)
+	}
+	s, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert event field to struct: %w", err)
+	}
+	encoded, err := proto.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal struct: %w", err)
+	}
+	buf = protowire.AppendTag(buf, num, protowire.BytesType)
+	return protowire.AppendBytes(buf, encoded), nil
+}
+
+func decodeProtoStruct(value []byte) (map[string]interface{}, error) {
+	var s structpb.Struct
+	if err := proto.Unmarshal(value, &s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal struct: %w", err)
+	}
+	return s.AsMap(), nil
+}
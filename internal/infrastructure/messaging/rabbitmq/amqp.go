@@ -0,0 +1,288 @@
+package rabbitmq
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// AMQP 0-9-1 프레임 타입
+const (
+	frameMethod    = 1
+	frameHeader    = 2
+	frameBody      = 3
+	frameHeartbeat = 8
+	frameEnd       = 0xCE
+)
+
+// AMQP 0-9-1 클래스/메서드 ID (발행자에 필요한 것만)
+const (
+	classConnection = 10
+	classChannel    = 20
+	classExchange   = 40
+	classBasic      = 60
+	classConfirm    = 85
+
+	methodConnectionStart   = 10
+	methodConnectionStartOk = 11
+	methodConnectionTune    = 30
+	methodConnectionTuneOk  = 31
+	methodConnectionOpen    = 40
+	methodConnectionOpenOk  = 41
+	methodConnectionClose   = 50
+	methodConnectionCloseOk = 51
+	methodConnectionBlocked = 60
+	methodConnectionUnblock = 61
+	methodChannelOpen       = 10
+	methodChannelOpenOk     = 11
+	methodChannelClose      = 40
+	methodChannelCloseOk    = 41
+	methodExchangeDeclare   = 10
+	methodExchangeDeclareOk = 11
+	methodBasicPublish      = 40
+	methodBasicReturn       = 50
+	methodBasicAck          = 80
+	methodBasicNack         = 120
+	methodConfirmSelect     = 10
+	methodConfirmSelectOk   = 11
+)
+
+// protocolHeader는 AMQP 0-9-1 연결 시작 헤더입니다
+var protocolHeader = []byte{'A', 'M', 'Q', 'P', 0, 0, 9, 1}
+
+// errMalformedFrame은 해석할 수 없는 프레임을 받았을 때 반환됩니다
+var errMalformedFrame = errors.New("amqp: malformed frame")
+
+// frame은 AMQP 프레임 하나입니다
+type frame struct {
+	typ     byte
+	channel uint16
+	payload []byte
+}
+
+// method는 메서드 프레임의 클래스/메서드 ID와 인자입니다
+type method struct {
+	classID  uint16
+	methodID uint16
+	args     *decoder
+}
+
+// is는 메서드가 지정한 클래스/메서드인지 확인합니다
+func (m method) is(classID, methodID uint16) bool {
+	return m.classID == classID && m.methodID == methodID
+}
+
+// readFrame은 프레임 하나를 읽습니다
+func readFrame(r *bufio.Reader) (frame, error) {
+	var head [7]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return frame{}, err
+	}
+	size := binary.BigEndian.Uint32(head[3:7])
+	buf := make([]byte, int(size)+1)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return frame{}, err
+	}
+	if buf[size] != frameEnd {
+		return frame{}, errMalformedFrame
+	}
+	return frame{typ: head[0], channel: binary.BigEndian.Uint16(head[1:3]), payload: buf[:size]}, nil
+}
+
+// writeFrame은 프레임 하나를 버퍼에 씁니다 (Flush는 호출자 책임)
+func writeFrame(w *bufio.Writer, typ byte, channel uint16, payload []byte) error {
+	var head [7]byte
+	head[0] = typ
+	binary.BigEndian.PutUint16(head[1:3], channel)
+	binary.BigEndian.PutUint32(head[3:7], uint32(len(payload)))
+	if _, err := w.Write(head[:]); err != nil {
+		return err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return err
+	}
+	return w.WriteByte(frameEnd)
+}
+
+// parseMethod는 메서드 프레임을 해석합니다
+func parseMethod(f frame) (method, error) {
+	if f.typ != frameMethod || len(f.payload) < 4 {
+		return method{}, errMalformedFrame
+	}
+	return method{
+		classID:  binary.BigEndian.Uint16(f.payload[0:2]),
+		methodID: binary.BigEndian.Uint16(f.payload[2:4]),
+		args:     &decoder{buf: f.payload[4:]},
+	}, nil
+}
+
+// encoder는 AMQP 필드 인코더입니다
+type encoder struct {
+	buf []byte
+}
+
+// newMethod는 클래스/메서드 ID로 시작하는 메서드 인코더를 생성합니다
+func newMethod(classID, methodID uint16) *encoder {
+	e := &encoder{}
+	e.short(classID)
+	e.short(methodID)
+	return e
+}
+
+func (e *encoder) octet(v byte) *encoder {
+	e.buf = append(e.buf, v)
+	return e
+}
+
+func (e *encoder) short(v uint16) *encoder {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, v)
+	return e
+}
+
+func (e *encoder) long(v uint32) *encoder {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, v)
+	return e
+}
+
+func (e *encoder) longlong(v uint64) *encoder {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+	return e
+}
+
+// shortstr는 길이 1바이트 문자열을 씁니다 (255바이트 초과분은 잘림)
+func (e *encoder) shortstr(s string) *encoder {
+	if len(s) > 255 {
+		s = s[:255]
+	}
+	e.buf = append(e.buf, byte(len(s)))
+	e.buf = append(e.buf, s...)
+	return e
+}
+
+func (e *encoder) longstr(s string) *encoder {
+	e.long(uint32(len(s)))
+	e.buf = append(e.buf, s...)
+	return e
+}
+
+// table은 필드 테이블을 씁니다 (string, bool, int, table 값 지원)
+func (e *encoder) table(t map[string]interface{}) *encoder {
+	inner := &encoder{}
+	for k, v := range t {
+		inner.shortstr(k)
+		switch val := v.(type) {
+		case string:
+			inner.octet('S').longstr(val)
+		case bool:
+			b := byte(0)
+			if val {
+				b = 1
+			}
+			inner.octet('t').octet(b)
+		case int:
+			inner.octet('I').long(uint32(int32(val)))
+		case map[string]interface{}:
+			inner.octet('F').table(val)
+		default:
+			inner.octet('V')
+		}
+	}
+	e.long(uint32(len(inner.buf)))
+	e.buf = append(e.buf, inner.buf...)
+	return e
+}
+
+// decoder는 AMQP 필드 디코더입니다 (길이가 부족하면 err를 기록하고 0 값을 반환)
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.buf) < n {
+		d.err = errMalformedFrame
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) octet() byte {
+	if b := d.take(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) short() uint16 {
+	if b := d.take(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) long() uint32 {
+	if b := d.take(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) longlong() uint64 {
+	if b := d.take(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (d *decoder) shortstr() string {
+	n := int(d.octet())
+	return string(d.take(n))
+}
+
+func (d *decoder) longstr() string {
+	n := int(d.long())
+	return string(d.take(n))
+}
+
+// skipTable은 필드 테이블을 건너뜁니다 (서버 속성은 사용하지 않음)
+func (d *decoder) skipTable() {
+	n := int(d.long())
+	d.take(n)
+}
+
+// closeError는 서버가 보낸 Connection.Close/Channel.Close를 에러로 변환합니다
+func closeError(scope string, m method) error {
+	code := m.args.short()
+	text := m.args.shortstr()
+	return fmt.Errorf("amqp: %s closed by server: %d %s", scope, code, text)
+}
+
+// contentHeader는 Basic 클래스 콘텐츠 헤더 프레임 페이로드를 생성합니다
+// 속성: content-type, delivery-mode(2=영속), message-id, timestamp, type
+func contentHeader(bodySize int, contentType, messageID, eventType string, timestamp time.Time) []byte {
+	const (
+		flagContentType  = 1 << 15
+		flagDeliveryMode = 1 << 12
+		flagMessageID    = 1 << 7
+		flagTimestamp    = 1 << 6
+		flagType         = 1 << 5
+	)
+
+	e := &encoder{}
+	e.short(classBasic).short(0).longlong(uint64(bodySize))
+	e.short(flagContentType | flagDeliveryMode | flagMessageID | flagTimestamp | flagType)
+	e.shortstr(contentType)
+	e.octet(2)
+	e.shortstr(messageID)
+	e.longlong(uint64(timestamp.Unix()))
+	e.shortstr(eventType)
+	return e.buf
+}
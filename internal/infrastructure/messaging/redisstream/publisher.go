@@ -0,0 +1,155 @@
+package redisstream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Config는 Redis Streams CDC 발행자 설정입니다
+type Config struct {
+	// KeyPrefix는 스트림 키 접두사입니다 (<prefix>:<collection>)
+	KeyPrefix string
+
+	// MaxLen은 스트림별 최대 항목 수입니다 (0이면 자르지 않음)
+	MaxLen int64
+
+	// ApproximateTrim이 true이면 MAXLEN ~ 로 근사 자르기를 사용합니다 (성능상 권장)
+	ApproximateTrim bool
+}
+
+// DefaultConfig는 기본 설정을 반환합니다
+func DefaultConfig() Config {
+	return Config{
+		KeyPrefix:       "cdc",
+		MaxLen:          100000,
+		ApproximateTrim: true,
+	}
+}
+
+// Publisher는 CDC 이벤트를 컬렉션별 Redis Stream에 XADD로 발행합니다 (messaging.CDCPublisher 구현)
+//
+// 항목 필드는 컨슈머 그룹(XREADGROUP)에서 JSON을 풀지 않고도 필터링할 수 있도록 평탄화되어 있습니다
+//   - event_id, event_type, document_id, collection, version, timestamp(RFC3339Nano), origin
+//   - content_type, payload: Kafka CDC 메시지와 같은 전체 이벤트 JSON (또는 CloudEvents 봉투)
+//
+// 항목 ID는 Redis가 생성하므로(*), 같은 스트림 안에서 발행 순서가 보장됩니다
+type Publisher struct {
+	client  redis.UniversalClient
+	config  Config
+	origin  string
+	encoder messaging.EventEncoder
+}
+
+// NewPublisher는 새로운 Redis Streams 발행자를 생성합니다 (연결은 호출자가 소유)
+func NewPublisher(client redis.UniversalClient, config Config) *Publisher {
+	if config.KeyPrefix == "" {
+		config.KeyPrefix = DefaultConfig().KeyPrefix
+	}
+	if config.MaxLen < 0 {
+		config.MaxLen = 0
+	}
+	return &Publisher{client: client, config: config}
+}
+
+// SetOrigin은 발행하는 이벤트의 메타데이터에 인스턴스 ID를 기록하도록 설정합니다
+func (p *Publisher) SetOrigin(origin string) {
+	p.origin = origin
+}
+
+// SetEncoder는 payload 필드의 형식(native, CloudEvents)을 설정합니다
+func (p *Publisher) SetEncoder(encoder messaging.EventEncoder) {
+	p.encoder = encoder
+}
+
+// StreamKey는 컬렉션의 스트림 키를 반환합니다
+func (p *Publisher) StreamKey(collection string) string {
+	return p.config.KeyPrefix + ":" + collection
+}
+
+// PublishDocumentCreated는 문서 생성 이벤트를 발행합니다
+func (p *Publisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	event := messaging.DocumentCreatedEvent{
+		DocumentEvent: p.newEvent(messaging.EventDocumentCreated, docID, collection, data, version),
+	}
+	return p.publish(ctx, &event.DocumentEvent, event)
+}
+
+// PublishDocumentUpdated는 문서 업데이트 이벤트를 발행합니다
+func (p *Publisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	event := messaging.DocumentUpdatedEvent{
+		DocumentEvent:   p.newEvent(messaging.EventDocumentUpdated, docID, collection, data, version),
+		PreviousVersion: previousVersion,
+		Changes:         changes,
+	}
+	return p.publish(ctx, &event.DocumentEvent, event)
+}
+
+// PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다
+func (p *Publisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	event := messaging.DocumentDeletedEvent{
+		DocumentEvent: p.newEvent(messaging.EventDocumentDeleted, docID, collection, nil, version),
+		DeletedAt:     time.Now(),
+	}
+	return p.publish(ctx, &event.DocumentEvent, event)
+}
+
+// newEvent는 공통 이벤트 필드를 채웁니다
+func (p *Publisher) newEvent(eventType, docID, collection string, data map[string]interface{}, version int) messaging.DocumentEvent {
+	now := time.Now()
+	return messaging.DocumentEvent{
+		EventID:    fmt.Sprintf("%s-%d", docID, now.UnixNano()),
+		EventType:  eventType,
+		Timestamp:  now,
+		DocumentID: docID,
+		Collection: collection,
+		Data:       data,
+		Version:    version,
+		Metadata:   messaging.OriginMetadata(p.origin),
+	}
+}
+
+// publish는 이벤트를 컬렉션 스트림에 추가합니다
+func (p *Publisher) publish(ctx context.Context, meta *messaging.DocumentEvent, event interface{}) error {
+	payload, err := p.encoder.Encode(meta, event)
+	if err != nil {
+		return err
+	}
+
+	key := p.StreamKey(meta.Collection)
+	args := &redis.XAddArgs{
+		Stream: key,
+		MaxLen: p.config.MaxLen,
+		Approx: p.config.ApproximateTrim && p.config.MaxLen > 0,
+		ID:     "*",
+		Values: []interface{}{
+			"event_id", meta.EventID,
+			"event_type", meta.EventType,
+			"document_id", meta.DocumentID,
+			"collection", meta.Collection,
+			"version", strconv.Itoa(meta.Version),
+			"timestamp", meta.Timestamp.UTC().Format(time.RFC3339Nano),
+			"origin", p.origin,
+			"content_type", p.encoder.ContentType(),
+			"payload", string(payload),
+		},
+	}
+
+	id, err := p.client.XAdd(ctx, args).Result()
+	if err != nil {
+		return fmt.Errorf("failed to publish event to stream %s: %w", key, err)
+	}
+
+	logger.Debug(ctx, "event published to redis stream",
+		zap.String("stream", key),
+		zap.String("entry_id", id),
+		zap.String("event_id", meta.EventID),
+	)
+	return nil
+}
@@ -0,0 +1,76 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ReplayHeader는 재생된 메시지에 붙는 헤더 키입니다 (값은 재생 ID)
+// 다운스트림은 이 헤더로 실시간 이벤트와 재생 이벤트를 구분할 수 있습니다
+const ReplayHeader = "cdc-replay"
+
+// ErrLogTruncated는 요청한 시각 이후의 CDC 이벤트 일부가 보존 기간이 지나 삭제되었을 때 반환됩니다
+var ErrLogTruncated = errors.New("cdc log does not cover the requested time range")
+
+// ReplayRequest는 CDC 이벤트 재생 조건입니다
+type ReplayRequest struct {
+	// ReplayID는 재생 작업 ID입니다 (재생 메시지의 cdc-replay 헤더 값)
+	ReplayID string
+
+	// Collection은 재생할 컬렉션입니다
+	Collection string
+
+	// From은 재생 시작 시각입니다 (FromOffset보다 우선)
+	From time.Time
+
+	// FromOffset은 모든 파티션에 적용할 시작 오프셋입니다 (From이 비어 있을 때만 사용, 음수면 가장 오래된 오프셋)
+	FromOffset int64
+
+	// To는 재생 종료 시각입니다 (비어 있으면 재생 시작 시점까지)
+	To time.Time
+
+	// TargetTopic은 재생 메시지를 보낼 토픽입니다 (비어 있으면 원래 토픽)
+	TargetTopic string
+
+	// Limit은 재생할 최대 이벤트 수입니다
+	Limit int
+
+	// DryRun이면 발행하지 않고 대상 이벤트 수만 셉니다
+	DryRun bool
+
+	// RequireFrom이면 From 이후 이벤트가 보존 기간으로 삭제되었을 수 있을 때 ErrLogTruncated를 반환합니다
+	RequireFrom bool
+}
+
+// ReplayResult는 CDC 이벤트 재생 결과입니다
+type ReplayResult struct {
+	// Scanned는 읽은 메시지 수입니다
+	Scanned int
+
+	// Matched는 컬렉션/시간 조건에 맞은 이벤트 수입니다
+	Matched int
+
+	// Replayed는 다시 발행한 이벤트 수입니다
+	Replayed int
+
+	// Truncated는 Limit을 넘어 일부 이벤트만 재생했는지 여부입니다
+	Truncated bool
+
+	// Next는 Truncated일 때 다음 재생을 시작할 시각입니다 (마지막으로 재생한 이벤트 시각)
+	Next time.Time
+}
+
+// Replayer는 이미 발행된 CDC 이벤트를 다시 발행합니다 (kafka.Replayer가 구현)
+type Replayer interface {
+	Replay(ctx context.Context, req *ReplayRequest) (*ReplayResult, error)
+}
+
+// EventScanner는 보존된 CDC 이벤트를 다시 발행하지 않고 읽습니다 (kafka.Replayer가 구현)
+// 시점 복원처럼 이벤트를 직접 적용해야 할 때 사용합니다
+type EventScanner interface {
+	// ScanEvents는 컬렉션/시간 조건에 맞는 이벤트를 fn에 전달합니다 (Limit, TargetTopic, DryRun은 무시)
+	// 같은 파티션의 이벤트는 오프셋 순서로 전달되지만, 파티션/토픽 사이의 순서는 보장하지 않으므로
+	// 호출자는 이벤트 시각과 버전으로 순서를 정해야 합니다
+	ScanEvents(ctx context.Context, req *ReplayRequest, fn func(event *DocumentEvent) error) (*ReplayResult, error)
+}
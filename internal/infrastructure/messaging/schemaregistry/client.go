@@ -0,0 +1,195 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/avro"
+)
+
+// ContentType은 Schema Registry REST API 콘텐츠 타입입니다
+const ContentType = "application/vnd.schemaregistry.v1+json"
+
+// MagicByte는 Confluent 와이어 포맷의 첫 바이트입니다 (0 + 4바이트 big-endian 스키마 ID + Avro 바이너리)
+const MagicByte byte = 0
+
+// ErrIncompatibleSchema는 레지스트리의 호환성 검사에서 스키마 진화가 거부되었을 때 반환됩니다
+var ErrIncompatibleSchema = errors.New("schema is incompatible with the registered versions")
+
+// ErrNotConfluentFormat은 메시지가 Confluent 와이어 포맷이 아닐 때 반환됩니다
+var ErrNotConfluentFormat = errors.New("message is not in confluent wire format")
+
+// Config는 Schema Registry 클라이언트 설정입니다
+type Config struct {
+	URL      string
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+// Client는 Confluent Schema Registry REST 클라이언트입니다
+// 등록한 스키마 ID와 ID로 조회한 스키마는 변하지 않으므로 프로세스 수명 동안 캐시합니다
+type Client struct {
+	baseURL    string
+	config     Config
+	httpClient *http.Client
+
+	mu      sync.RWMutex
+	ids     map[string]int // subject + "\x00" + schema -> ID
+	schemas map[int]*avro.Schema
+}
+
+// NewClient는 새로운 Schema Registry 클라이언트를 생성합니다
+func NewClient(config Config) (*Client, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("schema registry url is required")
+	}
+	if _, err := url.Parse(config.URL); err != nil {
+		return nil, fmt.Errorf("invalid schema registry url: %w", err)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &Client{
+		baseURL:    strings.TrimRight(config.URL, "/"),
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout},
+		ids:        make(map[string]int),
+		schemas:    make(map[int]*avro.Schema),
+	}, nil
+}
+
+// Register는 subject에 스키마를 등록하고 스키마 ID를 반환합니다
+// 이미 등록된 스키마이면 기존 ID를, 호환성 검사에 실패하면 ErrIncompatibleSchema를 반환합니다
+func (c *Client) Register(ctx context.Context, subject, schema string) (int, error) {
+	cacheKey := subject + "\x00" + schema
+	c.mu.RLock()
+	id, ok := c.ids[cacheKey]
+	c.mu.RUnlock()
+	if ok {
+		return id, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	var resp struct {
+		ID int `json:"id"`
+	}
+	path := "/subjects/" + url.PathEscape(subject) + "/versions"
+	if err := c.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return 0, fmt.Errorf("failed to register schema for subject %s: %w", subject, err)
+	}
+
+	c.mu.Lock()
+	c.ids[cacheKey] = resp.ID
+	c.mu.Unlock()
+	return resp.ID, nil
+}
+
+// SchemaByID는 ID로 스키마를 조회해 파싱합니다 (컨슈머가 작성자 스키마를 얻는 데 사용)
+func (c *Client) SchemaByID(ctx context.Context, id int) (*avro.Schema, error) {
+	c.mu.RLock()
+	schema, ok := c.schemas[id]
+	c.mu.RUnlock()
+	if ok {
+		return schema, nil
+	}
+
+	var resp struct {
+		Schema string `json:"schema"`
+	}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/schemas/ids/%d", id), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch schema %d: %w", id, err)
+	}
+
+	schema, err := avro.Parse(resp.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema %d: %w", id, err)
+	}
+
+	c.mu.Lock()
+	c.schemas[id] = schema
+	c.mu.Unlock()
+	return schema, nil
+}
+
+// do는 레지스트리 API를 호출하고 JSON 응답을 out에 디코딩합니다
+func (c *Client) do(ctx context.Context, method, path string, body []byte, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", ContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", ContentType)
+	}
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			ErrorCode int    `json:"error_code"`
+			Message   string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &apiErr)
+		if resp.StatusCode == http.StatusConflict {
+			return fmt.Errorf("%w: %s", ErrIncompatibleSchema, apiErr.Message)
+		}
+		return fmt.Errorf("schema registry returned %d (code %d): %s", resp.StatusCode, apiErr.ErrorCode, apiErr.Message)
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// Frame은 Avro 바이너리 앞에 Confluent 와이어 포맷 헤더를 붙입니다
+func Frame(schemaID int, payload []byte) []byte {
+	buf := make([]byte, 5, 5+len(payload))
+	buf[0] = MagicByte
+	binary.BigEndian.PutUint32(buf[1:5], uint32(schemaID))
+	return append(buf, payload...)
+}
+
+// Unframe은 Confluent 와이어 포맷에서 스키마 ID와 Avro 바이너리를 분리합니다
+func Unframe(message []byte) (int, []byte, error) {
+	if !IsFramed(message) {
+		return 0, nil, ErrNotConfluentFormat
+	}
+	return int(binary.BigEndian.Uint32(message[1:5])), message[5:], nil
+}
+
+// IsFramed는 메시지가 Confluent 와이어 포맷인지 확인합니다
+// JSON 메시지는 '{'로 시작하므로 첫 바이트로 구분할 수 있습니다
+func IsFramed(message []byte) bool {
+	return len(message) >= 5 && message[0] == MagicByte
+}
@@ -0,0 +1,202 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+)
+
+// FieldRename은 필드 이름 변경 규칙입니다
+type FieldRename struct {
+	// From은 원래 필드 경로입니다 (점 표기로 중첩 필드)
+	From string
+
+	// To는 같은 위치에서 사용할 새 필드 이름입니다
+	To string
+}
+
+// FieldTransform은 컬렉션별 CDC 이벤트 필드 변환 규칙입니다
+// 변환은 ChangedOnly → Drop → Rename 순서로 data와 changes에 적용됩니다
+type FieldTransform struct {
+	// Collection은 컬렉션 이름 또는 와일드카드 패턴입니다 (path.Match 문법)
+	Collection string
+
+	// Drop은 제거할 필드 경로입니다 (점 표기로 중첩 필드, 상위 필드를 지정하면 하위 필드 모두 제거)
+	Drop []string
+
+	// Rename은 필드 이름 변경 규칙입니다
+	Rename []FieldRename
+
+	// ChangedOnly이면 업데이트 이벤트의 data를 changes에 있는 최상위 필드로만 제한합니다 (changes가 없으면 그대로)
+	ChangedOnly bool
+}
+
+// TransformPublisher는 발행 전에 이벤트 필드를 변환하는 CDCPublisher 래퍼입니다
+// 민감한 필드가 이벤트 버스로 나가지 않도록 모든 발행 경로(Kafka, NATS, RabbitMQ, Redis Streams)에 동일하게 적용됩니다
+type TransformPublisher struct {
+	next       CDCPublisher
+	transforms []FieldTransform
+}
+
+// NewTransformPublisher는 새로운 필드 변환 발행자를 생성합니다
+// 컬렉션과 일치하는 모든 규칙을 순서대로 적용합니다
+func NewTransformPublisher(next CDCPublisher, transforms []FieldTransform) (*TransformPublisher, error) {
+	for i, t := range transforms {
+		if t.Collection == "" {
+			return nil, fmt.Errorf("transform %d: collection is required", i)
+		}
+		if _, err := path.Match(t.Collection, ""); err != nil {
+			return nil, fmt.Errorf("transform %d: invalid collection pattern %q: %w", i, t.Collection, err)
+		}
+		for _, r := range t.Rename {
+			if r.From == "" || r.To == "" || strings.Contains(r.To, ".") {
+				return nil, fmt.Errorf("transform %d: rename requires from and a field name without dots as to", i)
+			}
+		}
+	}
+	return &TransformPublisher{next: next, transforms: transforms}, nil
+}
+
+// SetOrigin은 내부 발행자에 인스턴스 ID를 설정합니다
+func (p *TransformPublisher) SetOrigin(origin string) {
+	p.next.SetOrigin(origin)
+}
+
+// SetEncoder는 내부 발행자에 메시지 형식을 설정합니다
+func (p *TransformPublisher) SetEncoder(encoder EventEncoder) {
+	p.next.SetEncoder(encoder)
+}
+
+// PublishDocumentCreated는 변환한 문서 생성 이벤트를 발행합니다
+func (p *TransformPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	data, _ = p.apply(collection, data, nil, false)
+	return p.next.PublishDocumentCreated(ctx, docID, collection, data, version)
+}
+
+// PublishDocumentUpdated는 변환한 문서 업데이트 이벤트를 발행합니다
+func (p *TransformPublisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	data, changes = p.apply(collection, data, changes, true)
+	return p.next.PublishDocumentUpdated(ctx, docID, collection, data, version, previousVersion, changes)
+}
+
+// PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다 (변환할 필드 없음)
+func (p *TransformPublisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	return p.next.PublishDocumentDeleted(ctx, docID, collection, version)
+}
+
+// apply는 컬렉션과 일치하는 규칙을 복사본에 적용합니다 (원본 맵은 수정하지 않음)
+func (p *TransformPublisher) apply(collection string, data, changes map[string]interface{}, update bool) (map[string]interface{}, map[string]interface{}) {
+	copied := false
+	for _, t := range p.transforms {
+		if matched, _ := path.Match(t.Collection, collection); !matched {
+			continue
+		}
+		if !copied {
+			data, changes = cloneFields(data), cloneFields(changes)
+			copied = true
+		}
+
+		if t.ChangedOnly && update && changes != nil && data != nil {
+			data = changedFields(data, changes)
+		}
+		for _, field := range t.Drop {
+			dropField(data, strings.Split(field, "."))
+			dropField(changes, strings.Split(field, "."))
+		}
+		for _, r := range t.Rename {
+			renameField(data, strings.Split(r.From, "."), r.To)
+			renameField(changes, strings.Split(r.From, "."), r.To)
+		}
+	}
+	return data, changes
+}
+
+// changedFields는 changes에 있는 최상위 필드만 남깁니다 ("a.b" 같은 점 표기 키는 최상위 필드 a로 봅니다)
+func changedFields(data, changes map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(changes))
+	for key := range changes {
+		top := strings.SplitN(key, ".", 2)[0]
+		if value, ok := data[top]; ok {
+			out[top] = value
+		}
+	}
+	return out
+}
+
+// dropField는 경로의 필드를 제거합니다
+// 중첩 맵과 "a.b" 같은 점 표기 키(업데이트 연산자 형식의 changes)를 모두 처리합니다
+func dropField(m map[string]interface{}, parts []string) {
+	if m == nil || len(parts) == 0 {
+		return
+	}
+	joined := strings.Join(parts, ".")
+	delete(m, joined)
+	for key := range m {
+		if strings.HasPrefix(key, joined+".") {
+			delete(m, key)
+		}
+	}
+	if len(parts) > 1 {
+		if child, ok := m[parts[0]].(map[string]interface{}); ok {
+			dropField(child, parts[1:])
+		}
+	}
+}
+
+// renameField는 경로의 필드 이름을 같은 위치에서 바꿉니다
+func renameField(m map[string]interface{}, parts []string, to string) {
+	if m == nil || len(parts) == 0 {
+		return
+	}
+	joined := strings.Join(parts, ".")
+	if value, ok := m[joined]; ok {
+		delete(m, joined)
+		m[strings.Join(append(parts[:len(parts)-1:len(parts)-1], to), ".")] = value
+	}
+	if len(parts) > 1 {
+		if child, ok := m[parts[0]].(map[string]interface{}); ok {
+			renameField(child, parts[1:], to)
+		}
+	}
+}
+
+// cloneFields는 맵을 깊은 복사합니다
+// 문자열 키 맵(bson.M 등)은 map[string]interface{}로 바꿔 중첩 필드 경로를 따라갈 수 있게 합니다
+func cloneFields(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = cloneValue(v)
+	}
+	return out
+}
+
+func cloneValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		return cloneFields(value)
+	case []interface{}:
+		out := make([]interface{}, len(value))
+		for i, item := range value {
+			out[i] = cloneValue(item)
+		}
+		return out
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String {
+		out := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[iter.Key().String()] = cloneValue(iter.Value().Interface())
+		}
+		return out
+	}
+	return v
+}
@@ -0,0 +1,288 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+// 웹훅 요청 헤더
+// 서명은 "<타임스탬프>.<본문>"의 HMAC-SHA256이며, 수신 측은 타임스탬프가 오래된 요청을 거부해 재전송 공격을 막을 수 있습니다
+const (
+	HeaderSignature  = "X-Webhook-Signature"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderDeliveryID = "X-Webhook-Delivery"
+	HeaderEventType  = "X-Webhook-Event"
+)
+
+const (
+	defaultDispatchWorkers = 4
+	defaultPollInterval    = time.Second
+	defaultRequestTimeout  = 10 * time.Second
+	defaultMaxAttempts     = 8
+	defaultRetryBackoff    = 10 * time.Second
+	defaultMaxRetryBackoff = time.Hour
+	maxErrorBodyBytes      = 512
+)
+
+// DeliveryStore는 전송 큐 저장소입니다 (repository.WebhookDeliveryRepository가 구현)
+type DeliveryStore interface {
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*entity.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+// SubscriptionFinder는 전송할 구독을 조회합니다 (repository.WebhookSubscriptionRepository가 구현)
+type SubscriptionFinder interface {
+	FindByID(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+}
+
+// DispatcherConfig는 웹훅 디스패처 설정입니다
+type DispatcherConfig struct {
+	// Workers는 동시에 전송하는 워커 수입니다 (기본 4)
+	Workers int
+
+	// PollInterval은 보낼 기록이 없을 때 큐를 다시 확인하기까지의 대기 시간입니다 (기본 1초)
+	PollInterval time.Duration
+
+	// Timeout은 콜백 요청 타임아웃입니다 (기본 10초)
+	Timeout time.Duration
+
+	// MaxAttempts는 실패로 표시하기 전 최대 전송 시도 횟수입니다 (기본 8)
+	MaxAttempts int
+
+	// Backoff는 첫 재시도 대기 시간입니다 (시도마다 두 배, 기본 10초)
+	Backoff time.Duration
+
+	// MaxBackoff는 재시도 대기 시간 상한입니다 (기본 1시간)
+	MaxBackoff time.Duration
+
+	// UserAgent는 콜백 요청의 User-Agent입니다
+	UserAgent string
+}
+
+// Dispatcher는 전송 큐의 웹훅을 콜백 URL로 보내고 실패하면 백오프를 두고 재시도합니다
+// 큐 항목을 lease로 가져가므로 여러 인스턴스가 동시에 실행해도 같은 기록을 중복 전송하지 않습니다 (전송 중 종료 시 재전송 가능)
+type Dispatcher struct {
+	subs   SubscriptionFinder
+	store  DeliveryStore
+	client *http.Client
+	config DispatcherConfig
+}
+
+// NewDispatcher는 새로운 웹훅 디스패처를 생성합니다
+func NewDispatcher(subs SubscriptionFinder, store DeliveryStore, config DispatcherConfig) *Dispatcher {
+	if config.Workers <= 0 {
+		config.Workers = defaultDispatchWorkers
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultRequestTimeout
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = defaultRetryBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = defaultMaxRetryBackoff
+	}
+	if config.UserAgent == "" {
+		config.UserAgent = "database-service-webhook/1.0"
+	}
+
+	return &Dispatcher{
+		subs:  subs,
+		store: store,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			// 리다이렉트를 따라가지 않습니다 (등록한 URL로만 전송)
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		config: config,
+	}
+}
+
+// Run은 컨텍스트가 취소될 때까지 워커를 실행합니다
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// work는 큐에서 기록을 하나씩 가져와 전송합니다
+func (d *Dispatcher) work(ctx context.Context) {
+	// 전송 중 종료되어도 lease가 지나면 다른 워커가 다시 가져갑니다
+	lease := d.config.Timeout + 30*time.Second
+	for {
+		delivery, err := d.store.ClaimDue(ctx, time.Now(), lease)
+		if err != nil && ctx.Err() == nil {
+			logger.Error(ctx, "failed to claim webhook delivery", zap.Error(err))
+		}
+		if delivery == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(d.config.PollInterval):
+			}
+			continue
+		}
+
+		d.deliver(ctx, delivery)
+	}
+}
+
+// deliver는 기록 하나를 전송하고 결과를 저장합니다
+func (d *Dispatcher) deliver(ctx context.Context, delivery *entity.WebhookDelivery) {
+	sub, err := d.subs.FindByID(ctx, delivery.SubscriptionID)
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.LastError = "subscription deleted"
+	case err != nil:
+		// 구독을 읽지 못하면 시도 횟수를 늘리지 않고 나중에 다시 보냅니다
+		logger.Warn(ctx, "failed to load webhook subscription", zap.String("subscription_id", delivery.SubscriptionID), zap.Error(err))
+		delivery.NextAttemptAt = time.Now().Add(d.config.Backoff)
+	case !sub.Active:
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.LastError = "subscription is inactive"
+	default:
+		d.send(ctx, sub, delivery)
+	}
+
+	// 종료 중이어도 결과는 저장해야 중복 전송을 줄일 수 있습니다
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := d.store.RecordAttempt(saveCtx, delivery); err != nil {
+		logger.Error(ctx, "failed to record webhook delivery attempt",
+			zap.String("delivery_id", delivery.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// send는 서명한 페이로드를 콜백 URL로 보내고 delivery의 상태를 갱신합니다
+func (d *Dispatcher) send(ctx context.Context, sub *entity.WebhookSubscription, delivery *entity.WebhookDelivery) {
+	delivery.Attempts++
+	start := time.Now()
+	statusCode, err := d.post(ctx, sub, delivery)
+	duration := time.Since(start)
+
+	if err != nil && ctx.Err() != nil {
+		// 종료로 중단된 전송은 시도로 세지 않고 바로 다시 보내도록 합니다
+		delivery.Attempts--
+		delivery.NextAttemptAt = time.Now()
+		return
+	}
+
+	delivery.LastStatusCode = statusCode
+	if err == nil {
+		delivery.Status = entity.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = time.Now()
+		metrics.GetMetrics().RecordWebhookDelivery("delivered", duration)
+		logger.Debug(ctx, "webhook delivered",
+			zap.String("delivery_id", delivery.ID),
+			zap.String("subscription_id", sub.ID),
+			zap.Int("status_code", statusCode),
+		)
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.config.MaxAttempts {
+		delivery.Status = entity.WebhookDeliveryFailed
+		metrics.GetMetrics().RecordWebhookDelivery("failed", duration)
+		logger.Warn(ctx, "webhook delivery failed permanently",
+			zap.String("delivery_id", delivery.ID),
+			zap.String("subscription_id", sub.ID),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Error(err),
+		)
+		return
+	}
+
+	delivery.NextAttemptAt = time.Now().Add(d.retryBackoff(delivery.Attempts))
+	metrics.GetMetrics().RecordWebhookDelivery("retry", duration)
+	logger.Debug(ctx, "webhook delivery failed, will retry",
+		zap.String("delivery_id", delivery.ID),
+		zap.Int("attempts", delivery.Attempts),
+		zap.Time("next_attempt_at", delivery.NextAttemptAt),
+		zap.Error(err),
+	)
+}
+
+// post는 콜백 요청을 보냅니다 (2xx가 아니면 에러)
+func (d *Dispatcher) post(ctx context.Context, sub *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", d.config.UserAgent)
+	req.Header.Set(HeaderDeliveryID, delivery.ID)
+	req.Header.Set(HeaderEventType, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(snippet))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// retryBackoff는 attempts번 실패한 뒤의 재시도 대기 시간입니다
+func (d *Dispatcher) retryBackoff(attempts int) time.Duration {
+	backoff := d.config.Backoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.config.MaxBackoff {
+			return d.config.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// Sign은 웹훅 페이로드 서명(X-Webhook-Signature 값)을 생성합니다: sha256=<hex(HMAC-SHA256(secret, "<timestamp>.<body>"))>
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
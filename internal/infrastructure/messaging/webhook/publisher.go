@@ -0,0 +1,236 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const defaultRefreshInterval = 30 * time.Second
+
+// SubscriptionLister는 웹훅 구독 목록을 조회합니다 (repository.WebhookSubscriptionRepository가 구현)
+type SubscriptionLister interface {
+	List(ctx context.Context) ([]*entity.WebhookSubscription, error)
+}
+
+// DeliveryQueue는 전송 대기 기록을 저장합니다 (repository.WebhookDeliveryRepository가 구현)
+type DeliveryQueue interface {
+	Enqueue(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+}
+
+// PublisherConfig는 웹훅 발행자 설정입니다
+type PublisherConfig struct {
+	// RefreshInterval은 구독 목록 캐시 갱신 주기입니다 (기본 30초, 다른 인스턴스의 구독 변경 반영)
+	RefreshInterval time.Duration
+}
+
+// Publisher는 CDC 이벤트를 일치하는 웹훅 구독의 전송 큐에 넣는 CDCPublisher 래퍼입니다
+// 전송은 Dispatcher가 비동기로 재시도하므로 쓰기 요청은 콜백 응답을 기다리지 않습니다
+type Publisher struct {
+	next   messaging.CDCPublisher // nil이면 웹훅만 발행합니다
+	subs   SubscriptionLister
+	queue  DeliveryQueue
+	config PublisherConfig
+
+	origin string
+
+	mu       sync.RWMutex
+	cached   []*entity.WebhookSubscription
+	loadedAt time.Time
+}
+
+// NewPublisher는 새로운 웹훅 발행자를 생성합니다
+func NewPublisher(next messaging.CDCPublisher, subs SubscriptionLister, queue DeliveryQueue, config PublisherConfig) *Publisher {
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+	return &Publisher{next: next, subs: subs, queue: queue, config: config}
+}
+
+// SetOrigin은 인스턴스 ID를 설정합니다 (웹훅 페이로드 메타데이터에도 기록)
+func (p *Publisher) SetOrigin(origin string) {
+	p.origin = origin
+	if p.next != nil {
+		p.next.SetOrigin(origin)
+	}
+}
+
+// SetEncoder는 내부 발행자에 메시지 형식을 설정합니다 (웹훅 페이로드는 항상 native JSON)
+func (p *Publisher) SetEncoder(encoder messaging.EventEncoder) {
+	if p.next != nil {
+		p.next.SetEncoder(encoder)
+	}
+}
+
+// Invalidate는 구독 목록 캐시를 비워 다음 이벤트에서 다시 읽도록 합니다
+func (p *Publisher) Invalidate() {
+	p.mu.Lock()
+	p.loadedAt = time.Time{}
+	p.mu.Unlock()
+}
+
+// PublishDocumentCreated는 문서 생성 이벤트를 발행합니다
+func (p *Publisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	var err error
+	if p.next != nil {
+		err = p.next.PublishDocumentCreated(ctx, docID, collection, data, version)
+	}
+	event := &messaging.DocumentCreatedEvent{
+		DocumentEvent: p.documentEvent(messaging.EventDocumentCreated, docID, collection, data, version),
+	}
+	return p.enqueue(ctx, err, &event.DocumentEvent, event)
+}
+
+// PublishDocumentUpdated는 문서 업데이트 이벤트를 발행합니다
+func (p *Publisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	var err error
+	if p.next != nil {
+		err = p.next.PublishDocumentUpdated(ctx, docID, collection, data, version, previousVersion, changes)
+	}
+	event := &messaging.DocumentUpdatedEvent{
+		DocumentEvent:   p.documentEvent(messaging.EventDocumentUpdated, docID, collection, data, version),
+		PreviousVersion: previousVersion,
+		Changes:         changes,
+	}
+	return p.enqueue(ctx, err, &event.DocumentEvent, event)
+}
+
+// PublishDocumentDeleted는 문서 삭제 이벤트를 발행합니다
+func (p *Publisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	var err error
+	if p.next != nil {
+		err = p.next.PublishDocumentDeleted(ctx, docID, collection, version)
+	}
+	event := &messaging.DocumentDeletedEvent{
+		DocumentEvent: p.documentEvent(messaging.EventDocumentDeleted, docID, collection, nil, version),
+	}
+	event.DeletedAt = event.Timestamp
+	return p.enqueue(ctx, err, &event.DocumentEvent, event)
+}
+
+func (p *Publisher) documentEvent(eventType, docID, collection string, data map[string]interface{}, version int) messaging.DocumentEvent {
+	now := time.Now()
+	return messaging.DocumentEvent{
+		EventID:    fmt.Sprintf("%s-%d", docID, now.UnixNano()),
+		EventType:  eventType,
+		Timestamp:  now,
+		DocumentID: docID,
+		Collection: collection,
+		Data:       data,
+		Version:    version,
+		Metadata:   messaging.OriginMetadata(p.origin),
+	}
+}
+
+// enqueue는 이벤트와 일치하는 구독마다 전송 대기 기록을 저장합니다
+// 내부 발행자가 실패해도 웹훅 전송은 독립적으로 진행하며, 내부 발행자의 에러를 우선 반환합니다
+func (p *Publisher) enqueue(ctx context.Context, publishErr error, meta *messaging.DocumentEvent, event interface{}) error {
+	subs, err := p.subscriptions(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to load webhook subscriptions", zap.Error(err))
+		if publishErr != nil {
+			return publishErr
+		}
+		return err
+	}
+
+	var deliveries []*entity.WebhookDelivery
+	var payload []byte
+	for _, sub := range subs {
+		if !Matches(sub, meta.EventType, meta.Collection, meta.Data) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				return fmt.Errorf("failed to marshal webhook payload: %w", err)
+			}
+		}
+		deliveries = append(deliveries, &entity.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        meta.EventID,
+			EventType:      meta.EventType,
+			Collection:     meta.Collection,
+			DocumentID:     meta.DocumentID,
+			Payload:        string(payload),
+			Status:         entity.WebhookDeliveryPending,
+			NextAttemptAt:  meta.Timestamp,
+			CreatedAt:      meta.Timestamp,
+		})
+	}
+	if len(deliveries) == 0 {
+		return publishErr
+	}
+
+	// 요청이 취소되었더라도 이미 커밋된 변경의 웹훅은 보내야 하므로 취소를 분리합니다
+	if err := p.queue.Enqueue(context.WithoutCancel(ctx), deliveries); err != nil {
+		logger.Error(ctx, "failed to enqueue webhook deliveries",
+			zap.String("event_type", meta.EventType),
+			zap.String("document_id", meta.DocumentID),
+			logger.Collection(meta.Collection),
+			zap.Error(err),
+		)
+		if publishErr != nil {
+			return publishErr
+		}
+		return err
+	}
+	return publishErr
+}
+
+// subscriptions는 캐시된 구독 목록을 반환하고, 갱신 주기가 지났으면 다시 읽습니다
+func (p *Publisher) subscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	p.mu.RLock()
+	if time.Since(p.loadedAt) < p.config.RefreshInterval {
+		subs := p.cached
+		p.mu.RUnlock()
+		return subs, nil
+	}
+	p.mu.RUnlock()
+
+	subs, err := p.subs.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.cached = subs
+	p.loadedAt = time.Now()
+	p.mu.Unlock()
+	return subs, nil
+}
+
+// Matches는 이벤트가 구독 조건(활성 상태, 컬렉션 패턴, 이벤트 타입, 데이터 필터)과 일치하는지 확인합니다
+// 삭제 이벤트에는 데이터가 없으므로 필터를 적용하지 않습니다
+func Matches(sub *entity.WebhookSubscription, eventType, collection string, data map[string]interface{}) bool {
+	if !sub.Active {
+		return false
+	}
+	if ok, _ := path.Match(sub.Collection, collection); !ok {
+		return false
+	}
+	if len(sub.EventTypes) > 0 {
+		found := false
+		for _, t := range sub.EventTypes {
+			if t == eventType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(sub.Filter) > 0 && eventType != messaging.EventDocumentDeleted {
+		return auth.MatchesConditions(data, sub.Filter)
+	}
+	return true
+}
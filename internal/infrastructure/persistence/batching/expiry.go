@@ -0,0 +1,28 @@
+package batching
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnableExpiry는 감싼 저장소의 만료 정리를 준비합니다
+func (r *Repository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	expirer, ok := r.DocumentRepository.(repository.DocumentExpirer)
+	if !ok {
+		return false, fmt.Errorf("repository does not support document expiry")
+	}
+	return expirer.EnableExpiry(ctx, collection)
+}
+
+// DeleteExpired는 감싼 저장소에서 만료 문서를 삭제합니다
+// 아직 배치에 남아 있는 문서는 저장된 뒤 다음 정리에서 삭제됩니다
+func (r *Repository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	expirer, ok := r.DocumentRepository.(repository.DocumentExpirer)
+	if !ok {
+		return 0, fmt.Errorf("repository does not support document expiry")
+	}
+	return expirer.DeleteExpired(ctx, collection, before, limit)
+}
@@ -0,0 +1,26 @@
+package batching
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// MaintenanceOperations는 감싼 저장소가 지원하는 유지보수 작업을 반환합니다
+func (r *Repository) MaintenanceOperations() []string {
+	maintainer, ok := r.DocumentRepository.(repository.CollectionMaintainer)
+	if !ok {
+		return nil
+	}
+	return maintainer.MaintenanceOperations()
+}
+
+// RunMaintenance는 감싼 저장소에서 컬렉션 유지보수 작업을 실행합니다
+// 아직 배치에 남아 있는 문서는 작업 대상에 포함되지 않습니다
+func (r *Repository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	maintainer, ok := r.DocumentRepository.(repository.CollectionMaintainer)
+	if !ok {
+		return nil, repository.ErrUnsupportedMaintenance
+	}
+	return maintainer.RunMaintenance(ctx, collection, operation, opts)
+}
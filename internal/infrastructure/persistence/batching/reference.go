@@ -0,0 +1,18 @@
+package batching
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// FindReferencing은 감싼 저장소에서 data의 field 값이 id인 문서의 ID를 조회합니다
+// 아직 배치에 남아 있는 문서는 조회되지 않습니다
+func (r *Repository) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	finder, ok := r.DocumentRepository.(repository.ReferenceFinder)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support references")
+	}
+	return finder.FindReferencing(ctx, collection, field, id, limit)
+}
@@ -0,0 +1,216 @@
+// Package batching은 단건 Save를 잠시 모아 SaveMany 배치로 저장하는 DocumentRepository 래퍼를 제공합니다
+//
+// 이벤트처럼 작은 문서가 대량으로 들어오는 컬렉션에서 몇 밀리초의 지연을 감수하고
+// 왕복 횟수를 줄여 처리량을 높이기 위한 선택적(opt-in) 저장 모드입니다
+package batching
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxDelay     = 5 * time.Millisecond
+	defaultMaxBatch     = 500
+	defaultFlushTimeout = 10 * time.Second
+)
+
+// Config는 쓰기 배치 설정입니다
+type Config struct {
+	// Collections는 배치로 저장할 컬렉션 목록입니다 (목록에 없는 컬렉션은 바로 저장)
+	Collections []string
+
+	// MaxDelay는 첫 문서가 들어온 뒤 배치를 저장하기까지 기다리는 최대 시간입니다 (0이면 5ms)
+	MaxDelay time.Duration
+
+	// MaxBatch는 배치 하나에 담는 최대 문서 수이며, 이 수가 차면 바로 저장합니다 (0이면 500)
+	MaxBatch int
+
+	// FlushTimeout은 배치 저장 한 번의 시간 한도입니다 (0이면 10s)
+	FlushTimeout time.Duration
+}
+
+// Repository는 지정한 컬렉션의 Save를 컬렉션별로 모아 SaveMany로 저장하는 DocumentRepository입니다
+// Save는 문서가 담긴 배치가 저장될 때까지 기다렸다가 배치의 결과를 반환하므로 호출자에게는 동기 저장처럼 보입니다
+// 배치 저장이 실패하면 그 배치에 담긴 모든 Save가 같은 에러를 받습니다
+// 나머지 작업은 감싼 저장소로 그대로 전달됩니다
+type Repository struct {
+	repository.DocumentRepository
+
+	collections  map[string]bool
+	maxDelay     time.Duration
+	maxBatch     int
+	flushTimeout time.Duration
+
+	mu      sync.Mutex
+	buffers map[string]*buffer
+	closed  bool
+	flushes sync.WaitGroup
+}
+
+// buffer는 컬렉션 하나에 모인 저장 대기 문서입니다
+type buffer struct {
+	items []pending
+	timer *time.Timer
+}
+
+// pending은 배치 저장을 기다리는 문서와 결과를 받을 채널입니다
+type pending struct {
+	doc  *entity.Document
+	done chan error
+}
+
+// NewRepository는 repo를 감싸는 쓰기 배치 저장소를 생성합니다
+func NewRepository(repo repository.DocumentRepository, cfg Config) *Repository {
+	collections := make(map[string]bool, len(cfg.Collections))
+	for _, name := range cfg.Collections {
+		collections[name] = true
+	}
+	r := &Repository{
+		DocumentRepository: repo,
+		collections:        collections,
+		maxDelay:           cfg.MaxDelay,
+		maxBatch:           cfg.MaxBatch,
+		flushTimeout:       cfg.FlushTimeout,
+		buffers:            make(map[string]*buffer),
+	}
+	if r.maxDelay <= 0 {
+		r.maxDelay = defaultMaxDelay
+	}
+	if r.maxBatch <= 0 {
+		r.maxBatch = defaultMaxBatch
+	}
+	if r.flushTimeout <= 0 {
+		r.flushTimeout = defaultFlushTimeout
+	}
+	return r
+}
+
+// Save는 배치 대상 컬렉션의 문서를 버퍼에 담고 배치가 저장될 때까지 기다립니다
+// ctx가 먼저 취소되면 ctx 에러를 반환하지만, 이미 버퍼에 담긴 문서는 배치와 함께 저장될 수 있습니다
+func (r *Repository) Save(ctx context.Context, doc *entity.Document) error {
+	collection := doc.Collection()
+	if !r.collections[collection] {
+		return r.DocumentRepository.Save(ctx, doc)
+	}
+
+	done := make(chan error, 1)
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return r.DocumentRepository.Save(ctx, doc)
+	}
+	b, ok := r.buffers[collection]
+	if !ok {
+		b = &buffer{}
+		r.buffers[collection] = b
+	}
+	b.items = append(b.items, pending{doc: doc, done: done})
+	switch {
+	case len(b.items) >= r.maxBatch:
+		items := r.takeLocked(collection)
+		r.mu.Unlock()
+		go r.flush(collection, items)
+	case len(b.items) == 1:
+		b.timer = time.AfterFunc(r.maxDelay, func() { r.flushCollection(collection) })
+		r.mu.Unlock()
+	default:
+		r.mu.Unlock()
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush는 모든 컬렉션의 대기 문서를 바로 저장하고, 진행 중인 배치 저장이 끝날 때까지 기다립니다
+func (r *Repository) Flush() {
+	r.mu.Lock()
+	batches := make(map[string][]pending, len(r.buffers))
+	for collection := range r.buffers {
+		batches[collection] = r.takeLocked(collection)
+	}
+	r.mu.Unlock()
+
+	for collection, items := range batches {
+		r.flush(collection, items)
+	}
+	r.flushes.Wait()
+}
+
+// Close는 배치 모드를 끝내고 대기 문서를 모두 저장합니다 (종료 시 호출)
+// Close 이후의 Save는 배치 없이 바로 저장됩니다
+func (r *Repository) Close() {
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+
+	r.Flush()
+}
+
+// flushCollection은 MaxDelay가 지난 컬렉션의 배치를 저장합니다
+func (r *Repository) flushCollection(collection string) {
+	r.mu.Lock()
+	items := r.takeLocked(collection)
+	r.mu.Unlock()
+
+	r.flush(collection, items)
+}
+
+// takeLocked는 컬렉션의 대기 문서를 꺼내고 버퍼를 비웁니다 (r.mu를 잡은 상태에서 호출)
+// 꺼낸 배치는 저장이 끝날 때까지 flushes에 포함되어 Flush가 기다립니다
+func (r *Repository) takeLocked(collection string) []pending {
+	b, ok := r.buffers[collection]
+	if !ok {
+		return nil
+	}
+	delete(r.buffers, collection)
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+	if len(b.items) > 0 {
+		r.flushes.Add(1)
+	}
+	return b.items
+}
+
+// flush는 배치를 SaveMany로 저장하고 결과를 배치의 모든 Save에 전달합니다
+// 배치는 여러 요청의 문서를 담으므로 요청 컨텍스트와 분리된 컨텍스트로 저장합니다
+func (r *Repository) flush(collection string, items []pending) {
+	if len(items) == 0 {
+		return
+	}
+	defer r.flushes.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.flushTimeout)
+	defer cancel()
+
+	docs := make([]*entity.Document, len(items))
+	for i, item := range items {
+		docs[i] = item.doc
+	}
+
+	err := r.DocumentRepository.SaveMany(ctx, docs)
+	if err != nil {
+		logger.Warn(ctx, "batched save failed",
+			zap.String("collection", collection),
+			zap.Int("documents", len(docs)),
+			zap.Error(err),
+		)
+		err = fmt.Errorf("batched save of %d documents failed: %w", len(docs), err)
+	}
+	for _, item := range items {
+		item.done <- err
+	}
+}
@@ -0,0 +1,26 @@
+package batching
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// CollectionStats는 감싼 저장소의 컬렉션 통계를 반환합니다 (아직 배치에 남아 있는 문서는 포함되지 않음)
+func (r *Repository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	reader, ok := r.DocumentRepository.(repository.CollectionStatsReader)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support collection stats")
+	}
+	return reader.CollectionStats(ctx, collection)
+}
+
+// DatabaseStats는 감싼 저장소의 데이터베이스 통계를 반환합니다
+func (r *Repository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	reader, ok := r.DocumentRepository.(repository.DatabaseStatsReader)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support database stats")
+	}
+	return reader.DatabaseStats(ctx)
+}
@@ -0,0 +1,28 @@
+package batching
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnsureUniqueKey는 감싼 저장소에 고유 제약을 준비합니다
+// 배치로 모아 저장하는 컬렉션은 배치 중 한 문서의 중복 키 위반으로 같은 배치의 저장이 모두 실패할 수 있습니다
+func (r *Repository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	enforcer, ok := r.DocumentRepository.(repository.UniqueKeyEnforcer)
+	if !ok {
+		return false, fmt.Errorf("repository does not support unique keys")
+	}
+	return enforcer.EnsureUniqueKey(ctx, collection, fields)
+}
+
+// FindByUniqueKey는 감싼 저장소에서 key와 같은 값을 가진 문서의 ID를 조회합니다
+// 아직 배치에 남아 있는 문서는 조회되지 않습니다
+func (r *Repository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	enforcer, ok := r.DocumentRepository.(repository.UniqueKeyEnforcer)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support unique keys")
+	}
+	return enforcer.FindByUniqueKey(ctx, collection, key, limit)
+}
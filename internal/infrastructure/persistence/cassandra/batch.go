@@ -0,0 +1,187 @@
+package cassandra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/gocql/gocql"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultBatchSize는 unlogged 배치 하나에 담는 기본 문장 수입니다
+	defaultBatchSize = 50
+
+	// defaultBatchConcurrency는 동시에 실행하는 기본 배치 수입니다
+	defaultBatchConcurrency = 16
+)
+
+// BatchConfig는 SaveMany의 배치 설정입니다
+type BatchConfig struct {
+	Size        int // 같은 파티션의 문장을 배치 하나에 담는 최대 수 (0이면 50)
+	Concurrency int // 동시에 실행하는 배치 수 (0이면 16)
+}
+
+// SetBatchConfig는 SaveMany의 배치 설정을 지정합니다
+func (r *CassandraRepository) SetBatchConfig(cfg BatchConfig) {
+	r.batchConfig = cfg
+}
+
+// BatchFailure는 실패한 배치입니다
+type BatchFailure struct {
+	IDs []string // 배치에 담긴 문서 ID
+	Err error
+}
+
+// BatchError는 SaveMany에서 일부 배치가 실패했을 때의 에러입니다
+// 배치는 서로 독립적으로 실행되므로 실패하지 않은 배치의 문서는 저장되어 있습니다
+type BatchError struct {
+	Total    int // 전체 배치 수
+	Failures []BatchFailure
+}
+
+// Error는 실패한 배치 수와 첫 번째 원인을 반환합니다
+func (e *BatchError) Error() string {
+	first := e.Failures[0]
+	return fmt.Sprintf("%d of %d batches failed (first: ids=%s: %v)",
+		len(e.Failures), e.Total, strings.Join(first.IDs, ","), first.Err)
+}
+
+// Unwrap은 배치별 에러를 반환합니다 (errors.Is/As 지원)
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// partitionBatch는 같은 파티션 키를 가진 문서 묶음입니다
+type partitionBatch struct {
+	docs []*entity.Document
+}
+
+// groupByPartition은 문서를 파티션 키(id)별로 묶고 Size 단위로 나눕니다
+// 여러 파티션을 한 배치에 담으면 코디네이터가 다른 노드로 다시 전달해야 하므로
+// 배치는 항상 한 파티션만 담아 토큰 인식 라우팅으로 복제본 노드에 바로 보냅니다
+// 현재 테이블은 id가 파티션 키이므로 대부분 문서 하나가 배치 하나가 되어 단일 문장으로 동시에 실행됩니다
+func groupByPartition(docs []*entity.Document, size int) []partitionBatch {
+	order := make([]string, 0, len(docs))
+	groups := make(map[string][]*entity.Document, len(docs))
+	for _, doc := range docs {
+		if _, ok := groups[doc.ID()]; !ok {
+			order = append(order, doc.ID())
+		}
+		groups[doc.ID()] = append(groups[doc.ID()], doc)
+	}
+
+	batches := make([]partitionBatch, 0, len(order))
+	for _, key := range order {
+		group := groups[key]
+		for start := 0; start < len(group); start += size {
+			end := start + size
+			if end > len(group) {
+				end = len(group)
+			}
+			batches = append(batches, partitionBatch{docs: group[start:end]})
+		}
+	}
+	return batches
+}
+
+// executeBatches는 파티션별 unlogged 배치를 동시 실행 수 제한 안에서 실행하고 실패한 배치를 모읍니다
+func (r *CassandraRepository) executeBatches(ctx context.Context, collection string, docs []*entity.Document) error {
+	size := r.batchConfig.Size
+	if size <= 0 {
+		size = defaultBatchSize
+	}
+	concurrency := r.batchConfig.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	queryStr := fmt.Sprintf(`
+		INSERT INTO %s.%s (id, data, created_at, updated_at, version, metadata)
+		VALUES (?, ?, ?, ?, ?, ?)
+		USING TTL ?
+	`, r.keyspace, collection)
+
+	batches := groupByPartition(docs, size)
+
+	var (
+		mu       sync.Mutex
+		failures []BatchFailure
+		g        errgroup.Group
+	)
+	g.SetLimit(concurrency)
+
+	for _, pb := range batches {
+		g.Go(func() error {
+			if err := r.executeBatch(ctx, queryStr, pb.docs); err != nil {
+				ids := make([]string, len(pb.docs))
+				for i, doc := range pb.docs {
+					ids[i] = doc.ID()
+				}
+				mu.Lock()
+				failures = append(failures, BatchFailure{IDs: ids, Err: err})
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if len(failures) > 0 {
+		return &BatchError{Total: len(batches), Failures: failures}
+	}
+	return nil
+}
+
+// executeBatch는 한 파티션의 문서를 저장합니다 (한 건이면 배치 없이 단일 문장으로 실행)
+func (r *CassandraRepository) executeBatch(ctx context.Context, queryStr string, docs []*entity.Document) error {
+	if len(docs) == 1 {
+		args, err := insertArgs(docs[0])
+		if err != nil {
+			return err
+		}
+		return r.session.Query(queryStr, args...).WithContext(ctx).Exec()
+	}
+
+	batch := r.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, doc := range docs {
+		args, err := insertArgs(doc)
+		if err != nil {
+			return err
+		}
+		batch.Query(queryStr, args...)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+// insertArgs는 INSERT 문의 바인드 인자를 만듭니다
+func insertArgs(doc *entity.Document) ([]interface{}, error) {
+	dataJSON, err := json.Marshal(doc.Data())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	metadataJSON, err := marshalMetadata(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		doc.ID(),
+		string(dataJSON),
+		doc.CreatedAt(),
+		doc.UpdatedAt(),
+		doc.Version(),
+		metadataJSON,
+		expiryTTL(doc.ExpiresAt(), time.Now()),
+	}, nil
+}
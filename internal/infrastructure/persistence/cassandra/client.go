@@ -25,6 +25,12 @@ type Config struct {
 
 	// Retry Policy
 	MaxRetries int
+
+	// LocalDC가 설정되면 토큰 인식 라우팅의 대체 정책으로 해당 데이터센터 노드를 우선합니다
+	LocalDC string
+
+	// PoolObserver는 연결 시도를 관찰해 연결 풀 상태를 집계합니다 (nil이면 관찰하지 않음)
+	PoolObserver *PoolObserver
 }
 
 // NewClient는 Cassandra 클라이언트를 생성합니다
@@ -79,6 +85,22 @@ func NewClient(ctx context.Context, config *Config) (*gocql.Session, error) {
 		cluster.ReconnectInterval = 10 * time.Second // 기본값
 	}
 
+	// Host Selection: 파티션 키의 토큰으로 복제본 노드에 바로 요청합니다 (코디네이터 재전달 방지)
+	fallback := gocql.RoundRobinHostPolicy()
+	if config.LocalDC != "" {
+		fallback = gocql.DCAwareRoundRobinPolicy(config.LocalDC)
+	}
+	cluster.PoolConfig.HostSelectionPolicy = gocql.TokenAwareHostPolicy(fallback, gocql.ShuffleReplicas())
+
+	// Pool Observer
+	if config.PoolObserver != nil {
+		config.PoolObserver.maxOpen = len(config.Hosts) * cluster.NumConns
+		cluster.ConnectObserver = config.PoolObserver
+	}
+
+	// 장애 조치 후 응답 없이 끊긴(half-open) 소켓을 TCP keepalive로 감지합니다
+	cluster.SocketKeepalive = 30 * time.Second
+
 	// Protocol Version
 	cluster.ProtoVersion = 4
 
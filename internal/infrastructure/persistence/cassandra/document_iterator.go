@@ -0,0 +1,96 @@
+package cassandra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/gocql/gocql"
+)
+
+// streamPageSize는 스트리밍 조회에서 한 번에 가져오는 행 수입니다 (gocql 자동 페이징)
+const streamPageSize = 500
+
+// FindStream은 옵션을 사용하여 문서를 조회하고 결과를 페이지 단위로 가져오는 커서로 반환합니다
+// Cassandra는 OFFSET을 지원하지 않으므로 Skip만큼의 행은 커서에서 건너뜁니다
+func (r *CassandraRepository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	if opts == nil {
+		opts = &repository.FindOptions{}
+	}
+	whereClause, args := r.buildWhereClause(filter)
+
+	// 건너뛸 행까지 포함해야 Skip 이후 Limit개를 반환할 수 있습니다
+	limit := opts.Limit
+	if limit > 0 && opts.Skip > 0 {
+		limit += opts.Skip
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, data, created_at, updated_at, version, metadata
+		FROM %s.%s
+		%s
+		%s
+		%s
+		ALLOW FILTERING
+	`, r.keyspace, collection,
+		whereClause,
+		r.buildOrderBy(opts.Sort),
+		r.buildLimit(limit),
+	)
+
+	iter := r.session.Query(query, args...).WithContext(ctx).PageSize(streamPageSize).Iter()
+
+	return &documentIterator{iter: iter, collection: collection, skip: opts.Skip}, nil
+}
+
+// documentIterator는 gocql.Iter를 DocumentIterator로 감쌉니다
+type documentIterator struct {
+	iter       *gocql.Iter
+	collection string
+	skip       int64
+	err        error
+
+	id, dataStr, metadataStr string
+	createdAt, updatedAt     time.Time
+	version                  int
+}
+
+func (it *documentIterator) Next(ctx context.Context) bool {
+	for ; it.skip > 0; it.skip-- {
+		if !it.scan(ctx) {
+			return false
+		}
+	}
+	return it.scan(ctx)
+}
+
+// scan은 다음 행을 읽으며, 행이 없으면 iterator를 닫고 에러를 기록합니다
+func (it *documentIterator) scan(ctx context.Context) bool {
+	if it.err != nil || ctx.Err() != nil {
+		return false
+	}
+	if it.iter.Scan(&it.id, &it.dataStr, &it.createdAt, &it.updatedAt, &it.version, &it.metadataStr) {
+		return true
+	}
+	if err := it.iter.Close(); err != nil {
+		it.err = fmt.Errorf("iterator error: %w", err)
+	}
+	return false
+}
+
+func (it *documentIterator) Decode() (*entity.Document, error) {
+	return decodeDocument(it.id, it.collection, it.dataStr, it.metadataStr, it.createdAt, it.updatedAt, it.version)
+}
+
+func (it *documentIterator) Err() error {
+	return it.err
+}
+
+func (it *documentIterator) Close(ctx context.Context) error {
+	if err := it.iter.Close(); err != nil && it.err == nil {
+		return fmt.Errorf("iterator error: %w", err)
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ import (
 type CassandraRepository struct {
 	session *gocql.Session
 	keyspace string
+
+	batchConfig BatchConfig // SaveMany의 파티션별 배치 설정
 }
 
 // NewCassandraRepository는 Cassandra 저장소를 생성합니다
@@ -49,75 +51,51 @@ func (r *CassandraRepository) ensureTableExists(ctx context.Context, collection
 
 // Save는 문서를 저장합니다
 func (r *CassandraRepository) Save(ctx context.Context, doc *entity.Document) error {
-	if err := r.ensureTableExists(ctx, doc.Collection); err != nil {
+	if err := r.ensureTableExists(ctx, doc.Collection()); err != nil {
 		return fmt.Errorf("failed to ensure table exists: %w", err)
 	}
 
-	dataJSON, err := json.Marshal(doc.Data)
+	dataJSON, err := json.Marshal(doc.Data())
 	if err != nil {
 		return fmt.Errorf("failed to marshal data: %w", err)
 	}
 
-	metadataJSON, err := json.Marshal(doc.Metadata)
+	metadataJSON, err := marshalMetadata(doc)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	query := fmt.Sprintf(`
 		INSERT INTO %s.%s (id, data, created_at, updated_at, version, metadata)
 		VALUES (?, ?, ?, ?, ?, ?)
-	`, r.keyspace, doc.Collection)
+		USING TTL ?
+	`, r.keyspace, doc.Collection())
 
 	return r.session.Query(query,
-		doc.ID,
+		doc.ID(),
 		string(dataJSON),
-		doc.CreatedAt,
-		doc.UpdatedAt,
-		doc.Version,
-		string(metadataJSON),
+		doc.CreatedAt(),
+		doc.UpdatedAt(),
+		doc.Version(),
+		metadataJSON,
+		expiryTTL(doc.ExpiresAt(), time.Now()),
 	).WithContext(ctx).Exec()
 }
 
-// SaveMany는 여러 문서를 한 번에 저장합니다 (Batch)
+// SaveMany는 여러 문서를 파티션별 unlogged 배치로 나누어 동시에 저장합니다
+// 여러 파티션을 담은 logged 배치는 코디네이터와 배치 로그에 부하가 몰리므로 사용하지 않습니다
+// 일부 배치가 실패하면 나머지 배치는 저장된 상태로 실패한 배치 목록을 담은 *BatchError를 반환합니다
 func (r *CassandraRepository) SaveMany(ctx context.Context, docs []*entity.Document) error {
 	if len(docs) == 0 {
 		return nil
 	}
 
-	collection := docs[0].Collection
+	collection := docs[0].Collection()
 	if err := r.ensureTableExists(ctx, collection); err != nil {
 		return fmt.Errorf("failed to ensure table exists: %w", err)
 	}
 
-	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
-
-	queryStr := fmt.Sprintf(`
-		INSERT INTO %s.%s (id, data, created_at, updated_at, version, metadata)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, r.keyspace, collection)
-
-	for _, doc := range docs {
-		dataJSON, err := json.Marshal(doc.Data)
-		if err != nil {
-			return fmt.Errorf("failed to marshal data: %w", err)
-		}
-
-		metadataJSON, err := json.Marshal(doc.Metadata)
-		if err != nil {
-			return fmt.Errorf("failed to marshal metadata: %w", err)
-		}
-
-		batch.Query(queryStr,
-			doc.ID,
-			string(dataJSON),
-			doc.CreatedAt,
-			doc.UpdatedAt,
-			doc.Version,
-			string(metadataJSON),
-		)
-	}
-
-	return r.session.ExecuteBatch(batch)
+	return r.executeBatches(ctx, collection, docs)
 }
 
 // FindByID는 ID로 문서를 조회합니다
@@ -128,15 +106,16 @@ func (r *CassandraRepository) FindByID(ctx context.Context, collection, id strin
 		WHERE id = ?
 	`, r.keyspace, collection)
 
-	var doc entity.Document
-	var dataStr, metadataStr string
+	var docID, dataStr, metadataStr string
+	var createdAt, updatedAt time.Time
+	var version int
 
 	err := r.session.Query(query, id).WithContext(ctx).Scan(
-		&doc.ID,
+		&docID,
 		&dataStr,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
+		&createdAt,
+		&updatedAt,
+		&version,
 		&metadataStr,
 	)
 	if err == gocql.ErrNotFound {
@@ -146,17 +125,7 @@ func (r *CassandraRepository) FindByID(ctx context.Context, collection, id strin
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	doc.Collection = collection
-
-	if err := json.Unmarshal([]byte(dataStr), &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-
-	if err := json.Unmarshal([]byte(metadataStr), &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
-	return &doc, nil
+	return decodeDocument(docID, collection, dataStr, metadataStr, createdAt, updatedAt, version)
 }
 
 // FindAll은 컬렉션의 모든 문서를 조회합니다
@@ -216,32 +185,36 @@ func (r *CassandraRepository) FindWithOptions(ctx context.Context, collection st
 
 // Update는 문서를 업데이트합니다
 func (r *CassandraRepository) Update(ctx context.Context, doc *entity.Document) error {
-	dataJSON, err := json.Marshal(doc.Data)
+	dataJSON, err := json.Marshal(doc.Data())
 	if err != nil {
 		return fmt.Errorf("failed to marshal data: %w", err)
 	}
 
-	metadataJSON, err := json.Marshal(doc.Metadata)
+	metadataJSON, err := marshalMetadata(doc)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	// Cassandra에서는 LWT (Lightweight Transaction) 사용
+	// 낙관적 잠금: doc.Update가 올린 버전의 직전 버전인 행만 갱신합니다
 	query := fmt.Sprintf(`
 		UPDATE %s.%s
+		USING TTL ?
 		SET data = ?, updated_at = ?, version = ?, metadata = ?
 		WHERE id = ?
 		IF version = ?
-	`, r.keyspace, doc.Collection)
+	`, r.keyspace, doc.Collection())
 
+	var currentVersion int
 	applied, err := r.session.Query(query,
+		expiryTTL(doc.ExpiresAt(), time.Now()),
 		string(dataJSON),
-		time.Now(),
-		doc.Version+1,
-		string(metadataJSON),
-		doc.ID,
-		doc.Version,
-	).WithContext(ctx).ScanCAS(&doc.Version) // CAS = Compare And Swap
+		doc.UpdatedAt(),
+		doc.Version(),
+		metadataJSON,
+		doc.ID(),
+		doc.Version()-1,
+	).WithContext(ctx).ScanCAS(&currentVersion) // CAS = Compare And Swap
 
 	if err != nil {
 		return fmt.Errorf("failed to update document: %w", err)
@@ -251,9 +224,6 @@ func (r *CassandraRepository) Update(ctx context.Context, doc *entity.Document)
 		return errors.New("optimistic lock error: document was modified by another process")
 	}
 
-	doc.Version++
-	doc.UpdatedAt = time.Now()
-
 	return nil
 }
 
@@ -268,8 +238,12 @@ func (r *CassandraRepository) UpdateMany(ctx context.Context, collection string,
 
 	var count int64
 	for _, doc := range docs {
+		data := doc.Data()
 		for key, value := range update {
-			doc.Data[key] = value
+			data[key] = value
+		}
+		if err := doc.Update(data); err != nil {
+			continue
 		}
 
 		if err := r.Update(ctx, doc); err != nil {
@@ -284,26 +258,28 @@ func (r *CassandraRepository) UpdateMany(ctx context.Context, collection string,
 
 // Replace는 문서를 교체합니다
 func (r *CassandraRepository) Replace(ctx context.Context, collection, id string, replacement *entity.Document) error {
-	dataJSON, err := json.Marshal(replacement.Data)
+	dataJSON, err := json.Marshal(replacement.Data())
 	if err != nil {
 		return fmt.Errorf("failed to marshal data: %w", err)
 	}
 
-	metadataJSON, err := json.Marshal(replacement.Metadata)
+	metadataJSON, err := marshalMetadata(replacement)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	query := fmt.Sprintf(`
 		UPDATE %s.%s
+		USING TTL ?
 		SET data = ?, updated_at = ?, version = version + 1, metadata = ?
 		WHERE id = ?
 	`, r.keyspace, collection)
 
 	return r.session.Query(query,
+		expiryTTL(replacement.ExpiresAt(), time.Now()),
 		string(dataJSON),
 		time.Now(),
-		string(metadataJSON),
+		metadataJSON,
 		id,
 	).WithContext(ctx).Exec()
 }
@@ -332,7 +308,7 @@ func (r *CassandraRepository) DeleteMany(ctx context.Context, collection string,
 	`, r.keyspace, collection)
 
 	for _, doc := range docs {
-		batch.Query(queryStr, doc.ID)
+		batch.Query(queryStr, doc.ID())
 	}
 
 	if err := r.session.ExecuteBatch(batch); err != nil {
@@ -353,8 +329,12 @@ func (r *CassandraRepository) FindAndUpdate(ctx context.Context, collection, id
 	}
 
 	// 업데이트 적용
+	data := doc.Data()
 	for key, value := range update {
-		doc.Data[key] = value
+		data[key] = value
+	}
+	if err := doc.Update(data); err != nil {
+		return nil, err
 	}
 
 	// 업데이트
@@ -478,22 +458,10 @@ func (r *CassandraRepository) scanDocuments(iter *gocql.Iter, collection string)
 	var version int
 
 	for iter.Scan(&id, &dataStr, &createdAt, &updatedAt, &version, &metadataStr) {
-		doc := &entity.Document{
-			ID:         id,
-			Collection: collection,
-			CreatedAt:  createdAt,
-			UpdatedAt:  updatedAt,
-			Version:    version,
-		}
-
-		if err := json.Unmarshal([]byte(dataStr), &doc.Data); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-		}
-
-		if err := json.Unmarshal([]byte(metadataStr), &doc.Metadata); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+		doc, err := decodeDocument(id, collection, dataStr, metadataStr, createdAt, updatedAt, version)
+		if err != nil {
+			return nil, err
 		}
-
 		documents = append(documents, doc)
 	}
 
@@ -504,6 +472,36 @@ func (r *CassandraRepository) scanDocuments(iter *gocql.Iter, collection string)
 	return documents, nil
 }
 
+// decodeDocument는 (id, data, created_at, updated_at, version, metadata) 행 값을 문서로 변환합니다
+func decodeDocument(id, collection, dataStr, metadataStr string, createdAt, updatedAt time.Time, version int) (*entity.Document, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(dataStr), &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	var metadata map[string]interface{}
+	if metadataStr != "" {
+		if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+		}
+	}
+
+	doc := entity.ReconstructDocument(id, collection, data, version, createdAt, updatedAt)
+	if expiresAt, ok := entity.ParseExpiresAt(metadata); ok {
+		doc.SetExpiresAt(expiresAt)
+	}
+	return doc, nil
+}
+
+// marshalMetadata는 문서의 metadata 컬럼 값을 직렬화합니다 (만료 시각)
+func marshalMetadata(doc *entity.Document) (string, error) {
+	metadataJSON, err := json.Marshal(entity.ExpiryMetadata(doc.ExpiresAt()))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	return string(metadataJSON), nil
+}
+
 // ===== 집계 (Aggregation) =====
 
 // Aggregate는 집계 파이프라인을 실행합니다
@@ -560,14 +558,14 @@ func (r *CassandraRepository) BulkWrite(ctx context.Context, operations []*repos
 				return nil, fmt.Errorf("failed to ensure table exists: %w", err)
 			}
 
-			dataJSON, err := json.Marshal(op.Document.Data)
+			dataJSON, err := json.Marshal(op.Document.Data())
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal data: %w", err)
 			}
 
-			metadataJSON, err := json.Marshal(op.Document.Metadata)
+			metadataJSON, err := marshalMetadata(op.Document)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+				return nil, err
 			}
 
 			queryStr := fmt.Sprintf(`
@@ -576,12 +574,12 @@ func (r *CassandraRepository) BulkWrite(ctx context.Context, operations []*repos
 			`, r.keyspace, op.Collection)
 
 			batch.Query(queryStr,
-				op.Document.ID,
+				op.Document.ID(),
 				string(dataJSON),
-				op.Document.CreatedAt,
-				op.Document.UpdatedAt,
-				op.Document.Version,
-				string(metadataJSON),
+				op.Document.CreatedAt(),
+				op.Document.UpdatedAt(),
+				op.Document.Version(),
+				metadataJSON,
 			)
 
 			result.InsertedCount++
@@ -746,9 +744,15 @@ func (r *CassandraRepository) Watch(ctx context.Context, collection string, pipe
 // ===== 트랜잭션 (Transaction) =====
 
 // WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-func (r *CassandraRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+// 격리 수준은 적용되지 않고 opts의 Timeout만 적용됩니다
+func (r *CassandraRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
 	// Cassandra에서는 전통적인 트랜잭션이 제한적입니다
 	// Batch를 사용하거나 LWT (Lightweight Transactions) 사용
+	if opts != nil && opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
 	return fn(ctx)
 }
 
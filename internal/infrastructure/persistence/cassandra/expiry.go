@@ -0,0 +1,31 @@
+package cassandra
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// expiryTTL은 문서의 만료 시각을 쓰기 TTL(초)로 변환합니다
+// 만료가 없으면 0(TTL 없음)이며, 이미 지난 만료 시각은 즉시 사라지도록 1초로 기록합니다
+func expiryTTL(expiresAt, now time.Time) int {
+	if expiresAt.IsZero() {
+		return 0
+	}
+	ttl := math.Ceil(expiresAt.Sub(now).Seconds())
+	if ttl < 1 {
+		return 1
+	}
+	return int(ttl)
+}
+
+// EnableExpiry는 만료 문서를 Cassandra가 스스로 삭제하므로 준비할 것이 없습니다
+// 만료 시각이 있는 문서는 저장/수정 시 USING TTL로 기록되어 TTL이 지나면 읽히지 않고 compaction에서 제거됩니다
+func (r *CassandraRepository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	return true, nil
+}
+
+// DeleteExpired는 쓰기 TTL로 이미 만료가 처리되므로 아무것도 삭제하지 않습니다
+func (r *CassandraRepository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	return 0, nil
+}
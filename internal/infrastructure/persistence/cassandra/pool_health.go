@@ -0,0 +1,48 @@
+package cassandra
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/poolhealth"
+	"github.com/gocql/gocql"
+)
+
+// healthProbeQuery는 예열/검증에 사용하는 가벼운 조회입니다
+const healthProbeQuery = "SELECT release_version FROM system.local"
+
+// healthPool은 Cassandra 세션의 poolhealth.Pool 구현입니다
+// gocql은 세션 생성 시 호스트마다 NumConns개 연결을 맺고, 연결마다 heartbeat로 끊어진 연결을 닫고 다시 연결하므로
+// 예열은 조회로 호스트 선택과 연결 경로를 확인하고, 검증은 조회 성공 여부만 확인합니다
+type healthPool struct {
+	session *gocql.Session
+}
+
+// NewHealthPool은 Cassandra 세션의 예열/검증 풀을 생성합니다
+func NewHealthPool(session *gocql.Session) poolhealth.Pool {
+	return &healthPool{session: session}
+}
+
+// WarmUp은 조회 n개를 동시에 실행합니다 (라운드로빈으로 여러 호스트에 나뉩니다)
+func (p *healthPool) WarmUp(ctx context.Context, n int) error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = p.session.Query(healthProbeQuery).WithContext(ctx).Exec()
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Validate는 조회 하나로 세션 상태를 확인합니다
+func (p *healthPool) Validate(ctx context.Context, timeout time.Duration) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return 0, p.session.Query(healthProbeQuery).WithContext(ctx).Exec()
+}
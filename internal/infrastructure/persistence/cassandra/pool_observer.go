@@ -0,0 +1,40 @@
+package cassandra
+
+import (
+	"sync/atomic"
+
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/gocql/gocql"
+)
+
+// PoolObserver는 gocql 연결 시도를 관찰해 연결 풀 상태를 집계합니다
+// gocql은 호스트별 고정 크기 풀을 사용하고 사용 중/유휴 연결 수를 노출하지 않으므로
+// 최대 연결 수(호스트 수 × NumConns)와 연결 성공/실패 누적 값만 제공합니다
+type PoolObserver struct {
+	maxOpen       int
+	connects      atomic.Int64
+	connectErrors atomic.Int64
+}
+
+// NewPoolObserver는 새로운 PoolObserver를 생성합니다
+func NewPoolObserver() *PoolObserver {
+	return &PoolObserver{}
+}
+
+// ObserveConnect는 gocql.ConnectObserver 구현입니다
+func (o *PoolObserver) ObserveConnect(c gocql.ObservedConnect) {
+	if c.Err != nil {
+		o.connectErrors.Add(1)
+		return
+	}
+	o.connects.Add(1)
+}
+
+// Stats는 연결 풀 상태를 반환합니다 (Open은 성공한 연결 누적 수)
+func (o *PoolObserver) Stats() poolstats.Stats {
+	return poolstats.Stats{
+		MaxOpen:       o.maxOpen,
+		Open:          int(o.connects.Load()),
+		ConnectErrors: o.connectErrors.Load(),
+	}
+}
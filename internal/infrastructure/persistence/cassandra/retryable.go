@@ -0,0 +1,32 @@
+package cassandra
+
+import (
+	"errors"
+
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"github.com/gocql/gocql"
+)
+
+// IsRetryable은 Cassandra 에러가 재시도 가능한지 확인합니다
+// 복제본 부족, 읽기/쓰기 시간 초과, 코디네이터 과부하/부트스트랩, 연결 없음은 재시도하고
+// 문법 오류나 권한 오류처럼 다시 실행해도 같은 결과가 나오는 에러는 재시도하지 않습니다
+func IsRetryable(err error) bool {
+	if errors.Is(err, gocql.ErrNoConnections) || errors.Is(err, gocql.ErrConnectionClosed) ||
+		errors.Is(err, gocql.ErrTimeoutNoResponse) {
+		return true
+	}
+
+	var reqErr gocql.RequestError
+	if errors.As(err, &reqErr) {
+		switch reqErr.Code() {
+		case gocql.ErrCodeUnavailable,
+			gocql.ErrCodeOverloaded,
+			gocql.ErrCodeBootstrapping,
+			gocql.ErrCodeWriteTimeout,
+			gocql.ErrCodeReadTimeout:
+			return true
+		}
+		return false
+	}
+	return retry.IsRetryable(err)
+}
@@ -0,0 +1,139 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/elastic/go-elasticsearch/v8/esutil"
+)
+
+// BulkConfig는 SaveMany/BulkWrite가 사용하는 BulkIndexer 설정입니다
+type BulkConfig struct {
+	Workers       int           // 동시에 전송하는 워커 수 (0이면 CPU 수)
+	FlushBytes    int           // 버퍼가 이 크기를 넘으면 전송 (0이면 5MB)
+	FlushInterval time.Duration // 마지막 전송 후 이 시간이 지나면 전송 (0이면 30초)
+	Refresh       string        // 전송 후 새로고침: true(기본), wait_for, false
+}
+
+// SetBulkConfig는 BulkIndexer 설정을 지정합니다
+func (r *ElasticsearchRepository) SetBulkConfig(cfg BulkConfig) {
+	r.bulkConfig = cfg
+}
+
+// bulkItem은 operations 위치를 함께 가진 BulkIndexer 항목입니다
+type bulkItem struct {
+	index int
+	item  esutil.BulkIndexerItem
+}
+
+// newBulkBody는 문서 본문을 BulkIndexer 항목 본문으로 직렬화합니다
+func newBulkBody(v interface{}) (*bytes.Reader, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal bulk item: %w", err)
+	}
+	return bytes.NewReader(body), nil
+}
+
+// runBulk는 BulkIndexer로 항목들을 전송하고 항목별 결과를 집계합니다
+// 일부 항목이 실패해도 나머지는 반영되며, 실패한 항목은 BulkResult.Errors에 operations 위치 순으로 담깁니다
+// 전송 자체가 실패한 경우(연결 오류 등)에만 에러를 반환합니다
+func (r *ElasticsearchRepository) runBulk(ctx context.Context, items []bulkItem) (*repository.BulkResult, error) {
+	refresh := r.bulkConfig.Refresh
+	if refresh == "" {
+		refresh = "true"
+	}
+
+	var (
+		mu          sync.Mutex
+		indexerErrs []error
+	)
+	indexer, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
+		Client:        r.client,
+		NumWorkers:    r.bulkConfig.Workers,
+		FlushBytes:    r.bulkConfig.FlushBytes,
+		FlushInterval: r.bulkConfig.FlushInterval,
+		Refresh:       refresh,
+		OnError: func(_ context.Context, err error) {
+			mu.Lock()
+			indexerErrs = append(indexerErrs, err)
+			mu.Unlock()
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bulk indexer: %w", err)
+	}
+
+	result := &repository.BulkResult{
+		UpsertedIDs: make(map[int]interface{}),
+	}
+
+	for _, bi := range items {
+		index := bi.index
+		item := bi.item
+		item.OnSuccess = func(_ context.Context, item esutil.BulkIndexerItem, res esutil.BulkIndexerResponseItem) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch item.Action {
+			case "index", "create":
+				result.InsertedCount++
+			case "update":
+				result.MatchedCount++
+				if res.Result == "updated" {
+					result.ModifiedCount++
+				}
+			case "delete":
+				if res.Result == "deleted" {
+					result.DeletedCount++
+				}
+			}
+		}
+		item.OnFailure = func(_ context.Context, item esutil.BulkIndexerItem, res esutil.BulkIndexerResponseItem, err error) {
+			// 없는 문서 삭제는 실패가 아닙니다
+			if item.Action == "delete" && res.Status == http.StatusNotFound {
+				return
+			}
+
+			reason := res.Error.Reason
+			if res.Error.Type != "" {
+				reason = res.Error.Type + ": " + reason
+			}
+			if err != nil {
+				reason = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.Errors = append(result.Errors, repository.BulkWriteError{
+				Index:  index,
+				ID:     item.DocumentID,
+				Status: res.Status,
+				Reason: reason,
+			})
+		}
+
+		if err := indexer.Add(ctx, item); err != nil {
+			indexer.Close(context.Background())
+			return nil, fmt.Errorf("failed to add bulk item: %w", err)
+		}
+	}
+
+	if err := indexer.Close(ctx); err != nil {
+		return nil, fmt.Errorf("failed to flush bulk indexer: %w", err)
+	}
+	if len(indexerErrs) > 0 {
+		return nil, fmt.Errorf("failed to execute bulk request: %w", indexerErrs[0])
+	}
+
+	sort.Slice(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Index < result.Errors[j].Index
+	})
+	return result, nil
+}
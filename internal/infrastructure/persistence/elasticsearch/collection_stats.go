@@ -0,0 +1,68 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// indexStatsResponse는 _stats API 응답 중 사용하는 부분입니다
+type indexStatsResponse struct {
+	All struct {
+		Primaries indexStatsSection `json:"primaries"`
+		Total     indexStatsSection `json:"total"`
+	} `json:"_all"`
+}
+
+type indexStatsSection struct {
+	Docs struct {
+		Count int64 `json:"count"`
+	} `json:"docs"`
+	Store struct {
+		SizeInBytes int64 `json:"size_in_bytes"`
+	} `json:"store"`
+}
+
+// CollectionStats는 _stats API로 인덱스 통계를 반환합니다
+// Size와 문서 수는 주 샤드 기준, StorageSize는 복제본을 포함한 전체 크기입니다
+// Elasticsearch는 모든 필드가 색인되므로 별도 인덱스 크기는 없습니다 (IndexCount, TotalIndexSize는 0)
+func (r *ElasticsearchRepository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	req := esapi.IndicesStatsRequest{
+		Index:  []string{collection},
+		Metric: []string{"docs", "store"},
+	}
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get index stats: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return nil, fmt.Errorf("%w: index %s does not exist", entity.ErrDocumentNotFound, collection)
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to get index stats: %s", res.String())
+	}
+
+	var body indexStatsResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	primaries := body.All.Primaries
+	stats := &repository.CollectionStats{
+		Collection:    collection,
+		Count:         primaries.Docs.Count,
+		Size:          primaries.Store.SizeInBytes,
+		StorageSize:   body.All.Total.Store.SizeInBytes,
+		StorageEngine: "lucene",
+	}
+	if stats.Count > 0 {
+		stats.AvgDocSize = float64(stats.Size) / float64(stats.Count)
+	}
+	return stats, nil
+}
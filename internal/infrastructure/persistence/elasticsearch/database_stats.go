@@ -0,0 +1,111 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"go.uber.org/zap"
+)
+
+// clusterStatsResponse는 _cluster/stats 응답 중 사용하는 부분입니다
+type clusterStatsResponse struct {
+	Indices struct {
+		Count int `json:"count"`
+		Docs  struct {
+			Count int64 `json:"count"`
+		} `json:"docs"`
+		Store struct {
+			SizeInBytes int64 `json:"size_in_bytes"`
+		} `json:"store"`
+	} `json:"indices"`
+}
+
+// DatabaseStats는 _cluster/stats, 인덱스별 _stats, _nodes/stats의 HTTP 연결 수로 클러스터 통계를 반환합니다
+// 인덱스 개수, 문서 수, 저장소 크기는 시스템 인덱스를 포함한 클러스터 전체 기준이고, 컬렉션별 통계와 DataSize는 시스템 인덱스(.으로 시작)를 제외합니다
+func (r *ElasticsearchRepository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	var cluster clusterStatsResponse
+	if err := r.doJSON(ctx, esapi.ClusterStatsRequest{}, &cluster); err != nil {
+		return nil, fmt.Errorf("failed to get cluster stats: %w", err)
+	}
+
+	var indices struct {
+		Indices map[string]struct {
+			Primaries indexStatsSection `json:"primaries"`
+			Total     indexStatsSection `json:"total"`
+		} `json:"indices"`
+	}
+	if err := r.doJSON(ctx, esapi.IndicesStatsRequest{Metric: []string{"docs", "store"}, Level: "indices"}, &indices); err != nil {
+		return nil, fmt.Errorf("failed to get index stats: %w", err)
+	}
+
+	names := make([]string, 0, len(indices.Indices))
+	for name := range indices.Indices {
+		if !strings.HasPrefix(name, ".") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	perCollection := make([]*repository.CollectionStats, 0, len(names))
+	for _, name := range names {
+		index := indices.Indices[name]
+		stats := &repository.CollectionStats{
+			Collection:    name,
+			Count:         index.Primaries.Docs.Count,
+			Size:          index.Primaries.Store.SizeInBytes,
+			StorageSize:   index.Total.Store.SizeInBytes,
+			StorageEngine: "lucene",
+		}
+		if stats.Count > 0 {
+			stats.AvgDocSize = float64(stats.Size) / float64(stats.Count)
+		}
+		perCollection = append(perCollection, stats)
+	}
+
+	result := repository.SummarizeCollections(perCollection)
+	result.Collections = cluster.Indices.Count
+	result.Documents = cluster.Indices.Docs.Count
+	result.StorageSize = cluster.Indices.Store.SizeInBytes
+
+	var nodes struct {
+		Nodes map[string]struct {
+			HTTP struct {
+				CurrentOpen int64 `json:"current_open"`
+			} `json:"http"`
+		} `json:"nodes"`
+	}
+	if err := r.doJSON(ctx, esapi.NodesStatsRequest{Metric: []string{"http"}}, &nodes); err != nil {
+		logger.Warn(ctx, "failed to get connection stats", zap.Error(err))
+		return result, nil
+	}
+	connections := &repository.ConnectionStats{}
+	for _, node := range nodes.Nodes {
+		connections.Current += node.HTTP.CurrentOpen
+	}
+	result.Connections = connections
+	return result, nil
+}
+
+// doJSON은 요청을 실행하고 응답 본문을 out으로 디코딩합니다
+func (r *ElasticsearchRepository) doJSON(ctx context.Context, req esapi.Request, out interface{}) error {
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return errors.New(res.String())
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
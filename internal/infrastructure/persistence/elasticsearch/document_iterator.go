@@ -0,0 +1,177 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+const (
+	// streamPageSize는 스트리밍 조회에서 scroll 요청 하나가 가져오는 문서 수입니다
+	streamPageSize = 500
+
+	// streamScrollKeepAlive는 다음 scroll 요청까지 검색 컨텍스트를 유지하는 시간입니다
+	streamScrollKeepAlive = time.Minute
+)
+
+// FindStream은 옵션을 사용하여 문서를 조회하고 결과를 scroll API로 가져오는 커서로 반환합니다
+// from/size는 10,000건을 넘을 수 없으므로 Skip/Limit은 커서에서 적용합니다
+func (r *ElasticsearchRepository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	if opts == nil {
+		opts = &repository.FindOptions{}
+	}
+
+	query := r.buildQueryWithOptions(filter, &repository.FindOptions{Sort: opts.Sort})
+	if _, ok := query["sort"]; !ok {
+		// 정렬이 필요 없으면 색인 순서(_doc)가 가장 효율적입니다
+		query["sort"] = []string{"_doc"}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	res, err := r.client.Search(
+		r.client.Search.WithContext(ctx),
+		r.client.Search.WithIndex(collection),
+		r.client.Search.WithBody(&buf),
+		r.client.Search.WithSize(streamPageSize),
+		r.client.Search.WithScroll(streamScrollKeepAlive),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	it := &documentIterator{
+		repo:       r,
+		collection: collection,
+		skip:       opts.Skip,
+		limit:      opts.Limit,
+		pos:        -1,
+	}
+	if err := it.load(res); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// scrollResponse는 search/scroll 응답에서 필요한 부분입니다
+type scrollResponse struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []struct {
+			Source map[string]interface{} `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// documentIterator는 Elasticsearch scroll 결과를 DocumentIterator로 감쌉니다
+type documentIterator struct {
+	repo       *ElasticsearchRepository
+	collection string
+	scrollID   string
+	sources    []map[string]interface{}
+	pos        int
+	skip       int64
+	limit      int64
+	returned   int64
+	done       bool
+	err        error
+}
+
+// load는 search/scroll 응답을 읽어 현재 페이지로 설정합니다
+func (it *documentIterator) load(res *esapi.Response) error {
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("failed to search documents: %s", res.String())
+	}
+
+	var response scrollResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if response.ScrollID != "" {
+		it.scrollID = response.ScrollID
+	}
+	it.sources = it.sources[:0]
+	for _, hit := range response.Hits.Hits {
+		it.sources = append(it.sources, hit.Source)
+	}
+	it.pos = -1
+	if len(it.sources) < streamPageSize {
+		it.done = true
+	}
+	return nil
+}
+
+func (it *documentIterator) Next(ctx context.Context) bool {
+	for {
+		if it.err != nil || ctx.Err() != nil {
+			return false
+		}
+		if it.limit > 0 && it.returned >= it.limit {
+			return false
+		}
+
+		if it.pos+1 < len(it.sources) {
+			it.pos++
+			if it.skip > 0 {
+				it.skip--
+				continue
+			}
+			it.returned++
+			return true
+		}
+
+		if it.done {
+			return false
+		}
+		res, err := it.repo.client.Scroll(
+			it.repo.client.Scroll.WithContext(ctx),
+			it.repo.client.Scroll.WithScrollID(it.scrollID),
+			it.repo.client.Scroll.WithScroll(streamScrollKeepAlive),
+		)
+		if err != nil {
+			it.err = fmt.Errorf("failed to scroll documents: %w", err)
+			return false
+		}
+		if err := it.load(res); err != nil {
+			it.err = err
+			return false
+		}
+	}
+}
+
+func (it *documentIterator) Decode() (*entity.Document, error) {
+	return it.repo.parseDocument(it.sources[it.pos], it.collection)
+}
+
+func (it *documentIterator) Err() error {
+	return it.err
+}
+
+// Close는 scroll 검색 컨텍스트를 해제합니다
+func (it *documentIterator) Close(ctx context.Context) error {
+	if it.scrollID == "" {
+		return nil
+	}
+	res, err := it.repo.client.ClearScroll(
+		it.repo.client.ClearScroll.WithContext(ctx),
+		it.repo.client.ClearScroll.WithScrollID(it.scrollID),
+	)
+	it.scrollID = ""
+	if err != nil {
+		return fmt.Errorf("failed to clear scroll: %w", err)
+	}
+	res.Body.Close()
+	return nil
+}
@@ -7,19 +7,23 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/elastic/go-elasticsearch/v8/esutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 // ElasticsearchRepository는 Elasticsearch 기반 문서 저장소입니다
 type ElasticsearchRepository struct {
-	client *elasticsearch.Client
+	client     *elasticsearch.Client
+	bulkConfig BulkConfig // SaveMany/BulkWrite의 BulkIndexer 설정
 }
 
 // NewElasticsearchRepository는 Elasticsearch 저장소를 생성합니다
@@ -74,18 +78,18 @@ func (r *ElasticsearchRepository) ensureIndexExists(ctx context.Context, index s
 
 // Save는 문서를 저장합니다
 func (r *ElasticsearchRepository) Save(ctx context.Context, doc *entity.Document) error {
-	if err := r.ensureIndexExists(ctx, doc.Collection); err != nil {
+	if err := r.ensureIndexExists(ctx, doc.Collection()); err != nil {
 		return fmt.Errorf("failed to ensure index exists: %w", err)
 	}
 
 	// Elasticsearch 문서 생성
 	esDoc := map[string]interface{}{
-		"id":         doc.ID,
-		"data":       doc.Data,
-		"created_at": doc.CreatedAt,
-		"updated_at": doc.UpdatedAt,
-		"version":    doc.Version,
-		"metadata":   doc.Metadata,
+		"id":         doc.ID(),
+		"data":       doc.Data(),
+		"created_at": doc.CreatedAt(),
+		"updated_at": doc.UpdatedAt(),
+		"version":    doc.Version(),
+		"metadata":   entity.ExpiryMetadata(doc.ExpiresAt()),
 	}
 
 	docJSON, err := json.Marshal(esDoc)
@@ -94,10 +98,10 @@ func (r *ElasticsearchRepository) Save(ctx context.Context, doc *entity.Document
 	}
 
 	res, err := r.client.Index(
-		doc.Collection,
+		doc.Collection(),
 		bytes.NewReader(docJSON),
 		r.client.Index.WithContext(ctx),
-		r.client.Index.WithDocumentID(doc.ID),
+		r.client.Index.WithDocumentID(doc.ID()),
 		r.client.Index.WithRefresh("true"),
 	)
 	if err != nil {
@@ -112,57 +116,46 @@ func (r *ElasticsearchRepository) Save(ctx context.Context, doc *entity.Document
 	return nil
 }
 
-// SaveMany는 여러 문서를 한 번에 저장합니다
+// SaveMany는 여러 문서를 BulkIndexer로 저장합니다
+// 일부 문서가 실패하면 나머지는 저장된 상태로 실패한 문서 수와 첫 번째 원인을 에러로 반환합니다
 func (r *ElasticsearchRepository) SaveMany(ctx context.Context, docs []*entity.Document) error {
 	if len(docs) == 0 {
 		return nil
 	}
 
-	collection := docs[0].Collection
+	collection := docs[0].Collection()
 	if err := r.ensureIndexExists(ctx, collection); err != nil {
 		return fmt.Errorf("failed to ensure index exists: %w", err)
 	}
 
-	// Bulk API 사용
-	var buf bytes.Buffer
-	for _, doc := range docs {
-		// Action line
-		meta := map[string]interface{}{
-			"index": map[string]interface{}{
-				"_index": collection,
-				"_id":    doc.ID,
-			},
-		}
-		metaJSON, _ := json.Marshal(meta)
-		buf.Write(metaJSON)
-		buf.WriteByte('\n')
-
-		// Document line
-		esDoc := map[string]interface{}{
-			"id":         doc.ID,
-			"data":       doc.Data,
-			"created_at": doc.CreatedAt,
-			"updated_at": doc.UpdatedAt,
-			"version":    doc.Version,
-			"metadata":   doc.Metadata,
+	items := make([]bulkItem, 0, len(docs))
+	for i, doc := range docs {
+		body, err := newBulkBody(map[string]interface{}{
+			"id":         doc.ID(),
+			"data":       doc.Data(),
+			"created_at": doc.CreatedAt(),
+			"updated_at": doc.UpdatedAt(),
+			"version":    doc.Version(),
+			"metadata":   entity.ExpiryMetadata(doc.ExpiresAt()),
+		})
+		if err != nil {
+			return err
 		}
-		docJSON, _ := json.Marshal(esDoc)
-		buf.Write(docJSON)
-		buf.WriteByte('\n')
+		items = append(items, bulkItem{index: i, item: esutil.BulkIndexerItem{
+			Index:      collection,
+			Action:     "index",
+			DocumentID: doc.ID(),
+			Body:       body,
+		}})
 	}
 
-	res, err := r.client.Bulk(
-		bytes.NewReader(buf.Bytes()),
-		r.client.Bulk.WithContext(ctx),
-		r.client.Bulk.WithRefresh("true"),
-	)
+	result, err := r.runBulk(ctx, items)
 	if err != nil {
 		return fmt.Errorf("failed to bulk index documents: %w", err)
 	}
-	defer res.Body.Close()
-
-	if res.IsError() {
-		return fmt.Errorf("failed to bulk index documents: %s", res.String())
+	if len(result.Errors) > 0 {
+		first := result.Errors[0]
+		return fmt.Errorf("failed to index %d of %d documents: %s: %s", len(result.Errors), len(docs), first.ID, first.Reason)
 	}
 
 	return nil
@@ -256,16 +249,16 @@ func (r *ElasticsearchRepository) FindWithOptions(ctx context.Context, collectio
 
 // Update는 문서를 업데이트합니다
 func (r *ElasticsearchRepository) Update(ctx context.Context, doc *entity.Document) error {
-	// Optimistic locking with version
+	// 낙관적 잠금: doc.Update가 올린 버전의 직전 버전인 문서만 갱신합니다
 	script := map[string]interface{}{
 		"script": map[string]interface{}{
 			"source": "if (ctx._source.version == params.expected_version) { ctx._source.data = params.data; ctx._source.updated_at = params.updated_at; ctx._source.version = params.new_version; ctx._source.metadata = params.metadata; } else { ctx.op = 'noop'; }",
 			"params": map[string]interface{}{
-				"expected_version": doc.Version,
-				"new_version":      doc.Version + 1,
-				"data":             doc.Data,
-				"updated_at":       doc.UpdatedAt,
-				"metadata":         doc.Metadata,
+				"expected_version": doc.Version() - 1,
+				"new_version":      doc.Version(),
+				"data":             doc.Data(),
+				"updated_at":       doc.UpdatedAt(),
+				"metadata":         entity.ExpiryMetadata(doc.ExpiresAt()),
 			},
 		},
 	}
@@ -276,8 +269,8 @@ func (r *ElasticsearchRepository) Update(ctx context.Context, doc *entity.Docume
 	}
 
 	res, err := r.client.Update(
-		doc.Collection,
-		doc.ID,
+		doc.Collection(),
+		doc.ID(),
 		bytes.NewReader(scriptJSON),
 		r.client.Update.WithContext(ctx),
 		r.client.Update.WithRefresh("true"),
@@ -300,7 +293,6 @@ func (r *ElasticsearchRepository) Update(ctx context.Context, doc *entity.Docume
 		return errors.New("optimistic lock error: document was modified by another process")
 	}
 
-	doc.Version++
 	return nil
 }
 
@@ -351,11 +343,11 @@ func (r *ElasticsearchRepository) UpdateMany(ctx context.Context, collection str
 func (r *ElasticsearchRepository) Replace(ctx context.Context, collection, id string, replacement *entity.Document) error {
 	esDoc := map[string]interface{}{
 		"id":         id,
-		"data":       replacement.Data,
-		"created_at": replacement.CreatedAt,
-		"updated_at": replacement.UpdatedAt,
-		"version":    replacement.Version,
-		"metadata":   replacement.Metadata,
+		"data":       replacement.Data(),
+		"created_at": replacement.CreatedAt(),
+		"updated_at": replacement.UpdatedAt(),
+		"version":    replacement.Version(),
+		"metadata":   entity.ExpiryMetadata(replacement.ExpiresAt()),
 	}
 
 	docJSON, err := json.Marshal(esDoc)
@@ -539,33 +531,29 @@ func (r *ElasticsearchRepository) buildUpdateScript(update map[string]interface{
 }
 
 func (r *ElasticsearchRepository) parseDocument(source map[string]interface{}, collection string) (*entity.Document, error) {
-	doc := &entity.Document{
-		Collection: collection,
-	}
+	id, _ := source["id"].(string)
+	data, _ := source["data"].(map[string]interface{})
 
-	if id, ok := source["id"].(string); ok {
-		doc.ID = id
-	}
-
-	if data, ok := source["data"].(map[string]interface{}); ok {
-		doc.Data = data
-	}
-
-	if createdAt, ok := source["created_at"].(string); ok {
+	var createdAt, updatedAt time.Time
+	if ts, ok := source["created_at"].(string); ok {
 		// Parse timestamp
-		doc.CreatedAt, _ = parseTimestamp(createdAt)
+		createdAt, _ = parseTimestamp(ts)
 	}
 
-	if updatedAt, ok := source["updated_at"].(string); ok {
-		doc.UpdatedAt, _ = parseTimestamp(updatedAt)
+	if ts, ok := source["updated_at"].(string); ok {
+		updatedAt, _ = parseTimestamp(ts)
 	}
 
-	if version, ok := source["version"].(float64); ok {
-		doc.Version = int(version)
+	var version int
+	if v, ok := source["version"].(float64); ok {
+		version = int(v)
 	}
 
+	doc := entity.ReconstructDocument(id, collection, data, version, createdAt, updatedAt)
 	if metadata, ok := source["metadata"].(map[string]interface{}); ok {
-		doc.Metadata = metadata
+		if expiresAt, ok := entity.ParseExpiresAt(metadata); ok {
+			doc.SetExpiresAt(expiresAt)
+		}
 	}
 
 	return doc, nil
@@ -619,8 +607,12 @@ func (r *ElasticsearchRepository) FindAndUpdate(ctx context.Context, collection,
 		return nil, err
 	}
 
+	data := doc.Data()
 	for key, value := range update {
-		doc.Data[key] = value
+		data[key] = value
+	}
+	if err := doc.Update(data); err != nil {
+		return nil, err
 	}
 
 	if err := r.Update(ctx, doc); err != nil {
@@ -811,97 +803,88 @@ func (r *ElasticsearchRepository) EstimatedDocumentCount(ctx context.Context, co
 
 // ===== Bulk Operations =====
 
-// BulkWrite는 여러 작업을 한 번에 실행합니다
+// BulkWrite는 여러 작업을 BulkIndexer로 실행합니다
+// 작업별 실패는 에러 대신 BulkResult.Errors로 반환합니다
 func (r *ElasticsearchRepository) BulkWrite(ctx context.Context, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
 	if len(operations) == 0 {
 		return &repository.BulkResult{}, nil
 	}
 
-	var buf bytes.Buffer
-	result := &repository.BulkResult{
-		UpsertedIDs: make(map[int]interface{}),
-	}
+	var (
+		items   []bulkItem
+		skipped []repository.BulkWriteError
+	)
+	ensured := make(map[string]bool)
 
 	for i, op := range operations {
 		switch op.Type {
 		case "insert":
-			if err := r.ensureIndexExists(ctx, op.Collection); err != nil {
-				return nil, fmt.Errorf("failed to ensure index exists: %w", err)
+			if !ensured[op.Collection] {
+				if err := r.ensureIndexExists(ctx, op.Collection); err != nil {
+					return nil, fmt.Errorf("failed to ensure index exists: %w", err)
+				}
+				ensured[op.Collection] = true
 			}
 
-			meta := map[string]interface{}{
-				"index": map[string]interface{}{
-					"_index": op.Collection,
-					"_id":    op.Document.ID,
-				},
-			}
-			metaJSON, _ := json.Marshal(meta)
-			buf.Write(metaJSON)
-			buf.WriteByte('\n')
-
-			esDoc := map[string]interface{}{
-				"id":         op.Document.ID,
-				"data":       op.Document.Data,
-				"created_at": op.Document.CreatedAt,
-				"updated_at": op.Document.UpdatedAt,
-				"version":    op.Document.Version,
-				"metadata":   op.Document.Metadata,
+			body, err := newBulkBody(map[string]interface{}{
+				"id":         op.Document.ID(),
+				"data":       op.Document.Data(),
+				"created_at": op.Document.CreatedAt(),
+				"updated_at": op.Document.UpdatedAt(),
+				"version":    op.Document.Version(),
+				"metadata":   entity.ExpiryMetadata(op.Document.ExpiresAt()),
+			})
+			if err != nil {
+				return nil, err
 			}
-			docJSON, _ := json.Marshal(esDoc)
-			buf.Write(docJSON)
-			buf.WriteByte('\n')
-
-			result.InsertedCount++
-
-		case "update":
-			meta := map[string]interface{}{
-				"update": map[string]interface{}{
-					"_index": op.Collection,
-					"_id":    op.Filter["id"],
-				},
+			items = append(items, bulkItem{index: i, item: esutil.BulkIndexerItem{
+				Index:      op.Collection,
+				Action:     "index",
+				DocumentID: op.Document.ID(),
+				Body:       body,
+			}})
+
+		case "update", "delete":
+			id, ok := op.Filter["id"]
+			if !ok {
+				skipped = append(skipped, repository.BulkWriteError{
+					Index:  i,
+					Reason: op.Type + " requires an id filter",
+				})
+				continue
 			}
-			metaJSON, _ := json.Marshal(meta)
-			buf.Write(metaJSON)
-			buf.WriteByte('\n')
 
-			updateDoc := map[string]interface{}{
-				"doc": op.Update,
+			item := esutil.BulkIndexerItem{
+				Index:      op.Collection,
+				Action:     op.Type,
+				DocumentID: fmt.Sprint(id),
 			}
-			docJSON, _ := json.Marshal(updateDoc)
-			buf.Write(docJSON)
-			buf.WriteByte('\n')
-
-			result.ModifiedCount++
-
-		case "delete":
-			meta := map[string]interface{}{
-				"delete": map[string]interface{}{
-					"_index": op.Collection,
-					"_id":    op.Filter["id"],
-				},
+			if op.Type == "update" {
+				body, err := newBulkBody(map[string]interface{}{"doc": op.Update})
+				if err != nil {
+					return nil, err
+				}
+				item.Body = body
 			}
-			metaJSON, _ := json.Marshal(meta)
-			buf.Write(metaJSON)
-			buf.WriteByte('\n')
+			items = append(items, bulkItem{index: i, item: item})
 
-			result.DeletedCount++
+		default:
+			skipped = append(skipped, repository.BulkWriteError{
+				Index:  i,
+				Reason: "unsupported operation type: " + op.Type,
+			})
 		}
-
-		result.UpsertedIDs[i] = i
 	}
 
-	res, err := r.client.Bulk(
-		bytes.NewReader(buf.Bytes()),
-		r.client.Bulk.WithContext(ctx),
-		r.client.Bulk.WithRefresh("true"),
-	)
+	result, err := r.runBulk(ctx, items)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bulk write: %w", err)
 	}
-	defer res.Body.Close()
-
-	if res.IsError() {
-		return nil, fmt.Errorf("failed to bulk write: %s", res.String())
+	if len(skipped) > 0 {
+		result.Errors = append(result.Errors, skipped...)
+		sort.Slice(result.Errors, func(i, j int) bool {
+			return result.Errors[i].Index < result.Errors[j].Index
+		})
 	}
 
 	return result, nil
@@ -1057,8 +1040,14 @@ func (r *ElasticsearchRepository) Watch(ctx context.Context, collection string,
 // ===== Transaction =====
 
 // WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-func (r *ElasticsearchRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+// 격리 수준은 적용되지 않고 opts의 Timeout만 적용됩니다
+func (r *ElasticsearchRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
 	// Elasticsearch는 트랜잭션을 지원하지 않습니다
+	if opts != nil && opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
 	return fn(ctx)
 }
 
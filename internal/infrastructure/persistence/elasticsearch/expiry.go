@@ -0,0 +1,80 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// EnableExpiry는 metadata.expires_at을 date 필드로 매핑합니다
+// Elasticsearch는 만료 문서를 스스로 삭제하지 않으므로 false를 반환하며, DeleteExpired로 주기적으로 정리해야 합니다
+func (r *ElasticsearchRepository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	if err := r.ensureIndexExists(ctx, collection); err != nil {
+		return false, fmt.Errorf("failed to ensure index exists: %w", err)
+	}
+
+	mapping := fmt.Sprintf(`{"properties": {"metadata": {"properties": {"%s": {"type": "date"}}}}}`, entity.ExpiresAtField)
+	res, err := r.client.Indices.PutMapping(
+		[]string{collection},
+		strings.NewReader(mapping),
+		r.client.Indices.PutMapping.WithContext(ctx),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to put expiry mapping: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return false, fmt.Errorf("failed to put expiry mapping: %s", res.String())
+	}
+	return false, nil
+}
+
+// DeleteExpired는 before 시점까지 만료된 문서를 delete_by_query로 삭제합니다
+func (r *ElasticsearchRepository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"range": map[string]interface{}{
+				"metadata." + entity.ExpiresAtField: map[string]interface{}{
+					"lte": entity.FormatExpiresAt(before),
+				},
+			},
+		},
+	}
+	if limit > 0 {
+		query["max_docs"] = limit
+	}
+
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal query: %w", err)
+	}
+
+	res, err := r.client.DeleteByQuery(
+		[]string{collection},
+		bytes.NewReader(queryJSON),
+		r.client.DeleteByQuery.WithContext(ctx),
+		r.client.DeleteByQuery.WithConflicts("proceed"),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired documents: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return 0, fmt.Errorf("failed to delete expired documents: %s", res.String())
+	}
+
+	var result struct {
+		Deleted int64 `json:"deleted"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return result.Deleted, nil
+}
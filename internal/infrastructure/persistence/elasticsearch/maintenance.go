@@ -0,0 +1,51 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// MaintenanceOperations는 Elasticsearch가 지원하는 유지보수 작업을 반환합니다
+func (r *ElasticsearchRepository) MaintenanceOperations() []string {
+	return []string{"forcemerge", "refresh", "reindex"}
+}
+
+// RunMaintenance는 인덱스에 forcemerge, refresh 또는 새 인덱스로의 재색인(reindex)을 실행하고 결과를 반환합니다
+// forcemerge는 세그먼트를 합쳐 삭제된 문서가 차지하던 공간을 정리하며, 쓰기가 끝난 인덱스에만 권장됩니다
+func (r *ElasticsearchRepository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	var req esapi.Request
+	switch operation {
+	case "forcemerge":
+		forcemerge := esapi.IndicesForcemergeRequest{Index: []string{collection}}
+		if opts.MaxSegments > 0 {
+			forcemerge.MaxNumSegments = &opts.MaxSegments
+		}
+		req = forcemerge
+	case "refresh":
+		req = esapi.IndicesRefreshRequest{Index: []string{collection}}
+	case "reindex":
+		return r.reindex(ctx, collection, opts)
+	default:
+		return nil, fmt.Errorf("%w: %s on elasticsearch", repository.ErrUnsupportedMaintenance, operation)
+	}
+
+	res, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s index %s: %w", operation, collection, err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to %s index %s: %s", operation, collection, res.String())
+	}
+
+	var body map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return map[string]interface{}{"shards": body["_shards"]}, nil
+}
@@ -0,0 +1,83 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnsureMapping은 인덱스가 없으면 mapping.Settings와 data 필드 매핑으로 생성하고, 있으면 data 매핑을 추가합니다 (repository.IndexMapper 구현)
+// 문서의 나머지 필드(id, version, 시각, metadata)는 ensureIndexExists와 같은 매핑을 사용합니다
+func (r *ElasticsearchRepository) EnsureMapping(ctx context.Context, collection string, mapping repository.IndexMapping) error {
+	data := map[string]interface{}{"type": "object"}
+	for k, v := range mapping.Data {
+		data[k] = v
+	}
+
+	res, err := r.client.Indices.Exists([]string{collection}, r.client.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to check index existence: %w", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode == 200 {
+		body, err := json.Marshal(map[string]interface{}{
+			"properties": map[string]interface{}{"data": data},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode mapping: %w", err)
+		}
+		res, err := r.client.Indices.PutMapping(
+			[]string{collection},
+			bytes.NewReader(body),
+			r.client.Indices.PutMapping.WithContext(ctx),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to put mapping: %w", err)
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return fmt.Errorf("failed to put mapping on %s (existing fields cannot change type, reindex instead): %s", collection, res.String())
+		}
+		return nil
+	}
+
+	index := map[string]interface{}{
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"id":         map[string]interface{}{"type": "keyword"},
+				"data":       data,
+				"created_at": map[string]interface{}{"type": "date"},
+				"updated_at": map[string]interface{}{"type": "date"},
+				"version":    map[string]interface{}{"type": "integer"},
+				"metadata":   map[string]interface{}{"type": "object", "enabled": true},
+			},
+		},
+	}
+	if len(mapping.Settings) > 0 {
+		index["settings"] = mapping.Settings
+	}
+	body, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("failed to encode mapping: %w", err)
+	}
+
+	res, err = r.client.Indices.Create(
+		collection,
+		r.client.Indices.Create.WithContext(ctx),
+		r.client.Indices.Create.WithBody(bytes.NewReader(body)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create index: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("failed to create index %s: %s", collection, res.String())
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package elasticsearch
+
+import "context"
+
+// referenceCandidates는 limit 없이 참조하는 문서를 찾을 때 가져오는 최대 문서 수입니다 (기본 index.max_result_window)
+const referenceCandidates = 10000
+
+// FindReferencing은 data의 field 값이 id인 문서의 ID를 조회합니다
+func (r *ElasticsearchRepository) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	size := limit
+	if size <= 0 || size > referenceCandidates {
+		size = referenceCandidates
+	}
+	return r.findByData(ctx, collection, map[string]interface{}{field: id}, size, limit)
+}
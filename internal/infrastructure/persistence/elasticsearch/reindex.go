@@ -0,0 +1,224 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"go.uber.org/zap"
+)
+
+// reindexPollInterval은 재색인 태스크 진행 상황 조회 간격입니다
+const reindexPollInterval = 2 * time.Second
+
+// internalIndexSettings는 새 인덱스를 만들 때 복사하지 않는 인덱스 설정입니다 (Elasticsearch가 관리)
+var internalIndexSettings = []string{"creation_date", "uuid", "version", "provided_name", "routing", "resize", "blocks", "history", "verified_before_close"}
+
+// reindexTask는 _tasks 조회 응답입니다
+type reindexTask struct {
+	Completed bool `json:"completed"`
+	Task      struct {
+		Status struct {
+			Total   int64 `json:"total"`
+			Created int64 `json:"created"`
+			Updated int64 `json:"updated"`
+		} `json:"status"`
+	} `json:"task"`
+	Response struct {
+		Failures []interface{} `json:"failures"`
+	} `json:"response"`
+	Error map[string]interface{} `json:"error"`
+}
+
+// reindex는 컬렉션을 같은 매핑과 설정의 새 인덱스로 재색인한 뒤, 컬렉션 이름의 별칭을 새 인덱스로 옮기고 기존 인덱스를 삭제합니다
+// 컬렉션이 인덱스 이름이면 처음 실행할 때 별칭으로 바뀌며, 이후 실행은 별칭이 가리키는 인덱스를 교체합니다
+// 재색인 중의 쓰기가 유실되지 않도록 기존 인덱스에 쓰기 차단을 걸므로, 완료될 때까지 쓰기 요청은 실패합니다
+func (r *ElasticsearchRepository) reindex(ctx context.Context, collection string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	source, aliased, err := r.resolveIndex(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	var indices map[string]struct {
+		Mappings map[string]interface{} `json:"mappings"`
+		Settings struct {
+			Index map[string]interface{} `json:"index"`
+		} `json:"settings"`
+	}
+	if err := r.doJSON(ctx, esapi.IndicesGetRequest{Index: []string{source}}, &indices); err != nil {
+		return nil, fmt.Errorf("failed to get index %s: %w", source, err)
+	}
+	current, ok := indices[source]
+	if !ok {
+		return nil, fmt.Errorf("failed to get index %s: not found in response", source)
+	}
+	settings := current.Settings.Index
+	for _, key := range internalIndexSettings {
+		delete(settings, key)
+	}
+
+	target := fmt.Sprintf("%s-%s", collection, time.Now().UTC().Format("20060102150405"))
+	body, err := json.Marshal(map[string]interface{}{
+		"settings": map[string]interface{}{"index": settings},
+		"mappings": current.Mappings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal index definition: %w", err)
+	}
+	if err := r.doJSON(ctx, esapi.IndicesCreateRequest{Index: target, Body: bytes.NewReader(body)}, &map[string]interface{}{}); err != nil {
+		return nil, fmt.Errorf("failed to create index %s: %w", target, err)
+	}
+
+	if err := r.doJSON(ctx, esapi.IndicesAddBlockRequest{Index: []string{source}, Block: "write"}, &map[string]interface{}{}); err != nil {
+		r.discardIndex(ctx, target)
+		return nil, fmt.Errorf("failed to block writes on %s: %w", source, err)
+	}
+
+	start := time.Now()
+	copied, err := r.runReindexTask(ctx, source, target, opts)
+	if err == nil {
+		err = r.swapAlias(ctx, collection, source, target, aliased)
+	}
+	if err != nil {
+		r.unblockWrites(ctx, source)
+		r.discardIndex(ctx, target)
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"source_index": source,
+		"target_index": target,
+		"documents":    copied,
+		"took_ms":      time.Since(start).Milliseconds(),
+	}, nil
+}
+
+// resolveIndex는 컬렉션이 가리키는 실제 인덱스와 컬렉션이 별칭인지를 반환합니다
+func (r *ElasticsearchRepository) resolveIndex(ctx context.Context, collection string) (string, bool, error) {
+	res, err := esapi.IndicesGetAliasRequest{Name: []string{collection}}.Do(ctx, r.client)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get alias %s: %w", collection, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return collection, false, nil
+	}
+	if res.IsError() {
+		return "", false, fmt.Errorf("failed to get alias %s: %s", collection, res.String())
+	}
+
+	var aliases map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&aliases); err != nil {
+		return "", false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(aliases) != 1 {
+		return "", false, fmt.Errorf("alias %s points to %d indices, expected exactly one", collection, len(aliases))
+	}
+	for index := range aliases {
+		return index, true, nil
+	}
+	return "", false, nil
+}
+
+// runReindexTask는 source에서 target으로 재색인 태스크를 시작하고 끝날 때까지 진행 상황을 보고합니다
+func (r *ElasticsearchRepository) runReindexTask(ctx context.Context, source, target string, opts repository.MaintenanceOptions) (int64, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"source": map[string]interface{}{"index": source},
+		"dest":   map[string]interface{}{"index": target},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal reindex body: %w", err)
+	}
+
+	waitForCompletion := false
+	var started struct {
+		Task string `json:"task"`
+	}
+	if err := r.doJSON(ctx, esapi.ReindexRequest{Body: bytes.NewReader(body), WaitForCompletion: &waitForCompletion}, &started); err != nil {
+		return 0, fmt.Errorf("failed to start reindex: %w", err)
+	}
+
+	ticker := time.NewTicker(reindexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var task reindexTask
+		if err := r.doJSON(ctx, esapi.TasksGetRequest{TaskID: started.Task}, &task); err != nil {
+			return 0, fmt.Errorf("failed to get reindex task %s: %w", started.Task, err)
+		}
+		status := task.Task.Status
+		opts.ReportProgress(status.Created+status.Updated, status.Total)
+
+		if task.Completed {
+			if task.Error != nil {
+				return 0, fmt.Errorf("reindex task %s failed: %v", started.Task, task.Error["reason"])
+			}
+			if len(task.Response.Failures) > 0 {
+				return 0, fmt.Errorf("reindex task %s failed for %d documents: %v", started.Task, len(task.Response.Failures), task.Response.Failures[0])
+			}
+			return status.Created + status.Updated, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			// 태스크를 취소하지 않으면 새 인덱스를 삭제한 뒤에도 계속 문서를 씀
+			if err := r.doJSON(context.WithoutCancel(ctx), esapi.TasksCancelRequest{TaskID: started.Task}, &map[string]interface{}{}); err != nil {
+				logger.Warn(ctx, "failed to cancel reindex task", zap.String("task", started.Task), zap.Error(err))
+			}
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// swapAlias는 컬렉션 별칭을 target으로 원자적으로 옮기고 source 인덱스를 삭제합니다
+// 컬렉션이 인덱스 이름이었으면 remove_index로 인덱스 삭제와 별칭 추가를 한 번에 처리합니다
+func (r *ElasticsearchRepository) swapAlias(ctx context.Context, collection, source, target string, aliased bool) error {
+	actions := []map[string]interface{}{
+		{"add": map[string]interface{}{"index": target, "alias": collection}},
+	}
+	if aliased {
+		actions = append(actions, map[string]interface{}{"remove": map[string]interface{}{"index": source, "alias": collection}})
+	} else {
+		actions = append(actions, map[string]interface{}{"remove_index": map[string]interface{}{"index": source}})
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return fmt.Errorf("failed to marshal alias actions: %w", err)
+	}
+	if err := r.doJSON(ctx, esapi.IndicesUpdateAliasesRequest{Body: bytes.NewReader(body)}, &map[string]interface{}{}); err != nil {
+		return fmt.Errorf("failed to swap alias %s to %s: %w", collection, target, err)
+	}
+
+	if aliased {
+		// 별칭은 이미 새 인덱스를 가리키므로 기존 인덱스 삭제 실패는 경고만 남김
+		if err := r.doJSON(ctx, esapi.IndicesDeleteRequest{Index: []string{source}}, &map[string]interface{}{}); err != nil {
+			logger.Warn(ctx, "failed to delete reindexed source index", zap.String("index", source), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// unblockWrites는 재색인이 실패했을 때 기존 인덱스의 쓰기 차단을 해제합니다
+func (r *ElasticsearchRepository) unblockWrites(ctx context.Context, index string) {
+	body := bytes.NewReader([]byte(`{"index":{"blocks":{"write":false}}}`))
+	err := r.doJSON(context.WithoutCancel(ctx), esapi.IndicesPutSettingsRequest{Index: []string{index}, Body: body}, &map[string]interface{}{})
+	if err != nil {
+		logger.Error(ctx, "failed to remove write block after failed reindex", zap.String("index", index), zap.Error(err))
+	}
+}
+
+// discardIndex는 재색인이 실패했을 때 만들어 둔 새 인덱스를 삭제합니다
+func (r *ElasticsearchRepository) discardIndex(ctx context.Context, index string) {
+	err := r.doJSON(context.WithoutCancel(ctx), esapi.IndicesDeleteRequest{Index: []string{index}}, &map[string]interface{}{})
+	if err != nil {
+		logger.Warn(ctx, "failed to delete index of failed reindex", zap.String("index", index), zap.Error(err))
+	}
+}
@@ -0,0 +1,109 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// uniqueKeyCandidates는 FindByUniqueKey가 정확한 값 비교를 위해 한 번에 가져오는 후보 문서 수입니다
+const uniqueKeyCandidates = 100
+
+// EnsureUniqueKey는 인덱스만 준비하고 false를 반환합니다
+// Elasticsearch에는 고유 제약이 없으므로 호출자가 FindByUniqueKey로 확인해야 합니다
+func (r *ElasticsearchRepository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	if err := r.ensureIndexExists(ctx, collection); err != nil {
+		return false, fmt.Errorf("failed to ensure index exists: %w", err)
+	}
+	return false, nil
+}
+
+// FindByUniqueKey는 data 필드 값이 key와 같은 문서의 ID를 조회합니다
+func (r *ElasticsearchRepository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	return r.findByData(ctx, collection, key, uniqueKeyCandidates, limit)
+}
+
+// findByData는 data 필드 값이 key와 같은 문서의 ID를 최대 limit개 조회합니다 (후보는 size개까지 가져옴)
+// 동적 매핑의 문자열 필드는 text(+keyword 하위 필드)라 term 조회가 정확하지 않으므로,
+// data.<필드>와 data.<필드>.keyword 중 하나가 맞는 후보를 가져온 뒤 _source의 값을 직접 비교합니다
+func (r *ElasticsearchRepository) findByData(ctx context.Context, collection string, key map[string]interface{}, size, limit int) ([]string, error) {
+	filters := make([]map[string]interface{}, 0, len(key))
+	for field, value := range key {
+		filters = append(filters, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{"term": map[string]interface{}{"data." + field: value}},
+					{"term": map[string]interface{}{"data." + field + ".keyword": value}},
+				},
+				"minimum_should_match": 1,
+			},
+		})
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"query":   map[string]interface{}{"bool": map[string]interface{}{"filter": filters}},
+		"_source": []string{"id", "data"},
+		"size":    size,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal query: %w", err)
+	}
+
+	var result struct {
+		Hits struct {
+			Hits []struct {
+				Source struct {
+					ID   string                 `json:"id"`
+					Data map[string]interface{} `json:"data"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	ignoreUnavailable := true
+	req := esapi.SearchRequest{
+		Index:             []string{collection},
+		Body:              bytes.NewReader(body),
+		IgnoreUnavailable: &ignoreUnavailable,
+	}
+	if err := r.doJSON(ctx, req, &result); err != nil {
+		return nil, fmt.Errorf("failed to find documents by data fields: %w", err)
+	}
+
+	var ids []string
+	for _, hit := range result.Hits.Hits {
+		if !matchesUniqueKey(hit.Source.Data, key) {
+			continue
+		}
+		ids = append(ids, hit.Source.ID)
+		if limit > 0 && len(ids) >= limit {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// matchesUniqueKey는 문서 데이터의 필드 값이 key와 모두 같은지 JSON 표현으로 비교합니다
+func matchesUniqueKey(data map[string]interface{}, key map[string]interface{}) bool {
+	for field, want := range key {
+		var current interface{} = data
+		for _, segment := range strings.Split(field, ".") {
+			m, ok := current.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			current = m[segment]
+		}
+		got, err := json.Marshal(current)
+		if err != nil {
+			return false
+		}
+		expected, err := json.Marshal(want)
+		if err != nil || !bytes.Equal(got, expected) {
+			return false
+		}
+	}
+	return true
+}
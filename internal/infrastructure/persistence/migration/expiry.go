@@ -0,0 +1,54 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnableExpiry는 현재 작업을 받는 저장소와, 마이그레이션 중이면 반대편 저장소에도 만료 정리를 준비합니다
+func (r *Repository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	_, active, mirror, release := r.route(collection)
+	defer release()
+
+	native := true
+	for _, repo := range []repository.DocumentRepository{active, mirror} {
+		if repo == nil {
+			continue
+		}
+		expirer, ok := repo.(repository.DocumentExpirer)
+		if !ok {
+			return false, fmt.Errorf("repository does not support document expiry")
+		}
+		ok, err := expirer.EnableExpiry(ctx, collection)
+		if err != nil {
+			return false, err
+		}
+		native = native && ok
+	}
+	return native, nil
+}
+
+// DeleteExpired는 현재 작업을 받는 저장소에서 만료 문서를 삭제하고, 마이그레이션 중이면 반대편 저장소에서도 삭제합니다
+// 만료 삭제는 문서 ID를 거치지 않으므로 dual-write 반영 대신 양쪽에 같은 조건을 적용합니다
+func (r *Repository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	_, active, mirror, release := r.route(collection)
+	defer release()
+
+	expirer, ok := active.(repository.DocumentExpirer)
+	if !ok {
+		return 0, fmt.Errorf("repository does not support document expiry")
+	}
+	deleted, err := expirer.DeleteExpired(ctx, collection, before, limit)
+	if err != nil || mirror == nil {
+		return deleted, err
+	}
+	if mirrorExpirer, ok := mirror.(repository.DocumentExpirer); ok {
+		if _, err := mirrorExpirer.DeleteExpired(ctx, collection, before, limit); err != nil {
+			return deleted, fmt.Errorf("failed to delete expired documents from mirror: %w", err)
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,47 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// MaintenanceOperations는 감싼 저장소가 지원하는 유지보수 작업을 반환합니다
+func (r *Repository) MaintenanceOperations() []string {
+	maintainer, ok := r.DocumentRepository.(repository.CollectionMaintainer)
+	if !ok {
+		return nil
+	}
+	return maintainer.MaintenanceOperations()
+}
+
+// RunMaintenance는 현재 작업을 받는 저장소에서 유지보수 작업을 실행하고, 마이그레이션 중이면 반대편 저장소에서도 실행합니다
+// 반대편 저장소가 같은 작업을 지원하지 않으면 건너뜁니다 (백엔드 종류가 다를 수 있음)
+func (r *Repository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	_, active, mirror, release := r.route(collection)
+	defer release()
+
+	maintainer, ok := active.(repository.CollectionMaintainer)
+	if !ok {
+		return nil, repository.ErrUnsupportedMaintenance
+	}
+	result, err := maintainer.RunMaintenance(ctx, collection, operation, opts)
+	if err != nil || mirror == nil {
+		return result, err
+	}
+	if mirrorMaintainer, ok := mirror.(repository.CollectionMaintainer); ok {
+		// 진행 상황은 현재 작업을 받는 저장소 기준으로만 보고
+		mirrorOpts := opts
+		mirrorOpts.Progress = nil
+		mirrorResult, err := mirrorMaintainer.RunMaintenance(ctx, collection, operation, mirrorOpts)
+		switch {
+		case err == nil:
+			result["mirror"] = mirrorResult
+		case !errors.Is(err, repository.ErrUnsupportedMaintenance):
+			return result, fmt.Errorf("failed to run maintenance on mirror: %w", err)
+		}
+	}
+	return result, nil
+}
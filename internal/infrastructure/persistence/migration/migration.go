@@ -0,0 +1,649 @@
+// Package migration은 컬렉션을 한 백엔드에서 다른 백엔드로 중단 없이 옮기는 온라인 마이그레이션을 제공합니다
+//
+// 마이그레이션이 시작되면 원본 백엔드로 가는 쓰기를 대상 백엔드에도 반영(dual-write)하고, 그 동안 기존 문서를
+// 대상으로 복사(backfill)한 뒤 양쪽의 체크섬을 비교합니다. 전환(cutover)하면 해당 컬렉션의 읽기/쓰기가
+// 대상 백엔드로 한 번에 넘어가며, 완료하기 전까지는 쓰기를 원본에도 반영해 되돌릴 수 있습니다
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultBatchSize = 500
+	lockStripes      = 64
+	maxFailedIDs     = 10000
+)
+
+var (
+	// ErrNotFound는 마이그레이션이 없을 때 반환됩니다
+	ErrNotFound = errors.New("migration not found")
+
+	// ErrInvalidState는 현재 단계에서 할 수 없는 작업을 요청했을 때 반환됩니다
+	ErrInvalidState = errors.New("invalid migration state")
+
+	// ErrInProgress는 마이그레이션 중인 컬렉션에 허용되지 않는 작업(삭제, 이름 변경, 다른 마이그레이션)을 요청했을 때 반환됩니다
+	ErrInProgress = errors.New("collection is being migrated")
+)
+
+// Phase는 마이그레이션 단계입니다
+type Phase string
+
+const (
+	// PhaseBackfilling은 dual-write 중 기존 문서를 대상으로 복사하는 단계입니다
+	PhaseBackfilling Phase = "backfilling"
+	// PhaseVerifying은 양쪽 체크섬을 비교하는 단계입니다
+	PhaseVerifying Phase = "verifying"
+	// PhaseReady는 체크섬이 일치해 전환할 수 있는 단계입니다
+	PhaseReady Phase = "ready"
+	// PhaseVerifyFailed는 체크섬이 일치하지 않은 단계입니다 (다시 검증하거나 강제로 전환)
+	PhaseVerifyFailed Phase = "verify_failed"
+	// PhaseCutOver는 읽기/쓰기를 대상으로 보내고 쓰기를 원본에도 반영하는 단계입니다
+	PhaseCutOver Phase = "cut_over"
+	// PhaseCompleted는 대상으로 라우팅하고 원본 반영을 멈춘 단계입니다
+	PhaseCompleted Phase = "completed"
+	// PhaseFailed는 복사나 검증 중 에러가 난 단계입니다 (원본으로 라우팅, dual-write 중단)
+	PhaseFailed Phase = "failed"
+	// PhaseAborted는 취소되어 원본으로 라우팅하는 단계입니다
+	PhaseAborted Phase = "aborted"
+)
+
+// VerifyResult는 원본과 대상의 체크섬 비교 결과입니다
+type VerifyResult struct {
+	SourceDocuments int64
+	TargetDocuments int64
+	SourceChecksum  string
+	TargetChecksum  string
+	Match           bool
+	VerifiedAt      time.Time
+}
+
+// Status는 마이그레이션 상태입니다
+type Status struct {
+	ID             string
+	Collection     string
+	Source         string
+	Target         string
+	Phase          Phase
+	Copied         int64 // backfill로 복사한 문서 수
+	Skipped        int64 // dual-write로 이미 반영되어 backfill이 건너뛴 문서 수
+	Total          int64 // backfill 시작 시점의 추정 문서 수
+	MirrorFailures int64 // 반대편 반영에 실패한 쓰기 수 (검증 전에 다시 반영)
+	Verify         *VerifyResult
+	Error          string
+	StartedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Migration은 컬렉션 하나의 온라인 마이그레이션입니다
+type Migration struct {
+	source repository.DocumentRepository
+	target repository.DocumentRepository
+
+	// gate는 전환을 원자적으로 만듭니다 (라우팅된 작업은 읽기 잠금, 단계 변경은 쓰기 잠금)
+	gate sync.RWMutex
+
+	// locks는 문서 ID별 반영을 직렬화합니다 (같은 문서의 반영이 순서를 바꿔 오래된 상태를 쓰지 않도록)
+	locks [lockStripes]sync.Mutex
+
+	mu      sync.Mutex
+	status  Status
+	touched map[string]struct{} // backfill 중 dual-write로 반영된 문서 ID (backfill이 덮어쓰지 않음)
+	failed  map[string]struct{} // 반영에 실패한 문서 ID
+}
+
+// Status는 현재 상태를 반환합니다
+func (m *Migration) Status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status := m.status
+	if m.status.Verify != nil {
+		verify := *m.status.Verify
+		status.Verify = &verify
+	}
+	return status
+}
+
+// ID는 마이그레이션 ID를 반환합니다
+func (m *Migration) ID() string {
+	return m.status.ID
+}
+
+func (m *Migration) phase() Phase {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status.Phase
+}
+
+func (m *Migration) update(fn func(s *Status)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(&m.status)
+	m.status.UpdatedAt = time.Now().UTC()
+}
+
+// transition은 단계가 from 중 하나일 때만 to로 바꿉니다 (gate 쓰기 잠금으로 진행 중인 작업이 끝난 뒤 바꿈)
+func (m *Migration) transition(to Phase, from ...Phase) error {
+	m.gate.Lock()
+	defer m.gate.Unlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, phase := range from {
+		if m.status.Phase == phase {
+			m.status.Phase = to
+			m.status.UpdatedAt = time.Now().UTC()
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: cannot move from %s to %s", ErrInvalidState, m.status.Phase, to)
+}
+
+// routes는 작업을 보낼 저장소와 쓰기를 반영할 반대편 저장소를 반환합니다 (반영하지 않으면 mirror는 nil)
+// 라우팅하지 않는 단계(failed, aborted)면 ok가 false입니다
+func (m *Migration) routes() (active, mirror repository.DocumentRepository, ok bool) {
+	switch m.phase() {
+	case PhaseBackfilling, PhaseVerifying, PhaseReady, PhaseVerifyFailed:
+		return m.source, m.target, true
+	case PhaseCutOver:
+		return m.target, m.source, true
+	case PhaseCompleted:
+		return m.target, nil, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// CutOver는 컬렉션의 읽기/쓰기를 대상으로 전환합니다 (force가 아니면 검증을 통과해야 함)
+// 진행 중인 dual-write가 끝난 뒤 한 번에 전환되며, 이후 쓰기는 원본에도 반영됩니다
+func (m *Migration) CutOver(force bool) error {
+	from := []Phase{PhaseReady}
+	if force {
+		from = append(from, PhaseVerifyFailed)
+	}
+	return m.transition(PhaseCutOver, from...)
+}
+
+// Complete는 원본 반영을 멈추고 마이그레이션을 끝냅니다 (컬렉션은 계속 대상으로 라우팅)
+func (m *Migration) Complete() error {
+	return m.transition(PhaseCompleted, PhaseCutOver)
+}
+
+// Abort는 마이그레이션을 취소하고 원본으로 라우팅합니다 (대상에 복사한 문서는 남겨 둠)
+// 전환 후 취소해도 쓰기가 원본에 반영되어 왔으므로 원본이 최신 상태입니다
+func (m *Migration) Abort() error {
+	return m.transition(PhaseAborted, PhaseBackfilling, PhaseVerifying, PhaseReady, PhaseVerifyFailed, PhaseCutOver, PhaseFailed)
+}
+
+// Fail은 마이그레이션을 실패로 기록하고 원본으로 라우팅합니다
+func (m *Migration) Fail(err error) {
+	m.gate.Lock()
+	defer m.gate.Unlock()
+	m.update(func(s *Status) {
+		if s.Phase == PhaseAborted || s.Phase == PhaseCutOver || s.Phase == PhaseCompleted {
+			return
+		}
+		s.Phase = PhaseFailed
+		s.Error = err.Error()
+	})
+}
+
+// Backfill은 원본의 기존 문서를 배치 단위로 대상에 복사합니다
+// 이미 dual-write로 반영된 문서는 최신 상태이므로 건너뜁니다
+func (m *Migration) Backfill(ctx context.Context, batchSize int) error {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	collection := m.status.Collection
+
+	if total, err := m.source.EstimatedDocumentCount(ctx, collection); err == nil {
+		m.update(func(s *Status) { s.Total = total })
+	}
+
+	it, err := m.source.FindStream(ctx, collection, map[string]interface{}{}, &repository.FindOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to open source cursor: %w", err)
+	}
+	defer it.Close(ctx)
+
+	batch := make([]*entity.Document, 0, batchSize)
+	for it.Next(ctx) {
+		if phase := m.phase(); phase != PhaseBackfilling {
+			return fmt.Errorf("%w: backfill stopped in phase %s", ErrInvalidState, phase)
+		}
+		doc, err := it.Decode()
+		if err != nil {
+			return err
+		}
+		batch = append(batch, doc)
+		if len(batch) >= batchSize {
+			if err := m.copyBatch(ctx, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	return m.copyBatch(ctx, batch)
+}
+
+// copyBatch는 배치의 문서 ID를 잠근 채 dual-write로 반영되지 않은 문서만 대상에 저장합니다
+func (m *Migration) copyBatch(ctx context.Context, batch []*entity.Document) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	stripes := make(map[int]bool)
+	for _, doc := range batch {
+		stripes[stripe(doc.ID())] = true
+	}
+	order := make([]int, 0, len(stripes))
+	for s := range stripes {
+		order = append(order, s)
+	}
+	sort.Ints(order)
+	for _, s := range order {
+		m.locks[s].Lock()
+	}
+	defer func() {
+		for _, s := range order {
+			m.locks[s].Unlock()
+		}
+	}()
+
+	m.mu.Lock()
+	docs := make([]*entity.Document, 0, len(batch))
+	for _, doc := range batch {
+		if _, ok := m.touched[doc.ID()]; !ok {
+			docs = append(docs, doc)
+		}
+	}
+	m.mu.Unlock()
+
+	if len(docs) > 0 {
+		if err := m.target.SaveMany(ctx, docs); err != nil {
+			return fmt.Errorf("failed to copy documents: %w", err)
+		}
+	}
+	m.update(func(s *Status) {
+		s.Copied += int64(len(docs))
+		s.Skipped += int64(len(batch) - len(docs))
+	})
+	return nil
+}
+
+// Run은 기존 문서를 복사한 뒤 검증합니다 (복사가 실패하면 실패로 기록하고 원본으로 라우팅)
+func (m *Migration) Run(ctx context.Context, batchSize int) {
+	if err := m.Backfill(ctx, batchSize); err != nil {
+		m.Fail(err)
+		return
+	}
+	// 복사가 끝났으므로 더 이상 건너뛸 문서를 기록할 필요 없음
+	m.mu.Lock()
+	m.touched = nil
+	m.mu.Unlock()
+	if err := m.transition(PhaseVerifying, PhaseBackfilling); err != nil {
+		return
+	}
+	m.settle(m.verify(ctx))
+}
+
+// Verify는 복사를 마친 마이그레이션을 다시 검증합니다 (ready, verify_failed, cut_over 단계)
+// 전환 후 검증은 단계를 바꾸지 않고 결과만 기록합니다
+func (m *Migration) Verify(ctx context.Context) error {
+	if m.phase() == PhaseCutOver {
+		_, err := m.verify(ctx)
+		return err
+	}
+	if err := m.transition(PhaseVerifying, PhaseReady, PhaseVerifyFailed); err != nil {
+		return err
+	}
+	m.settle(m.verify(ctx))
+	return nil
+}
+
+// settle은 검증 결과에 따라 ready 또는 verify_failed로 바꿉니다 (검증 중 취소되었으면 그대로 둠)
+func (m *Migration) settle(result *VerifyResult, err error) {
+	next := PhaseVerifyFailed
+	if err == nil && result.Match {
+		next = PhaseReady
+	}
+	if m.transition(next, PhaseVerifying) == nil && err != nil {
+		m.update(func(s *Status) { s.Error = err.Error() })
+	}
+}
+
+// verify는 반영에 실패한 문서를 다시 반영한 뒤 원본과 대상의 문서 수와 체크섬을 비교합니다
+// 체크섬은 순서와 무관하게 문서별 해시(ID, 버전, 데이터)를 XOR로 합친 값이므로 백엔드마다 정렬이 달라도 비교할 수 있습니다
+// 검증 중에 쓰기가 들어오면 양쪽을 읽는 시점 차이로 불일치가 나올 수 있으며, 이때는 다시 검증합니다
+func (m *Migration) verify(ctx context.Context) (*VerifyResult, error) {
+	m.retryFailed(ctx)
+
+	collection := m.status.Collection
+	sourceCount, sourceSum, err := checksum(ctx, m.source, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to checksum source: %w", err)
+	}
+	targetCount, targetSum, err := checksum(ctx, m.target, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to checksum target: %w", err)
+	}
+
+	result := &VerifyResult{
+		SourceDocuments: sourceCount,
+		TargetDocuments: targetCount,
+		SourceChecksum:  sourceSum,
+		TargetChecksum:  targetSum,
+		Match:           sourceCount == targetCount && sourceSum == targetSum,
+		VerifiedAt:      time.Now().UTC(),
+	}
+	m.update(func(s *Status) {
+		s.Verify = result
+		s.Error = ""
+	})
+	return result, nil
+}
+
+// mirror는 active에 쓴 문서들의 현재 상태를 반대편 저장소에 반영합니다
+// 문서마다 ID 잠금을 잡고 active에서 다시 읽어 쓰므로, 같은 문서의 반영 순서가 바뀌어도 마지막 반영이 최신 상태를 씁니다
+func (m *Migration) mirror(ctx context.Context, active, mirror repository.DocumentRepository, ids []string) {
+	collection := m.status.Collection
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if err := m.syncID(ctx, active, mirror, collection, id); err != nil {
+			m.mu.Lock()
+			if len(m.failed) < maxFailedIDs {
+				m.failed[id] = struct{}{}
+			}
+			m.status.MirrorFailures++
+			m.mu.Unlock()
+			logger.Warn(ctx, "migration dual-write failed",
+				zap.String("migration_id", m.status.ID),
+				zap.String("collection", collection),
+				zap.String("document_id", id),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// syncID는 문서 하나를 from의 현재 상태로 to에 반영합니다 (from에 없으면 to에서도 삭제)
+func (m *Migration) syncID(ctx context.Context, from, to repository.DocumentRepository, collection, id string) error {
+	lock := &m.locks[stripe(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	m.mu.Lock()
+	if m.touched != nil {
+		m.touched[id] = struct{}{}
+	}
+	m.mu.Unlock()
+
+	doc, err := from.FindByID(ctx, collection, id)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	if err := to.Delete(ctx, collection, id); err != nil && !isNotFound(err) {
+		return err
+	}
+	if doc == nil || err != nil {
+		return nil
+	}
+	return to.Save(ctx, doc)
+}
+
+// retryFailed는 반영에 실패했던 문서를 현재 라우팅 방향으로 다시 반영합니다
+func (m *Migration) retryFailed(ctx context.Context) {
+	m.gate.RLock()
+	defer m.gate.RUnlock()
+
+	active, mirror, ok := m.routes()
+	if !ok || mirror == nil {
+		return
+	}
+
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.failed))
+	for id := range m.failed {
+		ids = append(ids, id)
+	}
+	m.failed = make(map[string]struct{})
+	m.mu.Unlock()
+
+	m.mirror(ctx, active, mirror, ids)
+}
+
+// checksum은 컬렉션의 문서 수와 순서 무관 체크섬을 계산합니다
+func checksum(ctx context.Context, repo repository.DocumentRepository, collection string) (int64, string, error) {
+	it, err := repo.FindStream(ctx, collection, map[string]interface{}{}, &repository.FindOptions{})
+	if err != nil {
+		return 0, "", err
+	}
+	defer it.Close(ctx)
+
+	var count int64
+	var sum [sha256.Size]byte
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return 0, "", err
+		}
+		digest, err := documentDigest(doc)
+		if err != nil {
+			return 0, "", err
+		}
+		for i := range sum {
+			sum[i] ^= digest[i]
+		}
+		count++
+	}
+	if err := it.Err(); err != nil {
+		return 0, "", err
+	}
+	return count, fmt.Sprintf("%x", sum), nil
+}
+
+// documentDigest는 문서 ID, 버전, 데이터의 해시입니다
+// 데이터는 JSON으로 왕복해 백엔드마다 다른 숫자 타입(int32, int64, float64)과 키 순서를 정규화합니다
+func documentDigest(doc *entity.Document) ([sha256.Size]byte, error) {
+	data := make(map[string]interface{}, len(doc.Data()))
+	for k, v := range doc.Data() {
+		if k != "_id" {
+			data[k] = v
+		}
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("failed to encode document %s: %w", doc.ID(), err)
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	canonical, err := json.Marshal(normalized)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(doc.ID()))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.Itoa(doc.Version())))
+	h.Write([]byte{0})
+	h.Write(canonical)
+	var digest [sha256.Size]byte
+	copy(digest[:], h.Sum(nil))
+	return digest, nil
+}
+
+// Coordinator는 진행 중인 마이그레이션과 백엔드별 원래 저장소를 관리합니다
+// 마이그레이션 상태는 이 인스턴스의 메모리에 보관되므로 재시작하면 원래 라우팅으로 돌아갑니다
+type Coordinator struct {
+	mu         sync.RWMutex
+	repos      map[string]repository.DocumentRepository
+	migrations map[string]*Migration
+}
+
+// NewCoordinator는 새로운 Coordinator를 생성합니다
+func NewCoordinator() *Coordinator {
+	return &Coordinator{
+		repos:      make(map[string]repository.DocumentRepository),
+		migrations: make(map[string]*Migration),
+	}
+}
+
+// Wrap은 dbType의 주 저장소를 등록하고, 마이그레이션 중인 컬렉션의 작업을 라우팅하는 저장소로 감쌉니다
+func (c *Coordinator) Wrap(dbType string, repo repository.DocumentRepository) repository.DocumentRepository {
+	c.mu.Lock()
+	c.repos[dbType] = repo
+	c.mu.Unlock()
+	return &Repository{DocumentRepository: repo, dbType: dbType, coordinator: c}
+}
+
+// WrapReplica는 dbType의 읽기 복제본을 감쌉니다 (전환된 컬렉션의 읽기는 대상 주 저장소로 보냄)
+func (c *Coordinator) WrapReplica(dbType string, repo repository.DocumentRepository) repository.DocumentRepository {
+	return &Repository{DocumentRepository: repo, dbType: dbType, coordinator: c, replica: true}
+}
+
+// Start는 collection을 source에서 target으로 옮기는 마이그레이션을 시작합니다
+// 반환 즉시 source로 가는 쓰기가 target에도 반영되며, 호출자는 Backfill과 Verify를 실행해야 합니다
+func (c *Coordinator) Start(collection, source, target string) (*Migration, error) {
+	if source == target {
+		return nil, fmt.Errorf("source and target must differ")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sourceRepo, ok := c.repos[source]
+	if !ok {
+		return nil, fmt.Errorf("%s repository not initialized", source)
+	}
+	targetRepo, ok := c.repos[target]
+	if !ok {
+		return nil, fmt.Errorf("%s repository not initialized", target)
+	}
+	if m := c.involvingLocked(collection, source, target); m != nil {
+		return nil, fmt.Errorf("%w: migration %s", ErrInProgress, m.status.ID)
+	}
+
+	now := time.Now().UTC()
+	m := &Migration{
+		source: sourceRepo,
+		target: targetRepo,
+		status: Status{
+			ID:         uuid.New().String(),
+			Collection: collection,
+			Source:     source,
+			Target:     target,
+			Phase:      PhaseBackfilling,
+			StartedAt:  now,
+			UpdatedAt:  now,
+		},
+		touched: make(map[string]struct{}),
+		failed:  make(map[string]struct{}),
+	}
+	c.migrations[m.status.ID] = m
+	return m, nil
+}
+
+// CheckAvailable은 collection이 dbTypes 중 하나를 원본이나 대상으로 하는 진행 중인 마이그레이션에 쓰이지 않는지 확인합니다
+func (c *Coordinator) CheckAvailable(collection string, dbTypes ...string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if m := c.involvingLocked(collection, dbTypes...); m != nil {
+		return fmt.Errorf("%w: migration %s", ErrInProgress, m.status.ID)
+	}
+	return nil
+}
+
+// involvingLocked는 dbTypes 중 하나를 원본이나 대상으로 하는 collection의 끝나지 않은 마이그레이션을 반환합니다 (c.mu를 잡은 상태로 호출)
+func (c *Coordinator) involvingLocked(collection string, dbTypes ...string) *Migration {
+	for _, m := range c.migrations {
+		if m.status.Collection != collection {
+			continue
+		}
+		if _, mirror, routed := m.routes(); !routed || mirror == nil {
+			continue
+		}
+		for _, dbType := range dbTypes {
+			if m.status.Source == dbType || m.status.Target == dbType {
+				return m
+			}
+		}
+	}
+	return nil
+}
+
+// Get은 마이그레이션을 반환합니다
+func (c *Coordinator) Get(id string) (*Migration, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m, ok := c.migrations[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return m, nil
+}
+
+// List는 마이그레이션 목록을 시작한 순서로 반환합니다
+func (c *Coordinator) List() []*Migration {
+	c.mu.RLock()
+	migrations := make([]*Migration, 0, len(c.migrations))
+	for _, m := range c.migrations {
+		migrations = append(migrations, m)
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].status.StartedAt.Before(migrations[j].status.StartedAt)
+	})
+	return migrations
+}
+
+// lookup은 dbType에서 옮겨 가는 collection의 라우팅 중인 마이그레이션을 반환합니다
+func (c *Coordinator) lookup(dbType, collection string) *Migration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, m := range c.migrations {
+		if m.status.Source != dbType || m.status.Collection != collection {
+			continue
+		}
+		if _, _, ok := m.routes(); ok {
+			return m
+		}
+	}
+	return nil
+}
+
+// stripe는 문서 ID의 잠금 번호입니다
+func stripe(id string) int {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return int(h.Sum32() % lockStripes)
+}
+
+// isNotFound는 저장소의 문서 없음 에러인지 확인합니다 (백엔드마다 에러 값이 달라 메시지도 확인)
+func isNotFound(err error) bool {
+	return errors.Is(err, entity.ErrDocumentNotFound) || strings.Contains(err.Error(), "not found")
+}
@@ -0,0 +1,20 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// FindReferencing은 현재 작업을 받는 저장소에서 data의 field 값이 id인 문서의 ID를 조회합니다
+func (r *Repository) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+
+	finder, ok := active.(repository.ReferenceFinder)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support references")
+	}
+	return finder.FindReferencing(ctx, collection, field, id, limit)
+}
@@ -0,0 +1,416 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Repository는 마이그레이션 중인 컬렉션의 작업을 단계에 맞는 백엔드로 보내는 DocumentRepository입니다
+// 쓰기는 현재 백엔드에 실행한 뒤 바뀐 문서를 반대편 백엔드에 반영하고, 마이그레이션하지 않는 컬렉션은 감싼 저장소로 그대로 전달합니다
+type Repository struct {
+	repository.DocumentRepository
+
+	dbType      string
+	coordinator *Coordinator
+	replica     bool // 읽기 복제본이면 전환 전에는 복제본에서 읽음
+}
+
+// route는 collection 작업을 보낼 저장소와 쓰기를 반영할 저장소를 반환합니다
+// 마이그레이션 중이면 작업이 끝날 때까지 전환되지 않도록 release를 호출할 때까지 gate를 잡습니다
+func (r *Repository) route(collection string) (m *Migration, active, mirror repository.DocumentRepository, release func()) {
+	m = r.coordinator.lookup(r.dbType, collection)
+	if m == nil {
+		return nil, r.DocumentRepository, nil, func() {}
+	}
+
+	m.gate.RLock()
+	active, mirror, ok := m.routes()
+	if !ok {
+		m.gate.RUnlock()
+		return nil, r.DocumentRepository, nil, func() {}
+	}
+	if r.replica {
+		mirror = nil
+		if active == m.source {
+			active = r.DocumentRepository
+		}
+	}
+	return m, active, mirror, m.gate.RUnlock
+}
+
+// propagate는 쓰기로 바뀐 문서를 반대편 저장소에 반영합니다 (반영하지 않는 단계면 아무것도 하지 않음)
+func (m *Migration) propagate(ctx context.Context, active, mirror repository.DocumentRepository, ids ...string) {
+	if m == nil || mirror == nil {
+		return
+	}
+	m.mirror(ctx, active, mirror, ids)
+}
+
+// matchingIDs는 필터와 일치하는 문서 ID를 반환합니다 (반영할 저장소가 없으면 조회하지 않음)
+// 필터 기반 쓰기 후에는 더 이상 필터와 일치하지 않거나 삭제될 수 있으므로 쓰기 전에 조회합니다
+func matchingIDs(ctx context.Context, active, mirror repository.DocumentRepository, collection string, filter map[string]interface{}) ([]string, error) {
+	if mirror == nil {
+		return nil, nil
+	}
+	it, err := active.FindStream(ctx, collection, filter, &repository.FindOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find documents to mirror: %w", err)
+	}
+	defer it.Close(ctx)
+
+	var ids []string
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, doc.ID())
+	}
+	return ids, it.Err()
+}
+
+// ===== 기본 CRUD =====
+
+// Save는 문서를 저장합니다
+func (r *Repository) Save(ctx context.Context, doc *entity.Document) error {
+	m, active, mirror, release := r.route(doc.Collection())
+	defer release()
+
+	if err := active.Save(ctx, doc); err != nil {
+		return err
+	}
+	m.propagate(ctx, active, mirror, doc.ID())
+	return nil
+}
+
+// SaveMany는 여러 문서를 한 번에 저장합니다
+func (r *Repository) SaveMany(ctx context.Context, docs []*entity.Document) error {
+	if len(docs) == 0 {
+		return r.DocumentRepository.SaveMany(ctx, docs)
+	}
+	m, active, mirror, release := r.route(docs[0].Collection())
+	defer release()
+
+	err := active.SaveMany(ctx, docs)
+	if mirror != nil {
+		// 일부만 저장되었을 수 있으므로 실패해도 반영
+		ids := make([]string, len(docs))
+		for i, doc := range docs {
+			ids[i] = doc.ID()
+		}
+		m.propagate(ctx, active, mirror, ids...)
+	}
+	return err
+}
+
+// FindByID는 ID로 문서를 조회합니다
+func (r *Repository) FindByID(ctx context.Context, collection, id string) (*entity.Document, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.FindByID(ctx, collection, id)
+}
+
+// FindAll은 컬렉션의 모든 문서를 조회합니다
+func (r *Repository) FindAll(ctx context.Context, collection string, filter map[string]interface{}) ([]*entity.Document, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.FindAll(ctx, collection, filter)
+}
+
+// FindWithOptions는 옵션을 사용하여 문서를 조회합니다
+func (r *Repository) FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) ([]*entity.Document, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.FindWithOptions(ctx, collection, filter, opts)
+}
+
+// FindStream은 조회 결과를 커서로 반환합니다 (커서를 연 저장소에서 끝까지 읽음)
+func (r *Repository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.FindStream(ctx, collection, filter, opts)
+}
+
+// Update는 문서를 업데이트합니다
+func (r *Repository) Update(ctx context.Context, doc *entity.Document) error {
+	m, active, mirror, release := r.route(doc.Collection())
+	defer release()
+
+	if err := active.Update(ctx, doc); err != nil {
+		return err
+	}
+	m.propagate(ctx, active, mirror, doc.ID())
+	return nil
+}
+
+// UpdateMany는 필터와 일치하는 여러 문서를 업데이트합니다
+func (r *Repository) UpdateMany(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (int64, error) {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	ids, err := matchingIDs(ctx, active, mirror, collection, filter)
+	if err != nil {
+		return 0, err
+	}
+	n, err := active.UpdateMany(ctx, collection, filter, update)
+	m.propagate(ctx, active, mirror, ids...)
+	return n, err
+}
+
+// Replace는 문서를 교체합니다
+func (r *Repository) Replace(ctx context.Context, collection, id string, replacement *entity.Document) error {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	if err := active.Replace(ctx, collection, id, replacement); err != nil {
+		return err
+	}
+	m.propagate(ctx, active, mirror, id)
+	return nil
+}
+
+// Delete는 문서를 삭제합니다
+func (r *Repository) Delete(ctx context.Context, collection, id string) error {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	if err := active.Delete(ctx, collection, id); err != nil {
+		return err
+	}
+	m.propagate(ctx, active, mirror, id)
+	return nil
+}
+
+// DeleteMany는 필터와 일치하는 여러 문서를 삭제합니다
+func (r *Repository) DeleteMany(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	ids, err := matchingIDs(ctx, active, mirror, collection, filter)
+	if err != nil {
+		return 0, err
+	}
+	n, err := active.DeleteMany(ctx, collection, filter)
+	m.propagate(ctx, active, mirror, ids...)
+	return n, err
+}
+
+// ===== 원자적 연산 =====
+
+// FindAndUpdate는 문서를 찾아서 업데이트하고 업데이트된 문서를 반환합니다
+func (r *Repository) FindAndUpdate(ctx context.Context, collection, id string, update map[string]interface{}) (*entity.Document, error) {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	doc, err := active.FindAndUpdate(ctx, collection, id, update)
+	if err != nil {
+		return nil, err
+	}
+	m.propagate(ctx, active, mirror, id)
+	return doc, nil
+}
+
+// FindOneAndReplace는 문서를 찾아서 교체하고 교체된 문서를 반환합니다
+func (r *Repository) FindOneAndReplace(ctx context.Context, collection, id string, replacement *entity.Document) (*entity.Document, error) {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	doc, err := active.FindOneAndReplace(ctx, collection, id, replacement)
+	if err != nil {
+		return nil, err
+	}
+	m.propagate(ctx, active, mirror, id)
+	return doc, nil
+}
+
+// FindOneAndDelete는 문서를 찾아서 삭제하고 삭제된 문서를 반환합니다
+func (r *Repository) FindOneAndDelete(ctx context.Context, collection, id string) (*entity.Document, error) {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	doc, err := active.FindOneAndDelete(ctx, collection, id)
+	if err != nil {
+		return nil, err
+	}
+	m.propagate(ctx, active, mirror, id)
+	return doc, nil
+}
+
+// Upsert는 문서가 없으면 생성하고 있으면 업데이트합니다
+func (r *Repository) Upsert(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (string, error) {
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	ids, err := matchingIDs(ctx, active, mirror, collection, filter)
+	if err != nil {
+		return "", err
+	}
+	id, err := active.Upsert(ctx, collection, filter, update)
+	if err != nil {
+		return "", err
+	}
+	m.propagate(ctx, active, mirror, append(ids, id)...)
+	return id, nil
+}
+
+// ===== 집계 =====
+
+// Aggregate는 집계 파이프라인을 실행합니다
+func (r *Repository) Aggregate(ctx context.Context, collection string, pipeline []bson.M) ([]map[string]interface{}, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.Aggregate(ctx, collection, pipeline)
+}
+
+// Distinct는 고유한 값을 조회합니다
+func (r *Repository) Distinct(ctx context.Context, collection, field string, filter map[string]interface{}) ([]interface{}, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.Distinct(ctx, collection, field, filter)
+}
+
+// Count는 문서 개수를 반환합니다
+func (r *Repository) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.Count(ctx, collection, filter)
+}
+
+// EstimatedDocumentCount는 컬렉션의 추정 문서 개수를 반환합니다
+func (r *Repository) EstimatedDocumentCount(ctx context.Context, collection string) (int64, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.EstimatedDocumentCount(ctx, collection)
+}
+
+// ===== 벌크 작업 =====
+
+// BulkWrite는 여러 작업을 한 번에 실행합니다
+// 마이그레이션 중인 컬렉션의 작업은 다른 컬렉션의 작업과 섞을 수 없습니다 (컬렉션마다 백엔드가 다를 수 있음)
+func (r *Repository) BulkWrite(ctx context.Context, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
+	collections := make(map[string]bool)
+	for _, op := range operations {
+		collections[op.Collection] = true
+	}
+	var collection string
+	for name := range collections {
+		if r.coordinator.lookup(r.dbType, name) == nil {
+			continue
+		}
+		if len(collections) > 1 {
+			return nil, fmt.Errorf("%w: bulk write on %s cannot include other collections", ErrInProgress, name)
+		}
+		collection = name
+	}
+	if collection == "" {
+		return r.DocumentRepository.BulkWrite(ctx, operations)
+	}
+
+	m, active, mirror, release := r.route(collection)
+	defer release()
+
+	var ids []string
+	if mirror != nil {
+		for _, op := range operations {
+			switch {
+			case op.Type == "insert" && op.Document != nil:
+				ids = append(ids, op.Document.ID())
+			case op.Type == "replace" && op.ReplaceOneID != "":
+				ids = append(ids, op.ReplaceOneID)
+			case op.Filter != nil:
+				matched, err := matchingIDs(ctx, active, mirror, collection, op.Filter)
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, matched...)
+			}
+		}
+	}
+
+	result, err := active.BulkWrite(ctx, operations)
+	if result != nil {
+		for _, id := range result.UpsertedIDs {
+			switch v := id.(type) {
+			case string:
+				ids = append(ids, v)
+			case interface{ Hex() string }:
+				ids = append(ids, v.Hex())
+			}
+		}
+	}
+	// 일부 작업만 반영되었을 수 있으므로 실패해도 반영
+	m.propagate(ctx, active, mirror, ids...)
+	return result, err
+}
+
+// ===== 인덱스/컬렉션 관리 =====
+
+// CreateIndex는 현재 백엔드에 인덱스를 생성합니다 (반대편 인덱스는 마이그레이션 전에 준비)
+func (r *Repository) CreateIndex(ctx context.Context, collection string, model repository.IndexModel) (string, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.CreateIndex(ctx, collection, model)
+}
+
+// CreateIndexes는 현재 백엔드에 여러 인덱스를 생성합니다
+func (r *Repository) CreateIndexes(ctx context.Context, collection string, models []repository.IndexModel) ([]string, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.CreateIndexes(ctx, collection, models)
+}
+
+// DropIndex는 현재 백엔드의 인덱스를 삭제합니다
+func (r *Repository) DropIndex(ctx context.Context, collection, indexName string) error {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.DropIndex(ctx, collection, indexName)
+}
+
+// ListIndexes는 현재 백엔드의 인덱스 목록을 반환합니다
+func (r *Repository) ListIndexes(ctx context.Context, collection string) ([]map[string]interface{}, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.ListIndexes(ctx, collection)
+}
+
+// CollectionExists는 현재 백엔드에 컬렉션이 존재하는지 확인합니다
+func (r *Repository) CollectionExists(ctx context.Context, name string) (bool, error) {
+	_, active, _, release := r.route(name)
+	defer release()
+	return active.CollectionExists(ctx, name)
+}
+
+// DropCollection은 컬렉션을 삭제합니다 (이 백엔드가 원본이나 대상인 마이그레이션이 끝나기 전에는 거부)
+func (r *Repository) DropCollection(ctx context.Context, name string) error {
+	if err := r.coordinator.CheckAvailable(name, r.dbType); err != nil {
+		return err
+	}
+	_, active, _, release := r.route(name)
+	defer release()
+	return active.DropCollection(ctx, name)
+}
+
+// RenameCollection은 컬렉션 이름을 변경합니다 (마이그레이션 라우팅은 이름 기준이므로 라우팅 중에는 거부)
+func (r *Repository) RenameCollection(ctx context.Context, oldName, newName string) error {
+	for _, name := range []string{oldName, newName} {
+		if err := r.coordinator.CheckAvailable(name, r.dbType); err != nil {
+			return err
+		}
+		if r.coordinator.lookup(r.dbType, name) != nil {
+			return fmt.Errorf("%w: %s", ErrInProgress, name)
+		}
+	}
+	return r.DocumentRepository.RenameCollection(ctx, oldName, newName)
+}
+
+// Watch는 현재 백엔드에서 컬렉션의 변경 사항을 감지합니다
+func (r *Repository) Watch(ctx context.Context, collection string, pipeline []bson.M) (*mongo.ChangeStream, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+	return active.Watch(ctx, collection, pipeline)
+}
@@ -0,0 +1,30 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// CollectionStats는 현재 작업을 받는 저장소의 컬렉션 통계를 반환합니다
+func (r *Repository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+
+	reader, ok := active.(repository.CollectionStatsReader)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support collection stats")
+	}
+	return reader.CollectionStats(ctx, collection)
+}
+
+// DatabaseStats는 감싼 저장소의 데이터베이스 통계를 반환합니다
+// 데이터베이스 단위 통계이므로 마이그레이션 중인 컬렉션도 라우팅과 관계없이 감싼 저장소 기준입니다
+func (r *Repository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	reader, ok := r.DocumentRepository.(repository.DatabaseStatsReader)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support database stats")
+	}
+	return reader.DatabaseStats(ctx)
+}
@@ -0,0 +1,44 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnsureUniqueKey는 현재 작업을 받는 저장소와, 마이그레이션 중이면 반대편 저장소에도 고유 제약을 준비합니다
+// 양쪽 모두 스스로 중복을 거부할 때만 true를 반환합니다
+func (r *Repository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	_, active, mirror, release := r.route(collection)
+	defer release()
+
+	native := true
+	for _, repo := range []repository.DocumentRepository{active, mirror} {
+		if repo == nil {
+			continue
+		}
+		enforcer, ok := repo.(repository.UniqueKeyEnforcer)
+		if !ok {
+			return false, fmt.Errorf("repository does not support unique keys")
+		}
+		ok, err := enforcer.EnsureUniqueKey(ctx, collection, fields)
+		if err != nil {
+			return false, err
+		}
+		native = native && ok
+	}
+	return native, nil
+}
+
+// FindByUniqueKey는 현재 작업을 받는 저장소에서 key와 같은 값을 가진 문서의 ID를 조회합니다
+func (r *Repository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	_, active, _, release := r.route(collection)
+	defer release()
+
+	enforcer, ok := active.(repository.UniqueKeyEnforcer)
+	if !ok {
+		return nil, fmt.Errorf("repository does not support unique keys")
+	}
+	return enforcer.FindByUniqueKey(ctx, collection, key, limit)
+}
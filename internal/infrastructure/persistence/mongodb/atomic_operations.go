@@ -112,6 +112,7 @@ func (r *DocumentRepository) FindOneAndReplace(ctx context.Context, collection,
 		Version:    replacement.Version() + 1,
 		CreatedAt:  replacement.CreatedAt(),
 		UpdatedAt:  time.Now(),
+		ExpiresAt:  modelExpiresAt(replacement),
 	}
 
 	// 교체 후의 문서를 반환
@@ -0,0 +1,255 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+const (
+	// AuditCollectionName은 감사 로그 컬렉션 이름입니다
+	AuditCollectionName = "_audit_log"
+
+	auditTTLIndexName = "audit_ttl"
+
+	// indexOptionsConflictCode는 동일 키에 다른 옵션의 인덱스가 있을 때의 에러 코드입니다
+	indexOptionsConflictCode = 85
+)
+
+// AuditRepository는 MongoDB 기반 감사 로그 저장소입니다
+type AuditRepository struct {
+	collection *mongo.Collection
+}
+
+// auditModel은 MongoDB에 저장되는 감사 기록 모델입니다
+// 필터는 $ 연산자를 필드명으로 저장하지 않도록 JSON 문자열로 보관합니다
+type auditModel struct {
+	ID                 primitive.ObjectID     `bson:"_id,omitempty"`
+	Timestamp          time.Time              `bson:"timestamp"`
+	Operation          string                 `bson:"operation"`
+	DatabaseType       string                 `bson:"database_type,omitempty"`
+	Collection         string                 `bson:"collection,omitempty"`
+	DocumentID         string                 `bson:"document_id,omitempty"`
+	Actor              string                 `bson:"actor,omitempty"`
+	ActorName          string                 `bson:"actor_name,omitempty"`
+	TenantID           string                 `bson:"tenant_id,omitempty"`
+	ImpersonatedBy     string                 `bson:"impersonated_by,omitempty"`
+	ImpersonatedByName string                 `bson:"impersonated_by_name,omitempty"`
+	RequestID          string                 `bson:"request_id,omitempty"`
+	Filter             string                 `bson:"filter,omitempty"`
+	Before             map[string]interface{} `bson:"before,omitempty"`
+	After              map[string]interface{} `bson:"after,omitempty"`
+	BeforeVersion      int                    `bson:"before_version,omitempty"`
+	AfterVersion       int                    `bson:"after_version,omitempty"`
+	AffectedCount      int64                  `bson:"affected_count,omitempty"`
+	Success            bool                   `bson:"success"`
+	Error              string                 `bson:"error,omitempty"`
+}
+
+// NewAuditRepository는 새로운 감사 로그 저장소를 생성합니다
+func NewAuditRepository(database *mongo.Database) *AuditRepository {
+	return &AuditRepository{
+		collection: database.Collection(AuditCollectionName),
+	}
+}
+
+// Append는 감사 기록을 추가합니다
+func (r *AuditRepository) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	model := &auditModel{
+		Timestamp:          entry.Timestamp,
+		Operation:          string(entry.Operation),
+		DatabaseType:       entry.DatabaseType,
+		Collection:         entry.Collection,
+		DocumentID:         entry.DocumentID,
+		Actor:              entry.Actor,
+		ActorName:          entry.ActorName,
+		TenantID:           entry.TenantID,
+		ImpersonatedBy:     entry.ImpersonatedBy,
+		ImpersonatedByName: entry.ImpersonatedByName,
+		RequestID:          entry.RequestID,
+		Before:             entry.Before,
+		After:              entry.After,
+		BeforeVersion:      entry.BeforeVersion,
+		AfterVersion:       entry.AfterVersion,
+		AffectedCount:      entry.AffectedCount,
+		Success:            entry.Success,
+		Error:              entry.Error,
+	}
+
+	if len(entry.Filter) > 0 {
+		filterJSON, err := json.Marshal(entry.Filter)
+		if err != nil {
+			return fmt.Errorf("failed to encode audit filter: %w", err)
+		}
+		model.Filter = string(filterJSON)
+	}
+
+	result, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		entry.ID = oid.Hex()
+	}
+
+	return nil
+}
+
+// Query는 조건에 맞는 감사 기록을 최신순으로 조회합니다
+func (r *AuditRepository) Query(ctx context.Context, query *repository.AuditQuery) ([]*entity.AuditEntry, int64, error) {
+	filter := bson.M{}
+	if query.Collection != "" {
+		filter["collection"] = query.Collection
+	}
+	if query.DocumentID != "" {
+		filter["document_id"] = query.DocumentID
+	}
+	if query.Actor != "" {
+		filter["actor"] = query.Actor
+	}
+	if query.TenantID != "" {
+		filter["tenant_id"] = query.TenantID
+	}
+	if query.ImpersonatedBy != "" {
+		filter["impersonated_by"] = query.ImpersonatedBy
+	}
+	if query.RequestID != "" {
+		filter["request_id"] = query.RequestID
+	}
+	if query.Operation != "" {
+		filter["operation"] = string(query.Operation)
+	}
+	if !query.From.IsZero() || !query.To.IsZero() {
+		timeRange := bson.M{}
+		if !query.From.IsZero() {
+			timeRange["$gte"] = query.From
+		}
+		if !query.To.IsZero() {
+			timeRange["$lte"] = query.To
+		}
+		filter["timestamp"] = timeRange
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	findOpts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: -1}})
+	if query.Limit > 0 {
+		findOpts.SetLimit(query.Limit)
+	}
+	if query.Skip > 0 {
+		findOpts.SetSkip(query.Skip)
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query audit entries: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var models []auditModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode audit entries: %w", err)
+	}
+
+	entries := make([]*entity.AuditEntry, 0, len(models))
+	for _, m := range models {
+		entry := &entity.AuditEntry{
+			ID:                 m.ID.Hex(),
+			Timestamp:          m.Timestamp,
+			Operation:          entity.AuditOperation(m.Operation),
+			DatabaseType:       m.DatabaseType,
+			Collection:         m.Collection,
+			DocumentID:         m.DocumentID,
+			Actor:              m.Actor,
+			ActorName:          m.ActorName,
+			TenantID:           m.TenantID,
+			ImpersonatedBy:     m.ImpersonatedBy,
+			ImpersonatedByName: m.ImpersonatedByName,
+			RequestID:          m.RequestID,
+			Before:             m.Before,
+			After:              m.After,
+			BeforeVersion:      m.BeforeVersion,
+			AfterVersion:       m.AfterVersion,
+			AffectedCount:      m.AffectedCount,
+			Success:            m.Success,
+			Error:              m.Error,
+		}
+		if m.Filter != "" {
+			_ = json.Unmarshal([]byte(m.Filter), &entry.Filter)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, total, nil
+}
+
+// EnsureIndexes는 조회용 인덱스와 보존 기간 TTL 인덱스를 생성합니다
+func (r *AuditRepository) EnsureIndexes(ctx context.Context, retention time.Duration) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "collection", Value: 1}, {Key: "document_id", Value: 1}, {Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "actor", Value: 1}, {Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "impersonated_by", Value: 1}, {Key: "timestamp", Value: -1}}, Options: options.Index().SetSparse(true)},
+		{Keys: bson.D{{Key: "request_id", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create audit indexes: %w", err)
+	}
+
+	if retention <= 0 {
+		// 영구 보존: 기존 TTL 인덱스가 있으면 제거
+		if _, err := r.collection.Indexes().DropOne(ctx, auditTTLIndexName); err != nil {
+			var cmdErr mongo.CommandError
+			if !errors.As(err, &cmdErr) || cmdErr.Name != "IndexNotFound" {
+				return fmt.Errorf("failed to drop audit ttl index: %w", err)
+			}
+		}
+		return nil
+	}
+
+	expireAfter := int32(retention.Seconds())
+	_, err = r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "timestamp", Value: 1}},
+		Options: options.Index().SetName(auditTTLIndexName).SetExpireAfterSeconds(expireAfter),
+	})
+	if err == nil {
+		return nil
+	}
+
+	// 보존 기간이 변경된 경우 collMod로 TTL만 갱신합니다
+	var cmdErr mongo.CommandError
+	if !errors.As(err, &cmdErr) || cmdErr.Code != indexOptionsConflictCode {
+		return fmt.Errorf("failed to create audit ttl index: %w", err)
+	}
+
+	cmd := bson.D{
+		{Key: "collMod", Value: AuditCollectionName},
+		{Key: "index", Value: bson.D{
+			{Key: "name", Value: auditTTLIndexName},
+			{Key: "expireAfterSeconds", Value: expireAfter},
+		}},
+	}
+	if err := r.collection.Database().RunCommand(ctx, cmd).Err(); err != nil {
+		return fmt.Errorf("failed to update audit retention: %w", err)
+	}
+
+	logger.Info(ctx, "audit log retention updated",
+		zap.Duration("retention", retention),
+	)
+
+	return nil
+}
@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
@@ -40,11 +42,13 @@ func (r *DocumentRepository) SaveMany(ctx context.Context, docs []*entity.Docume
 	models := make([]interface{}, len(docs))
 	for i, doc := range docs {
 		models[i] = &documentModel{
+			ID:         presetObjectID(doc),
 			Collection: doc.Collection(),
 			Data:       doc.Data(),
 			Version:    doc.Version(),
 			CreatedAt:  doc.CreatedAt(),
 			UpdatedAt:  doc.UpdatedAt(),
+			ExpiresAt:  modelExpiresAt(doc),
 		}
 	}
 
@@ -162,9 +166,19 @@ func (r *DocumentRepository) DeleteMany(ctx context.Context, collection string,
 
 // WithTransaction은 트랜잭션 내에서 함수를 실행합니다
 // MongoDB 트랜잭션은 replica set 또는 sharded cluster에서만 작동합니다
-func (r *DocumentRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+func (r *DocumentRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
 	start := time.Now()
 
+	txnOpts, err := transactionOptions(opts)
+	if err != nil {
+		return err
+	}
+	if opts != nil && opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
 	session, err := r.client.StartSession()
 	if err != nil {
 		logger.Error(ctx, "failed to start session",
@@ -179,7 +193,7 @@ func (r *DocumentRepository) WithTransaction(ctx context.Context, fn func(ctx co
 	// 트랜잭션 실행
 	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
 		return nil, fn(sessCtx)
-	})
+	}, txnOpts)
 
 	if err != nil {
 		logger.Error(ctx, "transaction failed",
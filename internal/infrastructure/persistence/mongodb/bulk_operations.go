@@ -10,6 +10,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 )
 
@@ -45,7 +46,7 @@ func (r *DocumentRepository) BulkWrite(ctx context.Context, operations []*reposi
 		coll := r.database.Collection(collName)
 
 		// 순서대로 실행하지 않음 (ordered=false) - 더 나은 성능
-		opts := mongo.NewBulkWriteOptions().SetOrdered(false)
+		opts := options.BulkWrite().SetOrdered(false)
 
 		bulkResult, err := coll.BulkWrite(ctx, models, opts)
 		if err != nil {
@@ -108,11 +109,13 @@ func (r *DocumentRepository) convertToBulkWriteModel(op *repository.BulkOperatio
 			return nil, fmt.Errorf("document is required for insert operation")
 		}
 		model := &documentModel{
+			ID:         presetObjectID(op.Document),
 			Collection: op.Document.Collection(),
 			Data:       op.Document.Data(),
 			Version:    op.Document.Version(),
 			CreatedAt:  op.Document.CreatedAt(),
 			UpdatedAt:  op.Document.UpdatedAt(),
+			ExpiresAt:  modelExpiresAt(op.Document),
 		}
 		return mongo.NewInsertOneModel().SetDocument(model), nil
 
@@ -179,6 +182,7 @@ func (r *DocumentRepository) convertToBulkWriteModel(op *repository.BulkOperatio
 			Version:    op.Document.Version() + 1,
 			CreatedAt:  op.Document.CreatedAt(),
 			UpdatedAt:  time.Now(),
+			ExpiresAt:  modelExpiresAt(op.Document),
 		}
 
 		model := mongo.NewReplaceOneModel().
@@ -0,0 +1,375 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.uber.org/zap"
+)
+
+// ResumeTokenCollectionName은 변경 스트림 재개 토큰을 저장하는 컬렉션입니다
+const ResumeTokenCollectionName = "_cdc_resume_tokens"
+
+// ChangeStreamConfig는 변경 스트림 구독 설정입니다
+type ChangeStreamConfig struct {
+	// Name은 재개 토큰을 구분하는 이름입니다 (브리지 인스턴스마다 고유)
+	Name string
+
+	// Collections는 구독할 컬렉션입니다 (비어 있으면 데이터베이스 전체)
+	Collections []string
+
+	// ExcludeCollections는 제외할 컬렉션입니다 (_로 시작하는 내부 컬렉션은 항상 제외)
+	ExcludeCollections []string
+
+	// CheckpointInterval은 재개 토큰 저장 주기입니다 (기본 5초)
+	CheckpointInterval time.Duration
+}
+
+const defaultCheckpointInterval = 5 * time.Second
+
+// ChangeStreamSource는 MongoDB 데이터베이스의 변경 스트림을 읽어 처리 함수에 전달합니다
+// 처리한 위치의 재개 토큰을 같은 데이터베이스의 _cdc_resume_tokens 컬렉션에 저장해 재시작 시 이어서 읽습니다
+// 마지막 저장 이후 처리한 변경은 재시작 시 다시 전달되므로(at-least-once) 처리 함수는 재실행에 안전해야 합니다
+type ChangeStreamSource struct {
+	client   *mongo.Client
+	database *mongo.Database
+	tokens   *mongo.Collection
+	config   ChangeStreamConfig
+	metrics  *metrics.Metrics
+}
+
+// NewChangeStreamSource는 새로운 변경 스트림 소스를 생성합니다
+// 변경 스트림은 replica set 또는 sharded cluster에서만 동작합니다
+func NewChangeStreamSource(cfg *Config, streamCfg ChangeStreamConfig) (*ChangeStreamSource, error) {
+	if streamCfg.Name == "" {
+		return nil, fmt.Errorf("change stream name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+	defer cancel()
+
+	clientOptions := options.Client().
+		ApplyURI(cfg.URI).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetServerSelectionTimeout(cfg.ConnectTimeout).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetReadPreference(readpref.Primary())
+
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	if err := client.Ping(ctx, readpref.Primary()); err != nil {
+		return nil, fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+
+	return NewChangeStreamSourceWithClient(client, cfg.Database, streamCfg)
+}
+
+// NewChangeStreamSourceWithClient는 이미 연결된 클라이언트로 변경 스트림 소스를 생성합니다
+// Close는 전달받은 클라이언트의 연결도 종료합니다
+func NewChangeStreamSourceWithClient(client *mongo.Client, database string, streamCfg ChangeStreamConfig) (*ChangeStreamSource, error) {
+	if streamCfg.Name == "" {
+		return nil, fmt.Errorf("change stream name is required")
+	}
+	if streamCfg.CheckpointInterval <= 0 {
+		streamCfg.CheckpointInterval = defaultCheckpointInterval
+	}
+
+	db := client.Database(database)
+	return &ChangeStreamSource{
+		client:   client,
+		database: db,
+		tokens:   db.Collection(ResumeTokenCollectionName),
+		config:   streamCfg,
+		metrics:  metrics.GetMetrics(),
+	}, nil
+}
+
+// Run은 저장된 재개 토큰(없으면 현재 시점)부터 변경을 읽어 handle에 전달합니다
+// 컨텍스트가 취소되거나 handle이 실패하면 마지막으로 처리한 위치를 저장하고 반환합니다 (취소 시 nil)
+func (s *ChangeStreamSource) Run(ctx context.Context, handle func(ctx context.Context, event *repository.ChangeEvent) error) error {
+	token, err := s.loadToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := options.ChangeStream().
+		SetFullDocument(options.UpdateLookup).
+		SetMaxAwaitTime(time.Second)
+	if token != nil {
+		opts.SetResumeAfter(token)
+	}
+
+	stream, err := s.database.Watch(ctx, s.pipeline(), opts)
+	if err != nil {
+		return fmt.Errorf("failed to create change stream: %w", err)
+	}
+	defer stream.Close(context.WithoutCancel(ctx))
+
+	logger.Info(ctx, "change stream started",
+		zap.String("name", s.config.Name),
+		zap.String("database", s.database.Name()),
+		zap.Bool("resumed", token != nil),
+	)
+
+	// 처리에 성공한 변경까지만 저장합니다 (취소된 컨텍스트에서도 마지막 위치는 남김)
+	var pending bson.Raw
+	lastCheckpoint := time.Now()
+	checkpoint := func() error {
+		if pending == nil {
+			return nil
+		}
+		saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		if err := s.saveToken(saveCtx, pending); err != nil {
+			return err
+		}
+		pending = nil
+		lastCheckpoint = time.Now()
+		return nil
+	}
+
+	for {
+		if stream.TryNext(ctx) {
+			event, err := decodeChangeEvent(stream.Current)
+			if err != nil {
+				if saveErr := checkpoint(); saveErr != nil {
+					logger.Error(ctx, "failed to save resume token", zap.Error(saveErr))
+				}
+				return err
+			}
+			if event != nil {
+				if err := handle(ctx, event); err != nil {
+					if saveErr := checkpoint(); saveErr != nil {
+						logger.Error(ctx, "failed to save resume token", zap.Error(saveErr))
+					}
+					return fmt.Errorf("failed to handle %s on %s/%s: %w", event.Operation, event.Collection, event.DocumentID, err)
+				}
+			}
+		} else if err := stream.Err(); err != nil {
+			if saveErr := checkpoint(); saveErr != nil {
+				logger.Error(ctx, "failed to save resume token", zap.Error(saveErr))
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("change stream failed: %w", err)
+		}
+
+		// 변경이 없어도 서버가 돌려주는 배치 후 재개 토큰으로 위치를 앞당깁니다 (오래된 oplog 범위를 벗어나지 않도록)
+		if resumeToken := stream.ResumeToken(); resumeToken != nil {
+			pending = resumeToken
+		}
+		if time.Since(lastCheckpoint) >= s.config.CheckpointInterval {
+			if err := checkpoint(); err != nil {
+				return err
+			}
+		}
+
+		if ctx.Err() != nil {
+			if err := checkpoint(); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// pipeline은 문서 변경만 대상 컬렉션으로 거르는 집계 파이프라인을 생성합니다
+func (s *ChangeStreamSource) pipeline() mongo.Pipeline {
+	conditions := bson.A{
+		bson.M{"operationType": bson.M{"$in": bson.A{
+			repository.ChangeOperationInsert, repository.ChangeOperationUpdate, repository.ChangeOperationReplace, repository.ChangeOperationDelete,
+		}}},
+		bson.M{"ns.coll": bson.M{"$not": primitive.Regex{Pattern: "^_"}}},
+	}
+	if len(s.config.Collections) > 0 {
+		conditions = append(conditions, bson.M{"ns.coll": bson.M{"$in": s.config.Collections}})
+	}
+	if len(s.config.ExcludeCollections) > 0 {
+		conditions = append(conditions, bson.M{"ns.coll": bson.M{"$nin": s.config.ExcludeCollections}})
+	}
+	return mongo.Pipeline{{{Key: "$match", Value: bson.M{"$and": conditions}}}}
+}
+
+// loadToken은 저장된 재개 토큰을 조회합니다 (없으면 nil)
+func (s *ChangeStreamSource) loadToken(ctx context.Context) (bson.Raw, error) {
+	var doc struct {
+		Token bson.Raw `bson:"token"`
+	}
+	err := s.tokens.FindOne(ctx, bson.M{"_id": s.config.Name}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load resume token: %w", err)
+	}
+	return doc.Token, nil
+}
+
+// saveToken은 재개 토큰을 저장합니다
+func (s *ChangeStreamSource) saveToken(ctx context.Context, token bson.Raw) error {
+	start := time.Now()
+	_, err := s.tokens.UpdateOne(ctx,
+		bson.M{"_id": s.config.Name},
+		bson.M{"$set": bson.M{"token": token, "updated_at": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		s.metrics.RecordDBOperation("save_resume_token", ResumeTokenCollectionName, "error", time.Since(start))
+		return fmt.Errorf("failed to save resume token: %w", err)
+	}
+	s.metrics.RecordDBOperation("save_resume_token", ResumeTokenCollectionName, "success", time.Since(start))
+	return nil
+}
+
+// ResetToken은 저장된 재개 토큰을 삭제합니다 (다음 Run은 현재 시점부터 읽음)
+// oplog가 재개 토큰 위치를 지나 ChangeStreamHistoryLost로 재개할 수 없을 때 사용합니다
+func (s *ChangeStreamSource) ResetToken(ctx context.Context) error {
+	if _, err := s.tokens.DeleteOne(ctx, bson.M{"_id": s.config.Name}); err != nil {
+		return fmt.Errorf("failed to reset resume token: %w", err)
+	}
+	return nil
+}
+
+// Close는 MongoDB 연결을 종료합니다
+func (s *ChangeStreamSource) Close(ctx context.Context) error {
+	return s.client.Disconnect(ctx)
+}
+
+// IsHistoryLost는 재개 토큰 위치가 oplog에서 사라져 재개할 수 없는 에러인지 확인합니다
+func IsHistoryLost(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && serverErr.HasErrorCode(286)
+}
+
+// changeDocument는 변경 스트림 이벤트 문서입니다
+type changeDocument struct {
+	OperationType string `bson:"operationType"`
+	NS            struct {
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	DocumentKey struct {
+		ID interface{} `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument      bson.M `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.M   `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+	ClusterTime primitive.Timestamp `bson:"clusterTime"`
+}
+
+// decodeChangeEvent는 변경 스트림 이벤트를 repository.ChangeEvent로 변환합니다 (문서 변경이 아니면 nil)
+// 이 서비스가 저장한 문서({collection, data, version, ...})는 data와 version을 꺼내고,
+// 서비스 밖에서 쓴 문서는 _id를 뺀 문서 전체를 data로 사용합니다
+func decodeChangeEvent(raw bson.Raw) (*repository.ChangeEvent, error) {
+	var doc changeDocument
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode change event: %w", err)
+	}
+
+	switch doc.OperationType {
+	case repository.ChangeOperationInsert, repository.ChangeOperationUpdate, repository.ChangeOperationReplace, repository.ChangeOperationDelete:
+	case "invalidate", "drop", "dropDatabase", "rename":
+		return nil, fmt.Errorf("change stream invalidated by %s on %s", doc.OperationType, doc.NS.Coll)
+	default:
+		return nil, nil
+	}
+
+	event := &repository.ChangeEvent{
+		Operation:  doc.OperationType,
+		Collection: doc.NS.Coll,
+		DocumentID: documentIDString(doc.DocumentKey.ID),
+		Timestamp:  time.Unix(int64(doc.ClusterTime.T), 0),
+	}
+
+	serviceDocument := false
+	if doc.FullDocument != nil {
+		if data, ok := doc.FullDocument["data"].(bson.M); ok {
+			if _, hasVersion := doc.FullDocument["version"]; hasVersion {
+				serviceDocument = true
+				event.Data = data
+				event.Version = toInt(doc.FullDocument["version"])
+			}
+		}
+		if !serviceDocument {
+			event.Data = make(map[string]interface{}, len(doc.FullDocument))
+			for k, v := range doc.FullDocument {
+				if k != "_id" {
+					event.Data[k] = v
+				}
+			}
+		}
+	}
+
+	if doc.OperationType == repository.ChangeOperationUpdate {
+		event.Changes = make(map[string]interface{}, len(doc.UpdateDescription.UpdatedFields)+len(doc.UpdateDescription.RemovedFields))
+		for field, value := range doc.UpdateDescription.UpdatedFields {
+			addChange(event.Changes, field, value, serviceDocument)
+		}
+		for _, field := range doc.UpdateDescription.RemovedFields {
+			addChange(event.Changes, field, nil, serviceDocument)
+		}
+	}
+	return event, nil
+}
+
+// addChange는 변경 필드를 changes에 추가합니다
+// 서비스 문서는 data. 접두사를 떼고, data 전체가 교체되면 최상위 필드별로 펼치며, 메타데이터 필드(version, updated_at 등)는 제외합니다
+func addChange(changes map[string]interface{}, field string, value interface{}, serviceDocument bool) {
+	if !serviceDocument {
+		changes[field] = value
+		return
+	}
+	if rest, ok := strings.CutPrefix(field, "data."); ok {
+		changes[rest] = value
+		return
+	}
+	if data, ok := value.(bson.M); ok && field == "data" {
+		for k, v := range data {
+			changes[k] = v
+		}
+	}
+}
+
+// documentIDString은 문서 ID를 문자열로 변환합니다 (ObjectID는 16진수)
+func documentIDString(id interface{}) string {
+	switch v := id.(type) {
+	case primitive.ObjectID:
+		return v.Hex()
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// toInt는 BSON 숫자 값을 int로 변환합니다
+func toInt(v interface{}) int {
+	switch n := v.(type) {
+	case int32:
+		return int(n)
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	case int:
+		return n
+	default:
+		return 0
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/YouSangSon/database-service/internal/domain/repository"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -113,3 +114,36 @@ func (r *DocumentRepository) WatchWithResumeToken(ctx context.Context, collectio
 
 	return stream, nil
 }
+
+// WatchChanges는 컬렉션 변경을 백엔드에 독립적인 ChangeFeed로 전달합니다 (repository.ChangeWatcher 구현)
+// 스트림이 무효화되거나(컬렉션 삭제/이름 변경) 에러가 나면 피드가 닫히고 Err로 원인을 반환합니다
+func (r *DocumentRepository) WatchChanges(ctx context.Context, collection string) (*repository.ChangeFeed, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{"operationType": bson.M{"$in": []string{
+			repository.ChangeOperationInsert,
+			repository.ChangeOperationUpdate,
+			repository.ChangeOperationReplace,
+			repository.ChangeOperationDelete,
+			"invalidate",
+		}}}},
+	}
+	stream, err := r.Watch(ctx, collection, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.NewChangeFeed(ctx, 64, func(ctx context.Context, emit func(repository.ChangeEvent) bool) error {
+		defer stream.Close(context.WithoutCancel(ctx))
+
+		for stream.Next(ctx) {
+			event, err := decodeChangeEvent(stream.Current)
+			if err != nil {
+				return err
+			}
+			if event != nil && !emit(*event) {
+				return nil
+			}
+		}
+		return stream.Err()
+	}), nil
+}
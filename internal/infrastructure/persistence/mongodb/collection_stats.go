@@ -0,0 +1,52 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// mongoStorageEngines는 collStats 결과에서 엔진별 상세 정보가 담기는 필드 이름입니다
+var mongoStorageEngines = []string{"wiredTiger", "inMemory"}
+
+// CollectionStats는 collStats 명령으로 컬렉션 통계를 반환합니다
+func (r *DocumentRepository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	start := time.Now()
+	var stats bson.M
+	if err := r.database.RunCommand(ctx, bson.D{{Key: "collStats", Value: collection}}).Decode(&stats); err != nil {
+		r.metrics.RecordDBOperation("collstats", collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to get collection stats: %w", err)
+	}
+	r.metrics.RecordDBOperation("collstats", collection, "success", time.Since(start))
+	return collectionStatsFrom(collection, stats), nil
+}
+
+// collectionStatsFrom은 collStats 결과를 컬렉션 통계로 변환합니다
+func collectionStatsFrom(collection string, stats bson.M) *repository.CollectionStats {
+	result := &repository.CollectionStats{
+		Collection:     collection,
+		Count:          toInt64(stats["count"]),
+		Size:           toInt64(stats["size"]),
+		AvgDocSize:     toFloat64(stats["avgObjSize"]),
+		StorageSize:    toInt64(stats["storageSize"]),
+		IndexCount:     toInt(stats["nindexes"]),
+		TotalIndexSize: toInt64(stats["totalIndexSize"]),
+	}
+
+	if sizes, ok := stats["indexSizes"].(bson.M); ok {
+		result.IndexSizes = make(map[string]int64, len(sizes))
+		for name, size := range sizes {
+			result.IndexSizes[name] = toInt64(size)
+		}
+	}
+	for _, engine := range mongoStorageEngines {
+		if _, ok := stats[engine]; ok {
+			result.StorageEngine = engine
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+// DatabaseStats는 dbStats, 컬렉션별 collStats, serverStatus의 연결 수로 데이터베이스 통계를 반환합니다
+// serverStatus는 clusterMonitor 권한이 필요하므로, 실패하면 연결 수 없이 반환합니다
+func (r *DocumentRepository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	start := time.Now()
+	var dbStats bson.M
+	if err := r.database.RunCommand(ctx, bson.D{{Key: "dbStats", Value: 1}}).Decode(&dbStats); err != nil {
+		r.metrics.RecordDBOperation("dbstats", "database", "error", time.Since(start))
+		return nil, fmt.Errorf("failed to get database stats: %w", err)
+	}
+	r.metrics.RecordDBOperation("dbstats", "database", "success", time.Since(start))
+
+	// 뷰와 시스템 컬렉션은 collStats를 지원하지 않으므로 일반 컬렉션만 조회
+	names, err := r.database.ListCollectionNames(ctx, bson.M{"type": "collection", "name": bson.M{"$not": bson.M{"$regex": "^system\\."}}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	sort.Strings(names)
+
+	perCollection := make([]*repository.CollectionStats, 0, len(names))
+	for _, name := range names {
+		stats, err := r.CollectionStats(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		perCollection = append(perCollection, stats)
+	}
+
+	result := &repository.DatabaseStats{
+		Collections:   toInt(dbStats["collections"]),
+		Documents:     toInt64(dbStats["objects"]),
+		DataSize:      toInt64(dbStats["dataSize"]),
+		StorageSize:   toInt64(dbStats["storageSize"]),
+		IndexSize:     toInt64(dbStats["indexSize"]),
+		PerCollection: perCollection,
+	}
+
+	var status struct {
+		Connections struct {
+			Current   int64 `bson:"current"`
+			Available int64 `bson:"available"`
+			Active    int64 `bson:"active"`
+		} `bson:"connections"`
+	}
+	err = r.client.Database("admin").RunCommand(ctx, bson.D{{Key: "serverStatus", Value: 1}}).Decode(&status)
+	if err != nil {
+		logger.Warn(ctx, "failed to get connection stats", zap.Error(err))
+		return result, nil
+	}
+	result.Connections = &repository.ConnectionStats{
+		Current:   status.Connections.Current,
+		Active:    status.Connections.Active,
+		Available: status.Connections.Available,
+	}
+	return result, nil
+}
@@ -0,0 +1,217 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DeadLetterCollectionName은 발행 실패 CDC 이벤트 컬렉션 이름입니다
+const DeadLetterCollectionName = "_cdc_dead_letters"
+
+// DeadLetterRepository는 MongoDB 기반 CDC DLQ 저장소입니다
+type DeadLetterRepository struct {
+	collection *mongo.Collection
+}
+
+// deadLetterModel은 MongoDB에 저장되는 DLQ 이벤트 모델입니다
+// 문서 데이터는 $ 연산자 키가 있어도 저장할 수 있도록 JSON 문자열로 보관합니다
+type deadLetterModel struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	EventType       string             `bson:"event_type"`
+	DocumentID      string             `bson:"document_id"`
+	Collection      string             `bson:"collection"`
+	Data            string             `bson:"data,omitempty"`
+	Version         int                `bson:"version"`
+	PreviousVersion int                `bson:"previous_version,omitempty"`
+	Changes         string             `bson:"changes,omitempty"`
+	Error           string             `bson:"error"`
+	Attempts        int                `bson:"attempts"`
+	FailedAt        time.Time          `bson:"failed_at"`
+	LastRedriveAt   time.Time          `bson:"last_redrive_at,omitempty"`
+}
+
+// NewDeadLetterRepository는 새로운 DLQ 저장소를 생성합니다
+func NewDeadLetterRepository(database *mongo.Database) *DeadLetterRepository {
+	return &DeadLetterRepository{
+		collection: database.Collection(DeadLetterCollectionName),
+	}
+}
+
+// EnsureIndexes는 조회용 인덱스를 생성합니다
+func (r *DeadLetterRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "failed_at", Value: 1}}},
+		{Keys: bson.D{{Key: "collection", Value: 1}, {Key: "failed_at", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create dead letter indexes: %w", err)
+	}
+	return nil
+}
+
+// Save는 실패한 이벤트를 저장합니다
+func (r *DeadLetterRepository) Save(ctx context.Context, event *entity.DeadLetterEvent) error {
+	model := &deadLetterModel{
+		EventType:       event.EventType,
+		DocumentID:      event.DocumentID,
+		Collection:      event.Collection,
+		Version:         event.Version,
+		PreviousVersion: event.PreviousVersion,
+		Error:           event.Error,
+		Attempts:        event.Attempts,
+		FailedAt:        event.FailedAt,
+	}
+
+	var err error
+	if model.Data, err = encodeDeadLetterField(event.Data); err != nil {
+		return err
+	}
+	if model.Changes, err = encodeDeadLetterField(event.Changes); err != nil {
+		return err
+	}
+
+	result, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to save dead letter event: %w", err)
+	}
+
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		event.ID = oid.Hex()
+	}
+	return nil
+}
+
+// FindByID는 ID로 이벤트를 조회합니다
+func (r *DeadLetterRepository) FindByID(ctx context.Context, id string) (*entity.DeadLetterEvent, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, entity.ErrDocumentNotFound
+	}
+
+	var model deadLetterModel
+	if err := r.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to find dead letter event: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// List는 조건에 맞는 이벤트를 오래된 순으로 조회합니다 (재발행 순서 보존)
+func (r *DeadLetterRepository) List(ctx context.Context, query *repository.DeadLetterQuery) ([]*entity.DeadLetterEvent, int64, error) {
+	filter := bson.M{}
+	if query.Collection != "" {
+		filter["collection"] = query.Collection
+	}
+	if query.EventType != "" {
+		filter["event_type"] = query.EventType
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count dead letter events: %w", err)
+	}
+
+	findOpts := options.Find().SetSort(bson.D{{Key: "failed_at", Value: 1}, {Key: "_id", Value: 1}})
+	if query.Limit > 0 {
+		findOpts.SetLimit(query.Limit)
+	}
+	if query.Skip > 0 {
+		findOpts.SetSkip(query.Skip)
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query dead letter events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var models []deadLetterModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode dead letter events: %w", err)
+	}
+
+	events := make([]*entity.DeadLetterEvent, 0, len(models))
+	for i := range models {
+		events = append(events, models[i].toEntity())
+	}
+	return events, total, nil
+}
+
+// Delete는 이벤트를 삭제합니다
+func (r *DeadLetterRepository) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return entity.ErrDocumentNotFound
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return fmt.Errorf("failed to delete dead letter event: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return entity.ErrDocumentNotFound
+	}
+	return nil
+}
+
+// RecordRedriveFailure는 재발행 실패를 기록합니다
+func (r *DeadLetterRepository) RecordRedriveFailure(ctx context.Context, id string, redriveErr string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return entity.ErrDocumentNotFound
+	}
+
+	update := bson.M{
+		"$set": bson.M{"error": redriveErr, "last_redrive_at": time.Now()},
+		"$inc": bson.M{"attempts": 1},
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid}, update); err != nil {
+		return fmt.Errorf("failed to record redrive failure: %w", err)
+	}
+	return nil
+}
+
+func (m *deadLetterModel) toEntity() *entity.DeadLetterEvent {
+	event := &entity.DeadLetterEvent{
+		ID:              m.ID.Hex(),
+		EventType:       m.EventType,
+		DocumentID:      m.DocumentID,
+		Collection:      m.Collection,
+		Version:         m.Version,
+		PreviousVersion: m.PreviousVersion,
+		Error:           m.Error,
+		Attempts:        m.Attempts,
+		FailedAt:        m.FailedAt,
+		LastRedriveAt:   m.LastRedriveAt,
+	}
+	if m.Data != "" {
+		_ = json.Unmarshal([]byte(m.Data), &event.Data)
+	}
+	if m.Changes != "" {
+		_ = json.Unmarshal([]byte(m.Changes), &event.Changes)
+	}
+	return event
+}
+
+func encodeDeadLetterField(value map[string]interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode dead letter payload: %w", err)
+	}
+	return string(data), nil
+}
@@ -2,12 +2,14 @@ package mongodb
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
-	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
 	"github.com/YouSangSon/database-service/internal/pkg/vault"
@@ -23,29 +25,33 @@ import (
 // MongoDBCommandRepository는 MongoDB 기반 쓰기 전용 저장소입니다 (CQRS Write Side)
 // Primary 노드에만 연결하여 쓰기 작업을 처리합니다
 type MongoDBCommandRepository struct {
-	client        *mongo.Client
-	database      *mongo.Database
-	metrics       *metrics.Metrics
-	cdcPublisher  *kafka.CDCPublisher
-	vaultClient   *vault.Client
-	cdcEnabled    bool
-	writeOptions  *repository.WriteOptions
+	client         *mongo.Client
+	database       *mongo.Database
+	documents      *DocumentRepository // 인덱스/컬렉션/raw 명령 로직 재사용 (같은 클라이언트 사용)
+	metrics        *metrics.Metrics
+	cdcPublisher   messaging.CDCPublisher
+	vaultClient    *vault.Client
+	mu             sync.RWMutex
+	cdcEnabled     bool
+	cdcCollections map[string]bool // 비어 있으면 모든 컬렉션
+	writeOptions   *repository.WriteOptions
 }
 
 // CommandConfig는 쓰기 저장소 설정입니다
 type CommandConfig struct {
-	URI              string
-	Database         string
-	MaxPoolSize      uint64
-	MinPoolSize      uint64
-	MaxConnecting    uint64
-	ConnectTimeout   time.Duration
-	Timeout          time.Duration
-	WriteConcern     string // "majority", "1", "2"
-	RetryWrites      bool
-	CDCEnabled       bool
-	CDCPublisher     *kafka.CDCPublisher
-	VaultClient      *vault.Client
+	URI            string
+	Database       string
+	MaxPoolSize    uint64
+	MinPoolSize    uint64
+	MaxConnecting  uint64
+	ConnectTimeout time.Duration
+	Timeout        time.Duration
+	WriteConcern   string // "majority", "1", "2"
+	RetryWrites    bool
+	CDCEnabled     bool
+	CDCPublisher   messaging.CDCPublisher
+	VaultClient    *vault.Client
+	PoolMonitor    *PoolMonitor // 연결 풀 상태 집계 (nil이면 집계하지 않음)
 }
 
 // NewMongoDBCommandRepository는 새로운 MongoDB 쓰기 저장소를 생성합니다
@@ -79,6 +85,9 @@ func NewMongoDBCommandRepository(ctx context.Context, cfg *CommandConfig) (repos
 		SetReadPreference(readpref.Primary()). // 쓰기는 항상 Primary
 		SetWriteConcern(wc).
 		SetRetryWrites(cfg.RetryWrites)
+	if cfg.PoolMonitor != nil {
+		clientOptions.SetPoolMonitor(cfg.PoolMonitor.Monitor())
+	}
 
 	connectCtx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
 	defer cancel()
@@ -93,14 +102,26 @@ func NewMongoDBCommandRepository(ctx context.Context, cfg *CommandConfig) (repos
 		return nil, fmt.Errorf("failed to ping MongoDB primary: %w", err)
 	}
 
-	database := client.Database(cfg.Database)
-
 	logger.Info(ctx, "MongoDB command repository initialized successfully")
 
+	return NewMongoDBCommandRepositoryWithClient(client, cfg), nil
+}
+
+// NewMongoDBCommandRepositoryWithClient는 이미 연결된 클라이언트로 쓰기 저장소를 생성합니다
+// 연결 설정(URI, 풀, Write Concern)은 클라이언트를 따르며, cfg에서는 데이터베이스와 CDC 설정만 사용합니다
+func NewMongoDBCommandRepositoryWithClient(client *mongo.Client, cfg *CommandConfig) *MongoDBCommandRepository {
+	database := client.Database(cfg.Database)
+	m := metrics.GetMetrics()
+
 	return &MongoDBCommandRepository{
-		client:       client,
-		database:     database,
-		metrics:      metrics.GetMetrics(),
+		client:   client,
+		database: database,
+		documents: &DocumentRepository{
+			client:   client,
+			database: database,
+			metrics:  m,
+		},
+		metrics:      m,
 		cdcPublisher: cfg.CDCPublisher,
 		vaultClient:  cfg.VaultClient,
 		cdcEnabled:   cfg.CDCEnabled,
@@ -109,7 +130,7 @@ func NewMongoDBCommandRepository(ctx context.Context, cfg *CommandConfig) (repos
 			RetryWrites:  cfg.RetryWrites,
 			PublishCDC:   cfg.CDCEnabled,
 		},
-	}, nil
+	}
 }
 
 // Save는 문서를 저장합니다
@@ -152,12 +173,9 @@ func (r *MongoDBCommandRepository) Save(ctx context.Context, doc *entity.Documen
 	}
 
 	// CDC 이벤트 발행
-	if r.cdcEnabled && r.cdcPublisher != nil {
-		if err := r.cdcPublisher.PublishDocumentCreated(ctx, doc); err != nil {
-			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-			// CDC 실패해도 저장은 성공으로 처리
-		}
-	}
+	r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+		return r.cdcPublisher.PublishDocumentCreated(ctx, doc.ID(), doc.Collection(), doc.Data(), doc.Version())
+	})
 
 	return nil
 }
@@ -219,12 +237,10 @@ func (r *MongoDBCommandRepository) SaveMany(ctx context.Context, docs []*entity.
 	}
 
 	// CDC 이벤트 발행 (배치)
-	if r.cdcEnabled && r.cdcPublisher != nil {
-		for _, doc := range docs {
-			if err := r.cdcPublisher.PublishDocumentCreated(ctx, doc); err != nil {
-				logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-			}
-		}
+	for _, doc := range docs {
+		r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+			return r.cdcPublisher.PublishDocumentCreated(ctx, doc.ID(), doc.Collection(), doc.Data(), doc.Version())
+		})
 	}
 
 	return nil
@@ -273,11 +289,9 @@ func (r *MongoDBCommandRepository) Update(ctx context.Context, doc *entity.Docum
 	}
 
 	// CDC 이벤트 발행
-	if r.cdcEnabled && r.cdcPublisher != nil {
-		if err := r.cdcPublisher.PublishDocumentUpdated(ctx, doc); err != nil {
-			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-		}
-	}
+	r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+		return r.cdcPublisher.PublishDocumentUpdated(ctx, doc.ID(), doc.Collection(), doc.Data(), doc.Version(), doc.Version()-1, nil)
+	})
 
 	return nil
 }
@@ -354,11 +368,9 @@ func (r *MongoDBCommandRepository) Replace(ctx context.Context, collection, id s
 	}
 
 	// CDC 이벤트 발행
-	if r.cdcEnabled && r.cdcPublisher != nil {
-		if err := r.cdcPublisher.PublishDocumentUpdated(ctx, replacement); err != nil {
-			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-		}
-	}
+	r.publishCDC(ctx, replacement.Collection(), func(ctx context.Context) error {
+		return r.cdcPublisher.PublishDocumentUpdated(ctx, replacement.ID(), replacement.Collection(), replacement.Data(), replacement.Version(), replacement.Version()-1, nil)
+	})
 
 	return nil
 }
@@ -379,7 +391,7 @@ func (r *MongoDBCommandRepository) Delete(ctx context.Context, collection, id st
 
 	// CDC를 위해 삭제 전 문서 조회
 	var deletedDoc *entity.Document
-	if r.cdcEnabled && r.cdcPublisher != nil {
+	if r.cdcActive(collection) {
 		coll := r.database.Collection(collection)
 		var model documentModel
 		err := coll.FindOne(ctx, bson.M{"_id": objectID}).Decode(&model)
@@ -410,10 +422,10 @@ func (r *MongoDBCommandRepository) Delete(ctx context.Context, collection, id st
 	}
 
 	// CDC 이벤트 발행
-	if deletedDoc != nil && r.cdcPublisher != nil {
-		if err := r.cdcPublisher.PublishDocumentDeleted(ctx, deletedDoc); err != nil {
-			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-		}
+	if deletedDoc != nil {
+		r.publishCDC(ctx, collection, func(ctx context.Context) error {
+			return r.cdcPublisher.PublishDocumentDeleted(ctx, deletedDoc.ID(), deletedDoc.Collection(), deletedDoc.Version())
+		})
 	}
 
 	return nil
@@ -494,11 +506,9 @@ func (r *MongoDBCommandRepository) FindAndUpdate(ctx context.Context, collection
 	)
 
 	// CDC 이벤트 발행
-	if r.cdcEnabled && r.cdcPublisher != nil {
-		if err := r.cdcPublisher.PublishDocumentUpdated(ctx, doc); err != nil {
-			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-		}
-	}
+	r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+		return r.cdcPublisher.PublishDocumentUpdated(ctx, doc.ID(), doc.Collection(), doc.Data(), doc.Version(), doc.Version()-1, update)
+	})
 
 	return doc, nil
 }
@@ -590,11 +600,9 @@ func (r *MongoDBCommandRepository) FindOneAndDelete(ctx context.Context, collect
 	)
 
 	// CDC 이벤트 발행
-	if r.cdcEnabled && r.cdcPublisher != nil {
-		if err := r.cdcPublisher.PublishDocumentDeleted(ctx, doc); err != nil {
-			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
-		}
-	}
+	r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+		return r.cdcPublisher.PublishDocumentDeleted(ctx, doc.ID(), doc.Collection(), doc.Version())
+	})
 
 	return doc, nil
 }
@@ -633,66 +641,305 @@ func (r *MongoDBCommandRepository) Upsert(ctx context.Context, collection string
 	return id, nil
 }
 
-// Note: BulkWrite, CreateIndex, CreateIndexes, DropIndex, CreateCollection,
-// DropCollection, RenameCollection, WithTransaction, EnableChangeDataCapture,
-// DisableChangeDataCapture, ExecuteWriteCommand 메서드들은 기존 파일들을 참조하여 구현하거나
-// 별도 파일로 분리할 수 있습니다.
-
-// Placeholder methods for interface compliance
+// BulkWrite는 여러 쓰기 작업을 컬렉션별 비순차(ordered=false) 벌크 요청으로 실행합니다
+// 잘못된 작업이 하나라도 있으면 아무것도 실행하지 않으며, 일부 작업이 실패하면 성공한 작업의 결과와 함께 에러를 반환합니다
+// CDC 이벤트는 대상 ID를 아는 insert/replace 중 성공한 작업만 발행합니다 (필터 기반 update/delete는 UpdateMany/DeleteMany와 같이 변경 스트림 CDC로 전파)
 func (r *MongoDBCommandRepository) BulkWrite(ctx context.Context, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
-	// TODO: Implement using bulk_operations.go
-	return nil, fmt.Errorf("not implemented yet")
+	result := &repository.BulkResult{
+		UpsertedIDs: make(map[int]interface{}),
+	}
+	if len(operations) == 0 {
+		return result, nil
+	}
+
+	start := time.Now()
+
+	// 컬렉션별로 작업을 그룹화 (원래 작업 인덱스 유지)
+	type bulkBatch struct {
+		models  []mongo.WriteModel
+		indexes []int
+	}
+	batches := make(map[string]*bulkBatch)
+	var collections []string
+	insertedIDs := make(map[int]string)
+
+	for i, op := range operations {
+		var model mongo.WriteModel
+		var err error
+		if op.Type == "insert" && op.Document != nil {
+			// 벌크 결과는 삽입 ID를 돌려주지 않으므로 미리 할당해 CDC와 문서 ID에 사용
+			objectID := primitive.NewObjectID()
+			insertedIDs[i] = objectID.Hex()
+			model = mongo.NewInsertOneModel().SetDocument(&documentModel{
+				ID:         objectID,
+				Collection: op.Document.Collection(),
+				Data:       op.Document.Data(),
+				Version:    op.Document.Version(),
+				CreatedAt:  op.Document.CreatedAt(),
+				UpdatedAt:  op.Document.UpdatedAt(),
+			})
+		} else {
+			model, err = r.documents.convertToBulkWriteModel(op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: bulk operation %d: %v", entity.ErrInvalidData, i, err)
+		}
+
+		batch, ok := batches[op.Collection]
+		if !ok {
+			batch = &bulkBatch{}
+			batches[op.Collection] = batch
+			collections = append(collections, op.Collection)
+		}
+		batch.models = append(batch.models, model)
+		batch.indexes = append(batch.indexes, i)
+	}
+
+	var firstErr error
+	for _, collection := range collections {
+		batch := batches[collection]
+
+		bulkResult, err := r.database.Collection(collection).BulkWrite(ctx, batch.models, options.BulkWrite().SetOrdered(false))
+		failed := make(map[int]bool)
+		if err != nil {
+			r.metrics.RecordDBOperation("bulk_write", collection, "error", time.Since(start))
+			logger.Error(ctx, "bulk write operation failed",
+				zap.String("collection", collection),
+				zap.Error(err),
+			)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to bulk write to %s: %w", collection, err)
+			}
+
+			var bulkErr mongo.BulkWriteException
+			if !errors.As(err, &bulkErr) {
+				continue
+			}
+			for _, writeErr := range bulkErr.WriteErrors {
+				failed[writeErr.Index] = true
+			}
+		} else {
+			r.metrics.RecordDBOperation("bulk_write", collection, "success", time.Since(start))
+		}
+
+		if bulkResult != nil {
+			result.InsertedCount += bulkResult.InsertedCount
+			result.MatchedCount += bulkResult.MatchedCount
+			result.ModifiedCount += bulkResult.ModifiedCount
+			result.DeletedCount += bulkResult.DeletedCount
+			result.UpsertedCount += bulkResult.UpsertedCount
+			for idx, id := range bulkResult.UpsertedIDs {
+				result.UpsertedIDs[batch.indexes[idx]] = id
+			}
+		}
+
+		for j, opIndex := range batch.indexes {
+			if failed[j] {
+				continue
+			}
+			r.publishBulkCDC(ctx, operations[opIndex], insertedIDs[opIndex])
+		}
+	}
+
+	logger.Info(ctx, "bulk write operation completed",
+		zap.Int("operations", len(operations)),
+		zap.Int64("inserted", result.InsertedCount),
+		zap.Int64("modified", result.ModifiedCount),
+		zap.Int64("deleted", result.DeletedCount),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return result, firstErr
+}
+
+// publishBulkCDC는 성공한 벌크 작업의 CDC 이벤트를 발행하고 삽입된 문서에 ID를 설정합니다
+func (r *MongoDBCommandRepository) publishBulkCDC(ctx context.Context, op *repository.BulkOperation, insertedID string) {
+	switch op.Type {
+	case "insert":
+		doc := op.Document
+		doc.SetID(insertedID)
+		r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+			return r.cdcPublisher.PublishDocumentCreated(ctx, doc.ID(), doc.Collection(), doc.Data(), doc.Version())
+		})
+	case "replace":
+		doc := op.Document
+		r.publishCDC(ctx, doc.Collection(), func(ctx context.Context) error {
+			return r.cdcPublisher.PublishDocumentUpdated(ctx, op.ReplaceOneID, doc.Collection(), doc.Data(), doc.Version()+1, doc.Version(), nil)
+		})
+	}
 }
 
+// CreateIndex는 단일 인덱스를 생성합니다
 func (r *MongoDBCommandRepository) CreateIndex(ctx context.Context, collection string, model repository.IndexModel) (string, error) {
-	// TODO: Implement using index_operations.go
-	return "", fmt.Errorf("not implemented yet")
+	return r.documents.CreateIndex(ctx, collection, model)
 }
 
+// CreateIndexes는 여러 인덱스를 한 번에 생성합니다
 func (r *MongoDBCommandRepository) CreateIndexes(ctx context.Context, collection string, models []repository.IndexModel) ([]string, error) {
-	// TODO: Implement using index_operations.go
-	return nil, fmt.Errorf("not implemented yet")
+	return r.documents.CreateIndexes(ctx, collection, models)
 }
 
+// DropIndex는 인덱스를 삭제합니다
 func (r *MongoDBCommandRepository) DropIndex(ctx context.Context, collection, indexName string) error {
-	// TODO: Implement using index_operations.go
-	return fmt.Errorf("not implemented yet")
+	return r.documents.DropIndex(ctx, collection, indexName)
 }
 
+// CreateCollection은 컬렉션을 생성합니다
 func (r *MongoDBCommandRepository) CreateCollection(ctx context.Context, name string) error {
-	// TODO: Implement using collection_operations.go
-	return fmt.Errorf("not implemented yet")
+	return r.documents.CreateCollection(ctx, name)
 }
 
+// DropCollection은 컬렉션을 삭제합니다
 func (r *MongoDBCommandRepository) DropCollection(ctx context.Context, name string) error {
-	// TODO: Implement using collection_operations.go
-	return fmt.Errorf("not implemented yet")
+	return r.documents.DropCollection(ctx, name)
 }
 
+// RenameCollection은 컬렉션 이름을 변경합니다
 func (r *MongoDBCommandRepository) RenameCollection(ctx context.Context, oldName, newName string) error {
-	// TODO: Implement using collection_operations.go
-	return fmt.Errorf("not implemented yet")
+	return r.documents.RenameCollection(ctx, oldName, newName)
 }
 
-func (r *MongoDBCommandRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	// TODO: Implement using bulk_and_transaction_operations.go
-	return fmt.Errorf("not implemented yet")
+// WithTransaction은 트랜잭션 내에서 함수를 실행합니다 (replica set 또는 sharded cluster 필요)
+// 트랜잭션 안의 쓰기가 만드는 CDC 이벤트는 커밋 후에 발행하므로, 중단된 트랜잭션의 변경은 발행되지 않습니다
+func (r *MongoDBCommandRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
+	start := time.Now()
+
+	txnOpts, err := transactionOptions(opts)
+	if err != nil {
+		return err
+	}
+	if opts != nil && opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	session, err := r.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	pending := &pendingCDCEvents{}
+	txCtx := context.WithValue(ctx, pendingCDCKey{}, pending)
+
+	_, err = session.WithTransaction(txCtx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		// 일시적 오류로 재시도되면 이전 시도에서 보류한 이벤트는 버림
+		pending.reset()
+		return nil, fn(sessCtx)
+	}, txnOpts)
+	if err != nil {
+		r.metrics.RecordDBOperation("transaction", "multiple", "error", time.Since(start))
+		logger.Error(ctx, "transaction failed",
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+	r.metrics.RecordDBOperation("transaction", "multiple", "success", time.Since(start))
+
+	for _, publish := range pending.drain() {
+		if err := publish(ctx); err != nil {
+			logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
+		}
+	}
+
+	return nil
 }
 
+// EnableChangeDataCapture는 쓰기 시 CDC 이벤트 발행을 켭니다
+// collections가 비어 있으면 모든 컬렉션, 지정하면 해당 컬렉션의 변경만 발행합니다
 func (r *MongoDBCommandRepository) EnableChangeDataCapture(ctx context.Context, collections []string) error {
-	// TODO: Implement using change_streams.go
+	if r.cdcPublisher == nil {
+		return fmt.Errorf("cdc publisher is not configured")
+	}
+
+	selected := make(map[string]bool, len(collections))
+	for _, collection := range collections {
+		selected[collection] = true
+	}
+
+	r.mu.Lock()
 	r.cdcEnabled = true
+	r.cdcCollections = selected
+	r.writeOptions.PublishCDC = true
+	r.mu.Unlock()
+
+	logger.Info(ctx, "change data capture enabled", zap.Strings("collections", collections))
 	return nil
 }
 
+// DisableChangeDataCapture는 CDC 이벤트 발행을 끕니다
 func (r *MongoDBCommandRepository) DisableChangeDataCapture(ctx context.Context) error {
+	r.mu.Lock()
 	r.cdcEnabled = false
+	r.cdcCollections = nil
+	r.writeOptions.PublishCDC = false
+	r.mu.Unlock()
+
+	logger.Info(ctx, "change data capture disabled")
 	return nil
 }
 
+// ExecuteWriteCommand는 primary에서 MongoDB 명령(insert, update, delete, findAndModify 등)을 실행합니다
+// 임의 명령은 대상 문서를 알 수 없으므로 CDC 이벤트를 발행하지 않습니다 (변경 스트림 CDC로 전파)
 func (r *MongoDBCommandRepository) ExecuteWriteCommand(ctx context.Context, command interface{}) (interface{}, error) {
-	// TODO: Implement using raw_query_operations.go
-	return nil, fmt.Errorf("not implemented yet")
+	return r.documents.ExecuteRawQuery(ctx, command)
+}
+
+// cdcActive는 컬렉션의 변경을 CDC로 발행해야 하는지 확인합니다
+func (r *MongoDBCommandRepository) cdcActive(collection string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if !r.cdcEnabled || r.cdcPublisher == nil {
+		return false
+	}
+	return len(r.cdcCollections) == 0 || r.cdcCollections[collection]
+}
+
+// publishCDC는 CDC 이벤트를 발행합니다 (CDC가 꺼져 있거나 대상 컬렉션이 아니면 무시)
+// 트랜잭션 안에서는 커밋 후에 발행하도록 보류하며, 발행 실패는 쓰기 결과에 영향을 주지 않습니다
+func (r *MongoDBCommandRepository) publishCDC(ctx context.Context, collection string, publish func(ctx context.Context) error) {
+	if !r.cdcActive(collection) {
+		return
+	}
+	if pending, ok := ctx.Value(pendingCDCKey{}).(*pendingCDCEvents); ok {
+		pending.add(publish)
+		return
+	}
+	if err := publish(ctx); err != nil {
+		logger.Warn(ctx, "failed to publish CDC event", zap.Error(err))
+	}
+}
+
+// pendingCDCKey는 트랜잭션 중 보류된 CDC 이벤트의 컨텍스트 키입니다
+type pendingCDCKey struct{}
+
+// pendingCDCEvents는 트랜잭션 커밋 후 발행할 CDC 이벤트 목록입니다
+type pendingCDCEvents struct {
+	mu     sync.Mutex
+	events []func(ctx context.Context) error
+}
+
+func (p *pendingCDCEvents) add(publish func(ctx context.Context) error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, publish)
+}
+
+func (p *pendingCDCEvents) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = nil
+}
+
+func (p *pendingCDCEvents) drain() []func(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	events := p.events
+	p.events = nil
+	return events
 }
 
 // HealthCheck는 쓰기 저장소의 상태를 확인합니다
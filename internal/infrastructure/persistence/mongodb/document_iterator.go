@@ -0,0 +1,80 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// streamBatchSize는 스트리밍 조회에서 서버 커서가 한 번에 가져오는 문서 수입니다
+const streamBatchSize = 500
+
+// FindStream은 옵션을 사용하여 문서를 조회하고 결과를 서버 커서로 반환합니다
+func (r *DocumentRepository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	start := time.Now()
+
+	bsonFilter := bson.M{}
+	if filter != nil {
+		bsonFilter = bson.M(filter)
+	}
+
+	cursor, err := r.database.Collection(collection).Find(ctx, bsonFilter, buildFindOptions(opts).SetBatchSize(streamBatchSize))
+	if err != nil {
+		r.metrics.RecordDBOperation("find_stream", collection, "error", time.Since(start))
+		logger.Error(ctx, "failed to open document cursor",
+			logger.Collection(collection),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to find documents: %w", err)
+	}
+	r.metrics.RecordDBOperation("find_stream", collection, "success", time.Since(start))
+
+	return &documentIterator{cursor: cursor}, nil
+}
+
+// documentIterator는 MongoDB 커서를 DocumentIterator로 감쌉니다
+type documentIterator struct {
+	cursor *mongo.Cursor
+}
+
+func (it *documentIterator) Next(ctx context.Context) bool {
+	return it.cursor.Next(ctx)
+}
+
+func (it *documentIterator) Decode() (*entity.Document, error) {
+	var model documentModel
+	if err := it.cursor.Decode(&model); err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	doc := entity.ReconstructDocument(
+		model.ID.Hex(),
+		model.Collection,
+		model.Data,
+		model.Version,
+		model.CreatedAt,
+		model.UpdatedAt,
+	)
+	if model.ExpiresAt != nil {
+		doc.SetExpiresAt(*model.ExpiresAt)
+	}
+	return doc, nil
+}
+
+func (it *documentIterator) Err() error {
+	if err := it.cursor.Err(); err != nil {
+		return fmt.Errorf("cursor error: %w", err)
+	}
+	return nil
+}
+
+func (it *documentIterator) Close(ctx context.Context) error {
+	return it.cursor.Close(ctx)
+}
@@ -0,0 +1,940 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.uber.org/zap"
+)
+
+// MongoDBQueryRepository는 MongoDB 기반 읽기 전용 저장소입니다 (CQRS Read Side)
+// 읽기 선호도(secondaryPreferred, nearest 등), 읽기 일관성, hedged reads를 적용한 별도 연결 풀로 조회합니다
+type MongoDBQueryRepository struct {
+	client    *mongo.Client
+	database  *mongo.Database
+	documents *DocumentRepository // 공통 조회 로직 재사용 (같은 클라이언트 사용)
+	metrics   *metrics.Metrics
+	readPref  *readpref.ReadPref
+	cache     repository.CacheRepository
+}
+
+// QueryConfig는 읽기 저장소 설정입니다
+type QueryConfig struct {
+	URI            string
+	Database       string
+	MaxPoolSize    uint64
+	MinPoolSize    uint64
+	MaxConnecting  uint64
+	ConnectTimeout time.Duration
+	Timeout        time.Duration
+	ReadPreference string        // "secondaryPreferred"(기본), "secondary", "nearest", "primaryPreferred", "primary"
+	MaxStaleness   time.Duration // 이보다 지연된 secondary 제외 (0이면 제한 없음, 최소 90초)
+	ReadConcern    string        // "local", "available", "majority", "linearizable" (비어 있으면 서버 기본값)
+	HedgedReads    bool          // 샤드 클러스터에서 두 멤버에 동시 읽기 (primary 모드 불가)
+	PoolMonitor    *PoolMonitor  // 연결 풀 상태 집계 (nil이면 집계하지 않음)
+	Cache          repository.CacheRepository
+}
+
+// queryCachedDocument는 캐시에 저장하는 문서 표현입니다
+// entity.Document는 필드를 노출하지 않으므로 JSON 직렬화 가능한 형태로 변환해 저장합니다
+type queryCachedDocument struct {
+	ID         string                 `json:"id"`
+	Collection string                 `json:"collection"`
+	Data       map[string]interface{} `json:"data"`
+	Version    int                    `json:"version"`
+	CreatedAt  time.Time              `json:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at"`
+}
+
+// NewMongoDBQueryRepository는 새로운 MongoDB 읽기 저장소를 생성합니다
+func NewMongoDBQueryRepository(ctx context.Context, cfg *QueryConfig) (repository.DocumentQueryRepository, error) {
+	mode := cfg.ReadPreference
+	if mode == "" {
+		mode = "secondaryPreferred"
+	}
+
+	logger.Info(ctx, "initializing MongoDB query repository",
+		zap.String("database", cfg.Database),
+		zap.String("read_preference", mode),
+		zap.String("read_concern", cfg.ReadConcern),
+		zap.Bool("hedged_reads", cfg.HedgedReads),
+	)
+
+	rp, err := ParseReadPreference(mode, cfg.MaxStaleness, cfg.HedgedReads)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := ParseReadConcern(cfg.ReadConcern)
+	if err != nil {
+		return nil, err
+	}
+
+	clientOptions := options.Client().
+		ApplyURI(cfg.URI).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetMinPoolSize(cfg.MinPoolSize).
+		SetMaxConnecting(cfg.MaxConnecting).
+		SetServerSelectionTimeout(cfg.ConnectTimeout).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetSocketTimeout(cfg.Timeout).
+		SetReadPreference(rp)
+	if rc != nil {
+		clientOptions.SetReadConcern(rc)
+	}
+	if cfg.PoolMonitor != nil {
+		clientOptions.SetPoolMonitor(cfg.PoolMonitor.Monitor())
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, cfg.ConnectTimeout)
+	defer cancel()
+
+	client, err := mongo.Connect(connectCtx, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB read replica: %w", err)
+	}
+
+	// 읽기 선호도에 맞는 노드 연결 확인
+	if err := client.Ping(connectCtx, rp); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, fmt.Errorf("failed to ping MongoDB read replica: %w", err)
+	}
+
+	database := client.Database(cfg.Database)
+	m := metrics.GetMetrics()
+
+	logger.Info(ctx, "MongoDB query repository initialized successfully")
+
+	return &MongoDBQueryRepository{
+		client:   client,
+		database: database,
+		documents: &DocumentRepository{
+			client:   client,
+			database: database,
+			metrics:  m,
+		},
+		metrics:  m,
+		readPref: rp,
+		cache:    cfg.Cache,
+	}, nil
+}
+
+// ===== 기본 조회 작업 =====
+
+// FindByID는 ID로 문서를 조회합니다
+func (r *MongoDBQueryRepository) FindByID(ctx context.Context, collection, id string) (*entity.Document, error) {
+	return r.documents.FindByID(ctx, collection, id)
+}
+
+// FindOne은 필터와 일치하는 첫 번째 문서를 조회합니다
+func (r *MongoDBQueryRepository) FindOne(ctx context.Context, collection string, filter map[string]interface{}) (*entity.Document, error) {
+	start := time.Now()
+
+	var model documentModel
+	if err := r.database.Collection(collection).FindOne(ctx, toBSONFilter(filter)).Decode(&model); err != nil {
+		if err == mongo.ErrNoDocuments {
+			r.metrics.RecordDBOperation("find_one", collection, "not_found", time.Since(start))
+			return nil, entity.ErrDocumentNotFound
+		}
+		r.metrics.RecordDBOperation("find_one", collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to find document: %w", err)
+	}
+
+	r.metrics.RecordDBOperation("find_one", collection, "success", time.Since(start))
+	return model.toEntity(), nil
+}
+
+// FindAll은 필터와 일치하는 모든 문서를 조회합니다
+func (r *MongoDBQueryRepository) FindAll(ctx context.Context, collection string, filter map[string]interface{}) ([]*entity.Document, error) {
+	return r.documents.FindAll(ctx, collection, filter)
+}
+
+// FindWithOptions는 옵션을 사용하여 문서를 조회합니다
+func (r *MongoDBQueryRepository) FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) ([]*entity.Document, error) {
+	return r.documents.FindWithOptions(ctx, collection, filter, opts)
+}
+
+// FindByIDs는 여러 ID로 문서를 배치 조회합니다 (존재하지 않는 ID는 결과에서 제외)
+func (r *MongoDBQueryRepository) FindByIDs(ctx context.Context, collection string, ids []string) ([]*entity.Document, error) {
+	if len(ids) == 0 {
+		return []*entity.Document{}, nil
+	}
+
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid id format: %s", entity.ErrInvalidData, id)
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+
+	return r.find(ctx, "find_by_ids", collection, bson.M{"_id": bson.M{"$in": objectIDs}}, nil)
+}
+
+// ===== 집계 작업 =====
+
+// Aggregate는 집계 파이프라인을 실행합니다
+func (r *MongoDBQueryRepository) Aggregate(ctx context.Context, collection string, pipeline []bson.M) ([]map[string]interface{}, error) {
+	return r.documents.Aggregate(ctx, collection, pipeline)
+}
+
+// AggregateWithOptions는 옵션을 사용하여 집계를 실행합니다
+func (r *MongoDBQueryRepository) AggregateWithOptions(ctx context.Context, collection string, pipeline []bson.M, opts *repository.AggregateOptions) ([]map[string]interface{}, error) {
+	aggOpts := options.Aggregate()
+	if opts != nil {
+		aggOpts.SetAllowDiskUse(opts.AllowDiskUse)
+		if opts.MaxTime > 0 {
+			aggOpts.SetMaxTime(time.Duration(opts.MaxTime) * time.Millisecond)
+		}
+		if opts.BatchSize > 0 {
+			aggOpts.SetBatchSize(int32(opts.BatchSize))
+		}
+		if opts.Collation != nil {
+			aggOpts.SetCollation(toMongoCollation(opts.Collation))
+		}
+	}
+	return r.aggregate(ctx, "aggregate_with_options", collection, pipeline, aggOpts)
+}
+
+// Distinct는 필드의 고유한 값을 조회합니다
+func (r *MongoDBQueryRepository) Distinct(ctx context.Context, collection, field string, filter map[string]interface{}) ([]interface{}, error) {
+	return r.documents.Distinct(ctx, collection, field, filter)
+}
+
+// ===== 카운트 작업 =====
+
+// Count는 필터와 일치하는 문서 개수를 반환합니다
+func (r *MongoDBQueryRepository) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	return r.documents.Count(ctx, collection, filter)
+}
+
+// EstimatedDocumentCount는 컬렉션 메타데이터 기반 추정 문서 개수를 반환합니다
+func (r *MongoDBQueryRepository) EstimatedDocumentCount(ctx context.Context, collection string) (int64, error) {
+	return r.documents.EstimatedDocumentCount(ctx, collection)
+}
+
+// CountWithOptions는 옵션을 사용하여 개수를 반환합니다
+func (r *MongoDBQueryRepository) CountWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.CountOptions) (int64, error) {
+	start := time.Now()
+
+	countOpts := options.Count()
+	if opts != nil {
+		if opts.Limit > 0 {
+			countOpts.SetLimit(opts.Limit)
+		}
+		if opts.Skip > 0 {
+			countOpts.SetSkip(opts.Skip)
+		}
+		if opts.Hint != "" {
+			countOpts.SetHint(opts.Hint)
+		}
+	}
+
+	count, err := r.database.Collection(collection).CountDocuments(ctx, toBSONFilter(filter), countOpts)
+	if err != nil {
+		r.metrics.RecordDBOperation("count_with_options", collection, "error", time.Since(start))
+		return 0, fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	r.metrics.RecordDBOperation("count_with_options", collection, "success", time.Since(start))
+	return count, nil
+}
+
+// ===== 페이지네이션 =====
+
+// FindPage는 페이지 단위로 문서를 조회합니다
+// filter가 nil이면 page.Filter를 사용합니다
+func (r *MongoDBQueryRepository) FindPage(ctx context.Context, collection string, filter map[string]interface{}, page *repository.PageRequest) (*repository.PageResponse, error) {
+	if page == nil {
+		page = &repository.PageRequest{}
+	}
+	if filter == nil {
+		filter = page.Filter
+	}
+	pageNum := page.Page
+	if pageNum < 1 {
+		pageNum = 1
+	}
+	pageSize := page.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	total, err := r.documents.Count(ctx, collection, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	findOpts := options.Find().
+		SetSkip(int64((pageNum - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+	if len(page.Sort) > 0 {
+		findOpts.SetSort(toBSONSort(page.Sort))
+	}
+
+	items, err := r.find(ctx, "find_page", collection, toBSONFilter(filter), findOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(pageSize)))
+	return &repository.PageResponse{
+		Items:      items,
+		TotalItems: total,
+		TotalPages: totalPages,
+		Page:       pageNum,
+		PageSize:   pageSize,
+		HasNext:    pageNum < totalPages,
+		HasPrev:    pageNum > 1,
+	}, nil
+}
+
+// FindCursorBased는 _id 순서의 커서 기반 페이지네이션을 수행합니다
+// cursor는 이전 페이지 마지막 문서의 ID이며, 빈 문자열이면 처음부터 조회합니다
+func (r *MongoDBQueryRepository) FindCursorBased(ctx context.Context, collection string, filter map[string]interface{}, cursor string, limit int) (*repository.CursorPageResponse, error) {
+	if limit < 1 {
+		limit = 20
+	}
+
+	query := toBSONFilter(filter)
+	if cursor != "" {
+		after, err := primitive.ObjectIDFromHex(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid cursor: %s", entity.ErrInvalidData, cursor)
+		}
+		query = bson.M{"$and": []bson.M{query, {"_id": bson.M{"$gt": after}}}}
+	}
+
+	// 다음 페이지 존재 여부를 알기 위해 하나 더 조회
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(limit + 1))
+
+	items, err := r.find(ctx, "find_cursor_based", collection, query, findOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &repository.CursorPageResponse{Items: items}
+	if len(items) > limit {
+		resp.Items = items[:limit]
+		resp.HasMore = true
+		resp.NextCursor = resp.Items[limit-1].ID()
+	}
+	return resp, nil
+}
+
+// ===== 검색 작업 =====
+
+// Search는 텍스트 인덱스를 사용해 전문 검색을 수행합니다 (정렬 미지정 시 관련도 순)
+// $text 검색은 형태소 기반이므로 Fuzzy 옵션은 적용되지 않습니다 (Atlas Search 전용)
+func (r *MongoDBQueryRepository) Search(ctx context.Context, collection, searchText string, opts *repository.SearchOptions) ([]*entity.Document, error) {
+	text := bson.M{"$search": searchText}
+	if opts != nil && opts.Language != "" {
+		text["$language"] = opts.Language
+	}
+
+	findOpts := options.Find()
+	if opts != nil {
+		if opts.Limit > 0 {
+			findOpts.SetLimit(int64(opts.Limit))
+		}
+		if opts.Skip > 0 {
+			findOpts.SetSkip(int64(opts.Skip))
+		}
+		if len(opts.Projection) > 0 {
+			findOpts.SetProjection(bson.M(opts.Projection))
+		}
+	}
+	if opts != nil && len(opts.Sort) > 0 {
+		findOpts.SetSort(toBSONSort(opts.Sort))
+	} else {
+		findOpts.SetSort(bson.M{"score": bson.M{"$meta": "textScore"}})
+	}
+
+	return r.find(ctx, "search", collection, bson.M{"$text": text}, findOpts)
+}
+
+// FindByRegex는 정규식 패턴으로 문서를 검색합니다
+func (r *MongoDBQueryRepository) FindByRegex(ctx context.Context, collection, field, pattern string) ([]*entity.Document, error) {
+	filter := bson.M{field: primitive.Regex{Pattern: pattern}}
+	return r.find(ctx, "find_by_regex", collection, filter, nil)
+}
+
+// ===== 컬렉션 정보 조회 =====
+
+// ListCollections는 데이터베이스의 컬렉션 목록을 반환합니다
+func (r *MongoDBQueryRepository) ListCollections(ctx context.Context) ([]string, error) {
+	return r.documents.ListCollections(ctx)
+}
+
+// CollectionExists는 컬렉션이 존재하는지 확인합니다
+func (r *MongoDBQueryRepository) CollectionExists(ctx context.Context, name string) (bool, error) {
+	return r.documents.CollectionExists(ctx, name)
+}
+
+// GetCollectionStats는 collStats 명령으로 컬렉션 통계를 반환합니다
+func (r *MongoDBQueryRepository) GetCollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	stats, err := r.collStats(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectionStatsFrom(collection, stats), nil
+}
+
+// ===== 인덱스 정보 조회 =====
+
+// ListIndexes는 컬렉션의 인덱스 목록을 반환합니다
+func (r *MongoDBQueryRepository) ListIndexes(ctx context.Context, collection string) ([]map[string]interface{}, error) {
+	return r.documents.ListIndexes(ctx, collection)
+}
+
+// GetIndexStats는 $indexStats로 인덱스 사용 통계를 반환합니다
+// 접근 횟수는 응답한 노드(읽기 선호도로 선택된 노드)의 마지막 재시작 이후 값입니다
+func (r *MongoDBQueryRepository) GetIndexStats(ctx context.Context, collection string) ([]repository.IndexStat, error) {
+	results, err := r.aggregate(ctx, "index_stats", collection, []bson.M{{"$indexStats": bson.M{}}}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 인덱스 크기는 collStats에서 조회 (실패해도 사용 통계는 반환)
+	var sizes bson.M
+	if stats, err := r.collStats(ctx, collection); err == nil {
+		sizes, _ = stats["indexSizes"].(bson.M)
+	}
+
+	indexStats := make([]repository.IndexStat, 0, len(results))
+	for _, result := range results {
+		name, _ := result["name"].(string)
+		stat := repository.IndexStat{
+			Name: name,
+			Size: toInt64(sizes[name]),
+		}
+		if accesses, ok := result["accesses"].(map[string]interface{}); ok {
+			stat.Accesses = toInt64(accesses["ops"])
+			if since, ok := accesses["since"].(primitive.DateTime); ok {
+				stat.Since = since.Time().UTC().Format(time.RFC3339)
+			}
+		}
+		indexStats = append(indexStats, stat)
+	}
+	return indexStats, nil
+}
+
+// ===== 변경 스트림 구독 =====
+
+// Watch는 컬렉션의 변경 사항을 실시간으로 감지합니다
+func (r *MongoDBQueryRepository) Watch(ctx context.Context, collection string, pipeline []bson.M) (*mongo.ChangeStream, error) {
+	return r.documents.Watch(ctx, collection, pipeline)
+}
+
+// WatchWithOptions는 옵션을 사용하여 변경 스트림을 생성합니다
+func (r *MongoDBQueryRepository) WatchWithOptions(ctx context.Context, collection string, pipeline []bson.M, opts *repository.WatchOptions) (*mongo.ChangeStream, error) {
+	if pipeline == nil {
+		pipeline = []bson.M{}
+	}
+
+	csOpts := options.ChangeStream()
+	if opts != nil {
+		if opts.FullDocument != "" {
+			csOpts.SetFullDocument(options.FullDocument(opts.FullDocument))
+		}
+		if opts.ResumeAfter != nil {
+			csOpts.SetResumeAfter(opts.ResumeAfter)
+		}
+		if opts.StartAfter != nil {
+			csOpts.SetStartAfter(opts.StartAfter)
+		}
+		switch ts := opts.StartAtTime.(type) {
+		case *primitive.Timestamp:
+			csOpts.SetStartAtOperationTime(ts)
+		case primitive.Timestamp:
+			csOpts.SetStartAtOperationTime(&ts)
+		case nil:
+		default:
+			return nil, fmt.Errorf("%w: start at time must be a BSON timestamp, got %T", entity.ErrInvalidData, opts.StartAtTime)
+		}
+		if opts.BatchSize > 0 {
+			csOpts.SetBatchSize(opts.BatchSize)
+		}
+		if opts.MaxAwaitTime > 0 {
+			csOpts.SetMaxAwaitTime(time.Duration(opts.MaxAwaitTime) * time.Millisecond)
+		}
+		if opts.Collation != nil {
+			csOpts.SetCollation(*toMongoCollation(opts.Collation))
+		}
+		csOpts.SetShowExpandedEvents(opts.ShowExpandedEvents)
+	}
+
+	stream, err := r.database.Collection(collection).Watch(ctx, pipeline, csOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch collection: %w", err)
+	}
+	return stream, nil
+}
+
+// ===== 데이터 검증 =====
+
+// Explain은 find 쿼리의 실행 계획(queryPlanner)을 반환합니다
+func (r *MongoDBQueryRepository) Explain(ctx context.Context, collection string, filter map[string]interface{}) (map[string]interface{}, error) {
+	command := bson.D{
+		{Key: "explain", Value: bson.D{
+			{Key: "find", Value: collection},
+			{Key: "filter", Value: toBSONFilter(filter)},
+		}},
+		{Key: "verbosity", Value: "queryPlanner"},
+	}
+
+	var result map[string]interface{}
+	if err := r.runCommand(ctx, command).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+	return result, nil
+}
+
+// ValidateDocument는 문서가 컬렉션의 검증 규칙(validator)을 만족하는지 확인합니다
+// 저장될 형태(collection, data, version, created_at, updated_at)로 감싸 $documents 단계에서 검증하므로 MongoDB 5.1 이상이 필요합니다
+func (r *MongoDBQueryRepository) ValidateDocument(ctx context.Context, collection string, doc map[string]interface{}) (bool, error) {
+	specs, err := r.database.ListCollectionSpecifications(ctx, bson.M{"name": collection})
+	if err != nil {
+		return false, fmt.Errorf("failed to get collection options: %w", err)
+	}
+	if len(specs) == 0 || specs[0].Options == nil {
+		return true, nil
+	}
+	validator, err := specs[0].Options.LookupErr("validator")
+	if err != nil {
+		// 검증 규칙이 없는 컬렉션
+		return true, nil
+	}
+
+	now := time.Now()
+	candidate := documentModel{
+		Collection: collection,
+		Data:       doc,
+		Version:    1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	pipeline := []bson.M{
+		{"$documents": []interface{}{candidate}},
+		{"$match": validator},
+	}
+
+	cursor, err := r.database.Aggregate(ctx, pipeline)
+	if err != nil {
+		return false, fmt.Errorf("failed to validate document: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	return cursor.Next(ctx), cursor.Err()
+}
+
+// ===== Raw Query 실행 =====
+
+// ExecuteReadQuery는 읽기 선호도를 적용해 MongoDB 명령(find, aggregate, count 등)을 실행합니다
+// RunCommand는 기본적으로 primary로 보내지므로 저장소의 읽기 선호도를 명시적으로 지정합니다
+func (r *MongoDBQueryRepository) ExecuteReadQuery(ctx context.Context, query interface{}) (interface{}, error) {
+	var result bson.M
+	if err := r.ExecuteReadQueryWithResult(ctx, query, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExecuteReadQueryWithResult는 읽기 명령을 실행하고 결과를 지정된 변수에 디코드합니다
+func (r *MongoDBQueryRepository) ExecuteReadQueryWithResult(ctx context.Context, query interface{}, result interface{}) error {
+	start := time.Now()
+
+	var command interface{}
+	switch q := query.(type) {
+	case bson.M, bson.D:
+		command = q
+	case map[string]interface{}:
+		command = bson.M(q)
+	case string:
+		var m bson.M
+		if err := bson.UnmarshalExtJSON([]byte(q), true, &m); err != nil {
+			return fmt.Errorf("failed to parse query string: %w", err)
+		}
+		command = m
+	default:
+		return fmt.Errorf("unsupported query type: %T (expected bson.M, bson.D, map[string]interface{}, or JSON string)", query)
+	}
+
+	if err := r.runCommand(ctx, command).Decode(result); err != nil {
+		r.metrics.RecordDBOperation("read_query", "database", "error", time.Since(start))
+		return fmt.Errorf("failed to execute read query: %w", err)
+	}
+
+	r.metrics.RecordDBOperation("read_query", "database", "success", time.Since(start))
+	return nil
+}
+
+// ===== 캐시 통합 =====
+
+// FindByIDWithCache는 캐시를 먼저 확인하고 없으면 조회 후 ttl(초) 동안 캐시합니다 (cache-aside)
+// 캐시가 설정되지 않았으면 FindByID와 같습니다
+func (r *MongoDBQueryRepository) FindByIDWithCache(ctx context.Context, collection, id string, ttl int) (*entity.Document, error) {
+	if r.cache == nil {
+		return r.FindByID(ctx, collection, id)
+	}
+
+	key := queryCacheKey(collection, id)
+	if cached, err := r.cache.Get(ctx, key); err == nil {
+		if doc, err := fromQueryCache(cached); err == nil {
+			return doc, nil
+		}
+		logger.Warn(ctx, "failed to decode cached document", zap.String("key", key))
+	}
+
+	doc, err := r.FindByID(ctx, collection, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cache.Set(ctx, key, toQueryCache(doc), ttl); err != nil {
+		logger.Warn(ctx, "failed to cache document", zap.String("key", key), zap.Error(err))
+	}
+	return doc, nil
+}
+
+// InvalidateCache는 문서 캐시를 무효화합니다
+func (r *MongoDBQueryRepository) InvalidateCache(ctx context.Context, collection, id string) error {
+	if r.cache == nil {
+		return nil
+	}
+	if err := r.cache.Delete(ctx, queryCacheKey(collection, id)); err != nil {
+		return fmt.Errorf("failed to invalidate cache: %w", err)
+	}
+	return nil
+}
+
+// WarmUpCache는 문서를 한 번에 조회해 캐시에 미리 로드합니다 (TTL은 캐시 기본값)
+func (r *MongoDBQueryRepository) WarmUpCache(ctx context.Context, collection string, ids []string) error {
+	if r.cache == nil {
+		return nil
+	}
+
+	docs, err := r.FindByIDs(ctx, collection, ids)
+	if err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		if err := r.cache.Set(ctx, queryCacheKey(collection, doc.ID()), toQueryCache(doc), 0); err != nil {
+			return fmt.Errorf("failed to warm up cache: %w", err)
+		}
+	}
+
+	logger.Info(ctx, "cache warmed up",
+		logger.Collection(collection),
+		logger.Count(len(docs)),
+	)
+	return nil
+}
+
+// ===== 분석 쿼리 =====
+
+// GetTimeSeriesData는 created_at 기준 시간 범위의 문서 수를 interval 단위로 집계합니다
+// startTime, endTime은 Unix 밀리초이며, interval은 minute, hour, day, week, month 중 하나입니다 ($dateTrunc, MongoDB 5.0+)
+func (r *MongoDBQueryRepository) GetTimeSeriesData(ctx context.Context, collection string, startTime, endTime int64, interval string) ([]map[string]interface{}, error) {
+	switch interval {
+	case "minute", "hour", "day", "week", "month":
+	default:
+		return nil, fmt.Errorf("%w: unsupported interval: %s", entity.ErrInvalidData, interval)
+	}
+
+	pipeline := []bson.M{
+		{"$match": bson.M{"created_at": bson.M{
+			"$gte": time.UnixMilli(startTime),
+			"$lt":  time.UnixMilli(endTime),
+		}}},
+		{"$group": bson.M{
+			"_id":   bson.M{"$dateTrunc": bson.M{"date": "$created_at", "unit": interval}},
+			"count": bson.M{"$sum": 1},
+		}},
+		{"$sort": bson.M{"_id": 1}},
+		{"$project": bson.M{"_id": 0, "timestamp": "$_id", "count": 1}},
+	}
+	return r.aggregate(ctx, "time_series", collection, pipeline, nil)
+}
+
+// GetTopN는 sortField 내림차순으로 상위 N개 문서를 조회합니다
+func (r *MongoDBQueryRepository) GetTopN(ctx context.Context, collection string, sortField string, n int) ([]*entity.Document, error) {
+	if n < 1 {
+		return []*entity.Document{}, nil
+	}
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: sortField, Value: -1}}).
+		SetLimit(int64(n))
+	return r.find(ctx, "top_n", collection, bson.M{}, findOpts)
+}
+
+// GroupBy는 groupField로 그룹화하여 집계합니다
+// aggregations는 결과 필드명 → "연산자:필드" 형식입니다 (예: {"total": "sum:data.amount", "orders": "count"})
+// 지원 연산자: count, sum, avg, min, max
+func (r *MongoDBQueryRepository) GroupBy(ctx context.Context, collection string, groupField string, aggregations map[string]string) ([]map[string]interface{}, error) {
+	group := bson.M{"_id": "$" + groupField}
+	for name, spec := range aggregations {
+		op, field, _ := strings.Cut(spec, ":")
+		switch op {
+		case "count":
+			group[name] = bson.M{"$sum": 1}
+		case "sum", "avg", "min", "max":
+			if field == "" {
+				return nil, fmt.Errorf("%w: aggregation %q requires a field", entity.ErrInvalidData, name)
+			}
+			group[name] = bson.M{"$" + op: "$" + field}
+		default:
+			return nil, fmt.Errorf("%w: unsupported aggregation operator: %s", entity.ErrInvalidData, op)
+		}
+	}
+
+	pipeline := []bson.M{
+		{"$group": group},
+		{"$sort": bson.M{"_id": 1}},
+	}
+	return r.aggregate(ctx, "group_by", collection, pipeline, nil)
+}
+
+// ===== 헬스체크 =====
+
+// HealthCheck는 읽기 선호도로 선택되는 노드에 연결할 수 있는지 확인합니다
+func (r *MongoDBQueryRepository) HealthCheck(ctx context.Context) error {
+	return r.client.Ping(ctx, r.readPref)
+}
+
+// GetReplicationLag는 primary와 가장 뒤처진 secondary의 oplog 시간 차이를 반환합니다 (밀리초)
+// replSetGetStatus 권한(clusterMonitor)이 필요하며, secondary가 없으면 0을 반환합니다
+func (r *MongoDBQueryRepository) GetReplicationLag(ctx context.Context) (int64, error) {
+	var status struct {
+		Members []struct {
+			StateStr   string    `bson:"stateStr"`
+			OptimeDate time.Time `bson:"optimeDate"`
+		} `bson:"members"`
+	}
+	if err := r.client.Database("admin").RunCommand(ctx, bson.D{{Key: "replSetGetStatus", Value: 1}}).Decode(&status); err != nil {
+		return 0, fmt.Errorf("failed to get replica set status: %w", err)
+	}
+
+	var primary time.Time
+	var oldest time.Time
+	for _, member := range status.Members {
+		switch member.StateStr {
+		case "PRIMARY":
+			primary = member.OptimeDate
+		case "SECONDARY":
+			if oldest.IsZero() || member.OptimeDate.Before(oldest) {
+				oldest = member.OptimeDate
+			}
+		}
+	}
+	if primary.IsZero() || oldest.IsZero() || !oldest.Before(primary) {
+		return 0, nil
+	}
+	return primary.Sub(oldest).Milliseconds(), nil
+}
+
+// Close는 연결을 종료합니다
+func (r *MongoDBQueryRepository) Close(ctx context.Context) error {
+	return r.client.Disconnect(ctx)
+}
+
+// find는 필터로 문서를 조회하고 엔티티로 변환합니다
+func (r *MongoDBQueryRepository) find(ctx context.Context, operation, collection string, filter bson.M, opts *options.FindOptions) ([]*entity.Document, error) {
+	start := time.Now()
+
+	var findOpts []*options.FindOptions
+	if opts != nil {
+		findOpts = append(findOpts, opts)
+	}
+
+	cursor, err := r.database.Collection(collection).Find(ctx, filter, findOpts...)
+	if err != nil {
+		r.metrics.RecordDBOperation(operation, collection, "error", time.Since(start))
+		logger.Error(ctx, "failed to find documents",
+			logger.Collection(collection),
+			zap.String("operation", operation),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to find documents: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	documents := []*entity.Document{}
+	for cursor.Next(ctx) {
+		var model documentModel
+		if err := cursor.Decode(&model); err != nil {
+			logger.Warn(ctx, "failed to decode document", zap.Error(err))
+			continue
+		}
+		documents = append(documents, model.toEntity())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	r.metrics.RecordDBOperation(operation, collection, "success", time.Since(start))
+	return documents, nil
+}
+
+// aggregate는 집계 파이프라인을 실행하고 결과를 반환합니다
+func (r *MongoDBQueryRepository) aggregate(ctx context.Context, operation, collection string, pipeline []bson.M, opts *options.AggregateOptions) ([]map[string]interface{}, error) {
+	start := time.Now()
+
+	var aggOpts []*options.AggregateOptions
+	if opts != nil {
+		aggOpts = append(aggOpts, opts)
+	}
+
+	cursor, err := r.database.Collection(collection).Aggregate(ctx, pipeline, aggOpts...)
+	if err != nil {
+		r.metrics.RecordDBOperation(operation, collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to execute aggregation: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	results := []map[string]interface{}{}
+	if err := cursor.All(ctx, &results); err != nil {
+		r.metrics.RecordDBOperation(operation, collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to decode aggregation results: %w", err)
+	}
+
+	r.metrics.RecordDBOperation(operation, collection, "success", time.Since(start))
+	return results, nil
+}
+
+// collStats는 collStats 명령 결과를 반환합니다
+func (r *MongoDBQueryRepository) collStats(ctx context.Context, collection string) (bson.M, error) {
+	var stats bson.M
+	if err := r.runCommand(ctx, bson.D{{Key: "collStats", Value: collection}}).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("failed to get collection stats: %w", err)
+	}
+	return stats, nil
+}
+
+// runCommand는 저장소의 읽기 선호도로 명령을 실행합니다
+func (r *MongoDBQueryRepository) runCommand(ctx context.Context, command interface{}) *mongo.SingleResult {
+	return r.database.RunCommand(ctx, command, options.RunCmd().SetReadPreference(r.readPref))
+}
+
+// toEntity는 저장 모델을 도메인 엔티티로 변환합니다
+func (m *documentModel) toEntity() *entity.Document {
+	return entity.ReconstructDocument(
+		m.ID.Hex(),
+		m.Collection,
+		m.Data,
+		m.Version,
+		m.CreatedAt,
+		m.UpdatedAt,
+	)
+}
+
+// toBSONFilter는 nil 필터를 빈 필터로 바꿔 BSON 필터로 변환합니다
+func toBSONFilter(filter map[string]interface{}) bson.M {
+	if filter == nil {
+		return bson.M{}
+	}
+	return bson.M(filter)
+}
+
+// toBSONSort는 정렬 맵을 BSON 정렬로 변환합니다
+func toBSONSort(sort map[string]int) bson.D {
+	sorted := bson.D{}
+	for field, direction := range sort {
+		sorted = append(sorted, bson.E{Key: field, Value: direction})
+	}
+	return sorted
+}
+
+// toMongoCollation은 도메인 정렬 규칙을 드라이버 옵션으로 변환합니다
+func toMongoCollation(c *repository.Collation) *options.Collation {
+	return &options.Collation{
+		Locale:          c.Locale,
+		CaseLevel:       c.CaseLevel,
+		CaseFirst:       c.CaseFirst,
+		Strength:        c.Strength,
+		NumericOrdering: c.NumericOrdering,
+		Alternate:       c.Alternate,
+		MaxVariable:     c.MaxVariable,
+		Backwards:       c.Backwards,
+	}
+}
+
+// queryCacheKey는 문서 캐시 키를 생성합니다 (DocumentUseCase의 문서 캐시 키와 같은 형식)
+func queryCacheKey(collection, id string) string {
+	return fmt.Sprintf("document:%s:%s", collection, id)
+}
+
+// toQueryCache는 문서를 캐시 표현으로 변환합니다
+func toQueryCache(doc *entity.Document) *queryCachedDocument {
+	return &queryCachedDocument{
+		ID:         doc.ID(),
+		Collection: doc.Collection(),
+		Data:       doc.Data(),
+		Version:    doc.Version(),
+		CreatedAt:  doc.CreatedAt(),
+		UpdatedAt:  doc.UpdatedAt(),
+	}
+}
+
+// fromQueryCache는 캐시에서 읽은 값(역직렬화된 맵 등)을 문서로 변환합니다
+func fromQueryCache(cached interface{}) (*entity.Document, error) {
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return nil, err
+	}
+	var c queryCachedDocument
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.ID == "" {
+		return nil, fmt.Errorf("cached value is not a document")
+	}
+	return entity.ReconstructDocument(c.ID, c.Collection, c.Data, c.Version, c.CreatedAt, c.UpdatedAt), nil
+}
+
+// toInt64는 BSON 숫자 값을 int64로 변환합니다
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int32:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	case int:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+// toFloat64는 BSON 숫자 값을 float64로 변환합니다
+func toFloat64(v interface{}) float64 {
+	switch n := v.(type) {
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	case int:
+		return float64(n)
+	default:
+		return 0
+	}
+}
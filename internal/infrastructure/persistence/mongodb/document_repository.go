@@ -32,6 +32,7 @@ type documentModel struct {
 	Version    int                    `bson:"version"`
 	CreatedAt  time.Time              `bson:"created_at"`
 	UpdatedAt  time.Time              `bson:"updated_at"`
+	ExpiresAt  *time.Time             `bson:"expires_at,omitempty"` // TTL 인덱스가 이 시각이 지난 문서를 삭제
 }
 
 // Config는 MongoDB 설정입니다
@@ -43,6 +44,7 @@ type Config struct {
 	MaxConnecting  uint64
 	ConnectTimeout time.Duration
 	Timeout        time.Duration
+	PoolMonitor    *PoolMonitor // 연결 풀 상태 집계 (nil이면 집계하지 않음)
 }
 
 // NewDocumentRepository는 새로운 MongoDB 문서 저장소를 생성합니다
@@ -59,6 +61,9 @@ func NewDocumentRepository(cfg *Config) (repository.DocumentRepository, error) {
 		SetConnectTimeout(cfg.ConnectTimeout).
 		SetSocketTimeout(cfg.Timeout).
 		SetReadPreference(readpref.Primary())
+	if cfg.PoolMonitor != nil {
+		clientOptions.SetPoolMonitor(cfg.PoolMonitor.Monitor())
+	}
 
 	client, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
@@ -79,6 +84,26 @@ func NewDocumentRepository(cfg *Config) (repository.DocumentRepository, error) {
 	}, nil
 }
 
+// NewDocumentRepositoryWithClient는 이미 연결된 클라이언트로 문서 저장소를 생성합니다
+// 샤드 인스턴스처럼 호출자가 연결을 관리하는 경우에 사용하며, 클라이언트 종료는 호출자가 합니다
+func NewDocumentRepositoryWithClient(client *mongo.Client, database string) repository.DocumentRepository {
+	return &DocumentRepository{
+		client:   client,
+		database: client.Database(database),
+		metrics:  metrics.GetMetrics(),
+	}
+}
+
+// presetObjectID는 저장 전에 배정된 문서 ID(샤딩 라우팅 등)를 ObjectID로 변환합니다
+// ID가 없거나 ObjectID 형식이 아니면 zero 값을 반환해 서버가 ID를 생성합니다
+func presetObjectID(doc *entity.Document) primitive.ObjectID {
+	oid, err := primitive.ObjectIDFromHex(doc.ID())
+	if err != nil {
+		return primitive.NilObjectID
+	}
+	return oid
+}
+
 // Save는 문서를 저장합니다
 func (r *DocumentRepository) Save(ctx context.Context, doc *entity.Document) error {
 	start := time.Now()
@@ -95,11 +120,13 @@ func (r *DocumentRepository) Save(ctx context.Context, doc *entity.Document) err
 	}()
 
 	model := &documentModel{
+		ID:         presetObjectID(doc),
 		Collection: doc.Collection(),
 		Data:       doc.Data(),
 		Version:    doc.Version(),
 		CreatedAt:  doc.CreatedAt(),
 		UpdatedAt:  doc.UpdatedAt(),
+		ExpiresAt:  modelExpiresAt(doc),
 	}
 
 	coll := r.database.Collection(collection)
@@ -166,6 +193,9 @@ func (r *DocumentRepository) FindByID(ctx context.Context, collection, id string
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
+	if model.ExpiresAt != nil {
+		doc.SetExpiresAt(*model.ExpiresAt)
+	}
 
 	return doc, nil
 }
@@ -205,6 +235,11 @@ func (r *DocumentRepository) Update(ctx context.Context, doc *entity.Document) e
 			"updated_at": doc.UpdatedAt(),
 		},
 	}
+	if expiresAt := modelExpiresAt(doc); expiresAt != nil {
+		update["$set"].(bson.M)["expires_at"] = expiresAt
+	} else {
+		update["$unset"] = bson.M{"expires_at": ""}
+	}
 
 	result, err := coll.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -0,0 +1,82 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// expiryIndexName은 expires_at TTL 인덱스 이름입니다
+const expiryIndexName = "expires_at_ttl"
+
+// modelExpiresAt은 문서의 만료 시각을 저장 모델 값으로 변환합니다 (만료가 없으면 nil이라 필드를 저장하지 않음)
+func modelExpiresAt(doc *entity.Document) *time.Time {
+	expiresAt := doc.ExpiresAt()
+	if expiresAt.IsZero() {
+		return nil
+	}
+	return &expiresAt
+}
+
+// EnableExpiry는 expires_at 필드에 TTL 인덱스를 생성합니다 (expireAfterSeconds=0이라 필드 시각에 만료)
+// MongoDB TTL 모니터가 약 60초 주기로 만료 문서를 삭제하므로 항상 true를 반환합니다
+func (r *DocumentRepository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	_, err := r.database.Collection(collection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetName(expiryIndexName).SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create ttl index on %s: %w", collection, err)
+	}
+
+	logger.Info(ctx, "ttl index ensured",
+		logger.Collection(collection),
+		zap.String("index", expiryIndexName),
+	)
+	return true, nil
+}
+
+// DeleteExpired는 before 시점까지 만료된 문서를 삭제합니다
+// TTL 모니터가 정리하므로 보통 필요 없지만, 정리 주기보다 빨리 지워야 할 때 사용할 수 있습니다
+func (r *DocumentRepository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	start := time.Now()
+	coll := r.database.Collection(collection)
+	filter := bson.M{"expires_at": bson.M{"$lte": before}}
+
+	if limit > 0 {
+		// deleteMany는 개수 제한이 없으므로 대상 ID를 먼저 모아 한 배치만 삭제합니다
+		cursor, err := coll.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}).SetLimit(int64(limit)))
+		if err != nil {
+			return 0, fmt.Errorf("failed to find expired documents: %w", err)
+		}
+		var ids []struct {
+			ID interface{} `bson:"_id"`
+		}
+		if err := cursor.All(ctx, &ids); err != nil {
+			return 0, fmt.Errorf("failed to find expired documents: %w", err)
+		}
+		if len(ids) == 0 {
+			return 0, nil
+		}
+		in := make([]interface{}, len(ids))
+		for i, id := range ids {
+			in[i] = id.ID
+		}
+		filter = bson.M{"_id": bson.M{"$in": in}}
+	}
+
+	result, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		r.metrics.RecordDBOperation("delete_expired", collection, "error", time.Since(start))
+		return 0, fmt.Errorf("failed to delete expired documents: %w", err)
+	}
+	r.metrics.RecordDBOperation("delete_expired", collection, "success", time.Since(start))
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,43 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// MaintenanceOperations는 MongoDB가 지원하는 유지보수 작업을 반환합니다
+func (r *DocumentRepository) MaintenanceOperations() []string {
+	return []string{"compact", "reindex"}
+}
+
+// RunMaintenance는 컬렉션에 compact 명령 또는 인덱스 재생성(reindex)을 실행합니다
+// compact는 삭제로 생긴 빈 공간을 정리해 디스크를 반환하며, 실행한 노드에서만 동작합니다 (각 멤버에서 따로 실행)
+func (r *DocumentRepository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	switch operation {
+	case "compact":
+	case "reindex":
+		return r.reindex(ctx, collection, opts)
+	default:
+		return nil, fmt.Errorf("%w: %s on mongodb", repository.ErrUnsupportedMaintenance, operation)
+	}
+
+	start := time.Now()
+	var result bson.M
+	if err := r.database.RunCommand(ctx, bson.D{{Key: "compact", Value: collection}}).Decode(&result); err != nil {
+		r.metrics.RecordDBOperation("compact", collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to compact collection %s: %w", collection, err)
+	}
+	r.metrics.RecordDBOperation("compact", collection, "success", time.Since(start))
+
+	details := make(map[string]interface{}, len(result))
+	for k, v := range result {
+		if k != "ok" {
+			details[k] = v
+		}
+	}
+	return details, nil
+}
@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/poolhealth"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// healthPool은 MongoDB 클라이언트의 poolhealth.Pool 구현입니다
+// 드라이버가 서버 모니터링(heartbeat)으로 장애를 감지하면 해당 서버의 연결 풀을 비우므로
+// 검증은 주 서버 ping으로 상태만 확인합니다 (ping이 네트워크 오류로 실패해도 풀이 비워집니다)
+type healthPool struct {
+	client *mongo.Client
+}
+
+// NewHealthPool은 MongoDB 클라이언트의 예열/검증 풀을 생성합니다
+func NewHealthPool(client *mongo.Client) poolhealth.Pool {
+	return &healthPool{client: client}
+}
+
+// WarmUp은 ping n개를 동시에 보내 연결을 n개까지 맺습니다
+// 예열한 연결은 maxConnIdleTime 동안 풀에 남고, 그 뒤로는 minPoolSize만큼 유지됩니다
+func (p *healthPool) WarmUp(ctx context.Context, n int) error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = p.client.Ping(ctx, readpref.Primary())
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Validate는 주 서버에 ping을 보내 연결 상태를 확인합니다
+func (p *healthPool) Validate(ctx context.Context, timeout time.Duration) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return 0, p.client.Ping(ctx, readpref.Primary())
+}
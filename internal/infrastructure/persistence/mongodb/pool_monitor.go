@@ -0,0 +1,95 @@
+package mongodb
+
+import (
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// PoolMonitor는 드라이버 연결 풀 이벤트로 MongoDB 연결 풀 상태를 집계합니다
+// 클라이언트 하나에 하나씩 사용하며, 모든 서버(mongod/mongos)의 풀을 합산합니다
+type PoolMonitor struct {
+	mu            sync.Mutex
+	maxPoolSize   map[string]uint64 // 서버 주소별 최대 풀 크기
+	open          int
+	inUse         int
+	checkouts     int64
+	checkoutTime  time.Duration
+	timeouts      int64
+	connectErrors int64
+}
+
+// NewPoolMonitor는 새로운 PoolMonitor를 생성합니다
+func NewPoolMonitor() *PoolMonitor {
+	return &PoolMonitor{
+		maxPoolSize: make(map[string]uint64),
+	}
+}
+
+// Monitor는 options.Client().SetPoolMonitor에 전달할 드라이버 모니터를 반환합니다
+func (p *PoolMonitor) Monitor() *event.PoolMonitor {
+	return &event.PoolMonitor{Event: p.handle}
+}
+
+// handle은 연결 풀 이벤트를 반영합니다
+func (p *PoolMonitor) handle(e *event.PoolEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch e.Type {
+	case event.PoolCreated:
+		if e.PoolOptions != nil {
+			p.maxPoolSize[e.Address] = e.PoolOptions.MaxPoolSize
+		}
+	case event.PoolClosedEvent:
+		delete(p.maxPoolSize, e.Address)
+	case event.ConnectionCreated:
+		p.open++
+	case event.ConnectionClosed:
+		p.open--
+		if e.Reason == event.ReasonError {
+			p.connectErrors++
+		}
+	case event.GetSucceeded:
+		p.inUse++
+		p.checkouts++
+		p.checkoutTime += e.Duration
+	case event.GetFailed:
+		p.checkouts++
+		p.checkoutTime += e.Duration
+		if e.Reason == event.ReasonTimedOut {
+			p.timeouts++
+		}
+	case event.ConnectionReturned:
+		p.inUse--
+	}
+}
+
+// Stats는 연결 풀 상태를 반환합니다
+// WaitCount와 WaitDuration은 체크아웃 횟수와 체크아웃에 걸린 총 시간입니다 (연결 생성 시간 포함)
+func (p *PoolMonitor) Stats() poolstats.Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var maxOpen uint64
+	for _, size := range p.maxPoolSize {
+		maxOpen += size
+	}
+	idle := p.open - p.inUse
+	if idle < 0 {
+		idle = 0
+	}
+
+	return poolstats.Stats{
+		MaxOpen:       int(maxOpen),
+		Open:          p.open,
+		InUse:         p.inUse,
+		Idle:          idle,
+		WaitCount:     p.checkouts,
+		WaitDuration:  p.checkoutTime,
+		Timeouts:      p.timeouts,
+		ConnectErrors: p.connectErrors,
+	}
+}
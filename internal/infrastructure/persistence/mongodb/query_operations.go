@@ -37,40 +37,7 @@ func (r *DocumentRepository) FindWithOptions(ctx context.Context, collection str
 		bsonFilter = bson.M{}
 	}
 
-	// 옵션 설정
-	findOpts := options.Find()
-
-	if opts != nil {
-		// Sort 옵션
-		if len(opts.Sort) > 0 {
-			sort := bson.D{}
-			for k, v := range opts.Sort {
-				sort = append(sort, bson.E{Key: k, Value: v})
-			}
-			findOpts.SetSort(sort)
-		}
-
-		// Limit 옵션
-		if opts.Limit > 0 {
-			findOpts.SetLimit(opts.Limit)
-		}
-
-		// Skip 옵션
-		if opts.Skip > 0 {
-			findOpts.SetSkip(opts.Skip)
-		}
-
-		// Projection 옵션
-		if len(opts.Projection) > 0 {
-			projection := bson.M{}
-			for k, v := range opts.Projection {
-				projection[k] = v
-			}
-			findOpts.SetProjection(projection)
-		}
-	}
-
-	cursor, err := coll.Find(ctx, bsonFilter, findOpts)
+	cursor, err := coll.Find(ctx, bsonFilter, buildFindOptions(opts))
 	if err != nil {
 		r.metrics.RecordDBOperation("find_with_options", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to find documents with options",
@@ -112,6 +79,43 @@ func (r *DocumentRepository) FindWithOptions(ctx context.Context, collection str
 	return documents, nil
 }
 
+// buildFindOptions는 저장소 조회 옵션을 MongoDB find 옵션으로 변환합니다
+func buildFindOptions(opts *repository.FindOptions) *options.FindOptions {
+	findOpts := options.Find()
+
+	if opts != nil {
+		// Sort 옵션
+		if len(opts.Sort) > 0 {
+			sort := bson.D{}
+			for k, v := range opts.Sort {
+				sort = append(sort, bson.E{Key: k, Value: v})
+			}
+			findOpts.SetSort(sort)
+		}
+
+		// Limit 옵션
+		if opts.Limit > 0 {
+			findOpts.SetLimit(opts.Limit)
+		}
+
+		// Skip 옵션
+		if opts.Skip > 0 {
+			findOpts.SetSkip(opts.Skip)
+		}
+
+		// Projection 옵션
+		if len(opts.Projection) > 0 {
+			projection := bson.M{}
+			for k, v := range opts.Projection {
+				projection[k] = v
+			}
+			findOpts.SetProjection(projection)
+		}
+	}
+
+	return findOpts
+}
+
 // Upsert는 문서가 없으면 생성하고 있으면 업데이트합니다
 // 원자적 연산으로 동시성 환경에서 안전합니다
 func (r *DocumentRepository) Upsert(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (string, error) {
@@ -211,6 +215,7 @@ func (r *DocumentRepository) Replace(ctx context.Context, collection, id string,
 		Version:    replacement.Version() + 1,
 		CreatedAt:  replacement.CreatedAt(),
 		UpdatedAt:  time.Now(),
+		ExpiresAt:  modelExpiresAt(replacement),
 	}
 
 	result, err := coll.ReplaceOne(ctx, filter, replacementDoc)
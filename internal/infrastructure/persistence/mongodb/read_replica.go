@@ -0,0 +1,73 @@
+package mongodb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// NewReadReplicaRepository는 클라이언트의 읽기 선호도(secondaryPreferred 등)로 조회하는 읽기용 문서 저장소를 생성합니다
+// 클라이언트는 ParseReadPreference로 만든 읽기 선호도로 연결해야 하며, DocumentUseCase는 이 저장소를 읽기에만 사용합니다
+func NewReadReplicaRepository(client *mongo.Client, database string) repository.DocumentRepository {
+	return &DocumentRepository{
+		client:   client,
+		database: client.Database(database),
+		metrics:  metrics.GetMetrics(),
+	}
+}
+
+// ParseReadPreference는 읽기 선호도 모드 이름을 변환합니다
+// 지원 모드: primary, primaryPreferred, secondary, secondaryPreferred, nearest
+// maxStaleness가 0보다 크면 그보다 오래 지연된 secondary는 선택하지 않습니다 (MongoDB 최소 90초, primary 모드에는 적용 불가)
+// hedged가 true이면 샤드 클러스터에서 mongos가 두 멤버에 동시에 읽고 먼저 온 응답을 사용합니다 (MongoDB 4.4+, primary 모드에는 적용 불가)
+func ParseReadPreference(mode string, maxStaleness time.Duration, hedged bool) (*readpref.ReadPref, error) {
+	readMode, err := readpref.ModeFromString(mode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse read preference: %w", err)
+	}
+
+	var opts []readpref.Option
+	if maxStaleness > 0 {
+		if readMode == readpref.PrimaryMode {
+			return nil, fmt.Errorf("max staleness cannot be used with primary read preference")
+		}
+		opts = append(opts, readpref.WithMaxStaleness(maxStaleness))
+	}
+	if hedged {
+		if readMode == readpref.PrimaryMode {
+			return nil, fmt.Errorf("hedged reads cannot be used with primary read preference")
+		}
+		opts = append(opts, readpref.WithHedgeEnabled(true))
+	}
+
+	rp, err := readpref.New(readMode, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse read preference: %w", err)
+	}
+	return rp, nil
+}
+
+// ParseReadConcern은 읽기 일관성 수준 이름을 변환합니다
+// 지원 수준: local, available, majority, linearizable (빈 값이면 서버 기본값을 사용하도록 nil 반환)
+// snapshot은 트랜잭션 전용이므로 읽기 저장소 설정으로는 허용하지 않습니다
+func ParseReadConcern(level string) (*readconcern.ReadConcern, error) {
+	switch level {
+	case "":
+		return nil, nil
+	case "local":
+		return readconcern.Local(), nil
+	case "available":
+		return readconcern.Available(), nil
+	case "majority":
+		return readconcern.Majority(), nil
+	case "linearizable":
+		return readconcern.Linearizable(), nil
+	default:
+		return nil, fmt.Errorf("unsupported read concern: %s", level)
+	}
+}
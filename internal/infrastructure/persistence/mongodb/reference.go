@@ -0,0 +1,8 @@
+package mongodb
+
+import "context"
+
+// FindReferencing은 data의 field 값이 id인 문서의 ID를 조회합니다 (고유 키 조회와 같은 값 비교)
+func (r *DocumentRepository) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	return r.FindByUniqueKey(ctx, collection, map[string]interface{}{field: id}, limit)
+}
@@ -0,0 +1,78 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// reindex는 _id를 제외한 컬렉션의 인덱스를 하나씩 삭제한 뒤 같은 정의로 다시 생성합니다
+// 재생성하는 동안 그 인덱스를 쓰는 쿼리는 느려지고, unique 인덱스는 다시 생성될 때까지 중복을 막지 못합니다
+func (r *DocumentRepository) reindex(ctx context.Context, collection string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	indexes := r.database.Collection(collection).Indexes()
+	cursor, err := indexes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	var specs []bson.D
+	if err := cursor.All(ctx, &specs); err != nil {
+		return nil, fmt.Errorf("failed to decode indexes: %w", err)
+	}
+
+	var rebuild []bson.D
+	for _, spec := range specs {
+		if indexSpecName(spec) != "_id_" {
+			rebuild = append(rebuild, spec)
+		}
+	}
+
+	total := int64(len(rebuild))
+	opts.ReportProgress(0, total)
+
+	start := time.Now()
+	rebuilt := make([]string, 0, len(rebuild))
+	for _, spec := range rebuild {
+		name := indexSpecName(spec)
+		if _, err := indexes.DropOne(ctx, name); err != nil {
+			r.metrics.RecordDBOperation("reindex", collection, "error", time.Since(start))
+			return map[string]interface{}{"indexes": rebuilt}, fmt.Errorf("failed to drop index %s: %w", name, err)
+		}
+
+		// listIndexes가 돌려준 정의에서 버전/네임스페이스 필드만 빼고 그대로 다시 생성
+		definition := make(bson.D, 0, len(spec))
+		for _, field := range spec {
+			if field.Key != "v" && field.Key != "ns" {
+				definition = append(definition, field)
+			}
+		}
+		err := r.database.RunCommand(ctx, bson.D{
+			{Key: "createIndexes", Value: collection},
+			{Key: "indexes", Value: bson.A{definition}},
+		}).Err()
+		if err != nil {
+			r.metrics.RecordDBOperation("reindex", collection, "error", time.Since(start))
+			// 삭제된 인덱스를 수동으로 복구할 수 있도록 정의를 에러에 포함
+			return map[string]interface{}{"indexes": rebuilt}, fmt.Errorf("failed to recreate index %s (definition %v): %w", name, definition, err)
+		}
+
+		rebuilt = append(rebuilt, name)
+		opts.ReportProgress(int64(len(rebuilt)), total)
+	}
+	r.metrics.RecordDBOperation("reindex", collection, "success", time.Since(start))
+
+	return map[string]interface{}{"indexes": rebuilt}, nil
+}
+
+// indexSpecName은 listIndexes 결과의 인덱스 이름을 반환합니다
+func indexSpecName(spec bson.D) string {
+	for _, field := range spec {
+		if field.Key == "name" {
+			name, _ := field.Value.(string)
+			return name
+		}
+	}
+	return ""
+}
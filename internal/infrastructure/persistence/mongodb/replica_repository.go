@@ -0,0 +1,119 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// ReplicaRepository는 CDC 이벤트를 적용하는 MongoDB 복제 대상 저장소입니다
+// 일반 저장소와 달리 원본 문서의 ID와 버전을 그대로 유지하며, CDC 이벤트를 발행하지 않습니다
+type ReplicaRepository struct {
+	client   *mongo.Client
+	database *mongo.Database
+	metrics  *metrics.Metrics
+}
+
+// NewReplicaRepository는 새로운 MongoDB 복제 대상 저장소를 생성합니다
+func NewReplicaRepository(cfg *Config) (*ReplicaRepository, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+	defer cancel()
+
+	clientOptions := options.Client().
+		ApplyURI(cfg.URI).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetMinPoolSize(cfg.MinPoolSize).
+		SetMaxConnecting(cfg.MaxConnecting).
+		SetServerSelectionTimeout(cfg.ConnectTimeout).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetSocketTimeout(cfg.Timeout).
+		SetReadPreference(readpref.Primary())
+
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+
+	if err := client.Ping(ctx, readpref.Primary()); err != nil {
+		return nil, fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+
+	return &ReplicaRepository{
+		client:   client,
+		database: client.Database(cfg.Database),
+		metrics:  metrics.GetMetrics(),
+	}, nil
+}
+
+// ApplyUpsert는 대상 문서의 버전이 version보다 낮거나 문서가 없을 때만 저장합니다
+// 버전 조건이 맞지 않으면 필터가 일치하지 않아 upsert가 같은 _id로 삽입을 시도하고,
+// 중복 키 에러가 나므로 이를 이미 적용된 이벤트로 처리합니다
+func (r *ReplicaRepository) ApplyUpsert(ctx context.Context, collection, id string, data map[string]interface{}, version int, updatedAt time.Time) (bool, error) {
+	start := time.Now()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("%w: invalid id format: %s", entity.ErrInvalidData, id)
+	}
+
+	filter := bson.M{
+		"_id":     objectID,
+		"version": bson.M{"$lt": version},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"collection": collection,
+			"data":       data,
+			"version":    version,
+			"updated_at": updatedAt,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": updatedAt,
+		},
+	}
+
+	result, err := r.database.Collection(collection).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			r.metrics.RecordDBOperation("replica_upsert", collection, "skipped", time.Since(start))
+			return false, nil
+		}
+		r.metrics.RecordDBOperation("replica_upsert", collection, "error", time.Since(start))
+		return false, fmt.Errorf("failed to apply replica upsert: %w", err)
+	}
+
+	r.metrics.RecordDBOperation("replica_upsert", collection, "success", time.Since(start))
+	return result.ModifiedCount > 0 || result.UpsertedCount > 0, nil
+}
+
+// ApplyDelete는 문서를 삭제합니다 (이미 없으면 false)
+func (r *ReplicaRepository) ApplyDelete(ctx context.Context, collection, id string) (bool, error) {
+	start := time.Now()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("%w: invalid id format: %s", entity.ErrInvalidData, id)
+	}
+
+	result, err := r.database.Collection(collection).DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		r.metrics.RecordDBOperation("replica_delete", collection, "error", time.Since(start))
+		return false, fmt.Errorf("failed to apply replica delete: %w", err)
+	}
+
+	r.metrics.RecordDBOperation("replica_delete", collection, "success", time.Since(start))
+	return result.DeletedCount > 0, nil
+}
+
+// Close는 MongoDB 연결을 종료합니다
+func (r *ReplicaRepository) Close(ctx context.Context) error {
+	return r.client.Disconnect(ctx)
+}
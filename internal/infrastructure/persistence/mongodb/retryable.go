@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"errors"
+
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// retryableErrorCodes는 재시도 가능한 MongoDB 서버 에러 코드입니다 (선출 중, 종료 중, 네트워크 시간 초과 등)
+var retryableErrorCodes = []int{
+	6,     // HostUnreachable
+	7,     // HostNotFound
+	89,    // NetworkTimeout
+	91,    // ShutdownInProgress
+	189,   // PrimarySteppedDown
+	262,   // ExceededTimeLimit
+	9001,  // SocketException
+	10107, // NotWritablePrimary
+	11600, // InterruptedAtShutdown
+	11602, // InterruptedDueToReplStateChange
+	13435, // NotPrimaryNoSecondaryOk
+	13436, // NotPrimaryOrSecondary
+}
+
+// IsRetryable은 MongoDB 에러가 재시도 가능한지 확인합니다
+// 네트워크 에러, 시간 초과, 드라이버가 RetryableWriteError/TransientTransactionError로 표시한 에러와
+// 선출/종료 중 에러는 재시도하고, 중복 키나 검증 실패처럼 다시 실행해도 같은 결과가 나오는 에러는 재시도하지 않습니다
+func IsRetryable(err error) bool {
+	if mongo.IsNetworkError(err) || mongo.IsTimeout(err) {
+		return true
+	}
+
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		if serverErr.HasErrorLabel("RetryableWriteError") || serverErr.HasErrorLabel("TransientTransactionError") {
+			return true
+		}
+		for _, code := range retryableErrorCodes {
+			if serverErr.HasErrorCode(code) {
+				return true
+			}
+		}
+		return false
+	}
+	return retry.IsRetryable(err)
+}
@@ -0,0 +1,180 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RevisionCollectionName은 문서 리비전 컬렉션 이름입니다
+const RevisionCollectionName = "_revisions"
+
+// RevisionRepository는 MongoDB 기반 문서 리비전 저장소입니다
+type RevisionRepository struct {
+	collection *mongo.Collection
+}
+
+// revisionModel은 MongoDB에 저장되는 리비전 모델입니다
+type revisionModel struct {
+	ID           primitive.ObjectID     `bson:"_id,omitempty"`
+	DatabaseType string                 `bson:"database_type"`
+	Collection   string                 `bson:"collection"`
+	DocumentID   string                 `bson:"document_id"`
+	Version      int                    `bson:"version"`
+	Data         map[string]interface{} `bson:"data"`
+	ValidFrom    time.Time              `bson:"valid_from"`
+	ValidTo      time.Time              `bson:"valid_to"`
+	Operation    string                 `bson:"operation"`
+}
+
+// NewRevisionRepository는 새로운 리비전 저장소를 생성합니다
+func NewRevisionRepository(database *mongo.Database) *RevisionRepository {
+	return &RevisionRepository{
+		collection: database.Collection(RevisionCollectionName),
+	}
+}
+
+// revisionFilter는 문서 하나의 리비전 필터를 반환합니다
+func revisionFilter(key repository.RevisionKey) bson.M {
+	return bson.M{
+		"database_type": key.DatabaseType,
+		"collection":    key.Collection,
+		"document_id":   key.DocumentID,
+	}
+}
+
+// Append는 리비전을 추가합니다
+func (r *RevisionRepository) Append(ctx context.Context, revision *entity.Revision) error {
+	result, err := r.collection.InsertOne(ctx, &revisionModel{
+		DatabaseType: revision.DatabaseType,
+		Collection:   revision.Collection,
+		DocumentID:   revision.DocumentID,
+		Version:      revision.Version,
+		Data:         revision.Data,
+		ValidFrom:    revision.ValidFrom,
+		ValidTo:      revision.ValidTo,
+		Operation:    string(revision.Operation),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to append revision: %w", err)
+	}
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		revision.ID = oid.Hex()
+	}
+	return nil
+}
+
+// List는 문서의 리비전을 최신 버전부터 조회합니다
+func (r *RevisionRepository) List(ctx context.Context, key repository.RevisionKey, limit int64) ([]*entity.Revision, error) {
+	findOpts := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+	if limit > 0 {
+		findOpts.SetLimit(limit)
+	}
+
+	cursor, err := r.collection.Find(ctx, revisionFilter(key), findOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var models []revisionModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, fmt.Errorf("failed to decode revisions: %w", err)
+	}
+
+	revisions := make([]*entity.Revision, 0, len(models))
+	for i := range models {
+		revisions = append(revisions, models[i].toEntity())
+	}
+	return revisions, nil
+}
+
+// FindAsOf는 at 시점에 최신 상태였던 리비전을 조회합니다
+func (r *RevisionRepository) FindAsOf(ctx context.Context, key repository.RevisionKey, at time.Time) (*entity.Revision, error) {
+	filter := revisionFilter(key)
+	filter["valid_from"] = bson.M{"$lte": at}
+	filter["valid_to"] = bson.M{"$gt": at}
+
+	var model revisionModel
+	err := r.collection.FindOne(ctx, filter, options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})).Decode(&model)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, entity.ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// Prune은 보관 개수와 보존 기간을 넘은 리비전을 삭제합니다
+func (r *RevisionRepository) Prune(ctx context.Context, key repository.RevisionKey, keep int, olderThan time.Time) (int64, error) {
+	var conditions []bson.M
+	if !olderThan.IsZero() {
+		conditions = append(conditions, bson.M{"valid_to": bson.M{"$lt": olderThan}})
+	}
+	if keep > 0 {
+		// keep번째 최신 리비전보다 낮은 버전을 삭제
+		var boundary revisionModel
+		err := r.collection.FindOne(ctx, revisionFilter(key), options.FindOne().
+			SetSort(bson.D{{Key: "version", Value: -1}}).
+			SetSkip(int64(keep-1)).
+			SetProjection(bson.M{"version": 1}),
+		).Decode(&boundary)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, fmt.Errorf("failed to find revision boundary: %w", err)
+		}
+		if err == nil {
+			conditions = append(conditions, bson.M{"version": bson.M{"$lt": boundary.Version}})
+		}
+	}
+	if len(conditions) == 0 {
+		return 0, nil
+	}
+
+	filter := revisionFilter(key)
+	filter["$or"] = conditions
+	result, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune revisions: %w", err)
+	}
+	return result.DeletedCount, nil
+}
+
+// EnsureSchema는 문서별 버전 조회 인덱스를 생성합니다
+func (r *RevisionRepository) EnsureSchema(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "database_type", Value: 1},
+			{Key: "collection", Value: 1},
+			{Key: "document_id", Value: 1},
+			{Key: "version", Value: -1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create revision indexes: %w", err)
+	}
+	return nil
+}
+
+// toEntity는 저장 모델을 리비전 엔티티로 변환합니다
+func (m *revisionModel) toEntity() *entity.Revision {
+	return &entity.Revision{
+		ID:           m.ID.Hex(),
+		DatabaseType: m.DatabaseType,
+		Collection:   m.Collection,
+		DocumentID:   m.DocumentID,
+		Version:      m.Version,
+		Data:         m.Data,
+		ValidFrom:    m.ValidFrom,
+		ValidTo:      m.ValidTo,
+		Operation:    entity.AuditOperation(m.Operation),
+	}
+}
@@ -0,0 +1,195 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TenantCollectionName은 테넌트 컬렉션 이름입니다
+const TenantCollectionName = "_tenants"
+
+// TenantRepository는 MongoDB 기반 테넌트 저장소입니다
+type TenantRepository struct {
+	collection *mongo.Collection
+}
+
+// tenantModel은 MongoDB에 저장되는 테넌트 모델입니다 (_id는 테넌트 ID)
+type tenantModel struct {
+	ID           string              `bson:"_id"`
+	Namespace    string              `bson:"namespace"`
+	DatabaseType string              `bson:"database_type"`
+	Collections  []string            `bson:"collections,omitempty"`
+	Quota        tenantQuotaModel    `bson:"quota"`
+	APIKeys      []tenantAPIKeyModel `bson:"api_keys,omitempty"`
+	CDCTopics    []string            `bson:"cdc_topics,omitempty"`
+	CreatedAt    time.Time           `bson:"created_at"`
+	UpdatedAt    time.Time           `bson:"updated_at"`
+}
+
+// tenantQuotaModel은 테넌트 저장 용량 한도 모델입니다
+type tenantQuotaModel struct {
+	MaxDocuments int64 `bson:"max_documents,omitempty"`
+	MaxBytes     int64 `bson:"max_bytes,omitempty"`
+}
+
+// tenantAPIKeyModel은 테넌트 API 키 모델입니다 (비밀 값은 해시만 저장)
+type tenantAPIKeyModel struct {
+	ID          string    `bson:"id"`
+	SecretHash  []byte    `bson:"secret_hash"`
+	Roles       []string  `bson:"roles"`
+	Description string    `bson:"description,omitempty"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// NewTenantRepository는 새로운 테넌트 저장소를 생성합니다
+func NewTenantRepository(database *mongo.Database) *TenantRepository {
+	return &TenantRepository{collection: database.Collection(TenantCollectionName)}
+}
+
+// EnsureIndexes는 API 키 ID 조회용 고유 인덱스를 생성합니다
+func (r *TenantRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "api_keys.id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create tenant indexes: %w", err)
+	}
+	return nil
+}
+
+// Create는 테넌트를 저장합니다
+func (r *TenantRepository) Create(ctx context.Context, tenant *entity.Tenant) error {
+	if _, err := r.collection.InsertOne(ctx, toTenantModel(tenant)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%w: tenant %s already exists", entity.ErrDuplicateKey, tenant.ID)
+		}
+		return fmt.Errorf("failed to create tenant: %w", err)
+	}
+	return nil
+}
+
+// FindByID는 ID로 테넌트를 조회합니다
+func (r *TenantRepository) FindByID(ctx context.Context, id string) (*entity.Tenant, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByAPIKeyID는 API 키 ID로 키를 가진 테넌트를 조회합니다
+func (r *TenantRepository) FindByAPIKeyID(ctx context.Context, keyID string) (*entity.Tenant, error) {
+	return r.findOne(ctx, bson.M{"api_keys.id": keyID})
+}
+
+// List는 모든 테넌트를 생성 순으로 조회합니다
+func (r *TenantRepository) List(ctx context.Context) ([]*entity.Tenant, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenants: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var models []tenantModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, fmt.Errorf("failed to decode tenants: %w", err)
+	}
+
+	tenants := make([]*entity.Tenant, 0, len(models))
+	for i := range models {
+		tenants = append(tenants, models[i].toEntity())
+	}
+	return tenants, nil
+}
+
+// Update는 테넌트를 갱신합니다 (생성 시각은 유지)
+func (r *TenantRepository) Update(ctx context.Context, tenant *entity.Tenant) error {
+	model := toTenantModel(tenant)
+	update := bson.M{"$set": bson.M{
+		"namespace":     model.Namespace,
+		"database_type": model.DatabaseType,
+		"collections":   model.Collections,
+		"quota":         model.Quota,
+		"api_keys":      model.APIKeys,
+		"cdc_topics":    model.CDCTopics,
+		"updated_at":    model.UpdatedAt,
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": tenant.ID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update tenant: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return entity.ErrDocumentNotFound
+	}
+	return nil
+}
+
+// findOne은 filter와 일치하는 테넌트 하나를 조회합니다
+func (r *TenantRepository) findOne(ctx context.Context, filter bson.M) (*entity.Tenant, error) {
+	var model tenantModel
+	if err := r.collection.FindOne(ctx, filter).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to find tenant: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// toTenantModel은 엔티티를 저장 모델로 변환합니다
+func toTenantModel(tenant *entity.Tenant) *tenantModel {
+	model := &tenantModel{
+		ID:           tenant.ID,
+		Namespace:    tenant.Namespace,
+		DatabaseType: tenant.DatabaseType,
+		Collections:  tenant.Collections,
+		Quota: tenantQuotaModel{
+			MaxDocuments: tenant.Quota.MaxDocuments,
+			MaxBytes:     tenant.Quota.MaxBytes,
+		},
+		CDCTopics: tenant.CDCTopics,
+		CreatedAt: tenant.CreatedAt,
+		UpdatedAt: tenant.UpdatedAt,
+	}
+	for _, key := range tenant.APIKeys {
+		model.APIKeys = append(model.APIKeys, tenantAPIKeyModel{
+			ID:          key.ID,
+			SecretHash:  key.SecretHash,
+			Roles:       key.Roles,
+			Description: key.Description,
+			CreatedAt:   key.CreatedAt,
+		})
+	}
+	return model
+}
+
+// toEntity는 저장 모델을 엔티티로 변환합니다
+func (m *tenantModel) toEntity() *entity.Tenant {
+	tenant := &entity.Tenant{
+		ID:           m.ID,
+		Namespace:    m.Namespace,
+		DatabaseType: m.DatabaseType,
+		Collections:  m.Collections,
+		Quota: entity.TenantQuota{
+			MaxDocuments: m.Quota.MaxDocuments,
+			MaxBytes:     m.Quota.MaxBytes,
+		},
+		CDCTopics: m.CDCTopics,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	for _, key := range m.APIKeys {
+		tenant.APIKeys = append(tenant.APIKeys, entity.TenantAPIKey{
+			ID:          key.ID,
+			SecretHash:  key.SecretHash,
+			Roles:       key.Roles,
+			Description: key.Description,
+			CreatedAt:   key.CreatedAt,
+		})
+	}
+	return tenant
+}
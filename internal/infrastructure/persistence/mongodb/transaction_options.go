@@ -0,0 +1,45 @@
+package mongodb
+
+import (
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// transactionOptions는 저장소 트랜잭션 옵션을 MongoDB 트랜잭션 옵션으로 변환합니다
+// MongoDB는 격리 수준 대신 read concern을 사용하므로 가장 가까운 read concern으로 매핑합니다
+//   - read_uncommitted: local
+//   - read_committed: majority
+//   - repeatable_read, snapshot, serializable: snapshot (+ majority write concern)
+//
+// 트랜잭션 읽기는 항상 primary에서 실행되므로 ReadOnly는 적용되지 않습니다
+func transactionOptions(opts *repository.TxOptions) (*options.TransactionOptions, error) {
+	txnOpts := options.Transaction()
+	if opts == nil {
+		return txnOpts, nil
+	}
+
+	switch opts.Isolation {
+	case repository.IsolationDefault:
+	case repository.IsolationReadUncommitted:
+		txnOpts.SetReadConcern(readconcern.Local())
+	case repository.IsolationReadCommitted:
+		txnOpts.SetReadConcern(readconcern.Majority())
+	case repository.IsolationRepeatableRead, repository.IsolationSnapshot, repository.IsolationSerializable:
+		// snapshot read concern은 majority write concern으로 커밋해야 보장됨
+		txnOpts.SetReadConcern(readconcern.Snapshot())
+		txnOpts.SetWriteConcern(writeconcern.Majority())
+	default:
+		return nil, fmt.Errorf("unsupported isolation level: %s", opts.Isolation)
+	}
+
+	if opts.Timeout > 0 {
+		timeout := opts.Timeout
+		txnOpts.SetMaxCommitTime(&timeout)
+	}
+
+	return txnOpts, nil
+}
@@ -0,0 +1,77 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// IsDuplicateKey는 MongoDB 에러가 고유 인덱스(_id 포함) 위반인지 확인합니다
+func IsDuplicateKey(err error) bool {
+	return mongo.IsDuplicateKeyError(err)
+}
+
+// EnsureUniqueKey는 data 필드에 고유 인덱스를 생성합니다 (같은 이름과 정의의 인덱스가 있으면 그대로 둠)
+// 필드가 모두 있는 문서만 인덱스에 포함하는 partial index이므로 필드가 없는 문서는 여러 개여도 됩니다
+// MongoDB는 null을 값으로 인덱싱하므로 필드가 null인 문서도 한 개만 허용됩니다
+func (r *DocumentRepository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	keys := make(bson.D, 0, len(fields))
+	partial := bson.M{}
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: "data." + field, Value: 1})
+		partial["data."+field] = bson.M{"$exists": true}
+	}
+
+	name := repository.UniqueKeyName(fields)
+	_, err := r.database.Collection(collection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    keys,
+		Options: options.Index().SetName(name).SetUnique(true).SetPartialFilterExpression(partial),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create unique index on %s: %w", collection, err)
+	}
+
+	logger.Info(ctx, "unique index ensured",
+		logger.Collection(collection),
+		zap.String("index", name),
+	)
+	return true, nil
+}
+
+// FindByUniqueKey는 data 필드 값이 key와 같은 문서의 ID를 조회합니다
+func (r *DocumentRepository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	start := time.Now()
+	filter := bson.M{}
+	for field, value := range key {
+		filter["data."+field] = value
+	}
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := r.database.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		r.metrics.RecordDBOperation("find_unique_key", collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to find documents by unique key: %w", err)
+	}
+	var models []documentModel
+	if err := cursor.All(ctx, &models); err != nil {
+		r.metrics.RecordDBOperation("find_unique_key", collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to find documents by unique key: %w", err)
+	}
+	r.metrics.RecordDBOperation("find_unique_key", collection, "success", time.Since(start))
+
+	ids := make([]string, len(models))
+	for i, model := range models {
+		ids[i] = model.ID.Hex()
+	}
+	return ids, nil
+}
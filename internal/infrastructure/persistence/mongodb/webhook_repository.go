@@ -0,0 +1,451 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 웹훅 컬렉션 이름
+const (
+	WebhookSubscriptionCollectionName = "_webhook_subscriptions"
+	WebhookDeliveryCollectionName     = "_webhook_deliveries"
+)
+
+const webhookDeliveryTTLIndexName = "webhook_delivery_ttl"
+
+// WebhookSubscriptionRepository는 MongoDB 기반 웹훅 구독 저장소입니다
+type WebhookSubscriptionRepository struct {
+	collection *mongo.Collection
+	deliveries *mongo.Collection
+}
+
+// WebhookDeliveryRepository는 MongoDB 기반 웹훅 전송 로그(재시도 큐) 저장소입니다
+type WebhookDeliveryRepository struct {
+	collection *mongo.Collection
+}
+
+// webhookSubscriptionModel은 MongoDB에 저장되는 웹훅 구독 모델입니다
+// 필터는 $ 연산자 키가 있어도 저장할 수 있도록 JSON 문자열로 보관합니다
+type webhookSubscriptionModel struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	URL         string             `bson:"url"`
+	Collection  string             `bson:"collection"`
+	EventTypes  []string           `bson:"event_types,omitempty"`
+	Filter      string             `bson:"filter,omitempty"`
+	Secret      string             `bson:"secret"`
+	Description string             `bson:"description,omitempty"`
+	Active      bool               `bson:"active"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+// webhookDeliveryModel은 MongoDB에 저장되는 웹훅 전송 기록 모델입니다
+type webhookDeliveryModel struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	SubscriptionID string             `bson:"subscription_id"`
+	EventID        string             `bson:"event_id"`
+	EventType      string             `bson:"event_type"`
+	Collection     string             `bson:"collection"`
+	DocumentID     string             `bson:"document_id"`
+	Payload        string             `bson:"payload"`
+	Status         string             `bson:"status"`
+	Attempts       int                `bson:"attempts"`
+	NextAttemptAt  time.Time          `bson:"next_attempt_at"`
+	LastStatusCode int                `bson:"last_status_code,omitempty"`
+	LastError      string             `bson:"last_error,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	DeliveredAt    time.Time          `bson:"delivered_at,omitempty"`
+}
+
+// NewWebhookSubscriptionRepository는 새로운 웹훅 구독 저장소를 생성합니다
+func NewWebhookSubscriptionRepository(database *mongo.Database) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{
+		collection: database.Collection(WebhookSubscriptionCollectionName),
+		deliveries: database.Collection(WebhookDeliveryCollectionName),
+	}
+}
+
+// NewWebhookDeliveryRepository는 새로운 웹훅 전송 로그 저장소를 생성합니다
+func NewWebhookDeliveryRepository(database *mongo.Database) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		collection: database.Collection(WebhookDeliveryCollectionName),
+	}
+}
+
+// EnsureIndexes는 전송 큐/로그 조회용 인덱스와 전송 기록 보관 기간(TTL) 인덱스를 생성합니다
+func (r *WebhookDeliveryRepository) EnsureIndexes(ctx context.Context, retention time.Duration) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}},
+		{Keys: bson.D{{Key: "subscription_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery indexes: %w", err)
+	}
+	if retention <= 0 {
+		return nil
+	}
+
+	expireAfter := int32(retention.Seconds())
+	_, err = r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().SetName(webhookDeliveryTTLIndexName).SetExpireAfterSeconds(expireAfter),
+	})
+	if err == nil {
+		return nil
+	}
+
+	// 보관 기간이 변경된 경우 collMod로 TTL만 갱신합니다
+	var cmdErr mongo.CommandError
+	if !errors.As(err, &cmdErr) || cmdErr.Code != indexOptionsConflictCode {
+		return fmt.Errorf("failed to create webhook delivery ttl index: %w", err)
+	}
+	cmd := bson.D{
+		{Key: "collMod", Value: WebhookDeliveryCollectionName},
+		{Key: "index", Value: bson.D{
+			{Key: "name", Value: webhookDeliveryTTLIndexName},
+			{Key: "expireAfterSeconds", Value: expireAfter},
+		}},
+	}
+	if err := r.collection.Database().RunCommand(ctx, cmd).Err(); err != nil {
+		return fmt.Errorf("failed to update webhook delivery retention: %w", err)
+	}
+	return nil
+}
+
+// Create는 구독을 저장합니다
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, sub *entity.WebhookSubscription) error {
+	model, err := toWebhookSubscriptionModel(sub)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.collection.InsertOne(ctx, model)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	if oid, ok := result.InsertedID.(primitive.ObjectID); ok {
+		sub.ID = oid.Hex()
+	}
+	return nil
+}
+
+// FindByID는 ID로 구독을 조회합니다
+func (r *WebhookSubscriptionRepository) FindByID(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, entity.ErrDocumentNotFound
+	}
+
+	var model webhookSubscriptionModel
+	if err := r.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook subscription: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// List는 모든 구독을 생성 순으로 조회합니다
+func (r *WebhookSubscriptionRepository) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscriptions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var models []webhookSubscriptionModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook subscriptions: %w", err)
+	}
+
+	subs := make([]*entity.WebhookSubscription, 0, len(models))
+	for i := range models {
+		subs = append(subs, models[i].toEntity())
+	}
+	return subs, nil
+}
+
+// Update는 구독을 갱신합니다 (생성 시각은 유지)
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, sub *entity.WebhookSubscription) error {
+	oid, err := primitive.ObjectIDFromHex(sub.ID)
+	if err != nil {
+		return entity.ErrDocumentNotFound
+	}
+	model, err := toWebhookSubscriptionModel(sub)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"url":         model.URL,
+		"collection":  model.Collection,
+		"event_types": model.EventTypes,
+		"filter":      model.Filter,
+		"secret":      model.Secret,
+		"description": model.Description,
+		"active":      model.Active,
+		"updated_at":  model.UpdatedAt,
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return entity.ErrDocumentNotFound
+	}
+	return nil
+}
+
+// Delete는 구독과 전송 로그를 삭제합니다
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return entity.ErrDocumentNotFound
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return entity.ErrDocumentNotFound
+	}
+	if _, err := r.deliveries.DeleteMany(ctx, bson.M{"subscription_id": id}); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// Enqueue는 전송 대기 기록을 저장합니다
+func (r *WebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(deliveries))
+	for i, d := range deliveries {
+		docs[i] = toWebhookDeliveryModel(d)
+	}
+	result, err := r.collection.InsertMany(ctx, docs)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	for i, id := range result.InsertedIDs {
+		if oid, ok := id.(primitive.ObjectID); ok {
+			deliveries[i].ID = oid.Hex()
+		}
+	}
+	return nil
+}
+
+// ClaimDue는 전송할 때가 된 대기 기록을 하나 가져오고 다음 시도 시각을 lease만큼 미룹니다
+// 디스패처가 전송 도중 종료되면 lease가 지난 뒤 다른 디스패처가 다시 가져갑니다
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*entity.WebhookDelivery, error) {
+	filter := bson.M{
+		"status":          entity.WebhookDeliveryPending,
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.Before)
+
+	var model webhookDeliveryModel
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to claim webhook delivery: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// RecordAttempt는 전송 시도 결과를 저장합니다
+func (r *WebhookDeliveryRepository) RecordAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	oid, err := primitive.ObjectIDFromHex(delivery.ID)
+	if err != nil {
+		return entity.ErrDocumentNotFound
+	}
+
+	set := bson.M{
+		"status":           delivery.Status,
+		"attempts":         delivery.Attempts,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"last_status_code": delivery.LastStatusCode,
+		"last_error":       delivery.LastError,
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		set["delivered_at"] = delivery.DeliveredAt
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": set}); err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	return nil
+}
+
+// FindByID는 ID로 전송 기록을 조회합니다
+func (r *WebhookDeliveryRepository) FindByID(ctx context.Context, id string) (*entity.WebhookDelivery, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, entity.ErrDocumentNotFound
+	}
+
+	var model webhookDeliveryModel
+	if err := r.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&model); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook delivery: %w", err)
+	}
+	return model.toEntity(), nil
+}
+
+// List는 조건에 맞는 전송 기록을 최신 순으로 조회합니다
+func (r *WebhookDeliveryRepository) List(ctx context.Context, query *repository.WebhookDeliveryQuery) ([]*entity.WebhookDelivery, int64, error) {
+	filter := bson.M{}
+	if query.SubscriptionID != "" {
+		filter["subscription_id"] = query.SubscriptionID
+	}
+	if query.Status != "" {
+		filter["status"] = query.Status
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	findOpts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	if query.Limit > 0 {
+		findOpts.SetLimit(query.Limit)
+	}
+	if query.Skip > 0 {
+		findOpts.SetSkip(query.Skip)
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var models []webhookDeliveryModel
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, 0, len(models))
+	for i := range models {
+		deliveries = append(deliveries, models[i].toEntity())
+	}
+	return deliveries, total, nil
+}
+
+// Requeue는 전송 기록을 즉시 다시 보내도록 대기 상태로 되돌립니다 (시도 횟수 초기화)
+func (r *WebhookDeliveryRepository) Requeue(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return entity.ErrDocumentNotFound
+	}
+
+	update := bson.M{"$set": bson.M{
+		"status":          entity.WebhookDeliveryPending,
+		"attempts":        0,
+		"next_attempt_at": time.Now(),
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": oid}, update)
+	if err != nil {
+		return fmt.Errorf("failed to requeue webhook delivery: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return entity.ErrDocumentNotFound
+	}
+	return nil
+}
+
+func toWebhookSubscriptionModel(sub *entity.WebhookSubscription) (*webhookSubscriptionModel, error) {
+	model := &webhookSubscriptionModel{
+		URL:         sub.URL,
+		Collection:  sub.Collection,
+		EventTypes:  sub.EventTypes,
+		Secret:      sub.Secret,
+		Description: sub.Description,
+		Active:      sub.Active,
+		CreatedAt:   sub.CreatedAt,
+		UpdatedAt:   sub.UpdatedAt,
+	}
+	if sub.Filter != nil {
+		filter, err := json.Marshal(sub.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode webhook filter: %w", err)
+		}
+		model.Filter = string(filter)
+	}
+	return model, nil
+}
+
+func (m *webhookSubscriptionModel) toEntity() *entity.WebhookSubscription {
+	sub := &entity.WebhookSubscription{
+		ID:          m.ID.Hex(),
+		URL:         m.URL,
+		Collection:  m.Collection,
+		EventTypes:  m.EventTypes,
+		Secret:      m.Secret,
+		Description: m.Description,
+		Active:      m.Active,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.Filter != "" {
+		_ = json.Unmarshal([]byte(m.Filter), &sub.Filter)
+	}
+	return sub
+}
+
+func toWebhookDeliveryModel(d *entity.WebhookDelivery) *webhookDeliveryModel {
+	return &webhookDeliveryModel{
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Collection:     d.Collection,
+		DocumentID:     d.DocumentID,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func (m *webhookDeliveryModel) toEntity() *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             m.ID.Hex(),
+		SubscriptionID: m.SubscriptionID,
+		EventID:        m.EventID,
+		EventType:      m.EventType,
+		Collection:     m.Collection,
+		DocumentID:     m.DocumentID,
+		Payload:        m.Payload,
+		Status:         m.Status,
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		LastStatusCode: m.LastStatusCode,
+		LastError:      m.LastError,
+		CreatedAt:      m.CreatedAt,
+		DeliveredAt:    m.DeliveredAt,
+	}
+}
@@ -0,0 +1,106 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// DuplicateMode는 SaveMany에서 이미 있는 ID를 만났을 때의 동작입니다
+type DuplicateMode string
+
+const (
+	// DuplicateError는 중복 ID가 있으면 전체 저장을 실패시킵니다 (기본값)
+	DuplicateError DuplicateMode = "error"
+
+	// DuplicateIgnore는 이미 있는 문서를 그대로 두고 나머지만 저장합니다
+	DuplicateIgnore DuplicateMode = "ignore"
+
+	// DuplicateUpdate는 이미 있는 문서의 데이터와 메타데이터를 덮어쓰고 버전을 올립니다
+	DuplicateUpdate DuplicateMode = "update"
+)
+
+const (
+	// defaultInsertBatchSize는 다중 행 INSERT 한 번에 담는 기본 문서 수입니다
+	defaultInsertBatchSize = 500
+
+	// insertColumns는 INSERT 한 행의 컬럼 수입니다
+	insertColumns = 6
+
+	// maxInsertBatchSize는 한 문장의 바인드 파라미터 한도(65535)를 넘지 않는 최대 문서 수입니다
+	maxInsertBatchSize = 65535 / insertColumns
+)
+
+// SetInsertBatchSize는 SaveMany가 다중 행 INSERT 한 번에 담는 문서 수를 지정합니다
+// 0이면 기본값(500)을 사용하며, 바인드 파라미터 한도를 넘는 값은 최대치로 줄입니다
+// 문서가 크면 max_allowed_packet을 넘지 않도록 줄여서 설정합니다
+func (r *MySQLRepository) SetInsertBatchSize(size int) {
+	r.insertBatchSize = size
+}
+
+// SetDuplicateMode는 SaveMany에서 중복 ID를 처리하는 방식을 지정합니다
+func (r *MySQLRepository) SetDuplicateMode(mode DuplicateMode) {
+	r.duplicateMode = mode
+}
+
+// batchSize는 실제 사용할 배치 크기를 반환합니다
+func (r *MySQLRepository) batchSize() int {
+	switch {
+	case r.insertBatchSize <= 0:
+		return defaultInsertBatchSize
+	case r.insertBatchSize > maxInsertBatchSize:
+		return maxInsertBatchSize
+	default:
+		return r.insertBatchSize
+	}
+}
+
+// insertBatch는 문서들을 한 번의 다중 행 INSERT로 저장합니다
+func (r *MySQLRepository) insertBatch(ctx context.Context, tx *sql.Tx, collection string, docs []*entity.Document) error {
+	args := make([]interface{}, 0, len(docs)*insertColumns)
+	for _, doc := range docs {
+		dataJSON, err := r.marshalData(collection, doc.ID(), doc.Data())
+		if err != nil {
+			return err
+		}
+
+		metadataJSON, err := marshalMetadata(doc)
+		if err != nil {
+			return err
+		}
+
+		args = append(args, doc.ID(), dataJSON, doc.CreatedAt(), doc.UpdatedAt(), doc.Version(), metadataJSON)
+	}
+
+	if _, err := tx.ExecContext(ctx, r.batchInsertQuery(collection, len(docs)), args...); err != nil {
+		return fmt.Errorf("failed to insert documents: %w", err)
+	}
+	return nil
+}
+
+// batchInsertQuery는 rows개 행의 다중 행 INSERT 문을 생성합니다
+// 같은 크기의 배치는 같은 문장이 되므로 연결의 prepared statement 캐시를 재사용합니다
+func (r *MySQLRepository) batchInsertQuery(collection string, rows int) string {
+	var b strings.Builder
+	b.WriteString("INSERT INTO ")
+	b.WriteString(quoteIdentifier(collection))
+	b.WriteString(" (id, data, created_at, updated_at, version, metadata) VALUES ")
+	for i := 0; i < rows; i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(?, ?, ?, ?, ?, ?)")
+	}
+
+	switch r.duplicateMode {
+	case DuplicateIgnore:
+		// INSERT IGNORE는 중복 외의 오류(잘린 값 등)도 경고로 바꾸므로 id 자기 대입으로 중복만 무시합니다
+		b.WriteString(" ON DUPLICATE KEY UPDATE id = id")
+	case DuplicateUpdate:
+		b.WriteString(" ON DUPLICATE KEY UPDATE data = VALUES(data), updated_at = VALUES(updated_at), version = version + 1, metadata = VALUES(metadata)")
+	}
+	return b.String()
+}
@@ -0,0 +1,272 @@
+package mysql
+
+import (
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	gomysql "github.com/go-sql-driver/mysql"
+)
+
+// ChangeLogTable은 트리거가 행 변경을 기록하는 변경 로그 테이블입니다
+// binlog 복제 권한(REPLICATION SLAVE/CLIENT) 없이 동작하도록 트리거 기반으로 변경을 수집합니다
+const ChangeLogTable = "_cdc_change_log"
+
+const (
+	changeLogBatchSize        = 500
+	defaultChangePollInterval = time.Second
+	defaultChangeGapTimeout   = 2 * time.Second
+	mysqlErrTriggerExists     = 1359
+	mysqlMaxIdentifierLength  = 64
+)
+
+// changeLogRow는 변경 로그 행입니다
+type changeLogRow struct {
+	seq        uint64
+	operation  string
+	collection string
+	documentID string
+	version    int
+	changedAt  time.Time
+}
+
+// SetChangeCapture는 컬렉션 테이블을 만들 때 변경 로그 트리거도 함께 생성하도록 설정합니다
+// 활성화하면 서비스가 만든 모든 컬렉션의 변경이 _cdc_change_log에 기록되어 WatchChanges와 CDC 브리지에서 읽을 수 있습니다
+func (r *MySQLRepository) SetChangeCapture(enabled bool) {
+	r.changeCapture = enabled
+}
+
+// WatchChanges는 변경 로그를 폴링해 구독 시점 이후 컬렉션의 변경을 ChangeFeed로 전달합니다 (repository.ChangeWatcher 구현)
+// 테이블에 변경 로그 트리거가 없으면 생성합니다. 변경 필드(Changes)는 제공하지 않습니다
+func (r *MySQLRepository) WatchChanges(ctx context.Context, collection string) (*repository.ChangeFeed, error) {
+	if err := r.ensureTableExists(ctx, collection); err != nil {
+		return nil, fmt.Errorf("failed to ensure table exists: %w", err)
+	}
+	if err := r.ensureChangeTriggers(ctx, collection); err != nil {
+		return nil, err
+	}
+
+	latest, err := r.latestChangeSeq(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &changeCursor{last: latest, gapTimeout: defaultChangeGapTimeout}
+
+	return repository.NewChangeFeed(ctx, 64, func(ctx context.Context, emit func(repository.ChangeEvent) bool) error {
+		ticker := time.NewTicker(defaultChangePollInterval)
+		defer ticker.Stop()
+
+		for {
+			rows, err := r.readChangeLog(ctx, cursor.last)
+			if err != nil {
+				return err
+			}
+			ready := cursor.ready(rows)
+			for _, row := range ready {
+				if row.collection != collection {
+					cursor.advance(row.seq)
+					continue
+				}
+				event, err := r.changeEvent(ctx, row)
+				if err != nil {
+					return err
+				}
+				if event != nil && !emit(*event) {
+					return nil
+				}
+				cursor.advance(row.seq)
+			}
+
+			// 배치를 모두 처리했고 가득 찼으면 바로 다음 배치를 읽습니다
+			if len(rows) == changeLogBatchSize && len(ready) == len(rows) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}), nil
+}
+
+// ensureChangeLog는 변경 로그 테이블을 생성합니다
+func (r *MySQLRepository) ensureChangeLog(ctx context.Context) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			seq BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			operation VARCHAR(16) NOT NULL,
+			collection VARCHAR(255) NOT NULL,
+			document_id VARCHAR(255) NOT NULL,
+			version INTEGER NOT NULL DEFAULT 0,
+			changed_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+			KEY idx_changed_at (changed_at)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+	`, quoteIdentifier(ChangeLogTable))
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create change log table: %w", err)
+	}
+	return nil
+}
+
+// ensureChangeTriggers는 컬렉션 테이블에 insert/update/delete 변경 로그 트리거를 생성합니다 (이미 있으면 건너뜀)
+func (r *MySQLRepository) ensureChangeTriggers(ctx context.Context, collection string) error {
+	if _, ok := r.captured.Load(collection); ok {
+		return nil
+	}
+	if err := r.ensureChangeLog(ctx); err != nil {
+		return err
+	}
+
+	for _, op := range []struct {
+		event     string
+		operation string
+		row       string
+	}{
+		{"INSERT", repository.ChangeOperationInsert, "NEW"},
+		{"UPDATE", repository.ChangeOperationUpdate, "NEW"},
+		{"DELETE", repository.ChangeOperationDelete, "OLD"},
+	} {
+		query := fmt.Sprintf(`
+			CREATE TRIGGER %s AFTER %s ON %s FOR EACH ROW
+			INSERT INTO %s (operation, collection, document_id, version)
+			VALUES ('%s', %s, %s.id, %s.version)
+		`,
+			quoteIdentifier(changeTriggerName(op.operation, collection)), op.event, quoteIdentifier(collection),
+			quoteIdentifier(ChangeLogTable), op.operation, quoteString(collection), op.row, op.row,
+		)
+		if _, err := r.db.ExecContext(ctx, query); err != nil {
+			var mysqlErr *gomysql.MySQLError
+			if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrTriggerExists {
+				continue
+			}
+			return fmt.Errorf("failed to create change log trigger: %w", err)
+		}
+	}
+
+	r.captured.Store(collection, struct{}{})
+	return nil
+}
+
+// latestChangeSeq는 변경 로그의 마지막 순번을 반환합니다 (비어 있으면 0)
+func (r *MySQLRepository) latestChangeSeq(ctx context.Context) (uint64, error) {
+	var seq uint64
+	query := fmt.Sprintf(`SELECT COALESCE(MAX(seq), 0) FROM %s`, quoteIdentifier(ChangeLogTable))
+	if err := r.db.QueryRowContext(ctx, query).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to read change log position: %w", err)
+	}
+	return seq, nil
+}
+
+// readChangeLog는 after 이후의 변경 로그를 순번 순으로 읽습니다
+// 빈 순번을 판단할 수 있도록 컬렉션으로 거르지 않고 모두 읽으며, 호출자가 대상 컬렉션만 처리합니다
+func (r *MySQLRepository) readChangeLog(ctx context.Context, after uint64) ([]changeLogRow, error) {
+	query := fmt.Sprintf(`SELECT seq, operation, collection, document_id, version, changed_at FROM %s WHERE seq > ? ORDER BY seq LIMIT %d`,
+		quoteIdentifier(ChangeLogTable), changeLogBatchSize)
+
+	rows, err := r.db.QueryContext(ctx, query, after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read change log: %w", err)
+	}
+	defer rows.Close()
+
+	var result []changeLogRow
+	for rows.Next() {
+		var row changeLogRow
+		if err := rows.Scan(&row.seq, &row.operation, &row.collection, &row.documentID, &row.version, &row.changedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan change log: %w", err)
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// changeEvent는 변경 로그 행을 변경 이벤트로 변환합니다 (insert/update는 현재 행을 조회, 이미 삭제되었으면 nil)
+func (r *MySQLRepository) changeEvent(ctx context.Context, row changeLogRow) (*repository.ChangeEvent, error) {
+	event := &repository.ChangeEvent{
+		Operation:  row.operation,
+		Collection: row.collection,
+		DocumentID: row.documentID,
+		Version:    row.version,
+		Timestamp:  row.changedAt,
+	}
+	if row.operation == repository.ChangeOperationDelete {
+		return event, nil
+	}
+
+	query := fmt.Sprintf(`SELECT data, version FROM %s WHERE id = ?`, quoteIdentifier(row.collection))
+	var dataJSON []byte
+	err := r.db.QueryRowContext(ctx, query, row.documentID).Scan(&dataJSON, &event.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		// 조회 전에 삭제되었습니다 (뒤따르는 삭제 로그로 전달됨)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load changed document: %w", err)
+	}
+	if err := r.unmarshalData(ctx, row.collection, row.documentID, dataJSON, &event.Data); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// changeCursor는 변경 로그 읽기 위치입니다
+// AUTO_INCREMENT 순번은 커밋 순서와 다를 수 있어, 순번 사이에 빈 곳이 있으면 아직 커밋되지 않은 트랜잭션일 수 있으므로
+// gapTimeout 동안 기다렸다가 그래도 채워지지 않으면(롤백된 트랜잭션) 건너뜁니다
+// auto_increment_increment가 1보다 크면 매 행마다 gapTimeout만큼 지연됩니다
+type changeCursor struct {
+	last       uint64
+	gapTimeout time.Duration
+	gapSince   time.Time
+}
+
+// ready는 지금 처리해도 되는 행을 반환합니다 (빈 순번 앞까지)
+func (c *changeCursor) ready(rows []changeLogRow) []changeLogRow {
+	expected := c.last + 1
+	for i, row := range rows {
+		if row.seq == expected {
+			expected++
+			continue
+		}
+		if i == 0 {
+			if c.gapSince.IsZero() {
+				c.gapSince = time.Now()
+			}
+			if time.Since(c.gapSince) < c.gapTimeout {
+				return nil
+			}
+			// 오래된 빈 순번은 롤백된 트랜잭션으로 보고 건너뜁니다
+			expected = row.seq + 1
+			continue
+		}
+		return rows[:i]
+	}
+	return rows
+}
+
+// advance는 처리한 순번으로 위치를 옮깁니다
+func (c *changeCursor) advance(seq uint64) {
+	c.last = seq
+	c.gapSince = time.Time{}
+}
+
+// changeTriggerName은 컬렉션별 트리거 이름을 생성합니다 (64자를 넘으면 컬렉션 이름을 해시로 대체)
+func changeTriggerName(operation, collection string) string {
+	name := "_cdc_" + operation + "_" + collection
+	if len(name) <= mysqlMaxIdentifierLength {
+		return name
+	}
+	sum := sha1.Sum([]byte(collection))
+	return "_cdc_" + operation + "_" + hex.EncodeToString(sum[:])[:16]
+}
+
+// quoteString은 SQL 문자열 리터럴을 만듭니다
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(s) + "'"
+}
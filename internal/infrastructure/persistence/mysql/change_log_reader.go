@@ -0,0 +1,276 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ChangePositionTable은 변경 로그 읽기 위치를 저장하는 테이블입니다
+const ChangePositionTable = "_cdc_change_positions"
+
+const (
+	defaultChangeCheckpointInterval = 5 * time.Second
+	defaultChangeLogRetention       = 24 * time.Hour
+	changeTriggerRescanInterval     = time.Minute
+	changeLogPurgeInterval          = time.Hour
+)
+
+// ChangeLogConfig는 변경 로그 읽기 설정입니다
+type ChangeLogConfig struct {
+	// Name은 읽기 위치를 구분하는 이름입니다 (리더 인스턴스마다 고유)
+	Name string
+
+	// Collections는 읽을 컬렉션입니다 (비어 있으면 데이터베이스의 모든 테이블)
+	Collections []string
+
+	// ExcludeCollections는 제외할 컬렉션입니다 (_로 시작하는 내부 테이블은 항상 제외)
+	ExcludeCollections []string
+
+	// PollInterval은 새 변경이 없을 때 다시 읽기까지의 대기 시간입니다 (기본 1초)
+	PollInterval time.Duration
+
+	// CheckpointInterval은 읽기 위치 저장 주기입니다 (기본 5초)
+	CheckpointInterval time.Duration
+
+	// Retention은 변경 로그 보관 기간입니다 (기본 24시간, 리더가 주기적으로 정리)
+	Retention time.Duration
+}
+
+// ChangeLogReader는 트리거가 기록한 변경 로그를 읽어 처리 함수에 전달합니다
+// 처리한 위치를 _cdc_change_positions 테이블에 저장해 재시작 시 이어서 읽으며(at-least-once),
+// 대상 테이블에 변경 로그 트리거가 없으면 생성합니다
+type ChangeLogReader struct {
+	repo   *MySQLRepository
+	config ChangeLogConfig
+}
+
+// NewChangeLogReader는 새로운 변경 로그 리더를 생성합니다 (cipher는 data 컬럼이 암호화된 경우에만 지정)
+func NewChangeLogReader(db *sql.DB, cipher *encryption.Cipher, cfg ChangeLogConfig) (*ChangeLogReader, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("change log reader name is required")
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultChangePollInterval
+	}
+	if cfg.CheckpointInterval <= 0 {
+		cfg.CheckpointInterval = defaultChangeCheckpointInterval
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = defaultChangeLogRetention
+	}
+	return &ChangeLogReader{
+		repo:   &MySQLRepository{db: db, cipher: cipher},
+		config: cfg,
+	}, nil
+}
+
+// Run은 저장된 위치(없으면 현재 시점)부터 변경을 읽어 handle에 전달합니다
+// 컨텍스트가 취소되거나 handle이 실패하면 마지막으로 처리한 위치를 저장하고 반환합니다 (취소 시 nil)
+func (r *ChangeLogReader) Run(ctx context.Context, handle func(ctx context.Context, event *repository.ChangeEvent) error) error {
+	if err := r.ensurePositionTable(ctx); err != nil {
+		return err
+	}
+	if err := r.ensureTriggers(ctx); err != nil {
+		return err
+	}
+
+	position, found, err := r.loadPosition(ctx)
+	if err != nil {
+		return err
+	}
+	if !found {
+		if position, err = r.repo.latestChangeSeq(ctx); err != nil {
+			return err
+		}
+	}
+	cursor := &changeCursor{last: position, gapTimeout: defaultChangeGapTimeout}
+
+	logger.Info(ctx, "change log reader started",
+		zap.String("name", r.config.Name),
+		zap.Uint64("position", position),
+		zap.Bool("resumed", found),
+	)
+
+	saved := position
+	lastCheckpoint, lastRescan, lastPurge := time.Now(), time.Now(), time.Time{}
+	checkpoint := func() error {
+		if cursor.last == saved {
+			return nil
+		}
+		saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		if err := r.savePosition(saveCtx, cursor.last); err != nil {
+			return err
+		}
+		saved = cursor.last
+		lastCheckpoint = time.Now()
+		return nil
+	}
+
+	for {
+		rows, err := r.repo.readChangeLog(ctx, cursor.last)
+		if err != nil {
+			if ctx.Err() != nil {
+				return checkpoint()
+			}
+			if saveErr := checkpoint(); saveErr != nil {
+				logger.Error(ctx, "failed to save change log position", zap.Error(saveErr))
+			}
+			return err
+		}
+
+		ready := cursor.ready(rows)
+		for _, row := range ready {
+			if !r.matches(row.collection) {
+				cursor.advance(row.seq)
+				continue
+			}
+			event, err := r.repo.changeEvent(ctx, row)
+			if err == nil && event != nil {
+				err = handle(ctx, event)
+			}
+			if err != nil {
+				if saveErr := checkpoint(); saveErr != nil {
+					logger.Error(ctx, "failed to save change log position", zap.Error(saveErr))
+				}
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("failed to handle change %d on %s/%s: %w", row.seq, row.collection, row.documentID, err)
+			}
+			cursor.advance(row.seq)
+		}
+
+		if time.Since(lastCheckpoint) >= r.config.CheckpointInterval {
+			if err := checkpoint(); err != nil {
+				return err
+			}
+		}
+		// 서비스 밖에서 새로 만든 테이블에도 트리거를 생성합니다
+		if len(r.config.Collections) == 0 && time.Since(lastRescan) >= changeTriggerRescanInterval {
+			if err := r.ensureTriggers(ctx); err != nil {
+				logger.Warn(ctx, "failed to create change log triggers for new tables", zap.Error(err))
+			}
+			lastRescan = time.Now()
+		}
+		if time.Since(lastPurge) >= changeLogPurgeInterval {
+			r.purge(ctx)
+			lastPurge = time.Now()
+		}
+
+		if len(rows) == changeLogBatchSize && len(ready) == len(rows) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return checkpoint()
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+}
+
+// matches는 컬렉션이 읽기 대상인지 확인합니다
+func (r *ChangeLogReader) matches(collection string) bool {
+	if strings.HasPrefix(collection, "_") {
+		return false
+	}
+	for _, excluded := range r.config.ExcludeCollections {
+		if excluded == collection {
+			return false
+		}
+	}
+	if len(r.config.Collections) == 0 {
+		return true
+	}
+	for _, c := range r.config.Collections {
+		if c == collection {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureTriggers는 읽기 대상 테이블마다 변경 로그 트리거를 생성합니다
+func (r *ChangeLogReader) ensureTriggers(ctx context.Context) error {
+	collections := r.config.Collections
+	if len(collections) == 0 {
+		all, err := r.repo.ListCollections(ctx)
+		if err != nil {
+			return err
+		}
+		collections = all
+	}
+	if err := r.repo.ensureChangeLog(ctx); err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if !r.matches(collection) {
+			continue
+		}
+		if err := r.repo.ensureChangeTriggers(ctx, collection); err != nil {
+			return fmt.Errorf("collection %s: %w", collection, err)
+		}
+	}
+	return nil
+}
+
+// ensurePositionTable은 읽기 위치 테이블을 생성합니다
+func (r *ChangeLogReader) ensurePositionTable(ctx context.Context) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			name VARCHAR(255) NOT NULL PRIMARY KEY,
+			seq BIGINT UNSIGNED NOT NULL,
+			updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+		) ENGINE=InnoDB
+	`, quoteIdentifier(ChangePositionTable))
+	if _, err := r.repo.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create change position table: %w", err)
+	}
+	return nil
+}
+
+// loadPosition은 저장된 읽기 위치를 조회합니다
+func (r *ChangeLogReader) loadPosition(ctx context.Context) (uint64, bool, error) {
+	var seq uint64
+	query := fmt.Sprintf(`SELECT seq FROM %s WHERE name = ?`, quoteIdentifier(ChangePositionTable))
+	err := r.repo.db.QueryRowContext(ctx, query, r.config.Name).Scan(&seq)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to load change log position: %w", err)
+	}
+	return seq, true, nil
+}
+
+// savePosition은 읽기 위치를 저장합니다
+func (r *ChangeLogReader) savePosition(ctx context.Context, seq uint64) error {
+	query := fmt.Sprintf(`INSERT INTO %s (name, seq) VALUES (?, ?) ON DUPLICATE KEY UPDATE seq = VALUES(seq)`,
+		quoteIdentifier(ChangePositionTable))
+	if _, err := r.repo.db.ExecContext(ctx, query, r.config.Name, seq); err != nil {
+		return fmt.Errorf("failed to save change log position: %w", err)
+	}
+	return nil
+}
+
+// purge는 보관 기간이 지난 변경 로그를 삭제합니다 (실패해도 읽기는 계속)
+func (r *ChangeLogReader) purge(ctx context.Context) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE changed_at < NOW(6) - INTERVAL ? SECOND`, quoteIdentifier(ChangeLogTable))
+	result, err := r.repo.db.ExecContext(ctx, query, int64(r.config.Retention.Seconds()))
+	if err != nil {
+		logger.Warn(ctx, "failed to purge change log", zap.Error(err))
+		return
+	}
+	if purged, _ := result.RowsAffected(); purged > 0 {
+		logger.Info(ctx, "change log purged", zap.Int64("rows", purged))
+	}
+}
@@ -3,10 +3,13 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/stmtcache"
+	gomysql "github.com/go-sql-driver/mysql"
 )
 
 // Config는 MySQL 연결 설정입니다
@@ -28,32 +31,24 @@ type Config struct {
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+
+	// StatementCacheSize는 연결당 prepared statement 캐시 크기입니다 (0이면 기본값, 음수면 캐시하지 않음)
+	// 서버 전체 statement 수는 max_prepared_stmt_count를 넘을 수 없으므로 MaxOpenConns와 함께 조정합니다
+	StatementCacheSize int
+
+	// Credentials가 설정되면 새 연결마다 호출해 User/Password 대신 사용합니다 (Vault 동적 자격증명)
+	Credentials func() (username, password string)
 }
 
 // NewClient는 MySQL 클라이언트를 생성합니다
 func NewClient(ctx context.Context, config *Config) (*sql.DB, error) {
-	// Connection string 생성 (DSN format)
-	dsn := fmt.Sprintf(
-		"%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=%t&loc=%s",
-		config.User,
-		config.Password,
-		config.Host,
-		config.Port,
-		config.Database,
-		getCharset(config.Charset),
-		getParseTime(config.ParseTime),
-		getLoc(config.Loc),
-	)
-
-	if config.AllowNativePasswords {
-		dsn += "&allowNativePasswords=true"
-	}
-
-	db, err := sql.Open("mysql", dsn)
-	if err != nil {
+	// DSN 검증 (파라미터는 연결할 때마다 다시 파싱합니다)
+	if _, err := gomysql.ParseDSN(config.dsn()); err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	db := sql.OpenDB(&connector{config: config})
+
 	// Connection Pool 설정
 	if config.MaxOpenConns > 0 {
 		db.SetMaxOpenConns(config.MaxOpenConns)
@@ -91,6 +86,76 @@ func NewClient(ctx context.Context, config *Config) (*sql.DB, error) {
 	return db, nil
 }
 
+// connector는 연결할 때마다 최신 자격증명으로 DSN을 만드는 driver.Connector입니다
+// 자격증명이 교체되어도 sql.DB를 다시 만들지 않고 새 연결부터 새 자격증명을 사용합니다
+type connector struct {
+	config *Config
+}
+
+// Connect는 새 연결을 생성합니다
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	dsnConfig, err := gomysql.ParseDSN(c.config.dsn())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dsn: %w", err)
+	}
+	mysqlConnector, err := gomysql.NewConnector(dsnConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	conn, err := mysqlConnector.Connect(ctx)
+	if err != nil || c.config.StatementCacheSize < 0 {
+		return conn, err
+	}
+	return stmtcache.Wrap(conn, stmtcache.Options{
+		Size:       c.config.StatementCacheSize,
+		Invalidate: isStaleStatement,
+	}), nil
+}
+
+// isStaleStatement는 스키마 변경으로 캐시된 statement를 다시 준비해야 하는 에러인지 확인합니다
+func isStaleStatement(err error) bool {
+	var mysqlErr *gomysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return false
+	}
+	switch mysqlErr.Number {
+	case 1615, // ER_NEED_REPREPARE
+		1243: // ER_UNKNOWN_STMT_HANDLER
+		return true
+	}
+	return false
+}
+
+// Driver는 MySQL 드라이버를 반환합니다
+func (c *connector) Driver() driver.Driver {
+	return &gomysql.MySQLDriver{}
+}
+
+// dsn은 현재 자격증명으로 DSN을 생성합니다
+func (config *Config) dsn() string {
+	user, password := config.User, config.Password
+	if config.Credentials != nil {
+		user, password = config.Credentials()
+	}
+
+	dsn := fmt.Sprintf(
+		"%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=%t&loc=%s",
+		user,
+		password,
+		config.Host,
+		config.Port,
+		config.Database,
+		getCharset(config.Charset),
+		getParseTime(config.ParseTime),
+		getLoc(config.Loc),
+	)
+
+	if config.AllowNativePasswords {
+		dsn += "&allowNativePasswords=true"
+	}
+	return dsn
+}
+
 // Close는 데이터베이스 연결을 닫습니다
 func Close(db *sql.DB) error {
 	if db != nil {
@@ -0,0 +1,88 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// CollectionStats는 information_schema.TABLES와 InnoDB 통계로 문서 테이블의 통계를 반환합니다
+// 문서 수와 크기는 InnoDB 통계 기반 추정값이며(ANALYZE TABLE로 갱신), StorageSize는 재사용 가능한 빈 공간(DATA_FREE)을 포함합니다
+func (r *MySQLRepository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	stats := &repository.CollectionStats{Collection: collection}
+	var rows, dataLength, avgRowLength, indexLength, dataFree sql.NullInt64
+	var engine sql.NullString
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT TABLE_ROWS, DATA_LENGTH, AVG_ROW_LENGTH, INDEX_LENGTH, DATA_FREE, ENGINE
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?`, collection,
+	).Scan(&rows, &dataLength, &avgRowLength, &indexLength, &dataFree, &engine)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: table %s does not exist", entity.ErrDocumentNotFound, collection)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table stats: %w", err)
+	}
+
+	stats.Count = rows.Int64
+	stats.Size = dataLength.Int64
+	stats.AvgDocSize = float64(avgRowLength.Int64)
+	stats.StorageSize = dataLength.Int64 + dataFree.Int64
+	stats.TotalIndexSize = indexLength.Int64
+	stats.StorageEngine = engine.String
+
+	indexSizes, err := r.indexSizes(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	stats.IndexSizes = indexSizes
+	stats.IndexCount = len(indexSizes)
+	return stats, nil
+}
+
+// indexSizes는 mysql.innodb_index_stats의 페이지 수로 인덱스별 크기를 계산합니다
+// 통계 테이블을 읽을 권한이 없으면 인덱스 이름만 반환합니다 (크기 0)
+func (r *MySQLRepository) indexSizes(ctx context.Context, collection string) (map[string]int64, error) {
+	sizes := make(map[string]int64)
+
+	names, err := r.conn(ctx).QueryContext(ctx, `
+		SELECT DISTINCT INDEX_NAME
+		FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?`, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	defer names.Close()
+	for names.Next() {
+		var name string
+		if err := names.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+		sizes[name] = 0
+	}
+	if err := names.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+
+	pages, err := r.conn(ctx).QueryContext(ctx, `
+		SELECT index_name, stat_value * @@innodb_page_size
+		FROM mysql.innodb_index_stats
+		WHERE database_name = DATABASE() AND table_name = ? AND stat_name = 'size'`, collection)
+	if err != nil {
+		return sizes, nil
+	}
+	defer pages.Close()
+	for pages.Next() {
+		var name string
+		var size int64
+		if err := pages.Scan(&name, &size); err != nil {
+			return nil, fmt.Errorf("failed to scan index size: %w", err)
+		}
+		sizes[name] = size
+	}
+	return sizes, pages.Err()
+}
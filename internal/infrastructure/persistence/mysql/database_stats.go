@@ -0,0 +1,46 @@
+package mysql
+
+import (
+	"context"
+	"sort"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// DatabaseStats는 문서 테이블별 통계의 합계와 서버 상태 변수의 연결 수로 데이터베이스 통계를 반환합니다
+func (r *MySQLRepository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	collections, err := r.ListCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(collections)
+
+	perCollection := make([]*repository.CollectionStats, 0, len(collections))
+	for _, collection := range collections {
+		stats, err := r.CollectionStats(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		perCollection = append(perCollection, stats)
+	}
+	result := repository.SummarizeCollections(perCollection)
+
+	// Threads_connected/Threads_running은 서버 전체 기준
+	connections := &repository.ConnectionStats{}
+	var maxConnections int64
+	err = r.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT VARIABLE_VALUE FROM performance_schema.global_status WHERE VARIABLE_NAME = 'Threads_connected'),
+			(SELECT VARIABLE_VALUE FROM performance_schema.global_status WHERE VARIABLE_NAME = 'Threads_running'),
+			@@max_connections`,
+	).Scan(&connections.Current, &connections.Active, &maxConnections)
+	if err != nil {
+		logger.Warn(ctx, "failed to get connection stats", zap.Error(err))
+		return result, nil
+	}
+	connections.Available = maxConnections - connections.Current
+	result.Connections = connections
+	return result, nil
+}
@@ -7,17 +7,33 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 // MySQLRepository는 MySQL 기반 문서 저장소입니다
 type MySQLRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	cipher *encryption.Cipher // nil이면 data 컬럼을 평문으로 저장합니다
+
+	changeCapture bool     // true이면 테이블마다 변경 로그 트리거를 생성합니다
+	captured      sync.Map // 변경 로그 트리거를 확인한 컬렉션
+
+	insertBatchSize int           // SaveMany의 다중 행 INSERT 한 번에 담는 문서 수 (0이면 기본값)
+	duplicateMode   DuplicateMode // SaveMany에서 중복 ID 처리 방식 (비어 있으면 오류)
+
+	generated sync.Map // collection -> *generatedColumnSet (인덱스용 생성 컬럼 캐시)
+
+	migrator *schema.Migrator // 컬렉션 테이블에 적용할 스키마 마이그레이션 (nil이면 적용하지 않음)
 }
 
 // NewMySQLRepository는 MySQL 저장소를 생성합니다
@@ -26,6 +42,7 @@ func NewMySQLRepository(db *sql.DB) repository.DocumentRepository {
 }
 
 // ensureTableExists는 컬렉션(테이블)이 존재하는지 확인하고 없으면 생성합니다
+// 스키마 마이그레이션이 설정되면 남은 버전을 적용합니다
 func (r *MySQLRepository) ensureTableExists(ctx context.Context, collection string) error {
 	query := fmt.Sprintf(`
 		CREATE TABLE IF NOT EXISTS %s (
@@ -38,8 +55,16 @@ func (r *MySQLRepository) ensureTableExists(ctx context.Context, collection stri
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
 	`, quoteIdentifier(collection))
 
-	_, err := r.db.ExecContext(ctx, query)
-	return err
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	if err := r.ensureSchema(ctx, collection); err != nil {
+		return err
+	}
+	if r.changeCapture && !strings.HasPrefix(collection, "_") {
+		return r.ensureChangeTriggers(ctx, collection)
+	}
+	return nil
 }
 
 // quoteIdentifier는 MySQL 식별자를 인용합니다
@@ -51,31 +76,31 @@ func quoteIdentifier(name string) string {
 
 // Save는 문서를 저장합니다
 func (r *MySQLRepository) Save(ctx context.Context, doc *entity.Document) error {
-	if err := r.ensureTableExists(ctx, doc.Collection); err != nil {
+	if err := r.ensureTableExists(ctx, doc.Collection()); err != nil {
 		return fmt.Errorf("failed to ensure table exists: %w", err)
 	}
 
-	dataJSON, err := json.Marshal(doc.Data)
+	dataJSON, err := r.marshalData(doc.Collection(), doc.ID(), doc.Data())
 	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
+		return err
 	}
 
-	metadataJSON, err := json.Marshal(doc.Metadata)
+	metadataJSON, err := marshalMetadata(doc)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	query := fmt.Sprintf(`
 		INSERT INTO %s (id, data, created_at, updated_at, version, metadata)
 		VALUES (?, ?, ?, ?, ?, ?)
-	`, quoteIdentifier(doc.Collection))
+	`, quoteIdentifier(doc.Collection()))
 
-	_, err = r.db.ExecContext(ctx, query,
-		doc.ID,
+	_, err = r.conn(ctx).ExecContext(ctx, query,
+		doc.ID(),
 		dataJSON,
-		doc.CreatedAt,
-		doc.UpdatedAt,
-		doc.Version,
+		doc.CreatedAt(),
+		doc.UpdatedAt(),
+		doc.Version(),
 		metadataJSON,
 	)
 	if err != nil {
@@ -85,48 +110,32 @@ func (r *MySQLRepository) Save(ctx context.Context, doc *entity.Document) error
 	return nil
 }
 
-// SaveMany는 여러 문서를 한 번에 저장합니다
+// SaveMany는 여러 문서를 다중 행 INSERT 배치로 저장합니다
+// 모든 배치는 한 트랜잭션에서 실행되므로 하나라도 실패하면 전체가 롤백됩니다
 func (r *MySQLRepository) SaveMany(ctx context.Context, docs []*entity.Document) error {
 	if len(docs) == 0 {
 		return nil
 	}
 
-	collection := docs[0].Collection
+	collection := docs[0].Collection()
 	if err := r.ensureTableExists(ctx, collection); err != nil {
 		return fmt.Errorf("failed to ensure table exists: %w", err)
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
-	query := fmt.Sprintf(`
-		INSERT INTO %s (id, data, created_at, updated_at, version, metadata)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, quoteIdentifier(collection))
-
-	stmt, err := tx.PrepareContext(ctx, query)
-	if err != nil {
-		return fmt.Errorf("failed to prepare statement: %w", err)
-	}
-	defer stmt.Close()
-
-	for _, doc := range docs {
-		dataJSON, err := json.Marshal(doc.Data)
-		if err != nil {
-			return fmt.Errorf("failed to marshal data: %w", err)
-		}
-
-		metadataJSON, err := json.Marshal(doc.Metadata)
-		if err != nil {
-			return fmt.Errorf("failed to marshal metadata: %w", err)
+	batchSize := r.batchSize()
+	for start := 0; start < len(docs); start += batchSize {
+		end := start + batchSize
+		if end > len(docs) {
+			end = len(docs)
 		}
-
-		_, err = stmt.ExecContext(ctx, doc.ID, dataJSON, doc.CreatedAt, doc.UpdatedAt, doc.Version, metadataJSON)
-		if err != nil {
-			return fmt.Errorf("failed to insert document: %w", err)
+		if err := r.insertBatch(ctx, tx.Tx, collection, docs[start:end]); err != nil {
+			return err
 		}
 	}
 
@@ -145,40 +154,26 @@ func (r *MySQLRepository) FindByID(ctx context.Context, collection, id string) (
 		WHERE id = ?
 	`, quoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	doc, err := r.scanDocument(ctx, r.conn(ctx).QueryRowContext(ctx, query, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	doc.Collection = collection
-
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
-	return &doc, nil
+	return doc, nil
 }
 
 // FindAll은 컬렉션의 모든 문서를 조회합니다
 func (r *MySQLRepository) FindAll(ctx context.Context, collection string, filter map[string]interface{}) ([]*entity.Document, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requireIDFilter(filter, nil); err != nil {
+		return nil, err
+	}
+	whereClause, args, err := r.buildWhereClause(ctx, collection, filter)
+	if err != nil {
+		return nil, err
+	}
 
 	query := fmt.Sprintf(`
 		SELECT id, data, created_at, updated_at, version, metadata
@@ -186,18 +181,48 @@ func (r *MySQLRepository) FindAll(ctx context.Context, collection string, filter
 		%s
 	`, quoteIdentifier(collection), whereClause)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query documents: %w", err)
 	}
 	defer rows.Close()
 
-	return r.scanDocuments(rows, collection)
+	return r.scanDocuments(ctx, rows, collection)
 }
 
 // FindWithOptions는 옵션을 사용하여 문서를 조회합니다
 func (r *MySQLRepository) FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) ([]*entity.Document, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	query, args, err := r.buildFindQuery(ctx, collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanDocuments(ctx, rows, collection)
+}
+
+// buildFindQuery는 FindWithOptions/FindStream의 SELECT 쿼리를 만듭니다 (opts는 nil 가능)
+func (r *MySQLRepository) buildFindQuery(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (string, []interface{}, error) {
+	if opts == nil {
+		opts = &repository.FindOptions{}
+	}
+	if err := r.requireIDFilter(filter, opts.Sort); err != nil {
+		return "", nil, err
+	}
+	whereClause, args, err := r.buildWhereClause(ctx, collection, filter)
+	if err != nil {
+		return "", nil, err
+	}
+	orderBy, orderArgs, err := r.buildOrderBy(opts.Sort)
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, orderArgs...)
 
 	query := fmt.Sprintf(`
 		SELECT id, data, created_at, updated_at, version, metadata
@@ -208,44 +233,40 @@ func (r *MySQLRepository) FindWithOptions(ctx context.Context, collection string
 		%s
 	`, quoteIdentifier(collection),
 		whereClause,
-		r.buildOrderBy(opts.Sort),
+		orderBy,
 		r.buildLimit(opts.Limit),
 		r.buildOffset(opts.Skip),
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query documents: %w", err)
-	}
-	defer rows.Close()
-
-	return r.scanDocuments(rows, collection)
+	return query, args, nil
 }
 
 // Update는 문서를 업데이트합니다
 func (r *MySQLRepository) Update(ctx context.Context, doc *entity.Document) error {
-	dataJSON, err := json.Marshal(doc.Data)
+	dataJSON, err := r.marshalData(doc.Collection(), doc.ID(), doc.Data())
 	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
+		return err
 	}
 
-	metadataJSON, err := json.Marshal(doc.Metadata)
+	metadataJSON, err := marshalMetadata(doc)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
+	// 낙관적 잠금: doc.Update가 올린 버전의 직전 버전인 행만 갱신합니다
 	query := fmt.Sprintf(`
 		UPDATE %s
-		SET data = ?, updated_at = ?, version = version + 1, metadata = ?
+		SET data = ?, updated_at = ?, version = ?, metadata = ?
 		WHERE id = ? AND version = ?
-	`, quoteIdentifier(doc.Collection))
+	`, quoteIdentifier(doc.Collection()))
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.conn(ctx).ExecContext(ctx, query,
 		dataJSON,
-		time.Now(),
+		doc.UpdatedAt(),
+		doc.Version(),
 		metadataJSON,
-		doc.ID,
-		doc.Version,
+		doc.ID(),
+		doc.Version()-1,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update document: %w", err)
@@ -260,33 +281,32 @@ func (r *MySQLRepository) Update(ctx context.Context, doc *entity.Document) erro
 		return errors.New("optimistic lock error: document was modified by another process")
 	}
 
-	doc.Version++
-	doc.UpdatedAt = time.Now()
-
 	return nil
 }
 
 // UpdateMany는 필터와 일치하는 여러 문서를 업데이트합니다
 func (r *MySQLRepository) UpdateMany(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (int64, error) {
-	whereClause, args := r.buildWhereClause(filter)
-
-	setClauses := []string{}
-	for key, value := range update {
-		valueJSON, err := json.Marshal(value)
-		if err != nil {
-			return 0, fmt.Errorf("failed to marshal update value: %w", err)
-		}
-		setClauses = append(setClauses, fmt.Sprintf("data = JSON_SET(data, '$.%s', CAST(? AS JSON))", key))
-		args = append(args, valueJSON)
+	// JSON_SET 부분 업데이트는 암호화된 data 컬럼에 적용할 수 없습니다
+	if err := r.requirePlaintext("update many"); err != nil {
+		return 0, err
+	}
+	dataExpr, args, err := r.buildJSONSet(update)
+	if err != nil {
+		return 0, err
+	}
+	whereClause, whereArgs, err := r.buildWhereClause(ctx, collection, filter)
+	if err != nil {
+		return 0, err
 	}
+	args = append(args, whereArgs...)
 
 	query := fmt.Sprintf(`
 		UPDATE %s
-		SET %s, updated_at = NOW(6), version = version + 1
+		SET data = %s, updated_at = NOW(6), version = version + 1
 		%s
-	`, quoteIdentifier(collection), strings.Join(setClauses, ", "), whereClause)
+	`, quoteIdentifier(collection), dataExpr, whereClause)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("failed to update documents: %w", err)
 	}
@@ -301,14 +321,14 @@ func (r *MySQLRepository) UpdateMany(ctx context.Context, collection string, fil
 
 // Replace는 문서를 교체합니다
 func (r *MySQLRepository) Replace(ctx context.Context, collection, id string, replacement *entity.Document) error {
-	dataJSON, err := json.Marshal(replacement.Data)
+	dataJSON, err := r.marshalData(collection, id, replacement.Data())
 	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
+		return err
 	}
 
-	metadataJSON, err := json.Marshal(replacement.Metadata)
+	metadataJSON, err := marshalMetadata(replacement)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	query := fmt.Sprintf(`
@@ -317,7 +337,7 @@ func (r *MySQLRepository) Replace(ctx context.Context, collection, id string, re
 		WHERE id = ?
 	`, quoteIdentifier(collection))
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.conn(ctx).ExecContext(ctx, query,
 		dataJSON,
 		time.Now(),
 		metadataJSON,
@@ -345,7 +365,7 @@ func (r *MySQLRepository) Delete(ctx context.Context, collection, id string) err
 		DELETE FROM %s WHERE id = ?
 	`, quoteIdentifier(collection))
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.conn(ctx).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete document: %w", err)
 	}
@@ -364,14 +384,20 @@ func (r *MySQLRepository) Delete(ctx context.Context, collection, id string) err
 
 // DeleteMany는 필터와 일치하는 여러 문서를 삭제합니다
 func (r *MySQLRepository) DeleteMany(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requireIDFilter(filter, nil); err != nil {
+		return 0, err
+	}
+	whereClause, args, err := r.buildWhereClause(ctx, collection, filter)
+	if err != nil {
+		return 0, err
+	}
 
 	query := fmt.Sprintf(`
 		DELETE FROM %s
 		%s
 	`, quoteIdentifier(collection), whereClause)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("failed to delete documents: %w", err)
 	}
@@ -388,7 +414,7 @@ func (r *MySQLRepository) DeleteMany(ctx context.Context, collection string, fil
 
 // FindAndUpdate는 문서를 찾아서 업데이트하고 업데이트된 문서를 반환합니다
 func (r *MySQLRepository) FindAndUpdate(ctx context.Context, collection, id string, update map[string]interface{}) (*entity.Document, error) {
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -402,49 +428,35 @@ func (r *MySQLRepository) FindAndUpdate(ctx context.Context, collection, id stri
 		FOR UPDATE
 	`, quoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err = tx.QueryRowContext(ctx, query, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	doc, err := r.scanDocument(ctx, tx.QueryRowContext(ctx, query, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	doc.Collection = collection
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
 	// Apply updates
+	data := doc.Data()
 	for key, value := range update {
-		doc.Data[key] = value
+		data[key] = value
+	}
+	if err := doc.Update(data); err != nil {
+		return nil, err
 	}
 
-	updatedDataJSON, err := json.Marshal(doc.Data)
+	updatedDataJSON, err := r.marshalData(collection, id, data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal updated data: %w", err)
+		return nil, err
 	}
 
 	updateQuery := fmt.Sprintf(`
 		UPDATE %s
-		SET data = ?, updated_at = ?, version = version + 1
+		SET data = ?, updated_at = ?, version = ?
 		WHERE id = ?
 	`, quoteIdentifier(collection))
 
-	_, err = tx.ExecContext(ctx, updateQuery, updatedDataJSON, time.Now(), id)
+	_, err = tx.ExecContext(ctx, updateQuery, updatedDataJSON, doc.UpdatedAt(), doc.Version(), id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update document: %w", err)
 	}
@@ -453,15 +465,12 @@ func (r *MySQLRepository) FindAndUpdate(ctx context.Context, collection, id stri
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	doc.Version++
-	doc.UpdatedAt = time.Now()
-
-	return &doc, nil
+	return doc, nil
 }
 
 // FindOneAndReplace는 문서를 찾아서 교체하고 교체된 문서를 반환합니다
 func (r *MySQLRepository) FindOneAndReplace(ctx context.Context, collection, id string, replacement *entity.Document) (*entity.Document, error) {
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -475,32 +484,22 @@ func (r *MySQLRepository) FindOneAndReplace(ctx context.Context, collection, id
 		FOR UPDATE
 	`, quoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err = tx.QueryRowContext(ctx, selectQuery, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	existing, err := r.scanDocument(ctx, tx.QueryRowContext(ctx, selectQuery, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	replacementDataJSON, err := json.Marshal(replacement.Data)
+	replacementDataJSON, err := r.marshalData(collection, id, replacement.Data())
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal replacement data: %w", err)
+		return nil, err
 	}
 
-	replacementMetadataJSON, err := json.Marshal(replacement.Metadata)
+	replacementMetadataJSON, err := marshalMetadata(replacement)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal replacement metadata: %w", err)
+		return nil, err
 	}
 
 	updateQuery := fmt.Sprintf(`
@@ -509,7 +508,8 @@ func (r *MySQLRepository) FindOneAndReplace(ctx context.Context, collection, id
 		WHERE id = ?
 	`, quoteIdentifier(collection))
 
-	_, err = tx.ExecContext(ctx, updateQuery, replacementDataJSON, time.Now(), replacementMetadataJSON, id)
+	now := time.Now()
+	_, err = tx.ExecContext(ctx, updateQuery, replacementDataJSON, now, replacementMetadataJSON, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to replace document: %w", err)
 	}
@@ -519,18 +519,15 @@ func (r *MySQLRepository) FindOneAndReplace(ctx context.Context, collection, id
 	}
 
 	// 업데이트된 문서 반환
-	doc.Data = replacement.Data
-	doc.Metadata = replacement.Metadata
-	doc.Version++
-	doc.UpdatedAt = time.Now()
-	doc.Collection = collection
+	doc := entity.ReconstructDocument(id, collection, replacement.Data(), existing.Version()+1, existing.CreatedAt(), now)
+	doc.SetExpiresAt(replacement.ExpiresAt())
 
-	return &doc, nil
+	return doc, nil
 }
 
 // FindOneAndDelete는 문서를 찾아서 삭제하고 삭제된 문서를 반환합니다
 func (r *MySQLRepository) FindOneAndDelete(ctx context.Context, collection, id string) (*entity.Document, error) {
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -543,32 +540,14 @@ func (r *MySQLRepository) FindOneAndDelete(ctx context.Context, collection, id s
 		WHERE id = ?
 	`, quoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err = tx.QueryRowContext(ctx, selectQuery, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	doc, err := r.scanDocument(ctx, tx.QueryRowContext(ctx, selectQuery, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	doc.Collection = collection
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
 	// 삭제
 	deleteQuery := fmt.Sprintf(`
 		DELETE FROM %s WHERE id = ?
@@ -583,7 +562,7 @@ func (r *MySQLRepository) FindOneAndDelete(ctx context.Context, collection, id s
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return &doc, nil
+	return doc, nil
 }
 
 // Upsert는 문서가 없으면 생성하고 있으면 업데이트합니다
@@ -600,9 +579,9 @@ func (r *MySQLRepository) Upsert(ctx context.Context, collection string, filter
 		}
 	}
 
-	updateDataJSON, err := json.Marshal(update)
+	updateDataJSON, err := r.marshalData(collection, id, update)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal update data: %w", err)
+		return "", err
 	}
 
 	query := fmt.Sprintf(`
@@ -615,7 +594,7 @@ func (r *MySQLRepository) Upsert(ctx context.Context, collection string, filter
 	`, quoteIdentifier(collection))
 
 	now := time.Now()
-	_, err = r.db.ExecContext(ctx, query, id, updateDataJSON, now, now)
+	_, err = r.conn(ctx).ExecContext(ctx, query, id, updateDataJSON, now, now)
 	if err != nil {
 		return "", fmt.Errorf("failed to upsert document: %w", err)
 	}
@@ -625,48 +604,96 @@ func (r *MySQLRepository) Upsert(ctx context.Context, collection string, filter
 
 // ===== Helper methods =====
 
-func (r *MySQLRepository) buildWhereClause(filter map[string]interface{}) (string, []interface{}) {
+// buildWhereClause는 필터를 WHERE 절로 변환합니다
+// 필드 경로는 검증 후 JSON 경로 바인드 파라미터로 전달하므로 필터 키가 SQL 문자열에 직접 들어가지 않습니다
+// 인덱스용 생성 컬럼이 있는 필드의 문자열 비교는 생성 컬럼 조건을 함께 넣어 인덱스를 사용합니다
+func (r *MySQLRepository) buildWhereClause(ctx context.Context, collection string, filter map[string]interface{}) (string, []interface{}, error) {
 	if len(filter) == 0 {
-		return "", nil
+		return "", nil, nil
 	}
 
 	conditions := []string{}
 	args := []interface{}{}
 
 	for key, value := range filter {
-		if key == "_id" || key == "id" {
+		if sqljson.IsIDField(key) {
 			conditions = append(conditions, "id = ?")
 			args = append(args, value)
-		} else {
-			// JSON 필드 검색
-			valueJSON, _ := json.Marshal(value)
-			conditions = append(conditions, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = CAST(? AS JSON)", key))
-			args = append(args, string(valueJSON))
+			continue
+		}
+
+		// JSON 필드 검색
+		path, err := sqljson.Parse(key)
+		if err != nil {
+			return "", nil, err
+		}
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal filter value: %w", err)
+		}
+		if column, ok := r.generatedColumns(ctx, collection).lookup(path, value); ok {
+			// 생성 컬럼은 인덱스 탐색용이고, 타입과 대소문자 비교는 JSON 비교가 정확히 처리합니다
+			conditions = append(conditions, quoteIdentifier(column)+" = ?")
+			args = append(args, value)
 		}
+		conditions = append(conditions, "JSON_EXTRACT(data, ?) = CAST(? AS JSON)")
+		args = append(args, path.MySQL(), string(valueJSON))
 	}
 
-	return "WHERE " + strings.Join(conditions, " AND "), args
+	return "WHERE " + strings.Join(conditions, " AND "), args, nil
 }
 
-func (r *MySQLRepository) buildOrderBy(sort map[string]int) string {
+// buildOrderBy는 정렬 조건을 ORDER BY 절로 변환합니다
+// 반환된 인자는 WHERE 절 인자 뒤에 붙여야 합니다
+func (r *MySQLRepository) buildOrderBy(sort map[string]int) (string, []interface{}, error) {
 	if len(sort) == 0 {
-		return ""
+		return "", nil, nil
 	}
 
 	orders := []string{}
+	args := []interface{}{}
 	for key, direction := range sort {
 		dir := "ASC"
 		if direction == -1 {
 			dir = "DESC"
 		}
-		if key == "_id" || key == "id" {
+		if sqljson.IsIDField(key) {
 			orders = append(orders, fmt.Sprintf("id %s", dir))
-		} else {
-			orders = append(orders, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') %s", key, dir))
+			continue
 		}
+
+		path, err := sqljson.Parse(key)
+		if err != nil {
+			return "", nil, err
+		}
+		orders = append(orders, fmt.Sprintf("JSON_EXTRACT(data, ?) %s", dir))
+		args = append(args, path.MySQL())
 	}
 
-	return "ORDER BY " + strings.Join(orders, ", ")
+	return "ORDER BY " + strings.Join(orders, ", "), args, nil
+}
+
+// buildJSONSet은 업데이트 필드를 하나의 JSON_SET 표현식으로 변환합니다
+// 반환된 인자는 WHERE 절 인자보다 앞에 와야 합니다
+func (r *MySQLRepository) buildJSONSet(update map[string]interface{}) (string, []interface{}, error) {
+	pairs := []string{}
+	args := []interface{}{}
+	for key, value := range update {
+		path, err := sqljson.Parse(key)
+		if err != nil {
+			return "", nil, err
+		}
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal update value: %w", err)
+		}
+		pairs = append(pairs, "?, CAST(? AS JSON)")
+		args = append(args, path.MySQL(), string(valueJSON))
+	}
+	if len(pairs) == 0 {
+		return "data", nil, nil
+	}
+	return "JSON_SET(data, " + strings.Join(pairs, ", ") + ")", args, nil
 }
 
 func (r *MySQLRepository) buildLimit(limit int64) string {
@@ -683,43 +710,68 @@ func (r *MySQLRepository) buildOffset(offset int64) string {
 	return fmt.Sprintf("OFFSET %d", offset)
 }
 
-func (r *MySQLRepository) scanDocuments(rows *sql.Rows, collection string) ([]*entity.Document, error) {
+func (r *MySQLRepository) scanDocuments(ctx context.Context, rows *sql.Rows, collection string) ([]*entity.Document, error) {
 	documents := []*entity.Document{}
 
 	for rows.Next() {
-		var doc entity.Document
-		var dataJSON, metadataJSON []byte
-
-		err := rows.Scan(
-			&doc.ID,
-			&dataJSON,
-			&doc.CreatedAt,
-			&doc.UpdatedAt,
-			&doc.Version,
-			&metadataJSON,
-		)
+		doc, err := r.scanDocument(ctx, rows, collection)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
+			return nil, err
 		}
+		documents = append(documents, doc)
+	}
 
-		doc.Collection = collection
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
 
-		if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-		}
+	return documents, nil
+}
+
+// rowScanner는 *sql.Row와 *sql.Rows의 공통 Scan입니다
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanDocument는 (id, data, created_at, updated_at, version, metadata) 행을 문서로 변환합니다
+// 행이 없으면 sql.ErrNoRows를 감싼 에러를 반환합니다
+func (r *MySQLRepository) scanDocument(ctx context.Context, row rowScanner, collection string) (*entity.Document, error) {
+	var (
+		id                     string
+		dataJSON, metadataJSON []byte
+		createdAt, updatedAt   time.Time
+		version                int
+	)
+	if err := row.Scan(&id, &dataJSON, &createdAt, &updatedAt, &version, &metadataJSON); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
 
-		if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
+	var data map[string]interface{}
+	if err := r.unmarshalData(ctx, collection, id, dataJSON, &data); err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]interface{}
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &metadata); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
 		}
-
-		documents = append(documents, &doc)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration error: %w", err)
+	doc := entity.ReconstructDocument(id, collection, data, version, createdAt, updatedAt)
+	if expiresAt, ok := entity.ParseExpiresAt(metadata); ok {
+		doc.SetExpiresAt(expiresAt)
 	}
+	return doc, nil
+}
 
-	return documents, nil
+// marshalMetadata는 문서의 metadata 컬럼 값을 직렬화합니다 (만료 시각)
+func marshalMetadata(doc *entity.Document) ([]byte, error) {
+	metadataJSON, err := json.Marshal(entity.ExpiryMetadata(doc.ExpiresAt()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	return metadataJSON, nil
 }
 
 // ===== 집계 (Aggregation) =====
@@ -731,15 +783,26 @@ func (r *MySQLRepository) Aggregate(ctx context.Context, collection string, pipe
 
 // Distinct는 고유한 값을 조회합니다
 func (r *MySQLRepository) Distinct(ctx context.Context, collection, field string, filter map[string]interface{}) ([]interface{}, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requirePlaintext("distinct"); err != nil {
+		return nil, err
+	}
+	path, err := sqljson.Parse(field)
+	if err != nil {
+		return nil, err
+	}
+	whereClause, whereArgs, err := r.buildWhereClause(ctx, collection, filter)
+	if err != nil {
+		return nil, err
+	}
+	args := append([]interface{}{path.MySQL()}, whereArgs...)
 
 	query := fmt.Sprintf(`
-		SELECT DISTINCT JSON_UNQUOTE(JSON_EXTRACT(data, '$.%s')) as value
+		SELECT DISTINCT JSON_UNQUOTE(JSON_EXTRACT(data, ?)) as value
 		FROM %s
 		%s
-	`, field, quoteIdentifier(collection), whereClause)
+	`, quoteIdentifier(collection), whereClause)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query distinct values: %w", err)
 	}
@@ -759,14 +822,20 @@ func (r *MySQLRepository) Distinct(ctx context.Context, collection, field string
 
 // Count는 문서 개수를 반환합니다
 func (r *MySQLRepository) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requireIDFilter(filter, nil); err != nil {
+		return 0, err
+	}
+	whereClause, args, err := r.buildWhereClause(ctx, collection, filter)
+	if err != nil {
+		return 0, err
+	}
 
 	query := fmt.Sprintf(`
 		SELECT COUNT(*) FROM %s %s
 	`, quoteIdentifier(collection), whereClause)
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	err = r.conn(ctx).QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count documents: %w", err)
 	}
@@ -784,7 +853,7 @@ func (r *MySQLRepository) EstimatedDocumentCount(ctx context.Context, collection
 	`
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, collection).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, query, collection).Scan(&count)
 	if err != nil {
 		// 테이블이 없거나 통계가 없으면 정확한 카운트 반환
 		return r.Count(ctx, collection, nil)
@@ -801,7 +870,7 @@ func (r *MySQLRepository) BulkWrite(ctx context.Context, operations []*repositor
 		return &repository.BulkResult{}, nil
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -818,14 +887,14 @@ func (r *MySQLRepository) BulkWrite(ctx context.Context, operations []*repositor
 				return nil, fmt.Errorf("failed to ensure table exists: %w", err)
 			}
 
-			dataJSON, err := json.Marshal(op.Document.Data)
+			dataJSON, err := r.marshalData(op.Collection, op.Document.ID(), op.Document.Data())
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal data: %w", err)
+				return nil, err
 			}
 
-			metadataJSON, err := json.Marshal(op.Document.Metadata)
+			metadataJSON, err := marshalMetadata(op.Document)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+				return nil, err
 			}
 
 			query := fmt.Sprintf(`
@@ -834,11 +903,11 @@ func (r *MySQLRepository) BulkWrite(ctx context.Context, operations []*repositor
 			`, quoteIdentifier(op.Collection))
 
 			_, err = tx.ExecContext(ctx, query,
-				op.Document.ID,
+				op.Document.ID(),
 				dataJSON,
-				op.Document.CreatedAt,
-				op.Document.UpdatedAt,
-				op.Document.Version,
+				op.Document.CreatedAt(),
+				op.Document.UpdatedAt(),
+				op.Document.Version(),
 				metadataJSON,
 			)
 			if err != nil {
@@ -848,23 +917,24 @@ func (r *MySQLRepository) BulkWrite(ctx context.Context, operations []*repositor
 			result.InsertedCount++
 
 		case "update":
-			whereClause, args := r.buildWhereClause(op.Filter)
-
-			setClauses := []string{}
-			for key, value := range op.Update {
-				valueJSON, err := json.Marshal(value)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal update value: %w", err)
-				}
-				setClauses = append(setClauses, fmt.Sprintf("data = JSON_SET(data, '$.%s', CAST(? AS JSON))", key))
-				args = append(args, valueJSON)
+			if err := r.requirePlaintext("bulk update"); err != nil {
+				return nil, err
+			}
+			dataExpr, args, err := r.buildJSONSet(op.Update)
+			if err != nil {
+				return nil, err
+			}
+			whereClause, whereArgs, err := r.buildWhereClause(ctx, op.Collection, op.Filter)
+			if err != nil {
+				return nil, err
 			}
+			args = append(args, whereArgs...)
 
 			query := fmt.Sprintf(`
 				UPDATE %s
-				SET %s, updated_at = NOW(6), version = version + 1
+				SET data = %s, updated_at = NOW(6), version = version + 1
 				%s
-			`, quoteIdentifier(op.Collection), strings.Join(setClauses, ", "), whereClause)
+			`, quoteIdentifier(op.Collection), dataExpr, whereClause)
 
 			res, err := tx.ExecContext(ctx, query, args...)
 			if err != nil {
@@ -876,7 +946,13 @@ func (r *MySQLRepository) BulkWrite(ctx context.Context, operations []*repositor
 			result.ModifiedCount += affected
 
 		case "delete":
-			whereClause, args := r.buildWhereClause(op.Filter)
+			if err := r.requireIDFilter(op.Filter, nil); err != nil {
+				return nil, err
+			}
+			whereClause, args, err := r.buildWhereClause(ctx, op.Collection, op.Filter)
+			if err != nil {
+				return nil, err
+			}
 
 			query := fmt.Sprintf(`
 				DELETE FROM %s %s
@@ -895,14 +971,14 @@ func (r *MySQLRepository) BulkWrite(ctx context.Context, operations []*repositor
 				return nil, errors.New("replace operation requires a document")
 			}
 
-			dataJSON, err := json.Marshal(op.Document.Data)
+			dataJSON, err := r.marshalData(op.Collection, op.ReplaceOneID, op.Document.Data())
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal data: %w", err)
+				return nil, err
 			}
 
-			metadataJSON, err := json.Marshal(op.Document.Metadata)
+			metadataJSON, err := marshalMetadata(op.Document)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+				return nil, err
 			}
 
 			query := fmt.Sprintf(`
@@ -948,11 +1024,22 @@ func (r *MySQLRepository) CreateIndex(ctx context.Context, collection string, mo
 	// JSON 필드에 대한 인덱스 생성 (Generated Column 사용)
 	indexKeys := []string{}
 	for key := range model.Keys {
-		if key == "_id" || key == "id" {
+		if sqljson.IsIDField(key) {
 			indexKeys = append(indexKeys, "id")
 		} else {
-			// MySQL에서는 JSON 필드에 직접 인덱스를 생성할 수 없으므로 generated column 필요
-			indexKeys = append(indexKeys, fmt.Sprintf("(CAST(JSON_UNQUOTE(JSON_EXTRACT(data, '$.%s')) AS CHAR(255)))", key))
+			if err := r.requirePlaintext("index on data field"); err != nil {
+				return "", err
+			}
+			path, err := sqljson.Parse(key)
+			if err != nil {
+				return "", err
+			}
+			// JSON 필드는 STORED 생성 컬럼으로 꺼낸 뒤 그 컬럼에 인덱스를 생성합니다
+			column, err := r.ensureGeneratedColumn(ctx, collection, path)
+			if err != nil {
+				return "", err
+			}
+			indexKeys = append(indexKeys, quoteIdentifier(column))
 		}
 	}
 
@@ -1048,6 +1135,7 @@ func (r *MySQLRepository) DropCollection(ctx context.Context, name string) error
 	if err != nil {
 		return fmt.Errorf("failed to drop collection: %w", err)
 	}
+	r.generated.Delete(name)
 
 	return nil
 }
@@ -1060,6 +1148,8 @@ func (r *MySQLRepository) RenameCollection(ctx context.Context, oldName, newName
 	if err != nil {
 		return fmt.Errorf("failed to rename collection: %w", err)
 	}
+	r.generated.Delete(oldName)
+	r.generated.Delete(newName)
 
 	return nil
 }
@@ -1113,29 +1203,23 @@ func (r *MySQLRepository) CollectionExists(ctx context.Context, name string) (bo
 // ===== Change Streams =====
 
 // Watch는 컬렉션의 변경 사항을 실시간으로 감지합니다
+// *mongo.ChangeStream은 MongoDB 전용이므로, MySQL은 변경 로그 기반 WatchChanges(repository.ChangeWatcher)를 사용합니다
 func (r *MySQLRepository) Watch(ctx context.Context, collection string, pipeline []bson.M) (*mongo.ChangeStream, error) {
-	return nil, errors.New("watch is not supported in MySQL implementation")
+	return nil, errors.New("mongo change streams are not available in MySQL, use WatchChanges")
 }
 
 // ===== 트랜잭션 (Transaction) =====
 
 // WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-func (r *MySQLRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	if err := fn(ctx); err != nil {
-		return err
-	}
-
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+// 트랜잭션은 fn에 전달되는 ctx에 담기므로, 그 ctx로 호출한 문서 작업(조회, 쓰기, raw query)은 같은 트랜잭션에서 실행됩니다
+// 인덱스, 컬렉션 관리 같은 DDL은 트랜잭션 밖에서 실행됩니다
+func (r *MySQLRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
+	return sqltx.Run(ctx, r.db, opts, fn)
+}
 
-	return nil
+// conn은 ctx에 WithTransaction의 트랜잭션이 있으면 그 트랜잭션을, 없으면 연결 풀을 반환합니다
+func (r *MySQLRepository) conn(ctx context.Context) sqltx.Querier {
+	return sqltx.Conn(ctx, r.db)
 }
 
 // ===== Raw Query Execution =====
@@ -1147,7 +1231,7 @@ func (r *MySQLRepository) ExecuteRawQuery(ctx context.Context, query interface{}
 		return nil, errors.New("query must be a string for MySQL")
 	}
 
-	rows, err := r.db.QueryContext(ctx, sqlQuery)
+	rows, err := r.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute raw query: %w", err)
 	}
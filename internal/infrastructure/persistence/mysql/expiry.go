@@ -0,0 +1,67 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	gomysql "github.com/go-sql-driver/mysql"
+)
+
+const (
+	// expiresAtColumn은 metadata의 expires_at을 담는 인덱스용 생성 컬럼입니다
+	expiresAtColumn = "_expires_at"
+
+	mysqlErrDuplicateKeyName = 1061
+)
+
+// EnableExpiry는 metadata의 expires_at을 STORED 생성 컬럼으로 꺼내고 인덱스를 생성합니다
+// MySQL은 만료 문서를 스스로 삭제하지 않으므로 false를 반환하며, DeleteExpired로 주기적으로 정리해야 합니다
+func (r *MySQLRepository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	if err := r.ensureTableExists(ctx, collection); err != nil {
+		return false, fmt.Errorf("failed to ensure table exists: %w", err)
+	}
+
+	addColumn := fmt.Sprintf(`
+		ALTER TABLE %s ADD COLUMN %s VARCHAR(32)
+		GENERATED ALWAYS AS (JSON_UNQUOTE(JSON_EXTRACT(metadata, '$.%s'))) STORED
+	`, quoteIdentifier(collection), quoteIdentifier(expiresAtColumn), entity.ExpiresAtField)
+	if err := r.execIgnoring(ctx, addColumn, mysqlErrDuplicateColumn); err != nil {
+		return false, fmt.Errorf("failed to add expiry column to %s: %w", collection, err)
+	}
+
+	addIndex := fmt.Sprintf(`CREATE INDEX %s ON %s (%s)`,
+		quoteIdentifier("idx"+expiresAtColumn), quoteIdentifier(collection), quoteIdentifier(expiresAtColumn))
+	if err := r.execIgnoring(ctx, addIndex, mysqlErrDuplicateKeyName); err != nil {
+		return false, fmt.Errorf("failed to create expiry index on %s: %w", collection, err)
+	}
+	return false, nil
+}
+
+// DeleteExpired는 before 시점까지 만료된 문서를 삭제합니다
+// 만료 시각은 고정 길이 UTC 문자열(entity.ExpiresAtLayout)이므로 생성 컬럼의 문자열 비교로 인덱스를 사용합니다
+func (r *MySQLRepository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s <= ?`, quoteIdentifier(collection), quoteIdentifier(expiresAtColumn))
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, entity.FormatExpiresAt(before))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired documents: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// execIgnoring은 DDL을 실행하고 이미 적용된 경우의 MySQL 에러 번호는 무시합니다
+func (r *MySQLRepository) execIgnoring(ctx context.Context, query string, ignore uint16) error {
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		var mysqlErr *gomysql.MySQLError
+		if !errors.As(err, &mysqlErr) || mysqlErr.Number != ignore {
+			return err
+		}
+	}
+	return nil
+}
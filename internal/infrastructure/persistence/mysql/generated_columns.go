@@ -0,0 +1,138 @@
+package mysql
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	gomysql "github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+const (
+	// generatedColumnPrefix는 data 필드 인덱스용 생성 컬럼 이름의 접두사입니다
+	generatedColumnPrefix = "_g_"
+
+	// generatedColumnLength는 생성 컬럼에 저장하는 문자열의 최대 길이입니다 (더 긴 값은 잘라서 저장)
+	generatedColumnLength = 255
+
+	// generatedColumnsTTL은 생성 컬럼 목록 캐시의 유지 시간입니다 (다른 인스턴스가 만든 인덱스를 반영)
+	generatedColumnsTTL = time.Minute
+
+	mysqlErrDuplicateColumn = 1060
+)
+
+// generatedColumnSet은 컬렉션 테이블에 있는 인덱스용 생성 컬럼 목록입니다
+type generatedColumnSet struct {
+	columns  map[string]struct{}
+	loadedAt time.Time
+}
+
+// lookup은 필드 비교에 사용할 생성 컬럼을 반환합니다
+// 생성 컬럼은 JSON_UNQUOTE한 문자열을 잘라 저장하므로 잘리지 않는 길이의 문자열 값만 사용합니다
+func (s *generatedColumnSet) lookup(path sqljson.Path, value interface{}) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	str, ok := value.(string)
+	if !ok || utf8.RuneCountInString(str) >= generatedColumnLength {
+		return "", false
+	}
+	column := generatedColumnName(path)
+	if _, ok := s.columns[column]; !ok {
+		return "", false
+	}
+	return column, true
+}
+
+// generatedColumnName은 필드 경로의 생성 컬럼 이름을 만듭니다 (예: address.city -> _g_address_city_1a2b3c4d)
+// 경로 해시를 붙여 대소문자나 '_'만 다른 경로도 서로 다른 컬럼이 되도록 합니다
+func generatedColumnName(path sqljson.Path) string {
+	sum := sha1.Sum([]byte(path.String()))
+	suffix := "_" + hex.EncodeToString(sum[:])[:8]
+	name := strings.Join(path, "_")
+	if max := mysqlMaxIdentifierLength - len(generatedColumnPrefix) - len(suffix); len(name) > max {
+		name = name[:max]
+	}
+	return generatedColumnPrefix + name + suffix
+}
+
+// generatedColumns는 컬렉션 테이블의 생성 컬럼 목록을 반환합니다 (캐시, 조회 실패 시 nil)
+// 암호화가 설정되면 data 필드로 필터링할 수 없으므로 조회하지 않습니다
+func (r *MySQLRepository) generatedColumns(ctx context.Context, collection string) *generatedColumnSet {
+	if r.cipher != nil {
+		return nil
+	}
+	if cached, ok := r.generated.Load(collection); ok {
+		set := cached.(*generatedColumnSet)
+		if time.Since(set.loadedAt) < generatedColumnsTTL {
+			return set
+		}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT COLUMN_NAME
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE()
+		AND TABLE_NAME = ?
+		AND GENERATION_EXPRESSION <> ''
+	`, collection)
+	if err != nil {
+		logger.Warn(ctx, "failed to load generated columns", zap.String("collection", collection), zap.Error(err))
+		return nil
+	}
+	defer rows.Close()
+
+	set := &generatedColumnSet{columns: make(map[string]struct{}), loadedAt: time.Now()}
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			logger.Warn(ctx, "failed to scan generated column", zap.String("collection", collection), zap.Error(err))
+			return nil
+		}
+		if strings.HasPrefix(column, generatedColumnPrefix) {
+			set.columns[column] = struct{}{}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		logger.Warn(ctx, "failed to load generated columns", zap.String("collection", collection), zap.Error(err))
+		return nil
+	}
+
+	r.generated.Store(collection, set)
+	return set
+}
+
+// ensureGeneratedColumn은 data 필드 값을 담는 STORED 생성 컬럼을 추가하고 컬럼 이름을 반환합니다 (이미 있으면 건너뜀)
+// functional index와 달리 WHERE 절에서 컬럼을 직접 비교할 수 있어 표현식이 정확히 같지 않아도 인덱스를 사용합니다
+// 컬럼 추가는 테이블을 다시 쓰므로 큰 테이블에서는 시간이 걸립니다
+func (r *MySQLRepository) ensureGeneratedColumn(ctx context.Context, collection string, path sqljson.Path) (string, error) {
+	column := generatedColumnName(path)
+	if set := r.generatedColumns(ctx, collection); set != nil {
+		if _, ok := set.columns[column]; ok {
+			return column, nil
+		}
+	}
+
+	// DDL은 바인드 파라미터를 쓸 수 없으므로 검증된 경로만 리터럴로 넣습니다
+	query := fmt.Sprintf(`
+		ALTER TABLE %s ADD COLUMN %s VARCHAR(%d)
+		GENERATED ALWAYS AS (LEFT(JSON_UNQUOTE(JSON_EXTRACT(data, '%s')), %d)) STORED
+	`, quoteIdentifier(collection), quoteIdentifier(column), generatedColumnLength, path.MySQL(), generatedColumnLength)
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		var mysqlErr *gomysql.MySQLError
+		if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateColumn {
+			return "", fmt.Errorf("failed to add generated column for %s: %w", path, err)
+		}
+	}
+
+	r.generated.Delete(collection)
+	return column, nil
+}
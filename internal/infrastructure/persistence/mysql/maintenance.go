@@ -0,0 +1,53 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// MaintenanceOperations는 MySQL이 지원하는 유지보수 작업을 반환합니다
+func (r *MySQLRepository) MaintenanceOperations() []string {
+	return []string{"optimize", "analyze", "reindex"}
+}
+
+// RunMaintenance는 문서 테이블에 OPTIMIZE TABLE, ANALYZE TABLE 또는 인덱스 재생성(reindex)을 실행하고 결과를 반환합니다
+// InnoDB의 OPTIMIZE TABLE은 테이블을 다시 만들어(ALTER TABLE ... FORCE) 빈 공간을 반환합니다
+func (r *MySQLRepository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	var statement string
+	switch operation {
+	case "optimize":
+		statement = "OPTIMIZE TABLE " + quoteIdentifier(collection)
+	case "analyze":
+		statement = "ANALYZE TABLE " + quoteIdentifier(collection)
+	case "reindex":
+		return r.reindex(ctx, collection, opts)
+	default:
+		return nil, fmt.Errorf("%w: %s on mysql", repository.ErrUnsupportedMaintenance, operation)
+	}
+
+	// 두 명령 모두 결과를 (Table, Op, Msg_type, Msg_text) 행으로 반환하며, 실패도 error 행으로 보고합니다
+	rows, err := r.db.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s table %s: %w", operation, collection, err)
+	}
+	defer rows.Close()
+
+	var messages []string
+	for rows.Next() {
+		var table, op, msgType, msgText string
+		if err := rows.Scan(&table, &op, &msgType, &msgText); err != nil {
+			return nil, fmt.Errorf("failed to read %s result: %w", operation, err)
+		}
+		if msgType == "error" {
+			return nil, fmt.Errorf("failed to %s table %s: %s", operation, collection, msgText)
+		}
+		messages = append(messages, msgType+": "+msgText)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s result: %w", operation, err)
+	}
+
+	return map[string]interface{}{"messages": messages}, nil
+}
@@ -0,0 +1,41 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+)
+
+// FindReferencing은 data의 field 값이 id인 문서의 ID를 조회합니다
+// 고유 키 조회와 달리 생성 컬럼 없이 JSON 값을 직접 비교합니다
+func (r *MySQLRepository) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	if err := r.requirePlaintext("reference"); err != nil {
+		return nil, err
+	}
+	path, err := sqljson.Parse(field)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE JSON_UNQUOTE(JSON_EXTRACT(data, ?)) = ?`, quoteIdentifier(collection))
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, path.MySQL(), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find referencing documents: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var docID string
+		if err := rows.Scan(&docID); err != nil {
+			return nil, fmt.Errorf("failed to scan document id: %w", err)
+		}
+		ids = append(ids, docID)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,141 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// indexPart는 information_schema.STATISTICS의 인덱스 구성 요소입니다
+type indexPart struct {
+	column     sql.NullString
+	expression sql.NullString // 함수 인덱스 (MySQL 8.0.13+)
+	subPart    sql.NullInt64
+	descending bool
+}
+
+// indexDefinition은 인덱스를 다시 만들 때 필요한 정의입니다
+type indexDefinition struct {
+	name      string
+	unique    bool
+	indexType string // BTREE, HASH, FULLTEXT, SPATIAL
+	visible   bool
+	parts     []indexPart
+}
+
+// reindex는 PRIMARY를 제외한 문서 테이블의 인덱스를 하나씩 같은 정의로 삭제 후 다시 생성합니다
+// 삭제와 생성은 한 ALTER TABLE로 실행되어 원자적이며, InnoDB는 가능한 경우 온라인 DDL로 쓰기를 막지 않습니다
+func (r *MySQLRepository) reindex(ctx context.Context, collection string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	definitions, err := r.indexDefinitions(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int64(len(definitions))
+	opts.ReportProgress(0, total)
+
+	table := quoteIdentifier(collection)
+	rebuilt := make([]string, 0, len(definitions))
+	for _, def := range definitions {
+		statement := fmt.Sprintf("ALTER TABLE %s DROP INDEX %s, ADD %s", table, quoteIdentifier(def.name), def.clause())
+		if _, err := r.db.ExecContext(ctx, statement); err != nil {
+			return map[string]interface{}{"indexes": rebuilt}, fmt.Errorf("failed to rebuild index %s: %w", def.name, err)
+		}
+		rebuilt = append(rebuilt, def.name)
+		opts.ReportProgress(int64(len(rebuilt)), total)
+	}
+
+	return map[string]interface{}{"indexes": rebuilt}, nil
+}
+
+// indexDefinitions는 PRIMARY를 제외한 테이블 인덱스의 정의를 이름순으로 반환합니다
+func (r *MySQLRepository) indexDefinitions(ctx context.Context, collection string) ([]*indexDefinition, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT INDEX_NAME, NON_UNIQUE, INDEX_TYPE, IS_VISIBLE, COLUMN_NAME, EXPRESSION, SUB_PART, COLLATION
+		FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND INDEX_NAME <> 'PRIMARY'
+		ORDER BY INDEX_NAME, SEQ_IN_INDEX`, collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	defer rows.Close()
+
+	byName := make(map[string]*indexDefinition)
+	for rows.Next() {
+		var (
+			name, indexType, visible string
+			nonUnique                int
+			collation                sql.NullString
+			part                     indexPart
+		)
+		if err := rows.Scan(&name, &nonUnique, &indexType, &visible, &part.column, &part.expression, &part.subPart, &collation); err != nil {
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+		part.descending = collation.String == "D"
+
+		def, ok := byName[name]
+		if !ok {
+			def = &indexDefinition{name: name, unique: nonUnique == 0, indexType: indexType, visible: visible == "YES"}
+			byName[name] = def
+		}
+		def.parts = append(def.parts, part)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+
+	definitions := make([]*indexDefinition, 0, len(byName))
+	for _, def := range byName {
+		definitions = append(definitions, def)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].name < definitions[j].name
+	})
+	return definitions, nil
+}
+
+// clause는 ALTER TABLE ... ADD 뒤에 올 인덱스 정의를 반환합니다
+func (d *indexDefinition) clause() string {
+	var b strings.Builder
+	switch {
+	case d.indexType == "FULLTEXT":
+		b.WriteString("FULLTEXT INDEX ")
+	case d.indexType == "SPATIAL":
+		b.WriteString("SPATIAL INDEX ")
+	case d.unique:
+		b.WriteString("UNIQUE INDEX ")
+	default:
+		b.WriteString("INDEX ")
+	}
+	b.WriteString(quoteIdentifier(d.name))
+
+	parts := make([]string, 0, len(d.parts))
+	for _, p := range d.parts {
+		var part string
+		if p.expression.Valid {
+			part = "(" + p.expression.String + ")"
+		} else {
+			part = quoteIdentifier(p.column.String)
+			if p.subPart.Valid {
+				part += fmt.Sprintf("(%d)", p.subPart.Int64)
+			}
+		}
+		if p.descending {
+			part += " DESC"
+		}
+		parts = append(parts, part)
+	}
+	b.WriteString(" (" + strings.Join(parts, ", ") + ")")
+
+	if d.indexType == "HASH" {
+		b.WriteString(" USING HASH")
+	}
+	if !d.visible {
+		b.WriteString(" INVISIBLE")
+	}
+	return b.String()
+}
@@ -0,0 +1,30 @@
+package mysql
+
+import (
+	"database/sql/driver"
+	"errors"
+
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	gomysql "github.com/go-sql-driver/mysql"
+)
+
+// IsRetryable은 MySQL(Vitess 포함) 에러가 재시도 가능한지 확인합니다
+// 교착 상태, 잠금 대기 시간 초과, 연결 수 초과, 서버 종료와 끊어진 연결은 재시도하고
+// 중복 키나 문법 오류처럼 다시 실행해도 같은 결과가 나오는 에러는 재시도하지 않습니다
+func IsRetryable(err error) bool {
+	var mysqlErr *gomysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1213, // ER_LOCK_DEADLOCK
+			1205, // ER_LOCK_WAIT_TIMEOUT
+			1040, // ER_CON_COUNT_ERROR
+			1053: // ER_SERVER_SHUTDOWN
+			return true
+		}
+		return false
+	}
+	if errors.Is(err, gomysql.ErrInvalidConn) || errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	return retry.IsRetryable(err)
+}
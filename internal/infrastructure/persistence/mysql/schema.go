@@ -0,0 +1,78 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// schemaLockTimeout은 다른 인스턴스의 마이그레이션이 끝나기를 기다리는 최대 시간(초)입니다
+const schemaLockTimeout = 300
+
+// schemaDialect는 MySQL 문서 테이블 마이그레이션 방언입니다
+// MySQL DDL은 암묵적으로 커밋되므로 마이그레이션마다 문장을 실행한 뒤 기록합니다
+var schemaDialect = schema.Dialect{
+	Name:        "mysql",
+	Quote:       quoteIdentifier,
+	Placeholder: func(int) string { return "?" },
+	CreateHistory: `CREATE TABLE IF NOT EXISTS ` + schema.HistoryTable + ` (
+			table_name VARCHAR(255) NOT NULL,
+			version INTEGER NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			applied_at DATETIME(6) NOT NULL,
+			PRIMARY KEY (table_name, version)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci`,
+	ListTables: `
+		SELECT c.table_name FROM information_schema.columns c
+		JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+		WHERE c.table_schema = DATABASE() AND t.table_type = 'BASE TABLE' AND c.column_name IN ('data', 'metadata')
+		GROUP BY c.table_name HAVING COUNT(*) = 2`,
+	TransactionalDDL: false,
+	Lock: func(ctx context.Context, conn *sql.Conn) error {
+		var acquired sql.NullInt64
+		if err := conn.QueryRowContext(ctx, `SELECT GET_LOCK(?, ?)`, schema.HistoryTable, schemaLockTimeout).Scan(&acquired); err != nil {
+			return err
+		}
+		if !acquired.Valid || acquired.Int64 != 1 {
+			return fmt.Errorf("timed out after %ds waiting for another schema migration", schemaLockTimeout)
+		}
+		return nil
+	},
+	Unlock: func(ctx context.Context, conn *sql.Conn) error {
+		_, err := conn.ExecContext(ctx, `SELECT RELEASE_LOCK(?)`, schema.HistoryTable)
+		return err
+	},
+}
+
+// NewSchemaMigrator는 내장된 마이그레이션 파일로 MySQL 문서 테이블 Migrator를 생성합니다
+func NewSchemaMigrator(db *sql.DB) (*schema.Migrator, error) {
+	files, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := schema.Load(files)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewMigrator(db, schemaDialect, migrations), nil
+}
+
+// SetSchemaMigrator는 컬렉션 테이블을 처음 쓸 때 남은 스키마 마이그레이션을 적용하도록 설정합니다 (nil이면 적용하지 않음)
+func (r *MySQLRepository) SetSchemaMigrator(migrator *schema.Migrator) {
+	r.migrator = migrator
+}
+
+// ensureSchema는 컬렉션 테이블에 남은 스키마 마이그레이션을 적용합니다
+func (r *MySQLRepository) ensureSchema(ctx context.Context, collection string) error {
+	if r.migrator == nil {
+		return nil
+	}
+	return r.migrator.EnsureTable(ctx, collection)
+}
@@ -0,0 +1,111 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	gomysql "github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+// mysqlErrDuplicateEntry는 고유 키 위반 에러 번호입니다 (ER_DUP_ENTRY)
+const mysqlErrDuplicateEntry = 1062
+
+// IsDuplicateKey는 MySQL(Vitess 포함) 에러가 고유 키(기본 키 포함) 위반인지 확인합니다
+func IsDuplicateKey(err error) bool {
+	var mysqlErr *gomysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// EnsureUniqueKey는 data 필드를 STORED 생성 컬럼으로 꺼내고 그 컬럼들에 고유 인덱스를 생성합니다
+// 필드가 없는 문서는 컬럼이 NULL이라 제약 대상이 아니며, 값은 텍스트로 비교합니다
+// 생성 컬럼은 앞 255자만 저장하므로 앞 255자가 같은 긴 문자열은 같은 값으로 취급됩니다
+func (r *MySQLRepository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	if err := r.requirePlaintext("unique key"); err != nil {
+		return false, err
+	}
+	if err := r.ensureTableExists(ctx, collection); err != nil {
+		return false, fmt.Errorf("failed to ensure table exists: %w", err)
+	}
+
+	columns := make([]string, len(fields))
+	for i, field := range fields {
+		path, err := sqljson.Parse(field)
+		if err != nil {
+			return false, err
+		}
+		column, err := r.ensureGeneratedColumn(ctx, collection, path)
+		if err != nil {
+			return false, err
+		}
+		columns[i] = quoteIdentifier(column)
+	}
+
+	name := repository.UniqueKeyName(fields)
+	query := fmt.Sprintf(`CREATE UNIQUE INDEX %s ON %s (%s)`,
+		quoteIdentifier(name), quoteIdentifier(collection), strings.Join(columns, ", "))
+	if err := r.execIgnoring(ctx, query, mysqlErrDuplicateKeyName); err != nil {
+		return false, fmt.Errorf("failed to create unique index on %s: %w", collection, err)
+	}
+
+	logger.Info(ctx, "unique index ensured",
+		logger.Collection(collection),
+		zap.String("index", name),
+	)
+	return true, nil
+}
+
+// FindByUniqueKey는 생성 컬럼 값이 key와 같은 문서의 ID를 조회합니다 (EnsureUniqueKey 이후에 사용)
+func (r *MySQLRepository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	if err := r.requirePlaintext("unique key"); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(key))
+	for field := range key {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	conditions := make([]string, len(fields))
+	args := make([]interface{}, len(fields))
+	for i, field := range fields {
+		path, err := sqljson.Parse(field)
+		if err != nil {
+			return nil, err
+		}
+		text, err := sqljson.Text(key[field])
+		if err != nil {
+			return nil, err
+		}
+		conditions[i] = fmt.Sprintf("%s = LEFT(?, %d)", quoteIdentifier(generatedColumnName(path)), generatedColumnLength)
+		args[i] = text
+	}
+
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE %s`, quoteIdentifier(collection), strings.Join(conditions, " AND "))
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find documents by unique key: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan document id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
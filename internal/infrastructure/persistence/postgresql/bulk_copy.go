@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/lib/pq"
+)
+
+// defaultCopyThreshold는 SaveMany가 COPY를 사용하는 기본 최소 문서 수입니다
+// 이보다 적으면 COPY 시작/종료 왕복보다 준비된 INSERT 반복이 더 빠릅니다
+const defaultCopyThreshold = 100
+
+// SetCopyThreshold는 SaveMany가 COPY FROM으로 적재하는 최소 문서 수를 지정합니다
+// 0이면 기본값(100)을 사용하고, 음수면 항상 준비된 INSERT를 반복합니다
+func (r *PostgreSQLRepository) SetCopyThreshold(threshold int) {
+	r.copyThreshold = threshold
+}
+
+// useCopy는 문서 수가 COPY 기준 이상인지 확인합니다
+func (r *PostgreSQLRepository) useCopy(count int) bool {
+	threshold := r.copyThreshold
+	if threshold < 0 {
+		return false
+	}
+	if threshold == 0 {
+		threshold = defaultCopyThreshold
+	}
+	return count >= threshold
+}
+
+// copyMany는 트랜잭션 안에서 COPY FROM STDIN으로 문서를 적재합니다
+// 한 행이라도 실패하면(중복 ID 등) 전체 COPY가 실패하므로 INSERT 반복과 같은 원자성을 가집니다
+func (r *PostgreSQLRepository) copyMany(ctx context.Context, tx *sql.Tx, collection string, docs []*entity.Document) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(collection, "id", "data", "created_at", "updated_at", "version", "metadata"))
+	if err != nil {
+		return fmt.Errorf("failed to start copy: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, doc := range docs {
+		dataJSON, err := r.marshalData(collection, doc.ID(), doc.Data())
+		if err != nil {
+			return err
+		}
+
+		metadataJSON, err := marshalMetadata(doc)
+		if err != nil {
+			return err
+		}
+
+		// COPY 텍스트 형식에서 []byte는 bytea로 인코딩되므로 JSONB 컬럼에는 문자열로 전달합니다
+		if _, err := stmt.ExecContext(ctx, doc.ID(), string(dataJSON), doc.CreatedAt(), doc.UpdatedAt(), doc.Version(), string(metadataJSON)); err != nil {
+			return fmt.Errorf("failed to copy document: %w", err)
+		}
+	}
+
+	// 인자 없는 Exec가 버퍼를 비우고 COPY를 완료합니다
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return fmt.Errorf("failed to complete copy: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// 변경 알림 채널과 트리거
+// 페이로드 크기 제한(8000바이트) 때문에 알림에는 작업/컬렉션/ID/버전만 담고, 데이터는 수신 측에서 조회합니다
+const (
+	ChangeNotifyChannel = "database_service_changes"
+	changeNotifyFunc    = "database_service_notify_change"
+	changeNotifyTrigger = "database_service_notify_change"
+)
+
+const (
+	listenerMinReconnect = time.Second
+	listenerMaxReconnect = time.Minute
+	listenerPingInterval = 90 * time.Second
+)
+
+// changeNotification은 트리거가 보내는 알림 페이로드입니다
+type changeNotification struct {
+	Operation  string `json:"op"`
+	Collection string `json:"collection"`
+	ID         string `json:"id"`
+	Version    int    `json:"version"`
+}
+
+// SetListenerConfig는 변경 알림(LISTEN)에 사용할 연결 설정을 지정합니다 (WatchChanges 사용 시 필요)
+// LISTEN은 연결 단위로 동작하므로 풀과 별도의 전용 연결을 엽니다
+func (r *PostgreSQLRepository) SetListenerConfig(cfg *Config) {
+	r.listenerConfig = cfg
+}
+
+// WatchChanges는 트리거와 LISTEN/NOTIFY로 컬렉션 변경을 ChangeFeed로 전달합니다 (repository.ChangeWatcher 구현)
+// 테이블에 알림 트리거가 없으면 생성하며, 연결이 끊긴 동안의 알림은 유실될 수 있습니다 (at-most-once)
+// 변경 필드(Changes)는 제공하지 않습니다
+func (r *PostgreSQLRepository) WatchChanges(ctx context.Context, collection string) (*repository.ChangeFeed, error) {
+	if r.listenerConfig == nil {
+		return nil, errors.New("postgresql change notifications require a listener config")
+	}
+	if err := r.ensureChangeTrigger(ctx, collection); err != nil {
+		return nil, err
+	}
+
+	listener := pq.NewListener(r.listenerConfig.dsn(), listenerMinReconnect, listenerMaxReconnect,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				logger.Warn(ctx, "postgresql change listener connection event",
+					zap.Int("event", int(event)),
+					zap.Error(err),
+				)
+			}
+		})
+	if err := listener.Listen(ChangeNotifyChannel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to listen for changes: %w", err)
+	}
+
+	return repository.NewChangeFeed(ctx, 64, func(ctx context.Context, emit func(repository.ChangeEvent) bool) error {
+		defer listener.Close()
+
+		ping := time.NewTicker(listenerPingInterval)
+		defer ping.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+
+			case <-ping.C:
+				// 유휴 연결이 끊긴 것을 감지해 재연결을 시작합니다
+				go listener.Ping()
+
+			case n := <-listener.Notify:
+				if n == nil {
+					// 재연결됨: 끊긴 동안의 알림은 전달되지 않습니다
+					logger.Warn(ctx, "postgresql change listener reconnected, changes may have been missed",
+						logger.Collection(collection),
+					)
+					continue
+				}
+
+				var payload changeNotification
+				if err := json.Unmarshal([]byte(n.Extra), &payload); err != nil {
+					logger.Warn(ctx, "invalid change notification payload", zap.String("payload", n.Extra), zap.Error(err))
+					continue
+				}
+				if payload.Collection != collection {
+					continue
+				}
+
+				event, err := r.changeEvent(ctx, &payload)
+				if err != nil {
+					return err
+				}
+				if event != nil && !emit(*event) {
+					return nil
+				}
+			}
+		}
+	}), nil
+}
+
+// changeEvent는 알림을 변경 이벤트로 변환합니다 (insert/update는 현재 행을 조회, 이미 삭제되었으면 nil)
+func (r *PostgreSQLRepository) changeEvent(ctx context.Context, payload *changeNotification) (*repository.ChangeEvent, error) {
+	event := &repository.ChangeEvent{
+		Operation:  payload.Operation,
+		Collection: payload.Collection,
+		DocumentID: payload.ID,
+		Version:    payload.Version,
+		Timestamp:  time.Now(),
+	}
+	if payload.Operation == repository.ChangeOperationDelete {
+		return event, nil
+	}
+
+	query := fmt.Sprintf(`SELECT data, version, updated_at FROM %s WHERE id = $1`, pq.QuoteIdentifier(payload.Collection))
+	var dataJSON []byte
+	err := r.db.QueryRowContext(ctx, query, payload.ID).Scan(&dataJSON, &event.Version, &event.Timestamp)
+	if errors.Is(err, sql.ErrNoRows) {
+		// 조회 전에 삭제되었습니다 (뒤따르는 삭제 알림으로 전달됨)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load changed document: %w", err)
+	}
+	if err := r.unmarshalData(ctx, payload.Collection, payload.ID, dataJSON, &event.Data); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ensureChangeTrigger는 알림 함수와 컬렉션 테이블의 행 단위 트리거를 생성합니다
+// 트리거는 논리 컬렉션 이름을 인자(TG_ARGV[0])로 받습니다 (파티션 테이블에서는 TG_TABLE_NAME이 파티션 이름이 됨)
+// 같은 인자로 이미 설치되어 있으면 DDL 없이 반환하므로, 구독마다 트리거를 다시 만들지 않습니다
+func (r *PostgreSQLRepository) ensureChangeTrigger(ctx context.Context, collection string) error {
+	if err := r.ensureTableExists(ctx, collection); err != nil {
+		return fmt.Errorf("failed to ensure table exists: %w", err)
+	}
+
+	installed, err := r.changeTriggerInstalled(ctx, r.db, collection)
+	if err != nil {
+		return err
+	}
+	if installed {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 여러 인스턴스가 동시에 구독을 시작해도 한 번만 설치되도록 직렬화합니다
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, changeNotifyTrigger+":"+collection); err != nil {
+		return fmt.Errorf("failed to lock change notify trigger: %w", err)
+	}
+	installed, err = r.changeTriggerInstalled(ctx, tx, collection)
+	if err != nil {
+		return err
+	}
+	if installed {
+		return tx.Commit()
+	}
+
+	functionQuery := fmt.Sprintf(`
+		CREATE OR REPLACE FUNCTION %[1]s() RETURNS trigger AS $$
+		DECLARE
+			rec RECORD;
+		BEGIN
+			IF TG_OP = 'DELETE' THEN
+				rec := OLD;
+			ELSE
+				rec := NEW;
+			END IF;
+			PERFORM pg_notify('%[2]s', json_build_object(
+				'op', lower(TG_OP),
+				'collection', TG_ARGV[0],
+				'id', rec.id,
+				'version', rec.version
+			)::text);
+			RETURN NULL;
+		END;
+		$$ LANGUAGE plpgsql
+	`, changeNotifyFunc, ChangeNotifyChannel)
+	if _, err := tx.ExecContext(ctx, functionQuery); err != nil {
+		return fmt.Errorf("failed to create change notify function: %w", err)
+	}
+
+	// 인자 없이 설치된 이전 트리거를 교체합니다
+	table := pq.QuoteIdentifier(collection)
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP TRIGGER IF EXISTS %s ON %s`, changeNotifyTrigger, table)); err != nil {
+		return fmt.Errorf("failed to drop change notify trigger: %w", err)
+	}
+	triggerQuery := fmt.Sprintf(`
+		CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s
+		FOR EACH ROW EXECUTE FUNCTION %s(%s)
+	`, changeNotifyTrigger, table, changeNotifyFunc, pq.QuoteLiteral(collection))
+	if _, err := tx.ExecContext(ctx, triggerQuery); err != nil {
+		return fmt.Errorf("failed to create change notify trigger: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit change notify trigger: %w", err)
+	}
+	return nil
+}
+
+// changeTriggerInstalled는 컬렉션 테이블에 논리 컬렉션 이름을 인자로 받는 알림 트리거가 있는지 확인합니다
+func (r *PostgreSQLRepository) changeTriggerInstalled(ctx context.Context, q sqltx.Querier, collection string) (bool, error) {
+	var args []byte
+	err := q.QueryRowContext(ctx,
+		`SELECT tgargs FROM pg_trigger WHERE tgrelid = to_regclass($1) AND tgname = $2`,
+		pq.QuoteIdentifier(collection), changeNotifyTrigger,
+	).Scan(&args)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check change notify trigger: %w", err)
+	}
+	// tgargs는 각 인자를 NUL로 끝맺어 이어 붙인 값입니다
+	return string(args) == collection+"\x00", nil
+}
@@ -3,10 +3,14 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/stmtcache"
+	"github.com/lib/pq"
 )
 
 // Config는 PostgreSQL 연결 설정입니다
@@ -23,25 +27,18 @@ type Config struct {
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+
+	// StatementCacheSize는 연결당 prepared statement 캐시 크기입니다 (0이면 기본값, 음수면 캐시하지 않음)
+	// PgBouncer transaction 모드처럼 세션 단위 statement를 지원하지 않는 프록시를 거치면 음수로 설정합니다
+	StatementCacheSize int
+
+	// Credentials가 설정되면 새 연결마다 호출해 User/Password 대신 사용합니다 (Vault 동적 자격증명)
+	Credentials func() (username, password string)
 }
 
 // NewClient는 PostgreSQL 클라이언트를 생성합니다
 func NewClient(ctx context.Context, config *Config) (*sql.DB, error) {
-	// Connection string 생성
-	connStr := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		config.Host,
-		config.Port,
-		config.User,
-		config.Password,
-		config.Database,
-		config.SSLMode,
-	)
-
-	db, err := sql.Open("postgres", connStr)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
-	}
+	db := sql.OpenDB(&connector{config: config})
 
 	// Connection Pool 설정
 	if config.MaxOpenConns > 0 {
@@ -80,6 +77,81 @@ func NewClient(ctx context.Context, config *Config) (*sql.DB, error) {
 	return db, nil
 }
 
+// connector는 연결할 때마다 최신 자격증명으로 DSN을 만드는 driver.Connector입니다
+// 자격증명이 교체되어도 sql.DB를 다시 만들지 않고 새 연결부터 새 자격증명을 사용합니다
+type connector struct {
+	config *Config
+}
+
+// Connect는 새 연결을 생성합니다
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	pgConnector, err := pq.NewConnector(c.config.dsn())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	conn, err := pgConnector.Connect(ctx)
+	if err != nil || c.config.StatementCacheSize < 0 {
+		return conn, err
+	}
+	return stmtcache.Wrap(conn, stmtcache.Options{
+		Size:       c.config.StatementCacheSize,
+		Invalidate: isStaleStatement,
+		Bypass:     isCopyStatement,
+	}), nil
+}
+
+// isCopyStatement는 COPY 문인지 확인합니다
+// pq의 COPY statement는 Close할 때 적재를 마치므로 캐시해서 재사용할 수 없습니다
+func isCopyStatement(query string) bool {
+	return len(query) >= 4 && strings.EqualFold(query[:4], "COPY")
+}
+
+// isStaleStatement는 스키마 변경으로 캐시된 statement를 다시 준비해야 하는 에러인지 확인합니다
+// (예: "cached plan must not change result type", 서버에서 사라진 statement)
+func isStaleStatement(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	switch pqErr.Code {
+	case "0A000", // feature_not_supported
+		"26000": // invalid_sql_statement_name
+		return true
+	}
+	return false
+}
+
+// Driver는 PostgreSQL 드라이버를 반환합니다
+func (c *connector) Driver() driver.Driver {
+	return &pq.Driver{}
+}
+
+// dsn은 현재 자격증명으로 연결 문자열을 생성합니다
+func (config *Config) dsn() string {
+	user, password := config.User, config.Password
+	if config.Credentials != nil {
+		user, password = config.Credentials()
+	}
+
+	return fmt.Sprintf(
+		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		config.Host,
+		config.Port,
+		quoteValue(user),
+		quoteValue(password),
+		config.Database,
+		config.SSLMode,
+	)
+}
+
+// quoteValue는 연결 문자열 값에 공백이나 따옴표가 있으면 작은따옴표로 감쌉니다
+func quoteValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, " '\\") {
+		return v
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+}
+
 // Close는 데이터베이스 연결을 닫습니다
 func Close(db *sql.DB) error {
 	if db != nil {
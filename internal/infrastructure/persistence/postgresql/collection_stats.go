@@ -0,0 +1,65 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/lib/pq"
+)
+
+// CollectionStats는 시스템 카탈로그로 문서 테이블의 통계를 반환합니다
+// 문서 수는 pg_class.reltuples 추정값이며, 아직 ANALYZE되지 않은 테이블만 정확히 셉니다
+// Size는 TOAST를 포함한 테이블 크기, StorageSize는 인덱스까지 포함한 전체 디스크 사용량입니다
+func (r *PostgreSQLRepository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	table := pq.QuoteIdentifier(collection)
+
+	stats := &repository.CollectionStats{Collection: collection}
+	var reltuples float64
+	err := r.conn(ctx).QueryRowContext(ctx, `
+		SELECT c.reltuples, pg_table_size(c.oid), pg_total_relation_size(c.oid),
+		       pg_indexes_size(c.oid), COALESCE(am.amname, '')
+		FROM pg_class c
+		LEFT JOIN pg_am am ON am.oid = c.relam
+		WHERE c.oid = $1::regclass`, table,
+	).Scan(&reltuples, &stats.Size, &stats.StorageSize, &stats.TotalIndexSize, &stats.StorageEngine)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table stats: %w", err)
+	}
+
+	// reltuples는 한 번도 ANALYZE되지 않은 테이블에서 -1 (PostgreSQL 14+) 또는 0
+	stats.Count = int64(reltuples)
+	if reltuples <= 0 {
+		if stats.Count, err = r.Count(ctx, collection, nil); err != nil {
+			return nil, err
+		}
+	}
+	if stats.Count > 0 {
+		stats.AvgDocSize = float64(stats.Size) / float64(stats.Count)
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, `
+		SELECT ic.relname, pg_relation_size(i.indexrelid)
+		FROM pg_index i
+		JOIN pg_class ic ON ic.oid = i.indexrelid
+		WHERE i.indrelid = $1::regclass`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get index sizes: %w", err)
+	}
+	defer rows.Close()
+
+	stats.IndexSizes = make(map[string]int64)
+	for rows.Next() {
+		var name string
+		var size int64
+		if err := rows.Scan(&name, &size); err != nil {
+			return nil, fmt.Errorf("failed to scan index size: %w", err)
+		}
+		stats.IndexSizes[name] = size
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get index sizes: %w", err)
+	}
+	stats.IndexCount = len(stats.IndexSizes)
+	return stats, nil
+}
@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"context"
+	"sort"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// DatabaseStats는 문서 테이블별 통계의 합계와 pg_stat_activity의 연결 수로 데이터베이스 통계를 반환합니다
+// 크기는 문서 테이블만 합산합니다 (시스템 카탈로그와 WAL 제외)
+func (r *PostgreSQLRepository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	collections, err := r.ListCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(collections)
+
+	perCollection := make([]*repository.CollectionStats, 0, len(collections))
+	for _, collection := range collections {
+		stats, err := r.CollectionStats(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		perCollection = append(perCollection, stats)
+	}
+	result := repository.SummarizeCollections(perCollection)
+
+	// 다른 데이터베이스의 연결도 max_connections를 나눠 쓰므로 여유 연결 수는 서버 전체 기준
+	connections := &repository.ConnectionStats{}
+	var serverTotal, maxConnections int64
+	err = r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FILTER (WHERE datname = current_database()),
+		       COUNT(*) FILTER (WHERE datname = current_database() AND state = 'active'),
+		       COUNT(*),
+		       current_setting('max_connections')::BIGINT
+		FROM pg_stat_activity
+		WHERE backend_type = 'client backend'`,
+	).Scan(&connections.Current, &connections.Active, &serverTotal, &maxConnections)
+	if err != nil {
+		logger.Warn(ctx, "failed to get connection stats", zap.Error(err))
+		return result, nil
+	}
+	connections.Available = maxConnections - serverTotal
+	result.Connections = connections
+	return result, nil
+}
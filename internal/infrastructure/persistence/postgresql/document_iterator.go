@@ -0,0 +1,54 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// FindStream은 옵션을 사용하여 문서를 조회하고 결과를 행 단위 커서로 반환합니다
+// 커서를 닫을 때까지 연결 하나를 점유하므로 사용 후 반드시 Close를 호출해야 합니다
+func (r *PostgreSQLRepository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	query, args, err := r.buildFindQuery(collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+
+	return &documentIterator{repo: r, rows: rows, collection: collection}, nil
+}
+
+// documentIterator는 sql.Rows를 DocumentIterator로 감쌉니다
+type documentIterator struct {
+	repo       *PostgreSQLRepository
+	rows       *sql.Rows
+	collection string
+	ctx        context.Context
+}
+
+func (it *documentIterator) Next(ctx context.Context) bool {
+	it.ctx = ctx
+	return it.rows.Next()
+}
+
+func (it *documentIterator) Decode() (*entity.Document, error) {
+	return it.repo.scanDocument(it.ctx, it.rows, it.collection)
+}
+
+func (it *documentIterator) Err() error {
+	if err := it.rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %w", err)
+	}
+	return nil
+}
+
+func (it *documentIterator) Close(ctx context.Context) error {
+	return it.rows.Close()
+}
@@ -6,18 +6,32 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
 	"github.com/lib/pq"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 // PostgreSQLRepository는 PostgreSQL 기반 문서 저장소입니다
 type PostgreSQLRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	cipher *encryption.Cipher // nil이면 data 컬럼을 평문으로 저장합니다
+
+	listenerConfig *Config // 변경 알림(LISTEN) 전용 연결 설정 (WatchChanges에 필요)
+	copyThreshold  int     // SaveMany가 COPY를 사용하는 최소 문서 수 (0이면 기본값, 음수면 사용하지 않음)
+
+	partitions *partitioning // 컬렉션별 선언적 파티션 규칙 (nil이면 일반 테이블)
+
+	migrator *schema.Migrator // 컬렉션 테이블에 적용할 스키마 마이그레이션 (nil이면 적용하지 않음)
 }
 
 // NewPostgreSQLRepository는 PostgreSQL 저장소를 생성합니다
@@ -26,7 +40,15 @@ func NewPostgreSQLRepository(db *sql.DB) repository.DocumentRepository {
 }
 
 // ensureTableExists는 컬렉션(테이블)이 존재하는지 확인하고 없으면 생성합니다
+// 파티션 규칙에 해당하는 컬렉션은 파티션 테이블로 생성하고, 스키마 마이그레이션이 설정되면 남은 버전을 적용합니다
 func (r *PostgreSQLRepository) ensureTableExists(ctx context.Context, collection string) error {
+	if rule, ok := r.partitionRule(collection); ok {
+		if err := r.ensurePartitionedTable(ctx, collection, rule); err != nil {
+			return err
+		}
+		return r.ensureSchema(ctx, collection)
+	}
+
 	query := fmt.Sprintf(`
 		CREATE TABLE IF NOT EXISTS %s (
 			id VARCHAR(255) PRIMARY KEY,
@@ -38,39 +60,59 @@ func (r *PostgreSQLRepository) ensureTableExists(ctx context.Context, collection
 		)
 	`, pq.QuoteIdentifier(collection))
 
-	_, err := r.db.ExecContext(ctx, query)
-	return err
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	if err := r.ensureDataIndex(ctx, collection); err != nil {
+		return err
+	}
+	return r.ensureSchema(ctx, collection)
+}
+
+// ensureDataIndex는 data 컬럼에 jsonb_path_ops GIN 인덱스를 생성합니다
+// buildWhereClause의 @> 포함 조건이 이 인덱스를 사용합니다
+// 암호화가 설정되면 data 컬럼에 암호문만 있으므로 인덱스를 만들지 않습니다
+func (r *PostgreSQLRepository) ensureDataIndex(ctx context.Context, collection string) error {
+	if r.cipher != nil {
+		return nil
+	}
+	query := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (data jsonb_path_ops)`,
+		pq.QuoteIdentifier(collection+"_data_gin"), pq.QuoteIdentifier(collection))
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create data index on %s: %w", collection, err)
+	}
+	return nil
 }
 
 // ===== 기본 CRUD =====
 
 // Save는 문서를 저장합니다
 func (r *PostgreSQLRepository) Save(ctx context.Context, doc *entity.Document) error {
-	if err := r.ensureTableExists(ctx, doc.Collection); err != nil {
+	if err := r.ensureTableExists(ctx, doc.Collection()); err != nil {
 		return fmt.Errorf("failed to ensure table exists: %w", err)
 	}
 
-	dataJSON, err := json.Marshal(doc.Data)
+	dataJSON, err := r.marshalData(doc.Collection(), doc.ID(), doc.Data())
 	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
+		return err
 	}
 
-	metadataJSON, err := json.Marshal(doc.Metadata)
+	metadataJSON, err := marshalMetadata(doc)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	query := fmt.Sprintf(`
 		INSERT INTO %s (id, data, created_at, updated_at, version, metadata)
 		VALUES ($1, $2, $3, $4, $5, $6)
-	`, pq.QuoteIdentifier(doc.Collection))
+	`, pq.QuoteIdentifier(doc.Collection()))
 
-	_, err = r.db.ExecContext(ctx, query,
-		doc.ID,
+	_, err = r.conn(ctx).ExecContext(ctx, query,
+		doc.ID(),
 		dataJSON,
-		doc.CreatedAt,
-		doc.UpdatedAt,
-		doc.Version,
+		doc.CreatedAt(),
+		doc.UpdatedAt(),
+		doc.Version(),
 		metadataJSON,
 	)
 	if err != nil {
@@ -86,17 +128,28 @@ func (r *PostgreSQLRepository) SaveMany(ctx context.Context, docs []*entity.Docu
 		return nil
 	}
 
-	collection := docs[0].Collection
+	collection := docs[0].Collection()
 	if err := r.ensureTableExists(ctx, collection); err != nil {
 		return fmt.Errorf("failed to ensure table exists: %w", err)
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
+	// 대량 적재는 COPY FROM으로 한 번에 전송합니다
+	if r.useCopy(len(docs)) {
+		if err := r.copyMany(ctx, tx.Tx, collection, docs); err != nil {
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return nil
+	}
+
 	query := fmt.Sprintf(`
 		INSERT INTO %s (id, data, created_at, updated_at, version, metadata)
 		VALUES ($1, $2, $3, $4, $5, $6)
@@ -109,17 +162,17 @@ func (r *PostgreSQLRepository) SaveMany(ctx context.Context, docs []*entity.Docu
 	defer stmt.Close()
 
 	for _, doc := range docs {
-		dataJSON, err := json.Marshal(doc.Data)
+		dataJSON, err := r.marshalData(collection, doc.ID(), doc.Data())
 		if err != nil {
-			return fmt.Errorf("failed to marshal data: %w", err)
+			return err
 		}
 
-		metadataJSON, err := json.Marshal(doc.Metadata)
+		metadataJSON, err := marshalMetadata(doc)
 		if err != nil {
-			return fmt.Errorf("failed to marshal metadata: %w", err)
+			return err
 		}
 
-		_, err = stmt.ExecContext(ctx, doc.ID, dataJSON, doc.CreatedAt, doc.UpdatedAt, doc.Version, metadataJSON)
+		_, err = stmt.ExecContext(ctx, doc.ID(), dataJSON, doc.CreatedAt(), doc.UpdatedAt(), doc.Version(), metadataJSON)
 		if err != nil {
 			return fmt.Errorf("failed to insert document: %w", err)
 		}
@@ -140,40 +193,26 @@ func (r *PostgreSQLRepository) FindByID(ctx context.Context, collection, id stri
 		WHERE id = $1
 	`, pq.QuoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	doc, err := r.scanDocument(ctx, r.conn(ctx).QueryRowContext(ctx, query, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	doc.Collection = collection
-
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
-	return &doc, nil
+	return doc, nil
 }
 
 // FindAll은 컬렉션의 모든 문서를 조회합니다
 func (r *PostgreSQLRepository) FindAll(ctx context.Context, collection string, filter map[string]interface{}) ([]*entity.Document, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requireIDFilter(filter, nil); err != nil {
+		return nil, err
+	}
+	whereClause, args, err := r.buildWhereClause(filter)
+	if err != nil {
+		return nil, err
+	}
 
 	query := fmt.Sprintf(`
 		SELECT id, data, created_at, updated_at, version, metadata
@@ -181,18 +220,48 @@ func (r *PostgreSQLRepository) FindAll(ctx context.Context, collection string, f
 		%s
 	`, pq.QuoteIdentifier(collection), whereClause)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query documents: %w", err)
 	}
 	defer rows.Close()
 
-	return r.scanDocuments(rows, collection)
+	return r.scanDocuments(ctx, rows, collection)
 }
 
 // FindWithOptions는 옵션을 사용하여 문서를 조회합니다
 func (r *PostgreSQLRepository) FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) ([]*entity.Document, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	query, args, err := r.buildFindQuery(collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanDocuments(ctx, rows, collection)
+}
+
+// buildFindQuery는 FindWithOptions/FindStream의 SELECT 쿼리를 만듭니다 (opts는 nil 가능)
+func (r *PostgreSQLRepository) buildFindQuery(collection string, filter map[string]interface{}, opts *repository.FindOptions) (string, []interface{}, error) {
+	if opts == nil {
+		opts = &repository.FindOptions{}
+	}
+	if err := r.requireIDFilter(filter, opts.Sort); err != nil {
+		return "", nil, err
+	}
+	whereClause, args, err := r.buildWhereClause(filter)
+	if err != nil {
+		return "", nil, err
+	}
+	orderBy, orderArgs, err := r.buildOrderBy(opts.Sort, len(args)+1)
+	if err != nil {
+		return "", nil, err
+	}
+	args = append(args, orderArgs...)
 
 	query := fmt.Sprintf(`
 		SELECT id, data, created_at, updated_at, version, metadata
@@ -203,44 +272,40 @@ func (r *PostgreSQLRepository) FindWithOptions(ctx context.Context, collection s
 		%s
 	`, pq.QuoteIdentifier(collection),
 		whereClause,
-		r.buildOrderBy(opts.Sort),
+		orderBy,
 		r.buildLimit(opts.Limit),
 		r.buildOffset(opts.Skip),
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query documents: %w", err)
-	}
-	defer rows.Close()
-
-	return r.scanDocuments(rows, collection)
+	return query, args, nil
 }
 
 // Update는 문서를 업데이트합니다
 func (r *PostgreSQLRepository) Update(ctx context.Context, doc *entity.Document) error {
-	dataJSON, err := json.Marshal(doc.Data)
+	dataJSON, err := r.marshalData(doc.Collection(), doc.ID(), doc.Data())
 	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
+		return err
 	}
 
-	metadataJSON, err := json.Marshal(doc.Metadata)
+	metadataJSON, err := marshalMetadata(doc)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
+	// 낙관적 잠금: doc.Update가 올린 버전의 직전 버전인 행만 갱신합니다
 	query := fmt.Sprintf(`
 		UPDATE %s
-		SET data = $1, updated_at = $2, version = version + 1, metadata = $3
-		WHERE id = $4 AND version = $5
-	`, pq.QuoteIdentifier(doc.Collection))
+		SET data = $1, updated_at = $2, version = $3, metadata = $4
+		WHERE id = $5 AND version = $6
+	`, pq.QuoteIdentifier(doc.Collection()))
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.conn(ctx).ExecContext(ctx, query,
 		dataJSON,
-		time.Now(),
+		doc.UpdatedAt(),
+		doc.Version(),
 		metadataJSON,
-		doc.ID,
-		doc.Version,
+		doc.ID(),
+		doc.Version()-1,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update document: %w", err)
@@ -255,36 +320,32 @@ func (r *PostgreSQLRepository) Update(ctx context.Context, doc *entity.Document)
 		return errors.New("optimistic lock error: document was modified by another process")
 	}
 
-	doc.Version++
-	doc.UpdatedAt = time.Now()
-
 	return nil
 }
 
 // UpdateMany는 필터와 일치하는 여러 문서를 업데이트합니다
 func (r *PostgreSQLRepository) UpdateMany(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (int64, error) {
-	whereClause, args := r.buildWhereClause(filter)
-
-	setClauses := []string{}
-	argIndex := len(args) + 1
-
-	for key, value := range update {
-		valueJSON, err := json.Marshal(value)
-		if err != nil {
-			return 0, fmt.Errorf("failed to marshal update value: %w", err)
-		}
-		setClauses = append(setClauses, fmt.Sprintf("data = jsonb_set(data, '{%s}', $%d::jsonb)", key, argIndex))
-		args = append(args, valueJSON)
-		argIndex++
+	// jsonb_set 부분 업데이트는 암호화된 data 컬럼에 적용할 수 없습니다
+	if err := r.requirePlaintext("update many"); err != nil {
+		return 0, err
+	}
+	whereClause, args, err := r.buildWhereClause(filter)
+	if err != nil {
+		return 0, err
+	}
+	dataExpr, setArgs, err := r.buildJSONBSet(update, len(args)+1)
+	if err != nil {
+		return 0, err
 	}
+	args = append(args, setArgs...)
 
 	query := fmt.Sprintf(`
 		UPDATE %s
-		SET %s, updated_at = CURRENT_TIMESTAMP, version = version + 1
+		SET data = %s, updated_at = CURRENT_TIMESTAMP, version = version + 1
 		%s
-	`, pq.QuoteIdentifier(collection), strings.Join(setClauses, ", "), whereClause)
+	`, pq.QuoteIdentifier(collection), dataExpr, whereClause)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("failed to update documents: %w", err)
 	}
@@ -299,14 +360,14 @@ func (r *PostgreSQLRepository) UpdateMany(ctx context.Context, collection string
 
 // Replace는 문서를 교체합니다
 func (r *PostgreSQLRepository) Replace(ctx context.Context, collection, id string, replacement *entity.Document) error {
-	dataJSON, err := json.Marshal(replacement.Data)
+	dataJSON, err := r.marshalData(collection, id, replacement.Data())
 	if err != nil {
-		return fmt.Errorf("failed to marshal data: %w", err)
+		return err
 	}
 
-	metadataJSON, err := json.Marshal(replacement.Metadata)
+	metadataJSON, err := marshalMetadata(replacement)
 	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
+		return err
 	}
 
 	query := fmt.Sprintf(`
@@ -315,7 +376,7 @@ func (r *PostgreSQLRepository) Replace(ctx context.Context, collection, id strin
 		WHERE id = $4
 	`, pq.QuoteIdentifier(collection))
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.conn(ctx).ExecContext(ctx, query,
 		dataJSON,
 		time.Now(),
 		metadataJSON,
@@ -343,7 +404,7 @@ func (r *PostgreSQLRepository) Delete(ctx context.Context, collection, id string
 		DELETE FROM %s WHERE id = $1
 	`, pq.QuoteIdentifier(collection))
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.conn(ctx).ExecContext(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete document: %w", err)
 	}
@@ -362,14 +423,20 @@ func (r *PostgreSQLRepository) Delete(ctx context.Context, collection, id string
 
 // DeleteMany는 필터와 일치하는 여러 문서를 삭제합니다
 func (r *PostgreSQLRepository) DeleteMany(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requireIDFilter(filter, nil); err != nil {
+		return 0, err
+	}
+	whereClause, args, err := r.buildWhereClause(filter)
+	if err != nil {
+		return 0, err
+	}
 
 	query := fmt.Sprintf(`
 		DELETE FROM %s
 		%s
 	`, pq.QuoteIdentifier(collection), whereClause)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.conn(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, fmt.Errorf("failed to delete documents: %w", err)
 	}
@@ -386,7 +453,7 @@ func (r *PostgreSQLRepository) DeleteMany(ctx context.Context, collection string
 
 // FindAndUpdate는 문서를 찾아서 업데이트하고 업데이트된 문서를 반환합니다
 func (r *PostgreSQLRepository) FindAndUpdate(ctx context.Context, collection, id string, update map[string]interface{}) (*entity.Document, error) {
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -400,49 +467,35 @@ func (r *PostgreSQLRepository) FindAndUpdate(ctx context.Context, collection, id
 		FOR UPDATE
 	`, pq.QuoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err = tx.QueryRowContext(ctx, query, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	doc, err := r.scanDocument(ctx, tx.QueryRowContext(ctx, query, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to query document: %w", err)
 	}
 
-	doc.Collection = collection
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
 	// Apply updates
+	data := doc.Data()
 	for key, value := range update {
-		doc.Data[key] = value
+		data[key] = value
+	}
+	if err := doc.Update(data); err != nil {
+		return nil, err
 	}
 
-	updatedDataJSON, err := json.Marshal(doc.Data)
+	updatedDataJSON, err := r.marshalData(collection, id, data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal updated data: %w", err)
+		return nil, err
 	}
 
 	updateQuery := fmt.Sprintf(`
 		UPDATE %s
-		SET data = $1, updated_at = $2, version = version + 1
-		WHERE id = $3
+		SET data = $1, updated_at = $2, version = $3
+		WHERE id = $4
 	`, pq.QuoteIdentifier(collection))
 
-	_, err = tx.ExecContext(ctx, updateQuery, updatedDataJSON, time.Now(), id)
+	_, err = tx.ExecContext(ctx, updateQuery, updatedDataJSON, doc.UpdatedAt(), doc.Version(), id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update document: %w", err)
 	}
@@ -451,28 +504,25 @@ func (r *PostgreSQLRepository) FindAndUpdate(ctx context.Context, collection, id
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	doc.Version++
-	doc.UpdatedAt = time.Now()
-
-	return &doc, nil
+	return doc, nil
 }
 
 // FindOneAndReplace는 문서를 찾아서 교체하고 교체된 문서를 반환합니다
 func (r *PostgreSQLRepository) FindOneAndReplace(ctx context.Context, collection, id string, replacement *entity.Document) (*entity.Document, error) {
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
-	replacementDataJSON, err := json.Marshal(replacement.Data)
+	replacementDataJSON, err := r.marshalData(collection, id, replacement.Data())
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal replacement data: %w", err)
+		return nil, err
 	}
 
-	replacementMetadataJSON, err := json.Marshal(replacement.Metadata)
+	replacementMetadataJSON, err := marshalMetadata(replacement)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal replacement metadata: %w", err)
+		return nil, err
 	}
 
 	query := fmt.Sprintf(`
@@ -482,37 +532,20 @@ func (r *PostgreSQLRepository) FindOneAndReplace(ctx context.Context, collection
 		RETURNING id, data, created_at, updated_at, version, metadata
 	`, pq.QuoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err = tx.QueryRowContext(ctx, query, replacementDataJSON, time.Now(), replacementMetadataJSON, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	row := tx.QueryRowContext(ctx, query, replacementDataJSON, time.Now(), replacementMetadataJSON, id)
+	doc, err := r.scanDocument(ctx, row, collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to replace document: %w", err)
 	}
 
-	doc.Collection = collection
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return &doc, nil
+	return doc, nil
 }
 
 // FindOneAndDelete는 문서를 찾아서 삭제하고 삭제된 문서를 반환합니다
@@ -523,33 +556,15 @@ func (r *PostgreSQLRepository) FindOneAndDelete(ctx context.Context, collection,
 		RETURNING id, data, created_at, updated_at, version, metadata
 	`, pq.QuoteIdentifier(collection))
 
-	var doc entity.Document
-	var dataJSON, metadataJSON []byte
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&doc.ID,
-		&dataJSON,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-		&doc.Version,
-		&metadataJSON,
-	)
-	if err == sql.ErrNoRows {
+	doc, err := r.scanDocument(ctx, r.conn(ctx).QueryRowContext(ctx, query, id), collection)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.New("document not found")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete document: %w", err)
 	}
 
-	doc.Collection = collection
-	if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-	}
-	if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-	}
-
-	return &doc, nil
+	return doc, nil
 }
 
 // Upsert는 문서가 없으면 생성하고 있으면 업데이트합니다
@@ -568,9 +583,12 @@ func (r *PostgreSQLRepository) Upsert(ctx context.Context, collection string, fi
 		}
 	}
 
-	updateDataJSON, err := json.Marshal(update)
+	updateDataJSON, err := r.marshalData(collection, id, update)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal update data: %w", err)
+		return "", err
+	}
+	if r.isRangePartitioned(collection) {
+		return r.upsertRangePartitioned(ctx, collection, id, updateDataJSON)
 	}
 
 	query := fmt.Sprintf(`
@@ -583,7 +601,7 @@ func (r *PostgreSQLRepository) Upsert(ctx context.Context, collection string, fi
 
 	now := time.Now()
 	var resultID string
-	err = r.db.QueryRowContext(ctx, query, id, updateDataJSON, now, now).Scan(&resultID)
+	err = r.conn(ctx).QueryRowContext(ctx, query, id, updateDataJSON, now, now).Scan(&resultID)
 	if err != nil {
 		return "", fmt.Errorf("failed to upsert document: %w", err)
 	}
@@ -593,9 +611,12 @@ func (r *PostgreSQLRepository) Upsert(ctx context.Context, collection string, fi
 
 // ===== Helper methods =====
 
-func (r *PostgreSQLRepository) buildWhereClause(filter map[string]interface{}) (string, []interface{}) {
+// buildWhereClause는 필터를 WHERE 절로 변환합니다
+// 필드 경로는 검증 후 text[] 바인드 파라미터로, 값은 jsonb 바인드 파라미터로 전달하므로
+// 필터 키가 SQL 문자열에 직접 들어가지 않습니다
+func (r *PostgreSQLRepository) buildWhereClause(filter map[string]interface{}) (string, []interface{}, error) {
 	if len(filter) == 0 {
-		return "", nil
+		return "", nil, nil
 	}
 
 	conditions := []string{}
@@ -603,40 +624,127 @@ func (r *PostgreSQLRepository) buildWhereClause(filter map[string]interface{}) (
 	argIndex := 1
 
 	for key, value := range filter {
-		if key == "_id" || key == "id" {
+		if sqljson.IsIDField(key) {
 			conditions = append(conditions, fmt.Sprintf("id = $%d", argIndex))
 			args = append(args, value)
-		} else {
-			// JSONB 필드 검색
-			valueJSON, _ := json.Marshal(value)
-			conditions = append(conditions, fmt.Sprintf("data->>'%s' = $%d", key, argIndex))
-			args = append(args, string(valueJSON))
+			argIndex++
+			continue
 		}
-		argIndex++
+		if column, ok := timestampColumn(key); ok {
+			timeConditions, timeArgs, err := buildTimeConditions(column, value, argIndex)
+			if err != nil {
+				return "", nil, err
+			}
+			conditions = append(conditions, timeConditions...)
+			args = append(args, timeArgs...)
+			argIndex += len(timeArgs)
+			continue
+		}
+
+		// JSONB 필드 검색
+		path, err := sqljson.Parse(key)
+		if err != nil {
+			return "", nil, err
+		}
+		if containment, ok := containmentFilter(path, value); ok {
+			// 스칼라 값 비교는 GIN 인덱스를 사용할 수 있도록 @> 포함 조건으로 만듭니다
+			containmentJSON, err := json.Marshal(containment)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to marshal filter value: %w", err)
+			}
+			conditions = append(conditions, fmt.Sprintf("data @> $%d::jsonb", argIndex))
+			args = append(args, string(containmentJSON))
+			argIndex++
+			continue
+		}
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal filter value: %w", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("data #> $%d::text[] = $%d::jsonb", argIndex, argIndex+1))
+		args = append(args, path.Postgres(), string(valueJSON))
+		argIndex += 2
 	}
 
-	return "WHERE " + strings.Join(conditions, " AND "), args
+	return "WHERE " + strings.Join(conditions, " AND "), args, nil
 }
 
-func (r *PostgreSQLRepository) buildOrderBy(sort map[string]int) string {
+// containmentFilter는 경로와 스칼라 값을 @> 포함 조건 문서로 만듭니다 (예: a.b = 1 -> {"a":{"b":1}})
+// 객체/배열 값은 포함 관계가 일치 비교와 다르고, 숫자 세그먼트는 배열 인덱스이므로 false를 반환합니다
+// 필드가 배열이면 값이 배열 원소인 경우에도 일치합니다 (MongoDB의 배열 필드 일치와 같음)
+func containmentFilter(path sqljson.Path, value interface{}) (map[string]interface{}, bool) {
+	switch value.(type) {
+	case nil, string, bool, float64, float32, int, int32, int64, uint, uint32, uint64:
+	default:
+		return nil, false
+	}
+	for _, segment := range path {
+		if _, err := strconv.Atoi(segment); err == nil {
+			return nil, false
+		}
+	}
+
+	var node interface{} = value
+	for i := len(path) - 1; i >= 0; i-- {
+		node = map[string]interface{}{path[i]: node}
+	}
+	return node.(map[string]interface{}), true
+}
+
+// buildOrderBy는 정렬 조건을 ORDER BY 절로 변환합니다
+// argIndex는 정렬 경로 바인드 파라미터의 시작 번호입니다
+func (r *PostgreSQLRepository) buildOrderBy(sort map[string]int, argIndex int) (string, []interface{}, error) {
 	if len(sort) == 0 {
-		return ""
+		return "", nil, nil
 	}
 
 	orders := []string{}
+	args := []interface{}{}
 	for key, direction := range sort {
 		dir := "ASC"
 		if direction == -1 {
 			dir = "DESC"
 		}
-		if key == "_id" || key == "id" {
+		if sqljson.IsIDField(key) {
 			orders = append(orders, fmt.Sprintf("id %s", dir))
-		} else {
-			orders = append(orders, fmt.Sprintf("data->>'%s' %s", key, dir))
+			continue
+		}
+		if column, ok := timestampColumn(key); ok {
+			orders = append(orders, fmt.Sprintf("%s %s", column, dir))
+			continue
+		}
+
+		path, err := sqljson.Parse(key)
+		if err != nil {
+			return "", nil, err
 		}
+		orders = append(orders, fmt.Sprintf("data #> $%d::text[] %s", argIndex, dir))
+		args = append(args, path.Postgres())
+		argIndex++
 	}
 
-	return "ORDER BY " + strings.Join(orders, ", ")
+	return "ORDER BY " + strings.Join(orders, ", "), args, nil
+}
+
+// buildJSONBSet은 업데이트 필드를 중첩 jsonb_set 표현식으로 변환합니다
+// 같은 컬럼에 여러 번 대입할 수 없으므로 data = jsonb_set(jsonb_set(data, ...), ...) 형태로 만듭니다
+func (r *PostgreSQLRepository) buildJSONBSet(update map[string]interface{}, argIndex int) (string, []interface{}, error) {
+	expr := "data"
+	args := []interface{}{}
+	for key, value := range update {
+		path, err := sqljson.Parse(key)
+		if err != nil {
+			return "", nil, err
+		}
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal update value: %w", err)
+		}
+		expr = fmt.Sprintf("jsonb_set(%s, $%d::text[], $%d::jsonb, true)", expr, argIndex, argIndex+1)
+		args = append(args, path.Postgres(), string(valueJSON))
+		argIndex += 2
+	}
+	return expr, args, nil
 }
 
 func (r *PostgreSQLRepository) buildLimit(limit int64) string {
@@ -653,43 +761,63 @@ func (r *PostgreSQLRepository) buildOffset(offset int64) string {
 	return fmt.Sprintf("OFFSET %d", offset)
 }
 
-func (r *PostgreSQLRepository) scanDocuments(rows *sql.Rows, collection string) ([]*entity.Document, error) {
+func (r *PostgreSQLRepository) scanDocuments(ctx context.Context, rows *sql.Rows, collection string) ([]*entity.Document, error) {
 	documents := []*entity.Document{}
 
 	for rows.Next() {
-		var doc entity.Document
-		var dataJSON, metadataJSON []byte
-
-		err := rows.Scan(
-			&doc.ID,
-			&dataJSON,
-			&doc.CreatedAt,
-			&doc.UpdatedAt,
-			&doc.Version,
-			&metadataJSON,
-		)
+		doc, err := r.scanDocument(ctx, rows, collection)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan row: %w", err)
+			return nil, err
 		}
+		documents = append(documents, doc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
 
-		doc.Collection = collection
+	return documents, nil
+}
 
-		if err := json.Unmarshal(dataJSON, &doc.Data); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal data: %w", err)
-		}
+// scanDocument는 (id, data, created_at, updated_at, version, metadata) 행을 문서로 변환합니다
+// 행이 없으면 sql.ErrNoRows를 감싼 에러를 반환합니다
+func (r *PostgreSQLRepository) scanDocument(ctx context.Context, row rowScanner, collection string) (*entity.Document, error) {
+	var (
+		id                     string
+		dataJSON, metadataJSON []byte
+		createdAt, updatedAt   time.Time
+		version                int
+	)
+	if err := row.Scan(&id, &dataJSON, &createdAt, &updatedAt, &version, &metadataJSON); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
 
-		if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
+	var data map[string]interface{}
+	if err := r.unmarshalData(ctx, collection, id, dataJSON, &data); err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]interface{}
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &metadata); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
 		}
-
-		documents = append(documents, &doc)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration error: %w", err)
+	doc := entity.ReconstructDocument(id, collection, data, version, createdAt, updatedAt)
+	if expiresAt, ok := entity.ParseExpiresAt(metadata); ok {
+		doc.SetExpiresAt(expiresAt)
 	}
+	return doc, nil
+}
 
-	return documents, nil
+// marshalMetadata는 문서의 metadata 컬럼 값을 직렬화합니다 (만료 시각)
+func marshalMetadata(doc *entity.Document) ([]byte, error) {
+	metadataJSON, err := json.Marshal(entity.ExpiryMetadata(doc.ExpiresAt()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	return metadataJSON, nil
 }
 
 // ===== 집계 (Aggregation) =====
@@ -703,15 +831,26 @@ func (r *PostgreSQLRepository) Aggregate(ctx context.Context, collection string,
 
 // Distinct는 고유한 값을 조회합니다
 func (r *PostgreSQLRepository) Distinct(ctx context.Context, collection, field string, filter map[string]interface{}) ([]interface{}, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requirePlaintext("distinct"); err != nil {
+		return nil, err
+	}
+	whereClause, args, err := r.buildWhereClause(filter)
+	if err != nil {
+		return nil, err
+	}
+	path, err := sqljson.Parse(field)
+	if err != nil {
+		return nil, err
+	}
 
 	query := fmt.Sprintf(`
-		SELECT DISTINCT data->>'%s' as value
+		SELECT DISTINCT data #>> $%d::text[] as value
 		FROM %s
 		%s
-	`, field, pq.QuoteIdentifier(collection), whereClause)
+	`, len(args)+1, pq.QuoteIdentifier(collection), whereClause)
+	args = append(args, path.Postgres())
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query distinct values: %w", err)
 	}
@@ -731,14 +870,20 @@ func (r *PostgreSQLRepository) Distinct(ctx context.Context, collection, field s
 
 // Count는 문서 개수를 반환합니다
 func (r *PostgreSQLRepository) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
-	whereClause, args := r.buildWhereClause(filter)
+	if err := r.requireIDFilter(filter, nil); err != nil {
+		return 0, err
+	}
+	whereClause, args, err := r.buildWhereClause(filter)
+	if err != nil {
+		return 0, err
+	}
 
 	query := fmt.Sprintf(`
 		SELECT COUNT(*) FROM %s %s
 	`, pq.QuoteIdentifier(collection), whereClause)
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	err = r.conn(ctx).QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count documents: %w", err)
 	}
@@ -756,7 +901,7 @@ func (r *PostgreSQLRepository) EstimatedDocumentCount(ctx context.Context, colle
 	`
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, collection).Scan(&count)
+	err := r.conn(ctx).QueryRowContext(ctx, query, collection).Scan(&count)
 	if err != nil {
 		// 테이블이 없거나 통계가 없으면 정확한 카운트 반환
 		return r.Count(ctx, collection, nil)
@@ -773,7 +918,7 @@ func (r *PostgreSQLRepository) BulkWrite(ctx context.Context, operations []*repo
 		return &repository.BulkResult{}, nil
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -790,14 +935,14 @@ func (r *PostgreSQLRepository) BulkWrite(ctx context.Context, operations []*repo
 				return nil, fmt.Errorf("failed to ensure table exists: %w", err)
 			}
 
-			dataJSON, err := json.Marshal(op.Document.Data)
+			dataJSON, err := r.marshalData(op.Collection, op.Document.ID(), op.Document.Data())
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal data: %w", err)
+				return nil, err
 			}
 
-			metadataJSON, err := json.Marshal(op.Document.Metadata)
+			metadataJSON, err := marshalMetadata(op.Document)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+				return nil, err
 			}
 
 			query := fmt.Sprintf(`
@@ -806,11 +951,11 @@ func (r *PostgreSQLRepository) BulkWrite(ctx context.Context, operations []*repo
 			`, pq.QuoteIdentifier(op.Collection))
 
 			_, err = tx.ExecContext(ctx, query,
-				op.Document.ID,
+				op.Document.ID(),
 				dataJSON,
-				op.Document.CreatedAt,
-				op.Document.UpdatedAt,
-				op.Document.Version,
+				op.Document.CreatedAt(),
+				op.Document.UpdatedAt(),
+				op.Document.Version(),
 				metadataJSON,
 			)
 			if err != nil {
@@ -820,26 +965,24 @@ func (r *PostgreSQLRepository) BulkWrite(ctx context.Context, operations []*repo
 			result.InsertedCount++
 
 		case "update":
-			whereClause, args := r.buildWhereClause(op.Filter)
-
-			setClauses := []string{}
-			argIndex := len(args) + 1
-
-			for key, value := range op.Update {
-				valueJSON, err := json.Marshal(value)
-				if err != nil {
-					return nil, fmt.Errorf("failed to marshal update value: %w", err)
-				}
-				setClauses = append(setClauses, fmt.Sprintf("data = jsonb_set(data, '{%s}', $%d::jsonb)", key, argIndex))
-				args = append(args, valueJSON)
-				argIndex++
+			if err := r.requirePlaintext("bulk update"); err != nil {
+				return nil, err
 			}
+			whereClause, args, err := r.buildWhereClause(op.Filter)
+			if err != nil {
+				return nil, err
+			}
+			dataExpr, setArgs, err := r.buildJSONBSet(op.Update, len(args)+1)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, setArgs...)
 
 			query := fmt.Sprintf(`
 				UPDATE %s
-				SET %s, updated_at = CURRENT_TIMESTAMP, version = version + 1
+				SET data = %s, updated_at = CURRENT_TIMESTAMP, version = version + 1
 				%s
-			`, pq.QuoteIdentifier(op.Collection), strings.Join(setClauses, ", "), whereClause)
+			`, pq.QuoteIdentifier(op.Collection), dataExpr, whereClause)
 
 			res, err := tx.ExecContext(ctx, query, args...)
 			if err != nil {
@@ -851,7 +994,13 @@ func (r *PostgreSQLRepository) BulkWrite(ctx context.Context, operations []*repo
 			result.ModifiedCount += affected
 
 		case "delete":
-			whereClause, args := r.buildWhereClause(op.Filter)
+			if err := r.requireIDFilter(op.Filter, nil); err != nil {
+				return nil, err
+			}
+			whereClause, args, err := r.buildWhereClause(op.Filter)
+			if err != nil {
+				return nil, err
+			}
 
 			query := fmt.Sprintf(`
 				DELETE FROM %s %s
@@ -870,14 +1019,14 @@ func (r *PostgreSQLRepository) BulkWrite(ctx context.Context, operations []*repo
 				return nil, errors.New("replace operation requires a document")
 			}
 
-			dataJSON, err := json.Marshal(op.Document.Data)
+			dataJSON, err := r.marshalData(op.Collection, op.ReplaceOneID, op.Document.Data())
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal data: %w", err)
+				return nil, err
 			}
 
-			metadataJSON, err := json.Marshal(op.Document.Metadata)
+			metadataJSON, err := marshalMetadata(op.Document)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+				return nil, err
 			}
 
 			query := fmt.Sprintf(`
@@ -923,10 +1072,18 @@ func (r *PostgreSQLRepository) CreateIndex(ctx context.Context, collection strin
 	// JSONB 필드에 대한 인덱스 생성
 	indexKeys := []string{}
 	for key := range model.Keys {
-		if key == "_id" || key == "id" {
+		if sqljson.IsIDField(key) {
 			indexKeys = append(indexKeys, "id")
 		} else {
-			indexKeys = append(indexKeys, fmt.Sprintf("(data->>'%s')", key))
+			if err := r.requirePlaintext("index on data field"); err != nil {
+				return "", err
+			}
+			// DDL은 바인드 파라미터를 쓸 수 없으므로 검증된 경로만 리터럴로 넣습니다
+			path, err := sqljson.Parse(key)
+			if err != nil {
+				return "", err
+			}
+			indexKeys = append(indexKeys, fmt.Sprintf("(data #>> '%s')", path.Postgres()))
 		}
 	}
 
@@ -1083,31 +1240,23 @@ func (r *PostgreSQLRepository) CollectionExists(ctx context.Context, name string
 // ===== Change Streams =====
 
 // Watch는 컬렉션의 변경 사항을 실시간으로 감지합니다
+// *mongo.ChangeStream은 MongoDB 전용이므로, PostgreSQL은 LISTEN/NOTIFY 기반 WatchChanges(repository.ChangeWatcher)를 사용합니다
 func (r *PostgreSQLRepository) Watch(ctx context.Context, collection string, pipeline []bson.M) (*mongo.ChangeStream, error) {
-	// PostgreSQL에서는 LISTEN/NOTIFY를 사용할 수 있지만
-	// MongoDB의 ChangeStream과 완전히 호환되지 않습니다
-	return nil, errors.New("watch is not supported in PostgreSQL implementation")
+	return nil, errors.New("mongo change streams are not available in PostgreSQL, use WatchChanges")
 }
 
 // ===== 트랜잭션 (Transaction) =====
 
 // WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-func (r *PostgreSQLRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	if err := fn(ctx); err != nil {
-		return err
-	}
-
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+// 트랜잭션은 fn에 전달되는 ctx에 담기므로, 그 ctx로 호출한 문서 작업(조회, 쓰기, raw query)은 같은 트랜잭션에서 실행됩니다
+// 인덱스, 컬렉션 관리 같은 DDL은 트랜잭션 밖에서 실행됩니다
+func (r *PostgreSQLRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
+	return sqltx.Run(ctx, r.db, opts, fn)
+}
 
-	return nil
+// conn은 ctx에 WithTransaction의 트랜잭션이 있으면 그 트랜잭션을, 없으면 연결 풀을 반환합니다
+func (r *PostgreSQLRepository) conn(ctx context.Context) sqltx.Querier {
+	return sqltx.Conn(ctx, r.db)
 }
 
 // ===== Raw Query Execution =====
@@ -1119,7 +1268,7 @@ func (r *PostgreSQLRepository) ExecuteRawQuery(ctx context.Context, query interf
 		return nil, errors.New("query must be a string for PostgreSQL")
 	}
 
-	rows, err := r.db.QueryContext(ctx, sqlQuery)
+	rows, err := r.conn(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute raw query: %w", err)
 	}
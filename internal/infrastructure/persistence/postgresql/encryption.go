@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+)
+
+// NewEncryptedPostgreSQLRepository는 data 컬럼을 AES-GCM 봉투 암호화로 저장하는 저장소를 생성합니다
+// 디스크 암호화가 없는 배포 환경을 위한 것이며, 암호화 도입 전에 저장된 평문 행도 그대로 읽을 수 있습니다
+// 데이터 필드에 대한 서버 측 JSONB 연산(필터, 정렬, 부분 업데이트, Distinct, 인덱스)은 지원하지 않습니다
+func NewEncryptedPostgreSQLRepository(db *sql.DB, cipher *encryption.Cipher) repository.DocumentRepository {
+	return &PostgreSQLRepository{db: db, cipher: cipher}
+}
+
+// marshalData는 문서 데이터를 JSON으로 직렬화하고, 암호화가 설정되어 있으면 암호화합니다
+func (r *PostgreSQLRepository) marshalData(collection, id string, data map[string]interface{}) ([]byte, error) {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+	if r.cipher == nil {
+		return dataJSON, nil
+	}
+
+	encrypted, err := r.cipher.Encrypt(dataJSON, encryption.RowAAD(collection, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data: %w", err)
+	}
+	return encrypted, nil
+}
+
+// unmarshalData는 data 컬럼 값을 복호화(필요한 경우)하고 역직렬화합니다
+func (r *PostgreSQLRepository) unmarshalData(ctx context.Context, collection, id string, raw []byte, dst *map[string]interface{}) error {
+	if r.cipher != nil {
+		decrypted, err := r.cipher.Decrypt(ctx, raw, encryption.RowAAD(collection, id))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt data: %w", err)
+		}
+		raw = decrypted
+	}
+
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	return nil
+}
+
+// requirePlaintext는 암호화가 설정된 경우 데이터 필드에 대한 서버 측 연산을 거부합니다
+func (r *PostgreSQLRepository) requirePlaintext(operation string) error {
+	if r.cipher != nil {
+		return fmt.Errorf("%s: %w", operation, encryption.ErrUnsupportedOnEncryptedData)
+	}
+	return nil
+}
+
+// requireIDFilter는 암호화가 설정된 경우 id와 시각 컬럼 이외의 필드로 필터링/정렬하는 요청을 거부합니다
+func (r *PostgreSQLRepository) requireIDFilter(filter map[string]interface{}, sort map[string]int) error {
+	if r.cipher == nil {
+		return nil
+	}
+	for key := range filter {
+		if _, ok := timestampColumn(key); ok {
+			continue
+		}
+		if key != "_id" && key != "id" {
+			return fmt.Errorf("filter on data field %q: %w", key, encryption.ErrUnsupportedOnEncryptedData)
+		}
+	}
+	for key := range sort {
+		if _, ok := timestampColumn(key); ok {
+			continue
+		}
+		if key != "_id" && key != "id" {
+			return fmt.Errorf("sort on data field %q: %w", key, encryption.ErrUnsupportedOnEncryptedData)
+		}
+	}
+	return nil
+}
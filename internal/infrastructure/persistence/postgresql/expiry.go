@@ -0,0 +1,45 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/lib/pq"
+)
+
+// EnableExpiry는 metadata의 expires_at에 부분 표현식 인덱스를 생성합니다
+// PostgreSQL은 만료 문서를 스스로 삭제하지 않으므로 false를 반환하며, DeleteExpired로 주기적으로 정리해야 합니다
+func (r *PostgreSQLRepository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	if err := r.ensureTableExists(ctx, collection); err != nil {
+		return false, fmt.Errorf("failed to ensure table exists: %w", err)
+	}
+
+	query := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s ((metadata->>'%s')) WHERE metadata ? '%s'`,
+		pq.QuoteIdentifier(collection+"_expires_at"), pq.QuoteIdentifier(collection),
+		entity.ExpiresAtField, entity.ExpiresAtField)
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return false, fmt.Errorf("failed to create expiry index on %s: %w", collection, err)
+	}
+	return false, nil
+}
+
+// DeleteExpired는 before 시점까지 만료된 문서를 삭제합니다
+// 만료 시각은 고정 길이 UTC 문자열(entity.ExpiresAtLayout)이므로 문자열 비교로 인덱스를 사용합니다
+func (r *PostgreSQLRepository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	condition := fmt.Sprintf(`metadata->>'%s' <= $1`, entity.ExpiresAtField)
+	var query string
+	if limit > 0 {
+		query = fmt.Sprintf(`DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s LIMIT %[3]d)`,
+			pq.QuoteIdentifier(collection), condition, limit)
+	} else {
+		query = fmt.Sprintf(`DELETE FROM %s WHERE %s`, pq.QuoteIdentifier(collection), condition)
+	}
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, entity.FormatExpiresAt(before))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired documents: %w", err)
+	}
+	return result.RowsAffected()
+}
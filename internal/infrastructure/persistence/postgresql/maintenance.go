@@ -0,0 +1,63 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/lib/pq"
+)
+
+// MaintenanceOperations는 PostgreSQL이 지원하는 유지보수 작업을 반환합니다
+func (r *PostgreSQLRepository) MaintenanceOperations() []string {
+	return []string{"vacuum", "analyze", "reindex"}
+}
+
+// RunMaintenance는 문서 테이블에 VACUUM (ANALYZE), ANALYZE 또는 인덱스 재생성(reindex)을 실행하고 전후 테이블 크기를 반환합니다
+// VACUUM과 REINDEX CONCURRENTLY는 트랜잭션 안에서 실행할 수 없으므로 요청 트랜잭션과 관계없이 커넥션 풀에서 실행합니다
+func (r *PostgreSQLRepository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	table := pq.QuoteIdentifier(collection)
+
+	var statement string
+	switch operation {
+	case "vacuum":
+		if opts.Full {
+			statement = fmt.Sprintf("VACUUM (FULL, ANALYZE) %s", table)
+		} else {
+			statement = fmt.Sprintf("VACUUM (ANALYZE) %s", table)
+		}
+	case "analyze":
+		statement = fmt.Sprintf("ANALYZE %s", table)
+	case "reindex":
+		return r.reindex(ctx, collection, opts)
+	default:
+		return nil, fmt.Errorf("%w: %s on postgresql", repository.ErrUnsupportedMaintenance, operation)
+	}
+
+	before, err := r.tableSize(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.db.ExecContext(ctx, statement); err != nil {
+		return nil, fmt.Errorf("failed to %s table %s: %w", operation, collection, err)
+	}
+	after, err := r.tableSize(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"size_bytes_before": before,
+		"size_bytes_after":  after,
+	}, nil
+}
+
+// tableSize는 인덱스와 TOAST를 포함한 테이블 크기를 반환합니다
+func (r *PostgreSQLRepository) tableSize(ctx context.Context, table string) (int64, error) {
+	var size int64
+	err := r.db.QueryRowContext(ctx, `SELECT pg_total_relation_size($1::regclass)`, table).Scan(&size)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get table size: %w", err)
+	}
+	return size, nil
+}
@@ -0,0 +1,10 @@
+-- 문서 테이블 기본 형태 (저장소가 컬렉션을 처음 쓸 때 만드는 테이블과 같으므로 기존 테이블에는 기록만 남김)
+-- 이후 컬럼 변경은 이 파일을 고치지 않고 다음 버전 파일로 추가합니다
+CREATE TABLE IF NOT EXISTS {{.Table}} (
+    id VARCHAR(255) PRIMARY KEY,
+    data JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    metadata JSONB DEFAULT '{}'
+);
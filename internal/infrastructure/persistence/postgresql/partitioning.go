@@ -0,0 +1,390 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// PartitionStrategy는 컬렉션 테이블의 선언적 파티션 방식입니다
+type PartitionStrategy string
+
+const (
+	// PartitionByRange는 created_at 범위(일/주/월)로 파티션을 나눕니다
+	// 시간 범위 조회(_created_at 필터)는 해당 기간의 파티션만 읽습니다
+	PartitionByRange PartitionStrategy = "range"
+
+	// PartitionByHash는 id 해시로 고정 개수의 파티션에 고르게 나눕니다
+	PartitionByHash PartitionStrategy = "hash"
+)
+
+const (
+	defaultPartitionInterval = "month"
+	defaultPartitionPremake  = 3
+)
+
+// PartitionRule은 컬렉션별 파티션 설정입니다
+type PartitionRule struct {
+	Collection string            // 컬렉션 이름 또는 path.Match 패턴 (예: events_*)
+	Strategy   PartitionStrategy // range, hash
+	Interval   string            // range: day, week, month (비어 있으면 month)
+	Premake    int               // range: 현재 기간 이후 미리 만들어 둘 파티션 수 (0이면 3)
+	Modulus    int               // hash: 파티션 수
+}
+
+// partitioning은 파티션 규칙과 이미 파티션 테이블을 준비한 컬렉션을 관리합니다
+type partitioning struct {
+	rules   []PartitionRule
+	ensured sync.Map // collection -> struct{}
+}
+
+// SetPartitioning은 컬렉션을 만들 때 적용할 파티션 규칙을 지정합니다
+// 이미 만들어진 일반 테이블은 바뀌지 않으며, 규칙은 이후 새로 만드는 컬렉션에만 적용됩니다
+func (r *PostgreSQLRepository) SetPartitioning(rules []PartitionRule) error {
+	normalized := make([]PartitionRule, 0, len(rules))
+	for _, rule := range rules {
+		if _, err := path.Match(rule.Collection, ""); err != nil || rule.Collection == "" {
+			return fmt.Errorf("invalid partition collection pattern %q", rule.Collection)
+		}
+		switch rule.Strategy {
+		case PartitionByRange:
+			if rule.Interval == "" {
+				rule.Interval = defaultPartitionInterval
+			}
+			if _, err := periodStart(time.Now(), rule.Interval); err != nil {
+				return err
+			}
+			if rule.Premake <= 0 {
+				rule.Premake = defaultPartitionPremake
+			}
+		case PartitionByHash:
+			if rule.Modulus < 2 {
+				return fmt.Errorf("hash partitioning of %q requires modulus of at least 2", rule.Collection)
+			}
+		default:
+			return fmt.Errorf("unsupported partition strategy %q", rule.Strategy)
+		}
+		normalized = append(normalized, rule)
+	}
+	r.partitions = &partitioning{rules: normalized}
+	return nil
+}
+
+// partitionRule은 컬렉션에 적용되는 첫 번째 파티션 규칙을 반환합니다
+func (r *PostgreSQLRepository) partitionRule(collection string) (PartitionRule, bool) {
+	if r.partitions == nil {
+		return PartitionRule{}, false
+	}
+	for _, rule := range r.partitions.rules {
+		if ok, _ := path.Match(rule.Collection, collection); ok {
+			return rule, true
+		}
+	}
+	return PartitionRule{}, false
+}
+
+// isRangePartitioned는 컬렉션이 created_at 범위 파티션 규칙을 따르는지 확인합니다
+// 범위 파티션 테이블의 기본 키는 (id, created_at)이라 ON CONFLICT (id)를 사용할 수 없습니다
+func (r *PostgreSQLRepository) isRangePartitioned(collection string) bool {
+	rule, ok := r.partitionRule(collection)
+	return ok && rule.Strategy == PartitionByRange
+}
+
+// upsertRangePartitioned는 범위 파티션 컬렉션의 upsert입니다
+// (id, created_at) 기본 키에는 ON CONFLICT (id)를 쓸 수 없으므로 트랜잭션 안에서 UPDATE 후 없으면 INSERT합니다
+// 같은 id의 동시 upsert가 서로 다른 파티션에 중복 삽입하지 않도록 id 단위 advisory lock을 잡습니다
+func (r *PostgreSQLRepository) upsertRangePartitioned(ctx context.Context, collection, id string, dataJSON []byte) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, collection+"/"+id); err != nil {
+		return "", fmt.Errorf("failed to lock document: %w", err)
+	}
+
+	table := pq.QuoteIdentifier(collection)
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET data = $2, updated_at = $3, version = version + 1
+		WHERE id = $1
+	`, table), id, dataJSON, now)
+	if err != nil {
+		return "", fmt.Errorf("failed to upsert document: %w", err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if updated == 0 {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO %s (id, data, created_at, updated_at, version, metadata)
+			VALUES ($1, $2, $3, $3, 1, '{}')
+		`, table), id, dataJSON, now); err != nil {
+			return "", fmt.Errorf("failed to upsert document: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit upsert: %w", err)
+	}
+	return id, nil
+}
+
+// ensurePartitionedTable은 파티션 규칙에 따라 부모 테이블과 파티션을 생성합니다
+// 컬렉션마다 한 번만 실행하며, 범위 파티션의 이후 기간 파티션은 RunPartitionMaintenance가 만듭니다
+func (r *PostgreSQLRepository) ensurePartitionedTable(ctx context.Context, collection string, rule PartitionRule) error {
+	if _, ok := r.partitions.ensured.Load(collection); ok {
+		return nil
+	}
+
+	table := pq.QuoteIdentifier(collection)
+	columns := `
+			id VARCHAR(255) NOT NULL,
+			data JSONB NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			version INTEGER NOT NULL DEFAULT 1,
+			metadata JSONB DEFAULT '{}'`
+
+	var statements []string
+	switch rule.Strategy {
+	case PartitionByRange:
+		// 파티션 키가 기본 키에 포함되어야 하므로 (id, created_at)을 기본 키로 사용합니다
+		statements = append(statements,
+			fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (%s,
+			PRIMARY KEY (id, created_at)
+		) PARTITION BY RANGE (created_at)`, table, columns),
+			// 미리 만든 기간 밖의 행도 저장되도록 기본 파티션을 둡니다
+			fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s DEFAULT`,
+				pq.QuoteIdentifier(collection+"_default"), table),
+		)
+		rangeStatements, err := rangePartitionStatements(collection, rule, time.Now())
+		if err != nil {
+			return err
+		}
+		statements = append(statements, rangeStatements...)
+	case PartitionByHash:
+		statements = append(statements, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (%s,
+			PRIMARY KEY (id)
+		) PARTITION BY HASH (id)`, table, columns))
+		for i := 0; i < rule.Modulus; i++ {
+			statements = append(statements, fmt.Sprintf(
+				`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES WITH (MODULUS %d, REMAINDER %d)`,
+				pq.QuoteIdentifier(fmt.Sprintf("%s_p%d", collection, i)), table, rule.Modulus, i))
+		}
+	}
+
+	for _, statement := range statements {
+		if _, err := r.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to create partitioned collection %s: %w", collection, err)
+		}
+	}
+	// 부모 테이블의 인덱스는 모든 파티션에 생성됩니다
+	if err := r.ensureDataIndex(ctx, collection); err != nil {
+		return err
+	}
+	r.partitions.ensured.Store(collection, struct{}{})
+	return nil
+}
+
+// rangePartitionStatements는 현재 기간부터 Premake개 이후 기간까지의 범위 파티션 생성문을 만듭니다
+func rangePartitionStatements(collection string, rule PartitionRule, now time.Time) ([]string, error) {
+	start, err := periodStart(now, rule.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]string, 0, rule.Premake+1)
+	for i := 0; i <= rule.Premake; i++ {
+		end := nextPeriod(start, rule.Interval)
+		statements = append(statements, fmt.Sprintf(
+			`CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%s) TO (%s)`,
+			pq.QuoteIdentifier(fmt.Sprintf("%s_p%s", collection, start.Format("20060102"))),
+			pq.QuoteIdentifier(collection),
+			pq.QuoteLiteral(start.Format("2006-01-02 15:04:05")),
+			pq.QuoteLiteral(end.Format("2006-01-02 15:04:05")),
+		))
+		start = end
+	}
+	return statements, nil
+}
+
+// periodStart는 t가 속한 기간의 시작 시각(UTC)을 반환합니다 (주는 월요일 시작)
+func periodStart(t time.Time, interval string) (time.Time, error) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case "day":
+		return day, nil
+	case "week":
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset), nil
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported partition interval %q (day, week, month)", interval)
+	}
+}
+
+// nextPeriod는 기간 시작 시각의 다음 기간 시작 시각을 반환합니다
+func nextPeriod(start time.Time, interval string) time.Time {
+	switch interval {
+	case "day":
+		return start.AddDate(0, 0, 1)
+	case "week":
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// EnsurePartitions는 범위 파티션 컬렉션마다 현재 기간부터 미리 만들 기간까지의 파티션을 생성합니다
+// 데이터베이스에 이미 있는 파티션 테이블 중 범위 규칙에 해당하는 컬렉션만 대상으로 합니다
+func (r *PostgreSQLRepository) EnsurePartitions(ctx context.Context) error {
+	if r.partitions == nil {
+		return nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.relname
+		FROM pg_partitioned_table p
+		JOIN pg_class c ON c.oid = p.partrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = 'public' AND p.partstrat = 'r'
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to list partitioned collections: %w", err)
+	}
+	var collections []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan partitioned collection: %w", err)
+		}
+		collections = append(collections, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to list partitioned collections: %w", err)
+	}
+
+	var errs []string
+	for _, collection := range collections {
+		rule, ok := r.partitionRule(collection)
+		if !ok || rule.Strategy != PartitionByRange {
+			continue
+		}
+		statements, err := rangePartitionStatements(collection, rule, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, statement := range statements {
+			if _, err := r.db.ExecContext(ctx, statement); err != nil {
+				// 기본 파티션에 이미 해당 기간의 행이 있으면 생성이 실패하므로 다른 컬렉션은 계속 진행합니다
+				errs = append(errs, fmt.Sprintf("%s: %v", collection, err))
+				break
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to create partitions: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// RunPartitionMaintenance는 interval마다 EnsurePartitions를 실행해 다가오는 기간의 파티션을 미리 만듭니다 (ctx가 취소될 때까지 실행)
+func (r *PostgreSQLRepository) RunPartitionMaintenance(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.EnsurePartitions(ctx); err != nil {
+			logger.Warn(ctx, "postgresql partition maintenance failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// timestampColumns는 필터/정렬에서 data 필드가 아닌 시각 컬럼을 가리키는 키입니다
+// created_at 컬럼 조건은 범위 파티션 테이블에서 파티션 프루닝에 사용됩니다
+var timestampColumns = map[string]string{
+	"_created_at": "created_at",
+	"_updated_at": "updated_at",
+}
+
+// timestampColumn은 키가 시각 컬럼을 가리키면 컬럼 이름을 반환합니다
+func timestampColumn(key string) (string, bool) {
+	column, ok := timestampColumns[key]
+	return column, ok
+}
+
+// timeOperators는 시각 컬럼 조건에 허용하는 비교 연산자입니다
+var timeOperators = map[string]string{
+	"$eq":  "=",
+	"$gt":  ">",
+	"$gte": ">=",
+	"$lt":  "<",
+	"$lte": "<=",
+}
+
+// buildTimeConditions는 시각 컬럼 조건을 SQL 비교식으로 변환합니다
+// 값은 시각(time.Time 또는 RFC3339 문자열)이거나 {"$gte": ..., "$lt": ...} 형태의 연산자 맵입니다
+func buildTimeConditions(column string, value interface{}, argIndex int) ([]string, []interface{}, error) {
+	operators, ok := value.(map[string]interface{})
+	if !ok {
+		operators = map[string]interface{}{"$eq": value}
+	}
+
+	conditions := make([]string, 0, len(operators))
+	args := make([]interface{}, 0, len(operators))
+	for op, operand := range operators {
+		sqlOp, ok := timeOperators[op]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported operator %q on %s", op, column)
+		}
+		t, err := parseFilterTime(operand)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s value: %w", column, err)
+		}
+		conditions = append(conditions, fmt.Sprintf("%s %s $%d", column, sqlOp, argIndex))
+		args = append(args, t)
+		argIndex++
+	}
+	return conditions, args, nil
+}
+
+// parseFilterTime은 필터 값을 UTC 시각으로 변환합니다 (파티션 경계와 같은 기준)
+func parseFilterTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC(), nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("expected RFC3339 time, got %T", value)
+	}
+}
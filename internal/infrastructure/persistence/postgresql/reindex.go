@@ -0,0 +1,67 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/lib/pq"
+)
+
+// reindex는 문서 테이블의 인덱스를 하나씩 REINDEX INDEX CONCURRENTLY로 다시 만듭니다
+// 새 인덱스를 만든 뒤 기존 인덱스와 바꾸고 삭제하므로 재생성 중에도 읽기/쓰기가 막히지 않습니다
+// 실패하면 _ccnew 접미사의 INVALID 인덱스가 남을 수 있으며, 다시 실행하기 전에 삭제해야 합니다
+func (r *PostgreSQLRepository) reindex(ctx context.Context, collection string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	table := pq.QuoteIdentifier(collection)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.indexrelid::regclass::text
+		FROM pg_index i
+		WHERE i.indrelid = $1::regclass
+		ORDER BY 1`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	var indexes []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+		indexes = append(indexes, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+
+	before, err := r.tableSize(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int64(len(indexes))
+	opts.ReportProgress(0, total)
+
+	rebuilt := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		// regclass::text는 필요한 경우 이미 스키마와 따옴표를 포함
+		if _, err := r.db.ExecContext(ctx, "REINDEX INDEX CONCURRENTLY "+index); err != nil {
+			return map[string]interface{}{"indexes": rebuilt}, fmt.Errorf("failed to reindex %s: %w", index, err)
+		}
+		rebuilt = append(rebuilt, index)
+		opts.ReportProgress(int64(len(rebuilt)), total)
+	}
+
+	after, err := r.tableSize(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"indexes":           rebuilt,
+		"size_bytes_before": before,
+		"size_bytes_after":  after,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package postgresql
+
+import (
+	"database/sql/driver"
+	"errors"
+
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"github.com/lib/pq"
+)
+
+// IsRetryable은 PostgreSQL 에러가 재시도 가능한지 확인합니다
+// 연결 예외, 직렬화 실패/교착 상태, 자원 부족, 서버 종료는 재시도하고
+// 제약 조건 위반이나 문법 오류처럼 다시 실행해도 같은 결과가 나오는 에러는 재시도하지 않습니다
+func IsRetryable(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		case "08", // connection_exception
+			"40", // transaction_rollback (serialization_failure, deadlock_detected)
+			"53": // insufficient_resources (too_many_connections 등)
+			return true
+		}
+		switch pqErr.Code {
+		case "57P01", // admin_shutdown
+			"57P02", // crash_shutdown
+			"57P03": // cannot_connect_now
+			return true
+		}
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	return retry.IsRetryable(err)
+}
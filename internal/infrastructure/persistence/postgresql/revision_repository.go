@@ -0,0 +1,191 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// RevisionTableName은 문서 리비전 테이블 이름입니다
+const RevisionTableName = "_revisions"
+
+// RevisionRepository는 PostgreSQL 기반 문서 리비전 저장소입니다
+type RevisionRepository struct {
+	db *sql.DB
+}
+
+// NewRevisionRepository는 새로운 리비전 저장소를 생성합니다
+func NewRevisionRepository(db *sql.DB) *RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+// EnsureSchema는 리비전 테이블과 문서별 버전 조회 인덱스를 생성합니다
+func (r *RevisionRepository) EnsureSchema(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS _revisions (
+			id BIGSERIAL PRIMARY KEY,
+			database_type VARCHAR(32) NOT NULL,
+			collection VARCHAR(255) NOT NULL,
+			document_id VARCHAR(255) NOT NULL,
+			version INTEGER NOT NULL,
+			data JSONB NOT NULL,
+			valid_from TIMESTAMPTZ NOT NULL,
+			valid_to TIMESTAMPTZ NOT NULL,
+			operation VARCHAR(32) NOT NULL
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create revision table: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS _revisions_document_version
+		ON _revisions (database_type, collection, document_id, version DESC)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create revision index: %w", err)
+	}
+	return nil
+}
+
+// Append는 리비전을 추가합니다
+func (r *RevisionRepository) Append(ctx context.Context, revision *entity.Revision) error {
+	data, err := json.Marshal(revision.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal revision data: %w", err)
+	}
+
+	var id int64
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO _revisions (database_type, collection, document_id, version, data, valid_from, valid_to, operation)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`, revision.DatabaseType, revision.Collection, revision.DocumentID, revision.Version,
+		data, revision.ValidFrom, revision.ValidTo, string(revision.Operation)).Scan(&id)
+	if err != nil {
+		return fmt.Errorf("failed to append revision: %w", err)
+	}
+	revision.ID = strconv.FormatInt(id, 10)
+	return nil
+}
+
+// List는 문서의 리비전을 최신 버전부터 조회합니다
+func (r *RevisionRepository) List(ctx context.Context, key repository.RevisionKey, limit int64) ([]*entity.Revision, error) {
+	query := `
+		SELECT id, version, data, valid_from, valid_to, operation FROM _revisions
+		WHERE database_type = $1 AND collection = $2 AND document_id = $3
+		ORDER BY version DESC
+	`
+	args := []interface{}{key.DatabaseType, key.Collection, key.DocumentID}
+	if limit > 0 {
+		query += " LIMIT $4"
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*entity.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows, key)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// FindAsOf는 at 시점에 최신 상태였던 리비전을 조회합니다
+func (r *RevisionRepository) FindAsOf(ctx context.Context, key repository.RevisionKey, at time.Time) (*entity.Revision, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, version, data, valid_from, valid_to, operation FROM _revisions
+		WHERE database_type = $1 AND collection = $2 AND document_id = $3
+		  AND valid_from <= $4 AND valid_to > $4
+		ORDER BY version DESC
+		LIMIT 1
+	`, key.DatabaseType, key.Collection, key.DocumentID, at)
+
+	revision, err := scanRevision(row, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return revision, err
+}
+
+// Prune은 보관 개수와 보존 기간을 넘은 리비전을 삭제합니다
+func (r *RevisionRepository) Prune(ctx context.Context, key repository.RevisionKey, keep int, olderThan time.Time) (int64, error) {
+	var conditions []string
+	args := []interface{}{key.DatabaseType, key.Collection, key.DocumentID}
+	if !olderThan.IsZero() {
+		args = append(args, olderThan)
+		conditions = append(conditions, fmt.Sprintf("valid_to < $%d", len(args)))
+	}
+	if keep > 0 {
+		args = append(args, keep)
+		conditions = append(conditions, fmt.Sprintf(`id IN (
+			SELECT id FROM _revisions
+			WHERE database_type = $1 AND collection = $2 AND document_id = $3
+			ORDER BY version DESC
+			OFFSET $%d
+		)`, len(args)))
+	}
+	if len(conditions) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM _revisions
+		WHERE database_type = $1 AND collection = $2 AND document_id = $3
+		  AND (`+strings.Join(conditions, " OR ")+`)
+	`, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune revisions: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// rowScanner는 *sql.Row와 *sql.Rows의 공통 Scan입니다
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanRevision은 리비전 행을 엔티티로 변환합니다
+func scanRevision(row rowScanner, key repository.RevisionKey) (*entity.Revision, error) {
+	var (
+		id        int64
+		data      []byte
+		operation string
+		revision  = &entity.Revision{
+			DatabaseType: key.DatabaseType,
+			Collection:   key.Collection,
+			DocumentID:   key.DocumentID,
+		}
+	)
+	if err := row.Scan(&id, &revision.Version, &data, &revision.ValidFrom, &revision.ValidTo, &operation); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan revision: %w", err)
+	}
+	if err := json.Unmarshal(data, &revision.Data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal revision data: %w", err)
+	}
+	revision.ID = strconv.FormatInt(id, 10)
+	revision.Operation = entity.AuditOperation(operation)
+	return revision, nil
+}
@@ -0,0 +1,71 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"io/fs"
+	"strconv"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/lib/pq"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// schemaDialect는 PostgreSQL 문서 테이블 마이그레이션 방언입니다
+// 파티션 테이블은 부모 테이블에만 적용하며, 부모의 컬럼/인덱스 변경은 파티션에 전파됩니다
+var schemaDialect = schema.Dialect{
+	Name:        "postgresql",
+	Quote:       pq.QuoteIdentifier,
+	Placeholder: func(n int) string { return "$" + strconv.Itoa(n) },
+	CreateHistory: `CREATE TABLE IF NOT EXISTS ` + schema.HistoryTable + ` (
+			table_name VARCHAR(255) NOT NULL,
+			version INTEGER NOT NULL,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (table_name, version)
+		)`,
+	ListTables: `
+		SELECT c.relname FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = current_schema() AND c.relkind IN ('r', 'p') AND NOT c.relispartition
+		AND (SELECT COUNT(*) FROM pg_attribute a
+			WHERE a.attrelid = c.oid AND a.attname IN ('data', 'metadata') AND NOT a.attisdropped) = 2`,
+	TransactionalDDL: true,
+	Lock: func(ctx context.Context, conn *sql.Conn) error {
+		_, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock(hashtext('`+schema.HistoryTable+`'))`)
+		return err
+	},
+	Unlock: func(ctx context.Context, conn *sql.Conn) error {
+		_, err := conn.ExecContext(ctx, `SELECT pg_advisory_unlock(hashtext('`+schema.HistoryTable+`'))`)
+		return err
+	},
+}
+
+// NewSchemaMigrator는 내장된 마이그레이션 파일로 PostgreSQL 문서 테이블 Migrator를 생성합니다
+func NewSchemaMigrator(db *sql.DB) (*schema.Migrator, error) {
+	files, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := schema.Load(files)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewMigrator(db, schemaDialect, migrations), nil
+}
+
+// SetSchemaMigrator는 컬렉션 테이블을 처음 쓸 때 남은 스키마 마이그레이션을 적용하도록 설정합니다 (nil이면 적용하지 않음)
+func (r *PostgreSQLRepository) SetSchemaMigrator(migrator *schema.Migrator) {
+	r.migrator = migrator
+}
+
+// ensureSchema는 컬렉션 테이블에 남은 스키마 마이그레이션을 적용합니다
+func (r *PostgreSQLRepository) ensureSchema(ctx context.Context, collection string) error {
+	if r.migrator == nil {
+		return nil
+	}
+	return r.migrator.EnsureTable(ctx, collection)
+}
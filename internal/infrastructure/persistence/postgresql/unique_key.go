@@ -0,0 +1,111 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// pgUniqueViolation은 고유 제약 위반 SQLSTATE입니다
+const pgUniqueViolation = "23505"
+
+// IsDuplicateKey는 PostgreSQL 에러가 고유 제약(기본 키 포함) 위반인지 확인합니다
+func IsDuplicateKey(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pgUniqueViolation
+}
+
+// EnsureUniqueKey는 data 필드의 텍스트 값에 고유 표현식 인덱스를 생성합니다
+// 필드 중 하나라도 없거나 null이면 인덱스 값이 NULL이라 제약 대상이 아니며, 값은 텍스트로 비교하므로 1과 "1"은 같은 값입니다
+// 파티션 테이블은 파티션 키가 없는 고유 인덱스를 만들 수 없으므로 false를 반환합니다
+func (r *PostgreSQLRepository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	if err := r.requirePlaintext("unique key"); err != nil {
+		return false, err
+	}
+	if err := r.ensureTableExists(ctx, collection); err != nil {
+		return false, fmt.Errorf("failed to ensure table exists: %w", err)
+	}
+	if _, ok := r.partitionRule(collection); ok {
+		return false, nil
+	}
+
+	// DDL은 바인드 파라미터를 쓸 수 없으므로 검증된 경로만 리터럴로 넣습니다
+	keys := make([]string, len(fields))
+	for i, field := range fields {
+		path, err := sqljson.Parse(field)
+		if err != nil {
+			return false, err
+		}
+		keys[i] = fmt.Sprintf("(data #>> '%s')", path.Postgres())
+	}
+
+	name := repository.UniqueKeyName(fields)
+	query := fmt.Sprintf(`CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (%s)`,
+		pq.QuoteIdentifier(collection+"_"+name), pq.QuoteIdentifier(collection), strings.Join(keys, ", "))
+	if _, err := r.db.ExecContext(ctx, query); err != nil {
+		return false, fmt.Errorf("failed to create unique index on %s: %w", collection, err)
+	}
+
+	logger.Info(ctx, "unique index ensured",
+		logger.Collection(collection),
+		zap.String("index", collection+"_"+name),
+	)
+	return true, nil
+}
+
+// FindByUniqueKey는 data 필드의 텍스트 값이 key와 같은 문서의 ID를 조회합니다
+func (r *PostgreSQLRepository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	if err := r.requirePlaintext("unique key"); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(key))
+	for field := range key {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	conditions := make([]string, len(fields))
+	args := make([]interface{}, 0, len(fields)*2)
+	for i, field := range fields {
+		path, err := sqljson.Parse(field)
+		if err != nil {
+			return nil, err
+		}
+		text, err := sqljson.Text(key[field])
+		if err != nil {
+			return nil, err
+		}
+		conditions[i] = fmt.Sprintf("data #>> $%d::text[] = $%d", len(args)+1, len(args)+2)
+		args = append(args, path.Postgres(), text)
+	}
+
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE %s`, pq.QuoteIdentifier(collection), strings.Join(conditions, " AND "))
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find documents by unique key: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan document id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -13,8 +13,16 @@ import (
 )
 
 // CacheRepository는 Redis 기반 캐시 저장소입니다
+// 클라이언트는 단일 노드, Sentinel, 클러스터 모드를 모두 지원합니다
 type CacheRepository struct {
-	client *redis.Client
+	client redis.UniversalClient
+}
+
+// NewCacheRepositoryWithClient는 이미 연결된 클라이언트로 Redis 캐시 저장소를 생성합니다
+func NewCacheRepositoryWithClient(client redis.UniversalClient) *CacheRepository {
+	return &CacheRepository{
+		client: client,
+	}
 }
 
 // NewCacheRepository는 새로운 Redis 캐시 저장소를 생성합니다
@@ -123,6 +131,15 @@ func (r *CacheRepository) Exists(ctx context.Context, key string) (bool, error)
 	return result > 0, nil
 }
 
+// TTL은 키의 남은 수명을 반환합니다
+func (r *CacheRepository) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl: %w", err)
+	}
+	return ttl, nil
+}
+
 // Close는 Redis 연결을 종료합니다
 func (r *CacheRepository) Close() error {
 	return r.client.Close()
@@ -134,6 +151,6 @@ func (r *CacheRepository) Ping(ctx context.Context) error {
 }
 
 // GetClient는 Redis 클라이언트를 반환합니다 (테스트용)
-func (r *CacheRepository) GetClient() *redis.Client {
+func (r *CacheRepository) GetClient() redis.UniversalClient {
 	return r.client
 }
@@ -27,6 +27,9 @@ type RepositoryManager struct {
 	elasticsearchRepo repository.DocumentRepository
 	vitessRepo        repository.DocumentRepository
 
+	// readReplicas holds read-only repositories (MongoDB secondaries, SQL read replicas) keyed by database type
+	readReplicas map[string]repository.DocumentRepository
+
 	mu sync.RWMutex
 }
 
@@ -40,8 +43,7 @@ func (rm *RepositoryManager) InitializeMongoDB(ctx context.Context, client *mong
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
 
-	db := client.Database(database)
-	rm.mongoRepo = mongodb.NewMongoDocumentRepository(db)
+	rm.mongoRepo = mongodb.NewDocumentRepositoryWithClient(client, database)
 	return nil
 }
 
@@ -127,11 +129,16 @@ func (rm *RepositoryManager) RegisterElasticsearch(repo repository.DocumentRepos
 }
 
 // InitializeVitess initializes Vitess repository
-func (rm *RepositoryManager) InitializeVitess(ctx context.Context, db *sql.DB) error {
+func (rm *RepositoryManager) InitializeVitess(ctx context.Context, db *sql.DB, keyspace string) error {
+	repo, err := vitess.NewVitessRepositoryWithDB(ctx, db, keyspace)
+	if err != nil {
+		return err
+	}
+
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
 
-	rm.vitessRepo = vitess.NewVitessRepository(db)
+	rm.vitessRepo = repo
 	return nil
 }
 
@@ -185,6 +192,80 @@ func (rm *RepositoryManager) GetRepository(dbType string) (repository.DocumentRe
 	}
 }
 
+// Repositories returns every registered primary repository keyed by database type
+func (rm *RepositoryManager) Repositories() map[string]repository.DocumentRepository {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	repos := make(map[string]repository.DocumentRepository)
+	for dbType, repo := range map[string]repository.DocumentRepository{
+		"mongodb":       rm.mongoRepo,
+		"postgresql":    rm.postgresRepo,
+		"mysql":         rm.mysqlRepo,
+		"cassandra":     rm.cassandraRepo,
+		"elasticsearch": rm.elasticsearchRepo,
+		"vitess":        rm.vitessRepo,
+	} {
+		if repo != nil {
+			repos[dbType] = repo
+		}
+	}
+	return repos
+}
+
+// WrapRepositories replaces every registered primary repository with the result of wrap (read replicas are left as is)
+func (rm *RepositoryManager) WrapRepositories(wrap func(dbType string, repo repository.DocumentRepository) repository.DocumentRepository) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	for dbType, repo := range map[string]*repository.DocumentRepository{
+		"mongodb":       &rm.mongoRepo,
+		"postgresql":    &rm.postgresRepo,
+		"mysql":         &rm.mysqlRepo,
+		"cassandra":     &rm.cassandraRepo,
+		"elasticsearch": &rm.elasticsearchRepo,
+		"vitess":        &rm.vitessRepo,
+	} {
+		if *repo != nil {
+			*repo = wrap(dbType, *repo)
+		}
+	}
+}
+
+// WrapReadReplicas replaces every registered read replica repository with the result of wrap
+func (rm *RepositoryManager) WrapReadReplicas(wrap func(dbType string, repo repository.DocumentRepository) repository.DocumentRepository) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	for dbType, repo := range rm.readReplicas {
+		rm.readReplicas[dbType] = wrap(dbType, repo)
+	}
+}
+
+// RegisterReadReplica registers a read-only repository used for replica-routed reads of the given database type
+func (rm *RepositoryManager) RegisterReadReplica(dbType string, repo repository.DocumentRepository) error {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if repo == nil {
+		return fmt.Errorf("read replica repository for %s is nil", dbType)
+	}
+	if rm.readReplicas == nil {
+		rm.readReplicas = make(map[string]repository.DocumentRepository)
+	}
+	rm.readReplicas[dbType] = repo
+	return nil
+}
+
+// GetReadRepository returns the read replica repository of the given database type, if one is registered
+func (rm *RepositoryManager) GetReadRepository(dbType string) (repository.DocumentRepository, bool) {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	repo, ok := rm.readReplicas[dbType]
+	return repo, ok
+}
+
 // Close closes all database connections
 func (rm *RepositoryManager) Close() error {
 	rm.mu.Lock()
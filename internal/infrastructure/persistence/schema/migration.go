@@ -0,0 +1,132 @@
+// Package schema는 SQL 백엔드 문서 테이블(컬렉션마다 하나)의 스키마를 버전별 마이그레이션으로 관리합니다
+//
+// 마이그레이션은 NNNN_name.up.sql / NNNN_name.down.sql 파일 쌍이며, 모든 문서 테이블에 각각 적용됩니다
+// 파일은 text/template으로 테이블마다 렌더링됩니다 ({{.Table}}은 인용된 테이블 이름, {{.Name}}은 원래 이름,
+// {{quote (print .Name "_suffix")}}는 인용된 식별자). 문장은 줄 끝의 세미콜론으로 구분합니다
+// down 파일이 없는 마이그레이션은 되돌릴 수 없습니다
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// HistoryTable은 테이블별로 적용한 마이그레이션 버전을 기록하는 테이블입니다
+const HistoryTable = "_schema_migrations"
+
+var (
+	// ErrIrreversible은 down 파일이 없는 마이그레이션을 되돌리려 할 때 반환됩니다
+	ErrIrreversible = errors.New("migration cannot be reverted")
+
+	// ErrUnknownVersion은 마이그레이션 목록에 없는 버전을 지정했을 때 반환됩니다
+	ErrUnknownVersion = errors.New("unknown migration version")
+)
+
+var (
+	fileNamePattern  = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+	statementPattern = regexp.MustCompile(`;[ \t]*(\r?\n|$)`)
+)
+
+// Migration은 버전 하나의 스키마 변경입니다
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string // 비어 있으면 되돌릴 수 없음
+}
+
+// Reversible은 down 파일이 있는지 반환합니다
+func (m Migration) Reversible() bool {
+	return strings.TrimSpace(m.Down) != ""
+}
+
+// Load는 fsys 최상위의 마이그레이션 파일을 읽어 버전 순으로 반환합니다
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q (expected NNNN_name.up.sql or NNNN_name.down.sql)", entry.Name())
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d has conflicting names %q and %q", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(data)
+		} else {
+			m.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if strings.TrimSpace(m.Up) == "" {
+			return nil, fmt.Errorf("migration %04d_%s has no up script", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Render는 스크립트를 table에 맞게 렌더링하고 문장 단위로 나눕니다
+func Render(script, table string, quote func(string) string) ([]string, error) {
+	tmpl, err := template.New("migration").
+		Funcs(template.FuncMap{"quote": quote}).
+		Option("missingkey=error").
+		Parse(script)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse migration: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct{ Table, Name string }{Table: quote(table), Name: table}); err != nil {
+		return nil, fmt.Errorf("failed to render migration for %s: %w", table, err)
+	}
+
+	var statements []string
+	for _, statement := range statementPattern.Split(buf.String(), -1) {
+		if statement = strings.TrimSpace(statement); statement != "" && !isComment(statement) {
+			statements = append(statements, statement)
+		}
+	}
+	return statements, nil
+}
+
+// isComment는 문장이 주석 줄로만 이루어졌는지 반환합니다
+func isComment(statement string) bool {
+	for _, line := range strings.Split(statement, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,372 @@
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DirectionUp은 마이그레이션 적용입니다
+	DirectionUp = "up"
+	// DirectionDown은 마이그레이션 되돌리기입니다
+	DirectionDown = "down"
+)
+
+// Dialect는 백엔드별 SQL 차이입니다
+type Dialect struct {
+	Name             string
+	Quote            func(name string) string // 식별자 인용
+	Placeholder      func(n int) string       // n번째(1부터) 바인드 변수
+	CreateHistory    string                   // HistoryTable 생성문 (table_name, version, name, applied_at)
+	ListTables       string                   // 문서 테이블 이름 조회 (파티션 자식 테이블 제외)
+	TransactionalDDL bool                     // DDL과 기록을 한 트랜잭션으로 실행할 수 있는지 여부
+
+	// Lock과 Unlock은 여러 인스턴스가 동시에 마이그레이션하지 않도록 conn 세션에 잠금을 잡고 풉니다
+	Lock   func(ctx context.Context, conn *sql.Conn) error
+	Unlock func(ctx context.Context, conn *sql.Conn) error
+}
+
+// TableStatus는 문서 테이블 하나의 마이그레이션 상태입니다
+type TableStatus struct {
+	Table   string
+	Version int   // 적용한 가장 높은 버전 (없으면 0)
+	Pending []int // 아직 적용하지 않은 버전
+}
+
+// Change는 적용하거나 되돌린 마이그레이션 하나입니다
+type Change struct {
+	Table     string
+	Version   int
+	Name      string
+	Direction string
+}
+
+// Migrator는 모든 문서 테이블에 마이그레이션을 적용하고 HistoryTable에 기록합니다
+type Migrator struct {
+	db         *sql.DB
+	dialect    Dialect
+	migrations []Migration // 버전 순
+
+	ensured sync.Map // 이 프로세스에서 최신 버전을 확인한 테이블
+}
+
+// NewMigrator는 새로운 Migrator를 생성합니다 (migrations는 Load가 반환한 버전 순 목록)
+func NewMigrator(db *sql.DB, dialect Dialect, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		dialect:    dialect,
+		migrations: migrations,
+	}
+}
+
+// Dialect는 백엔드 이름을 반환합니다
+func (m *Migrator) Dialect() string {
+	return m.dialect.Name
+}
+
+// Latest는 가장 높은 마이그레이션 버전을 반환합니다 (없으면 0)
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status는 문서 테이블별 적용 버전과 남은 마이그레이션을 반환합니다
+func (m *Migrator) Status(ctx context.Context) ([]TableStatus, error) {
+	var statuses []TableStatus
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		tables, history, err := m.load(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			status := TableStatus{Table: table, Pending: []int{}}
+			for version := range history[table] {
+				if version > status.Version {
+					status.Version = version
+				}
+			}
+			for _, migration := range m.migrations {
+				if !history[table][migration.Version] {
+					status.Pending = append(status.Pending, migration.Version)
+				}
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Up은 모든 문서 테이블에 to 버전까지 남은 마이그레이션을 적용합니다 (to가 0이면 최신 버전)
+func (m *Migrator) Up(ctx context.Context, to int) ([]Change, error) {
+	if to == 0 {
+		if to = m.Latest(); to == 0 {
+			return nil, nil
+		}
+	}
+	if _, err := m.find(to); err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		tables, history, err := m.load(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			applied, err := m.upTable(ctx, conn, table, history[table], to)
+			changes = append(changes, applied...)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return changes, err
+}
+
+// Down은 모든 문서 테이블에서 to보다 높은 버전을 높은 버전부터 되돌립니다 (to가 0이면 전부)
+// 되돌려야 할 마이그레이션 중 하나라도 down 파일이 없거나 목록에 없는 버전이면 아무것도 바꾸지 않고 실패합니다
+func (m *Migrator) Down(ctx context.Context, to int) ([]Change, error) {
+	if to < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, to)
+	}
+	if to > 0 {
+		if _, err := m.find(to); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes []Change
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		tables, history, err := m.load(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		plans := make(map[string][]Migration, len(tables))
+		for _, table := range tables {
+			versions := make([]int, 0, len(history[table]))
+			for version := range history[table] {
+				if version > to {
+					versions = append(versions, version)
+				}
+			}
+			sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+			for _, version := range versions {
+				migration, err := m.find(version)
+				if err != nil {
+					return fmt.Errorf("%w (applied to %s by a newer release)", err, table)
+				}
+				if !migration.Reversible() {
+					return fmt.Errorf("%w: %04d_%s has no down script", ErrIrreversible, migration.Version, migration.Name)
+				}
+				plans[table] = append(plans[table], migration)
+			}
+		}
+
+		for _, table := range tables {
+			for _, migration := range plans[table] {
+				if err := m.apply(ctx, conn, table, migration, DirectionDown); err != nil {
+					return err
+				}
+				changes = append(changes, Change{Table: table, Version: migration.Version, Name: migration.Name, Direction: DirectionDown})
+			}
+		}
+		return nil
+	})
+	return changes, err
+}
+
+// EnsureTable은 새로 만든 문서 테이블에 남은 마이그레이션을 모두 적용합니다 (프로세스마다 테이블당 한 번만 확인)
+// 저장소가 CREATE TABLE IF NOT EXISTS로 기본 형태의 테이블을 만든 뒤 호출합니다
+func (m *Migrator) EnsureTable(ctx context.Context, table string) error {
+	if _, ok := m.ensured.Load(table); ok || strings.HasPrefix(table, "_") {
+		return nil
+	}
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		history, err := m.history(ctx, conn, table)
+		if err != nil {
+			return err
+		}
+		_, err = m.upTable(ctx, conn, table, history[table], m.Latest())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	m.ensured.Store(table, struct{}{})
+	return nil
+}
+
+// upTable은 table에 to 버전까지 적용하지 않은 마이그레이션을 적용합니다
+func (m *Migrator) upTable(ctx context.Context, conn *sql.Conn, table string, applied map[int]bool, to int) ([]Change, error) {
+	var changes []Change
+	for _, migration := range m.migrations {
+		if migration.Version > to || applied[migration.Version] {
+			continue
+		}
+		if err := m.apply(ctx, conn, table, migration, DirectionUp); err != nil {
+			return changes, err
+		}
+		changes = append(changes, Change{Table: table, Version: migration.Version, Name: migration.Name, Direction: DirectionUp})
+	}
+	return changes, nil
+}
+
+// apply는 마이그레이션 하나를 table에 실행하고 기록합니다
+// DDL이 트랜잭션을 지원하지 않는 백엔드(MySQL)에서는 중간에 실패하면 이미 실행한 문장이 남으므로 직접 정리해야 합니다
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, table string, migration Migration, direction string) error {
+	script := migration.Up
+	if direction == DirectionDown {
+		script = migration.Down
+	}
+	statements, err := Render(script, table, m.dialect.Quote)
+	if err != nil {
+		return err
+	}
+
+	history := m.dialect.Quote(HistoryTable)
+	p := m.dialect.Placeholder
+	record := fmt.Sprintf(`INSERT INTO %s (table_name, version, name, applied_at) VALUES (%s, %s, %s, %s)`,
+		history, p(1), p(2), p(3), p(4))
+	args := []interface{}{table, migration.Version, migration.Name, time.Now().UTC()}
+	if direction == DirectionDown {
+		record = fmt.Sprintf(`DELETE FROM %s WHERE table_name = %s AND version = %s`, history, p(1), p(2))
+		args = args[:2]
+	}
+
+	fail := func(err error) error {
+		return fmt.Errorf("migration %04d_%s %s on %s failed: %w", migration.Version, migration.Name, direction, table, err)
+	}
+
+	if !m.dialect.TransactionalDDL {
+		for _, statement := range statements {
+			if _, err := conn.ExecContext(ctx, statement); err != nil {
+				return fail(err)
+			}
+		}
+		if _, err := conn.ExecContext(ctx, record, args...); err != nil {
+			return fail(err)
+		}
+		return nil
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fail(err)
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			tx.Rollback()
+			return fail(err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		tx.Rollback()
+		return fail(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fail(err)
+	}
+	return nil
+}
+
+// withLock은 연결 하나에 마이그레이션 잠금을 잡고 HistoryTable을 만든 뒤 fn을 실행합니다
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if err := m.dialect.Lock(ctx, conn); err != nil {
+		return fmt.Errorf("failed to acquire schema migration lock: %w", err)
+	}
+	defer m.dialect.Unlock(context.WithoutCancel(ctx), conn)
+
+	if _, err := conn.ExecContext(ctx, m.dialect.CreateHistory); err != nil {
+		return fmt.Errorf("failed to create %s: %w", HistoryTable, err)
+	}
+	return fn(conn)
+}
+
+// load는 문서 테이블 목록과 테이블별 적용 버전을 반환합니다
+func (m *Migrator) load(ctx context.Context, conn *sql.Conn) ([]string, map[string]map[int]bool, error) {
+	rows, err := conn.QueryContext(ctx, m.dialect.ListTables)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, nil, fmt.Errorf("failed to list tables: %w", err)
+		}
+		// _로 시작하는 테이블은 서비스 내부 테이블 (변경 로그, 마이그레이션 기록 등)
+		if !strings.HasPrefix(table, "_") {
+			tables = append(tables, table)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	sort.Strings(tables)
+
+	history, err := m.history(ctx, conn, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return tables, history, nil
+}
+
+// history는 테이블별 적용 버전을 반환합니다 (table이 비어 있으면 모든 테이블)
+func (m *Migrator) history(ctx context.Context, conn *sql.Conn, table string) (map[string]map[int]bool, error) {
+	query := fmt.Sprintf(`SELECT table_name, version FROM %s`, m.dialect.Quote(HistoryTable))
+	var args []interface{}
+	if table != "" {
+		query += fmt.Sprintf(` WHERE table_name = %s`, m.dialect.Placeholder(1))
+		args = append(args, table)
+	}
+
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", HistoryTable, err)
+	}
+	defer rows.Close()
+
+	history := make(map[string]map[int]bool)
+	for rows.Next() {
+		var name string
+		var version int
+		if err := rows.Scan(&name, &version); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", HistoryTable, err)
+		}
+		if history[name] == nil {
+			history[name] = make(map[int]bool)
+		}
+		history[name][version] = true
+	}
+	return history, rows.Err()
+}
+
+// find는 버전의 마이그레이션을 반환합니다
+func (m *Migrator) find(version int) (Migration, error) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, nil
+		}
+	}
+	return Migration{}, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+}
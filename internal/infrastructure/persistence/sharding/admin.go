@@ -0,0 +1,110 @@
+package sharding
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// 인덱스와 컬렉션 관리 작업은 모든 샤드가 같은 스키마를 갖도록 항상 모든 샤드에 적용합니다
+
+// CreateIndex는 모든 샤드에 인덱스를 생성합니다
+func (r *Repository) CreateIndex(ctx context.Context, collection string, model repository.IndexModel) (string, error) {
+	names := make([]string, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		name, err := repo.CreateIndex(ctx, collection, model)
+		names[shard] = name
+		return err
+	})
+	return names[0], err
+}
+
+// CreateIndexes는 모든 샤드에 여러 인덱스를 생성합니다
+func (r *Repository) CreateIndexes(ctx context.Context, collection string, models []repository.IndexModel) ([]string, error) {
+	names := make([][]string, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		created, err := repo.CreateIndexes(ctx, collection, models)
+		names[shard] = created
+		return err
+	})
+	return names[0], err
+}
+
+// DropIndex는 모든 샤드에서 인덱스를 삭제합니다
+func (r *Repository) DropIndex(ctx context.Context, collection, indexName string) error {
+	return r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		return repo.DropIndex(ctx, collection, indexName)
+	})
+}
+
+// ListIndexes는 첫 번째 샤드의 인덱스 목록을 반환합니다 (모든 샤드에 같은 인덱스가 생성됩니다)
+func (r *Repository) ListIndexes(ctx context.Context, collection string) ([]map[string]interface{}, error) {
+	indexes, err := r.shards[0].Repository.ListIndexes(ctx, collection)
+	return indexes, r.wrap(0, err)
+}
+
+// CreateCollection은 모든 샤드에 컬렉션을 생성합니다
+func (r *Repository) CreateCollection(ctx context.Context, name string) error {
+	return r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		return repo.CreateCollection(ctx, name)
+	})
+}
+
+// DropCollection은 모든 샤드에서 컬렉션을 삭제합니다
+func (r *Repository) DropCollection(ctx context.Context, name string) error {
+	return r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		return repo.DropCollection(ctx, name)
+	})
+}
+
+// RenameCollection은 모든 샤드에서 컬렉션 이름을 변경합니다
+func (r *Repository) RenameCollection(ctx context.Context, oldName, newName string) error {
+	return r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		return repo.RenameCollection(ctx, oldName, newName)
+	})
+}
+
+// ListCollections는 모든 샤드의 컬렉션 목록을 합쳐 반환합니다
+func (r *Repository) ListCollections(ctx context.Context) ([]string, error) {
+	results := make([][]string, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		names, err := repo.ListCollections(ctx)
+		results[shard] = names
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	var merged []string
+	for _, names := range results {
+		for _, name := range names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			merged = append(merged, name)
+		}
+	}
+	return merged, nil
+}
+
+// CollectionExists는 컬렉션이 어느 샤드에든 있으면 true를 반환합니다
+func (r *Repository) CollectionExists(ctx context.Context, name string) (bool, error) {
+	exists := make([]bool, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		ok, err := repo.CollectionExists(ctx, name)
+		exists[shard] = ok
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, ok := range exists {
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,104 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// BulkWrite는 작업을 대상 샤드별로 나눠 병렬로 실행하고 결과를 합칩니다
+// 결과의 Errors와 UpsertedIDs 인덱스는 원래 operations 기준으로 되돌립니다
+// 샤드 간 원자성은 보장하지 않으므로 일부 샤드만 실패하면 나머지 샤드의 작업은 반영됩니다
+func (r *Repository) BulkWrite(ctx context.Context, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
+	groups := make(map[int][]int)
+	for i, op := range operations {
+		targets, err := r.bulkTargets(op)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		for _, shard := range targets {
+			groups[shard] = append(groups[shard], i)
+		}
+	}
+
+	targets := make([]int, 0, len(groups))
+	for shard := range groups {
+		targets = append(targets, shard)
+	}
+	results := make([]*repository.BulkResult, len(r.shards))
+	err := r.broadcast(ctx, targets, func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		indices := groups[shard]
+		ops := make([]*repository.BulkOperation, len(indices))
+		for k, i := range indices {
+			ops[k] = operations[i]
+		}
+		result, err := repo.BulkWrite(ctx, ops)
+		results[shard] = result
+		return err
+	})
+
+	merged := &repository.BulkResult{UpsertedIDs: make(map[int]interface{})}
+	for shard, result := range results {
+		if result == nil {
+			continue
+		}
+		indices := groups[shard]
+		merged.InsertedCount += result.InsertedCount
+		merged.MatchedCount += result.MatchedCount
+		merged.ModifiedCount += result.ModifiedCount
+		merged.DeletedCount += result.DeletedCount
+		merged.UpsertedCount += result.UpsertedCount
+		for k, id := range result.UpsertedIDs {
+			if k >= 0 && k < len(indices) {
+				merged.UpsertedIDs[indices[k]] = id
+			}
+		}
+		for _, opErr := range result.Errors {
+			if opErr.Index >= 0 && opErr.Index < len(indices) {
+				opErr.Index = indices[opErr.Index]
+			}
+			merged.Errors = append(merged.Errors, opErr)
+		}
+	}
+	return merged, err
+}
+
+// bulkTargets는 벌크 작업 하나를 실행할 샤드를 결정합니다
+// 삽입은 문서의 라우팅 키로, 나머지는 필터(교체는 ReplaceOneID 포함)로 결정하며
+// 필터가 샤드를 한정하지 않으면 여러 문서 작업이나 _id로 한 문서를 가리키는 작업만 모든 샤드에 보냅니다
+func (r *Repository) bulkTargets(op *repository.BulkOperation) ([]int, error) {
+	if op.Type == "insert" {
+		if op.Document == nil {
+			return nil, fmt.Errorf("document is required for insert operation")
+		}
+		shard, err := r.shardForWrite(op.Document)
+		if err != nil {
+			return nil, err
+		}
+		return []int{shard}, nil
+	}
+
+	if op.Type == "replace" && op.ReplaceOneID != "" {
+		if shard, ok := r.router.ShardOfID(op.ReplaceOneID); ok {
+			return []int{shard}, nil
+		}
+	}
+	if shard, ok := r.router.ShardOfFilter(op.Filter); ok {
+		return []int{shard}, nil
+	}
+	if op.Type == "replace" && op.Document != nil && !r.router.ByID() {
+		if shard, ok := r.router.ShardOf(op.Document); ok {
+			return []int{shard}, nil
+		}
+	}
+
+	if op.Upsert {
+		return nil, ErrShardNotResolved
+	}
+	_, byID := equalityValue(op.Filter, "_id")
+	if op.UpdateMany || op.DeleteMany || byID || (op.Type == "replace" && op.ReplaceOneID != "") {
+		return r.all(), nil
+	}
+	return nil, ErrShardNotResolved
+}
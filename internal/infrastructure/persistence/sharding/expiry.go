@@ -0,0 +1,42 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnableExpiry는 모든 샤드에 만료 정리를 준비합니다 (모든 샤드가 스스로 정리할 때만 true)
+func (r *Repository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	var manual atomic.Bool
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		expirer, ok := repo.(repository.DocumentExpirer)
+		if !ok {
+			return fmt.Errorf("repository does not support document expiry")
+		}
+		native, err := expirer.EnableExpiry(ctx, collection)
+		if !native {
+			manual.Store(true)
+		}
+		return err
+	})
+	return !manual.Load(), err
+}
+
+// DeleteExpired는 모든 샤드에서 만료 문서를 삭제합니다 (limit은 샤드마다 적용)
+func (r *Repository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	var deleted atomic.Int64
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		expirer, ok := repo.(repository.DocumentExpirer)
+		if !ok {
+			return fmt.Errorf("repository does not support document expiry")
+		}
+		n, err := expirer.DeleteExpired(ctx, collection, before, limit)
+		deleted.Add(n)
+		return err
+	})
+	return deleted.Load(), err
+}
@@ -0,0 +1,55 @@
+package sharding
+
+import (
+	"context"
+	"sync"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// MaintenanceOperations는 첫 번째 샤드가 지원하는 유지보수 작업을 반환합니다 (샤드는 같은 백엔드로 구성)
+func (r *Repository) MaintenanceOperations() []string {
+	maintainer, ok := r.shards[0].Repository.(repository.CollectionMaintainer)
+	if !ok {
+		return nil
+	}
+	return maintainer.MaintenanceOperations()
+}
+
+// RunMaintenance는 모든 샤드에서 유지보수 작업을 실행하고 샤드 이름별 결과를 반환합니다
+// 진행 상황은 모든 샤드의 합계로 보고합니다
+func (r *Repository) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	var mu sync.Mutex
+	shards := make(map[string]interface{}, len(r.shards))
+	done := make([]int64, len(r.shards))
+	total := make([]int64, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		maintainer, ok := repo.(repository.CollectionMaintainer)
+		if !ok {
+			return repository.ErrUnsupportedMaintenance
+		}
+		shardOpts := opts
+		if opts.Progress != nil {
+			shardOpts.Progress = func(shardDone, shardTotal int64) {
+				mu.Lock()
+				defer mu.Unlock()
+				done[shard], total[shard] = shardDone, shardTotal
+				var sumDone, sumTotal int64
+				for i := range done {
+					sumDone += done[i]
+					sumTotal += total[i]
+				}
+				opts.Progress(sumDone, sumTotal)
+			}
+		}
+		result, err := maintainer.RunMaintenance(ctx, collection, operation, shardOpts)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		shards[r.shards[shard].Name] = result
+		mu.Unlock()
+		return nil
+	})
+	return map[string]interface{}{"shards": shards}, err
+}
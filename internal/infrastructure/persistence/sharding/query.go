@@ -0,0 +1,369 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// FindAll은 필터가 한정하는 샤드(없으면 모든 샤드)에서 문서를 조회해 합칩니다
+func (r *Repository) FindAll(ctx context.Context, collection string, filter map[string]interface{}) ([]*entity.Document, error) {
+	return r.gather(ctx, r.targetsOf(filter), func(ctx context.Context, repo repository.DocumentRepository) ([]*entity.Document, error) {
+		return repo.FindAll(ctx, collection, filter)
+	})
+}
+
+// FindWithOptions는 옵션을 적용해 문서를 조회합니다
+// 여러 샤드에 걸친 조회는 샤드마다 skip+limit건을 가져와 메모리에서 정렬한 뒤 skip/limit을 적용합니다
+func (r *Repository) FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) ([]*entity.Document, error) {
+	targets := r.targetsOf(filter)
+	if len(targets) == 1 {
+		docs, err := r.shards[targets[0]].Repository.FindWithOptions(ctx, collection, filter, opts)
+		return docs, r.wrap(targets[0], err)
+	}
+
+	shardOpts := perShardOptions(opts)
+	docs, err := r.gather(ctx, targets, func(ctx context.Context, repo repository.DocumentRepository) ([]*entity.Document, error) {
+		return repo.FindWithOptions(ctx, collection, filter, shardOpts)
+	})
+	if err != nil || opts == nil {
+		return docs, err
+	}
+	sortDocuments(docs, opts.Sort)
+	return page(docs, opts.Skip, opts.Limit), nil
+}
+
+// FindStream은 조회 결과를 커서로 반환합니다
+// 여러 샤드에 걸친 조회는 정렬이 없으면 샤드 커서를 차례로 이어 읽고, 정렬이 있으면 FindWithOptions로 병합한 결과를 순회합니다
+func (r *Repository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	targets := r.targetsOf(filter)
+	if len(targets) == 1 {
+		it, err := r.shards[targets[0]].Repository.FindStream(ctx, collection, filter, opts)
+		return it, r.wrap(targets[0], err)
+	}
+
+	if opts != nil && len(opts.Sort) > 0 {
+		docs, err := r.FindWithOptions(ctx, collection, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+		return repository.NewSliceIterator(docs), nil
+	}
+
+	shardOpts := perShardOptions(opts)
+	it := &chainIterator{targets: targets}
+	if opts != nil {
+		it.skip, it.limit = opts.Skip, opts.Limit
+	}
+	it.open = func(ctx context.Context, shard int) (repository.DocumentIterator, error) {
+		stream, err := r.shards[shard].Repository.FindStream(ctx, collection, filter, shardOpts)
+		return stream, r.wrap(shard, err)
+	}
+	return it, nil
+}
+
+// Aggregate는 모든 샤드에서 파이프라인을 실행하고 결과를 이어 붙입니다
+// $group, $sort, $limit 같은 단계는 샤드 단위로 적용되므로 전체 결과가 필요하면 호출자가 다시 합산해야 합니다
+func (r *Repository) Aggregate(ctx context.Context, collection string, pipeline []bson.M) ([]map[string]interface{}, error) {
+	results := make([][]map[string]interface{}, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		rows, err := repo.Aggregate(ctx, collection, pipeline)
+		results[shard] = rows
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []map[string]interface{}
+	for _, rows := range results {
+		merged = append(merged, rows...)
+	}
+	return merged, nil
+}
+
+// Distinct는 대상 샤드의 고유 값을 합쳐 중복을 제거합니다
+func (r *Repository) Distinct(ctx context.Context, collection, field string, filter map[string]interface{}) ([]interface{}, error) {
+	targets := r.targetsOf(filter)
+	results := make([][]interface{}, len(r.shards))
+	err := r.broadcast(ctx, targets, func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		values, err := repo.Distinct(ctx, collection, field, filter)
+		results[shard] = values
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	var merged []interface{}
+	for _, values := range results {
+		for _, value := range values {
+			key := fmt.Sprintf("%T:%v", value, value)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			merged = append(merged, value)
+		}
+	}
+	return merged, nil
+}
+
+// Count는 대상 샤드의 문서 수를 합산합니다
+func (r *Repository) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	return r.sum(ctx, r.targetsOf(filter), func(ctx context.Context, repo repository.DocumentRepository) (int64, error) {
+		return repo.Count(ctx, collection, filter)
+	})
+}
+
+// EstimatedDocumentCount는 모든 샤드의 추정 문서 수를 합산합니다
+func (r *Repository) EstimatedDocumentCount(ctx context.Context, collection string) (int64, error) {
+	return r.sum(ctx, r.all(), func(ctx context.Context, repo repository.DocumentRepository) (int64, error) {
+		return repo.EstimatedDocumentCount(ctx, collection)
+	})
+}
+
+// gather는 대상 샤드마다 조회를 병렬로 실행하고 샤드 순서대로 결과를 이어 붙입니다
+func (r *Repository) gather(ctx context.Context, targets []int, fn func(ctx context.Context, repo repository.DocumentRepository) ([]*entity.Document, error)) ([]*entity.Document, error) {
+	results := make([][]*entity.Document, len(r.shards))
+	err := r.broadcast(ctx, targets, func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		docs, err := fn(ctx, repo)
+		results[shard] = docs
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []*entity.Document
+	for _, docs := range results {
+		merged = append(merged, docs...)
+	}
+	return merged, nil
+}
+
+// perShardOptions는 브로드캐스트 조회에서 샤드마다 사용할 옵션을 만듭니다
+// 어느 샤드의 문서가 앞쪽 페이지에 올지 모르므로 skip 없이 skip+limit건을 가져옵니다
+func perShardOptions(opts *repository.FindOptions) *repository.FindOptions {
+	if opts == nil {
+		return nil
+	}
+	shardOpts := *opts
+	shardOpts.Skip = 0
+	if opts.Limit > 0 {
+		shardOpts.Limit = opts.Limit + opts.Skip
+	}
+	return &shardOpts
+}
+
+// page는 병합된 결과에 skip/limit을 적용합니다
+func page(docs []*entity.Document, skip, limit int64) []*entity.Document {
+	if skip >= int64(len(docs)) {
+		return []*entity.Document{}
+	}
+	if skip > 0 {
+		docs = docs[skip:]
+	}
+	if limit > 0 && limit < int64(len(docs)) {
+		docs = docs[:limit]
+	}
+	return docs
+}
+
+// sortDocuments는 저장소 정렬 옵션(필드 → 1/-1)으로 문서를 정렬합니다
+// 정렬 옵션이 map이라 필드 간 우선순위가 없으므로 필드 이름 순으로 비교합니다
+func sortDocuments(docs []*entity.Document, spec map[string]int) {
+	if len(spec) == 0 {
+		return
+	}
+	fields := make([]string, 0, len(spec))
+	for field := range spec {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		for _, field := range fields {
+			c := compareValues(sortValue(docs[i], field), sortValue(docs[j], field))
+			if c == 0 {
+				continue
+			}
+			if spec[field] < 0 {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+// sortValue는 정렬 필드의 값을 꺼냅니다 (메타데이터 필드 또는 data.<경로>)
+func sortValue(doc *entity.Document, field string) interface{} {
+	switch field {
+	case "_id", "id":
+		return doc.ID()
+	case "created_at":
+		return doc.CreatedAt()
+	case "updated_at":
+		return doc.UpdatedAt()
+	case "version":
+		return doc.Version()
+	}
+	value, _ := lookup(doc.Data(), strings.TrimPrefix(field, "data."))
+	return value
+}
+
+// compareValues는 두 값을 비교합니다 (-1, 0, 1)
+// 타입이 다르면 MongoDB와 비슷하게 null < 숫자 < 문자열 < 기타 < bool < 시각 순서로 비교합니다
+func compareValues(a, b interface{}) int {
+	ra, rb := typeRank(a), typeRank(b)
+	if ra != rb {
+		return compareOrdered(ra, rb)
+	}
+
+	switch x := a.(type) {
+	case nil:
+		return 0
+	case string:
+		return strings.Compare(x, b.(string))
+	case bool:
+		y := b.(bool)
+		if x == y {
+			return 0
+		}
+		if !x {
+			return -1
+		}
+		return 1
+	case time.Time:
+		return x.Compare(b.(time.Time))
+	}
+	if fa, ok := toFloat(a); ok {
+		fb, _ := toFloat(b)
+		return compareOrdered(fa, fb)
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// typeRank는 타입 간 정렬 순서를 반환합니다
+func typeRank(value interface{}) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case string:
+		return 2
+	case bool:
+		return 4
+	case time.Time:
+		return 5
+	}
+	if _, ok := toFloat(value); ok {
+		return 1
+	}
+	return 3
+}
+
+// toFloat은 숫자 값을 float64로 변환합니다
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func compareOrdered[T int | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// chainIterator는 여러 샤드의 커서를 차례로 이어 읽으며 전체 결과에 skip/limit을 적용합니다
+// 샤드 커서는 이전 샤드를 다 읽은 뒤에 열어 동시에 열린 서버 커서를 하나로 유지합니다
+type chainIterator struct {
+	targets []int
+	open    func(ctx context.Context, shard int) (repository.DocumentIterator, error)
+
+	current  repository.DocumentIterator
+	next     int
+	skip     int64
+	limit    int64
+	returned int64
+	err      error
+}
+
+func (it *chainIterator) Next(ctx context.Context) bool {
+	for it.err == nil {
+		if it.limit > 0 && it.returned >= it.limit {
+			return false
+		}
+		if it.current == nil {
+			if it.next >= len(it.targets) {
+				return false
+			}
+			it.current, it.err = it.open(ctx, it.targets[it.next])
+			it.next++
+			continue
+		}
+
+		if it.current.Next(ctx) {
+			if it.skip > 0 {
+				it.skip--
+				continue
+			}
+			it.returned++
+			return true
+		}
+		it.err = it.current.Err()
+		_ = it.current.Close(ctx)
+		it.current = nil
+	}
+	return false
+}
+
+func (it *chainIterator) Decode() (*entity.Document, error) {
+	if it.current == nil {
+		return nil, fmt.Errorf("iterator is not positioned on a document")
+	}
+	return it.current.Decode()
+}
+
+func (it *chainIterator) Err() error {
+	return it.err
+}
+
+func (it *chainIterator) Close(ctx context.Context) error {
+	if it.current == nil {
+		return nil
+	}
+	err := it.current.Close(ctx)
+	it.current = nil
+	return err
+}
@@ -0,0 +1,40 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// FindReferencing은 모든 샤드에서 data의 field 값이 id인 문서의 ID를 모읍니다 (limit은 전체에 적용)
+// 참조하는 문서는 참조 대상과 다른 샤드에 있을 수 있으므로 항상 모든 샤드에 조회합니다
+func (r *Repository) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	var mu sync.Mutex
+	var ids []string
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		finder, ok := repo.(repository.ReferenceFinder)
+		if !ok {
+			return fmt.Errorf("repository does not support references")
+		}
+		found, err := finder.FindReferencing(ctx, collection, field, id, limit)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		ids = append(ids, found...)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(ids)
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
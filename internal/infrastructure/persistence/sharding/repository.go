@@ -0,0 +1,325 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	// ErrShardNotResolved는 쓰기 대상 샤드를 결정할 수 없을 때 발생합니다 (ID나 샤드 키 값이 없는 경우)
+	ErrShardNotResolved = errors.New("cannot resolve target shard: filter must match the shard key")
+
+	// ErrCrossShard는 여러 샤드에 걸쳐 실행할 수 없는 작업을 요청했을 때 발생합니다
+	ErrCrossShard = errors.New("operation cannot span multiple shards")
+)
+
+// Shard는 샤드 하나의 이름과 저장소입니다
+type Shard struct {
+	Name       string
+	Repository repository.DocumentRepository
+}
+
+// Config는 샤딩 저장소 설정입니다
+type Config struct {
+	// ShardKey는 라우팅에 사용할 문서 데이터 필드 경로입니다 (비어 있으면 문서 ID로 라우팅)
+	ShardKey string
+
+	// NewID는 라우팅 키가 없는 새 문서(ID 라우팅이거나 샤드 키 필드가 없는 문서)에 미리 배정할 ID를 생성합니다
+	// 저장 전에 샤드를 정해야 하므로 ID로 라우팅하면 필수입니다
+	NewID func() string
+}
+
+// Repository는 문서를 해시로 N개의 백엔드 인스턴스에 나눠 저장하는 DocumentRepository입니다
+// 라우팅 키가 정해지는 작업은 해당 샤드로만 보내고, 컬렉션 전체 조회와 관리 작업은 모든 샤드에 병렬로 브로드캐스트해 결과를 합칩니다
+type Repository struct {
+	router *Router
+	shards []Shard
+	newID  func() string
+}
+
+// NewRepository는 샤드 목록으로 샤딩 저장소를 생성합니다
+// 샤드 순서가 라우팅 결과를 결정하므로 샤드를 추가할 때는 목록 끝에만 추가해야 합니다
+func NewRepository(shards []Shard, cfg Config) (*Repository, error) {
+	router, err := NewRouter(len(shards), cfg.ShardKey)
+	if err != nil {
+		return nil, err
+	}
+	for i, shard := range shards {
+		if shard.Repository == nil {
+			return nil, fmt.Errorf("shard %d (%s) has no repository", i, shard.Name)
+		}
+	}
+	if router.ByID() && cfg.NewID == nil {
+		return nil, fmt.Errorf("sharding by document id requires an id generator")
+	}
+	return &Repository{router: router, shards: shards, newID: cfg.NewID}, nil
+}
+
+// Router는 라우터를 반환합니다
+func (r *Repository) Router() *Router {
+	return r.router
+}
+
+// Shards는 샤드 목록을 반환합니다
+func (r *Repository) Shards() []Shard {
+	return r.shards
+}
+
+// all은 모든 샤드 번호를 반환합니다
+func (r *Repository) all() []int {
+	targets := make([]int, len(r.shards))
+	for i := range targets {
+		targets[i] = i
+	}
+	return targets
+}
+
+// targetsOf는 필터가 한정하는 샤드를 반환합니다 (한정되지 않으면 모든 샤드)
+func (r *Repository) targetsOf(filter map[string]interface{}) []int {
+	if shard, ok := r.router.ShardOfFilter(filter); ok {
+		return []int{shard}
+	}
+	return r.all()
+}
+
+// targetsOfID는 ID가 속한 샤드를 반환합니다 (샤드 키로 라우팅하면 모든 샤드)
+func (r *Repository) targetsOfID(id string) []int {
+	if shard, ok := r.router.ShardOfID(id); ok {
+		return []int{shard}
+	}
+	return r.all()
+}
+
+// broadcast는 대상 샤드마다 fn을 병렬로 실행합니다
+// 하나라도 실패하면 나머지 호출의 컨텍스트를 취소하고 샤드 이름을 붙인 첫 에러를 반환합니다
+func (r *Repository) broadcast(ctx context.Context, targets []int, fn func(ctx context.Context, shard int, repo repository.DocumentRepository) error) error {
+	if len(targets) == 1 {
+		shard := targets[0]
+		return r.wrap(shard, fn(ctx, shard, r.shards[shard].Repository))
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for _, shard := range targets {
+		g.Go(func() error {
+			return r.wrap(shard, fn(gctx, shard, r.shards[shard].Repository))
+		})
+	}
+	return g.Wait()
+}
+
+// wrap은 에러에 샤드 이름을 붙입니다 (errors.Is로 원래 에러를 확인할 수 있습니다)
+func (r *Repository) wrap(shard int, err error) error {
+	if err == nil || len(r.shards) == 1 {
+		return err
+	}
+	return fmt.Errorf("shard %s: %w", r.shards[shard].Name, err)
+}
+
+// firstFound는 ID 기반 작업을 대상 샤드에 차례로 실행해 문서가 있는 첫 샤드의 결과를 반환합니다
+// 모든 샤드에서 찾지 못하면 ErrDocumentNotFound를 반환합니다
+func firstFound[T any](ctx context.Context, r *Repository, targets []int, fn func(repo repository.DocumentRepository) (T, error)) (T, error) {
+	var zero T
+	for _, shard := range targets {
+		result, err := fn(r.shards[shard].Repository)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.Is(err, entity.ErrDocumentNotFound) {
+			return zero, r.wrap(shard, err)
+		}
+		if ctx.Err() != nil {
+			return zero, ctx.Err()
+		}
+	}
+	return zero, entity.ErrDocumentNotFound
+}
+
+// Save는 문서를 라우팅 키가 속한 샤드에 저장합니다
+// ID로 라우팅하는데 ID가 없으면 저장 전에 ID를 배정합니다
+func (r *Repository) Save(ctx context.Context, doc *entity.Document) error {
+	shard, err := r.shardForWrite(doc)
+	if err != nil {
+		return err
+	}
+	return r.wrap(shard, r.shards[shard].Repository.Save(ctx, doc))
+}
+
+// shardForWrite는 새로 쓰는 문서의 샤드를 결정합니다
+func (r *Repository) shardForWrite(doc *entity.Document) (int, error) {
+	if _, ok := r.router.ShardOf(doc); !ok && r.newID != nil {
+		doc.SetID(r.newID())
+	}
+	shard, ok := r.router.ShardOf(doc)
+	if !ok {
+		return 0, ErrShardNotResolved
+	}
+	return shard, nil
+}
+
+// SaveMany는 문서를 샤드별로 나눠 병렬로 저장합니다
+func (r *Repository) SaveMany(ctx context.Context, docs []*entity.Document) error {
+	groups := make(map[int][]*entity.Document)
+	for _, doc := range docs {
+		shard, err := r.shardForWrite(doc)
+		if err != nil {
+			return err
+		}
+		groups[shard] = append(groups[shard], doc)
+	}
+
+	targets := make([]int, 0, len(groups))
+	for shard := range groups {
+		targets = append(targets, shard)
+	}
+	return r.broadcast(ctx, targets, func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		return repo.SaveMany(ctx, groups[shard])
+	})
+}
+
+// FindByID는 ID로 문서를 조회합니다 (샤드 키로 라우팅하면 모든 샤드를 차례로 조회)
+func (r *Repository) FindByID(ctx context.Context, collection, id string) (*entity.Document, error) {
+	return firstFound(ctx, r, r.targetsOfID(id), func(repo repository.DocumentRepository) (*entity.Document, error) {
+		return repo.FindByID(ctx, collection, id)
+	})
+}
+
+// Update는 문서가 속한 샤드에서 문서를 업데이트합니다
+// 샤드 키 값은 바뀌지 않는다고 가정하며, 샤드를 결정할 수 없으면 모든 샤드에서 찾습니다
+func (r *Repository) Update(ctx context.Context, doc *entity.Document) error {
+	targets := r.all()
+	if shard, ok := r.router.ShardOf(doc); ok {
+		targets = []int{shard}
+	}
+	_, err := firstFound(ctx, r, targets, func(repo repository.DocumentRepository) (struct{}, error) {
+		return struct{}{}, repo.Update(ctx, doc)
+	})
+	return err
+}
+
+// UpdateMany는 필터가 한정하는 샤드(없으면 모든 샤드)에서 문서를 업데이트하고 수정된 수를 합산합니다
+func (r *Repository) UpdateMany(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (int64, error) {
+	return r.sum(ctx, r.targetsOf(filter), func(ctx context.Context, repo repository.DocumentRepository) (int64, error) {
+		return repo.UpdateMany(ctx, collection, filter, update)
+	})
+}
+
+// Replace는 ID의 문서를 교체합니다
+func (r *Repository) Replace(ctx context.Context, collection, id string, replacement *entity.Document) error {
+	_, err := firstFound(ctx, r, r.targetsOfID(id), func(repo repository.DocumentRepository) (struct{}, error) {
+		return struct{}{}, repo.Replace(ctx, collection, id, replacement)
+	})
+	return err
+}
+
+// Delete는 ID의 문서를 삭제합니다
+func (r *Repository) Delete(ctx context.Context, collection, id string) error {
+	_, err := firstFound(ctx, r, r.targetsOfID(id), func(repo repository.DocumentRepository) (struct{}, error) {
+		return struct{}{}, repo.Delete(ctx, collection, id)
+	})
+	return err
+}
+
+// DeleteMany는 필터가 한정하는 샤드(없으면 모든 샤드)에서 문서를 삭제하고 삭제된 수를 합산합니다
+func (r *Repository) DeleteMany(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	return r.sum(ctx, r.targetsOf(filter), func(ctx context.Context, repo repository.DocumentRepository) (int64, error) {
+		return repo.DeleteMany(ctx, collection, filter)
+	})
+}
+
+// FindAndUpdate는 ID의 문서를 업데이트하고 업데이트된 문서를 반환합니다
+func (r *Repository) FindAndUpdate(ctx context.Context, collection, id string, update map[string]interface{}) (*entity.Document, error) {
+	return firstFound(ctx, r, r.targetsOfID(id), func(repo repository.DocumentRepository) (*entity.Document, error) {
+		return repo.FindAndUpdate(ctx, collection, id, update)
+	})
+}
+
+// FindOneAndReplace는 ID의 문서를 교체하고 교체된 문서를 반환합니다
+func (r *Repository) FindOneAndReplace(ctx context.Context, collection, id string, replacement *entity.Document) (*entity.Document, error) {
+	return firstFound(ctx, r, r.targetsOfID(id), func(repo repository.DocumentRepository) (*entity.Document, error) {
+		return repo.FindOneAndReplace(ctx, collection, id, replacement)
+	})
+}
+
+// FindOneAndDelete는 ID의 문서를 삭제하고 삭제된 문서를 반환합니다
+func (r *Repository) FindOneAndDelete(ctx context.Context, collection, id string) (*entity.Document, error) {
+	return firstFound(ctx, r, r.targetsOfID(id), func(repo repository.DocumentRepository) (*entity.Document, error) {
+		return repo.FindOneAndDelete(ctx, collection, id)
+	})
+}
+
+// Upsert는 필터가 한정하는 샤드에서 upsert합니다
+// 새 문서가 삽입될 샤드를 정해야 하므로 필터에 라우팅 키의 동등 조건이 있어야 합니다
+func (r *Repository) Upsert(ctx context.Context, collection string, filter map[string]interface{}, update map[string]interface{}) (string, error) {
+	shard, ok := r.router.ShardOfFilter(filter)
+	if !ok {
+		return "", ErrShardNotResolved
+	}
+	id, err := r.shards[shard].Repository.Upsert(ctx, collection, filter, update)
+	return id, r.wrap(shard, err)
+}
+
+// sum은 대상 샤드마다 fn을 병렬로 실행해 결과를 합산합니다
+func (r *Repository) sum(ctx context.Context, targets []int, fn func(ctx context.Context, repo repository.DocumentRepository) (int64, error)) (int64, error) {
+	counts := make([]int64, len(r.shards))
+	err := r.broadcast(ctx, targets, func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		n, err := fn(ctx, repo)
+		counts[shard] = n
+		return err
+	})
+
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	return total, err
+}
+
+// Watch는 변경 스트림을 엽니다
+// 샤드마다 별도 스트림이므로 샤드가 하나일 때만 지원합니다
+func (r *Repository) Watch(ctx context.Context, collection string, pipeline []bson.M) (*mongo.ChangeStream, error) {
+	if len(r.shards) != 1 {
+		return nil, ErrCrossShard
+	}
+	return r.shards[0].Repository.Watch(ctx, collection, pipeline)
+}
+
+// WithTransaction은 트랜잭션을 실행합니다
+// 샤드 간 분산 트랜잭션은 지원하지 않으므로 샤드가 하나일 때만 실행합니다
+func (r *Repository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
+	if len(r.shards) != 1 {
+		return ErrCrossShard
+	}
+	return r.shards[0].Repository.WithTransaction(ctx, opts, fn)
+}
+
+// ExecuteRawQuery는 원시 쿼리를 실행합니다 (어느 샤드에서 실행할지 알 수 없으므로 샤드가 하나일 때만 지원)
+func (r *Repository) ExecuteRawQuery(ctx context.Context, query interface{}) (interface{}, error) {
+	if len(r.shards) != 1 {
+		return nil, ErrCrossShard
+	}
+	return r.shards[0].Repository.ExecuteRawQuery(ctx, query)
+}
+
+// ExecuteRawQueryWithResult는 원시 쿼리를 실행하고 결과를 디코딩합니다 (샤드가 하나일 때만 지원)
+func (r *Repository) ExecuteRawQueryWithResult(ctx context.Context, query interface{}, result interface{}) error {
+	if len(r.shards) != 1 {
+		return ErrCrossShard
+	}
+	return r.shards[0].Repository.ExecuteRawQueryWithResult(ctx, query, result)
+}
+
+// HealthCheck는 모든 샤드의 상태를 확인합니다
+func (r *Repository) HealthCheck(ctx context.Context) error {
+	return r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		return repo.HealthCheck(ctx)
+	})
+}
+
+var _ repository.DocumentRepository = (*Repository)(nil)
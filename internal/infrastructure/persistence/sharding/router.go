@@ -0,0 +1,150 @@
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+)
+
+// Router는 샤드 키를 해시해 문서가 저장될 샤드를 결정합니다
+// 샤드 키를 지정하지 않으면 문서 ID로 라우팅하며, 지정하면 문서 데이터의 해당 필드 값으로 라우팅합니다
+type Router struct {
+	shards   int
+	shardKey string
+}
+
+// NewRouter는 샤드 수와 샤드 키(문서 데이터 필드 경로, 비어 있으면 문서 ID)로 라우터를 생성합니다
+func NewRouter(shards int, shardKey string) (*Router, error) {
+	if shards <= 0 {
+		return nil, fmt.Errorf("sharding requires at least one shard")
+	}
+	return &Router{shards: shards, shardKey: strings.TrimPrefix(shardKey, "data.")}, nil
+}
+
+// Shards는 샤드 수를 반환합니다
+func (r *Router) Shards() int {
+	return r.shards
+}
+
+// ByID는 문서 ID로 라우팅하는지 여부를 반환합니다
+// false이면 ID만으로는 샤드를 알 수 없어 ID 기반 작업은 모든 샤드에 브로드캐스트됩니다
+func (r *Router) ByID() bool {
+	return r.shardKey == ""
+}
+
+// Shard는 키가 속한 샤드 번호를 반환합니다
+// jump consistent hash를 사용해 샤드를 추가해도 약 1/N의 키만 다른 샤드로 옮겨집니다
+func (r *Router) Shard(key string) int {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return jumpHash(h.Sum64(), r.shards)
+}
+
+// ShardOf는 문서가 속한 샤드 번호를 반환합니다
+// 샤드 키 필드가 없는 문서는 ID로 라우팅하며, ID도 없으면 false를 반환합니다
+func (r *Router) ShardOf(doc *entity.Document) (int, bool) {
+	if !r.ByID() {
+		if value, ok := lookup(doc.Data(), r.shardKey); ok && isScalar(value) {
+			return r.Shard(fmt.Sprint(value)), true
+		}
+	}
+	if doc.ID() == "" {
+		return 0, false
+	}
+	return r.Shard(doc.ID()), true
+}
+
+// ShardOfID는 ID로 라우팅할 때 문서 ID가 속한 샤드 번호를 반환합니다 (샤드 키로 라우팅하면 false)
+func (r *Router) ShardOfID(id string) (int, bool) {
+	if !r.ByID() || id == "" {
+		return 0, false
+	}
+	return r.Shard(id), true
+}
+
+// ShardOfFilter는 필터가 하나의 샤드로 한정되는지 확인합니다
+// 라우팅 키(_id 또는 data.<샤드 키>)에 대한 동등 조건이 최상위나 $and 안에 있을 때만 한정됩니다
+func (r *Router) ShardOfFilter(filter map[string]interface{}) (int, bool) {
+	fields := []string{"_id", "id"}
+	if !r.ByID() {
+		fields = []string{"data." + r.shardKey}
+	}
+	for _, field := range fields {
+		if value, ok := equalityValue(filter, field); ok {
+			return r.Shard(fmt.Sprint(value)), true
+		}
+	}
+	return 0, false
+}
+
+// equalityValue는 필터에서 필드의 동등 조건 값을 찾습니다 ($eq 연산자와 $and 포함)
+func equalityValue(filter map[string]interface{}, field string) (interface{}, bool) {
+	if value, ok := filter[field]; ok {
+		if ops, isMap := value.(map[string]interface{}); isMap {
+			value, ok = ops["$eq"]
+			if !ok {
+				return nil, false
+			}
+		}
+		if isScalar(value) {
+			return value, true
+		}
+		return nil, false
+	}
+
+	if clauses, ok := filter["$and"].([]interface{}); ok {
+		for _, clause := range clauses {
+			if sub, isMap := clause.(map[string]interface{}); isMap {
+				if value, found := equalityValue(sub, field); found {
+					return value, true
+				}
+			}
+		}
+	}
+	if clauses, ok := filter["$and"].([]map[string]interface{}); ok {
+		for _, sub := range clauses {
+			if value, found := equalityValue(sub, field); found {
+				return value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// lookup은 점(.)으로 구분된 경로의 값을 찾습니다
+func lookup(data map[string]interface{}, path string) (interface{}, bool) {
+	current := interface{}(data)
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// isScalar는 라우팅 키로 사용할 수 있는 값인지 확인합니다
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, bool, int, int32, int64, uint, uint32, uint64, float32, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// jumpHash는 Lamping & Veach의 jump consistent hash입니다
+func jumpHash(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,106 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// CollectionStats는 모든 샤드의 컬렉션 통계를 합쳐 반환합니다
+func (r *Repository) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	perShard := make([]*repository.CollectionStats, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		reader, ok := repo.(repository.CollectionStatsReader)
+		if !ok {
+			return fmt.Errorf("repository does not support collection stats")
+		}
+		stats, err := reader.CollectionStats(ctx, collection)
+		if err != nil {
+			return err
+		}
+		perShard[shard] = stats
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergeCollectionStats(collection, perShard), nil
+}
+
+// DatabaseStats는 모든 샤드의 데이터베이스 통계를 합쳐 반환합니다 (컬렉션별 통계는 이름별로 합산)
+func (r *Repository) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	perShard := make([]*repository.DatabaseStats, len(r.shards))
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, shard int, repo repository.DocumentRepository) error {
+		reader, ok := repo.(repository.DatabaseStatsReader)
+		if !ok {
+			return fmt.Errorf("repository does not support database stats")
+		}
+		stats, err := reader.DatabaseStats(ctx)
+		if err != nil {
+			return err
+		}
+		perShard[shard] = stats
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byCollection := make(map[string][]*repository.CollectionStats)
+	total := &repository.DatabaseStats{}
+	for _, stats := range perShard {
+		total.Documents += stats.Documents
+		total.DataSize += stats.DataSize
+		total.StorageSize += stats.StorageSize
+		total.IndexSize += stats.IndexSize
+		if stats.Connections != nil {
+			if total.Connections == nil {
+				total.Connections = &repository.ConnectionStats{}
+			}
+			total.Connections.Current += stats.Connections.Current
+			total.Connections.Active += stats.Connections.Active
+			total.Connections.Available += stats.Connections.Available
+		}
+		for _, c := range stats.PerCollection {
+			byCollection[c.Collection] = append(byCollection[c.Collection], c)
+		}
+	}
+
+	names := make([]string, 0, len(byCollection))
+	for name := range byCollection {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		total.PerCollection = append(total.PerCollection, mergeCollectionStats(name, byCollection[name]))
+	}
+	total.Collections = len(names)
+	return total, nil
+}
+
+// mergeCollectionStats는 샤드별 컬렉션 통계를 합칩니다 (인덱스 개수와 저장 엔진은 첫 번째 샤드 기준)
+func mergeCollectionStats(collection string, perShard []*repository.CollectionStats) *repository.CollectionStats {
+	total := &repository.CollectionStats{
+		Collection:    collection,
+		IndexCount:    perShard[0].IndexCount,
+		StorageEngine: perShard[0].StorageEngine,
+	}
+	for _, stats := range perShard {
+		total.Count += stats.Count
+		total.Size += stats.Size
+		total.StorageSize += stats.StorageSize
+		total.TotalIndexSize += stats.TotalIndexSize
+		for name, size := range stats.IndexSizes {
+			if total.IndexSizes == nil {
+				total.IndexSizes = make(map[string]int64)
+			}
+			total.IndexSizes[name] += size
+		}
+	}
+	if total.Count > 0 {
+		total.AvgDocSize = float64(total.Size) / float64(total.Count)
+	}
+	return total
+}
@@ -0,0 +1,53 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// EnsureUniqueKey는 모든 샤드에 고유 제약을 준비하고 항상 false를 반환합니다
+// 샤드의 고유 인덱스는 그 샤드 안의 중복만 막으므로, 샤드를 넘는 중복은 호출자가 FindByUniqueKey로 확인해야 합니다
+func (r *Repository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		enforcer, ok := repo.(repository.UniqueKeyEnforcer)
+		if !ok {
+			return fmt.Errorf("repository does not support unique keys")
+		}
+		_, err := enforcer.EnsureUniqueKey(ctx, collection, fields)
+		return err
+	})
+	return false, err
+}
+
+// FindByUniqueKey는 모든 샤드에서 key와 같은 값을 가진 문서의 ID를 모읍니다 (limit은 전체에 적용)
+func (r *Repository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	var mu sync.Mutex
+	var ids []string
+	err := r.broadcast(ctx, r.all(), func(ctx context.Context, _ int, repo repository.DocumentRepository) error {
+		enforcer, ok := repo.(repository.UniqueKeyEnforcer)
+		if !ok {
+			return fmt.Errorf("repository does not support unique keys")
+		}
+		found, err := enforcer.FindByUniqueKey(ctx, collection, key, limit)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		ids = append(ids, found...)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(ids)
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
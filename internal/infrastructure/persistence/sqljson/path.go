@@ -0,0 +1,101 @@
+// Package sqljson은 SQL 저장소의 JSON 컬럼 필드 경로를 검증하고 바인드 파라미터 형식으로 변환합니다
+//
+// 필터/정렬/업데이트 키는 사용자 입력이므로 SQL 문자열에 직접 넣지 않고,
+// 허용된 문자로만 구성되었는지 검증한 뒤 경로 자체를 바인드 파라미터로 전달합니다
+package sqljson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFieldPath는 허용되지 않는 필드 경로의 에러입니다
+var ErrInvalidFieldPath = errors.New("invalid field path")
+
+const (
+	// MaxDepth는 중첩 경로의 최대 깊이입니다
+	MaxDepth = 16
+
+	// MaxSegmentLength는 경로 세그먼트의 최대 길이입니다
+	MaxSegmentLength = 64
+)
+
+// Path는 검증된 JSON 필드 경로입니다 (예: "address.city" -> ["address", "city"])
+// 숫자 세그먼트는 배열 인덱스로 해석합니다 (예: "items.0.sku")
+type Path []string
+
+// Parse는 점으로 구분된 필드 경로를 검증합니다
+// 세그먼트는 영문자, 숫자, '_', '-'만 허용합니다
+func Parse(field string) (Path, error) {
+	if field == "" {
+		return nil, fmt.Errorf("%w: empty field", ErrInvalidFieldPath)
+	}
+
+	segments := strings.Split(field, ".")
+	if len(segments) > MaxDepth {
+		return nil, fmt.Errorf("%w: %q exceeds max depth %d", ErrInvalidFieldPath, field, MaxDepth)
+	}
+	for _, segment := range segments {
+		if segment == "" || len(segment) > MaxSegmentLength {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidFieldPath, field)
+		}
+		for _, c := range segment {
+			if !isAllowed(c) {
+				return nil, fmt.Errorf("%w: %q contains disallowed character %q", ErrInvalidFieldPath, field, c)
+			}
+		}
+	}
+	return Path(segments), nil
+}
+
+// isAllowed는 세그먼트에 허용되는 문자인지 확인합니다
+func isAllowed(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+// Postgres는 PostgreSQL text[] 경로 리터럴을 반환합니다 (예: {address,city})
+// $n::text[] 바인드 파라미터로 #>, #>>, jsonb_set에 전달합니다
+func (p Path) Postgres() string {
+	return "{" + strings.Join(p, ",") + "}"
+}
+
+// MySQL은 MySQL JSON 경로 표현식을 반환합니다 (예: $."address"."city", $."items"[0])
+// JSON_EXTRACT, JSON_SET의 바인드 파라미터로 전달합니다
+func (p Path) MySQL() string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, segment := range p {
+		if _, err := strconv.Atoi(segment); err == nil {
+			b.WriteString("[" + segment + "]")
+			continue
+		}
+		b.WriteString(`."` + segment + `"`)
+	}
+	return b.String()
+}
+
+// String은 점으로 구분된 원래 경로를 반환합니다
+func (p Path) String() string {
+	return strings.Join(p, ".")
+}
+
+// IsIDField는 문서 ID 컬럼을 가리키는 키인지 확인합니다
+func IsIDField(field string) bool {
+	return field == "_id" || field == "id"
+}
+
+// Text는 값을 JSON 필드의 텍스트 추출 결과(PostgreSQL #>>, MySQL JSON_UNQUOTE)와 같은 형식의 문자열로 변환합니다
+// 문자열은 그대로, 그 밖의 값은 JSON 표현을 사용합니다 (1 -> "1", true -> "true")
+func Text(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode value: %w", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,147 @@
+// Package sqltx는 SQL 저장소(PostgreSQL, MySQL, Vitess)의 트랜잭션을 context로 전달합니다
+//
+// WithTransaction이 시작한 *sql.Tx를 context에 담아 두면, 같은 context로 호출한 저장소 작업이
+// 연결 풀 대신 그 트랜잭션에서 실행됩니다
+// 트랜잭션은 *sql.DB별로 구분하므로 다른 데이터베이스의 작업은 영향을 받지 않습니다
+package sqltx
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// Querier는 *sql.DB와 *sql.Tx가 공통으로 제공하는 쿼리 실행 메서드입니다
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// txKey는 트랜잭션을 context에 저장하는 키입니다 (트랜잭션을 시작한 *sql.DB별로 구분)
+type txKey struct {
+	db *sql.DB
+}
+
+// WithTx는 db의 트랜잭션 tx를 담은 context를 반환합니다
+func WithTx(ctx context.Context, db *sql.DB, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{db: db}, tx)
+}
+
+// FromContext는 context에 담긴 db의 트랜잭션을 반환합니다
+func FromContext(ctx context.Context, db *sql.DB) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{db: db}).(*sql.Tx)
+	return tx, ok
+}
+
+// Conn은 context에 db의 트랜잭션이 있으면 그 트랜잭션을, 없으면 db를 반환합니다
+func Conn(ctx context.Context, db *sql.DB) Querier {
+	if tx, ok := FromContext(ctx, db); ok {
+		return tx
+	}
+	return db
+}
+
+// Tx는 저장소 작업 하나가 사용하는 트랜잭션입니다
+// context에 이미 트랜잭션이 있으면 그 트랜잭션에 참여하며, 이때 Commit과 Rollback은 아무 동작도 하지 않고
+// 커밋 여부는 바깥 WithTransaction이 결정합니다 (작업의 에러가 바깥으로 전달되면 전체가 롤백됨)
+type Tx struct {
+	*sql.Tx
+	owned bool
+}
+
+// Begin은 저장소 작업 하나를 위한 트랜잭션을 시작하거나 context의 트랜잭션에 참여합니다
+// 참여하는 경우 opts는 무시되고 바깥 트랜잭션의 옵션을 따릅니다
+func Begin(ctx context.Context, db *sql.DB, opts *sql.TxOptions) (*Tx, error) {
+	if tx, ok := FromContext(ctx, db); ok {
+		return &Tx{Tx: tx}, nil
+	}
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, owned: true}, nil
+}
+
+// Commit은 직접 시작한 트랜잭션만 커밋합니다
+func (t *Tx) Commit() error {
+	if !t.owned {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+// Rollback은 직접 시작한 트랜잭션만 롤백합니다
+func (t *Tx) Rollback() error {
+	if !t.owned {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
+// Options는 저장소 트랜잭션 옵션을 database/sql 옵션으로 변환합니다
+func Options(opts *repository.TxOptions) (*sql.TxOptions, error) {
+	if opts == nil {
+		return nil, nil
+	}
+	sqlOpts := &sql.TxOptions{ReadOnly: opts.ReadOnly}
+	switch opts.Isolation {
+	case repository.IsolationDefault:
+		sqlOpts.Isolation = sql.LevelDefault
+	case repository.IsolationReadUncommitted:
+		sqlOpts.Isolation = sql.LevelReadUncommitted
+	case repository.IsolationReadCommitted:
+		sqlOpts.Isolation = sql.LevelReadCommitted
+	case repository.IsolationRepeatableRead:
+		sqlOpts.Isolation = sql.LevelRepeatableRead
+	case repository.IsolationSnapshot:
+		sqlOpts.Isolation = sql.LevelSnapshot
+	case repository.IsolationSerializable:
+		sqlOpts.Isolation = sql.LevelSerializable
+	default:
+		return nil, fmt.Errorf("unsupported isolation level: %s", opts.Isolation)
+	}
+	return sqlOpts, nil
+}
+
+// Run은 opts로 트랜잭션을 시작해 fn을 실행하고, fn이 성공하면 커밋합니다
+// fn에 전달되는 ctx에는 트랜잭션이 담겨 있어 그 ctx로 호출한 저장소 작업이 같은 트랜잭션에서 실행됩니다
+// ctx에 이미 같은 db의 트랜잭션이 있으면 새로 시작하지 않고 그 트랜잭션 안에서 fn을 실행합니다
+func Run(ctx context.Context, db *sql.DB, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
+	if _, ok := FromContext(ctx, db); ok {
+		return fn(ctx)
+	}
+
+	sqlOpts, err := Options(opts)
+	if err != nil {
+		return err
+	}
+	if opts != nil && opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	tx, err := db.BeginTx(ctx, sqlOpts)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(WithTx(ctx, db, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+// Package stmtcache는 SQL 연결마다 쿼리 문자열을 키로 하는 LRU prepared statement 캐시를 제공합니다
+//
+// database/sql은 인자가 있는 쿼리를 실행할 때마다 드라이버에서 statement를 새로 준비하고 닫습니다
+// 이 패키지는 driver.Conn을 감싸 같은 쿼리 문자열의 statement를 연결이 닫힐 때까지 재사용하므로
+// 준비 왕복과 서버의 파싱/플래닝 부하가 줄어듭니다
+// database/sql은 한 연결을 동시에 한 고루틴에서만 사용하므로 캐시는 잠금 없이 동작합니다
+package stmtcache
+
+import (
+	"container/list"
+	"context"
+	"database/sql/driver"
+	"errors"
+)
+
+// DefaultSize는 연결당 기본 캐시 크기입니다
+const DefaultSize = 128
+
+// Options는 statement 캐시 설정입니다
+type Options struct {
+	// Size는 연결당 캐시할 최대 statement 수입니다 (0이면 DefaultSize)
+	Size int
+
+	// Invalidate가 true를 반환하는 에러가 발생하면 해당 statement를 캐시에서 제거합니다
+	// 스키마 변경 등으로 서버에서 무효화된 statement를 다음 호출에서 다시 준비하기 위해 사용합니다
+	Invalidate func(err error) bool
+
+	// Bypass가 true를 반환하는 쿼리는 캐시하지 않고 드라이버에 그대로 전달합니다
+	// 닫을 때 동작이 끝나는 statement(PostgreSQL COPY 등)처럼 재사용할 수 없는 쿼리에 사용합니다
+	Bypass func(query string) bool
+}
+
+// Wrap은 연결에 statement 캐시를 붙입니다
+func Wrap(conn driver.Conn, opts Options) driver.Conn {
+	if opts.Size <= 0 {
+		opts.Size = DefaultSize
+	}
+	return &cachedConn{
+		Conn:    conn,
+		opts:    opts,
+		entries: make(map[string]*list.Element, opts.Size),
+		lru:     list.New(),
+	}
+}
+
+// entry는 캐시된 statement입니다
+type entry struct {
+	query string
+	stmt  driver.Stmt
+}
+
+// cachedConn은 statement 캐시를 가진 driver.Conn입니다
+type cachedConn struct {
+	driver.Conn
+	opts    Options
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// prepare는 캐시된 statement를 반환하고, 없으면 준비해서 캐시에 추가합니다
+func (c *cachedConn) prepare(ctx context.Context, query string) (driver.Stmt, error) {
+	if elem, ok := c.entries[query]; ok {
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*entry).stmt, nil
+	}
+
+	stmt, err := c.prepareUncached(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[query] = c.lru.PushFront(&entry{query: query, stmt: stmt})
+	for c.lru.Len() > c.opts.Size {
+		c.evict(c.lru.Back())
+	}
+	return stmt, nil
+}
+
+// prepareUncached는 캐시를 거치지 않고 statement를 준비합니다
+func (c *cachedConn) prepareUncached(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+// evict는 statement를 캐시에서 제거하고 닫습니다
+func (c *cachedConn) evict(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.entries, e.query)
+	_ = e.stmt.Close()
+}
+
+// checkError는 에러가 statement 무효화에 해당하면 캐시에서 제거합니다
+func (c *cachedConn) checkError(query string, err error) {
+	if err == nil {
+		return
+	}
+	if errors.Is(err, driver.ErrBadConn) || (c.opts.Invalidate != nil && c.opts.Invalidate(err)) {
+		if elem, ok := c.entries[query]; ok {
+			c.evict(elem)
+		}
+	}
+}
+
+// bypass는 캐시하지 않는 쿼리인지 확인합니다
+func (c *cachedConn) bypass(query string) bool {
+	return c.opts.Bypass != nil && c.opts.Bypass(query)
+}
+
+// Prepare는 캐시된 statement를 반환합니다
+func (c *cachedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext는 캐시된 statement를 반환합니다
+// 반환한 statement의 Close는 캐시가 소유한 statement를 닫지 않습니다
+func (c *cachedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if c.bypass(query) {
+		return c.prepareUncached(ctx, query)
+	}
+	stmt, err := c.prepare(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedStmt{Stmt: stmt, conn: c, query: query}, nil
+}
+
+// QueryContext는 인자가 있는 쿼리를 캐시된 statement로 실행합니다
+// 인자가 없는 쿼리(DDL 등)는 캐시하지 않고 드라이버에 그대로 전달합니다
+func (c *cachedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) == 0 || c.bypass(query) {
+		if queryer, ok := c.Conn.(driver.QueryerContext); ok {
+			return queryer.QueryContext(ctx, query, args)
+		}
+	}
+
+	stmt, err := c.prepare(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := queryStmt(ctx, stmt, args)
+	c.checkError(query, err)
+	return rows, err
+}
+
+// ExecContext는 인자가 있는 명령을 캐시된 statement로 실행합니다
+// 인자가 없는 명령(DDL 등)은 캐시하지 않고 드라이버에 그대로 전달합니다
+func (c *cachedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if len(args) == 0 || c.bypass(query) {
+		if execer, ok := c.Conn.(driver.ExecerContext); ok {
+			return execer.ExecContext(ctx, query, args)
+		}
+	}
+
+	stmt, err := c.prepare(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result, err := execStmt(ctx, stmt, args)
+	c.checkError(query, err)
+	return result, err
+}
+
+// BeginTx는 트랜잭션을 시작합니다 (같은 연결의 캐시된 statement는 트랜잭션 안에서도 사용됩니다)
+func (c *cachedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin() // ConnBeginTx를 구현하지 않는 드라이버용
+}
+
+// Ping은 드라이버의 Ping을 호출합니다
+func (c *cachedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// ResetSession은 드라이버의 ResetSession을 호출합니다
+func (c *cachedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+// IsValid는 드라이버의 IsValid를 호출합니다
+func (c *cachedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+// CheckNamedValue는 드라이버의 인자 변환을 사용합니다
+func (c *cachedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// Close는 캐시된 statement를 모두 닫고 연결을 닫습니다
+func (c *cachedConn) Close() error {
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+	return c.Conn.Close()
+}
+
+// cachedStmt는 PrepareContext가 반환하는 statement입니다
+// sql.Stmt가 닫혀도 캐시된 statement는 연결이 닫히거나 밀려날 때까지 유지됩니다
+// 임베드한 Stmt는 NumInput에만 사용하고 실행은 항상 캐시를 거칩니다
+type cachedStmt struct {
+	driver.Stmt
+	conn  *cachedConn
+	query string
+}
+
+// Close는 캐시된 statement를 닫지 않습니다
+func (s *cachedStmt) Close() error {
+	return nil
+}
+
+// QueryContext는 캐시된 statement로 쿼리를 실행합니다
+// 그 사이 statement가 캐시에서 밀려났으면 다시 준비합니다
+func (s *cachedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+// ExecContext는 캐시된 statement로 명령을 실행합니다
+// 그 사이 statement가 캐시에서 밀려났으면 다시 준비합니다
+func (s *cachedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+// CheckNamedValue는 드라이버의 인자 변환을 사용합니다
+func (s *cachedStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return s.conn.CheckNamedValue(nv)
+}
+
+// queryStmt는 StmtQueryContext를 지원하지 않는 드라이버도 처리합니다
+func queryStmt(ctx context.Context, stmt driver.Stmt, args []driver.NamedValue) (driver.Rows, error) {
+	if queryer, ok := stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+	values, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Query(values) // StmtQueryContext를 구현하지 않는 드라이버용
+}
+
+// execStmt는 StmtExecContext를 지원하지 않는 드라이버도 처리합니다
+func execStmt(ctx context.Context, stmt driver.Stmt, args []driver.NamedValue) (driver.Result, error) {
+	if execer, ok := stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+	values, err := namedValuesToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.Exec(values) // StmtExecContext를 구현하지 않는 드라이버용
+}
+
+// namedValuesToValues는 위치 인자만 허용합니다
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, errors.New("stmtcache: driver does not support named parameters")
+		}
+		values[i] = arg.Value
+	}
+	return values, nil
+}
@@ -29,10 +29,10 @@ func (r *VitessRepository) Aggregate(ctx context.Context, collection string, pip
 	// 파이프라인 분석 및 SQL로 변환
 	var whereClauses []string
 	var groupBy string
-	var having string
 	var orderBy string
 	var limit string
 	var skip string
+	var sortArgs []interface{} // ORDER BY는 WHERE 뒤에 오므로 인자를 따로 모읍니다
 
 	for _, stage := range pipeline {
 		for key, value := range stage {
@@ -40,22 +40,30 @@ func (r *VitessRepository) Aggregate(ctx context.Context, collection string, pip
 			case "$match":
 				// $match를 WHERE 절로 변환
 				if matchConditions, ok := value.(bson.M); ok {
-					for field, condition := range matchConditions {
-						whereClauses = append(whereClauses, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = ?", field))
-						args = append(args, condition)
+					conditions, matchArgs, err := jsonFilterConditions(matchConditions)
+					if err != nil {
+						return nil, err
 					}
+					whereClauses = append(whereClauses, conditions...)
+					args = append(args, matchArgs...)
 				}
 
 			case "$sort":
 				// $sort를 ORDER BY로 변환
 				if sortFields, ok := value.(bson.M); ok {
 					var sortClauses []string
+					sortArgs = sortArgs[:0]
 					for field, order := range sortFields {
 						direction := "ASC"
 						if order == -1 {
 							direction = "DESC"
 						}
-						sortClauses = append(sortClauses, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') %s", field, direction))
+						path, err := jsonPath(field)
+						if err != nil {
+							return nil, err
+						}
+						sortClauses = append(sortClauses, fmt.Sprintf("JSON_EXTRACT(data, ?) %s", direction))
+						sortArgs = append(sortArgs, path)
 					}
 					orderBy = " ORDER BY " + strings.Join(sortClauses, ", ")
 				}
@@ -94,6 +102,7 @@ func (r *VitessRepository) Aggregate(ctx context.Context, collection string, pip
 
 	// GROUP BY, ORDER BY, LIMIT, OFFSET 추가
 	query += groupBy + orderBy + limit + skip
+	args = append(args, sortArgs...)
 
 	logger.Debug(ctx, "executing aggregate",
 		logger.Collection(collection),
@@ -101,7 +110,7 @@ func (r *VitessRepository) Aggregate(ctx context.Context, collection string, pip
 		logger.Field("args", args),
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		r.metrics.RecordDBOperation("aggregate", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to execute aggregate",
@@ -147,22 +156,27 @@ func (r *VitessRepository) Distinct(ctx context.Context, collection, field strin
 		r.metrics.RecordDBOperation("distinct", collection, "success", duration)
 	}()
 
+	fieldPath, err := jsonPath(field)
+	if err != nil {
+		return nil, err
+	}
+
 	// JSON_EXTRACT를 사용하여 특정 필드의 고유 값 조회
-	query := fmt.Sprintf(`
-		SELECT DISTINCT JSON_EXTRACT(data, '$.%s') as value
+	query := `
+		SELECT DISTINCT JSON_EXTRACT(data, ?) as value
 		FROM documents
 		WHERE collection = ?
-	`, field)
+	`
 
-	args := []interface{}{collection}
+	args := []interface{}{fieldPath, collection}
 
 	// 필터 조건 추가
 	if len(filter) > 0 {
-		conditions := []string{}
-		for key, value := range filter {
-			conditions = append(conditions, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = ?", key))
-			args = append(args, value)
+		conditions, filterArgs, err := jsonFilterConditions(filter)
+		if err != nil {
+			return nil, err
 		}
+		args = append(args, filterArgs...)
 		if len(conditions) > 0 {
 			query += " AND " + strings.Join(conditions, " AND ")
 		}
@@ -174,7 +188,7 @@ func (r *VitessRepository) Distinct(ctx context.Context, collection, field strin
 		logger.Field("query", query),
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		r.metrics.RecordDBOperation("distinct", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to execute distinct",
@@ -246,7 +260,7 @@ func (r *VitessRepository) EstimatedDocumentCount(ctx context.Context, collectio
 	`
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, collection).Scan(&count)
+	err := r.getDB(ctx).QueryRowContext(ctx, query, collection).Scan(&count)
 	if err != nil {
 		r.metrics.RecordDBOperation("estimated_count", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to get estimated document count",
@@ -280,18 +294,18 @@ func (r *VitessRepository) CountWithFilter(ctx context.Context, collection strin
 
 	// 필터 조건 추가
 	if len(filter) > 0 {
-		conditions := []string{}
-		for key, value := range filter {
-			conditions = append(conditions, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = ?", key))
-			args = append(args, value)
+		conditions, filterArgs, err := jsonFilterConditions(filter)
+		if err != nil {
+			return 0, err
 		}
+		args = append(args, filterArgs...)
 		if len(conditions) > 0 {
 			query += " AND " + strings.Join(conditions, " AND ")
 		}
 	}
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	err := r.getDB(ctx).QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		r.metrics.RecordDBOperation("count_with_filter", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to count documents",
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -22,7 +23,7 @@ func (r *VitessRepository) FindAndUpdate(ctx context.Context, collection, id str
 	}()
 
 	// 트랜잭션 시작 (원자성 보장)
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{
 		Isolation: sql.LevelSerializable, // 직렬화 격리 수준으로 비관적 잠금 구현
 	})
 	if err != nil {
@@ -147,7 +148,7 @@ func (r *VitessRepository) FindOneAndReplace(ctx context.Context, collection, id
 	}()
 
 	// 트랜잭션 시작
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{
 		Isolation: sql.LevelSerializable,
 	})
 	if err != nil {
@@ -260,7 +261,7 @@ func (r *VitessRepository) FindOneAndDelete(ctx context.Context, collection, id
 	}()
 
 	// 트랜잭션 시작
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{
+	tx, err := sqltx.Begin(ctx, r.db, &sql.TxOptions{
 		Isolation: sql.LevelSerializable,
 	})
 	if err != nil {
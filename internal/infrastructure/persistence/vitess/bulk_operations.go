@@ -10,6 +10,7 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -29,7 +30,7 @@ func (r *VitessRepository) SaveMany(ctx context.Context, docs []*entity.Document
 	}()
 
 	// 트랜잭션 시작
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to begin transaction", zap.Error(err))
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -115,7 +116,7 @@ func (r *VitessRepository) UpdateMany(ctx context.Context, collection string, fi
 	}
 
 	// 트랜잭션 시작
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to begin transaction", zap.Error(err))
 		return 0, fmt.Errorf("failed to begin transaction: %w", err)
@@ -131,11 +132,14 @@ func (r *VitessRepository) UpdateMany(ctx context.Context, collection string, fi
 	var updated int64
 	for _, doc := range docs {
 		// 업데이트 적용
+		data := doc.Data()
 		for key, value := range update {
-			doc.Data()[key] = value
+			data[key] = value
+		}
+		if err := doc.Update(data); err != nil {
+			_ = tx.Rollback()
+			return 0, err
 		}
-
-		doc.IncrementVersion()
 
 		dataJSON, err := json.Marshal(doc.Data())
 		if err != nil {
@@ -205,17 +209,17 @@ func (r *VitessRepository) DeleteMany(ctx context.Context, collection string, fi
 
 	// 필터 조건 추가
 	if len(filter) > 0 {
-		conditions := []string{}
-		for key, value := range filter {
-			conditions = append(conditions, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = ?", key))
-			args = append(args, value)
+		conditions, filterArgs, err := jsonFilterConditions(filter)
+		if err != nil {
+			return 0, err
 		}
+		args = append(args, filterArgs...)
 		if len(conditions) > 0 {
 			query += " AND " + strings.Join(conditions, " AND ")
 		}
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.getDB(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		r.metrics.RecordDBOperation("delete_many", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to delete many documents",
@@ -258,7 +262,7 @@ func (r *VitessRepository) BulkWrite(ctx context.Context, operations []*reposito
 	}
 
 	// 트랜잭션 시작
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to begin transaction", zap.Error(err))
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
@@ -271,19 +275,19 @@ func (r *VitessRepository) BulkWrite(ctx context.Context, operations []*reposito
 		}
 	}()
 
-	txCtx := context.WithValue(ctx, "tx", tx)
+	txCtx := sqltx.WithTx(ctx, r.db, tx.Tx)
 
 	for i, op := range operations {
 		switch op.Type {
 		case "insert":
-			if err := r.bulkInsert(txCtx, tx, op); err != nil {
+			if err := r.bulkInsert(txCtx, tx.Tx, op); err != nil {
 				_ = tx.Rollback()
 				return nil, fmt.Errorf("bulk insert failed at index %d: %w", i, err)
 			}
 			result.InsertedCount++
 
 		case "update":
-			matched, modified, err := r.bulkUpdate(txCtx, tx, op)
+			matched, modified, err := r.bulkUpdate(txCtx, tx.Tx, op)
 			if err != nil {
 				_ = tx.Rollback()
 				return nil, fmt.Errorf("bulk update failed at index %d: %w", i, err)
@@ -292,7 +296,7 @@ func (r *VitessRepository) BulkWrite(ctx context.Context, operations []*reposito
 			result.ModifiedCount += modified
 
 		case "delete":
-			deleted, err := r.bulkDelete(txCtx, tx, op)
+			deleted, err := r.bulkDelete(txCtx, tx.Tx, op)
 			if err != nil {
 				_ = tx.Rollback()
 				return nil, fmt.Errorf("bulk delete failed at index %d: %w", i, err)
@@ -300,7 +304,7 @@ func (r *VitessRepository) BulkWrite(ctx context.Context, operations []*reposito
 			result.DeletedCount += deleted
 
 		case "replace":
-			matched, modified, err := r.bulkReplace(txCtx, tx, op)
+			matched, modified, err := r.bulkReplace(txCtx, tx.Tx, op)
 			if err != nil {
 				_ = tx.Rollback()
 				return nil, fmt.Errorf("bulk replace failed at index %d: %w", i, err)
@@ -374,11 +378,13 @@ func (r *VitessRepository) bulkUpdate(ctx context.Context, tx *sql.Tx, op *repos
 	}
 
 	for _, doc := range docsToUpdate {
+		data := doc.Data()
 		for key, value := range op.Update {
-			doc.Data()[key] = value
+			data[key] = value
+		}
+		if err := doc.Update(data); err != nil {
+			return 0, 0, err
 		}
-
-		doc.IncrementVersion()
 
 		dataJSON, err := json.Marshal(doc.Data())
 		if err != nil {
@@ -415,11 +421,11 @@ func (r *VitessRepository) bulkDelete(ctx context.Context, tx *sql.Tx, op *repos
 
 	// 필터 조건 추가
 	if len(op.Filter) > 0 {
-		conditions := []string{}
-		for key, value := range op.Filter {
-			conditions = append(conditions, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = ?", key))
-			args = append(args, value)
+		conditions, filterArgs, err := jsonFilterConditions(op.Filter)
+		if err != nil {
+			return 0, err
 		}
+		args = append(args, filterArgs...)
 		if len(conditions) > 0 {
 			query += " AND " + strings.Join(conditions, " AND ")
 		}
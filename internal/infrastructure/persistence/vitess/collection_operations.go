@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
@@ -240,7 +241,7 @@ func (r *VitessRepository) CollectionExists(ctx context.Context, name string) (b
 // Vitess/MySQL에서는 Change Streams를 직접 지원하지 않으므로
 // 이 메서드는 에러를 반환합니다
 // 실시간 변경 감지가 필요한 경우 Kafka CDC를 사용해야 합니다
-func (r *VitessRepository) Watch(ctx context.Context, collection string, pipeline []interface{}) (*mongo.ChangeStream, error) {
+func (r *VitessRepository) Watch(ctx context.Context, collection string, pipeline []bson.M) (*mongo.ChangeStream, error) {
 	logger.Warn(ctx, "watch is not supported in Vitess, use Kafka CDC instead",
 		logger.Collection(collection),
 	)
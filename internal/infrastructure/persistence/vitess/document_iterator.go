@@ -0,0 +1,62 @@
+package vitess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// FindStream은 옵션을 사용하여 문서를 조회하고 결과를 행 단위 커서로 반환합니다
+// 커서를 닫을 때까지 연결 하나를 점유하므로 사용 후 반드시 Close를 호출해야 합니다
+func (r *VitessRepository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	start := time.Now()
+
+	query, args, err := buildFindQuery(collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		r.metrics.RecordDBOperation("find_stream", collection, "error", time.Since(start))
+		logger.Error(ctx, "failed to open document cursor",
+			logger.Collection(collection),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to find documents: %w", err)
+	}
+	r.metrics.RecordDBOperation("find_stream", collection, "success", time.Since(start))
+
+	return &documentIterator{rows: rows, opts: opts}, nil
+}
+
+// documentIterator는 sql.Rows를 DocumentIterator로 감쌉니다
+type documentIterator struct {
+	rows *sql.Rows
+	opts *repository.FindOptions
+}
+
+func (it *documentIterator) Next(ctx context.Context) bool {
+	return it.rows.Next()
+}
+
+func (it *documentIterator) Decode() (*entity.Document, error) {
+	return scanDocument(it.rows, it.opts)
+}
+
+func (it *documentIterator) Err() error {
+	if err := it.rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %w", err)
+	}
+	return nil
+}
+
+func (it *documentIterator) Close(ctx context.Context) error {
+	return it.rows.Close()
+}
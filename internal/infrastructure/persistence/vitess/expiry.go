@@ -0,0 +1,61 @@
+package vitess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	gomysql "github.com/go-sql-driver/mysql"
+)
+
+const (
+	mysqlErrDuplicateColumn  = 1060
+	mysqlErrDuplicateKeyName = 1061
+)
+
+// expiresAtValue는 문서의 만료 시각을 expires_at 컬럼 값으로 변환합니다 (만료가 없으면 NULL)
+func expiresAtValue(doc *entity.Document) interface{} {
+	if doc.ExpiresAt().IsZero() {
+		return nil
+	}
+	return doc.ExpiresAt().UTC()
+}
+
+// EnableExpiry는 expires_at 컬럼과 인덱스가 없는 이전 documents 테이블에 추가합니다
+// Vitess는 만료 문서를 스스로 삭제하지 않으므로 false를 반환하며, DeleteExpired로 주기적으로 정리해야 합니다
+func (r *VitessRepository) EnableExpiry(ctx context.Context, collection string) (bool, error) {
+	for _, ddl := range []struct {
+		query  string
+		ignore uint16
+	}{
+		{`ALTER TABLE documents ADD COLUMN expires_at DATETIME(6) NULL`, mysqlErrDuplicateColumn},
+		{`CREATE INDEX idx_expires_at ON documents (collection, expires_at)`, mysqlErrDuplicateKeyName},
+	} {
+		if _, err := r.db.ExecContext(ctx, ddl.query); err != nil {
+			var mysqlErr *gomysql.MySQLError
+			if !errors.As(err, &mysqlErr) || mysqlErr.Number != ddl.ignore {
+				return false, fmt.Errorf("failed to prepare expiry column: %w", err)
+			}
+		}
+	}
+	return false, nil
+}
+
+// DeleteExpired는 컬렉션에서 before 시점까지 만료된 문서를 삭제합니다
+func (r *VitessRepository) DeleteExpired(ctx context.Context, collection string, before time.Time, limit int) (int64, error) {
+	start := time.Now()
+	query := `DELETE FROM documents WHERE collection = ? AND expires_at <= ?`
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	result, err := r.getDB(ctx).ExecContext(ctx, query, collection, before.UTC())
+	if err != nil {
+		r.metrics.RecordDBOperation("delete_expired", collection, "error", time.Since(start))
+		return 0, fmt.Errorf("failed to delete expired documents: %w", err)
+	}
+	r.metrics.RecordDBOperation("delete_expired", collection, "success", time.Since(start))
+	return result.RowsAffected()
+}
@@ -48,7 +48,12 @@ func (r *VitessRepository) CreateIndex(ctx context.Context, collection string, m
 		} else {
 			// JSON 필드에 대한 인덱스
 			// (JSON_EXTRACT를 직접 인덱스로 사용)
-			indexFields = append(indexFields, fmt.Sprintf("(CAST(JSON_EXTRACT(data, '$.%s') AS CHAR(255))) %s", field, direction))
+			// DDL은 바인드 파라미터를 쓸 수 없으므로 검증된 경로만 리터럴로 넣습니다
+			path, err := jsonPath(field)
+			if err != nil {
+				return "", err
+			}
+			indexFields = append(indexFields, fmt.Sprintf("(CAST(JSON_EXTRACT(data, '%s') AS CHAR(255))) %s", path, direction))
 		}
 	}
 
@@ -0,0 +1,30 @@
+package vitess
+
+import (
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+)
+
+// jsonPath는 필드 경로를 검증하고 JSON_EXTRACT 바인드 파라미터용 경로로 변환합니다
+func jsonPath(field string) (string, error) {
+	path, err := sqljson.Parse(field)
+	if err != nil {
+		return "", err
+	}
+	return path.MySQL(), nil
+}
+
+// jsonFilterConditions는 필터를 JSON_EXTRACT 조건과 바인드 인자로 변환합니다
+// 필드 경로도 바인드 파라미터로 전달하므로 필터 키가 SQL 문자열에 직접 들어가지 않습니다
+func jsonFilterConditions(filter map[string]interface{}) ([]string, []interface{}, error) {
+	conditions := make([]string, 0, len(filter))
+	args := make([]interface{}, 0, len(filter)*2)
+	for key, value := range filter {
+		path, err := jsonPath(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, "JSON_EXTRACT(data, ?) = ?")
+		args = append(args, path, value)
+	}
+	return conditions, args, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -23,6 +24,49 @@ func (r *VitessRepository) FindWithOptions(ctx context.Context, collection strin
 		r.metrics.RecordDBOperation("find_with_options", collection, "success", duration)
 	}()
 
+	query, args, err := buildFindQuery(collection, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(ctx, "executing find with options",
+		logger.Collection(collection),
+		logger.Field("query", query),
+		logger.Field("args", args),
+	)
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		r.metrics.RecordDBOperation("find_with_options", collection, "error", time.Since(start))
+		logger.Error(ctx, "failed to find documents with options",
+			logger.Collection(collection),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to find documents: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []*entity.Document
+	for rows.Next() {
+		doc, err := scanDocument(rows, opts)
+		if err != nil {
+			logger.Warn(ctx, "failed to scan document", zap.Error(err))
+			continue
+		}
+		documents = append(documents, doc)
+	}
+
+	logger.Info(ctx, "documents found with options",
+		logger.Collection(collection),
+		logger.Field("count", len(documents)),
+		logger.Duration(time.Since(start)),
+	)
+
+	return documents, nil
+}
+
+// buildFindQuery는 FindWithOptions/FindStream의 SELECT 쿼리를 만듭니다 (opts는 nil 가능)
+func buildFindQuery(collection string, filter map[string]interface{}, opts *repository.FindOptions) (string, []interface{}, error) {
 	// 기본 쿼리
 	query := `
 		SELECT id, collection, data, version, created_at, updated_at
@@ -34,11 +78,11 @@ func (r *VitessRepository) FindWithOptions(ctx context.Context, collection strin
 
 	// 필터 조건 추가 (JSON 필드 검색)
 	if len(filter) > 0 {
-		conditions := []string{}
-		for key, value := range filter {
-			conditions = append(conditions, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') = ?", key))
-			args = append(args, value)
+		conditions, filterArgs, err := jsonFilterConditions(filter)
+		if err != nil {
+			return "", nil, err
 		}
+		args = append(args, filterArgs...)
 		if len(conditions) > 0 {
 			query += " AND " + strings.Join(conditions, " AND ")
 		}
@@ -56,7 +100,12 @@ func (r *VitessRepository) FindWithOptions(ctx context.Context, collection strin
 			if field == "created_at" || field == "updated_at" {
 				sortClauses = append(sortClauses, fmt.Sprintf("%s %s", field, direction))
 			} else {
-				sortClauses = append(sortClauses, fmt.Sprintf("JSON_EXTRACT(data, '$.%s') %s", field, direction))
+				path, err := jsonPath(field)
+				if err != nil {
+					return "", nil, err
+				}
+				sortClauses = append(sortClauses, fmt.Sprintf("JSON_EXTRACT(data, ?) %s", direction))
+				args = append(args, path)
 			}
 		}
 		query += " ORDER BY " + strings.Join(sortClauses, ", ")
@@ -74,69 +123,43 @@ func (r *VitessRepository) FindWithOptions(ctx context.Context, collection strin
 		query += fmt.Sprintf(" OFFSET %d", opts.Skip)
 	}
 
-	logger.Debug(ctx, "executing find with options",
-		logger.Collection(collection),
-		logger.Field("query", query),
-		logger.Field("args", args),
+	return query, args, nil
+}
+
+// scanDocument는 현재 행을 문서로 변환하고 Projection을 적용합니다
+func scanDocument(rows *sql.Rows, opts *repository.FindOptions) (*entity.Document, error) {
+	var (
+		id        string
+		coll      string
+		dataJSON  []byte
+		version   int
+		createdAt time.Time
+		updatedAt time.Time
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
-	if err != nil {
-		r.metrics.RecordDBOperation("find_with_options", collection, "error", time.Since(start))
-		logger.Error(ctx, "failed to find documents with options",
-			logger.Collection(collection),
-			zap.Error(err),
-		)
-		return nil, fmt.Errorf("failed to find documents: %w", err)
+	if err := rows.Scan(&id, &coll, &dataJSON, &version, &createdAt, &updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
 	}
-	defer rows.Close()
-
-	var documents []*entity.Document
-	for rows.Next() {
-		var (
-			id        string
-			coll      string
-			dataJSON  []byte
-			version   int
-			createdAt time.Time
-			updatedAt time.Time
-		)
-
-		if err := rows.Scan(&id, &coll, &dataJSON, &version, &createdAt, &updatedAt); err != nil {
-			logger.Warn(ctx, "failed to scan row", zap.Error(err))
-			continue
-		}
 
-		var data map[string]interface{}
-		if err := json.Unmarshal(dataJSON, &data); err != nil {
-			logger.Warn(ctx, "failed to unmarshal data", zap.Error(err))
-			continue
-		}
+	var data map[string]interface{}
+	if err := json.Unmarshal(dataJSON, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
 
-		// Projection 적용 (필요한 필드만 선택)
-		if opts != nil && len(opts.Projection) > 0 {
-			projectedData := make(map[string]interface{})
-			for field, include := range opts.Projection {
-				if include == 1 || include == true {
-					if val, exists := data[field]; exists {
-						projectedData[field] = val
-					}
+	// Projection 적용 (필요한 필드만 선택)
+	if opts != nil && len(opts.Projection) > 0 {
+		projectedData := make(map[string]interface{})
+		for field, include := range opts.Projection {
+			if include == 1 || include == true {
+				if val, exists := data[field]; exists {
+					projectedData[field] = val
 				}
 			}
-			data = projectedData
 		}
-
-		doc := entity.ReconstructDocument(id, coll, data, version, createdAt, updatedAt)
-		documents = append(documents, doc)
+		data = projectedData
 	}
 
-	logger.Info(ctx, "documents found with options",
-		logger.Collection(collection),
-		logger.Field("count", len(documents)),
-		logger.Duration(time.Since(start)),
-	)
-
-	return documents, nil
+	return entity.ReconstructDocument(id, coll, data, version, createdAt, updatedAt), nil
 }
 
 // Upsert는 문서가 없으면 생성하고 있으면 업데이트합니다
@@ -159,11 +182,14 @@ func (r *VitessRepository) Upsert(ctx context.Context, collection string, filter
 		doc := docs[0]
 
 		// 업데이트 데이터 병합
+		data := doc.Data()
 		for key, value := range update {
-			doc.Data()[key] = value
+			data[key] = value
+		}
+		if err := doc.Update(data); err != nil {
+			r.metrics.RecordDBOperation("upsert", collection, "error", time.Since(start))
+			return "", err
 		}
-
-		doc.IncrementVersion()
 
 		if err := r.Update(ctx, doc); err != nil {
 			r.metrics.RecordDBOperation("upsert", collection, "error", time.Since(start))
@@ -188,7 +214,11 @@ func (r *VitessRepository) Upsert(ctx context.Context, collection string, filter
 		newData[key] = value
 	}
 
-	doc := entity.NewDocument(collection, newData)
+	doc, err := entity.NewDocument(collection, newData)
+	if err != nil {
+		r.metrics.RecordDBOperation("upsert", collection, "error", time.Since(start))
+		return "", err
+	}
 	if err := r.Save(ctx, doc); err != nil {
 		r.metrics.RecordDBOperation("upsert", collection, "error", time.Since(start))
 		return "", fmt.Errorf("failed to insert document: %w", err)
@@ -235,7 +265,7 @@ func (r *VitessRepository) Replace(ctx context.Context, collection, id string, r
 		WHERE id = ? AND collection = ?
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.getDB(ctx).ExecContext(ctx, query,
 		dataJSON,
 		time.Now(),
 		id,
@@ -270,14 +300,7 @@ func (r *VitessRepository) Replace(ctx context.Context, collection, id string, r
 	return nil
 }
 
-// getDB는 트랜잭션이 있으면 트랜잭션을 사용하고, 없으면 일반 DB를 사용합니다
-func (r *VitessRepository) getDB(ctx context.Context) interface {
-	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
-	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
-	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
-} {
-	if tx, ok := ctx.Value("tx").(*sql.Tx); ok {
-		return tx
-	}
-	return r.db
+// getDB는 WithTransaction의 트랜잭션이 있으면 트랜잭션을 사용하고, 없으면 일반 DB를 사용합니다
+func (r *VitessRepository) getDB(ctx context.Context) sqltx.Querier {
+	return sqltx.Conn(ctx, r.db)
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -47,7 +48,7 @@ func (r *VitessRepository) ExecuteRawQuery(ctx context.Context, query interface{
 
 	if isSelect {
 		// SELECT 쿼리 실행
-		rows, err := r.db.QueryContext(ctx, sqlQuery)
+		rows, err := r.getDB(ctx).QueryContext(ctx, sqlQuery)
 		if err != nil {
 			r.metrics.RecordDBOperation("raw_query", r.keyspace, "error", time.Since(start))
 			logger.Error(ctx, "failed to execute SELECT query",
@@ -74,7 +75,7 @@ func (r *VitessRepository) ExecuteRawQuery(ctx context.Context, query interface{
 	}
 
 	// DML 쿼리 (INSERT/UPDATE/DELETE) 실행
-	result, err := r.db.ExecContext(ctx, sqlQuery)
+	result, err := r.getDB(ctx).ExecContext(ctx, sqlQuery)
 	if err != nil {
 		r.metrics.RecordDBOperation("raw_query", r.keyspace, "error", time.Since(start))
 		logger.Error(ctx, "failed to execute DML query",
@@ -147,7 +148,7 @@ func (r *VitessRepository) ExecuteRawQueryWithResult(ctx context.Context, query
 		return fmt.Errorf("ExecuteRawQueryWithResult only supports SELECT queries")
 	}
 
-	rows, err := r.db.QueryContext(ctx, sqlQuery)
+	rows, err := r.getDB(ctx).QueryContext(ctx, sqlQuery)
 	if err != nil {
 		r.metrics.RecordDBOperation("raw_query_with_result", r.keyspace, "error", time.Since(start))
 		logger.Error(ctx, "failed to execute query",
@@ -208,7 +209,7 @@ func (r *VitessRepository) ExecutePreparedQuery(ctx context.Context, query strin
 
 	if isSelect {
 		// SELECT 쿼리
-		rows, err := r.db.QueryContext(ctx, query, args...)
+		rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
 		if err != nil {
 			r.metrics.RecordDBOperation("prepared_query", r.keyspace, "error", time.Since(start))
 			logger.Error(ctx, "failed to execute prepared SELECT query",
@@ -234,7 +235,7 @@ func (r *VitessRepository) ExecutePreparedQuery(ctx context.Context, query strin
 	}
 
 	// DML 쿼리
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := r.getDB(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
 		r.metrics.RecordDBOperation("prepared_query", r.keyspace, "error", time.Since(start))
 		logger.Error(ctx, "failed to execute prepared DML query",
@@ -278,7 +279,7 @@ func (r *VitessRepository) ExecuteBatch(ctx context.Context, queries []string) (
 	}()
 
 	// 트랜잭션 시작
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := sqltx.Begin(ctx, r.db, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to begin transaction", zap.Error(err))
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
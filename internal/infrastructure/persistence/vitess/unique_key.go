@@ -0,0 +1,74 @@
+package vitess
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+)
+
+// EnsureUniqueKey는 필드 경로만 검증하고 false를 반환합니다
+// 모든 컬렉션이 documents 테이블 하나를 공유하고 샤드를 넘는 고유 인덱스가 없으므로, 호출자가 FindByUniqueKey로 확인해야 합니다
+func (r *VitessRepository) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	for _, field := range fields {
+		if _, err := jsonPath(field); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// FindByUniqueKey는 컬렉션에서 data 필드의 텍스트 값이 key와 같은 문서의 ID를 조회합니다
+func (r *VitessRepository) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	start := time.Now()
+
+	fields := make([]string, 0, len(key))
+	for field := range key {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	conditions := []string{"collection = ?"}
+	args := []interface{}{collection}
+	for _, field := range fields {
+		path, err := jsonPath(field)
+		if err != nil {
+			return nil, err
+		}
+		text, err := sqljson.Text(key[field])
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, "JSON_UNQUOTE(JSON_EXTRACT(data, ?)) = ?")
+		args = append(args, path, text)
+	}
+
+	query := `SELECT id FROM documents WHERE ` + strings.Join(conditions, " AND ")
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		r.metrics.RecordDBOperation("find_unique_key", collection, "error", time.Since(start))
+		return nil, fmt.Errorf("failed to find documents by unique key: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan document id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	r.metrics.RecordDBOperation("find_unique_key", collection, "success", time.Since(start))
+	return ids, nil
+}
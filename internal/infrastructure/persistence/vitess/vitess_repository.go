@@ -9,6 +9,7 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
 	_ "github.com/go-sql-driver/mysql"
@@ -73,22 +74,33 @@ func NewVitessRepository(cfg *Config) (repository.DocumentRepository, error) {
 		return nil, fmt.Errorf("failed to ping vitess: %w", err)
 	}
 
+	repo, err := NewVitessRepositoryWithDB(context.Background(), db, cfg.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(context.Background(), "vitess repository initialized",
+		logger.Field("keyspace", cfg.Keyspace),
+		logger.Field("host", cfg.Host),
+	)
+
+	return repo, nil
+}
+
+// NewVitessRepositoryWithDB는 이미 연결된 *sql.DB로 Vitess 저장소를 생성합니다
+// 호출자가 연결 풀을 관리하는 경우에 사용하며, 연결 종료는 호출자가 합니다
+func NewVitessRepositoryWithDB(ctx context.Context, db *sql.DB, keyspace string) (repository.DocumentRepository, error) {
 	repo := &VitessRepository{
 		db:       db,
-		keyspace: cfg.Keyspace,
+		keyspace: keyspace,
 		metrics:  metrics.GetMetrics(),
 	}
 
 	// 테이블 초기화
-	if err := repo.initTables(context.Background()); err != nil {
+	if err := repo.initTables(ctx); err != nil {
 		return nil, fmt.Errorf("failed to init tables: %w", err)
 	}
 
-	logger.Info(context.Background(), "vitess repository initialized",
-		logger.Field("keyspace", cfg.Keyspace),
-		logger.Field("host", cfg.Host),
-	)
-
 	return repo, nil
 }
 
@@ -102,9 +114,11 @@ func (r *VitessRepository) initTables(ctx context.Context) error {
 			version INT NOT NULL DEFAULT 0,
 			created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
 			updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+			expires_at DATETIME(6) NULL,
 			INDEX idx_collection (collection),
 			INDEX idx_created_at (created_at),
-			INDEX idx_updated_at (updated_at)
+			INDEX idx_updated_at (updated_at),
+			INDEX idx_expires_at (collection, expires_at)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 	`
 
@@ -136,17 +150,18 @@ func (r *VitessRepository) Save(ctx context.Context, doc *entity.Document) error
 	}
 
 	query := `
-		INSERT INTO documents (id, collection, data, version, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO documents (id, collection, data, version, created_at, updated_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.getDB(ctx).ExecContext(ctx, query,
 		doc.ID(),
 		doc.Collection(),
 		dataJSON,
 		doc.Version(),
 		doc.CreatedAt(),
 		doc.UpdatedAt(),
+		expiresAtValue(doc),
 	)
 	if err != nil {
 		r.metrics.RecordDBOperation("save", doc.Collection(), "error", time.Since(start))
@@ -178,7 +193,7 @@ func (r *VitessRepository) FindByID(ctx context.Context, collection, id string)
 	}()
 
 	query := `
-		SELECT id, collection, data, version, created_at, updated_at
+		SELECT id, collection, data, version, created_at, updated_at, expires_at
 		FROM documents
 		WHERE id = ? AND collection = ?
 	`
@@ -190,10 +205,11 @@ func (r *VitessRepository) FindByID(ctx context.Context, collection, id string)
 		version    int
 		createdAt  time.Time
 		updatedAt  time.Time
+		expiresAt  sql.NullTime
 	)
 
-	err := r.db.QueryRowContext(ctx, query, id, collection).Scan(
-		&docID, &coll, &dataJSON, &version, &createdAt, &updatedAt,
+	err := r.getDB(ctx).QueryRowContext(ctx, query, id, collection).Scan(
+		&docID, &coll, &dataJSON, &version, &createdAt, &updatedAt, &expiresAt,
 	)
 	if err == sql.ErrNoRows {
 		r.metrics.RecordDBOperation("find", collection, "not_found", time.Since(start))
@@ -216,6 +232,9 @@ func (r *VitessRepository) FindByID(ctx context.Context, collection, id string)
 	}
 
 	doc := entity.ReconstructDocument(docID, coll, data, version, createdAt, updatedAt)
+	if expiresAt.Valid {
+		doc.SetExpiresAt(expiresAt.Time)
+	}
 	return doc, nil
 }
 
@@ -235,14 +254,15 @@ func (r *VitessRepository) Update(ctx context.Context, doc *entity.Document) err
 
 	query := `
 		UPDATE documents
-		SET data = ?, version = ?, updated_at = ?
+		SET data = ?, version = ?, updated_at = ?, expires_at = ?
 		WHERE id = ? AND collection = ? AND version = ?
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := r.getDB(ctx).ExecContext(ctx, query,
 		dataJSON,
 		doc.Version(),
 		doc.UpdatedAt(),
+		expiresAtValue(doc),
 		doc.ID(),
 		doc.Collection(),
 		doc.Version()-1, // 낙관적 잠금
@@ -281,7 +301,7 @@ func (r *VitessRepository) Delete(ctx context.Context, collection, id string) er
 
 	query := `DELETE FROM documents WHERE id = ? AND collection = ?`
 
-	result, err := r.db.ExecContext(ctx, query, id, collection)
+	result, err := r.getDB(ctx).ExecContext(ctx, query, id, collection)
 	if err != nil {
 		r.metrics.RecordDBOperation("delete", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to delete document",
@@ -321,7 +341,7 @@ func (r *VitessRepository) FindAll(ctx context.Context, collection string, filte
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, collection)
+	rows, err := r.getDB(ctx).QueryContext(ctx, query, collection)
 	if err != nil {
 		r.metrics.RecordDBOperation("find_all", collection, "error", time.Since(start))
 		logger.Error(ctx, "failed to find documents",
@@ -366,7 +386,7 @@ func (r *VitessRepository) Count(ctx context.Context, collection string, filter
 	query := `SELECT COUNT(*) FROM documents WHERE collection = ?`
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query, collection).Scan(&count)
+	err := r.getDB(ctx).QueryRowContext(ctx, query, collection).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count documents: %w", err)
 	}
@@ -385,38 +405,15 @@ func (r *VitessRepository) Close() error {
 }
 
 // WithTransaction은 트랜잭션 내에서 함수를 실행합니다
-func (r *VitessRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+// 트랜잭션은 fn에 전달되는 ctx에 담기므로, 그 ctx로 호출한 문서 작업은 같은 트랜잭션에서 실행됩니다
+func (r *VitessRepository) WithTransaction(ctx context.Context, opts *repository.TxOptions, fn func(ctx context.Context) error) error {
 	start := time.Now()
 
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		logger.Error(ctx, "failed to begin transaction", zap.Error(err))
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p)
-		}
-	}()
-
-	// 트랜잭션 컨텍스트 생성
-	txCtx := context.WithValue(ctx, "tx", tx)
-
-	if err := fn(txCtx); err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			logger.Error(ctx, "failed to rollback transaction", zap.Error(rbErr))
-		}
+	if err := sqltx.Run(ctx, r.db, opts, fn); err != nil {
 		logger.Error(ctx, "transaction failed", zap.Error(err))
 		return fmt.Errorf("transaction failed: %w", err)
 	}
 
-	if err := tx.Commit(); err != nil {
-		logger.Error(ctx, "failed to commit transaction", zap.Error(err))
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
 	logger.Info(ctx, "transaction completed successfully",
 		logger.Duration(time.Since(start)),
 	)
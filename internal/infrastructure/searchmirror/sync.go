@@ -0,0 +1,83 @@
+package searchmirror
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// syncLockStripes는 문서별 동기화를 직렬화하는 잠금 수입니다
+const syncLockStripes = 64
+
+// SyncResult는 문서 하나를 동기화한 결과입니다
+type SyncResult string
+
+const (
+	SyncIndexed SyncResult = "indexed" // 미러에 주 저장소의 현재 상태를 씀
+	SyncDeleted SyncResult = "deleted" // 주 저장소에 없어 미러에서 삭제
+)
+
+// Syncer는 변경 이벤트가 가리키는 문서를 주 저장소에서 다시 읽어 미러 인덱스에 반영합니다
+// 이벤트 내용 대신 현재 상태를 쓰므로 이벤트가 중복되거나 순서가 바뀌어도 결과가 같으며,
+// 같은 문서의 동기화는 이 인스턴스 안에서 직렬화해 늦게 읽은 상태가 먼저 쓰이지 않게 합니다
+type Syncer struct {
+	source     repository.DocumentRepository
+	mirror     repository.DocumentRepository
+	collection string
+	index      string
+
+	locks [syncLockStripes]sync.Mutex
+}
+
+// NewSyncer는 source의 collection을 mirror의 index로 동기화하는 Syncer를 생성합니다 (index가 비어 있으면 collection과 같은 이름)
+func NewSyncer(source, mirror repository.DocumentRepository, collection, index string) *Syncer {
+	if index == "" {
+		index = collection
+	}
+	return &Syncer{source: source, mirror: mirror, collection: collection, index: index}
+}
+
+// EnsureMapping은 미러 인덱스를 mapping으로 준비합니다 (미러 저장소가 repository.IndexMapper가 아니면 아무것도 하지 않음)
+func (s *Syncer) EnsureMapping(ctx context.Context, mapping repository.IndexMapping) error {
+	mapper, ok := s.mirror.(repository.IndexMapper)
+	if !ok {
+		return nil
+	}
+	if err := mapper.EnsureMapping(ctx, s.index, mapping); err != nil {
+		return fmt.Errorf("failed to prepare mirror index %s: %w", s.index, err)
+	}
+	return nil
+}
+
+// Sync는 문서 id를 주 저장소의 현재 상태로 미러에 씁니다 (주 저장소에 없으면 미러에서 삭제)
+func (s *Syncer) Sync(ctx context.Context, id string) (SyncResult, error) {
+	lock := &s.locks[stripe(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	source, err := find(ctx, s.source, s.collection, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s/%s: %w", s.collection, id, err)
+	}
+	if source == nil {
+		if err := s.mirror.Delete(ctx, s.index, id); err != nil && !isNotFound(err) {
+			return "", fmt.Errorf("failed to delete mirror %s/%s: %w", s.index, id, err)
+		}
+		return SyncDeleted, nil
+	}
+
+	if err := s.mirror.Save(ctx, mirrorDocument(source, s.index)); err != nil {
+		return "", fmt.Errorf("failed to index mirror %s/%s: %w", s.index, id, err)
+	}
+	return SyncIndexed, nil
+}
+
+// stripe는 문서 ID의 잠금 위치입니다
+func stripe(id string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return h.Sum32() % syncLockStripes
+}
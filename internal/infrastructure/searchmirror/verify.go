@@ -0,0 +1,293 @@
+// Package searchmirror는 주 저장소 컬렉션과 Elasticsearch 검색 미러의 일관성을 확인합니다
+//
+// 양쪽 문서를 모두 순회해 문서 수와 문서별 데이터 해시를 비교하고, 어긋난 문서는 ID로 다시 읽어
+// 비교하는 동안 바뀐 문서를 걸러낸 뒤 보고합니다. Repair이면 어긋난 문서를 주 저장소의 현재 상태로 미러에 다시 씁니다
+package searchmirror
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+)
+
+// defaultMaxDrift는 보고서에 담는 기본 최대 어긋난 문서 수입니다
+const defaultMaxDrift = 1000
+
+// progressEvery는 진행 상황을 보고하는 문서 간격입니다
+const progressEvery = 1000
+
+// DriftKind는 어긋난 종류입니다
+type DriftKind string
+
+const (
+	DriftMissing  DriftKind = "missing"  // 주 저장소에만 있음
+	DriftExtra    DriftKind = "extra"    // 미러에만 있음
+	DriftDiverged DriftKind = "diverged" // 양쪽 데이터가 다름
+)
+
+// Drift는 어긋난 문서입니다
+type Drift struct {
+	ID       string
+	Kind     DriftKind
+	Repaired bool
+	Error    string // 다시 쓰지 못한 원인
+}
+
+// Options는 검증 옵션입니다
+type Options struct {
+	// Repair이면 어긋난 문서를 주 저장소의 현재 상태로 미러에 다시 씁니다 (주 저장소에 없으면 미러에서 삭제)
+	Repair bool
+
+	// MaxDrift는 보고서에 담는 최대 어긋난 문서 수입니다 (0이면 1000, 수는 모두 집계)
+	MaxDrift int
+
+	// Progress는 순회한 문서 수를 보고합니다 (nil 가능)
+	Progress func(sourceScanned, mirrorScanned int64)
+}
+
+// Report는 검증 결과입니다
+type Report struct {
+	SourceCount  int64
+	MirrorCount  int64
+	Missing      int64
+	Extra        int64
+	Diverged     int64
+	Repaired     int64
+	RepairFailed int64
+	Drift        []Drift // 최대 Options.MaxDrift개
+}
+
+// InSync는 어긋난 문서가 없는지 반환합니다
+func (r *Report) InSync() bool {
+	return r.Missing == 0 && r.Extra == 0 && r.Diverged == 0
+}
+
+// Verifier는 주 저장소 컬렉션과 미러 인덱스를 비교합니다
+type Verifier struct {
+	source     repository.DocumentRepository
+	mirror     repository.DocumentRepository
+	collection string
+	index      string
+}
+
+// NewVerifier는 source의 collection과 mirror의 index를 비교하는 Verifier를 생성합니다 (index가 비어 있으면 collection과 같은 이름)
+func NewVerifier(source, mirror repository.DocumentRepository, collection, index string) *Verifier {
+	if index == "" {
+		index = collection
+	}
+	return &Verifier{source: source, mirror: mirror, collection: collection, index: index}
+}
+
+// Verify는 양쪽을 비교하고 (Repair이면 다시 맞춘 뒤) 결과를 반환합니다
+// 주 저장소 문서의 해시를 ID별로 메모리에 보관하므로 메모리 사용량은 컬렉션 문서 수에 비례합니다
+func (v *Verifier) Verify(ctx context.Context, opts Options) (*Report, error) {
+	if opts.MaxDrift <= 0 {
+		opts.MaxDrift = defaultMaxDrift
+	}
+	report := &Report{}
+	progress := func() {
+		if opts.Progress != nil {
+			opts.Progress(report.SourceCount, report.MirrorCount)
+		}
+	}
+
+	digests := make(map[string][sha256.Size]byte)
+	err := scan(ctx, v.source, v.collection, func(doc *entity.Document) error {
+		digest, err := Digest(doc)
+		if err != nil {
+			return err
+		}
+		digests[doc.ID()] = digest
+		report.SourceCount++
+		if report.SourceCount%progressEvery == 0 {
+			progress()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", v.collection, err)
+	}
+
+	// 한쪽에만 있거나 해시가 다른 문서는 후보로 모은 뒤 다시 확인
+	var candidates []string
+	err = scan(ctx, v.mirror, v.index, func(doc *entity.Document) error {
+		report.MirrorCount++
+		if report.MirrorCount%progressEvery == 0 {
+			progress()
+		}
+		expected, ok := digests[doc.ID()]
+		if !ok {
+			candidates = append(candidates, doc.ID())
+			return nil
+		}
+		delete(digests, doc.ID())
+		digest, err := Digest(doc)
+		if err != nil {
+			return err
+		}
+		if digest != expected {
+			candidates = append(candidates, doc.ID())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan mirror index %s: %w", v.index, err)
+	}
+	for id := range digests {
+		candidates = append(candidates, id)
+	}
+	progress()
+
+	for _, id := range candidates {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		drift, source, err := v.recheck(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if drift == nil {
+			continue
+		}
+
+		switch drift.Kind {
+		case DriftMissing:
+			report.Missing++
+		case DriftExtra:
+			report.Extra++
+		case DriftDiverged:
+			report.Diverged++
+		}
+		if opts.Repair {
+			if err := v.repair(ctx, id, source); err != nil {
+				drift.Error = err.Error()
+				report.RepairFailed++
+			} else {
+				drift.Repaired = true
+				report.Repaired++
+			}
+		}
+		if len(report.Drift) < opts.MaxDrift {
+			report.Drift = append(report.Drift, *drift)
+		}
+	}
+	return report, nil
+}
+
+// recheck는 ID로 양쪽 문서를 다시 읽어 여전히 어긋났는지 확인합니다 (비교 중 반영된 쓰기는 drift가 아님)
+func (v *Verifier) recheck(ctx context.Context, id string) (*Drift, *entity.Document, error) {
+	source, err := find(ctx, v.source, v.collection, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s/%s: %w", v.collection, id, err)
+	}
+	mirrored, err := find(ctx, v.mirror, v.index, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read mirror %s/%s: %w", v.index, id, err)
+	}
+
+	switch {
+	case source == nil && mirrored == nil:
+		return nil, nil, nil
+	case mirrored == nil:
+		return &Drift{ID: id, Kind: DriftMissing}, source, nil
+	case source == nil:
+		return &Drift{ID: id, Kind: DriftExtra}, nil, nil
+	}
+
+	sourceDigest, err := Digest(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	mirrorDigest, err := Digest(mirrored)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sourceDigest == mirrorDigest {
+		return nil, nil, nil
+	}
+	return &Drift{ID: id, Kind: DriftDiverged}, source, nil
+}
+
+// repair는 미러 문서를 주 저장소의 상태로 다시 씁니다 (source가 nil이면 미러에서 삭제)
+func (v *Verifier) repair(ctx context.Context, id string, source *entity.Document) error {
+	if source == nil {
+		if err := v.mirror.Delete(ctx, v.index, id); err != nil && !isNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	return v.mirror.Save(ctx, mirrorDocument(source, v.index))
+}
+
+// mirrorDocument는 주 저장소 문서를 미러 인덱스의 문서로 복사합니다
+func mirrorDocument(source *entity.Document, index string) *entity.Document {
+	doc := entity.ReconstructDocument(source.ID(), index, source.Data(), source.Version(), source.CreatedAt(), source.UpdatedAt())
+	doc.SetExpiresAt(source.ExpiresAt())
+	return doc
+}
+
+// Digest는 문서 데이터의 해시입니다 (ID, 버전, 시각은 제외)
+// 미러는 다른 경로(CDC 소비자 등)로 쓰일 수 있으므로 데이터만 비교하며,
+// JSON으로 왕복해 백엔드마다 다른 숫자/시각 타입과 키 순서를 정규화합니다
+func Digest(doc *entity.Document) ([sha256.Size]byte, error) {
+	data := make(map[string]interface{}, len(doc.Data()))
+	for k, v := range doc.Data() {
+		if k != "_id" {
+			data[k] = v
+		}
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("failed to encode document %s: %w", doc.ID(), err)
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	canonical, err := json.Marshal(normalized)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(canonical), nil
+}
+
+// scan은 컬렉션의 모든 문서를 순회합니다
+func scan(ctx context.Context, repo repository.DocumentRepository, collection string, fn func(doc *entity.Document) error) error {
+	it, err := repo.FindStream(ctx, collection, map[string]interface{}{}, &repository.FindOptions{})
+	if err != nil {
+		return err
+	}
+	defer it.Close(context.WithoutCancel(ctx))
+
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		if err != nil {
+			return err
+		}
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// find는 문서를 조회합니다 (없으면 nil)
+func find(ctx context.Context, repo repository.DocumentRepository, collection, id string) (*entity.Document, error) {
+	doc, err := repo.FindByID(ctx, collection, id)
+	if err != nil && isNotFound(err) {
+		return nil, nil
+	}
+	return doc, err
+}
+
+// isNotFound는 저장소의 문서 없음 에러인지 확인합니다 (백엔드마다 에러 값이 달라 메시지도 확인)
+func isNotFound(err error) bool {
+	return errors.Is(err, entity.ErrDocumentNotFound) || strings.Contains(err.Error(), "not found")
+}
@@ -0,0 +1,96 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AuthorizationMetadataKey = "authorization"
+)
+
+// publicMethods는 인증 없이 호출 가능한 메서드 접두사입니다
+var publicMethods = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// UnaryAuthInterceptor는 gRPC unary 요청의 OIDC Bearer 토큰을 검증합니다
+func UnaryAuthInterceptor(verifier *auth.OIDCVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor는 gRPC stream 요청의 OIDC Bearer 토큰을 검증합니다
+func StreamAuthInterceptor(verifier *auth.OIDCVerifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &wrappedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+	}
+}
+
+// authenticate는 metadata에서 토큰을 추출해 검증하고 Principal을 context에 저장합니다
+func authenticate(ctx context.Context, verifier *auth.OIDCVerifier, method string) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(AuthorizationMetadataKey); len(values) > 0 {
+			token = auth.BearerToken(values[0])
+		}
+	}
+
+	principal, err := verifier.Verify(ctx, token)
+	if err != nil {
+		logger.Warn(ctx, "gRPC authentication failed",
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		if errors.Is(err, auth.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "token expired")
+		}
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	ctx = auth.WithPrincipal(ctx, principal)
+	ctx = logger.WithFields(ctx, logger.UserID(principal.Subject))
+	return ctx, nil
+}
+
+// isPublicMethod는 인증이 필요 없는 메서드인지 확인합니다
+func isPublicMethod(fullMethod string) bool {
+	for _, prefix := range publicMethods {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
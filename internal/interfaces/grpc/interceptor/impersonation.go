@@ -0,0 +1,90 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ImpersonationMetadataKey는 대리 실행 대상을 지정하는 metadata 키입니다 (HTTP X-Impersonate와 동일 형식)
+var ImpersonationMetadataKey = strings.ToLower(auth.ImpersonationHeader)
+
+// UnaryImpersonationInterceptor는 x-impersonate metadata가 있으면 운영자를 대상 사용자/테넌트로 전환합니다
+// 인증 인터셉터 뒤에 위치해야 합니다
+func UnaryImpersonationInterceptor(impersonator *auth.Impersonator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := impersonate(ctx, impersonator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamImpersonationInterceptor는 stream 요청에 대리 실행을 적용합니다
+func StreamImpersonationInterceptor(impersonator *auth.Impersonator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := impersonate(ss.Context(), impersonator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		if ctx == ss.Context() {
+			return handler(srv, ss)
+		}
+		return handler(srv, &wrappedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+	}
+}
+
+// impersonate는 metadata의 대상으로 Principal을 전환합니다 (metadata가 없으면 context를 그대로 반환)
+func impersonate(ctx context.Context, impersonator *auth.Impersonator, method string) (context.Context, error) {
+	if isPublicMethod(method) {
+		return ctx, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	values := md.Get(ImpersonationMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+
+	operator, _ := auth.PrincipalFromContext(ctx)
+	principal, err := impersonator.Impersonate(operator, values[0])
+	if err != nil {
+		logger.Warn(ctx, "gRPC impersonation rejected",
+			zap.String("method", method),
+			zap.String("impersonate", values[0]),
+			zap.Error(err),
+		)
+		if errors.Is(err, auth.ErrInvalidImpersonationTarget) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.PermissionDenied, "impersonation not permitted")
+	}
+
+	logger.Info(ctx, "gRPC impersonation started",
+		zap.String("method", method),
+		logger.UserID(operator.Subject),
+		zap.String("target_subject", principal.Subject),
+		zap.String("target_tenant", principal.TenantID),
+	)
+
+	ctx = auth.WithPrincipal(ctx, principal)
+	ctx = logger.WithFields(ctx,
+		logger.UserID(principal.Subject),
+		zap.String("impersonated_by", operator.Subject),
+	)
+	return ctx, nil
+}
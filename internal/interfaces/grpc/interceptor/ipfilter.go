@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryIPFilterInterceptor는 gRPC unary 요청에 IP 허용/차단 규칙을 적용합니다
+func UnaryIPFilterInterceptor(filter *ipfilter.Filter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkIPFilter(ctx, filter, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamIPFilterInterceptor는 gRPC stream 요청에 IP 허용/차단 규칙을 적용합니다
+func StreamIPFilterInterceptor(filter *ipfilter.Filter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkIPFilter(ss.Context(), filter, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// checkIPFilter는 peer 주소를 기준으로 접근 허용 여부를 판정합니다
+func checkIPFilter(ctx context.Context, filter *ipfilter.Filter, method string) error {
+	var addr netip.Addr
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = ipfilter.ParseAddr(p.Addr.String())
+	}
+
+	decision := filter.Check(method, addr)
+	if decision.Allowed {
+		return nil
+	}
+
+	logger.Warn(ctx, "gRPC request blocked by ip filter",
+		zap.String("method", method),
+		zap.String("peer", addr.String()),
+		zap.String("rule_group", decision.Group),
+		zap.String("reason", decision.Reason),
+	)
+	return status.Error(codes.PermissionDenied, "access denied from this address")
+}
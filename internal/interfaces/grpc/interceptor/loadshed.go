@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/YouSangSon/database-service/internal/pkg/loadshed"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryLoadShedInterceptor는 gRPC unary 요청에 적응형 동시성 제한을 적용합니다
+func UnaryLoadShedInterceptor(limiter *loadshed.Limiter, m *metrics.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		token, err := acquireLoadShed(ctx, limiter, m, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		releaseLoadShed(token, limiter, m, err)
+		return resp, err
+	}
+}
+
+// StreamLoadShedInterceptor는 gRPC stream에 적응형 동시성 제한을 적용합니다 (stream이 끝날 때까지 한도를 차지)
+func StreamLoadShedInterceptor(limiter *loadshed.Limiter, m *metrics.Metrics) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		token, err := acquireLoadShed(ss.Context(), limiter, m, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		err = handler(srv, ss)
+		releaseLoadShed(token, limiter, m, err)
+		return err
+	}
+}
+
+// acquireLoadShed는 한도 안이면 토큰을 반환하고, 넘으면 ResourceExhausted와 retry-after 헤더를 반환합니다
+func acquireLoadShed(ctx context.Context, limiter *loadshed.Limiter, m *metrics.Metrics, method string) (*loadshed.Token, error) {
+	token, ok := limiter.Acquire()
+	if !ok {
+		m.RecordLoadShed("grpc", limiter.Limit(), limiter.InFlight(), true)
+		logger.Warn(ctx, "gRPC request shed by concurrency limiter",
+			zap.String("method", method),
+			zap.Int("limit", limiter.Limit()),
+		)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, "1"))
+		return nil, status.Error(codes.ResourceExhausted, "server overloaded, retry after 1s")
+	}
+	return token, nil
+}
+
+// releaseLoadShed는 토큰을 반환하며, 과부하로 실패한 요청이면 한도를 줄입니다
+func releaseLoadShed(token *loadshed.Token, limiter *loadshed.Limiter, m *metrics.Metrics, err error) {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		token.Done(true)
+	default:
+		token.Done(false)
+	}
+	m.RecordLoadShed("grpc", limiter.Limit(), limiter.InFlight(), false)
+}
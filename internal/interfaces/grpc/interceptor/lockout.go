@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryLockoutInterceptor는 peer IP별로 인증 실패를 집계해 반복 실패 시 요청을 차단합니다
+// 인증 인터셉터 앞에 위치해야 Unauthenticated 응답을 실패로 집계할 수 있습니다
+func UnaryLockoutInterceptor(guard *lockout.Guard) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		keys := peerLockoutKeys(ctx)
+		if err := checkLockout(ctx, guard, keys, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if status.Code(err) == codes.Unauthenticated {
+			guard.Fail(ctx, "grpc", keys...)
+		}
+		return resp, err
+	}
+}
+
+// StreamLockoutInterceptor는 stream 요청에 인증 실패 잠금을 적용합니다
+func StreamLockoutInterceptor(guard *lockout.Guard) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx := ss.Context()
+		keys := peerLockoutKeys(ctx)
+		if err := checkLockout(ctx, guard, keys, info.FullMethod); err != nil {
+			return err
+		}
+
+		err := handler(srv, ss)
+		if status.Code(err) == codes.Unauthenticated {
+			guard.Fail(ctx, "grpc", keys...)
+		}
+		return err
+	}
+}
+
+// peerLockoutKeys는 peer 주소로 잠금 키를 생성합니다
+func peerLockoutKeys(ctx context.Context) []string {
+	var clientIP string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if addr := ipfilter.ParseAddr(p.Addr.String()); addr.IsValid() {
+			clientIP = addr.String()
+		}
+	}
+	return lockout.Keys("", clientIP)
+}
+
+// checkLockout은 잠긴 peer의 요청을 ResourceExhausted로 거부합니다
+func checkLockout(ctx context.Context, guard *lockout.Guard, keys []string, method string) error {
+	remaining, locked := guard.Check(ctx, keys...)
+	if !locked {
+		return nil
+	}
+
+	logger.Warn(ctx, "gRPC authentication attempt while locked out",
+		zap.String("method", method),
+		zap.Strings("keys", keys),
+		zap.Duration("retry_after", remaining),
+	)
+	return status.Error(codes.ResourceExhausted,
+		fmt.Sprintf("too many failed authentication attempts, retry after %s", remaining.Round(time.Second)))
+}
@@ -25,6 +25,7 @@ func UnaryLoggingInterceptor() grpc.UnaryServerInterceptor {
 		requestID := extractOrGenerateRequestID(ctx)
 
 		// Context에 request ID 추가
+		ctx = logger.WithRequestID(ctx, requestID)
 		ctx = logger.WithFields(ctx,
 			logger.RequestID(requestID),
 		)
@@ -73,6 +74,7 @@ func StreamLoggingInterceptor() grpc.StreamServerInterceptor {
 		requestID := extractOrGenerateRequestID(ctx)
 
 		// Context에 request ID 추가
+		ctx = logger.WithRequestID(ctx, requestID)
 		ctx = logger.WithFields(ctx,
 			logger.RequestID(requestID),
 		)
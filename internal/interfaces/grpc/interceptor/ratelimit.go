@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RetryAfterMetadataKey = "retry-after"
+)
+
+// UnaryRateLimitInterceptor는 gRPC unary 요청에 토큰 버킷 rate limiting을 적용합니다
+func UnaryRateLimitInterceptor(limiter ratelimit.Limiter, policy *ratelimit.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter, policy, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimitInterceptor는 gRPC stream 시작 시 토큰 버킷 rate limiting을 적용합니다
+func StreamRateLimitInterceptor(limiter ratelimit.Limiter, policy *ratelimit.Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), limiter, policy, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// checkRateLimit은 토큰을 소비하고, 초과 시 ResourceExhausted와 retry-after 헤더를 반환합니다
+func checkRateLimit(ctx context.Context, limiter ratelimit.Limiter, policy *ratelimit.Policy, method string) error {
+	limit, scope := policy.Resolve(method)
+	if limit.IsZero() {
+		return nil
+	}
+
+	// 버킷은 인증된 principal 기준이며, 검증하지 않은 메타데이터(x-api-key 등)는 쓰지 않습니다
+	identity := ratelimit.Identity{}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		identity.TenantID = principal.TenantID
+		identity.Subject = principal.Subject
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		identity.ClientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(identity.ClientIP); err == nil {
+			identity.ClientIP = host
+		}
+	}
+	key := ratelimit.BucketKey(identity, scope)
+
+	result, err := limiter.Take(ctx, key, limit)
+	if err != nil {
+		// 에러 시 요청 허용 (fail open)
+		logger.Error(ctx, "rate limit check failed",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if !result.Allowed {
+		retryAfter := result.RetryAfterSeconds()
+		logger.Warn(ctx, "gRPC rate limit exceeded",
+			zap.String("method", method),
+			zap.String("key", key),
+		)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, strconv.FormatInt(retryAfter, 10)))
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %ds", retryAfter)
+	}
+
+	return nil
+}
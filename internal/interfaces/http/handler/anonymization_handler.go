@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AnonymizationHandler는 컬렉션 익명화 작업 HTTP 핸들러입니다
+type AnonymizationHandler struct {
+	anonymizationUC *usecase.AnonymizationUseCase
+}
+
+// NewAnonymizationHandler는 새로운 AnonymizationHandler를 생성합니다
+func NewAnonymizationHandler(anonymizationUC *usecase.AnonymizationUseCase) *AnonymizationHandler {
+	return &AnonymizationHandler{
+		anonymizationUC: anonymizationUC,
+	}
+}
+
+// StartJob starts a background job that hashes or rewrites fields across a collection
+func (h *AnonymizationHandler) StartJob(c *gin.Context) {
+	var req dto.StartAnonymizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.anonymizationUC.StartJob(c.Request.Context(), &req)
+	if err != nil {
+		h.respondError(c, err, "ANONYMIZATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ListJobs lists anonymization jobs on this instance (without per-document errors)
+func (h *AnonymizationHandler) ListJobs(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.anonymizationUC.ListJobs(c.Request.Context()),
+	})
+}
+
+// GetJob returns the progress of an anonymization job
+func (h *AnonymizationHandler) GetJob(c *gin.Context) {
+	resp, err := h.anonymizationUC.GetJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_ANONYMIZATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// CancelJob stops a running anonymization job after the current document
+func (h *AnonymizationHandler) CancelJob(c *gin.Context) {
+	resp, err := h.anonymizationUC.CancelJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "CANCEL_ANONYMIZATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ResumeJob re-runs a cancelled or failed job, skipping documents that are already anonymized
+func (h *AnonymizationHandler) ResumeJob(c *gin.Context) {
+	resp, err := h.anonymizationUC.ResumeJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "RESUME_ANONYMIZATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *AnonymizationHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "NOT_FOUND"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "anonymization request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AuditHandler는 감사 로그 조회 HTTP 핸들러입니다
+type AuditHandler struct {
+	auditUC *usecase.AuditUseCase
+}
+
+// NewAuditHandler는 새로운 AuditHandler를 생성합니다
+func NewAuditHandler(auditUC *usecase.AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		auditUC: auditUC,
+	}
+}
+
+// Query godoc
+// @Summary      Query audit log
+// @Description  Search the append-only audit log for compliance reviews
+// @Tags         audit
+// @Produce      json
+// @Param        collection   query     string  false  "Collection name"
+// @Param        document_id  query     string  false  "Document ID"
+// @Param        actor        query     string  false  "Actor subject"
+// @Param        tenant_id    query     string  false  "Tenant ID"
+// @Param        impersonated_by  query  string  false  "Operator subject for impersonated requests"
+// @Param        request_id   query     string  false  "Correlation ID"
+// @Param        operation    query     string  false  "Operation"
+// @Param        from         query     string  false  "Start time (RFC3339)"
+// @Param        to           query     string  false  "End time (RFC3339)"
+// @Param        page         query     int     false  "Page number"
+// @Param        page_size    query     int     false  "Page size"
+// @Success      200          {object}  dto.AuditLogQueryResponse
+// @Failure      400          {object}  ErrorResponse
+// @Failure      500          {object}  ErrorResponse
+// @Router       /api/v1/audit [get]
+func (h *AuditHandler) Query(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.AuditLogQueryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid query parameters",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.auditUC.QueryAuditLog(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "failed to query audit log", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to query audit log",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
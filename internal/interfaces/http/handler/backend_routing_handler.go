@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/backendrouting"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// BackendRoutingHandler는 요청별 백엔드 라우팅 표 조회/변경 HTTP 핸들러입니다
+type BackendRoutingHandler struct {
+	router *backendrouting.Router
+}
+
+// NewBackendRoutingHandler는 새로운 BackendRoutingHandler를 생성합니다
+func NewBackendRoutingHandler(router *backendrouting.Router) *BackendRoutingHandler {
+	return &BackendRoutingHandler{
+		router: router,
+	}
+}
+
+// List returns the backend routing table in effect on this instance
+func (h *BackendRoutingHandler) List(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.router.Status(),
+	})
+}
+
+// Pin repoints a collection to another enabled backend and waits for requests routed by the previous table to finish
+func (h *BackendRoutingHandler) Pin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.PinBackendRouteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	// 이 요청도 이전 라우팅 표로 처리 중이므로 드레인이 자기 자신을 기다리지 않도록 먼저 해제합니다
+	middleware.ReleaseDatabaseSelection(c)
+
+	result, err := h.router.Pin(ctx, c.Param("collection"), req.Backend)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "PIN_BACKEND_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	h.logSwitch(c, "collection backend pinned", result)
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    result,
+		Message: "Collection backend updated",
+	})
+}
+
+// Unpin removes a collection pin so the collection follows the configured rules or default backend again
+func (h *BackendRoutingHandler) Unpin(c *gin.Context) {
+	ctx := c.Request.Context()
+	middleware.ReleaseDatabaseSelection(c)
+
+	result, err := h.router.Unpin(ctx, c.Param("collection"))
+	if errors.Is(err, backendrouting.ErrNotPinned) {
+		c.JSON(http.StatusNotFound, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "BACKEND_PIN_NOT_FOUND",
+				Message: "collection " + c.Param("collection") + " is not pinned to a backend",
+			},
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "UNPIN_BACKEND_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	h.logSwitch(c, "collection backend unpinned", result)
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    result,
+		Message: "Collection backend pin removed",
+	})
+}
+
+// logSwitch는 백엔드 변경 결과를 기록합니다 (드레인 시간이 지났으면 경고)
+func (h *BackendRoutingHandler) logSwitch(c *gin.Context, msg string, result backendrouting.SwitchResult) {
+	fields := []zap.Field{
+		zap.String("collection", result.Collection),
+		zap.String("previous_backend", result.Previous),
+		zap.String("backend", result.Backend),
+		zap.Bool("drained", result.Drained),
+	}
+	if !result.Drained {
+		logger.Warn(c.Request.Context(), msg+" before in-flight requests finished", append(fields, zap.Int64("in_flight", result.InFlight))...)
+		return
+	}
+	logger.Info(c.Request.Context(), msg, fields...)
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// BackupHandler는 컬렉션 백업/복원 HTTP 핸들러입니다
+type BackupHandler struct {
+	backupUC *usecase.BackupUseCase
+}
+
+// NewBackupHandler는 새로운 BackupHandler를 생성합니다
+func NewBackupHandler(backupUC *usecase.BackupUseCase) *BackupHandler {
+	return &BackupHandler{
+		backupUC: backupUC,
+	}
+}
+
+// StartBackup starts a background backup of a collection
+func (h *BackupHandler) StartBackup(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.StartBackupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.backupUC.StartBackup(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "BACKUP_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// StartRestore starts a background restore of a backup into a collection
+func (h *BackupHandler) StartRestore(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.StartRestoreRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Error: &dto.APIError{
+					Code:    "INVALID_REQUEST",
+					Message: err.Error(),
+				},
+			})
+			return
+		}
+	}
+	req.BackupID = c.Param("backup_id")
+
+	resp, err := h.backupUC.StartRestore(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "RESTORE_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// PointInTimeRestore restores a collection to a timestamp from a backup and the CDC log (or previews it with dry_run)
+func (h *BackupHandler) PointInTimeRestore(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.PointInTimeRestoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.backupUC.PointInTimeRestore(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "PITR_FAILED")
+		return
+	}
+
+	status := http.StatusAccepted
+	if resp.DryRun {
+		status = http.StatusOK
+	}
+	c.JSON(status, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ListJobs lists backup and restore jobs on this instance
+func (h *BackupHandler) ListJobs(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.backupUC.ListJobs(c.Request.Context()),
+	})
+}
+
+// GetJob returns the progress of a backup or restore job
+func (h *BackupHandler) GetJob(c *gin.Context) {
+	resp, err := h.backupUC.GetJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_BACKUP_JOB_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *BackupHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "BACKUP_NOT_FOUND"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "backup request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CacheHandler는 컬렉션별 캐시 정책 관리 HTTP 핸들러입니다
+type CacheHandler struct {
+	documentUC *usecase.DocumentUseCase
+}
+
+// NewCacheHandler는 새로운 CacheHandler를 생성합니다
+func NewCacheHandler(documentUC *usecase.DocumentUseCase) *CacheHandler {
+	return &CacheHandler{
+		documentUC: documentUC,
+	}
+}
+
+// ListPolicies lists the cache policies in effect on this instance
+func (h *CacheHandler) ListPolicies(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.documentUC.ListCachePolicies(c.Request.Context()),
+	})
+}
+
+// PutPolicy adds or replaces the cache policy of a collection (pattern)
+func (h *CacheHandler) PutPolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.PutCachePolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	req.Collection = c.Param("collection")
+
+	resp, err := h.documentUC.PutCachePolicy(ctx, &req)
+	if err != nil {
+		logger.Warn(ctx, "failed to update cache policy", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "UPDATE_CACHE_POLICY_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+		Message: "Cache policy updated successfully",
+	})
+}
+
+// DeletePolicy removes the cache policy of a collection (pattern)
+func (h *CacheHandler) DeletePolicy(c *gin.Context) {
+	ctx := c.Request.Context()
+	collection := c.Param("collection")
+
+	removed, err := h.documentUC.DeleteCachePolicy(ctx, collection)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "DELETE_CACHE_POLICY_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	if !removed {
+		c.JSON(http.StatusNotFound, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "CACHE_POLICY_NOT_FOUND",
+				Message: "no cache policy for collection " + collection,
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: "Cache policy removed successfully",
+	})
+}
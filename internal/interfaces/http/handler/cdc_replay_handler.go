@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CDCReplayHandler는 CDC 이벤트 재생 HTTP 핸들러입니다
+type CDCReplayHandler struct {
+	replayUC *usecase.CDCReplayUseCase
+}
+
+// NewCDCReplayHandler는 새로운 CDCReplayHandler를 생성합니다
+func NewCDCReplayHandler(replayUC *usecase.CDCReplayUseCase) *CDCReplayHandler {
+	return &CDCReplayHandler{
+		replayUC: replayUC,
+	}
+}
+
+// Replay republishes a collection's CDC events from a timestamp or offset
+func (h *CDCReplayHandler) Replay(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CDCReplayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.replayUC.Replay(ctx, &req)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidData) {
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Error: &dto.APIError{
+					Code:    "INVALID_REQUEST",
+					Message: err.Error(),
+				},
+			})
+			return
+		}
+		logger.Error(ctx, "failed to replay cdc events", zap.Error(err))
+		c.JSON(http.StatusBadGateway, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "REPLAY_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/pkg/circuitbreaker"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CircuitBreakerHandler는 데이터베이스별 circuit breaker 조회/수동 제어 HTTP 핸들러입니다
+type CircuitBreakerHandler struct {
+	registry *circuitbreaker.Registry
+}
+
+// NewCircuitBreakerHandler는 새로운 CircuitBreakerHandler를 생성합니다
+func NewCircuitBreakerHandler(registry *circuitbreaker.Registry) *CircuitBreakerHandler {
+	return &CircuitBreakerHandler{
+		registry: registry,
+	}
+}
+
+// List returns the state and counts of every circuit breaker on this instance
+func (h *CircuitBreakerHandler) List(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.registry.List(),
+	})
+}
+
+// Trip opens a circuit breaker manually; it rejects requests until reset
+func (h *CircuitBreakerHandler) Trip(c *gin.Context) {
+	cb, ok := h.lookup(c)
+	if !ok {
+		return
+	}
+
+	cb.Trip()
+	logger.Warn(c.Request.Context(), "circuit breaker tripped manually", zap.String("name", cb.Name()))
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    cb.Status(),
+		Message: "Circuit breaker tripped",
+	})
+}
+
+// Reset closes a circuit breaker and clears its counts
+func (h *CircuitBreakerHandler) Reset(c *gin.Context) {
+	cb, ok := h.lookup(c)
+	if !ok {
+		return
+	}
+
+	cb.Reset()
+	logger.Info(c.Request.Context(), "circuit breaker reset manually", zap.String("name", cb.Name()))
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    cb.Status(),
+		Message: "Circuit breaker reset",
+	})
+}
+
+// lookup은 경로의 이름에 해당하는 circuit breaker를 찾고, 없으면 404 응답을 보냅니다
+func (h *CircuitBreakerHandler) lookup(c *gin.Context) (*circuitbreaker.CircuitBreaker, bool) {
+	name := c.Param("name")
+	cb, ok := h.registry.Lookup(name)
+	if !ok {
+		c.JSON(http.StatusNotFound, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "CIRCUIT_BREAKER_NOT_FOUND",
+				Message: fmt.Sprintf("circuit breaker %q not found", name),
+			},
+		})
+		return nil, false
+	}
+	return cb, true
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ConfigHandler는 설정 검증/진단 HTTP 핸들러입니다
+type ConfigHandler struct {
+	checker *config.Checker
+}
+
+// NewConfigHandler는 새로운 ConfigHandler를 생성합니다
+func NewConfigHandler(checker *config.Checker) *ConfigHandler {
+	return &ConfigHandler{
+		checker: checker,
+	}
+}
+
+// Validate re-reads the configuration file, validates it, probes every enabled backend and returns the redacted effective configuration
+func (h *ConfigHandler) Validate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	report := h.checker.Check(ctx)
+	if report.OK() {
+		c.JSON(http.StatusOK, dto.APIResponse{
+			Success: true,
+			Data:    report,
+		})
+		return
+	}
+
+	code, message := "BACKEND_UNREACHABLE", "One or more enabled backends could not be reached"
+	if !report.Valid {
+		code, message = "INVALID_CONFIG", report.Error
+	}
+	logger.Warn(ctx, "configuration check failed", zap.String("code", code), zap.String("error", message))
+	c.JSON(http.StatusUnprocessableEntity, dto.APIResponse{
+		Success: false,
+		Data:    report,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// DeadLetterHandler는 발행에 실패한 CDC 이벤트(DLQ) 관리 HTTP 핸들러입니다
+type DeadLetterHandler struct {
+	deadLetterUC *usecase.DeadLetterUseCase
+}
+
+// NewDeadLetterHandler는 새로운 DeadLetterHandler를 생성합니다
+func NewDeadLetterHandler(deadLetterUC *usecase.DeadLetterUseCase) *DeadLetterHandler {
+	return &DeadLetterHandler{
+		deadLetterUC: deadLetterUC,
+	}
+}
+
+// List lists dead-lettered CDC events, oldest first
+func (h *DeadLetterHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.DeadLetterListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.deadLetterUC.ListDeadLetters(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "failed to list dead letter events", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "LIST_DEAD_LETTERS_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Redrive republishes one dead-lettered event and removes it from the queue on success
+func (h *DeadLetterHandler) Redrive(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := h.deadLetterUC.RedriveDeadLetter(ctx, id); err != nil {
+		status, code := http.StatusBadGateway, "REDRIVE_FAILED"
+		if errors.Is(err, entity.ErrDocumentNotFound) {
+			status, code = http.StatusNotFound, "DEAD_LETTER_NOT_FOUND"
+		}
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    code,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: "Dead letter event redriven successfully",
+	})
+}
+
+// RedriveAll republishes matching dead-lettered events, oldest first
+func (h *DeadLetterHandler) RedriveAll(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.DeadLetterRedriveRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Error: &dto.APIError{
+					Code:    "INVALID_REQUEST",
+					Message: err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	resp, err := h.deadLetterUC.RedriveDeadLetters(ctx, &req)
+	if err != nil {
+		logger.Error(ctx, "failed to redrive dead letter events", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "REDRIVE_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Discard deletes a dead-lettered event without republishing it
+func (h *DeadLetterHandler) Discard(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	if err := h.deadLetterUC.DiscardDeadLetter(ctx, id); err != nil {
+		status, code := http.StatusInternalServerError, "DISCARD_FAILED"
+		if errors.Is(err, entity.ErrDocumentNotFound) {
+			status, code = http.StatusNotFound, "DEAD_LETTER_NOT_FOUND"
+		}
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    code,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: "Dead letter event discarded",
+	})
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// exportFlushEvery는 export 응답을 클라이언트로 내보내는 문서 간격입니다
+const exportFlushEvery = 100
+
+// Export godoc
+// @Summary      Export documents
+// @Description  Stream every matching document as newline-delimited JSON without buffering the result set
+// @Tags         documents
+// @Produce      application/x-ndjson
+// @Param        collection  path      string  true   "Collection name"
+// @Param        filter      query     string  false  "Filter as a JSON object"
+// @Param        sort        query     string  false  "Sort fields (e.g., created_at:-1,name:1)"
+// @Param        fields      query     string  false  "Comma-separated data fields to include"
+// @Param        limit       query     int     false  "Maximum number of documents (default all)"
+// @Param        include_deleted  query  bool    false  "Include soft-deleted documents"
+// @Success      200         {string}  string  "One dto.GetDocumentResponse per line"
+// @Failure      400         {object}  ErrorResponse
+// @Failure      500         {object}  ErrorResponse
+// @Router       /api/v1/documents/{collection}/export [get]
+func (h *DocumentHandler) Export(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, err := parseExportRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "Invalid export request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// 첫 문서를 쓰기 전에는 에러를 일반 JSON 응답으로 보낼 수 있으므로 헤더 전송을 미룹니다
+	encoder := json.NewEncoder(c.Writer)
+	started := false
+	var written int
+	count, err := h.documentUC.ExportDocuments(ctx, req, func(doc *dto.GetDocumentResponse) error {
+		if !started {
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Status(http.StatusOK)
+			started = true
+		}
+		if err := encoder.Encode(doc); err != nil {
+			return err
+		}
+		if written++; written%exportFlushEvery == 0 {
+			c.Writer.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to export documents",
+			zap.String("collection", req.Collection),
+			zap.Int64("exported", count),
+			zap.Error(err),
+		)
+		if !started {
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "Failed to export documents",
+				Message: err.Error(),
+			})
+			return
+		}
+		// 이미 응답을 보내는 중이면 상태 코드를 바꿀 수 없으므로 마지막 줄에 에러를 기록합니다
+		_ = encoder.Encode(ErrorResponse{Error: "Export interrupted", Message: err.Error()})
+		return
+	}
+
+	if !started {
+		c.Header("Content-Type", "application/x-ndjson")
+		c.Status(http.StatusOK)
+	}
+}
+
+// parseExportRequest는 export 쿼리 파라미터를 요청 DTO로 변환합니다
+func parseExportRequest(c *gin.Context) (*dto.ExportDocumentsRequest, error) {
+	req := &dto.ExportDocumentsRequest{
+		Collection: c.Param("collection"),
+	}
+
+	if filter := c.Query("filter"); filter != "" {
+		if err := json.Unmarshal([]byte(filter), &req.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	if sort := c.Query("sort"); sort != "" {
+		req.Sort = make(map[string]int)
+		for _, part := range strings.Split(sort, ",") {
+			field, direction, _ := strings.Cut(strings.TrimSpace(part), ":")
+			req.Sort[field] = 1
+			if direction == "-1" || strings.EqualFold(direction, "desc") {
+				req.Sort[field] = -1
+			}
+		}
+	}
+
+	if fields := c.Query("fields"); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				req.Fields = append(req.Fields, field)
+			}
+		}
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		l, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || l < 0 {
+			return nil, fmt.Errorf("limit must be a non-negative integer")
+		}
+		req.Limit = l
+	}
+
+	req.IncludeDeleted = c.Query("include_deleted") == "true"
+
+	return req, nil
+}
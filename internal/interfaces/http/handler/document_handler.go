@@ -1,12 +1,19 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/YouSangSon/database-service/internal/application/dto"
 	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/quota"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
@@ -32,6 +39,8 @@ func NewDocumentHandler(documentUC *usecase.DocumentUseCase) *DocumentHandler {
 // @Param        request  body      dto.CreateDocumentRequest  true  "Document creation request"
 // @Success      201      {object}  dto.CreateDocumentResponse
 // @Failure      400      {object}  ErrorResponse
+// @Failure      409      {object}  dto.APIResponse
+// @Failure      422      {object}  dto.APIResponse
 // @Failure      500      {object}  ErrorResponse
 // @Router       /api/v1/documents [post]
 func (h *DocumentHandler) Create(c *gin.Context) {
@@ -47,10 +56,22 @@ func (h *DocumentHandler) Create(c *gin.Context) {
 		return
 	}
 
+	ctx, err := middleware.WithCollectionDatabase(ctx, req.Collection)
+	if err != nil {
+		middleware.RespondBackendDisabled(c, err)
+		return
+	}
 	resp, err := h.documentUC.CreateDocument(ctx, &req)
+	if respondSchemaViolation(c, err) || respondDuplicateKey(c, err) || respondQuotaExceeded(c, err) {
+		return
+	}
 	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrExpiryNotEnabled) || errors.Is(err, usecase.ErrInvalidExpiry) {
+			statusCode = http.StatusBadRequest
+		}
 		logger.Error(ctx, "failed to create document", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(statusCode, ErrorResponse{
 			Error:   "Failed to create document",
 			Message: err.Error(),
 		})
@@ -68,7 +89,11 @@ func (h *DocumentHandler) Create(c *gin.Context) {
 // @Produce      json
 // @Param        collection  path      string  true  "Collection name"
 // @Param        id          path      string  true  "Document ID"
+// @Param        include_deleted  query  bool    false  "Include soft-deleted documents"
+// @Param        asOf        query     string  false  "Read the document as of this RFC3339 time"
+// @Param        populate    query     string  false  "Comma-separated reference fields to populate"
 // @Success      200         {object}  dto.GetDocumentResponse
+// @Failure      400         {object}  ErrorResponse
 // @Failure      404         {object}  ErrorResponse
 // @Failure      500         {object}  ErrorResponse
 // @Router       /api/v1/documents/{collection}/{id} [get]
@@ -79,15 +104,30 @@ func (h *DocumentHandler) GetByID(c *gin.Context) {
 	id := c.Param("id")
 
 	req := &dto.GetDocumentRequest{
-		Collection: collection,
-		ID:         id,
+		Collection:     collection,
+		ID:             id,
+		IncludeDeleted: c.Query("include_deleted") == "true",
+		Populate:       parsePopulate(c.Query("populate")),
+	}
+	if asOf := c.Query("asOf"); asOf != "" {
+		at, err := time.Parse(time.RFC3339Nano, asOf)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid asOf",
+				Message: "asOf must be an RFC3339 timestamp",
+			})
+			return
+		}
+		req.AsOf = &at
 	}
 
 	resp, err := h.documentUC.GetDocument(ctx, req)
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err.Error() == "document not found" {
+		if err.Error() == "document not found" || errors.Is(err, entity.ErrDocumentNotFound) {
 			statusCode = http.StatusNotFound
+		} else if errors.Is(err, usecase.ErrRevisionsNotEnabled) || errors.Is(err, usecase.ErrUnknownReference) {
+			statusCode = http.StatusBadRequest
 		}
 		logger.Error(ctx, "failed to get document", zap.Error(err))
 		c.JSON(statusCode, ErrorResponse{
@@ -111,6 +151,8 @@ func (h *DocumentHandler) GetByID(c *gin.Context) {
 // @Param        request     body      dto.UpdateDocumentRequest  true  "Document update request"
 // @Success      200         {object}  dto.UpdateDocumentResponse
 // @Failure      400         {object}  ErrorResponse
+// @Failure      409         {object}  dto.APIResponse
+// @Failure      422         {object}  dto.APIResponse
 // @Failure      404         {object}  ErrorResponse
 // @Failure      500         {object}  ErrorResponse
 // @Router       /api/v1/documents/{collection}/{id} [put]
@@ -136,7 +178,10 @@ func (h *DocumentHandler) Update(c *gin.Context) {
 		Data:       updateData,
 	}
 
-	resp, err := h.documentUC.UpdateDocument(ctx, req)
+	err := h.documentUC.UpdateDocument(ctx, req)
+	if respondSchemaViolation(c, err) || respondDuplicateKey(c, err) || respondQuotaExceeded(c, err) {
+		return
+	}
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		if err.Error() == "document not found" {
@@ -150,7 +195,23 @@ func (h *DocumentHandler) Update(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, resp)
+	// 갱신된 버전과 시각을 응답하기 위해 문서를 다시 읽습니다
+	updated, err := h.documentUC.GetDocument(ctx, &dto.GetDocumentRequest{Collection: collection, ID: id})
+	if err != nil {
+		logger.Error(ctx, "failed to read updated document", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "Failed to update document",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UpdateDocumentResponse{
+		ID:        updated.ID,
+		Data:      updated.Data,
+		Version:   updated.Version,
+		UpdatedAt: updated.UpdatedAt,
+	})
 }
 
 // Delete godoc
@@ -163,6 +224,7 @@ func (h *DocumentHandler) Update(c *gin.Context) {
 // @Param        id          path      string  true  "Document ID"
 // @Success      204         "No Content"
 // @Failure      404         {object}  ErrorResponse
+// @Failure      409         {object}  ErrorResponse
 // @Failure      500         {object}  ErrorResponse
 // @Router       /api/v1/documents/{collection}/{id} [delete]
 func (h *DocumentHandler) Delete(c *gin.Context) {
@@ -180,6 +242,8 @@ func (h *DocumentHandler) Delete(c *gin.Context) {
 		statusCode := http.StatusInternalServerError
 		if err.Error() == "document not found" {
 			statusCode = http.StatusNotFound
+		} else if errors.Is(err, usecase.ErrTooManyReferences) {
+			statusCode = http.StatusConflict
 		}
 		logger.Error(ctx, "failed to delete document", zap.Error(err))
 		c.JSON(statusCode, ErrorResponse{
@@ -192,6 +256,97 @@ func (h *DocumentHandler) Delete(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// Revisions godoc
+// @Summary      List document revisions
+// @Description  List prior versions of a document, newest first
+// @Tags         documents
+// @Produce      json
+// @Param        collection  path      string  true   "Collection name"
+// @Param        id          path      string  true   "Document ID"
+// @Param        limit       query     int     false  "Maximum number of revisions (default all)"
+// @Success      200         {object}  dto.ListRevisionsResponse
+// @Failure      400         {object}  ErrorResponse
+// @Failure      500         {object}  ErrorResponse
+// @Router       /api/v1/documents/{collection}/{id}/revisions [get]
+func (h *DocumentHandler) Revisions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req := &dto.ListRevisionsRequest{
+		Collection: c.Param("collection"),
+		ID:         c.Param("id"),
+	}
+	if limit := c.Query("limit"); limit != "" {
+		l, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || l < 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid limit",
+				Message: "limit must be a non-negative integer",
+			})
+			return
+		}
+		req.Limit = l
+	}
+
+	resp, err := h.documentUC.ListRevisions(ctx, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrRevisionsNotEnabled) {
+			statusCode = http.StatusBadRequest
+		}
+		logger.Error(ctx, "failed to list revisions", zap.Error(err))
+		c.JSON(statusCode, ErrorResponse{
+			Error:   "Failed to list revisions",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Restore godoc
+// @Summary      Restore a soft-deleted document
+// @Description  Remove the deletion marker of a document in a soft-delete collection
+// @Tags         documents
+// @Produce      json
+// @Param        collection  path      string  true  "Collection name"
+// @Param        id          path      string  true  "Document ID"
+// @Success      200         {object}  dto.GetDocumentResponse
+// @Failure      400         {object}  ErrorResponse
+// @Failure      404         {object}  ErrorResponse
+// @Failure      409         {object}  ErrorResponse
+// @Failure      500         {object}  ErrorResponse
+// @Router       /api/v1/documents/{collection}/{id}/restore [post]
+func (h *DocumentHandler) Restore(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req := &dto.RestoreDocumentRequest{
+		Collection: c.Param("collection"),
+		ID:         c.Param("id"),
+	}
+
+	resp, err := h.documentUC.RestoreDocument(ctx, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, usecase.ErrSoftDeleteNotEnabled):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, entity.ErrDocumentNotFound):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, usecase.ErrDocumentNotDeleted), errors.Is(err, entity.ErrVersionConflict):
+			statusCode = http.StatusConflict
+		}
+		logger.Error(ctx, "failed to restore document", zap.Error(err))
+		c.JSON(statusCode, ErrorResponse{
+			Error:   "Failed to restore document",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // List godoc
 // @Summary      List documents
 // @Description  List documents in a collection with pagination
@@ -199,9 +354,10 @@ func (h *DocumentHandler) Delete(c *gin.Context) {
 // @Accept       json
 // @Produce      json
 // @Param        collection  path      string  true   "Collection name"
-// @Param        limit       query     int     false  "Limit (default 10)"
-// @Param        offset      query     int     false  "Offset (default 0)"
-// @Param        sort        query     string  false  "Sort field (e.g., created_at:-1)"
+// @Param        page        query     int     false  "Page (default 1)"
+// @Param        page_size   query     int     false  "Page size (default 10)"
+// @Param        include_deleted  query  bool    false  "Include soft-deleted documents"
+// @Param        populate    query     string  false  "Comma-separated reference fields to populate"
 // @Success      200         {object}  dto.ListDocumentsResponse
 // @Failure      400         {object}  ErrorResponse
 // @Failure      500         {object}  ErrorResponse
@@ -213,29 +369,32 @@ func (h *DocumentHandler) List(c *gin.Context) {
 
 	var req dto.ListDocumentsRequest
 	req.Collection = collection
-	req.Limit = 10 // default
-	req.Offset = 0  // default
+	req.Page = 1      // default
+	req.PageSize = 10 // default
 
-	if limit, ok := c.GetQuery("limit"); ok {
-		if l, err := parseInt(limit); err == nil {
-			req.Limit = l
+	if page, ok := c.GetQuery("page"); ok {
+		if p, err := parseInt(page); err == nil {
+			req.Page = p
 		}
 	}
 
-	if offset, ok := c.GetQuery("offset"); ok {
-		if o, err := parseInt(offset); err == nil {
-			req.Offset = o
+	if pageSize, ok := c.GetQuery("page_size"); ok {
+		if ps, err := parseInt(pageSize); err == nil {
+			req.PageSize = ps
 		}
 	}
 
-	if sort, ok := c.GetQuery("sort"); ok {
-		req.Sort = sort
-	}
+	req.IncludeDeleted = c.Query("include_deleted") == "true"
+	req.Populate = parsePopulate(c.Query("populate"))
 
 	resp, err := h.documentUC.ListDocuments(ctx, &req)
 	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, usecase.ErrUnknownReference) {
+			statusCode = http.StatusBadRequest
+		}
 		logger.Error(ctx, "failed to list documents", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
+		c.JSON(statusCode, ErrorResponse{
 			Error:   "Failed to list documents",
 			Message: err.Error(),
 		})
@@ -332,8 +491,82 @@ func parseInt(s string) (int, error) {
 	return i, err
 }
 
+// parsePopulate는 쉼표로 구분된 populate 쿼리 파라미터를 참조 필드 목록으로 변환합니다
+func parsePopulate(s string) []string {
+	var fields []string
+	for _, field := range strings.Split(s, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
 // ErrorResponse는 에러 응답 구조체입니다
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message"`
 }
+
+// respondSchemaViolation은 컬렉션 스키마 위반을 위반 목록(JSON Pointer 경로)과 함께 422로 응답합니다
+// 스키마 위반이 아니면 응답하지 않고 false를 반환합니다
+func respondSchemaViolation(c *gin.Context, err error) bool {
+	var schemaErr *usecase.SchemaValidationError
+	if !errors.As(err, &schemaErr) {
+		return false
+	}
+
+	c.JSON(http.StatusUnprocessableEntity, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    "SCHEMA_VALIDATION_FAILED",
+			Message: schemaErr.Error(),
+			Details: schemaErr.Violations,
+		},
+	})
+	return true
+}
+
+// respondDuplicateKey는 고유 키 중복을 위반한 필드와 함께 409로 응답합니다
+// 중복 키 에러가 아니면 응답하지 않고 false를 반환합니다
+func respondDuplicateKey(c *gin.Context, err error) bool {
+	var dupErr *usecase.DuplicateKeyError
+	if !errors.As(err, &dupErr) {
+		return false
+	}
+
+	c.JSON(http.StatusConflict, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    "DUPLICATE_KEY",
+			Message: err.Error(),
+			Details: map[string]interface{}{"fields": dupErr.Fields},
+		},
+	})
+	return true
+}
+
+// respondQuotaExceeded는 저장 용량 쿼터 초과를 넘은 한도와 함께 507로 응답합니다
+func respondQuotaExceeded(c *gin.Context, err error) bool {
+	var quotaErr *quota.ExceededError
+	if !errors.As(err, &quotaErr) {
+		return false
+	}
+
+	c.JSON(http.StatusInsufficientStorage, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    "RESOURCE_EXHAUSTED",
+			Message: err.Error(),
+			Details: map[string]interface{}{
+				"scope":     quotaErr.Scope,
+				"name":      quotaErr.Name,
+				"resource":  quotaErr.Resource,
+				"limit":     quotaErr.Limit,
+				"used":      quotaErr.Used,
+				"requested": quotaErr.Requested,
+			},
+		},
+	})
+	return true
+}
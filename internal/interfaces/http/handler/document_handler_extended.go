@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/YouSangSon/database-service/internal/application/dto"
 	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -49,6 +53,9 @@ func (h *DocumentHandlerExtended) Replace(c *gin.Context) {
 	}
 
 	resp, err := h.documentUC.ReplaceDocument(ctx, req)
+	if respondSchemaViolation(c, err) || respondDuplicateKey(c, err) || respondQuotaExceeded(c, err) {
+		return
+	}
 	if err != nil {
 		logger.Error(ctx, "failed to replace document", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, dto.APIResponse{
@@ -325,6 +332,9 @@ func (h *DocumentHandlerExtended) Upsert(c *gin.Context) {
 	resp, err := h.documentUC.Upsert(ctx, &req)
 	if err != nil {
 		logger.Error(ctx, "failed to upsert document", zap.Error(err))
+		if respondQuotaExceeded(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, dto.APIResponse{
 			Success: false,
 			Error: &dto.APIError{
@@ -399,7 +409,15 @@ func (h *DocumentHandlerExtended) BulkInsert(c *gin.Context) {
 		return
 	}
 
+	ctx, err := middleware.WithCollectionDatabase(ctx, req.Collection)
+	if err != nil {
+		middleware.RespondBackendDisabled(c, err)
+		return
+	}
 	resp, err := h.documentUC.BulkInsert(ctx, &req)
+	if respondSchemaViolation(c, err) || respondDuplicateKey(c, err) || respondQuotaExceeded(c, err) {
+		return
+	}
 	if err != nil {
 		logger.Error(ctx, "failed to bulk insert documents", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, dto.APIResponse{
@@ -698,6 +716,11 @@ func (h *DocumentHandlerExtended) CreateCollection(c *gin.Context) {
 		return
 	}
 
+	ctx, err := middleware.WithCollectionDatabase(ctx, req.Collection)
+	if err != nil {
+		middleware.RespondBackendDisabled(c, err)
+		return
+	}
 	resp, err := h.documentUC.CreateCollection(ctx, &req)
 	if err != nil {
 		logger.Error(ctx, "failed to create collection", zap.Error(err))
@@ -752,7 +775,7 @@ func (h *DocumentHandlerExtended) DropCollection(c *gin.Context) {
 func (h *DocumentHandlerExtended) RenameCollection(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	oldName := c.Param("old_name")
+	oldName := c.Param("collection")
 
 	var data struct {
 		NewName string `json:"new_name" binding:"required"`
@@ -794,6 +817,52 @@ func (h *DocumentHandlerExtended) RenameCollection(c *gin.Context) {
 	})
 }
 
+// CloneCollection copies documents (optionally filtered) and indexes into a new collection
+func (h *DocumentHandlerExtended) CloneCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CloneCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Error(ctx, "invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	req.Source = c.Param("collection")
+
+	resp, err := h.documentUC.CloneCollection(ctx, &req)
+	if err != nil {
+		status, code := http.StatusInternalServerError, "CLONE_COLLECTION_FAILED"
+		switch {
+		case errors.Is(err, entity.ErrDocumentNotFound):
+			status, code = http.StatusNotFound, "COLLECTION_NOT_FOUND"
+		case errors.Is(err, entity.ErrInvalidData):
+			status, code = http.StatusBadRequest, "INVALID_REQUEST"
+		default:
+			logger.Error(ctx, "failed to clone collection", zap.Error(err))
+		}
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    code,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+		Message: "Collection cloned successfully",
+	})
+}
+
 // ListCollections lists all collections
 func (h *DocumentHandlerExtended) ListCollections(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -850,6 +919,33 @@ func (h *DocumentHandlerExtended) CollectionExists(c *gin.Context) {
 	})
 }
 
+// CollectionUsage는 컬렉션의 저장 용량 사용량과 쿼터를 반환합니다 (테넌트 컬렉션이면 테넌트 합계 포함)
+func (h *DocumentHandlerExtended) CollectionUsage(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := h.documentUC.CollectionStorageUsage(ctx, c.Param("collection"))
+	if err != nil {
+		logger.Error(ctx, "failed to get storage usage", zap.Error(err))
+		status, code := http.StatusInternalServerError, "STORAGE_USAGE_FAILED"
+		if errors.Is(err, auth.ErrForbidden) {
+			status, code = http.StatusForbidden, "FORBIDDEN"
+		}
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    code,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
 // ExecuteTransaction executes a transaction
 func (h *DocumentHandlerExtended) ExecuteTransaction(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -1084,6 +1180,16 @@ func (h *DocumentHandlerExtended) GetCollectionStats(c *gin.Context) {
 	}
 
 	resp, err := h.documentUC.GetCollectionStats(ctx, req)
+	if errors.Is(err, entity.ErrDocumentNotFound) {
+		c.JSON(http.StatusNotFound, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "COLLECTION_NOT_FOUND",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
 	if err != nil {
 		logger.Error(ctx, "failed to get collection stats", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, dto.APIResponse{
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// DuplicateHandler는 중복 문서 탐지/정리 HTTP 핸들러입니다
+type DuplicateHandler struct {
+	duplicateUC *usecase.DuplicateUseCase
+}
+
+// NewDuplicateHandler는 새로운 DuplicateHandler를 생성합니다
+func NewDuplicateHandler(duplicateUC *usecase.DuplicateUseCase) *DuplicateHandler {
+	return &DuplicateHandler{
+		duplicateUC: duplicateUC,
+	}
+}
+
+// StartScan starts a background scan that groups documents by key fields
+func (h *DuplicateHandler) StartScan(c *gin.Context) {
+	var req dto.StartDuplicateScanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondInvalid(c, err)
+		return
+	}
+
+	resp, err := h.duplicateUC.StartScan(c.Request.Context(), &req)
+	if err != nil {
+		h.respondError(c, err, "DUPLICATE_SCAN_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ListScans lists duplicate scans on this instance (without groups)
+func (h *DuplicateHandler) ListScans(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.duplicateUC.ListScans(c.Request.Context()),
+	})
+}
+
+// GetScan returns the status and duplicate groups of a scan
+func (h *DuplicateHandler) GetScan(c *gin.Context) {
+	resp, err := h.duplicateUC.GetScan(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_DUPLICATE_SCAN_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Resolve merges duplicates into the kept document or deletes them
+func (h *DuplicateHandler) Resolve(c *gin.Context) {
+	var req dto.ResolveDuplicatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondInvalid(c, err)
+		return
+	}
+
+	resp, err := h.duplicateUC.Resolve(c.Request.Context(), &req)
+	if err != nil {
+		h.respondError(c, err, "RESOLVE_DUPLICATES_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondInvalid responds to a malformed request body
+func (h *DuplicateHandler) respondInvalid(c *gin.Context, err error) {
+	c.JSON(http.StatusBadRequest, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    "INVALID_REQUEST",
+			Message: err.Error(),
+		},
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *DuplicateHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "NOT_FOUND"
+	case errors.Is(err, entity.ErrVersionConflict):
+		status, code = http.StatusConflict, "VERSION_CONFLICT"
+	case errors.Is(err, usecase.ErrTooManyReferences):
+		status = http.StatusConflict
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "duplicate request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// FeatureFlagHandler는 기능 플래그 조회/변경 HTTP 핸들러입니다
+type FeatureFlagHandler struct {
+	flags *featureflag.Flags
+}
+
+// NewFeatureFlagHandler는 새로운 FeatureFlagHandler를 생성합니다
+func NewFeatureFlagHandler(flags *featureflag.Flags) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		flags: flags,
+	}
+}
+
+// List evaluates every defined flag for this environment (and the tenant query parameter) and returns the runtime overrides
+func (h *FeatureFlagHandler) List(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.flags.Status(c.Query("tenant")),
+	})
+}
+
+// Get evaluates one flag for this environment and the tenant query parameter
+func (h *FeatureFlagHandler) Get(c *gin.Context) {
+	name := c.Param("name")
+	if !h.flags.Defined(name) {
+		h.respondNotFound(c, name)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.flags.Evaluate(name, c.Query("tenant")),
+	})
+}
+
+// Set overrides a flag for all tenants or one tenant; the override is stored in Redis and shared by every instance
+func (h *FeatureFlagHandler) Set(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+
+	var req dto.SetFeatureFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	err := h.flags.SetOverride(ctx, featureflag.Override{Flag: name, Tenant: req.Tenant, Enabled: *req.Enabled})
+	if errors.Is(err, featureflag.ErrUnknownFlag) {
+		h.respondNotFound(c, name)
+		return
+	}
+	if err != nil {
+		logger.Error(ctx, "failed to set feature flag override", zap.String("flag", name), zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "SET_FEATURE_FLAG_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	logger.Info(ctx, "feature flag overridden",
+		zap.String("flag", name),
+		zap.String("tenant", req.Tenant),
+		zap.Bool("enabled", *req.Enabled),
+	)
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.flags.Evaluate(name, req.Tenant),
+		Message: "Feature flag updated",
+	})
+}
+
+// Delete removes a runtime override (for all tenants, or the tenant query parameter) so the configured value applies again
+func (h *FeatureFlagHandler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+	tenant := c.Query("tenant")
+
+	err := h.flags.DeleteOverride(ctx, name, tenant)
+	switch {
+	case errors.Is(err, featureflag.ErrUnknownFlag):
+		h.respondNotFound(c, name)
+		return
+	case errors.Is(err, featureflag.ErrOverrideNotFound):
+		c.JSON(http.StatusNotFound, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "FEATURE_FLAG_OVERRIDE_NOT_FOUND",
+				Message: err.Error(),
+			},
+		})
+		return
+	case err != nil:
+		logger.Error(ctx, "failed to delete feature flag override", zap.String("flag", name), zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "DELETE_FEATURE_FLAG_FAILED",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+	logger.Info(ctx, "feature flag override removed", zap.String("flag", name), zap.String("tenant", tenant))
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.flags.Evaluate(name, tenant),
+		Message: "Feature flag override removed",
+	})
+}
+
+// respondNotFound는 정의되지 않은 플래그를 404로 응답합니다
+func (h *FeatureFlagHandler) respondNotFound(c *gin.Context, name string) {
+	c.JSON(http.StatusNotFound, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    "FEATURE_FLAG_NOT_FOUND",
+			Message: "feature flag " + name + " is not defined",
+		},
+	})
+}
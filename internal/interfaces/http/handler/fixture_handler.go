@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// FixtureHandler는 픽스처 불러오기 HTTP 핸들러입니다
+type FixtureHandler struct {
+	fixtureUC *usecase.FixtureUseCase
+}
+
+// NewFixtureHandler는 새로운 FixtureHandler를 생성합니다
+func NewFixtureHandler(fixtureUC *usecase.FixtureUseCase) *FixtureHandler {
+	return &FixtureHandler{
+		fixtureUC: fixtureUC,
+	}
+}
+
+// Load ingests a fixture set into its collections, skipping documents that are already loaded
+func (h *FixtureHandler) Load(c *gin.Context) {
+	var req dto.LoadFixturesRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Error: &dto.APIError{
+					Code:    "INVALID_REQUEST",
+					Message: err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	resp, err := h.fixtureUC.Load(c.Request.Context(), &req)
+	if err != nil {
+		status, code := http.StatusInternalServerError, "LOAD_FIXTURES_FAILED"
+		if errors.Is(err, entity.ErrInvalidData) {
+			status = http.StatusBadRequest
+		} else {
+			logger.Error(c.Request.Context(), "fixture load failed", zap.Error(err))
+		}
+		c.JSON(status, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    code,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
@@ -169,7 +169,7 @@ func (h *HealthHandler) Ready(c *gin.Context) {
 // checkMongoDB checks MongoDB connection
 func (h *HealthHandler) checkMongoDB(ctx context.Context) error {
 	// Try to count documents in a test collection
-	_, err := h.mongoRepo.Count(ctx, "__health_check__")
+	_, err := h.mongoRepo.Count(ctx, "__health_check__", map[string]interface{}{})
 	return err
 }
 
@@ -183,8 +183,7 @@ func (h *HealthHandler) checkRedis(ctx context.Context) error {
 		return err
 	}
 
-	var result string
-	if err := h.redisCache.Get(ctx, testKey, &result); err != nil {
+	if _, err := h.redisCache.Get(ctx, testKey); err != nil {
 		return err
 	}
 
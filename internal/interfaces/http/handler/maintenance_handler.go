@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// MaintenanceHandler는 컬렉션 유지보수 HTTP 핸들러입니다
+type MaintenanceHandler struct {
+	maintenanceUC *usecase.MaintenanceUseCase
+}
+
+// NewMaintenanceHandler는 새로운 MaintenanceHandler를 생성합니다
+func NewMaintenanceHandler(maintenanceUC *usecase.MaintenanceUseCase) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceUC: maintenanceUC,
+	}
+}
+
+// StartMaintenance starts a background maintenance operation (compact, vacuum, forcemerge, ...) on a collection
+func (h *MaintenanceHandler) StartMaintenance(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.StartMaintenanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.maintenanceUC.StartMaintenance(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "MAINTENANCE_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Operations lists the maintenance operations supported by a database type
+func (h *MaintenanceHandler) Operations(c *gin.Context) {
+	resp, err := h.maintenanceUC.Operations(c.Request.Context(), c.Param("db_type"))
+	if err != nil {
+		h.respondError(c, err, "GET_MAINTENANCE_OPERATIONS_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ListJobs lists maintenance jobs on this instance
+func (h *MaintenanceHandler) ListJobs(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.maintenanceUC.ListJobs(c.Request.Context()),
+	})
+}
+
+// GetJob returns the status and result of a maintenance job
+func (h *MaintenanceHandler) GetJob(c *gin.Context) {
+	resp, err := h.maintenanceUC.GetJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_MAINTENANCE_JOB_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *MaintenanceHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "NOT_FOUND"
+	case errors.Is(err, repository.ErrUnsupportedMaintenance):
+		status, code = http.StatusBadRequest, "UNSUPPORTED_OPERATION"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "maintenance request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
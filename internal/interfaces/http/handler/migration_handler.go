@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// MigrationHandler는 백엔드 간 온라인 마이그레이션 HTTP 핸들러입니다
+type MigrationHandler struct {
+	migrationUC *usecase.MigrationUseCase
+}
+
+// NewMigrationHandler는 새로운 MigrationHandler를 생성합니다
+func NewMigrationHandler(migrationUC *usecase.MigrationUseCase) *MigrationHandler {
+	return &MigrationHandler{
+		migrationUC: migrationUC,
+	}
+}
+
+// StartMigration starts dual-writing a collection to another backend and backfills it in the background
+func (h *MigrationHandler) StartMigration(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.StartMigrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.migrationUC.StartMigration(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ListMigrations lists migrations started on this instance
+func (h *MigrationHandler) ListMigrations(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.migrationUC.ListMigrations(c.Request.Context()),
+	})
+}
+
+// GetMigration returns the phase and progress of a migration
+func (h *MigrationHandler) GetMigration(c *gin.Context) {
+	resp, err := h.migrationUC.GetMigration(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// VerifyMigration re-compares source and target checksums in the background
+func (h *MigrationHandler) VerifyMigration(c *gin.Context) {
+	resp, err := h.migrationUC.VerifyMigration(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "VERIFY_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// CutOver switches reads and writes of the collection to the target backend
+func (h *MigrationHandler) CutOver(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CutOverMigrationRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Error: &dto.APIError{
+					Code:    "INVALID_REQUEST",
+					Message: err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	resp, err := h.migrationUC.CutOver(ctx, c.Param("id"), &req)
+	if err != nil {
+		h.respondError(c, err, "CUTOVER_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Complete stops mirroring writes back to the source backend
+func (h *MigrationHandler) Complete(c *gin.Context) {
+	resp, err := h.migrationUC.Complete(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "COMPLETE_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Abort cancels a migration and routes the collection back to the source backend
+func (h *MigrationHandler) Abort(c *gin.Context) {
+	resp, err := h.migrationUC.Abort(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "ABORT_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *MigrationHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "MIGRATION_NOT_FOUND"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "migration request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
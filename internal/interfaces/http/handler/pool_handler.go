@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/gin-gonic/gin"
+)
+
+// PoolHandler는 연결 풀 상태 조회 HTTP 핸들러입니다
+type PoolHandler struct {
+	registry *poolstats.Registry
+}
+
+// NewPoolHandler는 새로운 PoolHandler를 생성합니다
+func NewPoolHandler(registry *poolstats.Registry) *PoolHandler {
+	return &PoolHandler{
+		registry: registry,
+	}
+}
+
+// List returns the current state of every registered connection pool on this instance
+func (h *PoolHandler) List(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.registry.Snapshot(),
+	})
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SchemaMigrationHandler는 SQL 문서 테이블 스키마 마이그레이션 HTTP 핸들러입니다
+type SchemaMigrationHandler struct {
+	schemaUC *usecase.SchemaMigrationUseCase
+}
+
+// NewSchemaMigrationHandler는 새로운 SchemaMigrationHandler를 생성합니다
+func NewSchemaMigrationHandler(schemaUC *usecase.SchemaMigrationUseCase) *SchemaMigrationHandler {
+	return &SchemaMigrationHandler{
+		schemaUC: schemaUC,
+	}
+}
+
+// Status returns the schema version of every document table of a SQL backend
+func (h *SchemaMigrationHandler) Status(c *gin.Context) {
+	resp, err := h.schemaUC.Status(c.Request.Context(), c.Param("db_type"))
+	if err != nil {
+		h.respondError(c, err, "SCHEMA_STATUS_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Up applies pending schema migrations to every document table
+func (h *SchemaMigrationHandler) Up(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.SchemaMigrationUpRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.APIResponse{
+				Success: false,
+				Error: &dto.APIError{
+					Code:    "INVALID_REQUEST",
+					Message: err.Error(),
+				},
+			})
+			return
+		}
+	}
+
+	resp, err := h.schemaUC.Up(ctx, c.Param("db_type"), &req)
+	if err != nil {
+		h.respondError(c, err, "SCHEMA_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Down reverts schema migrations above the requested version
+func (h *SchemaMigrationHandler) Down(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.SchemaMigrationDownRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.schemaUC.Down(ctx, c.Param("db_type"), &req)
+	if err != nil {
+		h.respondError(c, err, "SCHEMA_MIGRATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *SchemaMigrationHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "schema migration request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SearchMirrorHandler는 검색 미러 일관성 검증 HTTP 핸들러입니다
+type SearchMirrorHandler struct {
+	searchMirrorUC *usecase.SearchMirrorUseCase
+}
+
+// NewSearchMirrorHandler는 새로운 SearchMirrorHandler를 생성합니다
+func NewSearchMirrorHandler(searchMirrorUC *usecase.SearchMirrorUseCase) *SearchMirrorHandler {
+	return &SearchMirrorHandler{
+		searchMirrorUC: searchMirrorUC,
+	}
+}
+
+// ListMirrors lists the collections mirrored into Elasticsearch
+func (h *SearchMirrorHandler) ListMirrors(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.searchMirrorUC.Mirrors(c.Request.Context()),
+	})
+}
+
+// StartVerification starts a background comparison of a collection and its mirror index
+func (h *SearchMirrorHandler) StartVerification(c *gin.Context) {
+	var req dto.StartMirrorVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.searchMirrorUC.StartVerification(c.Request.Context(), &req)
+	if err != nil {
+		h.respondError(c, err, "MIRROR_VERIFICATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// ListVerifications lists verification jobs on this instance (without drifted documents)
+func (h *SearchMirrorHandler) ListVerifications(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    h.searchMirrorUC.ListVerifications(c.Request.Context()),
+	})
+}
+
+// GetVerification returns the progress or drift report of a verification job
+func (h *SearchMirrorHandler) GetVerification(c *gin.Context) {
+	resp, err := h.searchMirrorUC.GetVerification(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_MIRROR_VERIFICATION_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *SearchMirrorHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "NOT_FOUND"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "search mirror request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// TenantHandler는 테넌트 프로비저닝 HTTP 핸들러입니다
+type TenantHandler struct {
+	tenantUC *usecase.TenantUseCase
+}
+
+// NewTenantHandler는 새로운 TenantHandler를 생성합니다
+func NewTenantHandler(tenantUC *usecase.TenantUseCase) *TenantHandler {
+	return &TenantHandler{
+		tenantUC: tenantUC,
+	}
+}
+
+// Provision creates a tenant's collections, indexes and CDC topics and stores its quota and API keys.
+// The report lists every step; API keys are returned only in this response.
+func (h *TenantHandler) Provision(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.ProvisionTenantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	report, err := h.tenantUC.Provision(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "PROVISION_TENANT_FAILED")
+		return
+	}
+
+	if !report.Complete {
+		// Created resources are kept; retrying the same request resumes provisioning
+		c.JSON(http.StatusBadGateway, dto.APIResponse{
+			Success: false,
+			Data:    report,
+			Error: &dto.APIError{
+				Code:    "PROVISIONING_INCOMPLETE",
+				Message: "One or more provisioning steps failed; retry the request to resume",
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.APIResponse{
+		Success: true,
+		Data:    report,
+		Message: "Tenant provisioned successfully",
+	})
+}
+
+// List lists provisioned tenants
+func (h *TenantHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := h.tenantUC.ListTenants(ctx)
+	if err != nil {
+		h.respondError(c, err, "LIST_TENANTS_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Get returns a provisioned tenant
+func (h *TenantHandler) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := h.tenantUC.GetTenant(ctx, c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_TENANT_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Usage returns the tenant's storage usage against its quota, with a per-collection breakdown
+func (h *TenantHandler) Usage(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := h.tenantUC.GetTenantUsage(ctx, c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_TENANT_USAGE_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *TenantHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "TENANT_NOT_FOUND"
+	case errors.Is(err, entity.ErrDuplicateKey):
+		status, code = http.StatusConflict, "TENANT_EXISTS"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "tenant request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// WebhookHandler는 웹훅 구독 관리와 전송 로그 HTTP 핸들러입니다
+type WebhookHandler struct {
+	webhookUC *usecase.WebhookUseCase
+}
+
+// NewWebhookHandler는 새로운 WebhookHandler를 생성합니다
+func NewWebhookHandler(webhookUC *usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUC: webhookUC,
+	}
+}
+
+// Create registers a webhook subscription; the signing secret is returned only in this response
+func (h *WebhookHandler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.CreateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.webhookUC.CreateSubscription(ctx, &req)
+	if err != nil {
+		h.respondError(c, err, "CREATE_WEBHOOK_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+		Message: "Webhook subscription created successfully",
+	})
+}
+
+// List lists webhook subscriptions
+func (h *WebhookHandler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := h.webhookUC.ListSubscriptions(ctx)
+	if err != nil {
+		h.respondError(c, err, "LIST_WEBHOOKS_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Get returns a webhook subscription
+func (h *WebhookHandler) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	resp, err := h.webhookUC.GetSubscription(ctx, c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "GET_WEBHOOK_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Update changes the given fields of a webhook subscription, optionally rotating its signing secret
+func (h *WebhookHandler) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.webhookUC.UpdateSubscription(ctx, c.Param("id"), &req)
+	if err != nil {
+		h.respondError(c, err, "UPDATE_WEBHOOK_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+		Message: "Webhook subscription updated successfully",
+	})
+}
+
+// Delete removes a webhook subscription and its delivery log
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.webhookUC.DeleteSubscription(ctx, c.Param("id")); err != nil {
+		h.respondError(c, err, "DELETE_WEBHOOK_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Message: "Webhook subscription deleted successfully",
+	})
+}
+
+// ListDeliveries lists the delivery log of a webhook subscription, newest first
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.WebhookDeliveryListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.APIResponse{
+			Success: false,
+			Error: &dto.APIError{
+				Code:    "INVALID_REQUEST",
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp, err := h.webhookUC.ListDeliveries(ctx, c.Param("id"), &req)
+	if err != nil {
+		h.respondError(c, err, "LIST_WEBHOOK_DELIVERIES_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.APIResponse{
+		Success: true,
+		Data:    resp,
+	})
+}
+
+// Redeliver queues a delivery to be sent again immediately
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.webhookUC.Redeliver(ctx, c.Param("id"), c.Param("delivery_id")); err != nil {
+		h.respondError(c, err, "REDELIVER_WEBHOOK_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.APIResponse{
+		Success: true,
+		Message: "Webhook delivery queued for redelivery",
+	})
+}
+
+// respondError maps use case errors to HTTP status codes
+func (h *WebhookHandler) respondError(c *gin.Context, err error, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, entity.ErrDocumentNotFound):
+		status, code = http.StatusNotFound, "WEBHOOK_NOT_FOUND"
+	case errors.Is(err, entity.ErrInvalidData):
+		status = http.StatusBadRequest
+	default:
+		logger.Error(c.Request.Context(), "webhook request failed", zap.String("code", code), zap.Error(err))
+	}
+
+	c.JSON(status, dto.APIResponse{
+		Success: false,
+		Error: &dto.APIError{
+			Code:    code,
+			Message: err.Error(),
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AuthenticateAPIKey는 X-API-Key 헤더의 테넌트 API 키를 검증하는 미들웨어입니다
+// API 키가 있으면 키로 인증하고, 없으면 fallback(예: 서명 또는 OIDC 인증)에 위임합니다
+// fallback이 nil이면 API 키 없는 요청은 거부됩니다
+func AuthenticateAPIKey(verifier *auth.APIKeyVerifier, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(auth.APIKeyHeader)
+		if token == "" && fallback != nil {
+			fallback(c)
+			return
+		}
+
+		ctx := c.Request.Context()
+		principal, err := verifier.Verify(ctx, token)
+		if err != nil {
+			logger.Warn(ctx, "api key authentication failed",
+				logger.HTTPPath(c.Request.URL.Path),
+				logger.RemoteAddr(clientIP(c)),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INVALID_API_KEY",
+					"message": "API key authentication failed",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		// 경로의 컬렉션은 라우팅 전에 확인합니다 (본문의 컬렉션은 유즈케이스에서 확인)
+		if collection := c.Param("collection"); collection != "" && !principal.CanAccessCollection(collection) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "FORBIDDEN",
+					"message": "Collection is outside the API key's tenant namespace",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// PrincipalKey는 gin context에서 인증된 Principal을 저장하는 키입니다
+	PrincipalKey = "principal"
+
+	// UserIDKey는 gin context에서 사용자 ID를 저장하는 키입니다 (RateLimitByUser에서 사용)
+	UserIDKey = "user_id"
+)
+
+// Authenticate는 OIDC Bearer 토큰을 검증하는 미들웨어입니다
+func Authenticate(verifier *auth.OIDCVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		token := auth.BearerToken(c.GetHeader("Authorization"))
+
+		principal, err := verifier.Verify(ctx, token)
+		if err != nil {
+			logger.Warn(ctx, "authentication failed",
+				logger.HTTPPath(c.Request.URL.Path),
+				logger.RemoteAddr(c.ClientIP()),
+				zap.Error(err),
+			)
+
+			code := "UNAUTHORIZED"
+			if errors.Is(err, auth.ErrTokenExpired) {
+				code = "TOKEN_EXPIRED"
+			}
+
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    code,
+					"message": "Authentication required",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// setPrincipal은 인증된 Principal을 gin context와 요청 context에 저장합니다
+func setPrincipal(c *gin.Context, principal *auth.Principal) {
+	c.Set(PrincipalKey, principal)
+	c.Set(UserIDKey, principal.Subject)
+	ctx := auth.WithPrincipal(c.Request.Context(), principal)
+	ctx = logger.WithFields(ctx, logger.UserID(principal.Subject))
+	c.Request = c.Request.WithContext(ctx)
+}
+
+// RequireRole은 주어진 역할(또는 상위 역할)을 요구하는 미들웨어입니다
+func RequireRole(role auth.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal := GetPrincipal(c)
+		if principal == nil {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "UNAUTHORIZED",
+					"message": "Authentication required",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		if !principal.HasRole(role) {
+			logger.Warn(c.Request.Context(), "authorization denied",
+				logger.UserID(principal.Subject),
+				zap.String("required_role", string(role)),
+				logger.HTTPPath(c.Request.URL.Path),
+			)
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "FORBIDDEN",
+					"message": "Insufficient permissions: requires role " + string(role),
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetPrincipal은 gin context에서 인증된 Principal을 반환합니다
+func GetPrincipal(c *gin.Context) *auth.Principal {
+	if v, exists := c.Get(PrincipalKey); exists {
+		if p, ok := v.(*auth.Principal); ok {
+			return p
+		}
+	}
+	return nil
+}
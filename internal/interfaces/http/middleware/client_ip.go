@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// TrustProxies는 X-Forwarded-For/X-Real-IP를 믿을 프록시(IP 또는 CIDR)를 gin 엔진에 설정합니다
+// 프록시가 없으면 전달 헤더를 무시하고 연결 주소를 클라이언트 IP로 사용합니다 (gin 기본값은 모든 프록시를 신뢰)
+// 설정은 엔진별로 적용되므로 한 프로세스의 여러 엔진(API, 프로파일링)이 서로 영향을 주지 않습니다
+func TrustProxies(engine *gin.Engine, proxies []string) error {
+	if len(proxies) == 0 {
+		proxies = nil
+	}
+	return engine.SetTrustedProxies(proxies)
+}
+
+// clientIP는 IP 필터, rate limit, 잠금에 쓰는 신뢰할 수 있는 클라이언트 IP를 반환합니다
+// TrustProxies로 신뢰할 프록시를 비워 두면 gin은 전달 헤더 대신 연결 주소(RemoteIP)를 반환합니다
+func clientIP(c *gin.Context) string {
+	return c.ClientIP()
+}
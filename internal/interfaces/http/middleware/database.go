@@ -2,8 +2,14 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 
+	"github.com/YouSangSon/database-service/internal/pkg/backendrouting"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
 	"github.com/gin-gonic/gin"
 )
 
@@ -23,35 +29,109 @@ const (
 type contextKey string
 
 const (
-	DatabaseTypeContextKey contextKey = "database_type"
+	DatabaseTypeContextKey    contextKey = "database_type"
+	ReadConsistencyContextKey contextKey = "read_consistency"
+
+	backendRoutingContextKey contextKey = "backend_routing"
+)
+
+// backendRoutingReleaseKey는 라우팅 표 사용 해제 함수를 저장하는 gin 컨텍스트 키입니다
+const backendRoutingReleaseKey = "backend_routing_release"
+
+// ErrBackendDisabled는 요청의 환경/테넌트에서 기능 플래그로 꺼진 백엔드를 고른 경우 반환됩니다
+var ErrBackendDisabled = errors.New("database backend is disabled by feature flag")
+
+// backendSelection은 본문의 컬렉션으로 데이터베이스를 다시 고를 때 쓰는 라우팅 표와 기능 플래그입니다
+type backendSelection struct {
+	routing *backendrouting.Table
+	flags   *featureflag.Flags
+}
+
+// ReadConsistency는 요청의 읽기 일관성 수준입니다 (X-Read-Consistency 헤더)
+type ReadConsistency string
+
+const (
+	// ReadConsistencyEventual은 컬렉션 읽기 라우팅 설정을 따릅니다 (기본값, 복제본 읽기 허용)
+	ReadConsistencyEventual ReadConsistency = "eventual"
+
+	// ReadConsistencyStrong은 라우팅 설정과 관계없이 주 저장소에서 읽습니다 (방금 쓴 값 읽기)
+	ReadConsistencyStrong ReadConsistency = "strong"
 )
 
 // DatabaseSelector는 데이터베이스 선택 미들웨어입니다
-func DatabaseSelector() gin.HandlerFunc {
+// 경로의 백엔드 세그먼트(/api/v1/{backend}/...), X-Database-Type 헤더, 컬렉션 라우팅 규칙, 기본 백엔드 순으로 결정합니다
+// router가 nil이면 컬렉션 규칙 없이 mongodb를 기본값으로 쓰며 활성화 여부를 확인하지 않습니다
+// router가 있으면 요청이 끝날 때까지 그 시점의 라우팅 표를 사용하며, 라우팅 변경은 이 요청이 끝나기를 기다립니다
+// flags가 있으면 요청의 환경/테넌트에서 꺼진 백엔드(backend.<이름> 플래그)는 403으로 거부합니다
+func DatabaseSelector(router *backendrouting.Router, flags *featureflag.Flags) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// X-Database-Type 헤더에서 데이터베이스 타입 읽기
-		dbType := c.GetHeader("X-Database-Type")
+		var routing *backendrouting.Table
+		if router != nil {
+			var release func()
+			routing, release = router.Acquire()
+			release = sync.OnceFunc(release)
+			c.Set(backendRoutingReleaseKey, release)
+			defer release()
+		}
+
+		// 경로 세그먼트와 X-Database-Type 헤더에서 데이터베이스 타입 읽기
+		dbType := c.Param("backend")
+		if header := c.GetHeader("X-Database-Type"); header != "" {
+			if dbType != "" && dbType != header {
+				abortDatabaseSelection(c, "CONFLICTING_DATABASE_TYPE", "X-Database-Type header does not match the backend in the path")
+				return
+			}
+			dbType = header
+		}
+		explicit := dbType != ""
 
-		// 기본값은 MongoDB
-		if dbType == "" {
-			dbType = string(DatabaseTypeMongoDB)
+		// 지정하지 않았으면 컬렉션 라우팅 규칙, 없으면 기본값
+		if !explicit {
+			if routing != nil {
+				dbType = routing.Resolve(c.Param("collection"))
+			} else {
+				dbType = string(DatabaseTypeMongoDB)
+			}
 		}
 
 		// 유효한 데이터베이스 타입인지 확인
 		if !isValidDatabaseType(dbType) {
+			abortDatabaseSelection(c, "INVALID_DATABASE_TYPE", "Invalid database type. Supported types: mongodb, postgresql, mysql, cassandra, elasticsearch, vitess")
+			return
+		}
+		if routing != nil && !routing.Enabled(dbType) {
+			abortDatabaseSelection(c, "DATABASE_NOT_ENABLED", fmt.Sprintf("Database %s is not enabled on this server. Enabled: %s", dbType, strings.Join(routing.Backends(), ", ")))
+			return
+		}
+		if !flags.Enabled(c.Request.Context(), featureflag.Backend(dbType)) {
+			RespondBackendDisabled(c, fmt.Errorf("%w: %s", ErrBackendDisabled, dbType))
+			return
+		}
+
+		// X-Read-Consistency 헤더에서 읽기 일관성 수준 읽기
+		consistency := ReadConsistency(c.GetHeader("X-Read-Consistency"))
+		if consistency == "" {
+			consistency = ReadConsistencyEventual
+		}
+		if consistency != ReadConsistencyEventual && consistency != ReadConsistencyStrong {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"success": false,
 				"error": gin.H{
-					"code":    "INVALID_DATABASE_TYPE",
-					"message": "Invalid database type. Supported types: mongodb, postgresql, mysql, cassandra, elasticsearch, vitess",
+					"code":    "INVALID_READ_CONSISTENCY",
+					"message": "Invalid read consistency. Supported values: eventual, strong",
 				},
 			})
 			c.Abort()
 			return
 		}
 
-		// 컨텍스트에 데이터베이스 타입 저장
+		// 컨텍스트에 데이터베이스 타입과 읽기 일관성 수준 저장
 		ctx := context.WithValue(c.Request.Context(), DatabaseTypeContextKey, DatabaseType(dbType))
+		ctx = context.WithValue(ctx, ReadConsistencyContextKey, consistency)
+		if !explicit && routing != nil {
+			// 본문에 컬렉션이 있는 요청은 핸들러가 WithCollectionDatabase로 다시 고를 수 있도록 라우팅 표를 남깁니다
+			ctx = context.WithValue(ctx, backendRoutingContextKey, backendSelection{routing: routing, flags: flags})
+		}
 		c.Request = c.Request.WithContext(ctx)
 
 		// 다음 핸들러로 전달
@@ -59,6 +139,55 @@ func DatabaseSelector() gin.HandlerFunc {
 	}
 }
 
+// WithCollectionDatabase는 요청이 데이터베이스를 직접 지정하지 않았을 때 컬렉션 라우팅 규칙에 따라 데이터베이스 타입을 다시 고릅니다
+// 컬렉션이 경로가 아니라 요청 본문에 있는 핸들러(문서 생성, 대량 삽입 등)가 사용합니다
+// 고른 백엔드가 기능 플래그로 꺼져 있으면 ErrBackendDisabled를 반환합니다
+func WithCollectionDatabase(ctx context.Context, collection string) (context.Context, error) {
+	selection, ok := ctx.Value(backendRoutingContextKey).(backendSelection)
+	if !ok || collection == "" {
+		return ctx, nil
+	}
+	backend := selection.routing.Resolve(collection)
+	if !selection.flags.Enabled(ctx, featureflag.Backend(backend)) {
+		return ctx, fmt.Errorf("%w: %s", ErrBackendDisabled, backend)
+	}
+	return context.WithValue(ctx, DatabaseTypeContextKey, DatabaseType(backend)), nil
+}
+
+// ReleaseDatabaseSelection은 요청이 더 이상 라우팅 표를 사용하지 않는다고 표시합니다
+// 라우팅 변경처럼 드레인을 기다리는 관리 요청이 자기 자신을 기다리지 않도록 호출합니다
+func ReleaseDatabaseSelection(c *gin.Context) {
+	if v, exists := c.Get(backendRoutingReleaseKey); exists {
+		if release, ok := v.(func()); ok {
+			release()
+		}
+	}
+}
+
+// RespondBackendDisabled는 기능 플래그로 꺼진 백엔드 오류(ErrBackendDisabled)를 403으로 응답하고 요청을 중단합니다
+func RespondBackendDisabled(c *gin.Context, err error) {
+	c.JSON(http.StatusForbidden, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "FEATURE_DISABLED",
+			"message": err.Error(),
+		},
+	})
+	c.Abort()
+}
+
+// abortDatabaseSelection은 데이터베이스 선택 오류를 400으로 응답하고 요청을 중단합니다
+func abortDatabaseSelection(c *gin.Context, code, message string) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    code,
+			"message": message,
+		},
+	})
+	c.Abort()
+}
+
 // isValidDatabaseType은 데이터베이스 타입이 유효한지 확인합니다
 func isValidDatabaseType(dbType string) bool {
 	validTypes := []string{
@@ -87,3 +216,12 @@ func GetDatabaseType(ctx context.Context) DatabaseType {
 	}
 	return dbType
 }
+
+// GetReadConsistency는 컨텍스트에서 읽기 일관성 수준을 가져옵니다
+func GetReadConsistency(ctx context.Context) ReadConsistency {
+	consistency, ok := ctx.Value(ReadConsistencyContextKey).(ReadConsistency)
+	if !ok {
+		return ReadConsistencyEventual // 기본값
+	}
+	return consistency
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Impersonate는 X-Impersonate 헤더가 있으면 인증된 운영자를 대상 사용자/테넌트로 전환하는 미들웨어입니다
+// 인증 미들웨어 뒤에 위치해야 하며, 헤더가 없는 요청은 그대로 통과합니다
+// 전환 이후의 역할 검사와 행 수준 보안은 대상 Principal 기준으로 적용되고,
+// 감사 기록에는 원래 운영자가 impersonated_by로 남습니다
+func Impersonate(impersonator *auth.Impersonator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value := c.GetHeader(auth.ImpersonationHeader)
+		if value == "" {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		operator := GetPrincipal(c)
+
+		principal, err := impersonator.Impersonate(operator, value)
+		if err != nil {
+			fields := []zap.Field{
+				logger.HTTPPath(c.Request.URL.Path),
+				logger.RemoteAddr(c.ClientIP()),
+				zap.String("impersonate", value),
+				zap.Error(err),
+			}
+			if operator != nil {
+				fields = append(fields, logger.UserID(operator.Subject))
+			}
+			logger.Warn(ctx, "impersonation rejected", fields...)
+
+			status, code, message := http.StatusForbidden, "IMPERSONATION_DENIED", "Impersonation not permitted"
+			if errors.Is(err, auth.ErrInvalidImpersonationTarget) {
+				status, code, message = http.StatusBadRequest, "INVALID_IMPERSONATION", err.Error()
+			}
+			c.JSON(status, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    code,
+					"message": message,
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		logger.Info(ctx, "impersonation started",
+			logger.UserID(operator.Subject),
+			zap.String("target_subject", principal.Subject),
+			zap.String("target_tenant", principal.TenantID),
+			logger.HTTPMethod(c.Request.Method),
+			logger.HTTPPath(c.Request.URL.Path),
+		)
+
+		setPrincipal(c, principal)
+		c.Request = c.Request.WithContext(logger.WithFields(c.Request.Context(),
+			zap.String("impersonated_by", operator.Subject),
+		))
+		c.Next()
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// IPFilter는 CIDR 기반 IP 허용/차단 미들웨어입니다
+// 클라이언트 IP는 TrustProxies로 설정한 프록시가 보낸 전달 헤더만 믿고, 설정이 없으면 연결 주소를 사용합니다
+func IPFilter(filter *ipfilter.Filter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientIP := clientIP(c)
+
+		decision := filter.Check(c.Request.URL.Path, ipfilter.ParseAddr(clientIP))
+		if !decision.Allowed {
+			logger.Warn(c.Request.Context(), "request blocked by ip filter",
+				logger.RemoteAddr(clientIP),
+				logger.HTTPPath(c.Request.URL.Path),
+				zap.String("rule_group", decision.Group),
+				zap.String("reason", decision.Reason),
+			)
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "IP_FORBIDDEN",
+					"message": "Access denied from this address",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/pkg/loadshed"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// LoadShed는 적응형 동시성 제한 미들웨어입니다
+// 동시 처리 한도를 넘는 요청은 데이터베이스로 보내지 않고 바로 503과 Retry-After 헤더로 거부합니다
+func LoadShed(limiter *loadshed.Limiter, m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := limiter.Acquire()
+		if !ok {
+			m.RecordLoadShed("http", limiter.Limit(), limiter.InFlight(), true)
+			logger.Warn(c.Request.Context(), "request shed by concurrency limiter",
+				zap.String("path", c.FullPath()),
+				zap.Int("limit", limiter.Limit()),
+			)
+
+			c.Header("Retry-After", "1")
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"error":       "server overloaded",
+				"code":        "OVERLOADED",
+				"retry_after": 1,
+			})
+			c.Abort()
+			return
+		}
+
+		// 핸들러가 panic해도 토큰이 반환되도록 defer로 해제하고, 상태는 해제 시점에 읽습니다
+		defer func() {
+			// 시간 초과나 백엔드 사용 불가로 끝난 요청은 과부하 신호로 보고 한도를 줄입니다
+			status := c.Writer.Status()
+			dropped := status == http.StatusServiceUnavailable ||
+				status == http.StatusGatewayTimeout ||
+				errors.Is(c.Request.Context().Err(), context.DeadlineExceeded)
+			token.Done(dropped)
+			m.RecordLoadShed("http", limiter.Limit(), limiter.InFlight(), false)
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// BruteForceProtection은 인증 미들웨어(authenticate)를 감싸 반복된 인증 실패를 차단합니다
+// 클라이언트 IP와 (서명 키 ID, 클라이언트 IP)별로 실패를 집계하며, 잠긴 동안에는 인증을 시도하지 않고 429를 반환합니다
+// 클라이언트 IP는 신뢰할 프록시가 보낸 전달 헤더만 믿으므로 X-Forwarded-For로 잠금을 피하거나 다른 IP를 잠글 수 없습니다
+func BruteForceProtection(guard *lockout.Guard, authenticate gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		keys := lockout.Keys(c.GetHeader(auth.SignatureKeyIDHeader), clientIP(c))
+
+		if remaining, locked := guard.Check(ctx, keys...); locked {
+			retryAfter := int64(remaining.Seconds())
+			if retryAfter < 1 {
+				retryAfter = 1
+			}
+
+			logger.Warn(ctx, "authentication attempt while locked out",
+				logger.HTTPPath(c.Request.URL.Path),
+				logger.RemoteAddr(clientIP(c)),
+				zap.Duration("retry_after", remaining),
+			)
+
+			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "AUTH_LOCKED",
+					"message": "Too many failed authentication attempts, retry later",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		authenticate(c)
+
+		if c.IsAborted() && GetPrincipal(c) == nil && c.Writer.Status() == http.StatusUnauthorized {
+			guard.Fail(ctx, "http", keys...)
+		}
+	}
+}
@@ -6,17 +6,11 @@ import (
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
-	"github.com/YouSangSon/database-service/internal/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-const (
-	RequestIDHeader = "X-Request-ID"
-	RequestIDKey    = "request_id"
-)
-
 // RequestIDMiddleware는 각 요청에 고유 ID를 부여합니다
 func RequestIDMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -86,7 +80,7 @@ func LoggingMiddleware() gin.HandlerFunc {
 				logger.Duration(duration),
 				logger.DurationMs(duration),
 				zap.Int("response_size", blw.body.Len()),
-				zap.Errors("errors", c.Errors.Errors()),
+				zap.Strings("errors", c.Errors.Errors()),
 			)
 		} else {
 			logLevel := logger.Info
@@ -2,10 +2,12 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
 	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
@@ -16,7 +18,7 @@ func RateLimit(redisExtended *cache.RedisExtended, limit int64, window time.Dura
 
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
-		clientIP := c.ClientIP()
+		clientIP := clientIP(c)
 
 		// Check rate limit
 		allowed, err := rateLimiter.Allow(ctx, clientIP, limit, window)
@@ -62,7 +64,7 @@ func RateLimitByAPIKey(redisExtended *cache.RedisExtended, limits map[string]int
 		apiKey := c.GetHeader("X-API-Key")
 		if apiKey == "" {
 			// If no API key, use IP-based rate limiting
-			apiKey = c.ClientIP()
+			apiKey = clientIP(c)
 		}
 
 		// Get limit for this API key (default to 100 if not specified)
@@ -115,7 +117,7 @@ func RateLimitByUser(redisExtended *cache.RedisExtended, limit int64, window tim
 		userID, exists := c.Get("user_id")
 		if !exists {
 			// If no user ID, use IP-based rate limiting
-			userID = c.ClientIP()
+			userID = clientIP(c)
 		}
 
 		userIDStr := userID.(string)
@@ -152,3 +154,61 @@ func RateLimitByUser(redisExtended *cache.RedisExtended, limit int64, window tim
 		c.Next()
 	}
 }
+
+// TokenBucketRateLimit는 Redis 토큰 버킷 기반 rate limiting 미들웨어입니다
+// 인증된 테넌트, 사용자, 신뢰할 수 있는 클라이언트 IP 순으로 버킷을 구분하며 라우트별 오버라이드를 지원합니다
+func TokenBucketRateLimit(limiter ratelimit.Limiter, policy *ratelimit.Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		route := c.Request.Method + " " + c.FullPath()
+		limit, scope := policy.Resolve(route)
+		if limit.IsZero() {
+			c.Next()
+			return
+		}
+
+		// 버킷은 인증된 principal 기준이며, 인증 전 헤더(X-API-Key 등)는 검증되지 않았으므로 쓰지 않습니다
+		identity := ratelimit.Identity{ClientIP: clientIP(c)}
+		if principal := GetPrincipal(c); principal != nil {
+			identity.TenantID = principal.TenantID
+			identity.Subject = principal.Subject
+		}
+		key := ratelimit.BucketKey(identity, scope)
+
+		result, err := limiter.Take(ctx, key, limit)
+		if err != nil {
+			logger.Error(ctx, "rate limit check failed",
+				zap.String("key", key),
+				zap.Error(err),
+			)
+			// On error, allow the request (fail open)
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.FormatInt(result.Limit, 10))
+		c.Header("X-RateLimit-Remaining", strconv.FormatInt(result.Remaining, 10))
+
+		if !result.Allowed {
+			retryAfter := result.RetryAfterSeconds()
+			logger.Warn(ctx, "rate limit exceeded",
+				zap.String("key", key),
+				zap.String("route", route),
+				zap.Int64("burst", limit.Burst),
+				zap.Float64("rate", limit.Rate),
+			)
+
+			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error":       "rate limit exceeded",
+				"retry_after": retryAfter,
+				"limit":       result.Limit,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
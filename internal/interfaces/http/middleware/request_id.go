@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
@@ -30,6 +31,11 @@ func RequestID() gin.HandlerFunc {
 		// Set request ID in response header
 		c.Writer.Header().Set(RequestIDHeader, requestID)
 
+		// Propagate request ID to downstream layers (audit log, logging)
+		ctx := logger.WithRequestID(c.Request.Context(), requestID)
+		ctx = logger.WithFields(ctx, logger.RequestID(requestID))
+		c.Request = c.Request.WithContext(ctx)
+
 		c.Next()
 	}
 }
@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxSignedBodyBytes는 서명 검증을 위해 메모리에 읽는 본문의 최대 크기입니다
+const maxSignedBodyBytes = 10 << 20 // 10 MB
+
+// AuthenticateSignature는 HMAC 요청 서명을 검증하는 미들웨어입니다
+// 서명 헤더가 있으면 서명으로 인증하고, 없으면 fallback(예: OIDC Bearer 인증)에 위임합니다
+// fallback이 nil이면 서명 없는 요청은 거부됩니다
+func AuthenticateSignature(verifier *auth.HMACVerifier, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(auth.SignatureHeader) == "" && fallback != nil {
+			fallback(c)
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		body, err := readBodyForSignature(c)
+		if err != nil {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "PAYLOAD_TOO_LARGE",
+					"message": "Request body too large for signature verification",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		requestURI := c.Request.RequestURI
+		if requestURI == "" {
+			requestURI = c.Request.URL.RequestURI()
+		}
+		principal, err := verifier.Verify(ctx,
+			c.GetHeader(auth.SignatureKeyIDHeader),
+			c.GetHeader(auth.SignatureHeader),
+			auth.SignedRequest{
+				Method:     c.Request.Method,
+				RequestURI: requestURI,
+				Timestamp:  c.GetHeader(auth.SignatureTimestampHeader),
+				Body:       body,
+			},
+		)
+		if errors.Is(err, auth.ErrReplayCheckUnavailable) {
+			logger.Error(ctx, "request signature replay check failed", zap.Error(err))
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "SIGNATURE_CHECK_UNAVAILABLE",
+					"message": "Request signature cannot be verified right now, retry later",
+				},
+			})
+			c.Abort()
+			return
+		}
+		if err != nil {
+			logger.Warn(ctx, "request signature verification failed",
+				logger.HTTPPath(c.Request.URL.Path),
+				logger.RemoteAddr(clientIP(c)),
+				zap.String("key_id", c.GetHeader(auth.SignatureKeyIDHeader)),
+				zap.Error(err),
+			)
+
+			code := "INVALID_SIGNATURE"
+			switch {
+			case errors.Is(err, auth.ErrSignatureExpired):
+				code = "SIGNATURE_EXPIRED"
+			case errors.Is(err, auth.ErrSignatureReplayed):
+				code = "SIGNATURE_REPLAYED"
+			}
+
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    code,
+					"message": "Request signature verification failed",
+				},
+			})
+			c.Abort()
+			return
+		}
+
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+// readBodyForSignature는 본문을 읽고 핸들러가 다시 읽을 수 있도록 복원합니다
+func readBodyForSignature(c *gin.Context) ([]byte, error) {
+	if c.Request.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxSignedBodyBytes+1))
+	c.Request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxSignedBodyBytes {
+		return nil, errors.New("request body too large")
+	}
+
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
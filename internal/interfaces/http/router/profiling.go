@@ -0,0 +1,28 @@
+package router
+
+import (
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/profiling"
+	"github.com/gin-gonic/gin"
+)
+
+// SetupProfilingRouter sets up the net/http/pprof routes served on the internal profiling port
+// Profiles require the admin role when verifier is set; otherwise keep the port bound to a private interface
+func SetupProfilingRouter(verifier *auth.OIDCVerifier, ipFilter *ipfilter.Filter) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.RequestID())
+	router.Use(middleware.RecoveryMiddleware())
+	if ipFilter != nil {
+		router.Use(middleware.IPFilter(ipFilter))
+	}
+
+	pprofGroup := router.Group("/debug/pprof")
+	if verifier != nil {
+		pprofGroup.Use(middleware.Authenticate(verifier), middleware.RequireRole(auth.RoleAdmin))
+	}
+	pprofGroup.Any("/*profile", gin.WrapH(profiling.Handler()))
+
+	return router
+}
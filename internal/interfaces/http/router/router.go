@@ -4,14 +4,109 @@ import (
 	"time"
 
 	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/config"
 	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
 	httpHandler "github.com/YouSangSon/database-service/internal/interfaces/http/handler"
 	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/backendrouting"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/YouSangSon/database-service/internal/pkg/loadshed"
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
 	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/YouSangSon/database-service/internal/pkg/poolstats"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// Options holds optional components for the router
+type Options struct {
+	// OIDCVerifier enables bearer token authentication and role checks on /api/v1 when set
+	OIDCVerifier *auth.OIDCVerifier
+
+	// HMACVerifier accepts HMAC-signed requests on /api/v1 when set (webhook-style integrations)
+	HMACVerifier *auth.HMACVerifier
+
+	// APIKeyVerifier accepts tenant API keys (X-API-Key) on /api/v1 when set, restricted to the tenant's collection namespace
+	APIKeyVerifier *auth.APIKeyVerifier
+
+	// Impersonator lets privileged operators act as another user/tenant via X-Impersonate when set
+	Impersonator *auth.Impersonator
+
+	// AuthLockout temporarily blocks signature key IDs and client IPs after repeated authentication failures when set
+	AuthLockout *lockout.Guard
+
+	// LoadShedder rejects /api/v1 requests with 503 once the adaptive concurrency limit is reached when set
+	LoadShedder *loadshed.Limiter
+
+	// RateLimitPolicy replaces the fixed-window IP limiter with a Redis token bucket when set
+	RateLimitPolicy *ratelimit.Policy
+
+	// IPFilter applies CIDR allow/deny rules to every request when set
+	IPFilter *ipfilter.Filter
+
+	// TrustedProxies lists proxy IPs/CIDRs whose X-Forwarded-For/X-Real-IP headers are honored.
+	// When empty, forwarded headers are ignored and the connection address is the client IP
+	TrustedProxies []string
+
+	// AuditUseCase exposes the audit log query API at /api/v1/audit when set
+	AuditUseCase *usecase.AuditUseCase
+
+	// DeadLetterUseCase exposes the CDC dead letter queue and redrive API at /api/v1/admin/cdc/dead-letters when set
+	DeadLetterUseCase *usecase.DeadLetterUseCase
+
+	// CDCReplayUseCase exposes the CDC replay API at /api/v1/admin/cdc/replay when set
+	CDCReplayUseCase *usecase.CDCReplayUseCase
+
+	// BackupUseCase exposes collection backup/restore jobs at /api/v1/admin/backups when set
+	// (point-in-time restore additionally requires an event scanner, see BackupUseCase.SetEventScanner)
+	BackupUseCase *usecase.BackupUseCase
+
+	// MaintenanceUseCase exposes collection maintenance jobs (compact, vacuum, forcemerge) at /api/v1/admin/maintenance when set
+	MaintenanceUseCase *usecase.MaintenanceUseCase
+
+	// DuplicateUseCase exposes duplicate document scans and merge/delete at /api/v1/admin/duplicates when set
+	DuplicateUseCase *usecase.DuplicateUseCase
+
+	// AnonymizationUseCase exposes bulk field anonymization jobs at /api/v1/admin/anonymization when set
+	AnonymizationUseCase *usecase.AnonymizationUseCase
+
+	// SearchMirrorUseCase exposes consistency checks between collections and their Elasticsearch mirrors at /api/v1/admin/search-mirror when set
+	SearchMirrorUseCase *usecase.SearchMirrorUseCase
+
+	// FixtureUseCase exposes loading JSON fixture sets into collections at /api/v1/admin/fixtures when set
+	FixtureUseCase *usecase.FixtureUseCase
+
+	// MigrationUseCase exposes online backend migrations (dual-write, backfill, cutover) at /api/v1/admin/migrations when set
+	MigrationUseCase *usecase.MigrationUseCase
+
+	// SchemaMigrationUseCase exposes versioned schema migrations of SQL document tables at /api/v1/admin/schema-migrations when set
+	SchemaMigrationUseCase *usecase.SchemaMigrationUseCase
+
+	// WebhookUseCase exposes webhook subscription management and delivery logs at /api/v1/webhooks when set
+	WebhookUseCase *usecase.WebhookUseCase
+
+	// TenantUseCase exposes tenant provisioning at /api/v1/admin/tenants when set
+	TenantUseCase *usecase.TenantUseCase
+
+	// BackendRouting picks the database for requests that don't name one (per-collection rules and default backend) and
+	// rejects backends that are not enabled when set; otherwise requests default to mongodb.
+	// It also exposes repointing collections to another backend at /api/v1/admin/backend-routes
+	BackendRouting *backendrouting.Router
+
+	// FeatureFlags gates backends per environment/tenant (backend.<name> flags) and exposes flag evaluation and
+	// runtime overrides at /api/v1/admin/feature-flags when set
+	FeatureFlags *featureflag.Flags
+
+	// ConfigChecker exposes validating the configuration file and probing enabled backends at /api/v1/admin/config/validate when set
+	ConfigChecker *config.Checker
+
+	// PoolStats exposes connection pool statistics at /api/v1/admin/pools when set
+	PoolStats *poolstats.Registry
+}
+
 // SetupRouter sets up all routes for the API server
 func SetupRouter(
 	documentUC *usecase.DocumentUseCase,
@@ -21,26 +116,38 @@ func SetupRouter(
 	enableTracing bool,
 	enableMetrics bool,
 	environment string,
+	opts *Options,
 ) *gin.Engine {
+	if opts == nil {
+		opts = &Options{}
+	}
+
 	// Set Gin mode based on environment
 	if environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	router := gin.New()
+	if err := middleware.TrustProxies(router, opts.TrustedProxies); err != nil {
+		// Invalid entries are rejected by config validation; never fall back to gin's trust-everything default
+		_ = middleware.TrustProxies(router, nil)
+	}
 
 	// Global Middlewares
 	router.Use(middleware.RequestID())
-	router.Use(middleware.Logger())
-	router.Use(middleware.Recovery())
+	router.Use(middleware.LoggingMiddleware())
+	router.Use(middleware.RecoveryMiddleware())
+	if opts.IPFilter != nil {
+		router.Use(middleware.IPFilter(opts.IPFilter))
+	}
 	router.Use(middleware.CORS())
 
 	if enableTracing {
-		router.Use(middleware.Tracing())
+		router.Use(middleware.TracingMiddleware())
 	}
 
 	if enableMetrics {
-		router.Use(middleware.Metrics(m))
+		router.Use(middleware.MetricsMiddleware())
 	}
 
 	// Extended Redis client for rate limiting
@@ -48,6 +155,36 @@ func SetupRouter(
 
 	// Rate limiting middleware
 	apiRateLimit := middleware.RateLimit(redisExtended, 1000, time.Minute)
+	if opts.RateLimitPolicy != nil {
+		apiRateLimit = middleware.TokenBucketRateLimit(
+			redisExtended.NewTokenBucketLimiter("api:ratelimit:bucket"),
+			opts.RateLimitPolicy,
+		)
+	}
+
+	// Authentication: bearer token, HMAC signature, tenant API key, or any of them when several are configured
+	var authenticate gin.HandlerFunc
+	if opts.OIDCVerifier != nil {
+		authenticate = middleware.Authenticate(opts.OIDCVerifier)
+	}
+	if opts.HMACVerifier != nil {
+		authenticate = middleware.AuthenticateSignature(opts.HMACVerifier, authenticate)
+	}
+	if opts.APIKeyVerifier != nil {
+		authenticate = middleware.AuthenticateAPIKey(opts.APIKeyVerifier, authenticate)
+	}
+	if authenticate != nil && opts.AuthLockout != nil {
+		authenticate = middleware.BruteForceProtection(opts.AuthLockout, authenticate)
+	}
+
+	// Reader/writer checks are no-ops unless authentication is enabled.
+	// Admin routes fail closed: without an authenticator no request carries a principal, so they answer 401
+	requireReader, requireWriter := passthrough, passthrough
+	requireAdmin := middleware.RequireRole(auth.RoleAdmin)
+	if authenticate != nil {
+		requireReader = middleware.RequireRole(auth.RoleReader)
+		requireWriter = middleware.RequireRole(auth.RoleWriter)
+	}
 
 	// Initialize handlers
 	documentHandler := httpHandler.NewDocumentHandler(documentUC)
@@ -64,121 +201,348 @@ func SetupRouter(
 	// API v1 Group with rate limiting and database selection
 	// ============================================
 	v1 := router.Group("/api/v1")
+	if opts.LoadShedder != nil {
+		v1.Use(middleware.LoadShed(opts.LoadShedder, m))
+	}
+	if authenticate != nil {
+		v1.Use(authenticate)
+		if opts.Impersonator != nil {
+			v1.Use(middleware.Impersonate(opts.Impersonator))
+		}
+	}
 	v1.Use(apiRateLimit)
-	v1.Use(middleware.DatabaseSelector())
+	v1.Use(middleware.DatabaseSelector(opts.BackendRouting, opts.FeatureFlags))
 	{
-		// ========================================
-		// Basic CRUD Operations
-		// ========================================
-		documents := v1.Group("/documents")
-		{
-			// Create document
-			documents.POST("", documentHandler.Create)
+		// Data routes are served at /api/v1/... (backend picked by X-Database-Type, collection routing or the default)
+		// and at /api/v1/{backend}/... which pins the request to that backend
+		dataRoutes := func(api *gin.RouterGroup) {
+			// ========================================
+			// Basic CRUD Operations
+			// ========================================
+			documents := api.Group("/documents")
+			{
+				// Create document
+				documents.POST("", requireWriter, documentHandler.Create)
 
-			// Read document
-			documents.GET("/:collection/:id", documentHandler.GetByID)
+				// Read document
+				documents.GET("/:collection/:id", requireReader, documentHandler.GetByID)
 
-			// Update document
-			documents.PUT("/:collection/:id", documentHandler.Update)
+				// Update document
+				documents.PUT("/:collection/:id", requireWriter, documentHandler.Update)
 
-			// Replace document
-			documents.PUT("/:collection/:id/replace", documentHandlerExt.Replace)
+				// Replace document
+				documents.PUT("/:collection/:id/replace", requireWriter, documentHandlerExt.Replace)
 
-			// Delete document
-			documents.DELETE("/:collection/:id", documentHandler.Delete)
-		}
+				// Delete document
+				documents.DELETE("/:collection/:id", requireWriter, documentHandler.Delete)
 
-		// ========================================
-		// Query & Search Operations
-		// ========================================
-		documents.GET("/:collection", documentHandler.List)
-		documents.POST("/:collection/search", documentHandlerExt.Search)
-		documents.POST("/:collection/count", documentHandlerExt.Count)
-		documents.GET("/:collection/count/estimate", documentHandlerExt.EstimatedCount)
+				// Restore soft-deleted document
+				documents.POST("/:collection/:id/restore", requireWriter, documentHandler.Restore)
 
-		// ========================================
-		// Atomic Operations
-		// ========================================
-		documents.POST("/:collection/:id/find-and-update", documentHandlerExt.FindAndUpdate)
-		documents.POST("/:collection/:id/find-and-replace", documentHandlerExt.FindAndReplace)
-		documents.POST("/:collection/:id/find-and-delete", documentHandlerExt.FindAndDelete)
-		documents.POST("/:collection/upsert", documentHandlerExt.Upsert)
+				// Revision history
+				documents.GET("/:collection/:id/revisions", requireReader, documentHandler.Revisions)
+			}
 
-		// ========================================
-		// Aggregations
-		// ========================================
-		documents.POST("/:collection/aggregate", documentHandler.Aggregate)
-		documents.POST("/:collection/distinct", documentHandlerExt.Distinct)
+			// ========================================
+			// Query & Search Operations
+			// ========================================
+			documents.GET("/:collection", requireReader, documentHandler.List)
+			documents.GET("/:collection/export", requireReader, documentHandler.Export)
+			documents.POST("/:collection/search", requireReader, documentHandlerExt.Search)
+			documents.POST("/:collection/count", requireReader, documentHandlerExt.Count)
+			documents.GET("/:collection/count/estimate", requireReader, documentHandlerExt.EstimatedCount)
 
-		// ========================================
-		// Bulk Operations
-		// ========================================
-		bulk := v1.Group("/documents/bulk")
-		{
-			bulk.POST("/insert", documentHandlerExt.BulkInsert)
+			// ========================================
+			// Atomic Operations
+			// ========================================
+			documents.POST("/:collection/:id/find-and-update", requireWriter, documentHandlerExt.FindAndUpdate)
+			documents.POST("/:collection/:id/find-and-replace", requireWriter, documentHandlerExt.FindAndReplace)
+			documents.POST("/:collection/:id/find-and-delete", requireWriter, documentHandlerExt.FindAndDelete)
+			documents.POST("/:collection/upsert", requireWriter, documentHandlerExt.Upsert)
+
+			// ========================================
+			// Aggregations
+			// ========================================
+			documents.POST("/:collection/aggregate", requireReader, documentHandler.Aggregate)
+			documents.POST("/:collection/distinct", requireReader, documentHandlerExt.Distinct)
+
+			// ========================================
+			// Bulk Operations
+			// ========================================
+			bulk := api.Group("/documents/bulk")
+			{
+				bulk.POST("/insert", requireWriter, documentHandlerExt.BulkInsert)
+			}
+			documents.POST("/:collection/update-many", requireWriter, documentHandlerExt.UpdateMany)
+			documents.POST("/:collection/delete-many", requireWriter, documentHandlerExt.DeleteMany)
+			api.POST("/documents/bulk/write", requireWriter, documentHandlerExt.BulkWrite)
+
+			// ========================================
+			// Index Management
+			// ========================================
+			indexes := api.Group("/indexes")
+			{
+				indexes.POST("/:collection", requireAdmin, documentHandlerExt.CreateIndex)
+				indexes.POST("/:collection/bulk", requireAdmin, documentHandlerExt.CreateIndexes)
+				indexes.DELETE("/:collection/:index_name", requireAdmin, documentHandlerExt.DropIndex)
+				indexes.GET("/:collection", requireReader, documentHandlerExt.ListIndexes)
+			}
+
+			// ========================================
+			// Collection Management
+			// ========================================
+			collections := api.Group("/collections")
+			{
+				collections.POST("", requireAdmin, documentHandlerExt.CreateCollection)
+				collections.DELETE("/:collection", requireAdmin, documentHandlerExt.DropCollection)
+				collections.POST("/:collection/rename", requireAdmin, documentHandlerExt.RenameCollection)
+				collections.POST("/:collection/clone", requireAdmin, documentHandlerExt.CloneCollection)
+				collections.GET("", requireReader, documentHandlerExt.ListCollections)
+				collections.GET("/:collection/exists", requireReader, documentHandlerExt.CollectionExists)
+				collections.GET("/:collection/usage", requireReader, documentHandlerExt.CollectionUsage)
+			}
+
+			// ========================================
+			// Transactions
+			// ========================================
+			transactions := api.Group("/transactions")
+			{
+				transactions.POST("/execute", requireWriter, documentHandlerExt.ExecuteTransaction)
+			}
+
+			// ========================================
+			// Raw Query Execution
+			// ========================================
+			query := api.Group("/query")
+			{
+				query.POST("/raw", requireAdmin, documentHandlerExt.ExecuteRaw)
+				query.POST("/raw/typed", requireAdmin, documentHandlerExt.ExecuteRawTyped)
+			}
 		}
-		documents.POST("/:collection/update-many", documentHandlerExt.UpdateMany)
-		documents.POST("/:collection/delete-many", documentHandlerExt.DeleteMany)
-		v1.POST("/documents/bulk/write", documentHandlerExt.BulkWrite)
+		dataRoutes(v1)
+		dataRoutes(v1.Group("/:backend"))
 
 		// ========================================
-		// Index Management
+		// Health & Monitoring (with DB selector)
 		// ========================================
-		indexes := v1.Group("/indexes")
+		health := v1.Group("/health")
 		{
-			indexes.POST("/:collection", documentHandlerExt.CreateIndex)
-			indexes.POST("/:collection/bulk", documentHandlerExt.CreateIndexes)
-			indexes.DELETE("/:collection/:index_name", documentHandlerExt.DropIndex)
-			indexes.GET("/:collection", documentHandlerExt.ListIndexes)
+			health.GET("/database/:db_type", requireAdmin, documentHandlerExt.DatabaseHealth)
 		}
 
-		// ========================================
-		// Collection Management
-		// ========================================
-		collections := v1.Group("/collections")
+		// Metrics endpoint
+		v1.GET("/metrics", requireAdmin, documentHandlerExt.GetMetrics)
+
+		// Stats endpoints
+		stats := v1.Group("/stats")
 		{
-			collections.POST("", documentHandlerExt.CreateCollection)
-			collections.DELETE("/:collection", documentHandlerExt.DropCollection)
-			collections.POST("/:old_name/rename", documentHandlerExt.RenameCollection)
-			collections.GET("", documentHandlerExt.ListCollections)
-			collections.GET("/:collection/exists", documentHandlerExt.CollectionExists)
+			stats.GET("/database/:db_type", requireAdmin, documentHandlerExt.GetDatabaseStats)
+			stats.GET("/collection/:collection", requireAdmin, documentHandlerExt.GetCollectionStats)
 		}
 
-		// ========================================
-		// Transactions
-		// ========================================
-		transactions := v1.Group("/transactions")
-		{
-			transactions.POST("/execute", documentHandlerExt.ExecuteTransaction)
+		// Audit log (compliance review)
+		if opts.AuditUseCase != nil {
+			auditHandler := httpHandler.NewAuditHandler(opts.AuditUseCase)
+			v1.GET("/audit", requireAdmin, auditHandler.Query)
 		}
 
-		// ========================================
-		// Raw Query Execution
-		// ========================================
-		query := v1.Group("/query")
+		// Cache policy administration (per instance, reset to config on restart)
+		cacheHandler := httpHandler.NewCacheHandler(documentUC)
+		cachePolicies := v1.Group("/admin/cache/policies")
 		{
-			query.POST("/raw", documentHandlerExt.ExecuteRaw)
-			query.POST("/raw/typed", documentHandlerExt.ExecuteRawTyped)
+			cachePolicies.GET("", requireAdmin, cacheHandler.ListPolicies)
+			cachePolicies.PUT("/:collection", requireAdmin, cacheHandler.PutPolicy)
+			cachePolicies.DELETE("/:collection", requireAdmin, cacheHandler.DeletePolicy)
 		}
 
-		// ========================================
-		// Health & Monitoring (with DB selector)
-		// ========================================
-		health := v1.Group("/health")
+		// Circuit breakers per database type (manual trip/reset applies to this instance only)
+		circuitBreakerHandler := httpHandler.NewCircuitBreakerHandler(documentUC.CircuitBreakers())
+		circuitBreakers := v1.Group("/admin/circuit-breakers")
 		{
-			health.GET("/database/:db_type", documentHandlerExt.DatabaseHealth)
+			circuitBreakers.GET("", requireAdmin, circuitBreakerHandler.List)
+			circuitBreakers.POST("/:name/trip", requireAdmin, circuitBreakerHandler.Trip)
+			circuitBreakers.POST("/:name/reset", requireAdmin, circuitBreakerHandler.Reset)
 		}
 
-		// Metrics endpoint
-		v1.GET("/metrics", documentHandlerExt.GetMetrics)
+		// Backend routing (collection pins apply to this instance only and reset to config on restart)
+		if opts.BackendRouting != nil {
+			backendRoutingHandler := httpHandler.NewBackendRoutingHandler(opts.BackendRouting)
+			backendRoutes := v1.Group("/admin/backend-routes")
+			{
+				backendRoutes.GET("", requireAdmin, backendRoutingHandler.List)
+				backendRoutes.PUT("/:collection", requireAdmin, backendRoutingHandler.Pin)
+				backendRoutes.DELETE("/:collection", requireAdmin, backendRoutingHandler.Unpin)
+			}
+		}
 
-		// Stats endpoints
-		stats := v1.Group("/stats")
-		{
-			stats.GET("/database/:db_type", documentHandlerExt.GetDatabaseStats)
-			stats.GET("/collection/:collection", documentHandlerExt.GetCollectionStats)
+		// CDC dead letter queue (events that failed to publish after retries)
+		if opts.DeadLetterUseCase != nil {
+			deadLetterHandler := httpHandler.NewDeadLetterHandler(opts.DeadLetterUseCase)
+			deadLetters := v1.Group("/admin/cdc/dead-letters")
+			{
+				deadLetters.GET("", requireAdmin, deadLetterHandler.List)
+				deadLetters.POST("/redrive", requireAdmin, deadLetterHandler.RedriveAll)
+				deadLetters.POST("/:id/redrive", requireAdmin, deadLetterHandler.Redrive)
+				deadLetters.DELETE("/:id", requireAdmin, deadLetterHandler.Discard)
+			}
+		}
+
+		// CDC replay (republish retained events to rebuild downstream read models)
+		if opts.CDCReplayUseCase != nil {
+			replayHandler := httpHandler.NewCDCReplayHandler(opts.CDCReplayUseCase)
+			v1.POST("/admin/cdc/replay", requireAdmin, replayHandler.Replay)
+		}
+
+		// Collection backup and restore (background jobs; progress is kept per instance)
+		if opts.BackupUseCase != nil {
+			backupHandler := httpHandler.NewBackupHandler(opts.BackupUseCase)
+			backups := v1.Group("/admin/backups")
+			{
+				backups.POST("", requireAdmin, backupHandler.StartBackup)
+				backups.POST("/:backup_id/restore", requireAdmin, backupHandler.StartRestore)
+				backups.POST("/pitr", requireAdmin, backupHandler.PointInTimeRestore)
+				backups.GET("/jobs", requireAdmin, backupHandler.ListJobs)
+				backups.GET("/jobs/:id", requireAdmin, backupHandler.GetJob)
+			}
+		}
+
+		// Collection maintenance (backend-specific compaction; results are kept per instance)
+		if opts.MaintenanceUseCase != nil {
+			maintenanceHandler := httpHandler.NewMaintenanceHandler(opts.MaintenanceUseCase)
+			maintenance := v1.Group("/admin/maintenance")
+			{
+				maintenance.POST("", requireAdmin, maintenanceHandler.StartMaintenance)
+				maintenance.GET("/operations/:db_type", requireAdmin, maintenanceHandler.Operations)
+				maintenance.GET("/jobs", requireAdmin, maintenanceHandler.ListJobs)
+				maintenance.GET("/jobs/:id", requireAdmin, maintenanceHandler.GetJob)
+			}
+		}
+
+		// Duplicate detection by key fields and merge/delete (scan results are kept per instance)
+		if opts.DuplicateUseCase != nil {
+			duplicateHandler := httpHandler.NewDuplicateHandler(opts.DuplicateUseCase)
+			duplicates := v1.Group("/admin/duplicates")
+			{
+				duplicates.POST("/scans", requireAdmin, duplicateHandler.StartScan)
+				duplicates.GET("/scans", requireAdmin, duplicateHandler.ListScans)
+				duplicates.GET("/scans/:id", requireAdmin, duplicateHandler.GetScan)
+				duplicates.POST("/resolve", requireAdmin, duplicateHandler.Resolve)
+			}
+		}
+
+		// Bulk anonymization of fields for erasure requests (job state is kept per instance)
+		if opts.AnonymizationUseCase != nil {
+			anonymizationHandler := httpHandler.NewAnonymizationHandler(opts.AnonymizationUseCase)
+			anonymization := v1.Group("/admin/anonymization")
+			{
+				anonymization.POST("/jobs", requireAdmin, anonymizationHandler.StartJob)
+				anonymization.GET("/jobs", requireAdmin, anonymizationHandler.ListJobs)
+				anonymization.GET("/jobs/:id", requireAdmin, anonymizationHandler.GetJob)
+				anonymization.POST("/jobs/:id/cancel", requireAdmin, anonymizationHandler.CancelJob)
+				anonymization.POST("/jobs/:id/resume", requireAdmin, anonymizationHandler.ResumeJob)
+			}
+		}
+
+		// Consistency checks between the primary store and the search mirror (results are kept per instance)
+		if opts.SearchMirrorUseCase != nil {
+			searchMirrorHandler := httpHandler.NewSearchMirrorHandler(opts.SearchMirrorUseCase)
+			searchMirror := v1.Group("/admin/search-mirror")
+			{
+				searchMirror.GET("/collections", requireAdmin, searchMirrorHandler.ListMirrors)
+				searchMirror.POST("/verifications", requireAdmin, searchMirrorHandler.StartVerification)
+				searchMirror.GET("/verifications", requireAdmin, searchMirrorHandler.ListVerifications)
+				searchMirror.GET("/verifications/:id", requireAdmin, searchMirrorHandler.GetVerification)
+			}
+		}
+
+		// Idempotent fixture loading for demo environments and integration tests
+		if opts.FixtureUseCase != nil {
+			fixtureHandler := httpHandler.NewFixtureHandler(opts.FixtureUseCase)
+			v1.POST("/admin/fixtures/load", requireAdmin, fixtureHandler.Load)
+		}
+
+		// Online migration of a collection between backends (state is kept per instance)
+		if opts.MigrationUseCase != nil {
+			migrationHandler := httpHandler.NewMigrationHandler(opts.MigrationUseCase)
+			migrations := v1.Group("/admin/migrations")
+			{
+				migrations.POST("", requireAdmin, migrationHandler.StartMigration)
+				migrations.GET("", requireAdmin, migrationHandler.ListMigrations)
+				migrations.GET("/:id", requireAdmin, migrationHandler.GetMigration)
+				migrations.POST("/:id/verify", requireAdmin, migrationHandler.VerifyMigration)
+				migrations.POST("/:id/cutover", requireAdmin, migrationHandler.CutOver)
+				migrations.POST("/:id/complete", requireAdmin, migrationHandler.Complete)
+				migrations.POST("/:id/abort", requireAdmin, migrationHandler.Abort)
+			}
+		}
+
+		// Versioned schema migrations of the SQL document tables (PostgreSQL, MySQL)
+		if opts.SchemaMigrationUseCase != nil {
+			schemaHandler := httpHandler.NewSchemaMigrationHandler(opts.SchemaMigrationUseCase)
+			schemaMigrations := v1.Group("/admin/schema-migrations")
+			{
+				schemaMigrations.GET("/:db_type", requireAdmin, schemaHandler.Status)
+				schemaMigrations.POST("/:db_type/up", requireAdmin, schemaHandler.Up)
+				schemaMigrations.POST("/:db_type/down", requireAdmin, schemaHandler.Down)
+			}
+		}
+
+		// Webhook subscriptions (HTTPS callbacks for document changes) and delivery logs
+		if opts.WebhookUseCase != nil {
+			webhookHandler := httpHandler.NewWebhookHandler(opts.WebhookUseCase)
+			webhooks := v1.Group("/webhooks/subscriptions")
+			{
+				webhooks.POST("", requireAdmin, webhookHandler.Create)
+				webhooks.GET("", requireAdmin, webhookHandler.List)
+				webhooks.GET("/:id", requireAdmin, webhookHandler.Get)
+				webhooks.PATCH("/:id", requireAdmin, webhookHandler.Update)
+				webhooks.DELETE("/:id", requireAdmin, webhookHandler.Delete)
+				webhooks.GET("/:id/deliveries", requireAdmin, webhookHandler.ListDeliveries)
+				webhooks.POST("/:id/deliveries/:delivery_id/redeliver", requireAdmin, webhookHandler.Redeliver)
+			}
+		}
+
+		// Tenant provisioning (collection namespace, indexes, quota, API keys, CDC topics)
+		if opts.TenantUseCase != nil {
+			tenantHandler := httpHandler.NewTenantHandler(opts.TenantUseCase)
+			tenants := v1.Group("/admin/tenants")
+			{
+				tenants.POST("", requireAdmin, tenantHandler.Provision)
+				tenants.GET("", requireAdmin, tenantHandler.List)
+				tenants.GET("/:id", requireAdmin, tenantHandler.Get)
+				tenants.GET("/:id/usage", requireAdmin, tenantHandler.Usage)
+			}
+		}
+
+		// Feature flags (overrides are stored in Redis and shared by every instance)
+		if opts.FeatureFlags != nil {
+			featureFlagHandler := httpHandler.NewFeatureFlagHandler(opts.FeatureFlags)
+			featureFlags := v1.Group("/admin/feature-flags")
+			{
+				featureFlags.GET("", requireAdmin, featureFlagHandler.List)
+				featureFlags.GET("/:name", requireAdmin, featureFlagHandler.Get)
+				featureFlags.PUT("/:name", requireAdmin, featureFlagHandler.Set)
+				featureFlags.DELETE("/:name", requireAdmin, featureFlagHandler.Delete)
+			}
+		}
+
+		// Configuration diagnostics (validation, backend connectivity, redacted effective config)
+		if opts.ConfigChecker != nil {
+			configHandler := httpHandler.NewConfigHandler(opts.ConfigChecker)
+			v1.POST("/admin/config/validate", requireAdmin, configHandler.Validate)
+		}
+
+		// Connection pool statistics (in-use, idle, waits per MongoDB/SQL/Redis/Cassandra pool)
+		if opts.PoolStats != nil {
+			poolHandler := httpHandler.NewPoolHandler(opts.PoolStats)
+			v1.GET("/admin/pools", requireAdmin, poolHandler.List)
 		}
 	}
 
 	return router
 }
+
+// passthrough is a no-op middleware
+func passthrough(c *gin.Context) {
+	c.Next()
+}
@@ -0,0 +1,163 @@
+// Package anonymize는 문서의 개인정보 필드를 되돌릴 수 없는 값으로 바꿉니다 (GDPR 삭제 요청 처리용)
+//
+// hash는 HMAC-SHA256으로 값을 가명화해 같은 원래 값은 같은 결과가 되므로 조인/집계는 유지되고,
+// rewrite는 값을 고정 값으로 덮어씁니다. 이미 익명화된 값은 다시 바꾸지 않으므로
+// 같은 규칙으로 여러 번 적용해도 결과가 같고, 중단된 작업을 처음부터 다시 실행해도 안전합니다
+package anonymize
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// HashPrefix는 hash 규칙으로 바꾼 값의 접두사입니다 (이미 익명화된 값을 구분하는 데 사용)
+const HashPrefix = "anon:"
+
+// minKeyLength는 hash 규칙에 필요한 최소 키 길이(바이트)입니다
+const minKeyLength = 16
+
+// Action은 필드 익명화 방식입니다
+type Action string
+
+const (
+	// ActionHash는 값을 HashPrefix + HMAC-SHA256(hex)로 바꿉니다
+	ActionHash Action = "hash"
+
+	// ActionRewrite는 값을 규칙의 Value로 덮어씁니다
+	ActionRewrite Action = "rewrite"
+)
+
+// Rule은 필드 익명화 규칙입니다
+type Rule struct {
+	Field  string      // 점으로 구분된 data 필드 경로
+	Action Action      // hash, rewrite
+	Value  interface{} // rewrite로 쓸 값 (nil이면 null)
+}
+
+// Anonymizer는 규칙에 따라 문서 데이터를 익명화합니다
+type Anonymizer struct {
+	key   []byte
+	rules []Rule
+}
+
+// New는 새로운 Anonymizer를 생성합니다
+// hash 규칙이 있으면 key가 16바이트 이상이어야 합니다 (키가 바뀌면 같은 값이라도 다른 결과가 됨)
+func New(key []byte, rules []Rule) (*Anonymizer, error) {
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("at least one rule is required")
+	}
+	seen := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if rule.Field == "" {
+			return nil, fmt.Errorf("rule %d: field is required", i)
+		}
+		if seen[rule.Field] {
+			return nil, fmt.Errorf("rule %d: field %s is listed more than once", i, rule.Field)
+		}
+		seen[rule.Field] = true
+
+		switch rule.Action {
+		case ActionHash:
+			if len(key) < minKeyLength {
+				return nil, fmt.Errorf("rule %d: hash requires a key of at least %d bytes", i, minKeyLength)
+			}
+		case ActionRewrite:
+		default:
+			return nil, fmt.Errorf("rule %d: unsupported action %q", i, rule.Action)
+		}
+	}
+	return &Anonymizer{key: key, rules: rules}, nil
+}
+
+// Fields는 규칙의 필드 경로 목록을 반환합니다
+func (a *Anonymizer) Fields() []string {
+	fields := make([]string, len(a.rules))
+	for i, rule := range a.rules {
+		fields[i] = rule.Field
+	}
+	return fields
+}
+
+// Apply는 규칙을 적용한 data 사본과 바꾼 필드 목록을 반환합니다 (data는 바꾸지 않음)
+// 없거나 null인 필드와 이미 익명화된 값은 그대로 두며, 바꾼 필드가 없으면 data를 그대로 반환합니다
+func (a *Anonymizer) Apply(data map[string]interface{}) (map[string]interface{}, []string) {
+	result := data
+	var changed []string
+	for _, rule := range a.rules {
+		parts := strings.Split(rule.Field, ".")
+		current, ok := lookup(result, parts)
+		if !ok {
+			continue
+		}
+
+		var next interface{}
+		switch rule.Action {
+		case ActionHash:
+			if s, isString := current.(string); isString && strings.HasPrefix(s, HashPrefix) {
+				continue
+			}
+			next = a.Hash(current)
+		case ActionRewrite:
+			if reflect.DeepEqual(current, rule.Value) {
+				continue
+			}
+			next = rule.Value
+		}
+
+		result = set(result, parts, next)
+		changed = append(changed, rule.Field)
+	}
+	return result, changed
+}
+
+// Hash는 값의 HMAC-SHA256을 HashPrefix를 붙인 hex 문자열로 반환합니다
+// 문자열은 그대로, 다른 타입은 JSON으로 직렬화해 해시하므로 1과 "1"은 다른 결과가 됩니다
+func (a *Anonymizer) Hash(value interface{}) string {
+	var input []byte
+	if s, ok := value.(string); ok {
+		input = []byte(s)
+	} else {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			encoded = []byte(fmt.Sprint(value))
+		}
+		input = append([]byte{'\x00'}, encoded...)
+	}
+
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write(input)
+	return HashPrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// lookup은 경로의 값을 꺼냅니다 (없거나 null이면 false)
+func lookup(data map[string]interface{}, parts []string) (interface{}, bool) {
+	var current interface{} = data
+	for _, part := range parts {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = m[part]
+	}
+	return current, current != nil
+}
+
+// set은 경로의 값을 바꾼 사본을 반환합니다 (경로에 있는 맵만 복사)
+func set(data map[string]interface{}, parts []string, value interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	if len(parts) == 1 {
+		copied[parts[0]] = value
+		return copied
+	}
+	child, _ := copied[parts[0]].(map[string]interface{})
+	copied[parts[0]] = set(child, parts[1:], value)
+	return copied
+}
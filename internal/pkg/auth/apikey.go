@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// APIKeyHeader는 API 키를 보내는 헤더입니다
+	APIKeyHeader = "X-API-Key"
+
+	// apiKeyPrefix는 발급한 API 키의 접두사입니다 (로그/저장소에서 키를 알아보기 쉽게)
+	apiKeyPrefix = "dbs"
+
+	// apiKeyIDBytes, apiKeySecretBytes는 키 ID와 비밀 값의 난수 길이입니다
+	apiKeyIDBytes     = 8
+	apiKeySecretBytes = 32
+
+	// defaultAPIKeyCacheTTL은 검증한 키를 저장소를 다시 조회하지 않고 재사용하는 시간입니다
+	defaultAPIKeyCacheTTL = time.Minute
+)
+
+var (
+	// ErrMissingAPIKey는 API 키 헤더가 없는 경우의 에러입니다
+	ErrMissingAPIKey = errors.New("missing api key")
+
+	// ErrInvalidAPIKey는 API 키 형식이 잘못되었거나 등록되지 않은 경우의 에러입니다
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+// APIKey는 저장소에 보관된 API 키입니다 (비밀 값은 SHA-256 해시만 보관)
+type APIKey struct {
+	ID       string
+	TenantID string
+
+	// Namespace는 이 키로 접근할 수 있는 컬렉션 이름 접두사입니다 (비어 있으면 제한 없음)
+	Namespace string
+
+	// SecretHash는 비밀 값의 SHA-256 해시입니다
+	SecretHash []byte
+
+	// Roles는 이 키로 인증된 요청에 부여할 역할입니다
+	Roles []Role
+}
+
+// APIKeyStore는 키 ID로 API 키를 조회하는 저장소입니다 (없으면 nil, nil)
+type APIKeyStore interface {
+	FindAPIKey(ctx context.Context, id string) (*APIKey, error)
+}
+
+// IssuedAPIKey는 새로 발급한 API 키입니다 (Token은 발급 응답에서만 반환하고 저장하지 않음)
+type IssuedAPIKey struct {
+	ID         string
+	Token      string
+	SecretHash []byte
+}
+
+// GenerateAPIKey는 "dbs_<id>_<secret>" 형식의 새 API 키를 발급합니다
+func GenerateAPIKey() (*IssuedAPIKey, error) {
+	id, err := randomHex(apiKeyIDBytes)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomHex(apiKeySecretBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &IssuedAPIKey{
+		ID:         id,
+		Token:      apiKeyPrefix + "_" + id + "_" + secret,
+		SecretHash: HashAPIKeySecret(secret),
+	}, nil
+}
+
+// ParseAPIKey는 API 키를 키 ID와 비밀 값으로 나눕니다
+func ParseAPIKey(token string) (id, secret string, err error) {
+	parts := strings.Split(token, "_")
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("%w: malformed key", ErrInvalidAPIKey)
+	}
+	return parts[1], parts[2], nil
+}
+
+// HashAPIKeySecret은 API 키 비밀 값의 SHA-256 해시를 반환합니다
+func HashAPIKeySecret(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+// APIKeyVerifier는 X-API-Key 헤더의 API 키를 검증합니다
+// 검증에 성공한 키는 cacheTTL 동안 캐시하므로 저장소에서 키를 바꾸면 그 시간 안에 반영됩니다
+type APIKeyVerifier struct {
+	store    APIKeyStore
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey
+}
+
+// cachedAPIKey는 캐시된 API 키와 만료 시각입니다
+type cachedAPIKey struct {
+	key       *APIKey
+	expiresAt time.Time
+}
+
+// NewAPIKeyVerifier는 새로운 APIKeyVerifier를 생성합니다 (cacheTTL이 0 이하이면 1분)
+func NewAPIKeyVerifier(store APIKeyStore, cacheTTL time.Duration) *APIKeyVerifier {
+	if cacheTTL <= 0 {
+		cacheTTL = defaultAPIKeyCacheTTL
+	}
+	return &APIKeyVerifier{
+		store:    store,
+		cacheTTL: cacheTTL,
+		now:      time.Now,
+		cache:    make(map[string]cachedAPIKey),
+	}
+}
+
+// Verify는 API 키를 검증하고 키에 해당하는 Principal을 반환합니다
+func (v *APIKeyVerifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	if token == "" {
+		return nil, ErrMissingAPIKey
+	}
+	id, secret, err := ParseAPIKey(token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := v.lookup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || subtle.ConstantTimeCompare(key.SecretHash, HashAPIKeySecret(secret)) != 1 {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return &Principal{
+		Subject:   "apikey:" + key.ID,
+		Issuer:    "apikey",
+		TenantID:  key.TenantID,
+		Namespace: key.Namespace,
+		Roles:     key.Roles,
+	}, nil
+}
+
+// Invalidate는 키 ID의 캐시를 비웁니다 (키를 폐기하거나 바꾼 인스턴스에서 즉시 반영)
+func (v *APIKeyVerifier) Invalidate(id string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.cache, id)
+}
+
+// lookup은 캐시 또는 저장소에서 키를 조회합니다 (등록되지 않은 키는 캐시하지 않음)
+func (v *APIKeyVerifier) lookup(ctx context.Context, id string) (*APIKey, error) {
+	now := v.now()
+
+	v.mu.Lock()
+	cached, ok := v.cache[id]
+	v.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.key, nil
+	}
+
+	key, err := v.store.FindAPIKey(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up api key: %w", err)
+	}
+	if key == nil {
+		return nil, nil
+	}
+
+	v.mu.Lock()
+	v.cache[id] = cachedAPIKey{key: key, expiresAt: now.Add(v.cacheTTL)}
+	v.mu.Unlock()
+	return key, nil
+}
+
+// randomHex는 n바이트 난수의 hex 문자열을 반환합니다
+func randomHex(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureKeyIDHeader는 서명에 사용한 키 ID 헤더입니다
+	SignatureKeyIDHeader = "X-Signature-Key-Id"
+
+	// SignatureTimestampHeader는 서명 시각(Unix 초) 헤더입니다
+	SignatureTimestampHeader = "X-Signature-Timestamp"
+
+	// SignatureHeader는 "sha256=<hex>" 형식의 서명 헤더입니다
+	SignatureHeader = "X-Signature"
+
+	// signatureScheme은 서명 값의 접두사입니다
+	signatureScheme = "sha256="
+)
+
+var (
+	// ErrMissingSignature는 서명 헤더가 없는 경우의 에러입니다
+	ErrMissingSignature = errors.New("missing request signature")
+
+	// ErrInvalidSignature는 서명이 일치하지 않거나 형식이 잘못된 경우의 에러입니다
+	ErrInvalidSignature = errors.New("invalid request signature")
+
+	// ErrSignatureExpired는 서명 시각이 허용 범위를 벗어난 경우의 에러입니다 (재전송 공격 방지)
+	ErrSignatureExpired = errors.New("request signature timestamp out of range")
+
+	// ErrSignatureReplayed는 허용 범위 안에서 이미 사용한 서명이 다시 온 경우의 에러입니다
+	ErrSignatureReplayed = errors.New("request signature already used")
+
+	// ErrReplayCheckUnavailable은 재전송 검사 저장소에 접근할 수 없는 경우의 에러입니다 (요청은 거부됩니다)
+	ErrReplayCheckUnavailable = errors.New("request signature replay check unavailable")
+)
+
+// HMACKey는 서명 검증용 공유 비밀키입니다
+type HMACKey struct {
+	// ID는 클라이언트가 X-Signature-Key-Id로 보내는 키 식별자입니다
+	ID string
+
+	// Secret은 공유 비밀키입니다
+	Secret []byte
+
+	// Roles는 이 키로 서명된 요청에 부여할 역할입니다
+	Roles []Role
+}
+
+// ReplayCache는 사용한 서명을 기억해 재전송을 막는 저장소입니다 (여러 인스턴스가 공유)
+type ReplayCache interface {
+	// Remember는 key가 처음이면 ttl 동안 기록하고 true를, 이미 기록되어 있으면 false를 반환합니다
+	Remember(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// HMACConfig는 HMAC 서명 검증기 설정입니다
+type HMACConfig struct {
+	// Keys는 허용할 키 목록입니다 (키 교체 시 새 키와 이전 키를 함께 등록합니다)
+	Keys []HMACKey
+
+	// Tolerance는 서명 시각과 서버 시각의 최대 허용 차이입니다
+	Tolerance time.Duration
+
+	// ReplayCache는 허용 범위 안에서 같은 (키 ID, 서명)의 재사용을 거부하는 저장소입니다
+	ReplayCache ReplayCache
+}
+
+// SignedRequest는 서명 대상 요청입니다
+type SignedRequest struct {
+	Method     string
+	RequestURI string // 경로와 쿼리 문자열 (예: /api/v1/documents/users/42?upsert=true)
+	Timestamp  string // Unix 초
+	Body       []byte
+}
+
+// HMACVerifier는 웹훅 스타일 연동을 위한 요청 서명 검증기입니다
+//
+// 서명 대상 문자열은 "<METHOD>\n<RequestURI>\n<timestamp>\n<hex(SHA256(body))>"이며
+// 서명은 hex(HMAC-SHA256(secret, 서명 대상 문자열))입니다
+// 메서드와 경로를 함께 서명하므로 서명을 다른 라우트에 쓸 수 없고, 같은 서명은 허용 범위 안에서 한 번만 받습니다
+type HMACVerifier struct {
+	keys      map[string]HMACKey
+	tolerance time.Duration
+	replay    ReplayCache
+	now       func() time.Time
+}
+
+// NewHMACVerifier는 새로운 HMACVerifier를 생성합니다
+func NewHMACVerifier(cfg *HMACConfig) (*HMACVerifier, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, fmt.Errorf("at least one hmac key is required")
+	}
+
+	keys := make(map[string]HMACKey, len(cfg.Keys))
+	for _, k := range cfg.Keys {
+		if k.ID == "" {
+			return nil, fmt.Errorf("hmac key id is required")
+		}
+		if len(k.Secret) < 32 {
+			return nil, fmt.Errorf("hmac key %q: secret must be at least 32 bytes", k.ID)
+		}
+		if _, dup := keys[k.ID]; dup {
+			return nil, fmt.Errorf("duplicate hmac key id %q", k.ID)
+		}
+		keys[k.ID] = k
+	}
+
+	if cfg.ReplayCache == nil {
+		return nil, fmt.Errorf("hmac replay cache is required")
+	}
+
+	tolerance := cfg.Tolerance
+	if tolerance <= 0 {
+		tolerance = 5 * time.Minute
+	}
+
+	return &HMACVerifier{
+		keys:      keys,
+		tolerance: tolerance,
+		replay:    cfg.ReplayCache,
+		now:       time.Now,
+	}, nil
+}
+
+// Verify는 요청 서명을 검증하고 키에 해당하는 Principal을 반환합니다
+func (v *HMACVerifier) Verify(ctx context.Context, keyID, signature string, req SignedRequest) (*Principal, error) {
+	if keyID == "" || req.Timestamp == "" || signature == "" {
+		return nil, ErrMissingSignature
+	}
+
+	key, ok := v.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key id", ErrInvalidSignature)
+	}
+
+	ts, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed timestamp", ErrInvalidSignature)
+	}
+	signedAt := time.Unix(ts, 0)
+	now := v.now()
+	skew := now.Sub(signedAt)
+	if skew > v.tolerance || skew < -v.tolerance {
+		return nil, ErrSignatureExpired
+	}
+
+	if !strings.HasPrefix(signature, signatureScheme) {
+		return nil, fmt.Errorf("%w: unsupported scheme", ErrInvalidSignature)
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signatureScheme))
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+
+	if !hmac.Equal(got, computeSignature(key.Secret, req)) {
+		return nil, ErrInvalidSignature
+	}
+
+	// 서명이 유효한 동안(서명 시각 + 허용 범위)만 기억하면 그 뒤의 재전송은 시각 검사에서 거부됩니다
+	ttl := signedAt.Add(v.tolerance).Sub(now)
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	first, err := v.replay.Remember(ctx, key.ID+":"+hex.EncodeToString(got), ttl)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReplayCheckUnavailable, err)
+	}
+	if !first {
+		return nil, ErrSignatureReplayed
+	}
+
+	return &Principal{
+		Subject: "hmac:" + key.ID,
+		Issuer:  "hmac",
+		Roles:   key.Roles,
+	}, nil
+}
+
+// Sign은 요청의 서명 헤더 값을 생성합니다 (클라이언트 및 테스트용)
+func Sign(secret []byte, req SignedRequest) string {
+	return signatureScheme + hex.EncodeToString(computeSignature(secret, req))
+}
+
+// computeSignature는 HMAC-SHA256(secret, "<METHOD>\n<RequestURI>\n<timestamp>\n<hex(SHA256(body))>")를 계산합니다
+func computeSignature(secret []byte, req SignedRequest) []byte {
+	bodyHash := sha256.Sum256(req.Body)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strings.ToUpper(req.Method)))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(req.RequestURI))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(req.Timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(hex.EncodeToString(bodyHash[:])))
+	return mac.Sum(nil)
+}
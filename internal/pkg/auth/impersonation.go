@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ImpersonationHeader는 대리 실행 대상을 지정하는 HTTP 헤더입니다 (gRPC는 소문자 metadata 키 사용)
+const ImpersonationHeader = "X-Impersonate"
+
+var (
+	// ErrImpersonationDenied는 호출자가 대리 실행 권한이 없을 때의 에러입니다
+	ErrImpersonationDenied = errors.New("impersonation not permitted")
+
+	// ErrInvalidImpersonationTarget은 대리 실행 대상 형식이 잘못되었을 때의 에러입니다
+	ErrInvalidImpersonationTarget = errors.New("invalid impersonation target")
+)
+
+// ImpersonationTarget은 대리 실행 대상 사용자/테넌트입니다
+type ImpersonationTarget struct {
+	Subject  string
+	TenantID string
+}
+
+// ParseImpersonationTarget은 헤더 값을 파싱합니다
+// 형식: "user:<subject>", "tenant:<tenant_id>", "user:<subject>,tenant:<tenant_id>"
+// 접두사가 없는 값은 사용자 subject로 취급합니다
+func ParseImpersonationTarget(value string) (ImpersonationTarget, error) {
+	var target ImpersonationTarget
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return target, fmt.Errorf("%w: empty value", ErrInvalidImpersonationTarget)
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		kind, id, found := strings.Cut(part, ":")
+		if !found {
+			kind, id = "user", part
+		}
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return target, fmt.Errorf("%w: empty %s", ErrInvalidImpersonationTarget, kind)
+		}
+
+		switch strings.TrimSpace(kind) {
+		case "user", "sub":
+			if target.Subject != "" {
+				return target, fmt.Errorf("%w: duplicate user", ErrInvalidImpersonationTarget)
+			}
+			target.Subject = id
+		case "tenant":
+			if target.TenantID != "" {
+				return target, fmt.Errorf("%w: duplicate tenant", ErrInvalidImpersonationTarget)
+			}
+			target.TenantID = id
+		default:
+			return target, fmt.Errorf("%w: unknown kind %q", ErrInvalidImpersonationTarget, kind)
+		}
+	}
+
+	return target, nil
+}
+
+// ImpersonationConfig는 대리 실행 정책입니다
+type ImpersonationConfig struct {
+	// AllowedRoles 중 하나를 보유한 호출자만 대리 실행할 수 있습니다 (기본값: admin)
+	AllowedRoles []Role
+
+	// TargetRoles는 대리 실행 중 적용할 역할입니다 (기본값: writer)
+	// 운영자의 관리자 권한이 그대로 넘어가 행 수준 보안 예외가 적용되지 않도록 별도로 지정합니다
+	TargetRoles []Role
+}
+
+// Impersonator는 권한 있는 운영자가 다른 사용자/테넌트로 요청을 실행하도록 Principal을 전환합니다
+type Impersonator struct {
+	allowedRoles []Role
+	targetRoles  []Role
+}
+
+// NewImpersonator는 새로운 Impersonator를 생성합니다
+func NewImpersonator(cfg ImpersonationConfig) (*Impersonator, error) {
+	allowed := cfg.AllowedRoles
+	if len(allowed) == 0 {
+		allowed = []Role{RoleAdmin}
+	}
+	target := cfg.TargetRoles
+	if len(target) == 0 {
+		target = []Role{RoleWriter}
+	}
+
+	for _, r := range append(append([]Role{}, allowed...), target...) {
+		if !r.IsValid() {
+			return nil, fmt.Errorf("invalid impersonation role: %s", r)
+		}
+	}
+
+	return &Impersonator{allowedRoles: allowed, targetRoles: target}, nil
+}
+
+// Impersonate는 운영자 권한을 확인하고 대상으로 전환된 Principal을 반환합니다
+// 반환된 Principal의 ImpersonatedBy에 원래 운영자가 기록되어 감사 로그에 함께 남습니다
+// 대상 사용자를 지정하지 않으면 운영자 subject로 대상 테넌트 범위에서 실행하며,
+// 대상 테넌트를 지정하지 않으면 테넌트 클레임이 비어 있어 테넌트 기반 행 수준 보안은 거부됩니다
+func (i *Impersonator) Impersonate(operator *Principal, value string) (*Principal, error) {
+	if operator == nil || !operator.HasAnyRole(i.allowedRoles...) {
+		return nil, ErrImpersonationDenied
+	}
+	if operator.ImpersonatedBy != nil {
+		return nil, fmt.Errorf("%w: nested impersonation", ErrImpersonationDenied)
+	}
+
+	target, err := ParseImpersonationTarget(value)
+	if err != nil {
+		return nil, err
+	}
+
+	principal := &Principal{
+		Subject:        target.Subject,
+		Issuer:         operator.Issuer,
+		TenantID:       target.TenantID,
+		Roles:          append([]Role{}, i.targetRoles...),
+		ImpersonatedBy: operator,
+	}
+	if principal.Subject == "" {
+		principal.Subject = operator.Subject
+		principal.Username = operator.Username
+	}
+	return principal, nil
+}
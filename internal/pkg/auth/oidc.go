@@ -0,0 +1,456 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrMissingToken은 토큰이 제공되지 않은 경우의 에러입니다
+	ErrMissingToken = errors.New("missing bearer token")
+
+	// ErrInvalidToken은 토큰 형식 또는 서명이 올바르지 않은 경우의 에러입니다
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrTokenExpired는 만료된 토큰에 대한 에러입니다
+	ErrTokenExpired = errors.New("token expired")
+
+	// ErrForbidden은 필요한 역할이 없는 경우의 에러입니다
+	ErrForbidden = errors.New("insufficient role")
+)
+
+// RoleMapping은 IdP 클레임 값을 서비스 역할로 매핑합니다
+type RoleMapping struct {
+	ClaimValue string
+	Role       Role
+}
+
+// OIDCConfig는 OIDC 검증기 설정입니다
+type OIDCConfig struct {
+	// IssuerURL은 OIDC 발급자 URL입니다 (예: https://keycloak/realms/main)
+	IssuerURL string
+
+	// Audiences는 허용할 aud 값 목록입니다. 하나 이상 일치해야 합니다
+	Audiences []string
+
+	// RolesClaims는 역할을 읽어올 클레임 경로 목록입니다
+	// Keycloak: realm_access.roles, Entra ID: roles, Auth0: https://<namespace>/roles
+	RolesClaims []string
+
+	// UsernameClaim은 사용자 이름 클레임입니다 (기본값: preferred_username)
+	UsernameClaim string
+
+	// TenantClaim은 테넌트 식별자 클레임입니다 (선택)
+	TenantClaim string
+
+	// RoleMappings는 클레임 값 → 서비스 역할 매핑입니다
+	// 매핑이 비어 있으면 클레임 값이 서비스 역할 이름과 같을 때만 인정합니다
+	RoleMappings []RoleMapping
+
+	// DefaultRoles는 인증된 모든 호출자에게 부여되는 역할입니다
+	DefaultRoles []Role
+
+	// AllowedAlgorithms는 허용할 서명 알고리즘 목록입니다
+	AllowedAlgorithms []string
+
+	// JWKSRefreshInterval은 JWKS 주기적 갱신 간격입니다
+	JWKSRefreshInterval time.Duration
+
+	// ClockSkew는 exp/nbf/iat 검증 시 허용 오차입니다
+	ClockSkew time.Duration
+
+	// HTTPClient는 discovery/JWKS 요청에 사용할 클라이언트입니다
+	HTTPClient *http.Client
+}
+
+// DefaultOIDCConfig는 기본 OIDC 설정을 반환합니다
+func DefaultOIDCConfig() *OIDCConfig {
+	return &OIDCConfig{
+		RolesClaims:         []string{"roles", "realm_access.roles"},
+		UsernameClaim:       "preferred_username",
+		AllowedAlgorithms:   []string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384"},
+		JWKSRefreshInterval: 1 * time.Hour,
+		ClockSkew:           30 * time.Second,
+	}
+}
+
+// Validate는 설정을 검증합니다
+func (c *OIDCConfig) Validate() error {
+	if c.IssuerURL == "" {
+		return fmt.Errorf("oidc issuer url is required")
+	}
+	if len(c.Audiences) == 0 {
+		return fmt.Errorf("at least one oidc audience is required")
+	}
+	for _, m := range c.RoleMappings {
+		if !m.Role.IsValid() {
+			return fmt.Errorf("invalid role mapping target: %s", m.Role)
+		}
+	}
+	for _, r := range c.DefaultRoles {
+		if !r.IsValid() {
+			return fmt.Errorf("invalid default role: %s", r)
+		}
+	}
+	return nil
+}
+
+// providerMetadata는 OIDC discovery 문서 중 필요한 필드입니다
+type providerMetadata struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// OIDCVerifier는 OIDC 발급자가 서명한 JWT 액세스 토큰을 검증합니다
+type OIDCVerifier struct {
+	config     *OIDCConfig
+	httpClient *http.Client
+	issuer     string
+	jwks       *keySet
+	allowedAlg map[string]bool
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+}
+
+// NewOIDCVerifier는 discovery 문서를 조회하고 JWKS를 로드한 검증기를 생성합니다
+func NewOIDCVerifier(ctx context.Context, cfg *OIDCConfig) (*OIDCVerifier, error) {
+	if cfg == nil {
+		cfg = DefaultOIDCConfig()
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid oidc config: %w", err)
+	}
+
+	defaults := DefaultOIDCConfig()
+	if len(cfg.RolesClaims) == 0 {
+		cfg.RolesClaims = defaults.RolesClaims
+	}
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = defaults.UsernameClaim
+	}
+	if len(cfg.AllowedAlgorithms) == 0 {
+		cfg.AllowedAlgorithms = defaults.AllowedAlgorithms
+	}
+	if cfg.JWKSRefreshInterval <= 0 {
+		cfg.JWKSRefreshInterval = defaults.JWKSRefreshInterval
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	meta, err := discover(ctx, httpClient, cfg.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool, len(cfg.AllowedAlgorithms))
+	for _, alg := range cfg.AllowedAlgorithms {
+		allowed[alg] = true
+	}
+
+	v := &OIDCVerifier{
+		config:     cfg,
+		httpClient: httpClient,
+		issuer:     meta.Issuer,
+		jwks:       newKeySet(httpClient, meta.JWKSURI),
+		allowedAlg: allowed,
+		stopCh:     make(chan struct{}),
+	}
+
+	if err := v.jwks.refresh(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load jwks: %w", err)
+	}
+
+	go v.refreshLoop()
+
+	logger.Info(ctx, "oidc verifier initialized",
+		zap.String("issuer", v.issuer),
+		zap.String("jwks_uri", meta.JWKSURI),
+		zap.Strings("audiences", cfg.Audiences),
+	)
+
+	return v, nil
+}
+
+// discover는 /.well-known/openid-configuration 문서를 조회합니다
+func discover(ctx context.Context, client *http.Client, issuerURL string) (*providerMetadata, error) {
+	wellKnown := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch oidc discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc discovery returned status %d", resp.StatusCode)
+	}
+
+	var meta providerMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("failed to decode oidc discovery document: %w", err)
+	}
+
+	// 발급자 위조 방지를 위해 discovery 문서의 issuer가 설정과 일치해야 합니다
+	if strings.TrimSuffix(meta.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return nil, fmt.Errorf("oidc issuer mismatch: expected %q, got %q", issuerURL, meta.Issuer)
+	}
+	if meta.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc discovery document has no jwks_uri")
+	}
+
+	return &meta, nil
+}
+
+// refreshLoop는 주기적으로 JWKS를 갱신합니다 (키 롤오버 대응)
+func (v *OIDCVerifier) refreshLoop() {
+	ticker := time.NewTicker(v.config.JWKSRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			if err := v.jwks.refresh(ctx); err != nil {
+				logger.Warn(ctx, "failed to refresh jwks", zap.Error(err))
+			}
+			cancel()
+		case <-v.stopCh:
+			return
+		}
+	}
+}
+
+// Close는 백그라운드 JWKS 갱신을 중지합니다
+func (v *OIDCVerifier) Close() {
+	v.stopOnce.Do(func() {
+		close(v.stopCh)
+	})
+}
+
+// Verify는 토큰을 검증하고 역할이 매핑된 Principal을 반환합니다
+func (v *OIDCVerifier) Verify(ctx context.Context, rawToken string) (*Principal, error) {
+	if rawToken == "" {
+		return nil, ErrMissingToken
+	}
+
+	token, err := parseJWT(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !v.allowedAlg[token.header.Alg] {
+		return nil, fmt.Errorf("%w: algorithm %q not allowed", ErrInvalidToken, token.header.Alg)
+	}
+
+	key, err := v.jwks.key(ctx, token.header.Kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if err := token.verifySignature(key); err != nil {
+		return nil, err
+	}
+
+	if err := v.validateClaims(token.claims); err != nil {
+		return nil, err
+	}
+
+	return v.buildPrincipal(token.claims), nil
+}
+
+// validateClaims는 iss/aud/exp/nbf/iat를 검증합니다
+func (v *OIDCVerifier) validateClaims(claims map[string]interface{}) error {
+	iss, _ := claims["iss"].(string)
+	if strings.TrimSuffix(iss, "/") != strings.TrimSuffix(v.issuer, "/") {
+		return fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, iss)
+	}
+
+	if !v.audienceMatches(claims["aud"]) {
+		return fmt.Errorf("%w: audience not accepted", ErrInvalidToken)
+	}
+
+	now := time.Now()
+	skew := v.config.ClockSkew
+
+	exp, ok := numericClaim(claims, "exp")
+	if !ok {
+		return fmt.Errorf("%w: missing exp claim", ErrInvalidToken)
+	}
+	if now.After(exp.Add(skew)) {
+		return ErrTokenExpired
+	}
+
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Add(skew).Before(nbf) {
+		return fmt.Errorf("%w: token not yet valid", ErrInvalidToken)
+	}
+
+	if iat, ok := numericClaim(claims, "iat"); ok && now.Add(skew).Before(iat) {
+		return fmt.Errorf("%w: token issued in the future", ErrInvalidToken)
+	}
+
+	return nil
+}
+
+// audienceMatches는 aud 클레임(문자열 또는 배열)이 허용 목록과 겹치는지 확인합니다
+func (v *OIDCVerifier) audienceMatches(raw interface{}) bool {
+	var auds []string
+	switch aud := raw.(type) {
+	case string:
+		auds = []string{aud}
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				auds = append(auds, s)
+			}
+		}
+	}
+
+	for _, a := range auds {
+		for _, allowed := range v.config.Audiences {
+			if a == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buildPrincipal은 클레임에서 Principal을 구성합니다
+func (v *OIDCVerifier) buildPrincipal(claims map[string]interface{}) *Principal {
+	p := &Principal{
+		Claims: claims,
+	}
+	p.Subject, _ = claims["sub"].(string)
+	p.Issuer, _ = claims["iss"].(string)
+	p.Email, _ = claims["email"].(string)
+	if s, ok := lookupClaim(claims, v.config.UsernameClaim).(string); ok {
+		p.Username = s
+	}
+	if v.config.TenantClaim != "" {
+		if s, ok := lookupClaim(claims, v.config.TenantClaim).(string); ok {
+			p.TenantID = s
+		}
+	}
+
+	p.Roles = v.mapRoles(claims)
+	return p
+}
+
+// mapRoles는 설정된 클레임의 값을 서비스 역할로 변환합니다
+func (v *OIDCVerifier) mapRoles(claims map[string]interface{}) []Role {
+	seen := make(map[Role]bool)
+	var roles []Role
+
+	add := func(r Role) {
+		if r.IsValid() && !seen[r] {
+			seen[r] = true
+			roles = append(roles, r)
+		}
+	}
+
+	for _, r := range v.config.DefaultRoles {
+		add(r)
+	}
+
+	for _, claimPath := range v.config.RolesClaims {
+		for _, value := range stringValues(lookupClaim(claims, claimPath)) {
+			if len(v.config.RoleMappings) == 0 {
+				add(Role(value))
+				continue
+			}
+			for _, m := range v.config.RoleMappings {
+				if m.ClaimValue == value {
+					add(m.Role)
+				}
+			}
+		}
+	}
+
+	return roles
+}
+
+// lookupClaim은 클레임을 조회합니다
+// 먼저 전체 이름을 최상위 키로 찾고 (Auth0의 URL 네임스페이스 클레임),
+// 없으면 점(.)으로 구분된 중첩 경로를 따라갑니다 (Keycloak의 realm_access.roles)
+func lookupClaim(claims map[string]interface{}, path string) interface{} {
+	if path == "" {
+		return nil
+	}
+	if v, ok := claims[path]; ok {
+		return v
+	}
+
+	var current interface{} = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current, ok = m[part]
+		if !ok {
+			return nil
+		}
+	}
+	return current
+}
+
+// stringValues는 문자열, 문자열 배열, 공백 구분 문자열(scope)을 모두 슬라이스로 변환합니다
+func stringValues(raw interface{}) []string {
+	switch val := raw.(type) {
+	case string:
+		return strings.Fields(val)
+	case []interface{}:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// numericClaim은 NumericDate 클레임을 time.Time으로 변환합니다
+func numericClaim(claims map[string]interface{}, name string) (time.Time, bool) {
+	switch v := claims[name].(type) {
+	case float64:
+		return time.Unix(int64(v), 0), true
+	case json.Number:
+		// NumericDate는 소수 초를 허용하므로 정수로만 읽으면 유효한 exp/nbf를 놓칩니다
+		f, err := v.Float64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Unix(int64(f), 0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// BearerToken은 Authorization 헤더 값에서 Bearer 토큰을 추출합니다
+func BearerToken(header string) string {
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(prefix):])
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+// Role은 RBAC 계층에서 사용하는 서비스 내부 역할입니다
+type Role string
+
+const (
+	// RoleReader는 문서 조회 권한을 가진 역할입니다
+	RoleReader Role = "reader"
+
+	// RoleWriter는 문서 생성/수정/삭제 권한을 가진 역할입니다
+	RoleWriter Role = "writer"
+
+	// RoleAdmin은 컬렉션, 인덱스, Raw 쿼리 등 관리 권한을 가진 역할입니다
+	RoleAdmin Role = "admin"
+)
+
+// roleRank는 역할 간 포함 관계를 표현합니다 (admin ⊃ writer ⊃ reader)
+var roleRank = map[Role]int{
+	RoleReader: 1,
+	RoleWriter: 2,
+	RoleAdmin:  3,
+}
+
+// IsValid는 알려진 역할인지 확인합니다
+func (r Role) IsValid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// Principal은 인증된 호출자 정보입니다
+type Principal struct {
+	Subject  string
+	Username string
+	Email    string
+	Issuer   string
+	TenantID string
+	Roles    []Role
+	Claims   map[string]interface{}
+
+	// Namespace는 접근할 수 있는 컬렉션 이름 접두사입니다 (테넌트 API 키, 비어 있으면 제한 없음)
+	Namespace string
+
+	// ImpersonatedBy는 대리 실행 중일 때 실제 요청한 운영자입니다 (일반 요청은 nil)
+	ImpersonatedBy *Principal
+}
+
+// HasRole은 주어진 역할(또는 그 상위 역할)을 보유하고 있는지 확인합니다
+func (p *Principal) HasRole(required Role) bool {
+	if p == nil {
+		return false
+	}
+	requiredRank, ok := roleRank[required]
+	if !ok {
+		return false
+	}
+	for _, r := range p.Roles {
+		if roleRank[r] >= requiredRank {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyRole은 주어진 역할 중 하나라도 보유하고 있는지 확인합니다
+func (p *Principal) HasAnyRole(roles ...Role) bool {
+	for _, r := range roles {
+		if p.HasRole(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAccessCollection은 컬렉션이 principal의 네임스페이스 안에 있는지 확인합니다 (principal이 없거나 네임스페이스가 없으면 제한 없음)
+func (p *Principal) CanAccessCollection(collection string) bool {
+	if p == nil || p.Namespace == "" {
+		return true
+	}
+	return strings.HasPrefix(collection, p.Namespace)
+}
+
+// IsAdmin은 관리자 역할을 보유하고 있는지 확인합니다
+func (p *Principal) IsAdmin() bool {
+	return p.HasRole(RoleAdmin)
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal은 context에 Principal을 저장합니다
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, p)
+}
+
+// PrincipalFromContext는 context에서 Principal을 가져옵니다
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return p, ok && p != nil
+}
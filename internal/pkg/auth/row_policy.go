@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// RowPolicy는 principal이 접근할 수 있는 문서를 제한하는 행 수준 보안 규칙입니다
+// 예: {Collection: "orders", Field: "data.owner", Claim: "sub"}는
+// 주문 문서 중 data.owner가 토큰의 sub와 같은 문서만 보이도록 합니다
+type RowPolicy struct {
+	// Collection은 적용 대상 컬렉션입니다 ("*" 또는 path.Match 패턴 지원)
+	Collection string
+
+	// Field는 문서 필드 경로입니다 (예: "data.owner", "data.tenant.id")
+	Field string
+
+	// Claim은 비교할 principal 값입니다
+	// sub, username, email, tenant_id, iss는 Principal 필드를, 그 외에는 토큰 클레임 경로를 사용합니다
+	Claim string
+
+	// ExemptRoles의 역할(또는 상위 역할)을 가진 principal은 이 규칙을 우회합니다
+	ExemptRoles []Role
+}
+
+// RowPolicySet은 컬렉션별 행 수준 보안 규칙 모음입니다
+type RowPolicySet struct {
+	policies []RowPolicy
+}
+
+// NewRowPolicySet은 새로운 RowPolicySet을 생성합니다
+func NewRowPolicySet(policies []RowPolicy) (*RowPolicySet, error) {
+	for i, p := range policies {
+		if p.Collection == "" {
+			return nil, fmt.Errorf("row policy %d: collection is required", i)
+		}
+		if _, err := path.Match(p.Collection, ""); err != nil {
+			return nil, fmt.Errorf("row policy %d: invalid collection pattern %q: %w", i, p.Collection, err)
+		}
+		if p.Field == "" || p.Claim == "" {
+			return nil, fmt.Errorf("row policy %d: field and claim are required", i)
+		}
+	}
+	return &RowPolicySet{policies: policies}, nil
+}
+
+// Conditions는 principal이 컬렉션에서 볼 수 있는 문서 조건을 반환합니다
+// 적용되는 규칙이 없으면 nil을 반환합니다
+// 규칙이 적용되는데 principal이 없거나 클레임 값이 없으면 ErrForbidden을 반환합니다 (fail-closed)
+func (s *RowPolicySet) Conditions(p *Principal, collection string) (map[string]interface{}, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	var conditions map[string]interface{}
+	for _, policy := range s.policies {
+		if !policy.appliesTo(collection) {
+			continue
+		}
+		if p != nil && p.HasAnyRole(policy.ExemptRoles...) {
+			continue
+		}
+		if p == nil {
+			return nil, fmt.Errorf("%w: row policy on %q requires an authenticated principal", ErrForbidden, collection)
+		}
+
+		value, ok := p.attribute(policy.Claim)
+		if !ok {
+			return nil, fmt.Errorf("%w: principal has no %q claim required by row policy", ErrForbidden, policy.Claim)
+		}
+
+		if conditions == nil {
+			conditions = make(map[string]interface{})
+		}
+		if existing, dup := conditions[policy.Field]; dup && existing != value {
+			// 같은 필드에 서로 다른 값을 요구하면 어떤 문서도 만족할 수 없습니다
+			return nil, fmt.Errorf("%w: conflicting row policies on field %q", ErrForbidden, policy.Field)
+		}
+		conditions[policy.Field] = value
+	}
+
+	return conditions, nil
+}
+
+// Restricts는 principal에게 적용되는 규칙이 하나라도 있는지 확인합니다
+// 컬렉션을 특정할 수 없는 작업(원시 쿼리 등)을 거부할 때 사용합니다
+func (s *RowPolicySet) Restricts(p *Principal) bool {
+	if s == nil {
+		return false
+	}
+	for _, policy := range s.policies {
+		if p == nil || !p.HasAnyRole(policy.ExemptRoles...) {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesTo는 규칙이 컬렉션에 적용되는지 확인합니다
+func (p RowPolicy) appliesTo(collection string) bool {
+	if p.Collection == "*" || p.Collection == collection {
+		return true
+	}
+	matched, _ := path.Match(p.Collection, collection)
+	return matched
+}
+
+// attribute는 행 수준 보안 규칙에서 참조하는 principal 값을 반환합니다
+func (p *Principal) attribute(name string) (interface{}, bool) {
+	var value string
+	switch name {
+	case "sub", "subject":
+		value = p.Subject
+	case "username", "preferred_username":
+		value = p.Username
+	case "email":
+		value = p.Email
+	case "tenant_id", "tenant":
+		value = p.TenantID
+	case "iss", "issuer":
+		value = p.Issuer
+	default:
+		raw := lookupClaim(p.Claims, name)
+		if raw == nil {
+			return nil, false
+		}
+		if s, ok := raw.(string); ok {
+			return s, s != ""
+		}
+		return fmt.Sprint(raw), true
+	}
+	return value, value != ""
+}
+
+// ScopeFilter는 사용자 필터에 행 수준 보안 조건을 AND로 결합합니다
+// 사용자 필터의 $or 등 최상위 연산자가 조건을 우회하지 못하도록 $and로 감쌉니다
+func ScopeFilter(filter, conditions map[string]interface{}) map[string]interface{} {
+	if len(conditions) == 0 {
+		return filter
+	}
+	if len(filter) == 0 {
+		scoped := make(map[string]interface{}, len(conditions))
+		for k, v := range conditions {
+			scoped[k] = v
+		}
+		return scoped
+	}
+	return map[string]interface{}{
+		"$and": []interface{}{filter, conditions},
+	}
+}
+
+// MatchesConditions는 문서가 행 수준 보안 조건을 만족하는지 확인합니다
+// doc은 저장 형태의 문서입니다 (예: {"data": {...}})
+func MatchesConditions(doc map[string]interface{}, conditions map[string]interface{}) bool {
+	for field, want := range conditions {
+		got, ok := lookupPath(doc, field)
+		if !ok || fmt.Sprint(got) != fmt.Sprint(want) {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateChangesConditions는 업데이트 문서가 행 수준 보안 필드를 다른 값으로 바꾸는지 확인합니다
+// {"$set": {"data.owner": "bob"}}처럼 연산자를 쓰는 경우와 전체 교체 문서를 모두 검사합니다
+func UpdateChangesConditions(update map[string]interface{}, conditions map[string]interface{}) bool {
+	for key, value := range update {
+		if !strings.HasPrefix(key, "$") {
+			if touchesCondition(key, value, conditions, true) {
+				return true
+			}
+			continue
+		}
+
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for field, fieldValue := range fields {
+			// $set만 같은 값을 허용하고 $unset, $rename, $inc 등은 모두 변경으로 봅니다
+			if touchesCondition(field, fieldValue, conditions, key == "$set") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// touchesCondition은 필드 변경이 행 수준 보안 조건을 깨뜨리는지 확인합니다
+func touchesCondition(field string, value interface{}, conditions map[string]interface{}, allowSameValue bool) bool {
+	for condField, want := range conditions {
+		switch {
+		case field == condField:
+			if !allowSameValue || fmt.Sprint(value) != fmt.Sprint(want) {
+				return true
+			}
+		case strings.HasPrefix(condField, field+"."):
+			// 상위 객체를 통째로 바꾸는 경우 새 값 안에서 조건 필드를 확인합니다
+			obj, ok := value.(map[string]interface{})
+			if !allowSameValue || !ok {
+				return true
+			}
+			got, ok := lookupPath(obj, strings.TrimPrefix(condField, field+"."))
+			if !ok || fmt.Sprint(got) != fmt.Sprint(want) {
+				return true
+			}
+		case strings.HasPrefix(field, condField+"."):
+			return true
+		}
+	}
+	return false
+}
+
+// StampConditions는 새 문서가 행 수준 보안 조건을 만족하도록 필드 값을 채웁니다
+// 이미 다른 값이 설정되어 있으면 ErrForbidden을 반환합니다
+func StampConditions(doc map[string]interface{}, conditions map[string]interface{}) error {
+	for field, want := range conditions {
+		if got, ok := lookupPath(doc, field); ok {
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				return fmt.Errorf("%w: field %q must equal the caller's identity", ErrForbidden, field)
+			}
+			continue
+		}
+		if err := setPath(doc, field, want); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupPath는 점(.)으로 구분된 경로의 값을 조회합니다
+func lookupPath(doc map[string]interface{}, fieldPath string) (interface{}, bool) {
+	var current interface{} = doc
+	for _, part := range strings.Split(fieldPath, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setPath는 점(.)으로 구분된 경로에 값을 설정하며 중간 객체가 없으면 생성합니다
+func setPath(doc map[string]interface{}, fieldPath string, value interface{}) error {
+	parts := strings.Split(fieldPath, ".")
+	current := doc
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part]
+		if !ok {
+			child := make(map[string]interface{})
+			current[part] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%w: field %q is not an object", ErrForbidden, part)
+		}
+		current = child
+	}
+	current[parts[len(parts)-1]] = value
+	return nil
+}
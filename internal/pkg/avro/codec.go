@@ -0,0 +1,425 @@
+package avro
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ErrShortBuffer는 데이터가 스키마보다 짧을 때 반환됩니다
+var ErrShortBuffer = errors.New("avro: short buffer")
+
+// Encode는 값을 Avro 바이너리로 인코딩합니다
+//
+// Go 값 대응: null=nil, boolean=bool, int/long=정수 타입, float/double=float32/float64,
+// bytes=[]byte, string/enum=string, record/map=map[string]interface{}, array=[]interface{}
+// union은 값의 Go 타입에 맞는 첫 번째 분기를 사용합니다
+// record에 없는 필드는 스키마 기본값으로 채웁니다
+func (s *Schema) Encode(value interface{}) ([]byte, error) {
+	return s.encode(nil, value)
+}
+
+func (s *Schema) encode(buf []byte, value interface{}) ([]byte, error) {
+	switch s.Type {
+	case "null":
+		if value != nil {
+			return nil, fmt.Errorf("avro: expected null, got %T", value)
+		}
+		return buf, nil
+
+	case "boolean":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected boolean, got %T", value)
+		}
+		if b {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+
+	case "int", "long":
+		n, ok := toInt64(value)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected %s, got %T", s.Type, value)
+		}
+		if s.Type == "int" && (n < math.MinInt32 || n > math.MaxInt32) {
+			return nil, fmt.Errorf("avro: int overflow: %d", n)
+		}
+		return binary.AppendVarint(buf, n), nil
+
+	case "float":
+		f, ok := toFloat64(value)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected float, got %T", value)
+		}
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(f))), nil
+
+	case "double":
+		f, ok := toFloat64(value)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected double, got %T", value)
+		}
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(f)), nil
+
+	case "bytes":
+		b, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected bytes, got %T", value)
+		}
+		buf = binary.AppendVarint(buf, int64(len(b)))
+		return append(buf, b...), nil
+
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected string, got %T", value)
+		}
+		buf = binary.AppendVarint(buf, int64(len(str)))
+		return append(buf, str...), nil
+
+	case "enum":
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("avro: expected enum symbol, got %T", value)
+		}
+		for i, sym := range s.Symbols {
+			if sym == str {
+				return binary.AppendVarint(buf, int64(i)), nil
+			}
+		}
+		return nil, fmt.Errorf("avro: unknown symbol %q for enum %s", str, s.Name)
+
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("avro: expected array, got %T", value)
+		}
+		if len(items) > 0 {
+			buf = binary.AppendVarint(buf, int64(len(items)))
+			for _, item := range items {
+				var err error
+				if buf, err = s.Items.encode(buf, item); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return append(buf, 0), nil
+
+	case "map":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			if sm, isStringMap := value.(map[string]string); isStringMap {
+				m = make(map[string]interface{}, len(sm))
+				for k, v := range sm {
+					m[k] = v
+				}
+			} else if value != nil {
+				return nil, fmt.Errorf("avro: expected map, got %T", value)
+			}
+		}
+		if len(m) > 0 {
+			// 키 순서를 고정해 같은 값이 항상 같은 바이트가 되도록 합니다
+			keys := make([]string, 0, len(m))
+			for k := range m {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			buf = binary.AppendVarint(buf, int64(len(m)))
+			for _, k := range keys {
+				buf = binary.AppendVarint(buf, int64(len(k)))
+				buf = append(buf, k...)
+				var err error
+				if buf, err = s.Values.encode(buf, m[k]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return append(buf, 0), nil
+
+	case "record":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("avro: expected record %s, got %T", s.Name, value)
+		}
+		for _, f := range s.Fields {
+			v, present := m[f.Name]
+			if !present {
+				if !f.HasDefault {
+					return nil, fmt.Errorf("avro: missing field %s.%s", s.Name, f.Name)
+				}
+				v = f.Default
+			}
+			var err error
+			if buf, err = f.Type.encode(buf, v); err != nil {
+				return nil, fmt.Errorf("field %s.%s: %w", s.Name, f.Name, err)
+			}
+		}
+		return buf, nil
+
+	case "union":
+		for i, branch := range s.Branches {
+			if !branch.accepts(value) {
+				continue
+			}
+			buf = binary.AppendVarint(buf, int64(i))
+			return branch.encode(buf, value)
+		}
+		return nil, fmt.Errorf("avro: no union branch for %T", value)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedSchema, s.Type)
+}
+
+// accepts는 union 분기 선택을 위해 값의 Go 타입이 스키마와 맞는지 확인합니다
+func (s *Schema) accepts(value interface{}) bool {
+	switch s.Type {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "int", "long":
+		_, ok := toInt64(value)
+		return ok
+	case "float", "double":
+		switch value.(type) {
+		case float32, float64:
+			return true
+		}
+		return false
+	case "bytes":
+		_, ok := value.([]byte)
+		return ok
+	case "string", "enum":
+		_, ok := value.(string)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "map", "record":
+		switch value.(type) {
+		case map[string]interface{}, map[string]string:
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// Decode는 Avro 바이너리를 이 스키마(작성자 스키마)로 디코딩합니다
+// 반환 값의 타입은 Encode의 대응과 같으며, int는 int32, long은 int64입니다
+func (s *Schema) Decode(data []byte) (interface{}, error) {
+	value, rest, err := s.decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("avro: %d trailing bytes", len(rest))
+	}
+	return value, nil
+}
+
+func (s *Schema) decode(data []byte) (interface{}, []byte, error) {
+	switch s.Type {
+	case "null":
+		return nil, data, nil
+
+	case "boolean":
+		if len(data) < 1 {
+			return nil, nil, ErrShortBuffer
+		}
+		return data[0] != 0, data[1:], nil
+
+	case "int", "long":
+		n, rest, err := readLong(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if s.Type == "int" {
+			return int32(n), rest, nil
+		}
+		return n, rest, nil
+
+	case "float":
+		if len(data) < 4 {
+			return nil, nil, ErrShortBuffer
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(data)), data[4:], nil
+
+	case "double":
+		if len(data) < 8 {
+			return nil, nil, ErrShortBuffer
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), data[8:], nil
+
+	case "bytes", "string":
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if s.Type == "string" {
+			return string(b), rest, nil
+		}
+		return append([]byte(nil), b...), rest, nil
+
+	case "enum":
+		n, rest, err := readLong(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n < 0 || int(n) >= len(s.Symbols) {
+			return nil, nil, fmt.Errorf("avro: enum index %d out of range for %s", n, s.Name)
+		}
+		return s.Symbols[n], rest, nil
+
+	case "array":
+		items := []interface{}{}
+		err := readBlocks(&data, func() error {
+			item, rest, err := s.Items.decode(data)
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+			data = rest
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return items, data, nil
+
+	case "map":
+		m := map[string]interface{}{}
+		err := readBlocks(&data, func() error {
+			key, rest, err := readBytes(data)
+			if err != nil {
+				return err
+			}
+			value, rest, err := s.Values.decode(rest)
+			if err != nil {
+				return err
+			}
+			m[string(key)] = value
+			data = rest
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return m, data, nil
+
+	case "record":
+		m := make(map[string]interface{}, len(s.Fields))
+		for _, f := range s.Fields {
+			value, rest, err := f.Type.decode(data)
+			if err != nil {
+				return nil, nil, fmt.Errorf("field %s.%s: %w", s.Name, f.Name, err)
+			}
+			m[f.Name] = value
+			data = rest
+		}
+		return m, data, nil
+
+	case "union":
+		idx, rest, err := readLong(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if idx < 0 || int(idx) >= len(s.Branches) {
+			return nil, nil, fmt.Errorf("avro: union index %d out of range", idx)
+		}
+		return s.Branches[idx].decode(rest)
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedSchema, s.Type)
+}
+
+// readBlocks는 array/map 블록을 읽습니다 (음수 개수 뒤에는 블록 바이트 크기가 옵니다)
+func readBlocks(data *[]byte, readItem func() error) error {
+	for {
+		count, rest, err := readLong(*data)
+		if err != nil {
+			return err
+		}
+		*data = rest
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			count = -count
+			if _, rest, err = readLong(*data); err != nil {
+				return err
+			}
+			*data = rest
+		}
+		for i := int64(0); i < count; i++ {
+			if err := readItem(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readLong은 zigzag varint를 읽습니다
+func readLong(data []byte) (int64, []byte, error) {
+	n, size := binary.Varint(data)
+	if size <= 0 {
+		return 0, nil, ErrShortBuffer
+	}
+	return n, data[size:], nil
+}
+
+// readBytes는 길이 접두 바이트열을 읽습니다
+func readBytes(data []byte) ([]byte, []byte, error) {
+	n, rest, err := readLong(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n < 0 || int64(len(rest)) < n {
+		return nil, nil, ErrShortBuffer
+	}
+	return rest[:n], rest[n:], nil
+}
+
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case float64:
+		// JSON 기본값은 float64로 파싱됩니다
+		if v == math.Trunc(v) {
+			return int64(v), true
+		}
+	}
+	return 0, false
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	if n, ok := toInt64(value); ok {
+		return float64(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,169 @@
+package avro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedSchema는 지원하지 않는 스키마 구성일 때 반환됩니다
+var ErrUnsupportedSchema = errors.New("avro: unsupported schema")
+
+// Schema는 파싱된 Avro 스키마 노드입니다
+// 지원 타입: null, boolean, int, long, float, double, bytes, string, record, enum, array, map, union
+// 논리 타입(timestamp-millis 등)은 기반 타입으로 처리합니다
+type Schema struct {
+	Type        string
+	Name        string // record, enum의 전체 이름 (namespace 포함)
+	LogicalType string
+	Fields      []*Field
+	Symbols     []string
+	Items       *Schema   // array
+	Values      *Schema   // map
+	Branches    []*Schema // union
+}
+
+// Field는 record 필드입니다
+type Field struct {
+	Name       string
+	Type       *Schema
+	Default    interface{}
+	HasDefault bool
+}
+
+// Parse는 JSON 형식의 Avro 스키마를 파싱합니다
+func Parse(schemaJSON string) (*Schema, error) {
+	var raw interface{}
+	if err := json.Unmarshal([]byte(schemaJSON), &raw); err != nil {
+		return nil, fmt.Errorf("avro: invalid schema json: %w", err)
+	}
+	p := &parser{names: make(map[string]*Schema)}
+	return p.parse(raw, "")
+}
+
+// parser는 이름 있는 타입(record, enum)을 기억해 이후 참조를 해석합니다
+type parser struct {
+	names map[string]*Schema
+}
+
+func (p *parser) parse(raw interface{}, namespace string) (*Schema, error) {
+	switch v := raw.(type) {
+	case string:
+		return p.parseName(v, namespace)
+	case []interface{}:
+		union := &Schema{Type: "union"}
+		for _, branch := range v {
+			s, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.Branches = append(union.Branches, s)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return p.parseObject(v, namespace)
+	default:
+		return nil, fmt.Errorf("%w: unexpected schema node %T", ErrUnsupportedSchema, raw)
+	}
+}
+
+func (p *parser) parseName(name, namespace string) (*Schema, error) {
+	switch name {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		return &Schema{Type: name}, nil
+	}
+	if s, ok := p.names[fullName(name, namespace)]; ok {
+		return s, nil
+	}
+	if s, ok := p.names[name]; ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("%w: unknown type %q", ErrUnsupportedSchema, name)
+}
+
+func (p *parser) parseObject(obj map[string]interface{}, namespace string) (*Schema, error) {
+	typ, _ := obj["type"].(string)
+	logical, _ := obj["logicalType"].(string)
+	if ns, ok := obj["namespace"].(string); ok {
+		namespace = ns
+	}
+
+	switch typ {
+	case "record", "error":
+		name, _ := obj["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("%w: record without name", ErrUnsupportedSchema)
+		}
+		s := &Schema{Type: "record", Name: fullName(name, namespace)}
+		p.names[s.Name] = s // 재귀 참조 허용
+
+		if idx := strings.LastIndex(s.Name, "."); idx >= 0 {
+			namespace = s.Name[:idx]
+		}
+		fields, _ := obj["fields"].([]interface{})
+		for _, rawField := range fields {
+			f, ok := rawField.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: invalid field in %s", ErrUnsupportedSchema, s.Name)
+			}
+			fieldName, _ := f["name"].(string)
+			fieldType, err := p.parse(f["type"], namespace)
+			if err != nil {
+				return nil, fmt.Errorf("field %s.%s: %w", s.Name, fieldName, err)
+			}
+			def, hasDefault := f["default"]
+			s.Fields = append(s.Fields, &Field{Name: fieldName, Type: fieldType, Default: def, HasDefault: hasDefault})
+		}
+		return s, nil
+
+	case "enum":
+		name, _ := obj["name"].(string)
+		s := &Schema{Type: "enum", Name: fullName(name, namespace)}
+		symbols, _ := obj["symbols"].([]interface{})
+		for _, sym := range symbols {
+			str, _ := sym.(string)
+			s.Symbols = append(s.Symbols, str)
+		}
+		p.names[s.Name] = s
+		return s, nil
+
+	case "array":
+		items, err := p.parse(obj["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items, LogicalType: logical}, nil
+
+	case "map":
+		values, err := p.parse(obj["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "map", Values: values, LogicalType: logical}, nil
+
+	case "fixed":
+		return nil, fmt.Errorf("%w: fixed", ErrUnsupportedSchema)
+
+	default:
+		s, err := p.parseName(typ, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if logical == "" {
+			return s, nil
+		}
+		// 논리 타입은 기반 타입 노드를 복사해 표시만 합니다
+		annotated := *s
+		annotated.LogicalType = logical
+		return &annotated, nil
+	}
+}
+
+// fullName은 namespace를 붙인 전체 이름을 반환합니다 (이미 점이 있으면 그대로)
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
@@ -0,0 +1,127 @@
+package backendrouting
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync/atomic"
+)
+
+// Route는 컬렉션을 특정 백엔드로 보내는 규칙입니다
+type Route struct {
+	Collection string `json:"collection"` // 컬렉션 이름 또는 와일드카드 패턴 (*, ?, [...])
+	Backend    string `json:"backend"`
+}
+
+// Table은 요청을 처리할 데이터베이스 백엔드를 고르는 라우팅 표입니다
+// 요청이 백엔드를 직접 지정하지 않으면 컬렉션 고정(관리 API로 지정), 컬렉션 규칙(먼저 선언된 규칙 우선), 기본 백엔드 순으로 사용합니다
+// Table은 만든 뒤 바뀌지 않으며, 실행 중 변경은 Router가 새 Table로 교체합니다
+type Table struct {
+	defaultBackend string
+	enabled        map[string]bool
+	routes         []Route
+	pins           map[string]string // 컬렉션 이름 -> 백엔드
+
+	inflight atomic.Int64 // 이 Table로 라우팅되어 처리 중인 요청 수
+}
+
+// New는 새로운 Table을 생성합니다
+// 기본 백엔드와 규칙의 백엔드는 enabled에 있어야 하며, 규칙의 컬렉션 패턴은 path.Match 문법이어야 합니다
+func New(defaultBackend string, enabled []string, routes []Route) (*Table, error) {
+	t := &Table{
+		defaultBackend: defaultBackend,
+		enabled:        make(map[string]bool, len(enabled)),
+		routes:         routes,
+		pins:           map[string]string{},
+	}
+	for _, backend := range enabled {
+		t.enabled[backend] = true
+	}
+
+	if !t.enabled[defaultBackend] {
+		return nil, fmt.Errorf("default backend %q is not enabled", defaultBackend)
+	}
+	for i, route := range routes {
+		if _, err := path.Match(route.Collection, ""); err != nil {
+			return nil, fmt.Errorf("route %d: invalid collection pattern %q: %w", i, route.Collection, err)
+		}
+		if !t.enabled[route.Backend] {
+			return nil, fmt.Errorf("route %d: backend %q is not enabled", i, route.Backend)
+		}
+	}
+	return t, nil
+}
+
+// Default는 기본 백엔드를 반환합니다
+func (t *Table) Default() string {
+	return t.defaultBackend
+}
+
+// Enabled는 백엔드가 이 서버에서 활성화되어 있는지 확인합니다
+func (t *Table) Enabled(backend string) bool {
+	return t.enabled[backend]
+}
+
+// Backends는 활성화된 백엔드 목록을 이름순으로 반환합니다
+func (t *Table) Backends() []string {
+	backends := make([]string, 0, len(t.enabled))
+	for backend := range t.enabled {
+		backends = append(backends, backend)
+	}
+	sort.Strings(backends)
+	return backends
+}
+
+// Routes는 컬렉션 규칙 목록을 반환합니다
+func (t *Table) Routes() []Route {
+	return append([]Route(nil), t.routes...)
+}
+
+// Pins는 관리 API로 고정한 컬렉션 목록을 컬렉션 이름순으로 반환합니다
+func (t *Table) Pins() []Route {
+	pins := make([]Route, 0, len(t.pins))
+	for collection, backend := range t.pins {
+		pins = append(pins, Route{Collection: collection, Backend: backend})
+	}
+	sort.Slice(pins, func(i, j int) bool { return pins[i].Collection < pins[j].Collection })
+	return pins
+}
+
+// Resolve는 컬렉션을 처리할 백엔드를 반환합니다 (일치하는 고정이나 규칙이 없거나 컬렉션이 비어 있으면 기본 백엔드)
+func (t *Table) Resolve(collection string) string {
+	if collection != "" {
+		if backend, ok := t.pins[collection]; ok {
+			return backend
+		}
+		for _, route := range t.routes {
+			if ok, _ := path.Match(route.Collection, collection); ok {
+				return route.Backend
+			}
+		}
+	}
+	return t.defaultBackend
+}
+
+// InFlight는 이 Table로 라우팅되어 아직 끝나지 않은 요청 수를 반환합니다
+func (t *Table) InFlight() int64 {
+	return t.inflight.Load()
+}
+
+// withPin은 컬렉션 고정을 바꾼 새 Table을 반환합니다 (backend가 비어 있으면 고정 해제)
+func (t *Table) withPin(collection, backend string) *Table {
+	next := &Table{
+		defaultBackend: t.defaultBackend,
+		enabled:        t.enabled,
+		routes:         t.routes,
+		pins:           make(map[string]string, len(t.pins)+1),
+	}
+	for c, b := range t.pins {
+		next.pins[c] = b
+	}
+	if backend == "" {
+		delete(next.pins, collection)
+	} else {
+		next.pins[collection] = backend
+	}
+	return next
+}
@@ -0,0 +1,150 @@
+package backendrouting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrNotPinned는 고정되지 않은 컬렉션의 고정을 해제하려 할 때 반환됩니다
+var ErrNotPinned = errors.New("collection is not pinned to a backend")
+
+// defaultDrainTimeout은 라우팅 변경 후 이전 라우팅 표로 처리 중인 요청을 기다리는 기본 시간입니다
+const defaultDrainTimeout = 30 * time.Second
+
+// drainPollInterval은 처리 중인 요청 수를 다시 확인하는 간격입니다
+const drainPollInterval = 10 * time.Millisecond
+
+// SwitchResult는 컬렉션 백엔드 변경 결과입니다
+type SwitchResult struct {
+	Collection string `json:"collection"`
+	Previous   string `json:"previous_backend"`
+	Backend    string `json:"backend"`
+	Drained    bool   `json:"drained"`             // 이전 라우팅 표로 처리 중이던 요청이 모두 끝났는지
+	InFlight   int64  `json:"in_flight,omitempty"` // Drained가 false일 때 아직 끝나지 않은 요청 수
+}
+
+// Status는 현재 라우팅 표의 내용입니다
+type Status struct {
+	Default  string   `json:"default"`
+	Backends []string `json:"backends"`
+	Routes   []Route  `json:"routes"`
+	Pins     []Route  `json:"pins"`
+	InFlight int64    `json:"in_flight"`
+}
+
+// Router는 실행 중 교체할 수 있는 라우팅 표입니다
+// 요청은 Acquire로 현재 표를 얻어 끝날 때까지 사용하고, 관리 API의 변경은 새 표로 원자적으로 교체한 뒤
+// 이전 표로 처리 중인 요청이 끝나기를 기다립니다 (이후 요청은 모두 새 백엔드로 갑니다)
+type Router struct {
+	mu           sync.Mutex // Pin/Unpin 직렬화
+	table        atomic.Pointer[Table]
+	drainTimeout time.Duration
+}
+
+// NewRouter는 새로운 Router를 생성합니다 (drainTimeout이 0 이하면 30초)
+func NewRouter(table *Table, drainTimeout time.Duration) *Router {
+	if drainTimeout <= 0 {
+		drainTimeout = defaultDrainTimeout
+	}
+	r := &Router{drainTimeout: drainTimeout}
+	r.table.Store(table)
+	return r
+}
+
+// Table은 현재 라우팅 표를 반환합니다
+func (r *Router) Table() *Table {
+	return r.table.Load()
+}
+
+// Acquire는 현재 라우팅 표를 반환하고 요청을 처리 중으로 표시합니다
+// 요청이 끝나면 반드시 release를 호출해야 합니다
+func (r *Router) Acquire() (table *Table, release func()) {
+	for {
+		t := r.table.Load()
+		t.inflight.Add(1)
+		// 증가시키는 사이에 표가 교체되었으면 새 표로 다시 시도합니다 (교체 후 드레인이 놓치지 않도록)
+		if r.table.Load() == t {
+			return t, func() { t.inflight.Add(-1) }
+		}
+		t.inflight.Add(-1)
+	}
+}
+
+// Status는 현재 라우팅 표의 내용을 반환합니다
+func (r *Router) Status() Status {
+	t := r.table.Load()
+	return Status{
+		Default:  t.Default(),
+		Backends: t.Backends(),
+		Routes:   t.Routes(),
+		Pins:     t.Pins(),
+		InFlight: t.InFlight(),
+	}
+}
+
+// Pin은 컬렉션을 backend로 고정하고, 이전 라우팅 표로 처리 중인 요청이 끝나기를 drainTimeout까지 기다립니다
+// 기다리는 시간이 지나도 변경은 유지되며 결과의 Drained가 false입니다
+func (r *Router) Pin(ctx context.Context, collection, backend string) (SwitchResult, error) {
+	if collection == "" {
+		return SwitchResult{}, fmt.Errorf("collection is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.table.Load()
+	if !current.Enabled(backend) {
+		return SwitchResult{}, fmt.Errorf("backend %q is not enabled (enabled: %v)", backend, current.Backends())
+	}
+	return r.swap(ctx, current, current.withPin(collection, backend), collection), nil
+}
+
+// Unpin은 컬렉션 고정을 해제해 설정의 규칙이나 기본 백엔드로 되돌리고, Pin과 같이 드레인합니다
+func (r *Router) Unpin(ctx context.Context, collection string) (SwitchResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.table.Load()
+	if _, ok := current.pins[collection]; !ok {
+		return SwitchResult{}, ErrNotPinned
+	}
+	return r.swap(ctx, current, current.withPin(collection, ""), collection), nil
+}
+
+// swap은 라우팅 표를 교체하고 이전 표로 처리 중인 요청을 드레인합니다
+func (r *Router) swap(ctx context.Context, current, next *Table, collection string) SwitchResult {
+	r.table.Store(next)
+
+	result := SwitchResult{
+		Collection: collection,
+		Previous:   current.Resolve(collection),
+		Backend:    next.Resolve(collection),
+	}
+	result.InFlight = r.drain(ctx, current)
+	result.Drained = result.InFlight == 0
+	return result
+}
+
+// drain은 표로 처리 중인 요청이 끝날 때까지 기다리고, 시간이 지나면 남은 요청 수를 반환합니다
+func (r *Router) drain(ctx context.Context, t *Table) int64 {
+	ctx, cancel := context.WithTimeout(ctx, r.drainTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		n := t.InFlight()
+		if n <= 0 {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return n
+		case <-ticker.C:
+		}
+	}
+}
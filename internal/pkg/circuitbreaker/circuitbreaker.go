@@ -27,6 +27,20 @@ const (
 	StateOpen
 )
 
+// String은 상태 이름을 반환합니다
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
 // Config는 circuit breaker 설정입니다
 type Config struct {
 	MaxRequests  uint32        // half-open 상태에서 허용할 최대 요청 수
@@ -38,11 +52,11 @@ type Config struct {
 
 // Counts는 circuit breaker의 통계입니다
 type Counts struct {
-	Requests             uint32
-	TotalSuccesses       uint32
-	TotalFailures        uint32
-	ConsecutiveSuccesses uint32
-	ConsecutiveFailures  uint32
+	Requests             uint32 `json:"requests"`
+	TotalSuccesses       uint32 `json:"total_successes"`
+	TotalFailures        uint32 `json:"total_failures"`
+	ConsecutiveSuccesses uint32 `json:"consecutive_successes"`
+	ConsecutiveFailures  uint32 `json:"consecutive_failures"`
 }
 
 // CircuitBreaker는 circuit breaker 구현입니다
@@ -59,18 +73,32 @@ type CircuitBreaker struct {
 	generation uint64
 	counts     Counts
 	expiry     time.Time
+	forced     bool // Trip으로 열린 경우 Reset 전까지 half-open으로 넘어가지 않음
 }
 
 // NewCircuitBreaker는 새로운 circuit breaker를 생성합니다
 func NewCircuitBreaker(name string, cfg Config) *CircuitBreaker {
-	cb := &CircuitBreaker{
-		name:          name,
-		maxRequests:   cfg.MaxRequests,
-		interval:      cfg.Interval,
-		timeout:       cfg.Timeout,
-		readyToTrip:   cfg.ReadyToTrip,
-		onStateChange: cfg.OnStateChange,
-	}
+	cb := &CircuitBreaker{name: name}
+	cb.apply(cfg)
+	cb.toNewGeneration(time.Now())
+	return cb
+}
+
+// Reconfigure는 상태와 통계를 유지한 채 임계값을 바꿉니다 (설정 다시 읽기용)
+// 바뀐 Interval과 Timeout은 다음 통계 리셋 또는 다음 open부터 적용됩니다
+func (cb *CircuitBreaker) Reconfigure(cfg Config) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	cb.apply(cfg)
+}
+
+// apply는 설정 값을 기본값으로 채워 적용합니다
+func (cb *CircuitBreaker) apply(cfg Config) {
+	cb.maxRequests = cfg.MaxRequests
+	cb.interval = cfg.Interval
+	cb.timeout = cfg.Timeout
+	cb.readyToTrip = cfg.ReadyToTrip
+	cb.onStateChange = cfg.OnStateChange
 
 	if cb.maxRequests == 0 {
 		cb.maxRequests = 1
@@ -86,9 +114,6 @@ func NewCircuitBreaker(name string, cfg Config) *CircuitBreaker {
 			return counts.ConsecutiveFailures > 5
 		}
 	}
-
-	cb.toNewGeneration(time.Now())
-	return cb
 }
 
 // Execute는 함수를 circuit breaker로 감싸서 실행합니다
@@ -182,7 +207,7 @@ func (cb *CircuitBreaker) currentState(now time.Time) (State, uint64) {
 			cb.toNewGeneration(now)
 		}
 	case StateOpen:
-		if cb.expiry.Before(now) {
+		if !cb.forced && cb.expiry.Before(now) {
 			cb.setState(StateHalfOpen, now)
 		}
 	}
@@ -239,3 +264,54 @@ func (cb *CircuitBreaker) Counts() Counts {
 
 	return cb.counts
 }
+
+// Name은 circuit breaker 이름을 반환합니다
+func (cb *CircuitBreaker) Name() string {
+	return cb.name
+}
+
+// Trip은 circuit을 수동으로 엽니다
+// 수동으로 연 circuit은 Timeout이 지나도 half-open으로 넘어가지 않고 Reset을 호출할 때까지 모든 요청을 거부합니다
+func (cb *CircuitBreaker) Trip() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.forced = true
+	cb.setState(StateOpen, time.Now())
+}
+
+// Reset은 circuit을 닫고 통계를 초기화합니다 (Trip으로 연 circuit도 해제)
+func (cb *CircuitBreaker) Reset() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	now := time.Now()
+	cb.forced = false
+	if cb.state == StateClosed {
+		cb.toNewGeneration(now)
+		return
+	}
+	cb.setState(StateClosed, now)
+}
+
+// Status는 circuit breaker의 현재 상태입니다
+type Status struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Forced bool   `json:"forced"` // Trip으로 수동으로 열렸는지 여부
+	Counts Counts `json:"counts"`
+}
+
+// Status는 현재 상태와 통계를 반환합니다
+func (cb *CircuitBreaker) Status() Status {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	state, _ := cb.currentState(time.Now())
+	return Status{
+		Name:   cb.name,
+		State:  state.String(),
+		Forced: cb.forced,
+		Counts: cb.counts,
+	}
+}
@@ -0,0 +1,162 @@
+package circuitbreaker
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Settings는 설정 파일로 지정하는 circuit breaker 임계값입니다
+// 0인 값은 기본값(DefaultSettings 또는 Registry의 기본 설정)을 사용합니다
+type Settings struct {
+	FailureRatio     float64       // 이 비율 이상 실패하면 open (0~1)
+	MinRequests      uint32        // 실패 비율을 판단하기 위한 최소 요청 수
+	Interval         time.Duration // closed 상태에서 통계를 리셋할 간격
+	OpenTimeout      time.Duration // open 상태를 유지한 뒤 half-open으로 넘어가기까지의 시간
+	HalfOpenRequests uint32        // half-open 상태에서 허용할 시험 요청 수 (모두 성공하면 closed)
+}
+
+// DefaultSettings는 기본 임계값입니다
+func DefaultSettings() Settings {
+	return Settings{
+		FailureRatio:     0.6,
+		MinRequests:      3,
+		Interval:         10 * time.Second,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenRequests: 3,
+	}
+}
+
+// withDefaults는 비어 있는 값을 base로 채웁니다
+func (s Settings) withDefaults(base Settings) Settings {
+	if s.FailureRatio <= 0 {
+		s.FailureRatio = base.FailureRatio
+	}
+	if s.MinRequests == 0 {
+		s.MinRequests = base.MinRequests
+	}
+	if s.Interval <= 0 {
+		s.Interval = base.Interval
+	}
+	if s.OpenTimeout <= 0 {
+		s.OpenTimeout = base.OpenTimeout
+	}
+	if s.HalfOpenRequests == 0 {
+		s.HalfOpenRequests = base.HalfOpenRequests
+	}
+	return s
+}
+
+// Config는 임계값으로 circuit breaker 설정을 만듭니다
+func (s Settings) Config(onStateChange func(name string, from State, to State)) Config {
+	return Config{
+		MaxRequests: s.HalfOpenRequests,
+		Interval:    s.Interval,
+		Timeout:     s.OpenTimeout,
+		ReadyToTrip: func(counts Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= s.MinRequests && failureRatio >= s.FailureRatio
+		},
+		OnStateChange: onStateChange,
+	}
+}
+
+// Registry는 이름(데이터베이스 종류 등)별 circuit breaker 모음입니다
+// 한 백엔드의 장애가 다른 백엔드 요청까지 차단하지 않도록 백엔드마다 독립된 circuit breaker를 사용합니다
+type Registry struct {
+	defaults      Settings
+	overrides     map[string]Settings
+	onStateChange func(name string, from State, to State)
+
+	mu       sync.RWMutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewRegistry는 새로운 Registry를 생성합니다
+// overrides에 없는 이름은 defaults를 사용하고, overrides의 비어 있는 값도 defaults로 채웁니다
+func NewRegistry(defaults Settings, overrides map[string]Settings, onStateChange func(name string, from State, to State)) *Registry {
+	defaults, resolved := resolveSettings(defaults, overrides)
+	return &Registry{
+		defaults:      defaults,
+		overrides:     resolved,
+		onStateChange: onStateChange,
+		breakers:      make(map[string]*CircuitBreaker),
+	}
+}
+
+// Update는 임계값을 바꾸고 이미 생성된 circuit breaker에도 적용합니다 (상태는 유지)
+func (r *Registry) Update(defaults Settings, overrides map[string]Settings) {
+	defaults, resolved := resolveSettings(defaults, overrides)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaults = defaults
+	r.overrides = resolved
+	for name, cb := range r.breakers {
+		cb.Reconfigure(r.settings(name).Config(r.onStateChange))
+	}
+}
+
+// resolveSettings는 기본 설정과 이름별 설정의 비어 있는 값을 채웁니다
+func resolveSettings(defaults Settings, overrides map[string]Settings) (Settings, map[string]Settings) {
+	defaults = defaults.withDefaults(DefaultSettings())
+	resolved := make(map[string]Settings, len(overrides))
+	for name, s := range overrides {
+		resolved[name] = s.withDefaults(defaults)
+	}
+	return defaults, resolved
+}
+
+// settings는 이름에 적용할 임계값을 반환합니다 (r.mu를 잡은 상태에서 호출)
+func (r *Registry) settings(name string) Settings {
+	if settings, ok := r.overrides[name]; ok {
+		return settings
+	}
+	return r.defaults
+}
+
+// Get은 이름의 circuit breaker를 반환하며, 없으면 생성합니다
+func (r *Registry) Get(name string) *CircuitBreaker {
+	r.mu.RLock()
+	cb, ok := r.breakers[name]
+	r.mu.RUnlock()
+	if ok {
+		return cb
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cb, ok := r.breakers[name]; ok {
+		return cb
+	}
+	cb = NewCircuitBreaker(name, r.settings(name).Config(r.onStateChange))
+	r.breakers[name] = cb
+	return cb
+}
+
+// Lookup은 이미 생성된 circuit breaker를 반환합니다
+func (r *Registry) Lookup(name string) (*CircuitBreaker, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cb, ok := r.breakers[name]
+	return cb, ok
+}
+
+// List는 모든 circuit breaker의 상태를 이름순으로 반환합니다
+func (r *Registry) List() []Status {
+	r.mu.RLock()
+	breakers := make([]*CircuitBreaker, 0, len(r.breakers))
+	for _, cb := range r.breakers {
+		breakers = append(breakers, cb)
+	}
+	r.mu.RUnlock()
+
+	statuses := make([]Status, len(breakers))
+	for i, cb := range breakers {
+		statuses[i] = cb.Status()
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
@@ -0,0 +1,158 @@
+// Package dedup은 키 필드 값이 같은 문서를 중복으로 묶습니다
+//
+// 키 필드 값을 정규화해 JSON 배열로 직렬화한 뒤 SHA-256 해시로 그룹을 나누므로,
+// 문서 전체를 메모리에 올리지 않고 문서당 해시 하나만 보관합니다.
+// 저장소에 접근하지 않는 순수 함수라 중복 탐지 작업과 병합 전 재확인이 같은 판정을 사용합니다
+package dedup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Options는 키 값 비교 방식입니다
+type Options struct {
+	CaseInsensitive bool // 문자열 값을 대소문자 구분 없이 비교
+	TrimSpace       bool // 문자열 값의 앞뒤 공백 무시
+}
+
+// Key는 문서의 중복 판정 키입니다
+type Key struct {
+	Hash   string                 // 정규화한 키 값의 SHA-256 (hex)
+	Values map[string]interface{} // 필드별 원래 키 값 (정규화 전 값이므로 그룹의 문서마다 다를 수 있음)
+}
+
+// KeyOf는 data에서 fields 값을 꺼내 중복 판정 키를 만듭니다
+// 필드 중 하나라도 없거나 null이면 중복 판정 대상이 아니므로 false를 반환합니다
+func KeyOf(data map[string]interface{}, fields []string, opts Options) (Key, bool) {
+	values := make(map[string]interface{}, len(fields))
+	normalized := make([]interface{}, len(fields))
+	for i, field := range fields {
+		value, ok := lookup(data, field)
+		if !ok {
+			return Key{}, false
+		}
+		values[field] = value
+		normalized[i] = normalize(value, opts)
+	}
+
+	encoded, err := json.Marshal(normalized)
+	if err != nil {
+		return Key{}, false
+	}
+	sum := sha256.Sum256(encoded)
+	return Key{Hash: hex.EncodeToString(sum[:]), Values: values}, true
+}
+
+// Group은 키가 같은 문서 묶음입니다
+type Group struct {
+	Key    Key
+	IDs    []string // 추가한 순서
+	KeepID string   // 남길 문서 제안 (가장 먼저 생성된 문서, 같으면 ID 순)
+
+	keepCreatedAt time.Time
+}
+
+// Grouper는 문서를 하나씩 받아 키가 같은 문서를 묶습니다
+type Grouper struct {
+	fields []string
+	opts   Options
+	first  map[string]member // 키별 첫 문서 (중복이 나오기 전까지는 그룹을 만들지 않음)
+	groups map[string]*Group
+}
+
+// member는 그룹이 만들어지기 전 키의 첫 문서입니다
+type member struct {
+	id        string
+	createdAt time.Time
+}
+
+// NewGrouper는 fields를 키로 문서를 묶는 Grouper를 생성합니다
+func NewGrouper(fields []string, opts Options) *Grouper {
+	return &Grouper{
+		fields: fields,
+		opts:   opts,
+		first:  make(map[string]member),
+		groups: make(map[string]*Group),
+	}
+}
+
+// Add는 문서를 추가합니다 (키 필드가 없는 문서는 무시하고 false를 반환)
+func (g *Grouper) Add(id string, createdAt time.Time, data map[string]interface{}) bool {
+	key, ok := KeyOf(data, g.fields, g.opts)
+	if !ok {
+		return false
+	}
+
+	if group, exists := g.groups[key.Hash]; exists {
+		group.add(id, createdAt)
+		return true
+	}
+	first, seen := g.first[key.Hash]
+	if !seen {
+		g.first[key.Hash] = member{id: id, createdAt: createdAt}
+		return true
+	}
+
+	group := &Group{Key: key}
+	group.add(first.id, first.createdAt)
+	group.add(id, createdAt)
+	g.groups[key.Hash] = group
+	delete(g.first, key.Hash)
+	return true
+}
+
+// Groups는 문서가 두 개 이상인 그룹을 문서가 많은 순서로 반환합니다 (같으면 해시 순)
+func (g *Grouper) Groups() []*Group {
+	groups := make([]*Group, 0, len(g.groups))
+	for _, group := range g.groups {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].IDs) != len(groups[j].IDs) {
+			return len(groups[i].IDs) > len(groups[j].IDs)
+		}
+		return groups[i].Key.Hash < groups[j].Key.Hash
+	})
+	return groups
+}
+
+// add는 그룹에 문서를 추가하고 남길 문서 제안을 갱신합니다
+func (g *Group) add(id string, createdAt time.Time) {
+	g.IDs = append(g.IDs, id)
+	if g.KeepID == "" || createdAt.Before(g.keepCreatedAt) || createdAt.Equal(g.keepCreatedAt) && id < g.KeepID {
+		g.KeepID, g.keepCreatedAt = id, createdAt
+	}
+}
+
+// lookup은 점으로 구분된 경로의 값을 꺼냅니다 (없거나 null이면 false)
+func lookup(data map[string]interface{}, field string) (interface{}, bool) {
+	var current interface{} = data
+	for _, segment := range strings.Split(field, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = m[segment]
+	}
+	return current, current != nil
+}
+
+// normalize는 옵션에 따라 문자열 값을 정규화합니다 (다른 타입은 그대로)
+func normalize(value interface{}, opts Options) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	if opts.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if opts.CaseInsensitive {
+		s = strings.ToLower(s)
+	}
+	return s
+}
@@ -0,0 +1,223 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedOnEncryptedData는 암호화된 데이터 컬럼에 대해 서버 측 JSON 연산을 요청한 경우의 에러입니다
+// (데이터 필드 필터/정렬, 부분 업데이트, Distinct, 데이터 필드 인덱스 등)
+var ErrUnsupportedOnEncryptedData = errors.New("operation is not supported on encrypted data")
+
+// envelopeKey는 암호화된 값을 표시하는 최상위 JSON 키입니다
+// 암호문도 유효한 JSON으로 저장되므로 JSONB/JSON 컬럼 타입을 그대로 사용할 수 있습니다
+const envelopeKey = "$enc"
+
+// KeyProvider는 데이터 키(DEK)를 발급하고 래핑된 데이터 키를 복호화하는 키 관리 시스템입니다
+// Vault Transit, 클라우드 KMS, 로컬 마스터 키 등으로 구현합니다
+type KeyProvider interface {
+	// GenerateDataKey는 새 데이터 키의 평문과 래핑된 값을 반환합니다
+	GenerateDataKey(ctx context.Context) (plaintext []byte, wrapped string, err error)
+
+	// DecryptDataKey는 래핑된 데이터 키를 복호화합니다
+	DecryptDataKey(ctx context.Context, wrapped string) ([]byte, error)
+}
+
+// sealed는 암호화된 값의 저장 형식입니다
+type sealed struct {
+	Version    int    `json:"v"`
+	Key        string `json:"k"` // 래핑된 데이터 키
+	Nonce      []byte `json:"n"`
+	Ciphertext []byte `json:"c"`
+}
+
+// envelope는 {"$enc": {...}} 형식의 JSON 문서입니다
+type envelope struct {
+	Enc *sealed `json:"$enc"`
+}
+
+// Cipher는 AES-256-GCM 봉투 암호화기입니다
+// 시작 시 데이터 키 하나를 발급받아 사용하고, 읽을 때는 값에 기록된 래핑 키로 데이터 키를 찾아 복호화합니다
+// 복호화된 데이터 키는 메모리에 캐시하므로 KMS 호출은 데이터 키마다 한 번만 발생합니다
+type Cipher struct {
+	provider KeyProvider
+
+	mu      sync.RWMutex
+	wrapped string
+	aead    cipher.AEAD
+
+	keys sync.Map // 래핑된 데이터 키 -> cipher.AEAD
+}
+
+// NewCipher는 새 데이터 키를 발급받아 Cipher를 생성합니다
+func NewCipher(ctx context.Context, provider KeyProvider) (*Cipher, error) {
+	c := &Cipher{provider: provider}
+	if err := c.Rotate(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Rotate는 새 데이터 키를 발급받아 이후 암호화에 사용합니다
+// 이전 키로 암호화된 값은 계속 복호화할 수 있습니다
+func (c *Cipher) Rotate(ctx context.Context) error {
+	plaintext, wrapped, err := c.provider.GenerateDataKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to generate data key: %w", err)
+	}
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return err
+	}
+
+	c.keys.Store(wrapped, aead)
+	c.mu.Lock()
+	c.wrapped, c.aead = wrapped, aead
+	c.mu.Unlock()
+	return nil
+}
+
+// Encrypt는 평문을 암호화해 JSON 봉투로 반환합니다
+// aad는 암호문을 특정 행에 묶는 추가 인증 데이터입니다 (다른 행으로 복사된 암호문은 복호화되지 않습니다)
+func (c *Cipher) Encrypt(plaintext, aad []byte) ([]byte, error) {
+	c.mu.RLock()
+	wrapped, aead := c.wrapped, c.aead
+	c.mu.RUnlock()
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return json.Marshal(envelope{Enc: &sealed{
+		Version:    1,
+		Key:        wrapped,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, aad),
+	}})
+}
+
+// Decrypt는 JSON 봉투를 복호화합니다
+// 암호화되지 않은 값(암호화 도입 전에 저장된 행)은 그대로 반환합니다
+func (c *Cipher) Decrypt(ctx context.Context, data, aad []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil || env.Enc == nil {
+		return nil, fmt.Errorf("malformed encrypted value")
+	}
+	if env.Enc.Version != 1 {
+		return nil, fmt.Errorf("unsupported encrypted value version: %d", env.Enc.Version)
+	}
+
+	aead, err := c.dataKey(ctx, env.Enc.Key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, env.Enc.Nonce, env.Enc.Ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value: %w", err)
+	}
+	return plaintext, nil
+}
+
+// dataKey는 래핑된 데이터 키에 해당하는 AEAD를 반환합니다 (캐시 또는 KMS 복호화)
+func (c *Cipher) dataKey(ctx context.Context, wrapped string) (cipher.AEAD, error) {
+	if aead, ok := c.keys.Load(wrapped); ok {
+		return aead.(cipher.AEAD), nil
+	}
+
+	plaintext, err := c.provider.DecryptDataKey(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	c.keys.Store(wrapped, aead)
+	return aead, nil
+}
+
+// IsEncrypted는 값이 암호화 봉투인지 확인합니다
+// JSONB/MySQL JSON은 공백을 정규화하므로 공백을 무시하고 첫 키만 확인합니다
+func IsEncrypted(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return false
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(data[1:]), []byte(`"`+envelopeKey+`"`))
+}
+
+// newAEAD는 256비트 데이터 키로 AES-GCM을 생성합니다
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("data key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// localKeyPrefix는 로컬 마스터 키로 래핑한 데이터 키의 접두사입니다
+const localKeyPrefix = "local:v1:"
+
+// LocalKeyProvider는 로컬 마스터 키(KEK)로 데이터 키를 래핑하는 KeyProvider입니다
+// KMS가 없는 환경이나 테스트용이며, 마스터 키는 환경변수 등 디스크 밖에서 주입해야 합니다
+type LocalKeyProvider struct {
+	kek cipher.AEAD
+}
+
+// NewLocalKeyProvider는 32바이트 마스터 키로 LocalKeyProvider를 생성합니다
+func NewLocalKeyProvider(masterKey []byte) (*LocalKeyProvider, error) {
+	kek, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master key: %w", err)
+	}
+	return &LocalKeyProvider{kek: kek}, nil
+}
+
+// GenerateDataKey는 무작위 데이터 키를 생성하고 마스터 키로 래핑합니다
+func (p *LocalKeyProvider) GenerateDataKey(ctx context.Context) ([]byte, string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+	nonce := make([]byte, p.kek.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	wrapped := p.kek.Seal(nonce, nonce, key, nil)
+	return key, localKeyPrefix + base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+// DecryptDataKey는 래핑된 데이터 키를 마스터 키로 복호화합니다
+func (p *LocalKeyProvider) DecryptDataKey(ctx context.Context, wrapped string) ([]byte, error) {
+	if !strings.HasPrefix(wrapped, localKeyPrefix) {
+		return nil, fmt.Errorf("data key was not wrapped by the local key provider")
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(wrapped, localKeyPrefix))
+	if err != nil || len(raw) < p.kek.NonceSize() {
+		return nil, fmt.Errorf("malformed wrapped data key")
+	}
+	nonceSize := p.kek.NonceSize()
+	return p.kek.Open(nil, raw[:nonceSize], raw[nonceSize:], nil)
+}
+
+// RowAAD는 암호문을 컬렉션과 문서 ID에 묶는 추가 인증 데이터를 생성합니다
+func RowAAD(collection, id string) []byte {
+	return []byte(collection + "\x00" + id)
+}
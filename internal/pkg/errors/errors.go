@@ -22,6 +22,7 @@ const (
 	ErrCodeInvalidCollection ErrorCode = "INVALID_COLLECTION"
 	ErrCodeInvalidDocument  ErrorCode = "INVALID_DOCUMENT"
 	ErrCodeVersionConflict  ErrorCode = "VERSION_CONFLICT"
+	ErrCodeDuplicateKey     ErrorCode = "DUPLICATE_KEY"
 
 	// 데이터베이스 에러
 	ErrCodeDatabaseConnection ErrorCode = "DATABASE_CONNECTION_ERROR"
@@ -121,6 +122,11 @@ func Is(err error, code ErrorCode) bool {
 	return false
 }
 
+// As는 에러 체인에서 target 타입의 에러를 찾습니다 (표준 errors.As와 같음)
+func As(err error, target interface{}) bool {
+	return errors.As(err, target)
+}
+
 // GetCode는 에러 코드를 반환합니다
 func GetCode(err error) ErrorCode {
 	var appErr *AppError
@@ -146,7 +152,7 @@ func getHTTPStatus(code ErrorCode) int {
 		return http.StatusBadRequest
 	case ErrCodeNotFound:
 		return http.StatusNotFound
-	case ErrCodeConflict, ErrCodeVersionConflict:
+	case ErrCodeConflict, ErrCodeVersionConflict, ErrCodeDuplicateKey:
 		return http.StatusConflict
 	case ErrCodeTimeout, ErrCodeDatabaseTimeout:
 		return http.StatusRequestTimeout
@@ -166,6 +172,7 @@ var (
 	ErrInvalidDocument      = New(ErrCodeInvalidDocument, "invalid document")
 	ErrDocumentNotFound     = New(ErrCodeNotFound, "document not found")
 	ErrVersionConflict      = New(ErrCodeVersionConflict, "version conflict - document was modified by another request")
+	ErrDuplicateKey         = New(ErrCodeDuplicateKey, "duplicate key - another document has the same unique value")
 	ErrDatabaseConnection   = New(ErrCodeDatabaseConnection, "database connection error")
 	ErrDatabaseQuery        = New(ErrCodeDatabaseQuery, "database query error")
 	ErrCacheConnection      = New(ErrCodeCacheConnection, "cache connection error")
@@ -0,0 +1,301 @@
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// CDC는 CDC 이벤트 발행을 켜고 끄는 플래그입니다
+const CDC = "cdc"
+
+// Backend는 데이터베이스 백엔드 사용 플래그 이름을 반환합니다 (backend.<이름>)
+func Backend(name string) string {
+	return "backend." + name
+}
+
+// CacheStrategy는 캐시 전략 사용 플래그 이름을 반환합니다 (cache.<전략>)
+func CacheStrategy(strategy string) string {
+	return "cache." + strategy
+}
+
+var (
+	// ErrUnknownFlag는 설정에 정의되지 않은 플래그를 변경하려 할 때 반환됩니다
+	ErrUnknownFlag = errors.New("feature flag is not defined")
+
+	// ErrOverrideNotFound는 없는 실행 중 변경을 삭제하려 할 때 반환됩니다
+	ErrOverrideNotFound = errors.New("feature flag override not found")
+)
+
+// 평가 결과의 출처 (우선순위 순)
+const (
+	SourceTenantOverride = "tenant_override" // Redis에 저장한 테넌트별 변경
+	SourceTenant         = "tenant"          // 설정의 테넌트별 값
+	SourceOverride       = "override"        // Redis에 저장한 전체 변경
+	SourceEnvironment    = "environment"     // 설정의 환경별 값
+	SourceDefault        = "default"         // 설정의 기본값
+	SourceUndefined      = "undefined"       // 정의되지 않은 플래그 (켜진 것으로 봄)
+)
+
+// Flag는 설정에 정의한 기능 플래그입니다
+type Flag struct {
+	Name         string
+	Description  string
+	Enabled      bool            // 기본값
+	Environments map[string]bool // 환경(app.environment)별 값
+	Tenants      map[string]bool // 테넌트 ID별 값
+}
+
+// Override는 관리 API로 저장한 실행 중 변경입니다 (Tenant가 비어 있으면 모든 테넌트)
+type Override struct {
+	Flag    string `json:"flag"`
+	Tenant  string `json:"tenant,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Store는 실행 중 변경을 보관하는 분산 저장소 인터페이스입니다 (모든 인스턴스가 공유)
+type Store interface {
+	// List는 저장된 모든 변경을 반환합니다
+	List(ctx context.Context) ([]Override, error)
+
+	// Set은 변경을 저장합니다
+	Set(ctx context.Context, override Override) error
+
+	// Delete는 변경을 삭제하고, 삭제한 변경이 있었는지 반환합니다
+	Delete(ctx context.Context, flag, tenant string) (bool, error)
+}
+
+// Evaluation은 플래그 평가 결과입니다
+type Evaluation struct {
+	Flag        string `json:"flag"`
+	Description string `json:"description,omitempty"`
+	Tenant      string `json:"tenant,omitempty"`
+	Enabled     bool   `json:"enabled"`
+	Source      string `json:"source"`
+}
+
+// Status는 테넌트 기준 플래그 평가 결과와 실행 중 변경 목록입니다
+type Status struct {
+	Environment string       `json:"environment"`
+	Tenant      string       `json:"tenant,omitempty"`
+	Flags       []Evaluation `json:"flags"`
+	Overrides   []Override   `json:"overrides"`
+}
+
+type overrideKey struct {
+	flag   string
+	tenant string
+}
+
+// Flags는 설정과 Redis 변경으로 기능 플래그를 평가합니다
+// 우선순위: 테넌트 변경 > 설정의 테넌트 값 > 전체 변경 > 설정의 환경 값 > 기본값
+// 정의되지 않은 플래그는 켜진 것으로 보므로, 코드의 게이트는 설정에 플래그를 추가하기 전까지 기존 동작을 유지합니다
+// 변경은 저장한 인스턴스에 바로 반영되고 다른 인스턴스에는 Run의 새로 고침 주기마다 반영됩니다
+type Flags struct {
+	environment string
+	flags       map[string]Flag
+	store       Store
+	overrides   atomic.Pointer[map[overrideKey]bool]
+}
+
+// New는 새로운 Flags를 생성합니다 (store가 nil이면 실행 중 변경을 지원하지 않습니다)
+func New(environment string, flags []Flag, store Store) (*Flags, error) {
+	f := &Flags{
+		environment: strings.ToLower(environment),
+		flags:       make(map[string]Flag, len(flags)),
+		store:       store,
+	}
+	for i, flag := range flags {
+		if flag.Name == "" || strings.ContainsAny(flag.Name, "@ ") {
+			return nil, fmt.Errorf("flag %d: invalid name %q", i, flag.Name)
+		}
+		if _, ok := f.flags[flag.Name]; ok {
+			return nil, fmt.Errorf("flag %d: duplicate name %q", i, flag.Name)
+		}
+		// 환경 이름은 대소문자를 구분하지 않습니다 (설정 파일의 맵 키는 소문자로 읽힘)
+		environments := make(map[string]bool, len(flag.Environments))
+		for env, enabled := range flag.Environments {
+			environments[strings.ToLower(env)] = enabled
+		}
+		flag.Environments = environments
+		f.flags[flag.Name] = flag
+	}
+	f.overrides.Store(&map[overrideKey]bool{})
+	return f, nil
+}
+
+// Environment는 플래그를 평가하는 환경 이름을 반환합니다
+func (f *Flags) Environment() string {
+	return f.environment
+}
+
+// Enabled는 요청 principal의 테넌트 기준으로 플래그가 켜져 있는지 확인합니다 (f가 nil이면 항상 true)
+func (f *Flags) Enabled(ctx context.Context, name string) bool {
+	if f == nil {
+		return true
+	}
+	var tenant string
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		tenant = principal.TenantID
+	}
+	return f.Evaluate(name, tenant).Enabled
+}
+
+// Defined는 플래그가 설정에 정의되어 있는지 확인합니다
+func (f *Flags) Defined(name string) bool {
+	_, ok := f.flags[name]
+	return ok
+}
+
+// Evaluate는 테넌트 기준으로 플래그를 평가합니다 (tenant가 비어 있으면 테넌트 값을 보지 않습니다)
+func (f *Flags) Evaluate(name, tenant string) Evaluation {
+	result := Evaluation{Flag: name, Tenant: tenant}
+	flag, ok := f.flags[name]
+	if !ok {
+		result.Enabled, result.Source = true, SourceUndefined
+		return result
+	}
+	result.Description = flag.Description
+
+	overrides := *f.overrides.Load()
+	if tenant != "" {
+		if enabled, ok := overrides[overrideKey{name, tenant}]; ok {
+			result.Enabled, result.Source = enabled, SourceTenantOverride
+			return result
+		}
+		if enabled, ok := flag.Tenants[tenant]; ok {
+			result.Enabled, result.Source = enabled, SourceTenant
+			return result
+		}
+	}
+	if enabled, ok := overrides[overrideKey{name, ""}]; ok {
+		result.Enabled, result.Source = enabled, SourceOverride
+		return result
+	}
+	if enabled, ok := flag.Environments[f.environment]; ok {
+		result.Enabled, result.Source = enabled, SourceEnvironment
+		return result
+	}
+	result.Enabled, result.Source = flag.Enabled, SourceDefault
+	return result
+}
+
+// List는 정의된 모든 플래그를 테넌트 기준으로 평가해 이름순으로 반환합니다
+func (f *Flags) List(tenant string) []Evaluation {
+	names := make([]string, 0, len(f.flags))
+	for name := range f.flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	evaluations := make([]Evaluation, 0, len(names))
+	for _, name := range names {
+		evaluations = append(evaluations, f.Evaluate(name, tenant))
+	}
+	return evaluations
+}
+
+// Status는 정의된 플래그의 평가 결과와 실행 중 변경 목록을 반환합니다
+func (f *Flags) Status(tenant string) Status {
+	return Status{
+		Environment: f.environment,
+		Tenant:      tenant,
+		Flags:       f.List(tenant),
+		Overrides:   f.Overrides(),
+	}
+}
+
+// Overrides는 현재 반영된 실행 중 변경을 플래그, 테넌트 순으로 반환합니다
+func (f *Flags) Overrides() []Override {
+	overrides := *f.overrides.Load()
+	list := make([]Override, 0, len(overrides))
+	for key, enabled := range overrides {
+		list = append(list, Override{Flag: key.flag, Tenant: key.tenant, Enabled: enabled})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Flag != list[j].Flag {
+			return list[i].Flag < list[j].Flag
+		}
+		return list[i].Tenant < list[j].Tenant
+	})
+	return list
+}
+
+// SetOverride는 플래그 값을 실행 중에 바꿉니다 (정의된 플래그만)
+func (f *Flags) SetOverride(ctx context.Context, override Override) error {
+	if !f.Defined(override.Flag) {
+		return fmt.Errorf("%w: %s", ErrUnknownFlag, override.Flag)
+	}
+	if f.store == nil {
+		return fmt.Errorf("feature flag overrides require a store")
+	}
+	if err := f.store.Set(ctx, override); err != nil {
+		return err
+	}
+	return f.Refresh(ctx)
+}
+
+// DeleteOverride는 실행 중 변경을 삭제해 설정 값으로 되돌립니다
+func (f *Flags) DeleteOverride(ctx context.Context, flag, tenant string) error {
+	if !f.Defined(flag) {
+		return fmt.Errorf("%w: %s", ErrUnknownFlag, flag)
+	}
+	if f.store == nil {
+		return ErrOverrideNotFound
+	}
+	deleted, err := f.store.Delete(ctx, flag, tenant)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrOverrideNotFound
+	}
+	return f.Refresh(ctx)
+}
+
+// Refresh는 저장소의 변경을 다시 읽어 반영합니다 (정의되지 않은 플래그의 변경은 무시)
+func (f *Flags) Refresh(ctx context.Context) error {
+	if f.store == nil {
+		return nil
+	}
+	list, err := f.store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load feature flag overrides: %w", err)
+	}
+	overrides := make(map[overrideKey]bool, len(list))
+	for _, o := range list {
+		if f.Defined(o.Flag) {
+			overrides[overrideKey{o.Flag, o.Tenant}] = o.Enabled
+		}
+	}
+	f.overrides.Store(&overrides)
+	return nil
+}
+
+// Run은 ctx가 취소될 때까지 interval마다 저장소의 변경을 다시 읽습니다
+// 저장소 장애 시에는 마지막으로 읽은 변경을 유지하고 에러 로그만 남깁니다
+func (f *Flags) Run(ctx context.Context, interval time.Duration) {
+	if f.store == nil || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.Refresh(ctx); err != nil {
+				logger.Error(ctx, "failed to refresh feature flags", zap.Error(err))
+			}
+		}
+	}
+}
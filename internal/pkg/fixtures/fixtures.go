@@ -0,0 +1,165 @@
+// Package fixtures는 데모 환경과 통합 테스트용 JSON 픽스처 디렉터리를 읽습니다
+//
+// 디렉터리의 <collection>.json 파일마다 문서 배열을 담으며, 문서는 _id로 식별됩니다.
+// 같은 _id의 문서는 다시 불러와도 새로 만들지 않으므로 같은 픽스처를 여러 번 불러와도 결과가 같습니다
+//
+//	[
+//	  {"_id": "u-1", "name": "Kim", "age": 30},
+//	  {"_id": "u-2", "name": "Lee", "age": 25}
+//	]
+package fixtures
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// IDField는 픽스처 문서의 ID 필드입니다 (문서 데이터에서는 제외)
+const IDField = "_id"
+
+// fileExt는 픽스처 파일 확장자입니다
+const fileExt = ".json"
+
+// Document는 픽스처 문서입니다
+type Document struct {
+	ID   string
+	Data map[string]interface{}
+}
+
+// Fixture는 컬렉션 하나의 픽스처 파일입니다
+type Fixture struct {
+	Collection string
+	File       string
+	Documents  []Document
+}
+
+// LoadDir은 dir의 *.json 파일을 파일 이름 순서로 읽습니다 (하위 디렉터리는 읽지 않음)
+// 파일 이름(확장자 제외)이 컬렉션 이름이며, 모든 문서는 비어 있지 않은 문자열 _id가 있어야 합니다
+func LoadDir(dir string) ([]Fixture, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), fileExt) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	fixtures := make([]Fixture, 0, len(names))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture %s: %w", name, err)
+		}
+		docs, err := Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", name, err)
+		}
+		fixtures = append(fixtures, Fixture{
+			Collection: strings.TrimSuffix(name, fileExt),
+			File:       path,
+			Documents:  docs,
+		})
+	}
+	return fixtures, nil
+}
+
+// Parse는 픽스처 파일 내용(문서 배열)을 읽습니다
+// 정수는 int64로 읽어 저장소에 실수로 저장되지 않게 합니다
+func Parse(raw []byte) ([]Document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var items []map[string]interface{}
+	if err := decoder.Decode(&items); err != nil {
+		return nil, fmt.Errorf("expected an array of documents: %w", err)
+	}
+
+	docs := make([]Document, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		id, ok := item[IDField].(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("document %d: %s must be a non-empty string", i, IDField)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("document %d: %s %s is listed more than once", i, IDField, id)
+		}
+		seen[id] = true
+
+		data := make(map[string]interface{}, len(item)-1)
+		for k, v := range item {
+			if k != IDField {
+				data[k] = normalize(v)
+			}
+		}
+		docs = append(docs, Document{ID: id, Data: data})
+	}
+	return docs, nil
+}
+
+// Equal은 두 문서 데이터가 같은지 비교합니다 (_id 제외)
+// 저장소마다 숫자 타입과 키 순서가 다르므로 JSON으로 정규화해 비교합니다
+func Equal(a, b map[string]interface{}) bool {
+	left, err := canonical(a)
+	if err != nil {
+		return false
+	}
+	right, err := canonical(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(left, right)
+}
+
+// canonical은 data를 키 순서가 정해진 JSON으로 인코딩합니다
+func canonical(data map[string]interface{}) ([]byte, error) {
+	filtered := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if k != IDField {
+			filtered[k] = v
+		}
+	}
+	raw, err := json.Marshal(filtered)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+	return json.Marshal(normalized)
+}
+
+// normalize는 json.Number를 int64(정수) 또는 float64로 바꿉니다
+func normalize(v interface{}) interface{} {
+	switch value := v.(type) {
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			return n
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = normalize(item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = normalize(item)
+		}
+		return value
+	}
+	return v
+}
@@ -0,0 +1,158 @@
+package ipfilter
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+)
+
+// Rules는 CIDR 기반 허용/차단 규칙입니다
+// Deny가 Allow보다 우선하며, Allow가 비어 있으면 차단되지 않은 모든 주소를 허용합니다
+type Rules struct {
+	Allow []netip.Prefix
+	Deny  []netip.Prefix
+}
+
+// Group은 특정 경로(HTTP 경로 또는 gRPC 메서드) 접두사에 추가로 적용되는 규칙입니다
+type Group struct {
+	Name     string
+	Prefixes []string
+	Rules    Rules
+}
+
+// Config는 IP 필터 전체 규칙입니다
+type Config struct {
+	Global Rules
+	Groups []Group
+}
+
+// Decision은 IP 필터 판정 결과입니다
+type Decision struct {
+	Allowed bool
+	Group   string // 거부한 규칙 그룹 ("global" 또는 그룹 이름)
+	Reason  string // "denylist" 또는 "not_allowlisted"
+}
+
+// Filter는 핫 리로드를 지원하는 IP 허용/차단 필터입니다
+type Filter struct {
+	config atomic.Pointer[Config]
+}
+
+// New는 새로운 Filter를 생성합니다
+func New(cfg *Config) *Filter {
+	f := &Filter{}
+	f.Update(cfg)
+	return f
+}
+
+// Update는 규칙을 원자적으로 교체합니다 (진행 중인 요청은 이전 규칙으로 판정됩니다)
+func (f *Filter) Update(cfg *Config) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	f.config.Store(cfg)
+}
+
+// Check는 target(HTTP 경로 또는 gRPC 전체 메서드 이름)에 대한 주소의 접근 허용 여부를 판정합니다
+// 전역 규칙을 먼저 적용하고, target과 접두사가 일치하는 모든 그룹의 규칙을 추가로 적용합니다
+// 주소를 알 수 없으면(유닉스 소켓 등) 어떤 CIDR에도 속하지 않은 것으로 보므로 허용 목록이 있을 때만 거부됩니다
+func (f *Filter) Check(target string, addr netip.Addr) Decision {
+	addr = addr.Unmap()
+
+	cfg := f.config.Load()
+	if reason, ok := cfg.Global.evaluate(addr); !ok {
+		return Decision{Allowed: false, Group: "global", Reason: reason}
+	}
+
+	for _, group := range cfg.Groups {
+		if !group.matches(target) {
+			continue
+		}
+		if reason, ok := group.Rules.evaluate(addr); !ok {
+			return Decision{Allowed: false, Group: group.Name, Reason: reason}
+		}
+	}
+
+	return Decision{Allowed: true}
+}
+
+// evaluate는 규칙을 적용합니다
+func (r Rules) evaluate(addr netip.Addr) (string, bool) {
+	for _, p := range r.Deny {
+		if p.Contains(addr) {
+			return "denylist", false
+		}
+	}
+	if len(r.Allow) == 0 {
+		return "", true
+	}
+	for _, p := range r.Allow {
+		if p.Contains(addr) {
+			return "", true
+		}
+	}
+	return "not_allowlisted", false
+}
+
+// matches는 target이 그룹 접두사와 일치하는지 확인합니다
+func (g Group) matches(target string) bool {
+	for _, prefix := range g.Prefixes {
+		if strings.HasPrefix(target, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRules는 CIDR 또는 단일 IP 문자열 목록을 Rules로 변환합니다
+func ParseRules(allow, deny []string) (Rules, error) {
+	allowPrefixes, err := ParsePrefixes(allow)
+	if err != nil {
+		return Rules{}, fmt.Errorf("invalid allow rule: %w", err)
+	}
+	denyPrefixes, err := ParsePrefixes(deny)
+	if err != nil {
+		return Rules{}, fmt.Errorf("invalid deny rule: %w", err)
+	}
+	return Rules{Allow: allowPrefixes, Deny: denyPrefixes}, nil
+}
+
+// ParsePrefixes는 "10.0.0.0/8", "192.168.1.10", "::1" 형식의 문자열을 파싱합니다
+func ParsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if strings.Contains(v, "/") {
+			p, err := netip.ParsePrefix(v)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %w", v, err)
+			}
+			prefixes = append(prefixes, p.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", v, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// ParseAddr는 "ip" 또는 "ip:port" 형식의 원격 주소를 파싱합니다
+func ParseAddr(remote string) netip.Addr {
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	addr, err := netip.ParseAddr(remote)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.Unmap()
+}
@@ -0,0 +1,371 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidSchema는 스키마를 해석할 수 없거나 지원하지 않는 구성일 때 반환됩니다
+var ErrInvalidSchema = errors.New("jsonschema: invalid schema")
+
+// Schema는 컴파일된 JSON Schema입니다
+//
+// 지원 키워드: type, enum, const, properties, required, additionalProperties, patternProperties,
+// minProperties, maxProperties, items, minItems, maxItems, uniqueItems, minLength, maxLength, pattern,
+// format(date-time, date, email, uuid, uri, ipv4, ipv6), minimum, maximum, exclusiveMinimum, exclusiveMaximum,
+// multipleOf, allOf, anyOf, oneOf, not, 문서 내부 $ref(#, #/$defs/..., #/definitions/...)
+// 그 밖의 키워드(title, description, default 등)는 무시합니다
+type Schema struct {
+	root *node
+}
+
+// node는 스키마 하나(또는 하위 스키마)입니다
+type node struct {
+	always *bool // boolean 스키마 (true는 모든 값, false는 어떤 값도 허용하지 않음)
+
+	types    []string
+	enum     []interface{}
+	constant interface{}
+	hasConst bool
+
+	properties           map[string]*node
+	patternProperties    []patternProperty
+	additionalProperties *node
+	required             []string
+	minProperties        *int
+	maxProperties        *int
+
+	items       *node
+	minItems    *int
+	maxItems    *int
+	uniqueItems bool
+
+	minLength *int
+	maxLength *int
+	pattern   *regexp.Regexp
+	format    string
+
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum *float64
+	exclusiveMaximum *float64
+	multipleOf       *float64
+
+	allOf []*node
+	anyOf []*node
+	oneOf []*node
+	not   *node
+
+	ref    string
+	target *node // 해석된 $ref
+}
+
+// patternProperty는 이름이 정규식에 맞는 속성의 스키마입니다
+type patternProperty struct {
+	pattern *regexp.Regexp
+	schema  *node
+}
+
+// Compile은 JSON 형식의 스키마를 컴파일합니다
+func Compile(schemaJSON []byte) (*Schema, error) {
+	dec := json.NewDecoder(bytes.NewReader(schemaJSON))
+	dec.UseNumber()
+	var raw interface{}
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+
+	c := &compiler{refs: make(map[string]*node)}
+	root, err := c.compile(raw, "#")
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range c.pending {
+		target, ok := c.refs[n.ref]
+		if !ok {
+			return nil, fmt.Errorf("%w: unresolved $ref %q (only references inside the schema are supported)", ErrInvalidSchema, n.ref)
+		}
+		n.target = target
+	}
+	return &Schema{root: root}, nil
+}
+
+// compiler는 $ref 대상이 될 수 있는 위치의 스키마를 기억해 컴파일 후 참조를 해석합니다
+type compiler struct {
+	refs    map[string]*node
+	pending []*node
+}
+
+var simpleTypes = map[string]bool{
+	"null": true, "boolean": true, "object": true, "array": true, "number": true, "integer": true, "string": true,
+}
+
+func (c *compiler) compile(raw interface{}, location string) (*node, error) {
+	n := &node{}
+	c.refs[location] = n
+
+	if b, ok := raw.(bool); ok {
+		n.always = &b
+		return n, nil
+	}
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: schema must be an object or boolean", ErrInvalidSchema, location)
+	}
+
+	if ref, ok := obj["$ref"]; ok {
+		s, ok := ref.(string)
+		if !ok || !strings.HasPrefix(s, "#") {
+			return nil, fmt.Errorf("%w: %s: unsupported $ref %v", ErrInvalidSchema, location, ref)
+		}
+		n.ref = s
+		c.pending = append(c.pending, n)
+	}
+
+	for _, defs := range []string{"$defs", "definitions"} {
+		entries, ok := obj[defs].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, sub := range entries {
+			if _, err := c.compile(sub, location+"/"+defs+"/"+escapePointer(name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var err error
+	if n.types, err = schemaTypes(obj["type"], location); err != nil {
+		return nil, err
+	}
+	if v, ok := obj["enum"]; ok {
+		values, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: enum must be an array", ErrInvalidSchema, location)
+		}
+		n.enum = values
+	}
+	if v, ok := obj["const"]; ok {
+		n.constant, n.hasConst = v, true
+	}
+
+	if props, ok := obj["properties"].(map[string]interface{}); ok {
+		n.properties = make(map[string]*node, len(props))
+		for name, sub := range props {
+			if n.properties[name], err = c.compile(sub, location+"/properties/"+escapePointer(name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if props, ok := obj["patternProperties"].(map[string]interface{}); ok {
+		patterns := make([]string, 0, len(props))
+		for p := range props {
+			patterns = append(patterns, p)
+		}
+		sort.Strings(patterns)
+		for _, p := range patterns {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: invalid patternProperties %q: %v", ErrInvalidSchema, location, p, err)
+			}
+			sub, err := c.compile(props[p], location+"/patternProperties/"+escapePointer(p))
+			if err != nil {
+				return nil, err
+			}
+			n.patternProperties = append(n.patternProperties, patternProperty{pattern: re, schema: sub})
+		}
+	}
+	if v, ok := obj["additionalProperties"]; ok {
+		if n.additionalProperties, err = c.compile(v, location+"/additionalProperties"); err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := obj["required"]; ok {
+		names, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: required must be an array", ErrInvalidSchema, location)
+		}
+		for _, name := range names {
+			s, ok := name.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s: required must contain strings", ErrInvalidSchema, location)
+			}
+			n.required = append(n.required, s)
+		}
+	}
+
+	if v, ok := obj["items"]; ok {
+		if _, isTuple := v.([]interface{}); isTuple {
+			return nil, fmt.Errorf("%w: %s: tuple items are not supported", ErrInvalidSchema, location)
+		}
+		if n.items, err = c.compile(v, location+"/items"); err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := obj["uniqueItems"].(bool); ok {
+		n.uniqueItems = v
+	}
+
+	for keyword, target := range map[string]**int{
+		"minProperties": &n.minProperties,
+		"maxProperties": &n.maxProperties,
+		"minItems":      &n.minItems,
+		"maxItems":      &n.maxItems,
+		"minLength":     &n.minLength,
+		"maxLength":     &n.maxLength,
+	} {
+		if *target, err = intKeyword(obj, keyword, location); err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := obj["pattern"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: pattern must be a string", ErrInvalidSchema, location)
+		}
+		if n.pattern, err = regexp.Compile(s); err != nil {
+			return nil, fmt.Errorf("%w: %s: invalid pattern %q: %v", ErrInvalidSchema, location, s, err)
+		}
+	}
+	if v, ok := obj["format"].(string); ok {
+		n.format = v
+	}
+
+	for keyword, target := range map[string]**float64{
+		"minimum":    &n.minimum,
+		"maximum":    &n.maximum,
+		"multipleOf": &n.multipleOf,
+	} {
+		if *target, err = numberKeyword(obj, keyword, location); err != nil {
+			return nil, err
+		}
+	}
+	if err := n.compileExclusive(obj, location); err != nil {
+		return nil, err
+	}
+
+	for keyword, target := range map[string]*[]*node{
+		"allOf": &n.allOf,
+		"anyOf": &n.anyOf,
+		"oneOf": &n.oneOf,
+	} {
+		v, ok := obj[keyword]
+		if !ok {
+			continue
+		}
+		subs, ok := v.([]interface{})
+		if !ok || len(subs) == 0 {
+			return nil, fmt.Errorf("%w: %s: %s must be a non-empty array", ErrInvalidSchema, location, keyword)
+		}
+		for i, sub := range subs {
+			compiled, err := c.compile(sub, fmt.Sprintf("%s/%s/%d", location, keyword, i))
+			if err != nil {
+				return nil, err
+			}
+			*target = append(*target, compiled)
+		}
+	}
+	if v, ok := obj["not"]; ok {
+		if n.not, err = c.compile(v, location+"/not"); err != nil {
+			return nil, err
+		}
+	}
+
+	return n, nil
+}
+
+// compileExclusive는 exclusiveMinimum/exclusiveMaximum을 해석합니다
+// draft-04의 boolean 형식(minimum/maximum을 배타적으로 만듦)도 받아들입니다
+func (n *node) compileExclusive(obj map[string]interface{}, location string) error {
+	for _, k := range []struct {
+		keyword   string
+		inclusive **float64
+		exclusive **float64
+	}{
+		{"exclusiveMinimum", &n.minimum, &n.exclusiveMinimum},
+		{"exclusiveMaximum", &n.maximum, &n.exclusiveMaximum},
+	} {
+		v, ok := obj[k.keyword]
+		if !ok {
+			continue
+		}
+		if b, ok := v.(bool); ok {
+			if b && *k.inclusive != nil {
+				*k.exclusive, *k.inclusive = *k.inclusive, nil
+			}
+			continue
+		}
+		f, ok := toFloat(v)
+		if !ok {
+			return fmt.Errorf("%w: %s: %s must be a number", ErrInvalidSchema, location, k.keyword)
+		}
+		*k.exclusive = &f
+	}
+	return nil
+}
+
+// schemaTypes는 type 키워드(문자열 또는 문자열 배열)를 해석합니다
+func schemaTypes(v interface{}, location string) ([]string, error) {
+	var names []interface{}
+	switch t := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		names = []interface{}{t}
+	case []interface{}:
+		names = t
+	default:
+		return nil, fmt.Errorf("%w: %s: type must be a string or an array", ErrInvalidSchema, location)
+	}
+
+	types := make([]string, 0, len(names))
+	for _, name := range names {
+		s, ok := name.(string)
+		if !ok || !simpleTypes[s] {
+			return nil, fmt.Errorf("%w: %s: unknown type %v", ErrInvalidSchema, location, name)
+		}
+		types = append(types, s)
+	}
+	return types, nil
+}
+
+// intKeyword는 음이 아닌 정수 키워드를 해석합니다 (없으면 nil)
+func intKeyword(obj map[string]interface{}, keyword, location string) (*int, error) {
+	v, ok := obj[keyword]
+	if !ok {
+		return nil, nil
+	}
+	f, ok := toFloat(v)
+	if !ok || f < 0 || f != float64(int(f)) {
+		return nil, fmt.Errorf("%w: %s: %s must be a non-negative integer", ErrInvalidSchema, location, keyword)
+	}
+	i := int(f)
+	return &i, nil
+}
+
+// numberKeyword는 숫자 키워드를 해석합니다 (없으면 nil)
+func numberKeyword(obj map[string]interface{}, keyword, location string) (*float64, error) {
+	v, ok := obj[keyword]
+	if !ok {
+		return nil, nil
+	}
+	f, ok := toFloat(v)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: %s must be a number", ErrInvalidSchema, location, keyword)
+	}
+	if keyword == "multipleOf" && f <= 0 {
+		return nil, fmt.Errorf("%w: %s: multipleOf must be greater than 0", ErrInvalidSchema, location)
+	}
+	return &f, nil
+}
+
+// escapePointer는 JSON Pointer 토큰을 이스케이프합니다 (RFC 6901)
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,348 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Violation은 스키마 위반 하나입니다
+type Violation struct {
+	Path    string `json:"path"`    // 위반한 값의 JSON Pointer (RFC 6901, 문서 전체는 "")
+	Keyword string `json:"keyword"` // 위반한 키워드 (required, type, maxLength 등)
+	Message string `json:"message"`
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Validate는 값을 검증하고 위반 목록을 반환합니다 (통과하면 nil)
+// 값은 JSON으로 직렬화한 결과를 검증하므로 time.Time, 정수 타입, 이름 있는 map 타입 등도 JSON과 같은 방식으로 다룹니다
+func (s *Schema) Validate(value interface{}) ([]Violation, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("jsonschema: failed to encode value: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var instance interface{}
+	if err := dec.Decode(&instance); err != nil {
+		return nil, fmt.Errorf("jsonschema: failed to decode value: %w", err)
+	}
+
+	var violations []Violation
+	s.root.validate(instance, "", &violations)
+	return violations, nil
+}
+
+func (n *node) validate(value interface{}, path string, out *[]Violation) {
+	report := func(keyword, format string, args ...interface{}) {
+		*out = append(*out, Violation{Path: path, Keyword: keyword, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if n.always != nil {
+		if !*n.always {
+			report("false", "no value is allowed here")
+		}
+		return
+	}
+	if n.target != nil {
+		n.target.validate(value, path, out)
+	}
+
+	if len(n.types) > 0 && !matchesType(value, n.types) {
+		report("type", "must be %s, got %s", strings.Join(n.types, " or "), typeOf(value))
+		return
+	}
+	if n.enum != nil {
+		found := false
+		for _, candidate := range n.enum {
+			if equal(value, candidate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report("enum", "must be one of the allowed values")
+		}
+	}
+	if n.hasConst && !equal(value, n.constant) {
+		report("const", "must be equal to the constant value")
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		n.validateObject(v, path, out, report)
+	case []interface{}:
+		n.validateArray(v, path, out, report)
+	case string:
+		n.validateString(v, report)
+	case json.Number:
+		n.validateNumber(v, report)
+	}
+
+	for _, sub := range n.allOf {
+		sub.validate(value, path, out)
+	}
+	if len(n.anyOf) > 0 {
+		matched := false
+		for _, sub := range n.anyOf {
+			if sub.valid(value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			report("anyOf", "must match at least one of the schemas")
+		}
+	}
+	if len(n.oneOf) > 0 {
+		matched := 0
+		for _, sub := range n.oneOf {
+			if sub.valid(value) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			report("oneOf", "must match exactly one of the schemas, matched %d", matched)
+		}
+	}
+	if n.not != nil && n.not.valid(value) {
+		report("not", "must not match the schema")
+	}
+}
+
+// valid는 위반 없이 통과하는지 반환합니다 (anyOf, oneOf, not 판정용)
+func (n *node) valid(value interface{}) bool {
+	var violations []Violation
+	n.validate(value, "", &violations)
+	return len(violations) == 0
+}
+
+func (n *node) validateObject(obj map[string]interface{}, path string, out *[]Violation, report func(string, string, ...interface{})) {
+	for _, name := range n.required {
+		if _, ok := obj[name]; !ok {
+			*out = append(*out, Violation{Path: path + "/" + escapePointer(name), Keyword: "required", Message: "is required"})
+		}
+	}
+	if n.minProperties != nil && len(obj) < *n.minProperties {
+		report("minProperties", "must have at least %d properties", *n.minProperties)
+	}
+	if n.maxProperties != nil && len(obj) > *n.maxProperties {
+		report("maxProperties", "must have at most %d properties", *n.maxProperties)
+	}
+
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := obj[name]
+		childPath := path + "/" + escapePointer(name)
+
+		matched := false
+		if sub, ok := n.properties[name]; ok {
+			sub.validate(value, childPath, out)
+			matched = true
+		}
+		for _, pp := range n.patternProperties {
+			if pp.pattern.MatchString(name) {
+				pp.schema.validate(value, childPath, out)
+				matched = true
+			}
+		}
+		if !matched && n.additionalProperties != nil {
+			if n.additionalProperties.always != nil && !*n.additionalProperties.always {
+				*out = append(*out, Violation{Path: childPath, Keyword: "additionalProperties", Message: "is not allowed"})
+				continue
+			}
+			n.additionalProperties.validate(value, childPath, out)
+		}
+	}
+}
+
+func (n *node) validateArray(items []interface{}, path string, out *[]Violation, report func(string, string, ...interface{})) {
+	if n.minItems != nil && len(items) < *n.minItems {
+		report("minItems", "must have at least %d items", *n.minItems)
+	}
+	if n.maxItems != nil && len(items) > *n.maxItems {
+		report("maxItems", "must have at most %d items", *n.maxItems)
+	}
+	if n.uniqueItems {
+	unique:
+		for i := range items {
+			for j := 0; j < i; j++ {
+				if equal(items[i], items[j]) {
+					report("uniqueItems", "items at %d and %d must not be equal", j, i)
+					break unique
+				}
+			}
+		}
+	}
+	if n.items != nil {
+		for i, item := range items {
+			n.items.validate(item, fmt.Sprintf("%s/%d", path, i), out)
+		}
+	}
+}
+
+func (n *node) validateString(s string, report func(string, string, ...interface{})) {
+	length := utf8.RuneCountInString(s)
+	if n.minLength != nil && length < *n.minLength {
+		report("minLength", "must be at least %d characters", *n.minLength)
+	}
+	if n.maxLength != nil && length > *n.maxLength {
+		report("maxLength", "must be at most %d characters", *n.maxLength)
+	}
+	if n.pattern != nil && !n.pattern.MatchString(s) {
+		report("pattern", "must match pattern %s", n.pattern.String())
+	}
+	if n.format != "" && !validFormat(n.format, s) {
+		report("format", "must be a valid %s", n.format)
+	}
+}
+
+func (n *node) validateNumber(num json.Number, report func(string, string, ...interface{})) {
+	f, err := num.Float64()
+	if err != nil {
+		return
+	}
+	if n.minimum != nil && f < *n.minimum {
+		report("minimum", "must be >= %v", *n.minimum)
+	}
+	if n.maximum != nil && f > *n.maximum {
+		report("maximum", "must be <= %v", *n.maximum)
+	}
+	if n.exclusiveMinimum != nil && f <= *n.exclusiveMinimum {
+		report("exclusiveMinimum", "must be > %v", *n.exclusiveMinimum)
+	}
+	if n.exclusiveMaximum != nil && f >= *n.exclusiveMaximum {
+		report("exclusiveMaximum", "must be < %v", *n.exclusiveMaximum)
+	}
+	if n.multipleOf != nil {
+		q := f / *n.multipleOf
+		if math.Abs(q-math.Round(q)) > 1e-9 {
+			report("multipleOf", "must be a multiple of %v", *n.multipleOf)
+		}
+	}
+}
+
+// validFormat은 지원하는 format을 검사합니다 (모르는 format은 통과)
+func validFormat(format, s string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", s)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(s)
+		return err == nil && addr.Address == s
+	case "uuid":
+		return uuidPattern.MatchString(s)
+	case "uri":
+		u, err := url.Parse(s)
+		return err == nil && u.Scheme != ""
+	case "ipv4":
+		ip := net.ParseIP(s)
+		return ip != nil && ip.To4() != nil && !strings.Contains(s, ":")
+	case "ipv6":
+		ip := net.ParseIP(s)
+		return ip != nil && strings.Contains(s, ":")
+	default:
+		return true
+	}
+}
+
+// matchesType은 값이 types 중 하나인지 확인합니다 (integer는 소수부가 없는 number)
+func matchesType(value interface{}, types []string) bool {
+	actual := typeOf(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// typeOf는 JSON 디코딩 결과의 JSON Schema 타입 이름을 반환합니다
+func typeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		if f, err := v.Float64(); err == nil && f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return "integer"
+		}
+		return "number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// equal은 두 JSON 값이 같은지 비교합니다 (숫자는 값으로 비교)
+func equal(a, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		af, ok := toFloat(av)
+		bf, ok2 := toFloat(b)
+		return ok && ok2 && af == bf
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			other, ok := bv[k]
+			if !ok || !equal(v, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !equal(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// toFloat은 스키마와 값의 숫자(json.Number)를 float64로 변환합니다
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
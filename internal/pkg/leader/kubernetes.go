@@ -0,0 +1,44 @@
+package leader
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// namespaceFile은 파드에 마운트되는 서비스 어카운트 네임스페이스 파일입니다
+const namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// KubernetesConfig는 Lease를 보관할 Kubernetes 클러스터 설정입니다
+type KubernetesConfig struct {
+	Namespace string // 비어 있으면 POD_NAMESPACE, 그것도 없으면 서비스 어카운트 네임스페이스
+}
+
+// NewKubernetesClient는 클러스터 내부 설정(서비스 어카운트)으로 클라이언트를 만들고 Lease 네임스페이스를 결정합니다
+// 서비스 어카운트에 coordination.k8s.io leases get/create/update 권한이 필요합니다
+func NewKubernetesClient(cfg KubernetesConfig) (kubernetes.Interface, string, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load in-cluster kubernetes config: %w", err)
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = os.Getenv("POD_NAMESPACE")
+	}
+	if namespace == "" {
+		data, err := os.ReadFile(namespaceFile)
+		if err != nil {
+			return nil, "", fmt.Errorf("kubernetes namespace is not configured: %w", err)
+		}
+		namespace = strings.TrimSpace(string(data))
+	}
+	return client, namespace, nil
+}
@@ -0,0 +1,187 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// Config는 리더 선출 설정입니다
+type Config struct {
+	Name          string        // 리스 이름 (같은 작업을 실행하는 인스턴스끼리 같아야 함)
+	Identity      string        // 이 인스턴스의 ID
+	LeaseDuration time.Duration // 리더가 갱신하지 않을 때 다른 인스턴스가 기다리는 시간 (기본 15s)
+	RenewDeadline time.Duration // 리더가 갱신에 계속 실패하면 리더십을 내려놓는 시간 (기본 10s)
+	RetryPeriod   time.Duration // 획득/갱신 시도 간격 (기본 2s)
+}
+
+// Elector는 Kubernetes Lease로 여러 인스턴스 중 하나만 작업을 실행하도록 하는 리더 선출기입니다
+//
+// 선출과 갱신은 client-go leaderelection(resourcelock.LeaseLock)이 담당합니다
+// 리더는 RenewDeadline 안에 갱신하지 못하면 작업을 멈추며, RenewDeadline이 LeaseDuration보다 짧아 다른 인스턴스가 이어받기 전에 멈춥니다
+type Elector struct {
+	lock    *resourcelock.LeaseLock
+	config  Config
+	leading atomic.Bool
+}
+
+// NewElector는 namespace의 Lease를 사용하는 새로운 Elector를 생성합니다
+func NewElector(client kubernetes.Interface, namespace string, cfg Config) (*Elector, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("lease name is required")
+	}
+	if cfg.Identity == "" {
+		return nil, fmt.Errorf("leader identity is required")
+	}
+	if cfg.LeaseDuration <= 0 {
+		cfg.LeaseDuration = 15 * time.Second
+	}
+	if cfg.RenewDeadline <= 0 {
+		cfg.RenewDeadline = 10 * time.Second
+	}
+	if cfg.RetryPeriod <= 0 {
+		cfg.RetryPeriod = 2 * time.Second
+	}
+	if cfg.RenewDeadline >= cfg.LeaseDuration {
+		return nil, fmt.Errorf("renew deadline (%s) must be shorter than lease duration (%s)", cfg.RenewDeadline, cfg.LeaseDuration)
+	}
+	// client-go는 갱신 간격에 지터(JitterFactor)를 더하므로 그만큼 여유가 있어야 합니다
+	if cfg.RenewDeadline <= time.Duration(leaderelection.JitterFactor*float64(cfg.RetryPeriod)) {
+		return nil, fmt.Errorf("renew deadline (%s) must be longer than %.1f x retry period (%s)", cfg.RenewDeadline, leaderelection.JitterFactor, cfg.RetryPeriod)
+	}
+	return &Elector{
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Name: cfg.Name, Namespace: namespace},
+			Client:     client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: cfg.Identity},
+		},
+		config: cfg,
+	}, nil
+}
+
+// IsLeader는 이 인스턴스가 지금 리더인지 확인합니다
+func (e *Elector) IsLeader() bool {
+	return e.leading.Load()
+}
+
+// Run은 리스를 얻을 때까지 기다린 뒤 work를 실행합니다
+// 리더십을 잃거나 ctx가 취소되면 work의 ctx를 취소하고 work가 끝나기를 기다려 반환합니다
+// ctx 취소나 work 종료로 끝나면 work가 끝난 뒤 리스를 반납해 다른 인스턴스가 바로 이어받게 합니다. 다시 선출에 참여하려면 Run을 다시 호출합니다
+func (e *Elector) Run(ctx context.Context, work func(ctx context.Context)) {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// client-go는 OnStartedLeading을 고루틴으로 실행하고 기다리지 않으므로 work 종료를 직접 기다립니다
+	var (
+		mu       sync.Mutex
+		stopped  bool
+		acquired bool
+		working  sync.WaitGroup
+		finished atomic.Bool
+	)
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          e.lock,
+		Name:          e.config.Name,
+		LeaseDuration: e.config.LeaseDuration,
+		RenewDeadline: e.config.RenewDeadline,
+		RetryPeriod:   e.config.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				mu.Lock()
+				if stopped {
+					mu.Unlock()
+					return
+				}
+				acquired = true
+				working.Add(1)
+				mu.Unlock()
+				defer working.Done()
+
+				logger.Info(ctx, "leader lease acquired",
+					zap.String("lease", e.config.Name),
+					zap.String("identity", e.config.Identity),
+				)
+				e.leading.Store(true)
+				defer e.leading.Store(false)
+				work(leaderCtx)
+
+				// work가 먼저 끝나면 선출을 멈춥니다
+				finished.Store(true)
+				cancel()
+			},
+			OnStoppedLeading: func() {},
+			OnNewLeader: func(identity string) {
+				if identity != e.config.Identity {
+					logger.Info(ctx, "waiting for leader lease",
+						zap.String("lease", e.config.Name),
+						zap.String("holder", identity),
+					)
+				}
+			},
+		},
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to create leader elector", zap.String("lease", e.config.Name), zap.Error(err))
+		return
+	}
+	elector.Run(runCtx)
+
+	mu.Lock()
+	stopped = true
+	mu.Unlock()
+	working.Wait()
+
+	if acquired && ctx.Err() == nil && !finished.Load() {
+		logger.Warn(ctx, "leader lease lost, stopping work", zap.String("lease", e.config.Name))
+	}
+	e.release(ctx)
+}
+
+// release는 work가 끝난 뒤 리스를 반납합니다 (이미 다른 인스턴스가 가져갔으면 그대로 둡니다)
+// client-go의 ReleaseOnCancel은 work가 끝나기 전에 반납하므로 사용하지 않습니다
+func (e *Elector) release(ctx context.Context) {
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), e.config.RenewDeadline)
+	defer cancel()
+
+	record, _, err := e.lock.Get(releaseCtx)
+	if err != nil || record.HolderIdentity != e.config.Identity {
+		return
+	}
+	record.HolderIdentity = ""
+	record.LeaseDurationSeconds = 1
+	record.RenewTime = metav1.NewTime(time.Now())
+	if err := e.lock.Update(releaseCtx, *record); err != nil {
+		logger.Warn(ctx, "failed to release leader lease", zap.String("lease", e.config.Name), zap.Error(err))
+		return
+	}
+	logger.Info(ctx, "leader lease released", zap.String("lease", e.config.Name))
+}
+
+// LeaseName은 이름을 Kubernetes 리소스 이름 규칙(소문자, 숫자, '-', '.', 최대 253자)에 맞게 바꿉니다
+func LeaseName(name string) string {
+	name = strings.ToLower(name)
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('-')
+		}
+	}
+	result := strings.Trim(b.String(), "-.")
+	if len(result) > 253 {
+		result = strings.Trim(result[:253], "-.")
+	}
+	return result
+}
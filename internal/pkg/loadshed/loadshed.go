@@ -0,0 +1,147 @@
+// Package loadshed는 응답 지연 변화에 따라 동시 처리 한도를 조정하는 적응형 동시성 제한기를 제공합니다
+//
+// 한도는 gradient 방식으로 조정됩니다. 장기 평균 응답 시간 대비 최근 응답 시간이 늘어나면(데이터베이스 포화의 신호)
+// 한도를 줄이고, 응답 시간이 안정적이면 대기열 여유분(한도의 제곱근)만큼 늘립니다.
+// 한도를 넘는 요청은 대기하지 않고 바로 거부되어, 데이터베이스가 느려질 때 요청이 쌓여 장애로 번지지 않습니다
+package loadshed
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Config는 동시성 제한기 설정입니다 (0이면 기본값)
+type Config struct {
+	InitialLimit int     // 시작 한도 (기본 20)
+	MinLimit     int     // 최소 한도 (기본 4)
+	MaxLimit     int     // 최대 한도 (기본 1000)
+	Smoothing    float64 // 새 한도를 반영하는 비율 0~1 (기본 0.2)
+	Tolerance    float64 // 장기 평균 대비 허용하는 응답 시간 증가 배율 (기본 1.5)
+	LongWindow   int     // 장기 평균 응답 시간의 샘플 수 (기본 600)
+}
+
+// withDefaults는 비어 있는 값을 기본값으로 채웁니다
+func (c Config) withDefaults() Config {
+	if c.MinLimit <= 0 {
+		c.MinLimit = 4
+	}
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = 1000
+	}
+	if c.InitialLimit <= 0 {
+		c.InitialLimit = 20
+	}
+	c.InitialLimit = min(max(c.InitialLimit, c.MinLimit), c.MaxLimit)
+	if c.Smoothing <= 0 || c.Smoothing > 1 {
+		c.Smoothing = 0.2
+	}
+	if c.Tolerance < 1 {
+		c.Tolerance = 1.5
+	}
+	if c.LongWindow <= 0 {
+		c.LongWindow = 600
+	}
+	return c
+}
+
+// Limiter는 적응형 동시성 제한기입니다
+type Limiter struct {
+	cfg Config
+
+	mu       sync.Mutex
+	limit    float64
+	inFlight int
+	longRTT  float64 // 장기 평균 응답 시간 (지수 이동 평균, 나노초)
+}
+
+// New는 새로운 Limiter를 생성합니다
+func New(cfg Config) *Limiter {
+	cfg = cfg.withDefaults()
+	return &Limiter{
+		cfg:   cfg,
+		limit: float64(cfg.InitialLimit),
+	}
+}
+
+// Token은 허용된 요청 하나입니다 (요청이 끝나면 Done을 호출해야 합니다)
+type Token struct {
+	limiter *Limiter
+	start   time.Time
+}
+
+// Acquire는 동시 처리 한도 안이면 요청을 허용합니다
+// 한도를 넘으면 false를 반환하며, 호출자는 요청을 거부해야 합니다 (HTTP 503, gRPC RESOURCE_EXHAUSTED)
+func (l *Limiter) Acquire() (*Token, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if float64(l.inFlight) >= l.limit {
+		return nil, false
+	}
+	l.inFlight++
+	return &Token{limiter: l, start: time.Now()}, true
+}
+
+// Done은 요청 완료를 기록하고 응답 시간으로 한도를 조정합니다
+// dropped는 과부하로 실패한 요청(시간 초과, 백엔드 사용 불가 등)이며, 이때는 한도를 바로 줄입니다
+func (t *Token) Done(dropped bool) {
+	t.limiter.release(time.Since(t.start), dropped)
+}
+
+// release는 처리 중인 요청 수를 줄이고 한도를 갱신합니다
+func (l *Limiter) release(rtt time.Duration, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	inFlight := l.inFlight
+	l.inFlight--
+
+	if dropped {
+		l.setLimit(l.limit * 0.9)
+		return
+	}
+
+	sample := float64(rtt)
+	if sample <= 0 {
+		return
+	}
+	if l.longRTT == 0 {
+		l.longRTT = sample
+	} else {
+		alpha := 2 / (float64(l.cfg.LongWindow) + 1)
+		l.longRTT += (sample - l.longRTT) * alpha
+	}
+	// 부하가 오래 이어져 장기 평균이 최근 값보다 훨씬 커지면 빨리 따라오도록 줄입니다
+	if l.longRTT/sample > 2 {
+		l.longRTT *= 0.95
+	}
+
+	gradient := math.Max(0.5, math.Min(1, l.cfg.Tolerance*l.longRTT/sample))
+	next := l.limit*gradient + math.Sqrt(l.limit)
+
+	// 한도의 절반도 사용하지 않는 동안에는 응답 시간이 한도의 영향을 받지 않으므로 한도를 늘리지 않습니다
+	if next > l.limit && float64(inFlight) < l.limit/2 {
+		return
+	}
+	l.setLimit(l.limit*(1-l.cfg.Smoothing) + next*l.cfg.Smoothing)
+}
+
+// setLimit은 한도를 최소/최대 범위로 제한해 설정합니다
+func (l *Limiter) setLimit(limit float64) {
+	l.limit = math.Max(float64(l.cfg.MinLimit), math.Min(float64(l.cfg.MaxLimit), limit))
+}
+
+// Limit은 현재 동시 처리 한도를 반환합니다
+func (l *Limiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// InFlight는 처리 중인 요청 수를 반환합니다
+func (l *Limiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
@@ -0,0 +1,190 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Policy는 인증 실패 잠금 정책입니다
+type Policy struct {
+	// MaxFailures는 Window 안에서 잠금이 걸리는 연속 실패 횟수입니다
+	MaxFailures int64
+
+	// Window는 실패 횟수를 집계하는 기간입니다 (마지막 잠금 또는 첫 실패 기준)
+	Window time.Duration
+
+	// BaseDuration은 첫 잠금 시간이며, 잠금이 반복될 때마다 두 배로 늘어납니다
+	BaseDuration time.Duration
+
+	// MaxDuration은 잠금 시간 상한입니다
+	MaxDuration time.Duration
+
+	// LevelTTL 동안 잠금이 다시 발생하지 않으면 잠금 단계를 초기화합니다
+	LevelTTL time.Duration
+}
+
+// DefaultPolicy는 기본 잠금 정책을 반환합니다
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxFailures:  5,
+		Window:       15 * time.Minute,
+		BaseDuration: time.Minute,
+		MaxDuration:  time.Hour,
+		LevelTTL:     24 * time.Hour,
+	}
+}
+
+// Validate는 정책 값을 검증합니다
+func (p Policy) Validate() error {
+	if p.MaxFailures <= 0 {
+		return fmt.Errorf("lockout max failures must be positive")
+	}
+	if p.Window <= 0 || p.BaseDuration <= 0 || p.LevelTTL <= 0 {
+		return fmt.Errorf("lockout window, base duration and level ttl must be positive")
+	}
+	if p.MaxDuration < p.BaseDuration {
+		return fmt.Errorf("lockout max duration must not be less than base duration")
+	}
+	return nil
+}
+
+// Duration은 level번째 잠금의 잠금 시간을 반환합니다 (BaseDuration * 2^(level-1), MaxDuration 상한)
+func (p Policy) Duration(level int64) time.Duration {
+	if level < 1 {
+		level = 1
+	}
+	d := p.BaseDuration
+	for i := int64(1); i < level; i++ {
+		if d >= p.MaxDuration/2 {
+			return p.MaxDuration
+		}
+		d *= 2
+	}
+	if d > p.MaxDuration {
+		return p.MaxDuration
+	}
+	return d
+}
+
+// Failure는 실패 기록 결과입니다
+type Failure struct {
+	Failures int64
+	Level    int64
+	Tripped  bool
+}
+
+// Store는 실패 횟수와 잠금 상태를 보관하는 분산 저장소 인터페이스입니다
+type Store interface {
+	// LockedFor는 잠금 남은 시간을 반환합니다 (잠금이 없으면 0)
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+
+	// RecordFailure는 실패를 집계하고, MaxFailures에 도달하면 잠금 단계를 올리고 집계를 초기화합니다
+	RecordFailure(ctx context.Context, key string, policy Policy) (*Failure, error)
+
+	// Lock은 키를 duration 동안 잠급니다
+	Lock(ctx context.Context, key string, duration time.Duration) error
+}
+
+// Event는 잠금이 발생했을 때의 보안 이벤트입니다
+type Event struct {
+	Key       string
+	Source    string
+	Failures  int64
+	Level     int64
+	LockedFor time.Duration
+}
+
+// Guard는 인증 실패를 키(API 키 ID, 클라이언트 IP 등)별로 집계해 무차별 대입 공격을 차단합니다
+// 저장소 장애 시에는 인증 자체를 막지 않도록 허용(fail-open)하고 에러 로그만 남깁니다
+type Guard struct {
+	store     Store
+	policy    Policy
+	onLockout func(ctx context.Context, event Event)
+}
+
+// NewGuard는 새로운 Guard를 생성합니다
+func NewGuard(store Store, policy Policy) (*Guard, error) {
+	if store == nil {
+		return nil, fmt.Errorf("lockout store is required")
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &Guard{store: store, policy: policy}, nil
+}
+
+// OnLockout은 잠금 발생 시 호출할 함수를 설정합니다 (감사 로그 기록 등)
+func (g *Guard) OnLockout(fn func(ctx context.Context, event Event)) {
+	g.onLockout = fn
+}
+
+// Check는 키 중 하나라도 잠겨 있으면 가장 긴 남은 시간과 true를 반환합니다
+func (g *Guard) Check(ctx context.Context, keys ...string) (time.Duration, bool) {
+	var longest time.Duration
+	for _, key := range keys {
+		remaining, err := g.store.LockedFor(ctx, key)
+		if err != nil {
+			logger.Error(ctx, "failed to check auth lockout", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if remaining > longest {
+			longest = remaining
+		}
+	}
+	return longest, longest > 0
+}
+
+// Fail은 키별로 인증 실패를 기록하고, 임계치에 도달한 키를 지수적으로 늘어나는 시간 동안 잠급니다
+func (g *Guard) Fail(ctx context.Context, source string, keys ...string) {
+	for _, key := range keys {
+		failure, err := g.store.RecordFailure(ctx, key, g.policy)
+		if err != nil {
+			logger.Error(ctx, "failed to record auth failure", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if !failure.Tripped {
+			continue
+		}
+
+		duration := g.policy.Duration(failure.Level)
+		if err := g.store.Lock(ctx, key, duration); err != nil {
+			logger.Error(ctx, "failed to apply auth lockout", zap.String("key", key), zap.Error(err))
+			continue
+		}
+
+		event := Event{
+			Key:       key,
+			Source:    source,
+			Failures:  failure.Failures,
+			Level:     failure.Level,
+			LockedFor: duration,
+		}
+		logger.Warn(ctx, "authentication locked out after repeated failures",
+			zap.String("key", key),
+			zap.String("source", source),
+			zap.Int64("level", failure.Level),
+			zap.Duration("locked_for", duration),
+		)
+		if g.onLockout != nil {
+			g.onLockout(ctx, event)
+		}
+	}
+}
+
+// Keys는 요청의 잠금 키 목록을 생성합니다 (클라이언트 IP, 그리고 API 키 ID는 클라이언트 IP별로 집계)
+// 키 ID는 검증 전의 요청 값이므로 IP 없이 집계하면 누구나 다른 곳에서 실패를 보내 실제 키를 잠글 수 있습니다
+// clientIP는 위조할 수 없는 값(신뢰할 프록시를 거친 주소 또는 연결 주소)이어야 합니다
+func Keys(keyID, clientIP string) []string {
+	keys := make([]string, 0, 2)
+	if keyID != "" && clientIP != "" {
+		keys = append(keys, "key:"+keyID+"@"+clientIP)
+	}
+	if clientIP != "" {
+		keys = append(keys, "ip:"+clientIP)
+	}
+	return keys
+}
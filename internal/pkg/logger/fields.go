@@ -179,6 +179,11 @@ func Any(key string, value interface{}) zap.Field {
 	return zap.Any(key, value)
 }
 
+// Field는 키와 값으로 필드를 반환합니다 (값의 타입에 맞는 인코딩을 사용)
+func Field(key string, value interface{}) zap.Field {
+	return zap.Any(key, value)
+}
+
 // Struct는 구조체를 JSON으로 직렬화한 필드를 반환합니다
 func Struct(key string, value interface{}) zap.Field {
 	return zap.Object(key, zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
@@ -11,11 +11,15 @@ import (
 type contextKey string
 
 const (
-	loggerKey contextKey = "logger"
+	loggerKey    contextKey = "logger"
+	requestIDKey contextKey = "request_id"
 )
 
 var globalLogger *zap.Logger
 
+// globalLevel은 글로벌 로거의 레벨입니다 (SetLevel로 실행 중에 바꿀 수 있음)
+var globalLevel = zap.NewAtomicLevel()
+
 // Config는 로거 설정입니다
 type Config struct {
 	Environment string
@@ -55,6 +59,8 @@ func Init(cfg Config) error {
 			config.Level = zap.NewAtomicLevelAt(level)
 		}
 	}
+	globalLevel.SetLevel(config.Level.Level())
+	config.Level = globalLevel
 
 	logger, err := config.Build(
 		zap.AddCallerSkip(1),
@@ -90,6 +96,22 @@ func Init(cfg Config) error {
 	return nil
 }
 
+// SetLevel은 실행 중에 글로벌 로거의 레벨을 바꿉니다 (debug, info, warn, error)
+// 컨텍스트에 추가된 로거도 글로벌 로거에서 만들어지므로 함께 적용됩니다
+func SetLevel(level string) error {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	globalLevel.SetLevel(parsed)
+	return nil
+}
+
+// Level은 글로벌 로거의 현재 레벨을 반환합니다
+func Level() string {
+	return globalLevel.String()
+}
+
 // GetLogger는 컨텍스트에서 로거를 가져오거나 글로벌 로거를 반환합니다
 func GetLogger(ctx context.Context) *zap.Logger {
 	if ctx != nil {
@@ -117,6 +139,23 @@ func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
 	return WithLogger(ctx, logger)
 }
 
+// WithRequestID는 컨텍스트에 요청 상관관계 ID를 저장합니다
+// 로그 필드와 별개로 감사 기록 등 하위 계층에서 조회할 수 있도록 보관합니다
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext는 컨텍스트의 요청 상관관계 ID를 반환합니다
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
 // Info는 info 레벨 로그를 출력합니다
 // 일반적인 정보성 메시지 (요청 처리, 작업 완료 등)
 func Info(ctx context.Context, msg string, fields ...zap.Field) {
@@ -25,8 +25,86 @@ type Metrics struct {
 	DBConnectionsActive prometheus.Gauge
 
 	// 캐시 메트릭
-	CacheHitsTotal   *prometheus.CounterVec
-	CacheMissesTotal *prometheus.CounterVec
+	CacheHitsTotal         *prometheus.CounterVec
+	CacheMissesTotal       *prometheus.CounterVec
+	CacheErrorsTotal       *prometheus.CounterVec
+	CacheOperationDuration *prometheus.HistogramVec
+
+	// 읽기 합치기 메트릭
+	CoalescedReadsTotal *prometheus.CounterVec
+
+	// 부하 차단 메트릭
+	LoadShedLimit         *prometheus.GaugeVec
+	LoadShedInFlight      *prometheus.GaugeVec
+	LoadShedRejectedTotal *prometheus.CounterVec
+
+	// 재시도 메트릭
+	RetryBudgetExhaustedTotal *prometheus.CounterVec
+
+	// 작업 시간 한도 메트릭
+	OperationTimeoutsTotal *prometheus.CounterVec
+
+	// Circuit breaker 메트릭
+	CircuitBreakerState            *prometheus.GaugeVec
+	CircuitBreakerTransitionsTotal *prometheus.CounterVec
+
+	// 개인정보 탐지 메트릭
+	PIIDetectionsTotal *prometheus.CounterVec
+
+	// JSON Schema 검증 메트릭
+	SchemaRejectsTotal *prometheus.CounterVec
+
+	// 문서 만료 정리 메트릭
+	DocumentsExpiredTotal *prometheus.CounterVec
+
+	// 소프트 삭제 영구 삭제 메트릭
+	DocumentsPurgedTotal *prometheus.CounterVec
+
+	// 보존 정책 정리 메트릭
+	RetentionDocumentsTotal *prometheus.CounterVec
+
+	// 콜드 데이터 보관 메트릭
+	DocumentsArchivedTotal   *prometheus.CounterVec
+	DocumentsRehydratedTotal *prometheus.CounterVec
+
+	// 컬렉션 유지보수 메트릭
+	MaintenanceRunsTotal       *prometheus.CounterVec
+	MaintenanceDurationSeconds *prometheus.HistogramVec
+
+	// 검색 미러 일관성 메트릭
+	SearchMirrorDriftDocuments *prometheus.GaugeVec
+	SearchMirrorRepairedTotal  *prometheus.CounterVec
+	SearchMirrorSyncTotal      *prometheus.CounterVec
+
+	// Kafka 프로듀서 메트릭
+	KafkaProduceErrorsTotal *prometheus.CounterVec
+
+	// 교차 클러스터 복제 메트릭
+	ReplicationEventsTotal *prometheus.CounterVec
+	ReplicationLagSeconds  prometheus.Gauge
+
+	// 변경 스트림 브리지 메트릭
+	CDCBridgeEventsTotal *prometheus.CounterVec
+	CDCBridgeLagSeconds  prometheus.Gauge
+
+	// 웹훅 전송 메트릭
+	WebhookDeliveriesTotal  *prometheus.CounterVec
+	WebhookDeliveryDuration prometheus.Histogram
+
+	// 저장 용량 쿼터 메트릭
+	StorageQuotaUsage           *prometheus.GaugeVec
+	StorageQuotaLimit           *prometheus.GaugeVec
+	StorageQuotaRejectionsTotal *prometheus.CounterVec
+
+	// 연결 풀 메트릭 (드라이버 누적 값을 주기적으로 반영하므로 모두 게이지)
+	DBPoolConnections         *prometheus.GaugeVec
+	DBPoolMaxConnections      *prometheus.GaugeVec
+	DBPoolWaitCount           *prometheus.GaugeVec
+	DBPoolWaitDurationSeconds *prometheus.GaugeVec
+	DBPoolTimeouts            *prometheus.GaugeVec
+	DBPoolConnectErrors       *prometheus.GaugeVec
+	DBPoolEvictionsTotal      *prometheus.CounterVec
+	DBPoolValidationFailures  *prometheus.CounterVec
 
 	// 시스템 메트릭
 	GoroutinesActive prometheus.Gauge
@@ -119,7 +197,7 @@ func Init(namespace string) *Metrics {
 				Name:      "cache_hits_total",
 				Help:      "Total number of cache hits",
 			},
-			[]string{"cache_name"},
+			[]string{"cache_name", "collection"},
 		),
 		CacheMissesTotal: promauto.NewCounterVec(
 			prometheus.CounterOpts{
@@ -127,7 +205,327 @@ func Init(namespace string) *Metrics {
 				Name:      "cache_misses_total",
 				Help:      "Total number of cache misses",
 			},
-			[]string{"cache_name"},
+			[]string{"cache_name", "collection"},
+		),
+		CoalescedReadsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "coalesced_reads_total",
+				Help:      "Total number of document reads that shared a backend query with a concurrent request",
+			},
+			[]string{"collection"},
+		),
+		LoadShedLimit: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "load_shed_concurrency_limit",
+				Help:      "Current adaptive concurrency limit",
+			},
+			[]string{"protocol"},
+		),
+		LoadShedInFlight: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "load_shed_in_flight",
+				Help:      "Number of requests currently admitted by the concurrency limiter",
+			},
+			[]string{"protocol"},
+		),
+		LoadShedRejectedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "load_shed_rejected_total",
+				Help:      "Total number of requests rejected because the concurrency limit was reached",
+			},
+			[]string{"protocol"},
+		),
+		RetryBudgetExhaustedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "retry_budget_exhausted_total",
+				Help:      "Total number of retries skipped because the retry budget was exhausted",
+			},
+			[]string{"database_type", "operation"},
+		),
+		OperationTimeoutsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "operation_timeouts_total",
+				Help:      "Total number of operations aborted by the per-operation timeout",
+			},
+			[]string{"database_type", "kind"},
+		),
+		CircuitBreakerState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "circuit_breaker_state",
+				Help:      "Current circuit breaker state (0=closed, 1=half_open, 2=open)",
+			},
+			[]string{"name"},
+		),
+		CircuitBreakerTransitionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "circuit_breaker_transitions_total",
+				Help:      "Total number of circuit breaker state changes",
+			},
+			[]string{"name", "from", "to"},
+		),
+		CacheErrorsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "cache_errors_total",
+				Help:      "Total number of failed cache operations",
+			},
+			[]string{"cache_name", "collection", "operation"},
+		),
+		CacheOperationDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "cache_operation_duration_seconds",
+				Help:      "Cache operation duration in seconds",
+				Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 12),
+			},
+			[]string{"cache_name", "collection", "operation"},
+		),
+		PIIDetectionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "pii_detections_total",
+				Help:      "Total number of personal data fields detected on write",
+			},
+			[]string{"collection", "type", "action"},
+		),
+		SchemaRejectsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "schema_rejects_total",
+				Help:      "Total number of writes rejected by collection JSON Schema validation",
+			},
+			[]string{"collection", "operation"},
+		),
+		DocumentsExpiredTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "documents_expired_total",
+				Help:      "Total number of expired documents deleted by the expiry sweeper",
+			},
+			[]string{"database_type", "collection"},
+		),
+		DocumentsPurgedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "documents_purged_total",
+				Help:      "Total number of soft-deleted documents permanently removed after retention",
+			},
+			[]string{"database_type", "collection"},
+		),
+		RetentionDocumentsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "retention_documents_total",
+				Help:      "Total number of documents removed (or selected in dry-run mode) by the retention janitor",
+			},
+			[]string{"database_type", "collection", "reason", "mode"},
+		),
+		DocumentsArchivedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "documents_archived_total",
+				Help:      "Total number of cold documents moved to the archive store",
+			},
+			[]string{"database_type", "collection"},
+		),
+		DocumentsRehydratedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "documents_rehydrated_total",
+				Help:      "Total number of archived documents restored to the primary store on read",
+			},
+			[]string{"database_type", "collection"},
+		),
+		MaintenanceRunsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "maintenance_runs_total",
+				Help:      "Total number of collection maintenance operations (compact, vacuum, forcemerge, ...)",
+			},
+			[]string{"database_type", "operation", "status"},
+		),
+		MaintenanceDurationSeconds: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "maintenance_duration_seconds",
+				Help:      "Duration of collection maintenance operations in seconds",
+				Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+			},
+			[]string{"database_type", "operation"},
+		),
+		SearchMirrorDriftDocuments: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "search_mirror_drift_documents",
+				Help:      "Documents that differed between the primary store and the search mirror in the last verification",
+			},
+			[]string{"collection", "kind"},
+		),
+		SearchMirrorRepairedTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "search_mirror_repaired_total",
+				Help:      "Total number of search mirror documents re-synced from the primary store",
+			},
+			[]string{"collection"},
+		),
+		SearchMirrorSyncTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "search_mirror_sync_total",
+				Help:      "Total number of CDC events applied to the search mirror by result",
+			},
+			[]string{"collection", "result"},
+		),
+		KafkaProduceErrorsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "kafka_produce_errors_total",
+				Help:      "Total number of Kafka messages that failed to publish after retries",
+			},
+			[]string{"topic"},
+		),
+		ReplicationEventsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "replication_events_total",
+				Help:      "Total number of CDC events processed by the replicator",
+			},
+			[]string{"event_type", "status"},
+		),
+		ReplicationLagSeconds: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "replication_lag_seconds",
+				Help:      "Age of the last CDC event applied by the replicator",
+			},
+		),
+		CDCBridgeEventsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "cdc_bridge_events_total",
+				Help:      "Total number of MongoDB change stream events handled by the CDC bridge",
+			},
+			[]string{"operation", "status"},
+		),
+		CDCBridgeLagSeconds: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "cdc_bridge_lag_seconds",
+				Help:      "Age of the last change stream event published by the CDC bridge",
+			},
+		),
+		WebhookDeliveriesTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "webhook_deliveries_total",
+				Help:      "Total number of webhook delivery attempts",
+			},
+			[]string{"status"},
+		),
+		WebhookDeliveryDuration: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "webhook_delivery_duration_seconds",
+				Help:      "Webhook delivery request duration in seconds",
+				Buckets:   prometheus.DefBuckets,
+			},
+		),
+		DBPoolConnections: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_pool_connections",
+				Help:      "Number of pooled connections by state (in_use, idle)",
+			},
+			[]string{"pool", "driver", "state"},
+		),
+		DBPoolMaxConnections: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_pool_max_connections",
+				Help:      "Configured maximum number of pooled connections (0 means unlimited)",
+			},
+			[]string{"pool", "driver"},
+		),
+		DBPoolWaitCount: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_pool_wait_count",
+				Help:      "Cumulative number of times a caller waited for a pooled connection",
+			},
+			[]string{"pool", "driver"},
+		),
+		DBPoolWaitDurationSeconds: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_pool_wait_duration_seconds",
+				Help:      "Cumulative time spent waiting for a pooled connection",
+			},
+			[]string{"pool", "driver"},
+		),
+		DBPoolTimeouts: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_pool_timeouts",
+				Help:      "Cumulative number of pool checkouts that timed out",
+			},
+			[]string{"pool", "driver"},
+		),
+		DBPoolConnectErrors: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "db_pool_connect_errors",
+				Help:      "Cumulative number of failed connection attempts",
+			},
+			[]string{"pool", "driver"},
+		),
+		DBPoolEvictionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "db_pool_evictions_total",
+				Help:      "Total number of idle connections evicted after failing background validation",
+			},
+			[]string{"pool"},
+		),
+		DBPoolValidationFailures: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "db_pool_validation_failures_total",
+				Help:      "Total number of background pool validation runs that failed",
+			},
+			[]string{"pool"},
+		),
+		StorageQuotaUsage: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "storage_quota_usage",
+				Help:      "Tracked storage usage (documents or bytes) of collections and tenants with a quota",
+			},
+			[]string{"database_type", "scope", "name", "resource"},
+		),
+		StorageQuotaLimit: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "storage_quota_limit",
+				Help:      "Configured storage quota (documents or bytes) of collections and tenants, 0 when unlimited",
+			},
+			[]string{"database_type", "scope", "name", "resource"},
+		),
+		StorageQuotaRejectionsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "storage_quota_rejections_total",
+				Help:      "Total number of writes rejected because a storage quota was exceeded",
+			},
+			[]string{"database_type", "scope", "name", "resource"},
 		),
 		GoroutinesActive: promauto.NewGauge(
 			prometheus.GaugeOpts{
@@ -171,11 +569,168 @@ func (m *Metrics) RecordDBOperation(operation, collection, status string, durati
 }
 
 // RecordCacheHit은 캐시 히트를 기록합니다
-func (m *Metrics) RecordCacheHit(cacheName string) {
-	m.CacheHitsTotal.WithLabelValues(cacheName).Inc()
+func (m *Metrics) RecordCacheHit(cacheName, collection string) {
+	m.CacheHitsTotal.WithLabelValues(cacheName, collection).Inc()
 }
 
 // RecordCacheMiss는 캐시 미스를 기록합니다
-func (m *Metrics) RecordCacheMiss(cacheName string) {
-	m.CacheMissesTotal.WithLabelValues(cacheName).Inc()
+func (m *Metrics) RecordCacheMiss(cacheName, collection string) {
+	m.CacheMissesTotal.WithLabelValues(cacheName, collection).Inc()
+}
+
+// RecordCoalescedRead는 동시 요청과 백엔드 조회를 공유한 문서 조회를 기록합니다
+func (m *Metrics) RecordCoalescedRead(collection string) {
+	m.CoalescedReadsTotal.WithLabelValues(collection).Inc()
+}
+
+// RecordLoadShed는 동시성 제한기의 현재 한도와 처리 중인 요청 수, 거부 여부를 기록합니다
+func (m *Metrics) RecordLoadShed(protocol string, limit, inFlight int, rejected bool) {
+	m.LoadShedLimit.WithLabelValues(protocol).Set(float64(limit))
+	m.LoadShedInFlight.WithLabelValues(protocol).Set(float64(inFlight))
+	if rejected {
+		m.LoadShedRejectedTotal.WithLabelValues(protocol).Inc()
+	}
+}
+
+// RecordRetryBudgetExhausted는 재시도 예산이 소진되어 건너뛴 재시도를 기록합니다
+func (m *Metrics) RecordRetryBudgetExhausted(databaseType, operation string) {
+	m.RetryBudgetExhaustedTotal.WithLabelValues(databaseType, operation).Inc()
+}
+
+// RecordOperationTimeout은 작업 종류(read, write, admin)별 시간 한도 초과로 중단된 작업을 기록합니다
+func (m *Metrics) RecordOperationTimeout(databaseType, kind string) {
+	m.OperationTimeoutsTotal.WithLabelValues(databaseType, kind).Inc()
+}
+
+// RecordCircuitBreakerTransition은 circuit breaker 상태 변경과 현재 상태 값(0=closed, 1=half_open, 2=open)을 기록합니다
+func (m *Metrics) RecordCircuitBreakerTransition(name, from, to string, state int) {
+	m.CircuitBreakerTransitionsTotal.WithLabelValues(name, from, to).Inc()
+	m.CircuitBreakerState.WithLabelValues(name).Set(float64(state))
+}
+
+// RecordCacheOperation은 캐시 작업(get, set, delete)의 지연 시간과 실패를 기록합니다
+func (m *Metrics) RecordCacheOperation(cacheName, collection, operation string, duration time.Duration, failed bool) {
+	m.CacheOperationDuration.WithLabelValues(cacheName, collection, operation).Observe(duration.Seconds())
+	if failed {
+		m.CacheErrorsTotal.WithLabelValues(cacheName, collection, operation).Inc()
+	}
+}
+
+// RecordPIIDetection은 개인정보 탐지를 기록합니다
+func (m *Metrics) RecordPIIDetection(collection, piiType, action string) {
+	m.PIIDetectionsTotal.WithLabelValues(collection, piiType, action).Inc()
+}
+
+// RecordSchemaReject는 JSON Schema 검증으로 거부된 쓰기를 기록합니다
+func (m *Metrics) RecordSchemaReject(collection, operation string) {
+	m.SchemaRejectsTotal.WithLabelValues(collection, operation).Inc()
+}
+
+// RecordDocumentsExpired는 만료 정리로 삭제한 문서 수를 기록합니다
+func (m *Metrics) RecordDocumentsExpired(databaseType, collection string, count int64) {
+	m.DocumentsExpiredTotal.WithLabelValues(databaseType, collection).Add(float64(count))
+}
+
+// RecordDocumentsPurged는 보존 기간이 지나 영구 삭제한 소프트 삭제 문서 수를 기록합니다
+func (m *Metrics) RecordDocumentsPurged(databaseType, collection string, count int64) {
+	m.DocumentsPurgedTotal.WithLabelValues(databaseType, collection).Add(float64(count))
+}
+
+// RecordRetentionDocuments는 보존 정책으로 삭제한 문서 수를 기록합니다 (dry-run이면 삭제 대상으로 고른 문서 수)
+func (m *Metrics) RecordRetentionDocuments(databaseType, collection, reason string, dryRun bool, count int64) {
+	mode := "deleted"
+	if dryRun {
+		mode = "dry_run"
+	}
+	m.RetentionDocumentsTotal.WithLabelValues(databaseType, collection, reason, mode).Add(float64(count))
+}
+
+// RecordDocumentsArchived는 보관 저장소로 옮긴 문서 수를 기록합니다
+func (m *Metrics) RecordDocumentsArchived(databaseType, collection string, count int64) {
+	m.DocumentsArchivedTotal.WithLabelValues(databaseType, collection).Add(float64(count))
+}
+
+// RecordDocumentRehydrated는 조회 시 주 저장소로 되살린 보관 문서를 기록합니다
+func (m *Metrics) RecordDocumentRehydrated(databaseType, collection string) {
+	m.DocumentsRehydratedTotal.WithLabelValues(databaseType, collection).Inc()
+}
+
+// RecordMaintenanceRun은 컬렉션 유지보수 작업 결과(success, error)와 소요 시간을 기록합니다
+func (m *Metrics) RecordMaintenanceRun(databaseType, operation, status string, duration time.Duration) {
+	m.MaintenanceRunsTotal.WithLabelValues(databaseType, operation, status).Inc()
+	m.MaintenanceDurationSeconds.WithLabelValues(databaseType, operation).Observe(duration.Seconds())
+}
+
+// RecordSearchMirrorVerification은 검색 미러 검증에서 찾은 어긋난 문서 수와 다시 맞춘 문서 수를 기록합니다
+func (m *Metrics) RecordSearchMirrorVerification(collection string, missing, extra, diverged, repaired int64) {
+	m.SearchMirrorDriftDocuments.WithLabelValues(collection, "missing").Set(float64(missing))
+	m.SearchMirrorDriftDocuments.WithLabelValues(collection, "extra").Set(float64(extra))
+	m.SearchMirrorDriftDocuments.WithLabelValues(collection, "diverged").Set(float64(diverged))
+	m.SearchMirrorRepairedTotal.WithLabelValues(collection).Add(float64(repaired))
+}
+
+// RecordSearchMirrorSync는 CDC 이벤트로 검색 미러를 동기화한 결과(indexed, deleted, failed)를 기록합니다
+func (m *Metrics) RecordSearchMirrorSync(collection, result string) {
+	m.SearchMirrorSyncTotal.WithLabelValues(collection, result).Inc()
+}
+
+// RecordReplicationEvent는 복제 이벤트 처리 결과(applied, skipped, duplicate, error)와 적용 지연을 기록합니다
+func (m *Metrics) RecordReplicationEvent(eventType, status string, lag time.Duration) {
+	m.ReplicationEventsTotal.WithLabelValues(eventType, status).Inc()
+	if status != "error" {
+		m.ReplicationLagSeconds.Set(lag.Seconds())
+	}
+}
+
+// RecordKafkaProduceError는 재시도 후에도 발행에 실패한 Kafka 메시지를 기록합니다
+func (m *Metrics) RecordKafkaProduceError(topic string) {
+	m.KafkaProduceErrorsTotal.WithLabelValues(topic).Inc()
+}
+
+// RecordCDCBridgeEvent는 변경 스트림 브리지의 이벤트 처리 결과(published, skipped, error)와 발행 지연을 기록합니다
+func (m *Metrics) RecordCDCBridgeEvent(operation, status string, lag time.Duration) {
+	m.CDCBridgeEventsTotal.WithLabelValues(operation, status).Inc()
+	if status != "error" {
+		m.CDCBridgeLagSeconds.Set(lag.Seconds())
+	}
+}
+
+// RecordWebhookDelivery는 웹훅 전송 시도 결과(delivered, retry, failed)와 요청 시간을 기록합니다
+func (m *Metrics) RecordWebhookDelivery(status string, duration time.Duration) {
+	m.WebhookDeliveriesTotal.WithLabelValues(status).Inc()
+	m.WebhookDeliveryDuration.Observe(duration.Seconds())
+}
+
+// RecordPoolStats는 연결 풀 상태(사용 중/유휴 연결 수, 누적 대기 횟수와 시간, 시간 초과, 연결 실패)를 기록합니다
+func (m *Metrics) RecordPoolStats(pool, driver string, maxOpen, inUse, idle int, waitCount int64, waitDuration time.Duration, timeouts, connectErrors int64) {
+	m.DBPoolConnections.WithLabelValues(pool, driver, "in_use").Set(float64(inUse))
+	m.DBPoolConnections.WithLabelValues(pool, driver, "idle").Set(float64(idle))
+	m.DBPoolMaxConnections.WithLabelValues(pool, driver).Set(float64(maxOpen))
+	m.DBPoolWaitCount.WithLabelValues(pool, driver).Set(float64(waitCount))
+	m.DBPoolWaitDurationSeconds.WithLabelValues(pool, driver).Set(waitDuration.Seconds())
+	m.DBPoolTimeouts.WithLabelValues(pool, driver).Set(float64(timeouts))
+	m.DBPoolConnectErrors.WithLabelValues(pool, driver).Set(float64(connectErrors))
+}
+
+// RecordPoolValidation은 연결 풀 검증 결과(버린 연결 수, 검증 실패)를 기록합니다
+func (m *Metrics) RecordPoolValidation(pool string, evicted int, failed bool) {
+	if evicted > 0 {
+		m.DBPoolEvictionsTotal.WithLabelValues(pool).Add(float64(evicted))
+	}
+	if failed {
+		m.DBPoolValidationFailures.WithLabelValues(pool).Inc()
+	}
+}
+
+// RecordQuotaUsage는 컬렉션/테넌트의 저장 용량 사용량과 한도를 기록합니다
+func (m *Metrics) RecordQuotaUsage(databaseType, scope, name string, documents, bytes, maxDocuments, maxBytes int64) {
+	m.StorageQuotaUsage.WithLabelValues(databaseType, scope, name, "documents").Set(float64(documents))
+	m.StorageQuotaUsage.WithLabelValues(databaseType, scope, name, "bytes").Set(float64(bytes))
+	m.StorageQuotaLimit.WithLabelValues(databaseType, scope, name, "documents").Set(float64(maxDocuments))
+	m.StorageQuotaLimit.WithLabelValues(databaseType, scope, name, "bytes").Set(float64(maxBytes))
+}
+
+// RecordQuotaRejection은 저장 용량 한도를 넘어 거부된 쓰기를 기록합니다
+func (m *Metrics) RecordQuotaRejection(databaseType, scope, name, resource string) {
+	m.StorageQuotaRejectionsTotal.WithLabelValues(databaseType, scope, name, resource).Inc()
 }
@@ -0,0 +1,138 @@
+package pii
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Detector는 문자열에서 특정 유형의 개인정보를 찾는 탐지기입니다
+// 새 유형(전화번호, 주민등록번호 등)은 이 인터페이스를 구현해 NewScanner에 전달합니다
+type Detector interface {
+	// Type은 탐지기 유형 이름입니다 (메트릭 라벨과 tag 결과에 사용)
+	Type() string
+
+	// FindAll은 탐지된 구간의 [시작, 끝) 바이트 인덱스를 반환합니다
+	FindAll(s string) [][]int
+
+	// Mask는 탐지된 값을 마스킹한 문자열을 반환합니다
+	Mask(match string) string
+}
+
+// 기본 제공 탐지기 유형
+const (
+	TypeEmail      = "email"
+	TypeCardNumber = "card_number"
+)
+
+// DetectorByType은 기본 제공 탐지기를 유형 이름으로 찾습니다
+func DetectorByType(name string) (Detector, error) {
+	switch name {
+	case TypeEmail:
+		return EmailDetector{}, nil
+	case TypeCardNumber:
+		return CardNumberDetector{}, nil
+	default:
+		return nil, fmt.Errorf("unknown pii detector: %s", name)
+	}
+}
+
+// DefaultDetectors는 기본 제공 탐지기 전체를 반환합니다
+func DefaultDetectors() []Detector {
+	return []Detector{EmailDetector{}, CardNumberDetector{}}
+}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+
+// EmailDetector는 이메일 주소를 탐지합니다
+type EmailDetector struct{}
+
+// Type은 탐지기 유형 이름입니다
+func (EmailDetector) Type() string { return TypeEmail }
+
+// FindAll은 이메일 주소 구간을 반환합니다
+func (EmailDetector) FindAll(s string) [][]int {
+	if !strings.Contains(s, "@") {
+		return nil
+	}
+	return emailPattern.FindAllStringIndex(s, -1)
+}
+
+// Mask는 로컬 파트의 첫 글자와 도메인만 남깁니다 (예: a***@example.com)
+func (EmailDetector) Mask(match string) string {
+	at := strings.LastIndex(match, "@")
+	if at <= 0 {
+		return strings.Repeat("*", len(match))
+	}
+	return match[:1] + "***" + match[at:]
+}
+
+// cardCandidatePattern은 공백/하이픈으로 구분될 수 있는 13~19자리 숫자열입니다
+var cardCandidatePattern = regexp.MustCompile(`\b\d(?:[ \-]?\d){12,18}\b`)
+
+// CardNumberDetector는 Luhn 검사를 통과하는 카드 번호를 탐지합니다
+type CardNumberDetector struct{}
+
+// Type은 탐지기 유형 이름입니다
+func (CardNumberDetector) Type() string { return TypeCardNumber }
+
+// FindAll은 카드 번호 구간을 반환합니다
+// 주문 번호 등 일반 숫자열의 오탐을 줄이기 위해 Luhn 체크섬을 통과한 값만 반환합니다
+func (CardNumberDetector) FindAll(s string) [][]int {
+	var matches [][]int
+	for _, m := range cardCandidatePattern.FindAllStringIndex(s, -1) {
+		if luhnValid(digitsOf(s[m[0]:m[1]])) {
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// Mask는 마지막 4자리만 남기고 숫자를 가립니다 (구분자는 유지)
+func (CardNumberDetector) Mask(match string) string {
+	keep := 4
+	out := []byte(match)
+	for i := len(out) - 1; i >= 0; i-- {
+		if out[i] < '0' || out[i] > '9' {
+			continue
+		}
+		if keep > 0 {
+			keep--
+			continue
+		}
+		out[i] = '*'
+	}
+	return string(out)
+}
+
+// digitsOf는 문자열에서 숫자만 추출합니다
+func digitsOf(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// luhnValid는 Luhn 체크섬을 검증합니다
+func luhnValid(digits string) bool {
+	if len(digits) < 13 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
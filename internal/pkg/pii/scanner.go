@@ -0,0 +1,206 @@
+package pii
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+)
+
+// ErrPIIDetected는 reject 정책이 적용된 컬렉션에 개인정보가 포함된 문서를 쓰려고 할 때의 에러입니다
+var ErrPIIDetected = errors.New("document contains personal data")
+
+// Action은 개인정보가 탐지되었을 때의 처리 방식입니다
+type Action string
+
+const (
+	// ActionTag는 값을 그대로 저장하고 탐지 결과를 TagField에 기록합니다
+	ActionTag Action = "tag"
+
+	// ActionMask는 탐지된 부분을 마스킹해 저장합니다
+	ActionMask Action = "mask"
+
+	// ActionReject는 쓰기를 거부합니다
+	ActionReject Action = "reject"
+)
+
+// TagField는 tag 정책에서 탐지 결과를 기록하는 문서 필드입니다
+const TagField = "_pii"
+
+// Policy는 컬렉션별 처리 방식입니다
+type Policy struct {
+	// Collection은 적용 대상 컬렉션입니다 ("*" 또는 path.Match 패턴 지원)
+	Collection string
+
+	// Action은 탐지 시 처리 방식입니다
+	Action Action
+
+	// ExemptFields의 필드(점 경로)는 검사하지 않습니다 (예: 의도적으로 이메일을 저장하는 "contact.email")
+	ExemptFields []string
+}
+
+// Finding은 탐지된 개인정보 하나입니다
+type Finding struct {
+	Field string // 점으로 구분한 필드 경로 (배열 원소는 인덱스 사용, 예: "contacts.0.email")
+	Type  string // 탐지기 유형 (email, card_number 등)
+}
+
+// Result는 문서 검사 결과입니다
+type Result struct {
+	Action   Action
+	Findings []Finding
+}
+
+// Scanner는 문서 데이터에서 개인정보를 탐지하고 정책에 따라 처리합니다
+type Scanner struct {
+	detectors     []Detector
+	policies      []Policy
+	defaultAction Action
+}
+
+// NewScanner는 새로운 Scanner를 생성합니다
+// 어떤 정책에도 해당하지 않는 컬렉션에는 defaultAction을 적용하며, 빈 값이면 검사하지 않습니다
+func NewScanner(detectors []Detector, policies []Policy, defaultAction Action) (*Scanner, error) {
+	if len(detectors) == 0 {
+		return nil, fmt.Errorf("at least one pii detector is required")
+	}
+	if defaultAction != "" && !defaultAction.valid() {
+		return nil, fmt.Errorf("invalid default pii action: %s", defaultAction)
+	}
+	for i, p := range policies {
+		if p.Collection == "" {
+			return nil, fmt.Errorf("pii policy %d: collection is required", i)
+		}
+		if _, err := path.Match(p.Collection, ""); err != nil {
+			return nil, fmt.Errorf("pii policy %d: invalid collection pattern %q: %w", i, p.Collection, err)
+		}
+		if !p.Action.valid() {
+			return nil, fmt.Errorf("pii policy %d: invalid action: %s", i, p.Action)
+		}
+	}
+	return &Scanner{detectors: detectors, policies: policies, defaultAction: defaultAction}, nil
+}
+
+// Scan은 문서 데이터를 검사하고 정책을 적용합니다
+// mask는 data를 제자리에서 수정하고, tag는 data에 TagField를 추가합니다
+// reject 정책에서 개인정보가 탐지되면 ErrPIIDetected를 반환합니다 (결과는 함께 반환)
+func (s *Scanner) Scan(collection string, data map[string]interface{}) (*Result, error) {
+	policy, ok := s.policyFor(collection)
+	if !ok || data == nil {
+		return &Result{}, nil
+	}
+
+	result := &Result{Action: policy.Action}
+	exempt := make(map[string]bool, len(policy.ExemptFields))
+	for _, f := range policy.ExemptFields {
+		exempt[f] = true
+	}
+
+	for key, value := range data {
+		if key == TagField {
+			continue
+		}
+		data[key] = s.walk(key, value, policy.Action, exempt, result)
+	}
+
+	if len(result.Findings) == 0 {
+		return result, nil
+	}
+	sort.Slice(result.Findings, func(i, j int) bool {
+		if result.Findings[i].Field != result.Findings[j].Field {
+			return result.Findings[i].Field < result.Findings[j].Field
+		}
+		return result.Findings[i].Type < result.Findings[j].Type
+	})
+
+	switch policy.Action {
+	case ActionReject:
+		return result, fmt.Errorf("%w: %s in field %q", ErrPIIDetected, result.Findings[0].Type, result.Findings[0].Field)
+	case ActionTag:
+		tags := make([]interface{}, 0, len(result.Findings))
+		for _, f := range result.Findings {
+			tags = append(tags, map[string]interface{}{"field": f.Field, "type": f.Type})
+		}
+		data[TagField] = tags
+	}
+	return result, nil
+}
+
+// walk는 값을 재귀적으로 검사하고, mask 정책이면 마스킹된 값을 반환합니다
+func (s *Scanner) walk(field string, value interface{}, action Action, exempt map[string]bool, result *Result) interface{} {
+	if exempt[field] {
+		return value
+	}
+
+	switch v := value.(type) {
+	case string:
+		return s.scanString(field, v, action, result)
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = s.walk(field+"."+key, child, action, exempt, result)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = s.walk(field+"."+strconv.Itoa(i), child, action, exempt, result)
+		}
+		return v
+	case []string:
+		for i, child := range v {
+			v[i] = s.scanString(field+"."+strconv.Itoa(i), child, action, result)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// scanString은 문자열 값에 모든 탐지기를 적용합니다
+func (s *Scanner) scanString(field, value string, action Action, result *Result) string {
+	for _, d := range s.detectors {
+		matches := d.FindAll(value)
+		if len(matches) == 0 {
+			continue
+		}
+		result.Findings = append(result.Findings, Finding{Field: field, Type: d.Type()})
+		if action == ActionMask {
+			value = maskMatches(value, matches, d)
+		}
+	}
+	return value
+}
+
+// maskMatches는 탐지된 구간을 탐지기의 마스킹 결과로 치환합니다
+func maskMatches(value string, matches [][]int, d Detector) string {
+	out := make([]byte, 0, len(value))
+	last := 0
+	for _, m := range matches {
+		out = append(out, value[last:m[0]]...)
+		out = append(out, d.Mask(value[m[0]:m[1]])...)
+		last = m[1]
+	}
+	return string(append(out, value[last:]...))
+}
+
+// policyFor는 컬렉션에 적용할 정책을 반환합니다 (먼저 선언된 정책 우선)
+func (s *Scanner) policyFor(collection string) (Policy, bool) {
+	for _, p := range s.policies {
+		if ok, _ := path.Match(p.Collection, collection); ok {
+			return p, true
+		}
+	}
+	if s.defaultAction == "" {
+		return Policy{}, false
+	}
+	return Policy{Collection: "*", Action: s.defaultAction}, true
+}
+
+// valid는 지원하는 처리 방식인지 확인합니다
+func (a Action) valid() bool {
+	switch a {
+	case ActionTag, ActionMask, ActionReject:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+// Package poolhealth는 데이터베이스 연결 풀의 시작 시 예열과 끊어진 연결 정리를 담당합니다
+//
+// 예열은 서비스가 요청을 받기 전에 연결을 미리 맺어 첫 요청의 연결 지연을 없애고,
+// 백그라운드 검증은 장애 조치(failover) 등으로 끊어진 유휴 연결을 요청이 사용하기 전에 버립니다
+package poolhealth
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultWarmUpTimeout      = 10 * time.Second
+	defaultValidationInterval = 30 * time.Second
+	defaultValidationTimeout  = 2 * time.Second
+)
+
+// Pool은 예열과 검증을 지원하는 연결 풀입니다
+type Pool interface {
+	// WarmUp은 연결을 n개까지 미리 맺습니다
+	WarmUp(ctx context.Context, n int) error
+
+	// Validate는 유휴 연결을 검사해 끊어진 연결을 버리고 버린 연결 수를 반환합니다
+	// timeout은 연결 하나를 검사하는 시간 한도이며, 드라이버가 연결을 직접 관리하는 풀은 상태 확인만 하고 0을 반환합니다
+	Validate(ctx context.Context, timeout time.Duration) (int, error)
+}
+
+// Registry는 이름별 연결 풀 예열/검증 관리자입니다
+// 데이터베이스 클라이언트를 생성한 곳에서 등록하고, 시작 시 WarmUp과 백그라운드 Run을 호출합니다
+type Registry struct {
+	mu    sync.RWMutex
+	pools map[string]registeredPool
+}
+
+// registeredPool은 등록된 연결 풀입니다
+type registeredPool struct {
+	pool Pool
+	warm int // 예열할 연결 수
+}
+
+// NewRegistry는 새로운 Registry를 생성합니다
+func NewRegistry() *Registry {
+	return &Registry{
+		pools: make(map[string]registeredPool),
+	}
+}
+
+// Register는 연결 풀을 등록합니다 (같은 이름이면 교체, warm이 0 이하이면 예열하지 않음)
+func (r *Registry) Register(name string, pool Pool, warm int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pools[name] = registeredPool{pool: pool, warm: warm}
+}
+
+// names는 등록된 연결 풀 이름을 이름순으로 반환합니다
+func (r *Registry) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.pools))
+	for name := range r.pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// get은 이름으로 등록된 연결 풀을 반환합니다
+func (r *Registry) get(name string) (registeredPool, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.pools[name]
+	return p, ok
+}
+
+// WarmUp은 등록된 모든 연결 풀을 동시에 예열하고 끝날 때까지 기다립니다
+// 예열 실패는 경고로만 기록합니다 (연결은 요청 시점에 다시 맺습니다)
+func (r *Registry) WarmUp(ctx context.Context, timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = defaultWarmUpTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, name := range r.names() {
+		p, ok := r.get(name)
+		if !ok || p.warm <= 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(name string, p registeredPool) {
+			defer wg.Done()
+			start := time.Now()
+			if err := p.pool.WarmUp(ctx, p.warm); err != nil {
+				logger.Warn(ctx, "connection pool warm-up failed",
+					zap.String("pool", name),
+					zap.Error(err),
+				)
+				return
+			}
+			logger.Info(ctx, "connection pool warmed up",
+				zap.String("pool", name),
+				zap.Int("connections", p.warm),
+				zap.Duration("duration", time.Since(start)),
+			)
+		}(name, p)
+	}
+	wg.Wait()
+}
+
+// Run은 interval마다 등록된 연결 풀을 검증합니다 (ctx가 취소될 때까지 실행)
+// timeout은 연결 하나를 검사하는 시간 한도입니다
+func (r *Registry) Run(ctx context.Context, interval, timeout time.Duration, m *metrics.Metrics) {
+	if interval <= 0 {
+		interval = defaultValidationInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultValidationTimeout
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, name := range r.names() {
+			if p, ok := r.get(name); ok {
+				r.validate(ctx, name, p.pool, timeout, m)
+			}
+		}
+	}
+}
+
+// validate는 연결 풀 하나를 검증하고 결과를 기록합니다
+func (r *Registry) validate(ctx context.Context, name string, pool Pool, timeout time.Duration, m *metrics.Metrics) {
+	evicted, err := pool.Validate(ctx, timeout)
+	if m != nil {
+		m.RecordPoolValidation(name, evicted, err != nil)
+	}
+	if err != nil {
+		logger.Warn(ctx, "connection pool validation failed",
+			zap.String("pool", name),
+			zap.Int("evicted", evicted),
+			zap.Error(err),
+		)
+		return
+	}
+	if evicted > 0 {
+		logger.Info(ctx, "evicted broken pooled connections",
+			zap.String("pool", name),
+			zap.Int("evicted", evicted),
+		)
+	}
+}
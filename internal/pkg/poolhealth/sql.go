@@ -0,0 +1,96 @@
+package poolhealth
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// sqlPool은 database/sql 연결 풀입니다
+type sqlPool struct {
+	db *sql.DB
+}
+
+// SQL은 database/sql 연결 풀의 Pool을 생성합니다
+// 예열할 연결 수는 MaxIdleConns 이하로 지정해야 예열한 연결이 풀에 남습니다
+func SQL(db *sql.DB) Pool {
+	return &sqlPool{db: db}
+}
+
+// RegisterSQL은 database/sql 연결 풀을 등록합니다
+func (r *Registry) RegisterSQL(name string, db *sql.DB, warm int) {
+	r.Register(name, SQL(db), warm)
+}
+
+// WarmUp은 연결 n개를 동시에 맺어 확인한 뒤 풀에 반환합니다
+// 모든 연결을 잡은 상태에서 반환해야 같은 연결을 재사용하지 않고 n개가 열립니다
+func (p *sqlPool) WarmUp(ctx context.Context, n int) error {
+	conns := make([]*sql.Conn, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conn, err := p.db.Conn(ctx)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			conns[i] = conn
+			errs[i] = conn.PingContext(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, conn := range conns {
+		if conn != nil {
+			conn.Close()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate는 현재 유휴 연결 수만큼 연결을 꺼내 ping하고, 실패한 연결은 풀에 돌려놓지 않고 닫습니다
+// 꺼내는 동안 요청이 유휴 연결을 가져가면 새 연결이 섞일 수 있지만 검사 결과에는 영향이 없습니다
+func (p *sqlPool) Validate(ctx context.Context, timeout time.Duration) (int, error) {
+	idle := p.db.Stats().Idle
+	conns := make([]*sql.Conn, 0, idle)
+	defer func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+
+	// 요청이 유휴 연결을 모두 가져가 풀이 가득 차면 기다리지 않도록 꺼내는 시간도 제한합니다
+	acquireCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var acquireErr error
+	for i := 0; i < idle; i++ {
+		conn, err := p.db.Conn(acquireCtx)
+		if err != nil {
+			acquireErr = fmt.Errorf("failed to acquire connection: %w", err)
+			break
+		}
+		conns = append(conns, conn)
+	}
+
+	evicted := 0
+	for _, conn := range conns {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := conn.PingContext(pingCtx)
+		cancel()
+		if err == nil {
+			continue
+		}
+		// driver.ErrBadConn을 반환하면 database/sql이 연결을 풀에 돌려놓지 않고 닫습니다
+		conn.Raw(func(any) error { return driver.ErrBadConn })
+		evicted++
+	}
+	return evicted, acquireErr
+}
@@ -0,0 +1,141 @@
+package poolstats
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+)
+
+// 드라이버 종류
+const (
+	DriverMongoDB   = "mongodb"
+	DriverSQL       = "sql"
+	DriverRedis     = "redis"
+	DriverCassandra = "cassandra"
+)
+
+// Stats는 연결 풀의 현재 상태입니다
+// WaitCount, WaitDuration, Timeouts, ConnectErrors는 풀 생성 이후 누적 값입니다
+type Stats struct {
+	Name   string `json:"name"`
+	Driver string `json:"driver"`
+
+	// MaxOpen은 최대 연결 수입니다 (0이면 제한 없음)
+	MaxOpen int `json:"max_open"`
+
+	// Open은 열려 있는 연결 수입니다 (InUse + Idle)
+	Open  int `json:"open"`
+	InUse int `json:"in_use"`
+	Idle  int `json:"idle"`
+
+	// WaitCount는 연결을 기다린 횟수입니다 (MongoDB는 체크아웃 횟수, Redis는 풀에 유휴 연결이 없던 횟수)
+	WaitCount int64 `json:"wait_count"`
+
+	// WaitDuration은 연결을 기다린 총 시간입니다 (MongoDB는 체크아웃에 걸린 총 시간, Redis는 제공하지 않음)
+	WaitDuration time.Duration `json:"wait_duration"`
+
+	// Timeouts는 연결 대기가 시간 초과된 횟수입니다
+	Timeouts int64 `json:"timeouts"`
+
+	// ConnectErrors는 새 연결을 맺지 못한 횟수입니다
+	ConnectErrors int64 `json:"connect_errors"`
+}
+
+// Source는 연결 풀 상태를 반환하는 함수입니다
+type Source func() Stats
+
+// Registry는 이름별 연결 풀 상태 수집기입니다
+// 데이터베이스/캐시 클라이언트를 생성한 곳에서 등록하고, 관리 API와 Prometheus 메트릭이 같은 값을 조회합니다
+type Registry struct {
+	mu      sync.RWMutex
+	sources map[string]registeredSource
+}
+
+// registeredSource는 등록된 연결 풀입니다
+type registeredSource struct {
+	driver string
+	source Source
+}
+
+// NewRegistry는 새로운 Registry를 생성합니다
+func NewRegistry() *Registry {
+	return &Registry{
+		sources: make(map[string]registeredSource),
+	}
+}
+
+// Register는 연결 풀을 등록합니다 (같은 이름이면 교체)
+func (r *Registry) Register(name, driver string, source Source) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sources[name] = registeredSource{driver: driver, source: source}
+}
+
+// RegisterSQL은 database/sql 연결 풀을 등록합니다
+func (r *Registry) RegisterSQL(name string, db *sql.DB) {
+	r.Register(name, DriverSQL, SQL(db))
+}
+
+// Snapshot은 등록된 모든 연결 풀의 현재 상태를 이름순으로 반환합니다
+func (r *Registry) Snapshot() []Stats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshot := make([]Stats, 0, len(r.sources))
+	for name, s := range r.sources {
+		stats := s.source()
+		stats.Name = name
+		stats.Driver = s.driver
+		snapshot = append(snapshot, stats)
+	}
+	sort.Slice(snapshot, func(i, j int) bool {
+		return snapshot[i].Name < snapshot[j].Name
+	})
+	return snapshot
+}
+
+// Run은 interval마다 연결 풀 상태를 Prometheus 메트릭으로 기록합니다 (ctx가 취소될 때까지 실행)
+func (r *Registry) Run(ctx context.Context, interval time.Duration, m *metrics.Metrics) {
+	if interval <= 0 {
+		interval = 15 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		r.record(m)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// record는 현재 상태를 메트릭으로 기록합니다
+func (r *Registry) record(m *metrics.Metrics) {
+	for _, s := range r.Snapshot() {
+		m.RecordPoolStats(s.Name, s.Driver, s.MaxOpen, s.InUse, s.Idle, s.WaitCount, s.WaitDuration, s.Timeouts, s.ConnectErrors)
+	}
+}
+
+// SQL은 database/sql 연결 풀의 상태를 반환하는 Source를 생성합니다
+func SQL(db *sql.DB) Source {
+	return func() Stats {
+		s := db.Stats()
+		return Stats{
+			MaxOpen:      s.MaxOpenConnections,
+			Open:         s.OpenConnections,
+			InUse:        s.InUse,
+			Idle:         s.Idle,
+			WaitCount:    s.WaitCount,
+			WaitDuration: s.WaitDuration,
+		}
+	}
+}
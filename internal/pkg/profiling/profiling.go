@@ -0,0 +1,22 @@
+// Package profiling은 net/http/pprof 핸들러와 연속 프로파일러(Pyroscope 호환) 전송을 제공합니다
+//
+// pprof 핸들러는 내부 포트의 별도 서버에서만 노출하고, 연속 프로파일링은 주기적으로 CPU/힙 프로파일을 수집해
+// 프로파일러 서버의 /ingest API로 전송합니다
+package profiling
+
+import (
+	"net/http"
+	"net/http/pprof"
+)
+
+// Handler는 /debug/pprof/ 아래에 pprof 엔드포인트를 등록한 핸들러를 반환합니다
+// http.DefaultServeMux를 사용하지 않으므로 API 서버에는 노출되지 않습니다
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
@@ -0,0 +1,168 @@
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPushInterval = 15 * time.Second
+	pushTimeout         = 10 * time.Second
+)
+
+// PushConfig는 연속 프로파일러 전송 설정입니다
+type PushConfig struct {
+	ServerAddress   string            // 프로파일러 서버 주소 (예: http://pyroscope:4040)
+	ApplicationName string            // 프로파일을 구분하는 애플리케이션 이름
+	Tags            map[string]string // 프로파일에 붙일 태그 (예: env, version)
+	AuthToken       string            // Bearer 인증 토큰 (비어 있으면 인증 헤더 없음)
+	Interval        time.Duration     // CPU 프로파일 수집 구간이자 전송 주기 (0이면 15s)
+	HTTPClient      *http.Client      // nil이면 기본 클라이언트
+}
+
+// Pusher는 CPU/힙 프로파일을 주기적으로 수집해 프로파일러 서버로 전송합니다
+type Pusher struct {
+	cfg    PushConfig
+	client *http.Client
+}
+
+// NewPusher는 새로운 Pusher를 생성합니다
+func NewPusher(cfg PushConfig) (*Pusher, error) {
+	if cfg.ServerAddress == "" {
+		return nil, fmt.Errorf("profiler server address is required")
+	}
+	if cfg.ApplicationName == "" {
+		return nil, fmt.Errorf("profiler application name is required")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultPushInterval
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: pushTimeout}
+	}
+	return &Pusher{cfg: cfg, client: client}, nil
+}
+
+// Run은 ctx가 취소될 때까지 Interval 구간마다 CPU 프로파일과 힙 프로파일을 전송합니다
+// 수집이나 전송 실패(다른 CPU 프로파일이 실행 중인 경우 포함)는 경고로만 기록하고 다음 구간에 다시 시도합니다
+func (p *Pusher) Run(ctx context.Context) {
+	for {
+		from := time.Now()
+		cpu, cpuErr := p.collectCPU(ctx)
+		until := time.Now()
+		if ctx.Err() != nil {
+			return
+		}
+
+		if cpuErr != nil {
+			logger.Warn(ctx, "failed to collect cpu profile", zap.Error(cpuErr))
+			// CPU 프로파일을 수집하지 못하면 구간을 기다리지 않았으므로 다음 구간까지 대기
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(p.cfg.Interval):
+			}
+		} else if err := p.upload(ctx, "cpu", cpu, from, until); err != nil {
+			logger.Warn(ctx, "failed to push cpu profile", zap.Error(err))
+		}
+
+		var heap bytes.Buffer
+		if err := pprof.Lookup("heap").WriteTo(&heap, 0); err != nil {
+			logger.Warn(ctx, "failed to collect heap profile", zap.Error(err))
+			continue
+		}
+		if err := p.upload(ctx, "heap", heap.Bytes(), from, until); err != nil {
+			logger.Warn(ctx, "failed to push heap profile", zap.Error(err))
+		}
+	}
+}
+
+// collectCPU는 Interval 동안(또는 ctx가 취소될 때까지) CPU 프로파일을 수집합니다
+func (p *Pusher) collectCPU(ctx context.Context) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(p.cfg.Interval):
+	}
+	pprof.StopCPUProfile()
+	return buf.Bytes(), nil
+}
+
+// upload는 프로파일 하나를 프로파일러 서버의 /ingest API로 전송합니다 (pprof 형식, multipart "profile" 필드)
+func (p *Pusher) upload(ctx context.Context, kind string, profile []byte, from, until time.Time) error {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("profile", "profile.pprof")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(profile); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("name", p.applicationName())
+	query.Set("from", strconv.FormatInt(from.Unix(), 10))
+	query.Set("until", strconv.FormatInt(until.Unix(), 10))
+	query.Set("format", "pprof")
+	query.Set("spyName", "gospy")
+	query.Set("sampleRate", "100")
+
+	endpoint := strings.TrimSuffix(p.cfg.ServerAddress, "/") + "/ingest?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	if p.cfg.AuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.cfg.AuthToken)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("push %s profile: %w", kind, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("push %s profile: status %d: %s", kind, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// applicationName은 태그를 붙인 애플리케이션 이름을 반환합니다 (예: database-service{env=prod,version=1.0})
+func (p *Pusher) applicationName() string {
+	if len(p.cfg.Tags) == 0 {
+		return p.cfg.ApplicationName
+	}
+	keys := make([]string, 0, len(p.cfg.Tags))
+	for k := range p.cfg.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	tags := make([]string, len(keys))
+	for i, k := range keys {
+		tags[i] = k + "=" + p.cfg.Tags[k]
+	}
+	return p.cfg.ApplicationName + "{" + strings.Join(tags, ",") + "}"
+}
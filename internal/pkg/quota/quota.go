@@ -0,0 +1,160 @@
+// Package quota는 컬렉션/테넌트 저장 용량 한도(문서 수, 바이트)를 추적하고 쓰기를 허용할지 판단합니다
+//
+// 사용량은 저장소 통계로 주기적으로 맞추고(Sync), 그 사이의 삽입은 요청한 문서 수와 크기만큼 미리 더해(Reserve)
+// 동시에 들어온 쓰기가 함께 한도를 넘지 않도록 합니다. 삭제와 수정으로 줄어든 사용량은 다음 Sync에 반영됩니다
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrExceeded는 쓰기가 저장 용량 한도를 넘는 경우의 에러입니다
+var ErrExceeded = errors.New("storage quota exceeded")
+
+// 한도 자원
+const (
+	ResourceDocuments = "documents"
+	ResourceBytes     = "bytes"
+)
+
+// Limit는 저장 용량 한도입니다 (0이면 해당 제한 없음)
+type Limit struct {
+	MaxDocuments int64
+	MaxBytes     int64
+}
+
+// Enabled는 한도에 제한이 하나라도 있는지 반환합니다
+func (l Limit) Enabled() bool {
+	return l.MaxDocuments > 0 || l.MaxBytes > 0
+}
+
+// Usage는 저장 용량 사용량입니다
+type Usage struct {
+	Documents int64
+	Bytes     int64
+	SyncedAt  time.Time // 마지막으로 저장소 통계와 맞춘 시각
+}
+
+// ExceededError는 넘은 한도를 담은 에러입니다 (errors.Is(err, ErrExceeded)로 판별)
+type ExceededError struct {
+	Scope     string // collection, tenant
+	Name      string // 컬렉션 이름 또는 테넌트 ID
+	Resource  string // documents, bytes
+	Limit     int64
+	Used      int64
+	Requested int64
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%s: %s %s %s limit %d, used %d, requested %d",
+		ErrExceeded, e.Scope, e.Name, e.Resource, e.Limit, e.Used, e.Requested)
+}
+
+func (e *ExceededError) Unwrap() error {
+	return ErrExceeded
+}
+
+// Check는 사용량에 documents, bytes를 더해도 한도를 넘지 않는지 확인합니다
+// bytes가 0이면(수정처럼 크기 변화를 미리 알 수 없는 쓰기) 이미 바이트 한도에 도달한 경우 거부합니다
+func Check(scope, name string, limit Limit, usage Usage, documents, bytes int64) error {
+	if limit.MaxDocuments > 0 && documents > 0 && usage.Documents+documents > limit.MaxDocuments {
+		return &ExceededError{
+			Scope: scope, Name: name, Resource: ResourceDocuments,
+			Limit: limit.MaxDocuments, Used: usage.Documents, Requested: documents,
+		}
+	}
+	if limit.MaxBytes > 0 && (usage.Bytes+bytes > limit.MaxBytes || (bytes == 0 && usage.Bytes >= limit.MaxBytes)) {
+		return &ExceededError{
+			Scope: scope, Name: name, Resource: ResourceBytes,
+			Limit: limit.MaxBytes, Used: usage.Bytes, Requested: bytes,
+		}
+	}
+	return nil
+}
+
+// Claim은 Reserve로 확인할 한도 하나입니다
+type Claim struct {
+	Key   string // Tracker 키 (예: mongodb/orders)
+	Scope string
+	Name  string
+	Limit Limit
+}
+
+// Tracker는 키별 사용량을 보관합니다 (동시 사용 안전)
+type Tracker struct {
+	mu    sync.Mutex
+	usage map[string]Usage
+}
+
+// NewTracker는 새로운 Tracker를 생성합니다
+func NewTracker() *Tracker {
+	return &Tracker{usage: make(map[string]Usage)}
+}
+
+// Get은 키의 사용량을 반환합니다 (한 번도 Sync하지 않았으면 false)
+func (t *Tracker) Get(key string) (Usage, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	usage, ok := t.usage[key]
+	return usage, ok
+}
+
+// Sync는 키의 사용량을 저장소 통계 값으로 바꿉니다
+func (t *Tracker) Sync(key string, documents, bytes int64, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.usage[key] = Usage{Documents: documents, Bytes: bytes, SyncedAt: at}
+}
+
+// Reserve는 모든 claim이 한도 안이면 각 키의 사용량에 documents, bytes를 더합니다
+// 하나라도 넘으면 아무 것도 더하지 않고 처음 넘은 한도의 ExceededError를 반환합니다
+func (t *Tracker) Reserve(claims []Claim, documents, bytes int64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, c := range claims {
+		if err := Check(c.Scope, c.Name, c.Limit, t.usage[c.Key], documents, bytes); err != nil {
+			return err
+		}
+	}
+	for _, c := range claims {
+		usage := t.usage[c.Key]
+		usage.Documents += documents
+		usage.Bytes += bytes
+		t.usage[c.Key] = usage
+	}
+	return nil
+}
+
+// Release는 Reserve로 더한 사용량을 되돌립니다 (쓰기가 실패한 경우)
+func (t *Tracker) Release(claims []Claim, documents, bytes int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, c := range claims {
+		usage, ok := t.usage[c.Key]
+		if !ok {
+			continue
+		}
+		usage.Documents = max(usage.Documents-documents, 0)
+		usage.Bytes = max(usage.Bytes-bytes, 0)
+		t.usage[c.Key] = usage
+	}
+}
+
+// Keys는 사용량을 보관 중인 키를 정렬해 반환합니다
+func (t *Tracker) Keys() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	keys := make([]string, 0, len(t.usage))
+	for key := range t.usage {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limit은 토큰 버킷 파라미터입니다
+type Limit struct {
+	// Rate는 초당 보충되는 토큰 수입니다
+	Rate float64
+
+	// Burst는 버킷 최대 용량입니다
+	Burst int64
+}
+
+// IsZero는 제한이 설정되지 않았는지 확인합니다
+func (l Limit) IsZero() bool {
+	return l.Rate <= 0 || l.Burst <= 0
+}
+
+// Rule은 특정 라우트에 대한 제한 오버라이드입니다
+type Rule struct {
+	// Pattern은 HTTP의 경우 "METHOD /path", gRPC의 경우 전체 메서드 이름입니다
+	// path.Match 문법을 따르며, 끝이 "*"이면 접두사 매칭으로 처리합니다
+	// 예: "POST /api/v1/documents/bulk/*", "/database.v1.DatabaseService/BulkWrite"
+	Pattern string
+	Limit   Limit
+}
+
+// Policy는 기본 제한과 라우트별 오버라이드 목록입니다
+// 미들웨어에 넘긴 뒤에는 필드를 직접 바꾸지 말고 Update를 사용합니다
+type Policy struct {
+	Default Limit
+	Rules   []Rule
+
+	mu sync.RWMutex
+}
+
+// Update는 기본 제한과 오버라이드를 next의 값으로 교체합니다 (설정 다시 읽기용)
+// 이미 쌓인 버킷 토큰은 유지되고 다음 요청부터 새 제한으로 보충/소비됩니다
+func (p *Policy) Update(next *Policy) {
+	next.mu.RLock()
+	defaultLimit, rules := next.Default, next.Rules
+	next.mu.RUnlock()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Default = defaultLimit
+	p.Rules = rules
+}
+
+// Resolve는 라우트에 적용할 제한과 버킷 스코프를 반환합니다
+// 오버라이드된 라우트는 별도의 버킷을 사용하도록 스코프를 분리합니다
+func (p *Policy) Resolve(route string) (Limit, string) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, rule := range p.Rules {
+		if matchRoute(rule.Pattern, route) {
+			return rule.Limit, rule.Pattern
+		}
+	}
+	return p.Default, "default"
+}
+
+// matchRoute는 패턴과 라우트가 일치하는지 확인합니다
+func matchRoute(pattern, route string) bool {
+	if pattern == route {
+		return true
+	}
+	if strings.HasSuffix(pattern, "*") && strings.HasPrefix(route, strings.TrimSuffix(pattern, "*")) {
+		return true
+	}
+	ok, err := path.Match(pattern, route)
+	return err == nil && ok
+}
+
+// Result는 토큰 소비 결과입니다
+type Result struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64
+	RetryAfter time.Duration
+}
+
+// Limiter는 분산 토큰 버킷 구현 인터페이스입니다
+type Limiter interface {
+	Take(ctx context.Context, key string, limit Limit) (*Result, error)
+}
+
+// Identity는 요청자의 식별 정보입니다
+// 테넌트와 사용자는 인증을 통과한 principal(OIDC 클레임, 검증된 HMAC/API 키)에서만 채워야 합니다
+// 검증하지 않은 헤더 값으로 버킷을 나누면 요청마다 값을 바꿔 제한을 피할 수 있습니다
+type Identity struct {
+	TenantID string
+	Subject  string
+	ClientIP string // 신뢰할 수 있는 클라이언트 IP (위조 가능한 X-Forwarded-For 제외)
+}
+
+// Key는 버킷 키를 생성합니다 (테넌트 > 사용자 > IP 순)
+func (id Identity) Key() string {
+	switch {
+	case id.TenantID != "":
+		return "tenant:" + id.TenantID
+	case id.Subject != "":
+		return "user:" + id.Subject
+	default:
+		return "ip:" + id.ClientIP
+	}
+}
+
+// BucketKey는 식별자와 라우트 스코프를 결합한 버킷 키를 생성합니다
+func BucketKey(id Identity, scope string) string {
+	return fmt.Sprintf("%s:%s", id.Key(), scope)
+}
+
+// RetryAfterSeconds는 Retry-After 헤더 값(최소 1초)을 반환합니다
+func (r *Result) RetryAfterSeconds() int64 {
+	secs := int64(r.RetryAfter / time.Second)
+	if r.RetryAfter%time.Second != 0 {
+		secs++
+	}
+	if secs < 1 {
+		secs = 1
+	}
+	return secs
+}
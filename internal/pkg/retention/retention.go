@@ -0,0 +1,73 @@
+// Package retention은 컬렉션 보존 정책(최대 보존 기간, 최대 문서 수)에 따라 삭제할 문서를 고릅니다
+//
+// 문서는 생성 시각 기준으로 최신 MaxCount개만 남기고, 남은 문서 중 MaxAge보다 오래된 문서를 삭제 대상으로 선택합니다.
+// 저장소에 접근하지 않는 순수 함수라 실제 삭제와 dry-run 모두 같은 선택 결과를 사용합니다
+package retention
+
+import (
+	"sort"
+	"time"
+)
+
+// Reason은 문서가 삭제 대상이 된 이유입니다
+type Reason string
+
+const (
+	ReasonMaxAge   Reason = "max_age"   // 보존 기간 초과
+	ReasonMaxCount Reason = "max_count" // 최대 문서 수 초과
+)
+
+// Policy는 컬렉션 보존 정책입니다 (0이면 해당 제한 없음)
+type Policy struct {
+	MaxAge   time.Duration // 생성 후 이 기간이 지난 문서 삭제
+	MaxCount int           // 최신 문서를 이 개수만큼만 보존
+}
+
+// Enabled는 정책에 제한이 하나라도 있는지 반환합니다
+func (p Policy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxCount > 0
+}
+
+// Item은 보존 정책을 판단할 문서입니다
+type Item struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+// Victim은 삭제 대상 문서입니다
+type Victim struct {
+	ID     string
+	Reason Reason
+}
+
+// Select는 now 기준으로 policy를 넘은 문서를 오래된 문서부터 반환합니다
+// 두 제한을 모두 넘은 문서는 max_count로 분류합니다. items의 순서는 바뀔 수 있습니다
+func Select(items []Item, policy Policy, now time.Time) []Victim {
+	if !policy.Enabled() || len(items) == 0 {
+		return nil
+	}
+
+	// 최신 문서부터 정렬 (생성 시각이 같으면 ID 순으로 고정)
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].CreatedAt.Equal(items[j].CreatedAt) {
+			return items[i].CreatedAt.After(items[j].CreatedAt)
+		}
+		return items[i].ID < items[j].ID
+	})
+
+	var cutoff time.Time
+	if policy.MaxAge > 0 {
+		cutoff = now.Add(-policy.MaxAge)
+	}
+
+	var victims []Victim
+	for i := len(items) - 1; i >= 0; i-- {
+		switch {
+		case policy.MaxCount > 0 && i >= policy.MaxCount:
+			victims = append(victims, Victim{ID: items[i].ID, Reason: ReasonMaxCount})
+		case !cutoff.IsZero() && items[i].CreatedAt.Before(cutoff):
+			victims = append(victims, Victim{ID: items[i].ID, Reason: ReasonMaxAge})
+		}
+	}
+	return victims
+}
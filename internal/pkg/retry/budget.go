@@ -0,0 +1,148 @@
+package retry
+
+import (
+	"sync"
+	"time"
+)
+
+// BudgetConfig는 재시도 예산 설정입니다
+type BudgetConfig struct {
+	// Ratio는 요청 하나가 적립하는 예산입니다 (0.1이면 재시도는 요청 수의 10%까지)
+	Ratio float64
+
+	// MinPerSecond는 요청과 관계없이 초당 적립되는 예산입니다 (요청이 적을 때도 재시도할 수 있도록)
+	MinPerSecond float64
+
+	// Max는 적립할 수 있는 최대 예산입니다 (장애 직전에 쌓인 예산이 한꺼번에 재시도로 쓰이는 것을 제한)
+	Max float64
+}
+
+// DefaultBudgetConfig는 기본 재시도 예산 설정입니다
+func DefaultBudgetConfig() BudgetConfig {
+	return BudgetConfig{
+		Ratio:        0.1,
+		MinPerSecond: 10,
+		Max:          100,
+	}
+}
+
+// withDefaults는 비어 있는 값을 기본값으로 채웁니다
+func (c BudgetConfig) withDefaults() BudgetConfig {
+	defaults := DefaultBudgetConfig()
+	if c.Ratio <= 0 {
+		c.Ratio = defaults.Ratio
+	}
+	if c.MinPerSecond <= 0 {
+		c.MinPerSecond = defaults.MinPerSecond
+	}
+	if c.Max <= 0 {
+		c.Max = defaults.Max
+	}
+	return c
+}
+
+// Budget은 재시도 예산입니다
+// Do를 호출할 때마다 Ratio만큼 적립하고 재시도할 때마다 1을 차감하므로,
+// 백엔드가 장애로 모든 요청이 실패해도 재시도는 요청 수의 Ratio 비율을 넘지 않아 재시도 폭주(retry storm)를 막습니다
+type Budget struct {
+	cfg BudgetConfig
+
+	mu      sync.Mutex
+	balance float64
+	last    time.Time
+
+	onExhausted func()
+}
+
+// NewBudget은 새로운 Budget을 생성합니다 (처음에는 최대 예산으로 시작)
+func NewBudget(cfg BudgetConfig) *Budget {
+	cfg = cfg.withDefaults()
+	return &Budget{
+		cfg:     cfg,
+		balance: cfg.Max,
+		last:    time.Now(),
+	}
+}
+
+// deposit은 요청 하나만큼 예산을 적립합니다
+func (b *Budget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(time.Now())
+	b.balance = min(b.balance+b.cfg.Ratio, b.cfg.Max)
+}
+
+// withdraw는 재시도 하나만큼 예산을 차감하며, 예산이 부족하면 false를 반환합니다
+func (b *Budget) withdraw() bool {
+	b.mu.Lock()
+	b.refill(time.Now())
+	if b.balance < 1 {
+		b.mu.Unlock()
+		if b.onExhausted != nil {
+			b.onExhausted()
+		}
+		return false
+	}
+	b.balance--
+	b.mu.Unlock()
+	return true
+}
+
+// refill은 마지막 적립 이후 시간에 비례한 예산을 적립합니다
+func (b *Budget) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	b.last = now
+	if elapsed > 0 && b.cfg.MinPerSecond > 0 {
+		b.balance = min(b.balance+elapsed*b.cfg.MinPerSecond, b.cfg.Max)
+	}
+}
+
+// Balance는 현재 남은 예산을 반환합니다
+func (b *Budget) Balance() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(time.Now())
+	return b.balance
+}
+
+// Budgets는 이름(백엔드+작업)별 재시도 예산 모음입니다
+// 한 작업의 재시도가 다른 작업의 예산을 소진하지 않도록 작업마다 독립된 예산을 사용합니다
+type Budgets struct {
+	cfg         BudgetConfig
+	onExhausted func(name string)
+
+	mu      sync.RWMutex
+	budgets map[string]*Budget
+}
+
+// NewBudgets는 새로운 Budgets를 생성합니다
+// onExhausted는 예산이 부족해 재시도하지 않을 때 호출됩니다 (nil 가능)
+func NewBudgets(cfg BudgetConfig, onExhausted func(name string)) *Budgets {
+	return &Budgets{
+		cfg:         cfg,
+		onExhausted: onExhausted,
+		budgets:     make(map[string]*Budget),
+	}
+}
+
+// Get은 이름의 예산을 반환하며, 없으면 생성합니다
+func (b *Budgets) Get(name string) *Budget {
+	b.mu.RLock()
+	budget, ok := b.budgets[name]
+	b.mu.RUnlock()
+	if ok {
+		return budget
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if budget, ok := b.budgets[name]; ok {
+		return budget
+	}
+	budget = NewBudget(b.cfg)
+	if b.onExhausted != nil {
+		budget.onExhausted = func() { b.onExhausted(name) }
+	}
+	b.budgets[name] = budget
+	return budget
+}
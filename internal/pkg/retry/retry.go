@@ -3,13 +3,20 @@ package retry
 import (
 	"context"
 	"errors"
+	"io"
 	"math"
+	"math/rand"
+	"net"
+	"syscall"
 	"time"
 )
 
 var (
 	// ErrMaxRetriesExceeded는 최대 재시도 횟수를 초과했을 때 발생합니다
 	ErrMaxRetriesExceeded = errors.New("maximum retries exceeded")
+
+	// ErrBudgetExhausted는 재시도 예산이 소진되어 재시도하지 않았을 때 발생합니다
+	ErrBudgetExhausted = errors.New("retry budget exhausted")
 )
 
 // Config는 재시도 설정입니다
@@ -19,6 +26,17 @@ type Config struct {
 	MaxInterval     time.Duration // 최대 대기 시간
 	Multiplier      float64       // 대기 시간 증가 배율
 	MaxElapsedTime  time.Duration // 최대 재시도 시간
+
+	// Jitter가 true면 대기 시간을 0과 계산된 backoff 사이에서 무작위로 고릅니다 (full jitter)
+	// 같은 순간 실패한 요청들이 같은 시각에 다시 몰리지 않게 합니다
+	Jitter bool
+
+	// Retryable은 재시도할 에러인지 판별합니다 (nil이면 IsRetryable)
+	// 백엔드 드라이버의 에러 코드로 일시적 에러와 영구적 에러를 구분할 때 지정합니다
+	Retryable func(err error) bool
+
+	// Budget이 있으면 재시도마다 예산을 차감하고, 소진되면 재시도하지 않습니다
+	Budget *Budget
 }
 
 // DefaultConfig는 기본 재시도 설정입니다
@@ -29,6 +47,7 @@ func DefaultConfig() Config {
 		MaxInterval:     30 * time.Second,
 		Multiplier:      2.0,
 		MaxElapsedTime:  2 * time.Minute,
+		Jitter:          true,
 	}
 }
 
@@ -41,6 +60,14 @@ func Do(ctx context.Context, cfg Config, fn RetryableFunc) error {
 		cfg.MaxAttempts = 1
 	}
 
+	retryable := cfg.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	if cfg.Budget != nil {
+		cfg.Budget.deposit()
+	}
+
 	startTime := time.Now()
 	var lastErr error
 
@@ -59,7 +86,7 @@ func Do(ctx context.Context, cfg Config, fn RetryableFunc) error {
 		}
 
 		// 재시도 가능한 에러인지 확인
-		if !IsRetryable(lastErr) {
+		if !retryable(lastErr) {
 			return lastErr
 		}
 
@@ -79,6 +106,11 @@ func Do(ctx context.Context, cfg Config, fn RetryableFunc) error {
 			}
 		}
 
+		// 재시도 예산 확인 (장애 시 재시도가 부하를 키우지 않도록)
+		if cfg.Budget != nil && !cfg.Budget.withdraw() {
+			return errors.Join(ErrBudgetExhausted, lastErr)
+		}
+
 		// 대기
 		select {
 		case <-ctx.Done():
@@ -94,7 +126,7 @@ func Do(ctx context.Context, cfg Config, fn RetryableFunc) error {
 	return ErrMaxRetriesExceeded
 }
 
-// calculateBackoff은 exponential backoff를 계산합니다
+// calculateBackoff은 exponential backoff를 계산합니다 (Jitter면 0~backoff 사이 무작위)
 func calculateBackoff(cfg Config, attempt int) time.Duration {
 	backoff := float64(cfg.InitialInterval) * math.Pow(cfg.Multiplier, float64(attempt-1))
 
@@ -102,17 +134,65 @@ func calculateBackoff(cfg Config, attempt int) time.Duration {
 		backoff = float64(cfg.MaxInterval)
 	}
 
+	if cfg.Jitter && backoff >= 1 {
+		return time.Duration(rand.Int63n(int64(backoff) + 1))
+	}
 	return time.Duration(backoff)
 }
 
+// classifiedError는 재시도 여부가 지정된 에러입니다
+type classifiedError struct {
+	err       error
+	retryable bool
+}
+
+func (e *classifiedError) Error() string { return e.err.Error() }
+
+func (e *classifiedError) Unwrap() error { return e.err }
+
+// MarkRetryable은 에러를 재시도 가능한 에러로 표시합니다
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{err: err, retryable: true}
+}
+
+// MarkTerminal은 에러를 재시도하지 않을 에러로 표시합니다
+func MarkTerminal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{err: err, retryable: false}
+}
+
 // IsRetryable은 에러가 재시도 가능한지 확인합니다
+// MarkRetryable/MarkTerminal로 표시된 에러는 표시를 따르고,
+// 그 밖에는 네트워크 시간 초과, 연결 거부/재설정, 연결 중 끊김 같은 일시적 에러만 재시도합니다
+// 백엔드별 에러 코드는 각 저장소 패키지의 IsRetryable이 판별한 뒤 이 함수로 넘깁니다
 func IsRetryable(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	// 여기에 재시도 가능한 에러 타입을 추가합니다
-	// 예: 네트워크 에러, 타임아웃, 일시적인 데이터베이스 에러 등
+	var classified *classifiedError
+	if errors.As(err, &classified) {
+		return classified.retryable
+	}
+
+	// 호출자의 취소/마감은 다시 시도해도 성공하지 않습니다
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
 
 	switch err.Error() {
 	case "connection refused", "connection reset", "timeout":
@@ -0,0 +1,372 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AWSConfig는 AWS Secrets Manager 공급자 설정입니다
+type AWSConfig struct {
+	Region   string
+	Endpoint string // 비어 있으면 https://secretsmanager.<region>.amazonaws.com (VPC 엔드포인트, LocalStack 등에 지정)
+
+	// 정적 키 (비어 있으면 환경변수, 웹 ID 토큰(IRSA), 컨테이너 자격증명(ECS, EKS Pod Identity) 순으로 찾습니다)
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	HTTPClient *http.Client // 비어 있으면 10초 타임아웃 클라이언트
+}
+
+// awsCredentials는 요청 서명에 쓰는 AWS 자격증명입니다
+type awsCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      time.Time // 비어 있으면 만료되지 않음
+}
+
+// AWSProvider는 AWS Secrets Manager의 시크릿을 읽는 공급자입니다
+// SDK 없이 GetSecretValue API를 SigV4로 서명해 호출하며, 항상 AWSCURRENT 버전을 읽습니다
+type AWSProvider struct {
+	config   AWSConfig
+	endpoint string
+	client   *http.Client
+
+	credentialsMutex sync.Mutex
+	credentials      *awsCredentials
+	loadCredentials  func(ctx context.Context) (*awsCredentials, error)
+}
+
+// NewAWSProvider는 새로운 AWS Secrets Manager 공급자를 생성합니다
+func NewAWSProvider(cfg AWSConfig) (*AWSProvider, error) {
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("aws region is required")
+	}
+	p := &AWSProvider{
+		config:   cfg,
+		endpoint: cfg.Endpoint,
+		client:   cfg.HTTPClient,
+	}
+	if p.endpoint == "" {
+		p.endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", cfg.Region)
+	}
+	if p.client == nil {
+		p.client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	loader, err := p.credentialsSource()
+	if err != nil {
+		return nil, err
+	}
+	p.loadCredentials = loader
+	return p, nil
+}
+
+// Name은 공급자 이름을 반환합니다
+func (p *AWSProvider) Name() string {
+	return "aws"
+}
+
+// GetSecret은 시크릿 ID(이름 또는 ARN)로 현재 버전(AWSCURRENT)을 가져옵니다
+func (p *AWSProvider) GetSecret(ctx context.Context, name string) (*Secret, error) {
+	body, err := json.Marshal(map[string]string{"SecretId": name})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secrets manager request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	creds, err := p.awsCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signAWSRequest(req, body, creds, p.config.Region, "secretsmanager", time.Now())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s from aws secrets manager: %w", name, err)
+	}
+	defer resp.Body.Close()
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aws secrets manager response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(payload, &apiErr)
+		if strings.HasSuffix(apiErr.Type, "ResourceNotFoundException") {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return nil, fmt.Errorf("aws secrets manager returned %d for secret %s: %s %s", resp.StatusCode, name, apiErr.Type, apiErr.Message)
+	}
+
+	var out struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary []byte `json:"SecretBinary"`
+		VersionID    string `json:"VersionId"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("failed to decode aws secrets manager response: %w", err)
+	}
+	raw := []byte(out.SecretString)
+	if out.SecretString == "" {
+		raw = out.SecretBinary
+	}
+	return &Secret{
+		Data:    parseSecretData(raw),
+		Version: out.VersionID,
+	}, nil
+}
+
+// awsCredentials는 서명에 쓸 자격증명을 반환하며, 임시 자격증명은 만료 5분 전에 다시 받습니다
+func (p *AWSProvider) awsCredentials(ctx context.Context) (*awsCredentials, error) {
+	p.credentialsMutex.Lock()
+	defer p.credentialsMutex.Unlock()
+
+	if p.credentials != nil && (p.credentials.Expiration.IsZero() || time.Until(p.credentials.Expiration) > 5*time.Minute) {
+		return p.credentials, nil
+	}
+	creds, err := p.loadCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws credentials: %w", err)
+	}
+	p.credentials = creds
+	return creds, nil
+}
+
+// credentialsSource는 설정과 환경변수로 자격증명 출처를 고릅니다
+// 정적 키 > AWS_ACCESS_KEY_ID > AWS_WEB_IDENTITY_TOKEN_FILE(IRSA) > AWS_CONTAINER_CREDENTIALS_*(ECS, EKS Pod Identity)
+func (p *AWSProvider) credentialsSource() (func(ctx context.Context) (*awsCredentials, error), error) {
+	static := func(creds *awsCredentials) func(context.Context) (*awsCredentials, error) {
+		return func(context.Context) (*awsCredentials, error) { return creds, nil }
+	}
+
+	if p.config.AccessKeyID != "" {
+		if p.config.SecretAccessKey == "" {
+			return nil, fmt.Errorf("aws secret access key is required with an access key id")
+		}
+		return static(&awsCredentials{
+			AccessKeyID:     p.config.AccessKeyID,
+			SecretAccessKey: p.config.SecretAccessKey,
+			SessionToken:    p.config.SessionToken,
+		}), nil
+	}
+	if id := os.Getenv("AWS_ACCESS_KEY_ID"); id != "" {
+		return static(&awsCredentials{
+			AccessKeyID:     id,
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		}), nil
+	}
+	if tokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"); tokenFile != "" {
+		roleARN := os.Getenv("AWS_ROLE_ARN")
+		if roleARN == "" {
+			return nil, fmt.Errorf("AWS_ROLE_ARN is required with AWS_WEB_IDENTITY_TOKEN_FILE")
+		}
+		return func(ctx context.Context) (*awsCredentials, error) {
+			return p.assumeRoleWithWebIdentity(ctx, roleARN, tokenFile)
+		}, nil
+	}
+	if uri := containerCredentialsURI(); uri != "" {
+		return func(ctx context.Context) (*awsCredentials, error) {
+			return p.containerCredentials(ctx, uri)
+		}, nil
+	}
+	return nil, fmt.Errorf("no aws credentials found (set secrets.aws.access_key_id, AWS_ACCESS_KEY_ID, IRSA or container credentials)")
+}
+
+// assumeRoleWithWebIdentity는 서비스 어카운트 토큰으로 STS 임시 자격증명을 받습니다 (IRSA)
+func (p *AWSProvider) assumeRoleWithWebIdentity(ctx context.Context, roleARN, tokenFile string) (*awsCredentials, error) {
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read web identity token: %w", err)
+	}
+	sessionName := os.Getenv("AWS_ROLE_SESSION_NAME")
+	if sessionName == "" {
+		sessionName = "database-service"
+	}
+	form := url.Values{
+		"Action":           {"AssumeRoleWithWebIdentity"},
+		"Version":          {"2011-06-15"},
+		"RoleArn":          {roleARN},
+		"RoleSessionName":  {sessionName},
+		"WebIdentityToken": {strings.TrimSpace(string(token))},
+	}
+	endpoint := os.Getenv("AWS_STS_ENDPOINT")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://sts.%s.amazonaws.com", p.config.Region)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume role with web identity: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sts returned %d for AssumeRoleWithWebIdentity", resp.StatusCode)
+	}
+
+	var out struct {
+		Credentials struct {
+			AccessKeyID     string    `xml:"AccessKeyId"`
+			SecretAccessKey string    `xml:"SecretAccessKey"`
+			SessionToken    string    `xml:"SessionToken"`
+			Expiration      time.Time `xml:"Expiration"`
+		} `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode sts response: %w", err)
+	}
+	return &awsCredentials{
+		AccessKeyID:     out.Credentials.AccessKeyID,
+		SecretAccessKey: out.Credentials.SecretAccessKey,
+		SessionToken:    out.Credentials.SessionToken,
+		Expiration:      out.Credentials.Expiration,
+	}, nil
+}
+
+// containerCredentialsURI는 ECS/EKS Pod Identity 자격증명 엔드포인트 주소를 반환합니다
+func containerCredentialsURI() string {
+	if uri := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI"); uri != "" {
+		return uri
+	}
+	if path := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); path != "" {
+		return "http://169.254.170.2" + path
+	}
+	return ""
+}
+
+// containerCredentials는 컨테이너 자격증명 엔드포인트에서 임시 자격증명을 받습니다
+func (p *AWSProvider) containerCredentials(ctx context.Context, uri string) (*awsCredentials, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	token := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN")
+	if tokenFile := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"); tokenFile != "" {
+		content, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read container authorization token: %w", err)
+		}
+		token = strings.TrimSpace(string(content))
+	}
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container credentials: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("container credentials endpoint returned %d", resp.StatusCode)
+	}
+
+	var out struct {
+		AccessKeyID     string    `json:"AccessKeyId"`
+		SecretAccessKey string    `json:"SecretAccessKey"`
+		Token           string    `json:"Token"`
+		Expiration      time.Time `json:"Expiration"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode container credentials: %w", err)
+	}
+	return &awsCredentials{
+		AccessKeyID:     out.AccessKeyID,
+		SecretAccessKey: out.SecretAccessKey,
+		SessionToken:    out.Token,
+		Expiration:      out.Expiration,
+	}, nil
+}
+
+// signAWSRequest는 요청에 AWS Signature Version 4 서명 헤더를 추가합니다
+// 요청에 설정된 모든 헤더와 Host를 서명에 포함하므로 서명한 뒤에는 헤더를 바꾸면 안 됩니다
+func signAWSRequest(req *http.Request, body []byte, creds *awsCredentials, region, service string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		sha256Hex(body),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
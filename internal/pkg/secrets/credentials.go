@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// CredentialsManager는 시크릿 매니저의 데이터베이스 자격증명을 주기적으로 다시 읽어 교체를 감지하는 관리자입니다
+//
+// 시크릿 매니저의 교체(rotation)로 버전이나 값이 바뀌면 OnRotate 콜백을 호출합니다 (커넥션 풀 갱신 등)
+// 이전 자격증명으로 맺은 연결은 끊지 않습니다. 이전 비밀번호를 바로 무효화하는 교체 방식이면 다음 확인까지 새 연결이 실패할 수 있습니다
+type CredentialsManager struct {
+	provider    Provider
+	engine      string // 로그용 이름 (postgresql, mysql)
+	name        string
+	credentials *Credentials
+	onRotate    []func(*Credentials)
+	mutex       sync.RWMutex
+}
+
+// NewCredentialsManager는 새로운 시크릿 자격증명 관리자를 생성합니다
+func NewCredentialsManager(provider Provider, engine, name string) *CredentialsManager {
+	return &CredentialsManager{
+		provider: provider,
+		engine:   engine,
+		name:     name,
+	}
+}
+
+// OnRotate는 시크릿이 교체되었을 때 호출할 콜백을 등록합니다
+func (m *CredentialsManager) OnRotate(fn func(*Credentials)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.onRotate = append(m.onRotate, fn)
+}
+
+// GetCredentials는 현재 자격증명을 반환하며, 아직 읽지 않았으면 시크릿 매니저에서 가져옵니다
+func (m *CredentialsManager) GetCredentials(ctx context.Context) (*Credentials, error) {
+	m.mutex.RLock()
+	creds := m.credentials
+	m.mutex.RUnlock()
+
+	if creds != nil {
+		return creds, nil
+	}
+	if _, err := m.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.credentials, nil
+}
+
+// Current는 새 연결을 맺을 때 사용할 사용자 이름과 비밀번호를 반환합니다
+func (m *CredentialsManager) Current() (username, password string) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	if m.credentials == nil {
+		return "", ""
+	}
+	return m.credentials.Username, m.credentials.Password
+}
+
+// Refresh는 시크릿을 다시 읽고, 이전 값과 다르면 자격증명을 바꾼 뒤 OnRotate 콜백을 호출합니다
+func (m *CredentialsManager) Refresh(ctx context.Context) (bool, error) {
+	secret, err := m.provider.GetSecret(ctx, m.name)
+	if err != nil {
+		return false, err
+	}
+	creds, err := credentialsFromSecret(m.name, secret)
+	if err != nil {
+		return false, err
+	}
+
+	m.mutex.Lock()
+	previous := m.credentials
+	if previous != nil && *previous == *creds {
+		m.mutex.Unlock()
+		return false, nil
+	}
+	m.credentials = creds
+	callbacks := append([]func(*Credentials){}, m.onRotate...)
+	m.mutex.Unlock()
+
+	if previous == nil {
+		logger.Info(ctx, "secret manager credentials loaded",
+			zap.String("provider", m.provider.Name()),
+			zap.String("engine", m.engine),
+			zap.String("username", creds.Username),
+			zap.String("version", creds.Version),
+		)
+		return false, nil
+	}
+
+	logger.Info(ctx, "secret manager credentials rotated",
+		zap.String("provider", m.provider.Name()),
+		zap.String("engine", m.engine),
+		zap.String("username", creds.Username),
+		zap.String("previous_version", previous.Version),
+		zap.String("version", creds.Version),
+	)
+	for _, fn := range callbacks {
+		fn(creds)
+	}
+	return true, nil
+}
+
+// Run은 ctx가 취소될 때까지 interval마다 시크릿을 다시 읽어 교체를 반영합니다
+// 시크릿 매니저 장애 시에는 마지막으로 읽은 자격증명을 유지하고 에러 로그만 남깁니다
+func (m *CredentialsManager) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := m.Refresh(ctx); err != nil {
+				logger.Error(ctx, "failed to refresh secret manager credentials",
+					zap.String("provider", m.provider.Name()),
+					zap.String("engine", m.engine),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package secrets
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// gcpScope는 Secret Manager 호출에 필요한 OAuth 범위입니다
+const gcpScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// GCPConfig는 GCP Secret Manager 공급자 설정입니다
+type GCPConfig struct {
+	Project  string // 짧은 시크릿 이름을 찾을 프로젝트 ID
+	Endpoint string // 비어 있으면 https://secretmanager.googleapis.com
+
+	// 서비스 계정 키 파일 (비어 있으면 GOOGLE_APPLICATION_CREDENTIALS, 그것도 없으면 메타데이터 서버(GKE Workload Identity, GCE))
+	CredentialsFile string
+
+	HTTPClient *http.Client // 비어 있으면 10초 타임아웃 클라이언트
+}
+
+// gcpToken은 OAuth 액세스 토큰입니다
+type gcpToken struct {
+	AccessToken string
+	Expiry      time.Time
+}
+
+// GCPProvider는 GCP Secret Manager의 시크릿을 읽는 공급자입니다
+// SDK 없이 REST API(versions/latest:access)를 호출합니다
+type GCPProvider struct {
+	config   GCPConfig
+	endpoint string
+	client   *http.Client
+
+	tokenMutex sync.Mutex
+	token      *gcpToken
+	fetchToken func(ctx context.Context) (*gcpToken, error)
+}
+
+// NewGCPProvider는 새로운 GCP Secret Manager 공급자를 생성합니다
+func NewGCPProvider(cfg GCPConfig) (*GCPProvider, error) {
+	p := &GCPProvider{
+		config:   cfg,
+		endpoint: strings.TrimSuffix(cfg.Endpoint, "/"),
+		client:   cfg.HTTPClient,
+	}
+	if p.endpoint == "" {
+		p.endpoint = "https://secretmanager.googleapis.com"
+	}
+	if p.client == nil {
+		p.client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	credentialsFile := cfg.CredentialsFile
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	if credentialsFile != "" {
+		key, err := loadGCPServiceAccountKey(credentialsFile)
+		if err != nil {
+			return nil, err
+		}
+		p.fetchToken = func(ctx context.Context) (*gcpToken, error) {
+			return p.serviceAccountToken(ctx, key)
+		}
+	} else {
+		p.fetchToken = p.metadataToken
+	}
+	return p, nil
+}
+
+// Name은 공급자 이름을 반환합니다
+func (p *GCPProvider) Name() string {
+	return "gcp"
+}
+
+// GetSecret은 시크릿의 최신 버전을 가져옵니다
+// name은 짧은 이름(설정의 프로젝트 사용) 또는 projects/<프로젝트>/secrets/<이름>[/versions/<버전>] 형식입니다
+func (p *GCPProvider) GetSecret(ctx context.Context, name string) (*Secret, error) {
+	resource, err := p.resourceName(name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/v1/"+resource+":access", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret manager request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s from gcp secret manager: %w", name, err)
+	}
+	defer resp.Body.Close()
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gcp secret manager response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(payload, &apiErr)
+		return nil, fmt.Errorf("gcp secret manager returned %d for secret %s: %s", resp.StatusCode, name, apiErr.Error.Message)
+	}
+
+	var out struct {
+		Name    string `json:"name"`
+		Payload struct {
+			Data []byte `json:"data"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(payload, &out); err != nil {
+		return nil, fmt.Errorf("failed to decode gcp secret manager response: %w", err)
+	}
+	return &Secret{
+		Data:    parseSecretData(out.Payload.Data),
+		Version: out.Name[strings.LastIndex(out.Name, "/")+1:],
+	}, nil
+}
+
+// resourceName은 시크릿 이름을 버전 리소스 이름으로 바꿉니다 (버전을 지정하지 않으면 latest)
+func (p *GCPProvider) resourceName(name string) (string, error) {
+	if !strings.HasPrefix(name, "projects/") {
+		if p.config.Project == "" {
+			return "", fmt.Errorf("gcp project is required for secret %s", name)
+		}
+		name = "projects/" + p.config.Project + "/secrets/" + name
+	}
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+	return name, nil
+}
+
+// accessToken은 캐시된 토큰을 반환하며, 만료 1분 전이면 새로 받습니다
+func (p *GCPProvider) accessToken(ctx context.Context) (string, error) {
+	p.tokenMutex.Lock()
+	defer p.tokenMutex.Unlock()
+
+	if p.token != nil && time.Until(p.token.Expiry) > time.Minute {
+		return p.token.AccessToken, nil
+	}
+	token, err := p.fetchToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get gcp access token: %w", err)
+	}
+	p.token = token
+	return token.AccessToken, nil
+}
+
+// tokenResponse는 OAuth 토큰 응답입니다 (메타데이터 서버, 토큰 엔드포인트 공통)
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// decodeTokenResponse는 토큰 응답을 읽습니다
+func decodeTokenResponse(resp *http.Response) (*gcpToken, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned %d", resp.StatusCode)
+	}
+	var out tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if out.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no access_token")
+	}
+	return &gcpToken{
+		AccessToken: out.AccessToken,
+		Expiry:      time.Now().Add(time.Duration(out.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// metadataToken은 메타데이터 서버에서 기본 서비스 계정의 토큰을 받습니다 (GKE Workload Identity, GCE)
+func (p *GCPProvider) metadataToken(ctx context.Context) (*gcpToken, error) {
+	host := os.Getenv("GCE_METADATA_HOST")
+	if host == "" {
+		host = "metadata.google.internal"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"http://"+host+"/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+	return decodeTokenResponse(resp)
+}
+
+// gcpServiceAccountKey는 서비스 계정 키 파일에서 쓰는 필드입니다
+type gcpServiceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+	signer      *rsa.PrivateKey
+}
+
+// loadGCPServiceAccountKey는 서비스 계정 키 파일을 읽습니다
+func loadGCPServiceAccountKey(path string) (*gcpServiceAccountKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gcp credentials file: %w", err)
+	}
+	var key gcpServiceAccountKey
+	if err := json.Unmarshal(content, &key); err != nil {
+		return nil, fmt.Errorf("failed to parse gcp credentials file: %w", err)
+	}
+	if key.ClientEmail == "" || key.PrivateKey == "" {
+		return nil, fmt.Errorf("gcp credentials file must be a service account key")
+	}
+	if key.TokenURI == "" {
+		key.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("gcp service account private key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse gcp service account private key: %w", err)
+	}
+	signer, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("gcp service account private key is not an RSA key")
+	}
+	key.signer = signer
+	return &key, nil
+}
+
+// serviceAccountToken은 서비스 계정 키로 서명한 JWT를 토큰 엔드포인트에서 액세스 토큰으로 교환합니다
+func (p *GCPProvider) serviceAccountToken(ctx context.Context, key *gcpServiceAccountKey) (*gcpToken, error) {
+	now := time.Now()
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"iss":   key.ClientEmail,
+		"scope": gcpScope,
+		"aud":   key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(nil, key.signer, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign service account assertion: %w", err)
+	}
+	assertion := unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, key.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange service account assertion: %w", err)
+	}
+	defer resp.Body.Close()
+	return decodeTokenResponse(resp)
+}
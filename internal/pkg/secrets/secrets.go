@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound는 시크릿이 없을 때 반환됩니다
+var ErrNotFound = errors.New("secret not found")
+
+// Secret은 시크릿 매니저에서 읽은 시크릿 값입니다
+type Secret struct {
+	Data    map[string]string // JSON 객체 시크릿의 필드 (JSON이 아니면 "value" 하나)
+	Version string            // 공급자의 버전 ID (교체 감지에 사용)
+}
+
+// Provider는 데이터베이스 자격증명 등 시크릿을 가져오는 공급자 인터페이스입니다 (AWS Secrets Manager, GCP Secret Manager, Vault KV)
+type Provider interface {
+	// Name은 로그에 남길 공급자 이름을 반환합니다
+	Name() string
+
+	// GetSecret은 이름(공급자별 ID 또는 경로)으로 시크릿의 현재 버전을 가져옵니다
+	GetSecret(ctx context.Context, name string) (*Secret, error)
+}
+
+// parseSecretData는 시크릿 값을 필드 맵으로 변환합니다
+// JSON 객체이면 각 필드를 문자열로 바꾸고 (RDS 시크릿의 port 같은 숫자 포함), 아니면 "value" 필드에 원문을 담습니다
+func parseSecretData(raw []byte) map[string]string {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		return map[string]string{"value": string(raw)}
+	}
+
+	data := make(map[string]string, len(fields))
+	for key, value := range fields {
+		switch v := value.(type) {
+		case string:
+			data[key] = v
+		case nil:
+			data[key] = ""
+		default:
+			encoded, _ := json.Marshal(v)
+			data[key] = string(encoded)
+		}
+	}
+	return data
+}
+
+// Credentials는 시크릿에서 읽은 데이터베이스 사용자 이름과 비밀번호입니다
+type Credentials struct {
+	Username string
+	Password string
+	Version  string
+}
+
+// credentialsFromSecret은 시크릿의 username, password 필드로 자격증명을 만듭니다
+func credentialsFromSecret(name string, secret *Secret) (*Credentials, error) {
+	username, ok := secret.Data["username"]
+	if !ok || username == "" {
+		return nil, fmt.Errorf("username not found in secret %s", name)
+	}
+	password, ok := secret.Data["password"]
+	if !ok {
+		return nil, fmt.Errorf("password not found in secret %s", name)
+	}
+	return &Credentials{
+		Username: username,
+		Password: password,
+		Version:  secret.Version,
+	}, nil
+}
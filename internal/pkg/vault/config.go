@@ -39,6 +39,7 @@ type Config struct {
 	RedisPath   string // Redis 자격증명 경로
 	SecretsPath string // 정적 시크릿 경로
 	TransitPath string // Transit 암호화 경로
+	KafkaPath   string // Kafka SASL 자격증명 경로
 
 	// 리뉴얼 설정
 	RenewInterval      time.Duration // 자동 갱신 간격
@@ -63,6 +64,7 @@ func DefaultConfig() *Config {
 		RedisPath:          "secret/data/redis",
 		SecretsPath:        "secret/data/app",
 		TransitPath:        "transit",
+		KafkaPath:          "secret/data/kafka",
 		RenewInterval:      15 * time.Minute,
 		RenewBeforeExpiry:  5 * time.Minute,
 		MaxRetries:         3,
@@ -270,3 +270,27 @@ func (c *Client) GenerateDataKey(ctx context.Context, keyName string, bits int)
 
 	return plaintext, ciphertext, nil
 }
+
+// ===== 봉투 암호화 키 제공자 =====
+
+// TransitKeyProvider는 Transit 엔진의 데이터 키로 봉투 암호화를 하는 키 제공자입니다
+// encryption.KeyProvider를 구현합니다
+type TransitKeyProvider struct {
+	client  *Client
+	keyName string
+}
+
+// NewTransitKeyProvider는 새로운 TransitKeyProvider를 생성합니다
+func NewTransitKeyProvider(client *Client, keyName string) *TransitKeyProvider {
+	return &TransitKeyProvider{client: client, keyName: keyName}
+}
+
+// GenerateDataKey는 256비트 데이터 키의 평문과 Transit으로 래핑된 값을 반환합니다
+func (p *TransitKeyProvider) GenerateDataKey(ctx context.Context) ([]byte, string, error) {
+	return p.client.GenerateDataKey(ctx, p.keyName, 256)
+}
+
+// DecryptDataKey는 래핑된 데이터 키를 Transit으로 복호화합니다
+func (p *TransitKeyProvider) DecryptDataKey(ctx context.Context, wrapped string) ([]byte, error) {
+	return p.client.Decrypt(ctx, p.keyName, wrapped)
+}
@@ -0,0 +1,218 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// KafkaCredentials는 Kafka SASL 자격증명입니다
+// 시크릿에 ca_cert, client_cert, client_key(PEM)가 있으면 TLS 설정에도 사용합니다
+type KafkaCredentials struct {
+	Username   string
+	Password   string
+	CACert     []byte
+	ClientCert []byte
+	ClientKey  []byte
+	LeaseID    string
+	RenewAt    time.Time
+	ExpiresAt  time.Time
+}
+
+// KafkaCredentialsManager는 Kafka 자격증명을 가져오고 자동 갱신하는 관리자입니다
+// 동적 시크릿(리스 있음)은 만료 전에 재발급하고, 정적 KV 시크릿은 RenewInterval마다 다시 읽어 변경을 감지합니다
+type KafkaCredentialsManager struct {
+	client      *Client
+	path        string
+	credentials *KafkaCredentials
+	onRotate    []func(*KafkaCredentials)
+	mutex       sync.RWMutex
+	stopChan    chan struct{}
+	isRunning   bool
+}
+
+// NewKafkaCredentialsManager는 새로운 Kafka 자격증명 관리자를 생성합니다
+// path가 비어 있으면 클라이언트 설정의 KafkaPath를 사용합니다
+func NewKafkaCredentialsManager(client *Client, path string) *KafkaCredentialsManager {
+	if path == "" {
+		path = client.config.KafkaPath
+	}
+	return &KafkaCredentialsManager{
+		client:   client,
+		path:     path,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// OnRotate는 자격증명이 바뀌었을 때 호출할 콜백을 등록합니다 (프로듀서/컨슈머 재연결 등)
+func (m *KafkaCredentialsManager) OnRotate(fn func(*KafkaCredentials)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.onRotate = append(m.onRotate, fn)
+}
+
+// GetCredentials는 Kafka 자격증명을 가져옵니다 (캐시 또는 새로 발급)
+func (m *KafkaCredentialsManager) GetCredentials(ctx context.Context) (*KafkaCredentials, error) {
+	m.mutex.RLock()
+	creds := m.credentials
+	m.mutex.RUnlock()
+
+	if creds != nil && (creds.ExpiresAt.IsZero() || time.Now().Before(creds.ExpiresAt)) {
+		return creds, nil
+	}
+	return m.refresh(ctx)
+}
+
+// refresh는 Vault에서 자격증명을 다시 읽고, 바뀌었으면 콜백을 호출한 뒤 이전 리스를 취소합니다
+func (m *KafkaCredentialsManager) refresh(ctx context.Context) (*KafkaCredentials, error) {
+	// 캐시된 값이 아닌 최신 값을 읽어야 하므로 GetSecret/GetDynamicSecret를 거치지 않습니다
+	secret, err := m.client.client.Logical().ReadWithContext(ctx, m.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kafka credentials: %w", err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("kafka credentials not found at path: %s", m.path)
+	}
+
+	data := secret.Data
+	if nested, ok := secret.Data["data"].(map[string]interface{}); ok {
+		data = nested // KV v2
+	}
+
+	username, ok := data["username"].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("username not found in kafka credentials")
+	}
+	password, ok := data["password"].(string)
+	if !ok || password == "" {
+		return nil, fmt.Errorf("password not found in kafka credentials")
+	}
+
+	now := time.Now()
+	creds := &KafkaCredentials{
+		Username:   username,
+		Password:   password,
+		CACert:     pemField(data, "ca_cert"),
+		ClientCert: pemField(data, "client_cert"),
+		ClientKey:  pemField(data, "client_key"),
+		LeaseID:    secret.LeaseID,
+	}
+	if secret.LeaseDuration > 0 {
+		creds.ExpiresAt = now.Add(time.Duration(secret.LeaseDuration) * time.Second)
+		creds.RenewAt = creds.ExpiresAt.Add(-m.client.config.RenewBeforeExpiry)
+	} else {
+		creds.RenewAt = now.Add(m.client.config.RenewInterval)
+	}
+
+	m.mutex.Lock()
+	previous := m.credentials
+	m.credentials = creds
+	callbacks := append([]func(*KafkaCredentials){}, m.onRotate...)
+	m.mutex.Unlock()
+
+	changed := previous != nil && (previous.Username != creds.Username || previous.Password != creds.Password)
+	if changed {
+		// 콜백이 새 자격증명으로 재연결을 마친 뒤에 이전 리스를 취소합니다
+		for _, fn := range callbacks {
+			fn(creds)
+		}
+		logger.Info(ctx, "kafka credentials rotated",
+			zap.String("path", m.path),
+			zap.String("username", creds.Username),
+			zap.Time("expires_at", creds.ExpiresAt),
+		)
+	}
+
+	if previous != nil && previous.LeaseID != "" && previous.LeaseID != creds.LeaseID {
+		if err := m.client.RevokeSecret(ctx, previous.LeaseID); err != nil {
+			logger.Warn(ctx, "failed to revoke old kafka credentials",
+				zap.String("lease_id", previous.LeaseID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return creds, nil
+}
+
+// StartAutoRenewal은 자동 갱신을 시작합니다
+func (m *KafkaCredentialsManager) StartAutoRenewal(ctx context.Context) {
+	if m.isRunning {
+		logger.Warn(ctx, "kafka credentials auto renewal already running")
+		return
+	}
+
+	m.isRunning = true
+	go m.autoRenewalLoop(ctx)
+
+	logger.Info(ctx, "kafka credentials auto renewal started", zap.String("path", m.path))
+}
+
+// autoRenewalLoop는 자동 갱신 루프입니다
+func (m *KafkaCredentialsManager) autoRenewalLoop(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopChan:
+			return
+		case <-ticker.C:
+			m.mutex.RLock()
+			shouldRenew := m.credentials == nil || time.Now().After(m.credentials.RenewAt)
+			m.mutex.RUnlock()
+
+			if shouldRenew {
+				if _, err := m.refresh(ctx); err != nil {
+					logger.Error(ctx, "failed to auto-renew kafka credentials",
+						zap.String("path", m.path),
+						zap.Error(err),
+					)
+				}
+			}
+		}
+	}
+}
+
+// StopAutoRenewal은 자동 갱신을 중지합니다
+func (m *KafkaCredentialsManager) StopAutoRenewal() {
+	if !m.isRunning {
+		return
+	}
+
+	close(m.stopChan)
+	m.isRunning = false
+
+	logger.Info(context.Background(), "kafka credentials auto renewal stopped")
+}
+
+// Close는 관리자를 종료하고 동적 자격증명의 리스를 취소합니다
+func (m *KafkaCredentialsManager) Close(ctx context.Context) error {
+	m.StopAutoRenewal()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.credentials == nil || m.credentials.LeaseID == "" {
+		return nil
+	}
+	if err := m.client.RevokeSecret(ctx, m.credentials.LeaseID); err != nil {
+		return fmt.Errorf("failed to revoke kafka credentials: %w", err)
+	}
+	m.credentials = nil
+	return nil
+}
+
+// pemField는 시크릿 데이터에서 PEM 문자열 필드를 읽습니다
+func pemField(data map[string]interface{}, key string) []byte {
+	if v, ok := data[key].(string); ok && v != "" {
+		return []byte(v)
+	}
+	return nil
+}
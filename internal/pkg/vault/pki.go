@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// CertificateRequest는 PKI 인증서 발급 요청입니다
+type CertificateRequest struct {
+	CommonName string
+	AltNames   []string
+	IPSANs     []string
+	TTL        time.Duration
+}
+
+// CertificateManager는 Vault PKI로 서버 인증서를 발급하고 만료 전에 교체하는 관리자입니다
+// tls.Config.GetCertificate로 연결되므로 리스너를 다시 열지 않고 새 핸드셰이크부터 새 인증서를 사용합니다
+type CertificateManager struct {
+	client      *Client
+	path        string // 예: pki/issue/database-service
+	request     CertificateRequest
+	renewBefore time.Duration
+	certificate atomic.Pointer[tls.Certificate]
+	stopChan    chan struct{}
+	isRunning   bool
+}
+
+// NewCertificateManager는 새로운 인증서 관리자를 생성합니다
+// renewBefore가 0이면 인증서 유효 기간의 1/3이 남았을 때 교체합니다
+func NewCertificateManager(client *Client, path string, request CertificateRequest, renewBefore time.Duration) *CertificateManager {
+	return &CertificateManager{
+		client:      client,
+		path:        path,
+		request:     request,
+		renewBefore: renewBefore,
+		stopChan:    make(chan struct{}),
+	}
+}
+
+// Issue는 새 인증서를 발급받아 현재 인증서와 교체합니다
+func (m *CertificateManager) Issue(ctx context.Context) error {
+	data := map[string]interface{}{
+		"common_name": m.request.CommonName,
+	}
+	if len(m.request.AltNames) > 0 {
+		data["alt_names"] = strings.Join(m.request.AltNames, ",")
+	}
+	if len(m.request.IPSANs) > 0 {
+		data["ip_sans"] = strings.Join(m.request.IPSANs, ",")
+	}
+	if m.request.TTL > 0 {
+		data["ttl"] = m.request.TTL.String()
+	}
+
+	secret, err := m.client.client.Logical().WriteWithContext(ctx, m.path, data)
+	if err != nil {
+		return fmt.Errorf("failed to issue certificate: %w", err)
+	}
+	if secret == nil || secret.Data == nil {
+		return fmt.Errorf("empty response from pki issue endpoint: %s", m.path)
+	}
+
+	certPEM, _ := secret.Data["certificate"].(string)
+	keyPEM, _ := secret.Data["private_key"].(string)
+	if certPEM == "" || keyPEM == "" {
+		return fmt.Errorf("certificate or private_key not found in pki response")
+	}
+
+	// 클라이언트가 중간 CA까지 검증할 수 있도록 체인을 붙입니다
+	chain := []string{certPEM}
+	if caChain, ok := secret.Data["ca_chain"].([]interface{}); ok && len(caChain) > 0 {
+		for _, ca := range caChain {
+			if s, ok := ca.(string); ok {
+				chain = append(chain, s)
+			}
+		}
+	} else if issuingCA, ok := secret.Data["issuing_ca"].(string); ok && issuingCA != "" {
+		chain = append(chain, issuingCA)
+	}
+
+	cert, err := tls.X509KeyPair([]byte(strings.Join(chain, "\n")), []byte(keyPEM))
+	if err != nil {
+		return fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+	cert.Leaf = leaf
+
+	m.certificate.Store(&cert)
+
+	logger.Info(ctx, "tls certificate issued from vault pki",
+		zap.String("common_name", leaf.Subject.CommonName),
+		zap.String("serial", fmt.Sprintf("%x", leaf.SerialNumber)),
+		zap.Time("not_after", leaf.NotAfter),
+	)
+	return nil
+}
+
+// GetCertificate는 tls.Config.GetCertificate 콜백입니다
+func (m *CertificateManager) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := m.certificate.Load()
+	if cert == nil {
+		return nil, fmt.Errorf("no tls certificate issued yet")
+	}
+	return cert, nil
+}
+
+// TLSConfig는 현재 인증서를 동적으로 제공하는 서버 TLS 설정을 반환합니다
+func (m *CertificateManager) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: m.GetCertificate,
+	}
+}
+
+// renewAt은 현재 인증서를 교체할 시각을 반환합니다
+func (m *CertificateManager) renewAt() time.Time {
+	cert := m.certificate.Load()
+	if cert == nil || cert.Leaf == nil {
+		return time.Time{}
+	}
+	renewBefore := m.renewBefore
+	if renewBefore <= 0 {
+		renewBefore = cert.Leaf.NotAfter.Sub(cert.Leaf.NotBefore) / 3
+	}
+	return cert.Leaf.NotAfter.Add(-renewBefore)
+}
+
+// StartAutoRotation은 만료 전 자동 교체를 시작합니다
+func (m *CertificateManager) StartAutoRotation(ctx context.Context) {
+	if m.isRunning {
+		logger.Warn(ctx, "certificate auto rotation already running")
+		return
+	}
+
+	m.isRunning = true
+	go m.autoRotationLoop(ctx)
+
+	logger.Info(ctx, "certificate auto rotation started", zap.Time("renew_at", m.renewAt()))
+}
+
+// autoRotationLoop는 자동 교체 루프입니다
+// 발급에 실패하면 기존 인증서를 유지하고 다음 주기에 다시 시도합니다
+func (m *CertificateManager) autoRotationLoop(ctx context.Context) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopChan:
+			return
+		case <-ticker.C:
+			if time.Now().Before(m.renewAt()) {
+				continue
+			}
+			if err := m.Issue(ctx); err != nil {
+				logger.Error(ctx, "failed to rotate tls certificate",
+					zap.String("path", m.path),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// StopAutoRotation은 자동 교체를 중지합니다
+func (m *CertificateManager) StopAutoRotation() {
+	if !m.isRunning {
+		return
+	}
+
+	close(m.stopChan)
+	m.isRunning = false
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YouSangSon/database-service/internal/pkg/secrets"
+)
+
+// KVSecretProvider는 Vault KV 시크릿을 secrets.Provider로 제공합니다
+// 동적 자격증명(database/creds)은 리스 갱신이 필요하므로 SQLCredentialsManager를 사용합니다
+type KVSecretProvider struct {
+	client *Client
+}
+
+// NewKVSecretProvider는 새로운 Vault KV 시크릿 공급자를 생성합니다
+func NewKVSecretProvider(client *Client) *KVSecretProvider {
+	return &KVSecretProvider{
+		client: client,
+	}
+}
+
+// Name은 공급자 이름을 반환합니다
+func (p *KVSecretProvider) Name() string {
+	return "vault"
+}
+
+// GetSecret은 KV 경로(v2는 secret/data/...)의 시크릿을 읽습니다
+// 교체를 감지해야 하므로 클라이언트 캐시를 거치지 않습니다
+func (p *KVSecretProvider) GetSecret(ctx context.Context, path string) (*secrets.Secret, error) {
+	secret, err := p.client.client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret: %w", err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%w: %s", secrets.ErrNotFound, path)
+	}
+
+	// KV v2는 값이 data 아래에, 버전이 metadata 아래에 있습니다
+	fields := secret.Data
+	var version string
+	if data, ok := secret.Data["data"].(map[string]interface{}); ok {
+		fields = data
+		if metadata, ok := secret.Data["metadata"].(map[string]interface{}); ok && metadata["version"] != nil {
+			version = fmt.Sprint(metadata["version"])
+		}
+	}
+
+	data := make(map[string]string, len(fields))
+	for key, value := range fields {
+		if value != nil {
+			data[key] = fmt.Sprint(value)
+		}
+	}
+	return &secrets.Secret{
+		Data:    data,
+		Version: version,
+	}, nil
+}
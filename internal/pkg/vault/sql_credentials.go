@@ -0,0 +1,229 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SQLCredentialsManager는 PostgreSQL/MySQL 동적 자격증명을 발급하고 리스를 갱신하는 관리자입니다
+//
+// 리스는 만료 전에 연장하고, 최대 TTL에 도달해 더 연장할 수 없으면 새 자격증명을 발급해 OnRotate 콜백을 호출합니다
+// 이전 자격증명은 사용 중인 연결이 끝날 수 있도록 취소하지 않고 자연 만료되게 둡니다
+type SQLCredentialsManager struct {
+	client      *Client
+	engine      string // 로그용 이름 (postgresql, mysql)
+	path        string
+	credentials *DatabaseCredentials
+	onRotate    []func(*DatabaseCredentials)
+	mutex       sync.RWMutex
+	stopChan    chan struct{}
+	isRunning   bool
+}
+
+// NewSQLCredentialsManager는 새로운 SQL 자격증명 관리자를 생성합니다
+func NewSQLCredentialsManager(client *Client, engine, path string) *SQLCredentialsManager {
+	return &SQLCredentialsManager{
+		client:   client,
+		engine:   engine,
+		path:     path,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// OnRotate는 새 자격증명이 발급되었을 때 호출할 콜백을 등록합니다 (커넥션 풀 갱신 등)
+func (m *SQLCredentialsManager) OnRotate(fn func(*DatabaseCredentials)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.onRotate = append(m.onRotate, fn)
+}
+
+// GetCredentials는 현재 자격증명을 반환하며, 없거나 만료되었으면 새로 발급합니다
+func (m *SQLCredentialsManager) GetCredentials(ctx context.Context) (*DatabaseCredentials, error) {
+	m.mutex.RLock()
+	creds := m.credentials
+	m.mutex.RUnlock()
+
+	if creds != nil && time.Now().Before(creds.ExpiresAt) {
+		return creds, nil
+	}
+	return m.issue(ctx)
+}
+
+// Current는 새 연결을 맺을 때 사용할 사용자 이름과 비밀번호를 반환합니다
+func (m *SQLCredentialsManager) Current() (username, password string) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	if m.credentials == nil {
+		return "", ""
+	}
+	return m.credentials.Username, m.credentials.Password
+}
+
+// issue는 새 자격증명을 발급하고, 기존 자격증명이 있었으면 OnRotate 콜백을 호출합니다
+func (m *SQLCredentialsManager) issue(ctx context.Context) (*DatabaseCredentials, error) {
+	// 캐시된 값이 아닌 새 자격증명이 필요하므로 GetDynamicSecret를 거치지 않습니다
+	secret, err := m.client.client.Logical().ReadWithContext(ctx, m.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s credentials: %w", m.engine, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%s credentials not found at path: %s", m.engine, m.path)
+	}
+
+	username, ok := secret.Data["username"].(string)
+	if !ok {
+		return nil, fmt.Errorf("username not found in %s credentials", m.engine)
+	}
+	password, ok := secret.Data["password"].(string)
+	if !ok {
+		return nil, fmt.Errorf("password not found in %s credentials", m.engine)
+	}
+
+	creds := &DatabaseCredentials{
+		Username:      username,
+		Password:      password,
+		LeaseID:       secret.LeaseID,
+		LeaseDuration: secret.LeaseDuration,
+	}
+	m.setLease(creds, secret.LeaseDuration)
+
+	m.mutex.Lock()
+	rotated := m.credentials != nil
+	m.credentials = creds
+	callbacks := append([]func(*DatabaseCredentials){}, m.onRotate...)
+	m.mutex.Unlock()
+
+	logger.Info(ctx, "sql credentials issued",
+		zap.String("engine", m.engine),
+		zap.String("username", username),
+		zap.String("lease_id", secret.LeaseID),
+		zap.Time("expires_at", creds.ExpiresAt),
+	)
+
+	if rotated {
+		for _, fn := range callbacks {
+			fn(creds)
+		}
+	}
+	return creds, nil
+}
+
+// renew는 현재 리스를 연장하고, 연장할 수 없으면 새 자격증명을 발급합니다
+func (m *SQLCredentialsManager) renew(ctx context.Context) error {
+	m.mutex.RLock()
+	creds := m.credentials
+	m.mutex.RUnlock()
+
+	if creds == nil || creds.LeaseID == "" {
+		_, err := m.issue(ctx)
+		return err
+	}
+
+	secret, err := m.client.client.Sys().RenewWithContext(ctx, creds.LeaseID, creds.LeaseDuration)
+	if err != nil || secret == nil || time.Duration(secret.LeaseDuration)*time.Second <= m.client.config.RenewBeforeExpiry {
+		// 리스가 최대 TTL에 도달했거나 취소되었으므로 새 자격증명으로 교체합니다
+		logger.Info(ctx, "sql credentials lease cannot be extended, issuing new credentials",
+			zap.String("engine", m.engine),
+			zap.String("lease_id", creds.LeaseID),
+		)
+		_, err := m.issue(ctx)
+		return err
+	}
+
+	m.mutex.Lock()
+	m.setLease(creds, secret.LeaseDuration)
+	m.mutex.Unlock()
+
+	logger.Debug(ctx, "sql credentials lease renewed",
+		zap.String("engine", m.engine),
+		zap.String("lease_id", creds.LeaseID),
+		zap.Int("lease_duration", secret.LeaseDuration),
+	)
+	return nil
+}
+
+// setLease는 리스 기간으로부터 만료/갱신 시각을 계산합니다
+func (m *SQLCredentialsManager) setLease(creds *DatabaseCredentials, leaseDuration int) {
+	now := time.Now()
+	creds.ExpiresAt = now.Add(time.Duration(leaseDuration) * time.Second)
+	creds.RenewAt = creds.ExpiresAt.Add(-m.client.config.RenewBeforeExpiry)
+	if leaseDuration == 0 {
+		// 리스가 없는 자격증명은 만료되지 않습니다
+		creds.ExpiresAt = now.Add(100 * 365 * 24 * time.Hour)
+		creds.RenewAt = creds.ExpiresAt
+	}
+}
+
+// StartAutoRenewal은 자동 갱신을 시작합니다
+func (m *SQLCredentialsManager) StartAutoRenewal(ctx context.Context) {
+	if m.isRunning {
+		logger.Warn(ctx, "sql credentials auto renewal already running", zap.String("engine", m.engine))
+		return
+	}
+
+	m.isRunning = true
+	go m.autoRenewalLoop(ctx)
+
+	logger.Info(ctx, "sql credentials auto renewal started", zap.String("engine", m.engine))
+}
+
+// autoRenewalLoop는 자동 갱신 루프입니다
+func (m *SQLCredentialsManager) autoRenewalLoop(ctx context.Context) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopChan:
+			return
+		case <-ticker.C:
+			m.mutex.RLock()
+			shouldRenew := m.credentials == nil || time.Now().After(m.credentials.RenewAt)
+			m.mutex.RUnlock()
+
+			if shouldRenew {
+				if err := m.renew(ctx); err != nil {
+					logger.Error(ctx, "failed to auto-renew sql credentials",
+						zap.String("engine", m.engine),
+						zap.Error(err),
+					)
+				}
+			}
+		}
+	}
+}
+
+// StopAutoRenewal은 자동 갱신을 중지합니다
+func (m *SQLCredentialsManager) StopAutoRenewal() {
+	if !m.isRunning {
+		return
+	}
+
+	close(m.stopChan)
+	m.isRunning = false
+}
+
+// Close는 자동 갱신을 중지하고 현재 리스를 취소합니다
+// 커넥션 풀을 닫은 뒤에 호출해야 합니다
+func (m *SQLCredentialsManager) Close(ctx context.Context) error {
+	m.StopAutoRenewal()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.credentials == nil || m.credentials.LeaseID == "" {
+		return nil
+	}
+	if err := m.client.RevokeSecret(ctx, m.credentials.LeaseID); err != nil {
+		return fmt.Errorf("failed to revoke %s credentials: %w", m.engine, err)
+	}
+	m.credentials = nil
+	return nil
+}
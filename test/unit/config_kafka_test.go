@@ -0,0 +1,72 @@
+package unit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// newKafkaConfig는 프로듀서 설정만 바꿔 검증할 수 있는 최소 Kafka 설정을 생성합니다
+func newKafkaConfig(producer config.KafkaProducerConfig) *config.Config {
+	cfg := &config.Config{}
+	cfg.App.Name = "database-service"
+	cfg.Server.HTTP.Port = 8080
+	cfg.Server.GRPC.Port = 9090
+	cfg.Kafka.Enabled = true
+	cfg.Kafka.Brokers = []string{"localhost:9092"}
+	cfg.Kafka.Producer = producer
+	return cfg
+}
+
+func TestConfigValidate_KafkaProducer(t *testing.T) {
+	tests := []struct {
+		name     string
+		producer config.KafkaProducerConfig
+		message  string
+	}{
+		{
+			name:     "batching and compression",
+			producer: config.KafkaProducerConfig{Compression: "zstd", Linger: 5 * time.Millisecond, BatchBytes: 1 << 20, BatchMessages: 500, Async: true},
+		},
+		{
+			name:     "idempotent with acks all",
+			producer: config.KafkaProducerConfig{EnableIdempotent: true, RequiredAcks: -1},
+		},
+		{
+			name:     "unknown compression",
+			producer: config.KafkaProducerConfig{Compression: "brotli"},
+			message:  "kafka.producer.compression must be one of none, gzip, snappy, lz4, zstd",
+		},
+		{
+			name:     "negative batch size",
+			producer: config.KafkaProducerConfig{BatchMessages: -1},
+			message:  "must not be negative",
+		},
+		{
+			name:     "negative linger",
+			producer: config.KafkaProducerConfig{Linger: -time.Millisecond},
+			message:  "kafka.producer.linger, timeout and retry_backoff must not be negative",
+		},
+		{
+			name:     "idempotent with leader ack",
+			producer: config.KafkaProducerConfig{EnableIdempotent: true, RequiredAcks: 1},
+			message:  "kafka.producer.enable_idempotent requires required_acks to be -1 (all)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := newKafkaConfig(tt.producer).Validate()
+
+			// Assert
+			if tt.message == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.message)
+		})
+	}
+}
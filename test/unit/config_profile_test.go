@@ -0,0 +1,136 @@
+package unit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profileBaseConfig = `
+app:
+  name: "database-service"
+  environment: "development"
+server:
+  http:
+    port: 8080
+    read_timeout: 30s
+    allowed_origins:
+      - "http://localhost:3000"
+      - "http://localhost:8080"
+redis:
+  host: "localhost"
+  pool_size: 100
+`
+
+const profileProductionOverlay = `
+app:
+  environment: "production"
+server:
+  http:
+    read_timeout: 60s
+    allowed_origins:
+      - "https://yourdomain.com"
+redis:
+  host: "redis.production.svc.cluster.local"
+`
+
+func writeProfileConfigs(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func TestLoadConfig_MergesProfileOverlay(t *testing.T) {
+	// Arrange
+	dir := writeProfileConfigs(t, map[string]string{
+		"config.yaml":            profileBaseConfig,
+		"config.production.yaml": profileProductionOverlay,
+	})
+	t.Setenv(config.ProfileEnv, "production")
+
+	// Act
+	cfg, sources, err := config.LoadConfigWithSources(dir, "config")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "production", sources.Profile)
+	assert.Equal(t, []string{filepath.Join(dir, "config.yaml"), filepath.Join(dir, "config.production.yaml")}, sources.Files)
+	assert.Equal(t, "production", cfg.App.Environment)
+	assert.Equal(t, "database-service", cfg.App.Name)                                   // 기본 파일 값 유지
+	assert.Equal(t, 8080, cfg.Server.HTTP.Port)                                         // 같은 맵의 다른 키 유지
+	assert.Equal(t, 60*time.Second, cfg.Server.HTTP.ReadTimeout)                        // 오버레이 값
+	assert.Equal(t, []string{"https://yourdomain.com"}, cfg.Server.HTTP.AllowedOrigins) // 목록은 통째로 교체
+	assert.Equal(t, "redis.production.svc.cluster.local", cfg.Redis.Host)
+	assert.Equal(t, 100, cfg.Redis.PoolSize)
+}
+
+func TestLoadConfig_EnvOverridesOverlay(t *testing.T) {
+	// Arrange
+	dir := writeProfileConfigs(t, map[string]string{
+		"config.yaml":            profileBaseConfig,
+		"config.production.yaml": profileProductionOverlay,
+	})
+	t.Setenv(config.ProfileEnv, "production")
+	t.Setenv("APP_REDIS_HOST", "redis-from-env")
+
+	// Act
+	cfg, err := config.LoadConfig(dir, "config")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "redis-from-env", cfg.Redis.Host)
+}
+
+func TestLoadConfig_ProfileFromBaseFileWithoutOverlay(t *testing.T) {
+	// Arrange
+	dir := writeProfileConfigs(t, map[string]string{
+		"config.yaml":            profileBaseConfig,
+		"config.production.yaml": profileProductionOverlay,
+	})
+	t.Setenv(config.ProfileEnv, "")
+
+	// Act
+	cfg, sources, err := config.LoadConfigWithSources(dir, "config")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "development", sources.Profile)
+	assert.Equal(t, []string{filepath.Join(dir, "config.yaml")}, sources.Files)
+	assert.Equal(t, "localhost", cfg.Redis.Host)
+}
+
+func TestLoadConfig_RejectsInvalidProfile(t *testing.T) {
+	// Arrange
+	dir := writeProfileConfigs(t, map[string]string{"config.yaml": profileBaseConfig})
+	t.Setenv(config.ProfileEnv, "../secrets")
+
+	// Act
+	_, err := config.LoadConfig(dir, "config")
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestLoadConfig_InvalidOverlayFails(t *testing.T) {
+	// Arrange
+	dir := writeProfileConfigs(t, map[string]string{
+		"config.yaml":         profileBaseConfig,
+		"config.staging.yaml": "server: [unclosed",
+	})
+	t.Setenv(config.ProfileEnv, "staging")
+
+	// Act
+	_, err := config.LoadConfig(dir, "config")
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "config.staging.yaml")
+}
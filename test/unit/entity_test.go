@@ -2,6 +2,7 @@ package unit
 
 import (
 	"testing"
+	"time"
 
 	"github.com/YouSangSon/database-service/internal/domain/entity"
 )
@@ -90,3 +91,111 @@ func TestDocument_Update_WithNilData(t *testing.T) {
 		t.Error("Update() with nil data should return error")
 	}
 }
+
+func TestDocument_IsExpired(t *testing.T) {
+	doc, _ := entity.NewDocument("sessions", map[string]interface{}{"user_id": "u1"})
+	now := time.Now()
+
+	if doc.IsExpired(now) {
+		t.Error("IsExpired() without expiry should be false")
+	}
+
+	doc.SetExpiresAt(now.Add(time.Minute))
+	if doc.IsExpired(now) {
+		t.Error("IsExpired() before expires_at should be false")
+	}
+	if !doc.IsExpired(now.Add(time.Minute)) {
+		t.Error("IsExpired() at expires_at should be true")
+	}
+
+	doc.SetExpiresAt(time.Time{})
+	if doc.IsExpired(now.Add(time.Hour)) {
+		t.Error("IsExpired() after clearing expiry should be false")
+	}
+}
+
+func TestFormatExpiresAt_SortsAsTime(t *testing.T) {
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("KST", 9*60*60))
+	earlier := entity.FormatExpiresAt(base)
+	later := entity.FormatExpiresAt(base.Add(1500 * time.Millisecond))
+
+	if len(earlier) != len(later) {
+		t.Errorf("FormatExpiresAt() lengths differ: %q, %q", earlier, later)
+	}
+	if earlier >= later {
+		t.Errorf("FormatExpiresAt() order = %q >= %q, want string order to match time order", earlier, later)
+	}
+
+	parsed, ok := entity.ParseExpiresAt(map[string]interface{}{entity.ExpiresAtField: earlier})
+	if !ok || !parsed.Equal(base) {
+		t.Errorf("ParseExpiresAt() = %v, %v, want %v", parsed, ok, base)
+	}
+	if _, ok := entity.ParseExpiresAt(map[string]interface{}{entity.ExpiresAtField: "tomorrow"}); ok {
+		t.Error("ParseExpiresAt() with invalid value should return false")
+	}
+}
+
+func TestDocument_DeletedAt(t *testing.T) {
+	doc, _ := entity.NewDocument("users", map[string]interface{}{"name": "John"})
+
+	if doc.IsDeleted() {
+		t.Error("IsDeleted() without marker should be false")
+	}
+	if _, ok := doc.DeletedAt(); ok {
+		t.Error("DeletedAt() without marker should return false")
+	}
+
+	deletedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	doc.Update(map[string]interface{}{"name": "John", entity.DeletedAtField: deletedAt.Format(time.RFC3339Nano)})
+
+	if !doc.IsDeleted() {
+		t.Error("IsDeleted() with marker should be true")
+	}
+	if got, ok := doc.DeletedAt(); !ok || !got.Equal(deletedAt) {
+		t.Errorf("DeletedAt() = %v, %v, want %v", got, ok, deletedAt)
+	}
+}
+
+func TestRevision_ValidAt(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	revision := &entity.Revision{ValidFrom: from, ValidTo: from.Add(time.Hour)}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"before valid from", from.Add(-time.Second), false},
+		{"at valid from", from, true},
+		{"within range", from.Add(30 * time.Minute), true},
+		{"at valid to", from.Add(time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := revision.ValidAt(tt.at); got != tt.want {
+				t.Errorf("ValidAt(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocument_ArchiveKey(t *testing.T) {
+	doc, _ := entity.NewDocument("orders", map[string]interface{}{"total": 10})
+
+	if doc.IsArchived() {
+		t.Error("IsArchived() without stub fields should be false")
+	}
+
+	doc.Update(map[string]interface{}{
+		entity.ArchiveKeyField: "archive/mongodb/orders/1/v1.json.gz",
+		entity.ArchivedAtField: "2026-03-01T00:00:00Z",
+	})
+
+	if key, ok := doc.ArchiveKey(); !ok || key != "archive/mongodb/orders/1/v1.json.gz" {
+		t.Errorf("ArchiveKey() = %q, %v", key, ok)
+	}
+	if !doc.IsArchived() {
+		t.Error("IsArchived() with stub fields should be true")
+	}
+}
@@ -0,0 +1,61 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIndexModelFromSpec_CopiesMongoIndexOptions(t *testing.T) {
+	// Arrange
+	spec := map[string]interface{}{
+		"v":                       int32(2),
+		"name":                    "status_1_created_-1",
+		"key":                     bson.D{{Key: "status", Value: int32(1)}, {Key: "created", Value: int32(-1)}},
+		"unique":                  true,
+		"expireAfterSeconds":      int64(3600),
+		"partialFilterExpression": map[string]interface{}{"archived": false},
+	}
+
+	// Act
+	model, ok := repository.IndexModelFromSpec(spec)
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"status": int32(1), "created": int32(-1)}, model.Keys)
+	require.NotNil(t, model.Options)
+	assert.Equal(t, "status_1_created_-1", model.Options.Name)
+	require.NotNil(t, model.Options.Unique)
+	assert.True(t, *model.Options.Unique)
+	assert.Nil(t, model.Options.Sparse)
+	require.NotNil(t, model.Options.ExpireAfter)
+	assert.Equal(t, int32(3600), *model.Options.ExpireAfter)
+	assert.Equal(t, bson.M{"archived": false}, model.Options.PartialFilter)
+}
+
+func TestIndexModelFromSpec_SkipsDefaultAndUnknownIndexes(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    map[string]interface{}
+		builtin bool
+	}{
+		{name: "mongodb _id", spec: map[string]interface{}{"name": "_id_", "key": bson.M{"_id": int32(1)}}, builtin: true},
+		{name: "postgresql primary key", spec: map[string]interface{}{"name": "orders_pkey", "definition": "CREATE UNIQUE INDEX orders_pkey ON orders USING btree (id)"}, builtin: true},
+		{name: "postgresql definition", spec: map[string]interface{}{"name": "idx_orders_status", "definition": "CREATE INDEX idx_orders_status ON orders ((data #>> '{status}'))"}},
+		{name: "mongodb text", spec: map[string]interface{}{"name": "title_text", "key": bson.M{"_fts": "text", "_ftsx": int32(1)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, ok := repository.IndexModelFromSpec(tt.spec)
+
+			// Assert
+			assert.False(t, ok)
+			assert.Equal(t, tt.builtin, repository.IsDefaultIndex(tt.spec))
+		})
+	}
+}
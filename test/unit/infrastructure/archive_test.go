@@ -0,0 +1,50 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/archive"
+	"github.com/YouSangSon/database-service/internal/infrastructure/backup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive_PutGetRoundTrip(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store, err := backup.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	archiver := archive.New(store)
+
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	doc := entity.ReconstructDocument("a/1", "orders", map[string]interface{}{"total": 42.5}, 7, created, created.Add(time.Hour))
+
+	// Act
+	key, err := archiver.Put(ctx, "mongodb", doc)
+	require.NoError(t, err)
+	restored, err := archiver.Get(ctx, key)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "archive/mongodb/orders/a%2F1/v7.json.gz", key)
+	assert.Equal(t, "a/1", restored.ID())
+	assert.Equal(t, "orders", restored.Collection())
+	assert.Equal(t, 7, restored.Version())
+	assert.Equal(t, 42.5, restored.Data()["total"])
+	assert.True(t, restored.UpdatedAt().Equal(created.Add(time.Hour)))
+}
+
+func TestArchive_GetMissingObject(t *testing.T) {
+	// Arrange
+	store, err := backup.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	// Act
+	_, err = archive.New(store).Get(context.Background(), "archive/mongodb/orders/missing/v1.json.gz")
+
+	// Assert
+	assert.ErrorIs(t, err, backup.ErrNotFound)
+}
@@ -0,0 +1,181 @@
+package infrastructure_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/backup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupSnapshot_RoundTrip(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store, err := backup.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	docs := []*entity.Document{
+		entity.ReconstructDocument("a", "users", map[string]interface{}{"name": "alice"}, 3, created, created.Add(time.Hour)),
+		entity.ReconstructDocument("b", "users", map[string]interface{}{"name": "bob"}, 1, created, created),
+	}
+
+	// Act - 백업
+	writer, err := backup.NewSnapshotWriter(ctx, store, backup.Manifest{BackupID: "users-1", Collection: "users", DatabaseType: "mongodb"})
+	require.NoError(t, err)
+	for _, doc := range docs {
+		require.NoError(t, writer.Write(doc))
+	}
+	written, err := writer.Commit(ctx)
+	require.NoError(t, err)
+
+	// Act - 다른 컬렉션으로 복원
+	manifest, err := backup.ReadManifest(ctx, store, "users-1")
+	require.NoError(t, err)
+	reader, err := backup.OpenSnapshot(ctx, store, manifest)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var restored []*entity.Document
+	for {
+		doc, err := reader.Next("users_copy")
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		restored = append(restored, doc)
+	}
+
+	// Assert
+	assert.Equal(t, int64(2), written.Documents)
+	assert.Equal(t, written.SHA256, manifest.SHA256)
+	assert.Equal(t, backup.Format, manifest.Format)
+	require.Len(t, restored, 2)
+	assert.Equal(t, "a", restored[0].ID())
+	assert.Equal(t, "users_copy", restored[0].Collection())
+	assert.Equal(t, "alice", restored[0].Data()["name"])
+	assert.Equal(t, 3, restored[0].Version())
+	assert.True(t, created.Equal(restored[0].CreatedAt()))
+}
+
+func TestBackupSnapshot_AbortedBackupHasNoManifest(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store, err := backup.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	writer, err := backup.NewSnapshotWriter(ctx, store, backup.Manifest{BackupID: "users-2", Collection: "users"})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(entity.ReconstructDocument("a", "users", map[string]interface{}{}, 1, time.Now(), time.Now())))
+
+	// Act
+	writer.Abort()
+	_, err = backup.ReadManifest(ctx, store, "users-2")
+
+	// Assert
+	assert.ErrorIs(t, err, backup.ErrNotFound)
+}
+
+func TestBackupSnapshot_DetectsChecksumMismatch(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := backup.NewLocalStore(dir)
+	require.NoError(t, err)
+
+	writer, err := backup.NewSnapshotWriter(ctx, store, backup.Manifest{BackupID: "users-3", Collection: "users"})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(entity.ReconstructDocument("a", "users", map[string]interface{}{"n": 1}, 1, time.Now(), time.Now())))
+	_, err = writer.Commit(ctx)
+	require.NoError(t, err)
+
+	// gzip 스트림 뒤에 바이트를 덧붙여 파일을 변조
+	f, err := os.OpenFile(filepath.Join(dir, backup.DocumentsKey("users-3")), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	manifest, err := backup.ReadManifest(ctx, store, "users-3")
+	require.NoError(t, err)
+	reader, err := backup.OpenSnapshot(ctx, store, manifest)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	// Act
+	var lastErr error
+	for {
+		_, err := reader.Next("users")
+		if err != nil {
+			lastErr = err
+			break
+		}
+	}
+
+	// Assert
+	assert.Error(t, lastErr)
+	assert.NotEqual(t, io.EOF, lastErr)
+}
+
+func TestBackupLocalStore_RejectsKeysOutsideDirectory(t *testing.T) {
+	// Arrange
+	store, err := backup.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	// Act
+	_, err = store.Create(context.Background(), "../escape/manifest.json")
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestBackupListManifests_FiltersCollectionAndSortsByStart(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store, err := backup.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	base := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	for _, m := range []backup.Manifest{
+		{BackupID: "users-b", Collection: "users", StartedAt: base.Add(2 * time.Hour)},
+		{BackupID: "users-a", Collection: "users", StartedAt: base},
+		{BackupID: "users-archive-a", Collection: "users-archive", StartedAt: base.Add(time.Hour)},
+	} {
+		writer, err := backup.NewSnapshotWriter(ctx, store, m)
+		require.NoError(t, err)
+		_, err = writer.Commit(ctx)
+		require.NoError(t, err)
+	}
+	aborted, err := backup.NewSnapshotWriter(ctx, store, backup.Manifest{BackupID: "users-c", Collection: "users"})
+	require.NoError(t, err)
+	aborted.Abort()
+
+	// Act
+	manifests, err := backup.ListManifests(ctx, store, "users")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	assert.Equal(t, "users-a", manifests[0].BackupID)
+	assert.Equal(t, "users-b", manifests[1].BackupID)
+}
+
+func TestBackupManifest_SnapshotTime(t *testing.T) {
+	// Arrange
+	started := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	completed := started.Add(10 * time.Minute)
+
+	// Act
+	consistent := (&backup.Manifest{Consistent: true, StartedAt: started, CompletedAt: completed}).SnapshotTime()
+	inconsistent := (&backup.Manifest{StartedAt: started, CompletedAt: completed}).SnapshotTime()
+
+	// Assert
+	assert.True(t, started.Equal(consistent))
+	assert.True(t, completed.Equal(inconsistent))
+}
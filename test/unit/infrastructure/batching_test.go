@@ -0,0 +1,108 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/batching"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchRecorder는 Save/SaveMany 호출을 기록하는 저장소입니다 (테스트에서 쓰는 메서드만 구현)
+type batchRecorder struct {
+	repository.DocumentRepository
+	mu      sync.Mutex
+	saves   int
+	batches [][]*entity.Document
+	err     error
+}
+
+func (r *batchRecorder) Save(ctx context.Context, doc *entity.Document) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saves++
+	return nil
+}
+
+func (r *batchRecorder) SaveMany(ctx context.Context, docs []*entity.Document) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, docs)
+	return r.err
+}
+
+func saveConcurrently(t *testing.T, repo repository.DocumentRepository, collection string, n int) []error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		doc := entity.ReconstructDocument(fmt.Sprintf("id-%d", i), collection, map[string]interface{}{"n": i}, 1, time.Now(), time.Now())
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = repo.Save(context.Background(), doc)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestBatchingRepository_CoalescesSavesIntoBatches(t *testing.T) {
+	// Arrange
+	inner := &batchRecorder{}
+	repo := batching.NewRepository(inner, batching.Config{
+		Collections: []string{"events"},
+		MaxDelay:    20 * time.Millisecond,
+		MaxBatch:    10,
+	})
+
+	// Act
+	errs := saveConcurrently(t, repo, "events", 25)
+	plain := repo.Save(context.Background(), entity.ReconstructDocument("x", "orders", map[string]interface{}{}, 1, time.Now(), time.Now()))
+
+	// Assert - 25건이 최대 10건씩 배치로 저장되고, 대상이 아닌 컬렉션은 바로 저장
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	require.NoError(t, plain)
+	total := 0
+	for _, batch := range inner.batches {
+		assert.LessOrEqual(t, len(batch), 10)
+		total += len(batch)
+	}
+	assert.Equal(t, 25, total)
+	assert.GreaterOrEqual(t, len(inner.batches), 3)
+	assert.Equal(t, 1, inner.saves)
+}
+
+func TestBatchingRepository_PropagatesBatchErrorAndFlushesOnClose(t *testing.T) {
+	// Arrange
+	inner := &batchRecorder{err: errors.New("write failed")}
+	repo := batching.NewRepository(inner, batching.Config{
+		Collections: []string{"events"},
+		MaxDelay:    time.Hour,
+	})
+
+	// Act
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		doc := entity.ReconstructDocument(fmt.Sprintf("id-%d", i), "events", map[string]interface{}{}, 1, time.Now(), time.Now())
+		go func() { errs <- repo.Save(context.Background(), doc) }()
+	}
+	time.Sleep(50 * time.Millisecond) // 3건이 버퍼에 담길 때까지 대기
+	repo.Close()
+
+	// Assert - MaxDelay 전에 Close가 대기 문서를 한 배치로 저장하고, 배치 에러가 모든 요청에 전달
+	for i := 0; i < 3; i++ {
+		err := <-errs
+		assert.ErrorContains(t, err, "write failed")
+	}
+	require.Len(t, inner.batches, 1)
+	assert.Len(t, inner.batches[0], 3)
+}
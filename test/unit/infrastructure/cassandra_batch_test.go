@@ -0,0 +1,43 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/cassandra"
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCassandraBatchError_SummarizesFailedBatches(t *testing.T) {
+	// Arrange
+	timeout := &gocql.RequestErrWriteTimeout{}
+	err := error(&cassandra.BatchError{
+		Total: 4,
+		Failures: []cassandra.BatchFailure{
+			{IDs: []string{"doc-1", "doc-2"}, Err: errors.New("coordinator overloaded")},
+			{IDs: []string{"doc-7"}, Err: timeout},
+		},
+	})
+
+	// Act
+	message := err.Error()
+
+	// Assert
+	assert.Equal(t, "2 of 4 batches failed (first: ids=doc-1,doc-2: coordinator overloaded)", message)
+	var writeTimeout *gocql.RequestErrWriteTimeout
+	assert.True(t, errors.As(err, &writeTimeout), "each batch error is reachable through the batch error")
+	assert.ErrorIs(t, err, timeout)
+}
+
+func TestCassandraSaveMany_EmptyInputIsNoop(t *testing.T) {
+	// Arrange
+	repo := cassandra.NewCassandraRepository(nil, "app")
+
+	// Act
+	err := repo.SaveMany(context.Background(), nil)
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,97 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPublisher는 마지막으로 발행된 data/changes를 기록하는 테스트용 CDC 발행자입니다
+type recordingPublisher struct {
+	data    map[string]interface{}
+	changes map[string]interface{}
+}
+
+func (p *recordingPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	p.data = data
+	return nil
+}
+
+func (p *recordingPublisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	p.data, p.changes = data, changes
+	return nil
+}
+
+func (p *recordingPublisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	return nil
+}
+
+func (p *recordingPublisher) SetOrigin(string)                          {}
+func (p *recordingPublisher) SetEncoder(encoder messaging.EventEncoder) {}
+
+func TestTransformPublisher_DropsAndRenamesFields(t *testing.T) {
+	// Arrange
+	next := &recordingPublisher{}
+	publisher, err := messaging.NewTransformPublisher(next, []messaging.FieldTransform{
+		{Collection: "*", Drop: []string{"password"}},
+		{Collection: "users", Drop: []string{"profile.ssn"}, Rename: []messaging.FieldRename{{From: "email", To: "contact_email"}}},
+	})
+	require.NoError(t, err)
+	data := map[string]interface{}{
+		"name":     "kim",
+		"email":    "kim@example.com",
+		"password": "secret",
+		"profile":  map[string]interface{}{"ssn": "900101-1234567", "city": "Seoul"},
+	}
+
+	// Act
+	err = publisher.PublishDocumentCreated(context.Background(), "doc-1", "users", data, 1)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":          "kim",
+		"contact_email": "kim@example.com",
+		"profile":       map[string]interface{}{"city": "Seoul"},
+	}, next.data)
+	assert.Contains(t, data, "password", "original data must not be modified")
+	assert.Contains(t, data["profile"], "ssn")
+}
+
+func TestTransformPublisher_ChangedOnlyLimitsUpdateData(t *testing.T) {
+	// Arrange
+	next := &recordingPublisher{}
+	publisher, err := messaging.NewTransformPublisher(next, []messaging.FieldTransform{
+		{Collection: "orders", ChangedOnly: true, Drop: []string{"card"}},
+	})
+	require.NoError(t, err)
+	data := map[string]interface{}{"status": "paid", "total": 100, "card": map[string]interface{}{"number": "4111"}}
+	changes := map[string]interface{}{"status": "paid", "card.number": "4111"}
+
+	// Act
+	err = publisher.PublishDocumentUpdated(context.Background(), "doc-1", "orders", data, 2, 1, changes)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"status": "paid"}, next.data)
+	assert.Equal(t, map[string]interface{}{"status": "paid"}, next.changes)
+}
+
+func TestTransformPublisher_IgnoresOtherCollections(t *testing.T) {
+	// Arrange
+	next := &recordingPublisher{}
+	publisher, err := messaging.NewTransformPublisher(next, []messaging.FieldTransform{
+		{Collection: "users", Drop: []string{"password"}},
+	})
+	require.NoError(t, err)
+
+	// Act
+	err = publisher.PublishDocumentCreated(context.Background(), "doc-1", "sessions", map[string]interface{}{"password": "x"}, 1)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"password": "x"}, next.data)
+}
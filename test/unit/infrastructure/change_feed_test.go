@@ -0,0 +1,102 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// drainChangeFeed는 피드가 닫힐 때까지 이벤트를 모읍니다
+func drainChangeFeed(t require.TestingT, feed *repository.ChangeFeed) []repository.ChangeEvent {
+	var events []repository.ChangeEvent
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event, ok := <-feed.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		case <-timeout:
+			require.FailNow(t, "change feed was not closed")
+		}
+	}
+}
+
+func TestChangeFeed_CloseEndsSubscriptionWithoutError(t *testing.T) {
+	// Arrange
+	stopped := make(chan struct{})
+	feed := repository.NewChangeFeed(context.Background(), 1, func(ctx context.Context, emit func(repository.ChangeEvent) bool) error {
+		defer close(stopped)
+		for emit(repository.ChangeEvent{Operation: repository.ChangeOperationInsert}) {
+		}
+		return ctx.Err()
+	})
+	<-feed.Events()
+
+	// Act
+	err := feed.Close()
+
+	// Assert
+	require.NoError(t, err)
+	<-stopped
+	drainChangeFeed(t, feed)
+	assert.NoError(t, feed.Err(), "closing is not a backend failure")
+}
+
+func TestChangeFeed_ReportsBackendError(t *testing.T) {
+	// Arrange
+	backendErr := errors.New("connection lost")
+
+	// Act
+	feed := repository.NewChangeFeed(context.Background(), 4, func(ctx context.Context, emit func(repository.ChangeEvent) bool) error {
+		emit(repository.ChangeEvent{Operation: repository.ChangeOperationDelete, DocumentID: "1"})
+		return backendErr
+	})
+	events := drainChangeFeed(t, feed)
+
+	// Assert
+	require.Len(t, events, 1)
+	assert.Equal(t, "1", events[0].DocumentID)
+	assert.ErrorIs(t, feed.Err(), backendErr)
+}
+
+func TestMongoDBWatchChanges_EndsFeedWhenStreamIsInvalidated(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("watch", func(mt *mtest.T) {
+		// Arrange
+		repo := mongodb.NewDocumentRepositoryWithClient(mt.Client, "app")
+		watcher, ok := repo.(repository.ChangeWatcher)
+		require.True(mt, ok)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "app.$cmd.aggregate", mtest.FirstBatch,
+			changeStreamEvent("t1", "insert", "users", "1", bson.E{Key: "fullDocument", Value: bson.D{
+				{Key: "_id", Value: "1"}, {Key: "collection", Value: "users"},
+				{Key: "data", Value: bson.D{{Key: "name", Value: "John"}}}, {Key: "version", Value: int32(1)},
+			}}),
+			changeStreamEvent("t2", "delete", "users", "1"),
+			changeStreamEvent("t3", "invalidate", "users", ""),
+		))
+
+		// Act
+		feed, err := watcher.WatchChanges(context.Background(), "users")
+		require.NoError(mt, err)
+		events := drainChangeFeed(mt, feed)
+
+		// Assert
+		require.Len(mt, events, 2)
+		assert.Equal(mt, repository.ChangeOperationInsert, events[0].Operation)
+		assert.Equal(mt, map[string]interface{}{"name": "John"}, events[0].Data)
+		assert.Equal(mt, repository.ChangeOperationDelete, events[1].Operation)
+		assert.Nil(mt, events[1].Data)
+		assert.ErrorContains(mt, feed.Err(), "change stream invalidated by invalidate")
+	})
+}
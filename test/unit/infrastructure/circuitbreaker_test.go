@@ -162,3 +162,85 @@ func TestCircuitBreaker_Counts(t *testing.T) {
 	assert.Equal(t, uint32(2), counts.TotalFailures)
 	assert.Equal(t, uint32(2), counts.ConsecutiveFailures)
 }
+
+func TestCircuitBreaker_TripHoldsOpenUntilReset(t *testing.T) {
+	// Arrange
+	cb := circuitbreaker.NewCircuitBreaker("test", circuitbreaker.Config{
+		Timeout: time.Millisecond * 10,
+	})
+	ctx := context.Background()
+	successFunc := func() (interface{}, error) {
+		return "success", nil
+	}
+
+	// Act
+	cb.Trip()
+	time.Sleep(time.Millisecond * 50)
+
+	// Assert - manually tripped circuit does not move to half-open after the timeout
+	assert.Equal(t, circuitbreaker.StateOpen, cb.State())
+	_, err := cb.Execute(ctx, successFunc)
+	assert.Equal(t, circuitbreaker.ErrCircuitOpen, err)
+	assert.True(t, cb.Status().Forced)
+
+	cb.Reset()
+	assert.Equal(t, circuitbreaker.StateClosed, cb.State())
+	_, err = cb.Execute(ctx, successFunc)
+	assert.NoError(t, err)
+}
+
+func TestCircuitBreakerRegistry_IsolatesBackends(t *testing.T) {
+	// Arrange
+	var transitions []string
+	registry := circuitbreaker.NewRegistry(
+		circuitbreaker.Settings{FailureRatio: 0.5, MinRequests: 2},
+		map[string]circuitbreaker.Settings{"cassandra": {MinRequests: 5}},
+		func(name string, from, to circuitbreaker.State) {
+			transitions = append(transitions, name+":"+from.String()+"->"+to.String())
+		},
+	)
+	ctx := context.Background()
+	failFunc := func() (interface{}, error) {
+		return nil, errors.New("test error")
+	}
+
+	// Act
+	for i := 0; i < 2; i++ {
+		_, _ = registry.Get("postgresql").Execute(ctx, failFunc)
+		_, _ = registry.Get("cassandra").Execute(ctx, failFunc)
+	}
+
+	// Assert
+	assert.Equal(t, circuitbreaker.StateOpen, registry.Get("postgresql").State())
+	assert.Equal(t, circuitbreaker.StateClosed, registry.Get("cassandra").State())
+	assert.Equal(t, circuitbreaker.StateClosed, registry.Get("mongodb").State())
+	assert.Equal(t, []string{"postgresql:closed->open"}, transitions)
+
+	statuses := registry.List()
+	assert.Len(t, statuses, 3)
+	assert.Equal(t, "cassandra", statuses[0].Name)
+	assert.Equal(t, "open", statuses[2].State)
+}
+
+func TestCircuitBreakerRegistry_UpdateKeepsStateAndAppliesThresholds(t *testing.T) {
+	// Arrange
+	registry := circuitbreaker.NewRegistry(circuitbreaker.Settings{FailureRatio: 0.5, MinRequests: 2}, nil, nil)
+	ctx := context.Background()
+	failFunc := func() (interface{}, error) {
+		return nil, errors.New("test error")
+	}
+	_, _ = registry.Get("cassandra").Execute(ctx, failFunc)
+
+	// Act
+	registry.Update(circuitbreaker.Settings{FailureRatio: 0.5, MinRequests: 5}, nil)
+	for i := 0; i < 3; i++ {
+		_, _ = registry.Get("cassandra").Execute(ctx, failFunc)
+	}
+	stateAfterFour := registry.Get("cassandra").State()
+	_, _ = registry.Get("cassandra").Execute(ctx, failFunc)
+
+	// Assert
+	assert.Equal(t, circuitbreaker.StateClosed, stateAfterFour, "raised min requests should apply to existing breakers")
+	assert.Equal(t, circuitbreaker.StateOpen, registry.Get("cassandra").State())
+	assert.Equal(t, uint32(0), registry.Get("cassandra").Counts().Requests)
+}
@@ -0,0 +1,147 @@
+package infrastructure_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCreatedEvent() messaging.DocumentCreatedEvent {
+	return messaging.DocumentCreatedEvent{
+		DocumentEvent: messaging.DocumentEvent{
+			EventID:    "doc-1-1700000000",
+			EventType:  messaging.EventDocumentCreated,
+			Timestamp:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			DocumentID: "doc-1",
+			Collection: "users",
+			Data:       map[string]interface{}{"name": "kim"},
+			Version:    1,
+		},
+	}
+}
+
+func TestEventEncoder_NativeByDefault(t *testing.T) {
+	// Arrange
+	event := newTestCreatedEvent()
+	encoder := messaging.EventEncoder{}
+
+	// Act
+	payload, err := encoder.Encode(&event.DocumentEvent, event)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", encoder.ContentType())
+
+	var decoded messaging.DocumentCreatedEvent
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, event.EventID, decoded.EventID)
+}
+
+func TestEventEncoder_CloudEventsEnvelope(t *testing.T) {
+	// Arrange
+	event := newTestCreatedEvent()
+	encoder := messaging.EventEncoder{Format: messaging.EventFormatCloudEvents, Source: "/database-service/test"}
+
+	// Act
+	payload, err := encoder.Encode(&event.DocumentEvent, event)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, messaging.CloudEventsContentType, encoder.ContentType())
+
+	var envelope messaging.CloudEvent
+	require.NoError(t, json.Unmarshal(payload, &envelope))
+	assert.Equal(t, "1.0", envelope.SpecVersion)
+	assert.Equal(t, event.EventID, envelope.ID)
+	assert.Equal(t, "/database-service/test", envelope.Source)
+	assert.Equal(t, "com.dbservice.document.created", envelope.Type)
+	assert.Equal(t, "users/doc-1", envelope.Subject)
+	assert.Equal(t, "doc-1", envelope.PartitionKey)
+	assert.True(t, event.Timestamp.Equal(envelope.Time))
+}
+
+func TestUnwrapEvent_AcceptsBothFormats(t *testing.T) {
+	// Arrange
+	event := newTestCreatedEvent()
+	native, err := messaging.EventEncoder{}.Encode(&event.DocumentEvent, event)
+	require.NoError(t, err)
+	wrapped, err := messaging.EventEncoder{Format: messaging.EventFormatCloudEvents}.Encode(&event.DocumentEvent, event)
+	require.NoError(t, err)
+
+	for _, message := range [][]byte{native, wrapped} {
+		// Act
+		data, err := messaging.UnwrapEvent(message)
+
+		// Assert
+		require.NoError(t, err)
+		var decoded messaging.DocumentCreatedEvent
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, "doc-1", decoded.DocumentID)
+		assert.Equal(t, "kim", decoded.Data["name"])
+	}
+}
+
+func TestEventEncoder_ProtobufRoundTrip(t *testing.T) {
+	// Arrange
+	event := messaging.DocumentUpdatedEvent{
+		DocumentEvent:   newTestCreatedEvent().DocumentEvent,
+		PreviousVersion: 1,
+		Changes:         map[string]interface{}{"name": "lee"},
+	}
+	event.EventType = messaging.EventDocumentUpdated
+	event.Version = 2
+	event.Metadata = map[string]string{messaging.MetadataOrigin: "host-1"}
+	encoder := messaging.EventEncoder{Format: messaging.EventFormatProtobuf}
+
+	// Act
+	payload, err := encoder.Encode(&event.DocumentEvent, event)
+	require.NoError(t, err)
+	data, err := messaging.DecodeProtoEvent(payload)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, messaging.ProtobufContentType, encoder.ContentType())
+
+	var decoded messaging.DocumentUpdatedEvent
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, messaging.EventDocumentUpdated, decoded.EventType)
+	assert.Equal(t, 2, decoded.Version)
+	assert.Equal(t, 1, decoded.PreviousVersion)
+	assert.Equal(t, "lee", decoded.Changes["name"])
+	assert.Equal(t, "host-1", decoded.Metadata[messaging.MetadataOrigin])
+	assert.True(t, event.Timestamp.Equal(decoded.Timestamp))
+}
+
+func TestEventEncoder_DebeziumEnvelope(t *testing.T) {
+	// Arrange
+	event := newTestCreatedEvent()
+	encoder := messaging.EventEncoder{Format: messaging.EventFormatDebezium, ServerName: "dbsvc"}
+
+	// Act
+	payload, err := encoder.Encode(&event.DocumentEvent, event)
+
+	// Assert
+	require.NoError(t, err)
+	var envelope messaging.DebeziumEnvelope
+	require.NoError(t, json.Unmarshal(payload, &envelope))
+	assert.Equal(t, messaging.DebeziumOpCreate, envelope.Op)
+	assert.Nil(t, envelope.Before)
+	assert.Equal(t, "doc-1", envelope.After["_id"])
+	assert.Equal(t, "kim", envelope.After["name"])
+	assert.Equal(t, "dbsvc", envelope.Source.Name)
+	assert.Equal(t, "users", envelope.Source.Collection)
+	assert.NotContains(t, event.Data, "_id")
+
+	data, err := messaging.UnwrapEvent(payload)
+	require.NoError(t, err)
+	var decoded messaging.DocumentCreatedEvent
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, event.EventID, decoded.EventID)
+	assert.Equal(t, "doc-1", decoded.DocumentID)
+	assert.Equal(t, messaging.EventDocumentCreated, decoded.EventType)
+	assert.Equal(t, map[string]interface{}{"name": "kim"}, decoded.Data)
+}
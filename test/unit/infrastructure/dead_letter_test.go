@@ -0,0 +1,112 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyPublisher는 처음 failures번 실패하는 테스트용 CDC 발행자입니다
+type flakyPublisher struct {
+	failures int
+	calls    int
+	deleted  []string
+}
+
+func (p *flakyPublisher) fail() error {
+	p.calls++
+	if p.calls <= p.failures {
+		return errors.New("broker unavailable")
+	}
+	return nil
+}
+
+func (p *flakyPublisher) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	return p.fail()
+}
+
+func (p *flakyPublisher) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	return p.fail()
+}
+
+func (p *flakyPublisher) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	if err := p.fail(); err != nil {
+		return err
+	}
+	p.deleted = append(p.deleted, docID)
+	return nil
+}
+
+func (p *flakyPublisher) SetOrigin(string)                          {}
+func (p *flakyPublisher) SetEncoder(encoder messaging.EventEncoder) {}
+
+type memoryDeadLetterStore struct {
+	events []*entity.DeadLetterEvent
+}
+
+func (s *memoryDeadLetterStore) Save(ctx context.Context, event *entity.DeadLetterEvent) error {
+	event.ID = "dl-1"
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestDeadLetterPublisher_RetriesBeforeSucceeding(t *testing.T) {
+	// Arrange
+	next := &flakyPublisher{failures: 2}
+	store := &memoryDeadLetterStore{}
+	publisher := messaging.NewDeadLetterPublisher(next, store, messaging.DeadLetterConfig{MaxAttempts: 3, Backoff: time.Millisecond})
+
+	// Act
+	err := publisher.PublishDocumentCreated(context.Background(), "doc-1", "users", map[string]interface{}{"name": "kim"}, 1)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.calls)
+	assert.Empty(t, store.events)
+}
+
+func TestDeadLetterPublisher_StoresEventAfterRetries(t *testing.T) {
+	// Arrange
+	next := &flakyPublisher{failures: 10}
+	store := &memoryDeadLetterStore{}
+	publisher := messaging.NewDeadLetterPublisher(next, store, messaging.DeadLetterConfig{MaxAttempts: 2, Backoff: time.Millisecond})
+
+	// Act
+	err := publisher.PublishDocumentUpdated(context.Background(), "doc-1", "users", map[string]interface{}{"name": "lee"}, 2, 1, nil)
+
+	// Assert
+	assert.ErrorIs(t, err, messaging.ErrDeadLettered)
+	require.Len(t, store.events, 1)
+	event := store.events[0]
+	assert.Equal(t, messaging.EventDocumentUpdated, event.EventType)
+	assert.Equal(t, "doc-1", event.DocumentID)
+	assert.Equal(t, 2, event.Version)
+	assert.Equal(t, 1, event.PreviousVersion)
+	assert.Equal(t, 2, event.Attempts)
+	assert.Contains(t, event.Error, "broker unavailable")
+}
+
+func TestDeadLetterPublisher_RedrivePublishesStoredEvent(t *testing.T) {
+	// Arrange
+	next := &flakyPublisher{}
+	publisher := messaging.NewDeadLetterPublisher(next, &memoryDeadLetterStore{}, messaging.DeadLetterConfig{})
+	event := &entity.DeadLetterEvent{
+		EventType:  messaging.EventDocumentDeleted,
+		DocumentID: "doc-9",
+		Collection: "users",
+		Version:    4,
+	}
+
+	// Act
+	err := publisher.Redrive(context.Background(), event)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-9"}, next.deleted)
+}
@@ -0,0 +1,152 @@
+package infrastructure_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	es "github.com/YouSangSon/database-service/internal/infrastructure/persistence/elasticsearch"
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkFailure는 fakeElasticsearch가 특정 문서에 돌려줄 항목 실패입니다
+type bulkFailure struct {
+	status    int
+	errorType string
+	reason    string
+}
+
+// fakeElasticsearch는 인덱스 존재 확인과 _bulk 요청에 응답하고 받은 bulk 동작을 기록하는 테스트용 서버입니다
+type fakeElasticsearch struct {
+	mu       sync.Mutex
+	failures map[string]bulkFailure
+	actions  []string
+	refresh  []string
+}
+
+// newFakeElasticsearchRepository는 fakeElasticsearch에 연결된 저장소를 생성합니다
+func newFakeElasticsearchRepository(t *testing.T, fake *fakeElasticsearch) repository.DocumentRepository {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/_bulk" {
+			// 인덱스는 이미 있음
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(fake.bulk(r))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{server.URL}})
+	require.NoError(t, err)
+	return es.NewElasticsearchRepository(client)
+}
+
+// bulk는 NDJSON 요청의 동작 줄마다 항목 결과를 생성합니다
+func (f *fakeElasticsearch) bulk(r *http.Request) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.refresh = append(f.refresh, r.URL.Query().Get("refresh"))
+
+	var items []interface{}
+	hasErrors := false
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		var line map[string]map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil || len(line) != 1 {
+			continue
+		}
+		for action, meta := range line {
+			switch action {
+			case "index", "create", "update", "delete":
+			default:
+				continue
+			}
+			id, _ := meta["_id"].(string)
+			f.actions = append(f.actions, action+":"+id)
+
+			item := map[string]interface{}{"_index": meta["_index"], "_id": id, "status": http.StatusOK, "result": "updated"}
+			switch action {
+			case "index", "create":
+				item["status"], item["result"] = http.StatusCreated, "created"
+			case "delete":
+				item["result"] = "deleted"
+			}
+			if failure, ok := f.failures[id]; ok {
+				hasErrors = true
+				item["status"] = failure.status
+				delete(item, "result")
+				if failure.errorType != "" {
+					item["error"] = map[string]interface{}{"type": failure.errorType, "reason": failure.reason}
+				} else {
+					item["result"] = "not_found"
+				}
+			}
+			items = append(items, map[string]interface{}{action: item})
+		}
+	}
+	return map[string]interface{}{"took": 1, "errors": hasErrors, "items": items}
+}
+
+func TestElasticsearchBulkWrite_ReportsPerItemFailuresInOperationOrder(t *testing.T) {
+	// Arrange
+	fake := &fakeElasticsearch{failures: map[string]bulkFailure{
+		"doc-2":   {status: http.StatusConflict, errorType: "version_conflict_engine_exception", reason: "version conflict"},
+		"missing": {status: http.StatusNotFound},
+	}}
+	repo := newFakeElasticsearchRepository(t, fake)
+	doc := entity.ReconstructDocument("doc-1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now())
+
+	// Act
+	result, err := repo.BulkWrite(context.Background(), []*repository.BulkOperation{
+		{Type: "insert", Collection: "users", Document: doc},
+		{Type: "update", Collection: "users", Filter: map[string]interface{}{"id": "doc-2"}, Update: map[string]interface{}{"name": "Jane"}},
+		{Type: "delete", Collection: "users", Filter: map[string]interface{}{"id": "missing"}},
+		{Type: "delete", Collection: "users", Filter: map[string]interface{}{"name": "John"}},
+		{Type: "replace", Collection: "users", Document: doc},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.InsertedCount)
+	assert.Equal(t, int64(0), result.DeletedCount)
+	assert.Equal(t, []string{"index:doc-1", "update:doc-2", "delete:missing"}, fake.actions)
+	require.Len(t, result.Errors, 3, "deleting a missing document is not a failure")
+	assert.Equal(t, repository.BulkWriteError{Index: 1, ID: "doc-2", Status: http.StatusConflict, Reason: "version_conflict_engine_exception: version conflict"}, result.Errors[0])
+	assert.Equal(t, 3, result.Errors[1].Index)
+	assert.Equal(t, "delete requires an id filter", result.Errors[1].Reason)
+	assert.Equal(t, 4, result.Errors[2].Index)
+	assert.Equal(t, "unsupported operation type: replace", result.Errors[2].Reason)
+}
+
+func TestElasticsearchSaveMany_FailsWhenAnyDocumentIsRejected(t *testing.T) {
+	// Arrange
+	fake := &fakeElasticsearch{failures: map[string]bulkFailure{
+		"doc-2": {status: http.StatusBadRequest, errorType: "mapper_parsing_exception", reason: "failed to parse field"},
+	}}
+	repo := newFakeElasticsearchRepository(t, fake)
+	repo.(*es.ElasticsearchRepository).SetBulkConfig(es.BulkConfig{Refresh: "wait_for"})
+	docs := []*entity.Document{
+		entity.ReconstructDocument("doc-1", "users", map[string]interface{}{"n": 1}, 1, time.Now(), time.Now()),
+		entity.ReconstructDocument("doc-2", "users", map[string]interface{}{"n": 2}, 1, time.Now(), time.Now()),
+	}
+
+	// Act
+	err := repo.SaveMany(context.Background(), docs)
+
+	// Assert
+	assert.ErrorContains(t, err, "failed to index 1 of 2 documents: doc-2: mapper_parsing_exception: failed to parse field")
+	assert.Equal(t, []string{"index:doc-1", "index:doc-2"}, fake.actions)
+	assert.Equal(t, []string{"wait_for"}, fake.refresh)
+}
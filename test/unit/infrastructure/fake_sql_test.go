@@ -0,0 +1,138 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSQLRows는 조회 결과입니다 (columns가 비어 있으면 결과 없음)
+type fakeSQLRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+// fakeSQLExec는 실행된 쓰기 쿼리와 인자입니다
+type fakeSQLExec struct {
+	query string
+	args  []driver.Value
+}
+
+// fakeSQLConn은 조회를 query 함수로 응답하고 쓰기 쿼리와 트랜잭션 결과를 기록하는 드라이버 연결입니다
+// exec가 지정되면 쓰기 쿼리의 결과를 정하며, 없으면 영향받은 행 1개로 응답합니다
+type fakeSQLConn struct {
+	mu        sync.Mutex
+	query     func(query string, args []driver.Value) (fakeSQLRows, error)
+	exec      func(query string, args []driver.Value) (driver.Result, error)
+	execs     []fakeSQLExec
+	commits   int
+	rollbacks int
+}
+
+func (c *fakeSQLConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *fakeSQLConn) Close() error { return nil }
+
+func (c *fakeSQLConn) Begin() (driver.Tx, error) { return &fakeSQLTx{conn: c}, nil }
+
+func (c *fakeSQLConn) QueryContext(ctx context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	if c.query == nil {
+		return &fakeSQLRowsCursor{}, nil
+	}
+	rows, err := c.query(query, namedValues(named))
+	if err != nil {
+		return nil, err
+	}
+	return &fakeSQLRowsCursor{rows: rows}, nil
+}
+
+func (c *fakeSQLConn) ExecContext(ctx context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	args := namedValues(named)
+	c.mu.Lock()
+	c.execs = append(c.execs, fakeSQLExec{query: query, args: args})
+	c.mu.Unlock()
+	if c.exec != nil {
+		return c.exec(query, args)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+// execsContaining은 substr을 포함하는 쓰기 쿼리를 실행 순서대로 반환합니다
+func (c *fakeSQLConn) execsContaining(substr string) []fakeSQLExec {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var matched []fakeSQLExec
+	for _, e := range c.execs {
+		if strings.Contains(e.query, substr) {
+			matched = append(matched, e)
+		}
+	}
+	return matched
+}
+
+func namedValues(named []driver.NamedValue) []driver.Value {
+	args := make([]driver.Value, len(named))
+	for i, v := range named {
+		args[i] = v.Value
+	}
+	return args
+}
+
+type fakeSQLTx struct {
+	conn *fakeSQLConn
+}
+
+func (t *fakeSQLTx) Commit() error {
+	t.conn.mu.Lock()
+	defer t.conn.mu.Unlock()
+	t.conn.commits++
+	return nil
+}
+
+func (t *fakeSQLTx) Rollback() error {
+	t.conn.mu.Lock()
+	defer t.conn.mu.Unlock()
+	t.conn.rollbacks++
+	return nil
+}
+
+type fakeSQLRowsCursor struct {
+	rows fakeSQLRows
+	next int
+}
+
+func (r *fakeSQLRowsCursor) Columns() []string { return r.rows.columns }
+
+func (r *fakeSQLRowsCursor) Close() error { return nil }
+
+func (r *fakeSQLRowsCursor) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows.values) {
+		return io.EOF
+	}
+	copy(dest, r.rows.values[r.next])
+	r.next++
+	return nil
+}
+
+type fakeSQLConnector struct {
+	conn *fakeSQLConn
+}
+
+func (c *fakeSQLConnector) Connect(context.Context) (driver.Conn, error) { return c.conn, nil }
+
+func (c *fakeSQLConnector) Driver() driver.Driver { return nil }
+
+func newFakeSQLDB(t *testing.T, conn *fakeSQLConn) *sql.DB {
+	t.Helper()
+	db := sql.OpenDB(&fakeSQLConnector{conn: conn})
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
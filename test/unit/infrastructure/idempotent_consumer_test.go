@@ -0,0 +1,103 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryDedupeStore는 테스트용 메모리 중복 제거 저장소입니다
+type memoryDedupeStore struct {
+	status map[string]string
+}
+
+func newMemoryDedupeStore() *memoryDedupeStore {
+	return &memoryDedupeStore{status: make(map[string]string)}
+}
+
+func (s *memoryDedupeStore) Reserve(ctx context.Context, consumer, eventID string, ttl time.Duration) (messaging.DedupeStatus, error) {
+	key := consumer + ":" + eventID
+	switch s.status[key] {
+	case "done":
+		return messaging.DedupeDuplicate, nil
+	case "processing":
+		return messaging.DedupeInProgress, nil
+	}
+	s.status[key] = "processing"
+	return messaging.DedupeAcquired, nil
+}
+
+func (s *memoryDedupeStore) Complete(ctx context.Context, consumer, eventID string, retention time.Duration) error {
+	s.status[consumer+":"+eventID] = "done"
+	return nil
+}
+
+func (s *memoryDedupeStore) Release(ctx context.Context, consumer, eventID string) error {
+	delete(s.status, consumer+":"+eventID)
+	return nil
+}
+
+func TestIdempotentConsumer_SkipsDuplicateEvents(t *testing.T) {
+	// Arrange
+	consumer := messaging.NewIdempotentConsumer(newMemoryDedupeStore(), messaging.IdempotencyConfig{Consumer: "replicator"})
+	calls := 0
+	handle := func(ctx context.Context) error {
+		calls++
+		return nil
+	}
+
+	// Act
+	first, err1 := consumer.Process(context.Background(), "evt-1", handle)
+	second, err2 := consumer.Process(context.Background(), "evt-1", handle)
+
+	// Assert
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	assert.True(t, first)
+	assert.False(t, second)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotentConsumer_ReleasesFailedEventsForRetry(t *testing.T) {
+	// Arrange
+	consumer := messaging.NewIdempotentConsumer(newMemoryDedupeStore(), messaging.IdempotencyConfig{Consumer: "replicator"})
+	calls := 0
+	handle := func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("replica unavailable")
+		}
+		return nil
+	}
+
+	// Act
+	_, err1 := consumer.Process(context.Background(), "evt-1", handle)
+	processed, err2 := consumer.Process(context.Background(), "evt-1", handle)
+
+	// Assert
+	assert.Error(t, err1)
+	require.NoError(t, err2)
+	assert.True(t, processed)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotentConsumer_WaitsForEventInProgress(t *testing.T) {
+	// Arrange
+	store := newMemoryDedupeStore()
+	store.status["replicator:evt-1"] = "processing"
+	consumer := messaging.NewIdempotentConsumer(store, messaging.IdempotencyConfig{Consumer: "replicator"})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Act
+	processed, err := consumer.Process(ctx, "evt-1", func(ctx context.Context) error { return nil })
+
+	// Assert
+	assert.False(t, processed)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
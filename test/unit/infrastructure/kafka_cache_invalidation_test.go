@@ -0,0 +1,59 @@
+package infrastructure_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheInvalidationConsumer_SkipsEventsFromOwnInstance(t *testing.T) {
+	// Arrange
+	topics := []string{"cdc.document.created", "cdc.document.updated", "cdc.document.deleted"}
+	event := func(id, origin string) messaging.DocumentUpdatedEvent {
+		return messaging.DocumentUpdatedEvent{DocumentEvent: messaging.DocumentEvent{
+			EventID:    "e-" + id,
+			DocumentID: id,
+			Collection: "users",
+			Version:    2,
+			Metadata:   map[string]string{kafka.MetadataOrigin: origin},
+		}}
+	}
+	broker := newMockKafkaBroker(t, "cache-instance-a", topics, []mockKafkaMessage{
+		{Topic: topics[1], Key: "own", Value: event("own", "instance-a")},
+		{Topic: topics[1], Key: "remote", Value: event("remote", "instance-b")},
+	})
+
+	var mu sync.Mutex
+	var invalidated []string
+	consumer, err := kafka.NewCacheInvalidationConsumer(&kafka.ConsumerConfig{
+		Brokers:       []string{broker.Addr()},
+		GroupID:       "cache-instance-a",
+		Topics:        topics,
+		InitialOffset: "oldest",
+	}, "instance-a", func(ctx context.Context, collection, id string) {
+		mu.Lock()
+		defer mu.Unlock()
+		invalidated = append(invalidated, collection+"/"+id)
+	})
+	require.NoError(t, err)
+
+	// Act
+	startCDCConsumer(t, consumer)
+
+	// Assert - 이 인스턴스가 발행한 이벤트는 이미 캐시에 반영되어 있어 건너뜁니다
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(invalidated) == 1
+	}, 10*time.Second, 20*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"users/remote"}, invalidated)
+}
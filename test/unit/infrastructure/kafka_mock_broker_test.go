@@ -0,0 +1,80 @@
+package infrastructure_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKafkaMessage는 mock 브로커가 토픽 파티션 0에서 전달할 메시지입니다
+type mockKafkaMessage struct {
+	Topic string
+	Key   string
+	Value interface{}
+}
+
+// newMockKafkaBroker는 컨슈머 그룹 하나가 주어진 토픽의 파티션 0을 처음부터 읽도록 응답하는 mock 브로커를 생성합니다
+// 발행 요청은 모두 성공으로 응답합니다
+func newMockKafkaBroker(t *testing.T, groupID string, topics []string, messages []mockKafkaMessage) *sarama.MockBroker {
+	t.Helper()
+	broker := sarama.NewMockBroker(t, 0)
+	t.Cleanup(broker.Close)
+
+	metadata := sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID())
+	offsets := sarama.NewMockOffsetResponse(t)
+	offsetFetch := sarama.NewMockOffsetFetchResponse(t).SetError(sarama.ErrNoError)
+	assignment := map[string][]int32{}
+	counts := map[string]int64{}
+	fetch := sarama.NewMockFetchResponse(t, len(messages)+1)
+	for _, msg := range messages {
+		value, err := json.Marshal(msg.Value)
+		require.NoError(t, err)
+		fetch.SetMessageWithKey(msg.Topic, 0, counts[msg.Topic], sarama.StringEncoder(msg.Key), sarama.ByteEncoder(value))
+		counts[msg.Topic]++
+	}
+	for _, topic := range topics {
+		metadata.SetLeader(topic, 0, broker.BrokerID())
+		offsets.SetOffset(topic, 0, sarama.OffsetOldest, 0).SetOffset(topic, 0, sarama.OffsetNewest, counts[topic])
+		offsetFetch.SetOffset(groupID, topic, 0, -1, "", sarama.ErrNoError)
+		fetch.SetHighWaterMark(topic, 0, counts[topic])
+		assignment[topic] = []int32{0}
+	}
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"ApiVersionsRequest":     sarama.NewMockApiVersionsResponse(t),
+		"MetadataRequest":        metadata,
+		"OffsetRequest":          offsets,
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).SetCoordinator(sarama.CoordinatorGroup, groupID, broker),
+		"HeartbeatRequest":       sarama.NewMockHeartbeatResponse(t),
+		"JoinGroupRequest":       sarama.NewMockJoinGroupResponse(t).SetGroupProtocol(sarama.RoundRobinBalanceStrategyName).SetMemberId("member").SetLeaderId("leader"),
+		"SyncGroupRequest": sarama.NewMockSyncGroupResponse(t).SetMemberAssignment(&sarama.ConsumerGroupMemberAssignment{
+			Version: 0,
+			Topics:  assignment,
+		}),
+		"OffsetFetchRequest":  offsetFetch,
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+		"LeaveGroupRequest":   sarama.NewMockLeaveGroupResponse(t),
+		"FetchRequest":        fetch,
+		"ProduceRequest":      sarama.NewMockProduceResponse(t),
+	})
+	return broker
+}
+
+// startCDCConsumer는 컨슈머를 백그라운드에서 시작하고 테스트가 끝나면 종료합니다
+func startCDCConsumer(t *testing.T, consumer *kafka.CDCConsumer) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = consumer.Start(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
@@ -0,0 +1,147 @@
+package infrastructure_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failedMessages는 프로듀서 ErrorHandler로 전달된 실패를 기록합니다
+type failedMessages struct {
+	mu     sync.Mutex
+	topics []string
+	errs   []error
+}
+
+func (f *failedMessages) handle(msg *sarama.ProducerMessage, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.topics = append(f.topics, msg.Topic)
+	f.errs = append(f.errs, err)
+}
+
+func (f *failedMessages) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.errs)
+}
+
+// newRejectingKafkaBroker는 모든 발행 요청을 err로 거부하는 mock 브로커를 생성합니다
+func newRejectingKafkaBroker(t *testing.T, topic string, err sarama.KError) *sarama.MockBroker {
+	t.Helper()
+	broker := sarama.NewMockBroker(t, 0)
+	t.Cleanup(broker.Close)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"ApiVersionsRequest": sarama.NewMockApiVersionsResponse(t),
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(topic, 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetError(topic, 0, err),
+	})
+	return broker
+}
+
+func TestParseCompression(t *testing.T) {
+	tests := []struct {
+		name  string
+		codec sarama.CompressionCodec
+	}{
+		{name: "", codec: sarama.CompressionNone},
+		{name: "none", codec: sarama.CompressionNone},
+		{name: "gzip", codec: sarama.CompressionGZIP},
+		{name: "Snappy", codec: sarama.CompressionSnappy},
+		{name: "lz4", codec: sarama.CompressionLZ4},
+		{name: "zstd", codec: sarama.CompressionZSTD},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			codec, err := kafka.ParseCompression(tt.name)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.codec, codec)
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		// Act
+		_, err := kafka.ParseCompression("brotli")
+
+		// Assert
+		assert.EqualError(t, err, `unsupported kafka compression "brotli"`)
+	})
+}
+
+func TestProducer_AsyncWaitsForBatchToFill(t *testing.T) {
+	// Arrange
+	broker := newMockKafkaBroker(t, "producer-test", []string{"documents.created"}, nil)
+	producer, err := kafka.NewProducer(&kafka.ProducerConfig{
+		Brokers:       []string{broker.Addr()},
+		ClientID:      "producer-test",
+		RequiredAcks:  sarama.WaitForLocal,
+		Compression:   sarama.CompressionGZIP,
+		UseAsync:      true,
+		Linger:        time.Minute,
+		BatchMessages: 3,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = producer.Close() })
+	ctx := context.Background()
+
+	// Act
+	for _, key := range []string{"1", "2"} {
+		require.NoError(t, producer.PublishRaw(ctx, "documents.created", key, []byte(`{}`), "application/json"))
+	}
+	time.Sleep(100 * time.Millisecond)
+	before := produceRequests(broker)
+	require.NoError(t, producer.PublishRaw(ctx, "documents.created", "3", []byte(`{}`), "application/json"))
+
+	// Assert
+	assert.Equal(t, 0, before, "a partial batch waits for the linger time")
+	assert.Eventually(t, func() bool { return produceRequests(broker) == 1 }, 5*time.Second, 10*time.Millisecond,
+		"the full batch is sent in one request")
+}
+
+func TestProducer_ReportsFailedMessagesToErrorHandler(t *testing.T) {
+	for _, async := range []bool{false, true} {
+		name := "sync"
+		if async {
+			name = "async"
+		}
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			broker := newRejectingKafkaBroker(t, "documents.created", sarama.ErrMessageSizeTooLarge)
+			failed := &failedMessages{}
+			producer, err := kafka.NewProducer(&kafka.ProducerConfig{
+				Brokers:      []string{broker.Addr()},
+				ClientID:     "producer-test",
+				RequiredAcks: sarama.WaitForLocal,
+				UseAsync:     async,
+				ErrorHandler: failed.handle,
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = producer.Close() })
+
+			// Act
+			err = producer.PublishRaw(context.Background(), "documents.created", "1", []byte(`{}`), "application/json")
+
+			// Assert
+			if async {
+				assert.NoError(t, err, "async publishing does not wait for the broker")
+			} else {
+				assert.ErrorContains(t, err, "failed to send event")
+			}
+			require.Eventually(t, func() bool { return failed.count() == 1 }, 5*time.Second, 10*time.Millisecond)
+			assert.Equal(t, []string{"documents.created"}, failed.topics)
+			assert.ErrorIs(t, failed.errs[0], sarama.ErrMessageSizeTooLarge)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// replayTestEvents는 두 CDC 토픽에 users 이벤트 3개와 orders 이벤트 1개를 시각이 섞이도록 배치합니다
+func replayTestEvents(base time.Time) []mockKafkaMessage {
+	return []mockKafkaMessage{
+		{Topic: "documents.created", Key: "1", Value: messaging.DocumentEvent{EventID: "e1", DocumentID: "1", Collection: "users", Timestamp: base}},
+		{Topic: "documents.created", Key: "9", Value: messaging.DocumentEvent{EventID: "e9", DocumentID: "9", Collection: "orders", Timestamp: base.Add(time.Second)}},
+		{Topic: "documents.created", Key: "2", Value: messaging.DocumentEvent{EventID: "e3", DocumentID: "2", Collection: "users", Timestamp: base.Add(3 * time.Second)}},
+		{Topic: "documents.updated", Key: "1", Value: messaging.DocumentEvent{EventID: "e2", DocumentID: "1", Collection: "users", Timestamp: base.Add(2 * time.Second)}},
+	}
+}
+
+// newTestReplayer는 mock 브로커에 연결된 프로듀서로 재생기를 생성합니다
+func newTestReplayer(t *testing.T, broker *sarama.MockBroker) *kafka.Replayer {
+	t.Helper()
+	producer, err := kafka.NewProducer(&kafka.ProducerConfig{
+		Brokers:      []string{broker.Addr()},
+		ClientID:     "replay-test",
+		RequiredAcks: sarama.WaitForLocal,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = producer.Close() })
+	return kafka.NewReplayer(producer, nil, []string{"documents.created", "documents.updated"})
+}
+
+// produceRequests는 mock 브로커가 받은 발행 요청 수를 반환합니다
+func produceRequests(broker *sarama.MockBroker) int {
+	count := 0
+	for _, rr := range broker.History() {
+		if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func TestReplayer_RejectsNonPositiveLimit(t *testing.T) {
+	// Arrange
+	replayer := kafka.NewReplayer(nil, nil, []string{"documents.created"})
+
+	// Act
+	_, err := replayer.Replay(context.Background(), &messaging.ReplayRequest{Collection: "users"})
+
+	// Assert
+	assert.ErrorContains(t, err, "replay limit must be positive")
+}
+
+func TestReplayer_DryRunCountsEarliestMatchesWithoutPublishing(t *testing.T) {
+	// Arrange
+	base := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	topics := []string{"documents.created", "documents.updated"}
+	broker := newMockKafkaBroker(t, "replay", topics, replayTestEvents(base))
+	replayer := newTestReplayer(t, broker)
+
+	// Act
+	result, err := replayer.Replay(context.Background(), &messaging.ReplayRequest{
+		ReplayID:   "r-1",
+		Collection: "users",
+		FromOffset: -1,
+		Limit:      2,
+		DryRun:     true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Scanned)
+	assert.Equal(t, 3, result.Matched, "events of other collections are skipped")
+	assert.Equal(t, 0, result.Replayed)
+	assert.True(t, result.Truncated)
+	assert.True(t, base.Add(2*time.Second).Equal(result.Next), "next resumes at the last replayed event across topics")
+	assert.Equal(t, 0, produceRequests(broker))
+}
+
+func TestReplayer_RepublishesMatchedEventsToTargetTopic(t *testing.T) {
+	// Arrange
+	base := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	topics := []string{"documents.created", "documents.updated", "documents.replay"}
+	broker := newMockKafkaBroker(t, "replay", topics, replayTestEvents(base))
+	replayer := newTestReplayer(t, broker)
+
+	// Act
+	result, err := replayer.Replay(context.Background(), &messaging.ReplayRequest{
+		ReplayID:    "r-2",
+		Collection:  "users",
+		FromOffset:  -1,
+		To:          base.Add(2 * time.Second),
+		TargetTopic: "documents.replay",
+		Limit:       10,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Matched, "events after To are not replayed")
+	assert.Equal(t, 2, result.Replayed)
+	assert.False(t, result.Truncated)
+	assert.Equal(t, 2, produceRequests(broker))
+}
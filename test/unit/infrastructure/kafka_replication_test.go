@@ -0,0 +1,167 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var replicationTopics = []string{"cdc.document.created", "cdc.document.updated", "cdc.document.deleted"}
+
+// memoryReplica는 적용한 이벤트를 기록하는 테스트용 복제 대상입니다 (repository.ReplicaRepository)
+// 버전 조건은 실제 구현처럼 이미 같은 이상 버전이 있으면 건너뜁니다
+type memoryReplica struct {
+	mu       sync.Mutex
+	versions map[string]int
+	applied  []string
+	calls    int
+	failures map[string]int // 문서 ID별로 남은 일시적 실패 횟수
+	invalid  map[string]bool
+}
+
+func newMemoryReplica() *memoryReplica {
+	return &memoryReplica{versions: map[string]int{}, failures: map[string]int{}, invalid: map[string]bool{}}
+}
+
+func (r *memoryReplica) ApplyUpsert(ctx context.Context, collection, id string, data map[string]interface{}, version int, updatedAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	if r.invalid[id] {
+		return false, fmt.Errorf("%w: invalid id %s", entity.ErrInvalidData, id)
+	}
+	if r.failures[id] > 0 {
+		r.failures[id]--
+		return false, errors.New("replica unavailable")
+	}
+	key := collection + "/" + id
+	if current, ok := r.versions[key]; ok && current >= version {
+		return false, nil
+	}
+	r.versions[key] = version
+	r.applied = append(r.applied, fmt.Sprintf("upsert %s v%d", key, version))
+	return true, nil
+}
+
+func (r *memoryReplica) ApplyDelete(ctx context.Context, collection, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := collection + "/" + id
+	if _, ok := r.versions[key]; !ok {
+		return false, nil
+	}
+	delete(r.versions, key)
+	r.applied = append(r.applied, "delete "+key)
+	return true, nil
+}
+
+func (r *memoryReplica) callCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func (r *memoryReplica) appliedEvents() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.applied...)
+}
+
+func replicationEvent(eventID, id string, version int) messaging.DocumentEvent {
+	return messaging.DocumentEvent{
+		EventID:    eventID,
+		Timestamp:  time.Now(),
+		DocumentID: id,
+		Collection: "users",
+		Data:       map[string]interface{}{"name": "John"},
+		Version:    version,
+	}
+}
+
+// runReplication은 mock 브로커의 메시지를 복제 컨슈머로 처리합니다 (테스트가 끝나면 컨슈머를 종료)
+func runReplication(t *testing.T, messages []mockKafkaMessage, replica *memoryReplica, dedupe *messaging.IdempotentConsumer) {
+	t.Helper()
+	broker := newMockKafkaBroker(t, "replicator", replicationTopics, messages)
+	consumer, err := kafka.NewReplicationConsumer(&kafka.ConsumerConfig{
+		Brokers:       []string{broker.Addr()},
+		GroupID:       "replicator",
+		Topics:        replicationTopics,
+		InitialOffset: "oldest",
+	}, replica, dedupe)
+	require.NoError(t, err)
+	startCDCConsumer(t, consumer)
+}
+
+func TestReplicationConsumer_AppliesEventsIdempotently(t *testing.T) {
+	// Arrange - 수정 이벤트가 재전달되고, 이미 적용된 이전 버전도 다시 도착하는 상황 (at-least-once)
+	replica := newMemoryReplica()
+	v1 := messaging.DocumentUpdatedEvent{DocumentEvent: replicationEvent("e1", "u1", 1)}
+	v2 := messaging.DocumentUpdatedEvent{DocumentEvent: replicationEvent("e2", "u1", 2)}
+	messages := []mockKafkaMessage{
+		{Topic: replicationTopics[1], Key: "u1", Value: v1},
+		{Topic: replicationTopics[1], Key: "u1", Value: v2},
+		{Topic: replicationTopics[1], Key: "u1", Value: v2},
+		{Topic: replicationTopics[1], Key: "u1", Value: v1},
+	}
+
+	// Act
+	runReplication(t, messages, replica, nil)
+
+	// Assert
+	assert.Eventually(t, func() bool {
+		return replica.callCount() == 4
+	}, 10*time.Second, 20*time.Millisecond)
+	assert.Equal(t, []string{"upsert users/u1 v1", "upsert users/u1 v2"}, replica.appliedEvents())
+}
+
+func TestReplicationConsumer_RetriesTransientFailuresAndDropsInvalidEvents(t *testing.T) {
+	// Arrange
+	replica := newMemoryReplica()
+	replica.failures["u1"] = 2
+	replica.invalid["bad id"] = true
+	messages := []mockKafkaMessage{
+		{Topic: replicationTopics[0], Key: "bad id", Value: messaging.DocumentCreatedEvent{DocumentEvent: replicationEvent("e0", "bad id", 1)}},
+		{Topic: replicationTopics[0], Key: "u1", Value: messaging.DocumentCreatedEvent{DocumentEvent: replicationEvent("e1", "u1", 1)}},
+	}
+
+	// Act
+	runReplication(t, messages, replica, nil)
+
+	// Assert - 잘못된 이벤트는 건너뛰고, 일시적인 실패는 성공할 때까지 재시도합니다
+	assert.Eventually(t, func() bool {
+		return len(replica.appliedEvents()) == 1
+	}, 10*time.Second, 20*time.Millisecond)
+	assert.Equal(t, []string{"upsert users/u1 v1"}, replica.appliedEvents())
+	assert.Equal(t, 4, replica.callCount(), "one call for the invalid event and three attempts for u1")
+}
+
+func TestReplicationConsumer_DedupeSkipsRedeliveredEventIDs(t *testing.T) {
+	// Arrange
+	replica := newMemoryReplica()
+	dedupe := messaging.NewIdempotentConsumer(newMemoryDedupeStore(), messaging.IdempotencyConfig{Consumer: "replicator"})
+	created := messaging.DocumentCreatedEvent{DocumentEvent: replicationEvent("e1", "u1", 1)}
+	messages := []mockKafkaMessage{
+		{Topic: replicationTopics[0], Key: "u1", Value: created},
+		{Topic: replicationTopics[0], Key: "u1", Value: created},
+		{Topic: replicationTopics[0], Key: "u2", Value: messaging.DocumentCreatedEvent{DocumentEvent: replicationEvent("e2", "u2", 1)}},
+	}
+
+	// Act
+	runReplication(t, messages, replica, dedupe)
+
+	// Assert - 재전달된 이벤트 ID는 복제 대상에 접근하기 전에 걸러집니다
+	assert.Eventually(t, func() bool {
+		return len(replica.appliedEvents()) == 2
+	}, 10*time.Second, 20*time.Millisecond)
+	assert.Equal(t, []string{"upsert users/u1 v1", "upsert users/u2 v1"}, replica.appliedEvents())
+	assert.Equal(t, 2, replica.callCount())
+}
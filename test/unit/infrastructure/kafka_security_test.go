@@ -0,0 +1,64 @@
+package infrastructure_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaSecurity_WithCredentialsKeepsOtherSettings(t *testing.T) {
+	// Arrange
+	security := &kafka.SecurityConfig{
+		SASL: kafka.SASLConfig{Enabled: true, Mechanism: kafka.SASLMechanismSCRAMSHA512, Username: "old", Password: "old-pass"},
+		TLS:  kafka.TLSConfig{Enabled: true, ServerName: "kafka.internal"},
+	}
+
+	// Act
+	rotated := security.WithCredentials("new", "new-pass")
+
+	// Assert
+	assert.Equal(t, "new", rotated.SASL.Username)
+	assert.Equal(t, "new-pass", rotated.SASL.Password)
+	assert.Equal(t, kafka.SASLMechanismSCRAMSHA512, rotated.SASL.Mechanism)
+	assert.Equal(t, "kafka.internal", rotated.TLS.ServerName)
+	assert.Equal(t, "old", security.SASL.Username, "the original config is not modified")
+}
+
+func TestKafkaSecurity_ProducerRejectsInvalidSettingsBeforeConnecting(t *testing.T) {
+	tests := []struct {
+		name     string
+		security *kafka.SecurityConfig
+		message  string
+	}{
+		{
+			name:     "missing password",
+			security: &kafka.SecurityConfig{SASL: kafka.SASLConfig{Enabled: true, Username: "svc"}},
+			message:  "username and password are required",
+		},
+		{
+			name:     "unsupported mechanism",
+			security: &kafka.SecurityConfig{SASL: kafka.SASLConfig{Enabled: true, Mechanism: "GSSAPI", Username: "svc", Password: "pw"}},
+			message:  "unsupported kafka sasl mechanism",
+		},
+		{
+			name:     "invalid ca pem",
+			security: &kafka.SecurityConfig{TLS: kafka.TLSConfig{Enabled: true, CAPEM: []byte("not a certificate")}},
+			message:  "failed to parse kafka ca certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := kafka.NewProducer(&kafka.ProducerConfig{
+				Brokers:  []string{"127.0.0.1:1"},
+				ClientID: "test",
+				Security: tt.security,
+			})
+
+			// Assert
+			assert.ErrorContains(t, err, tt.message)
+		})
+	}
+}
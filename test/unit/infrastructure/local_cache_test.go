@@ -0,0 +1,97 @@
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingCache는 조회 횟수를 기록하는 테스트용 원격 캐시입니다
+type countingCache struct {
+	values map[string]interface{}
+	gets   int
+}
+
+func (c *countingCache) Get(_ context.Context, key string) (interface{}, error) {
+	c.gets++
+	value, ok := c.values[key]
+	if !ok {
+		return nil, fmt.Errorf("key not found: %s", key)
+	}
+	return value, nil
+}
+
+func (c *countingCache) Set(_ context.Context, key string, value interface{}, _ int) error {
+	c.values[key] = value
+	return nil
+}
+
+func (c *countingCache) Delete(_ context.Context, key string) error {
+	delete(c.values, key)
+	return nil
+}
+
+func (c *countingCache) Exists(_ context.Context, key string) (bool, error) {
+	_, ok := c.values[key]
+	return ok, nil
+}
+
+func TestLocalCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Arrange
+	local := cache.NewLocalCache(cache.LocalCacheConfig{MaxEntries: 2, TTL: time.Minute})
+	local.Set("a", 1, 0)
+	local.Set("b", 2, 0)
+
+	// Act
+	_, _ = local.Get("a") // a를 최근 사용으로 표시
+	local.Set("c", 3, 0)
+
+	// Assert
+	_, hasA := local.Get("a")
+	_, hasB := local.Get("b")
+	_, hasC := local.Get("c")
+	assert.True(t, hasA)
+	assert.False(t, hasB)
+	assert.True(t, hasC)
+	assert.Equal(t, 2, local.Len())
+}
+
+func TestLocalCache_ExpiresEntries(t *testing.T) {
+	// Arrange
+	local := cache.NewLocalCache(cache.LocalCacheConfig{MaxEntries: 10, TTL: time.Minute})
+	local.Set("a", 1, 10*time.Millisecond)
+
+	// Act
+	time.Sleep(20 * time.Millisecond)
+	_, ok := local.Get("a")
+
+	// Assert
+	assert.False(t, ok)
+	assert.Equal(t, 0, local.Len())
+}
+
+func TestTieredCache_ServesHotKeysLocallyAndInvalidatesOnWrite(t *testing.T) {
+	// Arrange
+	remote := &countingCache{values: map[string]interface{}{}}
+	tiered := cache.NewTieredCache(cache.NewLocalCache(cache.DefaultLocalCacheConfig()), remote)
+	ctx := context.Background()
+	require.NoError(t, tiered.Set(ctx, "document:users:1", "v1", 60))
+
+	// Act
+	for i := 0; i < 5; i++ {
+		_, err := tiered.Get(ctx, "document:users:1")
+		require.NoError(t, err)
+	}
+	require.NoError(t, tiered.Set(ctx, "document:users:1", "v2", 60))
+	value, err := tiered.Get(ctx, "document:users:1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "v2", value)
+	assert.Equal(t, 2, remote.gets)
+}
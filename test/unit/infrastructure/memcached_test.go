@@ -0,0 +1,196 @@
+package infrastructure_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMemcached는 텍스트 프로토콜의 version/get/set/delete만 지원하는 테스트용 memcached 서버입니다
+type fakeMemcached struct {
+	mu       sync.Mutex
+	items    map[string][]byte
+	exptimes map[string]int64
+	addr     string
+}
+
+func newFakeMemcached(t *testing.T) *fakeMemcached {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	f := &fakeMemcached{items: map[string][]byte{}, exptimes: map[string]int64{}, addr: listener.Addr().String()}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f
+}
+
+func (f *fakeMemcached) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			return
+		}
+		switch fields[0] {
+		case "version":
+			fmt.Fprint(conn, "VERSION 1.6.21\r\n")
+		case "get":
+			f.mu.Lock()
+			value, ok := f.items[fields[1]]
+			f.mu.Unlock()
+			if ok {
+				fmt.Fprintf(conn, "VALUE %s 0 %d\r\n%s\r\n", fields[1], len(value), value)
+			}
+			fmt.Fprint(conn, "END\r\n")
+		case "set":
+			exptime, _ := strconv.ParseInt(fields[3], 10, 64)
+			size, _ := strconv.Atoi(fields[4])
+			buf := make([]byte, size+2)
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+			f.mu.Lock()
+			f.items[fields[1]] = buf[:size]
+			f.exptimes[fields[1]] = exptime
+			f.mu.Unlock()
+			fmt.Fprint(conn, "STORED\r\n")
+		case "delete":
+			f.mu.Lock()
+			_, ok := f.items[fields[1]]
+			delete(f.items, fields[1])
+			f.mu.Unlock()
+			if ok {
+				fmt.Fprint(conn, "DELETED\r\n")
+			} else {
+				fmt.Fprint(conn, "NOT_FOUND\r\n")
+			}
+		default:
+			fmt.Fprint(conn, "ERROR\r\n")
+		}
+	}
+}
+
+func (f *fakeMemcached) keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(f.items))
+	for key := range f.items {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (f *fakeMemcached) exptime(key string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.exptimes[key]
+}
+
+func newTestMemcachedCache(t *testing.T, server *fakeMemcached) *cache.MemcachedCache {
+	t.Helper()
+	c, err := cache.NewMemcachedCache(context.Background(), cache.MemcachedConfig{Servers: []string{server.addr}})
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestMemcachedCache_SetGetDeleteRoundTrip(t *testing.T) {
+	// Arrange
+	c := newTestMemcachedCache(t, newFakeMemcached(t))
+	ctx := context.Background()
+
+	// Act
+	require.NoError(t, c.Set(ctx, "doc:users:1", map[string]interface{}{"name": "John", "age": 30}, 60))
+	value, getErr := c.Get(ctx, "doc:users:1")
+	existsBefore, _ := c.Exists(ctx, "doc:users:1")
+	require.NoError(t, c.Delete(ctx, "doc:users:1"))
+	existsAfter, _ := c.Exists(ctx, "doc:users:1")
+	_, missingErr := c.Get(ctx, "doc:users:1")
+	deleteMissingErr := c.Delete(ctx, "doc:users:1")
+
+	// Assert
+	require.NoError(t, getErr)
+	assert.Equal(t, map[string]interface{}{"name": "John", "age": float64(30)}, value)
+	assert.True(t, existsBefore)
+	assert.False(t, existsAfter)
+	assert.ErrorContains(t, missingErr, "key not found")
+	assert.NoError(t, deleteMissingErr, "deleting a missing key is not an error")
+}
+
+func TestMemcachedCache_HashesKeysOutsideProtocolLimits(t *testing.T) {
+	// Arrange
+	server := newFakeMemcached(t)
+	c := newTestMemcachedCache(t, server)
+	ctx := context.Background()
+	longKey := "query:" + strings.Repeat("x", 300)
+
+	// Act
+	require.NoError(t, c.Set(ctx, longKey, "long", 60))
+	require.NoError(t, c.Set(ctx, "key with spaces", "spaced", 60))
+	long, longErr := c.Get(ctx, longKey)
+	spaced, spacedErr := c.Get(ctx, "key with spaces")
+
+	// Assert
+	require.NoError(t, longErr)
+	require.NoError(t, spacedErr)
+	assert.Equal(t, "long", long)
+	assert.Equal(t, "spaced", spaced)
+	for _, key := range server.keys() {
+		assert.True(t, strings.HasPrefix(key, "h:"), key)
+		assert.LessOrEqual(t, len(key), 250)
+	}
+}
+
+func TestMemcachedCache_SendsLongTTLAsAbsoluteTime(t *testing.T) {
+	// Arrange - memcached는 30일을 넘는 만료 값을 Unix 시각으로 해석합니다
+	server := newFakeMemcached(t)
+	c := newTestMemcachedCache(t, server)
+	ctx := context.Background()
+	sixtyDays := int((60 * 24 * time.Hour) / time.Second)
+
+	// Act
+	require.NoError(t, c.Set(ctx, "short", "v", 300))
+	require.NoError(t, c.Set(ctx, "long", "v", sixtyDays))
+
+	// Assert
+	assert.Equal(t, int64(300), server.exptime("short"))
+	assert.InDelta(t, time.Now().Unix()+int64(sixtyDays), server.exptime("long"), 5)
+}
+
+func TestNewMemcachedCache_FailsWithoutReachableServer(t *testing.T) {
+	// Act
+	_, noServersErr := cache.NewMemcachedCache(context.Background(), cache.MemcachedConfig{})
+	_, unreachableErr := cache.NewMemcachedCache(context.Background(), cache.MemcachedConfig{
+		Servers: []string{"127.0.0.1:1"},
+		Timeout: 200 * time.Millisecond,
+	})
+
+	// Assert
+	assert.ErrorContains(t, noServersErr, "at least one server")
+	assert.ErrorContains(t, unreachableErr, "failed to connect to memcached 127.0.0.1:1")
+}
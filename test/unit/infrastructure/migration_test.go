@@ -0,0 +1,188 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/migration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memBackend는 마이그레이션 테스트용 메모리 저장소입니다 (테스트에서 쓰는 메서드만 구현)
+type memBackend struct {
+	repository.DocumentRepository
+	mu   sync.Mutex
+	docs map[string]*entity.Document
+}
+
+func newMemBackend() *memBackend {
+	return &memBackend{docs: make(map[string]*entity.Document)}
+}
+
+func (b *memBackend) Save(ctx context.Context, doc *entity.Document) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.docs[doc.ID()] = doc
+	return nil
+}
+
+func (b *memBackend) SaveMany(ctx context.Context, docs []*entity.Document) error {
+	for _, doc := range docs {
+		b.Save(ctx, doc)
+	}
+	return nil
+}
+
+func (b *memBackend) FindByID(ctx context.Context, collection, id string) (*entity.Document, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	doc, ok := b.docs[id]
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return doc, nil
+}
+
+func (b *memBackend) Delete(ctx context.Context, collection, id string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.docs[id]; !ok {
+		return entity.ErrDocumentNotFound
+	}
+	delete(b.docs, id)
+	return nil
+}
+
+func (b *memBackend) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	docs := make([]*entity.Document, 0, len(b.docs))
+	for _, doc := range b.docs {
+		docs = append(docs, doc)
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].ID() < docs[j].ID() })
+	return repository.NewSliceIterator(docs), nil
+}
+
+func (b *memBackend) EstimatedDocumentCount(ctx context.Context, collection string) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return int64(len(b.docs)), nil
+}
+
+func (b *memBackend) has(id string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.docs[id]
+	return ok
+}
+
+func migrationDoc(id string, n int) *entity.Document {
+	return entity.ReconstructDocument(id, "users", map[string]interface{}{"n": n}, 1, time.Now(), time.Now())
+}
+
+// newMigrationFixture는 원본에 n개의 문서가 있는 mongodb → postgresql 마이그레이션을 시작합니다
+func newMigrationFixture(t *testing.T, n int) (*migration.Coordinator, *migration.Migration, repository.DocumentRepository, *memBackend, *memBackend) {
+	t.Helper()
+	source, target := newMemBackend(), newMemBackend()
+	for i := 0; i < n; i++ {
+		require.NoError(t, source.Save(context.Background(), migrationDoc(fmt.Sprintf("u-%02d", i), i)))
+	}
+	coordinator := migration.NewCoordinator()
+	repo := coordinator.Wrap("mongodb", source)
+	coordinator.Wrap("postgresql", target)
+
+	m, err := coordinator.Start("users", "mongodb", "postgresql")
+	require.NoError(t, err)
+	return coordinator, m, repo, source, target
+}
+
+func TestOnlineMigration_BackfillVerifyAndCutOver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	_, m, repo, source, target := newMigrationFixture(t, 25)
+
+	// Act - 복사 중 dual-write된 문서도 대상에 반영
+	require.NoError(t, repo.Save(ctx, migrationDoc("u-new", 100)))
+	m.Run(ctx, 10)
+
+	// Assert
+	status := m.Status()
+	assert.Equal(t, migration.PhaseReady, status.Phase)
+	assert.Equal(t, int64(26), status.Copied+status.Skipped)
+	require.NotNil(t, status.Verify)
+	assert.True(t, status.Verify.Match)
+	assert.Equal(t, int64(26), status.Verify.TargetDocuments)
+
+	// Act - 전환 후 읽기/쓰기는 대상으로 가고 원본에도 반영
+	require.NoError(t, m.CutOver(false))
+	require.NoError(t, source.Delete(ctx, "users", "u-00"))
+	found, err := repo.FindByID(ctx, "users", "u-00")
+	require.NoError(t, repo.Save(ctx, migrationDoc("u-after", 200)))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "u-00", found.ID())
+	assert.True(t, target.has("u-after"))
+	assert.True(t, source.has("u-after"))
+
+	// Act - 완료 후에는 원본에 반영하지 않음
+	require.NoError(t, m.Complete())
+	require.NoError(t, repo.Delete(ctx, "users", "u-after"))
+
+	// Assert
+	assert.False(t, target.has("u-after"))
+	assert.True(t, source.has("u-after"))
+}
+
+func TestOnlineMigration_VerifyMismatchBlocksCutOver(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	_, m, repo, source, target := newMigrationFixture(t, 5)
+	m.Run(ctx, 2)
+	require.Equal(t, migration.PhaseReady, m.Status().Phase)
+
+	// Act - 대상만 바뀌면 검증 실패
+	require.NoError(t, target.Delete(ctx, "users", "u-01"))
+	require.NoError(t, m.Verify(ctx))
+
+	// Assert
+	status := m.Status()
+	assert.Equal(t, migration.PhaseVerifyFailed, status.Phase)
+	assert.False(t, status.Verify.Match)
+	assert.True(t, errors.Is(m.CutOver(false), migration.ErrInvalidState))
+
+	// Act - 취소하면 원본으로만 쓰기
+	require.NoError(t, m.Abort())
+	require.NoError(t, repo.Save(ctx, migrationDoc("u-aborted", 9)))
+
+	// Assert
+	assert.True(t, source.has("u-aborted"))
+	assert.False(t, target.has("u-aborted"))
+}
+
+func TestOnlineMigration_RejectsConflictingMigration(t *testing.T) {
+	// Arrange
+	coordinator, m, repo, _, _ := newMigrationFixture(t, 1)
+
+	// Act
+	_, startErr := coordinator.Start("users", "postgresql", "mongodb")
+	dropErr := repo.DropCollection(context.Background(), "users")
+
+	// Assert
+	assert.True(t, errors.Is(startErr, migration.ErrInProgress))
+	assert.True(t, errors.Is(dropErr, migration.ErrInProgress))
+	assert.True(t, errors.Is(coordinator.CheckAvailable("users", "postgresql"), migration.ErrInProgress))
+	assert.NoError(t, coordinator.CheckAvailable("orders", "postgresql"))
+	got, err := coordinator.Get(m.ID())
+	require.NoError(t, err)
+	assert.Equal(t, m, got)
+}
@@ -0,0 +1,102 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// changeStreamEvent는 mock 배포가 돌려줄 변경 스트림 이벤트 문서를 생성합니다
+func changeStreamEvent(token, operation, collection, id string, fields ...bson.E) bson.D {
+	event := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "_data", Value: token}}},
+		{Key: "operationType", Value: operation},
+		{Key: "ns", Value: bson.D{{Key: "db", Value: "app"}, {Key: "coll", Value: collection}}},
+		{Key: "documentKey", Value: bson.D{{Key: "_id", Value: id}}},
+		{Key: "clusterTime", Value: primitive.Timestamp{T: 1700000000, I: 1}},
+	}
+	return append(event, fields...)
+}
+
+func TestChangeStreamSource_RequiresName(t *testing.T) {
+	// Act
+	_, err := mongodb.NewChangeStreamSourceWithClient(nil, "app", mongodb.ChangeStreamConfig{})
+
+	// Assert
+	assert.ErrorContains(t, err, "change stream name is required")
+}
+
+func TestChangeStreamSource_DecodesChangesAndCheckpointsLastHandledEvent(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("run", func(mt *mtest.T) {
+		// Arrange
+		source, err := mongodb.NewChangeStreamSourceWithClient(mt.Client, "app", mongodb.ChangeStreamConfig{Name: "bridge"})
+		require.NoError(mt, err)
+		mt.AddMockResponses(
+			// 저장된 재개 토큰 없음
+			mtest.CreateCursorResponse(0, "app."+mongodb.ResumeTokenCollectionName, mtest.FirstBatch),
+			mtest.CreateCursorResponse(0, "app.$cmd.aggregate", mtest.FirstBatch,
+				changeStreamEvent("t1", "insert", "users", "1", bson.E{Key: "fullDocument", Value: bson.D{
+					{Key: "_id", Value: "1"}, {Key: "collection", Value: "users"},
+					{Key: "data", Value: bson.D{{Key: "name", Value: "John"}}}, {Key: "version", Value: int32(1)},
+				}}),
+				changeStreamEvent("t2", "update", "users", "1", bson.E{Key: "updateDescription", Value: bson.D{
+					{Key: "updatedFields", Value: bson.D{{Key: "data.name", Value: "Jane"}, {Key: "version", Value: int32(2)}}},
+					{Key: "removedFields", Value: bson.A{"data.age"}},
+				}}, bson.E{Key: "fullDocument", Value: bson.D{
+					{Key: "_id", Value: "1"}, {Key: "collection", Value: "users"},
+					{Key: "data", Value: bson.D{{Key: "name", Value: "Jane"}}}, {Key: "version", Value: int32(2)},
+				}}),
+				changeStreamEvent("t3", "insert", "legacy", "9", bson.E{Key: "fullDocument", Value: bson.D{
+					{Key: "_id", Value: "9"}, {Key: "sku", Value: "A-1"},
+				}}),
+				changeStreamEvent("t4", "insert", "users", "2"),
+			),
+			// 재개 토큰 저장
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+		errStop := errors.New("stop")
+		var events []*repository.ChangeEvent
+
+		// Act
+		err = source.Run(context.Background(), func(ctx context.Context, event *repository.ChangeEvent) error {
+			if event.DocumentID == "2" {
+				return errStop
+			}
+			events = append(events, event)
+			return nil
+		})
+
+		// Assert
+		require.ErrorIs(mt, err, errStop)
+		require.Len(mt, events, 3)
+		assert.Equal(mt, map[string]interface{}{"name": "John"}, events[0].Data, "service documents expose only their data")
+		assert.Equal(mt, 1, events[0].Version)
+		assert.Equal(mt, int64(1700000000), events[0].Timestamp.Unix())
+		assert.Equal(mt, repository.ChangeOperationUpdate, events[1].Operation)
+		assert.Equal(mt, map[string]interface{}{"name": "Jane", "age": nil}, events[1].Changes, "metadata fields are not reported as changes")
+		assert.Equal(mt, 2, events[1].Version)
+		assert.Equal(mt, map[string]interface{}{"sku": "A-1"}, events[2].Data, "external documents are passed through without _id")
+
+		find := mt.GetStartedEvent()
+		require.NotNil(mt, find)
+		assert.Equal(mt, "find", find.CommandName)
+		aggregate := mt.GetStartedEvent()
+		require.NotNil(mt, aggregate)
+		assert.Equal(mt, "aggregate", aggregate.CommandName)
+		update := mt.GetStartedEvent()
+		require.NotNil(mt, update)
+		require.Equal(mt, "update", update.CommandName)
+		token := update.Command.Lookup("updates", "0", "u", "$set", "token", "_data")
+		assert.Equal(mt, "t3", token.StringValue(), "the position of the last handled change is saved")
+	})
+}
@@ -0,0 +1,158 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// createdEventRecorder는 발행된 생성 이벤트의 문서 ID를 기록하는 테스트용 CDC 발행자입니다
+type createdEventRecorder struct {
+	mu      sync.Mutex
+	created []string
+}
+
+func (p *createdEventRecorder) PublishDocumentCreated(ctx context.Context, docID, collection string, data map[string]interface{}, version int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.created = append(p.created, docID)
+	return nil
+}
+
+func (p *createdEventRecorder) PublishDocumentUpdated(ctx context.Context, docID, collection string, data map[string]interface{}, version, previousVersion int, changes map[string]interface{}) error {
+	return nil
+}
+
+func (p *createdEventRecorder) PublishDocumentDeleted(ctx context.Context, docID, collection string, version int) error {
+	return nil
+}
+
+func (p *createdEventRecorder) SetOrigin(string)                          {}
+func (p *createdEventRecorder) SetEncoder(encoder messaging.EventEncoder) {}
+
+func (p *createdEventRecorder) createdIDs() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.created...)
+}
+
+func newMockCommandRepository(mt *mtest.T, publisher messaging.CDCPublisher) *mongodb.MongoDBCommandRepository {
+	return mongodb.NewMongoDBCommandRepositoryWithClient(mt.Client, &mongodb.CommandConfig{
+		Database:     "app",
+		CDCEnabled:   true,
+		CDCPublisher: publisher,
+	})
+}
+
+func TestMongoDBCommandRepository_BulkWritePublishesOnlySucceededOperations(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("bulk", func(mt *mtest.T) {
+		// Arrange
+		publisher := &createdEventRecorder{}
+		repo := newMockCommandRepository(mt, publisher)
+		first, err := entity.NewDocument("users", map[string]interface{}{"name": "John"})
+		require.NoError(mt, err)
+		second, err := entity.NewDocument("users", map[string]interface{}{"name": "Jane"})
+		require.NoError(mt, err)
+		response := mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 1, Code: 11000, Message: "duplicate key"})
+		mt.AddMockResponses(append(response, bson.E{Key: "n", Value: 1}))
+
+		// Act
+		result, err := repo.BulkWrite(context.Background(), []*repository.BulkOperation{
+			{Type: "insert", Collection: "users", Document: first},
+			{Type: "insert", Collection: "users", Document: second},
+		})
+
+		// Assert
+		assert.ErrorContains(mt, err, "failed to bulk write to users")
+		require.NotNil(mt, result)
+		assert.Equal(mt, int64(1), result.InsertedCount)
+		require.NotEmpty(mt, first.ID(), "the inserted document gets its preassigned ID")
+		assert.Equal(mt, []string{first.ID()}, publisher.createdIDs(), "failed operations are not published")
+	})
+}
+
+func TestMongoDBCommandRepository_BulkWriteRejectsInvalidOperation(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("invalid", func(mt *mtest.T) {
+		// Arrange
+		repo := newMockCommandRepository(mt, &createdEventRecorder{})
+
+		// Act
+		_, err := repo.BulkWrite(context.Background(), []*repository.BulkOperation{
+			{Type: "update", Collection: "users"},
+		})
+
+		// Assert
+		assert.ErrorIs(mt, err, entity.ErrInvalidData)
+		assert.Nil(mt, mt.GetStartedEvent(), "nothing is sent when an operation is invalid")
+	})
+}
+
+func TestMongoDBCommandRepository_WithTransactionPublishesAfterCommitOnly(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("commit", func(mt *mtest.T) {
+		// Arrange
+		publisher := &createdEventRecorder{}
+		repo := newMockCommandRepository(mt, publisher)
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+		doc, err := entity.NewDocument("users", map[string]interface{}{"name": "John"})
+		require.NoError(mt, err)
+		var publishedInside []string
+
+		// Act
+		err = repo.WithTransaction(context.Background(), nil, func(ctx context.Context) error {
+			if err := repo.Save(ctx, doc); err != nil {
+				return err
+			}
+			publishedInside = publisher.createdIDs()
+			return nil
+		})
+
+		// Assert
+		require.NoError(mt, err)
+		assert.Empty(mt, publishedInside, "events wait for the commit")
+		assert.Equal(mt, []string{doc.ID()}, publisher.createdIDs())
+	})
+
+	mt.Run("abort", func(mt *mtest.T) {
+		// Arrange
+		publisher := &createdEventRecorder{}
+		repo := newMockCommandRepository(mt, publisher)
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+		doc, err := entity.NewDocument("users", map[string]interface{}{"name": "John"})
+		require.NoError(mt, err)
+		errRollback := errors.New("rollback")
+
+		// Act
+		err = repo.WithTransaction(context.Background(), nil, func(ctx context.Context) error {
+			if err := repo.Save(ctx, doc); err != nil {
+				return err
+			}
+			return errRollback
+		})
+
+		// Assert
+		assert.ErrorIs(mt, err, errRollback)
+		assert.Empty(mt, publisher.createdIDs(), "aborted writes are not published")
+	})
+}
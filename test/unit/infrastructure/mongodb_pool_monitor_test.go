@@ -0,0 +1,41 @@
+package infrastructure_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+func TestPoolMonitor_TracksConnectionsAndCheckouts(t *testing.T) {
+	// Arrange
+	monitor := mongodb.NewPoolMonitor()
+	driver := monitor.Monitor()
+	events := []*event.PoolEvent{
+		{Type: event.PoolCreated, Address: "db-0:27017", PoolOptions: &event.MonitorPoolOptions{MaxPoolSize: 50}},
+		{Type: event.PoolCreated, Address: "db-1:27017", PoolOptions: &event.MonitorPoolOptions{MaxPoolSize: 50}},
+		{Type: event.ConnectionCreated, Address: "db-0:27017"},
+		{Type: event.ConnectionCreated, Address: "db-0:27017"},
+		{Type: event.GetSucceeded, Address: "db-0:27017", Duration: 3 * time.Millisecond},
+		{Type: event.GetSucceeded, Address: "db-0:27017", Duration: 2 * time.Millisecond},
+		{Type: event.ConnectionReturned, Address: "db-0:27017"},
+		{Type: event.GetFailed, Address: "db-0:27017", Duration: 5 * time.Millisecond, Reason: event.ReasonTimedOut},
+	}
+
+	// Act
+	for _, e := range events {
+		driver.Event(e)
+	}
+	stats := monitor.Stats()
+
+	// Assert
+	assert.Equal(t, 100, stats.MaxOpen)
+	assert.Equal(t, 2, stats.Open)
+	assert.Equal(t, 1, stats.InUse)
+	assert.Equal(t, 1, stats.Idle)
+	assert.Equal(t, int64(3), stats.WaitCount)
+	assert.Equal(t, 10*time.Millisecond, stats.WaitDuration)
+	assert.Equal(t, int64(1), stats.Timeouts)
+}
@@ -0,0 +1,52 @@
+package infrastructure_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mongodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+func TestParseReadPreference_AppliesStalenessAndHedge(t *testing.T) {
+	// Arrange
+	maxStaleness := 120 * time.Second
+
+	// Act
+	rp, err := mongodb.ParseReadPreference("nearest", maxStaleness, true)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, readpref.NearestMode, rp.Mode())
+	staleness, ok := rp.MaxStaleness()
+	assert.True(t, ok)
+	assert.Equal(t, maxStaleness, staleness)
+	require.NotNil(t, rp.HedgeEnabled())
+	assert.True(t, *rp.HedgeEnabled())
+}
+
+func TestParseReadPreference_RejectsSecondaryOptionsOnPrimary(t *testing.T) {
+	// Act
+	_, stalenessErr := mongodb.ParseReadPreference("primary", 120*time.Second, false)
+	_, hedgeErr := mongodb.ParseReadPreference("primary", 0, true)
+
+	// Assert
+	assert.Error(t, stalenessErr)
+	assert.Error(t, hedgeErr)
+}
+
+func TestParseReadConcern(t *testing.T) {
+	// Act
+	empty, emptyErr := mongodb.ParseReadConcern("")
+	majority, majorityErr := mongodb.ParseReadConcern("majority")
+	_, snapshotErr := mongodb.ParseReadConcern("snapshot")
+
+	// Assert
+	require.NoError(t, emptyErr)
+	assert.Nil(t, empty)
+	require.NoError(t, majorityErr)
+	assert.Equal(t, "majority", majority.GetLevel())
+	assert.Error(t, snapshotErr)
+}
@@ -0,0 +1,107 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatchDocuments는 ID가 지정된 users 문서 n개를 생성합니다
+func newBatchDocuments(n int) []*entity.Document {
+	docs := make([]*entity.Document, n)
+	for i := range docs {
+		docs[i] = entity.ReconstructDocument(fmt.Sprintf("doc-%d", i+1), "users", map[string]interface{}{"n": i + 1}, 1, time.Now(), time.Now())
+	}
+	return docs
+}
+
+// newBatchRepository는 배치 크기와 중복 처리 방식을 지정한 MySQL 저장소를 생성합니다
+func newBatchRepository(t *testing.T, conn *fakeSQLConn, size int, mode mysql.DuplicateMode) *mysql.MySQLRepository {
+	t.Helper()
+	repo, ok := mysql.NewMySQLRepository(newFakeSQLDB(t, conn)).(*mysql.MySQLRepository)
+	require.True(t, ok)
+	repo.SetInsertBatchSize(size)
+	repo.SetDuplicateMode(mode)
+	return repo
+}
+
+func TestMySQLSaveMany_SplitsIntoMultiRowInsertsInOneTransaction(t *testing.T) {
+	// Arrange
+	conn := &fakeSQLConn{}
+	repo := newBatchRepository(t, conn, 2, mysql.DuplicateError)
+
+	// Act
+	err := repo.SaveMany(context.Background(), newBatchDocuments(5))
+
+	// Assert
+	require.NoError(t, err)
+	inserts := conn.execsContaining("INSERT INTO `users`")
+	require.Len(t, inserts, 3)
+	assert.Len(t, inserts[0].args, 12)
+	assert.Len(t, inserts[1].args, 12)
+	assert.Len(t, inserts[2].args, 6, "the last batch holds the remainder")
+	assert.Equal(t, 2, strings.Count(inserts[0].query, "(?, ?, ?, ?, ?, ?)"))
+	assert.NotContains(t, inserts[0].query, "ON DUPLICATE KEY")
+	assert.Equal(t, []driver.Value{"doc-1", "doc-3", "doc-5"}, []driver.Value{inserts[0].args[0], inserts[1].args[0], inserts[2].args[0]})
+	assert.Equal(t, 1, conn.commits)
+}
+
+func TestMySQLSaveMany_AppliesDuplicateMode(t *testing.T) {
+	tests := []struct {
+		mode   mysql.DuplicateMode
+		clause string
+	}{
+		{mode: mysql.DuplicateIgnore, clause: "ON DUPLICATE KEY UPDATE id = id"},
+		{mode: mysql.DuplicateUpdate, clause: "ON DUPLICATE KEY UPDATE data = VALUES(data), updated_at = VALUES(updated_at), version = version + 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			// Arrange
+			conn := &fakeSQLConn{}
+			repo := newBatchRepository(t, conn, 0, tt.mode)
+
+			// Act
+			err := repo.SaveMany(context.Background(), newBatchDocuments(3))
+
+			// Assert
+			require.NoError(t, err)
+			inserts := conn.execsContaining("INSERT INTO `users`")
+			require.Len(t, inserts, 1, "the default batch size holds all documents")
+			assert.Contains(t, inserts[0].query, tt.clause)
+		})
+	}
+}
+
+func TestMySQLSaveMany_RollsBackWhenABatchFails(t *testing.T) {
+	// Arrange
+	inserts := 0
+	conn := &fakeSQLConn{exec: func(query string, args []driver.Value) (driver.Result, error) {
+		if strings.HasPrefix(query, "INSERT INTO") {
+			inserts++
+			if inserts == 2 {
+				return nil, errors.New("Duplicate entry 'doc-3' for key 'PRIMARY'")
+			}
+		}
+		return driver.RowsAffected(1), nil
+	}}
+	repo := newBatchRepository(t, conn, 2, mysql.DuplicateError)
+
+	// Act
+	err := repo.SaveMany(context.Background(), newBatchDocuments(5))
+
+	// Assert
+	assert.ErrorContains(t, err, "failed to insert documents")
+	assert.Equal(t, 2, inserts, "later batches are not sent")
+	assert.Equal(t, 0, conn.commits)
+	assert.Equal(t, 1, conn.rollbacks)
+}
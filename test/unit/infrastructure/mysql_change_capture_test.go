@@ -0,0 +1,96 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newChangeLogConn은 저장된 위치와 변경 로그, 현재 문서를 돌려주는 드라이버 연결을 생성합니다
+func newChangeLogConn(position int64, changes [][]driver.Value, documents map[string][]driver.Value) *fakeSQLConn {
+	return &fakeSQLConn{query: func(query string, args []driver.Value) (fakeSQLRows, error) {
+		switch {
+		case strings.Contains(query, "FROM `"+mysql.ChangePositionTable+"`"):
+			return fakeSQLRows{columns: []string{"seq"}, values: [][]driver.Value{{position}}}, nil
+		case strings.Contains(query, "FROM `"+mysql.ChangeLogTable+"` WHERE seq > ?"):
+			after := args[0].(int64)
+			var rows [][]driver.Value
+			for _, change := range changes {
+				if change[0].(int64) > after {
+					rows = append(rows, change)
+				}
+			}
+			return fakeSQLRows{columns: []string{"seq", "operation", "collection", "document_id", "version", "changed_at"}, values: rows}, nil
+		case strings.Contains(query, "SELECT data, version FROM"):
+			if doc, ok := documents[args[0].(string)]; ok {
+				return fakeSQLRows{columns: []string{"data", "version"}, values: [][]driver.Value{doc}}, nil
+			}
+			return fakeSQLRows{columns: []string{"data", "version"}}, nil
+		}
+		return fakeSQLRows{}, nil
+	}}
+}
+
+func TestChangeLogReader_RequiresName(t *testing.T) {
+	// Act
+	_, err := mysql.NewChangeLogReader(nil, nil, mysql.ChangeLogConfig{})
+
+	// Assert
+	assert.ErrorContains(t, err, "change log reader name is required")
+}
+
+func TestChangeLogReader_ResumesFromSavedPositionAndCheckpointsOnStop(t *testing.T) {
+	// Arrange
+	changedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+	conn := newChangeLogConn(10, [][]driver.Value{
+		{int64(9), "insert", "users", "0", int64(1), changedAt},
+		{int64(11), "insert", "users", "1", int64(1), changedAt},
+		{int64(12), "insert", "orders", "7", int64(1), changedAt},
+		{int64(13), "update", "users", "3", int64(2), changedAt},
+		{int64(14), "delete", "users", "2", int64(4), changedAt},
+	}, map[string][]driver.Value{
+		"1": {[]byte(`{"name":"John"}`), int64(1)},
+	})
+	reader, err := mysql.NewChangeLogReader(newFakeSQLDB(t, conn), nil, mysql.ChangeLogConfig{
+		Name:         "bridge",
+		Collections:  []string{"users"},
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var events []repository.ChangeEvent
+
+	// Act
+	err = reader.Run(ctx, func(ctx context.Context, event *repository.ChangeEvent) error {
+		events = append(events, *event)
+		if event.Operation == repository.ChangeOperationDelete {
+			cancel()
+		}
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, events, 2, "other collections and rows deleted before loading are skipped")
+	assert.Equal(t, "1", events[0].DocumentID)
+	assert.Equal(t, map[string]interface{}{"name": "John"}, events[0].Data)
+	assert.Equal(t, 1, events[0].Version)
+	assert.Equal(t, repository.ChangeOperationDelete, events[1].Operation)
+	assert.Equal(t, 4, events[1].Version)
+	assert.True(t, changedAt.Equal(events[1].Timestamp))
+
+	triggers := conn.execsContaining("CREATE TRIGGER")
+	require.Len(t, triggers, 3)
+	assert.Contains(t, triggers[0].query, "`_cdc_insert_users` AFTER INSERT ON `users`")
+	saved := conn.execsContaining("INSERT INTO `" + mysql.ChangePositionTable + "`")
+	require.NotEmpty(t, saved)
+	assert.Equal(t, []driver.Value{"bridge", int64(14)}, saved[len(saved)-1].args)
+}
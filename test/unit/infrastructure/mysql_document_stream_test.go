@@ -0,0 +1,54 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLFindStream_DecodesRowsOneAtATime(t *testing.T) {
+	// Arrange
+	now := time.Now().UTC().Truncate(time.Second)
+	var queries []string
+	conn := &fakeSQLConn{query: func(query string, args []driver.Value) (fakeSQLRows, error) {
+		queries = append(queries, query)
+		return fakeSQLRows{
+			columns: []string{"id", "data", "created_at", "updated_at", "version", "metadata"},
+			values: [][]driver.Value{
+				{"1", []byte(`{"name":"John"}`), now, now, int64(1), []byte(`{}`)},
+				{"2", []byte(`{"name":"Jane"}`), now, now, int64(4), nil},
+			},
+		}, nil
+	}}
+	repo := mysql.NewMySQLRepository(newFakeSQLDB(t, conn))
+	ctx := context.Background()
+
+	// Act
+	it, err := repo.FindStream(ctx, "users", nil, &repository.FindOptions{Limit: 10})
+	require.NoError(t, err)
+	var docs []*entity.Document
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		require.NoError(t, err)
+		docs = append(docs, doc)
+	}
+
+	// Assert
+	require.NoError(t, it.Err())
+	require.NoError(t, it.Close(ctx))
+	require.Len(t, queries, 1)
+	assert.Contains(t, queries[0], "FROM `users`")
+	assert.Contains(t, queries[0], "LIMIT 10", "find options are applied to the cursor query")
+	require.Len(t, docs, 2)
+	assert.Equal(t, "users", docs[0].Collection())
+	assert.Equal(t, "John", docs[0].Data()["name"])
+	assert.Equal(t, "2", docs[1].ID())
+	assert.Equal(t, 4, docs[1].Version())
+}
@@ -0,0 +1,190 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/mysql"
+	gomysql "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// generatedColumnPattern은 address.city 필드의 생성 컬럼 이름과 일치합니다
+var generatedColumnPattern = regexp.MustCompile("`(_g_address_city_[0-9a-f]{8})`")
+
+// generatedColumnConn은 information_schema 조회에 columns를 돌려주고 문서 조회 쿼리를 기록하는 드라이버 연결입니다
+type generatedColumnConn struct {
+	*fakeSQLConn
+
+	mu        sync.Mutex
+	columns   []string
+	finds     []string
+	findArgs  [][]driver.Value
+	lookups   int
+	addColumn error
+}
+
+func newGeneratedColumnConn(columns ...string) *generatedColumnConn {
+	c := &generatedColumnConn{columns: columns}
+	c.fakeSQLConn = &fakeSQLConn{
+		query: func(query string, args []driver.Value) (fakeSQLRows, error) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if strings.Contains(query, "information_schema.COLUMNS") {
+				c.lookups++
+				rows := make([][]driver.Value, len(c.columns))
+				for i, column := range c.columns {
+					rows[i] = []driver.Value{column}
+				}
+				return fakeSQLRows{columns: []string{"COLUMN_NAME"}, values: rows}, nil
+			}
+			c.finds = append(c.finds, query)
+			c.findArgs = append(c.findArgs, args)
+			return fakeSQLRows{}, nil
+		},
+		exec: func(query string, args []driver.Value) (driver.Result, error) {
+			if strings.Contains(query, "ADD COLUMN") && c.addColumn != nil {
+				return nil, c.addColumn
+			}
+			return driver.RowsAffected(0), nil
+		},
+	}
+	return c
+}
+
+// addressCityColumn은 address.city 인덱스를 만들 때 추가되는 생성 컬럼 이름을 반환합니다
+func addressCityColumn(t *testing.T) string {
+	t.Helper()
+	conn := newGeneratedColumnConn()
+	_, err := mysql.NewMySQLRepository(newFakeSQLDB(t, conn.fakeSQLConn)).CreateIndex(context.Background(), "users", repository.IndexModel{
+		Keys:    map[string]interface{}{"address.city": 1},
+		Options: &repository.IndexOptions{Name: "idx_city"},
+	})
+	require.NoError(t, err)
+	alters := conn.execsContaining("ADD COLUMN")
+	require.Len(t, alters, 1)
+	match := generatedColumnPattern.FindStringSubmatch(alters[0].query)
+	require.NotNil(t, match)
+	return match[1]
+}
+
+func TestMySQLCreateIndex_IndexesStoredGeneratedColumn(t *testing.T) {
+	// Arrange
+	conn := newGeneratedColumnConn()
+	repo := mysql.NewMySQLRepository(newFakeSQLDB(t, conn.fakeSQLConn))
+
+	// Act
+	name, err := repo.CreateIndex(context.Background(), "users", repository.IndexModel{
+		Keys:    map[string]interface{}{"address.city": 1},
+		Options: &repository.IndexOptions{Name: "idx_city"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "idx_city", name)
+	alters := conn.execsContaining("ADD COLUMN")
+	require.Len(t, alters, 1)
+	assert.Contains(t, alters[0].query, "GENERATED ALWAYS AS (LEFT(JSON_UNQUOTE(JSON_EXTRACT(data, '$.\"address\".\"city\"')), 255)) STORED")
+	match := generatedColumnPattern.FindStringSubmatch(alters[0].query)
+	require.NotNil(t, match)
+	indexes := conn.execsContaining("INDEX `idx_city`")
+	require.Len(t, indexes, 1)
+	assert.Contains(t, indexes[0].query, "`idx_city` ON `users` (`"+match[1]+"`)")
+}
+
+func TestMySQLCreateIndex_ReusesExistingGeneratedColumn(t *testing.T) {
+	// Arrange
+	conn := newGeneratedColumnConn()
+	repo := mysql.NewMySQLRepository(newFakeSQLDB(t, conn.fakeSQLConn))
+	model := repository.IndexModel{Keys: map[string]interface{}{"address.city": 1}, Options: &repository.IndexOptions{Name: "idx_city"}}
+	_, err := repo.CreateIndex(context.Background(), "users", model)
+	require.NoError(t, err)
+	conn.mu.Lock()
+	conn.columns = []string{addressCityColumn(t)}
+	conn.mu.Unlock()
+
+	// Act
+	_, err = repo.CreateIndex(context.Background(), "users", model)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, conn.execsContaining("ADD COLUMN"), 1, "an existing generated column is not added again")
+	assert.Len(t, conn.execsContaining("INDEX `idx_city`"), 2)
+}
+
+func TestMySQLCreateIndex_ToleratesConcurrentlyAddedColumn(t *testing.T) {
+	// Arrange
+	conn := newGeneratedColumnConn()
+	conn.addColumn = &gomysql.MySQLError{Number: 1060, Message: "Duplicate column name"}
+	repo := mysql.NewMySQLRepository(newFakeSQLDB(t, conn.fakeSQLConn))
+
+	// Act
+	_, err := repo.CreateIndex(context.Background(), "users", repository.IndexModel{
+		Keys:    map[string]interface{}{"address.city": 1},
+		Options: &repository.IndexOptions{Name: "idx_city"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, conn.execsContaining("INDEX `idx_city`"), 1)
+}
+
+func TestMySQLFindAll_ComparesGeneratedColumnForShortStrings(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		useColumn bool
+	}{
+		{name: "short string", value: "Seoul", useColumn: true},
+		{name: "number", value: 42},
+		{name: "string longer than the column", value: strings.Repeat("x", 255)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			column := addressCityColumn(t)
+			conn := newGeneratedColumnConn(column)
+			repo := mysql.NewMySQLRepository(newFakeSQLDB(t, conn.fakeSQLConn))
+			ctx := context.Background()
+
+			// Act
+			_, err := repo.FindAll(ctx, "users", map[string]interface{}{"address.city": tt.value})
+			require.NoError(t, err)
+			_, err = repo.FindAll(ctx, "users", map[string]interface{}{"address.city": tt.value})
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, conn.finds, 2)
+			assert.Contains(t, conn.finds[0], "JSON_EXTRACT(data, ?) = CAST(? AS JSON)", "the JSON comparison stays exact")
+			assert.Equal(t, 1, conn.lookups, "generated columns are cached per collection")
+			if !tt.useColumn {
+				assert.NotContains(t, conn.finds[0], column)
+				return
+			}
+			assert.Contains(t, conn.finds[0], "`"+column+"` = ?")
+			assert.Equal(t, driver.Value("Seoul"), conn.findArgs[0][0])
+		})
+	}
+}
+
+func TestMySQLFindAll_IgnoresColumnsOfOtherFields(t *testing.T) {
+	// Arrange
+	conn := newGeneratedColumnConn(addressCityColumn(t), "legacy_city")
+	repo := mysql.NewMySQLRepository(newFakeSQLDB(t, conn.fakeSQLConn))
+
+	// Act
+	_, err := repo.FindAll(context.Background(), "users", map[string]interface{}{"address.zip": "04524"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, conn.finds, 1)
+	assert.NotContains(t, conn.finds[0], "_g_")
+	assert.NotContains(t, conn.finds[0], "legacy_city")
+}
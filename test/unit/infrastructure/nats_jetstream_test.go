@@ -0,0 +1,213 @@
+package infrastructure_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/nats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJetStream은 발행자 테스트용 최소 NATS 서버입니다
+// 스트림 조회/생성 API와 발행(PubAck)에 응답하고, 받은 발행 메시지를 기록합니다
+type fakeJetStream struct {
+	listener     net.Listener
+	streamExists bool
+
+	mu        sync.Mutex
+	requests  []string
+	published []fakePublish
+}
+
+type fakePublish struct {
+	subject string
+	header  string
+	payload []byte
+}
+
+func newFakeJetStream(t *testing.T, streamExists bool) *fakeJetStream {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeJetStream{listener: listener, streamExists: streamExists}
+	go s.serve()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *fakeJetStream) addr() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *fakeJetStream) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeJetStream) handle(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	fmt.Fprintf(conn, "INFO {\"headers\":true,\"jetstream\":true}\r\n")
+
+	seq := 0
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "PING":
+			fmt.Fprintf(conn, "PONG\r\n")
+		case "PUB", "HPUB":
+			headerSize := 0
+			if fields[0] == "HPUB" {
+				headerSize, _ = strconv.Atoi(fields[3])
+			}
+			total, _ := strconv.Atoi(fields[len(fields)-1])
+			buf := make([]byte, total+2)
+			if _, err := io.ReadFull(br, buf); err != nil {
+				return
+			}
+			subject, replyTo := fields[1], fields[2]
+
+			var reply string
+			switch {
+			case strings.HasPrefix(subject, "$JS.API.STREAM.INFO."):
+				s.record(subject, "", nil)
+				if s.streamExists {
+					reply = `{"config":{"name":"DOCUMENTS_CDC"}}`
+				} else {
+					reply = `{"error":{"code":404,"description":"stream not found"}}`
+				}
+			case strings.HasPrefix(subject, "$JS.API.STREAM.CREATE."):
+				s.record(subject, "", buf[:total])
+				reply = `{"config":{"name":"DOCUMENTS_CDC"}}`
+			default:
+				seq++
+				s.record(subject, string(buf[:headerSize]), buf[headerSize:total])
+				reply = fmt.Sprintf(`{"stream":"DOCUMENTS_CDC","seq":%d}`, seq)
+			}
+			fmt.Fprintf(conn, "MSG %s 1 %d\r\n%s\r\n", replyTo, len(reply), reply)
+		}
+	}
+}
+
+func (s *fakeJetStream) record(subject, header string, payload []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if strings.HasPrefix(subject, "$JS.API.") {
+		s.requests = append(s.requests, subject)
+		if payload != nil {
+			s.published = append(s.published, fakePublish{subject: subject, payload: payload})
+		}
+		return
+	}
+	s.published = append(s.published, fakePublish{subject: subject, header: header, payload: payload})
+}
+
+func (s *fakeJetStream) snapshot() ([]string, []fakePublish) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...), append([]fakePublish(nil), s.published...)
+}
+
+func TestJetStreamPublisher_CreatesMissingStream(t *testing.T) {
+	// Arrange
+	server := newFakeJetStream(t, false)
+
+	// Act
+	publisher, err := nats.NewJetStreamPublisher(context.Background(), nats.Config{
+		Servers:         []string{server.addr()},
+		Stream:          "DOCUMENTS_CDC",
+		SubjectPrefix:   "cdc",
+		DuplicateWindow: time.Minute,
+		Timeout:         2 * time.Second,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	requests, published := server.snapshot()
+	assert.Equal(t, []string{"$JS.API.STREAM.INFO.DOCUMENTS_CDC", "$JS.API.STREAM.CREATE.DOCUMENTS_CDC"}, requests)
+	require.Len(t, published, 1)
+
+	var streamConfig struct {
+		Subjects        []string `json:"subjects"`
+		DuplicateWindow int64    `json:"duplicate_window"`
+	}
+	require.NoError(t, json.Unmarshal(published[0].payload, &streamConfig))
+	assert.Equal(t, []string{"cdc.>"}, streamConfig.Subjects)
+	assert.Equal(t, time.Minute.Nanoseconds(), streamConfig.DuplicateWindow)
+}
+
+func TestJetStreamPublisher_PublishesPerCollectionSubjectWithMsgID(t *testing.T) {
+	// Arrange
+	server := newFakeJetStream(t, true)
+	publisher, err := nats.NewJetStreamPublisher(context.Background(), nats.Config{
+		Servers:       []string{server.addr()},
+		SubjectPrefix: "cdc",
+		Timeout:       2 * time.Second,
+	})
+	require.NoError(t, err)
+	defer publisher.Close()
+	publisher.SetOrigin("instance-1")
+
+	var _ messaging.CDCPublisher = publisher
+
+	// Act
+	err = publisher.PublishDocumentUpdated(context.Background(), "doc-1", "app.users", map[string]interface{}{"name": "kim"}, 2, 1, nil)
+
+	// Assert
+	require.NoError(t, err)
+	_, published := server.snapshot()
+	require.Len(t, published, 1)
+	assert.Equal(t, "cdc.app_users.updated", published[0].subject)
+
+	var event messaging.DocumentUpdatedEvent
+	require.NoError(t, json.Unmarshal(published[0].payload, &event))
+	assert.Equal(t, messaging.EventDocumentUpdated, event.EventType)
+	assert.Equal(t, "doc-1", event.DocumentID)
+	assert.Equal(t, 1, event.PreviousVersion)
+	assert.Equal(t, "instance-1", event.Metadata[messaging.MetadataOrigin])
+	assert.Contains(t, published[0].header, "Nats-Msg-Id: "+event.EventID+"\r\n")
+}
+
+func TestJetStreamPublisher_NoServerAvailable(t *testing.T) {
+	// Arrange
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	// Act
+	publisher, err := nats.NewJetStreamPublisher(context.Background(), nats.Config{
+		Servers: []string{"nats://" + addr},
+		Timeout: time.Second,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, publisher)
+}
@@ -0,0 +1,119 @@
+package infrastructure_test
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capturingQueryConn은 조회 쿼리와 인자를 기록하고 빈 결과를 돌려주는 드라이버 연결을 생성합니다
+func capturingQueryConn(queries *[]string, args *[][]driver.Value) *fakeSQLConn {
+	return &fakeSQLConn{query: func(query string, queryArgs []driver.Value) (fakeSQLRows, error) {
+		*queries = append(*queries, query)
+		*args = append(*args, queryArgs)
+		return fakeSQLRows{}, nil
+	}}
+}
+
+func TestPostgreSQLSave_CreatesDataGINIndex(t *testing.T) {
+	// Arrange
+	conn := &fakeSQLConn{}
+	repo := postgresql.NewPostgreSQLRepository(newFakeSQLDB(t, conn))
+	doc := entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now())
+
+	// Act
+	err := repo.Save(context.Background(), doc)
+
+	// Assert
+	require.NoError(t, err)
+	indexes := conn.execsContaining("USING GIN")
+	require.Len(t, indexes, 1)
+	assert.Equal(t, `CREATE INDEX IF NOT EXISTS "users_data_gin" ON "users" USING GIN (data jsonb_path_ops)`, indexes[0].query)
+}
+
+func TestPostgreSQLSave_SkipsDataIndexWhenEncrypted(t *testing.T) {
+	// Arrange
+	masterKey := make([]byte, 32)
+	_, err := rand.Read(masterKey)
+	require.NoError(t, err)
+	provider, err := encryption.NewLocalKeyProvider(masterKey)
+	require.NoError(t, err)
+	cipher, err := encryption.NewCipher(context.Background(), provider)
+	require.NoError(t, err)
+	conn := &fakeSQLConn{}
+	repo := postgresql.NewEncryptedPostgreSQLRepository(newFakeSQLDB(t, conn), cipher)
+	doc := entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now())
+
+	// Act
+	err = repo.Save(context.Background(), doc)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, conn.execsContaining("USING GIN"), "ciphertext cannot be indexed")
+}
+
+func TestPostgreSQLFindAll_UsesContainmentForScalarEquality(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    map[string]interface{}
+		condition string
+		args      []driver.Value
+	}{
+		{
+			name:      "top-level string",
+			filter:    map[string]interface{}{"status": "active"},
+			condition: "data @> $1::jsonb",
+			args:      []driver.Value{`{"status":"active"}`},
+		},
+		{
+			name:      "nested number",
+			filter:    map[string]interface{}{"profile.age": 30},
+			condition: "data @> $1::jsonb",
+			args:      []driver.Value{`{"profile":{"age":30}}`},
+		},
+		{
+			name:      "null",
+			filter:    map[string]interface{}{"deleted": nil},
+			condition: "data @> $1::jsonb",
+			args:      []driver.Value{`{"deleted":null}`},
+		},
+		{
+			name:      "object value",
+			filter:    map[string]interface{}{"address": map[string]interface{}{"city": "Seoul"}},
+			condition: "data #> $1::text[] = $2::jsonb",
+			args:      []driver.Value{"{address}", `{"city":"Seoul"}`},
+		},
+		{
+			name:      "array index",
+			filter:    map[string]interface{}{"tags.0": "go"},
+			condition: "data #> $1::text[] = $2::jsonb",
+			args:      []driver.Value{"{tags,0}", `"go"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var queries []string
+			var args [][]driver.Value
+			repo := postgresql.NewPostgreSQLRepository(newFakeSQLDB(t, capturingQueryConn(&queries, &args)))
+
+			// Act
+			_, err := repo.FindAll(context.Background(), "users", tt.filter)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, queries, 1)
+			assert.Contains(t, queries[0], "WHERE "+tt.condition)
+			assert.Equal(t, tt.args, args[0])
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/postgresql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPartitionedRepository는 파티션 규칙을 지정한 PostgreSQL 저장소를 생성합니다
+func newPartitionedRepository(t *testing.T, conn *fakeSQLConn, rules ...postgresql.PartitionRule) *postgresql.PostgreSQLRepository {
+	t.Helper()
+	repo, ok := postgresql.NewPostgreSQLRepository(newFakeSQLDB(t, conn)).(*postgresql.PostgreSQLRepository)
+	require.True(t, ok)
+	require.NoError(t, repo.SetPartitioning(rules))
+	return repo
+}
+
+func TestPostgreSQLSetPartitioning_RejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    postgresql.PartitionRule
+		message string
+	}{
+		{name: "empty collection", rule: postgresql.PartitionRule{Strategy: postgresql.PartitionByHash, Modulus: 4}, message: `invalid partition collection pattern ""`},
+		{name: "bad pattern", rule: postgresql.PartitionRule{Collection: "events_[", Strategy: postgresql.PartitionByRange}, message: "invalid partition collection pattern"},
+		{name: "unknown strategy", rule: postgresql.PartitionRule{Collection: "events", Strategy: "list"}, message: `unsupported partition strategy "list"`},
+		{name: "unknown interval", rule: postgresql.PartitionRule{Collection: "events", Strategy: postgresql.PartitionByRange, Interval: "year"}, message: `unsupported partition interval "year"`},
+		{name: "single hash partition", rule: postgresql.PartitionRule{Collection: "sessions", Strategy: postgresql.PartitionByHash, Modulus: 1}, message: "requires modulus of at least 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			repo := postgresql.NewPostgreSQLRepository(nil).(*postgresql.PostgreSQLRepository)
+
+			// Act
+			err := repo.SetPartitioning([]postgresql.PartitionRule{tt.rule})
+
+			// Assert
+			assert.ErrorContains(t, err, tt.message)
+		})
+	}
+}
+
+func TestPostgreSQLSave_CreatesRangePartitionsOnce(t *testing.T) {
+	// Arrange
+	conn := &fakeSQLConn{}
+	repo := newPartitionedRepository(t, conn, postgresql.PartitionRule{
+		Collection: "events_*",
+		Strategy:   postgresql.PartitionByRange,
+		Premake:    2,
+	})
+	ctx := context.Background()
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	for i := 0; i < 2; i++ {
+		doc := entity.ReconstructDocument(fmt.Sprintf("evt-%d", i), "events_login", map[string]interface{}{"n": i}, 1, now, now)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Assert
+	parents := conn.execsContaining("PARTITION BY RANGE (created_at)")
+	require.Len(t, parents, 1, "the partitioned table is prepared once per collection")
+	assert.Contains(t, parents[0].query, "PRIMARY KEY (id, created_at)")
+	assert.Len(t, conn.execsContaining(`PARTITION OF "events_login" DEFAULT`), 1)
+
+	partitions := conn.execsContaining("FOR VALUES FROM")
+	require.Len(t, partitions, 3, "the current period and two more are created ahead")
+	for i, partition := range partitions {
+		start := month.AddDate(0, i, 0)
+		assert.Contains(t, partition.query, fmt.Sprintf(`"events_login_p%s"`, start.Format("20060102")))
+		assert.Contains(t, partition.query, fmt.Sprintf("FROM ('%s') TO ('%s')",
+			start.Format("2006-01-02 15:04:05"), start.AddDate(0, 1, 0).Format("2006-01-02 15:04:05")))
+	}
+	assert.Len(t, conn.execsContaining(`INSERT INTO "events_login"`), 2)
+}
+
+func TestPostgreSQLSave_CreatesHashPartitions(t *testing.T) {
+	// Arrange
+	conn := &fakeSQLConn{}
+	repo := newPartitionedRepository(t, conn, postgresql.PartitionRule{
+		Collection: "sessions",
+		Strategy:   postgresql.PartitionByHash,
+		Modulus:    4,
+	})
+	doc := entity.ReconstructDocument("s-1", "sessions", map[string]interface{}{"user": "john"}, 1, time.Now(), time.Now())
+
+	// Act
+	err := repo.Save(context.Background(), doc)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, conn.execsContaining("PARTITION BY HASH (id)"), 1)
+	partitions := conn.execsContaining("FOR VALUES WITH")
+	require.Len(t, partitions, 4)
+	for i, partition := range partitions {
+		assert.Contains(t, partition.query, fmt.Sprintf(`"sessions_p%d" PARTITION OF "sessions" FOR VALUES WITH (MODULUS 4, REMAINDER %d)`, i, i))
+	}
+}
+
+func TestPostgreSQLUpsert_RangePartitionedUpdatesThenInserts(t *testing.T) {
+	// Arrange
+	conn := &fakeSQLConn{exec: func(query string, args []driver.Value) (driver.Result, error) {
+		if strings.Contains(query, "UPDATE") {
+			return driver.RowsAffected(0), nil
+		}
+		return driver.RowsAffected(1), nil
+	}}
+	repo := newPartitionedRepository(t, conn, postgresql.PartitionRule{Collection: "events", Strategy: postgresql.PartitionByRange})
+
+	// Act
+	id, err := repo.Upsert(context.Background(), "events", map[string]interface{}{"id": "evt-1"}, map[string]interface{}{"n": 1})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "evt-1", id)
+	locks := conn.execsContaining("pg_advisory_xact_lock")
+	require.Len(t, locks, 1)
+	assert.Equal(t, []driver.Value{"events/evt-1"}, locks[0].args)
+	assert.Len(t, conn.execsContaining(`UPDATE "events"`), 1)
+	inserts := conn.execsContaining(`INSERT INTO "events"`)
+	require.Len(t, inserts, 1, "the document is inserted when no row was updated")
+	assert.NotContains(t, inserts[0].query, "ON CONFLICT")
+	assert.Equal(t, 1, conn.commits)
+}
+
+func TestPostgreSQLFindWithOptions_FiltersAndSortsByCreatedAt(t *testing.T) {
+	// Arrange
+	var queries []string
+	var queryArgs [][]driver.Value
+	conn := &fakeSQLConn{query: func(query string, args []driver.Value) (fakeSQLRows, error) {
+		queries = append(queries, query)
+		queryArgs = append(queryArgs, args)
+		return fakeSQLRows{}, nil
+	}}
+	repo := newPartitionedRepository(t, conn, postgresql.PartitionRule{Collection: "events", Strategy: postgresql.PartitionByRange})
+	from := time.Date(2026, 3, 1, 9, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+
+	// Act
+	_, err := repo.FindWithOptions(context.Background(), "events", map[string]interface{}{
+		"_created_at": map[string]interface{}{"$gte": from},
+	}, &repository.FindOptions{Sort: map[string]int{"_created_at": -1}})
+	_, invalidErr := repo.FindWithOptions(context.Background(), "events", map[string]interface{}{
+		"_created_at": map[string]interface{}{"$ne": from},
+	}, nil)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+	assert.Contains(t, queries[0], "created_at >= $1")
+	assert.Contains(t, queries[0], "ORDER BY created_at DESC")
+	assert.Equal(t, []driver.Value{from.UTC()}, queryArgs[0], "filter times use the partition bounds' UTC")
+	assert.ErrorContains(t, invalidErr, `unsupported operator "$ne" on created_at`)
+}
@@ -0,0 +1,262 @@
+package infrastructure_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/rabbitmq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAMQPBroker는 발행자 테스트용 최소 AMQP 0-9-1 브로커입니다
+// 연결 협상, 교환기 선언, confirm 모드에 응답하고 발행된 메시지를 기록합니다
+type fakeAMQPBroker struct {
+	listener net.Listener
+	nack     bool
+
+	mu        sync.Mutex
+	exchanges []string
+	published []fakeAMQPMessage
+}
+
+type fakeAMQPMessage struct {
+	exchange   string
+	routingKey string
+	messageID  string
+	body       []byte
+}
+
+func newFakeAMQPBroker(t *testing.T, nack bool) *fakeAMQPBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	b := &fakeAMQPBroker{listener: listener, nack: nack}
+	go b.serve()
+	t.Cleanup(func() { listener.Close() })
+	return b
+}
+
+func (b *fakeAMQPBroker) url() string {
+	return "amqp://guest:guest@" + b.listener.Addr().String() + "/"
+}
+
+func (b *fakeAMQPBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *fakeAMQPBroker) handle(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return
+	}
+
+	// Connection.Start: version, server properties, mechanisms, locales
+	start := amqpMethod(10, 10)
+	start = append(start, 0, 9)
+	start = binary.BigEndian.AppendUint32(start, 0)
+	start = appendLongStr(start, "PLAIN AMQPLAIN")
+	start = appendLongStr(start, "en_US")
+	writeAMQPFrame(conn, 1, 0, start)
+
+	var tag, bodySize uint64
+	var pending *fakeAMQPMessage
+	for {
+		typ, channel, payload, err := readAMQPFrame(br)
+		if err != nil {
+			return
+		}
+
+		switch typ {
+		case 1:
+			classID := binary.BigEndian.Uint16(payload[0:2])
+			methodID := binary.BigEndian.Uint16(payload[2:4])
+			args := payload[4:]
+
+			switch {
+			case classID == 10 && methodID == 11: // StartOk → Tune
+				tune := amqpMethod(10, 30)
+				tune = binary.BigEndian.AppendUint16(tune, 2047)
+				tune = binary.BigEndian.AppendUint32(tune, 4096)
+				tune = binary.BigEndian.AppendUint16(tune, 0)
+				writeAMQPFrame(conn, 1, 0, tune)
+			case classID == 10 && methodID == 40: // Open → OpenOk
+				writeAMQPFrame(conn, 1, 0, append(amqpMethod(10, 41), 0))
+			case classID == 10 && methodID == 50: // Close → CloseOk
+				writeAMQPFrame(conn, 1, 0, amqpMethod(10, 51))
+				return
+			case classID == 20 && methodID == 10: // Channel.Open → OpenOk
+				writeAMQPFrame(conn, 1, channel, binary.BigEndian.AppendUint32(amqpMethod(20, 11), 0))
+			case classID == 40 && methodID == 10: // Exchange.Declare → DeclareOk
+				name, _ := readShortStr(args[2:])
+				b.mu.Lock()
+				b.exchanges = append(b.exchanges, name)
+				b.mu.Unlock()
+				writeAMQPFrame(conn, 1, channel, amqpMethod(40, 11))
+			case classID == 85 && methodID == 10: // Confirm.Select → SelectOk
+				writeAMQPFrame(conn, 1, channel, amqpMethod(85, 11))
+			case classID == 60 && methodID == 40: // Basic.Publish
+				exchange, rest := readShortStr(args[2:])
+				routingKey, _ := readShortStr(rest)
+				pending = &fakeAMQPMessage{exchange: exchange, routingKey: routingKey}
+			}
+		case 2: // 콘텐츠 헤더: 본문 크기, 속성(content-type, delivery-mode, message-id 순서)
+			bodySize = binary.BigEndian.Uint64(payload[4:12])
+			_, rest := readShortStr(payload[14:])
+			pending.messageID, _ = readShortStr(rest[1:])
+		case 3:
+			pending.body = append(pending.body, payload...)
+			if uint64(len(pending.body)) == bodySize {
+				tag++
+				b.confirm(conn, channel, tag, pending)
+			}
+		}
+	}
+}
+
+func (b *fakeAMQPBroker) confirm(conn net.Conn, channel uint16, tag uint64, msg *fakeAMQPMessage) {
+	b.mu.Lock()
+	b.published = append(b.published, *msg)
+	b.mu.Unlock()
+
+	methodID := uint16(80)
+	if b.nack {
+		methodID = 120
+	}
+	ack := binary.BigEndian.AppendUint64(amqpMethod(60, methodID), tag)
+	writeAMQPFrame(conn, 1, channel, append(ack, 0))
+}
+
+func (b *fakeAMQPBroker) snapshot() ([]string, []fakeAMQPMessage) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.exchanges...), append([]fakeAMQPMessage(nil), b.published...)
+}
+
+func amqpMethod(classID, methodID uint16) []byte {
+	buf := binary.BigEndian.AppendUint16(nil, classID)
+	return binary.BigEndian.AppendUint16(buf, methodID)
+}
+
+func appendLongStr(buf []byte, s string) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(s)))
+	return append(buf, s...)
+}
+
+func readShortStr(buf []byte) (string, []byte) {
+	n := int(buf[0])
+	return string(buf[1 : 1+n]), buf[1+n:]
+}
+
+func writeAMQPFrame(conn net.Conn, typ byte, channel uint16, payload []byte) {
+	frame := []byte{typ}
+	frame = binary.BigEndian.AppendUint16(frame, channel)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(len(payload)))
+	frame = append(frame, payload...)
+	conn.Write(append(frame, 0xCE))
+}
+
+func readAMQPFrame(br *bufio.Reader) (byte, uint16, []byte, error) {
+	head := make([]byte, 7)
+	if _, err := io.ReadFull(br, head); err != nil {
+		return 0, 0, nil, err
+	}
+	payload := make([]byte, binary.BigEndian.Uint32(head[3:7])+1)
+	if _, err := io.ReadFull(br, payload); err != nil {
+		return 0, 0, nil, err
+	}
+	return head[0], binary.BigEndian.Uint16(head[1:3]), payload[:len(payload)-1], nil
+}
+
+func TestRabbitMQPublisher_PublishesToEventExchangeWithCollectionRoutingKey(t *testing.T) {
+	// Arrange
+	broker := newFakeAMQPBroker(t, false)
+	publisher, err := rabbitmq.NewPublisher(context.Background(), rabbitmq.Config{
+		URL:     broker.url(),
+		Timeout: 2 * time.Second,
+	})
+	require.NoError(t, err)
+	defer publisher.Close()
+	publisher.SetOrigin("instance-1")
+
+	var _ messaging.CDCPublisher = publisher
+
+	// Act
+	err = publisher.PublishDocumentCreated(context.Background(), "doc-1", "users", map[string]interface{}{"name": "kim"}, 1)
+
+	// Assert
+	require.NoError(t, err)
+	exchanges, published := broker.snapshot()
+	assert.Equal(t, []string{"documents.created", "documents.updated", "documents.deleted"}, exchanges)
+	require.Len(t, published, 1)
+	assert.Equal(t, "documents.created", published[0].exchange)
+	assert.Equal(t, "users", published[0].routingKey)
+
+	var event messaging.DocumentCreatedEvent
+	require.NoError(t, json.Unmarshal(published[0].body, &event))
+	assert.Equal(t, messaging.EventDocumentCreated, event.EventType)
+	assert.Equal(t, event.EventID, published[0].messageID)
+	assert.Equal(t, "instance-1", event.Metadata[messaging.MetadataOrigin])
+}
+
+func TestRabbitMQPublisher_SplitsLargeBodyAcrossFrames(t *testing.T) {
+	// Arrange
+	broker := newFakeAMQPBroker(t, false)
+	publisher, err := rabbitmq.NewPublisher(context.Background(), rabbitmq.Config{
+		URL:     broker.url(),
+		Timeout: 2 * time.Second,
+	})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	large := make([]byte, 10000)
+	for i := range large {
+		large[i] = 'a'
+	}
+
+	// Act
+	err = publisher.PublishDocumentUpdated(context.Background(), "doc-1", "users", map[string]interface{}{"blob": string(large)}, 2, 1, nil)
+
+	// Assert
+	require.NoError(t, err)
+	_, published := broker.snapshot()
+	require.Len(t, published, 1)
+
+	var event messaging.DocumentUpdatedEvent
+	require.NoError(t, json.Unmarshal(published[0].body, &event))
+	assert.Equal(t, string(large), event.Data["blob"])
+}
+
+func TestRabbitMQPublisher_NackReturnsError(t *testing.T) {
+	// Arrange
+	broker := newFakeAMQPBroker(t, true)
+	publisher, err := rabbitmq.NewPublisher(context.Background(), rabbitmq.Config{
+		URL:     broker.url(),
+		Timeout: 2 * time.Second,
+	})
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	// Act
+	err = publisher.PublishDocumentDeleted(context.Background(), "doc-1", "users", 3)
+
+	// Assert
+	assert.ErrorIs(t, err, rabbitmq.ErrNacked)
+}
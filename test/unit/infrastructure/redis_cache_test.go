@@ -0,0 +1,70 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRedisCache_RejectsIncompleteModeSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *cache.Config
+		message string
+	}{
+		{
+			name:    "sentinel without addresses",
+			cfg:     &cache.Config{Mode: cache.ModeSentinel, MasterName: "mymaster"},
+			message: "requires at least one sentinel address",
+		},
+		{
+			name:    "sentinel without master name",
+			cfg:     &cache.Config{Mode: cache.ModeSentinel, Addresses: []string{"127.0.0.1:26379"}},
+			message: "requires a master name",
+		},
+		{
+			name:    "cluster without addresses",
+			cfg:     &cache.Config{Mode: cache.ModeCluster},
+			message: "requires at least one node address",
+		},
+		{
+			name:    "cluster with non-zero db",
+			cfg:     &cache.Config{Mode: cache.ModeCluster, Addresses: []string{"127.0.0.1:7000"}, DB: 2},
+			message: "does not support db 2",
+		},
+		{
+			name:    "unsupported mode",
+			cfg:     &cache.Config{Mode: "replicated"},
+			message: "unsupported redis mode: replicated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := cache.NewRedisCache(context.Background(), tt.cfg)
+
+			// Assert
+			assert.ErrorContains(t, err, tt.message)
+		})
+	}
+}
+
+func TestNewRedisCache_ConnectionErrorNamesMode(t *testing.T) {
+	// Arrange - 비어 있는 모드는 standalone으로 취급합니다
+	cfg := &cache.Config{
+		Host:        "127.0.0.1",
+		Port:        1,
+		MaxRetries:  -1,
+		DialTimeout: 200 * time.Millisecond,
+	}
+
+	// Act
+	_, err := cache.NewRedisCache(context.Background(), cfg)
+
+	// Assert
+	assert.ErrorContains(t, err, "failed to connect to redis (standalone)")
+}
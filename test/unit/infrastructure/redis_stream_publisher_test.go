@@ -0,0 +1,130 @@
+package infrastructure_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/redisstream"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingHook은 Redis에 보내지 않고 명령 인자를 기록하는 go-redis 훅입니다
+type recordingHook struct {
+	commands [][]interface{}
+	err      error
+}
+
+func (h *recordingHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return nil, errors.New("unexpected dial")
+	}
+}
+
+func (h *recordingHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		h.commands = append(h.commands, cmd.Args())
+		if h.err != nil {
+			cmd.SetErr(h.err)
+			return h.err
+		}
+		if c, ok := cmd.(*redis.StringCmd); ok {
+			c.SetVal(fmt.Sprintf("1700000000000-%d", len(h.commands)))
+		}
+		return nil
+	}
+}
+
+func (h *recordingHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func newRecordingRedisClient(t *testing.T) (*redis.Client, *recordingHook) {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:0"})
+	hook := &recordingHook{}
+	client.AddHook(hook)
+	t.Cleanup(func() { client.Close() })
+	return client, hook
+}
+
+// streamFields는 XADD 인자에서 필드/값 쌍을 꺼냅니다
+func streamFields(t *testing.T, args []interface{}) map[string]string {
+	t.Helper()
+	for i, arg := range args {
+		if arg == "*" {
+			fields := map[string]string{}
+			for j := i + 1; j+1 < len(args); j += 2 {
+				fields[fmt.Sprint(args[j])] = fmt.Sprint(args[j+1])
+			}
+			return fields
+		}
+	}
+	t.Fatalf("no entry id in %v", args)
+	return nil
+}
+
+func TestRedisStreamPublisher_AddsFlattenedEntryPerCollection(t *testing.T) {
+	// Arrange
+	client, hook := newRecordingRedisClient(t)
+	publisher := redisstream.NewPublisher(client, redisstream.DefaultConfig())
+	publisher.SetOrigin("instance-a")
+
+	// Act
+	err := publisher.PublishDocumentUpdated(context.Background(), "u1", "users", map[string]interface{}{"name": "Jane"}, 3, 2, map[string]interface{}{"name": "Jane"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, hook.commands, 1)
+	args := hook.commands[0]
+	assert.Equal(t, []interface{}{"xadd", "cdc:users", "maxlen", "~", int64(100000), "*"}, args[:6])
+
+	fields := streamFields(t, args)
+	assert.Equal(t, messaging.EventDocumentUpdated, fields["event_type"])
+	assert.Equal(t, "u1", fields["document_id"])
+	assert.Equal(t, "users", fields["collection"])
+	assert.Equal(t, "3", fields["version"])
+	assert.Equal(t, "instance-a", fields["origin"])
+	assert.Equal(t, "application/json", fields["content_type"])
+
+	var payload messaging.DocumentUpdatedEvent
+	require.NoError(t, json.Unmarshal([]byte(fields["payload"]), &payload))
+	assert.Equal(t, fields["event_id"], payload.EventID)
+	assert.Equal(t, 2, payload.PreviousVersion)
+	assert.Equal(t, "Jane", payload.Data["name"])
+	assert.Equal(t, "instance-a", payload.Metadata[messaging.MetadataOrigin])
+}
+
+func TestRedisStreamPublisher_UnboundedStreamSkipsTrimming(t *testing.T) {
+	// Arrange
+	client, hook := newRecordingRedisClient(t)
+	publisher := redisstream.NewPublisher(client, redisstream.Config{KeyPrefix: "changes", MaxLen: 0, ApproximateTrim: true})
+
+	// Act
+	err := publisher.PublishDocumentDeleted(context.Background(), "u1", "users", 4)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, hook.commands, 1)
+	assert.Equal(t, []interface{}{"xadd", "changes:users", "*"}, hook.commands[0][:3])
+	assert.Equal(t, messaging.EventDocumentDeleted, streamFields(t, hook.commands[0])["event_type"])
+}
+
+func TestRedisStreamPublisher_ReturnsStreamError(t *testing.T) {
+	// Arrange
+	client, hook := newRecordingRedisClient(t)
+	hook.err = errors.New("OOM command not allowed")
+	publisher := redisstream.NewPublisher(client, redisstream.DefaultConfig())
+
+	// Act
+	err := publisher.PublishDocumentCreated(context.Background(), "u1", "users", map[string]interface{}{"name": "John"}, 1)
+
+	// Assert
+	assert.ErrorContains(t, err, "failed to publish event to stream cdc:users")
+}
@@ -0,0 +1,78 @@
+package infrastructure_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func quoteDouble(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func TestSchemaLoad_PairsFilesInVersionOrder(t *testing.T) {
+	// Arrange
+	files := fstest.MapFS{
+		"0002_add_deleted_at.up.sql":   {Data: []byte("ALTER TABLE {{.Table}} ADD COLUMN deleted_at TIMESTAMP;")},
+		"0002_add_deleted_at.down.sql": {Data: []byte("ALTER TABLE {{.Table}} DROP COLUMN deleted_at;")},
+		"0001_baseline.up.sql":         {Data: []byte("CREATE TABLE IF NOT EXISTS {{.Table}} (id TEXT);")},
+		"README.md":                    {Data: []byte("ignored")},
+	}
+
+	// Act
+	migrations, err := schema.Load(files)
+
+	// Assert - 버전 순으로 정렬되고 down 파일이 없으면 되돌릴 수 없음
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, 1, migrations[0].Version)
+	assert.Equal(t, "baseline", migrations[0].Name)
+	assert.False(t, migrations[0].Reversible())
+	assert.Equal(t, 2, migrations[1].Version)
+	assert.True(t, migrations[1].Reversible())
+}
+
+func TestSchemaLoad_RejectsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{"bad name", fstest.MapFS{"add_column.sql": {Data: []byte("SELECT 1;")}}},
+		{"missing up", fstest.MapFS{"0001_baseline.down.sql": {Data: []byte("DROP TABLE {{.Table}};")}}},
+		{"conflicting names", fstest.MapFS{
+			"0001_a.up.sql": {Data: []byte("SELECT 1;")},
+			"0001_b.up.sql": {Data: []byte("SELECT 2;")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := schema.Load(tt.files)
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSchemaRender_QuotesTableAndSplitsStatements(t *testing.T) {
+	// Arrange
+	script := `-- 주석만 있는 문장은 실행하지 않음
+ALTER TABLE {{.Table}} ADD COLUMN deleted_at TIMESTAMP;
+CREATE INDEX {{quote (print .Name "_deleted_at")}} ON {{.Table}} (deleted_at);
+`
+
+	// Act
+	statements, err := schema.Render(script, `my"docs`, quoteDouble)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+	assert.Equal(t, "-- 주석만 있는 문장은 실행하지 않음\nALTER TABLE \"my\"\"docs\" ADD COLUMN deleted_at TIMESTAMP", statements[0])
+	assert.Equal(t, `CREATE INDEX "my""docs_deleted_at" ON "my""docs" (deleted_at)`, statements[1])
+}
@@ -0,0 +1,99 @@
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/searchmirror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMirrorFixture는 n개의 문서가 같은 내용으로 미러된 주 저장소와 미러를 만듭니다
+func newMirrorFixture(t *testing.T, n int) (*memBackend, *memBackend) {
+	t.Helper()
+	source, mirror := newMemBackend(), newMemBackend()
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("p-%02d", i)
+		require.NoError(t, source.Save(context.Background(), migrationDoc(id, i)))
+		// 미러는 다른 경로로 쓰이므로 버전과 숫자 타입이 달라도 같은 문서로 취급
+		mirrored := entity.ReconstructDocument(id, "users", map[string]interface{}{"n": float64(i)}, 7, time.Now(), time.Now())
+		require.NoError(t, mirror.Save(context.Background(), mirrored))
+	}
+	return source, mirror
+}
+
+func TestSearchMirrorVerifier_ReportsDrift(t *testing.T) {
+	// Arrange
+	source, mirror := newMirrorFixture(t, 5)
+	require.NoError(t, mirror.Delete(context.Background(), "users", "p-01"))
+	require.NoError(t, mirror.Save(context.Background(), migrationDoc("p-02", 99)))
+	require.NoError(t, mirror.Save(context.Background(), migrationDoc("stale", 1)))
+	verifier := searchmirror.NewVerifier(source, mirror, "users", "")
+
+	// Act
+	report, err := verifier.Verify(context.Background(), searchmirror.Options{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, report.InSync())
+	assert.Equal(t, int64(5), report.SourceCount)
+	assert.Equal(t, int64(5), report.MirrorCount)
+	assert.Equal(t, int64(1), report.Missing)
+	assert.Equal(t, int64(1), report.Extra)
+	assert.Equal(t, int64(1), report.Diverged)
+	assert.Zero(t, report.Repaired)
+	assert.False(t, mirror.has("p-01"), "verification alone does not change the mirror")
+}
+
+func TestSearchMirrorVerifier_RepairResyncsDivergentDocuments(t *testing.T) {
+	// Arrange
+	source, mirror := newMirrorFixture(t, 3)
+	require.NoError(t, mirror.Delete(context.Background(), "users", "p-00"))
+	require.NoError(t, mirror.Save(context.Background(), migrationDoc("p-01", 42)))
+	require.NoError(t, mirror.Save(context.Background(), migrationDoc("stale", 1)))
+	verifier := searchmirror.NewVerifier(source, mirror, "users", "")
+
+	// Act
+	report, err := verifier.Verify(context.Background(), searchmirror.Options{Repair: true})
+	require.NoError(t, err)
+	again, err := verifier.Verify(context.Background(), searchmirror.Options{})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(3), report.Repaired)
+	assert.Zero(t, report.RepairFailed)
+	assert.True(t, again.InSync())
+	assert.False(t, mirror.has("stale"))
+	repaired, err := mirror.FindByID(context.Background(), "users", "p-01")
+	require.NoError(t, err)
+	assert.Equal(t, 1, repaired.Data()["n"])
+}
+
+func TestSearchMirrorSyncer_MirrorsCurrentSourceState(t *testing.T) {
+	// Arrange
+	source, mirror := newMirrorFixture(t, 2)
+	require.NoError(t, source.Save(context.Background(), migrationDoc("p-01", 50)))
+	require.NoError(t, source.Delete(context.Background(), "users", "p-00"))
+	syncer := searchmirror.NewSyncer(source, mirror, "users", "")
+
+	// Act
+	updated, err := syncer.Sync(context.Background(), "p-01")
+	require.NoError(t, err)
+	deleted, err := syncer.Sync(context.Background(), "p-00")
+	require.NoError(t, err)
+	again, err := syncer.Sync(context.Background(), "p-00")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, searchmirror.SyncIndexed, updated)
+	assert.Equal(t, searchmirror.SyncDeleted, deleted)
+	assert.Equal(t, searchmirror.SyncDeleted, again, "replayed delete events are harmless")
+	assert.False(t, mirror.has("p-00"))
+	synced, err := mirror.FindByID(context.Background(), "users", "p-01")
+	require.NoError(t, err)
+	assert.Equal(t, 50, synced.Data()["n"])
+}
@@ -0,0 +1,515 @@
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memShard는 샤딩 테스트용 메모리 저장소입니다 (테스트에서 쓰는 메서드만 구현)
+type memShard struct {
+	repository.DocumentRepository
+	docs map[string]*entity.Document
+}
+
+func newMemShard() *memShard {
+	return &memShard{docs: make(map[string]*entity.Document)}
+}
+
+func (s *memShard) Save(ctx context.Context, doc *entity.Document) error {
+	s.docs[doc.ID()] = doc
+	return nil
+}
+
+func (s *memShard) FindByID(ctx context.Context, collection, id string) (*entity.Document, error) {
+	doc, ok := s.docs[id]
+	if !ok {
+		return nil, entity.ErrDocumentNotFound
+	}
+	return doc, nil
+}
+
+func (s *memShard) FindWithOptions(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) ([]*entity.Document, error) {
+	docs := make([]*entity.Document, 0, len(s.docs))
+	for _, doc := range s.docs {
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// BulkWrite는 삽입만 실행하며, ID가 "dup"인 문서는 개별 실패로 보고합니다
+func (s *memShard) BulkWrite(ctx context.Context, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
+	result := &repository.BulkResult{}
+	for i, op := range operations {
+		if op.Document.ID() == "dup" {
+			result.Errors = append(result.Errors, repository.BulkWriteError{Index: i, ID: "dup", Reason: "duplicate key"})
+			continue
+		}
+		s.docs[op.Document.ID()] = op.Document
+		result.InsertedCount++
+	}
+	return result, nil
+}
+
+func (s *memShard) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	docs, _ := s.FindWithOptions(ctx, collection, filter, opts)
+	sort.Slice(docs, func(i, j int) bool { return docs[i].ID() < docs[j].ID() })
+	return repository.NewSliceIterator(docs), nil
+}
+
+func (s *memShard) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	return int64(len(s.docs)), nil
+}
+
+func (s *memShard) MaintenanceOperations() []string {
+	return []string{"compact", "reindex"}
+}
+
+func (s *memShard) RunMaintenance(ctx context.Context, collection, operation string, opts repository.MaintenanceOptions) (map[string]interface{}, error) {
+	switch operation {
+	case "compact":
+		return map[string]interface{}{"documents": len(s.docs)}, nil
+	case "reindex":
+		total := int64(len(s.docs))
+		opts.ReportProgress(0, total)
+		opts.ReportProgress(total, total)
+		return map[string]interface{}{"documents": len(s.docs)}, nil
+	default:
+		return nil, repository.ErrUnsupportedMaintenance
+	}
+}
+
+func (s *memShard) CollectionStats(ctx context.Context, collection string) (*repository.CollectionStats, error) {
+	return &repository.CollectionStats{
+		Collection:     collection,
+		Count:          int64(len(s.docs)),
+		Size:           int64(len(s.docs)) * 100,
+		StorageSize:    4096,
+		IndexCount:     1,
+		TotalIndexSize: 1024,
+		IndexSizes:     map[string]int64{"_id_": 1024},
+		StorageEngine:  "wiredTiger",
+	}, nil
+}
+
+func (s *memShard) DatabaseStats(ctx context.Context) (*repository.DatabaseStats, error) {
+	users, _ := s.CollectionStats(ctx, "users")
+	stats := repository.SummarizeCollections([]*repository.CollectionStats{users})
+	stats.Connections = &repository.ConnectionStats{Current: 5, Active: 1}
+	return stats, nil
+}
+
+func (s *memShard) EnsureUniqueKey(ctx context.Context, collection string, fields []string) (bool, error) {
+	return true, nil
+}
+
+func (s *memShard) FindByUniqueKey(ctx context.Context, collection string, key map[string]interface{}, limit int) ([]string, error) {
+	var ids []string
+	for id, doc := range s.docs {
+		matched := true
+		for field, value := range key {
+			if doc.Data()[field] != value {
+				matched = false
+			}
+		}
+		if matched {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (s *memShard) FindReferencing(ctx context.Context, collection, field, id string, limit int) ([]string, error) {
+	return s.FindByUniqueKey(ctx, collection, map[string]interface{}{field: id}, limit)
+}
+
+func newShardedRepo(t *testing.T, shardKey string, n int) (*sharding.Repository, []*memShard) {
+	mems := make([]*memShard, n)
+	shards := make([]sharding.Shard, n)
+	for i := range shards {
+		mems[i] = newMemShard()
+		shards[i] = sharding.Shard{Name: fmt.Sprintf("shard-%d", i), Repository: mems[i]}
+	}
+	seq := 0
+	repo, err := sharding.NewRepository(shards, sharding.Config{
+		ShardKey: shardKey,
+		NewID: func() string {
+			seq++
+			return fmt.Sprintf("doc-%04d", seq)
+		},
+	})
+	require.NoError(t, err)
+	return repo, mems
+}
+
+func TestShardRouter_StableAndBalanced(t *testing.T) {
+	// Arrange
+	router, err := sharding.NewRouter(4, "")
+	require.NoError(t, err)
+	grown, err := sharding.NewRouter(5, "")
+	require.NoError(t, err)
+
+	// Act
+	counts := make([]int, 4)
+	moved := 0
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		shard := router.Shard(key)
+		counts[shard]++
+		assert.Equal(t, shard, router.Shard(key))
+		if grown.Shard(key) != shard {
+			moved++
+		}
+	}
+
+	// Assert - 고르게 분산되고, 샤드를 추가하면 약 1/5만 이동
+	for _, count := range counts {
+		assert.InDelta(t, 2500, count, 250)
+	}
+	assert.InDelta(t, 2000, moved, 300)
+}
+
+func TestShardRouter_ShardOfFilter(t *testing.T) {
+	// Arrange
+	byID, err := sharding.NewRouter(4, "")
+	require.NoError(t, err)
+	byTenant, err := sharding.NewRouter(4, "tenant_id")
+	require.NoError(t, err)
+
+	// Act & Assert
+	shard, ok := byID.ShardOfFilter(map[string]interface{}{"_id": "abc"})
+	assert.True(t, ok)
+	assert.Equal(t, byID.Shard("abc"), shard)
+
+	_, ok = byID.ShardOfFilter(map[string]interface{}{"data.status": "active"})
+	assert.False(t, ok)
+
+	shard, ok = byTenant.ShardOfFilter(map[string]interface{}{
+		"$and": []interface{}{
+			map[string]interface{}{"data.status": "active"},
+			map[string]interface{}{"data.tenant_id": map[string]interface{}{"$eq": "acme"}},
+		},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, byTenant.Shard("acme"), shard)
+
+	_, ok = byTenant.ShardOfFilter(map[string]interface{}{"data.tenant_id": map[string]interface{}{"$in": []string{"a", "b"}}})
+	assert.False(t, ok)
+}
+
+func TestShardedRepository_SaveRoutesByAssignedID(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, mems := newShardedRepo(t, "", 3)
+
+	// Act
+	for i := 0; i < 30; i++ {
+		doc, err := entity.NewDocument("orders", map[string]interface{}{"n": i})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Assert - 모든 문서에 ID가 배정되고 ID가 속한 샤드에만 저장
+	total := 0
+	for i, mem := range mems {
+		for id := range mem.docs {
+			assert.NotEmpty(t, id)
+			assert.Equal(t, i, repo.Router().Shard(id))
+		}
+		total += len(mem.docs)
+	}
+	assert.Equal(t, 30, total)
+
+	found, err := repo.FindByID(ctx, "orders", "doc-0007")
+	require.NoError(t, err)
+	assert.Equal(t, "doc-0007", found.ID())
+
+	_, err = repo.FindByID(ctx, "orders", "missing")
+	assert.ErrorIs(t, err, entity.ErrDocumentNotFound)
+}
+
+func TestShardedRepository_ShardKeyRoutingAndBroadcastLookup(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, mems := newShardedRepo(t, "tenant_id", 3)
+	doc := entity.ReconstructDocument("order-1", "orders", map[string]interface{}{"tenant_id": "acme"}, 1, time.Now(), time.Now())
+
+	// Act
+	require.NoError(t, repo.Save(ctx, doc))
+	found, err := repo.FindByID(ctx, "orders", "order-1")
+
+	// Assert - 샤드 키 값의 샤드에 저장되고, ID 조회는 모든 샤드에서 찾음
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", found.ID())
+	assert.Contains(t, mems[repo.Router().Shard("acme")].docs, "order-1")
+}
+
+func TestShardedRepository_FindWithOptionsMergesSortedPage(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 3)
+	for i := 0; i < 9; i++ {
+		doc := entity.ReconstructDocument(fmt.Sprintf("id-%d", i), "orders", map[string]interface{}{"rank": i}, 1, time.Now(), time.Now())
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	docs, err := repo.FindWithOptions(ctx, "orders", map[string]interface{}{}, &repository.FindOptions{
+		Sort:  map[string]int{"data.rank": -1},
+		Skip:  2,
+		Limit: 3,
+	})
+	count, countErr := repo.Count(ctx, "orders", map[string]interface{}{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, docs, 3)
+	for i, doc := range docs {
+		assert.Equal(t, 6-i, doc.Data()["rank"])
+	}
+	require.NoError(t, countErr)
+	assert.Equal(t, int64(9), count)
+}
+
+func TestShardedRepository_BulkWriteSplitsByShardAndRemapsErrors(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, mems := newShardedRepo(t, "", 3)
+	ids := []string{"id-0", "id-1", "dup", "id-3", "id-4", "id-5"}
+	operations := make([]*repository.BulkOperation, len(ids))
+	for i, id := range ids {
+		doc := entity.ReconstructDocument(id, "orders", map[string]interface{}{"n": i}, 1, time.Now(), time.Now())
+		operations[i] = &repository.BulkOperation{Type: "insert", Collection: "orders", Document: doc}
+	}
+
+	// Act
+	result, err := repo.BulkWrite(ctx, operations)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), result.InsertedCount)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 2, result.Errors[0].Index, "the shard-local index is mapped back to the request")
+	assert.Equal(t, "dup", result.Errors[0].ID)
+	for i, mem := range mems {
+		for id := range mem.docs {
+			assert.Equal(t, i, repo.Router().Shard(id), "%s is written to its own shard", id)
+		}
+	}
+}
+
+func TestShardedRepository_BulkWriteRejectsUnroutableUpsert(t *testing.T) {
+	// Arrange
+	repo, _ := newShardedRepo(t, "tenant_id", 3)
+
+	// Act
+	_, err := repo.BulkWrite(context.Background(), []*repository.BulkOperation{
+		{Type: "update", Collection: "orders", Filter: map[string]interface{}{"status": "open"}, Update: map[string]interface{}{"status": "closed"}, Upsert: true},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, sharding.ErrShardNotResolved)
+	assert.ErrorContains(t, err, "operation 0")
+}
+
+func TestShardedRepository_FindStreamChainsShardsWithSkipAndLimit(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 3)
+	for i := 0; i < 9; i++ {
+		doc := entity.ReconstructDocument(fmt.Sprintf("id-%d", i), "orders", map[string]interface{}{"n": i}, 1, time.Now(), time.Now())
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	it, err := repo.FindStream(ctx, "orders", map[string]interface{}{}, &repository.FindOptions{Skip: 2, Limit: 5})
+	require.NoError(t, err)
+	seen := map[string]bool{}
+	for it.Next(ctx) {
+		doc, err := it.Decode()
+		require.NoError(t, err)
+		seen[doc.ID()] = true
+	}
+
+	// Assert
+	require.NoError(t, it.Err())
+	require.NoError(t, it.Close(ctx))
+	assert.Len(t, seen, 5, "skip and limit apply to the whole result")
+}
+
+func TestShardedRepository_RunMaintenanceOnEveryShard(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, mems := newShardedRepo(t, "", 3)
+	for i := 0; i < 30; i++ {
+		doc, err := entity.NewDocument("users", map[string]interface{}{"n": i})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	result, err := repo.RunMaintenance(ctx, "users", "compact", repository.MaintenanceOptions{})
+	_, unsupportedErr := repo.RunMaintenance(ctx, "users", "vacuum", repository.MaintenanceOptions{})
+
+	// Assert
+	require.NoError(t, err)
+	shards, ok := result["shards"].(map[string]interface{})
+	require.True(t, ok)
+	require.Len(t, shards, 3)
+	for i, mem := range mems {
+		assert.Equal(t, map[string]interface{}{"documents": len(mem.docs)}, shards[fmt.Sprintf("shard-%d", i)])
+	}
+	assert.ErrorIs(t, unsupportedErr, repository.ErrUnsupportedMaintenance)
+	assert.Equal(t, []string{"compact", "reindex"}, repo.MaintenanceOperations())
+}
+
+func TestShardedRepository_ReindexReportsProgressAcrossShards(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 3)
+	for i := 0; i < 30; i++ {
+		doc, err := entity.NewDocument("users", map[string]interface{}{"n": i})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+	var mu sync.Mutex
+	var lastDone, lastTotal int64
+	opts := repository.MaintenanceOptions{
+		Progress: func(done, total int64) {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.LessOrEqual(t, done, total)
+			lastDone, lastTotal = done, total
+		},
+	}
+
+	// Act
+	_, err := repo.RunMaintenance(ctx, "users", "reindex", opts)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), lastDone)
+	assert.Equal(t, int64(30), lastTotal)
+}
+
+func TestShardedRepository_CollectionStatsSumsShards(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 3)
+	for i := 0; i < 30; i++ {
+		doc, err := entity.NewDocument("users", map[string]interface{}{"n": i})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	stats, err := repo.CollectionStats(ctx, "users")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), stats.Count)
+	assert.Equal(t, int64(3000), stats.Size)
+	assert.Equal(t, 100.0, stats.AvgDocSize)
+	assert.Equal(t, int64(3*4096), stats.StorageSize)
+	assert.Equal(t, 1, stats.IndexCount)
+	assert.Equal(t, map[string]int64{"_id_": 3 * 1024}, stats.IndexSizes)
+	assert.Equal(t, "wiredTiger", stats.StorageEngine)
+}
+
+func TestShardedRepository_DatabaseStatsMergesCollections(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 2)
+	for i := 0; i < 10; i++ {
+		doc, err := entity.NewDocument("users", map[string]interface{}{"n": i})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	stats, err := repo.DatabaseStats(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Collections)
+	assert.Equal(t, int64(10), stats.Documents)
+	assert.Equal(t, int64(1000), stats.DataSize)
+	assert.Equal(t, int64(2*4096), stats.StorageSize)
+	assert.Equal(t, int64(2*1024), stats.IndexSize)
+	require.NotNil(t, stats.Connections)
+	assert.Equal(t, int64(10), stats.Connections.Current)
+	assert.Equal(t, int64(2), stats.Connections.Active)
+	require.Len(t, stats.PerCollection, 1)
+	assert.Equal(t, "users", stats.PerCollection[0].Collection)
+	assert.Equal(t, int64(10), stats.PerCollection[0].Count)
+}
+
+func TestShardedRepository_UniqueKeyIsCheckedAcrossShards(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 3)
+	for i := 0; i < 9; i++ {
+		doc, err := entity.NewDocument("users", map[string]interface{}{"email": fmt.Sprintf("user%d@example.com", i%3)})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	native, err := repo.EnsureUniqueKey(ctx, "users", []string{"email"})
+	require.NoError(t, err)
+	ids, err := repo.FindByUniqueKey(ctx, "users", map[string]interface{}{"email": "user1@example.com"}, 2)
+	require.NoError(t, err)
+	missing, err := repo.FindByUniqueKey(ctx, "users", map[string]interface{}{"email": "nobody@example.com"}, 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, native, "shard-local indexes cannot enforce uniqueness across shards")
+	assert.Equal(t, []string{"doc-0002", "doc-0005"}, ids)
+	assert.Empty(t, missing)
+}
+
+func TestUniqueKeyName(t *testing.T) {
+	// Act
+	short := repository.UniqueKeyName([]string{"email", "profile.tenant"})
+	long := repository.UniqueKeyName([]string{strings.Repeat("a", 40), strings.Repeat("b", 40)})
+
+	// Assert
+	assert.Equal(t, "uniq_email_profile_tenant", short)
+	assert.LessOrEqual(t, len(long), 60)
+	assert.NotEqual(t, long, repository.UniqueKeyName([]string{strings.Repeat("a", 40), strings.Repeat("c", 40)}))
+}
+
+func TestShardedRepository_FindReferencingAcrossShards(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	repo, _ := newShardedRepo(t, "", 3)
+	for i := 0; i < 6; i++ {
+		customer := "cust-1"
+		if i%2 == 1 {
+			customer = "cust-2"
+		}
+		doc, err := entity.NewDocument("orders", map[string]interface{}{"customer_id": customer})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, doc))
+	}
+
+	// Act
+	all, err := repo.FindReferencing(ctx, "orders", "customer_id", "cust-1", 0)
+	require.NoError(t, err)
+	limited, err := repo.FindReferencing(ctx, "orders", "customer_id", "cust-1", 2)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"doc-0001", "doc-0003", "doc-0005"}, all)
+	assert.Equal(t, []string{"doc-0001", "doc-0003"}, limited)
+}
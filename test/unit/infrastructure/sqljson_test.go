@@ -0,0 +1,52 @@
+package infrastructure_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqljson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLJSONPath_Parse(t *testing.T) {
+	// Arrange & Act
+	path, err := sqljson.Parse("address.city")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `{address,city}`, path.Postgres())
+	assert.Equal(t, `$."address"."city"`, path.MySQL())
+}
+
+func TestSQLJSONPath_ArrayIndex(t *testing.T) {
+	// Arrange & Act
+	path, err := sqljson.Parse("items.0.sku")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `{items,0,sku}`, path.Postgres())
+	assert.Equal(t, `$."items"[0]."sku"`, path.MySQL())
+}
+
+func TestSQLJSONPath_RejectsInjection(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+	}{
+		{"quote breakout", "name') = '1' OR ('1"},
+		{"postgres array literal", "a,b}"},
+		{"mysql path quote", `a"."b`},
+		{"statement terminator", "a;DROP TABLE users"},
+		{"whitespace", "first name"},
+		{"empty segment", "a..b"},
+		{"empty", ""},
+		{"wildcard", "tags[*]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sqljson.Parse(tt.field)
+			assert.ErrorIs(t, err, sqljson.ErrInvalidFieldPath)
+		})
+	}
+}
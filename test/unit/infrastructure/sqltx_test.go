@@ -0,0 +1,167 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/sqltx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTxConn은 트랜잭션 시작/커밋/롤백과 트랜잭션 안에서 실행된 쿼리 수를 기록하는 드라이버 연결입니다
+type fakeTxConn struct {
+	begun      []driver.TxOptions
+	commits    int
+	rollbacks  int
+	inTx       bool
+	execsInTx  int
+	execsOutTx int
+}
+
+func (c *fakeTxConn) Prepare(query string) (driver.Stmt, error) { return &fakeTxStmt{conn: c}, nil }
+
+func (c *fakeTxConn) Close() error { return nil }
+
+func (c *fakeTxConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeTxConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.begun = append(c.begun, opts)
+	c.inTx = true
+	return &fakeTx{conn: c}, nil
+}
+
+type fakeTx struct {
+	conn *fakeTxConn
+}
+
+func (t *fakeTx) Commit() error {
+	t.conn.commits++
+	t.conn.inTx = false
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.conn.rollbacks++
+	t.conn.inTx = false
+	return nil
+}
+
+type fakeTxStmt struct {
+	conn *fakeTxConn
+}
+
+func (s *fakeTxStmt) Close() error { return nil }
+
+func (s *fakeTxStmt) NumInput() int { return -1 }
+
+func (s *fakeTxStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.conn.inTx {
+		s.conn.execsInTx++
+	} else {
+		s.conn.execsOutTx++
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeTxStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+type fakeTxConnector struct {
+	conn *fakeTxConn
+}
+
+func (c *fakeTxConnector) Connect(context.Context) (driver.Conn, error) { return c.conn, nil }
+
+func (c *fakeTxConnector) Driver() driver.Driver { return nil }
+
+func newSQLTxDB(t *testing.T) (*sql.DB, *fakeTxConn) {
+	t.Helper()
+	conn := &fakeTxConn{}
+	db := sql.OpenDB(&fakeTxConnector{conn: conn})
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db, conn
+}
+
+func TestSQLTx_RunCommitsWithOptions(t *testing.T) {
+	// Arrange
+	db, conn := newSQLTxDB(t)
+	opts := &repository.TxOptions{Isolation: repository.IsolationSerializable, ReadOnly: true}
+
+	// Act
+	err := sqltx.Run(context.Background(), db, opts, func(ctx context.Context) error {
+		_, err := sqltx.Conn(ctx, db).ExecContext(ctx, "UPDATE documents SET version = version + 1")
+		return err
+	})
+
+	// Assert - fn의 쿼리는 트랜잭션 안에서 실행되고 커밋됨
+	require.NoError(t, err)
+	require.Len(t, conn.begun, 1)
+	assert.Equal(t, driver.IsolationLevel(sql.LevelSerializable), conn.begun[0].Isolation)
+	assert.True(t, conn.begun[0].ReadOnly)
+	assert.Equal(t, 1, conn.execsInTx)
+	assert.Equal(t, 0, conn.execsOutTx)
+	assert.Equal(t, 1, conn.commits)
+	assert.Equal(t, 0, conn.rollbacks)
+}
+
+func TestSQLTx_RunRollsBackOnError(t *testing.T) {
+	// Arrange
+	db, conn := newSQLTxDB(t)
+	failure := errors.New("boom")
+
+	// Act
+	err := sqltx.Run(context.Background(), db, nil, func(ctx context.Context) error {
+		return failure
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 0, conn.commits)
+	assert.Equal(t, 1, conn.rollbacks)
+}
+
+func TestSQLTx_NestedOperationsJoinOuterTransaction(t *testing.T) {
+	// Arrange
+	db, conn := newSQLTxDB(t)
+
+	// Act - 바깥 트랜잭션 안에서 저장소 작업(Begin)과 중첩 Run을 실행
+	err := sqltx.Run(context.Background(), db, nil, func(ctx context.Context) error {
+		tx, err := sqltx.Begin(ctx, db, &sql.TxOptions{Isolation: sql.LevelSerializable})
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO documents VALUES (1)"); err != nil {
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		return sqltx.Run(ctx, db, nil, func(ctx context.Context) error {
+			_, err := sqltx.Conn(ctx, db).ExecContext(ctx, "INSERT INTO documents VALUES (2)")
+			return err
+		})
+	})
+
+	// Assert - 트랜잭션은 한 번만 시작/커밋됨
+	require.NoError(t, err)
+	assert.Len(t, conn.begun, 1)
+	assert.Equal(t, 2, conn.execsInTx)
+	assert.Equal(t, 1, conn.commits)
+}
+
+func TestSQLTx_OptionsRejectsUnknownIsolation(t *testing.T) {
+	// Act
+	_, err := sqltx.Options(&repository.TxOptions{Isolation: "chaos"})
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/persistence/stmtcache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStaleStatement = errors.New("cached plan must not change result type")
+
+// fakeStmtConn은 준비/닫기 횟수를 기록하는 드라이버 연결입니다
+type fakeStmtConn struct {
+	prepared map[string]int
+	closed   map[string]int
+	failNext error
+}
+
+func (c *fakeStmtConn) Prepare(query string) (driver.Stmt, error) {
+	c.prepared[query]++
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeStmtConn) Close() error { return nil }
+
+func (c *fakeStmtConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	conn  *fakeStmtConn
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	s.conn.closed[s.query]++
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.conn.failNext; err != nil {
+		s.conn.failNext = nil
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+type fakeStmtConnector struct {
+	conn *fakeStmtConn
+	opts stmtcache.Options
+}
+
+func (c *fakeStmtConnector) Connect(context.Context) (driver.Conn, error) {
+	return stmtcache.Wrap(c.conn, c.opts), nil
+}
+
+func (c *fakeStmtConnector) Driver() driver.Driver { return nil }
+
+func newStmtCacheDB(t *testing.T, opts stmtcache.Options) (*sql.DB, *fakeStmtConn) {
+	t.Helper()
+	conn := &fakeStmtConn{prepared: map[string]int{}, closed: map[string]int{}}
+	db := sql.OpenDB(&fakeStmtConnector{conn: conn, opts: opts})
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db, conn
+}
+
+func TestStmtCache_ReusesPreparedStatement(t *testing.T) {
+	// Arrange
+	db, conn := newStmtCacheDB(t, stmtcache.Options{})
+	ctx := context.Background()
+
+	// Act
+	for i := 0; i < 3; i++ {
+		_, err := db.ExecContext(ctx, "UPDATE docs SET v = $1", i)
+		require.NoError(t, err)
+	}
+
+	// Assert
+	assert.Equal(t, 1, conn.prepared["UPDATE docs SET v = $1"])
+	assert.Equal(t, 0, conn.closed["UPDATE docs SET v = $1"])
+}
+
+func TestStmtCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Arrange
+	db, conn := newStmtCacheDB(t, stmtcache.Options{Size: 2})
+	ctx := context.Background()
+
+	// Act
+	for _, query := range []string{"q1 $1", "q2 $1", "q1 $1", "q3 $1"} {
+		_, err := db.ExecContext(ctx, query, 1)
+		require.NoError(t, err)
+	}
+
+	// Assert
+	assert.Equal(t, 1, conn.closed["q2 $1"])
+	assert.Equal(t, 0, conn.closed["q1 $1"])
+	assert.Equal(t, 1, conn.prepared["q1 $1"])
+}
+
+func TestStmtCache_PrepareContextDoesNotCloseCachedStatement(t *testing.T) {
+	// Arrange
+	db, conn := newStmtCacheDB(t, stmtcache.Options{})
+	ctx := context.Background()
+
+	// Act
+	stmt, err := db.PrepareContext(ctx, "INSERT INTO docs VALUES ($1)")
+	require.NoError(t, err)
+	_, err = stmt.ExecContext(ctx, 1)
+	require.NoError(t, err)
+	require.NoError(t, stmt.Close())
+	_, err = db.ExecContext(ctx, "INSERT INTO docs VALUES ($1)", 2)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, conn.prepared["INSERT INTO docs VALUES ($1)"])
+	assert.Equal(t, 0, conn.closed["INSERT INTO docs VALUES ($1)"])
+}
+
+func TestStmtCache_InvalidatedStatementIsPreparedAgain(t *testing.T) {
+	// Arrange
+	db, conn := newStmtCacheDB(t, stmtcache.Options{
+		Invalidate: func(err error) bool { return errors.Is(err, errStaleStatement) },
+	})
+	ctx := context.Background()
+	_, err := db.ExecContext(ctx, "DELETE FROM docs WHERE id = $1", "a")
+	require.NoError(t, err)
+
+	// Act
+	conn.failNext = errStaleStatement
+	_, err = db.ExecContext(ctx, "DELETE FROM docs WHERE id = $1", "a")
+	require.ErrorIs(t, err, errStaleStatement)
+	_, err = db.ExecContext(ctx, "DELETE FROM docs WHERE id = $1", "a")
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 2, conn.prepared["DELETE FROM docs WHERE id = $1"])
+	assert.Equal(t, 1, conn.closed["DELETE FROM docs WHERE id = $1"])
+}
+
+func TestStmtCache_BypassedQueryIsNotCached(t *testing.T) {
+	// Arrange
+	db, conn := newStmtCacheDB(t, stmtcache.Options{
+		Bypass: func(query string) bool { return query == "COPY docs FROM STDIN" },
+	})
+	ctx := context.Background()
+
+	// Act
+	for i := 0; i < 2; i++ {
+		stmt, err := db.PrepareContext(ctx, "COPY docs FROM STDIN")
+		require.NoError(t, err)
+		require.NoError(t, stmt.Close())
+	}
+
+	// Assert
+	assert.Equal(t, 2, conn.prepared["COPY docs FROM STDIN"])
+	assert.Equal(t, 2, conn.closed["COPY docs FROM STDIN"])
+}
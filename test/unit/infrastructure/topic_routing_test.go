@@ -0,0 +1,74 @@
+package infrastructure_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTopicRouter(t *testing.T) *kafka.TopicRouter {
+	router, err := kafka.NewTopicRouter([]kafka.TopicRule{
+		{Collection: "orders", Topic: "orders.events"},
+		{Collection: "audit_*", Created: "audit.created", Deleted: "audit.deleted"},
+		{Collection: "tmp_*", Disabled: true},
+	}, "documents.created", "documents.updated", "documents.deleted")
+	require.NoError(t, err)
+	return router
+}
+
+func TestTopicRouter_ExactRuleUsesSingleTopic(t *testing.T) {
+	// Arrange
+	router := newTestTopicRouter(t)
+
+	// Act
+	created, createdOK := router.Route("orders", messaging.EventDocumentCreated)
+	deleted, deletedOK := router.Route("orders", messaging.EventDocumentDeleted)
+
+	// Assert
+	assert.True(t, createdOK)
+	assert.True(t, deletedOK)
+	assert.Equal(t, "orders.events", created)
+	assert.Equal(t, "orders.events", deleted)
+}
+
+func TestTopicRouter_WildcardRuleFallsBackToDefaultPerEventType(t *testing.T) {
+	// Arrange
+	router := newTestTopicRouter(t)
+
+	// Act
+	created, _ := router.Route("audit_logins", messaging.EventDocumentCreated)
+	updated, _ := router.Route("audit_logins", messaging.EventDocumentUpdated)
+
+	// Assert
+	assert.Equal(t, "audit.created", created)
+	assert.Equal(t, "documents.updated", updated)
+}
+
+func TestTopicRouter_DisabledAndUnmatchedCollections(t *testing.T) {
+	// Arrange
+	router := newTestTopicRouter(t)
+
+	// Act
+	_, disabledOK := router.Route("tmp_import", messaging.EventDocumentCreated)
+	topic, ok := router.Route("users", messaging.EventDocumentUpdated)
+
+	// Assert
+	assert.False(t, disabledOK)
+	assert.True(t, ok)
+	assert.Equal(t, "documents.updated", topic)
+	assert.ElementsMatch(t, []string{
+		"documents.created", "documents.updated", "documents.deleted",
+		"orders.events", "audit.created", "audit.deleted",
+	}, router.Topics())
+}
+
+func TestNewTopicRouter_RejectsInvalidPattern(t *testing.T) {
+	// Act
+	_, err := kafka.NewTopicRouter([]kafka.TopicRule{{Collection: "orders["}}, "c", "u", "d")
+
+	// Assert
+	assert.Error(t, err)
+}
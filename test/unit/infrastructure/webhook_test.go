@@ -0,0 +1,96 @@
+package infrastructure_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging"
+	"github.com/YouSangSon/database-service/internal/infrastructure/messaging/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticSubscriptions는 고정된 구독 목록을 반환하는 테스트용 저장소입니다
+type staticSubscriptions []*entity.WebhookSubscription
+
+func (s staticSubscriptions) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return s, nil
+}
+
+// recordingQueue는 전송 대기 기록을 모으는 테스트용 큐입니다
+type recordingQueue struct {
+	deliveries []*entity.WebhookDelivery
+}
+
+func (q *recordingQueue) Enqueue(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	q.deliveries = append(q.deliveries, deliveries...)
+	return nil
+}
+
+func TestWebhookSign_HMACOfTimestampAndBody(t *testing.T) {
+	// Arrange
+	body := []byte(`{"event_type":"document.created"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	// Act
+	signature := webhook.Sign("secret", 1700000000, body)
+
+	// Assert
+	assert.Equal(t, expected, signature)
+	assert.NotEqual(t, signature, webhook.Sign("other", 1700000000, body))
+	assert.NotEqual(t, signature, webhook.Sign("secret", 1700000001, body))
+}
+
+func TestWebhookMatches(t *testing.T) {
+	sub := &entity.WebhookSubscription{
+		Collection: "orders_*",
+		EventTypes: []string{messaging.EventDocumentCreated, messaging.EventDocumentDeleted},
+		Filter:     map[string]interface{}{"status": "paid"},
+		Active:     true,
+	}
+	paid := map[string]interface{}{"status": "paid"}
+
+	// Act & Assert
+	assert.True(t, webhook.Matches(sub, messaging.EventDocumentCreated, "orders_2024", paid))
+	assert.False(t, webhook.Matches(sub, messaging.EventDocumentCreated, "users", paid))
+	assert.False(t, webhook.Matches(sub, messaging.EventDocumentUpdated, "orders_2024", paid))
+	assert.False(t, webhook.Matches(sub, messaging.EventDocumentCreated, "orders_2024", map[string]interface{}{"status": "pending"}))
+	assert.True(t, webhook.Matches(sub, messaging.EventDocumentDeleted, "orders_2024", nil), "delete events have no data to filter")
+
+	inactive := *sub
+	inactive.Active = false
+	assert.False(t, webhook.Matches(&inactive, messaging.EventDocumentCreated, "orders_2024", paid))
+}
+
+func TestWebhookPublisher_EnqueuesMatchingSubscriptions(t *testing.T) {
+	// Arrange
+	next := &recordingPublisher{}
+	queue := &recordingQueue{}
+	subs := staticSubscriptions{
+		{ID: "all", Collection: "*", Active: true},
+		{ID: "users", Collection: "users", Active: true},
+		{ID: "orders", Collection: "orders", Active: true},
+	}
+	publisher := webhook.NewPublisher(next, subs, queue, webhook.PublisherConfig{})
+
+	// Act
+	err := publisher.PublishDocumentCreated(context.Background(), "doc-1", "users", map[string]interface{}{"name": "kim"}, 1)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "kim", next.data["name"], "inner publisher still receives the event")
+	require.Len(t, queue.deliveries, 2)
+	assert.Equal(t, "all", queue.deliveries[0].SubscriptionID)
+	assert.Equal(t, "users", queue.deliveries[1].SubscriptionID)
+	for _, d := range queue.deliveries {
+		assert.Equal(t, entity.WebhookDeliveryPending, d.Status)
+		assert.Equal(t, "doc-1", d.DocumentID)
+		assert.Contains(t, d.Payload, `"name":"kim"`)
+	}
+}
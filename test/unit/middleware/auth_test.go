@@ -0,0 +1,61 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAdminRouter(principal *auth.Principal) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	if principal != nil {
+		router.Use(func(c *gin.Context) {
+			c.Set(middleware.PrincipalKey, principal)
+			c.Next()
+		})
+	}
+	router.POST("/api/v1/admin/backups", middleware.RequireRole(auth.RoleAdmin), func(c *gin.Context) { c.Status(http.StatusAccepted) })
+	return router
+}
+
+func TestRequireRole_RejectsRequestsWithoutPrincipal(t *testing.T) {
+	// Arrange
+	router := newAdminRouter(nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/admin/backups", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestRequireRole_RejectsPrincipalWithoutRole(t *testing.T) {
+	// Arrange
+	router := newAdminRouter(&auth.Principal{Subject: "alice", Roles: []auth.Role{auth.RoleWriter}})
+	rec := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/admin/backups", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRequireRole_AllowsAdminPrincipal(t *testing.T) {
+	// Arrange
+	router := newAdminRouter(&auth.Principal{Subject: "alice", Roles: []auth.Role{auth.RoleAdmin}})
+	rec := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/admin/backups", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+}
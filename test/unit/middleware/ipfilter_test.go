@@ -0,0 +1,89 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIPFilterRouter(t *testing.T, trustedProxies []string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	admin, err := ipfilter.ParseRules([]string{"10.0.0.0/8"}, nil)
+	require.NoError(t, err)
+	filter := ipfilter.New(&ipfilter.Config{
+		Groups: []ipfilter.Group{{Name: "admin", Prefixes: []string{"/api/v1/admin"}, Rules: admin}},
+	})
+
+	router := gin.New()
+	require.NoError(t, middleware.TrustProxies(router, trustedProxies))
+	router.Use(middleware.IPFilter(filter))
+	router.GET("/api/v1/admin/pools", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func TestIPFilter_IgnoresSpoofedForwardedForWithoutTrustedProxies(t *testing.T) {
+	// Arrange
+	router := newIPFilterRouter(t, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/pools", nil)
+	req.RemoteAddr = "198.51.100.7:40000"
+	req.Header.Set("X-Forwarded-For", "10.1.2.3")
+	rec := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestIPFilter_IgnoresForwardedForFromUntrustedPeer(t *testing.T) {
+	// Arrange
+	router := newIPFilterRouter(t, []string{"192.0.2.0/24"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/pools", nil)
+	req.RemoteAddr = "198.51.100.7:40000"
+	req.Header.Set("X-Forwarded-For", "10.1.2.3")
+	rec := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestIPFilter_UsesForwardedForFromTrustedProxy(t *testing.T) {
+	// Arrange
+	router := newIPFilterRouter(t, []string{"192.0.2.0/24"})
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/pools", nil)
+	req.RemoteAddr = "192.0.2.10:40000"
+	req.Header.Set("X-Forwarded-For", "10.1.2.3")
+	rec := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestIPFilter_TrustedProxiesAreScopedPerEngine(t *testing.T) {
+	// Arrange
+	trusting := newIPFilterRouter(t, []string{"192.0.2.0/24"})
+	_ = newIPFilterRouter(t, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/pools", nil)
+	req.RemoteAddr = "192.0.2.10:40000"
+	req.Header.Set("X-Forwarded-For", "10.1.2.3")
+	rec := httptest.NewRecorder()
+
+	// Act
+	trusting.ServeHTTP(rec, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,34 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/loadshed"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadShed_ReleasesTokenWhenHandlerPanics(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	limiter := loadshed.New(loadshed.Config{InitialLimit: 4, MinLimit: 4, MaxLimit: 4})
+	router := gin.New()
+	router.Use(gin.Recovery(), middleware.LoadShed(limiter, metrics.Init("loadshed_test")))
+	router.GET("/panic", func(c *gin.Context) {
+		panic("handler failure")
+	})
+
+	// Act
+	for i := 0; i < 8; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/panic", nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	}
+
+	// Assert
+	assert.Equal(t, 0, limiter.InFlight())
+}
@@ -0,0 +1,87 @@
+package middleware_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/ratelimit"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingLimiter는 키별로 burst 개수만큼만 허용하는 테스트용 limiter입니다 (토큰 보충 없음)
+type countingLimiter struct {
+	mu    sync.Mutex
+	taken map[string]int64
+}
+
+func (l *countingLimiter) Take(_ context.Context, key string, limit ratelimit.Limit) (*ratelimit.Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.taken[key]++
+	remaining := limit.Burst - l.taken[key]
+	if remaining < 0 {
+		return &ratelimit.Result{Allowed: false, Limit: limit.Burst, RetryAfter: 1}, nil
+	}
+	return &ratelimit.Result{Allowed: true, Limit: limit.Burst, Remaining: remaining}, nil
+}
+
+func newRateLimitRouter(t *testing.T, principal *auth.Principal) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	require.NoError(t, middleware.TrustProxies(router, nil))
+	if principal != nil {
+		router.Use(func(c *gin.Context) {
+			c.Set(middleware.PrincipalKey, principal)
+			c.Next()
+		})
+	}
+	limiter := &countingLimiter{taken: map[string]int64{}}
+	router.Use(middleware.TokenBucketRateLimit(limiter, &ratelimit.Policy{Default: ratelimit.Limit{Rate: 1, Burst: 2}}))
+	router.GET("/api/v1/documents", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func TestTokenBucketRateLimit_RotatingAPIKeyHeaderDoesNotResetLimit(t *testing.T) {
+	// Arrange
+	router := newRateLimitRouter(t, nil)
+	codes := make([]int, 0, 3)
+
+	// Act
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/documents", nil)
+		req.RemoteAddr = "198.51.100.7:40000"
+		req.Header.Set("X-API-Key", fmt.Sprintf("random-%d", i))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		codes = append(codes, rec.Code)
+	}
+
+	// Assert
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+}
+
+func TestTokenBucketRateLimit_KeysByAuthenticatedTenant(t *testing.T) {
+	// Arrange
+	router := newRateLimitRouter(t, &auth.Principal{Subject: "apikey:k1", TenantID: "acme"})
+	codes := make([]int, 0, 3)
+
+	// Act
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/documents", nil)
+		req.RemoteAddr = fmt.Sprintf("198.51.100.%d:40000", i+1)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		codes = append(codes, rec.Code)
+	}
+
+	// Assert
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+}
@@ -0,0 +1,45 @@
+package pkg_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/anonymize"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymizer_ApplyIsIdempotent(t *testing.T) {
+	// Arrange
+	anonymizer, err := anonymize.New([]byte("0123456789abcdef"), []anonymize.Rule{
+		{Field: "email", Action: anonymize.ActionHash},
+		{Field: "profile.name", Action: anonymize.ActionRewrite, Value: "deleted user"},
+		{Field: "phone", Action: anonymize.ActionHash},
+	})
+	require.NoError(t, err)
+	data := map[string]interface{}{
+		"email":   "kim@example.com",
+		"profile": map[string]interface{}{"name": "Kim", "country": "KR"},
+		"phone":   nil,
+	}
+
+	// Act
+	first, changed := anonymizer.Apply(data)
+	second, changedAgain := anonymizer.Apply(first)
+
+	// Assert
+	assert.Equal(t, []string{"email", "profile.name"}, changed, "null fields are left alone")
+	assert.Equal(t, anonymizer.Hash("kim@example.com"), first["email"])
+	assert.Equal(t, map[string]interface{}{"name": "deleted user", "country": "KR"}, first["profile"])
+	assert.Equal(t, "kim@example.com", data["email"], "the input is not modified")
+	assert.Equal(t, "Kim", data["profile"].(map[string]interface{})["name"])
+	assert.Empty(t, changedAgain, "already anonymized values are not changed again")
+	assert.Equal(t, first, second)
+}
+
+func TestAnonymizer_RejectsShortHashKey(t *testing.T) {
+	// Act
+	_, err := anonymize.New([]byte("short"), []anonymize.Rule{{Field: "email", Action: anonymize.ActionHash}})
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package pkg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAPIKeyStore는 테스트용 메모리 API 키 저장소입니다
+type memoryAPIKeyStore struct {
+	keys    map[string]*auth.APIKey
+	lookups int
+}
+
+func (s *memoryAPIKeyStore) FindAPIKey(_ context.Context, id string) (*auth.APIKey, error) {
+	s.lookups++
+	return s.keys[id], nil
+}
+
+func newTestAPIKey(t *testing.T, store *memoryAPIKeyStore) string {
+	issued, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+	store.keys[issued.ID] = &auth.APIKey{
+		ID:         issued.ID,
+		TenantID:   "acme",
+		Namespace:  "acme_",
+		SecretHash: issued.SecretHash,
+		Roles:      []auth.Role{auth.RoleWriter},
+	}
+	return issued.Token
+}
+
+func TestAPIKeyVerifier_ValidKey(t *testing.T) {
+	// Arrange
+	store := &memoryAPIKeyStore{keys: map[string]*auth.APIKey{}}
+	token := newTestAPIKey(t, store)
+	verifier := auth.NewAPIKeyVerifier(store, time.Minute)
+
+	// Act
+	principal, err := verifier.Verify(context.Background(), token)
+	_, again := verifier.Verify(context.Background(), token)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, again)
+	assert.Equal(t, "acme", principal.TenantID)
+	assert.True(t, principal.HasRole(auth.RoleWriter))
+	assert.False(t, principal.HasRole(auth.RoleAdmin))
+	assert.Equal(t, 1, store.lookups, "verified key should be served from cache")
+}
+
+func TestAPIKeyVerifier_RejectsWrongSecretAndMalformedKeys(t *testing.T) {
+	// Arrange
+	store := &memoryAPIKeyStore{keys: map[string]*auth.APIKey{}}
+	token := newTestAPIKey(t, store)
+	verifier := auth.NewAPIKeyVerifier(store, time.Minute)
+	id, _, err := auth.ParseAPIKey(token)
+	require.NoError(t, err)
+
+	// Act & Assert
+	_, err = verifier.Verify(context.Background(), "dbs_"+id+"_deadbeef")
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+	_, err = verifier.Verify(context.Background(), "not-a-key")
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+	_, err = verifier.Verify(context.Background(), "dbs_unknown_secret")
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+	_, err = verifier.Verify(context.Background(), "")
+	assert.ErrorIs(t, err, auth.ErrMissingAPIKey)
+}
+
+func TestPrincipal_CanAccessCollection(t *testing.T) {
+	tenant := &auth.Principal{TenantID: "acme", Namespace: "acme_"}
+	unrestricted := &auth.Principal{Subject: "operator"}
+
+	assert.True(t, tenant.CanAccessCollection("acme_orders"))
+	assert.False(t, tenant.CanAccessCollection("acme-co_orders"))
+	assert.False(t, tenant.CanAccessCollection("orders"))
+	assert.True(t, unrestricted.CanAccessCollection("orders"))
+
+	var anonymous *auth.Principal
+	assert.True(t, anonymous.CanAccessCollection("orders"))
+}
@@ -0,0 +1,137 @@
+package pkg_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/avro"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEventSchemaV1 = `{
+  "type": "record",
+  "name": "Event",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "version", "type": "int"},
+    {"name": "timestamp", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "attrs", "type": {"type": "map", "values": "string"}, "default": {}},
+    {"name": "note", "type": ["null", "string"], "default": null}
+  ]
+}`
+
+const testEventSchemaV2 = `{
+  "type": "record",
+  "name": "Event",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": "string"},
+    {"name": "version", "type": "int"},
+    {"name": "timestamp", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "attrs", "type": {"type": "map", "values": "string"}, "default": {}},
+    {"name": "note", "type": ["null", "string"], "default": null},
+    {"name": "priority", "type": {"type": "enum", "name": "Priority", "symbols": ["LOW", "HIGH"]}, "default": "LOW"}
+  ]
+}`
+
+func TestAvro_RoundTrip(t *testing.T) {
+	// Arrange
+	schema, err := avro.Parse(testEventSchemaV1)
+	require.NoError(t, err)
+	record := map[string]interface{}{
+		"id":        "doc-1",
+		"version":   int32(3),
+		"timestamp": int64(1700000000123),
+		"tags":      []interface{}{"a", "b"},
+		"attrs":     map[string]interface{}{"origin": "host-1"},
+		"note":      "hello",
+	}
+
+	// Act
+	data, err := schema.Encode(record)
+	require.NoError(t, err)
+	decoded, err := schema.Decode(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, record, decoded)
+}
+
+func TestAvro_UnionNullAndDefaults(t *testing.T) {
+	// Arrange
+	schema, err := avro.Parse(testEventSchemaV1)
+	require.NoError(t, err)
+	record := map[string]interface{}{
+		"id":        "doc-2",
+		"version":   1,
+		"timestamp": int64(0),
+		"tags":      []interface{}{},
+	}
+
+	// Act
+	data, err := schema.Encode(record)
+	require.NoError(t, err)
+	decoded, err := schema.Decode(data)
+
+	// Assert
+	require.NoError(t, err)
+	fields := decoded.(map[string]interface{})
+	assert.Nil(t, fields["note"])
+	assert.Equal(t, map[string]interface{}{}, fields["attrs"])
+	assert.Equal(t, int32(1), fields["version"])
+}
+
+func TestAvro_EvolvedSchemaFillsEnumDefault(t *testing.T) {
+	// Arrange
+	v2, err := avro.Parse(testEventSchemaV2)
+	require.NoError(t, err)
+	record := map[string]interface{}{
+		"id":        "doc-3",
+		"version":   2,
+		"timestamp": int64(1),
+		"tags":      []interface{}{"x"},
+	}
+
+	// Act
+	data, err := v2.Encode(record)
+	require.NoError(t, err)
+	decoded, err := v2.Decode(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "LOW", decoded.(map[string]interface{})["priority"])
+}
+
+func TestAvro_EncodeRejectsMissingRequiredField(t *testing.T) {
+	// Arrange
+	schema, err := avro.Parse(testEventSchemaV1)
+	require.NoError(t, err)
+
+	// Act
+	_, err = schema.Encode(map[string]interface{}{"id": "doc-4"})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestAvro_DecodeRejectsTruncatedData(t *testing.T) {
+	// Arrange
+	schema, err := avro.Parse(testEventSchemaV1)
+	require.NoError(t, err)
+	data, err := schema.Encode(map[string]interface{}{
+		"id":        "doc-5",
+		"version":   1,
+		"timestamp": int64(1),
+		"tags":      []interface{}{"a"},
+	})
+	require.NoError(t, err)
+
+	// Act
+	_, err = schema.Decode(data[:len(data)-3])
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,148 @@
+package pkg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/backendrouting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackendRoutingResolve(t *testing.T) {
+	// Arrange
+	table, err := backendrouting.New("mongodb", []string{"mongodb", "postgresql", "cassandra"}, []backendrouting.Route{
+		{Collection: "events_audit", Backend: "postgresql"},
+		{Collection: "events_*", Backend: "cassandra"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		collection string
+		expected   string
+	}{
+		{collection: "events_audit", expected: "postgresql"},
+		{collection: "events_2026", expected: "cassandra"},
+		{collection: "users", expected: "mongodb"},
+		{collection: "", expected: "mongodb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.collection, func(t *testing.T) {
+			// Act
+			backend := table.Resolve(tt.collection)
+
+			// Assert
+			assert.Equal(t, tt.expected, backend)
+		})
+	}
+	assert.Equal(t, []string{"cassandra", "mongodb", "postgresql"}, table.Backends())
+	assert.True(t, table.Enabled("postgresql"))
+	assert.False(t, table.Enabled("mysql"))
+}
+
+func TestBackendRoutingNew_RejectsInvalidConfig(t *testing.T) {
+	enabled := []string{"mongodb", "postgresql"}
+
+	tests := []struct {
+		name           string
+		defaultBackend string
+		routes         []backendrouting.Route
+	}{
+		{name: "default not enabled", defaultBackend: "mysql"},
+		{name: "route backend not enabled", defaultBackend: "mongodb", routes: []backendrouting.Route{{Collection: "logs", Backend: "elasticsearch"}}},
+		{name: "invalid pattern", defaultBackend: "mongodb", routes: []backendrouting.Route{{Collection: "logs[", Backend: "postgresql"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			table, err := backendrouting.New(tt.defaultBackend, enabled, tt.routes)
+
+			// Assert
+			assert.Error(t, err)
+			assert.Nil(t, table)
+		})
+	}
+}
+
+func TestBackendRouter_PinWaitsForInFlightRequests(t *testing.T) {
+	// Arrange
+	table, err := backendrouting.New("mongodb", []string{"mongodb", "postgresql"}, nil)
+	require.NoError(t, err)
+	router := backendrouting.NewRouter(table, time.Second)
+
+	old, release := router.Acquire()
+	done := make(chan backendrouting.SwitchResult, 1)
+
+	// Act
+	go func() {
+		result, err := router.Pin(context.Background(), "orders", "postgresql")
+		assert.NoError(t, err)
+		done <- result
+	}()
+
+	// Assert
+	require.Eventually(t, func() bool { return router.Table() != old }, time.Second, time.Millisecond)
+	current, releaseCurrent := router.Acquire()
+	assert.Equal(t, "postgresql", current.Resolve("orders"))
+	assert.Equal(t, "mongodb", old.Resolve("orders"))
+	releaseCurrent()
+
+	select {
+	case <-done:
+		t.Fatal("pin returned before the in-flight request finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+
+	result := <-done
+	assert.True(t, result.Drained)
+	assert.Equal(t, "mongodb", result.Previous)
+	assert.Equal(t, "postgresql", result.Backend)
+}
+
+func TestBackendRouter_PinDrainTimeout(t *testing.T) {
+	// Arrange
+	table, err := backendrouting.New("mongodb", []string{"mongodb", "postgresql"}, nil)
+	require.NoError(t, err)
+	router := backendrouting.NewRouter(table, 20*time.Millisecond)
+	_, release := router.Acquire()
+	defer release()
+
+	// Act
+	result, err := router.Pin(context.Background(), "orders", "postgresql")
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, result.Drained)
+	assert.Equal(t, int64(1), result.InFlight)
+	assert.Equal(t, "postgresql", router.Table().Resolve("orders"))
+}
+
+func TestBackendRouter_UnpinAndRejectDisabledBackend(t *testing.T) {
+	// Arrange
+	table, err := backendrouting.New("mongodb", []string{"mongodb", "postgresql"}, []backendrouting.Route{
+		{Collection: "orders", Backend: "postgresql"},
+	})
+	require.NoError(t, err)
+	router := backendrouting.NewRouter(table, time.Second)
+	ctx := context.Background()
+
+	// Act
+	_, disabledErr := router.Pin(ctx, "orders", "mysql")
+	_, notPinnedErr := router.Unpin(ctx, "orders")
+	_, pinErr := router.Pin(ctx, "orders", "mongodb")
+	pinned := router.Table().Resolve("orders")
+	result, unpinErr := router.Unpin(ctx, "orders")
+
+	// Assert
+	assert.Error(t, disabledErr)
+	assert.ErrorIs(t, notPinnedErr, backendrouting.ErrNotPinned)
+	require.NoError(t, pinErr)
+	assert.Equal(t, "mongodb", pinned)
+	require.NoError(t, unpinErr)
+	assert.Equal(t, "postgresql", result.Backend)
+	assert.Empty(t, router.Status().Pins)
+}
@@ -0,0 +1,43 @@
+package pkg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/dedup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedup_GroupsByNormalizedKey(t *testing.T) {
+	// Arrange
+	base := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	grouper := dedup.NewGrouper([]string{"email", "profile.country"}, dedup.Options{CaseInsensitive: true, TrimSpace: true})
+
+	// Act
+	grouper.Add("b", base.Add(2*time.Hour), map[string]interface{}{"email": "Kim@Example.com ", "profile": map[string]interface{}{"country": "KR"}})
+	grouper.Add("a", base.Add(time.Hour), map[string]interface{}{"email": "kim@example.com", "profile": map[string]interface{}{"country": "KR"}})
+	grouper.Add("c", base, map[string]interface{}{"email": "kim@example.com", "profile": map[string]interface{}{"country": "US"}})
+	grouper.Add("d", base, map[string]interface{}{"email": "lee@example.com"})
+	skipped := !grouper.Add("e", base, map[string]interface{}{"email": "kim@example.com", "profile": map[string]interface{}{"country": nil}})
+	groups := grouper.Groups()
+
+	// Assert
+	assert.True(t, skipped, "documents missing a key field are not grouped")
+	require.Len(t, groups, 1)
+	assert.Equal(t, []string{"b", "a"}, groups[0].IDs)
+	assert.Equal(t, "a", groups[0].KeepID, "the oldest document is suggested as the survivor")
+	assert.Equal(t, "KR", groups[0].Key.Values["profile.country"])
+}
+
+func TestDedup_KeyOfDistinguishesTypes(t *testing.T) {
+	// Act
+	number, ok := dedup.KeyOf(map[string]interface{}{"code": float64(1)}, []string{"code"}, dedup.Options{})
+	require.True(t, ok)
+	text, ok := dedup.KeyOf(map[string]interface{}{"code": "1"}, []string{"code"}, dedup.Options{})
+	require.True(t, ok)
+
+	// Assert
+	assert.NotEqual(t, number.Hash, text.Hash)
+	assert.Len(t, number.Hash, 64)
+}
@@ -0,0 +1,104 @@
+package pkg_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCipher(t *testing.T) *encryption.Cipher {
+	masterKey := make([]byte, 32)
+	_, err := rand.Read(masterKey)
+	require.NoError(t, err)
+
+	provider, err := encryption.NewLocalKeyProvider(masterKey)
+	require.NoError(t, err)
+
+	cipher, err := encryption.NewCipher(context.Background(), provider)
+	require.NoError(t, err)
+	return cipher
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	// Arrange
+	cipher := newTestCipher(t)
+	plaintext := []byte(`{"name":"alice","ssn":"123-45-6789"}`)
+	aad := encryption.RowAAD("users", "user-1")
+
+	// Act
+	encrypted, err := cipher.Encrypt(plaintext, aad)
+	require.NoError(t, err)
+	decrypted, err := cipher.Decrypt(context.Background(), encrypted, aad)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, encryption.IsEncrypted(encrypted))
+	assert.NotContains(t, string(encrypted), "alice")
+	assert.Equal(t, plaintext, decrypted)
+}
+
+func TestCipher_DecryptAfterRotate(t *testing.T) {
+	// Arrange
+	cipher := newTestCipher(t)
+	aad := encryption.RowAAD("users", "user-1")
+	encrypted, err := cipher.Encrypt([]byte(`{"a":1}`), aad)
+	require.NoError(t, err)
+
+	// Act
+	require.NoError(t, cipher.Rotate(context.Background()))
+	decrypted, err := cipher.Decrypt(context.Background(), encrypted, aad)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(decrypted))
+}
+
+func TestCipher_RejectsCiphertextFromAnotherRow(t *testing.T) {
+	// Arrange
+	cipher := newTestCipher(t)
+	encrypted, err := cipher.Encrypt([]byte(`{"a":1}`), encryption.RowAAD("users", "user-1"))
+	require.NoError(t, err)
+
+	// Act
+	_, err = cipher.Decrypt(context.Background(), encrypted, encryption.RowAAD("users", "user-2"))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestCipher_PassesThroughPlaintext(t *testing.T) {
+	// Arrange
+	cipher := newTestCipher(t)
+	legacy := []byte(`{"name":"bob"}`)
+
+	// Act
+	decrypted, err := cipher.Decrypt(context.Background(), legacy, encryption.RowAAD("users", "user-3"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, legacy, decrypted)
+}
+
+func TestIsEncrypted(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected bool
+	}{
+		{"compact envelope", `{"$enc":{"v":1}}`, true},
+		{"jsonb normalized envelope", `{"$enc": {"c": "", "k": "", "n": "", "v": 1}}`, true},
+		{"plain document", `{"name":"alice"}`, false},
+		{"array", `[1,2]`, false},
+		{"empty", ``, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, encryption.IsEncrypted([]byte(tt.data)))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package pkg_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/YouSangSon/database-service/internal/pkg/featureflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryFlagStore는 테스트용 인메모리 기능 플래그 변경 저장소입니다
+type memoryFlagStore struct {
+	overrides map[[2]string]bool
+}
+
+func newMemoryFlagStore() *memoryFlagStore {
+	return &memoryFlagStore{overrides: map[[2]string]bool{}}
+}
+
+func (s *memoryFlagStore) List(_ context.Context) ([]featureflag.Override, error) {
+	list := make([]featureflag.Override, 0, len(s.overrides))
+	for key, enabled := range s.overrides {
+		list = append(list, featureflag.Override{Flag: key[0], Tenant: key[1], Enabled: enabled})
+	}
+	return list, nil
+}
+
+func (s *memoryFlagStore) Set(_ context.Context, o featureflag.Override) error {
+	s.overrides[[2]string{o.Flag, o.Tenant}] = o.Enabled
+	return nil
+}
+
+func (s *memoryFlagStore) Delete(_ context.Context, flag, tenant string) (bool, error) {
+	key := [2]string{flag, tenant}
+	_, ok := s.overrides[key]
+	delete(s.overrides, key)
+	return ok, nil
+}
+
+func newTestFlags(t *testing.T, store featureflag.Store) *featureflag.Flags {
+	t.Helper()
+	flags, err := featureflag.New("Production", []featureflag.Flag{
+		{
+			Name:         featureflag.Backend("cassandra"),
+			Enabled:      true,
+			Environments: map[string]bool{"production": false},
+			Tenants:      map[string]bool{"beta": true},
+		},
+		{Name: featureflag.CDC, Enabled: true},
+	}, store)
+	require.NoError(t, err)
+	return flags
+}
+
+func TestFlags_EvaluatesConfiguredValues(t *testing.T) {
+	// Arrange
+	flags := newTestFlags(t, nil)
+
+	// Act
+	byEnvironment := flags.Evaluate("backend.cassandra", "other")
+	byTenant := flags.Evaluate("backend.cassandra", "beta")
+	byDefault := flags.Evaluate(featureflag.CDC, "")
+	undefined := flags.Evaluate("backend.vitess", "beta")
+
+	// Assert
+	assert.Equal(t, "production", flags.Environment())
+	assert.False(t, byEnvironment.Enabled)
+	assert.Equal(t, featureflag.SourceEnvironment, byEnvironment.Source)
+	assert.True(t, byTenant.Enabled)
+	assert.Equal(t, featureflag.SourceTenant, byTenant.Source)
+	assert.True(t, byDefault.Enabled)
+	assert.Equal(t, featureflag.SourceDefault, byDefault.Source)
+	assert.True(t, undefined.Enabled)
+	assert.Equal(t, featureflag.SourceUndefined, undefined.Source)
+}
+
+func TestFlags_EnabledUsesPrincipalTenant(t *testing.T) {
+	// Arrange
+	flags := newTestFlags(t, nil)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "user-1", TenantID: "beta"})
+
+	// Act & Assert
+	assert.True(t, flags.Enabled(ctx, "backend.cassandra"))
+	assert.False(t, flags.Enabled(context.Background(), "backend.cassandra"))
+
+	var disabled *featureflag.Flags
+	assert.True(t, disabled.Enabled(ctx, "backend.cassandra"))
+}
+
+func TestFlags_OverridesTakePrecedence(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store := newMemoryFlagStore()
+	flags := newTestFlags(t, store)
+
+	// Act
+	require.NoError(t, flags.SetOverride(ctx, featureflag.Override{Flag: "backend.cassandra", Enabled: true}))
+	require.NoError(t, flags.SetOverride(ctx, featureflag.Override{Flag: "backend.cassandra", Tenant: "beta", Enabled: false}))
+
+	// Assert
+	global := flags.Evaluate("backend.cassandra", "other")
+	assert.True(t, global.Enabled)
+	assert.Equal(t, featureflag.SourceOverride, global.Source)
+	tenant := flags.Evaluate("backend.cassandra", "beta")
+	assert.False(t, tenant.Enabled)
+	assert.Equal(t, featureflag.SourceTenantOverride, tenant.Source)
+	assert.Len(t, flags.Status("").Overrides, 2)
+}
+
+func TestFlags_DeleteOverrideRestoresConfiguredValue(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store := newMemoryFlagStore()
+	flags := newTestFlags(t, store)
+	require.NoError(t, flags.SetOverride(ctx, featureflag.Override{Flag: featureflag.CDC, Enabled: false}))
+
+	// Act
+	err := flags.DeleteOverride(ctx, featureflag.CDC, "")
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, flags.Evaluate(featureflag.CDC, "").Enabled)
+	assert.ErrorIs(t, flags.DeleteOverride(ctx, featureflag.CDC, ""), featureflag.ErrOverrideNotFound)
+}
+
+func TestFlags_RejectsUndefinedFlags(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	store := newMemoryFlagStore()
+	flags := newTestFlags(t, store)
+	store.overrides[[2]string{"backend.vitess", ""}] = false
+
+	// Act
+	err := flags.SetOverride(ctx, featureflag.Override{Flag: "backend.vitess", Enabled: false})
+	require.NoError(t, flags.Refresh(ctx))
+
+	// Assert
+	assert.ErrorIs(t, err, featureflag.ErrUnknownFlag)
+	assert.Empty(t, flags.Overrides())
+	assert.True(t, flags.Evaluate("backend.vitess", "").Enabled)
+}
+
+func TestNew_RejectsInvalidFlags(t *testing.T) {
+	_, err := featureflag.New("production", []featureflag.Flag{{Name: "cdc"}, {Name: "cdc"}}, nil)
+	assert.Error(t, err)
+
+	_, err = featureflag.New("production", []featureflag.Flag{{Name: "backend@mongodb"}}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package pkg_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixtures_LoadDirReadsCollectionsInFileOrder(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "users.json"), []byte(`[
+		{"_id": "u-1", "name": "Kim", "age": 30, "profile": {"score": 1.5}},
+		{"_id": "u-2", "name": "Lee", "tags": [1, 2]}
+	]`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.json"), []byte(`[{"_id": "o-1", "user_id": "u-1"}]`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a fixture"), 0o644))
+
+	// Act
+	set, err := fixtures.LoadDir(dir)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, set, 2)
+	assert.Equal(t, "orders", set[0].Collection)
+	assert.Equal(t, "users", set[1].Collection)
+	users := set[1].Documents
+	assert.Equal(t, "u-1", users[0].ID)
+	assert.NotContains(t, users[0].Data, fixtures.IDField)
+	assert.Equal(t, int64(30), users[0].Data["age"], "integers are not stored as floats")
+	assert.Equal(t, 1.5, users[0].Data["profile"].(map[string]interface{})["score"])
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, users[1].Data["tags"])
+	assert.True(t, fixtures.Equal(users[0].Data, map[string]interface{}{"age": 30.0, "name": "Kim", "profile": map[string]interface{}{"score": 1.5}}))
+}
+
+func TestFixtures_ParseRejectsDocumentsWithoutStableIDs(t *testing.T) {
+	// Act
+	_, missing := fixtures.Parse([]byte(`[{"name": "Kim"}]`))
+	_, duplicated := fixtures.Parse([]byte(`[{"_id": "u-1"}, {"_id": "u-1"}]`))
+	_, notArray := fixtures.Parse([]byte(`{"_id": "u-1"}`))
+
+	// Assert
+	assert.ErrorContains(t, missing, "_id must be a non-empty string")
+	assert.ErrorContains(t, duplicated, "listed more than once")
+	assert.Error(t, notArray)
+}
@@ -0,0 +1,149 @@
+package pkg_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testHMACSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// memoryReplayCache는 테스트용 서명 재전송 방지 저장소입니다
+type memoryReplayCache struct {
+	mu   sync.Mutex
+	seen map[string]time.Duration
+}
+
+func (c *memoryReplayCache) Remember(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.seen[key]; ok {
+		return false, nil
+	}
+	c.seen[key] = ttl
+	return true, nil
+}
+
+func newTestHMACVerifier(t *testing.T) *auth.HMACVerifier {
+	v, err := auth.NewHMACVerifier(&auth.HMACConfig{
+		Keys: []auth.HMACKey{{
+			ID:     "billing",
+			Secret: testHMACSecret,
+			Roles:  []auth.Role{auth.RoleWriter},
+		}},
+		Tolerance:   time.Minute,
+		ReplayCache: &memoryReplayCache{seen: map[string]time.Duration{}},
+	})
+	require.NoError(t, err)
+	return v
+}
+
+func newSignedRequest(method, uri string, body []byte) auth.SignedRequest {
+	return auth.SignedRequest{
+		Method:     method,
+		RequestURI: uri,
+		Timestamp:  strconv.FormatInt(time.Now().Unix(), 10),
+		Body:       body,
+	}
+}
+
+func TestHMACVerifier_ValidSignature(t *testing.T) {
+	// Arrange
+	verifier := newTestHMACVerifier(t)
+	req := newSignedRequest("POST", "/api/v1/documents", []byte(`{"collection":"invoices","data":{"amount":42}}`))
+
+	// Act
+	principal, err := verifier.Verify(context.Background(), "billing", auth.Sign(testHMACSecret, req), req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "hmac:billing", principal.Subject)
+	assert.True(t, principal.HasRole(auth.RoleWriter))
+}
+
+func TestHMACVerifier_TamperedBody(t *testing.T) {
+	// Arrange
+	verifier := newTestHMACVerifier(t)
+	req := newSignedRequest("POST", "/api/v1/documents", []byte(`{"amount":42}`))
+	signature := auth.Sign(testHMACSecret, req)
+	req.Body = []byte(`{"amount":4200}`)
+
+	// Act
+	_, err := verifier.Verify(context.Background(), "billing", signature, req)
+
+	// Assert
+	assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+}
+
+func TestHMACVerifier_SignatureIsBoundToMethodAndPath(t *testing.T) {
+	// Arrange
+	verifier := newTestHMACVerifier(t)
+	get := newSignedRequest("GET", "/api/v1/documents/invoices/42", nil)
+	signature := auth.Sign(testHMACSecret, get)
+
+	deleteOther := get
+	deleteOther.Method = "DELETE"
+	otherPath := get
+	otherPath.RequestURI = "/api/v1/documents/invoices/43"
+
+	// Act
+	_, deleteErr := verifier.Verify(context.Background(), "billing", signature, deleteOther)
+	_, pathErr := verifier.Verify(context.Background(), "billing", signature, otherPath)
+
+	// Assert
+	assert.ErrorIs(t, deleteErr, auth.ErrInvalidSignature)
+	assert.ErrorIs(t, pathErr, auth.ErrInvalidSignature)
+}
+
+func TestHMACVerifier_RejectsReplayedSignature(t *testing.T) {
+	// Arrange
+	verifier := newTestHMACVerifier(t)
+	req := newSignedRequest("GET", "/api/v1/documents/invoices/42", nil)
+	signature := auth.Sign(testHMACSecret, req)
+	_, err := verifier.Verify(context.Background(), "billing", signature, req)
+	require.NoError(t, err)
+
+	// Act
+	_, err = verifier.Verify(context.Background(), "billing", signature, req)
+
+	// Assert
+	assert.ErrorIs(t, err, auth.ErrSignatureReplayed)
+}
+
+func TestHMACVerifier_StaleTimestamp(t *testing.T) {
+	// Arrange
+	verifier := newTestHMACVerifier(t)
+	req := newSignedRequest("POST", "/api/v1/documents", []byte(`{}`))
+	req.Timestamp = strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+
+	// Act
+	_, err := verifier.Verify(context.Background(), "billing", auth.Sign(testHMACSecret, req), req)
+
+	// Assert
+	assert.ErrorIs(t, err, auth.ErrSignatureExpired)
+}
+
+func TestHMACVerifier_UnknownKeyAndMissingHeaders(t *testing.T) {
+	verifier := newTestHMACVerifier(t)
+	req := newSignedRequest("GET", "/api/v1/documents", nil)
+
+	_, err := verifier.Verify(context.Background(), "unknown", auth.Sign(testHMACSecret, req), req)
+	assert.ErrorIs(t, err, auth.ErrInvalidSignature)
+
+	_, err = verifier.Verify(context.Background(), "billing", "", req)
+	assert.ErrorIs(t, err, auth.ErrMissingSignature)
+}
+
+func TestNewHMACVerifier_RejectsShortSecret(t *testing.T) {
+	_, err := auth.NewHMACVerifier(&auth.HMACConfig{
+		Keys:        []auth.HMACKey{{ID: "weak", Secret: []byte("short")}},
+		ReplayCache: &memoryReplayCache{seen: map[string]time.Duration{}},
+	})
+	assert.Error(t, err)
+}
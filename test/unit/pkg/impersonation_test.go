@@ -0,0 +1,60 @@
+package pkg_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImpersonationTarget(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    auth.ImpersonationTarget
+		wantErr bool
+	}{
+		{value: "alice", want: auth.ImpersonationTarget{Subject: "alice"}},
+		{value: "tenant:acme", want: auth.ImpersonationTarget{TenantID: "acme"}},
+		{value: "user:alice, tenant:acme", want: auth.ImpersonationTarget{Subject: "alice", TenantID: "acme"}},
+		{value: "", wantErr: true},
+		{value: "tenant:", wantErr: true},
+		{value: "group:ops", wantErr: true},
+		{value: "user:alice,user:bob", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := auth.ParseImpersonationTarget(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, auth.ErrInvalidImpersonationTarget)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImpersonator_Impersonate(t *testing.T) {
+	// Arrange
+	impersonator, err := auth.NewImpersonator(auth.ImpersonationConfig{})
+	require.NoError(t, err)
+	admin := &auth.Principal{Subject: "ops-1", Username: "ops", TenantID: "internal", Roles: []auth.Role{auth.RoleAdmin}}
+	writer := &auth.Principal{Subject: "bob", Roles: []auth.Role{auth.RoleWriter}}
+
+	// Act
+	principal, err := impersonator.Impersonate(admin, "user:alice,tenant:acme")
+	_, writerErr := impersonator.Impersonate(writer, "user:alice")
+	_, nestedErr := impersonator.Impersonate(principal, "user:carol")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "alice", principal.Subject)
+	assert.Equal(t, "acme", principal.TenantID)
+	assert.Equal(t, []auth.Role{auth.RoleWriter}, principal.Roles)
+	assert.False(t, principal.IsAdmin())
+	assert.Same(t, admin, principal.ImpersonatedBy)
+	assert.ErrorIs(t, writerErr, auth.ErrImpersonationDenied)
+	assert.ErrorIs(t, nestedErr, auth.ErrImpersonationDenied)
+}
@@ -0,0 +1,81 @@
+package pkg_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/ipfilter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIPFilter(t *testing.T) *ipfilter.Filter {
+	global, err := ipfilter.ParseRules(nil, []string{"203.0.113.0/24"})
+	require.NoError(t, err)
+	admin, err := ipfilter.ParseRules([]string{"10.0.0.0/8", "::1"}, []string{"10.0.0.99"})
+	require.NoError(t, err)
+
+	return ipfilter.New(&ipfilter.Config{
+		Global: global,
+		Groups: []ipfilter.Group{{
+			Name:     "admin",
+			Prefixes: []string{"/api/v1/collections", "/database.v1.DatabaseService/Drop"},
+			Rules:    admin,
+		}},
+	})
+}
+
+func TestIPFilter_Check(t *testing.T) {
+	filter := newTestIPFilter(t)
+
+	tests := []struct {
+		name    string
+		target  string
+		addr    string
+		allowed bool
+		group   string
+	}{
+		{"public route from anywhere", "/api/v1/documents", "198.51.100.7", true, ""},
+		{"global denylist", "/api/v1/documents", "203.0.113.5", false, "global"},
+		{"admin route from internal network", "/api/v1/collections", "10.1.2.3", true, ""},
+		{"admin route from outside", "/api/v1/collections", "198.51.100.7", false, "admin"},
+		{"admin denylist wins over allowlist", "/api/v1/collections", "10.0.0.99", false, "admin"},
+		{"grpc admin method from loopback v6", "/database.v1.DatabaseService/DropCollection", "::1", true, ""},
+		{"ipv4-mapped address", "/api/v1/collections", "::ffff:10.1.2.3", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			decision := filter.Check(tt.target, netip.MustParseAddr(tt.addr))
+
+			// Assert
+			assert.Equal(t, tt.allowed, decision.Allowed)
+			assert.Equal(t, tt.group, decision.Group)
+		})
+	}
+}
+
+func TestIPFilter_UpdateReplacesRules(t *testing.T) {
+	// Arrange
+	filter := newTestIPFilter(t)
+	addr := netip.MustParseAddr("203.0.113.5")
+	require.False(t, filter.Check("/health", addr).Allowed)
+
+	// Act
+	filter.Update(nil)
+
+	// Assert
+	assert.True(t, filter.Check("/health", addr).Allowed)
+}
+
+func TestIPFilter_ParseRulesRejectsInvalidCIDR(t *testing.T) {
+	_, err := ipfilter.ParseRules([]string{"10.0.0.0/33"}, nil)
+	assert.Error(t, err)
+}
+
+func TestIPFilter_ParseAddr(t *testing.T) {
+	assert.Equal(t, netip.MustParseAddr("192.0.2.1"), ipfilter.ParseAddr("192.0.2.1:54321"))
+	assert.Equal(t, netip.MustParseAddr("2001:db8::1"), ipfilter.ParseAddr("[2001:db8::1]:443"))
+	assert.False(t, ipfilter.ParseAddr("@").IsValid())
+}
@@ -0,0 +1,123 @@
+package pkg_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUserSchema = `{
+  "type": "object",
+  "required": ["name", "email"],
+  "additionalProperties": false,
+  "properties": {
+    "name": {"type": "string", "minLength": 1},
+    "email": {"type": "string", "format": "email"},
+    "age": {"type": "integer", "minimum": 0},
+    "code": {"type": "string", "pattern": "^[A-Z]{3}$"},
+    "address": {"$ref": "#/$defs/address"},
+    "tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true}
+  },
+  "$defs": {
+    "address": {
+      "type": "object",
+      "required": ["city"],
+      "properties": {"city": {"type": "string"}, "zip": {"type": "string", "maxLength": 5}}
+    }
+  }
+}`
+
+func TestJSONSchema_ValidDocument(t *testing.T) {
+	// Arrange
+	schema, err := jsonschema.Compile([]byte(testUserSchema))
+	require.NoError(t, err)
+
+	// Act
+	violations, err := schema.Validate(map[string]interface{}{
+		"name":    "Jane",
+		"email":   "jane@example.com",
+		"age":     31,
+		"code":    "ABC",
+		"address": map[string]interface{}{"city": "Seoul", "zip": "04524"},
+		"tags":    []string{"a", "b"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestJSONSchema_ViolationPaths(t *testing.T) {
+	// Arrange
+	schema, err := jsonschema.Compile([]byte(testUserSchema))
+	require.NoError(t, err)
+
+	// Act
+	violations, err := schema.Validate(map[string]interface{}{
+		"name":    "Jane",
+		"age":     "31",
+		"code":    "abc",
+		"address": map[string]interface{}{"zip": "123456"},
+		"tags":    []interface{}{"a", 1},
+		"extra":   true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	byPath := make(map[string]string)
+	for _, v := range violations {
+		byPath[v.Path] = v.Keyword
+	}
+	assert.Equal(t, map[string]string{
+		"/email":        "required",
+		"/age":          "type",
+		"/code":         "pattern",
+		"/address/city": "required",
+		"/address/zip":  "maxLength",
+		"/tags/1":       "type",
+		"/extra":        "additionalProperties",
+	}, byPath)
+}
+
+func TestJSONSchema_Combinators(t *testing.T) {
+	// Arrange
+	schema, err := jsonschema.Compile([]byte(`{
+  "oneOf": [
+    {"type": "string", "format": "uuid"},
+    {"type": "integer", "exclusiveMinimum": 0}
+  ]
+}`))
+	require.NoError(t, err)
+
+	// Act & Assert
+	violations, err := schema.Validate("6f1c2b9e-3a4d-4e5f-8a9b-0c1d2e3f4a5b")
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	violations, err = schema.Validate(0)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "oneOf", violations[0].Keyword)
+	assert.Equal(t, "", violations[0].Path)
+}
+
+func TestJSONSchema_CompileErrors(t *testing.T) {
+	cases := map[string]string{
+		"invalid json":    `{"type":`,
+		"unknown type":    `{"type": "text"}`,
+		"external ref":    `{"$ref": "https://example.com/schema.json"}`,
+		"unresolved ref":  `{"$ref": "#/$defs/missing"}`,
+		"invalid pattern": `{"pattern": "("}`,
+	}
+	for name, schema := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			_, err := jsonschema.Compile([]byte(schema))
+
+			// Assert
+			assert.ErrorIs(t, err, jsonschema.ErrInvalidSchema)
+		})
+	}
+}
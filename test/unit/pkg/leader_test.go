@@ -0,0 +1,318 @@
+package pkg_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/leader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const leaseNamespace = "apps"
+
+// newLeaseClient는 API 서버처럼 Lease의 resourceVersion으로 동시 수정을 거부하는 가짜 클라이언트를 생성합니다
+// fail이 켜지면 Lease 요청이 모두 실패합니다
+func newLeaseClient(fail *atomic.Bool) *fake.Clientset {
+	client := fake.NewClientset()
+	leases := coordinationv1.SchemeGroupVersion.WithResource("leases")
+	var version atomic.Int64
+
+	client.PrependReactor("*", "leases", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if fail != nil && fail.Load() {
+			return true, nil, errors.New("kubernetes api unavailable")
+		}
+		// 저장된 Lease와 호출자의 객체가 메모리를 공유하지 않도록 복사본을 저장하고 반환합니다
+		switch action.GetVerb() {
+		case "create":
+			lease := action.(k8stesting.CreateAction).GetObject().(*coordinationv1.Lease).DeepCopy()
+			lease.ResourceVersion = strconv.FormatInt(version.Add(1), 10)
+			if err := client.Tracker().Create(leases, lease.DeepCopy(), action.GetNamespace()); err != nil {
+				return true, nil, err
+			}
+			return true, lease, nil
+		case "update":
+			lease := action.(k8stesting.UpdateAction).GetObject().(*coordinationv1.Lease).DeepCopy()
+			current, err := client.Tracker().Get(leases, action.GetNamespace(), lease.Name)
+			if err != nil {
+				return true, nil, err
+			}
+			if current.(*coordinationv1.Lease).ResourceVersion != lease.ResourceVersion {
+				return true, nil, apierrors.NewConflict(leases.GroupResource(), lease.Name, errors.New("the object has been modified"))
+			}
+			lease.ResourceVersion = strconv.FormatInt(version.Add(1), 10)
+			if err := client.Tracker().Update(leases, lease.DeepCopy(), action.GetNamespace()); err != nil {
+				return true, nil, err
+			}
+			return true, lease, nil
+		}
+		return false, nil, nil
+	})
+	return client
+}
+
+func leaseHolder(t *testing.T, client *fake.Clientset) string {
+	t.Helper()
+	lease, err := client.CoordinationV1().Leases(leaseNamespace).Get(context.Background(), "jobs", metav1.GetOptions{})
+	require.NoError(t, err)
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+func testLeaderConfig(identity string) leader.Config {
+	return leader.Config{
+		Name:          "jobs",
+		Identity:      identity,
+		LeaseDuration: time.Second,
+		RenewDeadline: 400 * time.Millisecond,
+		RetryPeriod:   50 * time.Millisecond,
+	}
+}
+
+func TestNewElector_RejectsInvalidTimings(t *testing.T) {
+	// Arrange
+	cfg := testLeaderConfig("a")
+	cfg.RenewDeadline = cfg.LeaseDuration
+	jittered := testLeaderConfig("a")
+	jittered.RetryPeriod = jittered.RenewDeadline
+
+	// Act
+	_, err := leader.NewElector(newLeaseClient(nil), leaseNamespace, cfg)
+	_, jitterErr := leader.NewElector(newLeaseClient(nil), leaseNamespace, jittered)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Error(t, jitterErr)
+}
+
+func TestElector_RunAcquiresAndReleasesLease(t *testing.T) {
+	// Arrange
+	client := newLeaseClient(nil)
+	elector, err := leader.NewElector(client, leaseNamespace, testLeaderConfig("a"))
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+
+	// Act
+	go func() {
+		defer close(stopped)
+		elector.Run(ctx, func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+		})
+	}()
+
+	// Assert
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("work was not started")
+	}
+	assert.True(t, elector.IsLeader())
+	assert.Equal(t, "a", leaseHolder(t, client))
+
+	cancel()
+	<-stopped
+	assert.False(t, elector.IsLeader())
+	assert.Empty(t, leaseHolder(t, client))
+}
+
+func TestElector_ReleasesLeaseOnlyAfterWorkFinishes(t *testing.T) {
+	// Arrange
+	client := newLeaseClient(nil)
+	elector, err := leader.NewElector(client, leaseNamespace, testLeaderConfig("a"))
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	var holderWhileStopping string
+
+	go func() {
+		defer close(stopped)
+		elector.Run(ctx, func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+			// 정리 중인 작업이 끝나기 전에는 다른 인스턴스가 이어받지 않아야 합니다
+			time.Sleep(100 * time.Millisecond)
+			holderWhileStopping = leaseHolder(t, client)
+		})
+	}()
+	<-started
+
+	// Act
+	cancel()
+	<-stopped
+
+	// Assert
+	assert.Equal(t, "a", holderWhileStopping)
+	assert.Empty(t, leaseHolder(t, client))
+}
+
+func TestElector_OnlyOneLeaderAtATime(t *testing.T) {
+	// Arrange
+	client := newLeaseClient(nil)
+	first, err := leader.NewElector(client, leaseNamespace, testLeaderConfig("a"))
+	require.NoError(t, err)
+	second, err := leader.NewElector(client, leaseNamespace, testLeaderConfig("b"))
+	require.NoError(t, err)
+	firstCtx, firstCancel := context.WithCancel(context.Background())
+	secondCtx, secondCancel := context.WithCancel(context.Background())
+	defer secondCancel()
+
+	var mu sync.Mutex
+	running := 0
+	maxRunning := 0
+	work := func(started chan struct{}) func(ctx context.Context) {
+		return func(ctx context.Context) {
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+			close(started)
+			<-ctx.Done()
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}
+	}
+	firstStarted, secondStarted := make(chan struct{}), make(chan struct{})
+	firstDone := make(chan struct{})
+
+	// Act
+	go func() {
+		defer close(firstDone)
+		first.Run(firstCtx, work(firstStarted))
+	}()
+	<-firstStarted
+	go second.Run(secondCtx, work(secondStarted))
+	time.Sleep(300 * time.Millisecond)
+	secondWaiting := !second.IsLeader()
+
+	// 첫 번째 리더가 종료하며 리스를 반납하면 두 번째가 이어받습니다
+	firstCancel()
+	<-firstDone
+
+	// Assert
+	select {
+	case <-secondStarted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("second elector did not take over")
+	}
+	assert.True(t, secondWaiting)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, maxRunning)
+}
+
+func TestElector_StopsWorkWhenLeaseIsTaken(t *testing.T) {
+	// Arrange
+	client := newLeaseClient(nil)
+	elector, err := leader.NewElector(client, leaseNamespace, testLeaderConfig("a"))
+	require.NoError(t, err)
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.Run(context.Background(), func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+		})
+	}()
+	<-started
+
+	// Act - 다른 인스턴스가 리스를 가져감
+	leases := client.CoordinationV1().Leases(leaseNamespace)
+	lease, err := leases.Get(context.Background(), "jobs", metav1.GetOptions{})
+	require.NoError(t, err)
+	holder, duration, now := "b", int32(60), metav1.NewMicroTime(time.Now())
+	lease.Spec.HolderIdentity = &holder
+	lease.Spec.LeaseDurationSeconds = &duration
+	lease.Spec.AcquireTime = &now
+	lease.Spec.RenewTime = &now
+	for {
+		if _, err = leases.Update(context.Background(), lease, metav1.UpdateOptions{}); !apierrors.IsConflict(err) {
+			break
+		}
+		current, getErr := leases.Get(context.Background(), "jobs", metav1.GetOptions{})
+		require.NoError(t, getErr)
+		lease.ResourceVersion = current.ResourceVersion
+	}
+	require.NoError(t, err)
+
+	// Assert
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("work was not stopped after losing the lease")
+	}
+	assert.False(t, elector.IsLeader())
+	assert.Equal(t, "b", leaseHolder(t, client))
+}
+
+func TestElector_StopsWorkWhenRenewFails(t *testing.T) {
+	// Arrange
+	var fail atomic.Bool
+	client := newLeaseClient(&fail)
+	elector, err := leader.NewElector(client, leaseNamespace, testLeaderConfig("a"))
+	require.NoError(t, err)
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.Run(context.Background(), func(ctx context.Context) {
+			close(started)
+			<-ctx.Done()
+		})
+	}()
+	<-started
+
+	// Act
+	fail.Store(true)
+
+	// Assert
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("work was not stopped while the kubernetes api was failing")
+	}
+	assert.False(t, elector.IsLeader())
+}
+
+func TestNewKubernetesClient_RequiresCluster(t *testing.T) {
+	// Arrange
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+
+	// Act
+	_, _, err := leader.NewKubernetesClient(leader.KubernetesConfig{Namespace: leaseNamespace})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestLeaseName(t *testing.T) {
+	// Arrange
+	name := "Database_Service-janitor"
+
+	// Act
+	result := leader.LeaseName(name)
+
+	// Assert
+	assert.Equal(t, "database-service-janitor", result)
+}
@@ -0,0 +1,73 @@
+package pkg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/loadshed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadShed_RejectsBeyondLimit(t *testing.T) {
+	// Arrange
+	limiter := loadshed.New(loadshed.Config{InitialLimit: 2, MinLimit: 1, MaxLimit: 10})
+
+	// Act
+	first, ok1 := limiter.Acquire()
+	second, ok2 := limiter.Acquire()
+	_, ok3 := limiter.Acquire()
+
+	// Assert
+	assert.True(t, ok1)
+	assert.True(t, ok2)
+	assert.False(t, ok3)
+	assert.Equal(t, 2, limiter.InFlight())
+
+	first.Done(false)
+	_, ok4 := limiter.Acquire()
+	assert.True(t, ok4)
+	second.Done(false)
+}
+
+func TestLoadShed_DroppedRequestsShrinkLimit(t *testing.T) {
+	// Arrange
+	limiter := loadshed.New(loadshed.Config{InitialLimit: 100, MinLimit: 5, MaxLimit: 200})
+
+	// Act
+	for i := 0; i < 50; i++ {
+		token, ok := limiter.Acquire()
+		require.True(t, ok)
+		token.Done(true)
+	}
+
+	// Assert
+	assert.Equal(t, 5, limiter.Limit())
+	assert.Equal(t, 0, limiter.InFlight())
+}
+
+func TestLoadShed_LatencyIncreaseShrinksLimit(t *testing.T) {
+	// Arrange
+	limiter := loadshed.New(loadshed.Config{InitialLimit: 10, MinLimit: 1, MaxLimit: 100, Smoothing: 1, LongWindow: 1000})
+	for i := 0; i < 5; i++ {
+		token, _ := limiter.Acquire()
+		token.Done(false)
+	}
+	before := limiter.Limit()
+
+	// Act: 응답 시간이 장기 평균보다 크게 늘어난 요청들 (한도를 채운 상태)
+	tokens := make([]*loadshed.Token, 0, before)
+	for i := 0; i < before; i++ {
+		token, ok := limiter.Acquire()
+		require.True(t, ok)
+		tokens = append(tokens, token)
+	}
+	time.Sleep(20 * time.Millisecond)
+	tokens[0].Done(false)
+
+	// Assert
+	assert.Less(t, limiter.Limit(), before)
+	for _, token := range tokens[1:] {
+		token.Done(false)
+	}
+}
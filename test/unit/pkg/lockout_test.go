@@ -0,0 +1,109 @@
+package pkg_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLockoutStore는 테스트용 인메모리 잠금 저장소입니다 (만료는 다루지 않습니다)
+type memoryLockoutStore struct {
+	failures map[string]int64
+	levels   map[string]int64
+	locks    map[string]time.Duration
+}
+
+func newMemoryLockoutStore() *memoryLockoutStore {
+	return &memoryLockoutStore{
+		failures: map[string]int64{},
+		levels:   map[string]int64{},
+		locks:    map[string]time.Duration{},
+	}
+}
+
+func (s *memoryLockoutStore) LockedFor(_ context.Context, key string) (time.Duration, error) {
+	return s.locks[key], nil
+}
+
+func (s *memoryLockoutStore) RecordFailure(_ context.Context, key string, policy lockout.Policy) (*lockout.Failure, error) {
+	s.failures[key]++
+	failures := s.failures[key]
+	if failures < policy.MaxFailures {
+		return &lockout.Failure{Failures: failures, Level: s.levels[key]}, nil
+	}
+	s.levels[key]++
+	s.failures[key] = 0
+	return &lockout.Failure{Failures: failures, Level: s.levels[key], Tripped: true}, nil
+}
+
+func (s *memoryLockoutStore) Lock(_ context.Context, key string, duration time.Duration) error {
+	s.locks[key] = duration
+	return nil
+}
+
+func TestLockoutPolicy_DurationDoublesUpToMax(t *testing.T) {
+	policy := lockout.Policy{BaseDuration: time.Minute, MaxDuration: 10 * time.Minute}
+
+	assert.Equal(t, time.Minute, policy.Duration(1))
+	assert.Equal(t, 2*time.Minute, policy.Duration(2))
+	assert.Equal(t, 8*time.Minute, policy.Duration(4))
+	assert.Equal(t, 10*time.Minute, policy.Duration(5))
+	assert.Equal(t, 10*time.Minute, policy.Duration(64))
+}
+
+func TestGuard_LocksAfterMaxFailuresAndEmitsEvent(t *testing.T) {
+	// Arrange
+	store := newMemoryLockoutStore()
+	policy := lockout.DefaultPolicy()
+	policy.MaxFailures = 3
+	guard, err := lockout.NewGuard(store, policy)
+	require.NoError(t, err)
+
+	var events []lockout.Event
+	guard.OnLockout(func(_ context.Context, e lockout.Event) { events = append(events, e) })
+	keys := lockout.Keys("billing-webhook", "10.0.0.1")
+	ctx := context.Background()
+
+	// Act
+	guard.Fail(ctx, "http", keys...)
+	guard.Fail(ctx, "http", keys...)
+	_, lockedBefore := guard.Check(ctx, keys...)
+	guard.Fail(ctx, "http", keys...)
+	remaining, lockedAfter := guard.Check(ctx, keys...)
+
+	// Assert
+	assert.False(t, lockedBefore)
+	assert.True(t, lockedAfter)
+	assert.Equal(t, policy.BaseDuration, remaining)
+	require.Len(t, events, 2)
+	assert.Equal(t, "key:billing-webhook@10.0.0.1", events[0].Key)
+	assert.Equal(t, "ip:10.0.0.1", events[1].Key)
+	assert.Equal(t, int64(1), events[0].Level)
+}
+
+func TestGuard_FailuresFromAnotherIPDoNotLockValidKey(t *testing.T) {
+	// Arrange
+	store := newMemoryLockoutStore()
+	policy := lockout.DefaultPolicy()
+	policy.MaxFailures = 3
+	guard, err := lockout.NewGuard(store, policy)
+	require.NoError(t, err)
+	ctx := context.Background()
+	attacker := lockout.Keys("billing-webhook", "198.51.100.7")
+	partner := lockout.Keys("billing-webhook", "10.0.0.1")
+
+	// Act
+	for i := 0; i < 5; i++ {
+		guard.Fail(ctx, "http", attacker...)
+	}
+	_, attackerLocked := guard.Check(ctx, attacker...)
+	_, partnerLocked := guard.Check(ctx, partner...)
+
+	// Assert
+	assert.True(t, attackerLocked)
+	assert.False(t, partnerLocked)
+}
@@ -0,0 +1,176 @@
+package pkg_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testIssuer는 discovery와 JWKS를 제공하는 테스트용 OIDC 발급자입니다
+type testIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ti := &testIssuer{key: key, kid: "test-key"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   ti.server.URL,
+			"jwks_uri": ti.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": ti.kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	ti.server = httptest.NewServer(mux)
+	t.Cleanup(ti.server.Close)
+	return ti
+}
+
+func (ti *testIssuer) sign(t *testing.T, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": ti.kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, ti.key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func (ti *testIssuer) verifier(t *testing.T) *auth.OIDCVerifier {
+	cfg := auth.DefaultOIDCConfig()
+	cfg.IssuerURL = ti.server.URL
+	cfg.Audiences = []string{"database-service"}
+	cfg.RoleMappings = []auth.RoleMapping{
+		{ClaimValue: "db-writer", Role: auth.RoleWriter},
+		{ClaimValue: "db-admin", Role: auth.RoleAdmin},
+	}
+
+	v, err := auth.NewOIDCVerifier(context.Background(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(v.Close)
+	return v
+}
+
+func (ti *testIssuer) claims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":                ti.server.URL,
+		"aud":                []string{"database-service", "account"},
+		"sub":                "user-123",
+		"preferred_username": "alice",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"iat":                time.Now().Unix(),
+		"realm_access":       map[string]interface{}{"roles": []string{"db-writer", "offline_access"}},
+	}
+}
+
+func TestOIDCVerifier_ValidToken_MapsRoles(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	verifier := issuer.verifier(t)
+	token := issuer.sign(t, issuer.claims())
+
+	// Act
+	principal, err := verifier.Verify(context.Background(), token)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "user-123", principal.Subject)
+	assert.Equal(t, "alice", principal.Username)
+	assert.Equal(t, []auth.Role{auth.RoleWriter}, principal.Roles)
+	assert.True(t, principal.HasRole(auth.RoleReader))
+	assert.False(t, principal.HasRole(auth.RoleAdmin))
+}
+
+func TestOIDCVerifier_WrongAudience(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	verifier := issuer.verifier(t)
+	claims := issuer.claims()
+	claims["aud"] = "other-service"
+
+	// Act
+	_, err := verifier.Verify(context.Background(), issuer.sign(t, claims))
+
+	// Assert
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestOIDCVerifier_ExpiredToken(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	verifier := issuer.verifier(t)
+	claims := issuer.claims()
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+	// Act
+	_, err := verifier.Verify(context.Background(), issuer.sign(t, claims))
+
+	// Assert
+	assert.ErrorIs(t, err, auth.ErrTokenExpired)
+}
+
+func TestOIDCVerifier_TamperedSignature(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	verifier := issuer.verifier(t)
+	token := issuer.sign(t, issuer.claims())
+	tampered := token[:len(token)-4] + "AAAA"
+
+	// Act
+	_, err := verifier.Verify(context.Background(), tampered)
+
+	// Assert
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestBearerToken(t *testing.T) {
+	assert.Equal(t, "abc.def.ghi", auth.BearerToken("Bearer abc.def.ghi"))
+	assert.Equal(t, "abc.def.ghi", auth.BearerToken("bearer abc.def.ghi"))
+	assert.Equal(t, "", auth.BearerToken("Basic dXNlcjpwYXNz"))
+	assert.Equal(t, "", auth.BearerToken(""))
+}
+
+func TestOIDCVerifier_FractionalExpClaim(t *testing.T) {
+	// Arrange
+	issuer := newTestIssuer(t)
+	verifier := issuer.verifier(t)
+	claims := issuer.claims()
+	claims["exp"] = float64(time.Now().Add(time.Hour).Unix()) + 0.5
+
+	// Act
+	principal, err := verifier.Verify(context.Background(), issuer.sign(t, claims))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "user-123", principal.Subject)
+}
@@ -0,0 +1,86 @@
+package pkg_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/pii"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPIIScanner(t *testing.T) *pii.Scanner {
+	scanner, err := pii.NewScanner(pii.DefaultDetectors(), []pii.Policy{
+		{Collection: "payments", Action: pii.ActionReject},
+		{Collection: "users", Action: pii.ActionMask, ExemptFields: []string{"contact.email"}},
+	}, pii.ActionTag)
+	require.NoError(t, err)
+	return scanner
+}
+
+func TestPIIScanner_Mask(t *testing.T) {
+	// Arrange
+	scanner := newTestPIIScanner(t)
+	data := map[string]interface{}{
+		"note":    "card 4111 1111 1111 1111, mail alice@example.com",
+		"contact": map[string]interface{}{"email": "alice@example.com"},
+		"aliases": []interface{}{"bob@example.org"},
+	}
+
+	// Act
+	result, err := scanner.Scan("users", data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pii.ActionMask, result.Action)
+	assert.Equal(t, "card **** **** **** 1111, mail a***@example.com", data["note"])
+	assert.Equal(t, "alice@example.com", data["contact"].(map[string]interface{})["email"])
+	assert.Equal(t, "b***@example.org", data["aliases"].([]interface{})[0])
+	assert.Len(t, result.Findings, 3)
+}
+
+func TestPIIScanner_Reject(t *testing.T) {
+	// Arrange
+	scanner := newTestPIIScanner(t)
+	data := map[string]interface{}{"card": "4111-1111-1111-1111"}
+
+	// Act
+	_, err := scanner.Scan("payments", data)
+
+	// Assert
+	assert.ErrorIs(t, err, pii.ErrPIIDetected)
+}
+
+func TestPIIScanner_TagByDefault(t *testing.T) {
+	// Arrange
+	scanner := newTestPIIScanner(t)
+	data := map[string]interface{}{"email": "carol@example.com", "order": "1234567890123"}
+
+	// Act
+	result, err := scanner.Scan("orders", data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "carol@example.com", data["email"])
+	assert.Equal(t, []pii.Finding{{Field: "email", Type: pii.TypeEmail}}, result.Findings)
+	assert.Equal(t, []interface{}{map[string]interface{}{"field": "email", "type": "email"}}, data[pii.TagField])
+}
+
+func TestCardNumberDetector_RequiresLuhn(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"visa test number", "4111111111111111", true},
+		{"spaced", "5500 0000 0000 0004", true},
+		{"fails checksum", "4111111111111112", false},
+		{"too short", "411111111111", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := len(pii.CardNumberDetector{}.FindAll(tt.value)) > 0
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package pkg_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/poolhealth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConnector는 ping 실패를 흉내 낼 수 있는 테스트용 driver.Connector입니다
+type fakeConnector struct {
+	opened atomic.Int32
+	closed atomic.Int32
+	broken atomic.Bool
+}
+
+func (c *fakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	c.opened.Add(1)
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver { return nil }
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) Close() error {
+	c.connector.closed.Add(1)
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (c *fakeConn) Ping(ctx context.Context) error {
+	if c.connector.broken.Load() {
+		return errors.New("connection reset by peer")
+	}
+	return nil
+}
+
+func TestPoolHealth_SQLWarmUpOpensIdleConnections(t *testing.T) {
+	// Arrange
+	connector := &fakeConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	db.SetMaxIdleConns(4)
+
+	registry := poolhealth.NewRegistry()
+	registry.RegisterSQL("test", db, 4)
+
+	// Act
+	registry.WarmUp(context.Background(), time.Second)
+
+	// Assert
+	assert.Equal(t, int32(4), connector.opened.Load())
+	assert.Equal(t, 4, db.Stats().Idle)
+}
+
+func TestPoolHealth_SQLValidateEvictsBrokenConnections(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	connector := &fakeConnector{}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	db.SetMaxIdleConns(3)
+	pool := poolhealth.SQL(db)
+	require.NoError(t, pool.WarmUp(ctx, 3))
+
+	// Act - 정상 연결은 그대로 두고, 끊어진 연결만 버림
+	healthyEvicted, healthyErr := pool.Validate(ctx, time.Second)
+	connector.broken.Store(true)
+	brokenEvicted, brokenErr := pool.Validate(ctx, time.Second)
+
+	// Assert
+	require.NoError(t, healthyErr)
+	assert.Equal(t, 0, healthyEvicted)
+	require.NoError(t, brokenErr)
+	assert.Equal(t, 3, brokenEvicted)
+	assert.Equal(t, int32(3), connector.closed.Load())
+	assert.Equal(t, 0, db.Stats().Idle)
+}
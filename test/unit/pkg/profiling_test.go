@@ -0,0 +1,86 @@
+package pkg_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/profiling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfilingHandler_ServesPprofIndex(t *testing.T) {
+	// Arrange
+	handler := profiling.Handler()
+
+	// Act
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/goroutine?debug=1", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goroutine profile")
+}
+
+func TestProfilingPusher_UploadsProfiles(t *testing.T) {
+	// Arrange
+	type upload struct {
+		name, format, auth string
+		size               int
+	}
+	uploads := make(chan upload, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("profile")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		uploads <- upload{
+			name:   r.URL.Query().Get("name"),
+			format: r.URL.Query().Get("format"),
+			auth:   r.Header.Get("Authorization"),
+			size:   len(data),
+		}
+	}))
+	defer server.Close()
+
+	pusher, err := profiling.NewPusher(profiling.PushConfig{
+		ServerAddress:   server.URL,
+		ApplicationName: "database-service",
+		Tags:            map[string]string{"version": "1.0", "env": "test"},
+		AuthToken:       "secret",
+		Interval:        50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	// Act
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go pusher.Run(ctx)
+
+	// Assert - CPU와 힙 프로파일이 태그가 붙은 이름으로 전송
+	for i := 0; i < 2; i++ {
+		select {
+		case got := <-uploads:
+			assert.Equal(t, "database-service{env=test,version=1.0}", got.name)
+			assert.Equal(t, "pprof", got.format)
+			assert.Equal(t, "Bearer secret", got.auth)
+			assert.Positive(t, got.size)
+		case <-time.After(5 * time.Second):
+			t.Fatal("profile was not pushed")
+		}
+	}
+}
+
+func TestProfilingPusher_RequiresServerAddress(t *testing.T) {
+	// Act
+	_, err := profiling.NewPusher(profiling.PushConfig{ApplicationName: "database-service"})
+
+	// Assert
+	assert.Error(t, err)
+}
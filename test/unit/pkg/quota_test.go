@@ -0,0 +1,90 @@
+package pkg_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/quota"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaCheck(t *testing.T) {
+	limit := quota.Limit{MaxDocuments: 10, MaxBytes: 1000}
+
+	tests := []struct {
+		name      string
+		usage     quota.Usage
+		documents int64
+		bytes     int64
+		resource  string
+	}{
+		{name: "within limits", usage: quota.Usage{Documents: 9, Bytes: 900}, documents: 1, bytes: 100},
+		{name: "too many documents", usage: quota.Usage{Documents: 10}, documents: 1, bytes: 10, resource: quota.ResourceDocuments},
+		{name: "too many bytes", usage: quota.Usage{Documents: 1, Bytes: 950}, documents: 1, bytes: 51, resource: quota.ResourceBytes},
+		{name: "update below byte limit", usage: quota.Usage{Documents: 10, Bytes: 999}},
+		{name: "update at byte limit", usage: quota.Usage{Bytes: 1000}, resource: quota.ResourceBytes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := quota.Check("collection", "orders", limit, tt.usage, tt.documents, tt.bytes)
+
+			if tt.resource == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, quota.ErrExceeded)
+			var exceeded *quota.ExceededError
+			require.True(t, errors.As(err, &exceeded))
+			assert.Equal(t, tt.resource, exceeded.Resource)
+			assert.Equal(t, "orders", exceeded.Name)
+		})
+	}
+}
+
+func TestQuotaTracker_ReserveIsAllOrNothing(t *testing.T) {
+	// Arrange
+	tracker := quota.NewTracker()
+	tracker.Sync("mongodb/collection:acme_orders", 5, 500, time.Now())
+	tracker.Sync("mongodb/tenant:acme", 99, 5000, time.Now())
+	claims := []quota.Claim{
+		{Key: "mongodb/collection:acme_orders", Scope: "collection", Name: "acme_orders", Limit: quota.Limit{MaxDocuments: 100}},
+		{Key: "mongodb/tenant:acme", Scope: "tenant", Name: "acme", Limit: quota.Limit{MaxDocuments: 100}},
+	}
+
+	// Act
+	first := tracker.Reserve(claims, 1, 50)
+	second := tracker.Reserve(claims, 1, 50)
+
+	// Assert
+	require.NoError(t, first)
+	var exceeded *quota.ExceededError
+	require.True(t, errors.As(second, &exceeded))
+	assert.Equal(t, "tenant", exceeded.Scope)
+
+	collection, _ := tracker.Get("mongodb/collection:acme_orders")
+	tenant, _ := tracker.Get("mongodb/tenant:acme")
+	assert.Equal(t, int64(6), collection.Documents, "rejected reservation must not change usage")
+	assert.Equal(t, int64(100), tenant.Documents)
+}
+
+func TestQuotaTracker_Release(t *testing.T) {
+	// Arrange
+	tracker := quota.NewTracker()
+	tracker.Sync("mongodb/collection:orders", 0, 0, time.Now())
+	claims := []quota.Claim{{Key: "mongodb/collection:orders", Limit: quota.Limit{MaxDocuments: 10}}}
+	require.NoError(t, tracker.Reserve(claims, 3, 300))
+
+	// Act
+	tracker.Release(claims, 3, 300)
+	tracker.Release(claims, 1, 100)
+
+	// Assert
+	usage, ok := tracker.Get("mongodb/collection:orders")
+	require.True(t, ok)
+	assert.Equal(t, int64(0), usage.Documents)
+	assert.Equal(t, int64(0), usage.Bytes)
+	assert.Equal(t, []string{"mongodb/collection:orders"}, tracker.Keys())
+}
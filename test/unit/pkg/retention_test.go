@@ -0,0 +1,41 @@
+package pkg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/retention"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetention_SelectByAgeAndCount(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	items := []retention.Item{
+		{ID: "new", CreatedAt: now.Add(-time.Hour)},
+		{ID: "oldest", CreatedAt: now.Add(-72 * time.Hour)},
+		{ID: "old", CreatedAt: now.Add(-48 * time.Hour)},
+		{ID: "recent", CreatedAt: now.Add(-2 * time.Hour)},
+	}
+
+	// Act
+	victims := retention.Select(items, retention.Policy{MaxAge: 24 * time.Hour, MaxCount: 3}, now)
+
+	// Assert
+	assert.Equal(t, []retention.Victim{
+		{ID: "oldest", Reason: retention.ReasonMaxCount},
+		{ID: "old", Reason: retention.ReasonMaxAge},
+	}, victims)
+}
+
+func TestRetention_EmptyPolicySelectsNothing(t *testing.T) {
+	// Arrange
+	now := time.Now()
+	items := []retention.Item{{ID: "a", CreatedAt: now.Add(-24 * 365 * time.Hour)}}
+
+	// Act
+	victims := retention.Select(items, retention.Policy{}, now)
+
+	// Assert
+	assert.Empty(t, victims)
+}
@@ -0,0 +1,123 @@
+package pkg_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/retry"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTransient = errors.New("transient error")
+
+func retryTransient(err error) bool {
+	return errors.Is(err, errTransient)
+}
+
+func TestRetry_BudgetExhaustedStopsRetries(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	var exhausted []string
+	budgets := retry.NewBudgets(retry.BudgetConfig{Ratio: 0.1, MinPerSecond: 0.001, Max: 2}, func(name string) {
+		exhausted = append(exhausted, name)
+	})
+	config := retry.Config{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		Multiplier:      1,
+		Retryable:       retryTransient,
+		Budget:          budgets.Get("postgresql:update"),
+	}
+	attemptCount := 0
+
+	fn := func(ctx context.Context) error {
+		attemptCount++
+		return errTransient
+	}
+
+	// Act
+	err := retry.Do(ctx, config, fn)
+
+	// Assert - two retries consume the budget, the third is skipped
+	assert.ErrorIs(t, err, retry.ErrBudgetExhausted)
+	assert.ErrorIs(t, err, errTransient)
+	assert.Equal(t, 3, attemptCount)
+	assert.Equal(t, []string{"postgresql:update"}, exhausted)
+}
+
+func TestRetry_BudgetsAreIndependentPerOperation(t *testing.T) {
+	// Arrange
+	budgets := retry.NewBudgets(retry.BudgetConfig{Max: 1}, nil)
+	config := retry.Config{
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		Multiplier:      1,
+		Retryable:       retryTransient,
+	}
+	failing := func(ctx context.Context) error { return errTransient }
+
+	// Act
+	config.Budget = budgets.Get("mongodb:find")
+	_ = retry.Do(context.Background(), config, failing)
+
+	// Assert
+	assert.Less(t, budgets.Get("mongodb:find").Balance(), 1.0)
+	assert.GreaterOrEqual(t, budgets.Get("mongodb:create").Balance(), 1.0)
+}
+
+func TestRetry_ClassifierStopsOnTerminalError(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	terminalErr := errors.New("duplicate key")
+	config := retry.Config{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		Retryable:       retryTransient,
+	}
+	attemptCount := 0
+
+	// Act
+	err := retry.Do(ctx, config, func(ctx context.Context) error {
+		attemptCount++
+		return terminalErr
+	})
+
+	// Assert
+	assert.Equal(t, terminalErr, err)
+	assert.Equal(t, 1, attemptCount)
+}
+
+func TestRetry_JitterKeepsWaitWithinBackoff(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	config := retry.Config{
+		MaxAttempts:     4,
+		InitialInterval: time.Millisecond * 20,
+		MaxInterval:     time.Millisecond * 20,
+		Multiplier:      1,
+		Jitter:          true,
+		Retryable:       retryTransient,
+	}
+
+	// Act
+	start := time.Now()
+	_ = retry.Do(ctx, config, func(ctx context.Context) error {
+		return errTransient
+	})
+
+	// Assert - three waits of at most 20ms each
+	assert.Less(t, time.Since(start), time.Millisecond*60+time.Millisecond*30)
+}
+
+func TestIsRetryable_Classification(t *testing.T) {
+	assert.False(t, retry.IsRetryable(nil))
+	assert.False(t, retry.IsRetryable(context.Canceled))
+	assert.False(t, retry.IsRetryable(errors.New("validation failed")))
+	assert.True(t, retry.IsRetryable(retry.MarkRetryable(errors.New("lock timeout"))))
+	assert.False(t, retry.IsRetryable(retry.MarkTerminal(errors.New("timeout"))))
+	assert.True(t, retry.IsRetryable(errors.New("timeout")))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/YouSangSon/database-service/internal/pkg/retry"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRetry_Success_FirstAttempt(t *testing.T) {
@@ -33,10 +34,10 @@ func TestRetry_Success_AfterRetries(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	config := retry.Config{
-		MaxAttempts: 5,
-		InitialDelay: time.Millisecond * 10,
-		MaxDelay: time.Millisecond * 100,
-		Multiplier: 2.0,
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond * 10,
+		MaxInterval:     time.Millisecond * 100,
+		Multiplier:      2.0,
 	}
 	attemptCount := 0
 	failUntil := 3
@@ -44,7 +45,7 @@ func TestRetry_Success_AfterRetries(t *testing.T) {
 	fn := func(ctx context.Context) error {
 		attemptCount++
 		if attemptCount < failUntil {
-			return errors.New("temporary error")
+			return retry.MarkRetryable(errors.New("temporary error"))
 		}
 		return nil
 	}
@@ -61,36 +62,62 @@ func TestRetry_Failure_MaxAttemptsReached(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	config := retry.Config{
-		MaxAttempts: 3,
-		InitialDelay: time.Millisecond * 10,
-		MaxDelay: time.Millisecond * 100,
-		Multiplier: 2.0,
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond * 10,
+		MaxInterval:     time.Millisecond * 100,
+		Multiplier:      2.0,
 	}
 	attemptCount := 0
 	expectedErr := errors.New("persistent error")
 
 	fn := func(ctx context.Context) error {
 		attemptCount++
-		return expectedErr
+		return retry.MarkRetryable(expectedErr)
 	}
 
 	// Act
 	err := retry.Do(ctx, config, fn)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, expectedErr, err)
+	assert.ErrorIs(t, err, retry.ErrMaxRetriesExceeded)
+	assert.ErrorIs(t, err, expectedErr)
 	assert.Equal(t, 3, attemptCount)
 }
 
+func TestRetry_TerminalErrorIsNotRetried(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	config := retry.Config{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		Multiplier:      1,
+	}
+	attemptCount := 0
+	expectedErr := errors.New("duplicate key")
+
+	fn := func(ctx context.Context) error {
+		attemptCount++
+		return retry.MarkTerminal(expectedErr)
+	}
+
+	// Act
+	err := retry.Do(ctx, config, fn)
+
+	// Assert
+	assert.ErrorIs(t, err, expectedErr)
+	assert.NotErrorIs(t, err, retry.ErrMaxRetriesExceeded)
+	assert.Equal(t, 1, attemptCount)
+}
+
 func TestRetry_ContextCanceled(t *testing.T) {
 	// Arrange
 	ctx, cancel := context.WithCancel(context.Background())
 	config := retry.Config{
-		MaxAttempts: 10,
-		InitialDelay: time.Millisecond * 100,
-		MaxDelay: time.Second,
-		Multiplier: 2.0,
+		MaxAttempts:     10,
+		InitialInterval: time.Millisecond * 100,
+		MaxInterval:     time.Second,
+		Multiplier:      2.0,
 	}
 	attemptCount := 0
 
@@ -99,51 +126,89 @@ func TestRetry_ContextCanceled(t *testing.T) {
 		if attemptCount == 2 {
 			cancel() // Cancel context on second attempt
 		}
-		return errors.New("error")
+		return retry.MarkRetryable(errors.New("error"))
 	}
 
 	// Act
 	err := retry.Do(ctx, config, fn)
 
 	// Assert
-	assert.Error(t, err)
-	assert.True(t, attemptCount <= 3) // Should stop soon after cancellation
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, attemptCount)
 }
 
 func TestRetry_ExponentialBackoff(t *testing.T) {
 	// Arrange
 	ctx := context.Background()
 	config := retry.Config{
-		MaxAttempts: 4,
-		InitialDelay: time.Millisecond * 10,
-		MaxDelay: time.Millisecond * 100,
-		Multiplier: 2.0,
+		MaxAttempts:     4,
+		InitialInterval: time.Millisecond * 10,
+		MaxInterval:     time.Millisecond * 100,
+		Multiplier:      2.0,
 	}
 	attemptTimes := []time.Time{}
 
 	fn := func(ctx context.Context) error {
 		attemptTimes = append(attemptTimes, time.Now())
-		return errors.New("error")
+		return retry.MarkRetryable(errors.New("error"))
 	}
 
 	// Act
 	retry.Do(ctx, config, fn)
 
 	// Assert
-	assert.Len(t, attemptTimes, 4)
-
-	// Check delays are increasing exponentially
-	// Attempt 1 -> Attempt 2: ~10ms delay
-	// Attempt 2 -> Attempt 3: ~20ms delay
-	// Attempt 3 -> Attempt 4: ~40ms delay
-	if len(attemptTimes) >= 2 {
-		delay1 := attemptTimes[1].Sub(attemptTimes[0])
-		assert.True(t, delay1 >= time.Millisecond*8) // Allow some variance
+	require.Len(t, attemptTimes, 4)
+
+	// Without jitter the delays are 10ms, 20ms, 40ms
+	assert.GreaterOrEqual(t, attemptTimes[1].Sub(attemptTimes[0]), time.Millisecond*10)
+	assert.GreaterOrEqual(t, attemptTimes[2].Sub(attemptTimes[1]), time.Millisecond*20)
+	assert.GreaterOrEqual(t, attemptTimes[3].Sub(attemptTimes[2]), time.Millisecond*40)
+}
+
+func TestRetry_JitterKeepsDelaysWithinBackoff(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	config := retry.Config{
+		MaxAttempts:     11,
+		InitialInterval: time.Millisecond * 40,
+		MaxInterval:     time.Millisecond * 40,
+		Multiplier:      1,
+		Jitter:          true,
 	}
-	if len(attemptTimes) >= 3 {
-		delay2 := attemptTimes[2].Sub(attemptTimes[1])
-		assert.True(t, delay2 >= time.Millisecond*15)
+
+	fn := func(ctx context.Context) error {
+		return retry.MarkRetryable(errors.New("error"))
 	}
+
+	// Act
+	start := time.Now()
+	retry.Do(ctx, config, fn)
+	elapsed := time.Since(start)
+
+	// Assert - full jitter draws each of the 10 waits from [0, 40ms], so the total stays well below 10 * 40ms
+	assert.Less(t, elapsed, time.Millisecond*400)
+}
+
+func TestRetry_BudgetIsNotChargedWithoutRetries(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	budget := retry.NewBudget(retry.BudgetConfig{Ratio: 0.1, MinPerSecond: 0.001, Max: 1})
+	config := retry.Config{
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		Multiplier:      1,
+		Budget:          budget,
+	}
+
+	// Act
+	err := retry.Do(ctx, config, func(ctx context.Context) error {
+		return retry.MarkTerminal(errors.New("validation failed"))
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.InDelta(t, 1.0, budget.Balance(), 0.01)
 }
 
 func TestRetry_DefaultConfig(t *testing.T) {
@@ -152,7 +217,9 @@ func TestRetry_DefaultConfig(t *testing.T) {
 
 	// Assert
 	assert.Equal(t, 3, config.MaxAttempts)
-	assert.Equal(t, time.Second, config.InitialDelay)
-	assert.Equal(t, time.Second*30, config.MaxDelay)
+	assert.Equal(t, 500*time.Millisecond, config.InitialInterval)
+	assert.Equal(t, time.Second*30, config.MaxInterval)
 	assert.Equal(t, 2.0, config.Multiplier)
+	assert.Equal(t, 2*time.Minute, config.MaxElapsedTime)
+	assert.True(t, config.Jitter)
 }
@@ -0,0 +1,86 @@
+package pkg_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOwnerPolicySet(t *testing.T) *auth.RowPolicySet {
+	set, err := auth.NewRowPolicySet([]auth.RowPolicy{
+		{Collection: "orders", Field: "data.owner", Claim: "sub", ExemptRoles: []auth.Role{auth.RoleAdmin}},
+	})
+	require.NoError(t, err)
+	return set
+}
+
+func TestRowPolicySet_Conditions(t *testing.T) {
+	// Arrange
+	set := newOwnerPolicySet(t)
+	alice := &auth.Principal{Subject: "alice", Roles: []auth.Role{auth.RoleWriter}}
+	admin := &auth.Principal{Subject: "root", Roles: []auth.Role{auth.RoleAdmin}}
+
+	// Act
+	aliceCond, aliceErr := set.Conditions(alice, "orders")
+	adminCond, adminErr := set.Conditions(admin, "orders")
+	otherCond, otherErr := set.Conditions(alice, "products")
+	_, anonErr := set.Conditions(nil, "orders")
+
+	// Assert
+	require.NoError(t, aliceErr)
+	assert.Equal(t, map[string]interface{}{"data.owner": "alice"}, aliceCond)
+	require.NoError(t, adminErr)
+	assert.Empty(t, adminCond)
+	require.NoError(t, otherErr)
+	assert.Empty(t, otherCond)
+	assert.ErrorIs(t, anonErr, auth.ErrForbidden)
+}
+
+func TestScopeFilter_WrapsUserFilterInAnd(t *testing.T) {
+	// Arrange
+	conditions := map[string]interface{}{"data.owner": "alice"}
+	filter := map[string]interface{}{"$or": []interface{}{
+		map[string]interface{}{"data.status": "open"},
+		map[string]interface{}{"data.owner": "bob"},
+	}}
+
+	// Act
+	scoped := auth.ScopeFilter(filter, conditions)
+
+	// Assert
+	assert.Equal(t, map[string]interface{}{"$and": []interface{}{filter, conditions}}, scoped)
+	assert.Equal(t, conditions, auth.ScopeFilter(nil, conditions))
+}
+
+func TestStampConditions(t *testing.T) {
+	conditions := map[string]interface{}{"data.owner": "alice"}
+
+	doc := map[string]interface{}{"data": map[string]interface{}{"item": "book"}}
+	require.NoError(t, auth.StampConditions(doc, conditions))
+	assert.True(t, auth.MatchesConditions(doc, conditions))
+
+	forged := map[string]interface{}{"data": map[string]interface{}{"owner": "bob"}}
+	assert.ErrorIs(t, auth.StampConditions(forged, conditions), auth.ErrForbidden)
+}
+
+func TestUpdateChangesConditions(t *testing.T) {
+	conditions := map[string]interface{}{"data.owner": "alice"}
+
+	assert.False(t, auth.UpdateChangesConditions(map[string]interface{}{
+		"$set": map[string]interface{}{"data.status": "closed"},
+	}, conditions))
+	assert.False(t, auth.UpdateChangesConditions(map[string]interface{}{
+		"$set": map[string]interface{}{"data.owner": "alice"},
+	}, conditions))
+	assert.True(t, auth.UpdateChangesConditions(map[string]interface{}{
+		"$set": map[string]interface{}{"data.owner": "bob"},
+	}, conditions))
+	assert.True(t, auth.UpdateChangesConditions(map[string]interface{}{
+		"$unset": map[string]interface{}{"data.owner": ""},
+	}, conditions))
+	assert.True(t, auth.UpdateChangesConditions(map[string]interface{}{
+		"$set": map[string]interface{}{"data": map[string]interface{}{"status": "closed"}},
+	}, conditions))
+}
@@ -0,0 +1,166 @@
+package pkg_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/pkg/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticSecretProvider는 테스트용 시크릿 공급자입니다
+type staticSecretProvider struct {
+	secret *secrets.Secret
+}
+
+func (p *staticSecretProvider) Name() string { return "static" }
+
+func (p *staticSecretProvider) GetSecret(_ context.Context, _ string) (*secrets.Secret, error) {
+	return p.secret, nil
+}
+
+func TestAWSProvider_GetSecretSignsRequest(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secretsmanager.GetSecretValue", r.Header.Get("X-Amz-Target"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDTEST/"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/ap-northeast-2/secretsmanager/aws4_request")
+		assert.Equal(t, "session", r.Header.Get("X-Amz-Security-Token"))
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "prod/postgresql", body["SecretId"])
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"SecretString": `{"username":"app","password":"s3cret","port":5432}`,
+			"VersionId":    "v2",
+		})
+	}))
+	defer server.Close()
+
+	provider, err := secrets.NewAWSProvider(secrets.AWSConfig{
+		Region:          "ap-northeast-2",
+		Endpoint:        server.URL,
+		AccessKeyID:     "AKIDTEST",
+		SecretAccessKey: "secret",
+		SessionToken:    "session",
+	})
+	require.NoError(t, err)
+
+	// Act
+	secret, err := provider.GetSecret(context.Background(), "prod/postgresql")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "v2", secret.Version)
+	assert.Equal(t, "app", secret.Data["username"])
+	assert.Equal(t, "s3cret", secret.Data["password"])
+	assert.Equal(t, "5432", secret.Data["port"])
+}
+
+func TestAWSProvider_NotFound(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"not found"}`))
+	}))
+	defer server.Close()
+
+	provider, err := secrets.NewAWSProvider(secrets.AWSConfig{
+		Region:          "us-east-1",
+		Endpoint:        server.URL,
+		AccessKeyID:     "AKIDTEST",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+
+	// Act
+	_, err = provider.GetSecret(context.Background(), "missing")
+
+	// Assert
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+}
+
+func TestGCPProvider_GetSecretWithMetadataToken(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/computeMetadata/") {
+			assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token-1", "expires_in": 3600})
+			return
+		}
+		assert.Equal(t, "/v1/projects/my-project/secrets/mysql/versions/latest:access", r.URL.Path)
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"name":    "projects/123/secrets/mysql/versions/7",
+			"payload": map[string]string{"data": base64.StdEncoding.EncodeToString([]byte(`{"username":"app","password":"pw"}`))},
+		})
+	}))
+	defer server.Close()
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "")
+	t.Setenv("GCE_METADATA_HOST", strings.TrimPrefix(server.URL, "http://"))
+
+	provider, err := secrets.NewGCPProvider(secrets.GCPConfig{Project: "my-project", Endpoint: server.URL})
+	require.NoError(t, err)
+
+	// Act
+	secret, err := provider.GetSecret(context.Background(), "mysql")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "7", secret.Version)
+	assert.Equal(t, "app", secret.Data["username"])
+	assert.Equal(t, "pw", secret.Data["password"])
+}
+
+func TestCredentialsManager_CallsOnRotateWhenSecretChanges(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	provider := &staticSecretProvider{secret: &secrets.Secret{
+		Data:    map[string]string{"username": "app", "password": "old"},
+		Version: "v1",
+	}}
+	manager := secrets.NewCredentialsManager(provider, "postgresql", "prod/postgresql")
+	var rotated []string
+	manager.OnRotate(func(creds *secrets.Credentials) {
+		rotated = append(rotated, creds.Version)
+	})
+
+	// Act
+	_, err := manager.GetCredentials(ctx)
+	require.NoError(t, err)
+	unchanged, err := manager.Refresh(ctx)
+	require.NoError(t, err)
+	provider.secret = &secrets.Secret{
+		Data:    map[string]string{"username": "app", "password": "new"},
+		Version: "v2",
+	}
+	changed, err := manager.Refresh(ctx)
+	require.NoError(t, err)
+
+	// Assert
+	assert.False(t, unchanged)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"v2"}, rotated)
+	username, password := manager.Current()
+	assert.Equal(t, "app", username)
+	assert.Equal(t, "new", password)
+}
+
+func TestCredentialsManager_RequiresUsernameAndPassword(t *testing.T) {
+	// Arrange
+	provider := &staticSecretProvider{secret: &secrets.Secret{Data: map[string]string{"value": "plain"}}}
+	manager := secrets.NewCredentialsManager(provider, "mysql", "plain")
+
+	// Act
+	_, err := manager.GetCredentials(context.Background())
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,152 @@
+package pkg_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault는 경로별 응답을 돌려주고 리스 취소 요청을 기록하는 테스트용 Vault 서버입니다
+type fakeVault struct {
+	mu      sync.Mutex
+	routes  map[string]func(r *http.Request) map[string]interface{}
+	revoked []string
+}
+
+// newTestVaultClient는 fakeVault에 토큰으로 인증한 Vault 클라이언트를 생성합니다
+func newTestVaultClient(t *testing.T, fake *fakeVault) *vault.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/v1/")
+		switch {
+		case path == "auth/token/lookup-self":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"id": "test-token"}})
+			return
+		case path == "sys/leases/revoke":
+			var body struct {
+				LeaseID string `json:"lease_id"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			fake.mu.Lock()
+			fake.revoked = append(fake.revoked, body.LeaseID)
+			fake.mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		fake.mu.Lock()
+		route, ok := fake.routes[path]
+		fake.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(route(r))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := vault.DefaultConfig()
+	cfg.Address = server.URL
+	cfg.Token = "test-token"
+	client, err := vault.NewClient(cfg)
+	require.NoError(t, err)
+	return client
+}
+
+func (f *fakeVault) revokedLeases() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.revoked...)
+}
+
+func TestKafkaCredentialsManager_ReadsKVv2SecretWithTLSMaterial(t *testing.T) {
+	// Arrange
+	fake := &fakeVault{routes: map[string]func(r *http.Request) map[string]interface{}{
+		"secret/data/kafka": func(r *http.Request) map[string]interface{} {
+			return map[string]interface{}{"data": map[string]interface{}{"data": map[string]interface{}{
+				"username":    "svc-kafka",
+				"password":    "s3cret",
+				"ca_cert":     "-----BEGIN CERTIFICATE-----",
+				"client_cert": "client-cert",
+				"client_key":  "client-key",
+			}}}
+		},
+	}}
+	manager := vault.NewKafkaCredentialsManager(newTestVaultClient(t, fake), "")
+
+	// Act
+	creds, err := manager.GetCredentials(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "svc-kafka", creds.Username)
+	assert.Equal(t, "s3cret", creds.Password)
+	assert.Equal(t, []byte("-----BEGIN CERTIFICATE-----"), creds.CACert)
+	assert.Equal(t, []byte("client-cert"), creds.ClientCert)
+	assert.Equal(t, []byte("client-key"), creds.ClientKey)
+	assert.True(t, creds.ExpiresAt.IsZero(), "static secrets do not expire")
+}
+
+func TestKafkaCredentialsManager_RotatesExpiredLeaseAndRevokesPrevious(t *testing.T) {
+	// Arrange
+	var mu sync.Mutex
+	issued := 0
+	fake := &fakeVault{routes: map[string]func(r *http.Request) map[string]interface{}{
+		"kafka/creds/app": func(r *http.Request) map[string]interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			issued++
+			n := strconv.Itoa(issued)
+			return map[string]interface{}{
+				"lease_id":       "kafka/creds/app/lease-" + n,
+				"lease_duration": 1,
+				"data":           map[string]interface{}{"username": "user-" + n, "password": "pass-" + n},
+			}
+		},
+	}}
+	manager := vault.NewKafkaCredentialsManager(newTestVaultClient(t, fake), "kafka/creds/app")
+	var rotated []string
+	manager.OnRotate(func(creds *vault.KafkaCredentials) {
+		rotated = append(rotated, creds.Username)
+	})
+	first, err := manager.GetCredentials(context.Background())
+	require.NoError(t, err)
+
+	// Act - 리스가 만료된 뒤 다시 요청
+	time.Sleep(1100 * time.Millisecond)
+	second, err := manager.GetCredentials(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", first.Username)
+	assert.Equal(t, "user-2", second.Username)
+	assert.Equal(t, []string{"user-2"}, rotated)
+	assert.Equal(t, []string{"kafka/creds/app/lease-1"}, fake.revokedLeases())
+}
+
+func TestKafkaCredentialsManager_RejectsSecretWithoutPassword(t *testing.T) {
+	// Arrange
+	fake := &fakeVault{routes: map[string]func(r *http.Request) map[string]interface{}{
+		"secret/data/kafka": func(r *http.Request) map[string]interface{} {
+			return map[string]interface{}{"data": map[string]interface{}{"data": map[string]interface{}{"username": "svc-kafka"}}}
+		},
+	}}
+	manager := vault.NewKafkaCredentialsManager(newTestVaultClient(t, fake), "")
+
+	// Act
+	_, err := manager.GetCredentials(context.Background())
+
+	// Assert
+	assert.ErrorContains(t, err, "password not found")
+}
@@ -0,0 +1,110 @@
+package pkg_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// issueTestCertificate는 Vault PKI 응답 형식의 자체 서명 인증서를 생성합니다
+func issueTestCertificate(t *testing.T, commonName string, serial int64) map[string]interface{} {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return map[string]interface{}{
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"private_key": string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func TestCertificateManager_IssuesAndRotatesCertificate(t *testing.T) {
+	// Arrange
+	var mu sync.Mutex
+	var requests []map[string]interface{}
+	fake := &fakeVault{routes: map[string]func(r *http.Request) map[string]interface{}{
+		"pki/issue/database-service": func(r *http.Request) map[string]interface{} {
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, body)
+			return map[string]interface{}{"data": issueTestCertificate(t, "api.internal", int64(len(requests)))}
+		},
+	}}
+	manager := vault.NewCertificateManager(newTestVaultClient(t, fake), "pki/issue/database-service", vault.CertificateRequest{
+		CommonName: "api.internal",
+		AltNames:   []string{"api", "api.default.svc"},
+		TTL:        24 * time.Hour,
+	}, 0)
+	tlsConfig := manager.TLSConfig()
+
+	// Act
+	_, beforeErr := tlsConfig.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, manager.Issue(context.Background()))
+	first, err := tlsConfig.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.NoError(t, manager.Issue(context.Background()))
+	second, err := tlsConfig.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Error(t, beforeErr, "no certificate is served before the first issue")
+	assert.Equal(t, "api.internal", first.Leaf.Subject.CommonName)
+	assert.Equal(t, int64(1), first.Leaf.SerialNumber.Int64())
+	assert.Equal(t, int64(2), second.Leaf.SerialNumber.Int64(), "new handshakes use the rotated certificate")
+	assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+	require.Len(t, requests, 2)
+	assert.Equal(t, "api.internal", requests[0]["common_name"])
+	assert.Equal(t, "api,api.default.svc", requests[0]["alt_names"])
+	assert.Equal(t, "24h0m0s", requests[0]["ttl"])
+}
+
+func TestCertificateManager_KeepsCurrentCertificateWhenIssueFails(t *testing.T) {
+	// Arrange
+	fail := false
+	fake := &fakeVault{routes: map[string]func(r *http.Request) map[string]interface{}{
+		"pki/issue/database-service": func(r *http.Request) map[string]interface{} {
+			if fail {
+				return map[string]interface{}{"data": map[string]interface{}{"certificate": "", "private_key": ""}}
+			}
+			return map[string]interface{}{"data": issueTestCertificate(t, "api.internal", 7)}
+		},
+	}}
+	manager := vault.NewCertificateManager(newTestVaultClient(t, fake), "pki/issue/database-service", vault.CertificateRequest{CommonName: "api.internal"}, 0)
+	require.NoError(t, manager.Issue(context.Background()))
+
+	// Act
+	fail = true
+	err := manager.Issue(context.Background())
+
+	// Assert
+	assert.ErrorContains(t, err, "certificate or private_key not found")
+	cert, getErr := manager.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, getErr)
+	assert.Equal(t, int64(7), cert.Leaf.SerialNumber.Int64())
+}
@@ -0,0 +1,96 @@
+package pkg_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/pkg/vault"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newIssuingVault는 읽을 때마다 새 사용자와 리스를 발급하는 동적 자격증명 경로를 가진 fakeVault를 생성합니다
+func newIssuingVault(path string, leaseSeconds int) *fakeVault {
+	var mu sync.Mutex
+	issued := 0
+	return &fakeVault{routes: map[string]func(r *http.Request) map[string]interface{}{
+		path: func(r *http.Request) map[string]interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			issued++
+			n := strconv.Itoa(issued)
+			return map[string]interface{}{
+				"lease_id":       path + "/lease-" + n,
+				"lease_duration": leaseSeconds,
+				"renewable":      true,
+				"data":           map[string]interface{}{"username": "v-app-" + n, "password": "pw-" + n},
+			}
+		},
+	}}
+}
+
+func TestSQLCredentialsManager_IssuesAndCachesCredentials(t *testing.T) {
+	// Arrange
+	fake := newIssuingVault("database/creds/postgresql", 3600)
+	manager := vault.NewSQLCredentialsManager(newTestVaultClient(t, fake), "postgresql", "database/creds/postgresql")
+
+	// Act
+	first, err := manager.GetCredentials(context.Background())
+	require.NoError(t, err)
+	second, err := manager.GetCredentials(context.Background())
+	require.NoError(t, err)
+	username, password := manager.Current()
+
+	// Assert
+	assert.Equal(t, "v-app-1", first.Username)
+	assert.Same(t, first, second, "unexpired credentials are reused")
+	assert.Equal(t, "v-app-1", username)
+	assert.Equal(t, "pw-1", password)
+	assert.True(t, first.RenewAt.Before(first.ExpiresAt))
+}
+
+func TestSQLCredentialsManager_RotatesExpiredCredentialsWithoutRevokingOld(t *testing.T) {
+	// Arrange
+	fake := newIssuingVault("database/creds/mysql", 1)
+	manager := vault.NewSQLCredentialsManager(newTestVaultClient(t, fake), "mysql", "database/creds/mysql")
+	var rotated []string
+	manager.OnRotate(func(creds *vault.DatabaseCredentials) {
+		rotated = append(rotated, creds.Username)
+	})
+	_, err := manager.GetCredentials(context.Background())
+	require.NoError(t, err)
+
+	// Act - 리스가 만료된 뒤 다시 요청
+	time.Sleep(1100 * time.Millisecond)
+	creds, err := manager.GetCredentials(context.Background())
+
+	// Assert - 이전 자격증명은 사용 중인 연결을 위해 취소하지 않습니다
+	require.NoError(t, err)
+	assert.Equal(t, "v-app-2", creds.Username)
+	assert.Equal(t, []string{"v-app-2"}, rotated)
+	assert.Empty(t, fake.revokedLeases())
+	username, _ := manager.Current()
+	assert.Equal(t, "v-app-2", username)
+}
+
+func TestSQLCredentialsManager_CloseRevokesCurrentLease(t *testing.T) {
+	// Arrange
+	fake := newIssuingVault("database/creds/postgresql", 3600)
+	manager := vault.NewSQLCredentialsManager(newTestVaultClient(t, fake), "postgresql", "database/creds/postgresql")
+	_, err := manager.GetCredentials(context.Background())
+	require.NoError(t, err)
+
+	// Act
+	err = manager.Close(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"database/creds/postgresql/lease-1"}, fake.revokedLeases())
+	username, password := manager.Current()
+	assert.Empty(t, username)
+	assert.Empty(t, password)
+}
@@ -0,0 +1,147 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/YouSangSon/database-service/internal/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAuditRepository는 감사 기록과 마지막 조회 조건을 보관하는 테스트용 AuditRepository입니다
+type memoryAuditRepository struct {
+	mu        sync.Mutex
+	entries   []*entity.AuditEntry
+	lastQuery *repository.AuditQuery
+	appendErr error
+}
+
+func (r *memoryAuditRepository) Append(ctx context.Context, entry *entity.AuditEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.appendErr != nil {
+		return r.appendErr
+	}
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *memoryAuditRepository) Query(ctx context.Context, query *repository.AuditQuery) ([]*entity.AuditEntry, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastQuery = query
+	return r.entries, int64(len(r.entries)), nil
+}
+
+func (r *memoryAuditRepository) EnsureIndexes(ctx context.Context, retention time.Duration) error {
+	return nil
+}
+
+func TestAudit_UpdateRecordsBeforeAfterAndActor(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	audit := &memoryAuditRepository{}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetAuditRepository(audit)
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"age": 30}, 1, time.Now(), time.Now()))
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "u-1", Username: "alice", TenantID: "t-1"})
+
+	// Act
+	err := uc.UpdateDocument(ctx, &dto.UpdateDocumentRequest{
+		Collection: "users",
+		ID:         "1",
+		Data:       map[string]interface{}{"age": 31},
+		Version:    1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, audit.entries, 1)
+	entry := audit.entries[0]
+	assert.Equal(t, entity.AuditOpUpdate, entry.Operation)
+	assert.Equal(t, "users", entry.Collection)
+	assert.Equal(t, "1", entry.DocumentID)
+	assert.Equal(t, 30, entry.Before["age"])
+	assert.Equal(t, 1, entry.BeforeVersion)
+	assert.Equal(t, 31, entry.After["age"])
+	assert.Equal(t, 2, entry.AfterVersion)
+	assert.Equal(t, "u-1", entry.Actor)
+	assert.Equal(t, "alice", entry.ActorName)
+	assert.Equal(t, "t-1", entry.TenantID)
+	assert.True(t, entry.Success)
+	assert.False(t, entry.Timestamp.IsZero())
+}
+
+func TestAudit_FailedWriteIsRecordedAsFailure(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	repo.saveErr = errors.New("database connection failed")
+	audit := &memoryAuditRepository{}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetAuditRepository(audit)
+
+	// Act
+	_, err := uc.CreateDocument(context.Background(), &dto.CreateDocumentRequest{
+		Collection: "users",
+		Data:       map[string]interface{}{"name": "John"},
+	})
+
+	// Assert
+	require.Error(t, err)
+	require.Len(t, audit.entries, 1)
+	assert.Equal(t, entity.AuditOpCreate, audit.entries[0].Operation)
+	assert.False(t, audit.entries[0].Success)
+	assert.Contains(t, audit.entries[0].Error, "database connection failed")
+}
+
+func TestAudit_AppendFailureDoesNotFailOperation(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetAuditRepository(&memoryAuditRepository{appendErr: errors.New("audit store unavailable")})
+
+	// Act
+	resp, err := uc.CreateDocument(context.Background(), &dto.CreateDocumentRequest{
+		Collection: "users",
+		Data:       map[string]interface{}{"name": "John"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.ID)
+}
+
+func TestAuditUseCase_QueryClampsPageSize(t *testing.T) {
+	// Arrange
+	audit := &memoryAuditRepository{entries: []*entity.AuditEntry{
+		{ID: "a-1", Operation: entity.AuditOpDelete, Collection: "users", DocumentID: "1", Success: true},
+	}}
+	uc := usecase.NewAuditUseCase(audit)
+
+	// Act
+	resp, err := uc.QueryAuditLog(context.Background(), &dto.AuditLogQueryRequest{
+		Collection: "users",
+		Operation:  "delete",
+		Page:       3,
+		PageSize:   10000,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 500, resp.PageSize)
+	assert.Equal(t, 3, resp.Page)
+	assert.Equal(t, int64(1), resp.TotalCount)
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, "delete", resp.Entries[0].Operation)
+	assert.Equal(t, int64(500), audit.lastQuery.Limit)
+	assert.Equal(t, int64(1000), audit.lastQuery.Skip)
+	assert.Equal(t, entity.AuditOpDelete, audit.lastQuery.Operation)
+}
@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchRecordingRepository는 BulkWrite로 받은 작업 묶음을 기록하는 테스트용 저장소입니다
+// failing ID가 든 묶음은 통째로 실패하고, conflict ID인 작업은 개별 실패로 보고합니다
+type batchRecordingRepository struct {
+	repository.DocumentRepository
+
+	mu       sync.Mutex
+	batches  [][]string
+	failing  string
+	conflict string
+}
+
+// bulkLabel은 작업을 "type:id" 형태로 나타냅니다
+func bulkLabel(op *repository.BulkOperation) string {
+	return fmt.Sprintf("%s:%v", op.Type, op.Filter["id"])
+}
+
+func (r *batchRecordingRepository) BulkWrite(ctx context.Context, operations []*repository.BulkOperation) (*repository.BulkResult, error) {
+	labels := make([]string, len(operations))
+	for i, op := range operations {
+		labels[i] = bulkLabel(op)
+	}
+	r.mu.Lock()
+	r.batches = append(r.batches, labels)
+	r.mu.Unlock()
+
+	result := &repository.BulkResult{UpsertedIDs: map[int]interface{}{}}
+	for i, op := range operations {
+		id, _ := op.Filter["id"].(string)
+		switch {
+		case r.failing != "" && id == r.failing:
+			return nil, errors.New("partition unavailable")
+		case r.conflict != "" && id == r.conflict:
+			result.Errors = append(result.Errors, repository.BulkWriteError{Index: i, ID: id, Reason: "version conflict"})
+		case op.Type == "update":
+			result.ModifiedCount++
+		case op.Type == "delete":
+			result.DeletedCount++
+		}
+	}
+	return result, nil
+}
+
+// batchContaining은 label이 든 기록된 묶음을 반환합니다
+func (r *batchRecordingRepository) batchContaining(label string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, batch := range r.batches {
+		for _, l := range batch {
+			if l == label {
+				return batch
+			}
+		}
+	}
+	return nil
+}
+
+// updateThenDelete는 각 문서를 갱신한 뒤 삭제하는 작업 목록을 생성합니다
+func updateThenDelete(ids ...string) []dto.BulkOperation {
+	var ops []dto.BulkOperation
+	for _, id := range ids {
+		ops = append(ops, dto.BulkOperation{Type: "update", Collection: "users", ID: id, Update: map[string]interface{}{"active": false}})
+	}
+	for _, id := range ids {
+		ops = append(ops, dto.BulkOperation{Type: "delete", Collection: "users", ID: id})
+	}
+	return ops
+}
+
+func TestBulkWrite_ParallelPartitionsKeepPerDocumentOrder(t *testing.T) {
+	// Arrange
+	repo := &batchRecordingRepository{}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetBulkWriteParallelism(usecase.BulkWriteParallelism{Workers: 4, MinOperations: 2})
+	ids := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	// Act
+	resp, err := uc.BulkWrite(context.Background(), &dto.BulkWriteRequest{Operations: updateThenDelete(ids...)})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), resp.ModifiedCount)
+	assert.Equal(t, int64(8), resp.DeletedCount)
+	assert.Greater(t, len(repo.batches), 1, "operations are split into several partitions")
+	for _, id := range ids {
+		batch := repo.batchContaining("update:" + id)
+		require.NotNil(t, batch)
+		updateAt, deleteAt := -1, -1
+		for i, label := range batch {
+			switch label {
+			case "update:" + id:
+				updateAt = i
+			case "delete:" + id:
+				deleteAt = i
+			}
+		}
+		require.NotEqual(t, -1, deleteAt, "operations on %s run in the same partition", id)
+		assert.Less(t, updateAt, deleteAt, "operations on %s keep the request order", id)
+	}
+}
+
+func TestBulkWrite_ParallelReportsFailuresAtRequestIndexes(t *testing.T) {
+	// Arrange
+	repo := &batchRecordingRepository{failing: "b", conflict: "g"}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetBulkWriteParallelism(usecase.BulkWriteParallelism{Workers: 4, MinOperations: 2})
+	ops := updateThenDelete("a", "b", "c", "d", "e", "f", "g", "h")
+
+	// Act
+	resp, err := uc.BulkWrite(context.Background(), &dto.BulkWriteRequest{Operations: ops})
+
+	// Assert
+	require.NoError(t, err, "a failed partition does not fail the whole request")
+	require.Contains(t, repo.batchContaining("update:b"), "delete:b")
+	require.NotEmpty(t, resp.Errors)
+	reasons := map[int]string{}
+	for i, e := range resp.Errors {
+		if i > 0 {
+			assert.Less(t, resp.Errors[i-1].Index, e.Index, "errors are sorted by request index")
+		}
+		assert.Equal(t, ops[e.Index].ID, e.ID, "error index %d points at the failed operation", e.Index)
+		reasons[e.Index] = e.Reason
+	}
+	assert.Contains(t, reasons[1], "partition unavailable")
+	assert.Contains(t, reasons[9], "partition unavailable")
+	assert.Contains(t, reasons, 6, "per-operation errors are remapped to the request index")
+	assert.Contains(t, reasons, 14)
+	assert.Equal(t, int64(16-len(resp.Errors)), resp.ModifiedCount+resp.DeletedCount, "operations of the other partitions are applied")
+}
+
+func TestBulkWrite_ParallelFailsWhenEveryPartitionFails(t *testing.T) {
+	// Arrange
+	repo := &batchRecordingRepository{failing: "a"}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetBulkWriteParallelism(usecase.BulkWriteParallelism{Workers: 4, MinOperations: 2})
+	ops := []dto.BulkOperation{
+		{Type: "update", Collection: "users", Filter: map[string]interface{}{"active": true}, Update: map[string]interface{}{"active": false}},
+		{Type: "delete", Collection: "users", ID: "a"},
+	}
+
+	// Act
+	_, err := uc.BulkWrite(context.Background(), &dto.BulkWriteRequest{Operations: ops})
+
+	// Assert
+	assert.ErrorContains(t, err, "partition unavailable")
+	assert.Len(t, repo.batches, 1, "a collection with a filter-only operation stays in one partition")
+}
+
+func TestBulkWrite_RunsSequentiallyBelowMinOperations(t *testing.T) {
+	// Arrange
+	repo := &batchRecordingRepository{}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetBulkWriteParallelism(usecase.BulkWriteParallelism{Workers: 4, MinOperations: 100})
+
+	// Act
+	resp, err := uc.BulkWrite(context.Background(), &dto.BulkWriteRequest{Operations: updateThenDelete("a", "b", "c", "d")})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), resp.DeletedCount)
+	require.Len(t, repo.batches, 1)
+	assert.Equal(t, []string{"update:a", "update:b", "update:c", "update:d", "delete:a", "delete:b", "delete:c", "delete:d"}, repo.batches[0])
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidateCachedDocument_NextReadLoadsChangeFromAnotherInstance(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+
+	// 다른 인스턴스가 문서를 변경함
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "Jane"}, 2, time.Now(), time.Now()))
+	stale, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+
+	// Act
+	uc.InvalidateCachedDocument(ctx, "users", "1")
+	fresh, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "John", stale.Data["name"], "the cached value is served until invalidated")
+	assert.Equal(t, "Jane", fresh.Data["name"])
+	assert.Equal(t, 2, fresh.Version)
+	assert.Equal(t, int32(2), repo.findCalls.Load())
+}
+
+func TestInvalidateCachedDocument_IgnoresIncompleteEvents(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+
+	// Act
+	uc.InvalidateCachedDocument(ctx, "users", "")
+	uc.InvalidateCachedDocument(ctx, "", "1")
+	_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.findCalls.Load(), "the cache entry is kept")
+}
@@ -0,0 +1,74 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingSetCache는 쓰기가 항상 실패하는 jsonCache입니다
+type failingSetCache struct {
+	*jsonCache
+}
+
+func (c *failingSetCache) Set(ctx context.Context, key string, value interface{}, ttl int) error {
+	return errors.New("cache unavailable")
+}
+
+// observationCount는 히스토그램의 레이블별 관측 횟수를 반환합니다
+func observationCount(t *testing.T, vec *prometheus.HistogramVec, labels ...string) uint64 {
+	t.Helper()
+	var metric io_prometheus_client.Metric
+	require.NoError(t, vec.WithLabelValues(labels...).(prometheus.Histogram).Write(&metric))
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestGetDocument_RecordsCacheHitAndMissPerCollection(t *testing.T) {
+	// Arrange - 메트릭은 프로세스 전역이므로 이 테스트만 쓰는 컬렉션 이름을 사용합니다
+	m := metrics.GetMetrics()
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "metrics_hits", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+
+	// Act
+	for i := 0; i < 3; i++ {
+		_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "metrics_hits", ID: "1"})
+		require.NoError(t, err)
+	}
+
+	// Assert
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.CacheMissesTotal.WithLabelValues("document", "metrics_hits")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.CacheHitsTotal.WithLabelValues("document", "metrics_hits")))
+	assert.Equal(t, uint64(3), observationCount(t, m.CacheOperationDuration, "document", "metrics_hits", "get"))
+	assert.Equal(t, uint64(1), observationCount(t, m.CacheOperationDuration, "document", "metrics_hits", "set"))
+}
+
+func TestGetDocument_RecordsCacheSetErrors(t *testing.T) {
+	// Arrange
+	m := metrics.GetMetrics()
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, &failingSetCache{jsonCache: newJSONCache()})
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "metrics_errors", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+
+	// Act
+	resp, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "metrics_errors", ID: "1"})
+
+	// Assert - 캐시 쓰기 실패는 요청을 실패시키지 않고 메트릭으로만 드러납니다
+	require.NoError(t, err)
+	assert.Equal(t, "John", resp.Data["name"])
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.CacheErrorsTotal.WithLabelValues("document", "metrics_errors", "set")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.CacheErrorsTotal.WithLabelValues("document", "metrics_errors", "get")), "a miss is not an error")
+}
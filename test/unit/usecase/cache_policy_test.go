@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachePolicies_MatchesPatternsAndInheritsDefaults(t *testing.T) {
+	// Arrange
+	policies, err := usecase.NewCachePolicies(usecase.CachePolicy{
+		Collection:      "*",
+		TTL:             5 * time.Minute,
+		MaxDocumentSize: 4096,
+	}, []usecase.CachePolicy{
+		{Collection: "audit_*", Strategy: usecase.CacheNone},
+		{Collection: "sessions", Strategy: usecase.CacheWriteThrough, TTL: 30 * time.Second, MaxDocumentSize: 512},
+	})
+	require.NoError(t, err)
+
+	// Act
+	audit := policies.For("audit_logs")
+	sessions := policies.For("sessions")
+	users := policies.For("users")
+
+	// Assert
+	assert.Equal(t, usecase.CacheNone, audit.Strategy)
+	assert.Equal(t, 5*time.Minute, audit.TTL)
+	assert.Equal(t, 4096, audit.MaxDocumentSize)
+	assert.Equal(t, usecase.CacheWriteThrough, sessions.Strategy)
+	assert.Equal(t, 30*time.Second, sessions.TTL)
+	assert.Equal(t, 512, sessions.MaxDocumentSize)
+	assert.Equal(t, usecase.CacheReadThrough, users.Strategy, "the default strategy is read-through")
+}
+
+func TestCachePolicies_RejectsInvalidPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy usecase.CachePolicy
+	}{
+		{name: "missing collection", policy: usecase.CachePolicy{Strategy: usecase.CacheReadThrough}},
+		{name: "invalid pattern", policy: usecase.CachePolicy{Collection: "users[", Strategy: usecase.CacheReadThrough}},
+		{name: "unknown strategy", policy: usecase.CachePolicy{Collection: "users", Strategy: "refresh_ahead"}},
+		{name: "negative size", policy: usecase.CachePolicy{Collection: "users", Strategy: usecase.CacheReadThrough, MaxDocumentSize: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := usecase.NewCachePolicies(usecase.CachePolicy{Collection: "*"}, []usecase.CachePolicy{tt.policy})
+
+			// Assert
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCachePolicies_PutTakesPrecedenceAndRemoveRestoresDefault(t *testing.T) {
+	// Arrange
+	policies, err := usecase.NewCachePolicies(usecase.CachePolicy{Collection: "*"}, []usecase.CachePolicy{
+		{Collection: "user*", Strategy: usecase.CacheWriteThrough},
+	})
+	require.NoError(t, err)
+
+	// Act
+	_, putErr := policies.Put(usecase.CachePolicy{Collection: "users", Strategy: usecase.CacheNone})
+	afterPut := policies.For("users")
+	removed := policies.Remove("users")
+	afterRemove := policies.For("users")
+
+	// Assert
+	require.NoError(t, putErr)
+	assert.Equal(t, usecase.CacheNone, afterPut.Strategy, "a new policy is checked before existing patterns")
+	assert.True(t, removed)
+	assert.Equal(t, usecase.CacheWriteThrough, afterRemove.Strategy)
+	assert.False(t, policies.Remove("users"))
+}
+
+func TestGetDocument_BypassesCacheForDisabledCollection(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	policies, err := usecase.NewCachePolicies(usecase.CachePolicy{Collection: "*"}, []usecase.CachePolicy{
+		{Collection: "audit_logs", Strategy: usecase.CacheNone},
+	})
+	require.NoError(t, err)
+	uc.SetCachePolicies(policies)
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "audit_logs", map[string]interface{}{"action": "login"}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+
+	// Act
+	for i := 0; i < 2; i++ {
+		_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "audit_logs", ID: "1"})
+		require.NoError(t, err)
+		_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+		require.NoError(t, err)
+	}
+
+	// Assert - audit_logs는 두 번 모두 DB에서, users는 처음 한 번만 DB에서 읽습니다
+	assert.Equal(t, int32(3), repo.findCalls.Load())
+}
+
+func TestGetDocument_SkipsCachingDocumentsOverMaxSize(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	policies, err := usecase.NewCachePolicies(usecase.CachePolicy{Collection: "*", MaxDocumentSize: 256}, nil)
+	require.NoError(t, err)
+	uc.SetCachePolicies(policies)
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("big", "files", map[string]interface{}{"content": strings.Repeat("x", 1024)}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("small", "files", map[string]interface{}{"content": "x"}, 1, time.Now(), time.Now()))
+
+	// Act
+	for i := 0; i < 2; i++ {
+		_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "files", ID: "big"})
+		require.NoError(t, err)
+		_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "files", ID: "small"})
+		require.NoError(t, err)
+	}
+
+	// Assert
+	assert.Equal(t, int32(3), repo.findCalls.Load(), "only the small document is served from the cache")
+}
@@ -0,0 +1,81 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmCache_PreloadsMostRecentlyUpdatedDocuments(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	cache := newJSONCache()
+	uc := usecase.NewDocumentUseCase(repo, cache)
+	ctx := context.Background()
+	base := time.Now().Add(-time.Hour)
+	for i, id := range []string{"old", "newer", "newest"} {
+		updated := base.Add(time.Duration(i) * time.Minute)
+		repo.put(entity.ReconstructDocument(id, "products", map[string]interface{}{"sku": id}, 1, updated, updated))
+	}
+
+	// Act
+	warmed, err := uc.WarmCache(ctx, usecase.CacheWarmupTarget{Collection: "products", Limit: 2})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, warmed)
+	for _, id := range []string{"newest", "newer"} {
+		resp, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: id})
+		require.NoError(t, err)
+		assert.Equal(t, id, resp.Data["sku"])
+	}
+	assert.Equal(t, int32(0), repo.findCalls.Load(), "warmed documents are served from the cache")
+	_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: "old"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.findCalls.Load())
+}
+
+func TestWarmCache_LoadsExplicitIDsAndSkipsMissing(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+
+	// Act
+	warmed, err := uc.WarmCache(ctx, usecase.CacheWarmupTarget{Collection: "users", IDs: []string{"1", "missing"}})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, warmed)
+	resp, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "John", resp.Data["name"])
+	assert.Equal(t, int32(2), repo.findCalls.Load(), "only the warmup lookups reach the repository")
+}
+
+func TestWarmCache_SkipsCollectionsWithCachingDisabled(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	policies, err := usecase.NewCachePolicies(usecase.CachePolicy{Collection: "*"}, []usecase.CachePolicy{
+		{Collection: "audit_logs", Strategy: usecase.CacheNone},
+	})
+	require.NoError(t, err)
+	uc.SetCachePolicies(policies)
+	repo.put(entity.ReconstructDocument("1", "audit_logs", map[string]interface{}{"action": "login"}, 1, time.Now(), time.Now()))
+
+	// Act
+	warmed, err := uc.WarmCache(context.Background(), usecase.CacheWarmupTarget{Collection: "audit_logs", Limit: 10})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, warmed)
+	assert.Zero(t, repo.queryCalls.Load())
+}
@@ -0,0 +1,68 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ttlCache는 모든 항목의 남은 TTL을 고정값으로 보고하는 jsonCache입니다 (repository.CacheTTLReader)
+type ttlCache struct {
+	*jsonCache
+	remaining time.Duration
+}
+
+func (c *ttlCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.remaining, nil
+}
+
+func TestGetDocument_RefreshesExpiringHotKeyEarly(t *testing.T) {
+	// Arrange - 남은 TTL이 최근 조회 시간보다 훨씬 짧아 항상 조기 갱신 대상이 됩니다
+	repo := newMemoryDocumentRepository()
+	repo.findDelay = 5 * time.Millisecond
+	uc := usecase.NewDocumentUseCase(repo, &ttlCache{jsonCache: newJSONCache(), remaining: time.Nanosecond})
+	uc.SetEarlyRefresh(1000)
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "Jane"}, 2, time.Now(), time.Now()))
+
+	// Act
+	served, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+
+	// Assert - 캐시 값을 즉시 반환하고 백그라운드에서 새 값으로 갱신합니다
+	require.NoError(t, err)
+	assert.Equal(t, "John", served.Data["name"])
+	assert.Eventually(t, func() bool {
+		return repo.findCalls.Load() >= 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		resp, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+		return err == nil && resp.Data["name"] == "Jane"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGetDocument_NoEarlyRefreshWhenDisabled(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, &ttlCache{jsonCache: newJSONCache(), remaining: time.Nanosecond})
+	ctx := context.Background()
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+
+	// Act
+	_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	time.Sleep(20 * time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.findCalls.Load())
+}
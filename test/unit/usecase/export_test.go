@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingIterator는 문서 목록을 돌려준 뒤 커서 에러로 끝나는 테스트용 DocumentIterator입니다
+type failingIterator struct {
+	repository.DocumentIterator
+	err    error
+	closed bool
+}
+
+func (it *failingIterator) Err() error {
+	return it.err
+}
+
+func (it *failingIterator) Close(ctx context.Context) error {
+	it.closed = true
+	return nil
+}
+
+// failingStreamRepository는 FindStream이 failingIterator를 반환하는 테스트용 저장소입니다
+type failingStreamRepository struct {
+	*memoryDocumentRepository
+	it *failingIterator
+}
+
+func (r *failingStreamRepository) FindStream(ctx context.Context, collection string, filter map[string]interface{}, opts *repository.FindOptions) (repository.DocumentIterator, error) {
+	r.it.DocumentIterator = repository.NewSliceIterator(r.list(collection, filter))
+	return r.it, nil
+}
+
+func TestExportDocuments_EmitsMatchingDocumentsOneByOne(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetSoftDeletePolicies([]usecase.SoftDeletePolicy{{Collection: "users"}})
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"team": "a"}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("2", "users", map[string]interface{}{"team": "b"}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("3", "users", map[string]interface{}{"team": "a"}, 3, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("4", "users", map[string]interface{}{
+		"team":                "a",
+		entity.DeletedAtField: time.Now().UTC().Format(time.RFC3339Nano),
+	}, 2, time.Now(), time.Now()))
+	var emitted []*dto.GetDocumentResponse
+
+	// Act
+	count, err := uc.ExportDocuments(context.Background(), &dto.ExportDocumentsRequest{
+		Collection: "users",
+		Filter:     map[string]interface{}{"team": "a"},
+	}, func(doc *dto.GetDocumentResponse) error {
+		emitted = append(emitted, doc)
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count, "soft-deleted documents are skipped")
+	require.Len(t, emitted, 2)
+	assert.Equal(t, "1", emitted[0].ID)
+	assert.Equal(t, "3", emitted[1].ID)
+	assert.Equal(t, 3, emitted[1].Version)
+	assert.Equal(t, int32(0), repo.queryCalls.Load(), "the export does not load the result set")
+}
+
+func TestExportDocuments_StopsWhenEmitFails(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	for _, id := range []string{"1", "2", "3"} {
+		repo.put(entity.ReconstructDocument(id, "users", map[string]interface{}{}, 1, time.Now(), time.Now()))
+	}
+	errGone := errors.New("client disconnected")
+	calls := 0
+
+	// Act
+	count, err := uc.ExportDocuments(context.Background(), &dto.ExportDocumentsRequest{Collection: "users"}, func(doc *dto.GetDocumentResponse) error {
+		if calls++; calls == 2 {
+			return errGone
+		}
+		return nil
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errGone)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, 2, calls, "no document is read after the client goes away")
+}
+
+func TestExportDocuments_ReportsCursorErrorAndClosesCursor(t *testing.T) {
+	// Arrange
+	errCursor := errors.New("cursor killed")
+	repo := &failingStreamRepository{memoryDocumentRepository: newMemoryDocumentRepository(), it: &failingIterator{err: errCursor}}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{}, 1, time.Now(), time.Now()))
+
+	// Act
+	count, err := uc.ExportDocuments(context.Background(), &dto.ExportDocumentsRequest{Collection: "users"}, func(doc *dto.GetDocumentResponse) error {
+		return nil
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errCursor)
+	assert.ErrorContains(t, err, "failed to export documents")
+	assert.Equal(t, int64(1), count, "documents read before the failure are counted")
+	assert.True(t, repo.it.closed)
+}
@@ -0,0 +1,68 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDocument_CachesNotFoundResult(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetNegativeCacheTTL(30 * time.Second)
+	ctx := context.Background()
+
+	// Act
+	_, firstErr := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "doc-1"})
+	_, secondErr := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "doc-1"})
+
+	// Assert
+	assert.ErrorIs(t, firstErr, entity.ErrDocumentNotFound)
+	assert.ErrorIs(t, secondErr, entity.ErrDocumentNotFound)
+	assert.Equal(t, int32(1), repo.findCalls.Load(), "the repeated lookup is answered from the cache")
+}
+
+func TestGetDocument_CreatePurgesNotFoundEntry(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetNegativeCacheTTL(30 * time.Second)
+	ctx := context.Background()
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "doc-1"})
+	require.ErrorIs(t, err, entity.ErrDocumentNotFound)
+
+	// Act - 메모리 저장소는 첫 문서에 doc-1을 부여합니다
+	created, err := uc.CreateDocument(ctx, &dto.CreateDocumentRequest{
+		Collection: "users",
+		Data:       map[string]interface{}{"name": "John"},
+	})
+	require.NoError(t, err)
+	resp, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: created.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "doc-1", created.ID)
+	assert.Equal(t, "John", resp.Data["name"])
+}
+
+func TestGetDocument_NegativeCachingDisabledByDefault(t *testing.T) {
+	// Arrange
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	ctx := context.Background()
+
+	// Act
+	_, _ = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "missing"})
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "missing"})
+
+	// Assert
+	assert.ErrorIs(t, err, entity.ErrDocumentNotFound)
+	assert.Equal(t, int32(2), repo.findCalls.Load())
+}
@@ -0,0 +1,116 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/YouSangSon/database-service/internal/interfaces/http/middleware"
+	"github.com/YouSangSon/database-service/internal/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadlineRecordingRepository는 저장소 호출에 전달된 context의 deadline을 기록하는 테스트용 저장소입니다
+// blockSave가 true이면 Save는 context가 끝날 때까지 기다립니다
+type deadlineRecordingRepository struct {
+	*memoryDocumentRepository
+
+	mu        sync.Mutex
+	deadlines []time.Time
+	blockSave bool
+}
+
+func (r *deadlineRecordingRepository) record(ctx context.Context) {
+	deadline, _ := ctx.Deadline()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deadlines = append(r.deadlines, deadline)
+}
+
+func (r *deadlineRecordingRepository) Save(ctx context.Context, doc *entity.Document) error {
+	r.record(ctx)
+	if r.blockSave {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return r.memoryDocumentRepository.Save(ctx, doc)
+}
+
+func (r *deadlineRecordingRepository) FindByID(ctx context.Context, collection, id string) (*entity.Document, error) {
+	r.record(ctx)
+	return r.memoryDocumentRepository.FindByID(ctx, collection, id)
+}
+
+func TestCreateDocument_WriteTimeoutAbortsSlowSave(t *testing.T) {
+	// Arrange
+	repo := &deadlineRecordingRepository{memoryDocumentRepository: newMemoryDocumentRepository(), blockSave: true}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetOperationTimeouts(usecase.OperationTimeouts{Write: 20 * time.Millisecond})
+	ctx := context.Background()
+	timeouts := metrics.GetMetrics().OperationTimeoutsTotal.WithLabelValues(string(middleware.GetDatabaseType(ctx)), "write")
+	before := testutil.ToFloat64(timeouts)
+
+	// Act
+	started := time.Now()
+	_, err := uc.CreateDocument(ctx, &dto.CreateDocumentRequest{Collection: "users", Data: map[string]interface{}{"name": "John"}})
+
+	// Assert
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(started), time.Second, "the slow write is abandoned at the limit")
+	assert.Equal(t, before+1, testutil.ToFloat64(timeouts))
+}
+
+func TestCreateDocument_KeepsShorterRequestDeadline(t *testing.T) {
+	// Arrange
+	repo := &deadlineRecordingRepository{memoryDocumentRepository: newMemoryDocumentRepository()}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetOperationTimeouts(usecase.OperationTimeouts{Write: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	requestDeadline, _ := ctx.Deadline()
+
+	// Act
+	_, err := uc.CreateDocument(ctx, &dto.CreateDocumentRequest{Collection: "users", Data: map[string]interface{}{"name": "John"}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, repo.deadlines, 1)
+	assert.Equal(t, requestDeadline, repo.deadlines[0])
+}
+
+func TestGetDocument_ReadTimeoutBoundsRepositoryCall(t *testing.T) {
+	// Arrange
+	repo := &deadlineRecordingRepository{memoryDocumentRepository: newMemoryDocumentRepository()}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetOperationTimeouts(usecase.OperationTimeouts{Read: 2 * time.Second, Write: time.Hour})
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+
+	// Act
+	started := time.Now()
+	_, err := uc.GetDocument(context.Background(), &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, repo.deadlines, 1)
+	assert.WithinDuration(t, started.Add(2*time.Second), repo.deadlines[0], time.Second, "reads use the read limit, not the write limit")
+}
+
+func TestCreateDocument_NoDeadlineWithoutTimeouts(t *testing.T) {
+	// Arrange
+	repo := &deadlineRecordingRepository{memoryDocumentRepository: newMemoryDocumentRepository()}
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+
+	// Act
+	_, err := uc.CreateDocument(context.Background(), &dto.CreateDocumentRequest{Collection: "users", Data: map[string]interface{}{"name": "John"}})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, repo.deadlines, 1)
+	assert.True(t, repo.deadlines[0].IsZero())
+}
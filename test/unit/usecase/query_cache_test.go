@@ -0,0 +1,93 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newQueryCacheUseCase(t *testing.T) (*usecase.DocumentUseCase, *memoryDocumentRepository) {
+	t.Helper()
+	repo := newMemoryDocumentRepository()
+	repo.put(entity.ReconstructDocument("1", "orders", map[string]interface{}{"status": "open"}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("2", "orders", map[string]interface{}{"status": "open"}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("3", "orders", map[string]interface{}{"status": "closed"}, 1, time.Now(), time.Now()))
+	uc := usecase.NewDocumentUseCase(repo, newJSONCache())
+	uc.SetQueryCacheTTL(30 * time.Second)
+	return uc, repo
+}
+
+func TestCountDocuments_CachesResultPerFilter(t *testing.T) {
+	// Arrange
+	uc, repo := newQueryCacheUseCase(t)
+	ctx := context.Background()
+	open := &dto.CountDocumentsRequest{Collection: "orders", Filter: map[string]interface{}{"status": "open"}}
+	closed := &dto.CountDocumentsRequest{Collection: "orders", Filter: map[string]interface{}{"status": "closed"}}
+
+	// Act
+	first, err := uc.CountDocuments(ctx, open)
+	require.NoError(t, err)
+	second, err := uc.CountDocuments(ctx, open)
+	require.NoError(t, err)
+	other, err := uc.CountDocuments(ctx, closed)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(2), first.Count)
+	assert.Equal(t, int64(2), second.Count)
+	assert.Equal(t, int64(1), other.Count, "a different filter is cached under a different key")
+	assert.Equal(t, int32(2), repo.queryCalls.Load())
+}
+
+func TestSearchDocuments_CachesResultUntilCollectionChanges(t *testing.T) {
+	// Arrange
+	uc, repo := newQueryCacheUseCase(t)
+	ctx := context.Background()
+	req := &dto.SearchDocumentsRequest{Collection: "orders", Filter: map[string]interface{}{"status": "open"}, Limit: 10}
+	first, err := uc.SearchDocuments(ctx, req)
+	require.NoError(t, err)
+	cached, err := uc.SearchDocuments(ctx, req)
+	require.NoError(t, err)
+	callsBeforeWrite := repo.queryCalls.Load()
+
+	// Act
+	_, err = uc.CreateDocument(ctx, &dto.CreateDocumentRequest{Collection: "orders", Data: map[string]interface{}{"status": "open"}})
+	require.NoError(t, err)
+	fresh, err := uc.SearchDocuments(ctx, req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, first.Total, cached.Total)
+	require.Len(t, cached.Documents, 2)
+	assert.Equal(t, "open", cached.Documents[1].Data["status"])
+	assert.Equal(t, int32(2), callsBeforeWrite, "the cached search runs find and count only once")
+	assert.Len(t, fresh.Documents, 3)
+	assert.Equal(t, int64(3), fresh.Total)
+}
+
+func TestCountDocuments_ChangeEventInvalidatesCachedResult(t *testing.T) {
+	// Arrange - 다른 인스턴스가 문서를 추가한 뒤 CDC 이벤트가 도착하는 상황
+	uc, repo := newQueryCacheUseCase(t)
+	ctx := context.Background()
+	req := &dto.CountDocumentsRequest{Collection: "orders"}
+	_, err := uc.CountDocuments(ctx, req)
+	require.NoError(t, err)
+	repo.put(entity.ReconstructDocument("4", "orders", map[string]interface{}{"status": "open"}, 1, time.Now(), time.Now()))
+
+	// Act
+	stale, err := uc.CountDocuments(ctx, req)
+	require.NoError(t, err)
+	uc.InvalidateCachedDocument(ctx, "orders", "4")
+	fresh, err := uc.CountDocuments(ctx, req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stale.Count)
+	assert.Equal(t, int64(4), fresh.Count)
+}
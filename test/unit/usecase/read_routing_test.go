@@ -0,0 +1,40 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRouting_FirstMatchingRouteWins(t *testing.T) {
+	// Arrange
+	routing, err := usecase.NewReadRouting(usecase.ReadReplica, []usecase.ReadRoute{
+		{Collection: "orders", Mode: usecase.ReadPrimary},
+		{Collection: "order*", Mode: usecase.ReadReplica},
+		{Collection: "accounts_*", Mode: usecase.ReadPrimary},
+	})
+	require.NoError(t, err)
+
+	// Act & Assert
+	assert.Equal(t, usecase.ReadPrimary, routing.ModeFor("orders"))
+	assert.Equal(t, usecase.ReadReplica, routing.ModeFor("order_items"))
+	assert.Equal(t, usecase.ReadPrimary, routing.ModeFor("accounts_eu"))
+	assert.Equal(t, usecase.ReadReplica, routing.ModeFor("reports"), "unmatched collections use the default mode")
+}
+
+func TestNewReadRouting_RejectsInvalidRules(t *testing.T) {
+	_, err := usecase.NewReadRouting("secondary", nil)
+	assert.Error(t, err)
+
+	_, err = usecase.NewReadRouting(usecase.ReadPrimary, []usecase.ReadRoute{{Collection: "[", Mode: usecase.ReadReplica}})
+	assert.Error(t, err)
+
+	_, err = usecase.NewReadRouting(usecase.ReadPrimary, []usecase.ReadRoute{{Collection: "orders", Mode: "fast"}})
+	assert.Error(t, err)
+
+	routing, err := usecase.NewReadRouting("", nil)
+	require.NoError(t, err)
+	assert.Equal(t, usecase.ReadPrimary, routing.ModeFor("orders"), "empty default is primary")
+}
@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YouSangSon/database-service/internal/application/dto"
+	"github.com/YouSangSon/database-service/internal/application/usecase"
+	"github.com/YouSangSon/database-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStaleWhileRevalidateUseCase(t *testing.T, remaining time.Duration) (*usecase.DocumentUseCase, *memoryDocumentRepository) {
+	t.Helper()
+	repo := newMemoryDocumentRepository()
+	uc := usecase.NewDocumentUseCase(repo, &ttlCache{jsonCache: newJSONCache(), remaining: remaining})
+	policies, err := usecase.NewCachePolicies(usecase.CachePolicy{Collection: "*"}, []usecase.CachePolicy{
+		{Collection: "products", Strategy: usecase.CacheReadThrough, TTL: time.Minute, StaleWhileRevalidate: 30 * time.Second},
+	})
+	require.NoError(t, err)
+	uc.SetCachePolicies(policies)
+	repo.put(entity.ReconstructDocument("1", "products", map[string]interface{}{"price": 100}, 1, time.Now(), time.Now()))
+	repo.put(entity.ReconstructDocument("1", "users", map[string]interface{}{"name": "John"}, 1, time.Now(), time.Now()))
+	return uc, repo
+}
+
+func TestGetDocument_ServesStaleEntryAndRevalidatesInBackground(t *testing.T) {
+	// Arrange - 남은 보관 기간이 stale-while-revalidate 기간 안에 있으면 TTL이 지난 항목입니다
+	uc, repo := newStaleWhileRevalidateUseCase(t, 10*time.Second)
+	ctx := context.Background()
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: "1"})
+	require.NoError(t, err)
+	repo.put(entity.ReconstructDocument("1", "products", map[string]interface{}{"price": 120}, 2, time.Now(), time.Now()))
+	repo.findDelay = 50 * time.Millisecond
+
+	// Act
+	start := time.Now()
+	stale, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: "1"})
+	elapsed := time.Since(start)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), stale.Data["price"])
+	assert.Less(t, elapsed, repo.findDelay, "the stale entry is returned without waiting for the database")
+	assert.Eventually(t, func() bool {
+		resp, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: "1"})
+		return err == nil && resp.Version == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestGetDocument_FreshEntryIsNotRevalidated(t *testing.T) {
+	// Arrange - 남은 보관 기간이 stale-while-revalidate 기간보다 길면 아직 TTL 안입니다
+	uc, repo := newStaleWhileRevalidateUseCase(t, 45*time.Second)
+	ctx := context.Background()
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: "1"})
+	require.NoError(t, err)
+
+	// Act
+	_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "products", ID: "1"})
+	time.Sleep(20 * time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.findCalls.Load())
+}
+
+func TestGetDocument_StaleWhileRevalidateIsPerCollection(t *testing.T) {
+	// Arrange - users에는 stale-while-revalidate가 설정되지 않았습니다
+	uc, repo := newStaleWhileRevalidateUseCase(t, 10*time.Second)
+	ctx := context.Background()
+	_, err := uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	require.NoError(t, err)
+
+	// Act
+	_, err = uc.GetDocument(ctx, &dto.GetDocumentRequest{Collection: "users", ID: "1"})
+	time.Sleep(20 * time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), repo.findCalls.Load())
+}